@@ -0,0 +1,15 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+var addCmd = &cobra.Command{
+	Use:   "add",
+	Short: "Add resources",
+	Long:  "Use eksctl anywhere add to add resources to an existing cluster",
+}
+
+func init() {
+	rootCmd.AddCommand(addCmd)
+}
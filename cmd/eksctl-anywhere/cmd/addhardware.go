@@ -0,0 +1,132 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	tinkv1alpha1 "github.com/tinkerbell/tink/api/v1alpha1"
+
+	rufiov1alpha1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1/thirdparty/tinkerbell/rufio"
+	"github.com/aws/eks-anywhere/pkg/dependencies"
+	"github.com/aws/eks-anywhere/pkg/kubeconfig"
+	"github.com/aws/eks-anywhere/pkg/logger"
+	"github.com/aws/eks-anywhere/pkg/providers/tinkerbell/hardware"
+	"github.com/aws/eks-anywhere/pkg/types"
+)
+
+type addHardwareOptions struct {
+	csvPath    string
+	kubeConfig string
+	bmcOptions *hardware.BMCOptions
+}
+
+var aho = &addHardwareOptions{
+	bmcOptions: &hardware.BMCOptions{
+		RPC: &hardware.RPCOpts{},
+	},
+}
+
+var addHardwareCmd = &cobra.Command{
+	Use:          "hardware",
+	Short:        "Add hardware to a management cluster",
+	Long:         "Register the hardware in a CSV file with an existing Tinkerbell management cluster, so it can be used by its clusters",
+	PreRunE:      bindFlagsToViper,
+	SilenceUsage: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if err := aho.addHardware(cmd.Context()); err != nil {
+			return fmt.Errorf("failed to add hardware: %v", err)
+		}
+		return nil
+	},
+}
+
+func init() {
+	addCmd.AddCommand(addHardwareCmd)
+
+	fset := addHardwareCmd.Flags()
+	fset.StringVarP(&aho.csvPath, "filename", "f", "", TinkerbellHardwareCSVFlagDescription)
+	fset.StringVar(&aho.kubeConfig, "kubeconfig", "", "Management cluster kubeconfig file")
+	tinkerbellFlags(fset, aho.bmcOptions.RPC)
+
+	if err := addHardwareCmd.MarkFlagRequired("filename"); err != nil {
+		panic(err)
+	}
+	if err := addHardwareCmd.MarkFlagRequired("kubeconfig"); err != nil {
+		panic(err)
+	}
+}
+
+// hardwareInventoryClient reads and updates the hardware inventory of a management cluster.
+type hardwareInventoryClient interface {
+	AllTinkerbellHardware(ctx context.Context, kubeconfig string) ([]tinkv1alpha1.Hardware, error)
+	AllRufioMachines(ctx context.Context, kubeconfig string) ([]rufiov1alpha1.Machine, error)
+	ApplyKubeSpecFromBytes(ctx context.Context, cluster *types.Cluster, data []byte) error
+}
+
+func (o *addHardwareOptions) addHardware(ctx context.Context) error {
+	if err := kubeconfig.ValidateFilename(o.kubeConfig); err != nil {
+		return err
+	}
+
+	deps, err := dependencies.NewFactory().
+		WithExecutableMountDirs(o.kubeConfig).
+		WithExecutableBuilder().
+		WithKubectl().
+		Build(ctx)
+	if err != nil {
+		return err
+	}
+	defer close(ctx, deps)
+
+	added, err := addHardwareToInventory(ctx, deps.Kubectl, o.csvPath, o.kubeConfig, o.bmcOptions)
+	if err != nil {
+		return err
+	}
+
+	logger.Info("Hardware added to the cluster", "count", added)
+
+	return nil
+}
+
+// addHardwareToInventory validates the hardware in the CSV file against itself and the existing inventory
+// of the management cluster and applies it. It returns the number of hardware added.
+func addHardwareToInventory(ctx context.Context, client hardwareInventoryClient, csvPath, kubeconfigPath string, bmcOptions *hardware.BMCOptions) (int, error) {
+	existingHardware, err := client.AllTinkerbellHardware(ctx, kubeconfigPath)
+	if err != nil {
+		return 0, fmt.Errorf("retrieving hardware inventory: %v", err)
+	}
+
+	existingBMCs, err := client.AllRufioMachines(ctx, kubeconfigPath)
+	if err != nil {
+		return 0, fmt.Errorf("retrieving bmc machines inventory: %v", err)
+	}
+
+	machines, err := hardware.NewNormalizedCSVReaderFromFile(csvPath, bmcOptions)
+	if err != nil {
+		return 0, err
+	}
+
+	catalogue := hardware.NewCatalogue()
+	validator := hardware.NewDefaultMachineValidator()
+	validator.Register(hardware.NoInventoryCollisions(existingHardware, existingBMCs))
+
+	if err := hardware.TranslateAll(machines, hardware.NewMachineCatalogueWriter(catalogue), validator); err != nil {
+		return 0, err
+	}
+
+	if catalogue.TotalHardware() == 0 {
+		return 0, fmt.Errorf("no hardware found in %s", csvPath)
+	}
+
+	hardwareSpec, err := hardware.MarshalCatalogue(catalogue)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := client.ApplyKubeSpecFromBytes(ctx, &types.Cluster{KubeconfigFile: kubeconfigPath}, hardwareSpec); err != nil {
+		return 0, fmt.Errorf("applying hardware: %v", err)
+	}
+
+	return catalogue.TotalHardware(), nil
+}
@@ -0,0 +1,75 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	tinkv1alpha1 "github.com/tinkerbell/tink/api/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	rufiov1alpha1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1/thirdparty/tinkerbell/rufio"
+	"github.com/aws/eks-anywhere/pkg/providers/tinkerbell/hardware"
+	"github.com/aws/eks-anywhere/pkg/types"
+)
+
+type fakeHardwareInventoryClient struct {
+	hardware []tinkv1alpha1.Hardware
+	bmcs     []rufiov1alpha1.Machine
+	applied  []byte
+	err      error
+}
+
+func (f *fakeHardwareInventoryClient) AllTinkerbellHardware(_ context.Context, _ string) ([]tinkv1alpha1.Hardware, error) {
+	return f.hardware, f.err
+}
+
+func (f *fakeHardwareInventoryClient) AllRufioMachines(_ context.Context, _ string) ([]rufiov1alpha1.Machine, error) {
+	return f.bmcs, nil
+}
+
+func (f *fakeHardwareInventoryClient) ApplyKubeSpecFromBytes(_ context.Context, _ *types.Cluster, data []byte) error {
+	f.applied = data
+	return nil
+}
+
+func TestAddHardwareToInventory(t *testing.T) {
+	g := NewWithT(t)
+	client := &fakeHardwareInventoryClient{}
+
+	added, err := addHardwareToInventory(context.Background(), client, "testdata/hardware.csv", "mgmt.kubeconfig", &hardware.BMCOptions{RPC: &hardware.RPCOpts{}})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(added).To(Equal(2))
+	g.Expect(string(client.applied)).To(ContainSubstring("name: worker1"))
+	g.Expect(string(client.applied)).To(ContainSubstring("name: worker2"))
+	g.Expect(string(client.applied)).To(ContainSubstring("kind: Machine"))
+}
+
+func TestAddHardwareToInventoryCollision(t *testing.T) {
+	g := NewWithT(t)
+	client := &fakeHardwareInventoryClient{
+		hardware: []tinkv1alpha1.Hardware{
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "worker0"},
+				Spec: tinkv1alpha1.HardwareSpec{
+					Interfaces: []tinkv1alpha1.Interface{
+						{DHCP: &tinkv1alpha1.DHCP{MAC: "00:00:00:00:00:02", IP: &tinkv1alpha1.IP{Address: "10.10.10.12"}}},
+					},
+				},
+			},
+		},
+	}
+
+	_, err := addHardwareToInventory(context.Background(), client, "testdata/hardware.csv", "mgmt.kubeconfig", &hardware.BMCOptions{RPC: &hardware.RPCOpts{}})
+	g.Expect(err).To(MatchError(ContainSubstring("MACAddress 00:00:00:00:00:02 of hardware worker2 is already used by hardware worker0")))
+	g.Expect(client.applied).To(BeNil())
+}
+
+func TestAddHardwareToInventoryError(t *testing.T) {
+	g := NewWithT(t)
+	client := &fakeHardwareInventoryClient{err: errors.New("connection refused")}
+
+	_, err := addHardwareToInventory(context.Background(), client, "testdata/hardware.csv", "mgmt.kubeconfig", &hardware.BMCOptions{RPC: &hardware.RPCOpts{}})
+	g.Expect(err).To(MatchError("retrieving hardware inventory: connection refused"))
+}
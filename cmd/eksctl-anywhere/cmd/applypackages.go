@@ -23,6 +23,7 @@ var apo = &applyPackageOptions{}
 
 func init() {
 	applyCmd.AddCommand(applyPackagesCommand)
+	withCLIVersionSkewValidation(applyPackagesCommand, packagesTarget)
 
 	applyPackagesCommand.Flags().StringVarP(&apo.fileName, "filename", "f",
 		"", "Filename that contains curated packages custom resources to apply")
@@ -0,0 +1,15 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+var backupCmd = &cobra.Command{
+	Use:   "backup",
+	Short: "Backup resources",
+	Long:  "Use eksctl anywhere backup to save the state of resources, such as management clusters, to an archive",
+}
+
+func init() {
+	rootCmd.AddCommand(backupCmd)
+}
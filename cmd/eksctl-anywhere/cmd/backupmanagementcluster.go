@@ -0,0 +1,82 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/aws/eks-anywhere/pkg/dependencies"
+	"github.com/aws/eks-anywhere/pkg/kubeconfig"
+	"github.com/aws/eks-anywhere/pkg/logger"
+	"github.com/aws/eks-anywhere/pkg/managementbackup"
+	"github.com/aws/eks-anywhere/pkg/types"
+	"github.com/aws/eks-anywhere/pkg/version"
+)
+
+type backupManagementClusterOptions struct {
+	kubeConfig string
+	output     string
+}
+
+var bmco = &backupManagementClusterOptions{}
+
+var backupManagementClusterCmd = &cobra.Command{
+	Use:          "management-cluster <cluster-name>",
+	Short:        "Backup a management cluster",
+	Long:         "Save the CAPI objects and EKS Anywhere objects of a management cluster and all its workload clusters to an archive, to rebuild the management cluster with restore management-cluster if it's lost",
+	PreRunE:      bindFlagsToViper,
+	SilenceUsage: true,
+	Args:         cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if err := bmco.backupManagementCluster(cmd.Context(), args[0]); err != nil {
+			return fmt.Errorf("failed to backup management cluster: %v", err)
+		}
+		return nil
+	},
+}
+
+func init() {
+	backupCmd.AddCommand(backupManagementClusterCmd)
+	backupManagementClusterCmd.Flags().StringVar(&bmco.kubeConfig, "kubeconfig", "", "Management cluster kubeconfig file")
+	backupManagementClusterCmd.Flags().StringVarP(&bmco.output, "output", "o", "", "Path of the backup archive. Defaults to <cluster-name>-backup-<timestamp>.tar.gz")
+}
+
+func (o *backupManagementClusterOptions) backupManagementCluster(ctx context.Context, clusterName string) error {
+	kubeconfigPath, err := kubeconfig.ResolveAndValidateFilename(o.kubeConfig, clusterName)
+	if err != nil {
+		return err
+	}
+
+	output := o.output
+	if output == "" {
+		output = fmt.Sprintf("%s-backup-%s.tar.gz", clusterName, time.Now().Format("2006-01-02T15_04_05"))
+	}
+
+	deps, err := dependencies.NewFactory().
+		WithExecutableMountDirs(kubeconfigPath).
+		WithClusterctl().
+		WithUnAuthKubeClient().
+		Build(ctx)
+	if err != nil {
+		return err
+	}
+	defer close(ctx, deps)
+
+	managementCluster := &types.Cluster{
+		Name:           clusterName,
+		KubeconfigFile: kubeconfigPath,
+	}
+	client := deps.UnAuthKubeClient.KubeconfigClient(kubeconfigPath)
+
+	backupper := managementbackup.NewBackupper(client, deps.Clusterctl, version.Get().GitVersion)
+	metadata, err := backupper.Backup(ctx, managementCluster, output)
+	if err != nil {
+		return err
+	}
+
+	logger.MarkSuccess("Management cluster backup saved", "cluster", clusterName, "clusters", len(metadata.Clusters), "archive", output)
+
+	return nil
+}
@@ -18,6 +18,7 @@ import (
 	"github.com/aws/eks-anywhere/pkg/types"
 	"github.com/aws/eks-anywhere/pkg/validations"
 	"github.com/aws/eks-anywhere/pkg/validations/createvalidations"
+	"github.com/aws/eks-anywhere/pkg/version"
 	"github.com/aws/eks-anywhere/pkg/workflows/management"
 	"github.com/aws/eks-anywhere/pkg/workflows/workload"
 )
@@ -200,6 +201,12 @@ func (cc *createClusterOptions) createCluster(cmd *cobra.Command, _ []string) er
 	}
 	defer close(ctx, deps)
 
+	if clusterConfig.IsManaged() && !skippedValidations[validations.CLIVersionSkew] {
+		if err := validations.ValidateManagementClusterCLIVersionSkew(ctx, deps.UnAuthKubectlClient, version.Get().GitVersion, clusterSpec.ManagementCluster); err != nil {
+			return err
+		}
+	}
+
 	clusterSpec, err = deps.CreateClusterDefaulter.Run(ctx, clusterSpec)
 	if err != nil {
 		return err
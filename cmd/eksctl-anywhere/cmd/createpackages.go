@@ -23,6 +23,7 @@ var cpo = &createPackageOptions{}
 
 func init() {
 	createCmd.AddCommand(createPackagesCommand)
+	withCLIVersionSkewValidation(createPackagesCommand, packagesTarget)
 
 	createPackagesCommand.Flags().StringVarP(&cpo.fileName, "filename", "f",
 		"", "Filename that contains curated packages custom resources to create")
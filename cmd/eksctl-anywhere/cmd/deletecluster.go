@@ -55,6 +55,7 @@ var deleteClusterCmd = &cobra.Command{
 
 func init() {
 	deleteCmd.AddCommand(deleteClusterCmd)
+	withCLIVersionSkewValidation(deleteClusterCmd, clusterArgOrConfigTarget)
 	deleteClusterCmd.Flags().StringVarP(&dc.fileName, "filename", "f", "", "Filename that contains EKS-A cluster configuration, required if <cluster-name> is not provided")
 	deleteClusterCmd.Flags().StringVarP(&dc.wConfig, "w-config", "w", "", "Kubeconfig file to use when deleting a workload cluster")
 	deleteClusterCmd.Flags().BoolVar(&dc.forceCleanup, "force-cleanup", false, "Force deletion of previously created bootstrap cluster")
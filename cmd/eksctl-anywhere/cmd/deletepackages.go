@@ -9,6 +9,7 @@ import (
 
 	"github.com/aws/eks-anywhere/pkg/curatedpackages"
 	"github.com/aws/eks-anywhere/pkg/kubeconfig"
+	"github.com/aws/eks-anywhere/pkg/logger"
 )
 
 type deletePackageOptions struct {
@@ -17,12 +18,17 @@ type deletePackageOptions struct {
 	kubeConfig      string
 	clusterName     string
 	bundlesOverride string
+	// all deletes every package of the cluster instead of the named ones.
+	all bool
+	// removeOrphans uninstalls the Helm releases left behind by the deleted packages.
+	removeOrphans bool
 }
 
 var delPkgOpts = deletePackageOptions{}
 
 func init() {
 	deleteCmd.AddCommand(deletePackageCommand)
+	withCLIVersionSkewValidation(deletePackageCommand, packagesTarget)
 
 	deletePackageCommand.Flags().StringVar(&delPkgOpts.kubeConfig, "kubeconfig", "",
 		"Path to an optional kubeconfig file to use.")
@@ -30,6 +36,10 @@ func init() {
 		"Cluster for package deletion.")
 	deletePackageCommand.Flags().StringVar(&delPkgOpts.bundlesOverride, "bundles-override", "",
 		"Override default Bundles manifest (not recommended)")
+	deletePackageCommand.Flags().BoolVar(&delPkgOpts.all, "all", false,
+		"Delete all the packages of the cluster.")
+	deletePackageCommand.Flags().BoolVar(&delPkgOpts.removeOrphans, "remove-orphans", false,
+		"Uninstall the Helm releases left behind by the deleted packages.")
 	if err := deletePackageCommand.MarkFlagRequired("cluster"); err != nil {
 		log.Fatalf("marking cluster flag as required: %s", err)
 	}
@@ -45,10 +55,20 @@ var deletePackageCommand = &cobra.Command{
 	RunE: func(cmd *cobra.Command, args []string) error {
 		return deleteResources(cmd.Context(), args)
 	},
-	Args:       cobra.MinimumNArgs(1),
+	Args:       validateDeletePackageArgs,
 	Deprecated: "use `kubectl delete package` instead",
 }
 
+func validateDeletePackageArgs(cmd *cobra.Command, args []string) error {
+	if delPkgOpts.all && len(args) > 0 {
+		return fmt.Errorf("package names can't be specified with --all")
+	}
+	if !delPkgOpts.all && len(args) == 0 {
+		return fmt.Errorf("requires at least 1 package name or --all")
+	}
+	return nil
+}
+
 func deleteResources(ctx context.Context, args []string) error {
 	kubeConfig, err := kubeconfig.ResolveAndValidateFilename(delPkgOpts.kubeConfig, "")
 	if err != nil {
@@ -60,12 +80,34 @@ func deleteResources(ctx context.Context, args []string) error {
 	}
 	packages := curatedpackages.NewPackageClient(
 		deps.Kubectl,
+		curatedpackages.WithReleaseClient(deps.Helm),
 	)
 
-	err = packages.DeletePackages(ctx, args, kubeConfig, delPkgOpts.clusterName)
+	deleted := args
+	if delPkgOpts.all {
+		deleted, err = packages.DeleteAllPackages(ctx, kubeConfig, delPkgOpts.clusterName)
+	} else {
+		err = packages.DeletePackages(ctx, args, kubeConfig, delPkgOpts.clusterName)
+	}
 	if err != nil {
 		return err
 	}
 
-	return nil
+	orphans, err := packages.OrphanedReleases(ctx, deleted, kubeConfig, delPkgOpts.clusterName)
+	if err != nil {
+		return err
+	}
+	if len(orphans) == 0 {
+		return nil
+	}
+
+	if !delPkgOpts.removeOrphans {
+		for _, r := range orphans {
+			logger.Info("Warning: helm release left behind by deleted package", "name", r.Name, "namespace", r.Namespace)
+		}
+		logger.Info("Run the command again with --remove-orphans to uninstall them")
+		return nil
+	}
+
+	return packages.RemoveReleases(ctx, orphans, kubeConfig)
 }
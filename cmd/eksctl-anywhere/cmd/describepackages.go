@@ -23,6 +23,7 @@ var dpo = &describePackagesOption{}
 
 func init() {
 	describeCmd.AddCommand(describePackagesCommand)
+	withCLIVersionSkewValidation(describePackagesCommand, packagesTarget)
 
 	describePackagesCommand.Flags().StringVar(&dpo.kubeConfig, "kubeconfig", "",
 		"Path to an optional kubeconfig file to use.")
@@ -42,6 +43,9 @@ var describePackagesCommand = &cobra.Command{
 	PreRunE:      preRunPackages,
 	SilenceUsage: true,
 	RunE: func(cmd *cobra.Command, args []string) error {
+		if err := useReadOnlyCredentials(); err != nil {
+			return err
+		}
 		if err := describeResources(cmd.Context(), args); err != nil {
 			return err
 		}
@@ -44,6 +44,9 @@ func init() {
 	downloadImagesCmd.Flags().BoolVar(&downloadImagesRunner.includePackages, "include-packages", false, "this flag no longer works, use copy packages instead")
 	downloadImagesCmd.Flag("include-packages").Deprecated = "use copy packages command"
 	downloadImagesCmd.Flags().StringVarP(&downloadImagesRunner.bundlesOverride, "bundles-override", "", "", "Override default Bundles manifest (not recommended)")
+	downloadImagesCmd.Flags().StringVar(&downloadImagesRunner.fromVersion, "from-version", "", "Only download the images and charts that are new or changed since this EKS Anywhere version")
+	downloadImagesCmd.Flags().StringVar(&downloadImagesRunner.fromBundles, "from-bundles", "", "Only download the images and charts that are new or changed since this Bundles manifest")
+	downloadImagesCmd.MarkFlagsMutuallyExclusive("from-version", "from-bundles")
 	downloadImagesCmd.Flags().BoolVar(&downloadImagesRunner.insecure, "insecure", false, "Flag to indicate skipping TLS verification while downloading helm charts")
 }
 
@@ -52,6 +55,8 @@ var downloadImagesRunner = downloadImagesCommand{}
 type downloadImagesCommand struct {
 	outputFile      string
 	bundlesOverride string
+	fromVersion     string
+	fromBundles     string
 	includePackages bool
 	insecure        bool
 }
@@ -96,6 +101,8 @@ func (c downloadImagesCommand) Run(ctx context.Context) error {
 		Packager:           packagerForFile(c.outputFile),
 		ManifestDownloader: oras.NewBundleDownloader(deps.Logger, downloadFolder),
 		BundlesOverride:    c.bundlesOverride,
+		FromVersion:        c.fromVersion,
+		FromBundles:        c.fromBundles,
 	}
 
 	return downloadArtifacts.Run(ctx)
@@ -0,0 +1,15 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+var etcdCmd = &cobra.Command{
+	Use:   "etcd",
+	Short: "Manage the etcd of a cluster",
+	Long:  "Use eksctl anywhere etcd to take snapshots of the etcd of a cluster and restore a cluster from them",
+}
+
+func init() {
+	rootCmd.AddCommand(etcdCmd)
+}
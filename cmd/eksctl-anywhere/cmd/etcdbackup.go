@@ -0,0 +1,127 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/clients/kubernetes"
+	"github.com/aws/eks-anywhere/pkg/etcdbackup"
+	"github.com/aws/eks-anywhere/pkg/executables"
+	"github.com/aws/eks-anywhere/pkg/kubeconfig"
+	"github.com/aws/eks-anywhere/pkg/logger"
+	"github.com/aws/eks-anywhere/pkg/nodessh"
+)
+
+type etcdBackupOptions struct {
+	kubeConfig     string
+	namespace      string
+	destination    string
+	privateKeyPath string
+	user           string
+}
+
+var ebo = &etcdBackupOptions{}
+
+var etcdBackupCmd = &cobra.Command{
+	Use:          "backup <cluster-name>",
+	Short:        "Take a snapshot of the etcd of a cluster",
+	Long:         "Take a snapshot of the etcd of a cluster over SSH, or in the machine container for Docker clusters, on its first control plane machine with stacked etcd or its first etcd machine with external etcd, and save it to a local directory or an S3 bucket",
+	PreRunE:      bindFlagsToViper,
+	SilenceUsage: true,
+	Args:         cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if err := ebo.backup(cmd.Context(), args[0]); err != nil {
+			return fmt.Errorf("failed to back up etcd: %v", err)
+		}
+		return nil
+	},
+}
+
+func init() {
+	etcdCmd.AddCommand(etcdBackupCmd)
+	withCLIVersionSkewValidation(etcdBackupCmd, clusterArgTarget)
+	etcdBackupCmd.Flags().StringVar(&ebo.kubeConfig, "kubeconfig", "", "Management cluster kubeconfig file. Defaults to the cluster kubeconfig")
+	etcdBackupCmd.Flags().StringVarP(&ebo.namespace, "namespace", "n", "default", "Namespace of the cluster")
+	etcdBackupCmd.Flags().StringVar(&ebo.destination, "destination", ".", "Local directory or S3 location, like s3://<bucket>/<prefix>, to save the snapshot to")
+	etcdBackupCmd.Flags().StringVar(&ebo.privateKeyPath, "ssh-key", "", "Private key file to log in to the machines with. Defaults to the key generated for the cluster, <cluster-name>/eks-a-id_rsa")
+	etcdBackupCmd.Flags().StringVar(&ebo.user, "ssh-user", "", "User to log in to the machines with. Defaults to the user of their machine config")
+}
+
+func (o *etcdBackupOptions) backup(ctx context.Context, clusterName string) error {
+	store, err := etcdbackup.NewStore(o.destination)
+	if err != nil {
+		return err
+	}
+
+	management, err := etcdManagementClient(o.kubeConfig, clusterName)
+	if err != nil {
+		return err
+	}
+
+	cluster, machines, err := etcdMachines(ctx, management, clusterName, o.namespace, o.user)
+	if err != nil {
+		return err
+	}
+
+	snapshotter := etcdbackup.NewSnapshotter(etcdRunner(cluster, o.privateKeyPath))
+	snapshot, err := snapshotter.Snapshot(ctx, machines)
+	if err != nil {
+		return err
+	}
+
+	location, err := store.Save(ctx, etcdbackup.SnapshotName(clusterName, time.Now()), snapshot)
+	if err != nil {
+		return err
+	}
+
+	logger.MarkSuccess("etcd snapshot saved", "cluster", clusterName, "location", location)
+	return nil
+}
+
+func etcdManagementClient(kubeConfig, clusterName string) (client.Client, error) {
+	kubeconfigPath, err := kubeconfig.ResolveAndValidateFilename(kubeConfig, clusterName)
+	if err != nil {
+		return nil, err
+	}
+
+	return kubernetes.NewRuntimeClientFromFileName(kubeconfigPath)
+}
+
+func etcdMachines(ctx context.Context, management client.Client, clusterName, namespace, user string) (*anywherev1.Cluster, *etcdbackup.Machines, error) {
+	cluster := &anywherev1.Cluster{}
+	if err := management.Get(ctx, client.ObjectKey{Name: clusterName, Namespace: namespace}, cluster); err != nil {
+		return nil, nil, fmt.Errorf("reading cluster %s: %v", clusterName, err)
+	}
+
+	machines, err := etcdbackup.ResolveMachines(ctx, management, cluster)
+	if err != nil {
+		return nil, nil, err
+	}
+	if user != "" {
+		machines.SetUser(user)
+	}
+
+	return cluster, machines, nil
+}
+
+// etcdRunner returns the runner of the commands on the machines of the cluster, which runs them
+// in the machine containers for Docker clusters and over SSH otherwise.
+func etcdRunner(cluster *anywherev1.Cluster, privateKeyPath string) etcdbackup.Runner {
+	if cluster.Spec.DatacenterRef.Kind == anywherev1.DockerDatacenterKind {
+		return etcdbackup.NewDockerRunner(executables.BuildDockerExecutable())
+	}
+
+	return etcdbackup.NewSSHRunner(executables.NewLocalExecutablesBuilder().BuildSSHExecutable(), sshPrivateKeyPath(privateKeyPath, cluster.Name))
+}
+
+func sshPrivateKeyPath(privateKeyPath, clusterName string) string {
+	if privateKeyPath != "" {
+		return privateKeyPath
+	}
+	return nodessh.DefaultPrivateKeyPath(clusterName)
+}
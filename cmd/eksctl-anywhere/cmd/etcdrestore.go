@@ -0,0 +1,99 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/aws/eks-anywhere/pkg/clusterpause"
+	"github.com/aws/eks-anywhere/pkg/etcdbackup"
+	"github.com/aws/eks-anywhere/pkg/logger"
+	"github.com/aws/eks-anywhere/pkg/retrier"
+)
+
+// resumeAfterRestoreTimeout is how long to wait for the API server to serve the restored
+// cluster again before resuming its reconciliation.
+const resumeAfterRestoreTimeout = 10 * time.Minute
+
+type etcdRestoreOptions struct {
+	kubeConfig     string
+	namespace      string
+	snapshot       string
+	privateKeyPath string
+	user           string
+	forceUnlock    bool
+}
+
+var ero = &etcdRestoreOptions{}
+
+var etcdRestoreCmd = &cobra.Command{
+	Use:          "restore <cluster-name>",
+	Short:        "Restore the etcd of a cluster from a snapshot",
+	Long:         "Pause the reconciliation of a cluster, restore the snapshot on all its etcd members over SSH, or in the machine containers for Docker clusters, while its control plane is stopped, and resume the reconciliation once the control plane is back",
+	PreRunE:      bindFlagsToViper,
+	SilenceUsage: true,
+	Args:         cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if err := ero.restore(cmd.Context(), args[0]); err != nil {
+			return fmt.Errorf("failed to restore etcd: %v", err)
+		}
+		return nil
+	},
+}
+
+func init() {
+	etcdCmd.AddCommand(etcdRestoreCmd)
+	withCLIVersionSkewValidation(etcdRestoreCmd, clusterArgTarget)
+	etcdRestoreCmd.Flags().StringVar(&ero.kubeConfig, "kubeconfig", "", "Management cluster kubeconfig file. Defaults to the cluster kubeconfig")
+	etcdRestoreCmd.Flags().StringVarP(&ero.namespace, "namespace", "n", "default", "Namespace of the cluster")
+	etcdRestoreCmd.Flags().StringVar(&ero.snapshot, "snapshot", "", "Local file or S3 location, like s3://<bucket>/<key>, of the snapshot to restore")
+	etcdRestoreCmd.Flags().StringVar(&ero.privateKeyPath, "ssh-key", "", "Private key file to log in to the machines with. Defaults to the key generated for the cluster, <cluster-name>/eks-a-id_rsa")
+	etcdRestoreCmd.Flags().StringVar(&ero.user, "ssh-user", "", "User to log in to the machines with. Defaults to the user of their machine config")
+	etcdRestoreCmd.Flags().BoolVar(&ero.forceUnlock, "force-unlock", false, "Remove the operation lock of the cluster left by an interrupted operation before restoring it")
+
+	if err := etcdRestoreCmd.MarkFlagRequired("snapshot"); err != nil {
+		logger.Fatal(err, "marking snapshot as required")
+	}
+}
+
+func (o *etcdRestoreOptions) restore(ctx context.Context, clusterName string) error {
+	store, err := etcdbackup.NewStore(o.snapshot)
+	if err != nil {
+		return err
+	}
+
+	snapshot, err := store.Read(ctx, o.snapshot)
+	if err != nil {
+		return err
+	}
+
+	return withClusterLock(ctx, o.kubeConfig, clusterName, "restore etcd", o.forceUnlock, func(management client.Client) error {
+		cluster, machines, err := etcdMachines(ctx, management, clusterName, o.namespace, o.user)
+		if err != nil {
+			return err
+		}
+
+		if err := clusterpause.Pause(ctx, management, clusterName, o.namespace); err != nil {
+			return err
+		}
+
+		snapshotter := etcdbackup.NewSnapshotter(etcdRunner(cluster, o.privateKeyPath))
+		if err := snapshotter.Restore(ctx, machines, snapshot); err != nil {
+			return fmt.Errorf("%v, the cluster is left paused, resume it with resume cluster once etcd is healthy", err)
+		}
+
+		logger.Info("Waiting for the control plane to serve the restored cluster")
+		r := retrier.New(resumeAfterRestoreTimeout, retrier.WithRetryPolicy(retrier.BackOffPolicy(10*time.Second)))
+		if err := r.Retry(func() error {
+			return clusterpause.Resume(ctx, management, clusterName, o.namespace)
+		}); err != nil {
+			return fmt.Errorf("resuming cluster after restore: %v", err)
+		}
+
+		logger.MarkSuccess("etcd restored", "cluster", clusterName, "snapshot", o.snapshot)
+		return nil
+	})
+}
@@ -6,7 +6,6 @@ import (
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
-	"github.com/spf13/viper"
 
 	"github.com/aws/eks-anywhere/cmd/eksctl-anywhere/cmd/aflag"
 	"github.com/aws/eks-anywhere/pkg/api/v1alpha1"
@@ -74,9 +73,7 @@ func NewGenerateTinkerbellTemplateConfig() *cobra.Command {
 
 			// Reset the logger before evaluating anything in-case logic higher up the call path
 			// needs the logger.
-			if err := logger.Init(logger.Options{
-				Level: viper.GetInt("verbosity"),
-			}); err != nil {
+			if err := logger.Init(loggerOptions()); err != nil {
 				return err
 			}
 
@@ -254,6 +254,130 @@ func generateClusterConfig(clusterName string) error {
 		}
 
 		machineGroupYaml = append(machineGroupYaml, cpMcYaml, workerMcYaml, etcdMcYaml)
+	case constants.ProxmoxProviderName:
+		datacenterConfig := v1alpha1.NewProxmoxDatacenterConfigGenerate(clusterName)
+		dcYaml, err := yaml.Marshal(datacenterConfig)
+		if err != nil {
+			return fmt.Errorf("generating cluster yaml: %v", err)
+		}
+		datacenterYaml = dcYaml
+		clusterConfigOpts = append(clusterConfigOpts, v1alpha1.WithDatacenterRef(datacenterConfig))
+		clusterConfigOpts = append(clusterConfigOpts, v1alpha1.WithClusterEndpoint())
+		clusterConfigOpts = append(clusterConfigOpts,
+			v1alpha1.ControlPlaneConfigCount(1),
+			v1alpha1.WorkerNodeConfigCount(1),
+			v1alpha1.WorkerNodeConfigName(constants.DefaultWorkerNodeGroupName),
+		)
+
+		cpMachineConfig := v1alpha1.NewProxmoxMachineConfigGenerate(providers.GetControlPlaneNodeName(clusterName))
+		workerMachineConfig := v1alpha1.NewProxmoxMachineConfigGenerate(clusterName)
+		clusterConfigOpts = append(clusterConfigOpts,
+			v1alpha1.WithCPMachineGroupRef(cpMachineConfig),
+			v1alpha1.WithWorkerMachineGroupRef(workerMachineConfig),
+		)
+
+		cpMcYaml, err := yaml.Marshal(cpMachineConfig)
+		if err != nil {
+			return fmt.Errorf("generating cluster yaml: %v", err)
+		}
+		workerMcYaml, err := yaml.Marshal(workerMachineConfig)
+		if err != nil {
+			return fmt.Errorf("generating cluster yaml: %v", err)
+		}
+		machineGroupYaml = append(machineGroupYaml, cpMcYaml, workerMcYaml)
+	case constants.OpenStackProviderName:
+		datacenterConfig := v1alpha1.NewOpenStackDatacenterConfigGenerate(clusterName)
+		dcYaml, err := yaml.Marshal(datacenterConfig)
+		if err != nil {
+			return fmt.Errorf("generating cluster yaml: %v", err)
+		}
+		datacenterYaml = dcYaml
+		clusterConfigOpts = append(clusterConfigOpts, v1alpha1.WithDatacenterRef(datacenterConfig))
+		clusterConfigOpts = append(clusterConfigOpts, v1alpha1.WithClusterEndpoint())
+		clusterConfigOpts = append(clusterConfigOpts,
+			v1alpha1.ControlPlaneConfigCount(1),
+			v1alpha1.WorkerNodeConfigCount(1),
+			v1alpha1.WorkerNodeConfigName(constants.DefaultWorkerNodeGroupName),
+		)
+
+		cpMachineConfig := v1alpha1.NewOpenStackMachineConfigGenerate(providers.GetControlPlaneNodeName(clusterName))
+		workerMachineConfig := v1alpha1.NewOpenStackMachineConfigGenerate(clusterName)
+		clusterConfigOpts = append(clusterConfigOpts,
+			v1alpha1.WithCPMachineGroupRef(cpMachineConfig),
+			v1alpha1.WithWorkerMachineGroupRef(workerMachineConfig),
+		)
+
+		cpMcYaml, err := yaml.Marshal(cpMachineConfig)
+		if err != nil {
+			return fmt.Errorf("generating cluster yaml: %v", err)
+		}
+		workerMcYaml, err := yaml.Marshal(workerMachineConfig)
+		if err != nil {
+			return fmt.Errorf("generating cluster yaml: %v", err)
+		}
+		machineGroupYaml = append(machineGroupYaml, cpMcYaml, workerMcYaml)
+	case constants.AzureStackHCIProviderName:
+		datacenterConfig := v1alpha1.NewAzureStackHCIDatacenterConfigGenerate(clusterName)
+		dcYaml, err := yaml.Marshal(datacenterConfig)
+		if err != nil {
+			return fmt.Errorf("generating cluster yaml: %v", err)
+		}
+		datacenterYaml = dcYaml
+		clusterConfigOpts = append(clusterConfigOpts, v1alpha1.WithDatacenterRef(datacenterConfig))
+		clusterConfigOpts = append(clusterConfigOpts, v1alpha1.WithClusterEndpoint())
+		clusterConfigOpts = append(clusterConfigOpts,
+			v1alpha1.ControlPlaneConfigCount(1),
+			v1alpha1.WorkerNodeConfigCount(1),
+			v1alpha1.WorkerNodeConfigName(constants.DefaultWorkerNodeGroupName),
+		)
+
+		cpMachineConfig := v1alpha1.NewAzureStackHCIMachineConfigGenerate(providers.GetControlPlaneNodeName(clusterName))
+		workerMachineConfig := v1alpha1.NewAzureStackHCIMachineConfigGenerate(clusterName)
+		clusterConfigOpts = append(clusterConfigOpts,
+			v1alpha1.WithCPMachineGroupRef(cpMachineConfig),
+			v1alpha1.WithWorkerMachineGroupRef(workerMachineConfig),
+		)
+
+		cpMcYaml, err := yaml.Marshal(cpMachineConfig)
+		if err != nil {
+			return fmt.Errorf("generating cluster yaml: %v", err)
+		}
+		workerMcYaml, err := yaml.Marshal(workerMachineConfig)
+		if err != nil {
+			return fmt.Errorf("generating cluster yaml: %v", err)
+		}
+		machineGroupYaml = append(machineGroupYaml, cpMcYaml, workerMcYaml)
+	case constants.HarvesterProviderName:
+		datacenterConfig := v1alpha1.NewHarvesterDatacenterConfigGenerate(clusterName)
+		dcYaml, err := yaml.Marshal(datacenterConfig)
+		if err != nil {
+			return fmt.Errorf("generating cluster yaml: %v", err)
+		}
+		datacenterYaml = dcYaml
+		clusterConfigOpts = append(clusterConfigOpts, v1alpha1.WithDatacenterRef(datacenterConfig))
+		clusterConfigOpts = append(clusterConfigOpts, v1alpha1.WithClusterEndpoint())
+		clusterConfigOpts = append(clusterConfigOpts,
+			v1alpha1.ControlPlaneConfigCount(1),
+			v1alpha1.WorkerNodeConfigCount(1),
+			v1alpha1.WorkerNodeConfigName(constants.DefaultWorkerNodeGroupName),
+		)
+
+		cpMachineConfig := v1alpha1.NewHarvesterMachineConfigGenerate(providers.GetControlPlaneNodeName(clusterName))
+		workerMachineConfig := v1alpha1.NewHarvesterMachineConfigGenerate(clusterName)
+		clusterConfigOpts = append(clusterConfigOpts,
+			v1alpha1.WithCPMachineGroupRef(cpMachineConfig),
+			v1alpha1.WithWorkerMachineGroupRef(workerMachineConfig),
+		)
+
+		cpMcYaml, err := yaml.Marshal(cpMachineConfig)
+		if err != nil {
+			return fmt.Errorf("generating cluster yaml: %v", err)
+		}
+		workerMcYaml, err := yaml.Marshal(workerMachineConfig)
+		if err != nil {
+			return fmt.Errorf("generating cluster yaml: %v", err)
+		}
+		machineGroupYaml = append(machineGroupYaml, cpMcYaml, workerMcYaml)
 	default:
 		return fmt.Errorf("not a valid provider")
 	}
@@ -0,0 +1,92 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/cluster"
+	"github.com/aws/eks-anywhere/pkg/kubeconfig"
+)
+
+type generateOIDCKubeconfigOptions struct {
+	fileName   string
+	kubeConfig string
+	outputPath string
+}
+
+var goko = &generateOIDCKubeconfigOptions{}
+
+var generateOIDCKubeconfigCmd = &cobra.Command{
+	Use:   "oidc-kubeconfig",
+	Short: "Generate a kubeconfig authenticating with the cluster OIDC identity provider",
+	Long: "Generate a kubeconfig for a management or workload cluster configured with an OIDC identity provider. " +
+		"The kubeconfig gets ID tokens from the identity provider with the kubectl oidc-login plugin, instead of embedding client certificates.",
+	PreRunE:      bindFlagsToViper,
+	SilenceUsage: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return goko.generateOIDCKubeconfig()
+	},
+}
+
+func init() {
+	generateCmd.AddCommand(generateOIDCKubeconfigCmd)
+
+	fset := generateOIDCKubeconfigCmd.Flags()
+	fset.StringVarP(&goko.fileName, "filename", "f", "", "Filename that contains EKS-A cluster configuration")
+	fset.StringVar(&goko.kubeConfig, "kubeconfig", "", "Path to the admin kubeconfig of the cluster, defaults to the one generated for the cluster in the current directory")
+	fset.StringVarP(&goko.outputPath, "output", "o", "", "Path to write the OIDC kubeconfig to, defaults to stdout")
+
+	if err := generateOIDCKubeconfigCmd.MarkFlagRequired("filename"); err != nil {
+		panic(err)
+	}
+}
+
+func (o *generateOIDCKubeconfigOptions) generateOIDCKubeconfig() error {
+	config, err := cluster.ParseConfigFromFile(o.fileName)
+	if err != nil {
+		return err
+	}
+
+	oidc := clusterOIDCConfig(config)
+	if oidc == nil {
+		return fmt.Errorf("cluster %s has no OIDC identity provider", config.Cluster.Name)
+	}
+
+	kubeConfig, err := kubeconfig.ResolveAndValidateFilename(o.kubeConfig, config.Cluster.Name)
+	if err != nil {
+		return err
+	}
+	adminKubeconfig, err := os.ReadFile(kubeConfig)
+	if err != nil {
+		return fmt.Errorf("reading kubeconfig: %v", err)
+	}
+
+	oidcKubeconfig, err := kubeconfig.NewOIDCKubeconfig(config.Cluster.Name, adminKubeconfig, &oidc.Spec)
+	if err != nil {
+		return fmt.Errorf("generating oidc kubeconfig: %v", err)
+	}
+
+	if o.outputPath == "" {
+		_, err = os.Stdout.Write(oidcKubeconfig)
+		return err
+	}
+
+	if err := os.WriteFile(o.outputPath, oidcKubeconfig, 0o600); err != nil {
+		return fmt.Errorf("writing oidc kubeconfig: %v", err)
+	}
+
+	return nil
+}
+
+func clusterOIDCConfig(config *cluster.Config) *v1alpha1.OIDCConfig {
+	for _, ref := range config.Cluster.Spec.IdentityProviderRefs {
+		if ref.Kind == v1alpha1.OIDCConfigKind {
+			return config.OIDCConfig(ref.Name)
+		}
+	}
+
+	return nil
+}
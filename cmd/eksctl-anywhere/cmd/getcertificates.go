@@ -0,0 +1,99 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/certificates"
+	"github.com/aws/eks-anywhere/pkg/clients/kubernetes"
+	"github.com/aws/eks-anywhere/pkg/kubeconfig"
+	"github.com/aws/eks-anywhere/pkg/logger"
+)
+
+type getCertificatesOptions struct {
+	kubeConfig string
+	namespace  string
+	output     string
+}
+
+var gcerto = &getCertificatesOptions{}
+
+var getCertificatesCmd = &cobra.Command{
+	Use:          "certificates <cluster-name>",
+	Short:        "Get the certificate expiry of a cluster",
+	Long:         "List the expiry of the serving certificates of the API server, etcd and kubelet on every control plane and external etcd node of a cluster",
+	PreRunE:      bindFlagsToViper,
+	SilenceUsage: true,
+	Args:         cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if err := gcerto.getCertificates(cmd.Context(), args[0]); err != nil {
+			return fmt.Errorf("failed to get certificates: %v", err)
+		}
+		return nil
+	},
+}
+
+func init() {
+	getCmd.AddCommand(getCertificatesCmd)
+	getCertificatesCmd.Flags().StringVar(&gcerto.kubeConfig, "kubeconfig", "", "Management cluster kubeconfig file. Defaults to the cluster kubeconfig")
+	getCertificatesCmd.Flags().StringVarP(&gcerto.namespace, "namespace", "n", "default", "Namespace of the cluster")
+	getCertificatesCmd.Flags().StringVarP(&gcerto.output, outputFlagName, "o", outputDefault, "Output format: text|json|yaml")
+}
+
+func (o *getCertificatesOptions) getCertificates(ctx context.Context, clusterName string) error {
+	kubeconfigPath, err := kubeconfig.ResolveAndValidateFilename(o.kubeConfig, clusterName)
+	if err != nil {
+		return err
+	}
+
+	management, err := kubernetes.NewRuntimeClientFromFileName(kubeconfigPath)
+	if err != nil {
+		return err
+	}
+
+	cluster := &anywherev1.Cluster{}
+	if err := management.Get(ctx, client.ObjectKey{Name: clusterName, Namespace: o.namespace}, cluster); err != nil {
+		return fmt.Errorf("reading cluster %s: %v", clusterName, err)
+	}
+
+	certs, err := certificates.NewCertificateScanner(management, logger.Get()).ComponentCertificates(ctx, cluster)
+	if err != nil {
+		return err
+	}
+
+	serialized, err := serializeCertificates(certs, o.output)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(serialized)
+
+	return nil
+}
+
+func serializeCertificates(certs []certificates.ComponentCertificate, outputFormat string) (string, error) {
+	switch outputFormat {
+	case outputText:
+		if len(certs) == 0 {
+			return "No certificates found", nil
+		}
+		return serializeTable(
+			"MACHINE\tIP\tROLE\tCOMPONENT\tEXPIRES\tDAYS LEFT",
+			len(certs),
+			func(i int) string {
+				c := certs[i]
+				if c.Error != "" {
+					return fmt.Sprintf("%s\t%s\t%s\t%s\t%s\t%s", c.Machine, c.IP, c.Role, c.Component, "Unknown", c.Error)
+				}
+				return fmt.Sprintf("%s\t%s\t%s\t%s\t%s\t%d", c.Machine, c.IP, c.Role, c.Component, c.NotAfter.Format(time.RFC3339), c.ExpiresInDays)
+			},
+		)
+	default:
+		return serializeList(certs, outputFormat)
+	}
+}
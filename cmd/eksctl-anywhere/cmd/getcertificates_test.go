@@ -0,0 +1,57 @@
+package cmd
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/aws/eks-anywhere/pkg/certificates"
+)
+
+func certificatesForTest() []certificates.ComponentCertificate {
+	return []certificates.ComponentCertificate{
+		{
+			Machine:       "my-cluster-cp-xyz",
+			IP:            "192.168.0.10",
+			Role:          "control-plane",
+			Component:     certificates.APIServerComponent,
+			NotAfter:      time.Date(2027, 3, 1, 0, 0, 0, 0, time.UTC),
+			ExpiresInDays: 135,
+		},
+		{
+			Machine:   "my-cluster-etcd-abc",
+			IP:        "192.168.0.20",
+			Role:      "etcd",
+			Component: certificates.EtcdServerComponent,
+			Error:     "connecting to 192.168.0.20:2379: i/o timeout",
+		},
+	}
+}
+
+func TestSerializeCertificatesText(t *testing.T) {
+	g := NewWithT(t)
+
+	out, err := serializeCertificates(certificatesForTest(), outputText)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(out).To(ContainSubstring("DAYS LEFT"))
+	g.Expect(out).To(MatchRegexp(`my-cluster-cp-xyz\s+192.168.0.10\s+control-plane\s+kube-apiserver\s+2027-03-01T00:00:00Z\s+135`))
+	g.Expect(out).To(MatchRegexp(`my-cluster-etcd-abc\s+192.168.0.20\s+etcd\s+etcd\s+Unknown\s+connecting to`))
+}
+
+func TestSerializeCertificatesTextNoCertificates(t *testing.T) {
+	g := NewWithT(t)
+
+	out, err := serializeCertificates(nil, outputText)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(out).To(Equal("No certificates found"))
+}
+
+func TestSerializeCertificatesJson(t *testing.T) {
+	g := NewWithT(t)
+
+	out, err := serializeCertificates(certificatesForTest()[:1], outputJson)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(out).To(ContainSubstring(`"expiresInDays": 135`))
+	g.Expect(out).To(ContainSubstring(`"component": "kube-apiserver"`))
+}
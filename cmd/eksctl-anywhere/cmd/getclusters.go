@@ -0,0 +1,94 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/aws/eks-anywhere/pkg/clients/kubernetes"
+	"github.com/aws/eks-anywhere/pkg/clusterinventory"
+	"github.com/aws/eks-anywhere/pkg/kubeconfig"
+)
+
+type getClustersOptions struct {
+	kubeConfig    string
+	namespace     string
+	allNamespaces bool
+	output        string
+}
+
+var gco = &getClustersOptions{}
+
+var getClustersCmd = &cobra.Command{
+	Use:          "clusters",
+	Short:        "Get the clusters of a management cluster",
+	Long:         "List the EKS Anywhere clusters of a management cluster with their versions, readiness and whether their reconciliation is paused",
+	PreRunE:      bindFlagsToViper,
+	SilenceUsage: true,
+	Args:         cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		if err := gco.getClusters(cmd.Context()); err != nil {
+			return fmt.Errorf("failed to get clusters: %v", err)
+		}
+		return nil
+	},
+}
+
+func init() {
+	getCmd.AddCommand(getClustersCmd)
+	getClustersCmd.Flags().StringVar(&gco.kubeConfig, "kubeconfig", "", "Management cluster kubeconfig file. Defaults to the KUBECONFIG environment variable")
+	getClustersCmd.Flags().StringVarP(&gco.namespace, "namespace", "n", "default", "Namespace of the clusters")
+	getClustersCmd.Flags().BoolVarP(&gco.allNamespaces, "all-namespaces", "A", false, "List the clusters of all namespaces")
+	getClustersCmd.Flags().StringVarP(&gco.output, outputFlagName, "o", outputDefault, "Output format: text|json|yaml")
+}
+
+func (o *getClustersOptions) getClusters(ctx context.Context) error {
+	kubeconfigPath, err := kubeconfig.ResolveAndValidateFilename(o.kubeConfig, "")
+	if err != nil {
+		return err
+	}
+
+	management, err := kubernetes.NewRuntimeClientFromFileName(kubeconfigPath)
+	if err != nil {
+		return err
+	}
+
+	namespace := o.namespace
+	if o.allNamespaces {
+		namespace = ""
+	}
+
+	clusters, err := clusterinventory.Clusters(ctx, management, namespace)
+	if err != nil {
+		return err
+	}
+
+	serialized, err := serializeClusters(clusters, o.output)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(serialized)
+
+	return nil
+}
+
+func serializeClusters(clusters []clusterinventory.Cluster, outputFormat string) (string, error) {
+	switch outputFormat {
+	case outputText:
+		if len(clusters) == 0 {
+			return "No clusters found", nil
+		}
+		return serializeTable(
+			"NAMESPACE\tNAME\tKUBERNETES VERSION\tEKSA VERSION\tMANAGEMENT CLUSTER\tREADY\tPAUSED",
+			len(clusters),
+			func(i int) string {
+				c := clusters[i]
+				return fmt.Sprintf("%s\t%s\t%s\t%s\t%s\t%s\t%t", c.Namespace, c.Name, c.KubernetesVersion, c.EksaVersion, c.ManagementCluster, c.Ready, c.Paused)
+			},
+		)
+	default:
+		return serializeList(clusters, outputFormat)
+	}
+}
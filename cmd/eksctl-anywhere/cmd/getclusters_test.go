@@ -0,0 +1,38 @@
+package cmd
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/aws/eks-anywhere/pkg/clusterinventory"
+)
+
+func TestSerializeClustersText(t *testing.T) {
+	g := NewWithT(t)
+	clusters := []clusterinventory.Cluster{
+		{Name: "mgmt", Namespace: "default", KubernetesVersion: "1.33", ManagementCluster: "mgmt", Ready: "True"},
+		{Name: "w01", Namespace: "default", KubernetesVersion: "1.32", ManagementCluster: "mgmt", Ready: "Unknown", Paused: true},
+	}
+
+	out, err := serializeClusters(clusters, outputText)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(out).To(ContainSubstring("PAUSED"))
+	g.Expect(out).To(MatchRegexp(`w01\s+1.32\s+mgmt\s+Unknown\s+true`))
+}
+
+func TestSerializeClustersTextNoClusters(t *testing.T) {
+	g := NewWithT(t)
+
+	out, err := serializeClusters(nil, outputText)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(out).To(Equal("No clusters found"))
+}
+
+func TestSerializeClustersJson(t *testing.T) {
+	g := NewWithT(t)
+
+	out, err := serializeClusters([]clusterinventory.Cluster{{Name: "w01", Paused: true}}, outputJson)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(out).To(ContainSubstring(`"paused": true`))
+}
@@ -0,0 +1,148 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"sigs.k8s.io/yaml"
+
+	"github.com/aws/eks-anywhere/pkg/clients/kubernetes"
+	"github.com/aws/eks-anywhere/pkg/clusterinventory"
+	"github.com/aws/eks-anywhere/pkg/kubeconfig"
+	"github.com/aws/eks-anywhere/pkg/logger"
+)
+
+type getMachinesOptions struct {
+	kubeConfig string
+	output     string
+}
+
+var gmo = &getMachinesOptions{}
+
+var getMachinesCmd = &cobra.Command{
+	Use:          "machines <cluster-name>",
+	Short:        "Get the machines of a cluster",
+	Long:         "List the CAPI machines of a cluster with their provider ID, phase, version and IP, and the status of their nodes",
+	PreRunE:      bindFlagsToViper,
+	SilenceUsage: true,
+	Args:         cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if err := gmo.getMachines(cmd.Context(), args[0]); err != nil {
+			return fmt.Errorf("failed to get machines: %v", err)
+		}
+		return nil
+	},
+}
+
+func init() {
+	getCmd.AddCommand(getMachinesCmd)
+	getMachinesCmd.Flags().StringVar(&gmo.kubeConfig, "kubeconfig", "", "Management cluster kubeconfig file. Defaults to the cluster kubeconfig")
+	getMachinesCmd.Flags().StringVarP(&gmo.output, outputFlagName, "o", outputDefault, "Output format: text|json|yaml")
+}
+
+func (o *getMachinesOptions) getMachines(ctx context.Context, clusterName string) error {
+	inventory, err := collectClusterInventory(ctx, o.kubeConfig, clusterName, false)
+	if err != nil {
+		return err
+	}
+
+	serialized, err := serializeMachines(inventory.Machines, o.output)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(serialized)
+
+	return nil
+}
+
+// collectClusterInventory reads the machines of the cluster from the management cluster and
+// its nodes with the kubeconfig stored in the management cluster. When the nodes are not
+// required, failing to read them only shows a warning, so the machines of a cluster with
+// an unreachable API server can still be listed.
+func collectClusterInventory(ctx context.Context, kubeConfig, clusterName string, requireNodes bool) (*clusterinventory.Inventory, error) {
+	kubeconfigPath, err := kubeconfig.ResolveAndValidateFilename(kubeConfig, clusterName)
+	if err != nil {
+		return nil, err
+	}
+
+	management, err := kubernetes.NewRuntimeClientFromFileName(kubeconfigPath)
+	if err != nil {
+		return nil, err
+	}
+
+	workload, err := clusterinventory.WorkloadClient(ctx, management, clusterName)
+	if err == nil {
+		inventory, collectErr := clusterinventory.Collect(ctx, management, workload, clusterName)
+		if collectErr == nil {
+			return inventory, nil
+		}
+		err = collectErr
+	}
+
+	if requireNodes {
+		return nil, err
+	}
+	logger.Info("Warning: can't read the nodes of the cluster, node status won't be shown", "cluster", clusterName, "error", err)
+
+	return clusterinventory.Collect(ctx, management, nil, clusterName)
+}
+
+func serializeMachines(machines []clusterinventory.Machine, outputFormat string) (string, error) {
+	switch outputFormat {
+	case outputText:
+		if len(machines) == 0 {
+			return "No machines found", nil
+		}
+		return serializeTable(
+			"NAME\tNODE GROUP\tPHASE\tVERSION\tIP\tNODE\tNODE STATUS\tPROVIDER ID",
+			len(machines),
+			func(i int) string {
+				m := machines[i]
+				return fmt.Sprintf("%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s", m.Name, m.NodeGroup, m.Phase, m.Version, m.IP, m.Node, m.NodeStatus, m.ProviderID)
+			},
+		)
+	default:
+		return serializeList(machines, outputFormat)
+	}
+}
+
+func serializeTable(header string, rows int, row func(int) string) (string, error) {
+	buffer := bytes.Buffer{}
+	w := tabwriter.NewWriter(&buffer, 10, 4, 3, ' ', 0)
+	fmt.Fprintln(w, header)
+	for i := 0; i < rows; i++ {
+		fmt.Fprintln(w, row(i))
+	}
+	if err := w.Flush(); err != nil {
+		return "", fmt.Errorf("failed flushing table writer: %v", err)
+	}
+
+	return buffer.String(), nil
+}
+
+func serializeList[T any](items []T, outputFormat string) (string, error) {
+	if items == nil {
+		items = []T{}
+	}
+
+	var serialized []byte
+	var err error
+	switch outputFormat {
+	case outputJson:
+		serialized, err = json.MarshalIndent(items, "", "    ")
+	case outputYaml:
+		serialized, err = yaml.Marshal(items)
+	default:
+		return "", fmt.Errorf("invalid output format [%s]", outputFormat)
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed serializing the %s output: %v", outputFormat, err)
+	}
+
+	return string(serialized), nil
+}
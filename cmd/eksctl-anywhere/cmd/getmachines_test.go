@@ -0,0 +1,97 @@
+package cmd
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/aws/eks-anywhere/pkg/clusterinventory"
+)
+
+func machinesForTest() []clusterinventory.Machine {
+	return []clusterinventory.Machine{
+		{
+			Name:       "my-cluster-cp-xyz",
+			NodeGroup:  clusterinventory.ControlPlaneNodeGroup,
+			Phase:      "Running",
+			Version:    "v1.33.1-eks-1-33-5",
+			ProviderID: "vsphere://4223a1b2",
+			IP:         "192.168.0.10",
+			Node:       "my-cluster-cp-xyz",
+			NodeStatus: "Ready",
+		},
+		{
+			Name:      "my-cluster-md-0-abc",
+			NodeGroup: "md-0",
+			Phase:     "Provisioning",
+			Version:   "v1.33.1-eks-1-33-5",
+		},
+	}
+}
+
+func TestSerializeMachinesText(t *testing.T) {
+	g := NewWithT(t)
+
+	out, err := serializeMachines(machinesForTest(), outputText)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(out).To(ContainSubstring("NODE STATUS"))
+	g.Expect(out).To(ContainSubstring("vsphere://4223a1b2"))
+	g.Expect(out).To(ContainSubstring("Provisioning"))
+}
+
+func TestSerializeMachinesTextNoMachines(t *testing.T) {
+	g := NewWithT(t)
+
+	out, err := serializeMachines(nil, outputText)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(out).To(Equal("No machines found"))
+}
+
+func TestSerializeMachinesJson(t *testing.T) {
+	g := NewWithT(t)
+
+	out, err := serializeMachines(machinesForTest(), outputJson)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(out).To(ContainSubstring(`"providerID": "vsphere://4223a1b2"`))
+	g.Expect(out).To(ContainSubstring(`"nodeGroup": "md-0"`))
+}
+
+func TestSerializeMachinesYamlNoMachines(t *testing.T) {
+	g := NewWithT(t)
+
+	out, err := serializeMachines(nil, outputYaml)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(out).To(Equal("[]\n"))
+}
+
+func TestSerializeNodesText(t *testing.T) {
+	g := NewWithT(t)
+	nodes := []clusterinventory.Node{
+		{
+			Name:         "my-cluster-cp-xyz",
+			Status:       "Ready",
+			Roles:        "control-plane",
+			Version:      "v1.33.1-eks-1-33-5",
+			InternalIP:   "192.168.0.10",
+			Machine:      "my-cluster-cp-xyz",
+			MachinePhase: "Running",
+			NodeGroup:    clusterinventory.ControlPlaneNodeGroup,
+		},
+	}
+
+	out, err := serializeNodes(nodes, outputText)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(out).To(ContainSubstring("MACHINE PHASE"))
+	g.Expect(out).To(ContainSubstring("192.168.0.10"))
+
+	out, err = serializeNodes(nodes, outputYaml)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(out).To(ContainSubstring("machinePhase: Running"))
+}
+
+func TestSerializeNodesInvalidFormat(t *testing.T) {
+	g := NewWithT(t)
+
+	_, err := serializeNodes(nil, "table")
+	g.Expect(err).To(MatchError("invalid output format [table]"))
+}
@@ -0,0 +1,73 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/aws/eks-anywhere/pkg/clusterinventory"
+)
+
+type getNodesOptions struct {
+	kubeConfig string
+	output     string
+}
+
+var gno = &getNodesOptions{}
+
+var getNodesCmd = &cobra.Command{
+	Use:          "nodes <cluster-name>",
+	Short:        "Get the nodes of a cluster",
+	Long:         "List the nodes of a cluster with their status, roles, version and IP, and the CAPI machines backing them",
+	PreRunE:      bindFlagsToViper,
+	SilenceUsage: true,
+	Args:         cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if err := gno.getNodes(cmd.Context(), args[0]); err != nil {
+			return fmt.Errorf("failed to get nodes: %v", err)
+		}
+		return nil
+	},
+}
+
+func init() {
+	getCmd.AddCommand(getNodesCmd)
+	getNodesCmd.Flags().StringVar(&gno.kubeConfig, "kubeconfig", "", "Management cluster kubeconfig file. Defaults to the cluster kubeconfig")
+	getNodesCmd.Flags().StringVarP(&gno.output, outputFlagName, "o", outputDefault, "Output format: text|json|yaml")
+}
+
+func (o *getNodesOptions) getNodes(ctx context.Context, clusterName string) error {
+	inventory, err := collectClusterInventory(ctx, o.kubeConfig, clusterName, true)
+	if err != nil {
+		return err
+	}
+
+	serialized, err := serializeNodes(inventory.Nodes, o.output)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(serialized)
+
+	return nil
+}
+
+func serializeNodes(nodes []clusterinventory.Node, outputFormat string) (string, error) {
+	switch outputFormat {
+	case outputText:
+		if len(nodes) == 0 {
+			return "No nodes found", nil
+		}
+		return serializeTable(
+			"NAME\tSTATUS\tROLES\tVERSION\tINTERNAL IP\tNODE GROUP\tMACHINE\tMACHINE PHASE",
+			len(nodes),
+			func(i int) string {
+				n := nodes[i]
+				return fmt.Sprintf("%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s", n.Name, n.Status, n.Roles, n.Version, n.InternalIP, n.NodeGroup, n.Machine, n.MachinePhase)
+			},
+		)
+	default:
+		return serializeList(nodes, outputFormat)
+	}
+}
@@ -21,6 +21,7 @@ var gpo = &getPackageOptions{}
 
 func init() {
 	getCmd.AddCommand(getPackageCommand)
+	withCLIVersionSkewValidation(getPackageCommand, packagesTarget)
 
 	getPackageCommand.Flags().StringVarP(&gpo.output, "output", "o", "",
 		"Specifies the output format (valid option: json, yaml)")
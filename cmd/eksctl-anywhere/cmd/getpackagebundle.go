@@ -18,6 +18,7 @@ var gpbo = &getPackageBundleOptions{}
 
 func init() {
 	getCmd.AddCommand(getPackageBundleCommand)
+	withCLIVersionSkewValidation(getPackageBundleCommand, packagesTarget)
 
 	getPackageBundleCommand.Flags().StringVarP(&gpbo.output, "output", "o", "",
 		"Specifies the output format (valid option: json, yaml)")
@@ -18,6 +18,7 @@ var gpbco = &getPackageBundleControllerOptions{}
 
 func init() {
 	getCmd.AddCommand(getPackageBundleControllerCommand)
+	withCLIVersionSkewValidation(getPackageBundleControllerCommand, packagesTarget)
 
 	getPackageBundleControllerCommand.Flags().StringVarP(&gpbco.output, "output",
 		"o", "", "Specifies the output format (valid option: json, yaml)")
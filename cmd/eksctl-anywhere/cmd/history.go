@@ -0,0 +1,15 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+var historyCmd = &cobra.Command{
+	Use:   "history",
+	Short: "Show the history of resources",
+	Long:  "Use eksctl anywhere history to show the timeline of lifecycle events of a resource",
+}
+
+func init() {
+	rootCmd.AddCommand(historyCmd)
+}
@@ -0,0 +1,121 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/dependencies"
+	"github.com/aws/eks-anywhere/pkg/kubeconfig"
+)
+
+type historyClusterOptions struct {
+	kubeConfig string
+	namespace  string
+	output     string
+}
+
+var hco = &historyClusterOptions{}
+
+var historyClusterCmd = &cobra.Command{
+	Use:          "cluster <cluster-name>",
+	Short:        "Show the lifecycle events timeline of a cluster",
+	Long:         "Show the lifecycle events recorded for a cluster, like its creation, upgrades, node group scaling operations and curated package installations",
+	PreRunE:      bindFlagsToViper,
+	SilenceUsage: true,
+	Args:         cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if err := hco.historyCluster(cmd.Context(), args[0]); err != nil {
+			return fmt.Errorf("failed to display cluster history: %v", err)
+		}
+		return nil
+	},
+}
+
+func init() {
+	historyCmd.AddCommand(historyClusterCmd)
+	historyClusterCmd.Flags().StringVar(&hco.kubeConfig, "kubeconfig", "", "Management cluster kubeconfig file. Defaults to the cluster kubeconfig")
+	historyClusterCmd.Flags().StringVarP(&hco.namespace, "namespace", "n", "default", "Namespace of the cluster")
+	historyClusterCmd.Flags().StringVarP(&hco.output, outputFlagName, "o", outputDefault, "Output format: text|json")
+}
+
+func (o *historyClusterOptions) historyCluster(ctx context.Context, clusterName string) error {
+	kubeconfigPath, err := kubeconfig.ResolveAndValidateFilename(o.kubeConfig, clusterName)
+	if err != nil {
+		return err
+	}
+
+	deps, err := dependencies.NewFactory().
+		WithExecutableMountDirs(kubeconfigPath).
+		WithExecutableBuilder().
+		WithKubectl().
+		WithUnAuthKubeClient().
+		Build(ctx)
+	if err != nil {
+		return err
+	}
+	defer close(ctx, deps)
+
+	history := &v1alpha1.ClusterHistory{}
+	if err := deps.UnAuthKubeClient.Get(ctx, clusterName, o.namespace, kubeconfigPath, history); err != nil {
+		return fmt.Errorf("reading history for cluster %s: %v", clusterName, err)
+	}
+
+	serialized, err := serializeClusterHistory(history, o.output)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(serialized)
+
+	return nil
+}
+
+func serializeClusterHistory(history *v1alpha1.ClusterHistory, outputFormat string) (string, error) {
+	switch outputFormat {
+	case outputText:
+		return serializeClusterHistoryToText(history)
+	case outputJson:
+		return serializeClusterHistoryToJson(history)
+	default:
+		return "", fmt.Errorf("invalid output format [%s]", outputFormat)
+	}
+}
+
+func serializeClusterHistoryToText(history *v1alpha1.ClusterHistory) (string, error) {
+	if len(history.Status.Events) == 0 {
+		return fmt.Sprintf("No events recorded for cluster %s", history.Name), nil
+	}
+
+	buffer := bytes.Buffer{}
+	w := tabwriter.NewWriter(&buffer, 10, 4, 3, ' ', 0)
+	fmt.Fprintln(w, "TIME\tEVENT\tOBJECT\tFROM\tTO\tMESSAGE")
+	for _, e := range history.Status.Events {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\n", e.Time.UTC().Format(time.RFC3339), e.Type, e.Object, e.From, e.To, e.Message)
+	}
+	if err := w.Flush(); err != nil {
+		return "", fmt.Errorf("failed flushing table writer: %v", err)
+	}
+
+	return buffer.String(), nil
+}
+
+func serializeClusterHistoryToJson(history *v1alpha1.ClusterHistory) (string, error) {
+	events := history.Status.Events
+	if events == nil {
+		events = []v1alpha1.ClusterHistoryEvent{}
+	}
+
+	j, err := json.MarshalIndent(events, "", "    ")
+	if err != nil {
+		return "", fmt.Errorf("failed serializing the cluster history events: %v", err)
+	}
+
+	return string(j), nil
+}
@@ -0,0 +1,70 @@
+package cmd
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+)
+
+func clusterHistoryForTest() *v1alpha1.ClusterHistory {
+	return &v1alpha1.ClusterHistory{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-cluster"},
+		Status: v1alpha1.ClusterHistoryStatus{
+			Events: []v1alpha1.ClusterHistoryEvent{
+				{
+					Type:    v1alpha1.ClusterCreatedEvent,
+					Time:    metav1.NewTime(time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)),
+					To:      "1.28",
+					Message: "Cluster created with Kubernetes version 1.28",
+				},
+				{
+					Type:    v1alpha1.NodeGroupScaledEvent,
+					Time:    metav1.NewTime(time.Date(2024, 1, 2, 10, 0, 0, 0, time.UTC)),
+					Object:  "md-0",
+					From:    "1",
+					To:      "3",
+					Message: "Worker node group md-0 scaled from 1 to 3 nodes",
+				},
+			},
+		},
+	}
+}
+
+func TestSerializeClusterHistoryText(t *testing.T) {
+	g := NewWithT(t)
+
+	out, err := serializeClusterHistory(clusterHistoryForTest(), outputText)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(out).To(ContainSubstring("TIME"))
+	g.Expect(out).To(ContainSubstring("2024-01-01T10:00:00Z"))
+	g.Expect(out).To(ContainSubstring("NodeGroupScaled"))
+	g.Expect(out).To(ContainSubstring("Worker node group md-0 scaled from 1 to 3 nodes"))
+}
+
+func TestSerializeClusterHistoryTextNoEvents(t *testing.T) {
+	g := NewWithT(t)
+
+	out, err := serializeClusterHistory(&v1alpha1.ClusterHistory{ObjectMeta: metav1.ObjectMeta{Name: "my-cluster"}}, outputText)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(out).To(Equal("No events recorded for cluster my-cluster"))
+}
+
+func TestSerializeClusterHistoryJson(t *testing.T) {
+	g := NewWithT(t)
+
+	out, err := serializeClusterHistory(clusterHistoryForTest(), outputJson)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(out).To(ContainSubstring(`"type": "Created"`))
+	g.Expect(out).To(ContainSubstring(`"from": "1"`))
+}
+
+func TestSerializeClusterHistoryInvalidFormat(t *testing.T) {
+	g := NewWithT(t)
+
+	_, err := serializeClusterHistory(clusterHistoryForTest(), "yaml")
+	g.Expect(err).To(MatchError(ContainSubstring("invalid output format")))
+}
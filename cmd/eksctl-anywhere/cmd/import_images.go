@@ -19,6 +19,7 @@ import (
 	"github.com/aws/eks-anywhere/pkg/manifests/bundles"
 	"github.com/aws/eks-anywhere/pkg/registrymirror"
 	"github.com/aws/eks-anywhere/pkg/types"
+	releasev1 "github.com/aws/eks-anywhere/release/api/v1alpha1"
 )
 
 // imagesCmd represents the images command.
@@ -49,6 +50,7 @@ func init() {
 	if err := importImagesCmd.MarkFlagRequired("bundles"); err != nil {
 		log.Fatalf("Cannot mark 'bundles' as required: %s", err)
 	}
+	importImagesCmd.Flags().StringVar(&importImagesCommand.FromBundlesFile, "from-bundles", "", "Bundles file the registry already contains the artifacts for, required to import a tarball created with download images --from-version or --from-bundles")
 	importImagesCmd.Flags().BoolVar(&importImagesCommand.includePackages, "include-packages", false, "Flag to indicate inclusion of curated packages in imported images")
 	importImagesCmd.Flag("include-packages").Deprecated = "use copy packages command"
 	importImagesCmd.Flags().BoolVar(&importImagesCommand.insecure, "insecure", false, "Flag to indicate skipping TLS verification while pushing helm charts and bundles")
@@ -60,6 +62,7 @@ type ImportImagesCommand struct {
 	InputFile        string
 	RegistryEndpoint string
 	BundlesFile      string
+	FromBundlesFile  string
 	includePackages  bool
 	insecure         bool
 }
@@ -85,6 +88,14 @@ func (c ImportImagesCommand) Call(ctx context.Context) error {
 		return err
 	}
 
+	var previousBundles *releasev1.Bundles
+	if c.FromBundlesFile != "" {
+		previousBundles, err = bundles.Read(deps.ManifestReader, c.FromBundlesFile)
+		if err != nil {
+			return err
+		}
+	}
+
 	artifactsFolder := "tmp-eks-a-artifacts"
 	dockerClient := executables.BuildDockerExecutable()
 	toolsImageFile := filepath.Join(artifactsFolder, eksaToolsImageTarFile)
@@ -150,6 +161,7 @@ func (c ImportImagesCommand) Call(ctx context.Context) error {
 		),
 		TmpArtifactsFolder: artifactsFolder,
 		FileImporter:       oras.NewFileRegistryImporter(c.RegistryEndpoint, username, password, artifactsFolder),
+		PreviousBundles:    previousBundles,
 	}
 
 	return importArtifacts.Run(context.WithValue(ctx, types.InsecureRegistry, c.insecure))
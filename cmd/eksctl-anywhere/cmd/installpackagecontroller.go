@@ -25,6 +25,7 @@ var ico = &installControllerOptions{}
 
 func init() {
 	installCmd.AddCommand(installPackageControllerCommand)
+	withCLIVersionSkewValidation(installPackageControllerCommand, packagesTarget)
 	installPackageControllerCommand.Flags().StringVarP(&ico.fileName, "filename", "f", "", "Filename that contains EKS-A cluster configuration")
 	installPackageControllerCommand.Flags().StringVar(&ico.kubeConfig, "kubeConfig", "", "Management cluster kubeconfig file")
 	installPackageControllerCommand.Flags().StringVar(&ico.bundlesOverride, "bundles-override", "",
@@ -27,6 +27,7 @@ var ipo = &installPackageOptions{}
 
 func init() {
 	installCmd.AddCommand(installPackageCommand)
+	withCLIVersionSkewValidation(installPackageCommand, packagesTarget)
 
 	installPackageCommand.Flags().StringVar(&ipo.kubeVersion, "kube-version", "",
 		"Kubernetes Version of the cluster to be used. Format <major>.<minor>")
@@ -0,0 +1,24 @@
+package artifacts
+
+import (
+	releasev1 "github.com/aws/eks-anywhere/release/api/v1alpha1"
+)
+
+// newArtifacts returns the artifacts that are not included in the previous ones.
+// Artifacts are compared by their versioned name, so an image or chart with a new
+// tag or digest is considered new even if it was already present with a different one.
+func newArtifacts(artifacts, previous []releasev1.Image) []releasev1.Image {
+	previousNames := make(map[string]struct{}, len(previous))
+	for _, p := range previous {
+		previousNames[p.VersionedImage()] = struct{}{}
+	}
+
+	delta := make([]releasev1.Image, 0, len(artifacts))
+	for _, a := range artifacts {
+		if _, ok := previousNames[a.VersionedImage()]; !ok {
+			delta = append(delta, a)
+		}
+	}
+
+	return delta
+}
@@ -46,6 +46,13 @@ type Download struct {
 	DstFile                  string
 	ManifestDownloader       ManifestDownloader
 	BundlesOverride          string
+	// FromVersion is the EKS-A version the artifacts were previously downloaded for.
+	// When set, only the images and charts that are new or changed since that version
+	// are downloaded.
+	FromVersion string
+	// FromBundles is the Bundles manifest the artifacts were previously downloaded for.
+	// It takes precedence over FromVersion.
+	FromBundles string
 }
 
 func (d Download) Run(ctx context.Context) error {
@@ -77,11 +84,31 @@ func (d Download) Run(ctx context.Context) error {
 		return fmt.Errorf("downloading images: %v", err)
 	}
 
-	if err = d.BundlesImagesDownloader.Move(ctx, removeFromSlice(artifactNames(images), toolsImage)...); err != nil {
+	previous, err := d.previousBundles()
+	if err != nil {
 		return err
 	}
 
+	if previous != nil {
+		previousImages, err := d.Reader.ReadImagesFromBundles(ctx, previous)
+		if err != nil {
+			return fmt.Errorf("reading images from previous bundles: %v", err)
+		}
+		images = newArtifacts(images, previousImages)
+		logger.Info("Downloading only images new or changed since the previous bundles", "images", len(images))
+	}
+
+	// A differential download might not have any new images besides the tools image.
+	if imageNames := removeFromSlice(artifactNames(images), toolsImage); len(imageNames) > 0 {
+		if err = d.BundlesImagesDownloader.Move(ctx, imageNames...); err != nil {
+			return err
+		}
+	}
+
 	charts := d.Reader.ReadChartsFromBundles(ctx, b)
+	if previous != nil {
+		charts = newArtifacts(charts, d.Reader.ReadChartsFromBundles(ctx, previous))
+	}
 
 	d.ManifestDownloader.Download(ctx, b)
 
@@ -101,6 +128,27 @@ func (d Download) Run(ctx context.Context) error {
 	return nil
 }
 
+// previousBundles returns the Bundles the artifacts were previously downloaded for,
+// or nil when downloading all the artifacts.
+func (d Download) previousBundles() (*releasev1.Bundles, error) {
+	switch {
+	case d.FromBundles != "":
+		b, err := bundles.Read(d.FileReader, d.FromBundles)
+		if err != nil {
+			return nil, fmt.Errorf("reading previous bundles: %v", err)
+		}
+		return b, nil
+	case d.FromVersion != "":
+		b, err := d.Reader.ReadBundlesForVersion(d.FromVersion)
+		if err != nil {
+			return nil, fmt.Errorf("reading bundles for previous version %s: %v", d.FromVersion, err)
+		}
+		return b, nil
+	}
+
+	return nil, nil
+}
+
 func artifactNames(artifacts []releasev1.Image) []string {
 	taggedArtifacts := make([]string, 0, len(artifacts))
 	for _, a := range artifacts {
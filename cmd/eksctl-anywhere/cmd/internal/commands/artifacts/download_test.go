@@ -127,3 +127,69 @@ func TestDownloadErrorReadingImages(t *testing.T) {
 
 	tt.Expect(tt.command.Run(tt.ctx)).To(MatchError(ContainSubstring("downloading images: error reading images")))
 }
+
+func TestDownloadRunFromVersion(t *testing.T) {
+	tt := newDownloadArtifactsTest(t)
+	tt.command.FromVersion = "v0.9.0"
+	previousBundles := &releasev1.Bundles{}
+	previousImages := []releasev1.Image{
+		{
+			Name: "image 1",
+			URI:  "image1:1",
+		},
+		{
+			Name: "image 2",
+			URI:  "image2:0",
+		},
+	}
+	previousCharts := []releasev1.Image{
+		{
+			Name: "chart 1",
+			URI:  "chart:v1.0.0",
+		},
+	}
+
+	tt.reader.EXPECT().ReadBundlesForVersion("v1.0.0").Return(tt.bundles, nil)
+	tt.toolsDownloader.EXPECT().Move(tt.ctx, "tools:v1.0.0")
+	tt.reader.EXPECT().ReadImagesFromBundles(tt.ctx, tt.bundles).Return(tt.images, nil)
+	tt.reader.EXPECT().ReadBundlesForVersion("v0.9.0").Return(previousBundles, nil)
+	tt.reader.EXPECT().ReadImagesFromBundles(tt.ctx, previousBundles).Return(previousImages, nil)
+	tt.mover.EXPECT().Move(tt.ctx, "image2:1")
+	tt.reader.EXPECT().ReadChartsFromBundles(tt.ctx, tt.bundles).Return(tt.charts)
+	tt.reader.EXPECT().ReadChartsFromBundles(tt.ctx, previousBundles).Return(previousCharts)
+	tt.downloader.EXPECT().Download(tt.ctx, "package-chart:v1.0.0")
+	tt.packager.EXPECT().Package("tmp-folder", "artifacts.tar")
+	tt.manifestDownloader.EXPECT().Download(tt.ctx, tt.bundles)
+
+	tt.Expect(tt.command.Run(tt.ctx)).To(Succeed())
+}
+
+func TestDownloadRunFromVersionNoNewImages(t *testing.T) {
+	tt := newDownloadArtifactsTest(t)
+	tt.command.FromVersion = "v0.9.0"
+	previousBundles := &releasev1.Bundles{}
+
+	tt.reader.EXPECT().ReadBundlesForVersion("v1.0.0").Return(tt.bundles, nil)
+	tt.toolsDownloader.EXPECT().Move(tt.ctx, "tools:v1.0.0")
+	tt.reader.EXPECT().ReadImagesFromBundles(tt.ctx, tt.bundles).Return(tt.images, nil)
+	tt.reader.EXPECT().ReadBundlesForVersion("v0.9.0").Return(previousBundles, nil)
+	tt.reader.EXPECT().ReadImagesFromBundles(tt.ctx, previousBundles).Return(tt.images, nil)
+	tt.reader.EXPECT().ReadChartsFromBundles(tt.ctx, tt.bundles).Return(tt.charts)
+	tt.reader.EXPECT().ReadChartsFromBundles(tt.ctx, previousBundles).Return(tt.charts)
+	tt.downloader.EXPECT().Download(tt.ctx)
+	tt.packager.EXPECT().Package("tmp-folder", "artifacts.tar")
+	tt.manifestDownloader.EXPECT().Download(tt.ctx, tt.bundles)
+
+	tt.Expect(tt.command.Run(tt.ctx)).To(Succeed())
+}
+
+func TestDownloadErrorReadingPreviousBundles(t *testing.T) {
+	tt := newDownloadArtifactsTest(t)
+	tt.command.FromVersion = "v0.9.0"
+	tt.reader.EXPECT().ReadBundlesForVersion("v1.0.0").Return(tt.bundles, nil)
+	tt.toolsDownloader.EXPECT().Move(tt.ctx, "tools:v1.0.0")
+	tt.reader.EXPECT().ReadImagesFromBundles(tt.ctx, tt.bundles).Return(tt.images, nil)
+	tt.reader.EXPECT().ReadBundlesForVersion("v0.9.0").Return(nil, errors.New("bundles not found"))
+
+	tt.Expect(tt.command.Run(tt.ctx)).To(MatchError(ContainSubstring("reading bundles for previous version v0.9.0: bundles not found")))
+}
@@ -16,6 +16,10 @@ type Import struct {
 	ChartImporter      ChartImporter
 	TmpArtifactsFolder string
 	FileImporter       FileImporter
+	// PreviousBundles are the Bundles the registry already contains the artifacts for.
+	// When set, only the images and charts that are new or changed since those Bundles
+	// are imported, which is required for tarballs created with a differential download.
+	PreviousBundles *releasev1.Bundles
 }
 
 type ChartImporter interface {
@@ -43,11 +47,26 @@ func (i Import) Run(ctx context.Context) error {
 		filteredImages = append(filteredImages, img)
 	}
 
-	if err = i.ImageMover.Move(ctx, artifactNames(filteredImages)...); err != nil {
-		return err
+	if i.PreviousBundles != nil {
+		previousImages, err := i.Reader.ReadImagesFromBundles(ctx, i.PreviousBundles)
+		if err != nil {
+			return fmt.Errorf("reading images from previous bundles: %v", err)
+		}
+		filteredImages = newArtifacts(filteredImages, previousImages)
+	}
+
+	// A tarball from a differential download doesn't include an images file
+	// when none of the images changed.
+	if len(filteredImages) > 0 {
+		if err = i.ImageMover.Move(ctx, artifactNames(filteredImages)...); err != nil {
+			return err
+		}
 	}
 
 	charts := i.Reader.ReadChartsFromBundles(ctx, i.Bundles)
+	if i.PreviousBundles != nil {
+		charts = newArtifacts(charts, i.Reader.ReadChartsFromBundles(ctx, i.PreviousBundles))
+	}
 
 	if err := i.ChartImporter.Import(ctx, artifactNames(charts)...); err != nil {
 		return err
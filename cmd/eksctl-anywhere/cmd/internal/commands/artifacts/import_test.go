@@ -96,3 +96,25 @@ func TestImportRun(t *testing.T) {
 
 	tt.Expect(tt.command.Run(tt.ctx)).To(Succeed())
 }
+
+func TestImportRunWithPreviousBundles(t *testing.T) {
+	tt := newImportArtifactsTest(t)
+	previousBundles := &releasev1.Bundles{}
+	tt.command.PreviousBundles = previousBundles
+	previousImages := []releasev1.Image{
+		{
+			Name: "image 1",
+			URI:  "image1:1",
+		},
+	}
+
+	tt.reader.EXPECT().ReadImagesFromBundles(tt.ctx, tt.bundles).Return(tt.images, nil)
+	tt.reader.EXPECT().ReadImagesFromBundles(tt.ctx, previousBundles).Return(previousImages, nil)
+	tt.mover.EXPECT().Move(tt.ctx, "image2:1")
+	tt.reader.EXPECT().ReadChartsFromBundles(tt.ctx, tt.bundles).Return(tt.charts)
+	tt.reader.EXPECT().ReadChartsFromBundles(tt.ctx, previousBundles).Return(tt.charts)
+	tt.fileImporter.EXPECT().Push(tt.ctx, tt.bundles)
+	tt.importer.EXPECT().Import(tt.ctx)
+
+	tt.Expect(tt.command.Run(tt.ctx)).To(Succeed())
+}
@@ -29,6 +29,7 @@ var lpo = &listPackagesOption{}
 
 func init() {
 	listCmd.AddCommand(listPackagesCommand)
+	withCLIVersionSkewValidation(listPackagesCommand, packagesTarget)
 
 	listPackagesCommand.Flags().StringVar(&lpo.kubeVersion, "kube-version", "",
 		"Kubernetes version <major>.<minor> of the packages to list, for example: \"1.23\".")
@@ -0,0 +1,171 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/clients/kubernetes"
+	"github.com/aws/eks-anywhere/pkg/clusterlogs"
+	"github.com/aws/eks-anywhere/pkg/kubeconfig"
+	"github.com/aws/eks-anywhere/pkg/logger"
+)
+
+type logsOptions struct {
+	kubeConfig string
+	namespace  string
+	components []string
+	since      time.Duration
+	sinceTime  string
+	phase      string
+	follow     bool
+	outputDir  string
+}
+
+var lo = &logsOptions{}
+
+var logsCmd = &cobra.Command{
+	Use:   "logs <cluster-name>",
+	Short: "Show the logs of the lifecycle components of a cluster",
+	Long: "Show or follow the logs of the EKS Anywhere controller, the CAPI core, bootstrap, control plane and infrastructure providers, " +
+		"the etcdadm controllers and kube-vip of a cluster, filtered by time or by a lifecycle phase recorded in the cluster history",
+	PreRunE:      bindFlagsToViper,
+	SilenceUsage: true,
+	Args:         cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if err := lo.logs(cmd.Context(), args[0]); err != nil {
+			return fmt.Errorf("failed to get logs: %v", err)
+		}
+		return nil
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(logsCmd)
+	withCLIVersionSkewValidation(logsCmd, clusterArgTarget)
+	logsCmd.Flags().StringVar(&lo.kubeConfig, "kubeconfig", "", "Management cluster kubeconfig file. Defaults to the cluster kubeconfig")
+	logsCmd.Flags().StringVarP(&lo.namespace, "namespace", "n", "default", "Namespace of the cluster")
+	logsCmd.Flags().StringSliceVarP(&lo.components, "components", "c", nil, "Components to show the logs of: eksa-controller, capi, kubeadm-bootstrap, kubeadm-control-plane, infrastructure, etcdadm-bootstrap, etcdadm-controller or kube-vip. Defaults to all the components of the cluster")
+	logsCmd.Flags().DurationVar(&lo.since, "since", 0, "Only show the logs newer than a relative duration, like 30m or 2h")
+	logsCmd.Flags().StringVar(&lo.sinceTime, "since-time", "", "Only show the logs after a RFC3339 timestamp, like 2024-01-01T10:00:00Z")
+	logsCmd.Flags().StringVar(&lo.phase, "phase", "", "Only show the logs of the last lifecycle event of a type recorded in the cluster history, like KubernetesVersionUpgraded or NodeGroupScaled")
+	logsCmd.Flags().BoolVarP(&lo.follow, "follow", "f", false, "Stream the new logs until interrupted")
+	logsCmd.Flags().StringVar(&lo.outputDir, "output-dir", "", "Directory to save the logs of every pod to, in <component>/<pod>.log files, instead of printing them")
+}
+
+func (o *logsOptions) logs(ctx context.Context, clusterName string) error {
+	if err := o.validate(); err != nil {
+		return err
+	}
+
+	kubeconfigPath, err := kubeconfig.ResolveAndValidateFilename(o.kubeConfig, clusterName)
+	if err != nil {
+		return err
+	}
+
+	management, err := kubernetes.NewRuntimeClientFromFileName(kubeconfigPath)
+	if err != nil {
+		return err
+	}
+
+	cluster := &anywherev1.Cluster{}
+	if err := management.Get(ctx, client.ObjectKey{Name: clusterName, Namespace: o.namespace}, cluster); err != nil {
+		return fmt.Errorf("reading cluster %s: %v", clusterName, err)
+	}
+
+	window, err := o.window(ctx, management, cluster)
+	if err != nil {
+		return err
+	}
+	if o.follow && !window.Until.IsZero() {
+		return fmt.Errorf("phase %s is over, it can't be followed", o.phase)
+	}
+
+	components, err := clusterlogs.Select(clusterlogs.Components(cluster), o.components)
+	if err != nil {
+		return err
+	}
+
+	kubeconfigContent, err := os.ReadFile(kubeconfigPath)
+	if err != nil {
+		return err
+	}
+	managementClientset, err := clusterlogs.NewClientset(kubeconfigContent)
+	if err != nil {
+		return err
+	}
+
+	workloadClientset := managementClientset
+	if !cluster.IsSelfManaged() {
+		workloadClientset, err = clusterlogs.WorkloadClientset(ctx, management, clusterName)
+		if err != nil {
+			logger.Info("Warning: the cluster logs can't be read", "error", err)
+			workloadClientset = nil
+		}
+	}
+
+	var sink clusterlogs.Sink = clusterlogs.NewWriterSink(os.Stdout)
+	if o.outputDir != "" {
+		sink = clusterlogs.NewDirSink(o.outputDir)
+	}
+
+	err = clusterlogs.NewCollector(managementClientset, workloadClientset).Collect(ctx, components, clusterlogs.Options{
+		Window: window,
+		Follow: o.follow,
+	}, sink)
+	// Following only stops when interrupted.
+	if err != nil && ctx.Err() == nil {
+		return err
+	}
+
+	if o.outputDir != "" {
+		logger.Info("Logs saved", "directory", o.outputDir)
+	}
+
+	return nil
+}
+
+func (o *logsOptions) validate() error {
+	set := 0
+	for _, isSet := range []bool{o.since != 0, o.sinceTime != "", o.phase != ""} {
+		if isSet {
+			set++
+		}
+	}
+	if set > 1 {
+		return errors.New("only one of --since, --since-time and --phase can be set")
+	}
+	if o.since < 0 {
+		return errors.New("--since must be a positive duration")
+	}
+
+	return nil
+}
+
+// window returns the time range of the logs selected by the time and phase flags.
+func (o *logsOptions) window(ctx context.Context, management client.Client, cluster *anywherev1.Cluster) (clusterlogs.Window, error) {
+	switch {
+	case o.since != 0:
+		return clusterlogs.Window{Since: time.Now().Add(-o.since)}, nil
+	case o.sinceTime != "":
+		since, err := time.Parse(time.RFC3339, o.sinceTime)
+		if err != nil {
+			return clusterlogs.Window{}, fmt.Errorf("parsing --since-time: %v", err)
+		}
+		return clusterlogs.Window{Since: since}, nil
+	case o.phase != "":
+		history := &anywherev1.ClusterHistory{}
+		if err := management.Get(ctx, client.ObjectKey{Name: cluster.Name, Namespace: cluster.Namespace}, history); err != nil {
+			return clusterlogs.Window{}, fmt.Errorf("reading history for cluster %s: %v", cluster.Name, err)
+		}
+		return clusterlogs.PhaseWindow(history, anywherev1.ClusterHistoryEventType(o.phase))
+	default:
+		return clusterlogs.Window{}, nil
+	}
+}
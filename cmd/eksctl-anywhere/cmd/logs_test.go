@@ -0,0 +1,54 @@
+package cmd
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestLogsOptionsValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		opts    logsOptions
+		wantErr string
+	}{
+		{
+			name: "no time filter",
+		},
+		{
+			name: "since",
+			opts: logsOptions{since: time.Hour},
+		},
+		{
+			name: "phase",
+			opts: logsOptions{phase: "KubernetesVersionUpgraded"},
+		},
+		{
+			name:    "since and phase",
+			opts:    logsOptions{since: time.Hour, phase: "KubernetesVersionUpgraded"},
+			wantErr: "only one of --since, --since-time and --phase can be set",
+		},
+		{
+			name:    "since and since time",
+			opts:    logsOptions{since: time.Hour, sinceTime: "2024-01-01T10:00:00Z"},
+			wantErr: "only one of --since, --since-time and --phase can be set",
+		},
+		{
+			name:    "negative since",
+			opts:    logsOptions{since: -time.Hour},
+			wantErr: "--since must be a positive duration",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			err := tt.opts.validate()
+			if tt.wantErr == "" {
+				g.Expect(err).NotTo(HaveOccurred())
+			} else {
+				g.Expect(err).To(MatchError(tt.wantErr))
+			}
+		})
+	}
+}
@@ -0,0 +1,15 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+var migrateCmd = &cobra.Command{
+	Use:   "migrate",
+	Short: "Migrate resources",
+	Long:  "Use eksctl anywhere migrate to move cluster resources to another provider",
+}
+
+func init() {
+	expCmd.AddCommand(migrateCmd)
+}
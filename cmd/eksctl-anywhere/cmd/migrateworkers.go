@@ -0,0 +1,108 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/aws/eks-anywhere/pkg/dependencies"
+	"github.com/aws/eks-anywhere/pkg/kubeconfig"
+	"github.com/aws/eks-anywhere/pkg/logger"
+	"github.com/aws/eks-anywhere/pkg/providermigration"
+	unstructuredutil "github.com/aws/eks-anywhere/pkg/utils/unstructured"
+)
+
+type migrateWorkersOptions struct {
+	clusterName      string
+	clusterNamespace string
+	kubeConfig       string
+	source           string
+	targetManifest   string
+	manualCutover    bool
+}
+
+var mwo = &migrateWorkersOptions{}
+
+var migrateWorkersCmd = &cobra.Command{
+	Use:          "workers",
+	Short:        "Migrate a worker node pool to another provider",
+	Long:         "Create a node pool on another provider from a CAPI manifest and move the workloads to it one node at a time, scaling the original node pool down to zero. The cluster spec is not updated, so its reconciliation is left paused until a manual cutover",
+	PreRunE:      bindFlagsToViper,
+	SilenceUsage: true,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		if err := mwo.migrateWorkers(cmd.Context()); err != nil {
+			return fmt.Errorf("failed to migrate workers: %v", err)
+		}
+		return nil
+	},
+}
+
+func init() {
+	migrateCmd.AddCommand(migrateWorkersCmd)
+	withCLIVersionSkewValidation(migrateWorkersCmd, clusterFlagTarget)
+	migrateWorkersCmd.Flags().StringVar(&mwo.clusterName, "cluster", "", "Name of the cluster to migrate")
+	migrateWorkersCmd.Flags().StringVar(&mwo.clusterNamespace, "namespace", "default", "Namespace of the cluster in the management cluster")
+	migrateWorkersCmd.Flags().StringVar(&mwo.kubeConfig, "kubeconfig", "", "Management cluster kubeconfig file. Defaults to the cluster kubeconfig")
+	migrateWorkersCmd.Flags().StringVar(&mwo.source, "source-machine-deployment", "", "Name of the CAPI MachineDeployment of the node pool to retire")
+	migrateWorkersCmd.Flags().StringVar(&mwo.targetManifest, "target-manifest", "", "CAPI manifest of the new node pool, with its MachineDeployment and templates")
+	migrateWorkersCmd.Flags().BoolVar(&mwo.manualCutover, "manual-cutover", false, "Acknowledge that the cluster spec is not updated and its reconciliation stays paused until a manual cutover")
+
+	for _, flag := range []string{"cluster", "source-machine-deployment", "target-manifest"} {
+		if err := migrateWorkersCmd.MarkFlagRequired(flag); err != nil {
+			logger.Fatal(err, fmt.Sprintf("marking %s as required", flag))
+		}
+	}
+}
+
+func (o *migrateWorkersOptions) migrateWorkers(ctx context.Context) error {
+	if !o.manualCutover {
+		return errors.New("the cluster reconciliation stays paused after the migration, until a manual cutover: set --manual-cutover to proceed")
+	}
+
+	content, err := os.ReadFile(o.targetManifest)
+	if err != nil {
+		return fmt.Errorf("reading target manifest: %v", err)
+	}
+
+	targetObjects, err := unstructuredutil.YamlToUnstructured(content)
+	if err != nil {
+		return fmt.Errorf("parsing target manifest: %v", err)
+	}
+
+	managementKubeconfig, err := kubeconfig.ResolveAndValidateFilename(o.kubeConfig, o.clusterName)
+	if err != nil {
+		return err
+	}
+
+	workloadKubeconfig, err := kubeconfig.ResolveAndValidateFilename("", o.clusterName)
+	if err != nil {
+		return err
+	}
+
+	deps, err := dependencies.NewFactory().
+		WithExecutableMountDirs(managementKubeconfig, workloadKubeconfig).
+		WithExecutableBuilder().
+		WithKubectl().
+		WithUnAuthKubeClient().
+		Build(ctx)
+	if err != nil {
+		return err
+	}
+	defer close(ctx, deps)
+
+	migrator := providermigration.NewMigrator(
+		deps.UnAuthKubeClient.KubeconfigClient(managementKubeconfig),
+		deps.UnAuthKubeClient.KubeconfigClient(workloadKubeconfig),
+	)
+
+	return migrator.Migrate(ctx, providermigration.Migration{
+		ClusterName:             o.clusterName,
+		ClusterNamespace:        o.clusterNamespace,
+		SourceMachineDeployment: o.source,
+		TargetObjects:           targetObjects,
+		ManualCutover:           o.manualCutover,
+	})
+}
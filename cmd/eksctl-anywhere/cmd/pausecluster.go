@@ -0,0 +1,92 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/aws/eks-anywhere/pkg/clients/kubernetes"
+	"github.com/aws/eks-anywhere/pkg/clusterlock"
+	"github.com/aws/eks-anywhere/pkg/clusterpause"
+	"github.com/aws/eks-anywhere/pkg/controller/clientutil"
+	"github.com/aws/eks-anywhere/pkg/kubeconfig"
+	"github.com/aws/eks-anywhere/pkg/logger"
+)
+
+type pauseClusterOptions struct {
+	kubeConfig  string
+	namespace   string
+	forceUnlock bool
+}
+
+var pco = &pauseClusterOptions{}
+
+var pauseClusterCmd = &cobra.Command{
+	Use:          "cluster <cluster-name>",
+	Short:        "Pause the reconciliation of a cluster",
+	Long:         "Stop the EKS Anywhere, CAPI and provider controllers from reconciling a cluster, to freeze it during infrastructure maintenance. Resume it with resume cluster",
+	PreRunE:      bindFlagsToViper,
+	SilenceUsage: true,
+	Args:         cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if err := pco.pauseCluster(cmd.Context(), args[0]); err != nil {
+			return fmt.Errorf("failed to pause cluster: %v", err)
+		}
+		return nil
+	},
+}
+
+func init() {
+	pauseCmd.AddCommand(pauseClusterCmd)
+	withCLIVersionSkewValidation(pauseClusterCmd, clusterArgTarget)
+	pauseClusterCmd.Flags().StringVar(&pco.kubeConfig, "kubeconfig", "", "Management cluster kubeconfig file. Defaults to the cluster kubeconfig")
+	pauseClusterCmd.Flags().StringVarP(&pco.namespace, "namespace", "n", "default", "Namespace of the cluster")
+	pauseClusterCmd.Flags().BoolVar(&pco.forceUnlock, "force-unlock", false, "Remove the operation lock of the cluster left by an interrupted operation before pausing it")
+}
+
+func (o *pauseClusterOptions) pauseCluster(ctx context.Context, clusterName string) error {
+	return withClusterLock(ctx, o.kubeConfig, clusterName, "pause cluster", o.forceUnlock, func(management client.Client) error {
+		if err := clusterpause.Pause(ctx, management, clusterName, o.namespace); err != nil {
+			return err
+		}
+
+		logger.MarkSuccess("Cluster reconciliation paused", "cluster", clusterName)
+		return nil
+	})
+}
+
+// withClusterLock runs the operation with a client for the management cluster of the cluster while
+// holding the operation lock of the cluster, so it doesn't run during other CLI operations. With
+// forceUnlock, the lock left by an interrupted operation is removed first.
+func withClusterLock(ctx context.Context, kubeConfig, clusterName, operation string, forceUnlock bool, run func(client.Client) error) error {
+	kubeconfigPath, err := kubeconfig.ResolveAndValidateFilename(kubeConfig, clusterName)
+	if err != nil {
+		return err
+	}
+
+	management, err := kubernetes.NewRuntimeClientFromFileName(kubeconfigPath)
+	if err != nil {
+		return err
+	}
+
+	lockClient := clientutil.NewKubeClient(management)
+	if forceUnlock {
+		if err := clusterlock.ForceUnlock(ctx, lockClient, clusterName); err != nil {
+			return err
+		}
+	}
+
+	lock := clusterlock.New(lockClient, clusterName, operation)
+	if err := lock.Acquire(ctx); err != nil {
+		return err
+	}
+	defer func() {
+		if err := lock.Release(ctx); err != nil {
+			logger.Error(err, "Failed releasing the operation lock, remove it with --force-unlock in the next operation", "cluster", clusterName)
+		}
+	}()
+
+	return run(management)
+}
@@ -0,0 +1,20 @@
+package cmd
+
+import (
+	"github.com/aws/eks-anywhere/pkg/config"
+	"github.com/aws/eks-anywhere/pkg/logger"
+)
+
+// useReadOnlyCredentials makes validation-only commands use the read-only provider credentials
+// when they are set instead of the ones used to manage clusters.
+func useReadOnlyCredentials() error {
+	used, err := config.UseReadOnlyCredentials()
+	if err != nil {
+		return err
+	}
+	if used {
+		logger.Info("Using read-only provider credentials")
+	}
+
+	return nil
+}
@@ -17,6 +17,7 @@ import (
 type renewCertificatesOptions struct {
 	configFile string
 	component  string
+	nodes      []string
 }
 
 var rc = &renewCertificatesOptions{}
@@ -24,7 +25,7 @@ var rc = &renewCertificatesOptions{}
 var renewCertificatesCmd = &cobra.Command{
 	Use:          "certificates",
 	Short:        "Renew certificates",
-	Long:         "Renew external ETCD and control plane certificates",
+	Long:         "Renew external ETCD and control plane certificates, of all the nodes of the cluster or only of the selected ones",
 	PreRunE:      bindFlagsToViper,
 	SilenceUsage: true,
 	RunE:         rc.renewCertificates,
@@ -34,6 +35,7 @@ func init() {
 	renewCmd.AddCommand(renewCertificatesCmd)
 	renewCertificatesCmd.Flags().StringVarP(&rc.configFile, "config", "f", "", "Config file containing node and SSH information")
 	renewCertificatesCmd.Flags().StringVarP(&rc.component, "component", "c", "", fmt.Sprintf("Component to renew certificates for (%s or %s). If not specified, renews both.", constants.EtcdComponent, constants.ControlPlaneComponent))
+	renewCertificatesCmd.Flags().StringSliceVar(&rc.nodes, "nodes", nil, "Control plane and etcd node IPs to renew certificates for. If not specified, renews all the nodes.")
 
 	if err := renewCertificatesCmd.MarkFlagRequired("config"); err != nil {
 		logger.Fatal(err, "marking config as required")
@@ -79,6 +81,10 @@ func (rc *renewCertificatesOptions) renewCertificates(cmd *cobra.Command, _ []st
 		return err
 	}
 
+	if err := certificates.ValidateNodes(cfg, rc.nodes); err != nil {
+		return err
+	}
+
 	os := cfg.OS
 	if os == string(v1alpha1.Ubuntu) || os == string(v1alpha1.RedHat) {
 		os = string(certificates.OSTypeLinux)
@@ -89,5 +95,7 @@ func (rc *renewCertificatesOptions) renewCertificates(cmd *cobra.Command, _ []st
 		return err
 	}
 
+	renewer.Nodes = rc.nodes
+
 	return renewer.RenewCertificates(ctx, cfg, rc.component)
 }
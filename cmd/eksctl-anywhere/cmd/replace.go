@@ -0,0 +1,15 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+var replaceCmd = &cobra.Command{
+	Use:   "replace",
+	Short: "Replace resources",
+	Long:  "Use eksctl anywhere replace to replace cluster resources",
+}
+
+func init() {
+	expCmd.AddCommand(replaceCmd)
+}
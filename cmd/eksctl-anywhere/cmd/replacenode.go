@@ -0,0 +1,83 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/aws/eks-anywhere/pkg/dependencies"
+	"github.com/aws/eks-anywhere/pkg/kubeconfig"
+	"github.com/aws/eks-anywhere/pkg/logger"
+	"github.com/aws/eks-anywhere/pkg/nodereplacer"
+)
+
+type replaceNodeOptions struct {
+	clusterName string
+	kubeConfig  string
+	machine     string
+}
+
+var rno = &replaceNodeOptions{}
+
+var replaceNodeCmd = &cobra.Command{
+	Use:          "node",
+	Short:        "Replace a single node of a cluster",
+	Long:         "Cordon the node of a CAPI machine, delete the machine so its node is drained and wait for the replacement node to be ready, without changing the node counts of the cluster",
+	PreRunE:      bindFlagsToViper,
+	SilenceUsage: true,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		if err := rno.replaceNode(cmd.Context()); err != nil {
+			return fmt.Errorf("failed to replace node: %v", err)
+		}
+		return nil
+	},
+}
+
+func init() {
+	replaceCmd.AddCommand(replaceNodeCmd)
+	withCLIVersionSkewValidation(replaceNodeCmd, clusterFlagTarget)
+	replaceNodeCmd.Flags().StringVar(&rno.clusterName, "cluster", "", "Name of the cluster the node belongs to")
+	replaceNodeCmd.Flags().StringVar(&rno.kubeConfig, "kubeconfig", "", "Management cluster kubeconfig file. Defaults to the cluster kubeconfig")
+	replaceNodeCmd.Flags().StringVar(&rno.machine, "machine", "", "Name of the CAPI machine of the node to replace")
+
+	if err := replaceNodeCmd.MarkFlagRequired("cluster"); err != nil {
+		logger.Fatal(err, "marking cluster as required")
+	}
+	if err := replaceNodeCmd.MarkFlagRequired("machine"); err != nil {
+		logger.Fatal(err, "marking machine as required")
+	}
+}
+
+func (o *replaceNodeOptions) replaceNode(ctx context.Context) error {
+	managementKubeconfig, err := kubeconfig.ResolveAndValidateFilename(o.kubeConfig, o.clusterName)
+	if err != nil {
+		return err
+	}
+
+	workloadKubeconfig, err := kubeconfig.ResolveAndValidateFilename("", o.clusterName)
+	if err != nil {
+		return err
+	}
+
+	deps, err := dependencies.NewFactory().
+		WithExecutableMountDirs(managementKubeconfig, workloadKubeconfig).
+		WithExecutableBuilder().
+		WithKubectl().
+		WithUnAuthKubeClient().
+		Build(ctx)
+	if err != nil {
+		return err
+	}
+	defer close(ctx, deps)
+
+	replacer := nodereplacer.NewReplacer(
+		deps.UnAuthKubeClient.KubeconfigClient(managementKubeconfig),
+		deps.UnAuthKubeClient.KubeconfigClient(workloadKubeconfig),
+	)
+
+	return replacer.Replace(ctx, nodereplacer.Replacement{
+		ClusterName: o.clusterName,
+		MachineName: o.machine,
+	})
+}
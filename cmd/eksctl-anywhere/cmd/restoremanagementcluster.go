@@ -0,0 +1,78 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/aws/eks-anywhere/pkg/dependencies"
+	"github.com/aws/eks-anywhere/pkg/kubeconfig"
+	"github.com/aws/eks-anywhere/pkg/logger"
+	"github.com/aws/eks-anywhere/pkg/managementbackup"
+	"github.com/aws/eks-anywhere/pkg/types"
+)
+
+type restoreManagementClusterOptions struct {
+	kubeConfig string
+	from       string
+}
+
+var rmco = &restoreManagementClusterOptions{}
+
+var restoreManagementClusterCmd = &cobra.Command{
+	Use:          "management-cluster <cluster-name>",
+	Short:        "Restore the workload clusters of a management cluster from a backup",
+	Long:         "Import the workload clusters saved by backup management-cluster in a new management cluster, created with the same name from the cluster config in the backup archive, without recreating their machines",
+	PreRunE:      bindFlagsToViper,
+	SilenceUsage: true,
+	Args:         cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if err := rmco.restoreManagementCluster(cmd.Context(), args[0]); err != nil {
+			return fmt.Errorf("failed to restore management cluster: %v", err)
+		}
+		return nil
+	},
+}
+
+func init() {
+	restoreCmd.AddCommand(restoreManagementClusterCmd)
+	restoreManagementClusterCmd.Flags().StringVar(&rmco.kubeConfig, "kubeconfig", "", "New management cluster kubeconfig file")
+	restoreManagementClusterCmd.Flags().StringVar(&rmco.from, "from", "", "Path of the backup archive")
+	if err := restoreManagementClusterCmd.MarkFlagRequired("from"); err != nil {
+		panic(err)
+	}
+}
+
+func (o *restoreManagementClusterOptions) restoreManagementCluster(ctx context.Context, clusterName string) error {
+	kubeconfigPath, err := kubeconfig.ResolveAndValidateFilename(o.kubeConfig, clusterName)
+	if err != nil {
+		return err
+	}
+
+	deps, err := dependencies.NewFactory().
+		WithExecutableMountDirs(kubeconfigPath).
+		WithClusterctl().
+		WithUnAuthKubeClient().
+		Build(ctx)
+	if err != nil {
+		return err
+	}
+	defer close(ctx, deps)
+
+	managementCluster := &types.Cluster{
+		Name:           clusterName,
+		KubeconfigFile: kubeconfigPath,
+	}
+	client := deps.UnAuthKubeClient.KubeconfigClient(kubeconfigPath)
+
+	restorer := managementbackup.NewRestorer(client, deps.Clusterctl)
+	restored, err := restorer.Restore(ctx, managementCluster, o.from)
+	if err != nil {
+		return err
+	}
+
+	logger.MarkSuccess("Management cluster restored, the workload clusters are being reconciled by the new management cluster", "cluster", clusterName, "restored", restored)
+
+	return nil
+}
@@ -0,0 +1,54 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/aws/eks-anywhere/pkg/clusterpause"
+	"github.com/aws/eks-anywhere/pkg/logger"
+)
+
+type resumeClusterOptions struct {
+	kubeConfig  string
+	namespace   string
+	forceUnlock bool
+}
+
+var rsco = &resumeClusterOptions{}
+
+var resumeClusterCmd = &cobra.Command{
+	Use:          "cluster <cluster-name>",
+	Short:        "Resume the reconciliation of a paused cluster",
+	Long:         "Let the EKS Anywhere, CAPI and provider controllers reconcile a cluster paused with pause cluster again",
+	PreRunE:      bindFlagsToViper,
+	SilenceUsage: true,
+	Args:         cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if err := rsco.resumeCluster(cmd.Context(), args[0]); err != nil {
+			return fmt.Errorf("failed to resume cluster: %v", err)
+		}
+		return nil
+	},
+}
+
+func init() {
+	resumeCmd.AddCommand(resumeClusterCmd)
+	withCLIVersionSkewValidation(resumeClusterCmd, clusterArgTarget)
+	resumeClusterCmd.Flags().StringVar(&rsco.kubeConfig, "kubeconfig", "", "Management cluster kubeconfig file. Defaults to the cluster kubeconfig")
+	resumeClusterCmd.Flags().StringVarP(&rsco.namespace, "namespace", "n", "default", "Namespace of the cluster")
+	resumeClusterCmd.Flags().BoolVar(&rsco.forceUnlock, "force-unlock", false, "Remove the operation lock of the cluster left by an interrupted operation before resuming it")
+}
+
+func (o *resumeClusterOptions) resumeCluster(ctx context.Context, clusterName string) error {
+	return withClusterLock(ctx, o.kubeConfig, clusterName, "resume cluster", o.forceUnlock, func(management client.Client) error {
+		if err := clusterpause.Resume(ctx, management, clusterName, o.namespace); err != nil {
+			return err
+		}
+
+		logger.MarkSuccess("Cluster reconciliation resumed", "cluster", clusterName)
+		return nil
+	})
+}
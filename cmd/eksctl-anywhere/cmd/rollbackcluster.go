@@ -0,0 +1,105 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/aws/eks-anywhere/pkg/clusterlock"
+	"github.com/aws/eks-anywhere/pkg/dependencies"
+	"github.com/aws/eks-anywhere/pkg/kubeconfig"
+	"github.com/aws/eks-anywhere/pkg/logger"
+	"github.com/aws/eks-anywhere/pkg/rollback"
+)
+
+type rollbackClusterOptions struct {
+	kubeConfig  string
+	namespace   string
+	dryRun      bool
+	forceUnlock bool
+}
+
+var rco = &rollbackClusterOptions{}
+
+var rollbackClusterCmd = &cobra.Command{
+	Use:          "cluster <cluster-name>",
+	Short:        "Rollback the last EKS Anywhere version upgrade of a workload cluster",
+	Long:         "Revert the spec and EKS Anywhere version of a workload cluster to the previous ones recorded in its history, rolling back its CAPI objects to the templates and Bundles of that version",
+	PreRunE:      bindFlagsToViper,
+	SilenceUsage: true,
+	Args:         cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if err := rco.rollbackCluster(cmd.Context(), args[0]); err != nil {
+			return fmt.Errorf("failed to rollback cluster: %v", err)
+		}
+		return nil
+	},
+}
+
+func init() {
+	rollbackCmd.AddCommand(rollbackClusterCmd)
+	withCLIVersionSkewValidation(rollbackClusterCmd, clusterArgTarget)
+	rollbackClusterCmd.Flags().StringVar(&rco.kubeConfig, "kubeconfig", "", "Management cluster kubeconfig file")
+	rollbackClusterCmd.Flags().StringVarP(&rco.namespace, "namespace", "n", "default", "Namespace of the cluster")
+	rollbackClusterCmd.Flags().BoolVar(&rco.dryRun, "dry-run", false, "Show the rollback without applying it")
+	rollbackClusterCmd.Flags().BoolVar(&rco.forceUnlock, "force-unlock", false, "Remove the operation lock of the cluster left by an interrupted operation before rolling it back")
+
+	if err := rollbackClusterCmd.MarkFlagRequired("kubeconfig"); err != nil {
+		logger.Fatal(err, "marking kubeconfig as required")
+	}
+}
+
+func (o *rollbackClusterOptions) rollbackCluster(ctx context.Context, clusterName string) error {
+	// Workload clusters are managed from their management cluster, so there is no default kubeconfig.
+	kubeconfigPath := o.kubeConfig
+	if err := kubeconfig.ValidateFilename(kubeconfigPath); err != nil {
+		return err
+	}
+
+	deps, err := dependencies.NewFactory().
+		WithExecutableMountDirs(kubeconfigPath).
+		WithExecutableBuilder().
+		WithKubectl().
+		WithUnAuthKubeClient().
+		Build(ctx)
+	if err != nil {
+		return err
+	}
+	defer close(ctx, deps)
+
+	client := deps.UnAuthKubeClient.KubeconfigClient(kubeconfigPath)
+
+	lock := clusterlock.New(client, clusterName, "rollback cluster")
+	if o.forceUnlock {
+		if err := clusterlock.ForceUnlock(ctx, client, clusterName); err != nil {
+			return err
+		}
+	}
+	if err := lock.Acquire(ctx); err != nil {
+		return err
+	}
+	defer func() {
+		if err := lock.Release(ctx); err != nil {
+			logger.Error(err, "Failed releasing the operation lock, remove it with --force-unlock in the next operation", "cluster", clusterName)
+		}
+	}()
+
+	plan, err := rollback.NewPlan(ctx, client, clusterName, o.namespace)
+	if err != nil {
+		return err
+	}
+
+	if o.dryRun {
+		logger.Info("Cluster would be rolled back", "cluster", clusterName, "from", plan.From, "to", plan.To)
+		return nil
+	}
+
+	if err := plan.Apply(ctx, client); err != nil {
+		return err
+	}
+
+	logger.MarkSuccess("Cluster rollback applied, the cluster is being reconciled by the management cluster", "cluster", clusterName, "from", plan.From, "to", plan.To)
+
+	return nil
+}
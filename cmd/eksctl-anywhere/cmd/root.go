@@ -6,12 +6,16 @@ import (
 	"log"
 	"os"
 	"path/filepath"
+	"strings"
 	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 
+	"github.com/aws/eks-anywhere/pkg/api/v1alpha1"
 	"github.com/aws/eks-anywhere/pkg/logger"
+	"github.com/aws/eks-anywhere/pkg/telemetry"
+	"github.com/aws/eks-anywhere/pkg/version"
 )
 
 var rootCmd = &cobra.Command{
@@ -32,6 +36,8 @@ var rootCmd = &cobra.Command{
 
 func init() {
 	rootCmd.PersistentFlags().IntP("verbosity", "v", 0, "Set the log level verbosity")
+	rootCmd.PersistentFlags().String("log-format", logger.FormatText, fmt.Sprintf("Format of the console logs, %s or %s", logger.FormatText, logger.FormatJSON))
+	rootCmd.PersistentFlags().StringToInt("log-levels", nil, fmt.Sprintf("Log level verbosity per subsystem, overriding --verbosity. Subsystems: %s. Example: executables=6,providers=4", strings.Join(logger.Subsystems(), ", ")))
 	if err := viper.BindPFlags(rootCmd.PersistentFlags()); err != nil {
 		log.Fatalf("failed to bind flags for root: %v", err)
 	}
@@ -51,18 +57,67 @@ func initLogger() error {
 	}
 
 	outputFilePath := filepath.Join(".", "eksa-cli-logs", fmt.Sprintf("%s.log", time.Now().Format("2006-01-02T15_04_05")))
-	if err = logger.Init(logger.Options{
-		Level:          viper.GetInt("verbosity"),
-		OutputFilePath: outputFilePath,
-	}); err != nil {
+	opts := loggerOptions()
+	opts.OutputFilePath = outputFilePath
+	if err = logger.Init(opts); err != nil {
 		return fmt.Errorf("root cmd: %v", err)
 	}
 
 	return nil
 }
 
+// loggerOptions returns the console logging options set with the root cmd flags.
+func loggerOptions() logger.Options {
+	levels := map[string]int{}
+	for subsystem, level := range viper.GetStringMap("log-levels") {
+		if l, ok := level.(int); ok {
+			levels[subsystem] = l
+		}
+	}
+
+	return logger.Options{
+		Level:           viper.GetInt("verbosity"),
+		Format:          viper.GetString("log-format"),
+		SubsystemLevels: levels,
+	}
+}
+
 func Execute() error {
-	return rootCmd.ExecuteContext(context.Background())
+	ctx := context.Background()
+	start := time.Now()
+	cmd, err := rootCmd.ExecuteContextC(ctx)
+	sendTelemetry(ctx, cmd, time.Since(start), err)
+
+	return err
+}
+
+// sendTelemetry reports the command execution when telemetry has been opted in to.
+// Failing to send it never fails the command.
+func sendTelemetry(ctx context.Context, cmd *cobra.Command, duration time.Duration, err error) {
+	client := telemetry.NewClientFromEnv()
+	if client == nil || cmd == nil {
+		return
+	}
+
+	event := telemetry.NewEvent(cmd.CommandPath(), commandProvider(cmd), version.Get().GitVersion, duration, err)
+	if err := client.Send(ctx, event); err != nil {
+		logger.V(4).Info("Failed sending telemetry", "error", err)
+	}
+}
+
+// commandProvider returns the provider of the cluster config passed to the command, if any.
+func commandProvider(cmd *cobra.Command) string {
+	f := cmd.Flags().Lookup("filename")
+	if f == nil || f.Value.String() == "" {
+		return ""
+	}
+
+	cluster, err := v1alpha1.GetClusterConfig(f.Value.String())
+	if err != nil {
+		return ""
+	}
+
+	return telemetry.ProviderFromDatacenterKind(cluster.Spec.DatacenterRef.Kind)
 }
 
 // RootCmd returns the eksctl-anywhere root cmd.
@@ -0,0 +1,108 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/aws/eks-anywhere/pkg/crypto"
+	"github.com/aws/eks-anywhere/pkg/dependencies"
+	"github.com/aws/eks-anywhere/pkg/filewriter"
+	"github.com/aws/eks-anywhere/pkg/kubeconfig"
+	"github.com/aws/eks-anywhere/pkg/logger"
+	"github.com/aws/eks-anywhere/pkg/sshusers"
+)
+
+type rotateSSHKeysOptions struct {
+	clusterName string
+	kubeConfig  string
+	namespace   string
+	user        string
+}
+
+var rsko = &rotateSSHKeysOptions{}
+
+var rotateSSHKeysCmd = &cobra.Command{
+	Use:          "ssh-keys",
+	Short:        "Rotate the ssh keys of the cluster nodes",
+	Long:         "Generate a new ssh key, add it to the control plane and worker nodes, verify access with it and remove the old keys, updating the machine configs to match",
+	PreRunE:      bindFlagsToViper,
+	SilenceUsage: true,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		if err := rsko.rotateSSHKeys(cmd.Context()); err != nil {
+			return fmt.Errorf("failed to rotate ssh keys: %v", err)
+		}
+		return nil
+	},
+}
+
+func init() {
+	rotateCmd.AddCommand(rotateSSHKeysCmd)
+	withCLIVersionSkewValidation(rotateSSHKeysCmd, clusterFlagTarget)
+	rotateSSHKeysCmd.Flags().StringVar(&rsko.clusterName, "cluster", "", "Name of the cluster to rotate the ssh keys for")
+	rotateSSHKeysCmd.Flags().StringVar(&rsko.kubeConfig, "kubeconfig", "", "Management cluster kubeconfig file. Defaults to the cluster kubeconfig")
+	rotateSSHKeysCmd.Flags().StringVarP(&rsko.namespace, "namespace", "n", "default", "Namespace of the cluster")
+	rotateSSHKeysCmd.Flags().StringVar(&rsko.user, "user", "", "User to rotate the ssh keys for. Defaults to the first user of each machine config")
+
+	if err := rotateSSHKeysCmd.MarkFlagRequired("cluster"); err != nil {
+		logger.Fatal(err, "marking cluster as required")
+	}
+}
+
+func (o *rotateSSHKeysOptions) rotateSSHKeys(ctx context.Context) error {
+	managementKubeconfig, err := kubeconfig.ResolveAndValidateFilename(o.kubeConfig, o.clusterName)
+	if err != nil {
+		return err
+	}
+
+	workloadKubeconfig, err := kubeconfig.ResolveAndValidateFilename("", o.clusterName)
+	if err != nil {
+		return err
+	}
+
+	deps, err := dependencies.NewFactory().
+		WithExecutableMountDirs(managementKubeconfig, workloadKubeconfig).
+		WithExecutableBuilder().
+		WithKubectl().
+		WithUnAuthKubeClient().
+		Build(ctx)
+	if err != nil {
+		return err
+	}
+	defer close(ctx, deps)
+
+	writer, err := filewriter.NewWriter(o.clusterName)
+	if err != nil {
+		return err
+	}
+
+	keyName := fmt.Sprintf("eks-a-id_rsa-%d", time.Now().Unix())
+	privateKeyPath, publicKey, err := crypto.NewSshKeyPairUsingFileWriter(writer, keyName, keyName+".pub")
+	if err != nil {
+		return fmt.Errorf("generating ssh key pair: %v", err)
+	}
+	logger.Info(fmt.Sprintf("New private key saved to %s", privateKeyPath))
+
+	rotator := sshusers.NewRotator(
+		deps.UnAuthKubeClient.KubeconfigClient(managementKubeconfig),
+		deps.UnAuthKubeClient.KubeconfigClient(workloadKubeconfig),
+		sshusers.SSHAccessVerifier{},
+	)
+
+	if err := rotator.Rotate(ctx, sshusers.Rotation{
+		ClusterName:    o.clusterName,
+		Namespace:      o.namespace,
+		UserName:       o.user,
+		AuthorizedKey:  strings.TrimRight(string(publicKey), "\n"),
+		PrivateKeyPath: privateKeyPath,
+	}); err != nil {
+		return err
+	}
+
+	logger.Info(fmt.Sprintf("SSH keys rotated. Use 'ssh -i %s <username>@<Node-IP-Address>' to login to your cluster nodes and update the users in your cluster config file to match the machine configs", privateKeyPath))
+
+	return nil
+}
@@ -0,0 +1,15 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+var scaleCmd = &cobra.Command{
+	Use:   "scale",
+	Short: "Scale resources",
+	Long:  "Use eksctl anywhere scale to change the number of nodes of cluster resources",
+}
+
+func init() {
+	rootCmd.AddCommand(scaleCmd)
+}
@@ -0,0 +1,140 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/aws/eks-anywhere/pkg/clusterlock"
+	"github.com/aws/eks-anywhere/pkg/dependencies"
+	"github.com/aws/eks-anywhere/pkg/kubeconfig"
+	"github.com/aws/eks-anywhere/pkg/logger"
+	"github.com/aws/eks-anywhere/pkg/nodegroupscaler"
+)
+
+type scaleClusterOptions struct {
+	kubeConfig      string
+	namespace       string
+	fileName        string
+	workerNodeGroup string
+	count           int
+	noWait          bool
+	forceUnlock     bool
+}
+
+var sco = &scaleClusterOptions{}
+
+var scaleClusterCmd = &cobra.Command{
+	Use:          "cluster <cluster-name>",
+	Short:        "Scale a worker node group of a cluster",
+	Long:         "Change the count of a worker node group in the cluster object and wait for its nodes to be ready, without running a full cluster upgrade",
+	PreRunE:      bindFlagsToViper,
+	SilenceUsage: true,
+	Args:         cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if err := sco.scaleCluster(cmd.Context(), args[0]); err != nil {
+			return fmt.Errorf("failed to scale cluster: %v", err)
+		}
+		return nil
+	},
+}
+
+func init() {
+	scaleCmd.AddCommand(scaleClusterCmd)
+	withCLIVersionSkewValidation(scaleClusterCmd, clusterArgTarget)
+	scaleClusterCmd.Flags().StringVar(&sco.kubeConfig, "kubeconfig", "", "Management cluster kubeconfig file. Defaults to the cluster kubeconfig")
+	scaleClusterCmd.Flags().StringVarP(&sco.namespace, "namespace", "n", "default", "Namespace of the cluster")
+	scaleClusterCmd.Flags().StringVarP(&sco.fileName, "filename", "f", "", "Cluster spec file to update with the new count")
+	scaleClusterCmd.Flags().StringVar(&sco.workerNodeGroup, "worker-node-group", "", "Name of the worker node group to scale")
+	scaleClusterCmd.Flags().IntVar(&sco.count, "count", 0, "Desired number of nodes of the worker node group")
+	scaleClusterCmd.Flags().BoolVar(&sco.noWait, "no-wait", false, "Don't wait for the nodes of the worker node group to be ready")
+	scaleClusterCmd.Flags().BoolVar(&sco.forceUnlock, "force-unlock", false, "Remove the operation lock of the cluster left by an interrupted operation before scaling it")
+
+	if err := scaleClusterCmd.MarkFlagRequired("worker-node-group"); err != nil {
+		logger.Fatal(err, "marking worker-node-group as required")
+	}
+	if err := scaleClusterCmd.MarkFlagRequired("count"); err != nil {
+		logger.Fatal(err, "marking count as required")
+	}
+}
+
+func (o *scaleClusterOptions) scaleCluster(ctx context.Context, clusterName string) error {
+	scaling := nodegroupscaler.Scaling{
+		ClusterName:     clusterName,
+		Namespace:       o.namespace,
+		WorkerNodeGroup: o.workerNodeGroup,
+		Count:           o.count,
+	}
+
+	// Check the spec file can be updated before changing the cluster.
+	var specFile []byte
+	if o.fileName != "" {
+		content, err := os.ReadFile(o.fileName)
+		if err != nil {
+			return fmt.Errorf("reading cluster spec file: %v", err)
+		}
+		if specFile, err = nodegroupscaler.UpdateSpecFile(content, scaling); err != nil {
+			return err
+		}
+	}
+
+	kubeconfigPath, err := kubeconfig.ResolveAndValidateFilename(o.kubeConfig, clusterName)
+	if err != nil {
+		return err
+	}
+
+	deps, err := dependencies.NewFactory().
+		WithExecutableMountDirs(kubeconfigPath).
+		WithExecutableBuilder().
+		WithKubectl().
+		WithUnAuthKubeClient().
+		Build(ctx)
+	if err != nil {
+		return err
+	}
+	defer close(ctx, deps)
+
+	client := deps.UnAuthKubeClient.KubeconfigClient(kubeconfigPath)
+
+	lock := clusterlock.New(client, clusterName, "scale cluster")
+	if o.forceUnlock {
+		if err := clusterlock.ForceUnlock(ctx, client, clusterName); err != nil {
+			return err
+		}
+	}
+	if err := lock.Acquire(ctx); err != nil {
+		return err
+	}
+	defer func() {
+		if err := lock.Release(ctx); err != nil {
+			logger.Error(err, "Failed releasing the operation lock, remove it with --force-unlock in the next operation", "cluster", clusterName)
+		}
+	}()
+
+	scaler := nodegroupscaler.NewScaler(client)
+	if err := scaler.Scale(ctx, scaling); err != nil {
+		return err
+	}
+
+	if specFile != nil {
+		if err := os.WriteFile(o.fileName, specFile, 0o644); err != nil {
+			return fmt.Errorf("writing cluster spec file: %v", err)
+		}
+		logger.Info("Cluster spec file updated", "file", o.fileName)
+	}
+
+	if o.noWait {
+		logger.MarkSuccess("Worker node group count updated, the cluster is being reconciled by the management cluster", "cluster", clusterName, "workerNodeGroup", o.workerNodeGroup, "count", o.count)
+		return nil
+	}
+
+	if err := scaler.Wait(ctx, scaling); err != nil {
+		return err
+	}
+
+	logger.MarkSuccess("Worker node group scaled", "cluster", clusterName, "workerNodeGroup", o.workerNodeGroup, "count", o.count)
+
+	return nil
+}
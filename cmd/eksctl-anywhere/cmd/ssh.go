@@ -0,0 +1,15 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+var sshCmd = &cobra.Command{
+	Use:   "ssh",
+	Short: "SSH into resources",
+	Long:  "Use eksctl anywhere ssh to open SSH sessions on resources, such as the nodes of a cluster",
+}
+
+func init() {
+	rootCmd.AddCommand(sshCmd)
+}
@@ -0,0 +1,129 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/clients/kubernetes"
+	"github.com/aws/eks-anywhere/pkg/executables"
+	"github.com/aws/eks-anywhere/pkg/kubeconfig"
+	"github.com/aws/eks-anywhere/pkg/logger"
+	"github.com/aws/eks-anywhere/pkg/nodessh"
+)
+
+type sshNodeOptions struct {
+	clusterName    string
+	kubeConfig     string
+	namespace      string
+	nodeGroup      string
+	privateKeyPath string
+	user           string
+}
+
+var sno = &sshNodeOptions{}
+
+var sshNodeCmd = &cobra.Command{
+	Use:          "node [machine-or-node-name] [-- command]",
+	Short:        "Open an SSH session on a node of a cluster",
+	Long:         "Resolve a node of a cluster by the name of its CAPI machine or node, or by its node group, and open an interactive SSH session on it, or run a single command when one is given after --",
+	PreRunE:      bindFlagsToViper,
+	SilenceUsage: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		name, command, err := sshNodeArgs(args, cmd.ArgsLenAtDash())
+		if err != nil {
+			return err
+		}
+		if err := sno.sshNode(cmd.Context(), name, command); err != nil {
+			return fmt.Errorf("failed to ssh into node: %v", err)
+		}
+		return nil
+	},
+}
+
+func init() {
+	sshCmd.AddCommand(sshNodeCmd)
+	sshNodeCmd.Flags().StringVar(&sno.clusterName, "cluster", "", "Name of the cluster the node belongs to")
+	sshNodeCmd.Flags().StringVar(&sno.kubeConfig, "kubeconfig", "", "Management cluster kubeconfig file. Defaults to the cluster kubeconfig")
+	sshNodeCmd.Flags().StringVarP(&sno.namespace, "namespace", "n", "default", "Namespace of the cluster")
+	sshNodeCmd.Flags().StringVar(&sno.nodeGroup, "node-group", "", "Node group to pick a running machine from when no machine is given: control-plane, etcd or the name of a worker node group")
+	sshNodeCmd.Flags().StringVar(&sno.privateKeyPath, "ssh-key", "", "Private key file to log in with. Defaults to the key generated for the cluster, <cluster-name>/eks-a-id_rsa")
+	sshNodeCmd.Flags().StringVar(&sno.user, "ssh-user", "", "User to log in with. Defaults to the user of the machine config of the node")
+
+	if err := sshNodeCmd.MarkFlagRequired("cluster"); err != nil {
+		logger.Fatal(err, "marking cluster as required")
+	}
+}
+
+// sshNodeArgs splits the arguments of the command into the name of the machine or node, and the
+// command to run on it, which follows the dash.
+func sshNodeArgs(args []string, argsLenAtDash int) (name string, command []string, err error) {
+	names := args
+	if argsLenAtDash >= 0 {
+		names = args[:argsLenAtDash]
+		command = args[argsLenAtDash:]
+	}
+
+	if len(names) > 1 {
+		return "", nil, fmt.Errorf("expected at most one machine or node name, got %d", len(names))
+	}
+	if len(names) == 1 {
+		name = names[0]
+	}
+
+	return name, command, nil
+}
+
+func (o *sshNodeOptions) sshNode(ctx context.Context, name string, command []string) error {
+	if name == "" && o.nodeGroup == "" {
+		return errors.New("either a machine or node name or --node-group is required")
+	}
+	if name != "" && o.nodeGroup != "" {
+		return errors.New("a machine or node name and --node-group can't be set together")
+	}
+
+	kubeconfigPath, err := kubeconfig.ResolveAndValidateFilename(o.kubeConfig, o.clusterName)
+	if err != nil {
+		return err
+	}
+
+	management, err := kubernetes.NewRuntimeClientFromFileName(kubeconfigPath)
+	if err != nil {
+		return err
+	}
+
+	cluster := &anywherev1.Cluster{}
+	if err := management.Get(ctx, client.ObjectKey{Name: o.clusterName, Namespace: o.namespace}, cluster); err != nil {
+		return fmt.Errorf("reading cluster %s: %v", o.clusterName, err)
+	}
+
+	target, err := nodessh.Resolve(ctx, management, cluster, name, o.nodeGroup)
+	if err != nil {
+		return err
+	}
+	if o.user != "" {
+		target.User = o.user
+	}
+
+	privateKeyPath := o.privateKeyPath
+	if privateKeyPath == "" {
+		privateKeyPath = nodessh.DefaultPrivateKeyPath(o.clusterName)
+	}
+
+	logger.V(3).Info("Connecting to machine", "machine", target.Machine, "nodeGroup", target.NodeGroup, "ip", target.IP, "user", target.User)
+	if len(command) == 0 {
+		return nodessh.OpenSession(ctx, privateKeyPath, target)
+	}
+
+	out, err := executables.NewLocalExecutablesBuilder().BuildSSHExecutable().RunCommand(ctx, privateKeyPath, target.User, target.IP, command...)
+	if err != nil {
+		return err
+	}
+	fmt.Print(out)
+
+	return nil
+}
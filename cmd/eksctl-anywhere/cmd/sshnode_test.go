@@ -0,0 +1,72 @@
+package cmd
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestSSHNodeArgs(t *testing.T) {
+	tests := []struct {
+		name          string
+		args          []string
+		argsLenAtDash int
+		wantName      string
+		wantCommand   []string
+		wantErr       string
+	}{
+		{
+			name:          "no args",
+			argsLenAtDash: -1,
+		},
+		{
+			name:          "name only",
+			args:          []string{"node-1"},
+			argsLenAtDash: -1,
+			wantName:      "node-1",
+		},
+		{
+			name:          "name and command",
+			args:          []string{"node-1", "sudo", "crictl", "ps"},
+			argsLenAtDash: 1,
+			wantName:      "node-1",
+			wantCommand:   []string{"sudo", "crictl", "ps"},
+		},
+		{
+			name:          "command only",
+			args:          []string{"uptime"},
+			argsLenAtDash: 0,
+			wantCommand:   []string{"uptime"},
+		},
+		{
+			name:          "too many names",
+			args:          []string{"node-1", "node-2"},
+			argsLenAtDash: -1,
+			wantErr:       "expected at most one machine or node name, got 2",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			name, command, err := sshNodeArgs(tt.args, tt.argsLenAtDash)
+			if tt.wantErr != "" {
+				g.Expect(err).To(MatchError(tt.wantErr))
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(name).To(Equal(tt.wantName))
+			g.Expect(command).To(Equal(tt.wantCommand))
+		})
+	}
+}
+
+func TestSSHNodeRequiresTarget(t *testing.T) {
+	g := NewWithT(t)
+	o := &sshNodeOptions{clusterName: "my-cluster"}
+
+	g.Expect(o.sshNode(context.Background(), "", nil)).To(MatchError("either a machine or node name or --node-group is required"))
+
+	o.nodeGroup = "md-0"
+	g.Expect(o.sshNode(context.Background(), "node-1", nil)).To(MatchError("a machine or node name and --node-group can't be set together"))
+}
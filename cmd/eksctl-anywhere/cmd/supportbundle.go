@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"os"
 	"time"
 
 	"github.com/spf13/cobra"
@@ -25,6 +26,7 @@ type createSupportBundleOptions struct {
 	tinkerbellBootstrapIP string
 	bundlesManifest       string
 	auditLogs             bool
+	redactionProfile      string
 }
 
 var csbo = &createSupportBundleOptions{}
@@ -39,7 +41,7 @@ var supportbundleCmd = &cobra.Command{
 		if err := csbo.validate(cmd.Context()); err != nil {
 			return err
 		}
-		if err := csbo.createBundle(cmd.Context(), csbo.since, csbo.sinceTime, csbo.bundleConfig, csbo.bundlesManifest, csbo.auditLogs); err != nil {
+		if err := csbo.createBundle(cmd.Context(), csbo.since, csbo.sinceTime, csbo.bundleConfig, csbo.bundlesManifest, csbo.auditLogs, csbo.redactionProfile); err != nil {
 			return fmt.Errorf("failed to create support bundle: %v", err)
 		}
 		return nil
@@ -48,6 +50,7 @@ var supportbundleCmd = &cobra.Command{
 
 func init() {
 	generateCmd.AddCommand(supportbundleCmd)
+	withCLIVersionSkewValidation(supportbundleCmd, clusterConfigTarget)
 	supportbundleCmd.Flags().StringVarP(&csbo.sinceTime, "since-time", "", "", "Collect pod logs after a specific datetime(RFC3339) like 2021-06-28T15:04:05Z")
 	supportbundleCmd.Flags().StringVarP(&csbo.since, "since", "", "", "Collect pod logs in the latest duration like 5s, 2m, or 3h.")
 	supportbundleCmd.Flags().StringVarP(&csbo.bundleConfig, "bundle-config", "", "", "Bundle Config file to use when generating support bundle")
@@ -55,6 +58,7 @@ func init() {
 	supportbundleCmd.Flags().StringVarP(&csbo.wConfig, "w-config", "w", "", "Kubeconfig file to use when creating support bundle for a workload cluster")
 	supportbundleCmd.Flags().StringVarP(&csbo.bundlesManifest, "bundles-manifest", "", "", "Bundles manifest to use when generating support bundle (required for generating support bundle in airgap environment)")
 	supportbundleCmd.Flags().BoolVarP(&csbo.auditLogs, "audit-logs", "", false, "Include the latest api server audit log file in the support bundle")
+	supportbundleCmd.Flags().StringVarP(&csbo.redactionProfile, "redaction-profile", "", "", "Redaction profile file with a list of regexes to redact from the support bundle, on top of the built-in tokens, passwords and private keys redaction")
 	err := supportbundleCmd.MarkFlagRequired("filename")
 	if err != nil {
 		log.Fatalf("Error marking flag as required: %v", err)
@@ -75,7 +79,7 @@ func (csbo *createSupportBundleOptions) validate(ctx context.Context) error {
 	return nil
 }
 
-func (csbo *createSupportBundleOptions) createBundle(ctx context.Context, since, sinceTime, bundleConfig string, bundlesManifest string, auditLogs bool) error {
+func (csbo *createSupportBundleOptions) createBundle(ctx context.Context, since, sinceTime, bundleConfig string, bundlesManifest string, auditLogs bool, redactionProfilePath string) error {
 	var opts []cluster.FileSpecBuilderOpt
 	if bundlesManifest != "" {
 		opts = append(opts, cluster.WithOverrideBundlesManifest(bundlesManifest))
@@ -85,7 +89,20 @@ func (csbo *createSupportBundleOptions) createBundle(ctx context.Context, since,
 		return fmt.Errorf("unable to get cluster config from file: %v", err)
 	}
 
+	var redactionProfile *diagnostics.RedactionProfile
+	if redactionProfilePath != "" {
+		content, err := os.ReadFile(redactionProfilePath)
+		if err != nil {
+			return fmt.Errorf("reading redaction profile: %v", err)
+		}
+		redactionProfile, err = diagnostics.ParseRedactionProfile(content)
+		if err != nil {
+			return err
+		}
+	}
+
 	deps, err := dependencies.ForSpec(clusterSpec).
+		WithDiagnosticRedactionProfile(redactionProfile).
 		WithProvider(csbo.fileName, clusterSpec.Cluster, cc.skipIpCheck, csbo.hardwareFileName, false, csbo.tinkerbellBootstrapIP, map[string]bool{}, nil).
 		WithDiagnosticBundleFactory().
 		Build(ctx)
@@ -4,19 +4,25 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"os"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 
 	"github.com/aws/eks-anywhere/cmd/eksctl-anywhere/cmd/aflag"
 	"github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/clusterlock"
 	"github.com/aws/eks-anywhere/pkg/dependencies"
+	"github.com/aws/eks-anywhere/pkg/filewriter"
 	"github.com/aws/eks-anywhere/pkg/kubeconfig"
 	"github.com/aws/eks-anywhere/pkg/logger"
 	"github.com/aws/eks-anywhere/pkg/providers/tinkerbell/hardware"
 	"github.com/aws/eks-anywhere/pkg/types"
+	"github.com/aws/eks-anywhere/pkg/upgradeplan"
 	"github.com/aws/eks-anywhere/pkg/validations"
 	"github.com/aws/eks-anywhere/pkg/validations/upgradevalidations"
+	"github.com/aws/eks-anywhere/pkg/version"
 	"github.com/aws/eks-anywhere/pkg/workflows/management"
 	"github.com/aws/eks-anywhere/pkg/workflows/workload"
 )
@@ -29,6 +35,9 @@ type upgradeClusterOptions struct {
 	hardwareCSVPath       string
 	tinkerbellBootstrapIP string
 	skipValidations       []string
+	fromPlan              string
+	forceUnlock           bool
+	acknowledgeRollout    bool
 	providerOptions       *dependencies.ProviderOptions
 }
 
@@ -70,6 +79,9 @@ func init() {
 	upgradeClusterCmd.Flags().StringVarP(&uc.wConfig, "w-config", "w", "", "Kubeconfig file to use when upgrading a workload cluster")
 	upgradeClusterCmd.Flags().BoolVar(&uc.forceClean, "force-cleanup", false, "Force deletion of previously created bootstrap cluster")
 	hideForceCleanup(upgradeClusterCmd.Flags())
+	upgradeClusterCmd.Flags().StringVar(&uc.fromPlan, "from-plan", "", "Upgrade plan file generated with upgrade plan cluster --plan-output to execute")
+	upgradeClusterCmd.Flags().BoolVar(&uc.forceUnlock, "force-unlock", false, "Remove the operation lock of the cluster left by an interrupted operation before upgrading it")
+	upgradeClusterCmd.Flags().BoolVar(&uc.acknowledgeRollout, "acknowledge-rollout", false, "Acknowledge the changes of the cluster config that replace the machines of the cluster")
 	upgradeClusterCmd.Flags().StringArrayVar(&uc.skipValidations, "skip-validations", []string{}, fmt.Sprintf("Bypass upgrade validations by name. Valid arguments you can pass are --skip-validations=%s", strings.Join(upgradevalidations.SkippableValidations[:], ",")))
 	aflag.MarkRequired(createClusterCmd.Flags(), aflag.ClusterConfig.Name)
 	tinkerbellFlags(upgradeClusterCmd.Flags(), uc.providerOptions.Tinkerbell.BMCOptions.RPC)
@@ -89,6 +101,12 @@ func (uc *upgradeClusterOptions) upgradeCluster(cmd *cobra.Command, args []strin
 		return fmt.Errorf("the cluster config file provided is invalid: %v", err)
 	}
 
+	if uc.fromPlan != "" {
+		if err := uc.useUpgradePlan(clusterConfig.Name); err != nil {
+			return err
+		}
+	}
+
 	if clusterConfig.Spec.DatacenterRef.Kind == v1alpha1.TinkerbellDatacenterKind {
 		if err := checkTinkerbellFlags(cmd.Flags(), uc.hardwareCSVPath, Upgrade); err != nil {
 			return err
@@ -178,6 +196,10 @@ func (uc *upgradeClusterOptions) upgradeCluster(cmd *cobra.Command, args []strin
 		return err
 	}
 
+	if uc.acknowledgeRollout {
+		clusterSpec.Cluster.AcknowledgeRollout(time.Now().UTC().Format(time.RFC3339))
+	}
+
 	workloadCluster := &types.Cluster{
 		Name:           clusterSpec.Cluster.Name,
 		KubeconfigFile: getKubeconfigPath(clusterSpec.Cluster.Name, uc.wConfig),
@@ -190,6 +212,28 @@ func (uc *upgradeClusterOptions) upgradeCluster(cmd *cobra.Command, args []strin
 		managementCluster = clusterSpec.ManagementCluster
 	}
 
+	managementClient := deps.UnAuthKubeClient.KubeconfigClient(managementCluster.KubeconfigFile)
+	lock := clusterlock.New(managementClient, workloadCluster.Name, "upgrade cluster")
+	if uc.forceUnlock {
+		if err := clusterlock.ForceUnlock(ctx, managementClient, workloadCluster.Name); err != nil {
+			return err
+		}
+	}
+	if err := lock.Acquire(ctx); err != nil {
+		return err
+	}
+	defer func() {
+		if err := lock.Release(ctx); err != nil {
+			logger.Error(err, "Failed releasing the operation lock, remove it with --force-unlock in the next operation", "cluster", workloadCluster.Name)
+		}
+	}()
+
+	if !skippedValidations[validations.CLIVersionSkew] {
+		if err := validations.ValidateClusterCLIVersionSkew(ctx, deps.UnAuthKubectlClient, version.Get().GitVersion, managementCluster, workloadCluster.Name); err != nil {
+			return err
+		}
+	}
+
 	validationOpts := &validations.Opts{
 		Kubectl:            deps.UnAuthKubectlClient,
 		Spec:               clusterSpec,
@@ -201,6 +245,7 @@ func (uc *upgradeClusterOptions) upgradeCluster(cmd *cobra.Command, args []strin
 		KubeClient:         deps.UnAuthKubeClient.KubeconfigClient(managementCluster.KubeconfigFile),
 		ManifestReader:     deps.ManifestReader,
 		BundlesOverride:    uc.bundlesOverride,
+		AcknowledgeRollout: uc.acknowledgeRollout,
 	}
 
 	upgradeValidations := upgradevalidations.New(validationOpts)
@@ -241,6 +286,42 @@ func (uc *upgradeClusterOptions) upgradeCluster(cmd *cobra.Command, args []strin
 	return err
 }
 
+// useUpgradePlan validates the upgrade plan still applies to the cluster config and pins the
+// upgrade to the Bundles frozen in it.
+func (uc *upgradeClusterOptions) useUpgradePlan(clusterName string) error {
+	if uc.bundlesOverride != "" {
+		return errors.New("--from-plan and --bundles-override can't be used together")
+	}
+
+	plan, err := upgradeplan.Read(uc.fromPlan)
+	if err != nil {
+		return err
+	}
+
+	clusterConfig, err := os.ReadFile(uc.fileName)
+	if err != nil {
+		return fmt.Errorf("reading cluster config file: %v", err)
+	}
+
+	if err := plan.Validate(clusterName, clusterConfig, version.Get().GitVersion); err != nil {
+		return err
+	}
+
+	writer, err := filewriter.NewWriter(clusterName)
+	if err != nil {
+		return err
+	}
+
+	uc.bundlesOverride, err = plan.WriteBundles(writer)
+	if err != nil {
+		return err
+	}
+
+	logger.V(0).Info("Using upgrade plan", "file", uc.fromPlan, "eksaVersion", plan.EKSAVersion)
+
+	return nil
+}
+
 func (uc *upgradeClusterOptions) commonValidations(ctx context.Context) (cluster *v1alpha1.Cluster, err error) {
 	clusterConfig, err := commonValidation(ctx, uc.fileName)
 	if err != nil {
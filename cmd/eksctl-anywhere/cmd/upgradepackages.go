@@ -24,6 +24,7 @@ var upo = &upgradePackageOptions{}
 
 func init() {
 	upgradeCmd.AddCommand(upgradePackagesCommand)
+	withCLIVersionSkewValidation(upgradePackagesCommand, packagesTarget)
 
 	upgradePackagesCommand.Flags().StringVar(&upo.bundleVersion, "bundle-version",
 		"", "Bundle version to use")
@@ -6,15 +6,21 @@ import (
 	"encoding/json"
 	"fmt"
 	"log"
+	"os"
 	"text/tabwriter"
 
 	"github.com/spf13/cobra"
+	"sigs.k8s.io/yaml"
 
+	"github.com/aws/eks-anywhere/pkg/cluster"
 	"github.com/aws/eks-anywhere/pkg/dependencies"
 	"github.com/aws/eks-anywhere/pkg/eksd"
 	"github.com/aws/eks-anywhere/pkg/logger"
+	"github.com/aws/eks-anywhere/pkg/networking/calico"
 	"github.com/aws/eks-anywhere/pkg/networking/cilium"
 	"github.com/aws/eks-anywhere/pkg/types"
+	"github.com/aws/eks-anywhere/pkg/upgradeplan"
+	"github.com/aws/eks-anywhere/pkg/version"
 )
 
 const (
@@ -22,9 +28,14 @@ const (
 	outputDefault  = outputText
 	outputText     = "text"
 	outputJson     = "json"
+	outputYaml     = "yaml"
 )
 
-var output string
+var (
+	output     string
+	planOutput string
+	planDiff   bool
+)
 
 var upgradePlanClusterCmd = &cobra.Command{
 	Use:          "cluster",
@@ -42,10 +53,13 @@ var upgradePlanClusterCmd = &cobra.Command{
 
 func init() {
 	upgradePlanCmd.AddCommand(upgradePlanClusterCmd)
+	withCLIVersionSkewValidation(upgradePlanClusterCmd, clusterConfigTarget)
 	upgradePlanClusterCmd.Flags().StringVarP(&uc.fileName, "filename", "f", "", "Filename that contains EKS-A cluster configuration")
 	upgradePlanClusterCmd.Flags().StringVar(&uc.bundlesOverride, "bundles-override", "", "Override default Bundles manifest (not recommended)")
-	upgradePlanClusterCmd.Flags().StringVarP(&output, outputFlagName, "o", outputDefault, "Output format: text|json")
+	upgradePlanClusterCmd.Flags().StringVarP(&output, outputFlagName, "o", outputDefault, "Output format: text|json|yaml")
 	upgradePlanClusterCmd.Flags().StringVar(&uc.managementKubeconfig, "kubeconfig", "", "Management cluster kubeconfig file")
+	upgradePlanClusterCmd.Flags().StringVar(&planOutput, "plan-output", "", "File to write the upgrade plan to, so it can be executed later with upgrade cluster --from-plan")
+	upgradePlanClusterCmd.Flags().BoolVar(&planDiff, "diff", false, "Output the versions of every component and the kubelet version and OS template of every node group, including the unchanged ones")
 	err := upgradePlanClusterCmd.MarkFlagRequired("filename")
 	if err != nil {
 		log.Fatalf("Error marking flag as required: %v", err)
@@ -53,6 +67,10 @@ func init() {
 }
 
 func (uc *upgradeClusterOptions) upgradePlanCluster(ctx context.Context) error {
+	if err := useReadOnlyCredentials(); err != nil {
+		return err
+	}
+
 	if _, err := uc.commonValidations(ctx); err != nil {
 		return fmt.Errorf("common validations failed due to: %v", err)
 	}
@@ -97,24 +115,48 @@ func (uc *upgradeClusterOptions) upgradePlanCluster(ctx context.Context) error {
 	}
 
 	componentChangeDiffs.Append(cilium.ChangeDiff(currentSpec, newClusterSpec))
+	componentChangeDiffs.Append(calico.ChangeDiff(currentSpec, newClusterSpec))
 	componentChangeDiffs.Append(eksd.ChangeDiff(currentSpec, newClusterSpec))
 
-	serializedDiff, err := serialize(componentChangeDiffs, output)
+	var serializedDiff string
+	if planDiff {
+		serializedDiff, err = serializeUpgradePlanDiff(upgradeplan.BuildDiff(currentSpec, newClusterSpec), output)
+	} else {
+		serializedDiff, err = serialize(componentChangeDiffs, output)
+	}
 	if err != nil {
 		return err
 	}
 
 	logger.V(0).Info(serializedDiff)
 
+	if planOutput != "" {
+		if err := writeUpgradePlan(planOutput, uc.fileName, newClusterSpec, componentChangeDiffs); err != nil {
+			return err
+		}
+		logger.V(0).Info("Upgrade plan written", "file", planOutput)
+	}
+
 	return nil
 }
 
+func writeUpgradePlan(path, clusterConfigFile string, spec *cluster.Spec, diff *types.ChangeDiff) error {
+	clusterConfig, err := os.ReadFile(clusterConfigFile)
+	if err != nil {
+		return fmt.Errorf("reading cluster config file: %v", err)
+	}
+
+	return upgradeplan.Write(path, upgradeplan.New(spec, clusterConfig, diff, version.Get().GitVersion))
+}
+
 func serialize(componentChangeDiffs *types.ChangeDiff, outputFormat string) (string, error) {
 	switch outputFormat {
 	case outputText:
 		return serializeToText(componentChangeDiffs)
 	case outputJson:
 		return serializeToJson(componentChangeDiffs)
+	case outputYaml:
+		return serializeToYaml(componentChangeDiffs)
 	default:
 		return "", fmt.Errorf("invalid output format [%s]", outputFormat)
 	}
@@ -150,3 +192,56 @@ func serializeToJson(componentChangeDiffs *types.ChangeDiff) (string, error) {
 
 	return string(jsonDiff), nil
 }
+
+func serializeToYaml(componentChangeDiffs *types.ChangeDiff) (string, error) {
+	if componentChangeDiffs == nil {
+		componentChangeDiffs = &types.ChangeDiff{ComponentReports: []types.ComponentChangeDiff{}}
+	}
+
+	yamlDiff, err := yaml.Marshal(componentChangeDiffs)
+	if err != nil {
+		return "", fmt.Errorf("failed serializing the components diff to yaml: %v", err)
+	}
+
+	return string(yamlDiff), nil
+}
+
+func serializeUpgradePlanDiff(diff *upgradeplan.Diff, outputFormat string) (string, error) {
+	switch outputFormat {
+	case outputText:
+		return serializeUpgradePlanDiffToText(diff)
+	case outputJson:
+		jsonDiff, err := json.Marshal(diff)
+		if err != nil {
+			return "", fmt.Errorf("failed serializing the upgrade plan diff to json: %v", err)
+		}
+		return string(jsonDiff), nil
+	case outputYaml:
+		yamlDiff, err := yaml.Marshal(diff)
+		if err != nil {
+			return "", fmt.Errorf("failed serializing the upgrade plan diff to yaml: %v", err)
+		}
+		return string(yamlDiff), nil
+	default:
+		return "", fmt.Errorf("invalid output format [%s]", outputFormat)
+	}
+}
+
+func serializeUpgradePlanDiffToText(diff *upgradeplan.Diff) (string, error) {
+	buffer := bytes.Buffer{}
+	w := tabwriter.NewWriter(&buffer, 10, 4, 3, ' ', 0)
+	fmt.Fprintln(w, "COMPONENT\tCURRENT VERSION\tNEXT VERSION\tCHANGED")
+	for _, c := range diff.Components {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%t\n", c.Name, c.OldVersion, c.NewVersion, c.Changed)
+	}
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "NODE GROUP\tCURRENT KUBELET\tNEXT KUBELET\tCURRENT OS TEMPLATE\tNEXT OS TEMPLATE\tCHANGED")
+	for _, n := range diff.NodeGroups {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%t\n", n.Name, n.OldKubeletVersion, n.NewKubeletVersion, n.OldOSTemplate, n.NewOSTemplate, n.Changed)
+	}
+	if err := w.Flush(); err != nil {
+		return "", fmt.Errorf("failed flushing table writer: %v", err)
+	}
+
+	return buffer.String(), nil
+}
@@ -34,9 +34,10 @@ var upgradePlanManagementComponentsCmd = &cobra.Command{
 
 func init() {
 	upgradePlanCmd.AddCommand(upgradePlanManagementComponentsCmd)
+	withCLIVersionSkewValidation(upgradePlanManagementComponentsCmd, clusterConfigTarget)
 	upgradePlanManagementComponentsCmd.Flags().StringVarP(&uc.fileName, "filename", "f", "", "Filename that contains EKS-A cluster configuration")
 	upgradePlanManagementComponentsCmd.Flags().StringVar(&uc.bundlesOverride, "bundles-override", "", "Override default Bundles manifest (not recommended)")
-	upgradePlanManagementComponentsCmd.Flags().StringVarP(&output, outputFlagName, "o", outputDefault, "Output format: text|json")
+	upgradePlanManagementComponentsCmd.Flags().StringVarP(&output, outputFlagName, "o", outputDefault, "Output format: text|json|yaml")
 	upgradePlanManagementComponentsCmd.Flags().StringVar(&uc.managementKubeconfig, "kubeconfig", "", "Management cluster kubeconfig file")
 	err := upgradePlanManagementComponentsCmd.MarkFlagRequired("filename")
 	if err != nil {
@@ -45,6 +46,10 @@ func init() {
 }
 
 func (uc *upgradeClusterOptions) upgradePlanManagementComponents(ctx context.Context) error {
+	if err := useReadOnlyCredentials(); err != nil {
+		return err
+	}
+
 	if _, err := uc.commonValidations(ctx); err != nil {
 		return fmt.Errorf("common validations failed due to: %v", err)
 	}
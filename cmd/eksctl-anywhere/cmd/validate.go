@@ -10,6 +10,14 @@ var validateCmd = &cobra.Command{
 	Long:  "Use eksctl anywhere validate to validate a resource or action",
 }
 
+var expValidateCmd = &cobra.Command{
+	Use:        "validate",
+	Short:      "Validate resource or action",
+	Long:       "Use eksctl anywhere exp validate to validate a resource or action",
+	Deprecated: "use `eksctl anywhere validate` instead",
+}
+
 func init() {
-	expCmd.AddCommand(validateCmd)
+	rootCmd.AddCommand(validateCmd)
+	expCmd.AddCommand(expValidateCmd)
 }
@@ -1,10 +1,37 @@
 package cmd
 
 import (
+	"log"
+
 	"github.com/spf13/cobra"
+
+	"github.com/aws/eks-anywhere/pkg/dependencies"
+	"github.com/aws/eks-anywhere/pkg/providers/tinkerbell/hardware"
 )
 
+var vc = &validateOptions{
+	providerOptions: &dependencies.ProviderOptions{
+		Tinkerbell: &dependencies.TinkerbellOptions{
+			BMCOptions: &hardware.BMCOptions{
+				RPC: &hardware.RPCOpts{},
+			},
+		},
+	},
+}
+
 var validateCreateCmd = &cobra.Command{
+	Use:   "create -f <cluster-config-file> [flags]",
+	Short: "Validate create cluster",
+	Long: "Use eksctl anywhere validate create to run the create cluster validations, like the provider " +
+		"connectivity, templates, IP conflicts, control plane IP and registry mirror reachability, " +
+		"without creating a bootstrap cluster",
+	PreRunE:      bindFlagsToViper,
+	SilenceUsage: true,
+	Args:         cobra.NoArgs,
+	RunE:         vc.validateCreateCluster,
+}
+
+var expValidateCreateCmd = &cobra.Command{
 	Use:   "create",
 	Short: "Validate create resources",
 	Long:  "Use eksctl anywhere validate create to validate the create action on resources, such as cluster",
@@ -12,4 +39,15 @@ var validateCreateCmd = &cobra.Command{
 
 func init() {
 	validateCmd.AddCommand(validateCreateCmd)
+	applyTinkerbellHardwareFlag(validateCreateCmd.Flags(), &vc.hardwareCSVPath)
+	validateCreateCmd.Flags().StringVarP(&vc.fileName, "filename", "f", "", "Filename that contains EKS-A cluster configuration")
+	validateCreateCmd.Flags().StringVar(&vc.tinkerbellBootstrapIP, "tinkerbell-bootstrap-ip", "", "Override the local tinkerbell IP in the bootstrap cluster")
+	validateCreateCmd.Flags().StringVar(&vc.reportFile, "report", "", "Path of the file to write the JSON report of the validations to")
+
+	if err := validateCreateCmd.MarkFlagRequired("filename"); err != nil {
+		log.Fatalf("Error marking flag as required: %v", err)
+	}
+	tinkerbellFlags(validateCreateCmd.Flags(), vc.providerOptions.Tinkerbell.BMCOptions.RPC)
+
+	expValidateCmd.AddCommand(expValidateCreateCmd)
 }
@@ -1,6 +1,8 @@
 package cmd
 
 import (
+	"encoding/json"
+	"fmt"
 	"log"
 	"os"
 
@@ -9,6 +11,7 @@ import (
 	"github.com/aws/eks-anywhere/pkg/api/v1alpha1"
 	"github.com/aws/eks-anywhere/pkg/dependencies"
 	"github.com/aws/eks-anywhere/pkg/kubeconfig"
+	"github.com/aws/eks-anywhere/pkg/logger"
 	"github.com/aws/eks-anywhere/pkg/providers/tinkerbell/hardware"
 	"github.com/aws/eks-anywhere/pkg/types"
 	"github.com/aws/eks-anywhere/pkg/validations"
@@ -21,9 +24,17 @@ type validateOptions struct {
 	clusterOptions
 	hardwareCSVPath       string
 	tinkerbellBootstrapIP string
+	reportFile            string
 	providerOptions       *dependencies.ProviderOptions
 }
 
+// createValidationReport is the JSON report of the create cluster validations.
+type createValidationReport struct {
+	Cluster  string `json:"cluster"`
+	Provider string `json:"provider"`
+	*validations.Report
+}
+
 var valOpt = &validateOptions{
 	providerOptions: &dependencies.ProviderOptions{
 		Tinkerbell: &dependencies.TinkerbellOptions{
@@ -40,11 +51,12 @@ var validateCreateClusterCmd = &cobra.Command{
 	Long:         "Use eksctl anywhere validate create cluster to validate the create cluster action",
 	PreRunE:      bindFlagsToViper,
 	SilenceUsage: true,
+	Deprecated:   "use `eksctl anywhere validate create` instead",
 	RunE:         valOpt.validateCreateCluster,
 }
 
 func init() {
-	validateCreateCmd.AddCommand(validateCreateClusterCmd)
+	expValidateCreateCmd.AddCommand(validateCreateClusterCmd)
 	applyTinkerbellHardwareFlag(validateCreateClusterCmd.Flags(), &valOpt.hardwareCSVPath)
 	validateCreateClusterCmd.Flags().StringVarP(&valOpt.fileName, "filename", "f", "", "Filename that contains EKS-A cluster configuration")
 	validateCreateClusterCmd.Flags().StringVar(&valOpt.tinkerbellBootstrapIP, "tinkerbell-bootstrap-ip", "", "Override the local tinkerbell IP in the bootstrap cluster")
@@ -58,6 +70,10 @@ func init() {
 func (valOpt *validateOptions) validateCreateCluster(cmd *cobra.Command, _ []string) error {
 	ctx := cmd.Context()
 
+	if err := useReadOnlyCredentials(); err != nil {
+		return err
+	}
+
 	clusterSpec, err := readClusterSpec(valOpt.fileName, version.Get())
 	if err != nil {
 		return err
@@ -111,8 +127,33 @@ func (valOpt *validateOptions) validateCreateCluster(cmd *cobra.Command, _ []str
 	createValidations := createvalidations.New(validationOpts)
 
 	commandVal := createcluster.NewValidations(clusterSpec, deps.Provider, deps.GitOpsFlux, createValidations, deps.DockerClient)
-	err = commandVal.Validate(ctx)
+	report, err := commandVal.ValidateWithReport(ctx)
 
 	cleanupDirectory(tmpPath)
+
+	if valOpt.reportFile != "" {
+		if writeErr := writeCreateValidationReport(valOpt.reportFile, clusterSpec.Cluster, report); writeErr != nil {
+			return writeErr
+		}
+	}
+
 	return err
 }
+
+func writeCreateValidationReport(path string, cluster *v1alpha1.Cluster, report *validations.Report) error {
+	content, err := json.MarshalIndent(createValidationReport{
+		Cluster:  cluster.Name,
+		Provider: cluster.Spec.DatacenterRef.Kind,
+		Report:   report,
+	}, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshalling validation report: %v", err)
+	}
+
+	if err := os.WriteFile(path, append(content, '\n'), 0o644); err != nil {
+		return fmt.Errorf("writing validation report: %v", err)
+	}
+	logger.Info("Validation report written", "file", path)
+
+	return nil
+}
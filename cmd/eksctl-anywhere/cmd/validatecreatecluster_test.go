@@ -0,0 +1,42 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/validations"
+)
+
+func TestWriteCreateValidationReport(t *testing.T) {
+	g := NewWithT(t)
+	path := filepath.Join(t.TempDir(), "report.json")
+	cluster := &v1alpha1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "mgmt"},
+		Spec: v1alpha1.ClusterSpec{
+			DatacenterRef: v1alpha1.Ref{Kind: v1alpha1.VSphereDatacenterKind},
+		},
+	}
+	report := &validations.Report{
+		Validations: []validations.ReportResult{
+			{Name: "validate vsphere Provider", Error: "template not found", Remediation: "import the template"},
+		},
+	}
+
+	g.Expect(writeCreateValidationReport(path, cluster, report)).To(Succeed())
+
+	content, err := os.ReadFile(path)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(string(content)).To(MatchJSON(`{
+		"cluster": "mgmt",
+		"provider": "VSphereDatacenterConfig",
+		"passed": false,
+		"validations": [
+			{"name": "validate vsphere Provider", "passed": false, "error": "template not found", "remediation": "import the template"}
+		]
+	}`))
+}
@@ -0,0 +1,143 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/clients/kubernetes"
+	"github.com/aws/eks-anywhere/pkg/controller/clientutil"
+	"github.com/aws/eks-anywhere/pkg/kubeconfig"
+	"github.com/aws/eks-anywhere/pkg/validations"
+	"github.com/aws/eks-anywhere/pkg/version"
+)
+
+// versionSkewTarget is the cluster a command operates on, checked by the CLI version skew validation.
+type versionSkewTarget struct {
+	// clusterName is the name of the cluster, empty when the command operates on the management
+	// cluster the kubeconfig points to.
+	clusterName string
+	// kubeconfig is the kubeconfig file the command uses to reach the management cluster.
+	kubeconfig string
+}
+
+// versionSkewTargetFunc returns the cluster a command operates on from its flags and args.
+type versionSkewTargetFunc func(cmd *cobra.Command, args []string) (*versionSkewTarget, error)
+
+// withCLIVersionSkewValidation makes cmd check, after its own PreRunE, that the CLI supports the eksaVersion
+// of the management cluster and of the cluster the command operates on. It adds the --skip-validations
+// flag to the command to bypass the check.
+func withCLIVersionSkewValidation(cmd *cobra.Command, target versionSkewTargetFunc) {
+	var skipValidations []string
+	cmd.Flags().StringArrayVar(&skipValidations, "skip-validations", []string{}, fmt.Sprintf("Bypass validations by name. Valid arguments you can pass are --skip-validations=%s", validations.CLIVersionSkew))
+
+	preRun := cmd.PreRunE
+	cmd.PreRunE = func(cmd *cobra.Command, args []string) error {
+		if preRun != nil {
+			if err := preRun(cmd, args); err != nil {
+				return err
+			}
+		}
+
+		skipped, err := validations.ValidateSkippableValidation(skipValidations, []string{validations.CLIVersionSkew})
+		if err != nil {
+			return err
+		}
+		if skipped[validations.CLIVersionSkew] {
+			return nil
+		}
+
+		t, err := target(cmd, args)
+		if err != nil {
+			return err
+		}
+
+		return validateCLIVersionSkew(cmd, t)
+	}
+}
+
+func validateCLIVersionSkew(cmd *cobra.Command, t *versionSkewTarget) error {
+	// Commands report a missing kubeconfig in their own terms, and some don't need one at all.
+	if t.kubeconfig == "" || kubeconfig.ValidateFilename(t.kubeconfig) != nil {
+		return nil
+	}
+
+	c, err := kubernetes.NewRuntimeClientFromFileName(t.kubeconfig)
+	if err != nil {
+		return err
+	}
+
+	return validations.ValidateCLIVersionSkewFromClient(cmd.Context(), clientutil.NewKubeClient(c), version.Get().GitVersion, t.clusterName)
+}
+
+// clusterArgTarget is the target of commands that take the cluster name as their first arg and default
+// their kubeconfig to the one of the cluster.
+func clusterArgTarget(cmd *cobra.Command, args []string) (*versionSkewTarget, error) {
+	var name string
+	if len(args) > 0 {
+		name = args[0]
+	}
+
+	return &versionSkewTarget{
+		clusterName: name,
+		kubeconfig:  kubeconfig.ResolveFilename(stringFlag(cmd, "kubeconfig"), name),
+	}, nil
+}
+
+// clusterFlagTarget is the target of commands that take the cluster name with the --cluster flag and
+// default their kubeconfig to the one of the cluster.
+func clusterFlagTarget(cmd *cobra.Command, _ []string) (*versionSkewTarget, error) {
+	name := stringFlag(cmd, "cluster")
+	return &versionSkewTarget{
+		clusterName: name,
+		kubeconfig:  kubeconfig.ResolveFilename(stringFlag(cmd, "kubeconfig"), name),
+	}, nil
+}
+
+// packagesTarget is the target of the curated packages commands, which take the cluster name with the
+// --cluster flag, when it applies, and default their kubeconfig to KUBECONFIG.
+func packagesTarget(cmd *cobra.Command, _ []string) (*versionSkewTarget, error) {
+	return &versionSkewTarget{
+		clusterName: stringFlag(cmd, "cluster"),
+		kubeconfig:  kubeconfig.ResolveFilename(stringFlag(cmd, "kubeconfig", "kubeConfig"), ""),
+	}, nil
+}
+
+// clusterConfigTarget is the target of commands that read the cluster from the config file given with
+// --filename, and default their kubeconfig to the one of its management cluster.
+func clusterConfigTarget(cmd *cobra.Command, _ []string) (*versionSkewTarget, error) {
+	fileName := stringFlag(cmd, "filename")
+	if fileName == "" {
+		return &versionSkewTarget{}, nil
+	}
+
+	cluster, err := v1alpha1.GetClusterConfig(fileName)
+	if err != nil {
+		return nil, fmt.Errorf("reading cluster config to validate the CLI version skew: %v", err)
+	}
+
+	return &versionSkewTarget{
+		clusterName: cluster.Name,
+		kubeconfig:  kubeconfig.ResolveFilename(stringFlag(cmd, "kubeconfig"), cluster.ManagedBy()),
+	}, nil
+}
+
+// clusterArgOrConfigTarget is the target of commands that take either the cluster name as their first
+// arg or the cluster config file.
+func clusterArgOrConfigTarget(cmd *cobra.Command, args []string) (*versionSkewTarget, error) {
+	if len(args) > 0 {
+		return clusterArgTarget(cmd, args)
+	}
+	return clusterConfigTarget(cmd, args)
+}
+
+// stringFlag returns the value of the first of the flags defined for cmd.
+func stringFlag(cmd *cobra.Command, names ...string) string {
+	for _, name := range names {
+		if f := cmd.Flags().Lookup(name); f != nil {
+			return f.Value.String()
+		}
+	}
+	return ""
+}
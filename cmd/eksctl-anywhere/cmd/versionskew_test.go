@@ -0,0 +1,140 @@
+package cmd
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/spf13/cobra"
+)
+
+func versionSkewTestCmd(preRun func(*cobra.Command, []string) error) *cobra.Command {
+	cmd := &cobra.Command{Use: "test", PreRunE: preRun}
+	cmd.Flags().String("kubeconfig", "", "")
+	cmd.Flags().String("cluster", "", "")
+	cmd.Flags().StringP("filename", "f", "", "")
+	return cmd
+}
+
+func TestWithCLIVersionSkewValidationRunsPreRun(t *testing.T) {
+	g := NewWithT(t)
+	cmd := versionSkewTestCmd(func(*cobra.Command, []string) error { return errors.New("pre run failed") })
+	withCLIVersionSkewValidation(cmd, clusterArgTarget)
+
+	g.Expect(cmd.PreRunE(cmd, []string{"w01"})).To(MatchError("pre run failed"))
+}
+
+func TestWithCLIVersionSkewValidationInvalidSkippedValidation(t *testing.T) {
+	g := NewWithT(t)
+	cmd := versionSkewTestCmd(nil)
+	withCLIVersionSkewValidation(cmd, clusterArgTarget)
+	g.Expect(cmd.Flags().Set("skip-validations", "pod-disruption")).To(Succeed())
+
+	g.Expect(cmd.PreRunE(cmd, []string{"w01"})).To(MatchError(ContainSubstring("invalid validation name to be skipped")))
+}
+
+func TestWithCLIVersionSkewValidationSkipped(t *testing.T) {
+	g := NewWithT(t)
+	cmd := versionSkewTestCmd(nil)
+	withCLIVersionSkewValidation(cmd, func(*cobra.Command, []string) (*versionSkewTarget, error) {
+		return nil, errors.New("target should not be read")
+	})
+	g.Expect(cmd.Flags().Set("skip-validations", "cli-version-skew")).To(Succeed())
+
+	g.Expect(cmd.PreRunE(cmd, nil)).To(Succeed())
+}
+
+func TestWithCLIVersionSkewValidationNoKubeconfig(t *testing.T) {
+	g := NewWithT(t)
+	t.Chdir(t.TempDir())
+	cmd := versionSkewTestCmd(nil)
+	withCLIVersionSkewValidation(cmd, clusterArgTarget)
+
+	g.Expect(cmd.PreRunE(cmd, []string{"w01"})).To(Succeed())
+}
+
+func TestVersionSkewTargets(t *testing.T) {
+	t.Setenv("KUBECONFIG", "env.kubeconfig")
+	tests := []struct {
+		name   string
+		target versionSkewTargetFunc
+		flags  map[string]string
+		args   []string
+		want   versionSkewTarget
+	}{
+		{
+			name:   "cluster arg",
+			target: clusterArgTarget,
+			args:   []string{"w01"},
+			want:   versionSkewTarget{clusterName: "w01", kubeconfig: "w01/w01-eks-a-cluster.kubeconfig"},
+		},
+		{
+			name:   "cluster arg with kubeconfig",
+			target: clusterArgTarget,
+			flags:  map[string]string{"kubeconfig": "mgmt.kubeconfig"},
+			args:   []string{"w01"},
+			want:   versionSkewTarget{clusterName: "w01", kubeconfig: "mgmt.kubeconfig"},
+		},
+		{
+			name:   "cluster flag",
+			target: clusterFlagTarget,
+			flags:  map[string]string{"cluster": "w01"},
+			want:   versionSkewTarget{clusterName: "w01", kubeconfig: "w01/w01-eks-a-cluster.kubeconfig"},
+		},
+		{
+			name:   "packages",
+			target: packagesTarget,
+			flags:  map[string]string{"cluster": "w01"},
+			want:   versionSkewTarget{clusterName: "w01", kubeconfig: "env.kubeconfig"},
+		},
+		{
+			name:   "no config file",
+			target: clusterArgOrConfigTarget,
+			want:   versionSkewTarget{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			cmd := versionSkewTestCmd(nil)
+			for name, value := range tt.flags {
+				g.Expect(cmd.Flags().Set(name, value)).To(Succeed())
+			}
+
+			got, err := tt.target(cmd, tt.args)
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(*got).To(Equal(tt.want))
+		})
+	}
+}
+
+func TestClusterConfigTarget(t *testing.T) {
+	g := NewWithT(t)
+	config := filepath.Join(t.TempDir(), "w01.yaml")
+	g.Expect(os.WriteFile(config, []byte(`apiVersion: anywhere.eks.amazonaws.com/v1alpha1
+kind: Cluster
+metadata:
+  name: w01
+spec:
+  managementCluster:
+    name: mgmt
+`), 0o600)).To(Succeed())
+	cmd := versionSkewTestCmd(nil)
+	g.Expect(cmd.Flags().Set("filename", config)).To(Succeed())
+
+	got, err := clusterArgOrConfigTarget(cmd, nil)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(*got).To(Equal(versionSkewTarget{clusterName: "w01", kubeconfig: "mgmt/mgmt-eks-a-cluster.kubeconfig"}))
+}
+
+func TestClusterConfigTargetInvalidFile(t *testing.T) {
+	g := NewWithT(t)
+	cmd := versionSkewTestCmd(nil)
+	g.Expect(cmd.Flags().Set("filename", filepath.Join(t.TempDir(), "missing.yaml"))).To(Succeed())
+
+	_, err := clusterConfigTarget(cmd, nil)
+	g.Expect(err).To(MatchError(ContainSubstring("reading cluster config to validate the CLI version skew")))
+}
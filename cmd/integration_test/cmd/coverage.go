@@ -0,0 +1,71 @@
+package cmd
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+
+	"github.com/aws/eks-anywhere/internal/test/e2e"
+	"github.com/aws/eks-anywhere/pkg/logger"
+)
+
+const (
+	outputFolderFlagName = "output-folder"
+	failOnGapsFlagName   = "fail-on-gaps"
+)
+
+var coverageE2ECmd = &cobra.Command{
+	Use:          "coverage",
+	Short:        "Report E2E feature coverage",
+	Long:         "Tag the end to end tests with feature labels and report the required feature combinations no test covers",
+	SilenceUsage: true,
+	PreRun:       preRunCoverageSetup,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		err := reportE2ECoverage()
+		if err != nil {
+			logger.Fatal(err, "Failed to report e2e feature coverage")
+		}
+		return nil
+	},
+}
+
+func preRunCoverageSetup(cmd *cobra.Command, args []string) {
+	cmd.Flags().VisitAll(func(flag *pflag.Flag) {
+		err := viper.BindPFlag(flag.Name, flag)
+		if err != nil {
+			log.Fatalf("Error initializing flags: %v", err)
+		}
+	})
+}
+
+func init() {
+	integrationTestCmd.AddCommand(coverageE2ECmd)
+	coverageE2ECmd.Flags().StringP(regexFlagName, "r", "", "Only report the coverage of the tests matching the regular expression. Equivalent to go test -run")
+	coverageE2ECmd.Flags().StringSlice(skipFlagName, nil, "List of tests to skip")
+	coverageE2ECmd.Flags().StringP(outputFolderFlagName, "o", ".", "Folder destination for the feature coverage matrix")
+	coverageE2ECmd.Flags().Bool(failOnGapsFlagName, false, "Fail if a required feature combination is not covered by any test")
+}
+
+func reportE2ECoverage() error {
+	gaps, err := e2e.WriteFeatureCoverage(
+		viper.GetString(regexFlagName),
+		viper.GetStringSlice(skipFlagName),
+		viper.GetString(outputFolderFlagName),
+	)
+	if err != nil {
+		return fmt.Errorf("reporting e2e feature coverage: %v", err)
+	}
+
+	for _, gap := range gaps {
+		logger.Info("Feature combination not covered by any e2e test", "labels", gap)
+	}
+
+	if len(gaps) > 0 && viper.GetBool(failOnGapsFlagName) {
+		return fmt.Errorf("%d required feature combinations are not covered by any e2e test", len(gaps))
+	}
+
+	return nil
+}
@@ -31,6 +31,8 @@ import (
 	"github.com/aws/eks-anywhere/pkg/controller/handlers"
 	"github.com/aws/eks-anywhere/pkg/controller/serverside"
 	"github.com/aws/eks-anywhere/pkg/curatedpackages"
+	"github.com/aws/eks-anywhere/pkg/gpu"
+	"github.com/aws/eks-anywhere/pkg/nodeprovisioner"
 	"github.com/aws/eks-anywhere/pkg/providers/vsphere"
 	"github.com/aws/eks-anywhere/pkg/registrymirror"
 	"github.com/aws/eks-anywhere/pkg/utils/ptr"
@@ -54,6 +56,15 @@ type ClusterReconciler struct {
 	packagesClient             PackagesClient
 	machineHealthCheck         MachineHealthCheckReconciler
 	vSpherefailureDomainMover  FailureDomainApplier
+	sshUsers                   SSHUsersReconciler
+	externalDNS                ExternalDNSReconciler
+	spire                      SpireReconciler
+	defaultStorageClass        DefaultStorageClassReconciler
+	serviceLoadBalancer        ServiceLoadBalancerReconciler
+	coreDNS                    CoreDNSReconciler
+	gpu                        GPUReconciler
+	providerCredentials        ProviderCredentialsReconciler
+	nodeProvisioner            NodeProvisionerReconciler
 }
 
 // PackagesClient handles curated packages operations from within the cluster
@@ -81,6 +92,53 @@ type MachineHealthCheckReconciler interface {
 	Reconcile(ctx context.Context, logger logr.Logger, cluster *anywherev1.Cluster) error
 }
 
+// SSHUsersReconciler updates the ssh users of existing nodes in place for an eks-a cluster.
+type SSHUsersReconciler interface {
+	Reconcile(ctx context.Context, logger logr.Logger, cluster *anywherev1.Cluster) (controller.Result, error)
+}
+
+// ExternalDNSReconciler manages the external-dns installation of an eks-a cluster.
+type ExternalDNSReconciler interface {
+	Reconcile(ctx context.Context, logger logr.Logger, cluster *anywherev1.Cluster) (controller.Result, error)
+}
+
+// SpireReconciler manages the SPIRE server and agents installation of an eks-a cluster.
+type SpireReconciler interface {
+	Reconcile(ctx context.Context, logger logr.Logger, cluster *anywherev1.Cluster) (controller.Result, error)
+}
+
+// DefaultStorageClassReconciler manages the default storage class of an eks-a cluster.
+type DefaultStorageClassReconciler interface {
+	Reconcile(ctx context.Context, logger logr.Logger, cluster *anywherev1.Cluster) (controller.Result, error)
+}
+
+// ServiceLoadBalancerReconciler manages the load balancer for Services of type LoadBalancer of an eks-a cluster.
+type ServiceLoadBalancerReconciler interface {
+	Reconcile(ctx context.Context, logger logr.Logger, cluster *anywherev1.Cluster) (controller.Result, error)
+}
+
+// CoreDNSReconciler manages the CoreDNS configuration of an eks-a cluster.
+type CoreDNSReconciler interface {
+	Reconcile(ctx context.Context, logger logr.Logger, cluster *anywherev1.Cluster) (controller.Result, error)
+}
+
+// GPUReconciler manages the NVIDIA GPU support of the worker node groups of an eks-a cluster.
+type GPUReconciler interface {
+	Reconcile(ctx context.Context, logger logr.Logger, cluster *anywherev1.Cluster) (controller.Result, error)
+}
+
+// ProviderCredentialsReconciler validates the provider credentials of an eks-a cluster and reports
+// the result in its status.
+type ProviderCredentialsReconciler interface {
+	Reconcile(ctx context.Context, logger logr.Logger, cluster *anywherev1.Cluster) (controller.Result, error)
+}
+
+// NodeProvisionerReconciler scales the worker node groups of an eks-a cluster configured with the
+// on-demand provisioner.
+type NodeProvisionerReconciler interface {
+	Reconcile(ctx context.Context, logger logr.Logger, cluster *anywherev1.Cluster) (controller.Result, error)
+}
+
 // ClusterValidator runs cluster level preflight validations before it goes to provider reconciler.
 type ClusterValidator interface {
 	ValidateManagementClusterName(ctx context.Context, log logr.Logger, cluster *anywherev1.Cluster) error
@@ -89,6 +147,78 @@ type ClusterValidator interface {
 // ClusterReconcilerOption allows to configure the ClusterReconciler.
 type ClusterReconcilerOption func(*ClusterReconciler)
 
+// WithSSHUsersReconciler configures the ClusterReconciler to patch the ssh users of existing nodes
+// when they change in the machine configs, instead of leaving them until the machines are replaced.
+func WithSSHUsersReconciler(sshUsers SSHUsersReconciler) ClusterReconcilerOption {
+	return func(r *ClusterReconciler) {
+		r.sshUsers = sshUsers
+	}
+}
+
+// WithExternalDNSReconciler configures the ClusterReconciler to install external-dns on the
+// clusters with an external-dns configuration.
+func WithExternalDNSReconciler(externalDNS ExternalDNSReconciler) ClusterReconcilerOption {
+	return func(r *ClusterReconciler) {
+		r.externalDNS = externalDNS
+	}
+}
+
+// WithSpireReconciler configures the ClusterReconciler to install SPIRE on the
+// clusters with a spire configuration.
+func WithSpireReconciler(spire SpireReconciler) ClusterReconcilerOption {
+	return func(r *ClusterReconciler) {
+		r.spire = spire
+	}
+}
+
+// WithDefaultStorageClassReconciler configures the ClusterReconciler to create the default storage
+// class of the clusters with a default storage class configuration.
+func WithDefaultStorageClassReconciler(defaultStorageClass DefaultStorageClassReconciler) ClusterReconcilerOption {
+	return func(r *ClusterReconciler) {
+		r.defaultStorageClass = defaultStorageClass
+	}
+}
+
+// WithServiceLoadBalancerReconciler configures the ClusterReconciler to install kube-vip in services
+// mode on the clusters with a service load balancer configuration.
+func WithServiceLoadBalancerReconciler(serviceLoadBalancer ServiceLoadBalancerReconciler) ClusterReconcilerOption {
+	return func(r *ClusterReconciler) {
+		r.serviceLoadBalancer = serviceLoadBalancer
+	}
+}
+
+// WithCoreDNSReconciler configures the ClusterReconciler to keep the CoreDNS Corefile and replicas
+// of the clusters with a DNS configuration in sync with it.
+func WithCoreDNSReconciler(coreDNS CoreDNSReconciler) ClusterReconcilerOption {
+	return func(r *ClusterReconciler) {
+		r.coreDNS = coreDNS
+	}
+}
+
+// WithGPUReconciler configures the ClusterReconciler to install the nvidia runtime class and the
+// NVIDIA device plugin on the clusters with worker node groups with GPU support.
+func WithGPUReconciler(gpu GPUReconciler) ClusterReconcilerOption {
+	return func(r *ClusterReconciler) {
+		r.gpu = gpu
+	}
+}
+
+// WithProviderCredentialsReconciler configures the ClusterReconciler to periodically validate the
+// provider credentials of the clusters, even when they don't need to be reconciled.
+func WithProviderCredentialsReconciler(providerCredentials ProviderCredentialsReconciler) ClusterReconcilerOption {
+	return func(r *ClusterReconciler) {
+		r.providerCredentials = providerCredentials
+	}
+}
+
+// WithNodeProvisionerReconciler configures the ClusterReconciler to periodically scale the worker
+// node groups configured with the on-demand provisioner, even when the clusters don't need to be reconciled.
+func WithNodeProvisionerReconciler(nodeProvisioner NodeProvisionerReconciler) ClusterReconcilerOption {
+	return func(r *ClusterReconciler) {
+		r.nodeProvisioner = nodeProvisioner
+	}
+}
+
 // SpecBuilder builds a cluster specification from an EKS Anywhere Cluster object.
 type SpecBuilder interface {
 	BuildSpec(ctx context.Context, eksaCluster *anywherev1.Cluster) (*c.Spec, error)
@@ -260,12 +390,14 @@ func (r *ClusterReconciler) SetupWithManager(mgr ctrl.Manager, log logr.Logger)
 // +kubebuilder:rbac:groups="",resources=namespaces,verbs=get;list;create;delete;patch;update
 // +kubebuilder:rbac:groups="",resources=nodes,verbs=list
 // +kubebuilder:rbac:groups=addons.cluster.x-k8s.io,resources=clusterresourcesets,verbs=get;list;watch;create;update;patch;delete
-// +kubebuilder:rbac:groups=anywhere.eks.amazonaws.com,resources=clusters;gitopsconfigs;snowmachineconfigs;snowdatacenterconfigs;snowippools;vspheredatacenterconfigs;vspheremachineconfigs;dockerdatacenterconfigs;tinkerbellmachineconfigs;tinkerbelltemplateconfigs;tinkerbelldatacenterconfigs;cloudstackdatacenterconfigs;cloudstackmachineconfigs;nutanixdatacenterconfigs;nutanixmachineconfigs;oidcconfigs;fluxconfigs,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=anywhere.eks.amazonaws.com,resources=clusters;gitopsconfigs;snowmachineconfigs;snowdatacenterconfigs;snowippools;vspheredatacenterconfigs;vspheremachineconfigs;dockerdatacenterconfigs;tinkerbellmachineconfigs;tinkerbelltemplateconfigs;tinkerbelldatacenterconfigs;cloudstackdatacenterconfigs;cloudstackmachineconfigs;nutanixdatacenterconfigs;nutanixmachineconfigs;proxmoxdatacenterconfigs;proxmoxmachineconfigs;openstackdatacenterconfigs;openstackmachineconfigs;azurestackhcidatacenterconfigs;azurestackhcimachineconfigs;harvesterdatacenterconfigs;harvestermachineconfigs;oidcconfigs;fluxconfigs,verbs=get;list;watch;update;patch
 // +kubebuilder:rbac:groups=anywhere.eks.amazonaws.com,resources=awsiamconfigs,verbs=get;list;watch;update;patch;delete
 // +kubebuilder:rbac:groups=anywhere.eks.amazonaws.com,resources=clusters/status;snowmachineconfigs/status;snowippools/status;vspheredatacenterconfigs/status;vspheremachineconfigs/status;dockerdatacenterconfigs/status;tinkerbelldatacenterconfigs/status;tinkerbellmachineconfigs/status;tinkerbelltemplateconfigs/status;cloudstackdatacenterconfigs/status;cloudstackmachineconfigs/status;awsiamconfigs/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=anywhere.eks.amazonaws.com,resources=bundles,verbs=get;list;watch
 // +kubebuilder:rbac:groups=anywhere.eks.amazonaws.com,resources=clusters/finalizers;snowmachineconfigs/finalizers;snowippools/finalizers;vspheredatacenterconfigs/finalizers;vspheremachineconfigs/finalizers;cloudstackdatacenterconfigs/finalizers;cloudstackmachineconfigs/finalizers;dockerdatacenterconfigs/finalizers;bundles/finalizers;awsiamconfigs/finalizers;tinkerbelldatacenterconfigs/finalizers;tinkerbellmachineconfigs/finalizers;tinkerbelltemplateconfigs/finalizers,verbs=update
 // +kubebuilder:rbac:groups=bootstrap.cluster.x-k8s.io,resources=kubeadmconfigtemplates,verbs=create;get;list;patch;update;watch
+// +kubebuilder:rbac:groups=bootstrap.cluster.x-k8s.io,resources=kubeadmconfigs,verbs=get;list;watch
+// +kubebuilder:rbac:groups="cluster.x-k8s.io",resources=machines,verbs=list;watch;get
 // +kubebuilder:rbac:groups="cluster.x-k8s.io",resources=machinedeployments,verbs=list;watch;get;patch;update;create;delete
 // +kubebuilder:rbac:groups="cluster.x-k8s.io",resources=clusters,verbs=list;watch;get;patch;update;create;delete
 // +kubebuilder:rbac:groups="cluster.x-k8s.io",resources=machinehealthchecks,verbs=list;watch;get;patch;create
@@ -276,10 +408,11 @@ func (r *ClusterReconciler) SetupWithManager(mgr ctrl.Manager, log logr.Logger)
 // +kubebuilder:rbac:groups=etcdcluster.cluster.x-k8s.io,resources=*,verbs=create;get;list;patch;update;watch
 // +kubebuilder:rbac:groups=tinkerbell.org,resources=hardware,verbs=list;watch
 // +kubebuilder:rbac:groups=bmc.tinkerbell.org,resources=machines,verbs=list;watch
-// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=awssnowclusters;awssnowmachinetemplates;awssnowippools;vsphereclusters;vspheremachinetemplates;dockerclusters;dockermachinetemplates;tinkerbellclusters;tinkerbellmachinetemplates;cloudstackclusters;cloudstackmachinetemplates;nutanixclusters;nutanixmachinetemplates;vspherefailuredomains;vspheredeploymentzones,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=awssnowclusters;awssnowmachinetemplates;awssnowippools;vsphereclusters;vspheremachinetemplates;dockerclusters;dockermachinetemplates;tinkerbellclusters;tinkerbellmachinetemplates;cloudstackclusters;cloudstackmachinetemplates;nutanixclusters;nutanixmachinetemplates;proxmoxclusters;proxmoxmachinetemplates;openstackclusters;openstackmachinetemplates;azurestackhciclusters;azurestackhcimachinetemplates;harvesterclusters;harvestermachinetemplates;vspherefailuredomains;vspheredeploymentzones,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=packages.eks.amazonaws.com,resources=packages,verbs=create;delete;get;list;patch;update;watch
 // +kubebuilder:rbac:groups=packages.eks.amazonaws.com,namespace=eksa-system,resources=packagebundlecontrollers,verbs=delete
 // +kubebuilder:rbac:groups=anywhere.eks.amazonaws.com,resources=eksareleases,verbs=get;list;watch
+// +kubebuilder:rbac:groups=anywhere.eks.amazonaws.com,resources=clusterhistories,verbs=get;list;watch;create;update;patch;delete
 // The eksareleases permissions are being moved to the ClusterRole due to client trying to list this resource from cache.
 // When trying to list resources not already in cache, it starts an informer for that type using the scope of the cache.
 // So if the manager is cluster-scoped, the new informers created by the cache will be cluster-scoped
@@ -305,12 +438,24 @@ func (r *ClusterReconciler) Reconcile(ctx context.Context, req ctrl.Request) (re
 		return ctrl.Result{}, err
 	}
 
+	// originalStatus is used to only update the cluster history when the reconciliation changed the cluster.
+	originalStatus := cluster.Status.DeepCopy()
+
+	// credentialsResult holds when the provider credentials need to be validated again.
+	var credentialsResult ctrl.Result
+	// nodeProvisionerResult holds when the on-demand worker node groups need to be scaled again.
+	var nodeProvisionerResult ctrl.Result
+
 	defer func() {
 		err := r.updateStatus(ctx, log, cluster)
 		if err != nil {
 			reterr = kerrors.NewAggregate([]error{reterr, err})
 		}
 
+		if err := clusters.UpdateClusterHistory(ctx, r.client, cluster, originalStatus); err != nil {
+			reterr = kerrors.NewAggregate([]error{reterr, err})
+		}
+
 		// Always attempt to patch the object and status after each reconciliation.
 		patchOpts := []v1beta1patch.Option{}
 
@@ -333,12 +478,27 @@ func (r *ClusterReconciler) Reconcile(ctx context.Context, req ctrl.Request) (re
 		if reterr == nil && !result.Requeue && result.RequeueAfter <= 0 && v1beta1conditions.IsFalse(cluster, anywherev1.ReadyCondition) {
 			result = ctrl.Result{RequeueAfter: 10 * time.Second}
 		}
+
+		// Otherwise, requeue for the next provider credentials validation or node provisioning.
+		if reterr == nil && !result.Requeue && result.RequeueAfter <= 0 {
+			result = soonestRequeue(credentialsResult, nodeProvisionerResult)
+		}
 	}()
 
+	// Deletion is handled even for frozen clusters, so they don't get stuck on the finalizer.
 	if !cluster.DeletionTimestamp.IsZero() {
 		return r.reconcileDelete(ctx, log, cluster)
 	}
 
+	// If the cluster is frozen, return without any further processing until the frozen annotation
+	// is removed. The status is still updated, so it reflects the manual changes.
+	if cluster.IsFrozen() {
+		log.Info("Cluster is frozen, skipping reconciliation", "frozenBy", cluster.FrozenBy(), "reason", cluster.FrozenReason())
+		markFrozen(cluster)
+		return ctrl.Result{}, nil
+	}
+	v1beta1conditions.Delete(cluster, anywherev1.FrozenCondition)
+
 	// If the cluster is paused, return without any further processing.
 	if cluster.IsReconcilePaused() {
 		log.Info("Cluster reconciliation is paused")
@@ -363,6 +523,20 @@ func (r *ClusterReconciler) Reconcile(ctx context.Context, req ctrl.Request) (re
 		return ctrl.Result{}, err
 	}
 
+	// The provider credentials are validated before checking the generations, so they are
+	// validated periodically and not only when the cluster changes.
+	credentialsResult, err = r.reconcileProviderCredentials(ctx, log, cluster)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	// The on-demand worker node groups are scaled before checking the generations too, since
+	// their size depends on the pods of the cluster and not on its spec.
+	nodeProvisionerResult, err = r.reconcileNodeProvisioner(ctx, log, cluster)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
 	aggregatedGeneration := aggregatedGeneration(config)
 
 	// If there is no difference between the aggregated generation and childrenReconciledGeneration,
@@ -389,6 +563,40 @@ func (r *ClusterReconciler) Reconcile(ctx context.Context, req ctrl.Request) (re
 	return r.reconcile(ctx, log, cluster, aggregatedGeneration)
 }
 
+func (r *ClusterReconciler) reconcileProviderCredentials(ctx context.Context, log logr.Logger, cluster *anywherev1.Cluster) (ctrl.Result, error) {
+	if r.providerCredentials == nil {
+		return ctrl.Result{}, nil
+	}
+
+	result, err := r.providerCredentials.Reconcile(ctx, log, cluster)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	return result.ToCtrlResult(), nil
+}
+
+func (r *ClusterReconciler) reconcileNodeProvisioner(ctx context.Context, log logr.Logger, cluster *anywherev1.Cluster) (ctrl.Result, error) {
+	if r.nodeProvisioner == nil || !nodeprovisioner.Enabled(cluster) {
+		return ctrl.Result{}, nil
+	}
+
+	result, err := r.nodeProvisioner.Reconcile(ctx, log, cluster)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	return result.ToCtrlResult(), nil
+}
+
+// soonestRequeue returns the result that requeues first, ignoring the ones that don't requeue.
+func soonestRequeue(a, b ctrl.Result) ctrl.Result {
+	if a.RequeueAfter <= 0 || (b.RequeueAfter > 0 && b.RequeueAfter < a.RequeueAfter) {
+		return b
+	}
+	return a
+}
+
 func (r *ClusterReconciler) reconcile(ctx context.Context, log logr.Logger, cluster *anywherev1.Cluster, aggregatedGeneration int64) (ctrl.Result, error) {
 	clusterProviderReconciler := r.providerReconcilerRegistry.Get(cluster.Spec.DatacenterRef.Kind)
 
@@ -511,6 +719,62 @@ func (r *ClusterReconciler) postClusterProviderReconcile(ctx context.Context, lo
 		return controller.Result{}, err
 	}
 
+	if r.sshUsers != nil {
+		if result, err := r.sshUsers.Reconcile(ctx, log, cluster); err != nil {
+			return controller.Result{}, err
+		} else if result.Return() {
+			return result, nil
+		}
+	}
+
+	if r.externalDNS != nil && cluster.Spec.ExternalDNS != nil {
+		if result, err := r.externalDNS.Reconcile(ctx, log, cluster); err != nil {
+			return controller.Result{}, err
+		} else if result.Return() {
+			return result, nil
+		}
+	}
+
+	if r.spire != nil && cluster.Spec.Spire != nil {
+		if result, err := r.spire.Reconcile(ctx, log, cluster); err != nil {
+			return controller.Result{}, err
+		} else if result.Return() {
+			return result, nil
+		}
+	}
+
+	if r.defaultStorageClass != nil && cluster.Spec.DefaultStorageClass != nil {
+		if result, err := r.defaultStorageClass.Reconcile(ctx, log, cluster); err != nil {
+			return controller.Result{}, err
+		} else if result.Return() {
+			return result, nil
+		}
+	}
+
+	if r.serviceLoadBalancer != nil && cluster.Spec.ServiceLoadBalancer != nil {
+		if result, err := r.serviceLoadBalancer.Reconcile(ctx, log, cluster); err != nil {
+			return controller.Result{}, err
+		} else if result.Return() {
+			return result, nil
+		}
+	}
+
+	if r.coreDNS != nil && cluster.Spec.DNS != nil {
+		if result, err := r.coreDNS.Reconcile(ctx, log, cluster); err != nil {
+			return controller.Result{}, err
+		} else if result.Return() {
+			return result, nil
+		}
+	}
+
+	if r.gpu != nil && gpu.Enabled(cluster) {
+		if result, err := r.gpu.Reconcile(ctx, log, cluster); err != nil {
+			return controller.Result{}, err
+		} else if result.Return() {
+			return result, nil
+		}
+	}
+
 	return controller.Result{}, nil
 }
 
@@ -526,6 +790,20 @@ func (r *ClusterReconciler) packagesReconcile(ctx context.Context, log logr.Logg
 	return controller.Result{}, nil
 }
 
+// markFrozen sets the frozen condition of the cluster with who froze it and why.
+func markFrozen(cluster *anywherev1.Cluster) {
+	frozenBy := cluster.FrozenBy()
+	if frozenBy == "" {
+		frozenBy = "unknown user"
+	}
+	message := fmt.Sprintf("Reconciliation frozen by %s", frozenBy)
+	if reason := cluster.FrozenReason(); reason != "" {
+		message = fmt.Sprintf("%s: %s", message, reason)
+	}
+
+	v1beta1conditions.MarkTrueWithNegativePolarity(cluster, anywherev1.FrozenCondition, anywherev1.FrozenByAnnotationReason, clusterv1.ConditionSeverityWarning, "%s", message)
+}
+
 func (r *ClusterReconciler) updateStatus(ctx context.Context, log logr.Logger, cluster *anywherev1.Cluster) error {
 	// When EKS-A cluster is fully deleted, we do not need to update the status. Without this check
 	// the subsequent patch operations would fail if the status is updated after it is fully deleted.
@@ -245,6 +245,379 @@ func TestClusterReconcilerReconcileSelfManagedCluster(t *testing.T) {
 	g.Expect(result).To(Equal(ctrl.Result{}))
 }
 
+func TestClusterReconcilerReconcileSSHUsersRequeue(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	version := test.DevEksaVersion()
+
+	selfManagedCluster := &anywherev1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "my-management-cluster",
+		},
+		Spec: anywherev1.ClusterSpec{
+			KubernetesVersion: anywherev1.Kube132,
+			EksaVersion:       &version,
+			ClusterNetwork: anywherev1.ClusterNetwork{
+				CNIConfig: &anywherev1.CNIConfig{
+					Cilium: &anywherev1.CiliumConfig{},
+				},
+			},
+		},
+		Status: anywherev1.ClusterStatus{
+			ReconciledGeneration: 1,
+		},
+	}
+
+	kcp := testKubeadmControlPlaneFromCluster(selfManagedCluster)
+
+	mockCtrl := gomock.NewController(t)
+	providerReconciler := mocks.NewMockProviderClusterReconciler(mockCtrl)
+	iam := mocks.NewMockAWSIamConfigReconciler(mockCtrl)
+	mhcReconciler := mocks.NewMockMachineHealthCheckReconciler(mockCtrl)
+	sshUsersReconciler := mocks.NewMockSSHUsersReconciler(mockCtrl)
+
+	clusterValidator := mocks.NewMockClusterValidator(mockCtrl)
+	registry := newRegistryMock(providerReconciler)
+	c := fake.NewClientBuilder().WithRuntimeObjects(selfManagedCluster, kcp, test.EKSARelease(), createBundle(), createEKSDRelease()).
+		WithStatusSubresource(selfManagedCluster).
+		Build()
+	mockPkgs := mocks.NewMockPackagesClient(mockCtrl)
+	providerReconciler.EXPECT().Reconcile(ctx, gomock.AssignableToTypeOf(logr.Logger{}), sameName(selfManagedCluster))
+	mhcReconciler.EXPECT().Reconcile(ctx, gomock.AssignableToTypeOf(logr.Logger{}), sameName(selfManagedCluster)).Return(nil)
+	sshUsersReconciler.EXPECT().Reconcile(ctx, gomock.AssignableToTypeOf(logr.Logger{}), sameName(selfManagedCluster)).
+		Return(controller.ResultWithRequeue(10*time.Second), nil)
+
+	r := controllers.NewClusterReconciler(c, registry, iam, clusterValidator, mockPkgs, mhcReconciler, nil,
+		controllers.WithSSHUsersReconciler(sshUsersReconciler),
+	)
+	result, err := r.Reconcile(ctx, clusterRequest(selfManagedCluster))
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(result).To(Equal(ctrl.Result{RequeueAfter: 10 * time.Second}))
+}
+
+func TestClusterReconcilerReconcileExternalDNS(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	version := test.DevEksaVersion()
+
+	selfManagedCluster := &anywherev1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "my-management-cluster",
+		},
+		Spec: anywherev1.ClusterSpec{
+			KubernetesVersion: anywherev1.Kube132,
+			EksaVersion:       &version,
+			ClusterNetwork: anywherev1.ClusterNetwork{
+				CNIConfig: &anywherev1.CNIConfig{
+					Cilium: &anywherev1.CiliumConfig{},
+				},
+			},
+			ExternalDNS: &anywherev1.ExternalDNSConfiguration{
+				Provider:              anywherev1.Route53DNSProvider,
+				CredentialsSecretName: "route53-credentials",
+			},
+		},
+		Status: anywherev1.ClusterStatus{
+			ReconciledGeneration: 1,
+		},
+	}
+
+	kcp := testKubeadmControlPlaneFromCluster(selfManagedCluster)
+
+	mockCtrl := gomock.NewController(t)
+	providerReconciler := mocks.NewMockProviderClusterReconciler(mockCtrl)
+	iam := mocks.NewMockAWSIamConfigReconciler(mockCtrl)
+	mhcReconciler := mocks.NewMockMachineHealthCheckReconciler(mockCtrl)
+	externalDNSReconciler := mocks.NewMockExternalDNSReconciler(mockCtrl)
+
+	clusterValidator := mocks.NewMockClusterValidator(mockCtrl)
+	registry := newRegistryMock(providerReconciler)
+	c := fake.NewClientBuilder().WithRuntimeObjects(selfManagedCluster, kcp, test.EKSARelease(), createBundle(), createEKSDRelease()).
+		WithStatusSubresource(selfManagedCluster).
+		Build()
+	mockPkgs := mocks.NewMockPackagesClient(mockCtrl)
+	providerReconciler.EXPECT().Reconcile(ctx, gomock.AssignableToTypeOf(logr.Logger{}), sameName(selfManagedCluster))
+	mhcReconciler.EXPECT().Reconcile(ctx, gomock.AssignableToTypeOf(logr.Logger{}), sameName(selfManagedCluster)).Return(nil)
+	externalDNSReconciler.EXPECT().Reconcile(ctx, gomock.AssignableToTypeOf(logr.Logger{}), sameName(selfManagedCluster)).
+		Return(controller.Result{}, errors.New("reading external-dns credentials secret"))
+
+	r := controllers.NewClusterReconciler(c, registry, iam, clusterValidator, mockPkgs, mhcReconciler, nil,
+		controllers.WithExternalDNSReconciler(externalDNSReconciler),
+	)
+	_, err := r.Reconcile(ctx, clusterRequest(selfManagedCluster))
+	g.Expect(err).To(MatchError(ContainSubstring("reading external-dns credentials secret")))
+}
+
+func TestClusterReconcilerReconcileSpire(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	version := test.DevEksaVersion()
+
+	selfManagedCluster := &anywherev1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "my-management-cluster",
+		},
+		Spec: anywherev1.ClusterSpec{
+			KubernetesVersion: anywherev1.Kube132,
+			EksaVersion:       &version,
+			ClusterNetwork: anywherev1.ClusterNetwork{
+				CNIConfig: &anywherev1.CNIConfig{
+					Cilium: &anywherev1.CiliumConfig{},
+				},
+			},
+			Spire: &anywherev1.SpireConfiguration{
+				TrustDomain:        "example.org",
+				NodeAttestor:       anywherev1.X509PopSpireNodeAttestor,
+				CABundleSecretName: "spire-node-ca",
+			},
+		},
+		Status: anywherev1.ClusterStatus{
+			ReconciledGeneration: 1,
+		},
+	}
+
+	kcp := testKubeadmControlPlaneFromCluster(selfManagedCluster)
+
+	mockCtrl := gomock.NewController(t)
+	providerReconciler := mocks.NewMockProviderClusterReconciler(mockCtrl)
+	iam := mocks.NewMockAWSIamConfigReconciler(mockCtrl)
+	mhcReconciler := mocks.NewMockMachineHealthCheckReconciler(mockCtrl)
+	spireReconciler := mocks.NewMockSpireReconciler(mockCtrl)
+
+	clusterValidator := mocks.NewMockClusterValidator(mockCtrl)
+	registry := newRegistryMock(providerReconciler)
+	c := fake.NewClientBuilder().WithRuntimeObjects(selfManagedCluster, kcp, test.EKSARelease(), createBundle(), createEKSDRelease()).
+		WithStatusSubresource(selfManagedCluster).
+		Build()
+	mockPkgs := mocks.NewMockPackagesClient(mockCtrl)
+	providerReconciler.EXPECT().Reconcile(ctx, gomock.AssignableToTypeOf(logr.Logger{}), sameName(selfManagedCluster))
+	mhcReconciler.EXPECT().Reconcile(ctx, gomock.AssignableToTypeOf(logr.Logger{}), sameName(selfManagedCluster)).Return(nil)
+	spireReconciler.EXPECT().Reconcile(ctx, gomock.AssignableToTypeOf(logr.Logger{}), sameName(selfManagedCluster)).
+		Return(controller.Result{}, errors.New("reading spire CA bundle secret"))
+
+	r := controllers.NewClusterReconciler(c, registry, iam, clusterValidator, mockPkgs, mhcReconciler, nil,
+		controllers.WithSpireReconciler(spireReconciler),
+	)
+	_, err := r.Reconcile(ctx, clusterRequest(selfManagedCluster))
+	g.Expect(err).To(MatchError(ContainSubstring("reading spire CA bundle secret")))
+}
+
+func TestClusterReconcilerReconcileDefaultStorageClass(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	version := test.DevEksaVersion()
+
+	selfManagedCluster := &anywherev1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "my-management-cluster",
+		},
+		Spec: anywherev1.ClusterSpec{
+			KubernetesVersion: anywherev1.Kube132,
+			EksaVersion:       &version,
+			ClusterNetwork: anywherev1.ClusterNetwork{
+				CNIConfig: &anywherev1.CNIConfig{
+					Cilium: &anywherev1.CiliumConfig{},
+				},
+			},
+			DefaultStorageClass: &anywherev1.DefaultStorageClass{
+				Provisioner: "csi.vsphere.vmware.com",
+			},
+		},
+		Status: anywherev1.ClusterStatus{
+			ReconciledGeneration: 1,
+		},
+	}
+
+	kcp := testKubeadmControlPlaneFromCluster(selfManagedCluster)
+
+	mockCtrl := gomock.NewController(t)
+	providerReconciler := mocks.NewMockProviderClusterReconciler(mockCtrl)
+	iam := mocks.NewMockAWSIamConfigReconciler(mockCtrl)
+	mhcReconciler := mocks.NewMockMachineHealthCheckReconciler(mockCtrl)
+	storageClassReconciler := mocks.NewMockDefaultStorageClassReconciler(mockCtrl)
+
+	clusterValidator := mocks.NewMockClusterValidator(mockCtrl)
+	registry := newRegistryMock(providerReconciler)
+	c := fake.NewClientBuilder().WithRuntimeObjects(selfManagedCluster, kcp, test.EKSARelease(), createBundle(), createEKSDRelease()).
+		WithStatusSubresource(selfManagedCluster).
+		Build()
+	mockPkgs := mocks.NewMockPackagesClient(mockCtrl)
+	providerReconciler.EXPECT().Reconcile(ctx, gomock.AssignableToTypeOf(logr.Logger{}), sameName(selfManagedCluster))
+	mhcReconciler.EXPECT().Reconcile(ctx, gomock.AssignableToTypeOf(logr.Logger{}), sameName(selfManagedCluster)).Return(nil)
+	storageClassReconciler.EXPECT().Reconcile(ctx, gomock.AssignableToTypeOf(logr.Logger{}), sameName(selfManagedCluster)).
+		Return(controller.Result{}, errors.New("reading CSI driver csi.vsphere.vmware.com"))
+
+	r := controllers.NewClusterReconciler(c, registry, iam, clusterValidator, mockPkgs, mhcReconciler, nil,
+		controllers.WithDefaultStorageClassReconciler(storageClassReconciler),
+	)
+	_, err := r.Reconcile(ctx, clusterRequest(selfManagedCluster))
+	g.Expect(err).To(MatchError(ContainSubstring("reading CSI driver csi.vsphere.vmware.com")))
+}
+
+func TestClusterReconcilerReconcileServiceLoadBalancer(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	version := test.DevEksaVersion()
+
+	selfManagedCluster := &anywherev1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "my-management-cluster",
+		},
+		Spec: anywherev1.ClusterSpec{
+			KubernetesVersion: anywherev1.Kube132,
+			EksaVersion:       &version,
+			ClusterNetwork: anywherev1.ClusterNetwork{
+				CNIConfig: &anywherev1.CNIConfig{
+					Cilium: &anywherev1.CiliumConfig{},
+				},
+			},
+			ServiceLoadBalancer: &anywherev1.ServiceLoadBalancerConfiguration{
+				KubeVip: &anywherev1.KubeVipServiceLoadBalancer{
+					AddressPool: []string{"10.0.0.64/28"},
+				},
+			},
+		},
+		Status: anywherev1.ClusterStatus{
+			ReconciledGeneration: 1,
+		},
+	}
+
+	kcp := testKubeadmControlPlaneFromCluster(selfManagedCluster)
+
+	mockCtrl := gomock.NewController(t)
+	providerReconciler := mocks.NewMockProviderClusterReconciler(mockCtrl)
+	iam := mocks.NewMockAWSIamConfigReconciler(mockCtrl)
+	mhcReconciler := mocks.NewMockMachineHealthCheckReconciler(mockCtrl)
+	serviceLoadBalancerReconciler := mocks.NewMockServiceLoadBalancerReconciler(mockCtrl)
+
+	clusterValidator := mocks.NewMockClusterValidator(mockCtrl)
+	registry := newRegistryMock(providerReconciler)
+	c := fake.NewClientBuilder().WithRuntimeObjects(selfManagedCluster, kcp, test.EKSARelease(), createBundle(), createEKSDRelease()).
+		WithStatusSubresource(selfManagedCluster).
+		Build()
+	mockPkgs := mocks.NewMockPackagesClient(mockCtrl)
+	providerReconciler.EXPECT().Reconcile(ctx, gomock.AssignableToTypeOf(logr.Logger{}), sameName(selfManagedCluster))
+	mhcReconciler.EXPECT().Reconcile(ctx, gomock.AssignableToTypeOf(logr.Logger{}), sameName(selfManagedCluster)).Return(nil)
+	serviceLoadBalancerReconciler.EXPECT().Reconcile(ctx, gomock.AssignableToTypeOf(logr.Logger{}), sameName(selfManagedCluster)).
+		Return(controller.Result{}, errors.New("applying service load balancer manifest"))
+
+	r := controllers.NewClusterReconciler(c, registry, iam, clusterValidator, mockPkgs, mhcReconciler, nil,
+		controllers.WithServiceLoadBalancerReconciler(serviceLoadBalancerReconciler),
+	)
+	_, err := r.Reconcile(ctx, clusterRequest(selfManagedCluster))
+	g.Expect(err).To(MatchError(ContainSubstring("applying service load balancer manifest")))
+}
+
+func TestClusterReconcilerReconcileCoreDNS(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	version := test.DevEksaVersion()
+
+	selfManagedCluster := &anywherev1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "my-management-cluster",
+		},
+		Spec: anywherev1.ClusterSpec{
+			KubernetesVersion: anywherev1.Kube132,
+			EksaVersion:       &version,
+			ClusterNetwork: anywherev1.ClusterNetwork{
+				CNIConfig: &anywherev1.CNIConfig{
+					Cilium: &anywherev1.CiliumConfig{},
+				},
+			},
+			DNS: &anywherev1.CoreDNSConfiguration{
+				StubDomains: []anywherev1.DNSStubDomain{
+					{Domain: "corp.example.com", Nameservers: []string{"10.0.0.53"}},
+				},
+			},
+		},
+		Status: anywherev1.ClusterStatus{
+			ReconciledGeneration: 1,
+		},
+	}
+
+	kcp := testKubeadmControlPlaneFromCluster(selfManagedCluster)
+
+	mockCtrl := gomock.NewController(t)
+	providerReconciler := mocks.NewMockProviderClusterReconciler(mockCtrl)
+	iam := mocks.NewMockAWSIamConfigReconciler(mockCtrl)
+	mhcReconciler := mocks.NewMockMachineHealthCheckReconciler(mockCtrl)
+	coreDNSReconciler := mocks.NewMockCoreDNSReconciler(mockCtrl)
+
+	clusterValidator := mocks.NewMockClusterValidator(mockCtrl)
+	registry := newRegistryMock(providerReconciler)
+	c := fake.NewClientBuilder().WithRuntimeObjects(selfManagedCluster, kcp, test.EKSARelease(), createBundle(), createEKSDRelease()).
+		WithStatusSubresource(selfManagedCluster).
+		Build()
+	mockPkgs := mocks.NewMockPackagesClient(mockCtrl)
+	providerReconciler.EXPECT().Reconcile(ctx, gomock.AssignableToTypeOf(logr.Logger{}), sameName(selfManagedCluster))
+	mhcReconciler.EXPECT().Reconcile(ctx, gomock.AssignableToTypeOf(logr.Logger{}), sameName(selfManagedCluster)).Return(nil)
+	coreDNSReconciler.EXPECT().Reconcile(ctx, gomock.AssignableToTypeOf(logr.Logger{}), sameName(selfManagedCluster)).
+		Return(controller.Result{}, errors.New("patching coredns configmap"))
+
+	r := controllers.NewClusterReconciler(c, registry, iam, clusterValidator, mockPkgs, mhcReconciler, nil,
+		controllers.WithCoreDNSReconciler(coreDNSReconciler),
+	)
+	_, err := r.Reconcile(ctx, clusterRequest(selfManagedCluster))
+	g.Expect(err).To(MatchError(ContainSubstring("patching coredns configmap")))
+}
+
+func TestClusterReconcilerReconcileGPU(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	version := test.DevEksaVersion()
+
+	selfManagedCluster := &anywherev1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "my-management-cluster",
+		},
+		Spec: anywherev1.ClusterSpec{
+			KubernetesVersion: anywherev1.Kube132,
+			EksaVersion:       &version,
+			ClusterNetwork: anywherev1.ClusterNetwork{
+				CNIConfig: &anywherev1.CNIConfig{
+					Cilium: &anywherev1.CiliumConfig{},
+				},
+			},
+			WorkerNodeGroupConfigurations: []anywherev1.WorkerNodeGroupConfiguration{
+				{
+					Name:  "md-gpu",
+					Count: ptr.Int(1),
+					GPU:   &anywherev1.GPUConfiguration{},
+				},
+			},
+		},
+		Status: anywherev1.ClusterStatus{
+			ReconciledGeneration: 1,
+		},
+	}
+
+	kcp := testKubeadmControlPlaneFromCluster(selfManagedCluster)
+
+	mockCtrl := gomock.NewController(t)
+	providerReconciler := mocks.NewMockProviderClusterReconciler(mockCtrl)
+	iam := mocks.NewMockAWSIamConfigReconciler(mockCtrl)
+	mhcReconciler := mocks.NewMockMachineHealthCheckReconciler(mockCtrl)
+	gpuReconciler := mocks.NewMockGPUReconciler(mockCtrl)
+
+	clusterValidator := mocks.NewMockClusterValidator(mockCtrl)
+	registry := newRegistryMock(providerReconciler)
+	c := fake.NewClientBuilder().WithRuntimeObjects(selfManagedCluster, kcp, test.EKSARelease(), createBundle(), createEKSDRelease()).
+		WithStatusSubresource(selfManagedCluster).
+		Build()
+	mockPkgs := mocks.NewMockPackagesClient(mockCtrl)
+	providerReconciler.EXPECT().Reconcile(ctx, gomock.AssignableToTypeOf(logr.Logger{}), sameName(selfManagedCluster))
+	mhcReconciler.EXPECT().Reconcile(ctx, gomock.AssignableToTypeOf(logr.Logger{}), sameName(selfManagedCluster)).Return(nil)
+	gpuReconciler.EXPECT().Reconcile(ctx, gomock.AssignableToTypeOf(logr.Logger{}), sameName(selfManagedCluster)).
+		Return(controller.Result{}, errors.New("applying gpu manifest"))
+
+	r := controllers.NewClusterReconciler(c, registry, iam, clusterValidator, mockPkgs, mhcReconciler, nil,
+		controllers.WithGPUReconciler(gpuReconciler),
+	)
+	_, err := r.Reconcile(ctx, clusterRequest(selfManagedCluster))
+	g.Expect(err).To(MatchError(ContainSubstring("applying gpu manifest")))
+}
+
 func TestClusterReconcilerReconcileUnclearedClusterFailure(t *testing.T) {
 	config, bundles := baseTestVsphereCluster()
 	version := test.DevEksaVersion()
@@ -1037,6 +1410,110 @@ func TestClusterReconcilerReconcileGenerations(t *testing.T) {
 	}
 }
 
+func TestClusterReconcilerReconcileProviderCredentialsWithoutChanges(t *testing.T) {
+	config, bundles := baseTestVsphereCluster()
+	version := test.DevEksaVersion()
+	config.Cluster.Spec.EksaVersion = &version
+	config.Cluster.Generation = 2
+	config.Cluster.Status.ObservedGeneration = 2
+	config.Cluster.Status.ReconciledGeneration = 2
+	config.Cluster.Status.ChildrenReconciledGeneration = 12
+	config.VSphereDatacenter.Generation = 1
+	config.VSphereMachineConfigs[config.Cluster.Spec.ControlPlaneConfiguration.MachineGroupRef.Name].Generation = 2
+	config.VSphereMachineConfigs[config.Cluster.Spec.WorkerNodeGroupConfigurations[0].MachineGroupRef.Name].Generation = 5
+	for _, oidc := range config.OIDCConfigs {
+		oidc.Generation = 3
+	}
+	for _, awsIAM := range config.AWSIAMConfigs {
+		awsIAM.Generation = 1
+	}
+
+	kcp := testKubeadmControlPlaneFromCluster(config.Cluster)
+	machineDeployments := machineDeploymentsFromCluster(config.Cluster)
+
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	objs := make([]runtime.Object, 0, 7+len(machineDeployments))
+	objs = append(objs, config.Cluster, bundles, test.EKSARelease())
+	for _, o := range config.ChildObjects() {
+		objs = append(objs, o)
+	}
+	objs = append(objs, kcp)
+	for _, obj := range machineDeployments {
+		objs = append(objs, obj.DeepCopy())
+	}
+
+	client := fake.NewClientBuilder().WithRuntimeObjects(objs...).
+		WithStatusSubresource(config.Cluster).
+		Build()
+	mockCtrl := gomock.NewController(t)
+	providerReconciler := mocks.NewMockProviderClusterReconciler(mockCtrl)
+	iam := mocks.NewMockAWSIamConfigReconciler(mockCtrl)
+	clusterValidator := mocks.NewMockClusterValidator(mockCtrl)
+	registry := newRegistryMock(providerReconciler)
+	mockPkgs := mocks.NewMockPackagesClient(mockCtrl)
+	mhcReconciler := mocks.NewMockMachineHealthCheckReconciler(mockCtrl)
+	providerCredentialsReconciler := mocks.NewMockProviderCredentialsReconciler(mockCtrl)
+
+	providerReconciler.EXPECT().Reconcile(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+	providerCredentialsReconciler.EXPECT().Reconcile(ctx, gomock.AssignableToTypeOf(logr.Logger{}), sameName(config.Cluster)).
+		Return(controller.ResultWithRequeue(time.Hour), nil)
+
+	r := controllers.NewClusterReconciler(client, registry, iam, clusterValidator, mockPkgs, mhcReconciler, nil,
+		controllers.WithProviderCredentialsReconciler(providerCredentialsReconciler),
+	)
+
+	result, err := r.Reconcile(ctx, clusterRequest(config.Cluster))
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(result).To(Equal(ctrl.Result{RequeueAfter: time.Hour}))
+}
+
+func TestClusterReconcilerReconcileProviderCredentialsError(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	version := test.DevEksaVersion()
+
+	selfManagedCluster := &anywherev1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "my-management-cluster",
+		},
+		Spec: anywherev1.ClusterSpec{
+			KubernetesVersion: anywherev1.Kube132,
+			EksaVersion:       &version,
+			ClusterNetwork: anywherev1.ClusterNetwork{
+				CNIConfig: &anywherev1.CNIConfig{
+					Cilium: &anywherev1.CiliumConfig{},
+				},
+			},
+		},
+		Status: anywherev1.ClusterStatus{
+			ReconciledGeneration: 1,
+		},
+	}
+
+	mockCtrl := gomock.NewController(t)
+	providerReconciler := mocks.NewMockProviderClusterReconciler(mockCtrl)
+	iam := mocks.NewMockAWSIamConfigReconciler(mockCtrl)
+	mhcReconciler := mocks.NewMockMachineHealthCheckReconciler(mockCtrl)
+	providerCredentialsReconciler := mocks.NewMockProviderCredentialsReconciler(mockCtrl)
+
+	clusterValidator := mocks.NewMockClusterValidator(mockCtrl)
+	registry := newRegistryMock(providerReconciler)
+	c := fake.NewClientBuilder().WithRuntimeObjects(selfManagedCluster, test.EKSARelease(), createBundle(), createEKSDRelease()).
+		WithStatusSubresource(selfManagedCluster).
+		Build()
+	mockPkgs := mocks.NewMockPackagesClient(mockCtrl)
+	providerCredentialsReconciler.EXPECT().Reconcile(ctx, gomock.AssignableToTypeOf(logr.Logger{}), sameName(selfManagedCluster)).
+		Return(controller.Result{}, errors.New("reading provider credentials"))
+
+	r := controllers.NewClusterReconciler(c, registry, iam, clusterValidator, mockPkgs, mhcReconciler, nil,
+		controllers.WithProviderCredentialsReconciler(providerCredentialsReconciler),
+	)
+	_, err := r.Reconcile(ctx, clusterRequest(selfManagedCluster))
+	g.Expect(err).To(MatchError(ContainSubstring("reading provider credentials")))
+}
+
 func TestClusterReconcilerReconcilePausedCluster(t *testing.T) {
 	g := NewWithT(t)
 	ctx := context.Background()
@@ -1080,6 +1557,46 @@ func TestClusterReconcilerReconcilePausedCluster(t *testing.T) {
 	})
 }
 
+func TestClusterReconcilerReconcileFrozenCluster(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	managementCluster := vsphereCluster()
+	managementCluster.Name = "management-cluster"
+	cluster := vsphereCluster()
+	cluster.SetManagedBy(managementCluster.Name)
+	cluster.Annotations[anywherev1.FrozenAnnotation] = "replacing a failed disk"
+	cluster.Annotations[anywherev1.FrozenByAnnotation] = "jane@example.com"
+	capiCluster := newCAPICluster(cluster.Name, cluster.Namespace)
+	kcp := testKubeadmControlPlaneFromCluster(cluster)
+
+	c := fake.NewClientBuilder().WithRuntimeObjects(managementCluster, cluster, capiCluster, kcp).
+		WithStatusSubresource(cluster).
+		Build()
+
+	ctrl := gomock.NewController(t)
+	providerReconciler := mocks.NewMockProviderClusterReconciler(ctrl)
+	iam := mocks.NewMockAWSIamConfigReconciler(ctrl)
+	clusterValidator := mocks.NewMockClusterValidator(ctrl)
+	mhcReconciler := mocks.NewMockMachineHealthCheckReconciler(ctrl)
+
+	registry := newRegistryMock(providerReconciler)
+	r := controllers.NewClusterReconciler(c, registry, iam, clusterValidator, nil, mhcReconciler, nil)
+	_, err := r.Reconcile(ctx, clusterRequest(cluster))
+	g.Expect(err).NotTo(HaveOccurred())
+
+	api := envtest.NewAPIExpecter(t, c)
+	cl := envtest.CloneNameNamespace(cluster)
+	api.ShouldEventuallyMatch(ctx, cl, func(g Gomega) {
+		g.Expect(
+			controllerutil.ContainsFinalizer(cl, controllers.ClusterFinalizerName),
+		).To(BeFalse(), "Cluster should not have the finalizer added")
+		frozen := v1beta1conditions.Get(cl, anywherev1.FrozenCondition)
+		g.Expect(frozen).NotTo(BeNil())
+		g.Expect(frozen.Reason).To(Equal(anywherev1.FrozenByAnnotationReason))
+		g.Expect(frozen.Message).To(Equal("Reconciliation frozen by jane@example.com: replacing a failed disk"))
+	})
+}
+
 func TestClusterReconcilerReconcileDeletedSelfManagedCluster(t *testing.T) {
 	g := NewWithT(t)
 	ctx := context.Background()
@@ -1202,6 +1719,45 @@ func TestClusterReconcilerDeleteExistingCAPIClusterSuccess(t *testing.T) {
 	}
 }
 
+func TestClusterReconcilerDeleteFrozenCluster(t *testing.T) {
+	secret := createSecret()
+	managementCluster := vsphereCluster()
+	managementCluster.Name = "management-cluster"
+	cluster := vsphereCluster()
+	cluster.Spec.ManagementCluster = anywherev1.ManagementCluster{Name: "management-cluster"}
+	cluster.Annotations = map[string]string{anywherev1.FrozenAnnotation: "replacing a failed disk"}
+	now := metav1.Now()
+	cluster.DeletionTimestamp = &now
+	cluster.Finalizers = []string{"my-finalizer"}
+
+	datacenterConfig := vsphereDataCenter(cluster)
+	bundle := createBundle()
+	machineConfigCP := vsphereCPMachineConfig()
+	machineConfigWN := vsphereWorkerMachineConfig()
+
+	capiCluster := newCAPICluster(cluster.Name, cluster.Namespace)
+
+	objs := []runtime.Object{cluster, datacenterConfig, secret, bundle, machineConfigCP, machineConfigWN, managementCluster, capiCluster}
+
+	tt := newVsphereClusterReconcilerTest(t, objs...)
+
+	req := clusterRequest(cluster)
+
+	ctx := context.Background()
+
+	_, err := tt.reconciler.Reconcile(ctx, req)
+	if err != nil {
+		t.Fatalf("reconcile: (%v)", err)
+	}
+
+	apiCluster := &clusterv1beta2.Cluster{}
+
+	err = tt.client.Get(context.TODO(), req.NamespacedName, apiCluster)
+	if !apierrors.IsNotFound(err) {
+		t.Fatalf("expected apierrors.IsNotFound but got: (%v)", err)
+	}
+}
+
 func TestClusterReconcilerReconcileDeletePausedCluster(t *testing.T) {
 	g := NewWithT(t)
 	ctx := context.Background()
@@ -2,6 +2,7 @@ package controllers
 
 import (
 	"context"
+	"time"
 
 	"github.com/go-logr/logr"
 	"github.com/google/uuid"
@@ -18,51 +19,82 @@ import (
 	mhcreconciler "github.com/aws/eks-anywhere/pkg/clusterapi/machinehealthcheck/reconciler"
 	"github.com/aws/eks-anywhere/pkg/constants"
 	"github.com/aws/eks-anywhere/pkg/controller/clusters"
+	"github.com/aws/eks-anywhere/pkg/coredns"
 	"github.com/aws/eks-anywhere/pkg/crypto"
 	"github.com/aws/eks-anywhere/pkg/curatedpackages"
 	"github.com/aws/eks-anywhere/pkg/dependencies"
 	"github.com/aws/eks-anywhere/pkg/executables"
 	"github.com/aws/eks-anywhere/pkg/executables/cmk"
+	"github.com/aws/eks-anywhere/pkg/externaldns"
+	"github.com/aws/eks-anywhere/pkg/govmomi"
+	"github.com/aws/eks-anywhere/pkg/gpu"
 	"github.com/aws/eks-anywhere/pkg/helm"
+	"github.com/aws/eks-anywhere/pkg/networking/calico"
+	calicoreconciler "github.com/aws/eks-anywhere/pkg/networking/calico/reconciler"
 	"github.com/aws/eks-anywhere/pkg/networking/cilium"
 	ciliumreconciler "github.com/aws/eks-anywhere/pkg/networking/cilium/reconciler"
 	cnireconciler "github.com/aws/eks-anywhere/pkg/networking/reconciler"
+	"github.com/aws/eks-anywhere/pkg/nodeprovisioner"
+	azurestackhcireconciler "github.com/aws/eks-anywhere/pkg/providers/azurestackhci/reconciler"
 	"github.com/aws/eks-anywhere/pkg/providers/cloudstack"
 	cloudstackreconciler "github.com/aws/eks-anywhere/pkg/providers/cloudstack/reconciler"
+	"github.com/aws/eks-anywhere/pkg/providers/credentials"
 	dockerreconciler "github.com/aws/eks-anywhere/pkg/providers/docker/reconciler"
+	harvesterreconciler "github.com/aws/eks-anywhere/pkg/providers/harvester/reconciler"
+	"github.com/aws/eks-anywhere/pkg/providers/nutanix"
 	nutanixreconciler "github.com/aws/eks-anywhere/pkg/providers/nutanix/reconciler"
+	openstackreconciler "github.com/aws/eks-anywhere/pkg/providers/openstack/reconciler"
+	proxmoxreconciler "github.com/aws/eks-anywhere/pkg/providers/proxmox/reconciler"
 	"github.com/aws/eks-anywhere/pkg/providers/snow"
 	snowreconciler "github.com/aws/eks-anywhere/pkg/providers/snow/reconciler"
 	tinkerbellreconciler "github.com/aws/eks-anywhere/pkg/providers/tinkerbell/reconciler"
+	"github.com/aws/eks-anywhere/pkg/providers/vsphere"
 	vspherereconciler "github.com/aws/eks-anywhere/pkg/providers/vsphere/reconciler"
+	"github.com/aws/eks-anywhere/pkg/serviceloadbalancer"
+	"github.com/aws/eks-anywhere/pkg/spire"
+	"github.com/aws/eks-anywhere/pkg/sshusers"
+	"github.com/aws/eks-anywhere/pkg/storageclass"
 )
 
 type Manager = manager.Manager
 
 type Factory struct {
-	buildSteps                   []buildStep
-	dependencyFactory            *dependencies.Factory
-	manager                      Manager
-	registryBuilder              *clusters.ProviderClusterReconcilerRegistryBuilder
-	reconcilers                  Reconcilers
-	tracker                      clustercache.ClusterCache
-	registry                     *clusters.ProviderClusterReconcilerRegistry
-	dockerClusterReconciler      *dockerreconciler.Reconciler
-	vsphereClusterReconciler     *vspherereconciler.Reconciler
-	tinkerbellClusterReconciler  *tinkerbellreconciler.Reconciler
-	snowClusterReconciler        *snowreconciler.Reconciler
-	cloudstackClusterReconciler  *cloudstackreconciler.Reconciler
-	nutanixClusterReconciler     *nutanixreconciler.Reconciler
-	cniReconciler                *cnireconciler.Reconciler
-	ipValidator                  *clusters.IPValidator
-	awsIamConfigReconciler       *awsiamconfigreconciler.Reconciler
-	machineHealthCheckReconciler *mhcreconciler.Reconciler
-	logger                       logr.Logger
-	deps                         *dependencies.Dependencies
-	packageControllerClient      *curatedpackages.PackageControllerClient
-	cloudStackValidatorRegistry  cloudstack.ValidatorRegistry
-	ciliumTemplater              *cilium.Templater
-	helmClientFactory            cilium.HelmClientFactory
+	buildSteps                     []buildStep
+	dependencyFactory              *dependencies.Factory
+	manager                        Manager
+	registryBuilder                *clusters.ProviderClusterReconcilerRegistryBuilder
+	reconcilers                    Reconcilers
+	tracker                        clustercache.ClusterCache
+	registry                       *clusters.ProviderClusterReconcilerRegistry
+	dockerClusterReconciler        *dockerreconciler.Reconciler
+	vsphereClusterReconciler       *vspherereconciler.Reconciler
+	tinkerbellClusterReconciler    *tinkerbellreconciler.Reconciler
+	snowClusterReconciler          *snowreconciler.Reconciler
+	cloudstackClusterReconciler    *cloudstackreconciler.Reconciler
+	nutanixClusterReconciler       *nutanixreconciler.Reconciler
+	proxmoxClusterReconciler       *proxmoxreconciler.Reconciler
+	openstackClusterReconciler     *openstackreconciler.Reconciler
+	azurestackhciClusterReconciler *azurestackhcireconciler.Reconciler
+	harvesterClusterReconciler     *harvesterreconciler.Reconciler
+	cniReconciler                  *cnireconciler.Reconciler
+	ipValidator                    *clusters.IPValidator
+	awsIamConfigReconciler         *awsiamconfigreconciler.Reconciler
+	machineHealthCheckReconciler   *mhcreconciler.Reconciler
+	sshUsersReconciler             *sshusers.Reconciler
+	externalDNSReconciler          *externaldns.Reconciler
+	spireReconciler                *spire.Reconciler
+	defaultStorageClassReconciler  *storageclass.Reconciler
+	serviceLoadBalancerReconciler  *serviceloadbalancer.Reconciler
+	coreDNSReconciler              *coredns.Reconciler
+	gpuReconciler                  *gpu.Reconciler
+	providerCredentialsReconciler  *credentials.Reconciler
+	nodeProvisionerReconciler      *nodeprovisioner.Reconciler
+	logger                         logr.Logger
+	deps                           *dependencies.Dependencies
+	packageControllerClient        *curatedpackages.PackageControllerClient
+	cloudStackValidatorRegistry    cloudstack.ValidatorRegistry
+	ciliumTemplater                *cilium.Templater
+	helmClientFactory              cilium.HelmClientFactory
 }
 
 type Reconcilers struct {
@@ -122,7 +154,16 @@ func (f *Factory) WithClusterReconciler(capiProviders []clusterctlv1.Provider, o
 		WithProviderClusterReconcilerRegistry(capiProviders).
 		withAWSIamConfigReconciler().
 		withPackageControllerClient().
-		withMachineHealthCheckReconciler()
+		withMachineHealthCheckReconciler().
+		withSSHUsersReconciler().
+		withExternalDNSReconciler().
+		withSpireReconciler().
+		withDefaultStorageClassReconciler().
+		withServiceLoadBalancerReconciler().
+		withCoreDNSReconciler().
+		withGPUReconciler().
+		withProviderCredentialsReconciler().
+		withNodeProvisionerReconciler()
 
 	f.buildSteps = append(f.buildSteps, func(ctx context.Context) error {
 		if f.reconcilers.ClusterReconciler != nil {
@@ -137,7 +178,17 @@ func (f *Factory) WithClusterReconciler(capiProviders []clusterctlv1.Provider, o
 			f.packageControllerClient,
 			f.machineHealthCheckReconciler,
 			NewFailureDomainMover(f.manager.GetClient()),
-			opts...,
+			append([]ClusterReconcilerOption{
+				WithSSHUsersReconciler(f.sshUsersReconciler),
+				WithExternalDNSReconciler(f.externalDNSReconciler),
+				WithSpireReconciler(f.spireReconciler),
+				WithDefaultStorageClassReconciler(f.defaultStorageClassReconciler),
+				WithServiceLoadBalancerReconciler(f.serviceLoadBalancerReconciler),
+				WithCoreDNSReconciler(f.coreDNSReconciler),
+				WithGPUReconciler(f.gpuReconciler),
+				WithProviderCredentialsReconciler(f.providerCredentialsReconciler),
+				WithNodeProvisionerReconciler(f.nodeProvisionerReconciler),
+			}, opts...)...,
 		)
 
 		return nil
@@ -274,6 +325,94 @@ func (f *Factory) withNutanixClusterReconciler() *Factory {
 	return f
 }
 
+// withProxmoxClusterReconciler adds the ProxmoxClusterReconciler to the controller factory.
+func (f *Factory) withProxmoxClusterReconciler() *Factory {
+	f.withTracker().withCNIReconciler(f.getProviderNamespace(constants.ProxmoxProviderName)).withIPValidator()
+	f.buildSteps = append(f.buildSteps, func(ctx context.Context) error {
+		if f.proxmoxClusterReconciler != nil {
+			return nil
+		}
+
+		f.proxmoxClusterReconciler = proxmoxreconciler.New(
+			f.manager.GetClient(),
+			f.cniReconciler,
+			f.tracker,
+			f.ipValidator,
+		)
+		f.registryBuilder.Add(anywherev1.ProxmoxDatacenterKind, f.proxmoxClusterReconciler)
+
+		return nil
+	})
+
+	return f
+}
+
+// withOpenStackClusterReconciler adds the OpenStackClusterReconciler to the controller factory.
+func (f *Factory) withOpenStackClusterReconciler() *Factory {
+	f.withTracker().withCNIReconciler(f.getProviderNamespace(constants.OpenStackProviderName)).withIPValidator()
+	f.buildSteps = append(f.buildSteps, func(ctx context.Context) error {
+		if f.openstackClusterReconciler != nil {
+			return nil
+		}
+
+		f.openstackClusterReconciler = openstackreconciler.New(
+			f.manager.GetClient(),
+			f.cniReconciler,
+			f.tracker,
+			f.ipValidator,
+		)
+		f.registryBuilder.Add(anywherev1.OpenStackDatacenterKind, f.openstackClusterReconciler)
+
+		return nil
+	})
+
+	return f
+}
+
+// withAzureStackHCIClusterReconciler adds the AzureStackHCIClusterReconciler to the controller factory.
+func (f *Factory) withAzureStackHCIClusterReconciler() *Factory {
+	f.withTracker().withCNIReconciler(f.getProviderNamespace(constants.AzureStackHCIProviderName)).withIPValidator()
+	f.buildSteps = append(f.buildSteps, func(ctx context.Context) error {
+		if f.azurestackhciClusterReconciler != nil {
+			return nil
+		}
+
+		f.azurestackhciClusterReconciler = azurestackhcireconciler.New(
+			f.manager.GetClient(),
+			f.cniReconciler,
+			f.tracker,
+			f.ipValidator,
+		)
+		f.registryBuilder.Add(anywherev1.AzureStackHCIDatacenterKind, f.azurestackhciClusterReconciler)
+
+		return nil
+	})
+
+	return f
+}
+
+// withHarvesterClusterReconciler adds the HarvesterClusterReconciler to the controller factory.
+func (f *Factory) withHarvesterClusterReconciler() *Factory {
+	f.withTracker().withCNIReconciler(f.getProviderNamespace(constants.HarvesterProviderName)).withIPValidator()
+	f.buildSteps = append(f.buildSteps, func(ctx context.Context) error {
+		if f.harvesterClusterReconciler != nil {
+			return nil
+		}
+
+		f.harvesterClusterReconciler = harvesterreconciler.New(
+			f.manager.GetClient(),
+			f.cniReconciler,
+			f.tracker,
+			f.ipValidator,
+		)
+		f.registryBuilder.Add(anywherev1.HarvesterDatacenterKind, f.harvesterClusterReconciler)
+
+		return nil
+	})
+
+	return f
+}
+
 func (f *Factory) withTracker() *Factory {
 	f.buildSteps = append(f.buildSteps, func(ctx context.Context) error {
 		if f.tracker != nil {
@@ -315,12 +454,16 @@ func (f *Factory) withTracker() *Factory {
 }
 
 const (
-	dockerProviderName     = "docker"
-	snowProviderName       = "snow"
-	vSphereProviderName    = "vsphere"
-	tinkerbellProviderName = "tinkerbell"
-	cloudstackProviderName = "cloudstack"
-	nutanixProviderName    = "nutanix"
+	dockerProviderName        = "docker"
+	snowProviderName          = "snow"
+	vSphereProviderName       = "vsphere"
+	tinkerbellProviderName    = "tinkerbell"
+	cloudstackProviderName    = "cloudstack"
+	nutanixProviderName       = "nutanix"
+	proxmoxProviderName       = "proxmox"
+	openstackProviderName     = "openstack"
+	azurestackhciProviderName = "azurestackhci"
+	harvesterProviderName     = "harvester"
 )
 
 func (f *Factory) WithProviderClusterReconcilerRegistry(capiProviders []clusterctlv1.Provider) *Factory {
@@ -344,6 +487,14 @@ func (f *Factory) WithProviderClusterReconcilerRegistry(capiProviders []clusterc
 			f.withCloudStackClusterReconciler()
 		case nutanixProviderName:
 			f.withNutanixClusterReconciler()
+		case proxmoxProviderName:
+			f.withProxmoxClusterReconciler()
+		case openstackProviderName:
+			f.withOpenStackClusterReconciler()
+		case azurestackhciProviderName:
+			f.withAzureStackHCIClusterReconciler()
+		case harvesterProviderName:
+			f.withHarvesterClusterReconciler()
 		default:
 			f.logger.Info("Found unknown CAPI provider, ignoring", "providerName", p.ProviderName)
 		}
@@ -524,13 +675,17 @@ func (f *Factory) withCiliumTemplater() *Factory {
 
 func (f *Factory) withCNIReconciler(providerNamespace string) *Factory {
 	f.withCiliumTemplater()
+	f.dependencyFactory.WithFileReader()
 
 	f.buildSteps = append(f.buildSteps, func(ctx context.Context) error {
 		if f.cniReconciler != nil {
 			return nil
 		}
 
-		f.cniReconciler = cnireconciler.New(ciliumreconciler.New(f.ciliumTemplater, []string{providerNamespace}))
+		f.cniReconciler = cnireconciler.New(
+			ciliumreconciler.New(f.ciliumTemplater, []string{providerNamespace}),
+			calicoreconciler.New(calico.NewTemplater(f.deps.FileReader)),
+		)
 
 		return nil
 	})
@@ -611,6 +766,178 @@ func (f *Factory) withMachineHealthCheckReconciler() *Factory {
 	return f
 }
 
+func (f *Factory) withSSHUsersReconciler() *Factory {
+	f.withTracker()
+
+	f.buildSteps = append(f.buildSteps, func(ctx context.Context) error {
+		if f.sshUsersReconciler != nil {
+			return nil
+		}
+
+		f.sshUsersReconciler = sshusers.New(
+			f.manager.GetClient(),
+			f.tracker,
+		)
+
+		return nil
+	})
+
+	return f
+}
+
+func (f *Factory) withExternalDNSReconciler() *Factory {
+	f.withTracker()
+
+	f.buildSteps = append(f.buildSteps, func(ctx context.Context) error {
+		if f.externalDNSReconciler != nil {
+			return nil
+		}
+
+		f.externalDNSReconciler = externaldns.New(
+			f.manager.GetClient(),
+			f.tracker,
+		)
+
+		return nil
+	})
+
+	return f
+}
+
+func (f *Factory) withSpireReconciler() *Factory {
+	f.withTracker()
+
+	f.buildSteps = append(f.buildSteps, func(ctx context.Context) error {
+		if f.spireReconciler != nil {
+			return nil
+		}
+
+		f.spireReconciler = spire.New(
+			f.manager.GetClient(),
+			f.tracker,
+		)
+
+		return nil
+	})
+
+	return f
+}
+
+func (f *Factory) withDefaultStorageClassReconciler() *Factory {
+	f.withTracker()
+
+	f.buildSteps = append(f.buildSteps, func(ctx context.Context) error {
+		if f.defaultStorageClassReconciler != nil {
+			return nil
+		}
+
+		f.defaultStorageClassReconciler = storageclass.New(
+			f.manager.GetClient(),
+			f.tracker,
+		)
+
+		return nil
+	})
+
+	return f
+}
+
+func (f *Factory) withServiceLoadBalancerReconciler() *Factory {
+	f.withTracker()
+
+	f.buildSteps = append(f.buildSteps, func(ctx context.Context) error {
+		if f.serviceLoadBalancerReconciler != nil {
+			return nil
+		}
+
+		f.serviceLoadBalancerReconciler = serviceloadbalancer.New(
+			f.manager.GetClient(),
+			f.tracker,
+		)
+
+		return nil
+	})
+
+	return f
+}
+
+func (f *Factory) withCoreDNSReconciler() *Factory {
+	f.withTracker()
+
+	f.buildSteps = append(f.buildSteps, func(ctx context.Context) error {
+		if f.coreDNSReconciler != nil {
+			return nil
+		}
+
+		f.coreDNSReconciler = coredns.New(
+			f.manager.GetClient(),
+			f.tracker,
+		)
+
+		return nil
+	})
+
+	return f
+}
+
+func (f *Factory) withGPUReconciler() *Factory {
+	f.withTracker()
+
+	f.buildSteps = append(f.buildSteps, func(ctx context.Context) error {
+		if f.gpuReconciler != nil {
+			return nil
+		}
+
+		f.gpuReconciler = gpu.New(
+			f.manager.GetClient(),
+			f.tracker,
+		)
+
+		return nil
+	})
+
+	return f
+}
+
+func (f *Factory) withNodeProvisionerReconciler() *Factory {
+	f.withTracker()
+
+	f.buildSteps = append(f.buildSteps, func(ctx context.Context) error {
+		if f.nodeProvisionerReconciler != nil {
+			return nil
+		}
+
+		f.nodeProvisionerReconciler = nodeprovisioner.New(
+			f.manager.GetClient(),
+			f.tracker,
+		)
+
+		return nil
+	})
+
+	return f
+}
+
+func (f *Factory) withProviderCredentialsReconciler() *Factory {
+	f.buildSteps = append(f.buildSteps, func(ctx context.Context) error {
+		if f.providerCredentialsReconciler != nil {
+			return nil
+		}
+
+		f.providerCredentialsReconciler = credentials.New(
+			time.Now,
+			map[string]credentials.Validator{
+				anywherev1.VSphereDatacenterKind: vsphere.NewCredentialsValidator(f.manager.GetClient(), govmomi.NewVMOMIClientBuilder()),
+				anywherev1.NutanixDatacenterKind: nutanix.NewCredentialsValidator(f.manager.GetClient()),
+			},
+		)
+
+		return nil
+	})
+
+	return f
+}
+
 // WithKubeadmControlPlaneReconciler builds the KubeadmControlPlane reconciler.
 func (f *Factory) WithKubeadmControlPlaneReconciler() *Factory {
 	f.buildSteps = append(f.buildSteps, func(ctx context.Context) error {
@@ -715,6 +1042,14 @@ func (f *Factory) getProviderNamespace(providerName string) string {
 		providerNamespace = constants.CapcSystemNamespace
 	case nutanixProviderName:
 		providerNamespace = constants.CapxSystemNamespace
+	case proxmoxProviderName:
+		providerNamespace = constants.CapmoxSystemNamespace
+	case openstackProviderName:
+		providerNamespace = constants.CapoSystemNamespace
+	case azurestackhciProviderName:
+		providerNamespace = constants.CaphSystemNamespace
+	case harvesterProviderName:
+		providerNamespace = constants.CaphvSystemNamespace
 	case dockerProviderName:
 		providerNamespace = constants.CapdSystemNamespace
 	default:
@@ -246,6 +246,348 @@ func (mr *MockMachineHealthCheckReconcilerMockRecorder) Reconcile(ctx, logger, c
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reconcile", reflect.TypeOf((*MockMachineHealthCheckReconciler)(nil).Reconcile), ctx, logger, cluster)
 }
 
+// MockSSHUsersReconciler is a mock of SSHUsersReconciler interface.
+type MockSSHUsersReconciler struct {
+	ctrl     *gomock.Controller
+	recorder *MockSSHUsersReconcilerMockRecorder
+}
+
+// MockSSHUsersReconcilerMockRecorder is the mock recorder for MockSSHUsersReconciler.
+type MockSSHUsersReconcilerMockRecorder struct {
+	mock *MockSSHUsersReconciler
+}
+
+// NewMockSSHUsersReconciler creates a new mock instance.
+func NewMockSSHUsersReconciler(ctrl *gomock.Controller) *MockSSHUsersReconciler {
+	mock := &MockSSHUsersReconciler{ctrl: ctrl}
+	mock.recorder = &MockSSHUsersReconcilerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSSHUsersReconciler) EXPECT() *MockSSHUsersReconcilerMockRecorder {
+	return m.recorder
+}
+
+// Reconcile mocks base method.
+func (m *MockSSHUsersReconciler) Reconcile(ctx context.Context, logger logr.Logger, cluster *v1alpha1.Cluster) (controller.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reconcile", ctx, logger, cluster)
+	ret0, _ := ret[0].(controller.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Reconcile indicates an expected call of Reconcile.
+func (mr *MockSSHUsersReconcilerMockRecorder) Reconcile(ctx, logger, cluster interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reconcile", reflect.TypeOf((*MockSSHUsersReconciler)(nil).Reconcile), ctx, logger, cluster)
+}
+
+// MockExternalDNSReconciler is a mock of ExternalDNSReconciler interface.
+type MockExternalDNSReconciler struct {
+	ctrl     *gomock.Controller
+	recorder *MockExternalDNSReconcilerMockRecorder
+}
+
+// MockExternalDNSReconcilerMockRecorder is the mock recorder for MockExternalDNSReconciler.
+type MockExternalDNSReconcilerMockRecorder struct {
+	mock *MockExternalDNSReconciler
+}
+
+// NewMockExternalDNSReconciler creates a new mock instance.
+func NewMockExternalDNSReconciler(ctrl *gomock.Controller) *MockExternalDNSReconciler {
+	mock := &MockExternalDNSReconciler{ctrl: ctrl}
+	mock.recorder = &MockExternalDNSReconcilerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockExternalDNSReconciler) EXPECT() *MockExternalDNSReconcilerMockRecorder {
+	return m.recorder
+}
+
+// Reconcile mocks base method.
+func (m *MockExternalDNSReconciler) Reconcile(ctx context.Context, logger logr.Logger, cluster *v1alpha1.Cluster) (controller.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reconcile", ctx, logger, cluster)
+	ret0, _ := ret[0].(controller.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Reconcile indicates an expected call of Reconcile.
+func (mr *MockExternalDNSReconcilerMockRecorder) Reconcile(ctx, logger, cluster interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reconcile", reflect.TypeOf((*MockExternalDNSReconciler)(nil).Reconcile), ctx, logger, cluster)
+}
+
+// MockSpireReconciler is a mock of SpireReconciler interface.
+type MockSpireReconciler struct {
+	ctrl     *gomock.Controller
+	recorder *MockSpireReconcilerMockRecorder
+}
+
+// MockSpireReconcilerMockRecorder is the mock recorder for MockSpireReconciler.
+type MockSpireReconcilerMockRecorder struct {
+	mock *MockSpireReconciler
+}
+
+// NewMockSpireReconciler creates a new mock instance.
+func NewMockSpireReconciler(ctrl *gomock.Controller) *MockSpireReconciler {
+	mock := &MockSpireReconciler{ctrl: ctrl}
+	mock.recorder = &MockSpireReconcilerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSpireReconciler) EXPECT() *MockSpireReconcilerMockRecorder {
+	return m.recorder
+}
+
+// Reconcile mocks base method.
+func (m *MockSpireReconciler) Reconcile(ctx context.Context, logger logr.Logger, cluster *v1alpha1.Cluster) (controller.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reconcile", ctx, logger, cluster)
+	ret0, _ := ret[0].(controller.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Reconcile indicates an expected call of Reconcile.
+func (mr *MockSpireReconcilerMockRecorder) Reconcile(ctx, logger, cluster interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reconcile", reflect.TypeOf((*MockSpireReconciler)(nil).Reconcile), ctx, logger, cluster)
+}
+
+// MockDefaultStorageClassReconciler is a mock of DefaultStorageClassReconciler interface.
+type MockDefaultStorageClassReconciler struct {
+	ctrl     *gomock.Controller
+	recorder *MockDefaultStorageClassReconcilerMockRecorder
+}
+
+// MockDefaultStorageClassReconcilerMockRecorder is the mock recorder for MockDefaultStorageClassReconciler.
+type MockDefaultStorageClassReconcilerMockRecorder struct {
+	mock *MockDefaultStorageClassReconciler
+}
+
+// NewMockDefaultStorageClassReconciler creates a new mock instance.
+func NewMockDefaultStorageClassReconciler(ctrl *gomock.Controller) *MockDefaultStorageClassReconciler {
+	mock := &MockDefaultStorageClassReconciler{ctrl: ctrl}
+	mock.recorder = &MockDefaultStorageClassReconcilerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDefaultStorageClassReconciler) EXPECT() *MockDefaultStorageClassReconcilerMockRecorder {
+	return m.recorder
+}
+
+// Reconcile mocks base method.
+func (m *MockDefaultStorageClassReconciler) Reconcile(ctx context.Context, logger logr.Logger, cluster *v1alpha1.Cluster) (controller.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reconcile", ctx, logger, cluster)
+	ret0, _ := ret[0].(controller.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Reconcile indicates an expected call of Reconcile.
+func (mr *MockDefaultStorageClassReconcilerMockRecorder) Reconcile(ctx, logger, cluster interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reconcile", reflect.TypeOf((*MockDefaultStorageClassReconciler)(nil).Reconcile), ctx, logger, cluster)
+}
+
+// MockServiceLoadBalancerReconciler is a mock of ServiceLoadBalancerReconciler interface.
+type MockServiceLoadBalancerReconciler struct {
+	ctrl     *gomock.Controller
+	recorder *MockServiceLoadBalancerReconcilerMockRecorder
+}
+
+// MockServiceLoadBalancerReconcilerMockRecorder is the mock recorder for MockServiceLoadBalancerReconciler.
+type MockServiceLoadBalancerReconcilerMockRecorder struct {
+	mock *MockServiceLoadBalancerReconciler
+}
+
+// NewMockServiceLoadBalancerReconciler creates a new mock instance.
+func NewMockServiceLoadBalancerReconciler(ctrl *gomock.Controller) *MockServiceLoadBalancerReconciler {
+	mock := &MockServiceLoadBalancerReconciler{ctrl: ctrl}
+	mock.recorder = &MockServiceLoadBalancerReconcilerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockServiceLoadBalancerReconciler) EXPECT() *MockServiceLoadBalancerReconcilerMockRecorder {
+	return m.recorder
+}
+
+// Reconcile mocks base method.
+func (m *MockServiceLoadBalancerReconciler) Reconcile(ctx context.Context, logger logr.Logger, cluster *v1alpha1.Cluster) (controller.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reconcile", ctx, logger, cluster)
+	ret0, _ := ret[0].(controller.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Reconcile indicates an expected call of Reconcile.
+func (mr *MockServiceLoadBalancerReconcilerMockRecorder) Reconcile(ctx, logger, cluster interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reconcile", reflect.TypeOf((*MockServiceLoadBalancerReconciler)(nil).Reconcile), ctx, logger, cluster)
+}
+
+// MockCoreDNSReconciler is a mock of CoreDNSReconciler interface.
+type MockCoreDNSReconciler struct {
+	ctrl     *gomock.Controller
+	recorder *MockCoreDNSReconcilerMockRecorder
+}
+
+// MockCoreDNSReconcilerMockRecorder is the mock recorder for MockCoreDNSReconciler.
+type MockCoreDNSReconcilerMockRecorder struct {
+	mock *MockCoreDNSReconciler
+}
+
+// NewMockCoreDNSReconciler creates a new mock instance.
+func NewMockCoreDNSReconciler(ctrl *gomock.Controller) *MockCoreDNSReconciler {
+	mock := &MockCoreDNSReconciler{ctrl: ctrl}
+	mock.recorder = &MockCoreDNSReconcilerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCoreDNSReconciler) EXPECT() *MockCoreDNSReconcilerMockRecorder {
+	return m.recorder
+}
+
+// Reconcile mocks base method.
+func (m *MockCoreDNSReconciler) Reconcile(ctx context.Context, logger logr.Logger, cluster *v1alpha1.Cluster) (controller.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reconcile", ctx, logger, cluster)
+	ret0, _ := ret[0].(controller.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Reconcile indicates an expected call of Reconcile.
+func (mr *MockCoreDNSReconcilerMockRecorder) Reconcile(ctx, logger, cluster interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reconcile", reflect.TypeOf((*MockCoreDNSReconciler)(nil).Reconcile), ctx, logger, cluster)
+}
+
+// MockGPUReconciler is a mock of GPUReconciler interface.
+type MockGPUReconciler struct {
+	ctrl     *gomock.Controller
+	recorder *MockGPUReconcilerMockRecorder
+}
+
+// MockGPUReconcilerMockRecorder is the mock recorder for MockGPUReconciler.
+type MockGPUReconcilerMockRecorder struct {
+	mock *MockGPUReconciler
+}
+
+// NewMockGPUReconciler creates a new mock instance.
+func NewMockGPUReconciler(ctrl *gomock.Controller) *MockGPUReconciler {
+	mock := &MockGPUReconciler{ctrl: ctrl}
+	mock.recorder = &MockGPUReconcilerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGPUReconciler) EXPECT() *MockGPUReconcilerMockRecorder {
+	return m.recorder
+}
+
+// Reconcile mocks base method.
+func (m *MockGPUReconciler) Reconcile(ctx context.Context, logger logr.Logger, cluster *v1alpha1.Cluster) (controller.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reconcile", ctx, logger, cluster)
+	ret0, _ := ret[0].(controller.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Reconcile indicates an expected call of Reconcile.
+func (mr *MockGPUReconcilerMockRecorder) Reconcile(ctx, logger, cluster interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reconcile", reflect.TypeOf((*MockGPUReconciler)(nil).Reconcile), ctx, logger, cluster)
+}
+
+// MockProviderCredentialsReconciler is a mock of ProviderCredentialsReconciler interface.
+type MockProviderCredentialsReconciler struct {
+	ctrl     *gomock.Controller
+	recorder *MockProviderCredentialsReconcilerMockRecorder
+}
+
+// MockProviderCredentialsReconcilerMockRecorder is the mock recorder for MockProviderCredentialsReconciler.
+type MockProviderCredentialsReconcilerMockRecorder struct {
+	mock *MockProviderCredentialsReconciler
+}
+
+// NewMockProviderCredentialsReconciler creates a new mock instance.
+func NewMockProviderCredentialsReconciler(ctrl *gomock.Controller) *MockProviderCredentialsReconciler {
+	mock := &MockProviderCredentialsReconciler{ctrl: ctrl}
+	mock.recorder = &MockProviderCredentialsReconcilerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockProviderCredentialsReconciler) EXPECT() *MockProviderCredentialsReconcilerMockRecorder {
+	return m.recorder
+}
+
+// Reconcile mocks base method.
+func (m *MockProviderCredentialsReconciler) Reconcile(ctx context.Context, logger logr.Logger, cluster *v1alpha1.Cluster) (controller.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reconcile", ctx, logger, cluster)
+	ret0, _ := ret[0].(controller.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Reconcile indicates an expected call of Reconcile.
+func (mr *MockProviderCredentialsReconcilerMockRecorder) Reconcile(ctx, logger, cluster interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reconcile", reflect.TypeOf((*MockProviderCredentialsReconciler)(nil).Reconcile), ctx, logger, cluster)
+}
+
+// MockNodeProvisionerReconciler is a mock of NodeProvisionerReconciler interface.
+type MockNodeProvisionerReconciler struct {
+	ctrl     *gomock.Controller
+	recorder *MockNodeProvisionerReconcilerMockRecorder
+}
+
+// MockNodeProvisionerReconcilerMockRecorder is the mock recorder for MockNodeProvisionerReconciler.
+type MockNodeProvisionerReconcilerMockRecorder struct {
+	mock *MockNodeProvisionerReconciler
+}
+
+// NewMockNodeProvisionerReconciler creates a new mock instance.
+func NewMockNodeProvisionerReconciler(ctrl *gomock.Controller) *MockNodeProvisionerReconciler {
+	mock := &MockNodeProvisionerReconciler{ctrl: ctrl}
+	mock.recorder = &MockNodeProvisionerReconcilerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNodeProvisionerReconciler) EXPECT() *MockNodeProvisionerReconcilerMockRecorder {
+	return m.recorder
+}
+
+// Reconcile mocks base method.
+func (m *MockNodeProvisionerReconciler) Reconcile(ctx context.Context, logger logr.Logger, cluster *v1alpha1.Cluster) (controller.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reconcile", ctx, logger, cluster)
+	ret0, _ := ret[0].(controller.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Reconcile indicates an expected call of Reconcile.
+func (mr *MockNodeProvisionerReconcilerMockRecorder) Reconcile(ctx, logger, cluster interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reconcile", reflect.TypeOf((*MockNodeProvisionerReconciler)(nil).Reconcile), ctx, logger, cluster)
+}
+
 // MockClusterValidator is a mock of ClusterValidator interface.
 type MockClusterValidator struct {
 	ctrl     *gomock.Controller
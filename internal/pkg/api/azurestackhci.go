@@ -0,0 +1,107 @@
+package api
+
+import (
+	"os"
+
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/cluster"
+)
+
+// AzureStackHCIConfig is a wrapper for the Azure Stack HCI provider spec.
+type AzureStackHCIConfig struct {
+	datacenterConfig *anywherev1.AzureStackHCIDatacenterConfig
+	machineConfigs   map[string]*anywherev1.AzureStackHCIMachineConfig
+}
+
+// AzureStackHCIFiller updates an AzureStackHCIConfig.
+type AzureStackHCIFiller func(config *AzureStackHCIConfig)
+
+// AzureStackHCIToConfigFiller transforms a set of AzureStackHCIFiller's in a single ClusterConfigFiller.
+func AzureStackHCIToConfigFiller(fillers ...AzureStackHCIFiller) ClusterConfigFiller {
+	return func(c *cluster.Config) {
+		updateAzureStackHCI(c, fillers...)
+	}
+}
+
+func updateAzureStackHCI(config *cluster.Config, fillers ...AzureStackHCIFiller) {
+	ac := &AzureStackHCIConfig{
+		datacenterConfig: config.AzureStackHCIDatacenter,
+		machineConfigs:   config.AzureStackHCIMachineConfigs,
+	}
+
+	for _, f := range fillers {
+		f(ac)
+	}
+}
+
+// WithAzureStackHCIStringFromEnvVar returns an AzureStackHCIFiller that sets the given string value to the given environment variable.
+func WithAzureStackHCIStringFromEnvVar(envVar string, opt func(string) AzureStackHCIFiller) AzureStackHCIFiller {
+	return opt(os.Getenv(envVar))
+}
+
+// WithAzureStackHCICloudAgentFQDN returns an AzureStackHCIFiller that sets the cloud agent FQDN for the Azure Stack HCI provider.
+func WithAzureStackHCICloudAgentFQDN(value string) AzureStackHCIFiller {
+	return func(config *AzureStackHCIConfig) {
+		config.datacenterConfig.Spec.CloudAgentFQDN = value
+	}
+}
+
+// WithAzureStackHCILocation returns an AzureStackHCIFiller that sets the location for the Azure Stack HCI provider.
+func WithAzureStackHCILocation(value string) AzureStackHCIFiller {
+	return func(config *AzureStackHCIConfig) {
+		config.datacenterConfig.Spec.Location = value
+	}
+}
+
+// WithAzureStackHCIResourceGroup returns an AzureStackHCIFiller that sets the resource group the cluster resources are created in.
+func WithAzureStackHCIResourceGroup(value string) AzureStackHCIFiller {
+	return func(config *AzureStackHCIConfig) {
+		config.datacenterConfig.Spec.ResourceGroup = value
+	}
+}
+
+// WithAzureStackHCIVirtualNetwork returns an AzureStackHCIFiller that sets the virtual network the machines are attached to.
+func WithAzureStackHCIVirtualNetwork(value string) AzureStackHCIFiller {
+	return func(config *AzureStackHCIConfig) {
+		config.datacenterConfig.Spec.VirtualNetwork = value
+	}
+}
+
+// WithAzureStackHCIStorageContainer returns an AzureStackHCIFiller that sets the storage container the disks are created in.
+func WithAzureStackHCIStorageContainer(value string) AzureStackHCIFiller {
+	return func(config *AzureStackHCIConfig) {
+		config.datacenterConfig.Spec.StorageContainer = value
+	}
+}
+
+// WithAzureStackHCIVMSize returns an AzureStackHCIFiller that sets the VM size for all Azure Stack HCI machines.
+func WithAzureStackHCIVMSize(value string) AzureStackHCIFiller {
+	return func(config *AzureStackHCIConfig) {
+		for _, m := range config.machineConfigs {
+			m.Spec.VMSize = value
+		}
+	}
+}
+
+// WithAzureStackHCIImage returns an AzureStackHCIFiller that sets the image for all Azure Stack HCI machines.
+func WithAzureStackHCIImage(value string) AzureStackHCIFiller {
+	return func(config *AzureStackHCIConfig) {
+		for _, m := range config.machineConfigs {
+			m.Spec.Image = value
+		}
+	}
+}
+
+// WithAzureStackHCISSHAuthorizedKey returns an AzureStackHCIFiller that sets the SSH authorized key for all Azure Stack HCI machines.
+func WithAzureStackHCISSHAuthorizedKey(value string) AzureStackHCIFiller {
+	return func(config *AzureStackHCIConfig) {
+		for _, m := range config.machineConfigs {
+			m.Spec.Users = []anywherev1.UserConfiguration{
+				{
+					Name:              anywherev1.DefaultAzureStackHCIMachineConfigUser,
+					SshAuthorizedKeys: []string{value},
+				},
+			}
+		}
+	}
+}
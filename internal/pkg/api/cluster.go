@@ -188,9 +188,10 @@ func WithPodCidr(podCidr string) ClusterFiller {
 	}
 }
 
+// WithServiceCidr sets an explicit service CIDR, overriding the provider's default.
 func WithServiceCidr(svcCidr string) ClusterFiller {
 	return func(c *anywherev1.Cluster) {
-		c.Spec.ClusterNetwork.Services.CidrBlocks = []string{svcCidr}
+		c.Spec.ClusterNetwork.Services.CidrBlocks = strings.Split(svcCidr, ",")
 	}
 }
 
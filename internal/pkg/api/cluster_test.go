@@ -232,6 +232,30 @@ func TestWithPodCidr(t *testing.T) {
 	})
 }
 
+func TestWithServiceCidr(t *testing.T) {
+	cluster := &anywherev1.Cluster{
+		Spec: anywherev1.ClusterSpec{
+			ClusterNetwork: anywherev1.ClusterNetwork{
+				Services: anywherev1.Services{
+					CidrBlocks: []string{"10.96.0.0/12"},
+				},
+			},
+		},
+	}
+
+	t.Run("with a single CIDR block", func(t *testing.T) {
+		api.WithServiceCidr("10.100.0.0/16")(cluster)
+		g := NewWithT(t)
+		g.Expect(cluster.Spec.ClusterNetwork.Services.CidrBlocks).To(Equal([]string{"10.100.0.0/16"}))
+	})
+
+	t.Run("with dual-stack CIDR blocks", func(t *testing.T) {
+		api.WithServiceCidr("10.96.0.0/12,fd00:200::/108")(cluster)
+		g := NewWithT(t)
+		g.Expect(cluster.Spec.ClusterNetwork.Services.CidrBlocks).To(Equal([]string{"10.96.0.0/12", "fd00:200::/108"}))
+	})
+}
+
 func TestWithControlPlaneAPIServerExtraArgs(t *testing.T) {
 	tests := []struct {
 		name    string
@@ -0,0 +1,96 @@
+package api
+
+import (
+	"os"
+	"strings"
+
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/cluster"
+)
+
+// HarvesterConfig is a wrapper for the Harvester provider spec.
+type HarvesterConfig struct {
+	datacenterConfig *anywherev1.HarvesterDatacenterConfig
+	machineConfigs   map[string]*anywherev1.HarvesterMachineConfig
+}
+
+// HarvesterFiller updates a HarvesterConfig.
+type HarvesterFiller func(config *HarvesterConfig)
+
+// HarvesterToConfigFiller transforms a set of HarvesterFiller's in a single ClusterConfigFiller.
+func HarvesterToConfigFiller(fillers ...HarvesterFiller) ClusterConfigFiller {
+	return func(c *cluster.Config) {
+		updateHarvester(c, fillers...)
+	}
+}
+
+func updateHarvester(config *cluster.Config, fillers ...HarvesterFiller) {
+	hc := &HarvesterConfig{
+		datacenterConfig: config.HarvesterDatacenter,
+		machineConfigs:   config.HarvesterMachineConfigs,
+	}
+
+	for _, f := range fillers {
+		f(hc)
+	}
+}
+
+// WithHarvesterStringFromEnvVar returns a HarvesterFiller that sets the given string value to the given environment variable.
+func WithHarvesterStringFromEnvVar(envVar string, opt func(string) HarvesterFiller) HarvesterFiller {
+	return opt(os.Getenv(envVar))
+}
+
+// WithHarvesterServer returns a HarvesterFiller that sets the Harvester cluster API URL.
+func WithHarvesterServer(value string) HarvesterFiller {
+	return func(config *HarvesterConfig) {
+		config.datacenterConfig.Spec.Server = value
+	}
+}
+
+// WithHarvesterNamespace returns a HarvesterFiller that sets the Harvester namespace the VMs are created in.
+func WithHarvesterNamespace(value string) HarvesterFiller {
+	return func(config *HarvesterConfig) {
+		config.datacenterConfig.Spec.Namespace = value
+	}
+}
+
+// WithHarvesterNetworks returns a HarvesterFiller that sets the VM networks, given as a comma separated list, for all Harvester machines.
+func WithHarvesterNetworks(value string) HarvesterFiller {
+	return func(config *HarvesterConfig) {
+		for _, m := range config.machineConfigs {
+			m.Spec.Networks = strings.Split(value, ",")
+		}
+	}
+}
+
+// WithHarvesterImage returns a HarvesterFiller that sets the image for all Harvester machines.
+func WithHarvesterImage(value string) HarvesterFiller {
+	return func(config *HarvesterConfig) {
+		for _, m := range config.machineConfigs {
+			m.Spec.Image = value
+		}
+	}
+}
+
+// WithHarvesterImageURL returns a HarvesterFiller that sets the URL the image is uploaded from for all Harvester machines.
+func WithHarvesterImageURL(value string) HarvesterFiller {
+	return func(config *HarvesterConfig) {
+		for _, m := range config.machineConfigs {
+			m.Spec.ImageURL = value
+		}
+	}
+}
+
+// WithHarvesterSSHAuthorizedKey returns a HarvesterFiller that sets the SSH authorized key for all Harvester machines.
+func WithHarvesterSSHAuthorizedKey(value string) HarvesterFiller {
+	return func(config *HarvesterConfig) {
+		for _, m := range config.machineConfigs {
+			m.Spec.Users = []anywherev1.UserConfiguration{
+				{
+					Name:              anywherev1.DefaultHarvesterMachineConfigUser,
+					SshAuthorizedKeys: []string{value},
+				},
+			}
+		}
+	}
+}
@@ -0,0 +1,112 @@
+package api
+
+import (
+	"os"
+
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/cluster"
+)
+
+// OpenStackConfig is a wrapper for the OpenStack provider spec.
+type OpenStackConfig struct {
+	datacenterConfig *anywherev1.OpenStackDatacenterConfig
+	machineConfigs   map[string]*anywherev1.OpenStackMachineConfig
+}
+
+// OpenStackFiller updates an OpenStackConfig.
+type OpenStackFiller func(config *OpenStackConfig)
+
+// OpenStackToConfigFiller transforms a set of OpenStackFiller's in a single ClusterConfigFiller.
+func OpenStackToConfigFiller(fillers ...OpenStackFiller) ClusterConfigFiller {
+	return func(c *cluster.Config) {
+		updateOpenStack(c, fillers...)
+	}
+}
+
+func updateOpenStack(config *cluster.Config, fillers ...OpenStackFiller) {
+	oc := &OpenStackConfig{
+		datacenterConfig: config.OpenStackDatacenter,
+		machineConfigs:   config.OpenStackMachineConfigs,
+	}
+
+	for _, f := range fillers {
+		f(oc)
+	}
+}
+
+// WithOpenStackStringFromEnvVar returns an OpenStackFiller that sets the given string value to the given environment variable.
+func WithOpenStackStringFromEnvVar(envVar string, opt func(string) OpenStackFiller) OpenStackFiller {
+	return opt(os.Getenv(envVar))
+}
+
+// WithOpenStackBoolFromEnvVar returns an OpenStackFiller that sets the given bool value to the given environment variable.
+func WithOpenStackBoolFromEnvVar(envVar string, opt func(bool) OpenStackFiller) OpenStackFiller {
+	return opt(os.Getenv(envVar) == "true")
+}
+
+// WithOpenStackAuthURL returns an OpenStackFiller that sets the Identity API endpoint for the OpenStack provider.
+func WithOpenStackAuthURL(value string) OpenStackFiller {
+	return func(config *OpenStackConfig) {
+		config.datacenterConfig.Spec.AuthURL = value
+	}
+}
+
+// WithOpenStackRegion returns an OpenStackFiller that sets the region for the OpenStack provider.
+func WithOpenStackRegion(value string) OpenStackFiller {
+	return func(config *OpenStackConfig) {
+		config.datacenterConfig.Spec.Region = value
+	}
+}
+
+// WithOpenStackInsecure returns an OpenStackFiller that sets the insecure flag for the OpenStack provider.
+func WithOpenStackInsecure(value bool) OpenStackFiller {
+	return func(config *OpenStackConfig) {
+		config.datacenterConfig.Spec.Insecure = value
+	}
+}
+
+// WithOpenStackNetwork returns an OpenStackFiller that sets the network the machines are attached to.
+func WithOpenStackNetwork(value string) OpenStackFiller {
+	return func(config *OpenStackConfig) {
+		config.datacenterConfig.Spec.Network = value
+	}
+}
+
+// WithOpenStackSubnet returns an OpenStackFiller that sets the subnet of the network the machines get their IPs from.
+func WithOpenStackSubnet(value string) OpenStackFiller {
+	return func(config *OpenStackConfig) {
+		config.datacenterConfig.Spec.Subnet = value
+	}
+}
+
+// WithOpenStackFlavor returns an OpenStackFiller that sets the flavor for all OpenStack machines.
+func WithOpenStackFlavor(value string) OpenStackFiller {
+	return func(config *OpenStackConfig) {
+		for _, m := range config.machineConfigs {
+			m.Spec.Flavor = value
+		}
+	}
+}
+
+// WithOpenStackImage returns an OpenStackFiller that sets the image for all OpenStack machines.
+func WithOpenStackImage(value string) OpenStackFiller {
+	return func(config *OpenStackConfig) {
+		for _, m := range config.machineConfigs {
+			m.Spec.Image = value
+		}
+	}
+}
+
+// WithOpenStackSSHAuthorizedKey returns an OpenStackFiller that sets the SSH authorized key for all OpenStack machines.
+func WithOpenStackSSHAuthorizedKey(value string) OpenStackFiller {
+	return func(config *OpenStackConfig) {
+		for _, m := range config.machineConfigs {
+			m.Spec.Users = []anywherev1.UserConfiguration{
+				{
+					Name:              anywherev1.DefaultOpenStackMachineConfigUser,
+					SshAuthorizedKeys: []string{value},
+				},
+			}
+		}
+	}
+}
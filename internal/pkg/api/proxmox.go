@@ -0,0 +1,162 @@
+package api
+
+import (
+	"os"
+	"strconv"
+	"strings"
+
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/cluster"
+)
+
+// ProxmoxConfig is a wrapper for the Proxmox provider spec.
+type ProxmoxConfig struct {
+	datacenterConfig *anywherev1.ProxmoxDatacenterConfig
+	machineConfigs   map[string]*anywherev1.ProxmoxMachineConfig
+}
+
+// ProxmoxFiller updates a ProxmoxConfig.
+type ProxmoxFiller func(config *ProxmoxConfig)
+
+// ProxmoxToConfigFiller transforms a set of ProxmoxFiller's in a single ClusterConfigFiller.
+func ProxmoxToConfigFiller(fillers ...ProxmoxFiller) ClusterConfigFiller {
+	return func(c *cluster.Config) {
+		updateProxmox(c, fillers...)
+	}
+}
+
+func updateProxmox(config *cluster.Config, fillers ...ProxmoxFiller) {
+	pc := &ProxmoxConfig{
+		datacenterConfig: config.ProxmoxDatacenter,
+		machineConfigs:   config.ProxmoxMachineConfigs,
+	}
+
+	for _, f := range fillers {
+		f(pc)
+	}
+}
+
+// WithProxmoxStringFromEnvVar returns a ProxmoxFiller that sets the given string value to the given environment variable.
+func WithProxmoxStringFromEnvVar(envVar string, opt func(string) ProxmoxFiller) ProxmoxFiller {
+	return opt(os.Getenv(envVar))
+}
+
+// WithProxmoxIntFromEnvVar returns a ProxmoxFiller that sets the given integer value to the given environment variable.
+func WithProxmoxIntFromEnvVar(envVar string, opt func(int) ProxmoxFiller) ProxmoxFiller {
+	intVar, _ := strconv.Atoi(os.Getenv(envVar))
+	return opt(intVar)
+}
+
+// WithProxmoxBoolFromEnvVar returns a ProxmoxFiller that sets the given bool value to the given environment variable.
+func WithProxmoxBoolFromEnvVar(envVar string, opt func(bool) ProxmoxFiller) ProxmoxFiller {
+	return opt(os.Getenv(envVar) == "true")
+}
+
+// WithProxmoxEndpoint returns a ProxmoxFiller that sets the API endpoint for the Proxmox provider.
+func WithProxmoxEndpoint(value string) ProxmoxFiller {
+	return func(config *ProxmoxConfig) {
+		config.datacenterConfig.Spec.Endpoint = value
+	}
+}
+
+// WithProxmoxInsecure returns a ProxmoxFiller that sets the insecure flag for the Proxmox provider.
+func WithProxmoxInsecure(value bool) ProxmoxFiller {
+	return func(config *ProxmoxConfig) {
+		config.datacenterConfig.Spec.Insecure = value
+	}
+}
+
+// WithProxmoxNodes returns a ProxmoxFiller that sets the allowed nodes from a comma separated list.
+func WithProxmoxNodes(value string) ProxmoxFiller {
+	return func(config *ProxmoxConfig) {
+		config.datacenterConfig.Spec.Nodes = splitNonEmpty(value)
+	}
+}
+
+// WithProxmoxIPv4Addresses returns a ProxmoxFiller that sets the node IP pool from a comma separated list.
+func WithProxmoxIPv4Addresses(value string) ProxmoxFiller {
+	return func(config *ProxmoxConfig) {
+		config.datacenterConfig.Spec.IPv4Config.Addresses = splitNonEmpty(value)
+	}
+}
+
+// WithProxmoxIPv4Prefix returns a ProxmoxFiller that sets the node network prefix length.
+func WithProxmoxIPv4Prefix(value int) ProxmoxFiller {
+	return func(config *ProxmoxConfig) {
+		config.datacenterConfig.Spec.IPv4Config.Prefix = value
+	}
+}
+
+// WithProxmoxIPv4Gateway returns a ProxmoxFiller that sets the node network gateway.
+func WithProxmoxIPv4Gateway(value string) ProxmoxFiller {
+	return func(config *ProxmoxConfig) {
+		config.datacenterConfig.Spec.IPv4Config.Gateway = value
+	}
+}
+
+// WithProxmoxDNSServers returns a ProxmoxFiller that sets the DNS servers from a comma separated list.
+func WithProxmoxDNSServers(value string) ProxmoxFiller {
+	return func(config *ProxmoxConfig) {
+		config.datacenterConfig.Spec.DNSServers = splitNonEmpty(value)
+	}
+}
+
+// WithProxmoxSourceNode returns a ProxmoxFiller that sets the template source node for all Proxmox machines.
+func WithProxmoxSourceNode(value string) ProxmoxFiller {
+	return func(config *ProxmoxConfig) {
+		for _, m := range config.machineConfigs {
+			m.Spec.SourceNode = value
+		}
+	}
+}
+
+// WithProxmoxTemplateID returns a ProxmoxFiller that sets the VM template ID for all Proxmox machines.
+func WithProxmoxTemplateID(value int) ProxmoxFiller {
+	return func(config *ProxmoxConfig) {
+		for _, m := range config.machineConfigs {
+			m.Spec.TemplateID = int32(value)
+		}
+	}
+}
+
+// WithProxmoxBridge returns a ProxmoxFiller that sets the network bridge for all Proxmox machines.
+func WithProxmoxBridge(value string) ProxmoxFiller {
+	return func(config *ProxmoxConfig) {
+		for _, m := range config.machineConfigs {
+			m.Spec.Bridge = value
+		}
+	}
+}
+
+// WithProxmoxStorage returns a ProxmoxFiller that sets the disk storage for all Proxmox machines.
+func WithProxmoxStorage(value string) ProxmoxFiller {
+	return func(config *ProxmoxConfig) {
+		for _, m := range config.machineConfigs {
+			m.Spec.Storage = value
+		}
+	}
+}
+
+// WithProxmoxSSHAuthorizedKey returns a ProxmoxFiller that sets the SSH authorized key for all Proxmox machines.
+func WithProxmoxSSHAuthorizedKey(value string) ProxmoxFiller {
+	return func(config *ProxmoxConfig) {
+		for _, m := range config.machineConfigs {
+			m.Spec.Users = []anywherev1.UserConfiguration{
+				{
+					Name:              anywherev1.DefaultProxmoxMachineConfigUser,
+					SshAuthorizedKeys: []string{value},
+				},
+			}
+		}
+	}
+}
+
+func splitNonEmpty(value string) []string {
+	var items []string
+	for _, s := range strings.Split(value, ",") {
+		if s = strings.TrimSpace(s); s != "" {
+			items = append(items, s)
+		}
+	}
+	return items
+}
@@ -0,0 +1,54 @@
+package e2e
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	e2etest "github.com/aws/eks-anywhere/test/e2e"
+)
+
+const (
+	featureCoverageJSONFile     = "e2e-feature-coverage.json"
+	featureCoverageMarkdownFile = "e2e-feature-coverage.md"
+)
+
+// WriteFeatureCoverage tags the e2e tests matching the regex with their feature labels and
+// writes the coverage matrix of the required feature combinations to the output folder, as
+// JSON and markdown. Skipped tests don't count towards the coverage. It returns the names of the
+// uncovered combinations.
+func WriteFeatureCoverage(regex string, testsToSkip []string, outputFolder string) ([]string, error) {
+	tests, _, err := listTests(regex, testsToSkip)
+	if err != nil {
+		return nil, err
+	}
+
+	registry, err := e2etest.LoadFeatureRegistry()
+	if err != nil {
+		return nil, err
+	}
+	coverage := registry.Coverage(tests)
+
+	if err := os.MkdirAll(outputFolder, os.ModePerm); err != nil {
+		return nil, fmt.Errorf("creating feature coverage folder: %v", err)
+	}
+
+	content, err := json.MarshalIndent(coverage, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("marshalling feature coverage: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(outputFolder, featureCoverageJSONFile), content, 0o644); err != nil {
+		return nil, fmt.Errorf("writing feature coverage: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(outputFolder, featureCoverageMarkdownFile), []byte(coverage.Markdown()), 0o644); err != nil {
+		return nil, fmt.Errorf("writing feature coverage: %v", err)
+	}
+
+	var gaps []string
+	for _, gap := range coverage.Gaps() {
+		gaps = append(gaps, coverage.CombinationName(gap))
+	}
+
+	return gaps, nil
+}
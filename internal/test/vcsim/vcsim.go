@@ -0,0 +1,67 @@
+// Package vcsim runs an in-memory vCenter simulator, backed by govmomi's vcsim,
+// so vSphere code can be tested without access to a real vCenter.
+package vcsim
+
+import (
+	"crypto/tls"
+	"testing"
+
+	"github.com/vmware/govmomi/simulator"
+)
+
+// Inventory paths of the default objects created by the simulator.
+const (
+	Datacenter     = "DC0"
+	ComputeCluster = "/DC0/host/DC0_C0"
+	ResourcePool   = "/DC0/host/DC0_C0/Resources"
+	Datastore      = "/DC0/datastore/LocalDS_0"
+	Network        = "/DC0/network/VM Network"
+	Folder         = "/DC0/vm"
+	VirtualMachine = "/DC0/vm/DC0_C0_RP0_VM0"
+)
+
+// Simulator is a running vCenter simulator.
+type Simulator struct {
+	model  *simulator.Model
+	server *simulator.Server
+}
+
+// New starts a vCenter simulator with the default VPX inventory: one datacenter
+// with a cluster, its resource pool, a datastore, a network and a few VMs.
+// The simulator is stopped when the test finishes.
+func New(t testing.TB) *Simulator {
+	t.Helper()
+	model := simulator.VPX()
+	if err := model.Create(); err != nil {
+		t.Fatalf("creating vcsim model: %v", err)
+	}
+	model.Service.TLS = new(tls.Config)
+
+	server := model.Service.NewServer()
+	t.Cleanup(func() {
+		server.Close()
+		model.Remove()
+	})
+
+	return &Simulator{
+		model:  model,
+		server: server,
+	}
+}
+
+// Server returns the host and port the simulator is listening on, in the same
+// format as VSphereDatacenterConfig's server.
+func (s *Simulator) Server() string {
+	return s.server.URL.Host
+}
+
+// Username returns a username accepted by the simulator.
+func (s *Simulator) Username() string {
+	return s.server.URL.User.Username()
+}
+
+// Password returns the password for Username.
+func (s *Simulator) Password() string {
+	password, _ := s.server.URL.User.Password()
+	return password
+}
@@ -277,6 +277,10 @@ func setupWebhooks(setupLog logr.Logger, mgr ctrl.Manager) {
 	setupSnowWebhooks(setupLog, mgr)
 	setupTinkerbellWebhooks(setupLog, mgr)
 	setupNutanixWebhooks(setupLog, mgr)
+	setupProxmoxWebhooks(setupLog, mgr)
+	setupOpenStackWebhooks(setupLog, mgr)
+	setupAzureStackHCIWebhooks(setupLog, mgr)
+	setupHarvesterWebhooks(setupLog, mgr)
 }
 
 func setupCoreWebhooks(setupLog logr.Logger, mgr ctrl.Manager) {
@@ -361,6 +365,50 @@ func setupNutanixWebhooks(setupLog logr.Logger, mgr ctrl.Manager) {
 	}
 }
 
+func setupProxmoxWebhooks(setupLog logr.Logger, mgr ctrl.Manager) {
+	if err := (&anywherev1.ProxmoxDatacenterConfig{}).SetupWebhookWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create webhook", WEBHOOK, anywherev1.ProxmoxDatacenterKind)
+		os.Exit(1)
+	}
+	if err := (&anywherev1.ProxmoxMachineConfig{}).SetupWebhookWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create webhook", WEBHOOK, anywherev1.ProxmoxMachineConfigKind)
+		os.Exit(1)
+	}
+}
+
+func setupOpenStackWebhooks(setupLog logr.Logger, mgr ctrl.Manager) {
+	if err := (&anywherev1.OpenStackDatacenterConfig{}).SetupWebhookWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create webhook", WEBHOOK, anywherev1.OpenStackDatacenterKind)
+		os.Exit(1)
+	}
+	if err := (&anywherev1.OpenStackMachineConfig{}).SetupWebhookWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create webhook", WEBHOOK, anywherev1.OpenStackMachineConfigKind)
+		os.Exit(1)
+	}
+}
+
+func setupAzureStackHCIWebhooks(setupLog logr.Logger, mgr ctrl.Manager) {
+	if err := (&anywherev1.AzureStackHCIDatacenterConfig{}).SetupWebhookWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create webhook", WEBHOOK, anywherev1.AzureStackHCIDatacenterKind)
+		os.Exit(1)
+	}
+	if err := (&anywherev1.AzureStackHCIMachineConfig{}).SetupWebhookWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create webhook", WEBHOOK, anywherev1.AzureStackHCIMachineConfigKind)
+		os.Exit(1)
+	}
+}
+
+func setupHarvesterWebhooks(setupLog logr.Logger, mgr ctrl.Manager) {
+	if err := (&anywherev1.HarvesterDatacenterConfig{}).SetupWebhookWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create webhook", WEBHOOK, anywherev1.HarvesterDatacenterKind)
+		os.Exit(1)
+	}
+	if err := (&anywherev1.HarvesterMachineConfig{}).SetupWebhookWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create webhook", WEBHOOK, anywherev1.HarvesterMachineConfigKind)
+		os.Exit(1)
+	}
+}
+
 func setupChecks(setupLog logr.Logger, mgr ctrl.Manager) {
 	if err := mgr.AddHealthzCheck("healthz", healthz.Ping); err != nil {
 		setupLog.Error(err, "unable to set up health check")
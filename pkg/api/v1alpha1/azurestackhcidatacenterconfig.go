@@ -0,0 +1,48 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// AzureStackHCIDatacenterKind is the kind for an AzureStackHCIDatacenterConfig.
+const AzureStackHCIDatacenterKind = "AzureStackHCIDatacenterConfig"
+
+// NewAzureStackHCIDatacenterConfigGenerate is used for generating yaml for generate clusterconfig command.
+func NewAzureStackHCIDatacenterConfigGenerate(clusterName string) *AzureStackHCIDatacenterConfigGenerate {
+	return &AzureStackHCIDatacenterConfigGenerate{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       AzureStackHCIDatacenterKind,
+			APIVersion: SchemeBuilder.GroupVersion.String(),
+		},
+		ObjectMeta: ObjectMeta{
+			Name: clusterName,
+		},
+		Spec: AzureStackHCIDatacenterConfigSpec{
+			CloudAgentFQDN: "<enter cloud agent FQDN here>",
+			Location:       "<enter location here>",
+			VirtualNetwork: "<enter virtual network name here>",
+		},
+	}
+}
+
+func (c *AzureStackHCIDatacenterConfigGenerate) APIVersion() string {
+	return c.TypeMeta.APIVersion
+}
+
+func (c *AzureStackHCIDatacenterConfigGenerate) Kind() string {
+	return c.TypeMeta.Kind
+}
+
+func (c *AzureStackHCIDatacenterConfigGenerate) Name() string {
+	return c.ObjectMeta.Name
+}
+
+// GetAzureStackHCIDatacenterConfig parses config in a yaml file and returns an AzureStackHCIDatacenterConfig object.
+func GetAzureStackHCIDatacenterConfig(fileName string) (*AzureStackHCIDatacenterConfig, error) {
+	var clusterConfig AzureStackHCIDatacenterConfig
+	err := ParseClusterConfig(fileName, &clusterConfig)
+	if err != nil {
+		return nil, err
+	}
+	return &clusterConfig, nil
+}
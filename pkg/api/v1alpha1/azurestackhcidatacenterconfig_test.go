@@ -0,0 +1,86 @@
+package v1alpha1_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+)
+
+func validAzureStackHCIDatacenterConfig() *v1alpha1.AzureStackHCIDatacenterConfig {
+	return &v1alpha1.AzureStackHCIDatacenterConfig{
+		Spec: v1alpha1.AzureStackHCIDatacenterConfigSpec{
+			CloudAgentFQDN: "ca-cloudagent.hci.example.com",
+			Location:       "MocLocation",
+			VirtualNetwork: "eksa-vnet",
+		},
+	}
+}
+
+func TestAzureStackHCIDatacenterConfigValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		update  func(*v1alpha1.AzureStackHCIDatacenterConfig)
+		wantErr string
+	}{
+		{
+			name:   "valid",
+			update: func(*v1alpha1.AzureStackHCIDatacenterConfig) {},
+		},
+		{
+			name: "cloud agent ip with resource group and storage container",
+			update: func(c *v1alpha1.AzureStackHCIDatacenterConfig) {
+				c.Spec.CloudAgentFQDN = "10.0.0.5"
+				c.Spec.ResourceGroup = "eksa"
+				c.Spec.StorageContainer = "eksa-storage"
+			},
+		},
+		{
+			name:    "empty cloud agent fqdn",
+			update:  func(c *v1alpha1.AzureStackHCIDatacenterConfig) { c.Spec.CloudAgentFQDN = "" },
+			wantErr: "AzureStackHCIDatacenterConfig cloudAgentFQDN is not set or is empty",
+		},
+		{
+			name: "cloud agent fqdn with scheme",
+			update: func(c *v1alpha1.AzureStackHCIDatacenterConfig) {
+				c.Spec.CloudAgentFQDN = "https://ca-cloudagent.hci.example.com"
+			},
+			wantErr: "AzureStackHCIDatacenterConfig cloudAgentFQDN https://ca-cloudagent.hci.example.com must be a hostname or IP address without a scheme",
+		},
+		{
+			name:    "empty location",
+			update:  func(c *v1alpha1.AzureStackHCIDatacenterConfig) { c.Spec.Location = "" },
+			wantErr: "AzureStackHCIDatacenterConfig location is not set or is empty",
+		},
+		{
+			name:    "empty virtual network",
+			update:  func(c *v1alpha1.AzureStackHCIDatacenterConfig) { c.Spec.VirtualNetwork = "" },
+			wantErr: "AzureStackHCIDatacenterConfig virtualNetwork is not set or is empty",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			config := validAzureStackHCIDatacenterConfig()
+			tt.update(config)
+
+			err := config.Validate()
+			if tt.wantErr == "" {
+				g.Expect(err).NotTo(HaveOccurred())
+			} else {
+				g.Expect(err).To(MatchError(tt.wantErr))
+			}
+		})
+	}
+}
+
+func TestNewAzureStackHCIDatacenterConfigGenerate(t *testing.T) {
+	g := NewWithT(t)
+	config := v1alpha1.NewAzureStackHCIDatacenterConfigGenerate("test")
+
+	g.Expect(config.Kind()).To(Equal(v1alpha1.AzureStackHCIDatacenterKind))
+	g.Expect(config.APIVersion()).To(Equal(v1alpha1.SchemeBuilder.GroupVersion.String()))
+	g.Expect(config.Name()).To(Equal("test"))
+}
@@ -0,0 +1,154 @@
+// Important: Run "make generate" to regenerate code after modifying this file
+// json tags are required; new fields must have json tags for the fields to be serialized
+
+package v1alpha1
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// AzureStackHCIDatacenterConfigSpec defines the desired state of AzureStackHCIDatacenterConfig.
+type AzureStackHCIDatacenterConfigSpec struct {
+	// CloudAgentFQDN is the FQDN or IP address of the Azure Stack HCI cloud agent (MOC) service
+	// the cluster VMs are managed through.
+	// +kubebuilder:validation:Required
+	CloudAgentFQDN string `json:"cloudAgentFQDN"`
+
+	// Location is the Azure Stack HCI location the cluster VMs are created in.
+	// +kubebuilder:validation:Required
+	Location string `json:"location"`
+
+	// ResourceGroup is the resource group the cluster resources are created in.
+	// If not set, the cluster name is used.
+	// +optional
+	ResourceGroup string `json:"resourceGroup,omitempty"`
+
+	// VirtualNetwork is the name of the existing virtual network the cluster VMs are attached to.
+	// +kubebuilder:validation:Required
+	VirtualNetwork string `json:"virtualNetwork"`
+
+	// StorageContainer is the name of the storage container the VM disks are created in.
+	// If not set, the default storage container of the location is used.
+	// +optional
+	StorageContainer string `json:"storageContainer,omitempty"`
+}
+
+// AzureStackHCIDatacenterConfigStatus defines the observed state of AzureStackHCIDatacenterConfig.
+type AzureStackHCIDatacenterConfigStatus struct{}
+
+// AzureStackHCIDatacenterConfig is the Schema for the AzureStackHCIDatacenterConfigs API
+//
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+type AzureStackHCIDatacenterConfig struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AzureStackHCIDatacenterConfigSpec   `json:"spec,omitempty"`
+	Status AzureStackHCIDatacenterConfigStatus `json:"status,omitempty"`
+}
+
+// Kind returns the kind of the AzureStackHCIDatacenterConfig.
+func (in *AzureStackHCIDatacenterConfig) Kind() string {
+	return in.TypeMeta.Kind
+}
+
+// ExpectedKind returns the kind the AzureStackHCIDatacenterConfig is expected to have.
+func (in *AzureStackHCIDatacenterConfig) ExpectedKind() string {
+	return AzureStackHCIDatacenterKind
+}
+
+// PauseReconcile pauses the reconciliation of the AzureStackHCIDatacenterConfig.
+func (in *AzureStackHCIDatacenterConfig) PauseReconcile() {
+	if in.Annotations == nil {
+		in.Annotations = map[string]string{}
+	}
+	in.Annotations[pausedAnnotation] = "true"
+}
+
+// IsReconcilePaused returns true if the AzureStackHCIDatacenterConfig is paused.
+func (in *AzureStackHCIDatacenterConfig) IsReconcilePaused() bool {
+	if s, ok := in.Annotations[pausedAnnotation]; ok {
+		return s == "true"
+	}
+	return false
+}
+
+// ClearPauseAnnotation removes the pause annotation from the AzureStackHCIDatacenterConfig.
+func (in *AzureStackHCIDatacenterConfig) ClearPauseAnnotation() {
+	if in.Annotations != nil {
+		delete(in.Annotations, pausedAnnotation)
+	}
+}
+
+// ConvertConfigToConfigGenerateStruct converts the AzureStackHCIDatacenterConfig to AzureStackHCIDatacenterConfigGenerate.
+func (in *AzureStackHCIDatacenterConfig) ConvertConfigToConfigGenerateStruct() *AzureStackHCIDatacenterConfigGenerate {
+	namespace := defaultEksaNamespace
+	if in.Namespace != "" {
+		namespace = in.Namespace
+	}
+	config := &AzureStackHCIDatacenterConfigGenerate{
+		TypeMeta: in.TypeMeta,
+		ObjectMeta: ObjectMeta{
+			Name:        in.Name,
+			Annotations: in.Annotations,
+			Namespace:   namespace,
+		},
+		Spec: in.Spec,
+	}
+
+	return config
+}
+
+// Marshallable returns a Marshallable version of the AzureStackHCIDatacenterConfig.
+func (in *AzureStackHCIDatacenterConfig) Marshallable() Marshallable {
+	return in.ConvertConfigToConfigGenerateStruct()
+}
+
+// Validate validates the AzureStackHCIDatacenterConfig.
+func (in *AzureStackHCIDatacenterConfig) Validate() error {
+	if in.Spec.CloudAgentFQDN == "" {
+		return errors.New("AzureStackHCIDatacenterConfig cloudAgentFQDN is not set or is empty")
+	}
+
+	if strings.Contains(in.Spec.CloudAgentFQDN, "://") {
+		return fmt.Errorf("AzureStackHCIDatacenterConfig cloudAgentFQDN %s must be a hostname or IP address without a scheme", in.Spec.CloudAgentFQDN)
+	}
+
+	if in.Spec.Location == "" {
+		return errors.New("AzureStackHCIDatacenterConfig location is not set or is empty")
+	}
+
+	if in.Spec.VirtualNetwork == "" {
+		return errors.New("AzureStackHCIDatacenterConfig virtualNetwork is not set or is empty")
+	}
+
+	return nil
+}
+
+// AzureStackHCIDatacenterConfigGenerate is same as AzureStackHCIDatacenterConfig except stripped down for generation of yaml file during generate clusterconfig
+//
+// +kubebuilder:object:generate=false
+type AzureStackHCIDatacenterConfigGenerate struct {
+	metav1.TypeMeta `json:",inline"`
+	ObjectMeta      `json:"metadata,omitempty"`
+
+	Spec AzureStackHCIDatacenterConfigSpec `json:"spec,omitempty"`
+}
+
+// AzureStackHCIDatacenterConfigList contains a list of AzureStackHCIDatacenterConfig
+//
+// +kubebuilder:object:root=true
+type AzureStackHCIDatacenterConfigList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AzureStackHCIDatacenterConfig `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&AzureStackHCIDatacenterConfig{}, &AzureStackHCIDatacenterConfigList{})
+}
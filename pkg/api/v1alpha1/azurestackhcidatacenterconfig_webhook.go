@@ -0,0 +1,124 @@
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// azurestackhcidatacenterconfiglog is for logging in this package.
+var azurestackhcidatacenterconfiglog = logf.Log.WithName("azurestackhcidatacenterconfig-resource")
+
+// SetupWebhookWithManager sets up the webhook with the manager.
+func (in *AzureStackHCIDatacenterConfig) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(in).
+		WithValidator(in).
+		Complete()
+}
+
+//+kubebuilder:webhook:path=/validate-anywhere-eks-amazonaws-com-v1alpha1-azurestackhcidatacenterconfig,mutating=false,failurePolicy=fail,sideEffects=None,groups=anywhere.eks.amazonaws.com,resources=azurestackhcidatacenterconfigs,verbs=create;update,versions=v1alpha1,name=validation.azurestackhcidatacenterconfig.anywhere.amazonaws.com,admissionReviewVersions={v1,v1beta1}
+
+var _ webhook.CustomValidator = &AzureStackHCIDatacenterConfig{}
+
+// ValidateCreate implements webhook.CustomValidator so a webhook will be registered for the type.
+func (in *AzureStackHCIDatacenterConfig) ValidateCreate(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
+	azurestackhciConfig, ok := obj.(*AzureStackHCIDatacenterConfig)
+	if !ok {
+		return nil, fmt.Errorf("expected a AzureStackHCIDatacenterConfig but got %T", obj)
+	}
+
+	azurestackhcidatacenterconfiglog.Info("validate create", "name", azurestackhciConfig.Name)
+	if azurestackhciConfig.IsReconcilePaused() {
+		azurestackhcidatacenterconfiglog.Info("AzureStackHCIDatacenterConfig is paused, allowing create", "name", azurestackhciConfig.Name)
+		return nil, nil
+	}
+
+	if err := azurestackhciConfig.Validate(); err != nil {
+		return nil, apierrors.NewInvalid(
+			GroupVersion.WithKind(AzureStackHCIDatacenterKind).GroupKind(),
+			azurestackhciConfig.Name,
+			field.ErrorList{
+				field.Invalid(field.NewPath("spec"), azurestackhciConfig.Spec, err.Error()),
+			})
+	}
+
+	return nil, nil
+}
+
+// ValidateUpdate implements webhook.CustomValidator so a webhook will be registered for the type.
+func (in *AzureStackHCIDatacenterConfig) ValidateUpdate(_ context.Context, old, obj runtime.Object) (admission.Warnings, error) {
+	azurestackhciConfig, ok := obj.(*AzureStackHCIDatacenterConfig)
+	if !ok {
+		return nil, fmt.Errorf("expected a AzureStackHCIDatacenterConfig but got %T", obj)
+	}
+
+	azurestackhcidatacenterconfiglog.Info("validate update", "name", azurestackhciConfig.Name)
+	oldDatacenterConfig, ok := old.(*AzureStackHCIDatacenterConfig)
+	if !ok {
+		return nil, apierrors.NewBadRequest(fmt.Sprintf("expected a AzureStackHCIDatacenterConfig but got a %T", old))
+	}
+
+	if oldDatacenterConfig.IsReconcilePaused() {
+		azurestackhcidatacenterconfiglog.Info("AzureStackHCIDatacenterConfig is paused, allowing update", "name", azurestackhciConfig.Name)
+		return nil, nil
+	}
+
+	var allErrs field.ErrorList
+	allErrs = append(allErrs, validateImmutableFieldsAzureStackHCIDatacenterConfig(azurestackhciConfig, oldDatacenterConfig)...)
+
+	if err := azurestackhciConfig.Validate(); err != nil {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("spec"), azurestackhciConfig.Spec, err.Error()))
+	}
+
+	if len(allErrs) > 0 {
+		return nil, apierrors.NewInvalid(
+			GroupVersion.WithKind(AzureStackHCIDatacenterKind).GroupKind(),
+			azurestackhciConfig.Name,
+			allErrs)
+	}
+
+	return nil, nil
+}
+
+// ValidateDelete implements webhook.CustomValidator so a webhook will be registered for the type.
+func (in *AzureStackHCIDatacenterConfig) ValidateDelete(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
+	azurestackhciConfig, ok := obj.(*AzureStackHCIDatacenterConfig)
+	if !ok {
+		return nil, fmt.Errorf("expected a AzureStackHCIDatacenterConfig but got %T", obj)
+	}
+
+	azurestackhcidatacenterconfiglog.Info("validate delete", "name", azurestackhciConfig.Name)
+
+	return nil, nil
+}
+
+func validateImmutableFieldsAzureStackHCIDatacenterConfig(new, old *AzureStackHCIDatacenterConfig) field.ErrorList {
+	var allErrs field.ErrorList
+	specPath := field.NewPath("spec")
+
+	if new.Spec.CloudAgentFQDN != old.Spec.CloudAgentFQDN {
+		allErrs = append(allErrs, field.Forbidden(specPath.Child("cloudAgentFQDN"), "field is immutable"))
+	}
+
+	if new.Spec.Location != old.Spec.Location {
+		allErrs = append(allErrs, field.Forbidden(specPath.Child("location"), "field is immutable"))
+	}
+
+	if new.Spec.ResourceGroup != old.Spec.ResourceGroup {
+		allErrs = append(allErrs, field.Forbidden(specPath.Child("resourceGroup"), "field is immutable"))
+	}
+
+	if new.Spec.VirtualNetwork != old.Spec.VirtualNetwork {
+		allErrs = append(allErrs, field.Forbidden(specPath.Child("virtualNetwork"), "field is immutable"))
+	}
+
+	return allErrs
+}
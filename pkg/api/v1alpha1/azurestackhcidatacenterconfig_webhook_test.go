@@ -0,0 +1,111 @@
+package v1alpha1_test
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+)
+
+func TestAzureStackHCIDatacenterConfigValidateCreate(t *testing.T) {
+	g := NewWithT(t)
+	dcConf := validAzureStackHCIDatacenterConfig()
+	g.Expect(dcConf.ValidateCreate(context.Background(), dcConf)).Error().To(Succeed())
+}
+
+func TestAzureStackHCIDatacenterConfigValidateCreateReconcilePaused(t *testing.T) {
+	g := NewWithT(t)
+	dcConf := validAzureStackHCIDatacenterConfig()
+	dcConf.Spec.Location = ""
+	dcConf.PauseReconcile()
+	g.Expect(dcConf.ValidateCreate(context.Background(), dcConf)).Error().To(Succeed())
+}
+
+func TestAzureStackHCIDatacenterConfigValidateCreateInvalid(t *testing.T) {
+	g := NewWithT(t)
+	dcConf := validAzureStackHCIDatacenterConfig()
+	dcConf.Spec.Location = ""
+	g.Expect(dcConf.ValidateCreate(context.Background(), dcConf)).Error().To(MatchError(ContainSubstring("location is not set or is empty")))
+}
+
+func TestAzureStackHCIDatacenterConfigValidateCreateCastFail(t *testing.T) {
+	g := NewWithT(t)
+	dcConf := validAzureStackHCIDatacenterConfig()
+	g.Expect(dcConf.ValidateCreate(context.Background(), &v1alpha1.Cluster{})).Error().To(MatchError(ContainSubstring("expected a AzureStackHCIDatacenterConfig")))
+}
+
+func TestAzureStackHCIDatacenterConfigValidateUpdate(t *testing.T) {
+	tests := []struct {
+		name    string
+		update  func(*v1alpha1.AzureStackHCIDatacenterConfig)
+		wantErr string
+	}{
+		{
+			name:   "mutable storage container",
+			update: func(c *v1alpha1.AzureStackHCIDatacenterConfig) { c.Spec.StorageContainer = "other-container" },
+		},
+		{
+			name: "immutable cloud agent",
+			update: func(c *v1alpha1.AzureStackHCIDatacenterConfig) {
+				c.Spec.CloudAgentFQDN = "other-cloudagent.hci.example.com"
+			},
+			wantErr: "spec.cloudAgentFQDN: Forbidden: field is immutable",
+		},
+		{
+			name:    "immutable location",
+			update:  func(c *v1alpha1.AzureStackHCIDatacenterConfig) { c.Spec.Location = "OtherLocation" },
+			wantErr: "spec.location: Forbidden: field is immutable",
+		},
+		{
+			name:    "immutable resource group",
+			update:  func(c *v1alpha1.AzureStackHCIDatacenterConfig) { c.Spec.ResourceGroup = "other-group" },
+			wantErr: "spec.resourceGroup: Forbidden: field is immutable",
+		},
+		{
+			name:    "immutable virtual network",
+			update:  func(c *v1alpha1.AzureStackHCIDatacenterConfig) { c.Spec.VirtualNetwork = "other-vnet" },
+			wantErr: "spec.virtualNetwork: Forbidden: field is immutable",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			oldConf := validAzureStackHCIDatacenterConfig()
+			newConf := validAzureStackHCIDatacenterConfig()
+			tt.update(newConf)
+
+			_, err := newConf.ValidateUpdate(context.Background(), oldConf, newConf)
+			if tt.wantErr == "" {
+				g.Expect(err).NotTo(HaveOccurred())
+			} else {
+				g.Expect(err).To(MatchError(ContainSubstring(tt.wantErr)))
+			}
+		})
+	}
+}
+
+func TestAzureStackHCIDatacenterConfigValidateUpdateReconcilePaused(t *testing.T) {
+	g := NewWithT(t)
+	oldConf := validAzureStackHCIDatacenterConfig()
+	oldConf.PauseReconcile()
+	newConf := validAzureStackHCIDatacenterConfig()
+	newConf.Spec.Location = "OtherLocation"
+	g.Expect(newConf.ValidateUpdate(context.Background(), oldConf, newConf)).Error().To(Succeed())
+}
+
+func TestAzureStackHCIDatacenterConfigValidateUpdateCastFail(t *testing.T) {
+	g := NewWithT(t)
+	dcConf := validAzureStackHCIDatacenterConfig()
+	g.Expect(dcConf.ValidateUpdate(context.Background(), &v1alpha1.Cluster{}, dcConf)).Error().To(MatchError(ContainSubstring("expected a AzureStackHCIDatacenterConfig")))
+	g.Expect(dcConf.ValidateUpdate(context.Background(), dcConf, &v1alpha1.Cluster{})).Error().To(MatchError(ContainSubstring("expected a AzureStackHCIDatacenterConfig")))
+}
+
+func TestAzureStackHCIDatacenterConfigValidateDelete(t *testing.T) {
+	g := NewWithT(t)
+	dcConf := validAzureStackHCIDatacenterConfig()
+	g.Expect(dcConf.ValidateDelete(context.Background(), dcConf)).Error().To(Succeed())
+	g.Expect(dcConf.ValidateDelete(context.Background(), &v1alpha1.Cluster{})).Error().To(HaveOccurred())
+}
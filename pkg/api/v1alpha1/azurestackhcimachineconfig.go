@@ -0,0 +1,103 @@
+package v1alpha1
+
+import (
+	"errors"
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// AzureStackHCIMachineConfigKind is the kind for an AzureStackHCIMachineConfig.
+	AzureStackHCIMachineConfigKind = "AzureStackHCIMachineConfig"
+
+	// DefaultAzureStackHCIMachineConfigUser is the default username we set in machine config.
+	DefaultAzureStackHCIMachineConfigUser string = "eksa"
+
+	defaultAzureStackHCIOSFamily = Ubuntu
+
+	minAzureStackHCIOSDiskSizeGiB = 30
+)
+
+// NewAzureStackHCIMachineConfigGenerate returns a new instance of AzureStackHCIMachineConfigGenerate
+// used for generating yaml for generate clusterconfig command.
+func NewAzureStackHCIMachineConfigGenerate(name string) *AzureStackHCIMachineConfigGenerate {
+	return &AzureStackHCIMachineConfigGenerate{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       AzureStackHCIMachineConfigKind,
+			APIVersion: SchemeBuilder.GroupVersion.String(),
+		},
+		ObjectMeta: ObjectMeta{
+			Name: name,
+		},
+		Spec: AzureStackHCIMachineConfigSpec{
+			OSFamily: defaultAzureStackHCIOSFamily,
+			Users: []UserConfiguration{
+				{
+					Name:              DefaultAzureStackHCIMachineConfigUser,
+					SshAuthorizedKeys: []string{"ssh-rsa AAAA..."},
+				},
+			},
+			VMSize: "Standard_A4_v2",
+			Image:  "<enter gallery image name here>",
+		},
+	}
+}
+
+func (c *AzureStackHCIMachineConfigGenerate) APIVersion() string {
+	return c.TypeMeta.APIVersion
+}
+
+func (c *AzureStackHCIMachineConfigGenerate) Kind() string {
+	return c.TypeMeta.Kind
+}
+
+func (c *AzureStackHCIMachineConfigGenerate) Name() string {
+	return c.ObjectMeta.Name
+}
+
+func setAzureStackHCIMachineConfigDefaults(machineConfig *AzureStackHCIMachineConfig) {
+	if len(machineConfig.Spec.Users) == 0 {
+		machineConfig.Spec.Users = []UserConfiguration{{}}
+	}
+
+	if machineConfig.Spec.Users[0].Name == "" {
+		machineConfig.Spec.Users[0].Name = DefaultAzureStackHCIMachineConfigUser
+	}
+
+	if len(machineConfig.Spec.Users[0].SshAuthorizedKeys) == 0 {
+		machineConfig.Spec.Users[0].SshAuthorizedKeys = []string{""}
+	}
+
+	if machineConfig.Spec.OSFamily == "" {
+		machineConfig.Spec.OSFamily = defaultAzureStackHCIOSFamily
+	}
+}
+
+func validateAzureStackHCIMachineConfig(c *AzureStackHCIMachineConfig) error {
+	if err := validateObjectMeta(c.ObjectMeta); err != nil {
+		return fmt.Errorf("AzureStackHCIMachineConfig: %v", err)
+	}
+
+	if c.Spec.OSFamily != Ubuntu {
+		return fmt.Errorf("AzureStackHCIMachineConfig: unsupported spec.osFamily (%v); Please use one of the following: %s", c.Spec.OSFamily, Ubuntu)
+	}
+
+	if c.Spec.VMSize == "" {
+		return errors.New("AzureStackHCIMachineConfig: vmSize is not set or is empty")
+	}
+
+	if c.Spec.Image == "" {
+		return errors.New("AzureStackHCIMachineConfig: image is not set or is empty")
+	}
+
+	if c.Spec.OSDiskSizeGiB != 0 && c.Spec.OSDiskSizeGiB < minAzureStackHCIOSDiskSizeGiB {
+		return fmt.Errorf("AzureStackHCIMachineConfig: osDiskSizeGiB must be greater than or equal to %d", minAzureStackHCIOSDiskSizeGiB)
+	}
+
+	if len(c.Spec.Users) == 0 || c.Spec.Users[0].Name == "" {
+		return fmt.Errorf("AzureStackHCIMachineConfig: users[0].name is not set or is empty for %s", c.Name)
+	}
+
+	return nil
+}
@@ -0,0 +1,91 @@
+package v1alpha1_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+)
+
+func TestAzureStackHCIMachineConfigSetDefaults(t *testing.T) {
+	g := NewWithT(t)
+	config := &v1alpha1.AzureStackHCIMachineConfig{}
+	config.SetDefaults()
+
+	g.Expect(config.Spec).To(Equal(v1alpha1.AzureStackHCIMachineConfigSpec{
+		OSFamily: v1alpha1.Ubuntu,
+		Users: []v1alpha1.UserConfiguration{
+			{Name: v1alpha1.DefaultAzureStackHCIMachineConfigUser, SshAuthorizedKeys: []string{""}},
+		},
+	}))
+}
+
+func TestAzureStackHCIMachineConfigValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		update  func(*v1alpha1.AzureStackHCIMachineConfig)
+		wantErr string
+	}{
+		{
+			name:   "valid",
+			update: func(*v1alpha1.AzureStackHCIMachineConfig) {},
+		},
+		{
+			name:   "os disk size",
+			update: func(c *v1alpha1.AzureStackHCIMachineConfig) { c.Spec.OSDiskSizeGiB = 100 },
+		},
+		{
+			name:    "unsupported os family",
+			update:  func(c *v1alpha1.AzureStackHCIMachineConfig) { c.Spec.OSFamily = v1alpha1.Bottlerocket },
+			wantErr: "AzureStackHCIMachineConfig: unsupported spec.osFamily (bottlerocket); Please use one of the following: ubuntu",
+		},
+		{
+			name:    "empty vm size",
+			update:  func(c *v1alpha1.AzureStackHCIMachineConfig) { c.Spec.VMSize = "" },
+			wantErr: "AzureStackHCIMachineConfig: vmSize is not set or is empty",
+		},
+		{
+			name:    "empty image",
+			update:  func(c *v1alpha1.AzureStackHCIMachineConfig) { c.Spec.Image = "" },
+			wantErr: "AzureStackHCIMachineConfig: image is not set or is empty",
+		},
+		{
+			name:    "os disk too small",
+			update:  func(c *v1alpha1.AzureStackHCIMachineConfig) { c.Spec.OSDiskSizeGiB = 10 },
+			wantErr: "AzureStackHCIMachineConfig: osDiskSizeGiB must be greater than or equal to 30",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			config := &v1alpha1.AzureStackHCIMachineConfig{
+				Spec: v1alpha1.AzureStackHCIMachineConfigSpec{
+					VMSize: "Standard_A4_v2",
+					Image:  "ubuntu-2204-kube-v1.31",
+				},
+			}
+			config.Name = "test"
+			config.SetDefaults()
+			tt.update(config)
+
+			err := config.Validate()
+			if tt.wantErr == "" {
+				g.Expect(err).NotTo(HaveOccurred())
+			} else {
+				g.Expect(err).To(MatchError(tt.wantErr))
+			}
+		})
+	}
+}
+
+func TestNewAzureStackHCIMachineConfigGenerate(t *testing.T) {
+	g := NewWithT(t)
+	config := v1alpha1.NewAzureStackHCIMachineConfigGenerate("test-cp")
+
+	g.Expect(config.Kind()).To(Equal(v1alpha1.AzureStackHCIMachineConfigKind))
+	g.Expect(config.Name()).To(Equal("test-cp"))
+	g.Expect(config.Spec.OSFamily).To(Equal(v1alpha1.Ubuntu))
+	g.Expect(config.Spec.Users[0].Name).To(Equal(v1alpha1.DefaultAzureStackHCIMachineConfigUser))
+}
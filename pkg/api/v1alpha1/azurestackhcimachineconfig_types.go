@@ -0,0 +1,184 @@
+// Important: Run "make generate" to regenerate code after modifying this file
+// json tags are required; new fields must have json tags for the fields to be serialized
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// AzureStackHCIMachineConfigSpec defines the desired state of AzureStackHCIMachineConfig.
+type AzureStackHCIMachineConfigSpec struct {
+	OSFamily OSFamily            `json:"osFamily"`
+	Users    []UserConfiguration `json:"users,omitempty"`
+
+	// VMSize is the Azure Stack HCI VM size the cluster VMs are created with, e.g. Standard_A4_v2.
+	// +kubebuilder:validation:Required
+	VMSize string `json:"vmSize"`
+
+	// Image is the name of the gallery image the VMs are created from.
+	// The image must be built for the cluster's Kubernetes version.
+	// +kubebuilder:validation:Required
+	Image string `json:"image"`
+
+	// OSDiskSizeGiB is the size of the OS disk in GiB. If not set, the size of the image disk is used.
+	// +optional
+	OSDiskSizeGiB int32 `json:"osDiskSizeGiB,omitempty"`
+}
+
+// SetDefaults sets defaults to AzureStackHCIMachineConfig if user has not provided.
+func (in *AzureStackHCIMachineConfig) SetDefaults() {
+	setAzureStackHCIMachineConfigDefaults(in)
+}
+
+// PauseReconcile pauses the reconciliation of the AzureStackHCIMachineConfig.
+func (in *AzureStackHCIMachineConfig) PauseReconcile() {
+	if in.Annotations == nil {
+		in.Annotations = map[string]string{}
+	}
+	in.Annotations[pausedAnnotation] = "true"
+}
+
+// IsReconcilePaused returns true if the AzureStackHCIMachineConfig is paused.
+func (in *AzureStackHCIMachineConfig) IsReconcilePaused() bool {
+	if s, ok := in.Annotations[pausedAnnotation]; ok {
+		return s == "true"
+	}
+	return false
+}
+
+// SetControlPlane sets the AzureStackHCIMachineConfig as a control plane node.
+func (in *AzureStackHCIMachineConfig) SetControlPlane() {
+	if in.Annotations == nil {
+		in.Annotations = map[string]string{}
+	}
+	in.Annotations[controlPlaneAnnotation] = "true"
+}
+
+// IsControlPlane returns true if the AzureStackHCIMachineConfig is a control plane node.
+func (in *AzureStackHCIMachineConfig) IsControlPlane() bool {
+	if s, ok := in.Annotations[controlPlaneAnnotation]; ok {
+		return s == "true"
+	}
+	return false
+}
+
+// SetEtcd sets the AzureStackHCIMachineConfig as an etcd node.
+func (in *AzureStackHCIMachineConfig) SetEtcd() {
+	if in.Annotations == nil {
+		in.Annotations = map[string]string{}
+	}
+	in.Annotations[etcdAnnotation] = "true"
+}
+
+// IsEtcd returns true if the AzureStackHCIMachineConfig is an etcd node.
+func (in *AzureStackHCIMachineConfig) IsEtcd() bool {
+	if s, ok := in.Annotations[etcdAnnotation]; ok {
+		return s == "true"
+	}
+	return false
+}
+
+// SetManagedBy sets the cluster name that manages the AzureStackHCIMachineConfig.
+func (in *AzureStackHCIMachineConfig) SetManagedBy(clusterName string) {
+	if in.Annotations == nil {
+		in.Annotations = map[string]string{}
+	}
+	in.Annotations[managementAnnotation] = clusterName
+}
+
+// IsManaged returns true if the AzureStackHCIMachineConfig is managed by a cluster.
+func (in *AzureStackHCIMachineConfig) IsManaged() bool {
+	if s, ok := in.Annotations[managementAnnotation]; ok {
+		return s != ""
+	}
+	return false
+}
+
+// OSFamily returns the OSFamily of the AzureStackHCIMachineConfig.
+func (in *AzureStackHCIMachineConfig) OSFamily() OSFamily {
+	return in.Spec.OSFamily
+}
+
+// Users returns a list of configuration for OS users.
+func (in *AzureStackHCIMachineConfig) Users() []UserConfiguration {
+	return in.Spec.Users
+}
+
+// GetNamespace returns the namespace of the AzureStackHCIMachineConfig.
+func (in *AzureStackHCIMachineConfig) GetNamespace() string {
+	return in.Namespace
+}
+
+// GetName returns the name of the AzureStackHCIMachineConfig.
+func (in *AzureStackHCIMachineConfig) GetName() string {
+	return in.Name
+}
+
+// AzureStackHCIMachineConfigStatus defines the observed state of AzureStackHCIMachineConfig.
+type AzureStackHCIMachineConfigStatus struct{}
+
+// AzureStackHCIMachineConfig is the Schema for the azure stack hci machine configs API
+//
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+type AzureStackHCIMachineConfig struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AzureStackHCIMachineConfigSpec   `json:"spec,omitempty"`
+	Status AzureStackHCIMachineConfigStatus `json:"status,omitempty"`
+}
+
+// ConvertConfigToConfigGenerateStruct converts the AzureStackHCIMachineConfig to AzureStackHCIMachineConfigGenerate.
+func (in *AzureStackHCIMachineConfig) ConvertConfigToConfigGenerateStruct() *AzureStackHCIMachineConfigGenerate {
+	namespace := defaultEksaNamespace
+	if in.Namespace != "" {
+		namespace = in.Namespace
+	}
+	config := &AzureStackHCIMachineConfigGenerate{
+		TypeMeta: in.TypeMeta,
+		ObjectMeta: ObjectMeta{
+			Name:        in.Name,
+			Annotations: in.Annotations,
+			Namespace:   namespace,
+		},
+		Spec: in.Spec,
+	}
+
+	return config
+}
+
+// Marshallable returns a Marshallable version of the AzureStackHCIMachineConfig.
+func (in *AzureStackHCIMachineConfig) Marshallable() Marshallable {
+	return in.ConvertConfigToConfigGenerateStruct()
+}
+
+// Validate validates the AzureStackHCIMachineConfig.
+func (in *AzureStackHCIMachineConfig) Validate() error {
+	return validateAzureStackHCIMachineConfig(in)
+}
+
+// AzureStackHCIMachineConfigGenerate is same as AzureStackHCIMachineConfig except stripped down for generation of yaml file during
+// generate clusterconfig
+//
+// +kubebuilder:object:generate=false
+type AzureStackHCIMachineConfigGenerate struct {
+	metav1.TypeMeta `json:",inline"`
+	ObjectMeta      `json:"metadata,omitempty"`
+
+	Spec AzureStackHCIMachineConfigSpec `json:"spec,omitempty"`
+}
+
+// AzureStackHCIMachineConfigList contains a list of AzureStackHCIMachineConfig
+//
+// +kubebuilder:object:root=true
+type AzureStackHCIMachineConfigList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AzureStackHCIMachineConfig `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&AzureStackHCIMachineConfig{}, &AzureStackHCIMachineConfigList{})
+}
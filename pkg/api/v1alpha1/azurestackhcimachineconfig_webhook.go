@@ -0,0 +1,127 @@
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// azurestackhcimachineconfiglog is for logging in this package.
+var azurestackhcimachineconfiglog = logf.Log.WithName("azurestackhcimachineconfig-resource")
+
+// SetupWebhookWithManager sets up and registers the webhook with the manager.
+func (in *AzureStackHCIMachineConfig) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(in).
+		WithValidator(in).
+		Complete()
+}
+
+//+kubebuilder:webhook:path=/validate-anywhere-eks-amazonaws-com-v1alpha1-azurestackhcimachineconfig,mutating=false,failurePolicy=fail,sideEffects=None,groups=anywhere.eks.amazonaws.com,resources=azurestackhcimachineconfigs,verbs=create;update,versions=v1alpha1,name=validation.azurestackhcimachineconfig.anywhere.amazonaws.com,admissionReviewVersions={v1,v1beta1}
+
+var _ webhook.CustomValidator = &AzureStackHCIMachineConfig{}
+
+// ValidateCreate implements webhook.CustomValidator so a webhook will be registered for the type.
+func (in *AzureStackHCIMachineConfig) ValidateCreate(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
+	azurestackhciConfig, ok := obj.(*AzureStackHCIMachineConfig)
+	if !ok {
+		return nil, fmt.Errorf("expected a AzureStackHCIMachineConfig but got %T", obj)
+	}
+
+	azurestackhcimachineconfiglog.Info("validate create", "name", azurestackhciConfig.Name)
+	if err := azurestackhciConfig.Validate(); err != nil {
+		return nil, apierrors.NewInvalid(
+			GroupVersion.WithKind(AzureStackHCIMachineConfigKind).GroupKind(),
+			azurestackhciConfig.Name,
+			field.ErrorList{
+				field.Invalid(field.NewPath("spec"), azurestackhciConfig.Spec, err.Error()),
+			},
+		)
+	}
+
+	return nil, nil
+}
+
+// ValidateUpdate implements webhook.CustomValidator so a webhook will be registered for the type.
+func (in *AzureStackHCIMachineConfig) ValidateUpdate(_ context.Context, old, obj runtime.Object) (admission.Warnings, error) {
+	azurestackhciConfig, ok := obj.(*AzureStackHCIMachineConfig)
+	if !ok {
+		return nil, fmt.Errorf("expected a AzureStackHCIMachineConfig but got %T", obj)
+	}
+
+	azurestackhcimachineconfiglog.Info("validate update", "name", azurestackhciConfig.Name)
+
+	oldAzureStackHCIMachineConfig, ok := old.(*AzureStackHCIMachineConfig)
+	if !ok {
+		return nil, apierrors.NewBadRequest(fmt.Sprintf("expected a AzureStackHCIMachineConfig but got a %T", old))
+	}
+
+	var allErrs field.ErrorList
+	allErrs = append(allErrs, validateImmutableFieldsAzureStackHCIMachineConfig(azurestackhciConfig, oldAzureStackHCIMachineConfig)...)
+
+	if err := azurestackhciConfig.Validate(); err != nil {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("spec"), azurestackhciConfig.Spec, err.Error()))
+	}
+
+	if len(allErrs) > 0 {
+		return nil, apierrors.NewInvalid(
+			GroupVersion.WithKind(AzureStackHCIMachineConfigKind).GroupKind(),
+			azurestackhciConfig.Name,
+			allErrs,
+		)
+	}
+
+	return nil, nil
+}
+
+// ValidateDelete implements webhook.CustomValidator so a webhook will be registered for the type.
+func (in *AzureStackHCIMachineConfig) ValidateDelete(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
+	azurestackhciConfig, ok := obj.(*AzureStackHCIMachineConfig)
+	if !ok {
+		return nil, fmt.Errorf("expected a AzureStackHCIMachineConfig but got %T", obj)
+	}
+
+	azurestackhcimachineconfiglog.Info("validate delete", "name", azurestackhciConfig.Name)
+
+	return nil, nil
+}
+
+func validateImmutableFieldsAzureStackHCIMachineConfig(new, old *AzureStackHCIMachineConfig) field.ErrorList {
+	if old.IsReconcilePaused() {
+		azurestackhcimachineconfiglog.Info("Reconciliation is paused")
+		return nil
+	}
+
+	var allErrs field.ErrorList
+	specPath := field.NewPath("spec")
+
+	if new.Spec.OSFamily != old.Spec.OSFamily {
+		allErrs = append(allErrs, field.Forbidden(specPath.Child("osFamily"), "field is immutable"))
+	}
+
+	if old.IsManaged() {
+		azurestackhcimachineconfiglog.Info("Machine config is associated with workload cluster", "name", old.Name)
+		return allErrs
+	}
+
+	if !old.IsEtcd() && !old.IsControlPlane() {
+		azurestackhcimachineconfiglog.Info("Machine config is associated with management cluster's worker nodes", "name", old.Name)
+		return allErrs
+	}
+
+	azurestackhcimachineconfiglog.Info("Machine config is associated with management cluster's control plane or etcd", "name", old.Name)
+
+	if !reflect.DeepEqual(new.Spec.Users, old.Spec.Users) {
+		allErrs = append(allErrs, field.Forbidden(specPath.Child("users"), "field is immutable"))
+	}
+
+	return allErrs
+}
@@ -0,0 +1,129 @@
+package v1alpha1_test
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+)
+
+func azurestackhciMachineConfig() *v1alpha1.AzureStackHCIMachineConfig {
+	config := &v1alpha1.AzureStackHCIMachineConfig{
+		Spec: v1alpha1.AzureStackHCIMachineConfigSpec{
+			VMSize: "Standard_A4_v2",
+			Image:  "ubuntu-2204-kube-v1.31",
+		},
+	}
+	config.Name = "test"
+	config.SetDefaults()
+	return config
+}
+
+func TestAzureStackHCIMachineConfigValidateCreate(t *testing.T) {
+	g := NewWithT(t)
+	config := azurestackhciMachineConfig()
+	g.Expect(config.ValidateCreate(context.Background(), config)).Error().To(Succeed())
+}
+
+func TestAzureStackHCIMachineConfigValidateCreateInvalid(t *testing.T) {
+	g := NewWithT(t)
+	config := azurestackhciMachineConfig()
+	config.Spec.VMSize = ""
+	g.Expect(config.ValidateCreate(context.Background(), config)).Error().To(MatchError(ContainSubstring("vmSize is not set or is empty")))
+}
+
+func TestAzureStackHCIMachineConfigValidateCreateCastFail(t *testing.T) {
+	g := NewWithT(t)
+	config := azurestackhciMachineConfig()
+	g.Expect(config.ValidateCreate(context.Background(), &v1alpha1.Cluster{})).Error().To(MatchError(ContainSubstring("expected a AzureStackHCIMachineConfig")))
+}
+
+func TestAzureStackHCIMachineConfigValidateUpdate(t *testing.T) {
+	tests := []struct {
+		name    string
+		old     func(*v1alpha1.AzureStackHCIMachineConfig)
+		update  func(*v1alpha1.AzureStackHCIMachineConfig)
+		wantErr string
+	}{
+		{
+			name: "mutable image and vm size",
+			old:  func(*v1alpha1.AzureStackHCIMachineConfig) {},
+			update: func(c *v1alpha1.AzureStackHCIMachineConfig) {
+				c.Spec.Image = "ubuntu-2204-kube-v1.32"
+				c.Spec.VMSize = "Standard_A8_v2"
+			},
+		},
+		{
+			name:    "immutable os family",
+			old:     func(*v1alpha1.AzureStackHCIMachineConfig) {},
+			update:  func(c *v1alpha1.AzureStackHCIMachineConfig) { c.Spec.OSFamily = v1alpha1.Bottlerocket },
+			wantErr: "spec.osFamily: Forbidden: field is immutable",
+		},
+		{
+			name:    "immutable users of management control plane",
+			old:     func(c *v1alpha1.AzureStackHCIMachineConfig) { c.SetControlPlane() },
+			update:  func(c *v1alpha1.AzureStackHCIMachineConfig) { c.Spec.Users[0].Name = "admin" },
+			wantErr: "spec.users: Forbidden: field is immutable",
+		},
+		{
+			name: "mutable users of workload control plane",
+			old: func(c *v1alpha1.AzureStackHCIMachineConfig) {
+				c.SetControlPlane()
+				c.SetManagedBy("mgmt")
+			},
+			update: func(c *v1alpha1.AzureStackHCIMachineConfig) { c.Spec.Users[0].Name = "admin" },
+		},
+		{
+			name:   "mutable users of management workers",
+			old:    func(*v1alpha1.AzureStackHCIMachineConfig) {},
+			update: func(c *v1alpha1.AzureStackHCIMachineConfig) { c.Spec.Users[0].Name = "admin" },
+		},
+		{
+			name: "paused",
+			old: func(c *v1alpha1.AzureStackHCIMachineConfig) {
+				c.SetControlPlane()
+				c.PauseReconcile()
+			},
+			update: func(c *v1alpha1.AzureStackHCIMachineConfig) { c.Spec.Users[0].Name = "admin" },
+		},
+		{
+			name:    "invalid spec",
+			old:     func(*v1alpha1.AzureStackHCIMachineConfig) {},
+			update:  func(c *v1alpha1.AzureStackHCIMachineConfig) { c.Spec.Image = "" },
+			wantErr: "image is not set or is empty",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			oldConf := azurestackhciMachineConfig()
+			tt.old(oldConf)
+			newConf := oldConf.DeepCopy()
+			tt.update(newConf)
+
+			_, err := newConf.ValidateUpdate(context.Background(), oldConf, newConf)
+			if tt.wantErr == "" {
+				g.Expect(err).NotTo(HaveOccurred())
+			} else {
+				g.Expect(err).To(MatchError(ContainSubstring(tt.wantErr)))
+			}
+		})
+	}
+}
+
+func TestAzureStackHCIMachineConfigValidateUpdateCastFail(t *testing.T) {
+	g := NewWithT(t)
+	config := azurestackhciMachineConfig()
+	g.Expect(config.ValidateUpdate(context.Background(), &v1alpha1.Cluster{}, config)).Error().To(MatchError(ContainSubstring("expected a AzureStackHCIMachineConfig")))
+	g.Expect(config.ValidateUpdate(context.Background(), config, &v1alpha1.Cluster{})).Error().To(MatchError(ContainSubstring("expected a AzureStackHCIMachineConfig")))
+}
+
+func TestAzureStackHCIMachineConfigValidateDelete(t *testing.T) {
+	g := NewWithT(t)
+	config := azurestackhciMachineConfig()
+	g.Expect(config.ValidateDelete(context.Background(), config)).Error().To(Succeed())
+	g.Expect(config.ValidateDelete(context.Background(), &v1alpha1.Cluster{})).Error().To(HaveOccurred())
+}
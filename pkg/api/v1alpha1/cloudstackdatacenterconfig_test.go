@@ -206,6 +206,13 @@ func TestCloudStackDatacenterConfigSpecNotEqualEndpoint(t *testing.T) {
 	g.Expect(cloudStackDatacenterConfigSpec1.Equal(cloudStackDatacenterConfigSpec2)).To(BeFalse(), "ManagementApiEndpoint comparison in CloudStackDatacenterConfigSpec not detected")
 }
 
+func TestCloudStackDatacenterConfigSpecNotEqualResourceTags(t *testing.T) {
+	g := NewWithT(t)
+	cloudStackDatacenterConfigSpec2 := cloudStackDatacenterConfigSpec1.DeepCopy()
+	cloudStackDatacenterConfigSpec2.ResourceTags = map[string]string{"env": "prod"}
+	g.Expect(cloudStackDatacenterConfigSpec1.Equal(cloudStackDatacenterConfigSpec2)).To(BeFalse(), "ResourceTags comparison in CloudStackDatacenterConfigSpec not detected")
+}
+
 func TestCloudStackDatacenterConfigSpecNotEqualDomain(t *testing.T) {
 	g := NewWithT(t)
 	cloudStackDatacenterConfigSpec2 := cloudStackDatacenterConfigSpec1.DeepCopy()
@@ -278,6 +285,13 @@ func TestCloudStackDatacenterConfigSpecNotEqualAvailabilityZonesDomain(t *testin
 	g.Expect(cloudStackDatacenterConfigSpec1.Equal(cloudStackDatacenterConfigSpec2)).To(BeFalse(), "AvailabilityZones comparison in CloudStackDatacenterConfigSpec not detected")
 }
 
+func TestCloudStackDatacenterConfigSpecNotEqualAvailabilityZonesProject(t *testing.T) {
+	g := NewWithT(t)
+	cloudStackDatacenterConfigSpec2 := cloudStackDatacenterConfigSpecAzs.DeepCopy()
+	cloudStackDatacenterConfigSpec2.AvailabilityZones[0].Project = "fake-project"
+	g.Expect(cloudStackDatacenterConfigSpec1.Equal(cloudStackDatacenterConfigSpec2)).To(BeFalse(), "AvailabilityZones comparison in CloudStackDatacenterConfigSpec not detected")
+}
+
 func TestCloudStackDatacenterConfigSetDefaults(t *testing.T) {
 	g := NewWithT(t)
 	cloudStackDatacenterConfig := CloudStackDatacenterConfig{
@@ -364,6 +378,20 @@ func TestCloudStackDatacenterConfigValidate(t *testing.T) {
 			}),
 			wantErr: "checking management api endpoint: :1234.5234 is not a valid url",
 		},
+		{
+			name: "valid resource tags",
+			obj: cloudStackDatacenterConfig(func(c *CloudStackDatacenterConfig) {
+				c.Spec.ResourceTags = map[string]string{"env": "prod"}
+			}),
+			wantErr: "",
+		},
+		{
+			name: "invalid resource tags empty key",
+			obj: cloudStackDatacenterConfig(func(c *CloudStackDatacenterConfig) {
+				c.Spec.ResourceTags = map[string]string{" ": "prod"}
+			}),
+			wantErr: "resourceTags keys must not be empty",
+		},
 	}
 
 	for _, tt := range tests {
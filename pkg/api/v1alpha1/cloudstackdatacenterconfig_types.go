@@ -16,6 +16,7 @@ package v1alpha1
 
 import (
 	"fmt"
+	"maps"
 	"strings"
 
 	"github.com/pkg/errors"
@@ -53,6 +54,9 @@ type CloudStackDatacenterConfigSpec struct {
 	ManagementApiEndpoint string `json:"managementApiEndpoint,omitempty"`
 	// AvailabilityZones list of different partitions to distribute VMs across - corresponds to a list of CAPI failure domains
 	AvailabilityZones []CloudStackAvailabilityZone `json:"availabilityZones,omitempty"`
+	// ResourceTags are the key/value pairs added as tags to all the virtual machines created for the cluster.
+	// +optional
+	ResourceTags map[string]string `json:"resourceTags,omitempty"`
 }
 
 type CloudStackResourceIdentifier struct {
@@ -101,6 +105,10 @@ type CloudStackAvailabilityZone struct {
 	Domain string `json:"domain"`
 	// Account typically represents a customer of the service provider or a department in a large organization. Multiple users can exist in an account, and all CloudStack resources belong to an account. Accounts have users and users have credentials to operate on resources within that account. If an account name is provided, a domain must also be provided.
 	Account string `json:"account,omitempty"`
+	// Project is the name of the CloudStack project in which the VMs, networks and affinity groups for this availability zone are created.
+	// The account, or the user owning the credentials if no account is provided, must be a member of the project.
+	// +optional
+	Project string `json:"project,omitempty"`
 	// CloudStack Management API endpoint's IP. It is added to VM's noproxy list
 	ManagementApiEndpoint string `json:"managementApiEndpoint"`
 }
@@ -217,6 +225,12 @@ func (v *CloudStackDatacenterConfig) Validate() error {
 		}
 	}
 
+	for key := range v.Spec.ResourceTags {
+		if strings.TrimSpace(key) == "" {
+			return errors.New("resourceTags keys must not be empty")
+		}
+	}
+
 	return nil
 }
 
@@ -271,7 +285,8 @@ func (s *CloudStackDatacenterConfigSpec) Equal(o *CloudStackDatacenterConfigSpec
 	}
 	return s.ManagementApiEndpoint == o.ManagementApiEndpoint &&
 		s.Domain == o.Domain &&
-		s.Account == o.Account
+		s.Account == o.Account &&
+		maps.Equal(s.ResourceTags, o.ResourceTags)
 }
 
 func (z *CloudStackZone) Equal(o *CloudStackZone) bool {
@@ -301,6 +316,7 @@ func (az *CloudStackAvailabilityZone) Equal(o *CloudStackAvailabilityZone) bool
 		az.Name == o.Name &&
 		az.CredentialsRef == o.CredentialsRef &&
 		az.Account == o.Account &&
+		az.Project == o.Project &&
 		az.Domain == o.Domain &&
 		az.ManagementApiEndpoint == o.ManagementApiEndpoint
 }
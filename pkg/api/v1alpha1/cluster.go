@@ -11,13 +11,16 @@ import (
 	"net/url"
 	"os"
 	"regexp"
+	"slices"
 	"strconv"
 	"strings"
 
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/validation"
 	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	utilvalidation "k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	yamlutil "k8s.io/apimachinery/pkg/util/yaml"
 	"k8s.io/kubelet/config/v1beta1"
@@ -173,6 +176,9 @@ func WithEtcdMachineGroupRef(ref ProviderRefAccessor) ClusterGenerateOpt {
 	}
 }
 
+// maxMachineNamingTemplateLength is the maximum length of the Cluster API machine naming templates.
+const maxMachineNamingTemplateLength = 256
+
 var clusterConfigValidations = []func(*Cluster) error{
 	validateClusterConfigName,
 	validateControlPlaneEndpoint,
@@ -194,9 +200,21 @@ var clusterConfigValidations = []func(*Cluster) error{
 	validateControlPlaneCertSANs,
 	validateControlPlaneAPIServerExtraArgs,
 	validateControlPlaneAPIServerOIDCExtraArgs,
+	validateControlPlaneComponentExtraArgs,
 	validateControlPlaneKubeletConfiguration,
 	validateWorkerNodeKubeletConfiguration,
 	validateAuditPolicyContent,
+	validateAuditLog,
+	validatePodSecurityAdmission,
+	validateNodeOSConfiguration,
+	validateExternalDNS,
+	validateDefaultStorageClass,
+	validateServiceLoadBalancer,
+	validateDNS,
+	validateGPU,
+	validateMachineNaming,
+	validateKonnectivity,
+	validateSpire,
 }
 
 // GetClusterConfig parses a Cluster object from a multiobject yaml file in disk
@@ -392,6 +410,43 @@ func (c *Cluster) IsReconcilePaused() bool {
 	return false
 }
 
+// IsFrozen returns true if the cluster has the frozen annotation.
+func (c *Cluster) IsFrozen() bool {
+	_, ok := c.Annotations[FrozenAnnotation]
+	return ok
+}
+
+// FrozenReason returns the reason the cluster was frozen for, if any.
+func (c *Cluster) FrozenReason() string {
+	return c.Annotations[FrozenAnnotation]
+}
+
+// FrozenBy returns the user that froze the cluster, if known.
+func (c *Cluster) FrozenBy() string {
+	return c.Annotations[FrozenByAnnotation]
+}
+
+// setFrozenBy records who froze the cluster, ignoring any value already set on it. If old, the
+// cluster before the change, was already frozen, its record is kept; otherwise user is recorded as
+// the one that froze the cluster. The record is cleared when the cluster isn't frozen.
+func (c *Cluster) setFrozenBy(old *Cluster, user string) {
+	if !c.IsFrozen() {
+		delete(c.Annotations, FrozenByAnnotation)
+		return
+	}
+
+	if old != nil && old.IsFrozen() {
+		user = old.FrozenBy()
+	}
+
+	if user == "" {
+		delete(c.Annotations, FrozenByAnnotation)
+		return
+	}
+
+	c.Annotations[FrozenByAnnotation] = user
+}
+
 func ValidateClusterName(clusterName string) error {
 	// this regex will not work for AWS provider as CFN has restrictions with UPPERCASE chars;
 	// if you are using AWS provider please use only lowercase chars
@@ -494,6 +549,262 @@ func validateControlPlaneEndpoint(clusterConfig *Cluster) error {
 	if (clusterConfig.Spec.ControlPlaneConfiguration.Endpoint == nil || len(clusterConfig.Spec.ControlPlaneConfiguration.Endpoint.Host) <= 0) && clusterConfig.Spec.DatacenterRef.Kind != DockerDatacenterKind {
 		return errors.New("cluster controlPlaneConfiguration.Endpoint.Host is not set or is empty")
 	}
+	if clusterConfig.Spec.ControlPlaneConfiguration.Endpoint != nil && clusterConfig.Spec.ControlPlaneConfiguration.Endpoint.DNS != nil {
+		if err := validateControlPlaneEndpointDNS(clusterConfig.Spec.ControlPlaneConfiguration.Endpoint); err != nil {
+			return fmt.Errorf("cluster controlPlaneConfiguration.Endpoint.DNS is invalid: %v", err)
+		}
+	}
+	return nil
+}
+
+func validateExternalDNS(clusterConfig *Cluster) error {
+	externalDNS := clusterConfig.Spec.ExternalDNS
+	if externalDNS == nil {
+		return nil
+	}
+
+	switch externalDNS.Provider {
+	case Route53DNSProvider, InfobloxDNSProvider:
+	default:
+		return fmt.Errorf("cluster externalDns.provider %s is not supported, please use one of the following: %s, %s", externalDNS.Provider, Route53DNSProvider, InfobloxDNSProvider)
+	}
+
+	for _, domain := range externalDNS.DomainFilters {
+		if errs := utilvalidation.IsDNS1123Subdomain(domain); len(errs) > 0 {
+			return fmt.Errorf("cluster externalDns.domainFilters %s is not a valid domain: %s", domain, strings.Join(errs, ", "))
+		}
+	}
+
+	if externalDNS.CredentialsSecretName == "" {
+		return errors.New("cluster externalDns.credentialsSecretName is required")
+	}
+
+	return nil
+}
+
+func validateDefaultStorageClass(clusterConfig *Cluster) error {
+	storageClass := clusterConfig.Spec.DefaultStorageClass
+	if storageClass == nil {
+		return nil
+	}
+
+	if storageClass.Provisioner == "" {
+		return errors.New("cluster defaultStorageClass.provisioner is required")
+	}
+	if errs := utilvalidation.IsDNS1123Subdomain(storageClass.Provisioner); len(errs) > 0 {
+		return fmt.Errorf("cluster defaultStorageClass.provisioner %s is not a valid CSI driver name: %s", storageClass.Provisioner, strings.Join(errs, ", "))
+	}
+
+	switch storageClass.ReclaimPolicy {
+	case "", corev1.PersistentVolumeReclaimDelete, corev1.PersistentVolumeReclaimRetain:
+	default:
+		return fmt.Errorf("cluster defaultStorageClass.reclaimPolicy %s is not supported, please use one of the following: %s, %s", storageClass.ReclaimPolicy, corev1.PersistentVolumeReclaimDelete, corev1.PersistentVolumeReclaimRetain)
+	}
+
+	return nil
+}
+
+// spireTrustDomainRegex matches the trust domain names allowed by the SPIFFE ID specification.
+var spireTrustDomainRegex = regexp.MustCompile(`^[a-z0-9._-]+$`)
+
+func validateSpire(clusterConfig *Cluster) error {
+	spire := clusterConfig.Spec.Spire
+	if spire == nil {
+		return nil
+	}
+
+	attestors := SupportedSpireNodeAttestors(clusterConfig.Spec.DatacenterRef.Kind)
+	if len(attestors) == 0 {
+		return fmt.Errorf("cluster spire is not supported for %s, only for %s and %s", clusterConfig.Spec.DatacenterRef.Kind, VSphereDatacenterKind, TinkerbellDatacenterKind)
+	}
+
+	if !spireTrustDomainRegex.MatchString(spire.TrustDomain) {
+		return fmt.Errorf("cluster spire.trustDomain %q is invalid, it must only contain lowercase letters, digits, dots, dashes and underscores", spire.TrustDomain)
+	}
+
+	attestor := spire.Attestor()
+	if !slices.Contains(attestors, attestor) {
+		return fmt.Errorf("cluster spire.nodeAttestor %s is not supported for %s", attestor, clusterConfig.Spec.DatacenterRef.Kind)
+	}
+
+	if attestor != K8sPsatSpireNodeAttestor && spire.CABundleSecretName == "" {
+		return fmt.Errorf("cluster spire.caBundleSecretName is required for the %s node attestor", attestor)
+	}
+
+	return nil
+}
+
+func validateServiceLoadBalancer(clusterConfig *Cluster) error {
+	loadBalancer := clusterConfig.Spec.ServiceLoadBalancer
+	if loadBalancer == nil {
+		return nil
+	}
+
+	switch clusterConfig.Spec.DatacenterRef.Kind {
+	case VSphereDatacenterKind, TinkerbellDatacenterKind:
+	default:
+		return fmt.Errorf("cluster serviceLoadBalancer is not supported for %s, only for %s and %s", clusterConfig.Spec.DatacenterRef.Kind, VSphereDatacenterKind, TinkerbellDatacenterKind)
+	}
+
+	if loadBalancer.KubeVip == nil {
+		return errors.New("cluster serviceLoadBalancer.kubeVip is required")
+	}
+
+	if len(loadBalancer.KubeVip.AddressPool) == 0 {
+		return errors.New("cluster serviceLoadBalancer.kubeVip.addressPool can't be empty")
+	}
+
+	var endpointIP net.IP
+	if endpoint := clusterConfig.Spec.ControlPlaneConfiguration.Endpoint; endpoint != nil {
+		endpointIP = net.ParseIP(endpoint.Hostname())
+	}
+
+	for _, entry := range loadBalancer.KubeVip.AddressPool {
+		start, end, err := parseAddressPoolEntry(entry)
+		if err != nil {
+			return fmt.Errorf("cluster serviceLoadBalancer.kubeVip.addressPool: %v", err)
+		}
+		if endpointIP != nil && ipInRange(endpointIP, start, end) {
+			return fmt.Errorf("cluster serviceLoadBalancer.kubeVip.addressPool %s includes the control plane endpoint %s", entry, endpointIP)
+		}
+	}
+
+	return nil
+}
+
+func validateDNS(clusterConfig *Cluster) error {
+	dns := clusterConfig.Spec.DNS
+	if dns == nil {
+		return nil
+	}
+
+	domains := map[string]struct{}{}
+	for _, stub := range dns.StubDomains {
+		domain := strings.TrimSuffix(strings.ToLower(stub.Domain), ".")
+		if errs := utilvalidation.IsDNS1123Subdomain(domain); len(errs) > 0 {
+			return fmt.Errorf("cluster dns.stubDomains domain %s is invalid: %s", stub.Domain, strings.Join(errs, ", "))
+		}
+		if domain == "cluster.local" {
+			return fmt.Errorf("cluster dns.stubDomains domain %s can't be the cluster domain", stub.Domain)
+		}
+		if _, ok := domains[domain]; ok {
+			return fmt.Errorf("cluster dns.stubDomains domain %s is duplicated", stub.Domain)
+		}
+		domains[domain] = struct{}{}
+
+		if len(stub.Nameservers) == 0 {
+			return fmt.Errorf("cluster dns.stubDomains nameservers for %s can't be empty", stub.Domain)
+		}
+		for _, nameserver := range stub.Nameservers {
+			if err := validateNameserver(nameserver); err != nil {
+				return fmt.Errorf("cluster dns.stubDomains nameservers for %s: %v", stub.Domain, err)
+			}
+		}
+	}
+
+	for _, nameserver := range dns.UpstreamNameservers {
+		if err := validateNameserver(nameserver); err != nil {
+			return fmt.Errorf("cluster dns.upstreamNameservers: %v", err)
+		}
+	}
+
+	if autoscaling := dns.Autoscaling; autoscaling != nil {
+		if autoscaling.MinReplicas < 0 || autoscaling.MaxReplicas < 0 || autoscaling.NodesPerReplica < 0 || autoscaling.CoresPerReplica < 0 {
+			return errors.New("cluster dns.autoscaling parameters can't be negative")
+		}
+		if autoscaling.MaxReplicas > 0 && autoscaling.MaxReplicas < autoscaling.MinReplicas {
+			return fmt.Errorf("cluster dns.autoscaling.maxReplicas %d can't be lower than minReplicas %d", autoscaling.MaxReplicas, autoscaling.MinReplicas)
+		}
+		switch autoscaling.Autoscaler {
+		case "", ControllerDNSAutoscaler, ClusterProportionalDNSAutoscaler:
+		default:
+			return fmt.Errorf("cluster dns.autoscaling.autoscaler %s is not supported, please use one of the following: %s, %s", autoscaling.Autoscaler, ControllerDNSAutoscaler, ClusterProportionalDNSAutoscaler)
+		}
+	}
+
+	if cache := dns.NodeLocalDNSCache; cache != nil && cache.LocalIP != "" {
+		ip := net.ParseIP(cache.LocalIP)
+		if ip == nil || ip.To4() == nil || !ip.IsLinkLocalUnicast() {
+			return fmt.Errorf("cluster dns.nodeLocalDNSCache.localIP %s must be a link-local IPv4 address", cache.LocalIP)
+		}
+	}
+
+	return nil
+}
+
+// validateNameserver checks the nameserver is an IP, optionally followed by a port.
+func validateNameserver(nameserver string) error {
+	host := nameserver
+	if h, port, err := net.SplitHostPort(nameserver); err == nil {
+		if p, err := strconv.Atoi(port); err != nil || p < 1 || p > 65535 {
+			return fmt.Errorf("nameserver %s has an invalid port", nameserver)
+		}
+		host = h
+	}
+	if net.ParseIP(host) == nil {
+		return fmt.Errorf("nameserver %s is not a valid IP", nameserver)
+	}
+	return nil
+}
+
+// parseAddressPoolEntry parses an address pool entry, either a CIDR or a range of IPs like
+// 10.0.0.100-10.0.0.120, and returns the first and last IPs it includes.
+func parseAddressPoolEntry(entry string) (start, end net.IP, err error) {
+	if strings.Contains(entry, "/") {
+		_, ipNet, err := net.ParseCIDR(entry)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%s is not a valid CIDR", entry)
+		}
+		start = ipNet.IP
+		end = make(net.IP, len(start))
+		for i := range start {
+			end[i] = start[i] | ^ipNet.Mask[i]
+		}
+		return start, end, nil
+	}
+
+	first, last, found := strings.Cut(entry, "-")
+	if !found {
+		return nil, nil, fmt.Errorf("%s is not a valid CIDR or IP range", entry)
+	}
+	start, end = net.ParseIP(strings.TrimSpace(first)), net.ParseIP(strings.TrimSpace(last))
+	if start == nil || end == nil {
+		return nil, nil, fmt.Errorf("%s is not a valid IP range", entry)
+	}
+	if (start.To4() == nil) != (end.To4() == nil) {
+		return nil, nil, fmt.Errorf("%s mixes IPv4 and IPv6 addresses", entry)
+	}
+	if bytes.Compare(start.To16(), end.To16()) > 0 {
+		return nil, nil, fmt.Errorf("%s starts after it ends", entry)
+	}
+
+	return start, end, nil
+}
+
+func ipInRange(ip, start, end net.IP) bool {
+	ip = ip.To16()
+	return bytes.Compare(ip, start.To16()) >= 0 && bytes.Compare(ip, end.To16()) <= 0
+}
+
+func validateControlPlaneEndpointDNS(endpoint *Endpoint) error {
+	if net.ParseIP(endpoint.Hostname()) != nil {
+		return fmt.Errorf("host %s must be a hostname to create a DNS record for it", endpoint.Host)
+	}
+
+	switch endpoint.DNS.Provider {
+	case Route53DNSProvider:
+		if endpoint.DNS.Zone == "" {
+			return errors.New("zone must be set to the hosted zone ID for the route53 provider")
+		}
+	case InfobloxDNSProvider:
+	default:
+		return fmt.Errorf("provider %s is not supported, please use one of the following: %s, %s", endpoint.DNS.Provider, Route53DNSProvider, InfobloxDNSProvider)
+	}
+
+	if ip := net.ParseIP(endpoint.DNS.Address); ip == nil || ip.To4() == nil {
+		return fmt.Errorf("address %s is not a valid IPv4 address", endpoint.DNS.Address)
+	}
+
 	return nil
 }
 
@@ -517,6 +828,20 @@ func validateControlPlaneCertSANs(cfg *Cluster) error {
 	return nil
 }
 
+// RemovedCertSANs returns the SANs in old that are not present in new.
+// SANs can be appended to the API server certificate on upgrade, but removing them could break
+// clients that already rely on them to reach the API server.
+func RemovedCertSANs(old, new []string) []string {
+	var removed []string
+	for _, san := range old {
+		if !slices.Contains(new, san) {
+			removed = append(removed, san)
+		}
+	}
+
+	return removed
+}
+
 func validateControlPlaneAPIServerExtraArgs(clusterConfig *Cluster) error {
 	if clusterConfig.Spec.ControlPlaneConfiguration.APIServerExtraArgs != nil && !features.IsActive(features.APIServerExtraArgsEnabled()) {
 		return fmt.Errorf("configuring APIServerExtraArgs is not supported. Set env var %v to enable", features.APIServerExtraArgsEnabledEnvVar)
@@ -548,6 +873,57 @@ func validateControlPlaneAPIServerOIDCExtraArgs(clusterConfig *Cluster) error {
 	return nil
 }
 
+// controllerManagerManagedFlags are the kube-controller-manager flags configured by EKS Anywhere,
+// either unconditionally or from other fields of the cluster spec.
+var controllerManagerManagedFlags = []string{
+	"cloud-provider",
+	"kubeconfig",
+	"node-cidr-mask-size",
+	"tls-cipher-suites",
+}
+
+// schedulerManagedFlags are the kube-scheduler flags configured by EKS Anywhere.
+var schedulerManagedFlags = []string{
+	"kubeconfig",
+	"tls-cipher-suites",
+}
+
+func validateControlPlaneComponentExtraArgs(clusterConfig *Cluster) error {
+	cp := clusterConfig.Spec.ControlPlaneConfiguration
+	if len(cp.ControllerManagerExtraArgs) == 0 && len(cp.SchedulerExtraArgs) == 0 {
+		return nil
+	}
+
+	switch clusterConfig.Spec.DatacenterRef.Kind {
+	case VSphereDatacenterKind, CloudStackDatacenterKind, DockerDatacenterKind:
+	default:
+		return fmt.Errorf("controllerManagerExtraArgs and schedulerExtraArgs are not supported for %s", clusterConfig.Spec.DatacenterRef.Kind)
+	}
+
+	if err := validateComponentExtraArgs("controllerManagerExtraArgs", cp.ControllerManagerExtraArgs, controllerManagerManagedFlags); err != nil {
+		return err
+	}
+	return validateComponentExtraArgs("schedulerExtraArgs", cp.SchedulerExtraArgs, schedulerManagedFlags)
+}
+
+func validateComponentExtraArgs(fieldName string, args map[string]string, managedFlags []string) error {
+	flags := make([]string, 0, len(args))
+	for flag := range args {
+		flags = append(flags, flag)
+	}
+	slices.Sort(flags)
+
+	for _, flag := range flags {
+		if flag == "" || strings.HasPrefix(flag, "-") {
+			return fmt.Errorf("invalid flag %q in %s: flags must be specified without leading dashes", flag, fieldName)
+		}
+		if slices.Contains(managedFlags, flag) {
+			return fmt.Errorf("flag %s is managed by EKS Anywhere and cannot be configured in %s", flag, fieldName)
+		}
+	}
+	return nil
+}
+
 func validateControlPlaneKubeletConfiguration(clusterConfig *Cluster) error {
 	cpKubeletConfig := clusterConfig.Spec.ControlPlaneConfiguration.KubeletConfiguration
 
@@ -579,6 +955,125 @@ func validateAuditPolicyContent(c *Cluster) error {
 	return nil
 }
 
+func validateAuditLog(c *Cluster) error {
+	auditLog := c.Spec.ControlPlaneConfiguration.AuditLog
+	if auditLog == nil {
+		return nil
+	}
+
+	settings := []struct {
+		name  string
+		value *int
+	}{
+		{name: "maxAge", value: auditLog.MaxAge},
+		{name: "maxBackup", value: auditLog.MaxBackup},
+		{name: "maxSize", value: auditLog.MaxSize},
+	}
+	for _, setting := range settings {
+		if setting.value != nil && *setting.value < 0 {
+			return fmt.Errorf("auditLog.%s %d can't be negative", setting.name, *setting.value)
+		}
+	}
+
+	return nil
+}
+
+func validatePodSecurityAdmission(clusterConfig *Cluster) error {
+	psa := clusterConfig.Spec.ControlPlaneConfiguration.PodSecurityAdmission
+	if psa == nil {
+		return nil
+	}
+
+	switch clusterConfig.Spec.DatacenterRef.Kind {
+	case VSphereDatacenterKind, CloudStackDatacenterKind, DockerDatacenterKind:
+	default:
+		return fmt.Errorf("podSecurityAdmission is not supported for %s", clusterConfig.Spec.DatacenterRef.Kind)
+	}
+
+	if _, ok := clusterConfig.Spec.ControlPlaneConfiguration.APIServerExtraArgs["admission-control-config-file"]; ok {
+		return errors.New("podSecurityAdmission can't be configured with admission-control-config-file in apiServerExtraArgs")
+	}
+
+	levels := []struct {
+		mode  string
+		level PodSecurityLevel
+	}{
+		{mode: "enforce", level: psa.Enforce},
+		{mode: "audit", level: psa.Audit},
+		{mode: "warn", level: psa.Warn},
+	}
+	for _, l := range levels {
+		switch l.level {
+		case "", PodSecurityLevelPrivileged, PodSecurityLevelBaseline, PodSecurityLevelRestricted:
+		default:
+			return fmt.Errorf("podSecurityAdmission.%s %s is invalid, must be one of %s, %s or %s",
+				l.mode, l.level, PodSecurityLevelPrivileged, PodSecurityLevelBaseline, PodSecurityLevelRestricted)
+		}
+	}
+
+	if psa.Exemptions == nil {
+		return nil
+	}
+	for _, namespace := range psa.Exemptions.Namespaces {
+		if errs := utilvalidation.IsDNS1123Label(namespace); len(errs) > 0 {
+			return fmt.Errorf("podSecurityAdmission.exemptions namespace %s is invalid: %s", namespace, strings.Join(errs, ", "))
+		}
+	}
+	for _, username := range psa.Exemptions.Usernames {
+		if username == "" {
+			return errors.New("podSecurityAdmission.exemptions usernames can't be empty")
+		}
+	}
+	for _, runtimeClass := range psa.Exemptions.RuntimeClasses {
+		if errs := utilvalidation.IsDNS1123Subdomain(runtimeClass); len(errs) > 0 {
+			return fmt.Errorf("podSecurityAdmission.exemptions runtime class %s is invalid: %s", runtimeClass, strings.Join(errs, ", "))
+		}
+	}
+
+	return nil
+}
+
+var (
+	// sysctlNameRegex matches the sysctl names with dots or slashes as separators, like Kubernetes does.
+	sysctlNameRegex   = regexp.MustCompile(`^([a-z0-9]([-_a-z0-9]*[a-z0-9])?[./])*[a-z0-9]([-_a-z0-9]*[a-z0-9])?$`)
+	kernelModuleRegex = regexp.MustCompile(`^[a-zA-Z0-9_-]+$`)
+)
+
+func validateNodeOSConfiguration(clusterConfig *Cluster) error {
+	nodeOS := clusterConfig.Spec.NodeOSConfiguration
+	if nodeOS == nil {
+		return nil
+	}
+
+	switch clusterConfig.Spec.DatacenterRef.Kind {
+	case VSphereDatacenterKind, TinkerbellDatacenterKind:
+	default:
+		return fmt.Errorf("nodeOSConfiguration is not supported for %s", clusterConfig.Spec.DatacenterRef.Kind)
+	}
+
+	for name, value := range nodeOS.Sysctls {
+		if !sysctlNameRegex.MatchString(name) {
+			return fmt.Errorf("nodeOSConfiguration sysctl %q is invalid", name)
+		}
+		if value == "" || strings.ContainsAny(value, "\n\r") {
+			return fmt.Errorf("nodeOSConfiguration sysctl %s value %q is invalid", name, value)
+		}
+	}
+
+	modules := map[string]struct{}{}
+	for _, module := range nodeOS.KernelModules {
+		if !kernelModuleRegex.MatchString(module) {
+			return fmt.Errorf("nodeOSConfiguration kernel module %q is invalid", module)
+		}
+		if _, ok := modules[module]; ok {
+			return fmt.Errorf("nodeOSConfiguration kernel module %s is duplicated", module)
+		}
+		modules[module] = struct{}{}
+	}
+
+	return nil
+}
+
 func validateKubeletConfiguration(kubeletConfig *unstructured.Unstructured) error {
 	if kubeletConfig == nil {
 		return nil
@@ -608,6 +1103,106 @@ func validateKubeletConfiguration(kubeletConfig *unstructured.Unstructured) erro
 	return nil
 }
 
+func validateGPU(clusterConfig *Cluster) error {
+	for _, w := range clusterConfig.Spec.WorkerNodeGroupConfigurations {
+		if w.GPU == nil {
+			continue
+		}
+		switch clusterConfig.Spec.DatacenterRef.Kind {
+		case VSphereDatacenterKind, TinkerbellDatacenterKind, NutanixDatacenterKind:
+		default:
+			return fmt.Errorf("worker node group %s gpu is not supported for %s, only for %s, %s and %s", w.Name, clusterConfig.Spec.DatacenterRef.Kind, VSphereDatacenterKind, TinkerbellDatacenterKind, NutanixDatacenterKind)
+		}
+	}
+
+	return nil
+}
+
+func validateMachineNaming(clusterConfig *Cluster) error {
+	if naming := clusterConfig.Spec.ControlPlaneConfiguration.MachineNaming; naming != nil {
+		if err := validateMachineNamingConfiguration(clusterConfig, naming, ControlPlaneNodeGroupName, ""); err != nil {
+			return fmt.Errorf("control plane machineNaming: %v", err)
+		}
+	}
+
+	for _, w := range clusterConfig.Spec.WorkerNodeGroupConfigurations {
+		if w.MachineNaming == nil {
+			continue
+		}
+		// The zone of the machines depends on the provider failure domains, it's checked when generating the machine deployments.
+		if err := validateMachineNamingConfiguration(clusterConfig, w.MachineNaming, w.Name, "zone"); err != nil {
+			return fmt.Errorf("worker node group %s machineNaming: %v", w.Name, err)
+		}
+	}
+
+	return nil
+}
+
+func validateMachineNamingConfiguration(clusterConfig *Cluster, naming *MachineNamingConfiguration, nodeGroup, zone string) error {
+	switch clusterConfig.Spec.DatacenterRef.Kind {
+	case VSphereDatacenterKind, NutanixDatacenterKind:
+	default:
+		return fmt.Errorf("not supported for %s, only for %s and %s", clusterConfig.Spec.DatacenterRef.Kind, VSphereDatacenterKind, NutanixDatacenterKind)
+	}
+
+	if len(naming.Template) > maxMachineNamingTemplateLength {
+		return fmt.Errorf("template can't be longer than %d characters", maxMachineNamingTemplateLength)
+	}
+
+	capiTemplate, err := naming.CAPITemplate(nodeGroup, zone)
+	if err != nil {
+		return err
+	}
+
+	if !strings.Contains(capiTemplate, MachineNamingRandomVariable) {
+		return fmt.Errorf("template %s must use %s so the machine names are unique", naming.Template, MachineNamingRandomVariable)
+	}
+
+	name := strings.NewReplacer(
+		MachineNamingClusterNameVariable, clusterConfig.Name,
+		MachineNamingRandomVariable, "bcdfg",
+	).Replace(capiTemplate)
+	if errs := utilvalidation.IsDNS1123Subdomain(name); len(errs) != 0 {
+		return fmt.Errorf("template %s generates invalid machine names like %s: %s", naming.Template, name, strings.Join(errs, ", "))
+	}
+
+	return nil
+}
+
+func validateKonnectivity(clusterConfig *Cluster) error {
+	k := clusterConfig.Spec.ControlPlaneConfiguration.Konnectivity
+	if k == nil {
+		return nil
+	}
+
+	switch clusterConfig.Spec.DatacenterRef.Kind {
+	case VSphereDatacenterKind, TinkerbellDatacenterKind:
+	default:
+		return fmt.Errorf("konnectivity is not supported for %s, only for %s and %s", clusterConfig.Spec.DatacenterRef.Kind, VSphereDatacenterKind, TinkerbellDatacenterKind)
+	}
+
+	if k.AgentPort < 0 || k.AgentPort > 65535 {
+		return fmt.Errorf("konnectivity agentPort %d is not a valid port", k.AgentPort)
+	}
+
+	switch k.AgentPort {
+	case 6443, KonnectivityServerHealthPort, KonnectivityAgentHealthPort, KonnectivityAgentAdminPort, KonnectivityServerAdminPort:
+		return fmt.Errorf("konnectivity agentPort %d is already used on the control plane nodes", k.AgentPort)
+	}
+
+	// With several control plane nodes, the agents must connect to all the servers. The control plane endpoint
+	// is a VIP held by a single node, so the agents would only tunnel to the server of that node.
+	if k.ServerHost == "" && clusterConfig.Spec.ControlPlaneConfiguration.Count > 1 {
+		return errors.New("konnectivity serverHost is required with more than one control plane node")
+	}
+
+	if _, ok := clusterConfig.Spec.ControlPlaneConfiguration.APIServerExtraArgs[konnectivityEgressSelectorArg]; ok {
+		return fmt.Errorf("apiServerExtraArgs %s can't be set with konnectivity", konnectivityEgressSelectorArg)
+	}
+
+	return nil
+}
+
 func validateWorkerNodeGroups(clusterConfig *Cluster) error {
 	workerNodeGroupConfigs := clusterConfig.Spec.WorkerNodeGroupConfigurations
 	if len(workerNodeGroupConfigs) <= 0 {
@@ -635,6 +1230,10 @@ func validateWorkerNodeGroups(clusterConfig *Cluster) error {
 			return fmt.Errorf("validating autoscaling configuration: %v", err)
 		}
 
+		if err := validateAutoscalingProvisioner(&workerNodeGroupConfig, clusterConfig.Spec.DatacenterRef.Kind); err != nil {
+			return fmt.Errorf("validating autoscaling configuration: %v", err)
+		}
+
 		if err := validateMDUpgradeRolloutStrategy(&workerNodeGroupConfig, clusterConfig.Spec.DatacenterRef.Kind); err != nil {
 			return fmt.Errorf("validating upgrade rollout strategy configuration: %v", err)
 		}
@@ -702,6 +1301,33 @@ func validateAutoscalingConfig(w *WorkerNodeGroupConfiguration) error {
 	return nil
 }
 
+func validateAutoscalingProvisioner(w *WorkerNodeGroupConfiguration, datacenterRefKind string) error {
+	if w.AutoScalingConfiguration == nil {
+		return nil
+	}
+
+	switch w.AutoScalingConfiguration.Provisioner {
+	case "", ClusterAutoscalerProvisioner:
+		return nil
+	case OnDemandProvisioner:
+		if datacenterRefKind != VSphereDatacenterKind {
+			return fmt.Errorf("provisioner %s is only supported for vSphere", OnDemandProvisioner)
+		}
+		if !features.IsActive(features.VSphereOnDemandProvisionerEnabled()) {
+			return fmt.Errorf("provisioner %s is not enabled, set %s=true to use it", OnDemandProvisioner, features.VSphereOnDemandProvisionerEnvVar)
+		}
+		if len(w.FailureDomains) > 1 {
+			return fmt.Errorf("provisioner %s is not supported for worker node groups with more than one failure domain", OnDemandProvisioner)
+		}
+		if w.AutoScalingConfiguration.MaxCount == 0 {
+			return fmt.Errorf("max count must be greater than 0 for provisioner %s", OnDemandProvisioner)
+		}
+		return nil
+	default:
+		return fmt.Errorf("provisioner %s is not supported, supported provisioners are %s and %s", w.AutoScalingConfiguration.Provisioner, ClusterAutoscalerProvisioner, OnDemandProvisioner)
+	}
+}
+
 func validateNodeLabels(labels map[string]string, fldPath *field.Path) error {
 	errList := validation.ValidateLabels(labels, fldPath)
 	if len(errList) != 0 {
@@ -745,19 +1371,43 @@ func validateNetworking(clusterConfig *Cluster) error {
 	if len(clusterNetwork.Services.CidrBlocks) <= 0 {
 		return errors.New("services CIDR block not specified or empty")
 	}
-	if len(clusterNetwork.Pods.CidrBlocks) > 1 {
-		return fmt.Errorf("multiple CIDR blocks for Pods are not yet supported")
+	if len(clusterNetwork.Pods.CidrBlocks) > 2 {
+		return errors.New("at most two CIDR blocks for Pods, one IPv4 and one IPv6, are supported")
 	}
-	if len(clusterNetwork.Services.CidrBlocks) > 1 {
-		return fmt.Errorf("multiple CIDR blocks for Services are not yet supported")
+	if len(clusterNetwork.Services.CidrBlocks) > 2 {
+		return errors.New("at most two CIDR blocks for Services, one IPv4 and one IPv6, are supported")
 	}
-	_, podCIDRIPNet, err := net.ParseCIDR(clusterNetwork.Pods.CidrBlocks[0])
-	if err != nil {
-		return fmt.Errorf("invalid CIDR block format for Pods: %s. Please specify a valid CIDR block for pod subnet", clusterNetwork.Pods)
+
+	podCIDRIPNets := make([]*net.IPNet, 0, len(clusterNetwork.Pods.CidrBlocks))
+	for _, cidr := range clusterNetwork.Pods.CidrBlocks {
+		_, podCIDRIPNet, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return fmt.Errorf("invalid CIDR block format for Pods: %s. Please specify a valid CIDR block for pod subnet", clusterNetwork.Pods)
+		}
+		podCIDRIPNets = append(podCIDRIPNets, podCIDRIPNet)
 	}
-	_, serviceCIDRIPNet, err := net.ParseCIDR(clusterNetwork.Services.CidrBlocks[0])
-	if err != nil {
-		return fmt.Errorf("invalid CIDR block for Services: %s. Please specify a valid CIDR block for service subnet", clusterNetwork.Services)
+	serviceCIDRIPNets := make([]*net.IPNet, 0, len(clusterNetwork.Services.CidrBlocks))
+	for _, cidr := range clusterNetwork.Services.CidrBlocks {
+		_, serviceCIDRIPNet, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return fmt.Errorf("invalid CIDR block for Services: %s. Please specify a valid CIDR block for service subnet", clusterNetwork.Services)
+		}
+		serviceCIDRIPNets = append(serviceCIDRIPNets, serviceCIDRIPNet)
+	}
+
+	if err := validateCIDRBlockIPFamilies(clusterNetwork); err != nil {
+		return err
+	}
+
+	switch clusterNetwork.IPFamily() {
+	case IPv6IPFamily:
+		if err := validateIPv6Cluster(clusterConfig); err != nil {
+			return err
+		}
+	case DualStackIPFamily:
+		if err := validateDualStackCluster(clusterConfig); err != nil {
+			return err
+		}
 	}
 
 	if clusterConfig.Spec.DatacenterRef.Kind == SnowDatacenterKind {
@@ -765,32 +1415,125 @@ func validateNetworking(clusterConfig *Cluster) error {
 		if controlPlaneEndpoint == nil {
 			return fmt.Errorf("control plane endpoint %s is invalid", clusterConfig.Spec.ControlPlaneConfiguration.Endpoint.Host)
 		}
-		if podCIDRIPNet.Contains(controlPlaneEndpoint) {
+		if podCIDRIPNets[0].Contains(controlPlaneEndpoint) {
 			return fmt.Errorf("control plane endpoint %s conflicts with pods CIDR block %s", clusterConfig.Spec.ControlPlaneConfiguration.Endpoint.Host, clusterNetwork.Pods.CidrBlocks[0])
 		}
-		if serviceCIDRIPNet.Contains(controlPlaneEndpoint) {
+		if serviceCIDRIPNets[0].Contains(controlPlaneEndpoint) {
 			return fmt.Errorf("control plane endpoint %s conflicts with services CIDR block %s", clusterConfig.Spec.ControlPlaneConfiguration.Endpoint.Host, clusterNetwork.Services.CidrBlocks[0])
 		}
 	}
 
-	podMaskSize, _ := podCIDRIPNet.Mask.Size()
-	nodeCidrMaskSize := constants.DefaultNodeCidrMaskSize
+	for i, podCIDRIPNet := range podCIDRIPNets {
+		podMaskSize, _ := podCIDRIPNet.Mask.Size()
+		nodeCidrMaskSize := nodeCIDRMaskSize(clusterNetwork, cidrIPFamily(clusterNetwork.Pods.CidrBlocks[i]))
+		// the pod subnet mask needs to allow one or multiple node-masks
+		// i.e. if it has a /24 the node mask must be between 24 and 32 for ipv4, or a /48 between 48 and 64 for ipv6
+		// the below validations are run by kubeadm and we are bubbling those up here for better customer experience
+		if podMaskSize >= nodeCidrMaskSize {
+			return fmt.Errorf("the size of pod subnet with mask %d is smaller than or equal to the size of node subnet with mask %d", podMaskSize, nodeCidrMaskSize)
+		} else if (nodeCidrMaskSize - podMaskSize) > podSubnetNodeMaskMaxDiff {
+			// PodSubnetNodeMaskMaxDiff is limited to 16 due to an issue with uncompressed IP bitmap in core
+			// The node subnet mask size must be no more than the pod subnet mask size + 16
+			return fmt.Errorf("pod subnet mask (%d) and node-mask (%d) difference is greater than %d", podMaskSize, nodeCidrMaskSize, podSubnetNodeMaskMaxDiff)
+		}
+	}
+
+	return validateCNIPlugin(clusterNetwork)
+}
 
-	if clusterNetwork.Nodes != nil && clusterNetwork.Nodes.CIDRMaskSize != nil {
-		nodeCidrMaskSize = *clusterNetwork.Nodes.CIDRMaskSize
+// nodeCIDRMaskSize returns the mask size of the node CIDR blocks allocated from the pod CIDR block
+// of an IP family. The mask size of the cluster network applies to the only family of single-stack
+// clusters and to the IPv4 family of dual-stack clusters.
+func nodeCIDRMaskSize(clusterNetwork ClusterNetwork, ipFamily IPFamily) int {
+	if clusterNetwork.Nodes != nil && clusterNetwork.Nodes.CIDRMaskSize != nil &&
+		(!clusterNetwork.IsDualStack() || ipFamily == IPv4IPFamily) {
+		return *clusterNetwork.Nodes.CIDRMaskSize
 	}
-	// the pod subnet mask needs to allow one or multiple node-masks
-	// i.e. if it has a /24 the node mask must be between 24 and 32 for ipv4
-	// the below validations are run by kubeadm and we are bubbling those up here for better customer experience
-	if podMaskSize >= nodeCidrMaskSize {
-		return fmt.Errorf("the size of pod subnet with mask %d is smaller than or equal to the size of node subnet with mask %d", podMaskSize, nodeCidrMaskSize)
-	} else if (nodeCidrMaskSize - podMaskSize) > podSubnetNodeMaskMaxDiff {
-		// PodSubnetNodeMaskMaxDiff is limited to 16 due to an issue with uncompressed IP bitmap in core
-		// The node subnet mask size must be no more than the pod subnet mask size + 16
-		return fmt.Errorf("pod subnet mask (%d) and node-mask (%d) difference is greater than %d", podMaskSize, nodeCidrMaskSize, podSubnetNodeMaskMaxDiff)
+
+	if ipFamily == IPv6IPFamily {
+		return constants.DefaultNodeCidrMaskSizeIPv6
 	}
 
-	return validateCNIPlugin(clusterNetwork)
+	return constants.DefaultNodeCidrMaskSize
+}
+
+// validateCIDRBlockIPFamilies validates the pods and services CIDR blocks are either a single CIDR
+// block of the same IP family, or one IPv4 and one IPv6 CIDR block listed in the same order.
+func validateCIDRBlockIPFamilies(clusterNetwork ClusterNetwork) error {
+	pods, services := clusterNetwork.Pods.CidrBlocks, clusterNetwork.Services.CidrBlocks
+	if len(pods) == 2 && cidrIPFamily(pods[0]) == cidrIPFamily(pods[1]) {
+		return fmt.Errorf("pods CIDR blocks %s and %s are both %s, dual-stack clusters require one IPv4 and one IPv6 CIDR block", pods[0], pods[1], cidrIPFamily(pods[0]))
+	}
+	if len(services) == 2 && cidrIPFamily(services[0]) == cidrIPFamily(services[1]) {
+		return fmt.Errorf("services CIDR blocks %s and %s are both %s, dual-stack clusters require one IPv4 and one IPv6 CIDR block", services[0], services[1], cidrIPFamily(services[0]))
+	}
+	if len(pods) != len(services) {
+		return fmt.Errorf("pods have %d CIDR blocks but services have %d, dual-stack clusters require two CIDR blocks for both", len(pods), len(services))
+	}
+
+	podsIPFamily, servicesIPFamily := cidrIPFamily(pods[0]), cidrIPFamily(services[0])
+	if podsIPFamily == servicesIPFamily {
+		return nil
+	}
+	if len(pods) == 1 {
+		return fmt.Errorf("pods CIDR block %s is %s but services CIDR block %s is %s, both must be of the same IP family", pods[0], podsIPFamily, services[0], servicesIPFamily)
+	}
+
+	return fmt.Errorf("the first pods CIDR block %s is %s but the first services CIDR block %s is %s, both must be of the primary IP family of the cluster", pods[0], podsIPFamily, services[0], servicesIPFamily)
+}
+
+// ipv6Providers are the providers that support IPv6 single-stack and dual-stack clusters.
+var ipv6Providers = map[string]struct{}{
+	VSphereDatacenterKind:    {},
+	TinkerbellDatacenterKind: {},
+}
+
+// validateIPv6Cluster validates the configuration of a cluster with IPv6 pod and service CIDR blocks.
+func validateIPv6Cluster(clusterConfig *Cluster) error {
+	if _, ok := ipv6Providers[clusterConfig.Spec.DatacenterRef.Kind]; !ok {
+		return fmt.Errorf("IPv6 clusters are not supported for %s, only for %s and %s", clusterConfig.Spec.DatacenterRef.Kind, VSphereDatacenterKind, TinkerbellDatacenterKind)
+	}
+
+	cniConfig := clusterConfig.Spec.ClusterNetwork.CNIConfig
+	if cniConfig == nil || cniConfig.Cilium == nil {
+		return errors.New("IPv6 clusters are only supported with the Cilium CNI")
+	}
+
+	if endpoint := clusterConfig.Spec.ControlPlaneConfiguration.Endpoint; endpoint != nil {
+		if ip := net.ParseIP(endpoint.Host); ip != nil && ip.To4() != nil {
+			return fmt.Errorf("control plane endpoint %s must be an IPv6 address for IPv6 clusters", endpoint.Host)
+		}
+	}
+
+	if dns := clusterConfig.Spec.DNS; dns != nil && dns.NodeLocalDNSCache != nil {
+		return errors.New("dns.nodeLocalDNSCache is not supported for IPv6 clusters")
+	}
+
+	return nil
+}
+
+// validateDualStackCluster validates the configuration of a cluster with both IPv4 and IPv6 pod
+// and service CIDR blocks.
+func validateDualStackCluster(clusterConfig *Cluster) error {
+	if _, ok := ipv6Providers[clusterConfig.Spec.DatacenterRef.Kind]; !ok {
+		return fmt.Errorf("dual-stack clusters are not supported for %s, only for %s and %s", clusterConfig.Spec.DatacenterRef.Kind, VSphereDatacenterKind, TinkerbellDatacenterKind)
+	}
+
+	cniConfig := clusterConfig.Spec.ClusterNetwork.CNIConfig
+	if cniConfig == nil || cniConfig.Cilium == nil {
+		return errors.New("dual-stack clusters are only supported with the Cilium CNI")
+	}
+
+	// The API server advertises its address in the primary IP family of the cluster, so the
+	// control plane endpoint must be of that family too.
+	primaryIPFamily := clusterConfig.Spec.ClusterNetwork.PrimaryIPFamily()
+	if endpoint := clusterConfig.Spec.ControlPlaneConfiguration.Endpoint; endpoint != nil {
+		if ip := net.ParseIP(endpoint.Host); ip != nil && (ip.To4() != nil) != (primaryIPFamily == IPv4IPFamily) {
+			return fmt.Errorf("control plane endpoint %s must be an %s address, the primary IP family of the cluster", endpoint.Host, primaryIPFamily)
+		}
+	}
+
+	return nil
 }
 
 func validateCNIPlugin(network ClusterNetwork) error {
@@ -805,10 +1548,10 @@ func validateCNIPlugin(network ClusterNetwork) error {
 		return nil
 	}
 
-	return validateCNIConfig(network.CNIConfig)
+	return validateCNIConfig(network.CNIConfig, network.IPFamily())
 }
 
-func validateCNIConfig(cniConfig *CNIConfig) error {
+func validateCNIConfig(cniConfig *CNIConfig, ipFamily IPFamily) error {
 	if cniConfig == nil {
 		return fmt.Errorf("cni not specified")
 	}
@@ -817,7 +1560,7 @@ func validateCNIConfig(cniConfig *CNIConfig) error {
 
 	if cniConfig.Cilium != nil {
 		cniPluginSpecified++
-		if err := validateCiliumConfig(cniConfig.Cilium); err != nil {
+		if err := validateCiliumConfig(cniConfig.Cilium, ipFamily); err != nil {
 			allErrs = append(allErrs, err)
 		}
 	}
@@ -826,6 +1569,10 @@ func validateCNIConfig(cniConfig *CNIConfig) error {
 		cniPluginSpecified++
 	}
 
+	if cniConfig.Calico != nil {
+		cniPluginSpecified++
+	}
+
 	if cniPluginSpecified == 0 {
 		allErrs = append(allErrs, fmt.Errorf("no cni plugin specified"))
 	} else if cniPluginSpecified > 1 {
@@ -840,7 +1587,7 @@ func validateCNIConfig(cniConfig *CNIConfig) error {
 	return nil
 }
 
-func validateCiliumConfig(cilium *CiliumConfig) error {
+func validateCiliumConfig(cilium *CiliumConfig, ipFamily IPFamily) error {
 	if cilium == nil {
 		return nil
 	}
@@ -851,8 +1598,20 @@ func validateCiliumConfig(cilium *CiliumConfig) error {
 		}
 	}
 
-	if cilium.RoutingMode == "direct" && cilium.IPv4NativeRoutingCIDR == "" {
-		return errors.New("direct routing mode requires IPv4NativeRoutingCIDR to be set")
+	if cilium.RoutingMode == "direct" {
+		if ipFamily == IPv6IPFamily && cilium.IPv6NativeRoutingCIDR == "" {
+			return errors.New("direct routing mode requires IPv6NativeRoutingCIDR to be set for IPv6 clusters")
+		}
+		if ipFamily == DualStackIPFamily && cilium.IPv6NativeRoutingCIDR == "" {
+			return errors.New("direct routing mode requires IPv6NativeRoutingCIDR to be set for dual-stack clusters")
+		}
+		if ipFamily != IPv6IPFamily && cilium.IPv4NativeRoutingCIDR == "" {
+			return errors.New("direct routing mode requires IPv4NativeRoutingCIDR to be set")
+		}
+	}
+
+	if err := validateCiliumHubble(cilium.Hubble); err != nil {
+		return err
 	}
 
 	if cilium.PolicyEnforcementMode == "" {
@@ -866,20 +1625,43 @@ func validateCiliumConfig(cilium *CiliumConfig) error {
 	return nil
 }
 
+func validateCiliumHubble(hubble *CiliumHubbleConfig) error {
+	if hubble == nil {
+		return nil
+	}
+
+	if hubble.UI && !hubble.Relay {
+		return errors.New("cilium hubble ui requires hubble relay to be enabled")
+	}
+
+	for _, metric := range hubble.Metrics {
+		if strings.TrimSpace(metric) == "" {
+			return errors.New("cilium hubble metrics can't be empty")
+		}
+	}
+
+	return nil
+}
+
 func validateProxyConfig(clusterConfig *Cluster) error {
 	if clusterConfig.Spec.ProxyConfiguration == nil {
 		return nil
 	}
-	if clusterConfig.Spec.ProxyConfiguration.HttpProxy == "" {
+	return validateProxyConfiguration(clusterConfig.Spec.ProxyConfiguration)
+}
+
+// validateProxyConfiguration checks that both proxy endpoints are set and valid.
+func validateProxyConfiguration(proxy *ProxyConfiguration) error {
+	if proxy.HttpProxy == "" {
 		return errors.New("no value set for httpProxy")
 	}
-	if clusterConfig.Spec.ProxyConfiguration.HttpsProxy == "" {
+	if proxy.HttpsProxy == "" {
 		return errors.New("no value set for httpsProxy")
 	}
-	if err := validateProxyData(clusterConfig.Spec.ProxyConfiguration.HttpProxy); err != nil {
+	if err := validateProxyData(proxy.HttpProxy); err != nil {
 		return err
 	}
-	if err := validateProxyData(clusterConfig.Spec.ProxyConfiguration.HttpsProxy); err != nil {
+	if err := validateProxyData(proxy.HttpsProxy); err != nil {
 		return err
 	}
 	return nil
@@ -898,6 +1680,9 @@ func validateProxyData(proxy string) error {
 	}
 	host, port, err := net.SplitHostPort(proxyHost)
 	if err != nil {
+		if ip := net.ParseIP(proxyHost); ip != nil && ip.To4() == nil {
+			return fmt.Errorf("proxy endpoint %s is invalid, IPv6 addresses must be enclosed in brackets and followed by the port, e.g. [fd00::1]:3128", proxy)
+		}
 		return fmt.Errorf("proxy endpoint %s is invalid (%s), please provide a valid proxy address", proxy, err)
 	}
 	_, err = net.DefaultResolver.LookupIPAddr(context.Background(), host)
@@ -918,6 +1703,11 @@ func validateMirrorConfig(clusterConfig *Cluster) error {
 		return errors.New("no value set for RegistryMirrorConfiguration.Endpoint")
 	}
 
+	// The port is rendered after the endpoint, so IPv6 addresses are bracketed when rendering.
+	if strings.HasPrefix(clusterConfig.Spec.RegistryMirrorConfiguration.Endpoint, "[") {
+		return fmt.Errorf("registry mirror endpoint %s is invalid, please provide IPv6 addresses without brackets", clusterConfig.Spec.RegistryMirrorConfiguration.Endpoint)
+	}
+
 	if !networkutils.IsPortValid(clusterConfig.Spec.RegistryMirrorConfiguration.Port) {
 		return fmt.Errorf("registry mirror port %s is invalid, please provide a valid port", clusterConfig.Spec.RegistryMirrorConfiguration.Port)
 	}
@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"os"
 
+	corev1 "k8s.io/api/core/v1"
+
 	"github.com/aws/eks-anywhere/pkg/constants"
 	"github.com/aws/eks-anywhere/pkg/logger"
 	"github.com/aws/eks-anywhere/pkg/utils/ptr"
@@ -14,6 +16,7 @@ var clusterDefaults = []func(*Cluster) error{
 	setWorkerNodeGroupDefaults,
 	setCNIConfigDefault,
 	setEtcdEncryptionConfigDefaults,
+	setKonnectivityDefaults,
 }
 
 func setClusterDefaults(cluster *Cluster) error {
@@ -59,6 +62,49 @@ func setWorkerNodeGroupDefaults(cluster *Cluster) error {
 		} else if w.Count == nil {
 			w.Count = ptr.Int(1)
 		}
+		setGPUDefaults(w)
+	}
+
+	return nil
+}
+
+// setGPUDefaults labels the nodes of a worker node group with GPU support, so the NVIDIA device plugin
+// and the pods of the nvidia runtime class are scheduled on them, and taints them unless disabled.
+func setGPUDefaults(w *WorkerNodeGroupConfiguration) {
+	if w.GPU == nil {
+		return
+	}
+
+	if _, ok := w.Labels[GPUNodeLabel]; !ok {
+		if w.Labels == nil {
+			w.Labels = map[string]string{}
+		}
+		w.Labels[GPUNodeLabel] = "true"
+	}
+
+	if !w.GPU.TaintEnabled() {
+		return
+	}
+	for _, taint := range w.Taints {
+		if taint.Key == GPUTaintKey {
+			return
+		}
+	}
+	w.Taints = append(w.Taints, corev1.Taint{
+		Key:    GPUTaintKey,
+		Value:  "true",
+		Effect: corev1.TaintEffectNoSchedule,
+	})
+}
+
+func setKonnectivityDefaults(cluster *Cluster) error {
+	k := cluster.Spec.ControlPlaneConfiguration.Konnectivity
+	if k == nil {
+		return nil
+	}
+
+	if k.AgentPort == 0 {
+		k.AgentPort = DefaultKonnectivityAgentPort
 	}
 
 	return nil
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/aws/eks-anywhere/pkg/utils/ptr"
@@ -328,3 +329,105 @@ func TestSetClusterDefaults(t *testing.T) {
 		})
 	}
 }
+
+func TestSetGPUDefaults(t *testing.T) {
+	tests := []struct {
+		name     string
+		in, want WorkerNodeGroupConfiguration
+	}{
+		{
+			name: "no gpu",
+			in:   WorkerNodeGroupConfiguration{Name: "md-0"},
+			want: WorkerNodeGroupConfiguration{Name: "md-0"},
+		},
+		{
+			name: "gpu",
+			in: WorkerNodeGroupConfiguration{
+				Name:   "md-0",
+				Labels: map[string]string{"team": "ml"},
+				GPU:    &GPUConfiguration{},
+			},
+			want: WorkerNodeGroupConfiguration{
+				Name:   "md-0",
+				Labels: map[string]string{"team": "ml", GPUNodeLabel: "true"},
+				Taints: []corev1.Taint{{Key: GPUTaintKey, Value: "true", Effect: corev1.TaintEffectNoSchedule}},
+				GPU:    &GPUConfiguration{},
+			},
+		},
+		{
+			name: "gpu without taint",
+			in: WorkerNodeGroupConfiguration{
+				Name: "md-0",
+				GPU:  &GPUConfiguration{Taint: ptr.Bool(false)},
+			},
+			want: WorkerNodeGroupConfiguration{
+				Name:   "md-0",
+				Labels: map[string]string{GPUNodeLabel: "true"},
+				GPU:    &GPUConfiguration{Taint: ptr.Bool(false)},
+			},
+		},
+		{
+			name: "gpu with custom taint",
+			in: WorkerNodeGroupConfiguration{
+				Name:   "md-0",
+				Taints: []corev1.Taint{{Key: GPUTaintKey, Effect: corev1.TaintEffectNoExecute}},
+				GPU:    &GPUConfiguration{},
+			},
+			want: WorkerNodeGroupConfiguration{
+				Name:   "md-0",
+				Labels: map[string]string{GPUNodeLabel: "true"},
+				Taints: []corev1.Taint{{Key: GPUTaintKey, Effect: corev1.TaintEffectNoExecute}},
+				GPU:    &GPUConfiguration{},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			setGPUDefaults(&tt.in)
+			g.Expect(tt.in).To(Equal(tt.want))
+		})
+	}
+}
+
+func TestSetEtcdEncryptionConfigDefaultsKMSV2(t *testing.T) {
+	g := NewWithT(t)
+	cluster := &Cluster{
+		Spec: ClusterSpec{
+			EtcdEncryption: &[]EtcdEncryption{
+				{
+					Providers: []EtcdEncryptionProvider{
+						{
+							KMS: &KMS{
+								APIVersion:          KMSAPIVersionV2,
+								Name:                "test-config",
+								SocketListenAddress: "unix:///kms/socket/path",
+							},
+						},
+					},
+					Resources: []string{"secrets"},
+				},
+			},
+		},
+	}
+
+	g.Expect(setEtcdEncryptionConfigDefaults(cluster)).To(Succeed())
+	kms := (*cluster.Spec.EtcdEncryption)[0].Providers[0].KMS
+	g.Expect(kms.CacheSize).To(BeNil())
+	g.Expect(kms.Timeout).To(Equal(&DefaultKMSTimeout))
+}
+
+func TestSetKonnectivityDefaults(t *testing.T) {
+	g := NewWithT(t)
+	cluster := &Cluster{}
+	g.Expect(setKonnectivityDefaults(cluster)).To(Succeed())
+	g.Expect(cluster.Spec.ControlPlaneConfiguration.Konnectivity).To(BeNil())
+
+	cluster.Spec.ControlPlaneConfiguration.Konnectivity = &KonnectivityConfiguration{}
+	g.Expect(setKonnectivityDefaults(cluster)).To(Succeed())
+	g.Expect(cluster.Spec.ControlPlaneConfiguration.Konnectivity).To(Equal(&KonnectivityConfiguration{AgentPort: DefaultKonnectivityAgentPort}))
+
+	cluster.Spec.ControlPlaneConfiguration.Konnectivity = &KonnectivityConfiguration{AgentPort: 9132}
+	g.Expect(setKonnectivityDefaults(cluster)).To(Succeed())
+	g.Expect(cluster.Spec.ControlPlaneConfiguration.Konnectivity.AgentPort).To(Equal(9132))
+}
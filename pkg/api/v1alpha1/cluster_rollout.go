@@ -0,0 +1,267 @@
+package v1alpha1
+
+import (
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// AcknowledgeRolloutAnnotation acknowledges the changes of a Cluster update that replace the machines
+// of the cluster. It has to be set to a new value, like the current date, on every update that rolls
+// out new machines, so an acknowledgement for a past update doesn't apply to the next ones.
+const AcknowledgeRolloutAnnotation = "anywhere.eks.amazonaws.com/acknowledge-rollout"
+
+// RolloutImpact is how a change to the spec of a cluster is applied to its machines.
+type RolloutImpact string
+
+const (
+	// RolloutImpactNoOp changes don't touch the machines of the cluster.
+	RolloutImpactNoOp RolloutImpact = "no-op"
+	// RolloutImpactInPlace changes are applied without replacing the existing machines, like scaling
+	// a node group or upgrading it with the InPlace upgrade rollout strategy.
+	RolloutImpactInPlace RolloutImpact = "in-place"
+	// RolloutImpactRollout changes replace the machines of the control plane or of worker node groups.
+	RolloutImpactRollout RolloutImpact = "rollout"
+)
+
+// FieldChange is a changed field of a cluster spec with its impact on the machines of the cluster.
+// +kubebuilder:object:generate=false
+type FieldChange struct {
+	Path   string        `json:"path"`
+	Impact RolloutImpact `json:"impact"`
+}
+
+func (c FieldChange) String() string {
+	return fmt.Sprintf("%s (%s)", c.Path, c.Impact)
+}
+
+// RolloutChanges returns the changes that replace machines.
+func RolloutChanges(changes []FieldChange) []FieldChange {
+	var rollouts []FieldChange
+	for _, c := range changes {
+		if c.Impact == RolloutImpactRollout {
+			rollouts = append(rollouts, c)
+		}
+	}
+
+	return rollouts
+}
+
+// FieldChangePaths returns the paths of the changes, comma separated.
+func FieldChangePaths(changes []FieldChange) string {
+	paths := make([]string, 0, len(changes))
+	for _, c := range changes {
+		paths = append(paths, c.Path)
+	}
+
+	return strings.Join(paths, ", ")
+}
+
+type specField struct {
+	path   string
+	impact RolloutImpact
+	value  func(*ClusterSpec) interface{}
+}
+
+// clusterFields are the fields of the cluster spec shared by the control plane and all the worker
+// node groups. The kubernetes version is classified separately, since its impact depends on the
+// upgrade rollout strategy.
+var clusterFields = []specField{
+	{"spec.registryMirrorConfiguration", RolloutImpactRollout, func(s *ClusterSpec) interface{} { return s.RegistryMirrorConfiguration }},
+	{"spec.proxyConfiguration", RolloutImpactRollout, func(s *ClusterSpec) interface{} { return s.ProxyConfiguration }},
+	{"spec.podIamConfig", RolloutImpactRollout, func(s *ClusterSpec) interface{} { return s.PodIAMConfig }},
+	{"spec.identityProviderRefs", RolloutImpactRollout, func(s *ClusterSpec) interface{} { return s.IdentityProviderRefs }},
+	{"spec.etcdEncryption", RolloutImpactRollout, func(s *ClusterSpec) interface{} { return s.EtcdEncryption }},
+	{"spec.nodeOSConfiguration", RolloutImpactRollout, func(s *ClusterSpec) interface{} { return s.NodeOSConfiguration }},
+	{"spec.clusterNetwork.nodes", RolloutImpactRollout, func(s *ClusterSpec) interface{} { return s.ClusterNetwork.Nodes }},
+	{"spec.eksaVersion", RolloutImpactRollout, func(s *ClusterSpec) interface{} { return s.EksaVersion }},
+	{"spec.bundlesRef", RolloutImpactRollout, func(s *ClusterSpec) interface{} { return s.BundlesRef }},
+	{"spec.externalEtcdConfiguration.machineGroupRef", RolloutImpactRollout, func(s *ClusterSpec) interface{} {
+		if s.ExternalEtcdConfiguration == nil {
+			return nil
+		}
+		return s.ExternalEtcdConfiguration.MachineGroupRef
+	}},
+	{"spec.externalEtcdConfiguration.count", RolloutImpactInPlace, func(s *ClusterSpec) interface{} {
+		if s.ExternalEtcdConfiguration == nil {
+			return nil
+		}
+		return s.ExternalEtcdConfiguration.Count
+	}},
+	{"spec.clusterNetwork.cniConfig", RolloutImpactNoOp, func(s *ClusterSpec) interface{} { return s.ClusterNetwork.CNIConfig }},
+	{"spec.machineHealthCheck", RolloutImpactNoOp, func(s *ClusterSpec) interface{} { return s.MachineHealthCheck }},
+	{"spec.gitOpsRef", RolloutImpactNoOp, func(s *ClusterSpec) interface{} { return s.GitOpsRef }},
+	{"spec.packages", RolloutImpactNoOp, func(s *ClusterSpec) interface{} { return s.Packages }},
+	{"spec.externalDns", RolloutImpactNoOp, func(s *ClusterSpec) interface{} { return s.ExternalDNS }},
+	{"spec.defaultStorageClass", RolloutImpactNoOp, func(s *ClusterSpec) interface{} { return s.DefaultStorageClass }},
+	{"spec.serviceLoadBalancer", RolloutImpactNoOp, func(s *ClusterSpec) interface{} { return s.ServiceLoadBalancer }},
+	{"spec.dns", RolloutImpactNoOp, func(s *ClusterSpec) interface{} { return s.DNS }},
+	{"spec.licenseToken", RolloutImpactNoOp, func(s *ClusterSpec) interface{} { return s.LicenseToken }},
+}
+
+func controlPlaneField(name string, impact RolloutImpact, value func(*ControlPlaneConfiguration) interface{}) specField {
+	return specField{
+		path:   "spec.controlPlaneConfiguration." + name,
+		impact: impact,
+		value:  func(s *ClusterSpec) interface{} { return value(&s.ControlPlaneConfiguration) },
+	}
+}
+
+var controlPlaneFields = []specField{
+	controlPlaneField("count", RolloutImpactInPlace, func(c *ControlPlaneConfiguration) interface{} { return c.Count }),
+	controlPlaneField("machineGroupRef", RolloutImpactRollout, func(c *ControlPlaneConfiguration) interface{} { return c.MachineGroupRef }),
+	controlPlaneField("taints", RolloutImpactRollout, func(c *ControlPlaneConfiguration) interface{} { return c.Taints }),
+	controlPlaneField("labels", RolloutImpactRollout, func(c *ControlPlaneConfiguration) interface{} { return c.Labels }),
+	controlPlaneField("skipLoadBalancerDeployment", RolloutImpactRollout, func(c *ControlPlaneConfiguration) interface{} { return c.SkipLoadBalancerDeployment }),
+	controlPlaneField("certSans", RolloutImpactRollout, func(c *ControlPlaneConfiguration) interface{} { return c.CertSANs }),
+	controlPlaneField("apiServerExtraArgs", RolloutImpactRollout, func(c *ControlPlaneConfiguration) interface{} { return c.APIServerExtraArgs }),
+	controlPlaneField("controllerManagerExtraArgs", RolloutImpactRollout, func(c *ControlPlaneConfiguration) interface{} { return c.ControllerManagerExtraArgs }),
+	controlPlaneField("schedulerExtraArgs", RolloutImpactRollout, func(c *ControlPlaneConfiguration) interface{} { return c.SchedulerExtraArgs }),
+	controlPlaneField("kubeletConfiguration", RolloutImpactRollout, func(c *ControlPlaneConfiguration) interface{} { return c.KubeletConfiguration }),
+	controlPlaneField("auditPolicyContent", RolloutImpactRollout, func(c *ControlPlaneConfiguration) interface{} { return c.AuditPolicyContent }),
+	controlPlaneField("auditLog", RolloutImpactRollout, func(c *ControlPlaneConfiguration) interface{} { return c.AuditLog }),
+	controlPlaneField("skipAdmissionForSystemResources", RolloutImpactRollout, func(c *ControlPlaneConfiguration) interface{} { return c.SkipAdmissionForSystemResources }),
+	controlPlaneField("failureDomains", RolloutImpactRollout, func(c *ControlPlaneConfiguration) interface{} { return c.FailureDomains }),
+	controlPlaneField("podSecurityAdmission", RolloutImpactRollout, func(c *ControlPlaneConfiguration) interface{} { return c.PodSecurityAdmission }),
+	controlPlaneField("machineNaming", RolloutImpactRollout, func(c *ControlPlaneConfiguration) interface{} { return c.MachineNaming }),
+	controlPlaneField("konnectivity", RolloutImpactRollout, func(c *ControlPlaneConfiguration) interface{} { return c.Konnectivity }),
+	controlPlaneField("upgradeRolloutStrategy", RolloutImpactNoOp, func(c *ControlPlaneConfiguration) interface{} { return c.UpgradeRolloutStrategy }),
+	controlPlaneField("machineHealthCheck", RolloutImpactNoOp, func(c *ControlPlaneConfiguration) interface{} { return c.MachineHealthCheck }),
+}
+
+type workerNodeGroupField struct {
+	name   string
+	impact RolloutImpact
+	value  func(*WorkerNodeGroupConfiguration) interface{}
+}
+
+var workerNodeGroupFields = []workerNodeGroupField{
+	{"count", RolloutImpactInPlace, func(w *WorkerNodeGroupConfiguration) interface{} { return w.Count }},
+	{"autoscalingConfiguration", RolloutImpactInPlace, func(w *WorkerNodeGroupConfiguration) interface{} { return w.AutoScalingConfiguration }},
+	{"machineGroupRef", RolloutImpactRollout, func(w *WorkerNodeGroupConfiguration) interface{} { return w.MachineGroupRef }},
+	{"taints", RolloutImpactRollout, func(w *WorkerNodeGroupConfiguration) interface{} { return w.Taints }},
+	{"labels", RolloutImpactRollout, func(w *WorkerNodeGroupConfiguration) interface{} { return w.Labels }},
+	{"kubeletConfiguration", RolloutImpactRollout, func(w *WorkerNodeGroupConfiguration) interface{} { return w.KubeletConfiguration }},
+	{"failureDomains", RolloutImpactRollout, func(w *WorkerNodeGroupConfiguration) interface{} { return w.FailureDomains }},
+	{"failureDomainSpreadPolicy", RolloutImpactRollout, func(w *WorkerNodeGroupConfiguration) interface{} { return w.FailureDomainSpreadPolicy }},
+	{"gpu", RolloutImpactRollout, func(w *WorkerNodeGroupConfiguration) interface{} { return w.GPU }},
+	{"machineNaming", RolloutImpactRollout, func(w *WorkerNodeGroupConfiguration) interface{} { return w.MachineNaming }},
+	{"upgradeRolloutStrategy", RolloutImpactNoOp, func(w *WorkerNodeGroupConfiguration) interface{} { return w.UpgradeRolloutStrategy }},
+	{"machineHealthCheck", RolloutImpactNoOp, func(w *WorkerNodeGroupConfiguration) interface{} { return w.MachineHealthCheck }},
+}
+
+// ClusterFieldChanges compares the specs of two versions of a cluster and classifies every changed
+// field by its impact on the machines of the cluster. Worker node groups are matched by name: added
+// and removed groups create or delete machines without replacing the existing ones.
+func ClusterFieldChanges(old, new *Cluster) []FieldChange {
+	var changes []FieldChange
+	for _, f := range append(append([]specField{}, clusterFields...), controlPlaneFields...) {
+		if !equality.Semantic.DeepEqual(f.value(&old.Spec), f.value(&new.Spec)) {
+			changes = append(changes, FieldChange{Path: f.path, Impact: f.impact})
+		}
+	}
+
+	if old.Spec.KubernetesVersion != new.Spec.KubernetesVersion {
+		var strategy UpgradeRolloutStrategyType
+		if new.Spec.ControlPlaneConfiguration.UpgradeRolloutStrategy != nil {
+			strategy = new.Spec.ControlPlaneConfiguration.UpgradeRolloutStrategy.Type
+		}
+		changes = append(changes, FieldChange{Path: "spec.kubernetesVersion", Impact: kubernetesVersionImpact(strategy)})
+	}
+
+	oldGroups := make(map[string]*WorkerNodeGroupConfiguration, len(old.Spec.WorkerNodeGroupConfigurations))
+	for i := range old.Spec.WorkerNodeGroupConfigurations {
+		oldGroups[old.Spec.WorkerNodeGroupConfigurations[i].Name] = &old.Spec.WorkerNodeGroupConfigurations[i]
+	}
+
+	newGroups := make(map[string]bool, len(new.Spec.WorkerNodeGroupConfigurations))
+	for i := range new.Spec.WorkerNodeGroupConfigurations {
+		newGroup := &new.Spec.WorkerNodeGroupConfigurations[i]
+		newGroups[newGroup.Name] = true
+		path := fmt.Sprintf("spec.workerNodeGroupConfigurations[%s]", newGroup.Name)
+		oldGroup, ok := oldGroups[newGroup.Name]
+		if !ok {
+			changes = append(changes, FieldChange{Path: path, Impact: RolloutImpactInPlace})
+			continue
+		}
+
+		for _, f := range workerNodeGroupFields {
+			if !equality.Semantic.DeepEqual(f.value(oldGroup), f.value(newGroup)) {
+				changes = append(changes, FieldChange{Path: path + "." + f.name, Impact: f.impact})
+			}
+		}
+
+		if workerNodeGroupKubernetesVersion(old, oldGroup) != workerNodeGroupKubernetesVersion(new, newGroup) {
+			var strategy UpgradeRolloutStrategyType
+			if newGroup.UpgradeRolloutStrategy != nil {
+				strategy = newGroup.UpgradeRolloutStrategy.Type
+			}
+			changes = append(changes, FieldChange{Path: path + ".kubernetesVersion", Impact: kubernetesVersionImpact(strategy)})
+		}
+	}
+
+	for _, oldGroup := range old.Spec.WorkerNodeGroupConfigurations {
+		if !newGroups[oldGroup.Name] {
+			changes = append(changes, FieldChange{
+				Path:   fmt.Sprintf("spec.workerNodeGroupConfigurations[%s]", oldGroup.Name),
+				Impact: RolloutImpactInPlace,
+			})
+		}
+	}
+
+	return changes
+}
+
+func workerNodeGroupKubernetesVersion(c *Cluster, w *WorkerNodeGroupConfiguration) KubernetesVersion {
+	if w.KubernetesVersion != nil {
+		return *w.KubernetesVersion
+	}
+
+	return c.Spec.KubernetesVersion
+}
+
+// kubernetesVersionImpact returns the impact of a kubernetes version upgrade for an upgrade rollout
+// strategy type: only the InPlace strategy upgrades the machines without replacing them.
+func kubernetesVersionImpact(strategy UpgradeRolloutStrategyType) RolloutImpact {
+	if strategy == InPlaceStrategyType {
+		return RolloutImpactInPlace
+	}
+
+	return RolloutImpactRollout
+}
+
+// RolloutAcknowledged returns whether the cluster acknowledges the machine rollouts of its update
+// from the old version of the cluster, by setting the acknowledge rollout annotation to a new value.
+func (c *Cluster) RolloutAcknowledged(old *Cluster) bool {
+	value := c.Annotations[AcknowledgeRolloutAnnotation]
+	return value != "" && value != old.Annotations[AcknowledgeRolloutAnnotation]
+}
+
+// AcknowledgeRollout sets the acknowledge rollout annotation to the given value, which needs to be
+// different from the one of the current version of the cluster.
+func (c *Cluster) AcknowledgeRollout(value string) {
+	if c.Annotations == nil {
+		c.Annotations = map[string]string{}
+	}
+	c.Annotations[AcknowledgeRolloutAnnotation] = value
+}
+
+func validateRolloutAcknowledged(new, old *Cluster) field.ErrorList {
+	if old.IsReconcilePaused() || new.RolloutAcknowledged(old) {
+		return nil
+	}
+
+	rollouts := RolloutChanges(ClusterFieldChanges(old, new))
+	if len(rollouts) == 0 {
+		return nil
+	}
+
+	return field.ErrorList{
+		field.Forbidden(
+			field.NewPath("metadata", "annotations", AcknowledgeRolloutAnnotation),
+			fmt.Sprintf("changes to %s replace the machines of the cluster, set the annotation to a new value to acknowledge the rollout", FieldChangePaths(rollouts)),
+		),
+	}
+}
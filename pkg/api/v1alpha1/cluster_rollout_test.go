@@ -0,0 +1,138 @@
+package v1alpha1_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/utils/ptr"
+)
+
+func TestClusterFieldChanges(t *testing.T) {
+	tests := []struct {
+		name   string
+		update func(*v1alpha1.Cluster)
+		want   []v1alpha1.FieldChange
+	}{
+		{
+			name:   "no changes",
+			update: func(c *v1alpha1.Cluster) {},
+			want:   nil,
+		},
+		{
+			name: "scale control plane and workers",
+			update: func(c *v1alpha1.Cluster) {
+				c.Spec.ControlPlaneConfiguration.Count = 5
+				c.Spec.WorkerNodeGroupConfigurations[0].Count = ptr.Int(3)
+			},
+			want: []v1alpha1.FieldChange{
+				{Path: "spec.controlPlaneConfiguration.count", Impact: v1alpha1.RolloutImpactInPlace},
+				{Path: "spec.workerNodeGroupConfigurations[md-0].count", Impact: v1alpha1.RolloutImpactInPlace},
+			},
+		},
+		{
+			name: "registry mirror and control plane taints",
+			update: func(c *v1alpha1.Cluster) {
+				c.Spec.RegistryMirrorConfiguration = &v1alpha1.RegistryMirrorConfiguration{Endpoint: "1.2.3.4"}
+				c.Spec.ControlPlaneConfiguration.Taints = []corev1.Taint{{Key: "key", Effect: corev1.TaintEffectNoSchedule}}
+			},
+			want: []v1alpha1.FieldChange{
+				{Path: "spec.registryMirrorConfiguration", Impact: v1alpha1.RolloutImpactRollout},
+				{Path: "spec.controlPlaneConfiguration.taints", Impact: v1alpha1.RolloutImpactRollout},
+			},
+		},
+		{
+			name: "machine health check",
+			update: func(c *v1alpha1.Cluster) {
+				c.Spec.MachineHealthCheck = &v1alpha1.MachineHealthCheck{MaxUnhealthy: nil}
+			},
+			want: []v1alpha1.FieldChange{
+				{Path: "spec.machineHealthCheck", Impact: v1alpha1.RolloutImpactNoOp},
+			},
+		},
+		{
+			name: "kubernetes version with rolling update",
+			update: func(c *v1alpha1.Cluster) {
+				c.Spec.KubernetesVersion = v1alpha1.Kube122
+			},
+			want: []v1alpha1.FieldChange{
+				{Path: "spec.kubernetesVersion", Impact: v1alpha1.RolloutImpactRollout},
+				{Path: "spec.workerNodeGroupConfigurations[md-0].kubernetesVersion", Impact: v1alpha1.RolloutImpactRollout},
+			},
+		},
+		{
+			name: "kubernetes version in-place",
+			update: func(c *v1alpha1.Cluster) {
+				c.Spec.KubernetesVersion = v1alpha1.Kube122
+				c.Spec.ControlPlaneConfiguration.UpgradeRolloutStrategy = &v1alpha1.ControlPlaneUpgradeRolloutStrategy{Type: v1alpha1.InPlaceStrategyType}
+				c.Spec.WorkerNodeGroupConfigurations[0].UpgradeRolloutStrategy = &v1alpha1.WorkerNodesUpgradeRolloutStrategy{Type: v1alpha1.InPlaceStrategyType}
+			},
+			want: []v1alpha1.FieldChange{
+				{Path: "spec.controlPlaneConfiguration.upgradeRolloutStrategy", Impact: v1alpha1.RolloutImpactNoOp},
+				{Path: "spec.kubernetesVersion", Impact: v1alpha1.RolloutImpactInPlace},
+				{Path: "spec.workerNodeGroupConfigurations[md-0].upgradeRolloutStrategy", Impact: v1alpha1.RolloutImpactNoOp},
+				{Path: "spec.workerNodeGroupConfigurations[md-0].kubernetesVersion", Impact: v1alpha1.RolloutImpactInPlace},
+			},
+		},
+		{
+			name: "worker kubernetes version pinned",
+			update: func(c *v1alpha1.Cluster) {
+				kube121 := v1alpha1.Kube121
+				c.Spec.KubernetesVersion = v1alpha1.Kube122
+				c.Spec.WorkerNodeGroupConfigurations[0].KubernetesVersion = &kube121
+			},
+			want: []v1alpha1.FieldChange{
+				{Path: "spec.kubernetesVersion", Impact: v1alpha1.RolloutImpactRollout},
+			},
+		},
+		{
+			name: "add and remove worker node groups",
+			update: func(c *v1alpha1.Cluster) {
+				c.Spec.WorkerNodeGroupConfigurations[0].Name = "md-1"
+			},
+			want: []v1alpha1.FieldChange{
+				{Path: "spec.workerNodeGroupConfigurations[md-1]", Impact: v1alpha1.RolloutImpactInPlace},
+				{Path: "spec.workerNodeGroupConfigurations[md-0]", Impact: v1alpha1.RolloutImpactInPlace},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			old := baseCluster()
+			c := old.DeepCopy()
+			tt.update(c)
+
+			g.Expect(v1alpha1.ClusterFieldChanges(old, c)).To(Equal(tt.want))
+		})
+	}
+}
+
+func TestRolloutChanges(t *testing.T) {
+	g := NewWithT(t)
+	changes := []v1alpha1.FieldChange{
+		{Path: "spec.controlPlaneConfiguration.count", Impact: v1alpha1.RolloutImpactInPlace},
+		{Path: "spec.proxyConfiguration", Impact: v1alpha1.RolloutImpactRollout},
+		{Path: "spec.packages", Impact: v1alpha1.RolloutImpactNoOp},
+		{Path: "spec.controlPlaneConfiguration.labels", Impact: v1alpha1.RolloutImpactRollout},
+	}
+
+	rollouts := v1alpha1.RolloutChanges(changes)
+	g.Expect(rollouts).To(Equal([]v1alpha1.FieldChange{changes[1], changes[3]}))
+	g.Expect(v1alpha1.FieldChangePaths(rollouts)).To(Equal("spec.proxyConfiguration, spec.controlPlaneConfiguration.labels"))
+}
+
+func TestClusterRolloutAcknowledged(t *testing.T) {
+	g := NewWithT(t)
+	old := baseCluster()
+	c := old.DeepCopy()
+	g.Expect(c.RolloutAcknowledged(old)).To(BeFalse())
+
+	c.AcknowledgeRollout("2024-01-01")
+	g.Expect(c.RolloutAcknowledged(old)).To(BeTrue())
+
+	old.AcknowledgeRollout("2024-01-01")
+	g.Expect(c.RolloutAcknowledged(old)).To(BeFalse())
+}
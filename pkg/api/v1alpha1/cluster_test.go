@@ -1777,6 +1777,47 @@ func TestCluster_IsReconcilePaused(t *testing.T) {
 	}
 }
 
+func TestCluster_IsFrozen(t *testing.T) {
+	g := NewWithT(t)
+	c := &Cluster{}
+	g.Expect(c.IsFrozen()).To(BeFalse())
+
+	c.Annotations = map[string]string{FrozenAnnotation: ""}
+	g.Expect(c.IsFrozen()).To(BeTrue())
+	g.Expect(c.FrozenReason()).To(BeEmpty())
+
+	c.Annotations[FrozenAnnotation] = "manual etcd repair"
+	g.Expect(c.FrozenReason()).To(Equal("manual etcd repair"))
+}
+
+func TestCluster_SetFrozenBy(t *testing.T) {
+	g := NewWithT(t)
+	c := &Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Annotations: map[string]string{
+				FrozenAnnotation:   "manual etcd repair",
+				FrozenByAnnotation: "someone-else@example.com",
+			},
+		},
+	}
+
+	c.setFrozenBy(nil, "jane@example.com")
+	g.Expect(c.FrozenBy()).To(Equal("jane@example.com"), "the value set by the client should be ignored")
+
+	old := c.DeepCopy()
+	c.Annotations[FrozenByAnnotation] = "someone-else@example.com"
+	c.setFrozenBy(old, "john@example.com")
+	g.Expect(c.FrozenBy()).To(Equal("jane@example.com"), "the user that froze the cluster should be kept")
+
+	c.setFrozenBy(nil, "")
+	g.Expect(c.Annotations).NotTo(HaveKey(FrozenByAnnotation))
+
+	c.Annotations[FrozenByAnnotation] = "jane@example.com"
+	delete(c.Annotations, FrozenAnnotation)
+	c.setFrozenBy(old, "john@example.com")
+	g.Expect(c.Annotations).NotTo(HaveKey(FrozenByAnnotation))
+}
+
 func TestCluster_AddRemoveManagedByCLIAnnotation(t *testing.T) {
 	g := NewWithT(t)
 	c := &Cluster{
@@ -2140,6 +2181,18 @@ func TestCloudStackEndPointEquals(t *testing.T) {
 			prev: &Endpoint{Host: "host:6443"},
 			new:  &Endpoint{Host: "host::"},
 		},
+		{
+			name: "same ipv6 host, old default port, new no port",
+			want: true,
+			prev: &Endpoint{Host: "[fd00::10]:6443"},
+			new:  &Endpoint{Host: "fd00::10"},
+		},
+		{
+			name: "same ipv6 host, old no port, new custom port",
+			want: false,
+			prev: &Endpoint{Host: "fd00::10"},
+			new:  &Endpoint{Host: "[fd00::10]:6442"},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -3032,259 +3085,484 @@ func TestValidateNetworking(t *testing.T) {
 				},
 			},
 		},
-	}
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			got := validateNetworking(tt.cluster)
-			if !reflect.DeepEqual(tt.wantErr, got) {
-				t.Errorf("%v got = %v, want %v", tt.name, got, tt.wantErr)
-			}
-		})
-	}
-}
-
-func TestValidateCNIConfig(t *testing.T) {
-	tests := []struct {
-		name           string
-		wantErr        error
-		clusterNetwork *ClusterNetwork
-	}{
 		{
-			name:    "CNI plugin not specified",
-			wantErr: fmt.Errorf("validating cniConfig: no cni plugin specified"),
-			clusterNetwork: &ClusterNetwork{
-				CNIConfig: &CNIConfig{},
+			name:    "ipv6 cluster",
+			wantErr: nil,
+			cluster: &Cluster{
+				Spec: ClusterSpec{
+					DatacenterRef: Ref{
+						Kind: VSphereDatacenterKind,
+					},
+					ControlPlaneConfiguration: ControlPlaneConfiguration{
+						Endpoint: &Endpoint{
+							Host: "fd00::10",
+						},
+					},
+					ClusterNetwork: ClusterNetwork{
+						Pods: Pods{
+							CidrBlocks: []string{
+								"fd00:100::/48",
+							},
+						},
+						Services: Services{
+							CidrBlocks: []string{
+								"fd00:200::/108",
+							},
+						},
+						CNIConfig: &CNIConfig{Cilium: &CiliumConfig{}},
+					},
+				},
 			},
 		},
 		{
-			name:    "multiple CNI plugins specified",
-			wantErr: fmt.Errorf("validating cniConfig: cannot specify more than one cni plugins"),
-			clusterNetwork: &ClusterNetwork{
-				CNIConfig: &CNIConfig{
-					Cilium:   &CiliumConfig{},
-					Kindnetd: &KindnetdConfig{},
+			name:    "ipv6 cluster with node CIDR mask size larger than default",
+			wantErr: fmt.Errorf("the size of pod subnet with mask 64 is smaller than or equal to the size of node subnet with mask 64"),
+			cluster: &Cluster{
+				Spec: ClusterSpec{
+					DatacenterRef: Ref{
+						Kind: TinkerbellDatacenterKind,
+					},
+					ClusterNetwork: ClusterNetwork{
+						Pods: Pods{
+							CidrBlocks: []string{
+								"fd00:100::/64",
+							},
+						},
+						Services: Services{
+							CidrBlocks: []string{
+								"fd00:200::/108",
+							},
+						},
+						CNIConfig: &CNIConfig{Cilium: &CiliumConfig{}},
+					},
 				},
 			},
 		},
 		{
-			name:    "directmode needs native routing CIDR",
-			wantErr: fmt.Errorf("validating cniConfig: direct routing mode requires IPv4NativeRoutingCIDR to be set"),
-			clusterNetwork: &ClusterNetwork{
-				CNIConfig: &CNIConfig{
-					Cilium: &CiliumConfig{
-						RoutingMode: CiliumRoutingModeDirect,
+			name:    "ipv6 pods and ipv4 services",
+			wantErr: fmt.Errorf("pods CIDR block fd00:100::/48 is IPv6 but services CIDR block 10.96.0.0/12 is IPv4, both must be of the same IP family"),
+			cluster: &Cluster{
+				Spec: ClusterSpec{
+					DatacenterRef: Ref{
+						Kind: VSphereDatacenterKind,
+					},
+					ClusterNetwork: ClusterNetwork{
+						Pods: Pods{
+							CidrBlocks: []string{
+								"fd00:100::/48",
+							},
+						},
+						Services: Services{
+							CidrBlocks: []string{
+								"10.96.0.0/12",
+							},
+						},
+						CNIConfig: &CNIConfig{Cilium: &CiliumConfig{}},
 					},
 				},
 			},
 		},
 		{
-			name:    "invalid cilium policy enforcement mode",
-			wantErr: fmt.Errorf("validating cniConfig: cilium policyEnforcementMode \"invalid\" not supported"),
-			clusterNetwork: &ClusterNetwork{
-				CNIConfig: &CNIConfig{
-					Cilium: &CiliumConfig{
-						PolicyEnforcementMode: "invalid",
+			name:    "ipv6 cluster unsupported provider",
+			wantErr: fmt.Errorf("IPv6 clusters are not supported for CloudStackDatacenterConfig, only for VSphereDatacenterConfig and TinkerbellDatacenterConfig"),
+			cluster: &Cluster{
+				Spec: ClusterSpec{
+					DatacenterRef: Ref{
+						Kind: CloudStackDatacenterKind,
+					},
+					ClusterNetwork: ClusterNetwork{
+						Pods: Pods{
+							CidrBlocks: []string{
+								"fd00:100::/48",
+							},
+						},
+						Services: Services{
+							CidrBlocks: []string{
+								"fd00:200::/108",
+							},
+						},
+						CNIConfig: &CNIConfig{Cilium: &CiliumConfig{}},
 					},
 				},
 			},
 		},
 		{
-			name:    "invalid cilium policy enforcement mode and > 1 plugins",
-			wantErr: fmt.Errorf("validating cniConfig: [cilium policyEnforcementMode \"invalid\" not supported, cannot specify more than one cni plugins]"),
-			clusterNetwork: &ClusterNetwork{
-				CNIConfig: &CNIConfig{
-					Cilium: &CiliumConfig{
-						PolicyEnforcementMode: "invalid",
+			name:    "ipv6 cluster without cilium",
+			wantErr: fmt.Errorf("IPv6 clusters are only supported with the Cilium CNI"),
+			cluster: &Cluster{
+				Spec: ClusterSpec{
+					DatacenterRef: Ref{
+						Kind: VSphereDatacenterKind,
+					},
+					ClusterNetwork: ClusterNetwork{
+						Pods: Pods{
+							CidrBlocks: []string{
+								"fd00:100::/48",
+							},
+						},
+						Services: Services{
+							CidrBlocks: []string{
+								"fd00:200::/108",
+							},
+						},
+						CNIConfig: &CNIConfig{Calico: &CalicoConfig{}},
 					},
-					Kindnetd: &KindnetdConfig{},
 				},
 			},
 		},
 		{
-			name:    "valid cilium policy enforcement mode",
-			wantErr: nil,
-			clusterNetwork: &ClusterNetwork{
-				CNIConfig: &CNIConfig{
-					Cilium: &CiliumConfig{
-						PolicyEnforcementMode: "default",
+			name:    "ipv6 cluster with ipv4 control plane endpoint",
+			wantErr: fmt.Errorf("control plane endpoint 10.0.0.10 must be an IPv6 address for IPv6 clusters"),
+			cluster: &Cluster{
+				Spec: ClusterSpec{
+					DatacenterRef: Ref{
+						Kind: VSphereDatacenterKind,
+					},
+					ControlPlaneConfiguration: ControlPlaneConfiguration{
+						Endpoint: &Endpoint{
+							Host: "10.0.0.10",
+						},
+					},
+					ClusterNetwork: ClusterNetwork{
+						Pods: Pods{
+							CidrBlocks: []string{
+								"fd00:100::/48",
+							},
+						},
+						Services: Services{
+							CidrBlocks: []string{
+								"fd00:200::/108",
+							},
+						},
+						CNIConfig: &CNIConfig{Cilium: &CiliumConfig{}},
 					},
 				},
 			},
 		},
 		{
-			name:    "valid cilium policy enforcement mode",
-			wantErr: nil,
-			clusterNetwork: &ClusterNetwork{
-				CNIConfig: &CNIConfig{
-					Cilium: &CiliumConfig{
-						PolicyEnforcementMode: "always",
+			name:    "ipv6 cluster direct routing without ipv6 native routing CIDR",
+			wantErr: fmt.Errorf("validating cniConfig: direct routing mode requires IPv6NativeRoutingCIDR to be set for IPv6 clusters"),
+			cluster: &Cluster{
+				Spec: ClusterSpec{
+					DatacenterRef: Ref{
+						Kind: VSphereDatacenterKind,
+					},
+					ClusterNetwork: ClusterNetwork{
+						Pods: Pods{
+							CidrBlocks: []string{
+								"fd00:100::/48",
+							},
+						},
+						Services: Services{
+							CidrBlocks: []string{
+								"fd00:200::/108",
+							},
+						},
+						CNIConfig: &CNIConfig{Cilium: &CiliumConfig{RoutingMode: CiliumRoutingModeDirect, IPv4NativeRoutingCIDR: "10.0.0.0/16"}},
 					},
 				},
 			},
 		},
 		{
-			name:    "valid cilium policy enforcement mode",
+			name:    "valid dual-stack ipv4 primary",
 			wantErr: nil,
-			clusterNetwork: &ClusterNetwork{
-				CNIConfig: &CNIConfig{
-					Cilium: &CiliumConfig{
-						PolicyEnforcementMode: "never",
+			cluster: &Cluster{
+				Spec: ClusterSpec{
+					DatacenterRef: Ref{
+						Kind: VSphereDatacenterKind,
+					},
+					ControlPlaneConfiguration: ControlPlaneConfiguration{
+						Endpoint: &Endpoint{
+							Host: "10.0.0.10",
+						},
+					},
+					ClusterNetwork: ClusterNetwork{
+						Pods: Pods{
+							CidrBlocks: []string{
+								"192.168.0.0/16",
+								"fd00:100::/48",
+							},
+						},
+						Services: Services{
+							CidrBlocks: []string{
+								"10.96.0.0/12",
+								"fd00:200::/108",
+							},
+						},
+						CNIConfig: &CNIConfig{Cilium: &CiliumConfig{}},
 					},
 				},
 			},
 		},
 		{
-			name:    "CiliumSkipUpgradeWithoutOtherFields",
+			name:    "valid dual-stack ipv6 primary",
 			wantErr: nil,
-			clusterNetwork: &ClusterNetwork{
-				CNIConfig: &CNIConfig{
-					Cilium: &CiliumConfig{
-						SkipUpgrade: ptr.Bool(true),
+			cluster: &Cluster{
+				Spec: ClusterSpec{
+					DatacenterRef: Ref{
+						Kind: TinkerbellDatacenterKind,
+					},
+					ControlPlaneConfiguration: ControlPlaneConfiguration{
+						Endpoint: &Endpoint{
+							Host: "fd00:10::10",
+						},
+					},
+					ClusterNetwork: ClusterNetwork{
+						Pods: Pods{
+							CidrBlocks: []string{
+								"fd00:100::/48",
+								"192.168.0.0/16",
+							},
+						},
+						Services: Services{
+							CidrBlocks: []string{
+								"fd00:200::/108",
+								"10.96.0.0/12",
+							},
+						},
+						CNIConfig: &CNIConfig{Cilium: &CiliumConfig{}},
 					},
 				},
 			},
 		},
 		{
-			name: "CiliumSkipUpgradeWithOtherFields",
-			wantErr: fmt.Errorf("validating cniConfig: when using skipUpgrades for cilium all " +
-				"other fields must be empty"),
-			clusterNetwork: &ClusterNetwork{
-				CNIConfig: &CNIConfig{
-					Cilium: &CiliumConfig{
-						SkipUpgrade:           ptr.Bool(true),
-						PolicyEnforcementMode: "never",
-					},
-				},
+			name:    "dual-stack node cidr mask size applies to ipv4",
+			wantErr: nil,
+			cluster: &Cluster{
+				Spec: ClusterSpec{
+					DatacenterRef: Ref{
+						Kind: VSphereDatacenterKind,
+					},
+					ClusterNetwork: ClusterNetwork{
+						Pods: Pods{
+							CidrBlocks: []string{
+								"192.168.0.0/16",
+								"fd00:100::/56",
+							},
+						},
+						Services: Services{
+							CidrBlocks: []string{
+								"10.96.0.0/12",
+								"fd00:200::/108",
+							},
+						},
+						Nodes: &Nodes{
+							CIDRMaskSize: ptr.Int(28),
+						},
+						CNIConfig: &CNIConfig{Cilium: &CiliumConfig{}},
+					},
+				},
 			},
 		},
 		{
-			name: "CiliumSkipUpgradeExplicitFalseWithOtherFields",
-			clusterNetwork: &ClusterNetwork{
-				CNIConfig: &CNIConfig{
-					Cilium: &CiliumConfig{
-						SkipUpgrade:           ptr.Bool(false),
-						PolicyEnforcementMode: "never",
+			name:    "more than two pods CIDR blocks",
+			wantErr: fmt.Errorf("at most two CIDR blocks for Pods, one IPv4 and one IPv6, are supported"),
+			cluster: &Cluster{
+				Spec: ClusterSpec{
+					DatacenterRef: Ref{
+						Kind: VSphereDatacenterKind,
+					},
+					ClusterNetwork: ClusterNetwork{
+						Pods: Pods{
+							CidrBlocks: []string{
+								"192.168.0.0/16",
+								"fd00:100::/48",
+								"10.1.0.0/16",
+							},
+						},
+						Services: Services{
+							CidrBlocks: []string{
+								"10.96.0.0/12",
+								"fd00:200::/108",
+							},
+						},
+						CNIConfig: &CNIConfig{Cilium: &CiliumConfig{}},
 					},
 				},
 			},
 		},
-	}
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			got := validateCNIConfig(tt.clusterNetwork.CNIConfig)
-			if !reflect.DeepEqual(tt.wantErr, got) {
-				t.Errorf("%v got = %v, want %v", tt.name, got, tt.wantErr)
-			}
-		})
-	}
-}
-
-func TestValidateMirrorConfig(t *testing.T) {
-	tests := []struct {
-		name    string
-		wantErr string
-		cluster *Cluster
-	}{
 		{
-			name:    "registry mirror not specified",
-			wantErr: "",
+			name:    "dual-stack pods CIDR blocks of the same family",
+			wantErr: fmt.Errorf("pods CIDR blocks 192.168.0.0/16 and 10.1.0.0/16 are both IPv4, dual-stack clusters require one IPv4 and one IPv6 CIDR block"),
 			cluster: &Cluster{
 				Spec: ClusterSpec{
-					RegistryMirrorConfiguration: nil,
+					DatacenterRef: Ref{
+						Kind: VSphereDatacenterKind,
+					},
+					ClusterNetwork: ClusterNetwork{
+						Pods: Pods{
+							CidrBlocks: []string{
+								"192.168.0.0/16",
+								"10.1.0.0/16",
+							},
+						},
+						Services: Services{
+							CidrBlocks: []string{
+								"10.96.0.0/12",
+								"fd00:200::/108",
+							},
+						},
+						CNIConfig: &CNIConfig{Cilium: &CiliumConfig{}},
+					},
 				},
 			},
 		},
 		{
-			name:    "endpoint not specified",
-			wantErr: "no value set for RegistryMirrorConfiguration.Endpoint",
+			name:    "dual-stack pods with single-stack services",
+			wantErr: fmt.Errorf("pods have 2 CIDR blocks but services have 1, dual-stack clusters require two CIDR blocks for both"),
 			cluster: &Cluster{
 				Spec: ClusterSpec{
-					RegistryMirrorConfiguration: &RegistryMirrorConfiguration{
-						Endpoint: "",
+					DatacenterRef: Ref{
+						Kind: VSphereDatacenterKind,
+					},
+					ClusterNetwork: ClusterNetwork{
+						Pods: Pods{
+							CidrBlocks: []string{
+								"192.168.0.0/16",
+								"fd00:100::/48",
+							},
+						},
+						Services: Services{
+							CidrBlocks: []string{
+								"10.96.0.0/12",
+							},
+						},
+						CNIConfig: &CNIConfig{Cilium: &CiliumConfig{}},
 					},
 				},
 			},
 		},
 		{
-			name:    "invalid port",
-			wantErr: "registry mirror port 65536 is invalid",
+			name:    "dual-stack pods and services in different order",
+			wantErr: fmt.Errorf("the first pods CIDR block 192.168.0.0/16 is IPv4 but the first services CIDR block fd00:200::/108 is IPv6, both must be of the primary IP family of the cluster"),
 			cluster: &Cluster{
 				Spec: ClusterSpec{
-					RegistryMirrorConfiguration: &RegistryMirrorConfiguration{
-						Endpoint: "1.2.3.4",
-						Port:     "65536",
+					DatacenterRef: Ref{
+						Kind: VSphereDatacenterKind,
+					},
+					ClusterNetwork: ClusterNetwork{
+						Pods: Pods{
+							CidrBlocks: []string{
+								"192.168.0.0/16",
+								"fd00:100::/48",
+							},
+						},
+						Services: Services{
+							CidrBlocks: []string{
+								"fd00:200::/108",
+								"10.96.0.0/12",
+							},
+						},
+						CNIConfig: &CNIConfig{Cilium: &CiliumConfig{}},
 					},
 				},
 			},
 		},
 		{
-			name:    "multiple mappings for curated packages",
-			wantErr: "only one registry mirror for curated packages is suppported",
+			name:    "dual-stack cluster unsupported provider",
+			wantErr: fmt.Errorf("dual-stack clusters are not supported for NutanixDatacenterConfig, only for VSphereDatacenterConfig and TinkerbellDatacenterConfig"),
 			cluster: &Cluster{
 				Spec: ClusterSpec{
-					RegistryMirrorConfiguration: &RegistryMirrorConfiguration{
-						Endpoint: "1.2.3.4",
-						Port:     "30003",
-						OCINamespaces: []OCINamespace{
-							{
-								Registry:  "783794618700.dkr.ecr.us-west-2.amazonaws.com",
-								Namespace: "",
+					DatacenterRef: Ref{
+						Kind: NutanixDatacenterKind,
+					},
+					ClusterNetwork: ClusterNetwork{
+						Pods: Pods{
+							CidrBlocks: []string{
+								"192.168.0.0/16",
+								"fd00:100::/48",
 							},
-							{
-								Registry:  "783794618700.dkr.ecr.us-east-1.amazonaws.com",
-								Namespace: "",
+						},
+						Services: Services{
+							CidrBlocks: []string{
+								"10.96.0.0/12",
+								"fd00:200::/108",
 							},
 						},
+						CNIConfig: &CNIConfig{Cilium: &CiliumConfig{}},
 					},
 				},
 			},
 		},
 		{
-			name:    "empty registry in OCINamespace",
-			wantErr: "registry can't be set to empty in OCINamespaces",
+			name:    "dual-stack cluster without cilium",
+			wantErr: fmt.Errorf("dual-stack clusters are only supported with the Cilium CNI"),
 			cluster: &Cluster{
 				Spec: ClusterSpec{
-					RegistryMirrorConfiguration: &RegistryMirrorConfiguration{
-						Endpoint: "1.2.3.4",
-						Port:     "30003",
-						OCINamespaces: []OCINamespace{
-							{
-								Registry:  "",
-								Namespace: "",
+					DatacenterRef: Ref{
+						Kind: VSphereDatacenterKind,
+					},
+					ClusterNetwork: ClusterNetwork{
+						Pods: Pods{
+							CidrBlocks: []string{
+								"192.168.0.0/16",
+								"fd00:100::/48",
+							},
+						},
+						Services: Services{
+							CidrBlocks: []string{
+								"10.96.0.0/12",
+								"fd00:200::/108",
 							},
 						},
+						CNIConfig: &CNIConfig{Calico: &CalicoConfig{}},
 					},
 				},
 			},
 		},
 		{
-			name:    "insecureSkipVerify on snow provider",
-			wantErr: "",
+			name:    "dual-stack cluster with control plane endpoint not of the primary family",
+			wantErr: fmt.Errorf("control plane endpoint fd00:10::10 must be an IPv4 address, the primary IP family of the cluster"),
 			cluster: &Cluster{
 				Spec: ClusterSpec{
-					RegistryMirrorConfiguration: &RegistryMirrorConfiguration{
-						Endpoint:           "1.2.3.4",
-						Port:               "443",
-						InsecureSkipVerify: true,
-					},
 					DatacenterRef: Ref{
-						Kind: SnowDatacenterKind,
+						Kind: VSphereDatacenterKind,
+					},
+					ControlPlaneConfiguration: ControlPlaneConfiguration{
+						Endpoint: &Endpoint{
+							Host: "fd00:10::10",
+						},
+					},
+					ClusterNetwork: ClusterNetwork{
+						Pods: Pods{
+							CidrBlocks: []string{
+								"192.168.0.0/16",
+								"fd00:100::/48",
+							},
+						},
+						Services: Services{
+							CidrBlocks: []string{
+								"10.96.0.0/12",
+								"fd00:200::/108",
+							},
+						},
+						CNIConfig: &CNIConfig{Cilium: &CiliumConfig{}},
 					},
 				},
 			},
 		},
 		{
-			name:    "insecureSkipVerify on nutanix provider",
-			wantErr: "",
+			name:    "dual-stack cluster direct routing without ipv6 native routing CIDR",
+			wantErr: fmt.Errorf("validating cniConfig: direct routing mode requires IPv6NativeRoutingCIDR to be set for dual-stack clusters"),
 			cluster: &Cluster{
 				Spec: ClusterSpec{
-					RegistryMirrorConfiguration: &RegistryMirrorConfiguration{
-						Endpoint:           "1.2.3.4",
-						Port:               "443",
-						InsecureSkipVerify: true,
-					},
 					DatacenterRef: Ref{
-						Kind: NutanixDatacenterKind,
+						Kind: VSphereDatacenterKind,
+					},
+					ClusterNetwork: ClusterNetwork{
+						Pods: Pods{
+							CidrBlocks: []string{
+								"192.168.0.0/16",
+								"fd00:100::/48",
+							},
+						},
+						Services: Services{
+							CidrBlocks: []string{
+								"10.96.0.0/12",
+								"fd00:200::/108",
+							},
+						},
+						CNIConfig: &CNIConfig{Cilium: &CiliumConfig{RoutingMode: CiliumRoutingModeDirect, IPv4NativeRoutingCIDR: "10.0.0.0/16"}},
 					},
 				},
 			},
@@ -3292,237 +3570,191 @@ func TestValidateMirrorConfig(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			g := NewWithT(t)
-			err := validateMirrorConfig(tt.cluster)
-			if tt.wantErr == "" {
-				g.Expect(err).To(BeNil())
-			} else {
-				g.Expect(err).To(MatchError(ContainSubstring(tt.wantErr)))
+			got := validateNetworking(tt.cluster)
+			if !reflect.DeepEqual(tt.wantErr, got) {
+				t.Errorf("%v got = %v, want %v", tt.name, got, tt.wantErr)
 			}
 		})
 	}
 }
 
-func TestValidateAutoscalingConfig(t *testing.T) {
+func TestValidateCNIConfig(t *testing.T) {
 	tests := []struct {
-		name                         string
-		wantErr                      string
-		workerNodeGroupConfiguration *WorkerNodeGroupConfiguration
+		name           string
+		wantErr        error
+		clusterNetwork *ClusterNetwork
 	}{
 		{
-			name:                         "autoscaling config nil",
-			wantErr:                      "",
-			workerNodeGroupConfiguration: nil,
+			name:    "CNI plugin not specified",
+			wantErr: fmt.Errorf("validating cniConfig: no cni plugin specified"),
+			clusterNetwork: &ClusterNetwork{
+				CNIConfig: &CNIConfig{},
+			},
 		},
 		{
-			name:    "autoscaling config valid",
-			wantErr: "",
-			workerNodeGroupConfiguration: &WorkerNodeGroupConfiguration{
-				Count: ptr.Int(2),
-				AutoScalingConfiguration: &AutoScalingConfiguration{
-					MinCount: 1,
-					MaxCount: 2,
+			name:    "multiple CNI plugins specified",
+			wantErr: fmt.Errorf("validating cniConfig: cannot specify more than one cni plugins"),
+			clusterNetwork: &ClusterNetwork{
+				CNIConfig: &CNIConfig{
+					Cilium:   &CiliumConfig{},
+					Kindnetd: &KindnetdConfig{},
 				},
 			},
 		},
 		{
-			name:    "negative min count",
-			wantErr: "min count must be non negative",
-			workerNodeGroupConfiguration: &WorkerNodeGroupConfiguration{
-				Count: ptr.Int(1),
-				AutoScalingConfiguration: &AutoScalingConfiguration{
-					MinCount: -1,
+			name:    "cilium and calico specified",
+			wantErr: fmt.Errorf("validating cniConfig: cannot specify more than one cni plugins"),
+			clusterNetwork: &ClusterNetwork{
+				CNIConfig: &CNIConfig{
+					Cilium: &CiliumConfig{},
+					Calico: &CalicoConfig{},
 				},
 			},
 		},
 		{
-			name:    "min count > max count",
-			wantErr: "min count must be no greater than max count",
-			workerNodeGroupConfiguration: &WorkerNodeGroupConfiguration{
-				Count: ptr.Int(1),
-				AutoScalingConfiguration: &AutoScalingConfiguration{
-					MinCount: 2,
-					MaxCount: 1,
+			name:    "valid calico",
+			wantErr: nil,
+			clusterNetwork: &ClusterNetwork{
+				CNIConfig: &CNIConfig{
+					Calico: &CalicoConfig{},
 				},
 			},
 		},
 		{
-			name:    "count < min count",
-			wantErr: "min count must be less than or equal to count",
-			workerNodeGroupConfiguration: &WorkerNodeGroupConfiguration{
-				Count: ptr.Int(1),
-				AutoScalingConfiguration: &AutoScalingConfiguration{
-					MinCount: 2,
-					MaxCount: 3,
+			name:    "directmode needs native routing CIDR",
+			wantErr: fmt.Errorf("validating cniConfig: direct routing mode requires IPv4NativeRoutingCIDR to be set"),
+			clusterNetwork: &ClusterNetwork{
+				CNIConfig: &CNIConfig{
+					Cilium: &CiliumConfig{
+						RoutingMode: CiliumRoutingModeDirect,
+					},
 				},
 			},
 		},
 		{
-			name:    "count > max count",
-			wantErr: "max count must be greater than or equal to count",
-			workerNodeGroupConfiguration: &WorkerNodeGroupConfiguration{
-				Count: ptr.Int(4),
-				AutoScalingConfiguration: &AutoScalingConfiguration{
-					MinCount: 2,
-					MaxCount: 3,
+			name:    "hubble ui needs relay",
+			wantErr: fmt.Errorf("validating cniConfig: cilium hubble ui requires hubble relay to be enabled"),
+			clusterNetwork: &ClusterNetwork{
+				CNIConfig: &CNIConfig{
+					Cilium: &CiliumConfig{
+						Hubble: &CiliumHubbleConfig{UI: true},
+					},
 				},
 			},
 		},
 		{
-			name:    "count < 0 with nil autoscaling",
-			wantErr: "worker node count must be zero or greater if autoscaling is not enabled",
-			workerNodeGroupConfiguration: &WorkerNodeGroupConfiguration{
-				Count: ptr.Int(-1),
+			name:    "empty hubble metric",
+			wantErr: fmt.Errorf("validating cniConfig: cilium hubble metrics can't be empty"),
+			clusterNetwork: &ClusterNetwork{
+				CNIConfig: &CNIConfig{
+					Cilium: &CiliumConfig{
+						Hubble: &CiliumHubbleConfig{Metrics: []string{"dns", " "}},
+					},
+				},
 			},
 		},
 		{
-			name:    "nil autoscaling",
-			wantErr: "",
-			workerNodeGroupConfiguration: &WorkerNodeGroupConfiguration{
-				Count: ptr.Int(1),
+			name:    "valid hubble",
+			wantErr: nil,
+			clusterNetwork: &ClusterNetwork{
+				CNIConfig: &CNIConfig{
+					Cilium: &CiliumConfig{
+						Hubble: &CiliumHubbleConfig{
+							Relay:   true,
+							UI:      true,
+							Metrics: []string{"dns:query;ignoreAAAA", "drop"},
+						},
+					},
+				},
 			},
 		},
-	}
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			g := NewWithT(t)
-			err := validateAutoscalingConfig(tt.workerNodeGroupConfiguration)
-			if tt.wantErr == "" {
-				g.Expect(err).To(BeNil())
-			} else {
-				g.Expect(err).To(MatchError(ContainSubstring(tt.wantErr)))
-			}
-		})
-	}
-}
-
-func TestClusterRegistryAuth(t *testing.T) {
-	tests := []struct {
-		name    string
-		cluster *Cluster
-		want    bool
-	}{
 		{
-			name: "with registry mirror auth",
-			cluster: &Cluster{
-				Spec: ClusterSpec{
-					RegistryMirrorConfiguration: &RegistryMirrorConfiguration{
-						Endpoint:     "1.2.3.4",
-						Port:         "443",
-						Authenticate: true,
+			name:    "invalid cilium policy enforcement mode",
+			wantErr: fmt.Errorf("validating cniConfig: cilium policyEnforcementMode \"invalid\" not supported"),
+			clusterNetwork: &ClusterNetwork{
+				CNIConfig: &CNIConfig{
+					Cilium: &CiliumConfig{
+						PolicyEnforcementMode: "invalid",
 					},
 				},
 			},
-			want: true,
 		},
 		{
-			name: "without registry mirror auth",
-			cluster: &Cluster{
-				Spec: ClusterSpec{
-					RegistryMirrorConfiguration: &RegistryMirrorConfiguration{
-						Endpoint: "1.2.3.4",
-						Port:     "443",
+			name:    "invalid cilium policy enforcement mode and > 1 plugins",
+			wantErr: fmt.Errorf("validating cniConfig: [cilium policyEnforcementMode \"invalid\" not supported, cannot specify more than one cni plugins]"),
+			clusterNetwork: &ClusterNetwork{
+				CNIConfig: &CNIConfig{
+					Cilium: &CiliumConfig{
+						PolicyEnforcementMode: "invalid",
 					},
+					Kindnetd: &KindnetdConfig{},
 				},
 			},
-			want: false,
 		},
 		{
-			name:    "without registry mirror",
-			cluster: &Cluster{},
-			want:    false,
+			name:    "valid cilium policy enforcement mode",
+			wantErr: nil,
+			clusterNetwork: &ClusterNetwork{
+				CNIConfig: &CNIConfig{
+					Cilium: &CiliumConfig{
+						PolicyEnforcementMode: "default",
+					},
+				},
+			},
 		},
-	}
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			g := NewWithT(t)
-			g.Expect(tt.cluster.RegistryAuth()).To(Equal(tt.want))
-		})
-	}
-}
-
-func TestClusterProxyConfiguration(t *testing.T) {
-	tests := []struct {
-		name    string
-		cluster *Cluster
-		want    map[string]string
-	}{
 		{
-			name: "with proxy configuration",
-			cluster: &Cluster{
-				Spec: ClusterSpec{
-					ControlPlaneConfiguration: ControlPlaneConfiguration{
-						Endpoint: &Endpoint{
-							Host: "1.2.3.4",
-						},
-					},
-					ProxyConfiguration: &ProxyConfiguration{
-						HttpProxy:  "test-http",
-						HttpsProxy: "test-https",
-						NoProxy:    []string{"test-noproxy-1", "test-noproxy-2", "test-noproxy-3"},
+			name:    "valid cilium policy enforcement mode",
+			wantErr: nil,
+			clusterNetwork: &ClusterNetwork{
+				CNIConfig: &CNIConfig{
+					Cilium: &CiliumConfig{
+						PolicyEnforcementMode: "always",
 					},
 				},
 			},
-			want: map[string]string{
-				"HTTP_PROXY":  "test-http",
-				"HTTPS_PROXY": "test-https",
-				"NO_PROXY":    "test-noproxy-1,test-noproxy-2,test-noproxy-3,1.2.3.4",
-			},
 		},
 		{
-			name:    "without proxy configuration",
-			cluster: &Cluster{},
-			want:    nil,
+			name:    "valid cilium policy enforcement mode",
+			wantErr: nil,
+			clusterNetwork: &ClusterNetwork{
+				CNIConfig: &CNIConfig{
+					Cilium: &CiliumConfig{
+						PolicyEnforcementMode: "never",
+					},
+				},
+			},
 		},
-	}
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			g := NewWithT(t)
-			g.Expect(tt.cluster.ProxyConfiguration()).To(Equal(tt.want))
-		})
-	}
-}
-
-func TestValidateControlPlaneEndpoint(t *testing.T) {
-	tests := []struct {
-		name    string
-		wantErr string
-		cluster *Cluster
-	}{
 		{
-			name:    "docker provider - control plane endpoint is not set",
-			wantErr: "",
-			cluster: &Cluster{
-				Spec: ClusterSpec{
-					DatacenterRef: Ref{
-						Kind: DockerDatacenterKind,
+			name:    "CiliumSkipUpgradeWithoutOtherFields",
+			wantErr: nil,
+			clusterNetwork: &ClusterNetwork{
+				CNIConfig: &CNIConfig{
+					Cilium: &CiliumConfig{
+						SkipUpgrade: ptr.Bool(true),
 					},
-					ControlPlaneConfiguration: ControlPlaneConfiguration{},
 				},
 			},
 		},
 		{
-			name:    "control plane ip is not set",
-			wantErr: "cluster controlPlaneConfiguration.Endpoint.Host is not set or is empty",
-			cluster: &Cluster{
-				Spec: ClusterSpec{
-					ControlPlaneConfiguration: ControlPlaneConfiguration{
-						Endpoint: &Endpoint{
-							Host: "",
-						},
+			name: "CiliumSkipUpgradeWithOtherFields",
+			wantErr: fmt.Errorf("validating cniConfig: when using skipUpgrades for cilium all " +
+				"other fields must be empty"),
+			clusterNetwork: &ClusterNetwork{
+				CNIConfig: &CNIConfig{
+					Cilium: &CiliumConfig{
+						SkipUpgrade:           ptr.Bool(true),
+						PolicyEnforcementMode: "never",
 					},
 				},
 			},
 		},
 		{
-			name:    "control plane ip is set",
-			wantErr: "",
-			cluster: &Cluster{
-				Spec: ClusterSpec{
-					ControlPlaneConfiguration: ControlPlaneConfiguration{
-						Endpoint: &Endpoint{
-							Host: "test-ip",
-						},
+			name: "CiliumSkipUpgradeExplicitFalseWithOtherFields",
+			clusterNetwork: &ClusterNetwork{
+				CNIConfig: &CNIConfig{
+					Cilium: &CiliumConfig{
+						SkipUpgrade:           ptr.Bool(false),
+						PolicyEnforcementMode: "never",
 					},
 				},
 			},
@@ -3530,210 +3762,144 @@ func TestValidateControlPlaneEndpoint(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			g := NewWithT(t)
-			err := validateControlPlaneEndpoint(tt.cluster)
-			if tt.wantErr == "" {
-				g.Expect(err).To(BeNil())
-			} else {
-				g.Expect(err).To(MatchError(ContainSubstring(tt.wantErr)))
+			got := validateCNIConfig(tt.clusterNetwork.CNIConfig, tt.clusterNetwork.IPFamily())
+			if !reflect.DeepEqual(tt.wantErr, got) {
+				t.Errorf("%v got = %v, want %v", tt.name, got, tt.wantErr)
 			}
 		})
 	}
 }
 
-func TestValidateControlPlaneReplicas(t *testing.T) {
+func TestValidateMirrorConfig(t *testing.T) {
 	tests := []struct {
 		name    string
 		wantErr string
 		cluster *Cluster
 	}{
 		{
-			name:    "Odd CP replicas with stacked etcd",
+			name:    "registry mirror not specified",
 			wantErr: "",
 			cluster: &Cluster{
 				Spec: ClusterSpec{
-					ControlPlaneConfiguration: ControlPlaneConfiguration{
-						Count: 3,
-					},
+					RegistryMirrorConfiguration: nil,
 				},
 			},
 		},
 		{
-			name:    "Even CP replicas with stacked etcd",
-			wantErr: "control plane node count cannot be an even number when using stacked etcd topology",
+			name:    "endpoint not specified",
+			wantErr: "no value set for RegistryMirrorConfiguration.Endpoint",
 			cluster: &Cluster{
 				Spec: ClusterSpec{
-					ControlPlaneConfiguration: ControlPlaneConfiguration{
-						Count: 2,
+					RegistryMirrorConfiguration: &RegistryMirrorConfiguration{
+						Endpoint: "",
 					},
 				},
 			},
 		},
 		{
-			name:    "Odd CP replicas with unstacked etcd",
-			wantErr: "",
+			name:    "invalid port",
+			wantErr: "registry mirror port 65536 is invalid",
 			cluster: &Cluster{
 				Spec: ClusterSpec{
-					ControlPlaneConfiguration: ControlPlaneConfiguration{
-						Count: 3,
-					},
-					ExternalEtcdConfiguration: &ExternalEtcdConfiguration{
-						Count: 1,
+					RegistryMirrorConfiguration: &RegistryMirrorConfiguration{
+						Endpoint: "1.2.3.4",
+						Port:     "65536",
 					},
 				},
 			},
 		},
 		{
-			name:    "Odd CP replicas with unstacked etcd",
-			wantErr: "",
+			name:    "multiple mappings for curated packages",
+			wantErr: "only one registry mirror for curated packages is suppported",
 			cluster: &Cluster{
 				Spec: ClusterSpec{
-					ControlPlaneConfiguration: ControlPlaneConfiguration{
-						Count: 2,
-					},
-					ExternalEtcdConfiguration: &ExternalEtcdConfiguration{
-						Count: 1,
+					RegistryMirrorConfiguration: &RegistryMirrorConfiguration{
+						Endpoint: "1.2.3.4",
+						Port:     "30003",
+						OCINamespaces: []OCINamespace{
+							{
+								Registry:  "783794618700.dkr.ecr.us-west-2.amazonaws.com",
+								Namespace: "",
+							},
+							{
+								Registry:  "783794618700.dkr.ecr.us-east-1.amazonaws.com",
+								Namespace: "",
+							},
+						},
 					},
 				},
 			},
 		},
-	}
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			g := NewWithT(t)
-			err := validateControlPlaneReplicas(tt.cluster)
-			if tt.wantErr == "" {
-				g.Expect(err).To(BeNil())
-			} else {
-				g.Expect(err).To(MatchError(ContainSubstring(tt.wantErr)))
-			}
-		})
-	}
-}
-
-func TestValidateCPUpgradeRolloutStrategy(t *testing.T) {
-	tests := []struct {
-		name           string
-		wantErr        string
-		featureEnvVars []string
-		cluster        *Cluster
-	}{
 		{
-			name:    "rolling upgrade strategy invalid",
-			wantErr: "ControlPlaneConfiguration: only 'RollingUpdate' and 'InPlace' are supported for upgrade rollout strategy type",
+			name:    "empty registry in OCINamespace",
+			wantErr: "registry can't be set to empty in OCINamespaces",
 			cluster: &Cluster{
 				Spec: ClusterSpec{
-					ControlPlaneConfiguration: ControlPlaneConfiguration{
-						UpgradeRolloutStrategy: &ControlPlaneUpgradeRolloutStrategy{Type: "NotRollingUpdate"},
+					RegistryMirrorConfiguration: &RegistryMirrorConfiguration{
+						Endpoint: "1.2.3.4",
+						Port:     "30003",
+						OCINamespaces: []OCINamespace{
+							{
+								Registry:  "",
+								Namespace: "",
+							},
+						},
 					},
 				},
 			},
 		},
 		{
-			name:    "rolling upgrade knobs not specified",
+			name:    "ipv6 endpoint",
 			wantErr: "",
 			cluster: &Cluster{
 				Spec: ClusterSpec{
-					ControlPlaneConfiguration: ControlPlaneConfiguration{
-						UpgradeRolloutStrategy: &ControlPlaneUpgradeRolloutStrategy{Type: "RollingUpdate"},
-					},
-				},
-			},
-		},
-		{
-			name:    "rolling upgrade invalid",
-			wantErr: "maxSurge for control plane must be 0 or 1",
-			cluster: &Cluster{
-				Spec: ClusterSpec{
-					ControlPlaneConfiguration: ControlPlaneConfiguration{
-						UpgradeRolloutStrategy: &ControlPlaneUpgradeRolloutStrategy{Type: "RollingUpdate", RollingUpdate: &ControlPlaneRollingUpdateParams{MaxSurge: 2}},
+					RegistryMirrorConfiguration: &RegistryMirrorConfiguration{
+						Endpoint: "fd00::20",
+						Port:     "443",
 					},
 				},
 			},
 		},
 		{
-			name:    "rolling upgrade knobs valid value 0",
-			wantErr: "",
+			name:    "bracketed ipv6 endpoint",
+			wantErr: "registry mirror endpoint [fd00::20] is invalid, please provide IPv6 addresses without brackets",
 			cluster: &Cluster{
 				Spec: ClusterSpec{
-					ControlPlaneConfiguration: ControlPlaneConfiguration{
-						UpgradeRolloutStrategy: &ControlPlaneUpgradeRolloutStrategy{Type: "RollingUpdate", RollingUpdate: &ControlPlaneRollingUpdateParams{MaxSurge: 0}},
+					RegistryMirrorConfiguration: &RegistryMirrorConfiguration{
+						Endpoint: "[fd00::20]",
+						Port:     "443",
 					},
 				},
 			},
 		},
 		{
-			name:    "rolling upgrade knobs valid value 1",
+			name:    "insecureSkipVerify on snow provider",
 			wantErr: "",
 			cluster: &Cluster{
 				Spec: ClusterSpec{
-					ControlPlaneConfiguration: ControlPlaneConfiguration{
-						UpgradeRolloutStrategy: &ControlPlaneUpgradeRolloutStrategy{Type: "RollingUpdate", RollingUpdate: &ControlPlaneRollingUpdateParams{MaxSurge: 1}},
-					},
-				},
-			},
-		},
-		{
-			name:    "rolling upgrade knobs invalid value -1",
-			wantErr: "ControlPlaneConfiguration: maxSurge for control plane cannot be a negative value",
-			cluster: &Cluster{
-				Spec: ClusterSpec{
-					ControlPlaneConfiguration: ControlPlaneConfiguration{
-						UpgradeRolloutStrategy: &ControlPlaneUpgradeRolloutStrategy{Type: "RollingUpdate", RollingUpdate: &ControlPlaneRollingUpdateParams{MaxSurge: -1}},
+					RegistryMirrorConfiguration: &RegistryMirrorConfiguration{
+						Endpoint:           "1.2.3.4",
+						Port:               "443",
+						InsecureSkipVerify: true,
 					},
-				},
-			},
-		},
-		{
-			name:    "in place upgrade - tinkerbell",
-			wantErr: "",
-			cluster: &Cluster{
-				Spec: ClusterSpec{
 					DatacenterRef: Ref{
-						Kind: TinkerbellDatacenterKind,
-					},
-					ControlPlaneConfiguration: ControlPlaneConfiguration{
-						UpgradeRolloutStrategy: &ControlPlaneUpgradeRolloutStrategy{Type: "InPlace"},
+						Kind: SnowDatacenterKind,
 					},
 				},
 			},
 		},
 		{
-			name:    "in place upgrade - provider not supported",
-			wantErr: "ControlPlaneConfiguration: 'InPlace' upgrade rollout strategy type is only supported on Bare Metal",
+			name:    "insecureSkipVerify on nutanix provider",
+			wantErr: "",
 			cluster: &Cluster{
 				Spec: ClusterSpec{
-					ControlPlaneConfiguration: ControlPlaneConfiguration{
-						UpgradeRolloutStrategy: &ControlPlaneUpgradeRolloutStrategy{Type: "InPlace"},
+					RegistryMirrorConfiguration: &RegistryMirrorConfiguration{
+						Endpoint:           "1.2.3.4",
+						Port:               "443",
+						InsecureSkipVerify: true,
 					},
-				},
-			},
-		},
-		{
-			name:           "in place upgrade - vsphere, external etcd",
-			wantErr:        "stacked etcd must be configured when performing in place upgrades",
-			featureEnvVars: []string{features.VSphereInPlaceEnvVar},
-			cluster: &Cluster{
-				Spec: ClusterSpec{
 					DatacenterRef: Ref{
-						Kind: VSphereDatacenterKind,
-					},
-					ControlPlaneConfiguration: ControlPlaneConfiguration{
-						UpgradeRolloutStrategy: &ControlPlaneUpgradeRolloutStrategy{Type: "InPlace"},
-					},
-					ExternalEtcdConfiguration: &ExternalEtcdConfiguration{
-						Count: 3,
-					},
-				},
-			},
-		},
-		{
-			name:    "in place upgrade - rollingUpdate field specified",
-			wantErr: "ControlPlaneConfiguration: RollingUpdate field must be empty for 'InPlace' upgrade rollout strategy type",
-			cluster: &Cluster{
-				Spec: ClusterSpec{
-					ControlPlaneConfiguration: ControlPlaneConfiguration{
-						UpgradeRolloutStrategy: &ControlPlaneUpgradeRolloutStrategy{Type: "InPlace", RollingUpdate: &ControlPlaneRollingUpdateParams{MaxSurge: 1}},
+						Kind: NutanixDatacenterKind,
 					},
 				},
 			},
@@ -3742,290 +3908,1968 @@ func TestValidateCPUpgradeRolloutStrategy(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			g := NewWithT(t)
-			for _, e := range tt.featureEnvVars {
-				t.Setenv(e, "true")
-			}
-			err := validateCPUpgradeRolloutStrategy(tt.cluster)
+			err := validateMirrorConfig(tt.cluster)
 			if tt.wantErr == "" {
 				g.Expect(err).To(BeNil())
 			} else {
 				g.Expect(err).To(MatchError(ContainSubstring(tt.wantErr)))
 			}
-			features.ClearCache()
 		})
 	}
 }
 
-func TestValidateMDUpgradeRolloutStrategy(t *testing.T) {
+func TestValidateAutoscalingConfig(t *testing.T) {
 	tests := []struct {
-		name    string
-		wantErr string
-		cluster *Cluster
+		name                         string
+		wantErr                      string
+		workerNodeGroupConfiguration *WorkerNodeGroupConfiguration
 	}{
 		{
-			name:    "rolling upgrade strategy invalid",
-			wantErr: "WorkerNodeGroupConfiguration: only 'RollingUpdate' and 'InPlace' are supported for upgrade rollout strategy type",
-			cluster: &Cluster{
-				Spec: ClusterSpec{
-					WorkerNodeGroupConfigurations: []WorkerNodeGroupConfiguration{{
-						UpgradeRolloutStrategy: &WorkerNodesUpgradeRolloutStrategy{Type: "NotRollingUpdate"},
-					}},
-				},
-			},
+			name:                         "autoscaling config nil",
+			wantErr:                      "",
+			workerNodeGroupConfiguration: nil,
 		},
 		{
-			name:    "rolling upgrade knobs not specified",
-			wantErr: "WorkerNodeGroupConfiguration: upgradeRolloutStrategy.rollingUpdate field is required for upgradeRolloutStrategy.type RollingUpdate",
-			cluster: &Cluster{
-				Spec: ClusterSpec{
-					WorkerNodeGroupConfigurations: []WorkerNodeGroupConfiguration{{
-						UpgradeRolloutStrategy: &WorkerNodesUpgradeRolloutStrategy{Type: "RollingUpdate"},
-					}},
+			name:    "autoscaling config valid",
+			wantErr: "",
+			workerNodeGroupConfiguration: &WorkerNodeGroupConfiguration{
+				Count: ptr.Int(2),
+				AutoScalingConfiguration: &AutoScalingConfiguration{
+					MinCount: 1,
+					MaxCount: 2,
 				},
 			},
 		},
 		{
-			name:    "rolling upgrade invalid",
-			wantErr: "WorkerNodeGroupConfiguration: maxSurge and maxUnavailable not specified or are 0. maxSurge and maxUnavailable cannot both be 0",
-			cluster: &Cluster{
-				Spec: ClusterSpec{
-					WorkerNodeGroupConfigurations: []WorkerNodeGroupConfiguration{{
-						UpgradeRolloutStrategy: &WorkerNodesUpgradeRolloutStrategy{Type: "RollingUpdate", RollingUpdate: &WorkerNodesRollingUpdateParams{MaxSurge: 0, MaxUnavailable: 0}},
-					}},
+			name:    "negative min count",
+			wantErr: "min count must be non negative",
+			workerNodeGroupConfiguration: &WorkerNodeGroupConfiguration{
+				Count: ptr.Int(1),
+				AutoScalingConfiguration: &AutoScalingConfiguration{
+					MinCount: -1,
 				},
 			},
 		},
 		{
-			name:    "rolling upgrade knobs valid value 0,1",
-			wantErr: "",
-			cluster: &Cluster{
-				Spec: ClusterSpec{
-					WorkerNodeGroupConfigurations: []WorkerNodeGroupConfiguration{{
-						UpgradeRolloutStrategy: &WorkerNodesUpgradeRolloutStrategy{Type: "RollingUpdate", RollingUpdate: &WorkerNodesRollingUpdateParams{MaxSurge: 0, MaxUnavailable: 1}},
-					}},
+			name:    "min count > max count",
+			wantErr: "min count must be no greater than max count",
+			workerNodeGroupConfiguration: &WorkerNodeGroupConfiguration{
+				Count: ptr.Int(1),
+				AutoScalingConfiguration: &AutoScalingConfiguration{
+					MinCount: 2,
+					MaxCount: 1,
 				},
 			},
 		},
 		{
-			name:    "rolling upgrade knobs valid value 1,0",
-			wantErr: "",
-			cluster: &Cluster{
-				Spec: ClusterSpec{
-					WorkerNodeGroupConfigurations: []WorkerNodeGroupConfiguration{{
-						UpgradeRolloutStrategy: &WorkerNodesUpgradeRolloutStrategy{Type: "RollingUpdate", RollingUpdate: &WorkerNodesRollingUpdateParams{MaxSurge: 1, MaxUnavailable: 0}},
-					}},
+			name:    "count < min count",
+			wantErr: "min count must be less than or equal to count",
+			workerNodeGroupConfiguration: &WorkerNodeGroupConfiguration{
+				Count: ptr.Int(1),
+				AutoScalingConfiguration: &AutoScalingConfiguration{
+					MinCount: 2,
+					MaxCount: 3,
 				},
 			},
 		},
 		{
-			name:    "rolling upgrade knobs valid value 5,0",
-			wantErr: "",
-			cluster: &Cluster{
-				Spec: ClusterSpec{
-					WorkerNodeGroupConfigurations: []WorkerNodeGroupConfiguration{{
-						UpgradeRolloutStrategy: &WorkerNodesUpgradeRolloutStrategy{Type: "RollingUpdate", RollingUpdate: &WorkerNodesRollingUpdateParams{MaxSurge: 5, MaxUnavailable: 0}},
-					}},
+			name:    "count > max count",
+			wantErr: "max count must be greater than or equal to count",
+			workerNodeGroupConfiguration: &WorkerNodeGroupConfiguration{
+				Count: ptr.Int(4),
+				AutoScalingConfiguration: &AutoScalingConfiguration{
+					MinCount: 2,
+					MaxCount: 3,
 				},
 			},
 		},
 		{
-			name:    "rolling upgrade knobs valid value 3,1",
-			wantErr: "",
-			cluster: &Cluster{
-				Spec: ClusterSpec{
-					WorkerNodeGroupConfigurations: []WorkerNodeGroupConfiguration{{
-						UpgradeRolloutStrategy: &WorkerNodesUpgradeRolloutStrategy{Type: "RollingUpdate", RollingUpdate: &WorkerNodesRollingUpdateParams{MaxSurge: 3, MaxUnavailable: 1}},
-					}},
-				},
+			name:    "count < 0 with nil autoscaling",
+			wantErr: "worker node count must be zero or greater if autoscaling is not enabled",
+			workerNodeGroupConfiguration: &WorkerNodeGroupConfiguration{
+				Count: ptr.Int(-1),
 			},
 		},
 		{
-			name:    "rolling upgrade knobs invalid values 3,-1",
+			name:    "nil autoscaling",
+			wantErr: "",
+			workerNodeGroupConfiguration: &WorkerNodeGroupConfiguration{
+				Count: ptr.Int(1),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			err := validateAutoscalingConfig(tt.workerNodeGroupConfiguration)
+			if tt.wantErr == "" {
+				g.Expect(err).To(BeNil())
+			} else {
+				g.Expect(err).To(MatchError(ContainSubstring(tt.wantErr)))
+			}
+		})
+	}
+}
+
+func TestValidateAutoscalingProvisioner(t *testing.T) {
+	tests := []struct {
+		name              string
+		wantErr           string
+		datacenterRefKind string
+		featureEnabled    bool
+		autoscaling       *AutoScalingConfiguration
+		failureDomains    []string
+	}{
+		{
+			name:              "nil autoscaling",
+			datacenterRefKind: DockerDatacenterKind,
+		},
+		{
+			name:              "default provisioner",
+			datacenterRefKind: DockerDatacenterKind,
+			autoscaling:       &AutoScalingConfiguration{MinCount: 1, MaxCount: 3},
+		},
+		{
+			name:              "cluster autoscaler provisioner",
+			datacenterRefKind: DockerDatacenterKind,
+			autoscaling:       &AutoScalingConfiguration{MinCount: 1, MaxCount: 3, Provisioner: ClusterAutoscalerProvisioner},
+		},
+		{
+			name:              "on demand provisioner",
+			datacenterRefKind: VSphereDatacenterKind,
+			featureEnabled:    true,
+			autoscaling:       &AutoScalingConfiguration{MinCount: 0, MaxCount: 3, Provisioner: OnDemandProvisioner},
+			failureDomains:    []string{"fd-1"},
+		},
+		{
+			name:              "on demand provisioner not vSphere",
+			wantErr:           "provisioner OnDemand is only supported for vSphere",
+			datacenterRefKind: TinkerbellDatacenterKind,
+			featureEnabled:    true,
+			autoscaling:       &AutoScalingConfiguration{MinCount: 1, MaxCount: 3, Provisioner: OnDemandProvisioner},
+		},
+		{
+			name:              "on demand provisioner feature disabled",
+			wantErr:           "provisioner OnDemand is not enabled, set VSPHERE_ON_DEMAND_PROVISIONER=true to use it",
+			datacenterRefKind: VSphereDatacenterKind,
+			autoscaling:       &AutoScalingConfiguration{MinCount: 1, MaxCount: 3, Provisioner: OnDemandProvisioner},
+		},
+		{
+			name:              "on demand provisioner multiple failure domains",
+			wantErr:           "provisioner OnDemand is not supported for worker node groups with more than one failure domain",
+			datacenterRefKind: VSphereDatacenterKind,
+			featureEnabled:    true,
+			autoscaling:       &AutoScalingConfiguration{MinCount: 1, MaxCount: 3, Provisioner: OnDemandProvisioner},
+			failureDomains:    []string{"fd-1", "fd-2"},
+		},
+		{
+			name:              "on demand provisioner zero max count",
+			wantErr:           "max count must be greater than 0 for provisioner OnDemand",
+			datacenterRefKind: VSphereDatacenterKind,
+			featureEnabled:    true,
+			autoscaling:       &AutoScalingConfiguration{Provisioner: OnDemandProvisioner},
+		},
+		{
+			name:              "unknown provisioner",
+			wantErr:           "provisioner Karpenter is not supported, supported provisioners are ClusterAutoscaler and OnDemand",
+			datacenterRefKind: VSphereDatacenterKind,
+			autoscaling:       &AutoScalingConfiguration{MinCount: 1, MaxCount: 3, Provisioner: "Karpenter"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			features.ClearCache()
+			if tt.featureEnabled {
+				t.Setenv(features.VSphereOnDemandProvisionerEnvVar, "true")
+			}
+			t.Cleanup(features.ClearCache)
+
+			w := &WorkerNodeGroupConfiguration{
+				Count:                    ptr.Int(1),
+				AutoScalingConfiguration: tt.autoscaling,
+				FailureDomains:           tt.failureDomains,
+			}
+			err := validateAutoscalingProvisioner(w, tt.datacenterRefKind)
+			if tt.wantErr == "" {
+				g.Expect(err).To(BeNil())
+			} else {
+				g.Expect(err).To(MatchError(tt.wantErr))
+			}
+		})
+	}
+}
+
+func TestAutoScalingConfigurationEqualProvisioner(t *testing.T) {
+	g := NewWithT(t)
+	defaultProvisioner := &AutoScalingConfiguration{MinCount: 1, MaxCount: 3}
+	clusterAutoscaler := &AutoScalingConfiguration{MinCount: 1, MaxCount: 3, Provisioner: ClusterAutoscalerProvisioner}
+	onDemand := &AutoScalingConfiguration{MinCount: 1, MaxCount: 3, Provisioner: OnDemandProvisioner}
+
+	g.Expect(defaultProvisioner.Equal(clusterAutoscaler)).To(BeTrue())
+	g.Expect(defaultProvisioner.Equal(onDemand)).To(BeFalse())
+	g.Expect(onDemand.OnDemand()).To(BeTrue())
+	g.Expect(clusterAutoscaler.OnDemand()).To(BeFalse())
+}
+
+func TestClusterRegistryAuth(t *testing.T) {
+	tests := []struct {
+		name    string
+		cluster *Cluster
+		want    bool
+	}{
+		{
+			name: "with registry mirror auth",
+			cluster: &Cluster{
+				Spec: ClusterSpec{
+					RegistryMirrorConfiguration: &RegistryMirrorConfiguration{
+						Endpoint:     "1.2.3.4",
+						Port:         "443",
+						Authenticate: true,
+					},
+				},
+			},
+			want: true,
+		},
+		{
+			name: "without registry mirror auth",
+			cluster: &Cluster{
+				Spec: ClusterSpec{
+					RegistryMirrorConfiguration: &RegistryMirrorConfiguration{
+						Endpoint: "1.2.3.4",
+						Port:     "443",
+					},
+				},
+			},
+			want: false,
+		},
+		{
+			name:    "without registry mirror",
+			cluster: &Cluster{},
+			want:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(tt.cluster.RegistryAuth()).To(Equal(tt.want))
+		})
+	}
+}
+
+func TestClusterProxyConfiguration(t *testing.T) {
+	tests := []struct {
+		name    string
+		cluster *Cluster
+		want    map[string]string
+	}{
+		{
+			name: "with proxy configuration",
+			cluster: &Cluster{
+				Spec: ClusterSpec{
+					ControlPlaneConfiguration: ControlPlaneConfiguration{
+						Endpoint: &Endpoint{
+							Host: "1.2.3.4",
+						},
+					},
+					ProxyConfiguration: &ProxyConfiguration{
+						HttpProxy:  "test-http",
+						HttpsProxy: "test-https",
+						NoProxy:    []string{"test-noproxy-1", "test-noproxy-2", "test-noproxy-3"},
+					},
+				},
+			},
+			want: map[string]string{
+				"HTTP_PROXY":  "test-http",
+				"HTTPS_PROXY": "test-https",
+				"NO_PROXY":    "test-noproxy-1,test-noproxy-2,test-noproxy-3,1.2.3.4",
+			},
+		},
+		{
+			name:    "without proxy configuration",
+			cluster: &Cluster{},
+			want:    nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(tt.cluster.ProxyConfiguration()).To(Equal(tt.want))
+		})
+	}
+}
+
+func TestValidateControlPlaneEndpoint(t *testing.T) {
+	tests := []struct {
+		name    string
+		wantErr string
+		cluster *Cluster
+	}{
+		{
+			name:    "docker provider - control plane endpoint is not set",
+			wantErr: "",
+			cluster: &Cluster{
+				Spec: ClusterSpec{
+					DatacenterRef: Ref{
+						Kind: DockerDatacenterKind,
+					},
+					ControlPlaneConfiguration: ControlPlaneConfiguration{},
+				},
+			},
+		},
+		{
+			name:    "control plane ip is not set",
+			wantErr: "cluster controlPlaneConfiguration.Endpoint.Host is not set or is empty",
+			cluster: &Cluster{
+				Spec: ClusterSpec{
+					ControlPlaneConfiguration: ControlPlaneConfiguration{
+						Endpoint: &Endpoint{
+							Host: "",
+						},
+					},
+				},
+			},
+		},
+		{
+			name:    "control plane ip is set",
+			wantErr: "",
+			cluster: &Cluster{
+				Spec: ClusterSpec{
+					ControlPlaneConfiguration: ControlPlaneConfiguration{
+						Endpoint: &Endpoint{
+							Host: "test-ip",
+						},
+					},
+				},
+			},
+		},
+		{
+			name:    "dns record with route53",
+			wantErr: "",
+			cluster: &Cluster{
+				Spec: ClusterSpec{
+					ControlPlaneConfiguration: ControlPlaneConfiguration{
+						Endpoint: &Endpoint{
+							Host: "cp.example.com",
+							DNS:  &EndpointDNS{Provider: Route53DNSProvider, Address: "10.0.0.5", Zone: "Z123"},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:    "dns record with infoblox and port",
+			wantErr: "",
+			cluster: &Cluster{
+				Spec: ClusterSpec{
+					ControlPlaneConfiguration: ControlPlaneConfiguration{
+						Endpoint: &Endpoint{
+							Host: "cp.example.com:6443",
+							DNS:  &EndpointDNS{Provider: InfobloxDNSProvider, Address: "10.0.0.5"},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:    "dns record for an ip",
+			wantErr: "cluster controlPlaneConfiguration.Endpoint.DNS is invalid: host 10.0.0.5 must be a hostname to create a DNS record for it",
+			cluster: &Cluster{
+				Spec: ClusterSpec{
+					ControlPlaneConfiguration: ControlPlaneConfiguration{
+						Endpoint: &Endpoint{
+							Host: "10.0.0.5",
+							DNS:  &EndpointDNS{Provider: InfobloxDNSProvider, Address: "10.0.0.5"},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:    "dns record with route53 without zone",
+			wantErr: "zone must be set to the hosted zone ID for the route53 provider",
+			cluster: &Cluster{
+				Spec: ClusterSpec{
+					ControlPlaneConfiguration: ControlPlaneConfiguration{
+						Endpoint: &Endpoint{
+							Host: "cp.example.com",
+							DNS:  &EndpointDNS{Provider: Route53DNSProvider, Address: "10.0.0.5"},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:    "dns record with unknown provider",
+			wantErr: "provider bind is not supported",
+			cluster: &Cluster{
+				Spec: ClusterSpec{
+					ControlPlaneConfiguration: ControlPlaneConfiguration{
+						Endpoint: &Endpoint{
+							Host: "cp.example.com",
+							DNS:  &EndpointDNS{Provider: "bind", Address: "10.0.0.5"},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:    "dns record with invalid address",
+			wantErr: "address cp is not a valid IPv4 address",
+			cluster: &Cluster{
+				Spec: ClusterSpec{
+					ControlPlaneConfiguration: ControlPlaneConfiguration{
+						Endpoint: &Endpoint{
+							Host: "cp.example.com",
+							DNS:  &EndpointDNS{Provider: InfobloxDNSProvider, Address: "cp"},
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			err := validateControlPlaneEndpoint(tt.cluster)
+			if tt.wantErr == "" {
+				g.Expect(err).To(BeNil())
+			} else {
+				g.Expect(err).To(MatchError(ContainSubstring(tt.wantErr)))
+			}
+		})
+	}
+}
+
+func TestValidateExternalDNS(t *testing.T) {
+	tests := []struct {
+		name        string
+		wantErr     string
+		externalDNS *ExternalDNSConfiguration
+	}{
+		{
+			name:        "no external dns",
+			wantErr:     "",
+			externalDNS: nil,
+		},
+		{
+			name:    "route53",
+			wantErr: "",
+			externalDNS: &ExternalDNSConfiguration{
+				Provider:              Route53DNSProvider,
+				DomainFilters:         []string{"example.com", "apps.example.org"},
+				CredentialsSecretName: "route53-credentials",
+			},
+		},
+		{
+			name:    "infoblox without domain filters",
+			wantErr: "",
+			externalDNS: &ExternalDNSConfiguration{
+				Provider:              InfobloxDNSProvider,
+				CredentialsSecretName: "infoblox-credentials",
+			},
+		},
+		{
+			name:    "unsupported provider",
+			wantErr: "cluster externalDns.provider azure is not supported",
+			externalDNS: &ExternalDNSConfiguration{
+				Provider:              "azure",
+				CredentialsSecretName: "azure-credentials",
+			},
+		},
+		{
+			name:    "invalid domain filter",
+			wantErr: "cluster externalDns.domainFilters Example_.com is not a valid domain",
+			externalDNS: &ExternalDNSConfiguration{
+				Provider:              Route53DNSProvider,
+				DomainFilters:         []string{"Example_.com"},
+				CredentialsSecretName: "route53-credentials",
+			},
+		},
+		{
+			name:    "missing credentials secret",
+			wantErr: "cluster externalDns.credentialsSecretName is required",
+			externalDNS: &ExternalDNSConfiguration{
+				Provider: Route53DNSProvider,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			cluster := &Cluster{
+				Spec: ClusterSpec{
+					ExternalDNS: tt.externalDNS,
+				},
+			}
+			err := validateExternalDNS(cluster)
+			if tt.wantErr == "" {
+				g.Expect(err).To(BeNil())
+			} else {
+				g.Expect(err).To(MatchError(ContainSubstring(tt.wantErr)))
+			}
+		})
+	}
+}
+
+func TestValidateDefaultStorageClass(t *testing.T) {
+	tests := []struct {
+		name         string
+		wantErr      string
+		storageClass *DefaultStorageClass
+	}{
+		{
+			name:         "no default storage class",
+			wantErr:      "",
+			storageClass: nil,
+		},
+		{
+			name:    "provisioner with parameters and reclaim policy",
+			wantErr: "",
+			storageClass: &DefaultStorageClass{
+				Provisioner:   "csi.vsphere.vmware.com",
+				Parameters:    map[string]string{"storagepolicyname": "gold"},
+				ReclaimPolicy: v1.PersistentVolumeReclaimRetain,
+			},
+		},
+		{
+			name:    "missing provisioner",
+			wantErr: "cluster defaultStorageClass.provisioner is required",
+			storageClass: &DefaultStorageClass{
+				ReclaimPolicy: v1.PersistentVolumeReclaimDelete,
+			},
+		},
+		{
+			name:    "invalid provisioner",
+			wantErr: "cluster defaultStorageClass.provisioner kubernetes.io/vsphere-volume is not a valid CSI driver name",
+			storageClass: &DefaultStorageClass{
+				Provisioner: "kubernetes.io/vsphere-volume",
+			},
+		},
+		{
+			name:    "unsupported reclaim policy",
+			wantErr: "cluster defaultStorageClass.reclaimPolicy Recycle is not supported",
+			storageClass: &DefaultStorageClass{
+				Provisioner:   "csi.vsphere.vmware.com",
+				ReclaimPolicy: v1.PersistentVolumeReclaimRecycle,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			cluster := &Cluster{
+				Spec: ClusterSpec{
+					DefaultStorageClass: tt.storageClass,
+				},
+			}
+			err := validateDefaultStorageClass(cluster)
+			if tt.wantErr == "" {
+				g.Expect(err).To(BeNil())
+			} else {
+				g.Expect(err).To(MatchError(ContainSubstring(tt.wantErr)))
+			}
+		})
+	}
+}
+
+func TestValidateServiceLoadBalancer(t *testing.T) {
+	tests := []struct {
+		name           string
+		wantErr        string
+		datacenterKind string
+		loadBalancer   *ServiceLoadBalancerConfiguration
+	}{
+		{
+			name:           "no service load balancer",
+			wantErr:        "",
+			datacenterKind: DockerDatacenterKind,
+			loadBalancer:   nil,
+		},
+		{
+			name:           "cidr and range on vsphere",
+			wantErr:        "",
+			datacenterKind: VSphereDatacenterKind,
+			loadBalancer: &ServiceLoadBalancerConfiguration{
+				KubeVip: &KubeVipServiceLoadBalancer{
+					AddressPool: []string{"10.0.0.64/28", "10.0.0.100-10.0.0.120"},
+				},
+			},
+		},
+		{
+			name:           "ipv6 range on tinkerbell",
+			wantErr:        "",
+			datacenterKind: TinkerbellDatacenterKind,
+			loadBalancer: &ServiceLoadBalancerConfiguration{
+				KubeVip: &KubeVipServiceLoadBalancer{
+					AddressPool: []string{"fd00::10-fd00::20"},
+				},
+			},
+		},
+		{
+			name:           "unsupported provider",
+			wantErr:        "cluster serviceLoadBalancer is not supported for DockerDatacenterConfig",
+			datacenterKind: DockerDatacenterKind,
+			loadBalancer: &ServiceLoadBalancerConfiguration{
+				KubeVip: &KubeVipServiceLoadBalancer{
+					AddressPool: []string{"10.0.0.64/28"},
+				},
+			},
+		},
+		{
+			name:           "missing kube-vip",
+			wantErr:        "cluster serviceLoadBalancer.kubeVip is required",
+			datacenterKind: VSphereDatacenterKind,
+			loadBalancer:   &ServiceLoadBalancerConfiguration{},
+		},
+		{
+			name:           "empty address pool",
+			wantErr:        "cluster serviceLoadBalancer.kubeVip.addressPool can't be empty",
+			datacenterKind: VSphereDatacenterKind,
+			loadBalancer: &ServiceLoadBalancerConfiguration{
+				KubeVip: &KubeVipServiceLoadBalancer{},
+			},
+		},
+		{
+			name:           "invalid cidr",
+			wantErr:        "10.0.0.64/33 is not a valid CIDR",
+			datacenterKind: VSphereDatacenterKind,
+			loadBalancer: &ServiceLoadBalancerConfiguration{
+				KubeVip: &KubeVipServiceLoadBalancer{
+					AddressPool: []string{"10.0.0.64/33"},
+				},
+			},
+		},
+		{
+			name:           "single ip",
+			wantErr:        "10.0.0.64 is not a valid CIDR or IP range",
+			datacenterKind: VSphereDatacenterKind,
+			loadBalancer: &ServiceLoadBalancerConfiguration{
+				KubeVip: &KubeVipServiceLoadBalancer{
+					AddressPool: []string{"10.0.0.64"},
+				},
+			},
+		},
+		{
+			name:           "invalid range",
+			wantErr:        "10.0.0.100-10.0.0 is not a valid IP range",
+			datacenterKind: VSphereDatacenterKind,
+			loadBalancer: &ServiceLoadBalancerConfiguration{
+				KubeVip: &KubeVipServiceLoadBalancer{
+					AddressPool: []string{"10.0.0.100-10.0.0"},
+				},
+			},
+		},
+		{
+			name:           "mixed ip families",
+			wantErr:        "10.0.0.100-fd00::20 mixes IPv4 and IPv6 addresses",
+			datacenterKind: VSphereDatacenterKind,
+			loadBalancer: &ServiceLoadBalancerConfiguration{
+				KubeVip: &KubeVipServiceLoadBalancer{
+					AddressPool: []string{"10.0.0.100-fd00::20"},
+				},
+			},
+		},
+		{
+			name:           "reversed range",
+			wantErr:        "10.0.0.120-10.0.0.100 starts after it ends",
+			datacenterKind: VSphereDatacenterKind,
+			loadBalancer: &ServiceLoadBalancerConfiguration{
+				KubeVip: &KubeVipServiceLoadBalancer{
+					AddressPool: []string{"10.0.0.120-10.0.0.100"},
+				},
+			},
+		},
+		{
+			name:           "pool includes control plane endpoint",
+			wantErr:        "cluster serviceLoadBalancer.kubeVip.addressPool 10.0.0.0/24 includes the control plane endpoint 10.0.0.10",
+			datacenterKind: VSphereDatacenterKind,
+			loadBalancer: &ServiceLoadBalancerConfiguration{
+				KubeVip: &KubeVipServiceLoadBalancer{
+					AddressPool: []string{"10.0.0.0/24"},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			cluster := &Cluster{
+				Spec: ClusterSpec{
+					DatacenterRef: Ref{
+						Kind: tt.datacenterKind,
+					},
+					ControlPlaneConfiguration: ControlPlaneConfiguration{
+						Endpoint: &Endpoint{
+							Host: "10.0.0.10",
+						},
+					},
+					ServiceLoadBalancer: tt.loadBalancer,
+				},
+			}
+			err := validateServiceLoadBalancer(cluster)
+			if tt.wantErr == "" {
+				g.Expect(err).To(BeNil())
+			} else {
+				g.Expect(err).To(MatchError(ContainSubstring(tt.wantErr)))
+			}
+		})
+	}
+}
+
+func TestValidateGPU(t *testing.T) {
+	tests := []struct {
+		name           string
+		wantErr        string
+		datacenterKind string
+		gpu            *GPUConfiguration
+	}{
+		{
+			name:           "no gpu",
+			datacenterKind: DockerDatacenterKind,
+		},
+		{
+			name:           "gpu on vsphere",
+			datacenterKind: VSphereDatacenterKind,
+			gpu:            &GPUConfiguration{},
+		},
+		{
+			name:           "gpu on nutanix",
+			datacenterKind: NutanixDatacenterKind,
+			gpu:            &GPUConfiguration{Taint: ptr.Bool(false)},
+		},
+		{
+			name:           "unsupported provider",
+			wantErr:        "worker node group md-0 gpu is not supported for CloudStackDatacenterConfig",
+			datacenterKind: CloudStackDatacenterKind,
+			gpu:            &GPUConfiguration{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			cluster := &Cluster{
+				Spec: ClusterSpec{
+					DatacenterRef: Ref{
+						Kind: tt.datacenterKind,
+					},
+					WorkerNodeGroupConfigurations: []WorkerNodeGroupConfiguration{
+						{Name: "md-0", GPU: tt.gpu},
+					},
+				},
+			}
+			err := validateGPU(cluster)
+			if tt.wantErr == "" {
+				g.Expect(err).To(BeNil())
+			} else {
+				g.Expect(err).To(MatchError(ContainSubstring(tt.wantErr)))
+			}
+		})
+	}
+}
+
+func TestValidateMachineNaming(t *testing.T) {
+	tests := []struct {
+		name           string
+		wantErr        string
+		datacenterKind string
+		controlPlane   *MachineNamingConfiguration
+		worker         *MachineNamingConfiguration
+	}{
+		{
+			name:           "no machine naming",
+			datacenterKind: DockerDatacenterKind,
+		},
+		{
+			name:           "valid templates",
+			datacenterKind: VSphereDatacenterKind,
+			controlPlane:   &MachineNamingConfiguration{Template: "corp-{{ .cluster.name }}-cp-{{ .random }}"},
+			worker:         &MachineNamingConfiguration{Template: "corp-{{ .nodeGroup.name }}-{{ .zone }}-{{ .random }}"},
+		},
+		{
+			name:           "unsupported provider",
+			wantErr:        "worker node group md-0 machineNaming: not supported for CloudStackDatacenterConfig",
+			datacenterKind: CloudStackDatacenterKind,
+			worker:         &MachineNamingConfiguration{Template: "corp-{{ .random }}"},
+		},
+		{
+			name:           "missing random",
+			wantErr:        "control plane machineNaming: template corp-{{ .cluster.name }} must use {{ .random }}",
+			datacenterKind: NutanixDatacenterKind,
+			controlPlane:   &MachineNamingConfiguration{Template: "corp-{{ .cluster.name }}"},
+		},
+		{
+			name:           "zone in control plane",
+			wantErr:        `control plane machineNaming: rendering machine naming template`,
+			datacenterKind: VSphereDatacenterKind,
+			controlPlane:   &MachineNamingConfiguration{Template: "{{ .zone }}-{{ .random }}"},
+		},
+		{
+			name:           "unknown variable",
+			wantErr:        `worker node group md-0 machineNaming: rendering machine naming template`,
+			datacenterKind: VSphereDatacenterKind,
+			worker:         &MachineNamingConfiguration{Template: "{{ .index }}-{{ .random }}"},
+		},
+		{
+			name:           "invalid template",
+			wantErr:        "worker node group md-0 machineNaming: parsing machine naming template",
+			datacenterKind: VSphereDatacenterKind,
+			worker:         &MachineNamingConfiguration{Template: "{{ .random "},
+		},
+		{
+			name:           "invalid names",
+			wantErr:        "worker node group md-0 machineNaming: template Corp_{{ .random }} generates invalid machine names like Corp_bcdfg",
+			datacenterKind: VSphereDatacenterKind,
+			worker:         &MachineNamingConfiguration{Template: "Corp_{{ .random }}"},
+		},
+		{
+			name:           "template too long",
+			wantErr:        "worker node group md-0 machineNaming: template can't be longer than 256 characters",
+			datacenterKind: VSphereDatacenterKind,
+			worker:         &MachineNamingConfiguration{Template: strings.Repeat("a", 250) + "{{ .random }}"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			cluster := &Cluster{
+				ObjectMeta: metav1.ObjectMeta{Name: "my-cluster"},
+				Spec: ClusterSpec{
+					DatacenterRef: Ref{
+						Kind: tt.datacenterKind,
+					},
+					ControlPlaneConfiguration: ControlPlaneConfiguration{
+						MachineNaming: tt.controlPlane,
+					},
+					WorkerNodeGroupConfigurations: []WorkerNodeGroupConfiguration{
+						{Name: "md-0", MachineNaming: tt.worker},
+					},
+				},
+			}
+			err := validateMachineNaming(cluster)
+			if tt.wantErr == "" {
+				g.Expect(err).To(BeNil())
+			} else {
+				g.Expect(err).To(MatchError(ContainSubstring(tt.wantErr)))
+			}
+		})
+	}
+}
+
+func TestValidateControlPlaneReplicas(t *testing.T) {
+	tests := []struct {
+		name    string
+		wantErr string
+		cluster *Cluster
+	}{
+		{
+			name:    "Odd CP replicas with stacked etcd",
+			wantErr: "",
+			cluster: &Cluster{
+				Spec: ClusterSpec{
+					ControlPlaneConfiguration: ControlPlaneConfiguration{
+						Count: 3,
+					},
+				},
+			},
+		},
+		{
+			name:    "Even CP replicas with stacked etcd",
+			wantErr: "control plane node count cannot be an even number when using stacked etcd topology",
+			cluster: &Cluster{
+				Spec: ClusterSpec{
+					ControlPlaneConfiguration: ControlPlaneConfiguration{
+						Count: 2,
+					},
+				},
+			},
+		},
+		{
+			name:    "Odd CP replicas with unstacked etcd",
+			wantErr: "",
+			cluster: &Cluster{
+				Spec: ClusterSpec{
+					ControlPlaneConfiguration: ControlPlaneConfiguration{
+						Count: 3,
+					},
+					ExternalEtcdConfiguration: &ExternalEtcdConfiguration{
+						Count: 1,
+					},
+				},
+			},
+		},
+		{
+			name:    "Odd CP replicas with unstacked etcd",
+			wantErr: "",
+			cluster: &Cluster{
+				Spec: ClusterSpec{
+					ControlPlaneConfiguration: ControlPlaneConfiguration{
+						Count: 2,
+					},
+					ExternalEtcdConfiguration: &ExternalEtcdConfiguration{
+						Count: 1,
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			err := validateControlPlaneReplicas(tt.cluster)
+			if tt.wantErr == "" {
+				g.Expect(err).To(BeNil())
+			} else {
+				g.Expect(err).To(MatchError(ContainSubstring(tt.wantErr)))
+			}
+		})
+	}
+}
+
+func TestValidateCPUpgradeRolloutStrategy(t *testing.T) {
+	tests := []struct {
+		name           string
+		wantErr        string
+		featureEnvVars []string
+		cluster        *Cluster
+	}{
+		{
+			name:    "rolling upgrade strategy invalid",
+			wantErr: "ControlPlaneConfiguration: only 'RollingUpdate' and 'InPlace' are supported for upgrade rollout strategy type",
+			cluster: &Cluster{
+				Spec: ClusterSpec{
+					ControlPlaneConfiguration: ControlPlaneConfiguration{
+						UpgradeRolloutStrategy: &ControlPlaneUpgradeRolloutStrategy{Type: "NotRollingUpdate"},
+					},
+				},
+			},
+		},
+		{
+			name:    "rolling upgrade knobs not specified",
+			wantErr: "",
+			cluster: &Cluster{
+				Spec: ClusterSpec{
+					ControlPlaneConfiguration: ControlPlaneConfiguration{
+						UpgradeRolloutStrategy: &ControlPlaneUpgradeRolloutStrategy{Type: "RollingUpdate"},
+					},
+				},
+			},
+		},
+		{
+			name:    "rolling upgrade invalid",
+			wantErr: "maxSurge for control plane must be 0 or 1",
+			cluster: &Cluster{
+				Spec: ClusterSpec{
+					ControlPlaneConfiguration: ControlPlaneConfiguration{
+						UpgradeRolloutStrategy: &ControlPlaneUpgradeRolloutStrategy{Type: "RollingUpdate", RollingUpdate: &ControlPlaneRollingUpdateParams{MaxSurge: 2}},
+					},
+				},
+			},
+		},
+		{
+			name:    "rolling upgrade knobs valid value 0",
+			wantErr: "",
+			cluster: &Cluster{
+				Spec: ClusterSpec{
+					ControlPlaneConfiguration: ControlPlaneConfiguration{
+						UpgradeRolloutStrategy: &ControlPlaneUpgradeRolloutStrategy{Type: "RollingUpdate", RollingUpdate: &ControlPlaneRollingUpdateParams{MaxSurge: 0}},
+					},
+				},
+			},
+		},
+		{
+			name:    "rolling upgrade knobs valid value 1",
+			wantErr: "",
+			cluster: &Cluster{
+				Spec: ClusterSpec{
+					ControlPlaneConfiguration: ControlPlaneConfiguration{
+						UpgradeRolloutStrategy: &ControlPlaneUpgradeRolloutStrategy{Type: "RollingUpdate", RollingUpdate: &ControlPlaneRollingUpdateParams{MaxSurge: 1}},
+					},
+				},
+			},
+		},
+		{
+			name:    "rolling upgrade knobs invalid value -1",
+			wantErr: "ControlPlaneConfiguration: maxSurge for control plane cannot be a negative value",
+			cluster: &Cluster{
+				Spec: ClusterSpec{
+					ControlPlaneConfiguration: ControlPlaneConfiguration{
+						UpgradeRolloutStrategy: &ControlPlaneUpgradeRolloutStrategy{Type: "RollingUpdate", RollingUpdate: &ControlPlaneRollingUpdateParams{MaxSurge: -1}},
+					},
+				},
+			},
+		},
+		{
+			name:    "in place upgrade - tinkerbell",
+			wantErr: "",
+			cluster: &Cluster{
+				Spec: ClusterSpec{
+					DatacenterRef: Ref{
+						Kind: TinkerbellDatacenterKind,
+					},
+					ControlPlaneConfiguration: ControlPlaneConfiguration{
+						UpgradeRolloutStrategy: &ControlPlaneUpgradeRolloutStrategy{Type: "InPlace"},
+					},
+				},
+			},
+		},
+		{
+			name:    "in place upgrade - provider not supported",
+			wantErr: "ControlPlaneConfiguration: 'InPlace' upgrade rollout strategy type is only supported on Bare Metal",
+			cluster: &Cluster{
+				Spec: ClusterSpec{
+					ControlPlaneConfiguration: ControlPlaneConfiguration{
+						UpgradeRolloutStrategy: &ControlPlaneUpgradeRolloutStrategy{Type: "InPlace"},
+					},
+				},
+			},
+		},
+		{
+			name:           "in place upgrade - vsphere, external etcd",
+			wantErr:        "stacked etcd must be configured when performing in place upgrades",
+			featureEnvVars: []string{features.VSphereInPlaceEnvVar},
+			cluster: &Cluster{
+				Spec: ClusterSpec{
+					DatacenterRef: Ref{
+						Kind: VSphereDatacenterKind,
+					},
+					ControlPlaneConfiguration: ControlPlaneConfiguration{
+						UpgradeRolloutStrategy: &ControlPlaneUpgradeRolloutStrategy{Type: "InPlace"},
+					},
+					ExternalEtcdConfiguration: &ExternalEtcdConfiguration{
+						Count: 3,
+					},
+				},
+			},
+		},
+		{
+			name:    "in place upgrade - rollingUpdate field specified",
+			wantErr: "ControlPlaneConfiguration: RollingUpdate field must be empty for 'InPlace' upgrade rollout strategy type",
+			cluster: &Cluster{
+				Spec: ClusterSpec{
+					ControlPlaneConfiguration: ControlPlaneConfiguration{
+						UpgradeRolloutStrategy: &ControlPlaneUpgradeRolloutStrategy{Type: "InPlace", RollingUpdate: &ControlPlaneRollingUpdateParams{MaxSurge: 1}},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			for _, e := range tt.featureEnvVars {
+				t.Setenv(e, "true")
+			}
+			err := validateCPUpgradeRolloutStrategy(tt.cluster)
+			if tt.wantErr == "" {
+				g.Expect(err).To(BeNil())
+			} else {
+				g.Expect(err).To(MatchError(ContainSubstring(tt.wantErr)))
+			}
+			features.ClearCache()
+		})
+	}
+}
+
+func TestValidateMDUpgradeRolloutStrategy(t *testing.T) {
+	tests := []struct {
+		name    string
+		wantErr string
+		cluster *Cluster
+	}{
+		{
+			name:    "rolling upgrade strategy invalid",
+			wantErr: "WorkerNodeGroupConfiguration: only 'RollingUpdate' and 'InPlace' are supported for upgrade rollout strategy type",
+			cluster: &Cluster{
+				Spec: ClusterSpec{
+					WorkerNodeGroupConfigurations: []WorkerNodeGroupConfiguration{{
+						UpgradeRolloutStrategy: &WorkerNodesUpgradeRolloutStrategy{Type: "NotRollingUpdate"},
+					}},
+				},
+			},
+		},
+		{
+			name:    "rolling upgrade knobs not specified",
+			wantErr: "WorkerNodeGroupConfiguration: upgradeRolloutStrategy.rollingUpdate field is required for upgradeRolloutStrategy.type RollingUpdate",
+			cluster: &Cluster{
+				Spec: ClusterSpec{
+					WorkerNodeGroupConfigurations: []WorkerNodeGroupConfiguration{{
+						UpgradeRolloutStrategy: &WorkerNodesUpgradeRolloutStrategy{Type: "RollingUpdate"},
+					}},
+				},
+			},
+		},
+		{
+			name:    "rolling upgrade invalid",
+			wantErr: "WorkerNodeGroupConfiguration: maxSurge and maxUnavailable not specified or are 0. maxSurge and maxUnavailable cannot both be 0",
+			cluster: &Cluster{
+				Spec: ClusterSpec{
+					WorkerNodeGroupConfigurations: []WorkerNodeGroupConfiguration{{
+						UpgradeRolloutStrategy: &WorkerNodesUpgradeRolloutStrategy{Type: "RollingUpdate", RollingUpdate: &WorkerNodesRollingUpdateParams{MaxSurge: 0, MaxUnavailable: 0}},
+					}},
+				},
+			},
+		},
+		{
+			name:    "rolling upgrade knobs valid value 0,1",
+			wantErr: "",
+			cluster: &Cluster{
+				Spec: ClusterSpec{
+					WorkerNodeGroupConfigurations: []WorkerNodeGroupConfiguration{{
+						UpgradeRolloutStrategy: &WorkerNodesUpgradeRolloutStrategy{Type: "RollingUpdate", RollingUpdate: &WorkerNodesRollingUpdateParams{MaxSurge: 0, MaxUnavailable: 1}},
+					}},
+				},
+			},
+		},
+		{
+			name:    "rolling upgrade knobs valid value 1,0",
+			wantErr: "",
+			cluster: &Cluster{
+				Spec: ClusterSpec{
+					WorkerNodeGroupConfigurations: []WorkerNodeGroupConfiguration{{
+						UpgradeRolloutStrategy: &WorkerNodesUpgradeRolloutStrategy{Type: "RollingUpdate", RollingUpdate: &WorkerNodesRollingUpdateParams{MaxSurge: 1, MaxUnavailable: 0}},
+					}},
+				},
+			},
+		},
+		{
+			name:    "rolling upgrade knobs valid value 5,0",
+			wantErr: "",
+			cluster: &Cluster{
+				Spec: ClusterSpec{
+					WorkerNodeGroupConfigurations: []WorkerNodeGroupConfiguration{{
+						UpgradeRolloutStrategy: &WorkerNodesUpgradeRolloutStrategy{Type: "RollingUpdate", RollingUpdate: &WorkerNodesRollingUpdateParams{MaxSurge: 5, MaxUnavailable: 0}},
+					}},
+				},
+			},
+		},
+		{
+			name:    "rolling upgrade knobs valid value 3,1",
+			wantErr: "",
+			cluster: &Cluster{
+				Spec: ClusterSpec{
+					WorkerNodeGroupConfigurations: []WorkerNodeGroupConfiguration{{
+						UpgradeRolloutStrategy: &WorkerNodesUpgradeRolloutStrategy{Type: "RollingUpdate", RollingUpdate: &WorkerNodesRollingUpdateParams{MaxSurge: 3, MaxUnavailable: 1}},
+					}},
+				},
+			},
+		},
+		{
+			name:    "rolling upgrade knobs invalid values 3,-1",
+			wantErr: "WorkerNodeGroupConfiguration: maxSurge and maxUnavailable values cannot be negative",
+			cluster: &Cluster{
+				Spec: ClusterSpec{
+					WorkerNodeGroupConfigurations: []WorkerNodeGroupConfiguration{{
+						UpgradeRolloutStrategy: &WorkerNodesUpgradeRolloutStrategy{Type: "RollingUpdate", RollingUpdate: &WorkerNodesRollingUpdateParams{MaxSurge: 3, MaxUnavailable: -1}},
+					}},
+				},
+			},
+		},
+		{
+			name:    "rolling upgrade knobs invalid values -3,1",
+			wantErr: "WorkerNodeGroupConfiguration: maxSurge and maxUnavailable values cannot be negative",
+			cluster: &Cluster{
+				Spec: ClusterSpec{
+					WorkerNodeGroupConfigurations: []WorkerNodeGroupConfiguration{{
+						UpgradeRolloutStrategy: &WorkerNodesUpgradeRolloutStrategy{Type: "RollingUpdate", RollingUpdate: &WorkerNodesRollingUpdateParams{MaxSurge: -3, MaxUnavailable: 1}},
+					}},
+				},
+			},
+		},
+		{
+			name:    "rolling upgrade knobs invalid values -3,-1",
 			wantErr: "WorkerNodeGroupConfiguration: maxSurge and maxUnavailable values cannot be negative",
 			cluster: &Cluster{
 				Spec: ClusterSpec{
-					WorkerNodeGroupConfigurations: []WorkerNodeGroupConfiguration{{
-						UpgradeRolloutStrategy: &WorkerNodesUpgradeRolloutStrategy{Type: "RollingUpdate", RollingUpdate: &WorkerNodesRollingUpdateParams{MaxSurge: 3, MaxUnavailable: -1}},
-					}},
+					WorkerNodeGroupConfigurations: []WorkerNodeGroupConfiguration{{
+						UpgradeRolloutStrategy: &WorkerNodesUpgradeRolloutStrategy{Type: "RollingUpdate", RollingUpdate: &WorkerNodesRollingUpdateParams{MaxSurge: -3, MaxUnavailable: -1}},
+					}},
+				},
+			},
+		},
+		{
+			name:    "in place upgrade - tinkerbell",
+			wantErr: "",
+			cluster: &Cluster{
+				Spec: ClusterSpec{
+					DatacenterRef: Ref{
+						Kind: TinkerbellDatacenterKind,
+					},
+					WorkerNodeGroupConfigurations: []WorkerNodeGroupConfiguration{{
+						UpgradeRolloutStrategy: &WorkerNodesUpgradeRolloutStrategy{Type: "InPlace"},
+					}},
+				},
+			},
+		},
+		{
+			name:    "in place upgrade - provider not supported",
+			wantErr: "WorkerNodeGroupConfiguration: 'InPlace' upgrade rollout strategy type is only supported on Bare Metal",
+			cluster: &Cluster{
+				Spec: ClusterSpec{
+					WorkerNodeGroupConfigurations: []WorkerNodeGroupConfiguration{{
+						UpgradeRolloutStrategy: &WorkerNodesUpgradeRolloutStrategy{Type: "InPlace"},
+					}},
+				},
+			},
+		},
+		{
+			name:    "in place upgrade - rollingUpdate field specified",
+			wantErr: "WorkerNodeGroupConfiguration: RollingUpdate field must be empty for 'InPlace' upgrade rollout strategy type",
+			cluster: &Cluster{
+				Spec: ClusterSpec{
+					WorkerNodeGroupConfigurations: []WorkerNodeGroupConfiguration{{
+						UpgradeRolloutStrategy: &WorkerNodesUpgradeRolloutStrategy{Type: "InPlace", RollingUpdate: &WorkerNodesRollingUpdateParams{MaxSurge: 5, MaxUnavailable: 0}},
+					}},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			err := validateMDUpgradeRolloutStrategy(&tt.cluster.Spec.WorkerNodeGroupConfigurations[0], tt.cluster.Spec.DatacenterRef.Kind)
+			if tt.wantErr == "" {
+				g.Expect(err).To(BeNil())
+			} else {
+				g.Expect(err).To(MatchError(ContainSubstring(tt.wantErr)))
+			}
+		})
+	}
+}
+
+func TestValidateVSphereMDInPlaceNotEnabled(t *testing.T) {
+	g := NewWithT(t)
+	cluster := &Cluster{
+		Spec: ClusterSpec{
+			WorkerNodeGroupConfigurations: []WorkerNodeGroupConfiguration{{
+				UpgradeRolloutStrategy: &WorkerNodesUpgradeRolloutStrategy{Type: "InPlace"},
+			}},
+			DatacenterRef: Ref{
+				Kind: VSphereDatacenterKind,
+			},
+		},
+	}
+	err := validateMDUpgradeRolloutStrategy(&cluster.Spec.WorkerNodeGroupConfigurations[0], cluster.Spec.DatacenterRef.Kind)
+	g.Expect(err).To(MatchError(ContainSubstring("in place upgrades are not supported on vSphere")))
+}
+
+func TestValidateVSphereCPInPlaceNotEnabled(t *testing.T) {
+	g := NewWithT(t)
+	cluster := &Cluster{
+		Spec: ClusterSpec{
+			ControlPlaneConfiguration: ControlPlaneConfiguration{
+				UpgradeRolloutStrategy: &ControlPlaneUpgradeRolloutStrategy{Type: "InPlace"},
+			},
+			DatacenterRef: Ref{
+				Kind: VSphereDatacenterKind,
+			},
+		},
+	}
+	err := validateCPUpgradeRolloutStrategy(cluster)
+	g.Expect(err).To(MatchError(ContainSubstring("in place upgrades are not supported on vSphere")))
+}
+
+func TestValidateVSphereMDInPlaceEnabled(t *testing.T) {
+	g := NewWithT(t)
+	cluster := &Cluster{
+		Spec: ClusterSpec{
+			WorkerNodeGroupConfigurations: []WorkerNodeGroupConfiguration{{
+				UpgradeRolloutStrategy: &WorkerNodesUpgradeRolloutStrategy{Type: "InPlace"},
+			}},
+			DatacenterRef: Ref{
+				Kind: VSphereDatacenterKind,
+			},
+		},
+	}
+	features.ClearCache()
+	t.Setenv(features.VSphereInPlaceEnvVar, "true")
+	err := validateMDUpgradeRolloutStrategy(&cluster.Spec.WorkerNodeGroupConfigurations[0], cluster.Spec.DatacenterRef.Kind)
+	g.Expect(err).To(BeNil())
+}
+
+func TestValidateVSphereCPInPlaceEnabled(t *testing.T) {
+	g := NewWithT(t)
+	cluster := &Cluster{
+		Spec: ClusterSpec{
+			ControlPlaneConfiguration: ControlPlaneConfiguration{
+				UpgradeRolloutStrategy: &ControlPlaneUpgradeRolloutStrategy{Type: "InPlace"},
+			},
+			DatacenterRef: Ref{
+				Kind: VSphereDatacenterKind,
+			},
+		},
+	}
+	features.ClearCache()
+	t.Setenv(features.VSphereInPlaceEnvVar, "true")
+	err := validateCPUpgradeRolloutStrategy(cluster)
+	g.Expect(err).To(BeNil())
+}
+
+func TestValidateEksaVersion(t *testing.T) {
+	tests := []struct {
+		name       string
+		wantErr    string
+		version    string
+		bundlesRef *BundlesRef
+	}{
+		{
+			name:       "both bundlesref and version",
+			wantErr:    "cannot pass both bundlesRef and eksaVersion",
+			version:    "v0.0.0",
+			bundlesRef: &BundlesRef{},
+		},
+		{
+			name:       "eksaversion success",
+			wantErr:    "",
+			version:    "v0.0.0",
+			bundlesRef: nil,
+		},
+		{
+			name:       "eksaversion fail",
+			wantErr:    "eksaVersion is not a valid semver",
+			version:    "invalid",
+			bundlesRef: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			config := &Cluster{
+				Spec: ClusterSpec{
+					EksaVersion: (*EksaVersion)(&tt.version),
+					BundlesRef:  tt.bundlesRef,
+				},
+			}
+			err := validateEksaVersion(config)
+			if tt.wantErr == "" {
+				g.Expect(err).To(BeNil())
+			} else {
+				g.Expect(err).To(MatchError(ContainSubstring(tt.wantErr)))
+			}
+		})
+	}
+}
+
+func TestGetClusterDefaultKubernetesVersion(t *testing.T) {
+	g := NewWithT(t)
+	g.Expect(GetClusterDefaultKubernetesVersion()).To(Equal(Kube136))
+}
+
+func TestClusterWorkerNodeConfigCount(t *testing.T) {
+	tests := []struct {
+		name    string
+		cluster *Cluster
+		want    []WorkerNodeGroupConfiguration
+	}{
+		{
+			name: "with worker node config count",
+			cluster: &Cluster{
+				Spec: ClusterSpec{},
+			},
+			want: []WorkerNodeGroupConfiguration{
+				{
+					Name:                     "",
+					Count:                    ptr.Int(5),
+					AutoScalingConfiguration: nil,
+					MachineGroupRef:          nil,
+					Taints:                   nil,
+					Labels:                   nil,
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cg := NewClusterGenerate("test-cluster", WorkerNodeConfigCount(5))
+			g := NewWithT(t)
+			g.Expect(cg.Spec.WorkerNodeGroupConfigurations).To(Equal(tt.want))
+		})
+	}
+}
+
+func TestClusterCPUpgradeRolloutStrategyNil(t *testing.T) {
+	tests := []struct {
+		name    string
+		cluster *Cluster
+		want    ControlPlaneConfiguration
+	}{
+		{
+			name: "with control plane rollout upgrade strategy nil",
+			cluster: &Cluster{
+				Spec: ClusterSpec{},
+			},
+			want: ControlPlaneConfiguration{
+				Endpoint:               nil,
+				Count:                  1,
+				MachineGroupRef:        nil,
+				Taints:                 nil,
+				Labels:                 nil,
+				UpgradeRolloutStrategy: nil,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cg := NewClusterGenerate("test-cluster", ControlPlaneConfigCount(1))
+			g := NewWithT(t)
+			g.Expect(cg.Spec.ControlPlaneConfiguration).To(Equal(tt.want))
+		})
+	}
+}
+
+func TestClusterCPUpgradeRolloutStrategyNotNil(t *testing.T) {
+	tests := []struct {
+		name    string
+		cluster *Cluster
+		want    ControlPlaneConfiguration
+	}{
+		{
+			name: "with control plane rollout upgrade strategy non-nil",
+			cluster: &Cluster{
+				Spec: ClusterSpec{},
+			},
+			want: ControlPlaneConfiguration{
+				Endpoint:               nil,
+				Count:                  1,
+				MachineGroupRef:        nil,
+				Taints:                 nil,
+				Labels:                 nil,
+				UpgradeRolloutStrategy: &ControlPlaneUpgradeRolloutStrategy{Type: "RollingUpdate", RollingUpdate: &ControlPlaneRollingUpdateParams{MaxSurge: 5}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cg := NewClusterGenerate("test-cluster", ControlPlaneConfigCount(1), WithCPUpgradeRolloutStrategy(5, 2))
+			g := NewWithT(t)
+			g.Expect(cg.Spec.ControlPlaneConfiguration).To(Equal(tt.want))
+		})
+	}
+}
+
+func TestClusterMDUpgradeRolloutStrategyNil(t *testing.T) {
+	tests := []struct {
+		name    string
+		cluster *Cluster
+		want    []WorkerNodeGroupConfiguration
+	}{
+		{
+			name: "with md rollout upgrade strategy knobs not specified",
+			cluster: &Cluster{
+				Spec: ClusterSpec{},
+			},
+			want: []WorkerNodeGroupConfiguration{
+				{
+					Count:           ptr.Int(1),
+					MachineGroupRef: nil,
+					Taints:          nil,
+					Labels:          nil,
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cg := NewClusterGenerate("test-cluster", WorkerNodeConfigCount(1))
+			g := NewWithT(t)
+			g.Expect(cg.Spec.WorkerNodeGroupConfigurations).To(Equal(tt.want))
+		})
+	}
+}
+
+func TestClusterMDUpgradeRolloutStrategyNotNil(t *testing.T) {
+	tests := []struct {
+		name    string
+		cluster *Cluster
+		want    []WorkerNodeGroupConfiguration
+	}{
+		{
+			name: "with md rollout upgrade strategy",
+			cluster: &Cluster{
+				Spec: ClusterSpec{},
+			},
+			want: []WorkerNodeGroupConfiguration{
+				{
+					Count:                  ptr.Int(1),
+					MachineGroupRef:        nil,
+					Taints:                 nil,
+					Labels:                 nil,
+					UpgradeRolloutStrategy: &WorkerNodesUpgradeRolloutStrategy{Type: "RollingUpdate", RollingUpdate: &WorkerNodesRollingUpdateParams{MaxSurge: 5, MaxUnavailable: 2}},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cg := NewClusterGenerate("test-cluster", WorkerNodeConfigCount(1), WithWorkerMachineUpgradeRolloutStrategy(5, 2))
+			g := NewWithT(t)
+			g.Expect(cg.Spec.WorkerNodeGroupConfigurations).To(Equal(tt.want))
+		})
+	}
+}
+
+func TestClusterCPUpgradeInPlace(t *testing.T) {
+	g := NewWithT(t)
+
+	cluster := NewClusterGenerate("test-cluster", WorkerNodeConfigCount(1))
+	cluster.Spec.ControlPlaneConfiguration.UpgradeRolloutStrategy = &ControlPlaneUpgradeRolloutStrategy{Type: InPlaceStrategyType}
+	cluster.Spec.WorkerNodeGroupConfigurations[0].UpgradeRolloutStrategy = &WorkerNodesUpgradeRolloutStrategy{Type: InPlaceStrategyType}
+
+	clusterSpec, err := yaml.Marshal(cluster)
+	g.Expect(err).To(BeNil())
+
+	expected, err := os.ReadFile("testdata/cluster_in_place_upgrade.yaml")
+	g.Expect(err).To(BeNil())
+	g.Expect(string(clusterSpec)).To(BeEquivalentTo(string(expected)))
+}
+
+func TestValidateAuditPolicyContent(t *testing.T) {
+	tests := []struct {
+		name        string
+		auditPolicy string
+		wantErr     bool
+	}{
+		{
+			name:        "empty audit policy content",
+			auditPolicy: "",
+			wantErr:     false,
+		},
+		{
+			name: "valid audit policy content",
+			auditPolicy: `apiVersion: audit.k8s.io/v1
+kind: Policy
+rules:
+- level: Metadata
+  omitStages:
+  - RequestReceived`,
+			wantErr: false,
+		},
+		{
+			name: "invalid audit policy content - not valid YAML",
+			auditPolicy: `apiVersion: audit.k8s.io/v1
+kind: Policy
+rules:
+- level: Metadata
+  omitStages:
+  - RequestReceived
+  invalid yaml content`,
+			wantErr: true,
+		},
+		{
+			name: "invalid audit policy content - malformed YAML",
+			auditPolicy: `apiVersion: audit.k8s.io/v1
+kind: Policy
+rules:
+- level: Metadata
+  omitStages:
+  - RequestReceived
+ badIndentation: value`,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Cluster{
+				Spec: ClusterSpec{
+					ControlPlaneConfiguration: ControlPlaneConfiguration{
+						AuditPolicyContent: tt.auditPolicy,
+					},
 				},
-			},
+			}
+			err := validateAuditPolicyContent(c)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("validateAuditPolicyContent() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateAuditLog(t *testing.T) {
+	tests := []struct {
+		name     string
+		auditLog *AuditLogConfiguration
+		wantErr  string
+	}{
+		{
+			name:     "no audit log configuration",
+			auditLog: nil,
 		},
 		{
-			name:    "rolling upgrade knobs invalid values -3,1",
-			wantErr: "WorkerNodeGroupConfiguration: maxSurge and maxUnavailable values cannot be negative",
-			cluster: &Cluster{
-				Spec: ClusterSpec{
-					WorkerNodeGroupConfigurations: []WorkerNodeGroupConfiguration{{
-						UpgradeRolloutStrategy: &WorkerNodesUpgradeRolloutStrategy{Type: "RollingUpdate", RollingUpdate: &WorkerNodesRollingUpdateParams{MaxSurge: -3, MaxUnavailable: 1}},
-					}},
-				},
+			name: "valid audit log configuration",
+			auditLog: &AuditLogConfiguration{
+				MaxAge:    ptr.Int(7),
+				MaxBackup: ptr.Int(0),
+				MaxSize:   ptr.Int(100),
 			},
 		},
 		{
-			name:    "rolling upgrade knobs invalid values -3,-1",
-			wantErr: "WorkerNodeGroupConfiguration: maxSurge and maxUnavailable values cannot be negative",
-			cluster: &Cluster{
-				Spec: ClusterSpec{
-					WorkerNodeGroupConfigurations: []WorkerNodeGroupConfiguration{{
-						UpgradeRolloutStrategy: &WorkerNodesUpgradeRolloutStrategy{Type: "RollingUpdate", RollingUpdate: &WorkerNodesRollingUpdateParams{MaxSurge: -3, MaxUnavailable: -1}},
-					}},
-				},
+			name: "negative max age",
+			auditLog: &AuditLogConfiguration{
+				MaxAge: ptr.Int(-1),
 			},
+			wantErr: "auditLog.maxAge -1 can't be negative",
 		},
 		{
-			name:    "in place upgrade - tinkerbell",
-			wantErr: "",
-			cluster: &Cluster{
+			name: "negative max size",
+			auditLog: &AuditLogConfiguration{
+				MaxSize: ptr.Int(-10),
+			},
+			wantErr: "auditLog.maxSize -10 can't be negative",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			c := &Cluster{
 				Spec: ClusterSpec{
-					DatacenterRef: Ref{
-						Kind: TinkerbellDatacenterKind,
+					ControlPlaneConfiguration: ControlPlaneConfiguration{
+						AuditLog: tt.auditLog,
 					},
-					WorkerNodeGroupConfigurations: []WorkerNodeGroupConfiguration{{
-						UpgradeRolloutStrategy: &WorkerNodesUpgradeRolloutStrategy{Type: "InPlace"},
-					}},
 				},
+			}
+			err := validateAuditLog(c)
+			if tt.wantErr == "" {
+				g.Expect(err).To(BeNil())
+			} else {
+				g.Expect(err).To(MatchError(tt.wantErr))
+			}
+		})
+	}
+}
+
+func TestValidateControlPlaneComponentExtraArgs(t *testing.T) {
+	tests := []struct {
+		name                       string
+		datacenterKind             string
+		controllerManagerExtraArgs map[string]string
+		schedulerExtraArgs         map[string]string
+		wantErr                    string
+	}{
+		{
+			name:           "no extra args",
+			datacenterKind: TinkerbellDatacenterKind,
+		},
+		{
+			name:           "valid extra args",
+			datacenterKind: VSphereDatacenterKind,
+			controllerManagerExtraArgs: map[string]string{
+				"profiling":    "true",
+				"bind-address": "0.0.0.0",
+			},
+			schedulerExtraArgs: map[string]string{
+				"bind-address": "0.0.0.0",
 			},
 		},
 		{
-			name:    "in place upgrade - provider not supported",
-			wantErr: "WorkerNodeGroupConfiguration: 'InPlace' upgrade rollout strategy type is only supported on Bare Metal",
-			cluster: &Cluster{
-				Spec: ClusterSpec{
-					WorkerNodeGroupConfigurations: []WorkerNodeGroupConfiguration{{
-						UpgradeRolloutStrategy: &WorkerNodesUpgradeRolloutStrategy{Type: "InPlace"},
-					}},
-				},
+			name:           "unsupported provider",
+			datacenterKind: TinkerbellDatacenterKind,
+			schedulerExtraArgs: map[string]string{
+				"profiling": "true",
 			},
+			wantErr: "controllerManagerExtraArgs and schedulerExtraArgs are not supported for TinkerbellDatacenterConfig",
 		},
 		{
-			name:    "in place upgrade - rollingUpdate field specified",
-			wantErr: "WorkerNodeGroupConfiguration: RollingUpdate field must be empty for 'InPlace' upgrade rollout strategy type",
-			cluster: &Cluster{
-				Spec: ClusterSpec{
-					WorkerNodeGroupConfigurations: []WorkerNodeGroupConfiguration{{
-						UpgradeRolloutStrategy: &WorkerNodesUpgradeRolloutStrategy{Type: "InPlace", RollingUpdate: &WorkerNodesRollingUpdateParams{MaxSurge: 5, MaxUnavailable: 0}},
-					}},
-				},
+			name:           "managed controller manager flag",
+			datacenterKind: DockerDatacenterKind,
+			controllerManagerExtraArgs: map[string]string{
+				"node-cidr-mask-size": "24",
+			},
+			wantErr: "flag node-cidr-mask-size is managed by EKS Anywhere and cannot be configured in controllerManagerExtraArgs",
+		},
+		{
+			name:           "managed scheduler flag",
+			datacenterKind: CloudStackDatacenterKind,
+			schedulerExtraArgs: map[string]string{
+				"tls-cipher-suites": "TLS_AES_128_GCM_SHA256",
+			},
+			wantErr: "flag tls-cipher-suites is managed by EKS Anywhere and cannot be configured in schedulerExtraArgs",
+		},
+		{
+			name:           "flag with leading dashes",
+			datacenterKind: VSphereDatacenterKind,
+			schedulerExtraArgs: map[string]string{
+				"--profiling": "true",
 			},
+			wantErr: "invalid flag \"--profiling\" in schedulerExtraArgs: flags must be specified without leading dashes",
 		},
 	}
+
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			g := NewWithT(t)
-			err := validateMDUpgradeRolloutStrategy(&tt.cluster.Spec.WorkerNodeGroupConfigurations[0], tt.cluster.Spec.DatacenterRef.Kind)
+			c := &Cluster{
+				Spec: ClusterSpec{
+					DatacenterRef: Ref{Kind: tt.datacenterKind},
+					ControlPlaneConfiguration: ControlPlaneConfiguration{
+						ControllerManagerExtraArgs: tt.controllerManagerExtraArgs,
+						SchedulerExtraArgs:         tt.schedulerExtraArgs,
+					},
+				},
+			}
+			err := validateControlPlaneComponentExtraArgs(c)
 			if tt.wantErr == "" {
 				g.Expect(err).To(BeNil())
 			} else {
-				g.Expect(err).To(MatchError(ContainSubstring(tt.wantErr)))
+				g.Expect(err).To(MatchError(tt.wantErr))
 			}
 		})
 	}
 }
 
-func TestValidateVSphereMDInPlaceNotEnabled(t *testing.T) {
-	g := NewWithT(t)
-	cluster := &Cluster{
-		Spec: ClusterSpec{
-			WorkerNodeGroupConfigurations: []WorkerNodeGroupConfiguration{{
-				UpgradeRolloutStrategy: &WorkerNodesUpgradeRolloutStrategy{Type: "InPlace"},
-			}},
-			DatacenterRef: Ref{
-				Kind: VSphereDatacenterKind,
+func TestValidatePodSecurityAdmission(t *testing.T) {
+	tests := []struct {
+		name               string
+		datacenterKind     string
+		psa                *PodSecurityAdmissionConfiguration
+		apiServerExtraArgs map[string]string
+		wantErr            string
+	}{
+		{
+			name:           "not configured",
+			datacenterKind: TinkerbellDatacenterKind,
+		},
+		{
+			name:           "valid",
+			datacenterKind: VSphereDatacenterKind,
+			psa: &PodSecurityAdmissionConfiguration{
+				Enforce: PodSecurityLevelBaseline,
+				Audit:   PodSecurityLevelRestricted,
+				Warn:    PodSecurityLevelRestricted,
+				Exemptions: &PodSecurityAdmissionExemptions{
+					Usernames:      []string{"system:serviceaccount:ci:deployer"},
+					RuntimeClasses: []string{"gvisor"},
+					Namespaces:     []string{"monitoring"},
+				},
 			},
 		},
-	}
-	err := validateMDUpgradeRolloutStrategy(&cluster.Spec.WorkerNodeGroupConfigurations[0], cluster.Spec.DatacenterRef.Kind)
-	g.Expect(err).To(MatchError(ContainSubstring("in place upgrades are not supported on vSphere")))
-}
-
-func TestValidateVSphereCPInPlaceNotEnabled(t *testing.T) {
-	g := NewWithT(t)
-	cluster := &Cluster{
-		Spec: ClusterSpec{
-			ControlPlaneConfiguration: ControlPlaneConfiguration{
-				UpgradeRolloutStrategy: &ControlPlaneUpgradeRolloutStrategy{Type: "InPlace"},
+		{
+			name:           "unsupported provider",
+			datacenterKind: NutanixDatacenterKind,
+			psa:            &PodSecurityAdmissionConfiguration{Enforce: PodSecurityLevelBaseline},
+			wantErr:        "podSecurityAdmission is not supported for NutanixDatacenterConfig",
+		},
+		{
+			name:               "admission control config file in apiServerExtraArgs",
+			datacenterKind:     DockerDatacenterKind,
+			psa:                &PodSecurityAdmissionConfiguration{Enforce: PodSecurityLevelBaseline},
+			apiServerExtraArgs: map[string]string{"admission-control-config-file": "/etc/kubernetes/admission.yaml"},
+			wantErr:            "podSecurityAdmission can't be configured with admission-control-config-file in apiServerExtraArgs",
+		},
+		{
+			name:           "invalid level",
+			datacenterKind: CloudStackDatacenterKind,
+			psa:            &PodSecurityAdmissionConfiguration{Warn: "strict"},
+			wantErr:        "podSecurityAdmission.warn strict is invalid, must be one of privileged, baseline or restricted",
+		},
+		{
+			name:           "invalid exempted namespace",
+			datacenterKind: VSphereDatacenterKind,
+			psa: &PodSecurityAdmissionConfiguration{
+				Exemptions: &PodSecurityAdmissionExemptions{Namespaces: []string{"Monitoring"}},
 			},
-			DatacenterRef: Ref{
-				Kind: VSphereDatacenterKind,
+			wantErr: "podSecurityAdmission.exemptions namespace Monitoring is invalid",
+		},
+		{
+			name:           "empty exempted username",
+			datacenterKind: VSphereDatacenterKind,
+			psa: &PodSecurityAdmissionConfiguration{
+				Exemptions: &PodSecurityAdmissionExemptions{Usernames: []string{""}},
 			},
+			wantErr: "podSecurityAdmission.exemptions usernames can't be empty",
 		},
-	}
-	err := validateCPUpgradeRolloutStrategy(cluster)
-	g.Expect(err).To(MatchError(ContainSubstring("in place upgrades are not supported on vSphere")))
-}
-
-func TestValidateVSphereMDInPlaceEnabled(t *testing.T) {
-	g := NewWithT(t)
-	cluster := &Cluster{
-		Spec: ClusterSpec{
-			WorkerNodeGroupConfigurations: []WorkerNodeGroupConfiguration{{
-				UpgradeRolloutStrategy: &WorkerNodesUpgradeRolloutStrategy{Type: "InPlace"},
-			}},
-			DatacenterRef: Ref{
-				Kind: VSphereDatacenterKind,
+		{
+			name:           "invalid exempted runtime class",
+			datacenterKind: VSphereDatacenterKind,
+			psa: &PodSecurityAdmissionConfiguration{
+				Exemptions: &PodSecurityAdmissionExemptions{RuntimeClasses: []string{"g_visor"}},
 			},
+			wantErr: "podSecurityAdmission.exemptions runtime class g_visor is invalid",
 		},
 	}
-	features.ClearCache()
-	t.Setenv(features.VSphereInPlaceEnvVar, "true")
-	err := validateMDUpgradeRolloutStrategy(&cluster.Spec.WorkerNodeGroupConfigurations[0], cluster.Spec.DatacenterRef.Kind)
-	g.Expect(err).To(BeNil())
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			c := &Cluster{
+				Spec: ClusterSpec{
+					DatacenterRef: Ref{Kind: tt.datacenterKind},
+					ControlPlaneConfiguration: ControlPlaneConfiguration{
+						PodSecurityAdmission: tt.psa,
+						APIServerExtraArgs:   tt.apiServerExtraArgs,
+					},
+				},
+			}
+			err := validatePodSecurityAdmission(c)
+			if tt.wantErr == "" {
+				g.Expect(err).To(BeNil())
+			} else {
+				g.Expect(err).To(MatchError(ContainSubstring(tt.wantErr)))
+			}
+		})
+	}
 }
 
-func TestValidateVSphereCPInPlaceEnabled(t *testing.T) {
-	g := NewWithT(t)
-	cluster := &Cluster{
-		Spec: ClusterSpec{
-			ControlPlaneConfiguration: ControlPlaneConfiguration{
-				UpgradeRolloutStrategy: &ControlPlaneUpgradeRolloutStrategy{Type: "InPlace"},
-			},
-			DatacenterRef: Ref{
-				Kind: VSphereDatacenterKind,
+func TestValidateNodeOSConfiguration(t *testing.T) {
+	tests := []struct {
+		name           string
+		datacenterKind string
+		nodeOS         *NodeOSConfiguration
+		wantErr        string
+	}{
+		{
+			name:           "not configured",
+			datacenterKind: DockerDatacenterKind,
+		},
+		{
+			name:           "valid",
+			datacenterKind: VSphereDatacenterKind,
+			nodeOS: &NodeOSConfiguration{
+				Sysctls: map[string]string{
+					"net.core.somaxconn":                 "65535",
+					"net.ipv4.ip_local_port_range":       "1024 65535",
+					"net/ipv4/conf/eth0.100/forwarding":  "1",
+					"net.bridge.bridge-nf-call-iptables": "1",
+				},
+				KernelModules: []string{"br_netfilter", "nf-conntrack"},
 			},
 		},
+		{
+			name:           "unsupported provider",
+			datacenterKind: NutanixDatacenterKind,
+			nodeOS:         &NodeOSConfiguration{KernelModules: []string{"br_netfilter"}},
+			wantErr:        "nodeOSConfiguration is not supported for NutanixDatacenterConfig",
+		},
+		{
+			name:           "invalid sysctl name",
+			datacenterKind: TinkerbellDatacenterKind,
+			nodeOS:         &NodeOSConfiguration{Sysctls: map[string]string{"net.core.somaxconn;reboot": "1"}},
+			wantErr:        "nodeOSConfiguration sysctl \"net.core.somaxconn;reboot\" is invalid",
+		},
+		{
+			name:           "empty sysctl value",
+			datacenterKind: TinkerbellDatacenterKind,
+			nodeOS:         &NodeOSConfiguration{Sysctls: map[string]string{"vm.max_map_count": ""}},
+			wantErr:        "nodeOSConfiguration sysctl vm.max_map_count value \"\" is invalid",
+		},
+		{
+			name:           "multiline sysctl value",
+			datacenterKind: TinkerbellDatacenterKind,
+			nodeOS:         &NodeOSConfiguration{Sysctls: map[string]string{"vm.max_map_count": "262144\nkernel.panic = 0"}},
+			wantErr:        "nodeOSConfiguration sysctl vm.max_map_count value",
+		},
+		{
+			name:           "invalid kernel module",
+			datacenterKind: VSphereDatacenterKind,
+			nodeOS:         &NodeOSConfiguration{KernelModules: []string{"br_netfilter.ko"}},
+			wantErr:        "nodeOSConfiguration kernel module \"br_netfilter.ko\" is invalid",
+		},
+		{
+			name:           "duplicated kernel module",
+			datacenterKind: VSphereDatacenterKind,
+			nodeOS:         &NodeOSConfiguration{KernelModules: []string{"overlay", "overlay"}},
+			wantErr:        "nodeOSConfiguration kernel module overlay is duplicated",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			c := &Cluster{
+				Spec: ClusterSpec{
+					DatacenterRef:       Ref{Kind: tt.datacenterKind},
+					NodeOSConfiguration: tt.nodeOS,
+				},
+			}
+			err := validateNodeOSConfiguration(c)
+			if tt.wantErr == "" {
+				g.Expect(err).To(BeNil())
+			} else {
+				g.Expect(err).To(MatchError(ContainSubstring(tt.wantErr)))
+			}
+		})
 	}
-	features.ClearCache()
-	t.Setenv(features.VSphereInPlaceEnvVar, "true")
-	err := validateCPUpgradeRolloutStrategy(cluster)
-	g.Expect(err).To(BeNil())
 }
 
-func TestValidateEksaVersion(t *testing.T) {
+func TestValidateKonnectivity(t *testing.T) {
 	tests := []struct {
-		name       string
-		wantErr    string
-		version    string
-		bundlesRef *BundlesRef
+		name               string
+		datacenterKind     string
+		count              int
+		konnectivity       *KonnectivityConfiguration
+		apiServerExtraArgs map[string]string
+		wantErr            string
 	}{
 		{
-			name:       "both bundlesref and version",
-			wantErr:    "cannot pass both bundlesRef and eksaVersion",
-			version:    "v0.0.0",
-			bundlesRef: &BundlesRef{},
+			name:           "not configured",
+			datacenterKind: NutanixDatacenterKind,
+			count:          3,
 		},
 		{
-			name:       "eksaversion success",
-			wantErr:    "",
-			version:    "v0.0.0",
-			bundlesRef: nil,
+			name:           "single control plane node",
+			datacenterKind: TinkerbellDatacenterKind,
+			count:          1,
+			konnectivity:   &KonnectivityConfiguration{AgentPort: 8132},
 		},
 		{
-			name:       "eksaversion fail",
-			wantErr:    "eksaVersion is not a valid semver",
-			version:    "invalid",
-			bundlesRef: nil,
+			name:           "server host with several control plane nodes",
+			datacenterKind: VSphereDatacenterKind,
+			count:          3,
+			konnectivity:   &KonnectivityConfiguration{ServerHost: "konnectivity.example.com", AgentPort: 8132},
+		},
+		{
+			name:           "unsupported provider",
+			datacenterKind: CloudStackDatacenterKind,
+			count:          1,
+			konnectivity:   &KonnectivityConfiguration{},
+			wantErr:        "konnectivity is not supported for CloudStackDatacenterConfig",
+		},
+		{
+			name:           "invalid port",
+			datacenterKind: VSphereDatacenterKind,
+			count:          1,
+			konnectivity:   &KonnectivityConfiguration{AgentPort: 70000},
+			wantErr:        "konnectivity agentPort 70000 is not a valid port",
+		},
+		{
+			name:           "port used by the control plane",
+			datacenterKind: VSphereDatacenterKind,
+			count:          1,
+			konnectivity:   &KonnectivityConfiguration{AgentPort: KonnectivityServerAdminPort},
+			wantErr:        "konnectivity agentPort 8095 is already used on the control plane nodes",
+		},
+		{
+			name:           "several control plane nodes without server host",
+			datacenterKind: TinkerbellDatacenterKind,
+			count:          3,
+			konnectivity:   &KonnectivityConfiguration{AgentPort: 8132},
+			wantErr:        "konnectivity serverHost is required with more than one control plane node",
+		},
+		{
+			name:               "egress selector in apiServerExtraArgs",
+			datacenterKind:     VSphereDatacenterKind,
+			count:              1,
+			konnectivity:       &KonnectivityConfiguration{AgentPort: 8132},
+			apiServerExtraArgs: map[string]string{"egress-selector-config-file": "/etc/kubernetes/egress.yaml"},
+			wantErr:            "apiServerExtraArgs egress-selector-config-file can't be set with konnectivity",
 		},
 	}
+
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			g := NewWithT(t)
-			config := &Cluster{
+			c := &Cluster{
 				Spec: ClusterSpec{
-					EksaVersion: (*EksaVersion)(&tt.version),
-					BundlesRef:  tt.bundlesRef,
+					DatacenterRef: Ref{Kind: tt.datacenterKind},
+					ControlPlaneConfiguration: ControlPlaneConfiguration{
+						Count:              tt.count,
+						Konnectivity:       tt.konnectivity,
+						APIServerExtraArgs: tt.apiServerExtraArgs,
+					},
 				},
 			}
-			err := validateEksaVersion(config)
+			err := validateKonnectivity(c)
 			if tt.wantErr == "" {
 				g.Expect(err).To(BeNil())
 			} else {
@@ -4035,236 +5879,334 @@ func TestValidateEksaVersion(t *testing.T) {
 	}
 }
 
-func TestGetClusterDefaultKubernetesVersion(t *testing.T) {
-	g := NewWithT(t)
-	g.Expect(GetClusterDefaultKubernetesVersion()).To(Equal(Kube136))
-}
-
-func TestClusterWorkerNodeConfigCount(t *testing.T) {
+func TestValidateDNS(t *testing.T) {
 	tests := []struct {
 		name    string
-		cluster *Cluster
-		want    []WorkerNodeGroupConfiguration
+		dns     *CoreDNSConfiguration
+		wantErr string
 	}{
 		{
-			name: "with worker node config count",
-			cluster: &Cluster{
-				Spec: ClusterSpec{},
+			name: "no dns configuration",
+		},
+		{
+			name: "valid dns configuration",
+			dns: &CoreDNSConfiguration{
+				StubDomains: []DNSStubDomain{
+					{Domain: "corp.example.com", Nameservers: []string{"10.0.0.10", "10.0.0.11:5353"}},
+					{Domain: "Lab.Example.com.", Nameservers: []string{"[fd00::10]:53"}},
+				},
+				UpstreamNameservers: []string{"1.1.1.1", "fd00::53"},
+				Autoscaling: &DNSAutoscaling{
+					MinReplicas:     2,
+					MaxReplicas:     10,
+					NodesPerReplica: 8,
+					Autoscaler:      ClusterProportionalDNSAutoscaler,
+				},
+				NodeLocalDNSCache: &NodeLocalDNSCache{LocalIP: "169.254.25.10"},
 			},
-			want: []WorkerNodeGroupConfiguration{
-				{
-					Name:                     "",
-					Count:                    ptr.Int(5),
-					AutoScalingConfiguration: nil,
-					MachineGroupRef:          nil,
-					Taints:                   nil,
-					Labels:                   nil,
+		},
+		{
+			name: "invalid stub domain",
+			dns: &CoreDNSConfiguration{
+				StubDomains: []DNSStubDomain{{Domain: "corp_example", Nameservers: []string{"10.0.0.10"}}},
+			},
+			wantErr: "cluster dns.stubDomains domain corp_example is invalid",
+		},
+		{
+			name: "cluster domain as stub domain",
+			dns: &CoreDNSConfiguration{
+				StubDomains: []DNSStubDomain{{Domain: "cluster.local", Nameservers: []string{"10.0.0.10"}}},
+			},
+			wantErr: "cluster dns.stubDomains domain cluster.local can't be the cluster domain",
+		},
+		{
+			name: "duplicated stub domain",
+			dns: &CoreDNSConfiguration{
+				StubDomains: []DNSStubDomain{
+					{Domain: "corp.example.com", Nameservers: []string{"10.0.0.10"}},
+					{Domain: "CORP.example.com", Nameservers: []string{"10.0.0.11"}},
 				},
 			},
+			wantErr: "cluster dns.stubDomains domain CORP.example.com is duplicated",
 		},
-	}
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			cg := NewClusterGenerate("test-cluster", WorkerNodeConfigCount(5))
-			g := NewWithT(t)
-			g.Expect(cg.Spec.WorkerNodeGroupConfigurations).To(Equal(tt.want))
-		})
-	}
-}
-
-func TestClusterCPUpgradeRolloutStrategyNil(t *testing.T) {
-	tests := []struct {
-		name    string
-		cluster *Cluster
-		want    ControlPlaneConfiguration
-	}{
 		{
-			name: "with control plane rollout upgrade strategy nil",
-			cluster: &Cluster{
-				Spec: ClusterSpec{},
+			name: "stub domain without nameservers",
+			dns: &CoreDNSConfiguration{
+				StubDomains: []DNSStubDomain{{Domain: "corp.example.com"}},
 			},
-			want: ControlPlaneConfiguration{
-				Endpoint:               nil,
-				Count:                  1,
-				MachineGroupRef:        nil,
-				Taints:                 nil,
-				Labels:                 nil,
-				UpgradeRolloutStrategy: nil,
+			wantErr: "cluster dns.stubDomains nameservers for corp.example.com can't be empty",
+		},
+		{
+			name: "stub domain with hostname nameserver",
+			dns: &CoreDNSConfiguration{
+				StubDomains: []DNSStubDomain{{Domain: "corp.example.com", Nameservers: []string{"ns1.example.com"}}},
+			},
+			wantErr: "cluster dns.stubDomains nameservers for corp.example.com: nameserver ns1.example.com is not a valid IP",
+		},
+		{
+			name: "upstream nameserver with invalid port",
+			dns: &CoreDNSConfiguration{
+				UpstreamNameservers: []string{"1.1.1.1:70000"},
+			},
+			wantErr: "cluster dns.upstreamNameservers: nameserver 1.1.1.1:70000 has an invalid port",
+		},
+		{
+			name: "negative autoscaling parameter",
+			dns: &CoreDNSConfiguration{
+				Autoscaling: &DNSAutoscaling{NodesPerReplica: -1},
+			},
+			wantErr: "cluster dns.autoscaling parameters can't be negative",
+		},
+		{
+			name: "max replicas lower than min replicas",
+			dns: &CoreDNSConfiguration{
+				Autoscaling: &DNSAutoscaling{MinReplicas: 3, MaxReplicas: 2},
+			},
+			wantErr: "cluster dns.autoscaling.maxReplicas 2 can't be lower than minReplicas 3",
+		},
+		{
+			name: "unsupported autoscaler",
+			dns: &CoreDNSConfiguration{
+				Autoscaling: &DNSAutoscaling{Autoscaler: "HPA"},
+			},
+			wantErr: "cluster dns.autoscaling.autoscaler HPA is not supported",
+		},
+		{
+			name: "node local dns cache without local ip",
+			dns: &CoreDNSConfiguration{
+				NodeLocalDNSCache: &NodeLocalDNSCache{},
+			},
+		},
+		{
+			name: "node local dns cache with routable local ip",
+			dns: &CoreDNSConfiguration{
+				NodeLocalDNSCache: &NodeLocalDNSCache{LocalIP: "10.0.0.10"},
 			},
+			wantErr: "cluster dns.nodeLocalDNSCache.localIP 10.0.0.10 must be a link-local IPv4 address",
 		},
 	}
+
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			cg := NewClusterGenerate("test-cluster", ControlPlaneConfigCount(1))
 			g := NewWithT(t)
-			g.Expect(cg.Spec.ControlPlaneConfiguration).To(Equal(tt.want))
+			c := &Cluster{
+				Spec: ClusterSpec{
+					DNS: tt.dns,
+				},
+			}
+			err := validateDNS(c)
+			if tt.wantErr == "" {
+				g.Expect(err).To(BeNil())
+			} else {
+				g.Expect(err).To(MatchError(ContainSubstring(tt.wantErr)))
+			}
 		})
 	}
 }
 
-func TestClusterCPUpgradeRolloutStrategyNotNil(t *testing.T) {
+func TestGetControlPlaneHostPort(t *testing.T) {
 	tests := []struct {
-		name    string
-		cluster *Cluster
-		want    ControlPlaneConfiguration
+		name     string
+		host     string
+		wantHost string
+		wantPort string
+		wantErr  string
 	}{
 		{
-			name: "with control plane rollout upgrade strategy non-nil",
-			cluster: &Cluster{
-				Spec: ClusterSpec{},
-			},
-			want: ControlPlaneConfiguration{
-				Endpoint:               nil,
-				Count:                  1,
-				MachineGroupRef:        nil,
-				Taints:                 nil,
-				Labels:                 nil,
-				UpgradeRolloutStrategy: &ControlPlaneUpgradeRolloutStrategy{Type: "RollingUpdate", RollingUpdate: &ControlPlaneRollingUpdateParams{MaxSurge: 5}},
-			},
+			name:     "ipv4 without port",
+			host:     "1.2.3.4",
+			wantHost: "1.2.3.4",
+			wantPort: "6443",
+		},
+		{
+			name:     "ipv4 with port",
+			host:     "1.2.3.4:6442",
+			wantHost: "1.2.3.4",
+			wantPort: "6442",
+		},
+		{
+			name:     "hostname without port",
+			host:     "cp.example.com",
+			wantHost: "cp.example.com",
+			wantPort: "6443",
+		},
+		{
+			name:     "ipv6 without port",
+			host:     "fd00::10",
+			wantHost: "fd00::10",
+			wantPort: "6443",
+		},
+		{
+			name:     "bracketed ipv6 without port",
+			host:     "[fd00::10]",
+			wantHost: "fd00::10",
+			wantPort: "6443",
+		},
+		{
+			name:     "ipv6 with port",
+			host:     "[fd00::10]:6442",
+			wantHost: "fd00::10",
+			wantPort: "6442",
+		},
+		{
+			name:    "invalid host",
+			host:    "host::",
+			wantErr: "host host:: is invalid",
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			cg := NewClusterGenerate("test-cluster", ControlPlaneConfigCount(1), WithCPUpgradeRolloutStrategy(5, 2))
 			g := NewWithT(t)
-			g.Expect(cg.Spec.ControlPlaneConfiguration).To(Equal(tt.want))
+			host, port, err := GetControlPlaneHostPort(tt.host, ControlEndpointDefaultPort)
+			if tt.wantErr != "" {
+				g.Expect(err).To(MatchError(ContainSubstring(tt.wantErr)))
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(host).To(Equal(tt.wantHost))
+			g.Expect(port).To(Equal(tt.wantPort))
 		})
 	}
 }
 
-func TestClusterMDUpgradeRolloutStrategyNil(t *testing.T) {
+func TestEndpointHostname(t *testing.T) {
 	tests := []struct {
-		name    string
-		cluster *Cluster
-		want    []WorkerNodeGroupConfiguration
+		host string
+		want string
 	}{
-		{
-			name: "with md rollout upgrade strategy knobs not specified",
-			cluster: &Cluster{
-				Spec: ClusterSpec{},
-			},
-			want: []WorkerNodeGroupConfiguration{
-				{
-					Count:           ptr.Int(1),
-					MachineGroupRef: nil,
-					Taints:          nil,
-					Labels:          nil,
-				},
-			},
-		},
+		{host: "cp.example.com", want: "cp.example.com"},
+		{host: "cp.example.com:6443", want: "cp.example.com"},
+		{host: "fd00::10", want: "fd00::10"},
+		{host: "[fd00::10]", want: "fd00::10"},
+		{host: "[fd00::10]:6443", want: "fd00::10"},
 	}
 	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			cg := NewClusterGenerate("test-cluster", WorkerNodeConfigCount(1))
+		t.Run(tt.host, func(t *testing.T) {
 			g := NewWithT(t)
-			g.Expect(cg.Spec.WorkerNodeGroupConfigurations).To(Equal(tt.want))
+			g.Expect((&Endpoint{Host: tt.host}).Hostname()).To(Equal(tt.want))
 		})
 	}
 }
 
-func TestClusterMDUpgradeRolloutStrategyNotNil(t *testing.T) {
+func TestValidateProxyData(t *testing.T) {
 	tests := []struct {
 		name    string
-		cluster *Cluster
-		want    []WorkerNodeGroupConfiguration
+		proxy   string
+		wantErr string
 	}{
 		{
-			name: "with md rollout upgrade strategy",
-			cluster: &Cluster{
-				Spec: ClusterSpec{},
-			},
-			want: []WorkerNodeGroupConfiguration{
-				{
-					Count:                  ptr.Int(1),
-					MachineGroupRef:        nil,
-					Taints:                 nil,
-					Labels:                 nil,
-					UpgradeRolloutStrategy: &WorkerNodesUpgradeRolloutStrategy{Type: "RollingUpdate", RollingUpdate: &WorkerNodesRollingUpdateParams{MaxSurge: 5, MaxUnavailable: 2}},
-				},
-			},
+			name:  "ipv4 url",
+			proxy: "http://1.2.3.4:3128",
+		},
+		{
+			name:  "ipv4 address",
+			proxy: "1.2.3.4:3128",
+		},
+		{
+			name:  "ipv6 url",
+			proxy: "http://[fd00::1]:3128",
+		},
+		{
+			name:  "ipv6 address",
+			proxy: "[fd00::1]:3128",
+		},
+		{
+			name:    "ipv6 address without brackets",
+			proxy:   "fd00::1:3128",
+			wantErr: "IPv6 addresses must be enclosed in brackets and followed by the port, e.g. [fd00::1]:3128",
+		},
+		{
+			name:    "invalid port",
+			proxy:   "http://[fd00::1]:0",
+			wantErr: "proxy port 0 is invalid",
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			cg := NewClusterGenerate("test-cluster", WorkerNodeConfigCount(1), WithWorkerMachineUpgradeRolloutStrategy(5, 2))
 			g := NewWithT(t)
-			g.Expect(cg.Spec.WorkerNodeGroupConfigurations).To(Equal(tt.want))
+			err := validateProxyData(tt.proxy)
+			if tt.wantErr == "" {
+				g.Expect(err).ToNot(HaveOccurred())
+			} else {
+				g.Expect(err).To(MatchError(ContainSubstring(tt.wantErr)))
+			}
 		})
 	}
 }
 
-func TestClusterCPUpgradeInPlace(t *testing.T) {
-	g := NewWithT(t)
-
-	cluster := NewClusterGenerate("test-cluster", WorkerNodeConfigCount(1))
-	cluster.Spec.ControlPlaneConfiguration.UpgradeRolloutStrategy = &ControlPlaneUpgradeRolloutStrategy{Type: InPlaceStrategyType}
-	cluster.Spec.WorkerNodeGroupConfigurations[0].UpgradeRolloutStrategy = &WorkerNodesUpgradeRolloutStrategy{Type: InPlaceStrategyType}
-
-	clusterSpec, err := yaml.Marshal(cluster)
-	g.Expect(err).To(BeNil())
-
-	expected, err := os.ReadFile("testdata/cluster_in_place_upgrade.yaml")
-	g.Expect(err).To(BeNil())
-	g.Expect(string(clusterSpec)).To(BeEquivalentTo(string(expected)))
-}
-
-func TestValidateAuditPolicyContent(t *testing.T) {
+func TestValidateSpire(t *testing.T) {
 	tests := []struct {
-		name        string
-		auditPolicy string
-		wantErr     bool
+		name           string
+		wantErr        string
+		datacenterKind string
+		spire          *SpireConfiguration
 	}{
 		{
-			name:        "empty audit policy content",
-			auditPolicy: "",
-			wantErr:     false,
+			name:           "no spire",
+			datacenterKind: DockerDatacenterKind,
 		},
 		{
-			name: "valid audit policy content",
-			auditPolicy: `apiVersion: audit.k8s.io/v1
-kind: Policy
-rules:
-- level: Metadata
-  omitStages:
-  - RequestReceived`,
-			wantErr: false,
+			name:           "default attestor on vsphere",
+			datacenterKind: VSphereDatacenterKind,
+			spire:          &SpireConfiguration{TrustDomain: "example.org"},
 		},
 		{
-			name: "invalid audit policy content - not valid YAML",
-			auditPolicy: `apiVersion: audit.k8s.io/v1
-kind: Policy
-rules:
-- level: Metadata
-  omitStages:
-  - RequestReceived
-  invalid yaml content`,
-			wantErr: true,
+			name:           "x509pop on vsphere",
+			datacenterKind: VSphereDatacenterKind,
+			spire:          &SpireConfiguration{TrustDomain: "example.org", NodeAttestor: X509PopSpireNodeAttestor, CABundleSecretName: "node-ca"},
 		},
 		{
-			name: "invalid audit policy content - malformed YAML",
-			auditPolicy: `apiVersion: audit.k8s.io/v1
-kind: Policy
-rules:
-- level: Metadata
-  omitStages:
-  - RequestReceived
- badIndentation: value`,
-			wantErr: true,
+			name:           "tpm devid on tinkerbell",
+			datacenterKind: TinkerbellDatacenterKind,
+			spire:          &SpireConfiguration{TrustDomain: "prod.example.org", NodeAttestor: TPMDevIDSpireNodeAttestor, CABundleSecretName: "devid-ca"},
+		},
+		{
+			name:           "unsupported provider",
+			wantErr:        "cluster spire is not supported for DockerDatacenterConfig",
+			datacenterKind: DockerDatacenterKind,
+			spire:          &SpireConfiguration{TrustDomain: "example.org"},
+		},
+		{
+			name:           "invalid trust domain",
+			wantErr:        `cluster spire.trustDomain "Example.org" is invalid`,
+			datacenterKind: VSphereDatacenterKind,
+			spire:          &SpireConfiguration{TrustDomain: "Example.org"},
+		},
+		{
+			name:           "empty trust domain",
+			wantErr:        `cluster spire.trustDomain "" is invalid`,
+			datacenterKind: VSphereDatacenterKind,
+			spire:          &SpireConfiguration{},
+		},
+		{
+			name:           "tpm devid on vsphere",
+			wantErr:        "cluster spire.nodeAttestor tpm_devid is not supported for VSphereDatacenterConfig",
+			datacenterKind: VSphereDatacenterKind,
+			spire:          &SpireConfiguration{TrustDomain: "example.org", NodeAttestor: TPMDevIDSpireNodeAttestor, CABundleSecretName: "devid-ca"},
+		},
+		{
+			name:           "x509pop without ca bundle",
+			wantErr:        "cluster spire.caBundleSecretName is required for the x509pop node attestor",
+			datacenterKind: TinkerbellDatacenterKind,
+			spire:          &SpireConfiguration{TrustDomain: "example.org", NodeAttestor: X509PopSpireNodeAttestor},
 		},
 	}
-
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			c := &Cluster{
+			g := NewWithT(t)
+			cluster := &Cluster{
 				Spec: ClusterSpec{
-					ControlPlaneConfiguration: ControlPlaneConfiguration{
-						AuditPolicyContent: tt.auditPolicy,
+					DatacenterRef: Ref{
+						Kind: tt.datacenterKind,
 					},
+					Spire: tt.spire,
 				},
 			}
-			err := validateAuditPolicyContent(c)
-			if (err != nil) != tt.wantErr {
-				t.Errorf("validateAuditPolicyContent() error = %v, wantErr %v", err, tt.wantErr)
+			err := validateSpire(cluster)
+			if tt.wantErr == "" {
+				g.Expect(err).To(BeNil())
+			} else {
+				g.Expect(err).To(MatchError(ContainSubstring(tt.wantErr)))
 			}
 		})
 	}
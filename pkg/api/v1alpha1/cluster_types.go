@@ -5,6 +5,7 @@ import (
 	"net"
 	"reflect"
 	"strings"
+	"text/template"
 
 	corev1 "k8s.io/api/core/v1"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
@@ -66,6 +67,15 @@ const (
 	// AllowDeleteWhenPausedAnnotation is an annotation applied to an EKS-A cluster that allows the deletion of the cluster
 	// when paused.
 	AllowDeleteWhenPausedAnnotation = "anywhere.eks.amazonaws.com/allow-delete-when-paused"
+
+	// FrozenAnnotation can be applied by operators to an EKS-A cluster to stop the controller from
+	// reconciling it, for instance while they intervene manually on the cluster. Unlike the paused
+	// annotation, the CLI never removes it. Its value is the reason of the freeze.
+	FrozenAnnotation = "anywhere.eks.amazonaws.com/frozen"
+
+	// FrozenByAnnotation records the user that froze the cluster. The cluster webhook sets it from
+	// the request that adds the frozen annotation and overrides any value set by clients.
+	FrozenByAnnotation = "anywhere.eks.amazonaws.com/frozen-by"
 )
 
 // NOTE: json tags are required.  Any new fields you add must have json tags for the fields to be serialized.
@@ -93,6 +103,31 @@ type ClusterSpec struct {
 	MachineHealthCheck *MachineHealthCheck `json:"machineHealthCheck,omitempty"`
 	EtcdEncryption     *[]EtcdEncryption   `json:"etcdEncryption,omitempty"`
 	LicenseToken       string              `json:"licenseToken,omitempty"`
+	// ExternalDNS configures an external-dns installation managed by EKS Anywhere, which creates
+	// DNS records for the Ingresses and LoadBalancer Services of the cluster.
+	// +optional
+	ExternalDNS *ExternalDNSConfiguration `json:"externalDns,omitempty"`
+	// DefaultStorageClass configures a StorageClass EKS Anywhere creates and maintains in the cluster,
+	// annotated as the default one for PersistentVolumeClaims without a storage class.
+	// +optional
+	DefaultStorageClass *DefaultStorageClass `json:"defaultStorageClass,omitempty"`
+	// ServiceLoadBalancer configures a load balancer managed by EKS Anywhere for the Services of
+	// type LoadBalancer. Only supported for the vSphere and Tinkerbell providers.
+	// +optional
+	ServiceLoadBalancer *ServiceLoadBalancerConfiguration `json:"serviceLoadBalancer,omitempty"`
+	// DNS configures the CoreDNS Corefile and replicas, which EKS Anywhere keeps in sync across upgrades.
+	// +optional
+	DNS *CoreDNSConfiguration `json:"dns,omitempty"`
+	// NodeOSConfiguration configures the kernel of all the control plane and worker nodes, so
+	// CNI or database tuning doesn't require custom node images.
+	// Only supported for the vSphere and Tinkerbell providers.
+	// +optional
+	NodeOSConfiguration *NodeOSConfiguration `json:"nodeOSConfiguration,omitempty"`
+	// Spire configures a SPIRE server and agents managed by EKS Anywhere, which attest the identity
+	// of the nodes and issue SPIFFE identities to the workloads of the cluster.
+	// Only supported for the vSphere and Tinkerbell providers.
+	// +optional
+	Spire *SpireConfiguration `json:"spire,omitempty"`
 }
 
 // EksaVersion is the semver identifying the release of eks-a used to populate the cluster components.
@@ -194,6 +229,24 @@ func (n *Cluster) Equal(o *Cluster) bool {
 	if n.Spec.LicenseToken != o.Spec.LicenseToken {
 		return false
 	}
+	if !n.Spec.ExternalDNS.Equal(o.Spec.ExternalDNS) {
+		return false
+	}
+	if !n.Spec.DefaultStorageClass.Equal(o.Spec.DefaultStorageClass) {
+		return false
+	}
+	if !n.Spec.ServiceLoadBalancer.Equal(o.Spec.ServiceLoadBalancer) {
+		return false
+	}
+	if !n.Spec.DNS.Equal(o.Spec.DNS) {
+		return false
+	}
+	if !n.Spec.NodeOSConfiguration.Equal(o.Spec.NodeOSConfiguration) {
+		return false
+	}
+	if !n.Spec.Spire.Equal(o.Spec.Spire) {
+		return false
+	}
 
 	return true
 }
@@ -319,6 +372,14 @@ type ControlPlaneConfiguration struct {
 	MachineHealthCheck *MachineHealthCheck `json:"machineHealthCheck,omitempty"`
 	// APIServerExtraArgs defines the flags to configure for the API server.
 	APIServerExtraArgs map[string]string `json:"apiServerExtraArgs,omitempty"`
+	// ControllerManagerExtraArgs defines the flags to configure for the kube-controller-manager.
+	// Only supported for the vSphere, CloudStack and Docker providers.
+	// +optional
+	ControllerManagerExtraArgs map[string]string `json:"controllerManagerExtraArgs,omitempty"`
+	// SchedulerExtraArgs defines the flags to configure for the kube-scheduler.
+	// Only supported for the vSphere, CloudStack and Docker providers.
+	// +optional
+	SchedulerExtraArgs map[string]string `json:"schedulerExtraArgs,omitempty"`
 	// KubeletConfiguration is a struct that exposes the Kubelet settings for the user to set on control plane nodes.
 	// +kubebuilder:pruning:PreserveUnknownFields
 	KubeletConfiguration *unstructured.Unstructured `json:"kubeletConfiguration,omitempty"`
@@ -326,12 +387,132 @@ type ControlPlaneConfiguration struct {
 	// If not specified, the default audit policy will be used.
 	// +optional
 	AuditPolicyContent string `json:"auditPolicyContent,omitempty"`
+	// AuditLog configures the rotation of the kube-apiserver audit log files.
+	// If not specified, the default audit log settings will be used.
+	// +optional
+	AuditLog *AuditLogConfiguration `json:"auditLog,omitempty"`
 	// SkipAdmissionForSystemResources skips admission plugin checks for system-level Kubernetes resources
 	// When enabled, operations on system resources (such as kube-system ns resources Pods,
 	// RBAC, API service registrations, flow control, etc. and system user operations) will bypass admission plugins
 	// will bypass admission plugins to prevent potential deadlocks or failures for cluster operations.
 	// +optional
 	SkipAdmissionForSystemResources *bool `json:"skipAdmissionForSystemResources,omitempty"`
+	// FailureDomains is the optional list of failure domains to spread the control plane nodes across.
+	// Only supported for the vSphere provider.
+	// +optional
+	FailureDomains []string `json:"failureDomains,omitempty"`
+	// PodSecurityAdmission configures the cluster-wide defaults of the PodSecurity admission plugin.
+	// Only supported for the vSphere, CloudStack and Docker providers.
+	// +optional
+	PodSecurityAdmission *PodSecurityAdmissionConfiguration `json:"podSecurityAdmission,omitempty"`
+	// MachineNaming defines the names of the control plane machines, and of their VMs.
+	// Only supported for the vSphere and Nutanix providers.
+	// +optional
+	MachineNaming *MachineNamingConfiguration `json:"machineNaming,omitempty"`
+	// Konnectivity routes the traffic from the API server to the nodes, like logs, exec and webhook calls,
+	// through tunnels opened by konnectivity agents on the nodes to konnectivity servers on the control
+	// plane nodes. It's used when the control plane network can't reach the node networks directly.
+	// Only supported for the vSphere and Tinkerbell providers.
+	// +optional
+	Konnectivity *KonnectivityConfiguration `json:"konnectivity,omitempty"`
+}
+
+const (
+	// DefaultKonnectivityAgentPort is the default port of the konnectivity servers the agents connect to.
+	DefaultKonnectivityAgentPort = 8132
+	// KonnectivityServerHealthPort is the health port of the konnectivity servers.
+	KonnectivityServerHealthPort = 8092
+	// KonnectivityAgentHealthPort is the health port of the konnectivity agents.
+	KonnectivityAgentHealthPort = 8093
+	// KonnectivityAgentAdminPort is the admin port of the konnectivity agents.
+	KonnectivityAgentAdminPort = 8094
+	// KonnectivityServerAdminPort is the admin port of the konnectivity servers.
+	KonnectivityServerAdminPort = 8095
+
+	konnectivityEgressSelectorArg = "egress-selector-config-file"
+)
+
+// KonnectivityConfiguration defines the konnectivity servers of the control plane and how the
+// konnectivity agents of the nodes reach them.
+type KonnectivityConfiguration struct {
+	// ServerHost is the address the konnectivity agents connect to, which must reach the konnectivity servers
+	// of all the control plane nodes, like a load balancer. Required with more than one control plane node.
+	// Defaults to the control plane endpoint host.
+	// +optional
+	ServerHost string `json:"serverHost,omitempty"`
+	// AgentPort is the port of the konnectivity servers the agents connect to. Defaults to 8132.
+	// +optional
+	AgentPort int `json:"agentPort,omitempty"`
+}
+
+// Equal compares two KonnectivityConfigurations.
+func (n *KonnectivityConfiguration) Equal(o *KonnectivityConfiguration) bool {
+	if n == o {
+		return true
+	}
+	if n == nil || o == nil {
+		return false
+	}
+	return n.ServerHost == o.ServerHost && n.AgentPort == o.AgentPort
+}
+
+// MachineNamingConfiguration defines the naming of the machines of a node group, and of their VMs,
+// instead of the default <cluster>-<node group>-<hash>.
+type MachineNamingConfiguration struct {
+	// Template is the Go template of the machine names. It can use the variables {{ .cluster.name }},
+	// {{ .nodeGroup.name }}, {{ .zone }}, the failure domain of the machines, and {{ .random }}, a random
+	// 5 characters suffix, which is required so the names of the machines replaced during upgrades don't collide.
+	// Names longer than 63 characters are trimmed to 58 characters followed by a random suffix.
+	Template string `json:"template"`
+}
+
+const (
+	// ControlPlaneNodeGroupName is the value of the {{ .nodeGroup.name }} variable of the control
+	// plane machine naming template.
+	ControlPlaneNodeGroupName = "control-plane"
+
+	// MachineNamingClusterNameVariable is the Cluster API machine naming variable of the cluster name.
+	MachineNamingClusterNameVariable = "{{ .cluster.name }}"
+	// MachineNamingRandomVariable is the Cluster API machine naming variable of the random suffix.
+	MachineNamingRandomVariable = "{{ .random }}"
+)
+
+// CAPITemplate returns the Cluster API machine naming template of the machines of a node group
+// in a zone, with the node group and zone variables replaced by their values. The cluster name and
+// random variables are left for Cluster API to replace. An empty zone means the machines have no
+// failure domain, so templates using it are rejected.
+func (m *MachineNamingConfiguration) CAPITemplate(nodeGroup, zone string) (string, error) {
+	tmpl, err := template.New("machineNaming").Option("missingkey=error").Parse(m.Template)
+	if err != nil {
+		return "", fmt.Errorf("parsing machine naming template: %v", err)
+	}
+
+	values := map[string]interface{}{
+		"cluster":   map[string]string{"name": MachineNamingClusterNameVariable},
+		"nodeGroup": map[string]string{"name": nodeGroup},
+		"random":    MachineNamingRandomVariable,
+	}
+	if zone != "" {
+		values["zone"] = zone
+	}
+
+	var b strings.Builder
+	if err := tmpl.Execute(&b, values); err != nil {
+		return "", fmt.Errorf("rendering machine naming template: %v", err)
+	}
+
+	return b.String(), nil
+}
+
+// Equal compares two MachineNamingConfigurations.
+func (m *MachineNamingConfiguration) Equal(o *MachineNamingConfiguration) bool {
+	if m == o {
+		return true
+	}
+	if m == nil || o == nil {
+		return false
+	}
+	return m.Template == o.Template
 }
 
 // MachineHealthCheck allows to configure timeouts for machine health checks. Machine Health Checks are responsible for remediating unhealthy Machines.
@@ -393,12 +574,143 @@ func (n *ControlPlaneConfiguration) Equal(o *ControlPlaneConfiguration) bool {
 	return n.Count == o.Count && n.MachineGroupRef.Equal(o.MachineGroupRef) &&
 		TaintsSliceEqual(n.Taints, o.Taints) && MapEqual(n.Labels, o.Labels) &&
 		SliceEqual(n.CertSANs, o.CertSANs) && MapEqual(n.APIServerExtraArgs, o.APIServerExtraArgs) &&
-		n.AuditPolicyContent == o.AuditPolicyContent && skipAdmissionEqual
+		MapEqual(n.ControllerManagerExtraArgs, o.ControllerManagerExtraArgs) && MapEqual(n.SchedulerExtraArgs, o.SchedulerExtraArgs) &&
+		n.AuditPolicyContent == o.AuditPolicyContent && n.AuditLog.Equal(o.AuditLog) && skipAdmissionEqual &&
+		SliceEqual(n.FailureDomains, o.FailureDomains) && n.PodSecurityAdmission.Equal(o.PodSecurityAdmission) &&
+		n.MachineNaming.Equal(o.MachineNaming) && n.Konnectivity.Equal(o.Konnectivity)
+}
+
+// AuditLogConfiguration defines the rotation settings of the kube-apiserver audit log files.
+type AuditLogConfiguration struct {
+	// MaxAge is the maximum number of days to retain old audit log files. Defaults to 30.
+	// +optional
+	MaxAge *int `json:"maxAge,omitempty"`
+	// MaxBackup is the maximum number of old audit log files to retain. Defaults to 10.
+	// +optional
+	MaxBackup *int `json:"maxBackup,omitempty"`
+	// MaxSize is the maximum size in megabytes of the audit log file before it gets rotated. Defaults to 512.
+	// +optional
+	MaxSize *int `json:"maxSize,omitempty"`
+}
+
+// Equal returns true if both audit log configurations are the same.
+func (n *AuditLogConfiguration) Equal(o *AuditLogConfiguration) bool {
+	if n == o {
+		return true
+	}
+	if n == nil || o == nil {
+		return false
+	}
+	return intPtrEqual(n.MaxAge, o.MaxAge) && intPtrEqual(n.MaxBackup, o.MaxBackup) && intPtrEqual(n.MaxSize, o.MaxSize)
+}
+
+// PodSecurityLevel is a Pod Security Standards level.
+type PodSecurityLevel string
+
+const (
+	// PodSecurityLevelPrivileged allows any pod.
+	PodSecurityLevelPrivileged PodSecurityLevel = "privileged"
+	// PodSecurityLevelBaseline prevents known privilege escalations.
+	PodSecurityLevelBaseline PodSecurityLevel = "baseline"
+	// PodSecurityLevelRestricted enforces the pod hardening best practices.
+	PodSecurityLevelRestricted PodSecurityLevel = "restricted"
+)
+
+// PodSecurityAdmissionConfiguration defines the cluster-wide defaults of the PodSecurity admission plugin.
+// They apply to the namespaces without pod-security.kubernetes.io labels.
+type PodSecurityAdmissionConfiguration struct {
+	// Enforce is the level whose violations reject the pod. Defaults to privileged.
+	// +kubebuilder:validation:Enum=privileged;baseline;restricted
+	// +optional
+	Enforce PodSecurityLevel `json:"enforce,omitempty"`
+	// Audit is the level whose violations are recorded in the audit log. Defaults to privileged.
+	// +kubebuilder:validation:Enum=privileged;baseline;restricted
+	// +optional
+	Audit PodSecurityLevel `json:"audit,omitempty"`
+	// Warn is the level whose violations are returned as warnings to the user. Defaults to privileged.
+	// +kubebuilder:validation:Enum=privileged;baseline;restricted
+	// +optional
+	Warn PodSecurityLevel `json:"warn,omitempty"`
+	// Exemptions are the requests the PodSecurity admission plugin doesn't check, on top of
+	// the EKS Anywhere system namespaces, which are always exempted.
+	// +optional
+	Exemptions *PodSecurityAdmissionExemptions `json:"exemptions,omitempty"`
+}
+
+// Equal returns true if both pod security admission configurations are the same.
+func (n *PodSecurityAdmissionConfiguration) Equal(o *PodSecurityAdmissionConfiguration) bool {
+	if n == o {
+		return true
+	}
+	if n == nil || o == nil {
+		return false
+	}
+	return n.Enforce == o.Enforce && n.Audit == o.Audit && n.Warn == o.Warn && n.Exemptions.Equal(o.Exemptions)
+}
+
+// PodSecurityAdmissionExemptions defines the requests exempted from the PodSecurity admission plugin checks.
+type PodSecurityAdmissionExemptions struct {
+	// Usernames are the authenticated users whose requests are exempted.
+	// +optional
+	Usernames []string `json:"usernames,omitempty"`
+	// RuntimeClasses are the runtime class names of the exempted pods.
+	// +optional
+	RuntimeClasses []string `json:"runtimeClasses,omitempty"`
+	// Namespaces are the exempted namespaces.
+	// +optional
+	Namespaces []string `json:"namespaces,omitempty"`
+}
+
+// Equal returns true if both pod security admission exemptions are the same.
+func (n *PodSecurityAdmissionExemptions) Equal(o *PodSecurityAdmissionExemptions) bool {
+	if n == o {
+		return true
+	}
+	if n == nil || o == nil {
+		return false
+	}
+	return SliceEqual(n.Usernames, o.Usernames) && SliceEqual(n.RuntimeClasses, o.RuntimeClasses) &&
+		SliceEqual(n.Namespaces, o.Namespaces)
 }
 
 type Endpoint struct {
 	// Host defines the ip that you want to use to connect to the control plane
 	Host string `json:"host"`
+	// DNS configures the creation of the DNS record for Host, when it is a hostname, before
+	// the cluster machines are created.
+	// +optional
+	DNS *EndpointDNS `json:"dns,omitempty"`
+}
+
+// EndpointDNS defines the DNS record the CLI creates for a control plane endpoint hostname.
+type EndpointDNS struct {
+	// Provider is the DNS provider the record is created with.
+	// +kubebuilder:validation:Enum=route53;infoblox
+	Provider DNSProvider `json:"provider"`
+	// Address is the IPv4 address the record points to.
+	Address string `json:"address"`
+	// Zone is the Route 53 hosted zone ID for route53, and the DNS view for infoblox,
+	// where it defaults to the default view.
+	// +optional
+	Zone string `json:"zone,omitempty"`
+}
+
+// DNSProvider is a DNS service the control plane endpoint record can be created with.
+type DNSProvider string
+
+const (
+	// Route53DNSProvider creates the record in an Amazon Route 53 hosted zone.
+	Route53DNSProvider DNSProvider = "route53"
+	// InfobloxDNSProvider creates the record through the Infoblox WAPI.
+	InfobloxDNSProvider DNSProvider = "infoblox"
+)
+
+// Hostname returns the host of the endpoint without the port, if it has one.
+func (n *Endpoint) Hostname() string {
+	if host, _, err := net.SplitHostPort(n.Host); err == nil {
+		return host
+	}
+	return strings.TrimSuffix(strings.TrimPrefix(n.Host, "["), "]")
 }
 
 // Equal compares if expected endpoint and existing endpoint are equal for non CloudStack clusters.
@@ -451,7 +763,11 @@ func (n *Endpoint) CloudStackEqual(o *Endpoint) bool {
 }
 
 // GetControlPlaneHostPort retrieves the ControlPlaneConfiguration host and port split defined in the cluster.Spec.
+// IPv6 addresses without a port can be set with or without brackets.
 func GetControlPlaneHostPort(pHost string, defaultPort string) (string, string, error) {
+	if host := strings.TrimSuffix(strings.TrimPrefix(pHost, "["), "]"); net.ParseIP(host) != nil {
+		return host, defaultPort, nil
+	}
 	host, port, err := net.SplitHostPort(pHost)
 	if err != nil {
 		if strings.Contains(err.Error(), "missing port") {
@@ -490,8 +806,54 @@ type WorkerNodeGroupConfiguration struct {
 	KubeletConfiguration *unstructured.Unstructured `json:"kubeletConfiguration,omitempty"`
 	// FailureDomains is the optional list of failure domains to distribute worker nodes across the infrastructure.
 	FailureDomains []string `json:"failureDomains,omitempty"`
+	// FailureDomainSpreadPolicy defines how the worker nodes are distributed when more than one failure domain is set.
+	// Defaults to Even.
+	FailureDomainSpreadPolicy FailureDomainSpreadPolicy `json:"failureDomainSpreadPolicy,omitempty"`
+	// GPU enables the NVIDIA GPUs of the worker nodes for the pods, with the NVIDIA device plugin and the nvidia runtime class.
+	GPU *GPUConfiguration `json:"gpu,omitempty"`
+	// MachineNaming defines the names of the worker machines, and of their VMs.
+	// Only supported for the vSphere and Nutanix providers.
+	// +optional
+	MachineNaming *MachineNamingConfiguration `json:"machineNaming,omitempty"`
+}
+
+// GPUConfiguration defines the NVIDIA GPU support of a worker node group.
+type GPUConfiguration struct {
+	// Taint adds the nvidia.com/gpu NoSchedule taint to the worker nodes, so only the pods tolerating it,
+	// like the ones using the nvidia runtime class, are scheduled on them. Defaults to true.
+	Taint *bool `json:"taint,omitempty"`
+}
+
+const (
+	// GPUNodeLabel is the label of the nodes of the worker node groups with GPU support.
+	GPUNodeLabel = "nvidia.com/gpu.present"
+	// GPUTaintKey is the key of the taint of the nodes of the worker node groups with GPU support.
+	GPUTaintKey = "nvidia.com/gpu"
+)
+
+// TaintEnabled returns whether the worker nodes are tainted for the GPU pods.
+func (g *GPUConfiguration) TaintEnabled() bool {
+	return g.Taint == nil || *g.Taint
+}
+
+// Equal compares two GPUConfigurations.
+func (g *GPUConfiguration) Equal(o *GPUConfiguration) bool {
+	if g == o {
+		return true
+	}
+	if g == nil || o == nil {
+		return false
+	}
+	return g.TaintEnabled() == o.TaintEnabled()
 }
 
+// FailureDomainSpreadPolicy defines how the worker nodes of a group are distributed across its failure domains.
+type FailureDomainSpreadPolicy string
+
+// FailureDomainSpreadPolicyEven splits the worker nodes count evenly across the failure domains,
+// assigning the remaining nodes to the first failure domains in the list.
+const FailureDomainSpreadPolicyEven FailureDomainSpreadPolicy = "Even"
+
 // Equal compares two WorkerNodeGroupConfigurations.
 func (w WorkerNodeGroupConfiguration) Equal(other WorkerNodeGroupConfiguration) bool {
 	return w.Name == other.Name &&
@@ -501,7 +863,11 @@ func (w WorkerNodeGroupConfiguration) Equal(other WorkerNodeGroupConfiguration)
 		w.KubernetesVersion.Equal(other.KubernetesVersion) &&
 		TaintsSliceEqual(w.Taints, other.Taints) &&
 		MapEqual(w.Labels, other.Labels) &&
-		w.UpgradeRolloutStrategy.Equal(other.UpgradeRolloutStrategy)
+		w.UpgradeRolloutStrategy.Equal(other.UpgradeRolloutStrategy) &&
+		SliceEqual(w.FailureDomains, other.FailureDomains) &&
+		w.FailureDomainSpreadPolicy == other.FailureDomainSpreadPolicy &&
+		w.GPU.Equal(other.GPU) &&
+		w.MachineNaming.Equal(other.MachineNaming)
 }
 
 // Equal compares two KubernetesVersions.
@@ -603,6 +969,60 @@ func (n *ClusterNetwork) Equal(o *ClusterNetwork) bool {
 		n.Nodes.Equal(o.Nodes)
 }
 
+// IPFamily is the IP family of the pod and service networks of a cluster.
+type IPFamily string
+
+const (
+	// IPv4IPFamily is the IP family of clusters with IPv4 pod and service CIDR blocks.
+	IPv4IPFamily IPFamily = "IPv4"
+	// IPv6IPFamily is the IP family of clusters with IPv6 pod and service CIDR blocks.
+	IPv6IPFamily IPFamily = "IPv6"
+	// DualStackIPFamily is the IP family of clusters with both an IPv4 and an IPv6 pod and
+	// service CIDR block.
+	DualStackIPFamily IPFamily = "DualStack"
+)
+
+// IPFamily returns the IP family of the cluster network, based on its pod CIDR blocks.
+// It defaults to IPv4 when the pod CIDR blocks are not set or not valid.
+func (n *ClusterNetwork) IPFamily() IPFamily {
+	primary := n.PrimaryIPFamily()
+	if n != nil && len(n.Pods.CidrBlocks) > 1 && cidrIPFamily(n.Pods.CidrBlocks[1]) != primary {
+		return DualStackIPFamily
+	}
+
+	return primary
+}
+
+// PrimaryIPFamily returns the IP family of the first pod CIDR block of the cluster network, which
+// is the family Kubernetes prefers in dual-stack clusters. It defaults to IPv4 when the pod CIDR
+// blocks are not set or not valid.
+func (n *ClusterNetwork) PrimaryIPFamily() IPFamily {
+	if n == nil || len(n.Pods.CidrBlocks) == 0 {
+		return IPv4IPFamily
+	}
+
+	return cidrIPFamily(n.Pods.CidrBlocks[0])
+}
+
+// IsIPv6 returns true if the pods and services of the cluster only use IPv6 addresses.
+func (n *ClusterNetwork) IsIPv6() bool {
+	return n.IPFamily() == IPv6IPFamily
+}
+
+// IsDualStack returns true if the pods and services of the cluster use both IPv4 and IPv6 addresses.
+func (n *ClusterNetwork) IsDualStack() bool {
+	return n.IPFamily() == DualStackIPFamily
+}
+
+func cidrIPFamily(cidr string) IPFamily {
+	ip, _, err := net.ParseCIDR(cidr)
+	if err != nil || ip.To4() != nil {
+		return IPv4IPFamily
+	}
+
+	return IPv6IPFamily
+}
+
 func getCNIConfig(cn *ClusterNetwork) *CNIConfig {
 	/* Only needed since we're introducing CNIConfig to replace the deprecated CNI field. This way we can compare the individual fields
 	for the CNI plugin configuration*/
@@ -646,6 +1066,9 @@ func (n *CNIConfig) Equal(o *CNIConfig) bool {
 	if !n.Kindnetd.Equal(o.Kindnetd) {
 		return false
 	}
+	if !n.Calico.Equal(o.Calico) {
+		return false
+	}
 	return true
 }
 
@@ -685,6 +1108,14 @@ func (n *CiliumConfig) Equal(o *CiliumConfig) bool {
 		return false
 	}
 
+	if n.KubeProxyReplacementEnabled() != o.KubeProxyReplacementEnabled() {
+		return false
+	}
+
+	if !n.Hubble.Equal(o.Hubble) {
+		return false
+	}
+
 	oSkipUpgradeIsFalse := o.SkipUpgrade == nil || !*o.SkipUpgrade
 	nSkipUpgradeIsFalse := n.SkipUpgrade == nil || !*n.SkipUpgrade
 
@@ -718,19 +1149,31 @@ func (n *KindnetdConfig) Equal(o *KindnetdConfig) bool {
 	return true
 }
 
+// Equal returns true if both Calico configurations are the same. A nil SkipUpgrade is considered false.
+func (n *CalicoConfig) Equal(o *CalicoConfig) bool {
+	if n == o {
+		return true
+	}
+	if n == nil || o == nil {
+		return false
+	}
+	return n.IsManaged() == o.IsManaged()
+}
+
 func UsersSliceEqual(a, b []UserConfiguration) bool {
 	if len(a) != len(b) {
 		return false
 	}
-	m := make(map[string][]string, len(a))
+	m := make(map[string]UserConfiguration, len(a))
 	for _, v := range a {
-		m[v.Name] = v.SshAuthorizedKeys
+		m[v.Name] = v
 	}
 	for _, v := range b {
-		if _, ok := m[v.Name]; !ok {
+		u, ok := m[v.Name]
+		if !ok {
 			return false
 		}
-		if !SliceEqual(v.SshAuthorizedKeys, m[v.Name]) {
+		if !SliceEqual(v.SshAuthorizedKeys, u.SshAuthorizedKeys) || v.SudoPolicy() != u.SudoPolicy() {
 			return false
 		}
 	}
@@ -768,6 +1211,9 @@ func CNIPluginSame(n ClusterNetwork, o ClusterNetwork) bool {
 			if (n.CNIConfig.Kindnetd != nil && o.CNIConfig.Kindnetd == nil) || (n.CNIConfig.Kindnetd == nil && o.CNIConfig.Kindnetd != nil) {
 				return false
 			}
+			if (n.CNIConfig.Calico != nil && o.CNIConfig.Calico == nil) || (n.CNIConfig.Calico == nil && o.CNIConfig.Calico != nil) {
+				return false
+			}
 		}
 	}
 
@@ -835,7 +1281,8 @@ type ResolvConf struct {
 }
 
 type Nodes struct {
-	// CIDRMaskSize defines the mask size for node cidr in the cluster, default for ipv4 is 24. This is an optional field
+	// CIDRMaskSize defines the mask size for node cidr in the cluster, default for ipv4 is 24 and for ipv6 is 64.
+	// In dual-stack clusters, it only applies to the ipv4 node cidrs. This is an optional field
 	CIDRMaskSize *int `json:"cidrMaskSize,omitempty"`
 }
 
@@ -907,11 +1354,20 @@ type CiliumRoutingMode string
 type CNIConfig struct {
 	Cilium   *CiliumConfig   `json:"cilium,omitempty"`
 	Kindnetd *KindnetdConfig `json:"kindnetd,omitempty"`
+	// Calico installs Calico as the CNI of the cluster instead of Cilium.
+	// +optional
+	Calico *CalicoConfig `json:"calico,omitempty"`
 }
 
 // IsManaged indicates if EKS-A is responsible for the CNI installation.
 func (n *CNIConfig) IsManaged() bool {
-	return n != nil && (n.Kindnetd != nil || n.Cilium != nil && n.Cilium.IsManaged())
+	return n != nil && (n.Kindnetd != nil || n.Cilium != nil && n.Cilium.IsManaged() || n.Calico != nil && n.Calico.IsManaged())
+}
+
+// KubeProxyReplacementEnabled indicates if the CNI replaces kube-proxy, in which case kube-proxy
+// is not deployed in the cluster.
+func (n *CNIConfig) KubeProxyReplacementEnabled() bool {
+	return n != nil && n.Cilium.KubeProxyReplacementEnabled()
 }
 
 // CiliumConfig contains configuration specific to the Cilium CNI.
@@ -962,6 +1418,16 @@ type CiliumConfig struct {
 	// +optional
 	CNIExclusive *bool `json:"cniExclusive,omitempty"`
 
+	// KubeProxyReplacement runs Cilium in kube-proxy-less mode: kube-proxy is not deployed and
+	// Cilium implements the Kubernetes services, reaching the API server through the control plane
+	// endpoint. It can only be set when creating the cluster.
+	// +optional
+	KubeProxyReplacement *bool `json:"kubeProxyReplacement,omitempty"`
+
+	// Hubble enables the Hubble flow observability of Cilium.
+	// +optional
+	Hubble *CiliumHubbleConfig `json:"hubble,omitempty"`
+
 	// HelmValues specifies the complete Helm values configuration for Cilium in YAML format.
 	// When set, this parameter takes precedence over all other Cilium-specific fields in this configuration.
 	// All other Cilium properties (CNIExclusive, EgressMasqueradeInterfaces, IPv4NativeRoutingCIDR, etc.)
@@ -978,9 +1444,58 @@ func (n *CiliumConfig) IsManaged() bool {
 	return n.SkipUpgrade == nil || !*n.SkipUpgrade
 }
 
+// CiliumHubbleConfig contains the configuration of Hubble, the observability layer of Cilium.
+// The Hubble server runs in the Cilium agents as soon as it's set.
+type CiliumHubbleConfig struct {
+	// Relay deploys Hubble Relay to observe the flows of all the nodes of the cluster.
+	// +optional
+	Relay bool `json:"relay,omitempty"`
+
+	// UI deploys the Hubble UI. It requires Relay.
+	// +optional
+	UI bool `json:"ui,omitempty"`
+
+	// Metrics is the list of Hubble metrics exported by the Cilium agents, like dns, drop, tcp,
+	// flow, port-distribution, icmp or httpV2, with their options.
+	// +optional
+	Metrics []string `json:"metrics,omitempty"`
+}
+
+// Equal compares two CiliumHubbleConfigs.
+func (n *CiliumHubbleConfig) Equal(o *CiliumHubbleConfig) bool {
+	if n == o {
+		return true
+	}
+	if n == nil || o == nil {
+		return false
+	}
+
+	return n.Relay == o.Relay && n.UI == o.UI && SliceEqual(n.Metrics, o.Metrics)
+}
+
+// KubeProxyReplacementEnabled returns true if Cilium replaces kube-proxy.
+func (n *CiliumConfig) KubeProxyReplacementEnabled() bool {
+	return n != nil && n.KubeProxyReplacement != nil && *n.KubeProxyReplacement
+}
+
 // KindnetdConfig contains configuration specific to the Kindnetd CNI.
 type KindnetdConfig struct{}
 
+// CalicoConfig contains configuration specific to the Calico CNI.
+type CalicoConfig struct {
+	// SkipUpgrade indicates that Calico maintenance should be skipped during upgrades. EKS Anywhere
+	// still installs Calico when creating the cluster. This can be used when operators wish to
+	// self manage the Calico installation, like when moving to Calico Enterprise.
+	// +optional
+	SkipUpgrade *bool `json:"skipUpgrade,omitempty"`
+}
+
+// IsManaged returns true if SkipUpgrade is nil or false indicating EKS-A is responsible for
+// the Calico installation.
+func (n *CalicoConfig) IsManaged() bool {
+	return n.SkipUpgrade == nil || !*n.SkipUpgrade
+}
+
 const (
 	// Cilium is the EKS-A Cilium.
 	Cilium CNI = "cilium"
@@ -1051,6 +1566,9 @@ const (
 
 	// ExtendedK8sVersionSupportNotSupportedReason reports that validation for supporting extended kubernetes version failed.
 	ExtendedK8sVersionSupportNotSupportedReason FailureReasonType = "ExtendedKubernetesVersionSupportNotSupported"
+
+	// DefaultStorageClassInvalidReason reports that the CSI driver of the default storage class is not installed in the cluster.
+	DefaultStorageClassInvalidReason FailureReasonType = "DefaultStorageClassInvalid"
 )
 
 // Reasons for the terminal failures while reconciling the Cluster object specific for Tinkerbell.
@@ -1320,6 +1838,204 @@ func (n *ManagementCluster) Equal(o ManagementCluster) bool {
 	return n.Name == o.Name
 }
 
+// ExternalDNSConfiguration defines the external-dns installation managed by EKS Anywhere.
+type ExternalDNSConfiguration struct {
+	// Provider is the DNS provider external-dns manages the records with.
+	// +kubebuilder:validation:Enum=route53;infoblox
+	Provider DNSProvider `json:"provider"`
+	// DomainFilters limits the domains external-dns manages records for. When empty, it manages
+	// records for all the domains the provider credentials have access to.
+	// +optional
+	DomainFilters []string `json:"domainFilters,omitempty"`
+	// CredentialsSecretName is the name of a secret in the namespace of the cluster object with the
+	// provider credentials. Its keys are set as environment variables of the external-dns container.
+	CredentialsSecretName string `json:"credentialsSecretName"`
+}
+
+// Equal returns true if both external-dns configurations are the same.
+func (n *ExternalDNSConfiguration) Equal(o *ExternalDNSConfiguration) bool {
+	if n == o {
+		return true
+	}
+	if n == nil || o == nil {
+		return false
+	}
+	return n.Provider == o.Provider && SliceEqual(n.DomainFilters, o.DomainFilters) &&
+		n.CredentialsSecretName == o.CredentialsSecretName
+}
+
+// DefaultStorageClass defines the default StorageClass managed by EKS Anywhere.
+type DefaultStorageClass struct {
+	// Provisioner is the name of the CSI driver that provisions the volumes. The driver needs to be
+	// installed in the cluster.
+	Provisioner string `json:"provisioner"`
+	// Parameters are passed to the provisioner when creating volumes.
+	// +optional
+	Parameters map[string]string `json:"parameters,omitempty"`
+	// ReclaimPolicy is the reclaim policy of the volumes provisioned for this storage class.
+	// Defaults to Delete.
+	// +kubebuilder:validation:Enum=Delete;Retain
+	// +optional
+	ReclaimPolicy corev1.PersistentVolumeReclaimPolicy `json:"reclaimPolicy,omitempty"`
+}
+
+// Equal returns true if both default storage class configurations are the same.
+func (n *DefaultStorageClass) Equal(o *DefaultStorageClass) bool {
+	if n == o {
+		return true
+	}
+	if n == nil || o == nil {
+		return false
+	}
+	return n.Provisioner == o.Provisioner && reflect.DeepEqual(n.Parameters, o.Parameters) &&
+		n.ReclaimPolicy == o.ReclaimPolicy
+}
+
+// ServiceLoadBalancerConfiguration defines the load balancer for Services of type LoadBalancer
+// managed by EKS Anywhere.
+type ServiceLoadBalancerConfiguration struct {
+	// KubeVip runs kube-vip in services mode, which announces the Service addresses with ARP.
+	KubeVip *KubeVipServiceLoadBalancer `json:"kubeVip,omitempty"`
+}
+
+// Equal returns true if both service load balancer configurations are the same.
+func (n *ServiceLoadBalancerConfiguration) Equal(o *ServiceLoadBalancerConfiguration) bool {
+	if n == o {
+		return true
+	}
+	if n == nil || o == nil {
+		return false
+	}
+	return n.KubeVip.Equal(o.KubeVip)
+}
+
+// KubeVipServiceLoadBalancer defines the kube-vip services mode configuration.
+type KubeVipServiceLoadBalancer struct {
+	// AddressPool is the list of addresses assigned to the Services of type LoadBalancer. Each entry
+	// is either a CIDR, like 10.0.0.64/28, or a range of IPs, like 10.0.0.100-10.0.0.120.
+	AddressPool []string `json:"addressPool"`
+}
+
+// Equal returns true if both kube-vip services mode configurations are the same.
+func (n *KubeVipServiceLoadBalancer) Equal(o *KubeVipServiceLoadBalancer) bool {
+	if n == o {
+		return true
+	}
+	if n == nil || o == nil {
+		return false
+	}
+	return SliceEqual(n.AddressPool, o.AddressPool)
+}
+
+// CoreDNSConfiguration defines the CoreDNS configuration managed by EKS Anywhere.
+type CoreDNSConfiguration struct {
+	// StubDomains forwards the queries for a domain to a dedicated set of nameservers.
+	// +optional
+	StubDomains []DNSStubDomain `json:"stubDomains,omitempty"`
+	// UpstreamNameservers are the nameservers the queries outside the cluster domain are forwarded to.
+	// Defaults to the nameservers in the resolv.conf of the nodes.
+	// +optional
+	UpstreamNameservers []string `json:"upstreamNameservers,omitempty"`
+	// Autoscaling scales the CoreDNS replicas with the number of nodes and cores of the cluster.
+	// +optional
+	Autoscaling *DNSAutoscaling `json:"autoscaling,omitempty"`
+	// NodeLocalDNSCache deploys a DNS cache on every node, which answers the DNS queries of the pods
+	// of the node and only forwards the cache misses to CoreDNS.
+	// +optional
+	NodeLocalDNSCache *NodeLocalDNSCache `json:"nodeLocalDNSCache,omitempty"`
+}
+
+// Equal returns true if both CoreDNS configurations are the same.
+func (n *CoreDNSConfiguration) Equal(o *CoreDNSConfiguration) bool {
+	if n == o {
+		return true
+	}
+	if n == nil || o == nil {
+		return false
+	}
+	return reflect.DeepEqual(n.StubDomains, o.StubDomains) &&
+		SliceEqual(n.UpstreamNameservers, o.UpstreamNameservers) && n.Autoscaling.Equal(o.Autoscaling) &&
+		n.NodeLocalDNSCache.Equal(o.NodeLocalDNSCache)
+}
+
+// DNSStubDomain defines the nameservers for a DNS domain.
+type DNSStubDomain struct {
+	// Domain is the DNS domain the queries are forwarded for, like corp.example.com.
+	Domain string `json:"domain"`
+	// Nameservers are the IPs, with an optional port, of the nameservers for the domain.
+	Nameservers []string `json:"nameservers"`
+}
+
+// DNSAutoscaling defines the linear scaling parameters of the CoreDNS replicas. The number of
+// replicas is the largest of nodes/nodesPerReplica and cores/coresPerReplica, rounded up and
+// bounded by minReplicas and maxReplicas.
+type DNSAutoscaling struct {
+	// MinReplicas is the minimum number of CoreDNS replicas. Defaults to 2.
+	// +optional
+	MinReplicas int `json:"minReplicas,omitempty"`
+	// MaxReplicas is the maximum number of CoreDNS replicas. No limit if not set.
+	// +optional
+	MaxReplicas int `json:"maxReplicas,omitempty"`
+	// NodesPerReplica is the number of nodes served by each CoreDNS replica. Defaults to 16.
+	// +optional
+	NodesPerReplica int `json:"nodesPerReplica,omitempty"`
+	// CoresPerReplica is the number of node cores served by each CoreDNS replica. Defaults to 256.
+	// +optional
+	CoresPerReplica int `json:"coresPerReplica,omitempty"`
+	// Autoscaler defines what scales the CoreDNS replicas. Controller, the default, lets the EKS
+	// Anywhere controller scale them when it reconciles the cluster. ClusterProportionalAutoscaler
+	// deploys the cluster-proportional-autoscaler in the cluster, which follows the changes of the
+	// nodes as soon as they happen.
+	// +kubebuilder:validation:Enum=Controller;ClusterProportionalAutoscaler
+	// +optional
+	Autoscaler DNSAutoscaler `json:"autoscaler,omitempty"`
+}
+
+// DNSAutoscaler defines what scales the CoreDNS replicas.
+type DNSAutoscaler string
+
+const (
+	// ControllerDNSAutoscaler lets the EKS Anywhere controller scale the CoreDNS replicas.
+	ControllerDNSAutoscaler DNSAutoscaler = "Controller"
+	// ClusterProportionalDNSAutoscaler lets the cluster-proportional-autoscaler scale the CoreDNS replicas.
+	ClusterProportionalDNSAutoscaler DNSAutoscaler = "ClusterProportionalAutoscaler"
+)
+
+// ClusterProportional returns whether the CoreDNS replicas are scaled by the cluster-proportional-autoscaler.
+func (n *DNSAutoscaling) ClusterProportional() bool {
+	return n != nil && n.Autoscaler == ClusterProportionalDNSAutoscaler
+}
+
+// Equal returns true if both DNS autoscaling configurations are the same.
+func (n *DNSAutoscaling) Equal(o *DNSAutoscaling) bool {
+	if n == o {
+		return true
+	}
+	if n == nil || o == nil {
+		return false
+	}
+	return *n == *o
+}
+
+// NodeLocalDNSCache defines the NodeLocal DNSCache deployed on every node of the cluster.
+type NodeLocalDNSCache struct {
+	// LocalIP is the link-local IP the cache listens on, next to the IP of the kube-dns Service.
+	// Defaults to 169.254.20.10.
+	// +optional
+	LocalIP string `json:"localIP,omitempty"`
+}
+
+// Equal returns true if both NodeLocal DNSCache configurations are the same.
+func (n *NodeLocalDNSCache) Equal(o *NodeLocalDNSCache) bool {
+	if n == o {
+		return true
+	}
+	if n == nil || o == nil {
+		return false
+	}
+	return *n == *o
+}
+
 type PodIAMConfig struct {
 	ServiceAccountIssuer string `json:"serviceAccountIssuer"`
 }
@@ -1343,6 +2059,30 @@ type AutoScalingConfiguration struct {
 	// MaxCount defines the maximum number of nodes for the associated resource group.
 	// +optional
 	MaxCount int `json:"maxCount,omitempty"`
+
+	// Provisioner defines what scales the nodes of the group between MinCount and MaxCount.
+	// ClusterAutoscaler, the default, lets the Kubernetes Cluster Autoscaler scale the group.
+	// OnDemand is an experimental provisioner, only supported for vSphere, that creates machines from
+	// the machine config of the group when pods can't be scheduled and removes the empty ones.
+	// +kubebuilder:validation:Enum=ClusterAutoscaler;OnDemand
+	// +optional
+	Provisioner AutoScalingProvisioner `json:"provisioner,omitempty"`
+}
+
+// AutoScalingProvisioner defines what scales the nodes of an autoscaled worker node group.
+type AutoScalingProvisioner string
+
+const (
+	// ClusterAutoscalerProvisioner lets the Kubernetes Cluster Autoscaler scale the worker node group.
+	ClusterAutoscalerProvisioner AutoScalingProvisioner = "ClusterAutoscaler"
+	// OnDemandProvisioner lets the EKS Anywhere controller create machines for the unschedulable pods
+	// of the cluster and remove the empty ones.
+	OnDemandProvisioner AutoScalingProvisioner = "OnDemand"
+)
+
+// OnDemand returns whether the worker node group is scaled by the on-demand provisioner.
+func (a *AutoScalingConfiguration) OnDemand() bool {
+	return a != nil && a.Provisioner == OnDemandProvisioner
 }
 
 // Equal compares two AutoScalingConfigurations.
@@ -1355,7 +2095,7 @@ func (a *AutoScalingConfiguration) Equal(other *AutoScalingConfiguration) bool {
 		return false
 	}
 
-	return a.MaxCount == other.MaxCount && a.MinCount == other.MinCount
+	return a.MaxCount == other.MaxCount && a.MinCount == other.MinCount && a.OnDemand() == other.OnDemand()
 }
 
 // UpgradeRolloutStrategyType defines the types of upgrade rollout strategies.
@@ -1707,3 +2447,90 @@ type ClusterList struct {
 func init() {
 	SchemeBuilder.Register(&Cluster{}, &ClusterList{})
 }
+
+// NodeOSConfiguration defines the kernel configuration of the cluster nodes.
+type NodeOSConfiguration struct {
+	// Sysctls are the kernel parameters set on the nodes, like net.core.somaxconn.
+	// The kernel sysctlSettings of the Bottlerocket machine configs take precedence.
+	// +optional
+	Sysctls map[string]string `json:"sysctls,omitempty"`
+	// KernelModules are the kernel modules loaded on the nodes, like br_netfilter.
+	// Only supported for Ubuntu and Red Hat nodes.
+	// +optional
+	KernelModules []string `json:"kernelModules,omitempty"`
+}
+
+// Equal returns true if both node OS configurations are the same.
+func (n *NodeOSConfiguration) Equal(o *NodeOSConfiguration) bool {
+	if n == o {
+		return true
+	}
+	if n == nil || o == nil {
+		return false
+	}
+	return MapEqual(n.Sysctls, o.Sysctls) && SliceEqual(n.KernelModules, o.KernelModules)
+}
+
+// SpireConfiguration defines the SPIRE deployment managed by EKS Anywhere.
+type SpireConfiguration struct {
+	// TrustDomain is the SPIFFE trust domain of the identities issued by SPIRE, like example.org.
+	TrustDomain string `json:"trustDomain"`
+	// NodeAttestor is the plugin the SPIRE agents attest the identity of their node with.
+	// Defaults to k8s_psat, which is supported by both providers. x509pop is supported for vSphere and
+	// Tinkerbell, and tpm_devid only for Tinkerbell, since it requires a TPM on the machines.
+	// +kubebuilder:validation:Enum=k8s_psat;x509pop;tpm_devid
+	// +optional
+	NodeAttestor SpireNodeAttestor `json:"nodeAttestor,omitempty"`
+	// CABundleSecretName is the name of a secret in the namespace of the cluster object with the CA
+	// certificates the node certificates are verified against, in its ca.crt key. tpm_devid also
+	// requires the CA certificates of the TPM endorsement keys in its endorsement-ca.crt key.
+	// Required for the x509pop and tpm_devid node attestors.
+	// +optional
+	CABundleSecretName string `json:"caBundleSecretName,omitempty"`
+}
+
+// SpireNodeAttestor is a SPIRE node attestation plugin.
+type SpireNodeAttestor string
+
+const (
+	// K8sPsatSpireNodeAttestor attests nodes with projected service account tokens, validated by the
+	// SPIRE server against the Kubernetes API server.
+	K8sPsatSpireNodeAttestor SpireNodeAttestor = "k8s_psat"
+	// X509PopSpireNodeAttestor attests nodes with the proof of possession of an X.509 certificate
+	// provisioned on the machines.
+	X509PopSpireNodeAttestor SpireNodeAttestor = "x509pop"
+	// TPMDevIDSpireNodeAttestor attests bare metal nodes with the DevID certificate of their TPM.
+	TPMDevIDSpireNodeAttestor SpireNodeAttestor = "tpm_devid"
+)
+
+// SupportedSpireNodeAttestors returns the SPIRE node attestors supported for the machines of a
+// provider, identified by the kind of its datacenter config.
+func SupportedSpireNodeAttestors(datacenterKind string) []SpireNodeAttestor {
+	switch datacenterKind {
+	case VSphereDatacenterKind:
+		return []SpireNodeAttestor{K8sPsatSpireNodeAttestor, X509PopSpireNodeAttestor}
+	case TinkerbellDatacenterKind:
+		return []SpireNodeAttestor{K8sPsatSpireNodeAttestor, X509PopSpireNodeAttestor, TPMDevIDSpireNodeAttestor}
+	}
+
+	return nil
+}
+
+// Attestor returns the node attestor of the configuration, or the default one when not set.
+func (n *SpireConfiguration) Attestor() SpireNodeAttestor {
+	if n.NodeAttestor == "" {
+		return K8sPsatSpireNodeAttestor
+	}
+	return n.NodeAttestor
+}
+
+// Equal returns true if both SPIRE configurations are the same.
+func (n *SpireConfiguration) Equal(o *SpireConfiguration) bool {
+	if n == o {
+		return true
+	}
+	if n == nil || o == nil {
+		return false
+	}
+	return *n == *o
+}
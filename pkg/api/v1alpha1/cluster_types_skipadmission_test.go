@@ -126,3 +126,23 @@ func TestControlPlaneConfigurationEqualSkipAdmissionWithOtherFields(t *testing.T
 
 	g.Expect(config1.Equal(config2)).To(BeTrue())
 }
+
+func TestControlPlaneConfigurationEqualFailureDomains(t *testing.T) {
+	g := NewWithT(t)
+
+	config1 := &ControlPlaneConfiguration{
+		Count:          3,
+		FailureDomains: []string{"fd-1", "fd-2"},
+	}
+	config2 := &ControlPlaneConfiguration{
+		Count:          3,
+		FailureDomains: []string{"fd-1", "fd-2"},
+	}
+	config3 := &ControlPlaneConfiguration{
+		Count:          3,
+		FailureDomains: []string{"fd-1"},
+	}
+
+	g.Expect(config1.Equal(config2)).To(BeTrue())
+	g.Expect(config1.Equal(config3)).To(BeFalse())
+}
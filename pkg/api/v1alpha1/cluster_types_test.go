@@ -416,6 +416,39 @@ func TestWorkerNodeGroupConfigurationEqual(t *testing.T) {
 			},
 			want: true,
 		},
+		{
+			testName: "both exist, failure domains diff",
+			cluster1Wngs: []v1alpha1.WorkerNodeGroupConfiguration{
+				{
+					Count:          ptr.Int(1),
+					FailureDomains: []string{"az-1"},
+				},
+			},
+			cluster2Wngs: []v1alpha1.WorkerNodeGroupConfiguration{
+				{
+					Count:          ptr.Int(1),
+					FailureDomains: []string{"az-1", "az-2"},
+				},
+			},
+			want: false,
+		},
+		{
+			testName: "both exist, failure domain spread policy diff",
+			cluster1Wngs: []v1alpha1.WorkerNodeGroupConfiguration{
+				{
+					Count:                     ptr.Int(1),
+					FailureDomains:            []string{"az-1", "az-2"},
+					FailureDomainSpreadPolicy: v1alpha1.FailureDomainSpreadPolicyEven,
+				},
+			},
+			cluster2Wngs: []v1alpha1.WorkerNodeGroupConfiguration{
+				{
+					Count:          ptr.Int(1),
+					FailureDomains: []string{"az-1", "az-2"},
+				},
+			},
+			want: false,
+		},
 		{
 			testName: "both exist, count diff",
 			cluster1Wngs: []v1alpha1.WorkerNodeGroupConfiguration{
@@ -1748,6 +1781,24 @@ func TestControlPlaneConfigurationEqual(t *testing.T) {
 			},
 			want: true,
 		},
+		{
+			testName: "different controller manager extra args",
+			cluster1CPConfig: &v1alpha1.ControlPlaneConfiguration{
+				ControllerManagerExtraArgs: map[string]string{"profiling": "true"},
+			},
+			cluster2CPConfig: &v1alpha1.ControlPlaneConfiguration{
+				ControllerManagerExtraArgs: map[string]string{"profiling": "false"},
+			},
+			want: false,
+		},
+		{
+			testName: "different scheduler extra args",
+			cluster1CPConfig: &v1alpha1.ControlPlaneConfiguration{
+				SchedulerExtraArgs: map[string]string{"bind-address": "0.0.0.0"},
+			},
+			cluster2CPConfig: &v1alpha1.ControlPlaneConfiguration{},
+			want:             false,
+		},
 	}
 	for _, tt := range testCases {
 		t.Run(tt.testName, func(t *testing.T) {
@@ -2976,6 +3027,54 @@ func TestCiliumConfigEquality(t *testing.T) {
 			},
 			Equal: false,
 		},
+		{
+			Name: "Hubble same metrics in different order",
+			A: &v1alpha1.CiliumConfig{
+				Hubble: &v1alpha1.CiliumHubbleConfig{Relay: true, Metrics: []string{"dns", "drop"}},
+			},
+			B: &v1alpha1.CiliumConfig{
+				Hubble: &v1alpha1.CiliumHubbleConfig{Relay: true, Metrics: []string{"drop", "dns"}},
+			},
+			Equal: true,
+		},
+		{
+			Name: "Hubble nil vs enabled",
+			A:    &v1alpha1.CiliumConfig{},
+			B: &v1alpha1.CiliumConfig{
+				Hubble: &v1alpha1.CiliumHubbleConfig{},
+			},
+			Equal: false,
+		},
+		{
+			Name: "Hubble different ui",
+			A: &v1alpha1.CiliumConfig{
+				Hubble: &v1alpha1.CiliumHubbleConfig{Relay: true},
+			},
+			B: &v1alpha1.CiliumConfig{
+				Hubble: &v1alpha1.CiliumHubbleConfig{Relay: true, UI: true},
+			},
+			Equal: false,
+		},
+		{
+			Name: "KubeProxyReplacement nil vs false",
+			A: &v1alpha1.CiliumConfig{
+				KubeProxyReplacement: nil,
+			},
+			B: &v1alpha1.CiliumConfig{
+				KubeProxyReplacement: ptr.Bool(false),
+			},
+			Equal: true,
+		},
+		{
+			Name: "KubeProxyReplacement nil vs true",
+			A: &v1alpha1.CiliumConfig{
+				KubeProxyReplacement: nil,
+			},
+			B: &v1alpha1.CiliumConfig{
+				KubeProxyReplacement: ptr.Bool(true),
+			},
+			Equal: false,
+		},
 		{
 			Name: "CNIExclusive false vs true",
 			A: &v1alpha1.CiliumConfig{
@@ -3691,6 +3790,22 @@ func TestCNIConfigIsManaged(t *testing.T) {
 			},
 			want: false,
 		},
+		{
+			name: "managed Calico",
+			cniConfig: &v1alpha1.CNIConfig{
+				Calico: &v1alpha1.CalicoConfig{},
+			},
+			want: true,
+		},
+		{
+			name: "not managed Calico",
+			cniConfig: &v1alpha1.CNIConfig{
+				Calico: &v1alpha1.CalicoConfig{
+					SkipUpgrade: ptr.Bool(true),
+				},
+			},
+			want: false,
+		},
 	}
 
 	for _, tt := range testCases {
@@ -3701,6 +3816,112 @@ func TestCNIConfigIsManaged(t *testing.T) {
 	}
 }
 
+func TestCNIConfigKubeProxyReplacementEnabled(t *testing.T) {
+	testCases := []struct {
+		name      string
+		cniConfig *v1alpha1.CNIConfig
+		want      bool
+	}{
+		{
+			name: "nil receiver",
+			want: false,
+		},
+		{
+			name: "calico",
+			cniConfig: &v1alpha1.CNIConfig{
+				Calico: &v1alpha1.CalicoConfig{},
+			},
+			want: false,
+		},
+		{
+			name: "cilium default",
+			cniConfig: &v1alpha1.CNIConfig{
+				Cilium: &v1alpha1.CiliumConfig{},
+			},
+			want: false,
+		},
+		{
+			name: "cilium kube-proxy replacement",
+			cniConfig: &v1alpha1.CNIConfig{
+				Cilium: &v1alpha1.CiliumConfig{
+					KubeProxyReplacement: ptr.Bool(true),
+				},
+			},
+			want: true,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(tt.cniConfig.KubeProxyReplacementEnabled()).To(Equal(tt.want))
+		})
+	}
+}
+
+func TestClusterNetworkIPFamily(t *testing.T) {
+	testCases := []struct {
+		name        string
+		network     *v1alpha1.ClusterNetwork
+		want        v1alpha1.IPFamily
+		wantPrimary v1alpha1.IPFamily
+	}{
+		{
+			name:        "nil receiver",
+			want:        v1alpha1.IPv4IPFamily,
+			wantPrimary: v1alpha1.IPv4IPFamily,
+		},
+		{
+			name:        "no pod CIDR blocks",
+			network:     &v1alpha1.ClusterNetwork{},
+			want:        v1alpha1.IPv4IPFamily,
+			wantPrimary: v1alpha1.IPv4IPFamily,
+		},
+		{
+			name: "ipv4",
+			network: &v1alpha1.ClusterNetwork{
+				Pods: v1alpha1.Pods{CidrBlocks: []string{"192.168.0.0/16"}},
+			},
+			want:        v1alpha1.IPv4IPFamily,
+			wantPrimary: v1alpha1.IPv4IPFamily,
+		},
+		{
+			name: "ipv6",
+			network: &v1alpha1.ClusterNetwork{
+				Pods: v1alpha1.Pods{CidrBlocks: []string{"fd00:100::/48"}},
+			},
+			want:        v1alpha1.IPv6IPFamily,
+			wantPrimary: v1alpha1.IPv6IPFamily,
+		},
+		{
+			name: "dual-stack ipv4 primary",
+			network: &v1alpha1.ClusterNetwork{
+				Pods: v1alpha1.Pods{CidrBlocks: []string{"192.168.0.0/16", "fd00:100::/48"}},
+			},
+			want:        v1alpha1.DualStackIPFamily,
+			wantPrimary: v1alpha1.IPv4IPFamily,
+		},
+		{
+			name: "dual-stack ipv6 primary",
+			network: &v1alpha1.ClusterNetwork{
+				Pods: v1alpha1.Pods{CidrBlocks: []string{"fd00:100::/48", "192.168.0.0/16"}},
+			},
+			want:        v1alpha1.DualStackIPFamily,
+			wantPrimary: v1alpha1.IPv6IPFamily,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(tt.network.IPFamily()).To(Equal(tt.want))
+			g.Expect(tt.network.PrimaryIPFamily()).To(Equal(tt.wantPrimary))
+			g.Expect(tt.network.IsIPv6()).To(Equal(tt.want == v1alpha1.IPv6IPFamily))
+			g.Expect(tt.network.IsDualStack()).To(Equal(tt.want == v1alpha1.DualStackIPFamily))
+		})
+	}
+}
+
 func TestValidateCluster(t *testing.T) {
 	for _, tc := range []struct {
 		Name           string
@@ -3831,3 +4052,388 @@ func TestCluster_ManagementComponentsVersion(t *testing.T) {
 		})
 	}
 }
+
+func TestAuditLogConfigurationEqual(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b *v1alpha1.AuditLogConfiguration
+		want bool
+	}{
+		{
+			name: "both nil",
+			want: true,
+		},
+		{
+			name: "one nil",
+			a:    &v1alpha1.AuditLogConfiguration{},
+			want: false,
+		},
+		{
+			name: "same settings",
+			a:    &v1alpha1.AuditLogConfiguration{MaxAge: ptr.Int(7), MaxSize: ptr.Int(100)},
+			b:    &v1alpha1.AuditLogConfiguration{MaxAge: ptr.Int(7), MaxSize: ptr.Int(100)},
+			want: true,
+		},
+		{
+			name: "different max backup",
+			a:    &v1alpha1.AuditLogConfiguration{MaxBackup: ptr.Int(5)},
+			b:    &v1alpha1.AuditLogConfiguration{},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(tt.a.Equal(tt.b)).To(Equal(tt.want))
+		})
+	}
+}
+
+func TestPodSecurityAdmissionConfigurationEqual(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b *v1alpha1.PodSecurityAdmissionConfiguration
+		want bool
+	}{
+		{
+			name: "both nil",
+			want: true,
+		},
+		{
+			name: "one nil",
+			a:    &v1alpha1.PodSecurityAdmissionConfiguration{},
+			want: false,
+		},
+		{
+			name: "same settings",
+			a: &v1alpha1.PodSecurityAdmissionConfiguration{
+				Enforce:    v1alpha1.PodSecurityLevelBaseline,
+				Exemptions: &v1alpha1.PodSecurityAdmissionExemptions{Namespaces: []string{"a", "b"}},
+			},
+			b: &v1alpha1.PodSecurityAdmissionConfiguration{
+				Enforce:    v1alpha1.PodSecurityLevelBaseline,
+				Exemptions: &v1alpha1.PodSecurityAdmissionExemptions{Namespaces: []string{"b", "a"}},
+			},
+			want: true,
+		},
+		{
+			name: "different warn level",
+			a:    &v1alpha1.PodSecurityAdmissionConfiguration{Warn: v1alpha1.PodSecurityLevelRestricted},
+			b:    &v1alpha1.PodSecurityAdmissionConfiguration{Warn: v1alpha1.PodSecurityLevelBaseline},
+			want: false,
+		},
+		{
+			name: "different exempted users",
+			a: &v1alpha1.PodSecurityAdmissionConfiguration{
+				Exemptions: &v1alpha1.PodSecurityAdmissionExemptions{Usernames: []string{"admin"}},
+			},
+			b:    &v1alpha1.PodSecurityAdmissionConfiguration{},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(tt.a.Equal(tt.b)).To(Equal(tt.want))
+		})
+	}
+}
+
+func TestKonnectivityConfigurationEqual(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b *v1alpha1.KonnectivityConfiguration
+		want bool
+	}{
+		{
+			name: "both nil",
+			want: true,
+		},
+		{
+			name: "one nil",
+			b:    &v1alpha1.KonnectivityConfiguration{},
+			want: false,
+		},
+		{
+			name: "same settings",
+			a:    &v1alpha1.KonnectivityConfiguration{ServerHost: "10.0.0.10", AgentPort: 8132},
+			b:    &v1alpha1.KonnectivityConfiguration{ServerHost: "10.0.0.10", AgentPort: 8132},
+			want: true,
+		},
+		{
+			name: "different agent port",
+			a:    &v1alpha1.KonnectivityConfiguration{AgentPort: 8132},
+			b:    &v1alpha1.KonnectivityConfiguration{AgentPort: 9132},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(tt.a.Equal(tt.b)).To(Equal(tt.want))
+		})
+	}
+}
+
+func TestCoreDNSConfiguration_Equal(t *testing.T) {
+	tests := []struct {
+		name   string
+		cn, co *v1alpha1.CoreDNSConfiguration
+		want   bool
+	}{
+		{
+			name: "both nil",
+			want: true,
+		},
+		{
+			name: "one nil",
+			cn:   &v1alpha1.CoreDNSConfiguration{},
+			want: false,
+		},
+		{
+			name: "equal",
+			cn: &v1alpha1.CoreDNSConfiguration{
+				StubDomains:         []v1alpha1.DNSStubDomain{{Domain: "corp.example.com", Nameservers: []string{"10.0.0.53"}}},
+				UpstreamNameservers: []string{"1.1.1.1"},
+				Autoscaling:         &v1alpha1.DNSAutoscaling{MinReplicas: 3},
+			},
+			co: &v1alpha1.CoreDNSConfiguration{
+				StubDomains:         []v1alpha1.DNSStubDomain{{Domain: "corp.example.com", Nameservers: []string{"10.0.0.53"}}},
+				UpstreamNameservers: []string{"1.1.1.1"},
+				Autoscaling:         &v1alpha1.DNSAutoscaling{MinReplicas: 3},
+			},
+			want: true,
+		},
+		{
+			name: "different stub domain nameservers",
+			cn: &v1alpha1.CoreDNSConfiguration{
+				StubDomains: []v1alpha1.DNSStubDomain{{Domain: "corp.example.com", Nameservers: []string{"10.0.0.53"}}},
+			},
+			co: &v1alpha1.CoreDNSConfiguration{
+				StubDomains: []v1alpha1.DNSStubDomain{{Domain: "corp.example.com", Nameservers: []string{"10.0.1.53"}}},
+			},
+			want: false,
+		},
+		{
+			name: "different upstream nameservers",
+			cn:   &v1alpha1.CoreDNSConfiguration{UpstreamNameservers: []string{"1.1.1.1"}},
+			co:   &v1alpha1.CoreDNSConfiguration{UpstreamNameservers: []string{"8.8.8.8"}},
+			want: false,
+		},
+		{
+			name: "different autoscaling",
+			cn:   &v1alpha1.CoreDNSConfiguration{Autoscaling: &v1alpha1.DNSAutoscaling{MaxReplicas: 5}},
+			co:   &v1alpha1.CoreDNSConfiguration{},
+			want: false,
+		},
+		{
+			name: "different autoscaler",
+			cn:   &v1alpha1.CoreDNSConfiguration{Autoscaling: &v1alpha1.DNSAutoscaling{Autoscaler: v1alpha1.ClusterProportionalDNSAutoscaler}},
+			co:   &v1alpha1.CoreDNSConfiguration{Autoscaling: &v1alpha1.DNSAutoscaling{}},
+			want: false,
+		},
+		{
+			name: "different node local dns cache",
+			cn:   &v1alpha1.CoreDNSConfiguration{NodeLocalDNSCache: &v1alpha1.NodeLocalDNSCache{LocalIP: "169.254.25.10"}},
+			co:   &v1alpha1.CoreDNSConfiguration{NodeLocalDNSCache: &v1alpha1.NodeLocalDNSCache{}},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(tt.cn.Equal(tt.co)).To(Equal(tt.want))
+		})
+	}
+}
+
+func TestNodeOSConfiguration_Equal(t *testing.T) {
+	tests := []struct {
+		name   string
+		cn, co *v1alpha1.NodeOSConfiguration
+		want   bool
+	}{
+		{
+			name: "both nil",
+			want: true,
+		},
+		{
+			name: "one nil",
+			cn:   &v1alpha1.NodeOSConfiguration{},
+			want: false,
+		},
+		{
+			name: "equal",
+			cn: &v1alpha1.NodeOSConfiguration{
+				Sysctls:       map[string]string{"vm.max_map_count": "262144"},
+				KernelModules: []string{"br_netfilter"},
+			},
+			co: &v1alpha1.NodeOSConfiguration{
+				Sysctls:       map[string]string{"vm.max_map_count": "262144"},
+				KernelModules: []string{"br_netfilter"},
+			},
+			want: true,
+		},
+		{
+			name: "different sysctls",
+			cn:   &v1alpha1.NodeOSConfiguration{Sysctls: map[string]string{"vm.max_map_count": "262144"}},
+			co:   &v1alpha1.NodeOSConfiguration{Sysctls: map[string]string{"vm.max_map_count": "65530"}},
+			want: false,
+		},
+		{
+			name: "different kernel modules",
+			cn:   &v1alpha1.NodeOSConfiguration{KernelModules: []string{"br_netfilter"}},
+			co:   &v1alpha1.NodeOSConfiguration{KernelModules: []string{"overlay"}},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(tt.cn.Equal(tt.co)).To(Equal(tt.want))
+		})
+	}
+}
+
+func TestMachineNamingConfigurationCAPITemplate(t *testing.T) {
+	tests := []struct {
+		name      string
+		template  string
+		nodeGroup string
+		zone      string
+		want      string
+		wantErr   string
+	}{
+		{
+			name:      "all variables",
+			template:  "corp-{{ .cluster.name }}-{{ .nodeGroup.name }}-{{ .zone }}-{{ .random }}",
+			nodeGroup: "md-0",
+			zone:      "az1",
+			want:      "corp-{{ .cluster.name }}-md-0-az1-{{ .random }}",
+		},
+		{
+			name:      "compact variables",
+			template:  "{{.nodeGroup.name}}{{.random}}",
+			nodeGroup: "md-0",
+			want:      "md-0{{ .random }}",
+		},
+		{
+			name:      "zone without failure domain",
+			template:  "{{ .zone }}-{{ .random }}",
+			nodeGroup: "md-0",
+			wantErr:   `map has no entry for key "zone"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			naming := &v1alpha1.MachineNamingConfiguration{Template: tt.template}
+			got, err := naming.CAPITemplate(tt.nodeGroup, tt.zone)
+			if tt.wantErr != "" {
+				g.Expect(err).To(MatchError(ContainSubstring(tt.wantErr)))
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(got).To(Equal(tt.want))
+		})
+	}
+}
+
+func TestMachineNamingConfigurationEqual(t *testing.T) {
+	g := NewWithT(t)
+	naming := &v1alpha1.MachineNamingConfiguration{Template: "corp-{{ .random }}"}
+
+	g.Expect(naming.Equal(&v1alpha1.MachineNamingConfiguration{Template: "corp-{{ .random }}"})).To(BeTrue())
+	g.Expect(naming.Equal(&v1alpha1.MachineNamingConfiguration{Template: "{{ .random }}"})).To(BeFalse())
+	g.Expect(naming.Equal(nil)).To(BeFalse())
+	g.Expect((*v1alpha1.MachineNamingConfiguration)(nil).Equal(nil)).To(BeTrue())
+}
+
+func TestSpireConfigurationEqual(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b *v1alpha1.SpireConfiguration
+		want bool
+	}{
+		{
+			name: "both nil",
+			want: true,
+		},
+		{
+			name: "one nil",
+			b:    &v1alpha1.SpireConfiguration{TrustDomain: "example.org"},
+			want: false,
+		},
+		{
+			name: "same settings",
+			a:    &v1alpha1.SpireConfiguration{TrustDomain: "example.org", NodeAttestor: v1alpha1.X509PopSpireNodeAttestor, CABundleSecretName: "node-ca"},
+			b:    &v1alpha1.SpireConfiguration{TrustDomain: "example.org", NodeAttestor: v1alpha1.X509PopSpireNodeAttestor, CABundleSecretName: "node-ca"},
+			want: true,
+		},
+		{
+			name: "different node attestor",
+			a:    &v1alpha1.SpireConfiguration{TrustDomain: "example.org"},
+			b:    &v1alpha1.SpireConfiguration{TrustDomain: "example.org", NodeAttestor: v1alpha1.X509PopSpireNodeAttestor},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(tt.a.Equal(tt.b)).To(Equal(tt.want))
+		})
+	}
+}
+
+func TestSpireConfigurationAttestor(t *testing.T) {
+	g := NewWithT(t)
+	g.Expect((&v1alpha1.SpireConfiguration{}).Attestor()).To(Equal(v1alpha1.K8sPsatSpireNodeAttestor))
+	g.Expect((&v1alpha1.SpireConfiguration{NodeAttestor: v1alpha1.TPMDevIDSpireNodeAttestor}).Attestor()).To(Equal(v1alpha1.TPMDevIDSpireNodeAttestor))
+}
+
+func TestCalicoConfigEqual(t *testing.T) {
+	testCases := []struct {
+		name     string
+		n, o     *v1alpha1.CalicoConfig
+		expected bool
+	}{
+		{
+			name:     "both nil",
+			expected: true,
+		},
+		{
+			name:     "one nil",
+			n:        &v1alpha1.CalicoConfig{},
+			expected: false,
+		},
+		{
+			name:     "nil and false skip upgrade",
+			n:        &v1alpha1.CalicoConfig{},
+			o:        &v1alpha1.CalicoConfig{SkipUpgrade: ptr.Bool(false)},
+			expected: true,
+		},
+		{
+			name:     "different skip upgrade",
+			n:        &v1alpha1.CalicoConfig{},
+			o:        &v1alpha1.CalicoConfig{SkipUpgrade: ptr.Bool(true)},
+			expected: false,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(tt.n.Equal(tt.o)).To(Equal(tt.expected))
+		})
+	}
+}
+
+func TestCNIPluginSameCalico(t *testing.T) {
+	g := NewWithT(t)
+	cilium := v1alpha1.ClusterNetwork{CNIConfig: &v1alpha1.CNIConfig{Cilium: &v1alpha1.CiliumConfig{}}}
+	calico := v1alpha1.ClusterNetwork{CNIConfig: &v1alpha1.CNIConfig{Calico: &v1alpha1.CalicoConfig{}}}
+
+	g.Expect(v1alpha1.CNIPluginSame(calico, calico)).To(BeTrue())
+	g.Expect(v1alpha1.CNIPluginSame(calico, cilium)).To(BeFalse())
+	g.Expect(v1alpha1.CNIPluginSame(cilium, calico)).To(BeFalse())
+}
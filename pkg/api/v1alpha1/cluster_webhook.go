@@ -16,6 +16,7 @@ package v1alpha1
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"reflect"
 
@@ -28,6 +29,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
+	"github.com/aws/eks-anywhere/pkg/features"
 	"github.com/aws/eks-anywhere/pkg/semver"
 )
 
@@ -49,7 +51,8 @@ func (r *Cluster) SetupWebhookWithManager(mgr ctrl.Manager) error {
 var _ webhook.CustomDefaulter = &Cluster{}
 
 // Default implements webhook.CustomDefaulter so a webhook will be registered for the type.
-func (r *Cluster) Default(_ context.Context, obj runtime.Object) error {
+// It also records the user that freezes the cluster.
+func (r *Cluster) Default(ctx context.Context, obj runtime.Object) error {
 	cluster, ok := obj.(*Cluster)
 	if !ok {
 		return fmt.Errorf("expected a Cluster but got %T", obj)
@@ -58,6 +61,21 @@ func (r *Cluster) Default(_ context.Context, obj runtime.Object) error {
 	clusterlog.Info("Setting up Cluster defaults", "name", cluster.Name, "namespace", cluster.Namespace)
 	cluster.SetDefaults()
 
+	// The frozen-by record is only ever derived from the admission request, so clients can't
+	// attribute a freeze to someone else.
+	var user string
+	var old *Cluster
+	if req, err := admission.RequestFromContext(ctx); err == nil {
+		user = req.UserInfo.Username
+		if len(req.OldObject.Raw) > 0 {
+			old = &Cluster{}
+			if err := json.Unmarshal(req.OldObject.Raw, old); err != nil {
+				return fmt.Errorf("decoding old Cluster: %v", err)
+			}
+		}
+	}
+	cluster.setFrozenBy(old, user)
+
 	return nil
 }
 
@@ -131,6 +149,10 @@ func (r *Cluster) ValidateUpdate(_ context.Context, old, obj runtime.Object) (ad
 
 	allErrs = append(allErrs, validateEtcdEncryptionSupport(newCluster)...)
 
+	if features.IsActive(features.RolloutGuardrailsEnabled()) {
+		allErrs = append(allErrs, validateRolloutAcknowledged(newCluster, oldCluster)...)
+	}
+
 	if len(allErrs) != 0 {
 		return nil, apierrors.NewInvalid(GroupVersion.WithKind(ClusterKind).GroupKind(), newCluster.Name, allErrs)
 	}
@@ -312,6 +334,12 @@ func validateImmutableFieldsCluster(new, old *Cluster) field.ErrorList {
 			field.Forbidden(specPath.Child("ControlPlaneConfiguration.endpoint"), fmt.Sprintf("field is immutable %v", new.Spec.ControlPlaneConfiguration.Endpoint)))
 	}
 
+	if removed := RemovedCertSANs(old.Spec.ControlPlaneConfiguration.CertSANs, new.Spec.ControlPlaneConfiguration.CertSANs); len(removed) > 0 {
+		allErrs = append(
+			allErrs,
+			field.Forbidden(specPath.Child("ControlPlaneConfiguration.certSans"), fmt.Sprintf("cert SANs can only be added, cannot remove %v", removed)))
+	}
+
 	if !new.Spec.ClusterNetwork.Pods.Equal(&old.Spec.ClusterNetwork.Pods) {
 		allErrs = append(
 			allErrs,
@@ -336,6 +364,12 @@ func validateImmutableFieldsCluster(new, old *Cluster) field.ErrorList {
 			field.Forbidden(specPath.Child("clusterNetwork", "nodes"), "field is immutable"))
 	}
 
+	if new.Spec.ClusterNetwork.CNIConfig.KubeProxyReplacementEnabled() != old.Spec.ClusterNetwork.CNIConfig.KubeProxyReplacementEnabled() {
+		allErrs = append(
+			allErrs,
+			field.Forbidden(specPath.Child("clusterNetwork", "cniConfig", "cilium", "kubeProxyReplacement"), "field is immutable"))
+	}
+
 	if !new.Spec.ProxyConfiguration.Equal(old.Spec.ProxyConfiguration) {
 		allErrs = append(
 			allErrs,
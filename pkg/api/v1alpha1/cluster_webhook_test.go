@@ -2,15 +2,19 @@ package v1alpha1_test
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"strings"
 	"testing"
 
 	. "github.com/onsi/gomega"
 	"github.com/pkg/errors"
+	admissionv1 "k8s.io/api/admission/v1"
+	authenticationv1 "k8s.io/api/authentication/v1"
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	_ "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
 	"github.com/aws/eks-anywhere/internal/test"
 	"github.com/aws/eks-anywhere/pkg/api/v1alpha1"
@@ -32,6 +36,76 @@ func TestClusterDefault(t *testing.T) {
 	g.Expect(cOld.Spec.RegistryMirrorConfiguration.Port).To(Equal(constants.DefaultHttpsPort))
 }
 
+func TestClusterDefaultFrozenBy(t *testing.T) {
+	g := NewWithT(t)
+	c := baseCluster()
+	c.Annotations = map[string]string{v1alpha1.FrozenAnnotation: "manual etcd repair"}
+	ctx := admission.NewContextWithRequest(context.Background(), admission.Request{
+		AdmissionRequest: admissionv1.AdmissionRequest{
+			UserInfo: authenticationv1.UserInfo{Username: "jane@example.com"},
+		},
+	})
+
+	g.Expect(c.Default(ctx, c)).To(Succeed())
+	g.Expect(c.FrozenBy()).To(Equal("jane@example.com"))
+}
+
+func TestClusterDefaultFrozenByIgnoresClientValue(t *testing.T) {
+	g := NewWithT(t)
+	c := baseCluster()
+	c.Annotations = map[string]string{
+		v1alpha1.FrozenAnnotation:   "manual etcd repair",
+		v1alpha1.FrozenByAnnotation: "someone-else@example.com",
+	}
+
+	g.Expect(c.Default(context.Background(), c)).To(Succeed())
+	g.Expect(c.Annotations).NotTo(HaveKey(v1alpha1.FrozenByAnnotation))
+
+	c.Annotations[v1alpha1.FrozenByAnnotation] = "someone-else@example.com"
+	ctx := admission.NewContextWithRequest(context.Background(), admission.Request{
+		AdmissionRequest: admissionv1.AdmissionRequest{
+			UserInfo: authenticationv1.UserInfo{Username: "jane@example.com"},
+		},
+	})
+	g.Expect(c.Default(ctx, c)).To(Succeed())
+	g.Expect(c.FrozenBy()).To(Equal("jane@example.com"))
+}
+
+func TestClusterDefaultFrozenByKeepsOldValue(t *testing.T) {
+	g := NewWithT(t)
+	old := baseCluster()
+	old.Annotations = map[string]string{
+		v1alpha1.FrozenAnnotation:   "manual etcd repair",
+		v1alpha1.FrozenByAnnotation: "jane@example.com",
+	}
+	oldRaw, err := json.Marshal(old)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	c := old.DeepCopy()
+	c.Annotations[v1alpha1.FrozenByAnnotation] = "someone-else@example.com"
+	ctx := admission.NewContextWithRequest(context.Background(), admission.Request{
+		AdmissionRequest: admissionv1.AdmissionRequest{
+			UserInfo:  authenticationv1.UserInfo{Username: "john@example.com"},
+			OldObject: runtime.RawExtension{Raw: oldRaw},
+		},
+	})
+
+	g.Expect(c.Default(ctx, c)).To(Succeed())
+	g.Expect(c.FrozenBy()).To(Equal("jane@example.com"))
+}
+
+func TestClusterDefaultFrozenByInvalidOldObject(t *testing.T) {
+	g := NewWithT(t)
+	c := baseCluster()
+	ctx := admission.NewContextWithRequest(context.Background(), admission.Request{
+		AdmissionRequest: admissionv1.AdmissionRequest{
+			OldObject: runtime.RawExtension{Raw: []byte("{")},
+		},
+	})
+
+	g.Expect(c.Default(ctx, c)).To(MatchError(ContainSubstring("decoding old Cluster")))
+}
+
 func TestClusterValidateUpdateManagementValueImmutable(t *testing.T) {
 	cOld := baseCluster()
 	cOld.SetSelfManaged()
@@ -201,6 +275,26 @@ func TestClusterValidateUpdateControlPlaneConfigurationNewEndpointNilImmutable(t
 	g.Expect(c.ValidateUpdate(context.TODO(), cOld, c)).Error().To(MatchError(ContainSubstring("spec.ControlPlaneConfiguration.endpoint: Forbidden: field is immutable")))
 }
 
+func TestClusterValidateUpdateControlPlaneConfigurationCertSANsAppended(t *testing.T) {
+	cOld := baseCluster()
+	cOld.Spec.ControlPlaneConfiguration.CertSANs = []string{"api.example.com"}
+	c := cOld.DeepCopy()
+	c.Spec.ControlPlaneConfiguration.CertSANs = []string{"10.0.0.10", "api.example.com"}
+
+	g := NewWithT(t)
+	g.Expect(c.ValidateUpdate(context.TODO(), cOld, c)).Error().To(Succeed())
+}
+
+func TestClusterValidateUpdateControlPlaneConfigurationCertSANsRemoved(t *testing.T) {
+	cOld := baseCluster()
+	cOld.Spec.ControlPlaneConfiguration.CertSANs = []string{"api.example.com", "10.0.0.10"}
+	c := cOld.DeepCopy()
+	c.Spec.ControlPlaneConfiguration.CertSANs = []string{"api.example.com"}
+
+	g := NewWithT(t)
+	g.Expect(c.ValidateUpdate(context.TODO(), cOld, c)).Error().To(MatchError(ContainSubstring("spec.ControlPlaneConfiguration.certSans: Forbidden: cert SANs can only be added, cannot remove [10.0.0.10]")))
+}
+
 func TestCloudStackClusterValidateUpdateControlPlaneConfigurationOldDefaultPortNewNoPort(t *testing.T) {
 	cOld := baseCluster()
 	cOld.Spec.ControlPlaneConfiguration.Endpoint = &v1alpha1.Endpoint{Host: "1.1.1.1:6443"}
@@ -521,6 +615,17 @@ func TestClusterValidateUpdateClusterNetworkNodesImmutable(t *testing.T) {
 	g.Expect(c.ValidateUpdate(context.TODO(), cOld, c)).Error().To(MatchError(ContainSubstring("spec.clusterNetwork.nodes: Forbidden: field is immutable")))
 }
 
+func TestClusterValidateUpdateCiliumKubeProxyReplacementImmutable(t *testing.T) {
+	features.ClearCache()
+	cOld := baseCluster()
+	cOld.Spec.ClusterNetwork.CNIConfig = &v1alpha1.CNIConfig{Cilium: &v1alpha1.CiliumConfig{}}
+	c := cOld.DeepCopy()
+	c.Spec.ClusterNetwork.CNIConfig.Cilium.KubeProxyReplacement = ptr.Bool(true)
+
+	g := NewWithT(t)
+	g.Expect(c.ValidateUpdate(context.TODO(), cOld, c)).Error().To(MatchError(ContainSubstring("spec.clusterNetwork.cniConfig.cilium.kubeProxyReplacement: Forbidden: field is immutable")))
+}
+
 func TestClusterValidateUpdateProxyConfigurationEqualOrder(t *testing.T) {
 	cOld := baseCluster()
 	cOld.Spec.ProxyConfiguration = &v1alpha1.ProxyConfiguration{
@@ -1299,8 +1404,8 @@ func TestClusterUpdateEtcdEncryption(t *testing.T) {
 			},
 		},
 		{
-			testName:    "two_encryption_providers",
-			expectedErr: errors.New("etcdEncryption[0].providers in invalid, only 1 encryption provider is currently supported"),
+			testName:    "two_encryption_providers_same_name",
+			expectedErr: errors.New("etcdEncryption[0].providers[1] is invalid: kms.name test_config1 is duplicated"),
 			encryptionConfig: &[]v1alpha1.EtcdEncryption{
 				{
 					Providers: []v1alpha1.EtcdEncryptionProvider{
@@ -1312,7 +1417,31 @@ func TestClusterUpdateEtcdEncryption(t *testing.T) {
 						},
 						{
 							KMS: &v1alpha1.KMS{
+								Name:                "test_config1",
+								SocketListenAddress: "unix:///def",
+							},
+						},
+					},
+					Resources: resources,
+				},
+			},
+		},
+		{
+			testName:    "two_encryption_providers_rotation",
+			expectedErr: nil,
+			encryptionConfig: &[]v1alpha1.EtcdEncryption{
+				{
+					Providers: []v1alpha1.EtcdEncryptionProvider{
+						{
+							KMS: &v1alpha1.KMS{
+								APIVersion:          v1alpha1.KMSAPIVersionV2,
 								Name:                "test_config2",
+								SocketListenAddress: "unix:///var/run/vault/kms.sock",
+							},
+						},
+						{
+							KMS: &v1alpha1.KMS{
+								Name:                "test_config1",
 								SocketListenAddress: "unix:///abc",
 							},
 						},
@@ -1321,6 +1450,43 @@ func TestClusterUpdateEtcdEncryption(t *testing.T) {
 				},
 			},
 		},
+		{
+			testName:    "invalid_kms_config_api_version",
+			expectedErr: errors.New("etcdEncryption[0].providers[0] is invalid: kms.apiVersion v3 is not supported, only v1 and v2 are supported"),
+			encryptionConfig: &[]v1alpha1.EtcdEncryption{
+				{
+					Providers: []v1alpha1.EtcdEncryptionProvider{
+						{
+							KMS: &v1alpha1.KMS{
+								APIVersion:          "v3",
+								Name:                "test_config",
+								SocketListenAddress: "unix:///abc",
+							},
+						},
+					},
+					Resources: resources,
+				},
+			},
+		},
+		{
+			testName:    "invalid_kms_v2_config_cachesize",
+			expectedErr: errors.New("etcdEncryption[0].providers[0] is invalid: kms.cachesize is not supported with the v2 API"),
+			encryptionConfig: &[]v1alpha1.EtcdEncryption{
+				{
+					Providers: []v1alpha1.EtcdEncryptionProvider{
+						{
+							KMS: &v1alpha1.KMS{
+								APIVersion:          v1alpha1.KMSAPIVersionV2,
+								Name:                "test_config",
+								SocketListenAddress: "unix:///abc",
+								CacheSize:           ptr.Int32(100),
+							},
+						},
+					},
+					Resources: resources,
+				},
+			},
+		},
 		{
 			testName:    "valid_config",
 			expectedErr: nil,
@@ -1494,7 +1660,7 @@ func TestClusterUpdateControlPlaneLabelsInvalid(t *testing.T) {
 		Labels: map[string]string{
 			"test": "val1",
 		},
-		Endpoint:        &v1alpha1.Endpoint{"1.1.1.1"},
+		Endpoint:        &v1alpha1.Endpoint{Host: "1.1.1.1"},
 		MachineGroupRef: &v1alpha1.Ref{},
 		Count:           1,
 	}
@@ -2307,6 +2473,25 @@ func TestClusterDefaultCastFail(t *testing.T) {
 	g.Expect(err.Error()).To(ContainSubstring("expected a Cluster"))
 }
 
+func TestClusterValidateUpdateComponentExtraArgs(t *testing.T) {
+	g := NewWithT(t)
+	oldCluster := baseCluster()
+	cluster := oldCluster.DeepCopy()
+	cluster.Spec.ControlPlaneConfiguration.ControllerManagerExtraArgs = map[string]string{"profiling": "true"}
+	cluster.Spec.ControlPlaneConfiguration.SchedulerExtraArgs = map[string]string{"bind-address": "0.0.0.0"}
+
+	g.Expect(cluster.ValidateUpdate(context.Background(), oldCluster, cluster)).Error().To(Succeed())
+}
+
+func TestClusterValidateUpdateComponentExtraArgsManagedFlag(t *testing.T) {
+	g := NewWithT(t)
+	oldCluster := baseCluster()
+	cluster := oldCluster.DeepCopy()
+	cluster.Spec.ControlPlaneConfiguration.ControllerManagerExtraArgs = map[string]string{"cloud-provider": "aws"}
+
+	g.Expect(cluster.ValidateUpdate(context.Background(), oldCluster, cluster)).Error().To(MatchError(ContainSubstring("flag cloud-provider is managed by EKS Anywhere")))
+}
+
 func TestClusterValidateCreateCastFail(t *testing.T) {
 	g := NewWithT(t)
 
@@ -2360,3 +2545,60 @@ func TestClusterValidateDeleteCastFail(t *testing.T) {
 	g.Expect(err).To(HaveOccurred())
 	g.Expect(err.Error()).To(ContainSubstring("expected a Cluster"))
 }
+
+func TestClusterValidateUpdateRolloutNotAcknowledged(t *testing.T) {
+	features.ClearCache()
+	t.Setenv(features.RolloutGuardrailsEnvVar, "true")
+	cOld := baseCluster()
+	c := cOld.DeepCopy()
+	c.Spec.WorkerNodeGroupConfigurations[0].Labels = map[string]string{"zone": "a"}
+
+	g := NewWithT(t)
+	g.Expect(c.ValidateUpdate(context.TODO(), cOld, c)).Error().To(MatchError(ContainSubstring("changes to spec.workerNodeGroupConfigurations[md-0].labels replace the machines of the cluster")))
+}
+
+func TestClusterValidateUpdateRolloutAcknowledged(t *testing.T) {
+	features.ClearCache()
+	t.Setenv(features.RolloutGuardrailsEnvVar, "true")
+	cOld := baseCluster()
+	cOld.AcknowledgeRollout("2024-01-01")
+	c := cOld.DeepCopy()
+	c.Spec.WorkerNodeGroupConfigurations[0].Labels = map[string]string{"zone": "a"}
+	c.AcknowledgeRollout("2024-02-01")
+
+	g := NewWithT(t)
+	g.Expect(c.ValidateUpdate(context.TODO(), cOld, c)).Error().To(Succeed())
+}
+
+func TestClusterValidateUpdateRolloutPreviouslyAcknowledged(t *testing.T) {
+	features.ClearCache()
+	t.Setenv(features.RolloutGuardrailsEnvVar, "true")
+	cOld := baseCluster()
+	cOld.AcknowledgeRollout("2024-01-01")
+	c := cOld.DeepCopy()
+	c.Spec.WorkerNodeGroupConfigurations[0].Labels = map[string]string{"zone": "a"}
+
+	g := NewWithT(t)
+	g.Expect(c.ValidateUpdate(context.TODO(), cOld, c)).Error().To(MatchError(ContainSubstring(v1alpha1.AcknowledgeRolloutAnnotation)))
+}
+
+func TestClusterValidateUpdateRolloutGuardrailsDisabled(t *testing.T) {
+	features.ClearCache()
+	cOld := baseCluster()
+	c := cOld.DeepCopy()
+	c.Spec.WorkerNodeGroupConfigurations[0].Labels = map[string]string{"zone": "a"}
+
+	g := NewWithT(t)
+	g.Expect(c.ValidateUpdate(context.TODO(), cOld, c)).Error().To(Succeed())
+}
+
+func TestClusterValidateUpdateScaleWithRolloutGuardrails(t *testing.T) {
+	features.ClearCache()
+	t.Setenv(features.RolloutGuardrailsEnvVar, "true")
+	cOld := baseCluster()
+	c := cOld.DeepCopy()
+	c.Spec.WorkerNodeGroupConfigurations[0].Count = ptr.Int(3)
+
+	g := NewWithT(t)
+	g.Expect(c.ValidateUpdate(context.TODO(), cOld, c)).Error().To(Succeed())
+}
@@ -0,0 +1,18 @@
+package v1alpha1
+
+import (
+	"sort"
+)
+
+// AddEvents adds events to the history timeline keeping it sorted by time.
+// The oldest events are dropped when the timeline grows past MaxClusterHistoryEvents.
+func (h *ClusterHistory) AddEvents(events ...ClusterHistoryEvent) {
+	h.Status.Events = append(h.Status.Events, events...)
+	sort.SliceStable(h.Status.Events, func(i, j int) bool {
+		return h.Status.Events[i].Time.Before(&h.Status.Events[j].Time)
+	})
+
+	if extra := len(h.Status.Events) - MaxClusterHistoryEvents; extra > 0 {
+		h.Status.Events = h.Status.Events[extra:]
+	}
+}
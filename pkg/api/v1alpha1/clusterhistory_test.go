@@ -0,0 +1,41 @@
+package v1alpha1_test
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+)
+
+func TestClusterHistoryAddEventsSortsByTime(t *testing.T) {
+	g := NewWithT(t)
+	now := time.Now()
+	h := &v1alpha1.ClusterHistory{}
+	h.AddEvents(v1alpha1.ClusterHistoryEvent{Type: v1alpha1.ClusterCreatedEvent, Time: metav1.NewTime(now)})
+	h.AddEvents(
+		v1alpha1.ClusterHistoryEvent{Type: v1alpha1.NodeGroupScaledEvent, Time: metav1.NewTime(now.Add(time.Hour))},
+		v1alpha1.ClusterHistoryEvent{Type: v1alpha1.PackageInstalledEvent, Time: metav1.NewTime(now.Add(time.Minute))},
+	)
+
+	g.Expect(h.Status.Events).To(HaveLen(3))
+	g.Expect(h.Status.Events[0].Type).To(Equal(v1alpha1.ClusterCreatedEvent))
+	g.Expect(h.Status.Events[1].Type).To(Equal(v1alpha1.PackageInstalledEvent))
+	g.Expect(h.Status.Events[2].Type).To(Equal(v1alpha1.NodeGroupScaledEvent))
+}
+
+func TestClusterHistoryAddEventsDropsOldest(t *testing.T) {
+	g := NewWithT(t)
+	now := time.Now()
+	h := &v1alpha1.ClusterHistory{}
+	for i := 0; i < v1alpha1.MaxClusterHistoryEvents; i++ {
+		h.AddEvents(v1alpha1.ClusterHistoryEvent{Type: v1alpha1.NodeGroupScaledEvent, Time: metav1.NewTime(now.Add(time.Duration(i) * time.Minute))})
+	}
+	h.AddEvents(v1alpha1.ClusterHistoryEvent{Type: v1alpha1.KubernetesVersionUpgradedEvent, Time: metav1.NewTime(now.Add(time.Hour * 24))})
+
+	g.Expect(h.Status.Events).To(HaveLen(v1alpha1.MaxClusterHistoryEvents))
+	g.Expect(h.Status.Events[0].Time.Time).To(BeTemporally("==", now.Add(time.Minute)))
+	g.Expect(h.Status.Events[v1alpha1.MaxClusterHistoryEvents-1].Type).To(Equal(v1alpha1.KubernetesVersionUpgradedEvent))
+}
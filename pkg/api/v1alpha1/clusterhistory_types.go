@@ -0,0 +1,137 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// ClusterHistoryKind stores the Kind for ClusterHistory.
+	ClusterHistoryKind = "ClusterHistory"
+
+	// MaxClusterHistoryEvents is the maximum number of events kept in a ClusterHistory.
+	// The oldest events are dropped when new ones are recorded past this limit.
+	MaxClusterHistoryEvents = 100
+)
+
+// ClusterHistoryEventType is the type of a lifecycle event of a cluster.
+type ClusterHistoryEventType string
+
+const (
+	// ClusterCreatedEvent is recorded the first time the cluster becomes ready.
+	ClusterCreatedEvent ClusterHistoryEventType = "Created"
+
+	// KubernetesVersionUpgradedEvent is recorded when the cluster is ready after a Kubernetes version change.
+	KubernetesVersionUpgradedEvent ClusterHistoryEventType = "KubernetesVersionUpgraded"
+
+	// EksaVersionUpgradedEvent is recorded when the cluster is ready after an EKS Anywhere version change.
+	EksaVersionUpgradedEvent ClusterHistoryEventType = "EksaVersionUpgraded"
+
+	// NodeGroupScaledEvent is recorded when the cluster is ready after the count of a worker node group changed.
+	NodeGroupScaledEvent ClusterHistoryEventType = "NodeGroupScaled"
+
+	// NodeGroupAddedEvent is recorded when the cluster is ready after a worker node group was added.
+	NodeGroupAddedEvent ClusterHistoryEventType = "NodeGroupAdded"
+
+	// NodeGroupRemovedEvent is recorded when the cluster is ready after a worker node group was removed.
+	NodeGroupRemovedEvent ClusterHistoryEventType = "NodeGroupRemoved"
+
+	// PackageInstalledEvent is recorded when a curated package is installed in the cluster.
+	PackageInstalledEvent ClusterHistoryEventType = "PackageInstalled"
+)
+
+// ClusterHistoryEvent is a significant lifecycle event of a cluster.
+type ClusterHistoryEvent struct {
+	// Type is the type of the event.
+	Type ClusterHistoryEventType `json:"type"`
+
+	// Time is when the event happened.
+	Time metav1.Time `json:"time"`
+
+	// Object is the name of the object the event refers to, like a worker node group or a package.
+	// +optional
+	Object string `json:"object,omitempty"`
+
+	// From is the value before the event, like the previous Kubernetes version.
+	// +optional
+	From string `json:"from,omitempty"`
+
+	// To is the value after the event, like the new Kubernetes version.
+	// +optional
+	To string `json:"to,omitempty"`
+
+	// Message is a human readable description of the event.
+	Message string `json:"message"`
+}
+
+// ClusterHistoryObservedState is the state of the cluster the last time events were recorded.
+// It's used to detect changes between reconciliations.
+type ClusterHistoryObservedState struct {
+	// KubernetesVersion is the Kubernetes version of the control plane.
+	KubernetesVersion KubernetesVersion `json:"kubernetesVersion,omitempty"`
+
+	// EksaVersion is the EKS Anywhere version of the cluster.
+	// +optional
+	EksaVersion string `json:"eksaVersion,omitempty"`
+
+	// WorkerNodeGroups maps the name of each worker node group to its count.
+	// Node groups managed by the cluster autoscaler are tracked with a count of -1.
+	// +optional
+	WorkerNodeGroups map[string]int `json:"workerNodeGroups,omitempty"`
+
+	// Packages is the list of curated packages installed in the cluster.
+	// +optional
+	Packages []string `json:"packages,omitempty"`
+
+	// Spec is the cluster spec the cluster was ready with. It's used to roll back an upgrade
+	// that didn't complete.
+	// +optional
+	// +kubebuilder:validation:Schemaless
+	// +kubebuilder:validation:Type=object
+	// +kubebuilder:pruning:PreserveUnknownFields
+	Spec *ClusterSpec `json:"spec,omitempty"`
+}
+
+// ClusterHistoryStatus defines the observed state of ClusterHistory.
+type ClusterHistoryStatus struct {
+	// Events is the timeline of lifecycle events of the cluster, oldest first.
+	// +optional
+	Events []ClusterHistoryEvent `json:"events,omitempty"`
+
+	// Observed is the cluster state the latest events were recorded from.
+	// +optional
+	Observed *ClusterHistoryObservedState `json:"observed,omitempty"`
+
+	// PreviousSpec is the cluster spec the cluster was ready with before the last EKS Anywhere
+	// version upgrade. It's used to roll back that upgrade.
+	// +optional
+	// +kubebuilder:validation:Schemaless
+	// +kubebuilder:validation:Type=object
+	// +kubebuilder:pruning:PreserveUnknownFields
+	PreviousSpec *ClusterSpec `json:"previousSpec,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:resource:path=clusterhistories,shortName=ch,scope=Namespaced,singular=clusterhistory
+//+kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp",description="Time duration since creation of the ClusterHistory"
+
+// ClusterHistory is the Schema for the clusterhistories API.
+// It's named after the cluster it records events for and lives in the same namespace.
+type ClusterHistory struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Status ClusterHistoryStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// ClusterHistoryList contains a list of ClusterHistory.
+type ClusterHistoryList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ClusterHistory `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ClusterHistory{}, &ClusterHistoryList{})
+}
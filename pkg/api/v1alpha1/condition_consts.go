@@ -66,6 +66,15 @@ const (
 	MachineDeploymentNotReadyReason = "MachineDeploymentNotReady"
 )
 
+const (
+	// FrozenCondition reports the cluster has the frozen annotation, so the controller doesn't
+	// reconcile it. Its message says who froze the cluster and why.
+	FrozenCondition ConditionType = "Frozen"
+
+	// FrozenByAnnotationReason reports the cluster was frozen with the frozen annotation.
+	FrozenByAnnotationReason = "FrozenByAnnotation"
+)
+
 const (
 	// DefaultCNIConfiguredCondition reports the default cni cluster has been configured successfully.
 	DefaultCNIConfiguredCondition ConditionType = "DefaultCNIConfigured"
@@ -78,3 +87,19 @@ const (
 	// create a cluster.
 	SkipUpgradesForDefaultCNIConfiguredReason = "SkipUpgradesForDefaultCNIConfigured"
 )
+
+const (
+	// ProviderCredentialsValidCondition reports whether the provider credentials stored in the management cluster
+	// are accepted by the provider and not about to expire. It doesn't affect the Ready condition.
+	ProviderCredentialsValidCondition ConditionType = "ProviderCredentialsValid"
+
+	// ProviderCredentialsInvalidReason reports that the provider rejected the stored credentials.
+	ProviderCredentialsInvalidReason = "ProviderCredentialsInvalid"
+
+	// ProviderCredentialsExpiredReason reports that the stored credentials are past their expiration date,
+	// reported by the provider or annotated in their Secret.
+	ProviderCredentialsExpiredReason = "ProviderCredentialsExpired"
+
+	// ProviderCredentialsExpiringReason reports that the expiration date of the stored credentials is close.
+	ProviderCredentialsExpiringReason = "ProviderCredentialsExpiring"
+)
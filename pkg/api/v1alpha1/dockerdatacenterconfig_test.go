@@ -4,6 +4,7 @@ import (
 	"reflect"
 	"testing"
 
+	. "github.com/onsi/gomega"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/aws/eks-anywhere/pkg/api/v1alpha1"
@@ -65,3 +66,48 @@ func TestGetDockerDatacenterConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestDockerDatacenterConfigValidate(t *testing.T) {
+	tests := []struct {
+		name         string
+		loadBalancer *v1alpha1.DockerLoadBalancer
+		wantErr      string
+	}{
+		{
+			name: "no load balancer",
+		},
+		{
+			name:         "ip address and host port",
+			loadBalancer: &v1alpha1.DockerLoadBalancer{ExternalAddress: "10.0.0.5", HostPort: 30443},
+		},
+		{
+			name:         "hostname",
+			loadBalancer: &v1alpha1.DockerLoadBalancer{ExternalAddress: "docker-host.example.com"},
+		},
+		{
+			name:         "invalid address",
+			loadBalancer: &v1alpha1.DockerLoadBalancer{ExternalAddress: "https://docker-host"},
+			wantErr:      "invalid DockerDatacenterConfig loadBalancer.externalAddress https://docker-host: must be an IP or hostname",
+		},
+		{
+			name:         "invalid host port",
+			loadBalancer: &v1alpha1.DockerLoadBalancer{HostPort: 70000},
+			wantErr:      "invalid DockerDatacenterConfig loadBalancer.hostPort 70000: must be between 1 and 65535",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			d := &v1alpha1.DockerDatacenterConfig{
+				Spec: v1alpha1.DockerDatacenterConfigSpec{LoadBalancer: tt.loadBalancer},
+			}
+
+			err := d.Validate()
+			if tt.wantErr == "" {
+				g.Expect(err).NotTo(HaveOccurred())
+			} else {
+				g.Expect(err).To(MatchError(tt.wantErr))
+			}
+		})
+	}
+}
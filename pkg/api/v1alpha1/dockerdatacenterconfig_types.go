@@ -1,15 +1,34 @@
 package v1alpha1
 
 import (
+	"fmt"
+	"net"
+
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
 )
 
 // NOTE: json tags are required.  Any new fields you add must have json tags for the fields to be serialized.
 
 // DockerDatacenterConfigSpec defines the desired state of DockerDatacenterConfig.
 type DockerDatacenterConfigSpec struct { // Important: Run "make generate" to regenerate code after modifying this file
-	// Foo is an example field of DockerDatacenterConfig. Edit DockerDatacenter_types.go to remove/update
-	// Foo string `json:"foo,omitempty"`
+	// LoadBalancer configures how the control plane load balancer is reached from outside the
+	// Docker host, for clusters created on remote Docker hosts or CI runners.
+	// +optional
+	LoadBalancer *DockerLoadBalancer `json:"loadBalancer,omitempty"`
+}
+
+// DockerLoadBalancer defines the control plane endpoint written to the cluster kubeconfig.
+type DockerLoadBalancer struct {
+	// ExternalAddress is the IP or hostname the load balancer is reachable at.
+	// Defaults to 127.0.0.1.
+	// +optional
+	ExternalAddress string `json:"externalAddress,omitempty"`
+
+	// HostPort is the port the load balancer is reachable at on ExternalAddress.
+	// Defaults to the host port Docker published the load balancer on.
+	// +optional
+	HostPort int `json:"hostPort,omitempty"`
 }
 
 // DockerDatacenterConfigStatus defines the observed state of DockerDatacenterConfig.
@@ -72,6 +91,19 @@ func (d *DockerDatacenterConfig) Marshallable() Marshallable {
 }
 
 func (d *DockerDatacenterConfig) Validate() error {
+	lb := d.Spec.LoadBalancer
+	if lb == nil {
+		return nil
+	}
+
+	if lb.ExternalAddress != "" && net.ParseIP(lb.ExternalAddress) == nil && len(validation.IsDNS1123Subdomain(lb.ExternalAddress)) > 0 {
+		return fmt.Errorf("invalid DockerDatacenterConfig loadBalancer.externalAddress %s: must be an IP or hostname", lb.ExternalAddress)
+	}
+
+	if lb.HostPort < 0 || lb.HostPort > 65535 {
+		return fmt.Errorf("invalid DockerDatacenterConfig loadBalancer.hostPort %d: must be between 1 and 65535", lb.HostPort)
+	}
+
 	return nil
 }
 
@@ -35,13 +35,15 @@ func ValidateEtcdEncryptionConfig(config *[]EtcdEncryption) error {
 		if len(c.Providers) == 0 {
 			return errors.Errorf("etcdEncryption[%d].providers cannot be empty", i)
 		}
-		if len(c.Providers) != 1 {
-			return errors.Errorf("etcdEncryption[%d].providers in invalid, only 1 encryption provider is currently supported", i)
-		}
+		names := make(map[string]struct{}, len(c.Providers))
 		for j, p := range c.Providers {
 			if err := validateKMSConfig(p.KMS); err != nil {
 				return errors.Errorf("etcdEncryption[%d].providers[%d] is invalid: %v", i, j, err)
 			}
+			if _, ok := names[p.KMS.Name]; ok {
+				return errors.Errorf("etcdEncryption[%d].providers[%d] is invalid: kms.name %s is duplicated", i, j, p.KMS.Name)
+			}
+			names[p.KMS.Name] = struct{}{}
 		}
 		if len(c.Resources) == 0 {
 			return errors.Errorf("etcdEncryption[%d].resources cannot be empty", i)
@@ -58,6 +60,15 @@ func validateKMSConfig(kms *KMS) error {
 	if len(kms.Name) == 0 {
 		return errors.New("kms.name cannot be empty")
 	}
+	switch kms.APIVersion {
+	case "", KMSAPIVersionV1:
+	case KMSAPIVersionV2:
+		if kms.CacheSize != nil {
+			return errors.New("kms.cachesize is not supported with the v2 API")
+		}
+	default:
+		return errors.Errorf("kms.apiVersion %s is not supported, only %s and %s are supported", kms.APIVersion, KMSAPIVersionV1, KMSAPIVersionV2)
+	}
 	if len(kms.SocketListenAddress) == 0 {
 		return errors.New("kms.socketListenAddress cannot be empty")
 	}
@@ -86,7 +97,7 @@ func setEtcdEncryptionConfigDefaults(cluster *Cluster) error {
 
 func setKMSConfigDefauts(kms *KMS) {
 	if kms != nil {
-		if kms.CacheSize == nil {
+		if kms.CacheSize == nil && !kms.IsV2() {
 			kms.CacheSize = DefaultKMSCacheSize
 		}
 		if kms.Timeout == nil {
@@ -94,3 +105,8 @@ func setKMSConfigDefauts(kms *KMS) {
 		}
 	}
 }
+
+// IsV2 returns true if the KMS provider uses the v2 API.
+func (k *KMS) IsV2() bool {
+	return k.APIVersion == KMSAPIVersionV2
+}
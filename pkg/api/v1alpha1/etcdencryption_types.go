@@ -10,16 +10,31 @@ type EtcdEncryption struct {
 }
 
 // EtcdEncryptionProvider defines the configuration for ETCD encryption providers.
-// Currently only KMS provider is supported.
+// Currently only KMS provider is supported. When more than one provider is configured,
+// the first one encrypts the data and the others can only decrypt it.
 type EtcdEncryptionProvider struct {
 	// KMS defines the configuration for KMS Encryption provider.
 	KMS *KMS `json:"kms"`
 }
 
+// KMSAPIVersion is the version of the KMS plugin API used by kube-apiserver to talk to the KMS plugin.
+type KMSAPIVersion string
+
+const (
+	// KMSAPIVersionV1 is the deprecated KMS v1 API.
+	KMSAPIVersionV1 KMSAPIVersion = "v1"
+	// KMSAPIVersionV2 is the KMS v2 API, which supports key rotation without restarting kube-apiserver.
+	KMSAPIVersionV2 KMSAPIVersion = "v2"
+)
+
 // KMS defines the configuration for KMS Encryption provider.
 type KMS struct {
+	// APIVersion defines the KMS plugin API version. Defaults to v1.
+	// +kubebuilder:validation:Enum=v1;v2
+	// +optional
+	APIVersion KMSAPIVersion `json:"apiVersion,omitempty"`
 	// CacheSize defines the maximum number of encrypted objects to be cached in memory. The default value is 1000.
-	// You can set this to a negative value to disable caching.
+	// You can set this to a negative value to disable caching. It is only supported with the v1 API.
 	CacheSize *int32 `json:"cachesize,omitempty"`
 	// Name defines the name of KMS plugin to be used.
 	Name string `json:"name"`
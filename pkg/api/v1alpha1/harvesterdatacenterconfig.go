@@ -0,0 +1,47 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// HarvesterDatacenterKind is the kind for a HarvesterDatacenterConfig.
+const HarvesterDatacenterKind = "HarvesterDatacenterConfig"
+
+// NewHarvesterDatacenterConfigGenerate is used for generating yaml for generate clusterconfig command.
+func NewHarvesterDatacenterConfigGenerate(clusterName string) *HarvesterDatacenterConfigGenerate {
+	return &HarvesterDatacenterConfigGenerate{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       HarvesterDatacenterKind,
+			APIVersion: SchemeBuilder.GroupVersion.String(),
+		},
+		ObjectMeta: ObjectMeta{
+			Name: clusterName,
+		},
+		Spec: HarvesterDatacenterConfigSpec{
+			Server:    "<enter Harvester cluster API URL here>",
+			Namespace: "default",
+		},
+	}
+}
+
+func (c *HarvesterDatacenterConfigGenerate) APIVersion() string {
+	return c.TypeMeta.APIVersion
+}
+
+func (c *HarvesterDatacenterConfigGenerate) Kind() string {
+	return c.TypeMeta.Kind
+}
+
+func (c *HarvesterDatacenterConfigGenerate) Name() string {
+	return c.ObjectMeta.Name
+}
+
+// GetHarvesterDatacenterConfig parses config in a yaml file and returns a HarvesterDatacenterConfig object.
+func GetHarvesterDatacenterConfig(fileName string) (*HarvesterDatacenterConfig, error) {
+	var clusterConfig HarvesterDatacenterConfig
+	err := ParseClusterConfig(fileName, &clusterConfig)
+	if err != nil {
+		return nil, err
+	}
+	return &clusterConfig, nil
+}
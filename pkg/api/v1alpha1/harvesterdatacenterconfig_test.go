@@ -0,0 +1,75 @@
+package v1alpha1_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+)
+
+func validHarvesterDatacenterConfig() *v1alpha1.HarvesterDatacenterConfig {
+	return &v1alpha1.HarvesterDatacenterConfig{
+		Spec: v1alpha1.HarvesterDatacenterConfigSpec{
+			Server:    "https://harvester.example.com:6443",
+			Namespace: "default",
+		},
+	}
+}
+
+func TestHarvesterDatacenterConfigValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		update  func(*v1alpha1.HarvesterDatacenterConfig)
+		wantErr string
+	}{
+		{
+			name:   "valid",
+			update: func(*v1alpha1.HarvesterDatacenterConfig) {},
+		},
+		{
+			name:    "empty server",
+			update:  func(c *v1alpha1.HarvesterDatacenterConfig) { c.Spec.Server = "" },
+			wantErr: "HarvesterDatacenterConfig server is not set or is empty",
+		},
+		{
+			name:    "server without scheme",
+			update:  func(c *v1alpha1.HarvesterDatacenterConfig) { c.Spec.Server = "harvester.example.com" },
+			wantErr: "HarvesterDatacenterConfig server harvester.example.com must be an https URL",
+		},
+		{
+			name:    "http server",
+			update:  func(c *v1alpha1.HarvesterDatacenterConfig) { c.Spec.Server = "http://harvester.example.com" },
+			wantErr: "HarvesterDatacenterConfig server http://harvester.example.com must be an https URL",
+		},
+		{
+			name:    "empty namespace",
+			update:  func(c *v1alpha1.HarvesterDatacenterConfig) { c.Spec.Namespace = "" },
+			wantErr: "HarvesterDatacenterConfig namespace is not set or is empty",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			config := validHarvesterDatacenterConfig()
+			tt.update(config)
+
+			err := config.Validate()
+			if tt.wantErr == "" {
+				g.Expect(err).NotTo(HaveOccurred())
+			} else {
+				g.Expect(err).To(MatchError(tt.wantErr))
+			}
+		})
+	}
+}
+
+func TestNewHarvesterDatacenterConfigGenerate(t *testing.T) {
+	g := NewWithT(t)
+	config := v1alpha1.NewHarvesterDatacenterConfigGenerate("test")
+
+	g.Expect(config.Kind()).To(Equal(v1alpha1.HarvesterDatacenterKind))
+	g.Expect(config.APIVersion()).To(Equal(v1alpha1.SchemeBuilder.GroupVersion.String()))
+	g.Expect(config.Name()).To(Equal("test"))
+}
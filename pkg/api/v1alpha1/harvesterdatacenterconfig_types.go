@@ -0,0 +1,136 @@
+// Important: Run "make generate" to regenerate code after modifying this file
+// json tags are required; new fields must have json tags for the fields to be serialized
+
+package v1alpha1
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// HarvesterDatacenterConfigSpec defines the desired state of HarvesterDatacenterConfig.
+type HarvesterDatacenterConfigSpec struct {
+	// Server is the URL of the Harvester cluster API, as found in the Harvester kubeconfig.
+	// +kubebuilder:validation:Required
+	Server string `json:"server"`
+
+	// Namespace is the Harvester namespace the cluster VMs and images are created in.
+	// +kubebuilder:validation:Required
+	Namespace string `json:"namespace"`
+}
+
+// HarvesterDatacenterConfigStatus defines the observed state of HarvesterDatacenterConfig.
+type HarvesterDatacenterConfigStatus struct{}
+
+// HarvesterDatacenterConfig is the Schema for the HarvesterDatacenterConfigs API
+//
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+type HarvesterDatacenterConfig struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   HarvesterDatacenterConfigSpec   `json:"spec,omitempty"`
+	Status HarvesterDatacenterConfigStatus `json:"status,omitempty"`
+}
+
+// Kind returns the kind of the HarvesterDatacenterConfig.
+func (in *HarvesterDatacenterConfig) Kind() string {
+	return in.TypeMeta.Kind
+}
+
+// ExpectedKind returns the kind the HarvesterDatacenterConfig is expected to have.
+func (in *HarvesterDatacenterConfig) ExpectedKind() string {
+	return HarvesterDatacenterKind
+}
+
+// PauseReconcile pauses the reconciliation of the HarvesterDatacenterConfig.
+func (in *HarvesterDatacenterConfig) PauseReconcile() {
+	if in.Annotations == nil {
+		in.Annotations = map[string]string{}
+	}
+	in.Annotations[pausedAnnotation] = "true"
+}
+
+// IsReconcilePaused returns true if the HarvesterDatacenterConfig is paused.
+func (in *HarvesterDatacenterConfig) IsReconcilePaused() bool {
+	if s, ok := in.Annotations[pausedAnnotation]; ok {
+		return s == "true"
+	}
+	return false
+}
+
+// ClearPauseAnnotation removes the pause annotation from the HarvesterDatacenterConfig.
+func (in *HarvesterDatacenterConfig) ClearPauseAnnotation() {
+	if in.Annotations != nil {
+		delete(in.Annotations, pausedAnnotation)
+	}
+}
+
+// ConvertConfigToConfigGenerateStruct converts the HarvesterDatacenterConfig to HarvesterDatacenterConfigGenerate.
+func (in *HarvesterDatacenterConfig) ConvertConfigToConfigGenerateStruct() *HarvesterDatacenterConfigGenerate {
+	namespace := defaultEksaNamespace
+	if in.Namespace != "" {
+		namespace = in.Namespace
+	}
+	config := &HarvesterDatacenterConfigGenerate{
+		TypeMeta: in.TypeMeta,
+		ObjectMeta: ObjectMeta{
+			Name:        in.Name,
+			Annotations: in.Annotations,
+			Namespace:   namespace,
+		},
+		Spec: in.Spec,
+	}
+
+	return config
+}
+
+// Marshallable returns a Marshallable version of the HarvesterDatacenterConfig.
+func (in *HarvesterDatacenterConfig) Marshallable() Marshallable {
+	return in.ConvertConfigToConfigGenerateStruct()
+}
+
+// Validate validates the HarvesterDatacenterConfig.
+func (in *HarvesterDatacenterConfig) Validate() error {
+	if in.Spec.Server == "" {
+		return errors.New("HarvesterDatacenterConfig server is not set or is empty")
+	}
+
+	serverURL, err := url.ParseRequestURI(in.Spec.Server)
+	if err != nil || serverURL.Scheme != "https" || serverURL.Host == "" {
+		return fmt.Errorf("HarvesterDatacenterConfig server %s must be an https URL", in.Spec.Server)
+	}
+
+	if in.Spec.Namespace == "" {
+		return errors.New("HarvesterDatacenterConfig namespace is not set or is empty")
+	}
+
+	return nil
+}
+
+// HarvesterDatacenterConfigGenerate is same as HarvesterDatacenterConfig except stripped down for generation of yaml file during generate clusterconfig
+//
+// +kubebuilder:object:generate=false
+type HarvesterDatacenterConfigGenerate struct {
+	metav1.TypeMeta `json:",inline"`
+	ObjectMeta      `json:"metadata,omitempty"`
+
+	Spec HarvesterDatacenterConfigSpec `json:"spec,omitempty"`
+}
+
+// HarvesterDatacenterConfigList contains a list of HarvesterDatacenterConfig
+//
+// +kubebuilder:object:root=true
+type HarvesterDatacenterConfigList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []HarvesterDatacenterConfig `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&HarvesterDatacenterConfig{}, &HarvesterDatacenterConfigList{})
+}
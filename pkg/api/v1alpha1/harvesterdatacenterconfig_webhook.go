@@ -0,0 +1,116 @@
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// harvesterdatacenterconfiglog is for logging in this package.
+var harvesterdatacenterconfiglog = logf.Log.WithName("harvesterdatacenterconfig-resource")
+
+// SetupWebhookWithManager sets up the webhook with the manager.
+func (in *HarvesterDatacenterConfig) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(in).
+		WithValidator(in).
+		Complete()
+}
+
+//+kubebuilder:webhook:path=/validate-anywhere-eks-amazonaws-com-v1alpha1-harvesterdatacenterconfig,mutating=false,failurePolicy=fail,sideEffects=None,groups=anywhere.eks.amazonaws.com,resources=harvesterdatacenterconfigs,verbs=create;update,versions=v1alpha1,name=validation.harvesterdatacenterconfig.anywhere.amazonaws.com,admissionReviewVersions={v1,v1beta1}
+
+var _ webhook.CustomValidator = &HarvesterDatacenterConfig{}
+
+// ValidateCreate implements webhook.CustomValidator so a webhook will be registered for the type.
+func (in *HarvesterDatacenterConfig) ValidateCreate(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
+	harvesterConfig, ok := obj.(*HarvesterDatacenterConfig)
+	if !ok {
+		return nil, fmt.Errorf("expected a HarvesterDatacenterConfig but got %T", obj)
+	}
+
+	harvesterdatacenterconfiglog.Info("validate create", "name", harvesterConfig.Name)
+	if harvesterConfig.IsReconcilePaused() {
+		harvesterdatacenterconfiglog.Info("HarvesterDatacenterConfig is paused, allowing create", "name", harvesterConfig.Name)
+		return nil, nil
+	}
+
+	if err := harvesterConfig.Validate(); err != nil {
+		return nil, apierrors.NewInvalid(
+			GroupVersion.WithKind(HarvesterDatacenterKind).GroupKind(),
+			harvesterConfig.Name,
+			field.ErrorList{
+				field.Invalid(field.NewPath("spec"), harvesterConfig.Spec, err.Error()),
+			})
+	}
+
+	return nil, nil
+}
+
+// ValidateUpdate implements webhook.CustomValidator so a webhook will be registered for the type.
+func (in *HarvesterDatacenterConfig) ValidateUpdate(_ context.Context, old, obj runtime.Object) (admission.Warnings, error) {
+	harvesterConfig, ok := obj.(*HarvesterDatacenterConfig)
+	if !ok {
+		return nil, fmt.Errorf("expected a HarvesterDatacenterConfig but got %T", obj)
+	}
+
+	harvesterdatacenterconfiglog.Info("validate update", "name", harvesterConfig.Name)
+	oldDatacenterConfig, ok := old.(*HarvesterDatacenterConfig)
+	if !ok {
+		return nil, apierrors.NewBadRequest(fmt.Sprintf("expected a HarvesterDatacenterConfig but got a %T", old))
+	}
+
+	if oldDatacenterConfig.IsReconcilePaused() {
+		harvesterdatacenterconfiglog.Info("HarvesterDatacenterConfig is paused, allowing update", "name", harvesterConfig.Name)
+		return nil, nil
+	}
+
+	var allErrs field.ErrorList
+	allErrs = append(allErrs, validateImmutableFieldsHarvesterDatacenterConfig(harvesterConfig, oldDatacenterConfig)...)
+
+	if err := harvesterConfig.Validate(); err != nil {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("spec"), harvesterConfig.Spec, err.Error()))
+	}
+
+	if len(allErrs) > 0 {
+		return nil, apierrors.NewInvalid(
+			GroupVersion.WithKind(HarvesterDatacenterKind).GroupKind(),
+			harvesterConfig.Name,
+			allErrs)
+	}
+
+	return nil, nil
+}
+
+// ValidateDelete implements webhook.CustomValidator so a webhook will be registered for the type.
+func (in *HarvesterDatacenterConfig) ValidateDelete(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
+	harvesterConfig, ok := obj.(*HarvesterDatacenterConfig)
+	if !ok {
+		return nil, fmt.Errorf("expected a HarvesterDatacenterConfig but got %T", obj)
+	}
+
+	harvesterdatacenterconfiglog.Info("validate delete", "name", harvesterConfig.Name)
+
+	return nil, nil
+}
+
+func validateImmutableFieldsHarvesterDatacenterConfig(new, old *HarvesterDatacenterConfig) field.ErrorList {
+	var allErrs field.ErrorList
+	specPath := field.NewPath("spec")
+
+	if new.Spec.Server != old.Spec.Server {
+		allErrs = append(allErrs, field.Forbidden(specPath.Child("server"), "field is immutable"))
+	}
+
+	if new.Spec.Namespace != old.Spec.Namespace {
+		allErrs = append(allErrs, field.Forbidden(specPath.Child("namespace"), "field is immutable"))
+	}
+
+	return allErrs
+}
@@ -0,0 +1,99 @@
+package v1alpha1_test
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+)
+
+func TestHarvesterDatacenterConfigValidateCreate(t *testing.T) {
+	g := NewWithT(t)
+	dcConf := validHarvesterDatacenterConfig()
+	g.Expect(dcConf.ValidateCreate(context.Background(), dcConf)).Error().To(Succeed())
+}
+
+func TestHarvesterDatacenterConfigValidateCreateReconcilePaused(t *testing.T) {
+	g := NewWithT(t)
+	dcConf := validHarvesterDatacenterConfig()
+	dcConf.Spec.Namespace = ""
+	dcConf.PauseReconcile()
+	g.Expect(dcConf.ValidateCreate(context.Background(), dcConf)).Error().To(Succeed())
+}
+
+func TestHarvesterDatacenterConfigValidateCreateInvalid(t *testing.T) {
+	g := NewWithT(t)
+	dcConf := validHarvesterDatacenterConfig()
+	dcConf.Spec.Namespace = ""
+	g.Expect(dcConf.ValidateCreate(context.Background(), dcConf)).Error().To(MatchError(ContainSubstring("namespace is not set or is empty")))
+}
+
+func TestHarvesterDatacenterConfigValidateCreateCastFail(t *testing.T) {
+	g := NewWithT(t)
+	dcConf := validHarvesterDatacenterConfig()
+	g.Expect(dcConf.ValidateCreate(context.Background(), &v1alpha1.Cluster{})).Error().To(MatchError(ContainSubstring("expected a HarvesterDatacenterConfig")))
+}
+
+func TestHarvesterDatacenterConfigValidateUpdate(t *testing.T) {
+	tests := []struct {
+		name    string
+		update  func(*v1alpha1.HarvesterDatacenterConfig)
+		wantErr string
+	}{
+		{
+			name:   "unchanged",
+			update: func(*v1alpha1.HarvesterDatacenterConfig) {},
+		},
+		{
+			name:    "immutable server",
+			update:  func(c *v1alpha1.HarvesterDatacenterConfig) { c.Spec.Server = "https://harvester2.example.com:6443" },
+			wantErr: "spec.server: Forbidden: field is immutable",
+		},
+		{
+			name:    "immutable namespace",
+			update:  func(c *v1alpha1.HarvesterDatacenterConfig) { c.Spec.Namespace = "eksa" },
+			wantErr: "spec.namespace: Forbidden: field is immutable",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			oldConf := validHarvesterDatacenterConfig()
+			newConf := validHarvesterDatacenterConfig()
+			tt.update(newConf)
+
+			_, err := newConf.ValidateUpdate(context.Background(), oldConf, newConf)
+			if tt.wantErr == "" {
+				g.Expect(err).NotTo(HaveOccurred())
+			} else {
+				g.Expect(err).To(MatchError(ContainSubstring(tt.wantErr)))
+			}
+		})
+	}
+}
+
+func TestHarvesterDatacenterConfigValidateUpdateReconcilePaused(t *testing.T) {
+	g := NewWithT(t)
+	oldConf := validHarvesterDatacenterConfig()
+	oldConf.PauseReconcile()
+	newConf := validHarvesterDatacenterConfig()
+	newConf.Spec.Server = "https://harvester2.example.com:6443"
+	g.Expect(newConf.ValidateUpdate(context.Background(), oldConf, newConf)).Error().To(Succeed())
+}
+
+func TestHarvesterDatacenterConfigValidateUpdateCastFail(t *testing.T) {
+	g := NewWithT(t)
+	dcConf := validHarvesterDatacenterConfig()
+	g.Expect(dcConf.ValidateUpdate(context.Background(), &v1alpha1.Cluster{}, dcConf)).Error().To(MatchError(ContainSubstring("expected a HarvesterDatacenterConfig")))
+	g.Expect(dcConf.ValidateUpdate(context.Background(), dcConf, &v1alpha1.Cluster{})).Error().To(MatchError(ContainSubstring("expected a HarvesterDatacenterConfig")))
+}
+
+func TestHarvesterDatacenterConfigValidateDelete(t *testing.T) {
+	g := NewWithT(t)
+	dcConf := validHarvesterDatacenterConfig()
+	g.Expect(dcConf.ValidateDelete(context.Background(), dcConf)).Error().To(Succeed())
+	g.Expect(dcConf.ValidateDelete(context.Background(), &v1alpha1.Cluster{})).Error().To(HaveOccurred())
+}
@@ -0,0 +1,145 @@
+package v1alpha1
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// HarvesterMachineConfigKind is the kind for a HarvesterMachineConfig.
+	HarvesterMachineConfigKind = "HarvesterMachineConfig"
+
+	// DefaultHarvesterMachineConfigUser is the default username we set in machine config.
+	DefaultHarvesterMachineConfigUser string = "eksa"
+
+	defaultHarvesterOSFamily = Ubuntu
+
+	defaultHarvesterCPU         = 2
+	defaultHarvesterMemoryGiB   = 4
+	defaultHarvesterDiskSizeGiB = 40
+
+	minHarvesterDiskSizeGiB = 20
+)
+
+// NewHarvesterMachineConfigGenerate returns a new instance of HarvesterMachineConfigGenerate
+// used for generating yaml for generate clusterconfig command.
+func NewHarvesterMachineConfigGenerate(name string) *HarvesterMachineConfigGenerate {
+	return &HarvesterMachineConfigGenerate{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       HarvesterMachineConfigKind,
+			APIVersion: SchemeBuilder.GroupVersion.String(),
+		},
+		ObjectMeta: ObjectMeta{
+			Name: name,
+		},
+		Spec: HarvesterMachineConfigSpec{
+			OSFamily: defaultHarvesterOSFamily,
+			Users: []UserConfiguration{
+				{
+					Name:              DefaultHarvesterMachineConfigUser,
+					SshAuthorizedKeys: []string{"ssh-rsa AAAA..."},
+				},
+			},
+			CPU:         defaultHarvesterCPU,
+			MemoryGiB:   defaultHarvesterMemoryGiB,
+			DiskSizeGiB: defaultHarvesterDiskSizeGiB,
+			Image:       "<enter image name here>",
+			Networks:    []string{"<enter VM network name here>"},
+		},
+	}
+}
+
+func (c *HarvesterMachineConfigGenerate) APIVersion() string {
+	return c.TypeMeta.APIVersion
+}
+
+func (c *HarvesterMachineConfigGenerate) Kind() string {
+	return c.TypeMeta.Kind
+}
+
+func (c *HarvesterMachineConfigGenerate) Name() string {
+	return c.ObjectMeta.Name
+}
+
+func setHarvesterMachineConfigDefaults(machineConfig *HarvesterMachineConfig) {
+	if len(machineConfig.Spec.Users) == 0 {
+		machineConfig.Spec.Users = []UserConfiguration{{}}
+	}
+
+	if machineConfig.Spec.Users[0].Name == "" {
+		machineConfig.Spec.Users[0].Name = DefaultHarvesterMachineConfigUser
+	}
+
+	if len(machineConfig.Spec.Users[0].SshAuthorizedKeys) == 0 {
+		machineConfig.Spec.Users[0].SshAuthorizedKeys = []string{""}
+	}
+
+	if machineConfig.Spec.OSFamily == "" {
+		machineConfig.Spec.OSFamily = defaultHarvesterOSFamily
+	}
+
+	if machineConfig.Spec.CPU == 0 {
+		machineConfig.Spec.CPU = defaultHarvesterCPU
+	}
+
+	if machineConfig.Spec.MemoryGiB == 0 {
+		machineConfig.Spec.MemoryGiB = defaultHarvesterMemoryGiB
+	}
+
+	if machineConfig.Spec.DiskSizeGiB == 0 {
+		machineConfig.Spec.DiskSizeGiB = defaultHarvesterDiskSizeGiB
+	}
+}
+
+func validateHarvesterMachineConfig(c *HarvesterMachineConfig) error {
+	if err := validateObjectMeta(c.ObjectMeta); err != nil {
+		return fmt.Errorf("HarvesterMachineConfig: %v", err)
+	}
+
+	if c.Spec.OSFamily != Ubuntu {
+		return fmt.Errorf("HarvesterMachineConfig: unsupported spec.osFamily (%v); Please use one of the following: %s", c.Spec.OSFamily, Ubuntu)
+	}
+
+	if c.Spec.CPU < 1 {
+		return errors.New("HarvesterMachineConfig: cpu must be greater than 0")
+	}
+
+	if c.Spec.MemoryGiB < 1 {
+		return errors.New("HarvesterMachineConfig: memoryGiB must be greater than 0")
+	}
+
+	if c.Spec.DiskSizeGiB < minHarvesterDiskSizeGiB {
+		return fmt.Errorf("HarvesterMachineConfig: diskSizeGiB must be greater than or equal to %d", minHarvesterDiskSizeGiB)
+	}
+
+	if c.Spec.Image == "" {
+		return errors.New("HarvesterMachineConfig: image is not set or is empty")
+	}
+
+	if c.Spec.ImageURL != "" {
+		imageURL, err := url.ParseRequestURI(c.Spec.ImageURL)
+		if err != nil || (imageURL.Scheme != "http" && imageURL.Scheme != "https") {
+			return fmt.Errorf("HarvesterMachineConfig: imageURL %s must be an http or https URL", c.Spec.ImageURL)
+		}
+	}
+
+	if len(c.Spec.Networks) == 0 {
+		return errors.New("HarvesterMachineConfig: networks must contain at least one network")
+	}
+
+	for _, network := range c.Spec.Networks {
+		if network == "" || strings.Count(network, "/") > 1 {
+			return fmt.Errorf("HarvesterMachineConfig: network %q must be a name or namespace/name", network)
+		}
+	}
+
+	if len(c.Spec.Users) == 0 || c.Spec.Users[0].Name == "" {
+		return fmt.Errorf("HarvesterMachineConfig: users[0].name is not set or is empty for %s", c.Name)
+	}
+
+	return nil
+}
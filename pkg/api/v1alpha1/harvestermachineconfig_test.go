@@ -0,0 +1,117 @@
+package v1alpha1_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+)
+
+func TestHarvesterMachineConfigSetDefaults(t *testing.T) {
+	g := NewWithT(t)
+	config := &v1alpha1.HarvesterMachineConfig{}
+	config.SetDefaults()
+
+	g.Expect(config.Spec).To(Equal(v1alpha1.HarvesterMachineConfigSpec{
+		OSFamily: v1alpha1.Ubuntu,
+		Users: []v1alpha1.UserConfiguration{
+			{Name: v1alpha1.DefaultHarvesterMachineConfigUser, SshAuthorizedKeys: []string{""}},
+		},
+		CPU:         2,
+		MemoryGiB:   4,
+		DiskSizeGiB: 40,
+	}))
+}
+
+func TestHarvesterMachineConfigValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		update  func(*v1alpha1.HarvesterMachineConfig)
+		wantErr string
+	}{
+		{
+			name:   "valid",
+			update: func(*v1alpha1.HarvesterMachineConfig) {},
+		},
+		{
+			name: "image url and namespaced network",
+			update: func(c *v1alpha1.HarvesterMachineConfig) {
+				c.Spec.ImageURL = "https://cloud-images.example.com/ubuntu.img"
+				c.Spec.Networks = []string{"vm-networks/eksa-net", "storage-net"}
+			},
+		},
+		{
+			name:    "unsupported os family",
+			update:  func(c *v1alpha1.HarvesterMachineConfig) { c.Spec.OSFamily = v1alpha1.Bottlerocket },
+			wantErr: "HarvesterMachineConfig: unsupported spec.osFamily (bottlerocket); Please use one of the following: ubuntu",
+		},
+		{
+			name:    "negative cpu",
+			update:  func(c *v1alpha1.HarvesterMachineConfig) { c.Spec.CPU = -1 },
+			wantErr: "HarvesterMachineConfig: cpu must be greater than 0",
+		},
+		{
+			name:    "negative memory",
+			update:  func(c *v1alpha1.HarvesterMachineConfig) { c.Spec.MemoryGiB = -1 },
+			wantErr: "HarvesterMachineConfig: memoryGiB must be greater than 0",
+		},
+		{
+			name:    "disk too small",
+			update:  func(c *v1alpha1.HarvesterMachineConfig) { c.Spec.DiskSizeGiB = 10 },
+			wantErr: "HarvesterMachineConfig: diskSizeGiB must be greater than or equal to 20",
+		},
+		{
+			name:    "empty image",
+			update:  func(c *v1alpha1.HarvesterMachineConfig) { c.Spec.Image = "" },
+			wantErr: "HarvesterMachineConfig: image is not set or is empty",
+		},
+		{
+			name:    "invalid image url",
+			update:  func(c *v1alpha1.HarvesterMachineConfig) { c.Spec.ImageURL = "ftp://images.example.com/ubuntu.img" },
+			wantErr: "HarvesterMachineConfig: imageURL ftp://images.example.com/ubuntu.img must be an http or https URL",
+		},
+		{
+			name:    "no networks",
+			update:  func(c *v1alpha1.HarvesterMachineConfig) { c.Spec.Networks = nil },
+			wantErr: "HarvesterMachineConfig: networks must contain at least one network",
+		},
+		{
+			name:    "invalid network",
+			update:  func(c *v1alpha1.HarvesterMachineConfig) { c.Spec.Networks = []string{"a/b/c"} },
+			wantErr: `HarvesterMachineConfig: network "a/b/c" must be a name or namespace/name`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			config := &v1alpha1.HarvesterMachineConfig{
+				Spec: v1alpha1.HarvesterMachineConfigSpec{
+					Image:    "ubuntu-2204-kube-v1-31",
+					Networks: []string{"eksa-net"},
+				},
+			}
+			config.Name = "test"
+			config.SetDefaults()
+			tt.update(config)
+
+			err := config.Validate()
+			if tt.wantErr == "" {
+				g.Expect(err).NotTo(HaveOccurred())
+			} else {
+				g.Expect(err).To(MatchError(tt.wantErr))
+			}
+		})
+	}
+}
+
+func TestNewHarvesterMachineConfigGenerate(t *testing.T) {
+	g := NewWithT(t)
+	config := v1alpha1.NewHarvesterMachineConfigGenerate("test-cp")
+
+	g.Expect(config.Kind()).To(Equal(v1alpha1.HarvesterMachineConfigKind))
+	g.Expect(config.Name()).To(Equal("test-cp"))
+	g.Expect(config.Spec.OSFamily).To(Equal(v1alpha1.Ubuntu))
+	g.Expect(config.Spec.Users[0].Name).To(Equal(v1alpha1.DefaultHarvesterMachineConfigUser))
+}
@@ -0,0 +1,197 @@
+// Important: Run "make generate" to regenerate code after modifying this file
+// json tags are required; new fields must have json tags for the fields to be serialized
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// HarvesterMachineConfigSpec defines the desired state of HarvesterMachineConfig.
+type HarvesterMachineConfigSpec struct {
+	OSFamily OSFamily            `json:"osFamily"`
+	Users    []UserConfiguration `json:"users,omitempty"`
+
+	// CPU is the number of CPU cores of the VMs.
+	// +optional
+	CPU int32 `json:"cpu,omitempty"`
+
+	// MemoryGiB is the memory of the VMs in GiB.
+	// +optional
+	MemoryGiB int32 `json:"memoryGiB,omitempty"`
+
+	// DiskSizeGiB is the size of the root disk of the VMs in GiB.
+	// +optional
+	DiskSizeGiB int32 `json:"diskSizeGiB,omitempty"`
+
+	// Image is the name of the Harvester VM image in the datacenter namespace the root disk is created from.
+	// The image must be built for the cluster's Kubernetes version.
+	// +kubebuilder:validation:Required
+	Image string `json:"image"`
+
+	// ImageURL is the URL the image is uploaded from when it does not exist in Harvester yet.
+	// +optional
+	ImageURL string `json:"imageURL,omitempty"`
+
+	// Networks is the list of Harvester VM networks the VMs are attached to, either as a name in the
+	// datacenter namespace or as namespace/name. The first network is the one the node IP is taken from.
+	// +kubebuilder:validation:MinItems=1
+	Networks []string `json:"networks"`
+}
+
+// SetDefaults sets defaults to HarvesterMachineConfig if user has not provided.
+func (in *HarvesterMachineConfig) SetDefaults() {
+	setHarvesterMachineConfigDefaults(in)
+}
+
+// PauseReconcile pauses the reconciliation of the HarvesterMachineConfig.
+func (in *HarvesterMachineConfig) PauseReconcile() {
+	if in.Annotations == nil {
+		in.Annotations = map[string]string{}
+	}
+	in.Annotations[pausedAnnotation] = "true"
+}
+
+// IsReconcilePaused returns true if the HarvesterMachineConfig is paused.
+func (in *HarvesterMachineConfig) IsReconcilePaused() bool {
+	if s, ok := in.Annotations[pausedAnnotation]; ok {
+		return s == "true"
+	}
+	return false
+}
+
+// SetControlPlane sets the HarvesterMachineConfig as a control plane node.
+func (in *HarvesterMachineConfig) SetControlPlane() {
+	if in.Annotations == nil {
+		in.Annotations = map[string]string{}
+	}
+	in.Annotations[controlPlaneAnnotation] = "true"
+}
+
+// IsControlPlane returns true if the HarvesterMachineConfig is a control plane node.
+func (in *HarvesterMachineConfig) IsControlPlane() bool {
+	if s, ok := in.Annotations[controlPlaneAnnotation]; ok {
+		return s == "true"
+	}
+	return false
+}
+
+// SetEtcd sets the HarvesterMachineConfig as an etcd node.
+func (in *HarvesterMachineConfig) SetEtcd() {
+	if in.Annotations == nil {
+		in.Annotations = map[string]string{}
+	}
+	in.Annotations[etcdAnnotation] = "true"
+}
+
+// IsEtcd returns true if the HarvesterMachineConfig is an etcd node.
+func (in *HarvesterMachineConfig) IsEtcd() bool {
+	if s, ok := in.Annotations[etcdAnnotation]; ok {
+		return s == "true"
+	}
+	return false
+}
+
+// SetManagedBy sets the cluster name that manages the HarvesterMachineConfig.
+func (in *HarvesterMachineConfig) SetManagedBy(clusterName string) {
+	if in.Annotations == nil {
+		in.Annotations = map[string]string{}
+	}
+	in.Annotations[managementAnnotation] = clusterName
+}
+
+// IsManaged returns true if the HarvesterMachineConfig is managed by a cluster.
+func (in *HarvesterMachineConfig) IsManaged() bool {
+	if s, ok := in.Annotations[managementAnnotation]; ok {
+		return s != ""
+	}
+	return false
+}
+
+// OSFamily returns the OSFamily of the HarvesterMachineConfig.
+func (in *HarvesterMachineConfig) OSFamily() OSFamily {
+	return in.Spec.OSFamily
+}
+
+// Users returns a list of configuration for OS users.
+func (in *HarvesterMachineConfig) Users() []UserConfiguration {
+	return in.Spec.Users
+}
+
+// GetNamespace returns the namespace of the HarvesterMachineConfig.
+func (in *HarvesterMachineConfig) GetNamespace() string {
+	return in.Namespace
+}
+
+// GetName returns the name of the HarvesterMachineConfig.
+func (in *HarvesterMachineConfig) GetName() string {
+	return in.Name
+}
+
+// HarvesterMachineConfigStatus defines the observed state of HarvesterMachineConfig.
+type HarvesterMachineConfigStatus struct{}
+
+// HarvesterMachineConfig is the Schema for the azure stack hci machine configs API
+//
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+type HarvesterMachineConfig struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   HarvesterMachineConfigSpec   `json:"spec,omitempty"`
+	Status HarvesterMachineConfigStatus `json:"status,omitempty"`
+}
+
+// ConvertConfigToConfigGenerateStruct converts the HarvesterMachineConfig to HarvesterMachineConfigGenerate.
+func (in *HarvesterMachineConfig) ConvertConfigToConfigGenerateStruct() *HarvesterMachineConfigGenerate {
+	namespace := defaultEksaNamespace
+	if in.Namespace != "" {
+		namespace = in.Namespace
+	}
+	config := &HarvesterMachineConfigGenerate{
+		TypeMeta: in.TypeMeta,
+		ObjectMeta: ObjectMeta{
+			Name:        in.Name,
+			Annotations: in.Annotations,
+			Namespace:   namespace,
+		},
+		Spec: in.Spec,
+	}
+
+	return config
+}
+
+// Marshallable returns a Marshallable version of the HarvesterMachineConfig.
+func (in *HarvesterMachineConfig) Marshallable() Marshallable {
+	return in.ConvertConfigToConfigGenerateStruct()
+}
+
+// Validate validates the HarvesterMachineConfig.
+func (in *HarvesterMachineConfig) Validate() error {
+	return validateHarvesterMachineConfig(in)
+}
+
+// HarvesterMachineConfigGenerate is same as HarvesterMachineConfig except stripped down for generation of yaml file during
+// generate clusterconfig
+//
+// +kubebuilder:object:generate=false
+type HarvesterMachineConfigGenerate struct {
+	metav1.TypeMeta `json:",inline"`
+	ObjectMeta      `json:"metadata,omitempty"`
+
+	Spec HarvesterMachineConfigSpec `json:"spec,omitempty"`
+}
+
+// HarvesterMachineConfigList contains a list of HarvesterMachineConfig
+//
+// +kubebuilder:object:root=true
+type HarvesterMachineConfigList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []HarvesterMachineConfig `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&HarvesterMachineConfig{}, &HarvesterMachineConfigList{})
+}
@@ -0,0 +1,127 @@
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// harvestermachineconfiglog is for logging in this package.
+var harvestermachineconfiglog = logf.Log.WithName("harvestermachineconfig-resource")
+
+// SetupWebhookWithManager sets up and registers the webhook with the manager.
+func (in *HarvesterMachineConfig) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(in).
+		WithValidator(in).
+		Complete()
+}
+
+//+kubebuilder:webhook:path=/validate-anywhere-eks-amazonaws-com-v1alpha1-harvestermachineconfig,mutating=false,failurePolicy=fail,sideEffects=None,groups=anywhere.eks.amazonaws.com,resources=harvestermachineconfigs,verbs=create;update,versions=v1alpha1,name=validation.harvestermachineconfig.anywhere.amazonaws.com,admissionReviewVersions={v1,v1beta1}
+
+var _ webhook.CustomValidator = &HarvesterMachineConfig{}
+
+// ValidateCreate implements webhook.CustomValidator so a webhook will be registered for the type.
+func (in *HarvesterMachineConfig) ValidateCreate(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
+	harvesterConfig, ok := obj.(*HarvesterMachineConfig)
+	if !ok {
+		return nil, fmt.Errorf("expected a HarvesterMachineConfig but got %T", obj)
+	}
+
+	harvestermachineconfiglog.Info("validate create", "name", harvesterConfig.Name)
+	if err := harvesterConfig.Validate(); err != nil {
+		return nil, apierrors.NewInvalid(
+			GroupVersion.WithKind(HarvesterMachineConfigKind).GroupKind(),
+			harvesterConfig.Name,
+			field.ErrorList{
+				field.Invalid(field.NewPath("spec"), harvesterConfig.Spec, err.Error()),
+			},
+		)
+	}
+
+	return nil, nil
+}
+
+// ValidateUpdate implements webhook.CustomValidator so a webhook will be registered for the type.
+func (in *HarvesterMachineConfig) ValidateUpdate(_ context.Context, old, obj runtime.Object) (admission.Warnings, error) {
+	harvesterConfig, ok := obj.(*HarvesterMachineConfig)
+	if !ok {
+		return nil, fmt.Errorf("expected a HarvesterMachineConfig but got %T", obj)
+	}
+
+	harvestermachineconfiglog.Info("validate update", "name", harvesterConfig.Name)
+
+	oldHarvesterMachineConfig, ok := old.(*HarvesterMachineConfig)
+	if !ok {
+		return nil, apierrors.NewBadRequest(fmt.Sprintf("expected a HarvesterMachineConfig but got a %T", old))
+	}
+
+	var allErrs field.ErrorList
+	allErrs = append(allErrs, validateImmutableFieldsHarvesterMachineConfig(harvesterConfig, oldHarvesterMachineConfig)...)
+
+	if err := harvesterConfig.Validate(); err != nil {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("spec"), harvesterConfig.Spec, err.Error()))
+	}
+
+	if len(allErrs) > 0 {
+		return nil, apierrors.NewInvalid(
+			GroupVersion.WithKind(HarvesterMachineConfigKind).GroupKind(),
+			harvesterConfig.Name,
+			allErrs,
+		)
+	}
+
+	return nil, nil
+}
+
+// ValidateDelete implements webhook.CustomValidator so a webhook will be registered for the type.
+func (in *HarvesterMachineConfig) ValidateDelete(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
+	harvesterConfig, ok := obj.(*HarvesterMachineConfig)
+	if !ok {
+		return nil, fmt.Errorf("expected a HarvesterMachineConfig but got %T", obj)
+	}
+
+	harvestermachineconfiglog.Info("validate delete", "name", harvesterConfig.Name)
+
+	return nil, nil
+}
+
+func validateImmutableFieldsHarvesterMachineConfig(new, old *HarvesterMachineConfig) field.ErrorList {
+	if old.IsReconcilePaused() {
+		harvestermachineconfiglog.Info("Reconciliation is paused")
+		return nil
+	}
+
+	var allErrs field.ErrorList
+	specPath := field.NewPath("spec")
+
+	if new.Spec.OSFamily != old.Spec.OSFamily {
+		allErrs = append(allErrs, field.Forbidden(specPath.Child("osFamily"), "field is immutable"))
+	}
+
+	if old.IsManaged() {
+		harvestermachineconfiglog.Info("Machine config is associated with workload cluster", "name", old.Name)
+		return allErrs
+	}
+
+	if !old.IsEtcd() && !old.IsControlPlane() {
+		harvestermachineconfiglog.Info("Machine config is associated with management cluster's worker nodes", "name", old.Name)
+		return allErrs
+	}
+
+	harvestermachineconfiglog.Info("Machine config is associated with management cluster's control plane or etcd", "name", old.Name)
+
+	if !reflect.DeepEqual(new.Spec.Users, old.Spec.Users) {
+		allErrs = append(allErrs, field.Forbidden(specPath.Child("users"), "field is immutable"))
+	}
+
+	return allErrs
+}
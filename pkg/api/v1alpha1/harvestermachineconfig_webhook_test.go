@@ -0,0 +1,129 @@
+package v1alpha1_test
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+)
+
+func harvesterMachineConfig() *v1alpha1.HarvesterMachineConfig {
+	config := &v1alpha1.HarvesterMachineConfig{
+		Spec: v1alpha1.HarvesterMachineConfigSpec{
+			Image:    "ubuntu-2204-kube-v1-31",
+			Networks: []string{"eksa-net"},
+		},
+	}
+	config.Name = "test"
+	config.SetDefaults()
+	return config
+}
+
+func TestHarvesterMachineConfigValidateCreate(t *testing.T) {
+	g := NewWithT(t)
+	config := harvesterMachineConfig()
+	g.Expect(config.ValidateCreate(context.Background(), config)).Error().To(Succeed())
+}
+
+func TestHarvesterMachineConfigValidateCreateInvalid(t *testing.T) {
+	g := NewWithT(t)
+	config := harvesterMachineConfig()
+	config.Spec.Image = ""
+	g.Expect(config.ValidateCreate(context.Background(), config)).Error().To(MatchError(ContainSubstring("image is not set or is empty")))
+}
+
+func TestHarvesterMachineConfigValidateCreateCastFail(t *testing.T) {
+	g := NewWithT(t)
+	config := harvesterMachineConfig()
+	g.Expect(config.ValidateCreate(context.Background(), &v1alpha1.Cluster{})).Error().To(MatchError(ContainSubstring("expected a HarvesterMachineConfig")))
+}
+
+func TestHarvesterMachineConfigValidateUpdate(t *testing.T) {
+	tests := []struct {
+		name    string
+		old     func(*v1alpha1.HarvesterMachineConfig)
+		update  func(*v1alpha1.HarvesterMachineConfig)
+		wantErr string
+	}{
+		{
+			name: "mutable image and cpu",
+			old:  func(*v1alpha1.HarvesterMachineConfig) {},
+			update: func(c *v1alpha1.HarvesterMachineConfig) {
+				c.Spec.Image = "ubuntu-2204-kube-v1-32"
+				c.Spec.CPU = 4
+			},
+		},
+		{
+			name:    "immutable os family",
+			old:     func(*v1alpha1.HarvesterMachineConfig) {},
+			update:  func(c *v1alpha1.HarvesterMachineConfig) { c.Spec.OSFamily = v1alpha1.Bottlerocket },
+			wantErr: "spec.osFamily: Forbidden: field is immutable",
+		},
+		{
+			name:    "immutable users of management control plane",
+			old:     func(c *v1alpha1.HarvesterMachineConfig) { c.SetControlPlane() },
+			update:  func(c *v1alpha1.HarvesterMachineConfig) { c.Spec.Users[0].Name = "admin" },
+			wantErr: "spec.users: Forbidden: field is immutable",
+		},
+		{
+			name: "mutable users of workload control plane",
+			old: func(c *v1alpha1.HarvesterMachineConfig) {
+				c.SetControlPlane()
+				c.SetManagedBy("mgmt")
+			},
+			update: func(c *v1alpha1.HarvesterMachineConfig) { c.Spec.Users[0].Name = "admin" },
+		},
+		{
+			name:   "mutable users of management workers",
+			old:    func(*v1alpha1.HarvesterMachineConfig) {},
+			update: func(c *v1alpha1.HarvesterMachineConfig) { c.Spec.Users[0].Name = "admin" },
+		},
+		{
+			name: "paused",
+			old: func(c *v1alpha1.HarvesterMachineConfig) {
+				c.SetControlPlane()
+				c.PauseReconcile()
+			},
+			update: func(c *v1alpha1.HarvesterMachineConfig) { c.Spec.Users[0].Name = "admin" },
+		},
+		{
+			name:    "invalid spec",
+			old:     func(*v1alpha1.HarvesterMachineConfig) {},
+			update:  func(c *v1alpha1.HarvesterMachineConfig) { c.Spec.Networks = nil },
+			wantErr: "networks must contain at least one network",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			oldConf := harvesterMachineConfig()
+			tt.old(oldConf)
+			newConf := oldConf.DeepCopy()
+			tt.update(newConf)
+
+			_, err := newConf.ValidateUpdate(context.Background(), oldConf, newConf)
+			if tt.wantErr == "" {
+				g.Expect(err).NotTo(HaveOccurred())
+			} else {
+				g.Expect(err).To(MatchError(ContainSubstring(tt.wantErr)))
+			}
+		})
+	}
+}
+
+func TestHarvesterMachineConfigValidateUpdateCastFail(t *testing.T) {
+	g := NewWithT(t)
+	config := harvesterMachineConfig()
+	g.Expect(config.ValidateUpdate(context.Background(), &v1alpha1.Cluster{}, config)).Error().To(MatchError(ContainSubstring("expected a HarvesterMachineConfig")))
+	g.Expect(config.ValidateUpdate(context.Background(), config, &v1alpha1.Cluster{})).Error().To(MatchError(ContainSubstring("expected a HarvesterMachineConfig")))
+}
+
+func TestHarvesterMachineConfigValidateDelete(t *testing.T) {
+	g := NewWithT(t)
+	config := harvesterMachineConfig()
+	g.Expect(config.ValidateDelete(context.Background(), config)).Error().To(Succeed())
+	g.Expect(config.ValidateDelete(context.Background(), &v1alpha1.Cluster{})).Error().To(HaveOccurred())
+}
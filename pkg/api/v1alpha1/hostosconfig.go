@@ -2,13 +2,16 @@ package v1alpha1
 
 import (
 	"crypto/x509"
+	"encoding/base64"
 	"encoding/pem"
 	"fmt"
 	"net"
 	"net/url"
 	"strings"
 
+	"github.com/pelletier/go-toml/v2"
 	"github.com/pkg/errors"
+	utilvalidation "k8s.io/apimachinery/pkg/util/validation"
 	"sigs.k8s.io/cluster-api/api/bootstrap/kubeadm/v1beta2"
 )
 
@@ -27,9 +30,51 @@ func validateHostOSConfig(config *HostOSConfiguration, osFamily OSFamily) error
 		}
 	}
 
+	if err := validateContainerdConfiguration(config.ContainerdConfiguration, osFamily); err != nil {
+		return err
+	}
+
 	return validateBotterocketConfig(config.BottlerocketConfiguration, osFamily)
 }
 
+func validateContainerdConfiguration(config *ContainerdConfiguration, osFamily OSFamily) error {
+	if config == nil {
+		return nil
+	}
+
+	if osFamily == Bottlerocket {
+		return fmt.Errorf("ContainerdConfiguration can not be used with osFamily: \"%s\"", Bottlerocket)
+	}
+
+	if len(config.ConfigDropIns) == 0 {
+		return errors.New("ContainerdConfiguration.ConfigDropIns can not be empty")
+	}
+
+	names := map[string]struct{}{}
+	for _, dropIn := range config.ConfigDropIns {
+		if errs := utilvalidation.IsDNS1123Label(dropIn.Name); len(errs) > 0 {
+			return fmt.Errorf("containerd configDropIns name %q is invalid: %s", dropIn.Name, strings.Join(errs, ", "))
+		}
+		if _, ok := names[dropIn.Name]; ok {
+			return fmt.Errorf("containerd configDropIns name %s is duplicated", dropIn.Name)
+		}
+		names[dropIn.Name] = struct{}{}
+
+		content := map[string]interface{}{}
+		if err := toml.Unmarshal([]byte(dropIn.Content), &content); err != nil {
+			return fmt.Errorf("containerd configDropIns %s content is not valid TOML: %v", dropIn.Name, err)
+		}
+		// The main configuration owns the imports and the configuration version.
+		for _, key := range []string{"imports", "version"} {
+			if _, ok := content[key]; ok {
+				return fmt.Errorf("containerd configDropIns %s can not set %s", dropIn.Name, key)
+			}
+		}
+	}
+
+	return nil
+}
+
 func validateNTPServers(config *NTPConfiguration) error {
 	if config == nil {
 		return nil
@@ -95,7 +140,73 @@ func validateBotterocketConfig(config *BottlerocketConfiguration, osFamily OSFam
 		return err
 	}
 
-	return validateBottlerocketBootSettingsConfiguration(config.Boot)
+	if err := validateBottlerocketBootSettingsConfiguration(config.Boot); err != nil {
+		return err
+	}
+
+	return validateBottlerocketContainers(config)
+}
+
+// reservedBottlerocketContainerNames are the containers configured by EKS Anywhere and Cluster API.
+var reservedBottlerocketContainerNames = []string{"admin", "control", "kubeadm-bootstrap", "bottlerocket-bootstrap-snow"}
+
+func validateBottlerocketContainers(config *BottlerocketConfiguration) error {
+	names := map[string]struct{}{}
+	for _, c := range config.BootstrapContainers {
+		if err := validateBottlerocketContainer("bootstrapContainers", c.Name, c.Image, c.UserData, names); err != nil {
+			return err
+		}
+		switch c.Mode {
+		case "", BottlerocketBootstrapContainerModeAlways, BottlerocketBootstrapContainerModeOnce, BottlerocketBootstrapContainerModeOff:
+		default:
+			return fmt.Errorf("bootstrapContainers %s mode %s is invalid, must be one of %s, %s or %s", c.Name, c.Mode,
+				BottlerocketBootstrapContainerModeAlways, BottlerocketBootstrapContainerModeOnce, BottlerocketBootstrapContainerModeOff)
+		}
+	}
+
+	names = map[string]struct{}{}
+	for _, c := range config.HostContainers {
+		if err := validateBottlerocketContainer("hostContainers", c.Name, c.Image, c.UserData, names); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func validateBottlerocketContainer(field, name, image, userData string, names map[string]struct{}) error {
+	if errs := utilvalidation.IsDNS1123Label(name); len(errs) > 0 {
+		return fmt.Errorf("%s name %q is invalid: %s", field, name, strings.Join(errs, ", "))
+	}
+	for _, reserved := range reservedBottlerocketContainerNames {
+		if name == reserved {
+			return fmt.Errorf("%s name %s is reserved", field, name)
+		}
+	}
+	if _, ok := names[name]; ok {
+		return fmt.Errorf("%s name %s is duplicated", field, name)
+	}
+	names[name] = struct{}{}
+
+	if _, tag := SplitContainerImage(image); tag == "" {
+		return fmt.Errorf("%s %s image %q must include a tag or digest", field, name, image)
+	}
+
+	if _, err := base64.StdEncoding.DecodeString(userData); err != nil {
+		return fmt.Errorf("%s %s userData must be base64 encoded: %v", field, name, err)
+	}
+
+	return nil
+}
+
+// SplitContainerImage splits a container image in its repository and its tag or digest.
+// The tag is empty if the image doesn't have any.
+func SplitContainerImage(image string) (repository, tag string) {
+	i := strings.LastIndex(image, ":")
+	if i <= strings.LastIndex(image, "/") {
+		return image, ""
+	}
+	return image[:i], image[i+1:]
 }
 
 func validateBottlerocketKubernetesConfig(config *v1beta2.BottlerocketKubernetesSettings) error {
@@ -406,6 +406,190 @@ OelAabtJKd8B2BUsR7JRIN8=
 			osFamily: Bottlerocket,
 			wantErr:  "",
 		},
+		{
+			name: "valid bottlerocket containers",
+			hostOSConfig: &HostOSConfiguration{
+				BottlerocketConfiguration: &BottlerocketConfiguration{
+					BootstrapContainers: []BottlerocketBootstrapContainer{
+						{
+							Name:      "setup",
+							Image:     "public.ecr.aws/setup:v1",
+							Mode:      BottlerocketBootstrapContainerModeOnce,
+							Essential: true,
+							UserData:  "ZWNobyBoZWxsbw==",
+						},
+					},
+					HostContainers: []BottlerocketHostContainer{
+						{
+							Name:         "agent",
+							Image:        "registry:5000/security/agent@sha256:abcd",
+							Superpowered: true,
+						},
+						{
+							Name:  "setup",
+							Image: "public.ecr.aws/setup:v1",
+						},
+					},
+				},
+			},
+			osFamily: Bottlerocket,
+			wantErr:  "",
+		},
+		{
+			name: "reserved host container name",
+			hostOSConfig: &HostOSConfiguration{
+				BottlerocketConfiguration: &BottlerocketConfiguration{
+					HostContainers: []BottlerocketHostContainer{
+						{Name: "admin", Image: "public.ecr.aws/admin:v1"},
+					},
+				},
+			},
+			osFamily: Bottlerocket,
+			wantErr:  "hostContainers name admin is reserved",
+		},
+		{
+			name: "invalid bootstrap container name",
+			hostOSConfig: &HostOSConfiguration{
+				BottlerocketConfiguration: &BottlerocketConfiguration{
+					BootstrapContainers: []BottlerocketBootstrapContainer{
+						{Name: "Setup", Image: "public.ecr.aws/setup:v1"},
+					},
+				},
+			},
+			osFamily: Bottlerocket,
+			wantErr:  "bootstrapContainers name \"Setup\" is invalid",
+		},
+		{
+			name: "duplicated host container name",
+			hostOSConfig: &HostOSConfiguration{
+				BottlerocketConfiguration: &BottlerocketConfiguration{
+					HostContainers: []BottlerocketHostContainer{
+						{Name: "agent", Image: "public.ecr.aws/agent:v1"},
+						{Name: "agent", Image: "public.ecr.aws/agent:v2"},
+					},
+				},
+			},
+			osFamily: Bottlerocket,
+			wantErr:  "hostContainers name agent is duplicated",
+		},
+		{
+			name: "container image without tag",
+			hostOSConfig: &HostOSConfiguration{
+				BottlerocketConfiguration: &BottlerocketConfiguration{
+					HostContainers: []BottlerocketHostContainer{
+						{Name: "agent", Image: "registry:5000/agent"},
+					},
+				},
+			},
+			osFamily: Bottlerocket,
+			wantErr:  "hostContainers agent image \"registry:5000/agent\" must include a tag or digest",
+		},
+		{
+			name: "invalid container user data",
+			hostOSConfig: &HostOSConfiguration{
+				BottlerocketConfiguration: &BottlerocketConfiguration{
+					BootstrapContainers: []BottlerocketBootstrapContainer{
+						{Name: "setup", Image: "public.ecr.aws/setup:v1", UserData: "echo hello"},
+					},
+				},
+			},
+			osFamily: Bottlerocket,
+			wantErr:  "bootstrapContainers setup userData must be base64 encoded",
+		},
+		{
+			name: "invalid bootstrap container mode",
+			hostOSConfig: &HostOSConfiguration{
+				BottlerocketConfiguration: &BottlerocketConfiguration{
+					BootstrapContainers: []BottlerocketBootstrapContainer{
+						{Name: "setup", Image: "public.ecr.aws/setup:v1", Mode: "never"},
+					},
+				},
+			},
+			osFamily: Bottlerocket,
+			wantErr:  "bootstrapContainers setup mode never is invalid, must be one of always, once or off",
+		},
+		{
+			name: "valid containerd config drop-ins",
+			hostOSConfig: &HostOSConfiguration{
+				ContainerdConfiguration: &ContainerdConfiguration{
+					ConfigDropIns: []ContainerdConfigDropIn{
+						{Name: "gvisor", Content: "[plugins.\"io.containerd.grpc.v1.cri\".containerd.runtimes.runsc]\nruntime_type = \"io.containerd.runsc.v1\"\n"},
+						{Name: "nri", Content: "[plugins.\"io.containerd.nri.v1.nri\"]\ndisable = false\n"},
+					},
+				},
+			},
+			osFamily: Ubuntu,
+			wantErr:  "",
+		},
+		{
+			name: "containerd config with bottlerocket",
+			hostOSConfig: &HostOSConfiguration{
+				ContainerdConfiguration: &ContainerdConfiguration{
+					ConfigDropIns: []ContainerdConfigDropIn{
+						{Name: "gvisor", Content: "[plugins.\"io.containerd.grpc.v1.cri\".containerd.runtimes.runsc]\nruntime_type = \"io.containerd.runsc.v1\"\n"},
+					},
+				},
+			},
+			osFamily: Bottlerocket,
+			wantErr:  "ContainerdConfiguration can not be used with osFamily: \"bottlerocket\"",
+		},
+		{
+			name: "empty containerd config drop-ins",
+			hostOSConfig: &HostOSConfiguration{
+				ContainerdConfiguration: &ContainerdConfiguration{},
+			},
+			osFamily: RedHat,
+			wantErr:  "ContainerdConfiguration.ConfigDropIns can not be empty",
+		},
+		{
+			name: "invalid containerd config drop-in name",
+			hostOSConfig: &HostOSConfiguration{
+				ContainerdConfiguration: &ContainerdConfiguration{
+					ConfigDropIns: []ContainerdConfigDropIn{
+						{Name: "gVisor.toml", Content: ""},
+					},
+				},
+			},
+			osFamily: Ubuntu,
+			wantErr:  "containerd configDropIns name \"gVisor.toml\" is invalid",
+		},
+		{
+			name: "duplicated containerd config drop-in name",
+			hostOSConfig: &HostOSConfiguration{
+				ContainerdConfiguration: &ContainerdConfiguration{
+					ConfigDropIns: []ContainerdConfigDropIn{
+						{Name: "gvisor", Content: "[plugins.\"io.containerd.grpc.v1.cri\".containerd.runtimes.runsc]\nruntime_type = \"io.containerd.runsc.v1\"\n"},
+						{Name: "gvisor", Content: "[plugins.\"io.containerd.grpc.v1.cri\".containerd.runtimes.runsc]\nruntime_type = \"io.containerd.runsc.v1\"\n"},
+					},
+				},
+			},
+			osFamily: Ubuntu,
+			wantErr:  "containerd configDropIns name gvisor is duplicated",
+		},
+		{
+			name: "invalid containerd config drop-in content",
+			hostOSConfig: &HostOSConfiguration{
+				ContainerdConfiguration: &ContainerdConfiguration{
+					ConfigDropIns: []ContainerdConfigDropIn{
+						{Name: "gvisor", Content: "[plugins"},
+					},
+				},
+			},
+			osFamily: Ubuntu,
+			wantErr:  "containerd configDropIns gvisor content is not valid TOML",
+		},
+		{
+			name: "containerd config drop-in setting imports",
+			hostOSConfig: &HostOSConfiguration{
+				ContainerdConfiguration: &ContainerdConfiguration{
+					ConfigDropIns: []ContainerdConfigDropIn{
+						{Name: "gvisor", Content: "imports = [\"/etc/other/*.toml\"]"},
+					},
+				},
+			},
+			osFamily: Ubuntu,
+			wantErr:  "containerd configDropIns gvisor can not set imports",
+		},
 	}
 
 	for _, tt := range tests {
@@ -12,6 +12,26 @@ type HostOSConfiguration struct {
 
 	// +optional
 	CertBundles []certBundle `json:"certBundles,omitempty"`
+
+	// +optional
+	ContainerdConfiguration *ContainerdConfiguration `json:"containerdConfiguration,omitempty"`
+}
+
+// ContainerdConfiguration defines the containerd configuration on the host OS.
+// It is only supported when the `osFamily` is ubuntu or redhat.
+type ContainerdConfiguration struct {
+	// ConfigDropIns defines TOML snippets imported by the containerd configuration,
+	// like additional runtimes or the NRI plugin settings.
+	ConfigDropIns []ContainerdConfigDropIn `json:"configDropIns"`
+}
+
+// ContainerdConfigDropIn defines a containerd configuration snippet.
+type ContainerdConfigDropIn struct {
+	// Name is the name of the snippet file, without extension.
+	Name string `json:"name"`
+
+	// Content is the TOML content of the snippet.
+	Content string `json:"content"`
 }
 
 // NTPConfiguration defines the NTP configuration on the host OS.
@@ -32,6 +52,65 @@ type BottlerocketConfiguration struct {
 
 	// Boot defines the boot settings for bottlerocket.
 	Boot *v1beta2.BottlerocketBootSettings `json:"boot,omitempty"`
+
+	// BootstrapContainers defines additional bootstrap containers, which run before the node joins the cluster.
+	// +optional
+	BootstrapContainers []BottlerocketBootstrapContainer `json:"bootstrapContainers,omitempty"`
+
+	// HostContainers defines additional host containers, which run outside of Kubernetes for the node lifetime.
+	// +optional
+	HostContainers []BottlerocketHostContainer `json:"hostContainers,omitempty"`
+}
+
+// BottlerocketBootstrapContainerMode defines when a Bottlerocket bootstrap container runs.
+type BottlerocketBootstrapContainerMode string
+
+const (
+	// BottlerocketBootstrapContainerModeAlways runs the bootstrap container on every boot.
+	BottlerocketBootstrapContainerModeAlways BottlerocketBootstrapContainerMode = "always"
+	// BottlerocketBootstrapContainerModeOnce runs the bootstrap container on the first boot only.
+	BottlerocketBootstrapContainerModeOnce BottlerocketBootstrapContainerMode = "once"
+	// BottlerocketBootstrapContainerModeOff disables the bootstrap container.
+	BottlerocketBootstrapContainerModeOff BottlerocketBootstrapContainerMode = "off"
+)
+
+// BottlerocketBootstrapContainer defines a Bottlerocket bootstrap container.
+type BottlerocketBootstrapContainer struct {
+	// Name is the name of the container in the Bottlerocket settings.
+	Name string `json:"name"`
+
+	// Image is the container image, including its tag or digest.
+	Image string `json:"image"`
+
+	// Mode defines when the container runs. Defaults to always.
+	// +kubebuilder:validation:Enum=always;once;off
+	// +optional
+	Mode BottlerocketBootstrapContainerMode `json:"mode,omitempty"`
+
+	// Essential stops the node boot if the container exits with a non-zero code.
+	// +optional
+	Essential bool `json:"essential,omitempty"`
+
+	// UserData is the base64 encoded data passed to the container.
+	// +optional
+	UserData string `json:"userData,omitempty"`
+}
+
+// BottlerocketHostContainer defines a Bottlerocket host container.
+type BottlerocketHostContainer struct {
+	// Name is the name of the container in the Bottlerocket settings.
+	Name string `json:"name"`
+
+	// Image is the container image, including its tag or digest.
+	Image string `json:"image"`
+
+	// Superpowered gives the container full access to the host.
+	// +optional
+	Superpowered bool `json:"superpowered,omitempty"`
+
+	// UserData is the base64 encoded data passed to the container.
+	// +optional
+	UserData string `json:"userData,omitempty"`
 }
 
 // Cert defines additional trusted cert bundles on the host OS.
@@ -1,6 +1,10 @@
 package v1alpha1
 
-import "fmt"
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
 
 type OSFamily string
 
@@ -10,12 +14,31 @@ const (
 	RedHat       OSFamily = "redhat"
 )
 
+// DefaultUserSudoPolicy is the sudo policy given to machine users that don't set one.
+const DefaultUserSudoPolicy = "ALL=(ALL) NOPASSWD:ALL"
+
 // UserConfiguration defines the configuration of the user to be added to the VM.
 type UserConfiguration struct {
 	Name              string   `json:"name"`
 	SshAuthorizedKeys []string `json:"sshAuthorizedKeys"`
+
+	// Sudo is the sudoers policy for the user, e.g. "ALL=(ALL) ALL".
+	// Defaults to passwordless sudo for all commands.
+	// +optional
+	Sudo string `json:"sudo,omitempty"`
+}
+
+// SudoPolicy returns the sudoers policy for the user, falling back to the default one.
+func (u UserConfiguration) SudoPolicy() string {
+	if u.Sudo == "" {
+		return DefaultUserSudoPolicy
+	}
+	return u.Sudo
 }
 
+// linuxUsernameRegex matches the usernames cloud-init and useradd can create.
+var linuxUsernameRegex = regexp.MustCompile(`^[A-Za-z0-9_][A-Za-z0-9_.-]*[$]?$`)
+
 func defaultMachineConfigUsers(defaultUsername string, users []UserConfiguration) []UserConfiguration {
 	if len(users) <= 0 {
 		users = []UserConfiguration{{}}
@@ -40,5 +63,37 @@ func validateMachineConfigUsers(machineConfigName string, machineConfigKind stri
 	if len(users[0].SshAuthorizedKeys) == 0 || users[0].SshAuthorizedKeys[0] == "" {
 		return fmt.Errorf("users[0].SshAuthorizedKeys is not set or is empty for %s %s, please provide a valid ssh authorized key for user %s", machineConfigKind, machineConfigName, users[0].Name)
 	}
+	return validateAdditionalMachineConfigUsers(machineConfigName, machineConfigKind, users)
+}
+
+// validateAdditionalMachineConfigUsers validates the fields of every user that the
+// users[0] checks don't cover, so multiple users and keys can be configured safely.
+func validateAdditionalMachineConfigUsers(machineConfigName string, machineConfigKind string, users []UserConfiguration) error {
+	names := make(map[string]struct{}, len(users))
+	for i, user := range users {
+		if user.Name == "" {
+			return fmt.Errorf("users[%d].name is not set or is empty for %s %s, please provide a username", i, machineConfigKind, machineConfigName)
+		}
+		if !linuxUsernameRegex.MatchString(user.Name) {
+			return fmt.Errorf("users[%d].name %s is not a valid username for %s %s", i, user.Name, machineConfigKind, machineConfigName)
+		}
+		if _, ok := names[user.Name]; ok {
+			return fmt.Errorf("users[%d].name %s is duplicated for %s %s", i, user.Name, machineConfigKind, machineConfigName)
+		}
+		names[user.Name] = struct{}{}
+
+		if len(user.SshAuthorizedKeys) == 0 {
+			return fmt.Errorf("users[%d].SshAuthorizedKeys is not set or is empty for %s %s, please provide a valid ssh authorized key for user %s", i, machineConfigKind, machineConfigName, user.Name)
+		}
+		for j, key := range user.SshAuthorizedKeys {
+			if key == "" || strings.ContainsAny(key, "\n\r") {
+				return fmt.Errorf("users[%d].SshAuthorizedKeys[%d] is not a valid ssh authorized key for %s %s", i, j, machineConfigKind, machineConfigName)
+			}
+		}
+
+		if strings.ContainsAny(user.Sudo, "\n\r") {
+			return fmt.Errorf("users[%d].sudo must be a single line for %s %s", i, machineConfigKind, machineConfigName)
+		}
+	}
 	return nil
 }
@@ -0,0 +1,95 @@
+package v1alpha1
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestUserConfigurationSudoPolicy(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect(UserConfiguration{Name: "capv"}.SudoPolicy()).To(Equal(DefaultUserSudoPolicy))
+	g.Expect(UserConfiguration{Name: "capv", Sudo: "ALL=(ALL) ALL"}.SudoPolicy()).To(Equal("ALL=(ALL) ALL"))
+}
+
+func TestValidateMachineConfigUsers(t *testing.T) {
+	tests := []struct {
+		name    string
+		users   []UserConfiguration
+		wantErr string
+	}{
+		{
+			name: "multiple users and keys",
+			users: []UserConfiguration{
+				{Name: "capv", SshAuthorizedKeys: []string{"ssh-rsa AAAA", "ssh-rsa BBBB"}},
+				{Name: "ops.team", SshAuthorizedKeys: []string{"ssh-rsa CCCC"}, Sudo: "ALL=(ALL) ALL"},
+			},
+		},
+		{
+			name: "additional user without name",
+			users: []UserConfiguration{
+				{Name: "capv", SshAuthorizedKeys: []string{"ssh-rsa AAAA"}},
+				{SshAuthorizedKeys: []string{"ssh-rsa BBBB"}},
+			},
+			wantErr: "users[1].name is not set or is empty for VSphereMachineConfig test, please provide a username",
+		},
+		{
+			name: "invalid username",
+			users: []UserConfiguration{
+				{Name: "capv", SshAuthorizedKeys: []string{"ssh-rsa AAAA"}},
+				{Name: "ops team", SshAuthorizedKeys: []string{"ssh-rsa BBBB"}},
+			},
+			wantErr: "users[1].name ops team is not a valid username for VSphereMachineConfig test",
+		},
+		{
+			name: "duplicated username",
+			users: []UserConfiguration{
+				{Name: "capv", SshAuthorizedKeys: []string{"ssh-rsa AAAA"}},
+				{Name: "capv", SshAuthorizedKeys: []string{"ssh-rsa BBBB"}},
+			},
+			wantErr: "users[1].name capv is duplicated for VSphereMachineConfig test",
+		},
+		{
+			name: "additional user without keys",
+			users: []UserConfiguration{
+				{Name: "capv", SshAuthorizedKeys: []string{"ssh-rsa AAAA"}},
+				{Name: "ops"},
+			},
+			wantErr: "users[1].SshAuthorizedKeys is not set or is empty for VSphereMachineConfig test, please provide a valid ssh authorized key for user ops",
+		},
+		{
+			name: "empty additional key",
+			users: []UserConfiguration{
+				{Name: "capv", SshAuthorizedKeys: []string{"ssh-rsa AAAA", ""}},
+			},
+			wantErr: "users[0].SshAuthorizedKeys[1] is not a valid ssh authorized key for VSphereMachineConfig test",
+		},
+		{
+			name: "multiline key",
+			users: []UserConfiguration{
+				{Name: "capv", SshAuthorizedKeys: []string{"ssh-rsa AAAA\nssh-rsa BBBB"}},
+			},
+			wantErr: "users[0].SshAuthorizedKeys[0] is not a valid ssh authorized key for VSphereMachineConfig test",
+		},
+		{
+			name: "multiline sudo",
+			users: []UserConfiguration{
+				{Name: "capv", SshAuthorizedKeys: []string{"ssh-rsa AAAA"}, Sudo: "ALL=(ALL) ALL\nroot ALL"},
+			},
+			wantErr: "users[0].sudo must be a single line for VSphereMachineConfig test",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			err := validateMachineConfigUsers("test", VSphereMachineConfigKind, tt.users)
+			if tt.wantErr == "" {
+				g.Expect(err).NotTo(HaveOccurred())
+			} else {
+				g.Expect(err).To(MatchError(tt.wantErr))
+			}
+		})
+	}
+}
@@ -191,6 +191,39 @@ func TestGetNutanixDatacenterConfigValidConfig(t *testing.T) {
 				assert.Contains(t, err.Error(), "NutanixDatacenterConfig.Spec.FailureDomains.Subnets: missing subnet UUID: default/eksa-unit-test")
 			},
 		},
+		{
+			name:     "datacenterconfig-valid-provider-proxy",
+			fileName: "testdata/nutanix/datacenterconfig-valid-provider-proxy.yaml",
+			assertions: func(t *testing.T, dcConf *v1alpha1.NutanixDatacenterConfig) {
+				assert.NoError(t, dcConf.Validate())
+			},
+		},
+		{
+			name:     "datacenterconfig-invalid-provider-proxy",
+			fileName: "testdata/nutanix/datacenterconfig-invalid-provider-proxy.yaml",
+			assertions: func(t *testing.T, dcConf *v1alpha1.NutanixDatacenterConfig) {
+				err := dcConf.Validate()
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), "NutanixDatacenterConfig providerProxyConfiguration is not valid: no value set for httpProxy")
+			},
+		},
+		{
+			name:     "datacenterconfig-valid-resource-tags",
+			fileName: "testdata/nutanix/datacenterconfig-valid-resource-tags.yaml",
+			assertions: func(t *testing.T, dcConf *v1alpha1.NutanixDatacenterConfig) {
+				assert.NoError(t, dcConf.Validate())
+				assert.Equal(t, map[string]string{"env": "prod", "owner": "platform"}, dcConf.Spec.ResourceTags)
+			},
+		},
+		{
+			name:     "datacenterconfig-invalid-resource-tags",
+			fileName: "testdata/nutanix/datacenterconfig-invalid-resource-tags.yaml",
+			assertions: func(t *testing.T, dcConf *v1alpha1.NutanixDatacenterConfig) {
+				err := dcConf.Validate()
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), "NutanixDatacenterConfig resourceTags must have a non empty key and value")
+			},
+		},
 	}
 
 	for _, test := range tests {
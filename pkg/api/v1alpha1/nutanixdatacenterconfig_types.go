@@ -52,6 +52,16 @@ type NutanixDatacenterConfigSpec struct {
 	// List should be valid IP addresses and IP address ranges.
 	// +optional
 	CcmExcludeNodeIPs []string `json:"ccmExcludeNodeIPs,omitempty"`
+
+	// ProviderProxyConfiguration is the optional proxy used by the CLI to reach Prism Central.
+	// It is independent of the cluster proxy configuration, which only applies to the nodes.
+	// +optional
+	ProviderProxyConfiguration *ProxyConfiguration `json:"providerProxyConfiguration,omitempty"`
+
+	// ResourceTags are the key/value pairs added as categories to all the VMs created for the cluster.
+	// The categories must already exist in Prism Central.
+	// +optional
+	ResourceTags map[string]string `json:"resourceTags,omitempty"`
 }
 
 // NutanixDatacenterFailureDomain defines the failure domain for the Nutanix Datacenter.
@@ -191,6 +201,18 @@ func (in *NutanixDatacenterConfig) Validate() error {
 		}
 	}
 
+	if in.Spec.ProviderProxyConfiguration != nil {
+		if err := validateProxyConfiguration(in.Spec.ProviderProxyConfiguration); err != nil {
+			return fmt.Errorf("NutanixDatacenterConfig providerProxyConfiguration is not valid: %v", err)
+		}
+	}
+
+	for key, value := range in.Spec.ResourceTags {
+		if key == "" || value == "" {
+			return fmt.Errorf("NutanixDatacenterConfig resourceTags must have a non empty key and value: %q: %q", key, value)
+		}
+	}
+
 	return nil
 }
 
@@ -13,6 +13,9 @@ type NutanixIdentifierType string
 // NutanixGPUIdentifierType is an enumeration of different GPU identifier types.
 type NutanixGPUIdentifierType string
 
+// NutanixGPUMode is an enumeration of the ways a GPU can be assigned to a VM.
+type NutanixGPUMode string
+
 // NutanixBootType is an enumeration of different boot types.
 type NutanixBootType string
 
@@ -38,6 +41,11 @@ const (
 	// NutanixGPUIdentifierName is a GPU identifier identifying the object by Name.
 	NutanixGPUIdentifierName NutanixGPUIdentifierType = "name"
 
+	// NutanixGPUModePassthrough assigns a whole physical GPU to the VM.
+	NutanixGPUModePassthrough NutanixGPUMode = "passthrough"
+	// NutanixGPUModeVirtual assigns a vGPU profile, shared with other VMs, to the VM.
+	NutanixGPUModeVirtual NutanixGPUMode = "virtual"
+
 	// NutanixBootTypeLegacy is a resource identifier identifying the legacy boot type for virtual machines.
 	NutanixBootTypeLegacy NutanixBootType = "legacy"
 
@@ -97,6 +105,12 @@ type NutanixGPUIdentifier struct {
 	// +kubebuilder:validation:Required
 	// +kubebuilder:validation:Enum:=deviceID;name
 	Type NutanixGPUIdentifierType `json:"type"`
+
+	// mode is how the GPU is assigned to the VM: passthrough for a physical GPU or
+	// virtual for a vGPU profile. If not set, GPUs in any mode can be assigned.
+	// +optional
+	// +kubebuilder:validation:Enum:=passthrough;virtual
+	Mode NutanixGPUMode `json:"mode,omitempty"`
 }
 
 // NutanixMachineConfigGenerateOpt is a functional option that can be passed to NewNutanixMachineConfigGenerate to
@@ -0,0 +1,48 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// OpenStackDatacenterKind is the kind for an OpenStackDatacenterConfig.
+const OpenStackDatacenterKind = "OpenStackDatacenterConfig"
+
+// NewOpenStackDatacenterConfigGenerate is used for generating yaml for generate clusterconfig command.
+func NewOpenStackDatacenterConfigGenerate(clusterName string) *OpenStackDatacenterConfigGenerate {
+	return &OpenStackDatacenterConfigGenerate{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       OpenStackDatacenterKind,
+			APIVersion: SchemeBuilder.GroupVersion.String(),
+		},
+		ObjectMeta: ObjectMeta{
+			Name: clusterName,
+		},
+		Spec: OpenStackDatacenterConfigSpec{
+			AuthURL: "<enter OpenStack Identity API endpoint, e.g. https://openstack.example.com:5000/v3, here>",
+			Region:  "RegionOne",
+			Network: "<enter network name here>",
+		},
+	}
+}
+
+func (c *OpenStackDatacenterConfigGenerate) APIVersion() string {
+	return c.TypeMeta.APIVersion
+}
+
+func (c *OpenStackDatacenterConfigGenerate) Kind() string {
+	return c.TypeMeta.Kind
+}
+
+func (c *OpenStackDatacenterConfigGenerate) Name() string {
+	return c.ObjectMeta.Name
+}
+
+// GetOpenStackDatacenterConfig parses config in a yaml file and returns an OpenStackDatacenterConfig object.
+func GetOpenStackDatacenterConfig(fileName string) (*OpenStackDatacenterConfig, error) {
+	var clusterConfig OpenStackDatacenterConfig
+	err := ParseClusterConfig(fileName, &clusterConfig)
+	if err != nil {
+		return nil, err
+	}
+	return &clusterConfig, nil
+}
@@ -0,0 +1,91 @@
+package v1alpha1_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+)
+
+func validOpenStackDatacenterConfig() *v1alpha1.OpenStackDatacenterConfig {
+	return &v1alpha1.OpenStackDatacenterConfig{
+		Spec: v1alpha1.OpenStackDatacenterConfigSpec{
+			AuthURL:        "https://openstack.example.com:5000/v3",
+			Region:         "RegionOne",
+			Network:        "eksa",
+			Subnet:         "eksa-subnet",
+			FailureDomains: []string{"nova"},
+		},
+	}
+}
+
+func TestOpenStackDatacenterConfigValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		update  func(*v1alpha1.OpenStackDatacenterConfig)
+		wantErr string
+	}{
+		{
+			name:   "valid",
+			update: func(*v1alpha1.OpenStackDatacenterConfig) {},
+		},
+		{
+			name: "auth url with trailing slash",
+			update: func(c *v1alpha1.OpenStackDatacenterConfig) {
+				c.Spec.AuthURL = "https://openstack.example.com/identity/v3/"
+			},
+		},
+		{
+			name:    "empty auth url",
+			update:  func(c *v1alpha1.OpenStackDatacenterConfig) { c.Spec.AuthURL = "" },
+			wantErr: "OpenStackDatacenterConfig authURL is not set or is empty",
+		},
+		{
+			name:    "invalid auth url",
+			update:  func(c *v1alpha1.OpenStackDatacenterConfig) { c.Spec.AuthURL = "openstack.example.com" },
+			wantErr: "OpenStackDatacenterConfig authURL openstack.example.com is not a valid URL",
+		},
+		{
+			name: "identity v2 auth url",
+			update: func(c *v1alpha1.OpenStackDatacenterConfig) {
+				c.Spec.AuthURL = "https://openstack.example.com:5000/v2.0"
+			},
+			wantErr: "OpenStackDatacenterConfig authURL https://openstack.example.com:5000/v2.0 must point to the Identity v3 API",
+		},
+		{
+			name:    "empty network",
+			update:  func(c *v1alpha1.OpenStackDatacenterConfig) { c.Spec.Network = "" },
+			wantErr: "OpenStackDatacenterConfig network is not set or is empty",
+		},
+		{
+			name:    "empty failure domain",
+			update:  func(c *v1alpha1.OpenStackDatacenterConfig) { c.Spec.FailureDomains = []string{""} },
+			wantErr: "OpenStackDatacenterConfig failureDomains must not contain empty names",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			config := validOpenStackDatacenterConfig()
+			tt.update(config)
+
+			err := config.Validate()
+			if tt.wantErr == "" {
+				g.Expect(err).NotTo(HaveOccurred())
+			} else {
+				g.Expect(err).To(MatchError(tt.wantErr))
+			}
+		})
+	}
+}
+
+func TestNewOpenStackDatacenterConfigGenerate(t *testing.T) {
+	g := NewWithT(t)
+	config := v1alpha1.NewOpenStackDatacenterConfigGenerate("test")
+
+	g.Expect(config.Kind()).To(Equal(v1alpha1.OpenStackDatacenterKind))
+	g.Expect(config.APIVersion()).To(Equal(v1alpha1.SchemeBuilder.GroupVersion.String()))
+	g.Expect(config.Name()).To(Equal("test"))
+}
@@ -0,0 +1,170 @@
+// Important: Run "make generate" to regenerate code after modifying this file
+// json tags are required; new fields must have json tags for the fields to be serialized
+
+package v1alpha1
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// OpenStackDatacenterConfigSpec defines the desired state of OpenStackDatacenterConfig.
+type OpenStackDatacenterConfigSpec struct {
+	// AuthURL is the URL of the OpenStack Identity (Keystone) v3 API, e.g. https://openstack.example.com:5000/v3.
+	// +kubebuilder:validation:Required
+	AuthURL string `json:"authURL"`
+
+	// Region is the OpenStack region the cluster is created in.
+	// +optional
+	Region string `json:"region,omitempty"`
+
+	// Insecure is the optional flag to skip TLS verification of the OpenStack APIs.
+	// This is not recommended for production use.
+	Insecure bool `json:"insecure,omitempty"`
+
+	// CACertBase64 is the optional base64 encoded CA bundle used to verify the OpenStack API certificates
+	// when they are signed by a private certificate authority.
+	// +optional
+	CACertBase64 string `json:"caCertBase64,omitempty"`
+
+	// Network is the name of the existing network the cluster nodes are attached to.
+	// +kubebuilder:validation:Required
+	Network string `json:"network"`
+
+	// Subnet is the name of the subnet of Network the nodes get their addresses from.
+	// If not set, the network must have a single subnet.
+	// +optional
+	Subnet string `json:"subnet,omitempty"`
+
+	// FailureDomains is the list of compute availability zones the control plane nodes are spread across.
+	// If empty, nodes are created in the default availability zone.
+	// +optional
+	FailureDomains []string `json:"failureDomains,omitempty"`
+}
+
+// OpenStackDatacenterConfigStatus defines the observed state of OpenStackDatacenterConfig.
+type OpenStackDatacenterConfigStatus struct{}
+
+// OpenStackDatacenterConfig is the Schema for the OpenStackDatacenterConfigs API
+//
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+type OpenStackDatacenterConfig struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   OpenStackDatacenterConfigSpec   `json:"spec,omitempty"`
+	Status OpenStackDatacenterConfigStatus `json:"status,omitempty"`
+}
+
+// Kind returns the kind of the OpenStackDatacenterConfig.
+func (in *OpenStackDatacenterConfig) Kind() string {
+	return in.TypeMeta.Kind
+}
+
+// ExpectedKind returns the kind the OpenStackDatacenterConfig is expected to have.
+func (in *OpenStackDatacenterConfig) ExpectedKind() string {
+	return OpenStackDatacenterKind
+}
+
+// PauseReconcile pauses the reconciliation of the OpenStackDatacenterConfig.
+func (in *OpenStackDatacenterConfig) PauseReconcile() {
+	if in.Annotations == nil {
+		in.Annotations = map[string]string{}
+	}
+	in.Annotations[pausedAnnotation] = "true"
+}
+
+// IsReconcilePaused returns true if the OpenStackDatacenterConfig is paused.
+func (in *OpenStackDatacenterConfig) IsReconcilePaused() bool {
+	if s, ok := in.Annotations[pausedAnnotation]; ok {
+		return s == "true"
+	}
+	return false
+}
+
+// ClearPauseAnnotation removes the pause annotation from the OpenStackDatacenterConfig.
+func (in *OpenStackDatacenterConfig) ClearPauseAnnotation() {
+	if in.Annotations != nil {
+		delete(in.Annotations, pausedAnnotation)
+	}
+}
+
+// ConvertConfigToConfigGenerateStruct converts the OpenStackDatacenterConfig to OpenStackDatacenterConfigGenerate.
+func (in *OpenStackDatacenterConfig) ConvertConfigToConfigGenerateStruct() *OpenStackDatacenterConfigGenerate {
+	namespace := defaultEksaNamespace
+	if in.Namespace != "" {
+		namespace = in.Namespace
+	}
+	config := &OpenStackDatacenterConfigGenerate{
+		TypeMeta: in.TypeMeta,
+		ObjectMeta: ObjectMeta{
+			Name:        in.Name,
+			Annotations: in.Annotations,
+			Namespace:   namespace,
+		},
+		Spec: in.Spec,
+	}
+
+	return config
+}
+
+// Marshallable returns a Marshallable version of the OpenStackDatacenterConfig.
+func (in *OpenStackDatacenterConfig) Marshallable() Marshallable {
+	return in.ConvertConfigToConfigGenerateStruct()
+}
+
+// Validate validates the OpenStackDatacenterConfig.
+func (in *OpenStackDatacenterConfig) Validate() error {
+	if len(in.Spec.AuthURL) <= 0 {
+		return errors.New("OpenStackDatacenterConfig authURL is not set or is empty")
+	}
+
+	authURL, err := url.ParseRequestURI(in.Spec.AuthURL)
+	if err != nil || authURL.Host == "" || (authURL.Scheme != "https" && authURL.Scheme != "http") {
+		return fmt.Errorf("OpenStackDatacenterConfig authURL %s is not a valid URL", in.Spec.AuthURL)
+	}
+
+	if !strings.HasSuffix(strings.TrimSuffix(authURL.Path, "/"), "/v3") {
+		return fmt.Errorf("OpenStackDatacenterConfig authURL %s must point to the Identity v3 API", in.Spec.AuthURL)
+	}
+
+	if in.Spec.Network == "" {
+		return errors.New("OpenStackDatacenterConfig network is not set or is empty")
+	}
+
+	for _, zone := range in.Spec.FailureDomains {
+		if zone == "" {
+			return errors.New("OpenStackDatacenterConfig failureDomains must not contain empty names")
+		}
+	}
+
+	return nil
+}
+
+// OpenStackDatacenterConfigGenerate is same as OpenStackDatacenterConfig except stripped down for generation of yaml file during generate clusterconfig
+//
+// +kubebuilder:object:generate=false
+type OpenStackDatacenterConfigGenerate struct {
+	metav1.TypeMeta `json:",inline"`
+	ObjectMeta      `json:"metadata,omitempty"`
+
+	Spec OpenStackDatacenterConfigSpec `json:"spec,omitempty"`
+}
+
+// OpenStackDatacenterConfigList contains a list of OpenStackDatacenterConfig
+//
+// +kubebuilder:object:root=true
+type OpenStackDatacenterConfigList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []OpenStackDatacenterConfig `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&OpenStackDatacenterConfig{}, &OpenStackDatacenterConfigList{})
+}
@@ -0,0 +1,124 @@
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// openstackdatacenterconfiglog is for logging in this package.
+var openstackdatacenterconfiglog = logf.Log.WithName("openstackdatacenterconfig-resource")
+
+// SetupWebhookWithManager sets up the webhook with the manager.
+func (in *OpenStackDatacenterConfig) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(in).
+		WithValidator(in).
+		Complete()
+}
+
+//+kubebuilder:webhook:path=/validate-anywhere-eks-amazonaws-com-v1alpha1-openstackdatacenterconfig,mutating=false,failurePolicy=fail,sideEffects=None,groups=anywhere.eks.amazonaws.com,resources=openstackdatacenterconfigs,verbs=create;update,versions=v1alpha1,name=validation.openstackdatacenterconfig.anywhere.amazonaws.com,admissionReviewVersions={v1,v1beta1}
+
+var _ webhook.CustomValidator = &OpenStackDatacenterConfig{}
+
+// ValidateCreate implements webhook.CustomValidator so a webhook will be registered for the type.
+func (in *OpenStackDatacenterConfig) ValidateCreate(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
+	openstackConfig, ok := obj.(*OpenStackDatacenterConfig)
+	if !ok {
+		return nil, fmt.Errorf("expected a OpenStackDatacenterConfig but got %T", obj)
+	}
+
+	openstackdatacenterconfiglog.Info("validate create", "name", openstackConfig.Name)
+	if openstackConfig.IsReconcilePaused() {
+		openstackdatacenterconfiglog.Info("OpenStackDatacenterConfig is paused, allowing create", "name", openstackConfig.Name)
+		return nil, nil
+	}
+
+	if err := openstackConfig.Validate(); err != nil {
+		return nil, apierrors.NewInvalid(
+			GroupVersion.WithKind(OpenStackDatacenterKind).GroupKind(),
+			openstackConfig.Name,
+			field.ErrorList{
+				field.Invalid(field.NewPath("spec"), openstackConfig.Spec, err.Error()),
+			})
+	}
+
+	return nil, nil
+}
+
+// ValidateUpdate implements webhook.CustomValidator so a webhook will be registered for the type.
+func (in *OpenStackDatacenterConfig) ValidateUpdate(_ context.Context, old, obj runtime.Object) (admission.Warnings, error) {
+	openstackConfig, ok := obj.(*OpenStackDatacenterConfig)
+	if !ok {
+		return nil, fmt.Errorf("expected a OpenStackDatacenterConfig but got %T", obj)
+	}
+
+	openstackdatacenterconfiglog.Info("validate update", "name", openstackConfig.Name)
+	oldDatacenterConfig, ok := old.(*OpenStackDatacenterConfig)
+	if !ok {
+		return nil, apierrors.NewBadRequest(fmt.Sprintf("expected a OpenStackDatacenterConfig but got a %T", old))
+	}
+
+	if oldDatacenterConfig.IsReconcilePaused() {
+		openstackdatacenterconfiglog.Info("OpenStackDatacenterConfig is paused, allowing update", "name", openstackConfig.Name)
+		return nil, nil
+	}
+
+	var allErrs field.ErrorList
+	allErrs = append(allErrs, validateImmutableFieldsOpenStackDatacenterConfig(openstackConfig, oldDatacenterConfig)...)
+
+	if err := openstackConfig.Validate(); err != nil {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("spec"), openstackConfig.Spec, err.Error()))
+	}
+
+	if len(allErrs) > 0 {
+		return nil, apierrors.NewInvalid(
+			GroupVersion.WithKind(OpenStackDatacenterKind).GroupKind(),
+			openstackConfig.Name,
+			allErrs)
+	}
+
+	return nil, nil
+}
+
+// ValidateDelete implements webhook.CustomValidator so a webhook will be registered for the type.
+func (in *OpenStackDatacenterConfig) ValidateDelete(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
+	openstackConfig, ok := obj.(*OpenStackDatacenterConfig)
+	if !ok {
+		return nil, fmt.Errorf("expected a OpenStackDatacenterConfig but got %T", obj)
+	}
+
+	openstackdatacenterconfiglog.Info("validate delete", "name", openstackConfig.Name)
+
+	return nil, nil
+}
+
+func validateImmutableFieldsOpenStackDatacenterConfig(new, old *OpenStackDatacenterConfig) field.ErrorList {
+	var allErrs field.ErrorList
+	specPath := field.NewPath("spec")
+
+	if new.Spec.AuthURL != old.Spec.AuthURL {
+		allErrs = append(allErrs, field.Forbidden(specPath.Child("authURL"), "field is immutable"))
+	}
+
+	if new.Spec.Region != old.Spec.Region {
+		allErrs = append(allErrs, field.Forbidden(specPath.Child("region"), "field is immutable"))
+	}
+
+	if new.Spec.Network != old.Spec.Network {
+		allErrs = append(allErrs, field.Forbidden(specPath.Child("network"), "field is immutable"))
+	}
+
+	if new.Spec.Subnet != old.Spec.Subnet {
+		allErrs = append(allErrs, field.Forbidden(specPath.Child("subnet"), "field is immutable"))
+	}
+
+	return allErrs
+}
@@ -0,0 +1,114 @@
+package v1alpha1_test
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+)
+
+func TestOpenStackDatacenterConfigValidateCreate(t *testing.T) {
+	g := NewWithT(t)
+	dcConf := validOpenStackDatacenterConfig()
+	g.Expect(dcConf.ValidateCreate(context.Background(), dcConf)).Error().To(Succeed())
+}
+
+func TestOpenStackDatacenterConfigValidateCreateReconcilePaused(t *testing.T) {
+	g := NewWithT(t)
+	dcConf := validOpenStackDatacenterConfig()
+	dcConf.Spec.AuthURL = ""
+	dcConf.PauseReconcile()
+	g.Expect(dcConf.ValidateCreate(context.Background(), dcConf)).Error().To(Succeed())
+}
+
+func TestOpenStackDatacenterConfigValidateCreateInvalid(t *testing.T) {
+	g := NewWithT(t)
+	dcConf := validOpenStackDatacenterConfig()
+	dcConf.Spec.AuthURL = ""
+	g.Expect(dcConf.ValidateCreate(context.Background(), dcConf)).Error().To(MatchError(ContainSubstring("authURL is not set or is empty")))
+}
+
+func TestOpenStackDatacenterConfigValidateCreateCastFail(t *testing.T) {
+	g := NewWithT(t)
+	dcConf := validOpenStackDatacenterConfig()
+	g.Expect(dcConf.ValidateCreate(context.Background(), &v1alpha1.Cluster{})).Error().To(MatchError(ContainSubstring("expected a OpenStackDatacenterConfig")))
+}
+
+func TestOpenStackDatacenterConfigValidateUpdate(t *testing.T) {
+	tests := []struct {
+		name    string
+		update  func(*v1alpha1.OpenStackDatacenterConfig)
+		wantErr string
+	}{
+		{
+			name:   "mutable failure domains",
+			update: func(c *v1alpha1.OpenStackDatacenterConfig) { c.Spec.FailureDomains = []string{"nova", "nova-2"} },
+		},
+		{
+			name:    "immutable auth url",
+			update:  func(c *v1alpha1.OpenStackDatacenterConfig) { c.Spec.AuthURL = "https://openstack2.example.com:5000/v3" },
+			wantErr: "spec.authURL: Forbidden: field is immutable",
+		},
+		{
+			name:    "immutable region",
+			update:  func(c *v1alpha1.OpenStackDatacenterConfig) { c.Spec.Region = "RegionTwo" },
+			wantErr: "spec.region: Forbidden: field is immutable",
+		},
+		{
+			name:    "immutable network",
+			update:  func(c *v1alpha1.OpenStackDatacenterConfig) { c.Spec.Network = "other" },
+			wantErr: "spec.network: Forbidden: field is immutable",
+		},
+		{
+			name:    "immutable subnet",
+			update:  func(c *v1alpha1.OpenStackDatacenterConfig) { c.Spec.Subnet = "other-subnet" },
+			wantErr: "spec.subnet: Forbidden: field is immutable",
+		},
+		{
+			name:    "invalid spec",
+			update:  func(c *v1alpha1.OpenStackDatacenterConfig) { c.Spec.FailureDomains = []string{""} },
+			wantErr: "failureDomains",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			oldConf := validOpenStackDatacenterConfig()
+			newConf := validOpenStackDatacenterConfig()
+			tt.update(newConf)
+
+			_, err := newConf.ValidateUpdate(context.Background(), oldConf, newConf)
+			if tt.wantErr == "" {
+				g.Expect(err).NotTo(HaveOccurred())
+			} else {
+				g.Expect(err).To(MatchError(ContainSubstring(tt.wantErr)))
+			}
+		})
+	}
+}
+
+func TestOpenStackDatacenterConfigValidateUpdateReconcilePaused(t *testing.T) {
+	g := NewWithT(t)
+	oldConf := validOpenStackDatacenterConfig()
+	oldConf.PauseReconcile()
+	newConf := validOpenStackDatacenterConfig()
+	newConf.Spec.AuthURL = "https://openstack2.example.com:5000/v3"
+	g.Expect(newConf.ValidateUpdate(context.Background(), oldConf, newConf)).Error().To(Succeed())
+}
+
+func TestOpenStackDatacenterConfigValidateUpdateCastFail(t *testing.T) {
+	g := NewWithT(t)
+	dcConf := validOpenStackDatacenterConfig()
+	g.Expect(dcConf.ValidateUpdate(context.Background(), &v1alpha1.Cluster{}, dcConf)).Error().To(MatchError(ContainSubstring("expected a OpenStackDatacenterConfig")))
+	g.Expect(dcConf.ValidateUpdate(context.Background(), dcConf, &v1alpha1.Cluster{})).Error().To(MatchError(ContainSubstring("expected a OpenStackDatacenterConfig")))
+}
+
+func TestOpenStackDatacenterConfigValidateDelete(t *testing.T) {
+	g := NewWithT(t)
+	dcConf := validOpenStackDatacenterConfig()
+	g.Expect(dcConf.ValidateDelete(context.Background(), dcConf)).Error().To(Succeed())
+	g.Expect(dcConf.ValidateDelete(context.Background(), &v1alpha1.Cluster{})).Error().To(HaveOccurred())
+}
@@ -0,0 +1,109 @@
+package v1alpha1
+
+import (
+	"errors"
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// OpenStackMachineConfigKind is the kind for an OpenStackMachineConfig.
+	OpenStackMachineConfigKind = "OpenStackMachineConfig"
+
+	// DefaultOpenStackMachineConfigUser is the default username we set in machine config.
+	DefaultOpenStackMachineConfigUser string = "eksa"
+
+	defaultOpenStackOSFamily = Ubuntu
+
+	minOpenStackRootVolumeSizeGiB = 20
+)
+
+// NewOpenStackMachineConfigGenerate returns a new instance of OpenStackMachineConfigGenerate
+// used for generating yaml for generate clusterconfig command.
+func NewOpenStackMachineConfigGenerate(name string) *OpenStackMachineConfigGenerate {
+	return &OpenStackMachineConfigGenerate{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       OpenStackMachineConfigKind,
+			APIVersion: SchemeBuilder.GroupVersion.String(),
+		},
+		ObjectMeta: ObjectMeta{
+			Name: name,
+		},
+		Spec: OpenStackMachineConfigSpec{
+			OSFamily: defaultOpenStackOSFamily,
+			Users: []UserConfiguration{
+				{
+					Name:              DefaultOpenStackMachineConfigUser,
+					SshAuthorizedKeys: []string{"ssh-rsa AAAA..."},
+				},
+			},
+			Flavor: "<enter flavor name here>",
+			Image:  "<enter image name here>",
+		},
+	}
+}
+
+func (c *OpenStackMachineConfigGenerate) APIVersion() string {
+	return c.TypeMeta.APIVersion
+}
+
+func (c *OpenStackMachineConfigGenerate) Kind() string {
+	return c.TypeMeta.Kind
+}
+
+func (c *OpenStackMachineConfigGenerate) Name() string {
+	return c.ObjectMeta.Name
+}
+
+func setOpenStackMachineConfigDefaults(machineConfig *OpenStackMachineConfig) {
+	if len(machineConfig.Spec.Users) == 0 {
+		machineConfig.Spec.Users = []UserConfiguration{{}}
+	}
+
+	if machineConfig.Spec.Users[0].Name == "" {
+		machineConfig.Spec.Users[0].Name = DefaultOpenStackMachineConfigUser
+	}
+
+	if len(machineConfig.Spec.Users[0].SshAuthorizedKeys) == 0 {
+		machineConfig.Spec.Users[0].SshAuthorizedKeys = []string{""}
+	}
+
+	if machineConfig.Spec.OSFamily == "" {
+		machineConfig.Spec.OSFamily = defaultOpenStackOSFamily
+	}
+}
+
+func validateOpenStackMachineConfig(c *OpenStackMachineConfig) error {
+	if err := validateObjectMeta(c.ObjectMeta); err != nil {
+		return fmt.Errorf("OpenStackMachineConfig: %v", err)
+	}
+
+	if c.Spec.OSFamily != Ubuntu {
+		return fmt.Errorf("OpenStackMachineConfig: unsupported spec.osFamily (%v); Please use one of the following: %s", c.Spec.OSFamily, Ubuntu)
+	}
+
+	if c.Spec.Flavor == "" {
+		return errors.New("OpenStackMachineConfig: flavor is not set or is empty")
+	}
+
+	if c.Spec.Image == "" {
+		return errors.New("OpenStackMachineConfig: image is not set or is empty")
+	}
+
+	if c.Spec.RootVolumeSizeGiB != 0 && c.Spec.RootVolumeSizeGiB < minOpenStackRootVolumeSizeGiB {
+		return fmt.Errorf("OpenStackMachineConfig: rootVolumeSizeGiB must be greater than or equal to %d", minOpenStackRootVolumeSizeGiB)
+	}
+
+	for _, group := range c.Spec.SecurityGroups {
+		if group == "" {
+			return errors.New("OpenStackMachineConfig: securityGroups must not contain empty names")
+		}
+	}
+
+	if len(c.Spec.Users) == 0 || c.Spec.Users[0].Name == "" {
+		return fmt.Errorf("OpenStackMachineConfig: users[0].name is not set or is empty for %s", c.Name)
+	}
+
+	return nil
+}
@@ -0,0 +1,96 @@
+package v1alpha1_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+)
+
+func TestOpenStackMachineConfigSetDefaults(t *testing.T) {
+	g := NewWithT(t)
+	config := &v1alpha1.OpenStackMachineConfig{}
+	config.SetDefaults()
+
+	g.Expect(config.Spec).To(Equal(v1alpha1.OpenStackMachineConfigSpec{
+		OSFamily: v1alpha1.Ubuntu,
+		Users: []v1alpha1.UserConfiguration{
+			{Name: v1alpha1.DefaultOpenStackMachineConfigUser, SshAuthorizedKeys: []string{""}},
+		},
+	}))
+}
+
+func TestOpenStackMachineConfigValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		update  func(*v1alpha1.OpenStackMachineConfig)
+		wantErr string
+	}{
+		{
+			name:   "valid",
+			update: func(*v1alpha1.OpenStackMachineConfig) {},
+		},
+		{
+			name:   "boot from volume",
+			update: func(c *v1alpha1.OpenStackMachineConfig) { c.Spec.RootVolumeSizeGiB = 50 },
+		},
+		{
+			name:    "unsupported os family",
+			update:  func(c *v1alpha1.OpenStackMachineConfig) { c.Spec.OSFamily = v1alpha1.Bottlerocket },
+			wantErr: "OpenStackMachineConfig: unsupported spec.osFamily (bottlerocket); Please use one of the following: ubuntu",
+		},
+		{
+			name:    "empty flavor",
+			update:  func(c *v1alpha1.OpenStackMachineConfig) { c.Spec.Flavor = "" },
+			wantErr: "OpenStackMachineConfig: flavor is not set or is empty",
+		},
+		{
+			name:    "empty image",
+			update:  func(c *v1alpha1.OpenStackMachineConfig) { c.Spec.Image = "" },
+			wantErr: "OpenStackMachineConfig: image is not set or is empty",
+		},
+		{
+			name:    "root volume too small",
+			update:  func(c *v1alpha1.OpenStackMachineConfig) { c.Spec.RootVolumeSizeGiB = 10 },
+			wantErr: "OpenStackMachineConfig: rootVolumeSizeGiB must be greater than or equal to 20",
+		},
+		{
+			name:    "empty security group",
+			update:  func(c *v1alpha1.OpenStackMachineConfig) { c.Spec.SecurityGroups = []string{""} },
+			wantErr: "OpenStackMachineConfig: securityGroups must not contain empty names",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			config := &v1alpha1.OpenStackMachineConfig{
+				Spec: v1alpha1.OpenStackMachineConfigSpec{
+					Flavor: "m1.large",
+					Image:  "ubuntu-2204-kube-v1.31",
+				},
+			}
+			config.Name = "test"
+			config.SetDefaults()
+			tt.update(config)
+
+			err := config.Validate()
+			if tt.wantErr == "" {
+				g.Expect(err).NotTo(HaveOccurred())
+			} else {
+				g.Expect(err).To(MatchError(tt.wantErr))
+			}
+		})
+	}
+}
+
+func TestNewOpenStackMachineConfigGenerate(t *testing.T) {
+	g := NewWithT(t)
+	config := v1alpha1.NewOpenStackMachineConfigGenerate("test-cp")
+
+	g.Expect(config.Kind()).To(Equal(v1alpha1.OpenStackMachineConfigKind))
+	g.Expect(config.Name()).To(Equal("test-cp"))
+	g.Expect(config.Spec.OSFamily).To(Equal(v1alpha1.Ubuntu))
+	g.Expect(config.Spec.Users[0].Name).To(Equal(v1alpha1.DefaultOpenStackMachineConfigUser))
+}
@@ -0,0 +1,194 @@
+// Important: Run "make generate" to regenerate code after modifying this file
+// json tags are required; new fields must have json tags for the fields to be serialized
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// OpenStackMachineConfigSpec defines the desired state of OpenStackMachineConfig.
+type OpenStackMachineConfigSpec struct {
+	OSFamily OSFamily            `json:"osFamily"`
+	Users    []UserConfiguration `json:"users,omitempty"`
+
+	// Flavor is the name of the compute flavor the instances are created with.
+	// +kubebuilder:validation:Required
+	Flavor string `json:"flavor"`
+
+	// Image is the name of the image the instances are booted from.
+	// The image must be built for the cluster's Kubernetes version.
+	// +kubebuilder:validation:Required
+	Image string `json:"image"`
+
+	// RootVolumeSizeGiB is the size of the root volume in GiB. If set, the instances boot from a
+	// block storage volume created from Image instead of the flavor's ephemeral disk.
+	// +optional
+	RootVolumeSizeGiB int32 `json:"rootVolumeSizeGiB,omitempty"`
+
+	// RootVolumeType is the block storage volume type of the root volume. Only used when RootVolumeSizeGiB is set.
+	// +optional
+	RootVolumeType string `json:"rootVolumeType,omitempty"`
+
+	// SecurityGroups is the list of names of existing security groups attached to the instances
+	// in addition to the ones managed for the cluster.
+	// +optional
+	SecurityGroups []string `json:"securityGroups,omitempty"`
+}
+
+// SetDefaults sets defaults to OpenStackMachineConfig if user has not provided.
+func (in *OpenStackMachineConfig) SetDefaults() {
+	setOpenStackMachineConfigDefaults(in)
+}
+
+// PauseReconcile pauses the reconciliation of the OpenStackMachineConfig.
+func (in *OpenStackMachineConfig) PauseReconcile() {
+	if in.Annotations == nil {
+		in.Annotations = map[string]string{}
+	}
+	in.Annotations[pausedAnnotation] = "true"
+}
+
+// IsReconcilePaused returns true if the OpenStackMachineConfig is paused.
+func (in *OpenStackMachineConfig) IsReconcilePaused() bool {
+	if s, ok := in.Annotations[pausedAnnotation]; ok {
+		return s == "true"
+	}
+	return false
+}
+
+// SetControlPlane sets the OpenStackMachineConfig as a control plane node.
+func (in *OpenStackMachineConfig) SetControlPlane() {
+	if in.Annotations == nil {
+		in.Annotations = map[string]string{}
+	}
+	in.Annotations[controlPlaneAnnotation] = "true"
+}
+
+// IsControlPlane returns true if the OpenStackMachineConfig is a control plane node.
+func (in *OpenStackMachineConfig) IsControlPlane() bool {
+	if s, ok := in.Annotations[controlPlaneAnnotation]; ok {
+		return s == "true"
+	}
+	return false
+}
+
+// SetEtcd sets the OpenStackMachineConfig as an etcd node.
+func (in *OpenStackMachineConfig) SetEtcd() {
+	if in.Annotations == nil {
+		in.Annotations = map[string]string{}
+	}
+	in.Annotations[etcdAnnotation] = "true"
+}
+
+// IsEtcd returns true if the OpenStackMachineConfig is an etcd node.
+func (in *OpenStackMachineConfig) IsEtcd() bool {
+	if s, ok := in.Annotations[etcdAnnotation]; ok {
+		return s == "true"
+	}
+	return false
+}
+
+// SetManagedBy sets the cluster name that manages the OpenStackMachineConfig.
+func (in *OpenStackMachineConfig) SetManagedBy(clusterName string) {
+	if in.Annotations == nil {
+		in.Annotations = map[string]string{}
+	}
+	in.Annotations[managementAnnotation] = clusterName
+}
+
+// IsManaged returns true if the OpenStackMachineConfig is managed by a cluster.
+func (in *OpenStackMachineConfig) IsManaged() bool {
+	if s, ok := in.Annotations[managementAnnotation]; ok {
+		return s != ""
+	}
+	return false
+}
+
+// OSFamily returns the OSFamily of the OpenStackMachineConfig.
+func (in *OpenStackMachineConfig) OSFamily() OSFamily {
+	return in.Spec.OSFamily
+}
+
+// Users returns a list of configuration for OS users.
+func (in *OpenStackMachineConfig) Users() []UserConfiguration {
+	return in.Spec.Users
+}
+
+// GetNamespace returns the namespace of the OpenStackMachineConfig.
+func (in *OpenStackMachineConfig) GetNamespace() string {
+	return in.Namespace
+}
+
+// GetName returns the name of the OpenStackMachineConfig.
+func (in *OpenStackMachineConfig) GetName() string {
+	return in.Name
+}
+
+// OpenStackMachineConfigStatus defines the observed state of OpenStackMachineConfig.
+type OpenStackMachineConfigStatus struct{}
+
+// OpenStackMachineConfig is the Schema for the openstack machine configs API
+//
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+type OpenStackMachineConfig struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   OpenStackMachineConfigSpec   `json:"spec,omitempty"`
+	Status OpenStackMachineConfigStatus `json:"status,omitempty"`
+}
+
+// ConvertConfigToConfigGenerateStruct converts the OpenStackMachineConfig to OpenStackMachineConfigGenerate.
+func (in *OpenStackMachineConfig) ConvertConfigToConfigGenerateStruct() *OpenStackMachineConfigGenerate {
+	namespace := defaultEksaNamespace
+	if in.Namespace != "" {
+		namespace = in.Namespace
+	}
+	config := &OpenStackMachineConfigGenerate{
+		TypeMeta: in.TypeMeta,
+		ObjectMeta: ObjectMeta{
+			Name:        in.Name,
+			Annotations: in.Annotations,
+			Namespace:   namespace,
+		},
+		Spec: in.Spec,
+	}
+
+	return config
+}
+
+// Marshallable returns a Marshallable version of the OpenStackMachineConfig.
+func (in *OpenStackMachineConfig) Marshallable() Marshallable {
+	return in.ConvertConfigToConfigGenerateStruct()
+}
+
+// Validate validates the OpenStackMachineConfig.
+func (in *OpenStackMachineConfig) Validate() error {
+	return validateOpenStackMachineConfig(in)
+}
+
+// OpenStackMachineConfigGenerate is same as OpenStackMachineConfig except stripped down for generation of yaml file during
+// generate clusterconfig
+//
+// +kubebuilder:object:generate=false
+type OpenStackMachineConfigGenerate struct {
+	metav1.TypeMeta `json:",inline"`
+	ObjectMeta      `json:"metadata,omitempty"`
+
+	Spec OpenStackMachineConfigSpec `json:"spec,omitempty"`
+}
+
+// OpenStackMachineConfigList contains a list of OpenStackMachineConfig
+//
+// +kubebuilder:object:root=true
+type OpenStackMachineConfigList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []OpenStackMachineConfig `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&OpenStackMachineConfig{}, &OpenStackMachineConfigList{})
+}
@@ -0,0 +1,127 @@
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// openstackmachineconfiglog is for logging in this package.
+var openstackmachineconfiglog = logf.Log.WithName("openstackmachineconfig-resource")
+
+// SetupWebhookWithManager sets up and registers the webhook with the manager.
+func (in *OpenStackMachineConfig) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(in).
+		WithValidator(in).
+		Complete()
+}
+
+//+kubebuilder:webhook:path=/validate-anywhere-eks-amazonaws-com-v1alpha1-openstackmachineconfig,mutating=false,failurePolicy=fail,sideEffects=None,groups=anywhere.eks.amazonaws.com,resources=openstackmachineconfigs,verbs=create;update,versions=v1alpha1,name=validation.openstackmachineconfig.anywhere.amazonaws.com,admissionReviewVersions={v1,v1beta1}
+
+var _ webhook.CustomValidator = &OpenStackMachineConfig{}
+
+// ValidateCreate implements webhook.CustomValidator so a webhook will be registered for the type.
+func (in *OpenStackMachineConfig) ValidateCreate(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
+	openstackConfig, ok := obj.(*OpenStackMachineConfig)
+	if !ok {
+		return nil, fmt.Errorf("expected a OpenStackMachineConfig but got %T", obj)
+	}
+
+	openstackmachineconfiglog.Info("validate create", "name", openstackConfig.Name)
+	if err := openstackConfig.Validate(); err != nil {
+		return nil, apierrors.NewInvalid(
+			GroupVersion.WithKind(OpenStackMachineConfigKind).GroupKind(),
+			openstackConfig.Name,
+			field.ErrorList{
+				field.Invalid(field.NewPath("spec"), openstackConfig.Spec, err.Error()),
+			},
+		)
+	}
+
+	return nil, nil
+}
+
+// ValidateUpdate implements webhook.CustomValidator so a webhook will be registered for the type.
+func (in *OpenStackMachineConfig) ValidateUpdate(_ context.Context, old, obj runtime.Object) (admission.Warnings, error) {
+	openstackConfig, ok := obj.(*OpenStackMachineConfig)
+	if !ok {
+		return nil, fmt.Errorf("expected a OpenStackMachineConfig but got %T", obj)
+	}
+
+	openstackmachineconfiglog.Info("validate update", "name", openstackConfig.Name)
+
+	oldOpenStackMachineConfig, ok := old.(*OpenStackMachineConfig)
+	if !ok {
+		return nil, apierrors.NewBadRequest(fmt.Sprintf("expected a OpenStackMachineConfig but got a %T", old))
+	}
+
+	var allErrs field.ErrorList
+	allErrs = append(allErrs, validateImmutableFieldsOpenStackMachineConfig(openstackConfig, oldOpenStackMachineConfig)...)
+
+	if err := openstackConfig.Validate(); err != nil {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("spec"), openstackConfig.Spec, err.Error()))
+	}
+
+	if len(allErrs) > 0 {
+		return nil, apierrors.NewInvalid(
+			GroupVersion.WithKind(OpenStackMachineConfigKind).GroupKind(),
+			openstackConfig.Name,
+			allErrs,
+		)
+	}
+
+	return nil, nil
+}
+
+// ValidateDelete implements webhook.CustomValidator so a webhook will be registered for the type.
+func (in *OpenStackMachineConfig) ValidateDelete(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
+	openstackConfig, ok := obj.(*OpenStackMachineConfig)
+	if !ok {
+		return nil, fmt.Errorf("expected a OpenStackMachineConfig but got %T", obj)
+	}
+
+	openstackmachineconfiglog.Info("validate delete", "name", openstackConfig.Name)
+
+	return nil, nil
+}
+
+func validateImmutableFieldsOpenStackMachineConfig(new, old *OpenStackMachineConfig) field.ErrorList {
+	if old.IsReconcilePaused() {
+		openstackmachineconfiglog.Info("Reconciliation is paused")
+		return nil
+	}
+
+	var allErrs field.ErrorList
+	specPath := field.NewPath("spec")
+
+	if new.Spec.OSFamily != old.Spec.OSFamily {
+		allErrs = append(allErrs, field.Forbidden(specPath.Child("osFamily"), "field is immutable"))
+	}
+
+	if old.IsManaged() {
+		openstackmachineconfiglog.Info("Machine config is associated with workload cluster", "name", old.Name)
+		return allErrs
+	}
+
+	if !old.IsEtcd() && !old.IsControlPlane() {
+		openstackmachineconfiglog.Info("Machine config is associated with management cluster's worker nodes", "name", old.Name)
+		return allErrs
+	}
+
+	openstackmachineconfiglog.Info("Machine config is associated with management cluster's control plane or etcd", "name", old.Name)
+
+	if !reflect.DeepEqual(new.Spec.Users, old.Spec.Users) {
+		allErrs = append(allErrs, field.Forbidden(specPath.Child("users"), "field is immutable"))
+	}
+
+	return allErrs
+}
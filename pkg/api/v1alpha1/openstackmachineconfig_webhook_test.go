@@ -0,0 +1,129 @@
+package v1alpha1_test
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+)
+
+func openstackMachineConfig() *v1alpha1.OpenStackMachineConfig {
+	config := &v1alpha1.OpenStackMachineConfig{
+		Spec: v1alpha1.OpenStackMachineConfigSpec{
+			Flavor: "m1.large",
+			Image:  "ubuntu-2204-kube-v1.31",
+		},
+	}
+	config.Name = "test"
+	config.SetDefaults()
+	return config
+}
+
+func TestOpenStackMachineConfigValidateCreate(t *testing.T) {
+	g := NewWithT(t)
+	config := openstackMachineConfig()
+	g.Expect(config.ValidateCreate(context.Background(), config)).Error().To(Succeed())
+}
+
+func TestOpenStackMachineConfigValidateCreateInvalid(t *testing.T) {
+	g := NewWithT(t)
+	config := openstackMachineConfig()
+	config.Spec.Flavor = ""
+	g.Expect(config.ValidateCreate(context.Background(), config)).Error().To(MatchError(ContainSubstring("flavor is not set or is empty")))
+}
+
+func TestOpenStackMachineConfigValidateCreateCastFail(t *testing.T) {
+	g := NewWithT(t)
+	config := openstackMachineConfig()
+	g.Expect(config.ValidateCreate(context.Background(), &v1alpha1.Cluster{})).Error().To(MatchError(ContainSubstring("expected a OpenStackMachineConfig")))
+}
+
+func TestOpenStackMachineConfigValidateUpdate(t *testing.T) {
+	tests := []struct {
+		name    string
+		old     func(*v1alpha1.OpenStackMachineConfig)
+		update  func(*v1alpha1.OpenStackMachineConfig)
+		wantErr string
+	}{
+		{
+			name: "mutable image and flavor",
+			old:  func(*v1alpha1.OpenStackMachineConfig) {},
+			update: func(c *v1alpha1.OpenStackMachineConfig) {
+				c.Spec.Image = "ubuntu-2204-kube-v1.32"
+				c.Spec.Flavor = "m1.xlarge"
+			},
+		},
+		{
+			name:    "immutable os family",
+			old:     func(*v1alpha1.OpenStackMachineConfig) {},
+			update:  func(c *v1alpha1.OpenStackMachineConfig) { c.Spec.OSFamily = v1alpha1.Bottlerocket },
+			wantErr: "spec.osFamily: Forbidden: field is immutable",
+		},
+		{
+			name:    "immutable users of management control plane",
+			old:     func(c *v1alpha1.OpenStackMachineConfig) { c.SetControlPlane() },
+			update:  func(c *v1alpha1.OpenStackMachineConfig) { c.Spec.Users[0].Name = "admin" },
+			wantErr: "spec.users: Forbidden: field is immutable",
+		},
+		{
+			name: "mutable users of workload control plane",
+			old: func(c *v1alpha1.OpenStackMachineConfig) {
+				c.SetControlPlane()
+				c.SetManagedBy("mgmt")
+			},
+			update: func(c *v1alpha1.OpenStackMachineConfig) { c.Spec.Users[0].Name = "admin" },
+		},
+		{
+			name:   "mutable users of management workers",
+			old:    func(*v1alpha1.OpenStackMachineConfig) {},
+			update: func(c *v1alpha1.OpenStackMachineConfig) { c.Spec.Users[0].Name = "admin" },
+		},
+		{
+			name: "paused",
+			old: func(c *v1alpha1.OpenStackMachineConfig) {
+				c.SetControlPlane()
+				c.PauseReconcile()
+			},
+			update: func(c *v1alpha1.OpenStackMachineConfig) { c.Spec.Users[0].Name = "admin" },
+		},
+		{
+			name:    "invalid spec",
+			old:     func(*v1alpha1.OpenStackMachineConfig) {},
+			update:  func(c *v1alpha1.OpenStackMachineConfig) { c.Spec.RootVolumeSizeGiB = 10 },
+			wantErr: "rootVolumeSizeGiB must be greater than or equal to 20",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			oldConf := openstackMachineConfig()
+			tt.old(oldConf)
+			newConf := oldConf.DeepCopy()
+			tt.update(newConf)
+
+			_, err := newConf.ValidateUpdate(context.Background(), oldConf, newConf)
+			if tt.wantErr == "" {
+				g.Expect(err).NotTo(HaveOccurred())
+			} else {
+				g.Expect(err).To(MatchError(ContainSubstring(tt.wantErr)))
+			}
+		})
+	}
+}
+
+func TestOpenStackMachineConfigValidateUpdateCastFail(t *testing.T) {
+	g := NewWithT(t)
+	config := openstackMachineConfig()
+	g.Expect(config.ValidateUpdate(context.Background(), &v1alpha1.Cluster{}, config)).Error().To(MatchError(ContainSubstring("expected a OpenStackMachineConfig")))
+	g.Expect(config.ValidateUpdate(context.Background(), config, &v1alpha1.Cluster{})).Error().To(MatchError(ContainSubstring("expected a OpenStackMachineConfig")))
+}
+
+func TestOpenStackMachineConfigValidateDelete(t *testing.T) {
+	g := NewWithT(t)
+	config := openstackMachineConfig()
+	g.Expect(config.ValidateDelete(context.Background(), config)).Error().To(Succeed())
+	g.Expect(config.ValidateDelete(context.Background(), &v1alpha1.Cluster{})).Error().To(HaveOccurred())
+}
@@ -0,0 +1,52 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ProxmoxDatacenterKind is the kind for a ProxmoxDatacenterConfig.
+const ProxmoxDatacenterKind = "ProxmoxDatacenterConfig"
+
+// NewProxmoxDatacenterConfigGenerate is used for generating yaml for generate clusterconfig command.
+func NewProxmoxDatacenterConfigGenerate(clusterName string) *ProxmoxDatacenterConfigGenerate {
+	return &ProxmoxDatacenterConfigGenerate{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       ProxmoxDatacenterKind,
+			APIVersion: SchemeBuilder.GroupVersion.String(),
+		},
+		ObjectMeta: ObjectMeta{
+			Name: clusterName,
+		},
+		Spec: ProxmoxDatacenterConfigSpec{
+			Endpoint: "<enter Proxmox VE API endpoint, e.g. https://pve.example.com:8006, here>",
+			IPv4Config: ProxmoxIPv4Config{
+				Addresses: []string{"<enter node IP address range here>"},
+				Prefix:    24,
+				Gateway:   "<enter gateway IP here>",
+			},
+			DNSServers: []string{"<enter DNS server IP here>"},
+		},
+	}
+}
+
+func (c *ProxmoxDatacenterConfigGenerate) APIVersion() string {
+	return c.TypeMeta.APIVersion
+}
+
+func (c *ProxmoxDatacenterConfigGenerate) Kind() string {
+	return c.TypeMeta.Kind
+}
+
+func (c *ProxmoxDatacenterConfigGenerate) Name() string {
+	return c.ObjectMeta.Name
+}
+
+// GetProxmoxDatacenterConfig parses config in a yaml file and returns a ProxmoxDatacenterConfig object.
+func GetProxmoxDatacenterConfig(fileName string) (*ProxmoxDatacenterConfig, error) {
+	var clusterConfig ProxmoxDatacenterConfig
+	err := ParseClusterConfig(fileName, &clusterConfig)
+	if err != nil {
+		return nil, err
+	}
+	return &clusterConfig, nil
+}
@@ -0,0 +1,113 @@
+package v1alpha1_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+)
+
+func validProxmoxDatacenterConfig() *v1alpha1.ProxmoxDatacenterConfig {
+	return &v1alpha1.ProxmoxDatacenterConfig{
+		Spec: v1alpha1.ProxmoxDatacenterConfigSpec{
+			Endpoint: "https://pve.example.com:8006",
+			Nodes:    []string{"pve1"},
+			IPv4Config: v1alpha1.ProxmoxIPv4Config{
+				Addresses: []string{"10.0.0.10-10.0.0.50", "10.0.1.0/24", "10.0.2.1"},
+				Prefix:    24,
+				Gateway:   "10.0.0.1",
+			},
+			DNSServers: []string{"10.0.0.1"},
+		},
+	}
+}
+
+func TestProxmoxDatacenterConfigValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		update  func(*v1alpha1.ProxmoxDatacenterConfig)
+		wantErr string
+	}{
+		{
+			name:   "valid",
+			update: func(*v1alpha1.ProxmoxDatacenterConfig) {},
+		},
+		{
+			name:    "empty endpoint",
+			update:  func(c *v1alpha1.ProxmoxDatacenterConfig) { c.Spec.Endpoint = "" },
+			wantErr: "ProxmoxDatacenterConfig endpoint is not set or is empty",
+		},
+		{
+			name:    "invalid endpoint",
+			update:  func(c *v1alpha1.ProxmoxDatacenterConfig) { c.Spec.Endpoint = "pve.example.com" },
+			wantErr: "ProxmoxDatacenterConfig endpoint pve.example.com is not a valid URL",
+		},
+		{
+			name:    "empty node",
+			update:  func(c *v1alpha1.ProxmoxDatacenterConfig) { c.Spec.Nodes = []string{""} },
+			wantErr: "ProxmoxDatacenterConfig nodes must not contain empty names",
+		},
+		{
+			name:    "no addresses",
+			update:  func(c *v1alpha1.ProxmoxDatacenterConfig) { c.Spec.IPv4Config.Addresses = nil },
+			wantErr: "ProxmoxDatacenterConfig ipv4Config is not valid: addresses is not set or is empty",
+		},
+		{
+			name:    "invalid address",
+			update:  func(c *v1alpha1.ProxmoxDatacenterConfig) { c.Spec.IPv4Config.Addresses = []string{"10.0.0"} },
+			wantErr: "ProxmoxDatacenterConfig ipv4Config is not valid: address 10.0.0 is not a valid IPv4 address, CIDR or range",
+		},
+		{
+			name: "reversed range",
+			update: func(c *v1alpha1.ProxmoxDatacenterConfig) {
+				c.Spec.IPv4Config.Addresses = []string{"10.0.0.50-10.0.0.10"}
+			},
+			wantErr: "ProxmoxDatacenterConfig ipv4Config is not valid: address range 10.0.0.50-10.0.0.10 starts after it ends",
+		},
+		{
+			name:    "invalid prefix",
+			update:  func(c *v1alpha1.ProxmoxDatacenterConfig) { c.Spec.IPv4Config.Prefix = 33 },
+			wantErr: "ProxmoxDatacenterConfig ipv4Config is not valid: prefix 33 must be between 1 and 32",
+		},
+		{
+			name:    "invalid gateway",
+			update:  func(c *v1alpha1.ProxmoxDatacenterConfig) { c.Spec.IPv4Config.Gateway = "fd00::1" },
+			wantErr: "ProxmoxDatacenterConfig ipv4Config is not valid: gateway fd00::1 is not a valid IPv4 address",
+		},
+		{
+			name:    "no dns servers",
+			update:  func(c *v1alpha1.ProxmoxDatacenterConfig) { c.Spec.DNSServers = nil },
+			wantErr: "ProxmoxDatacenterConfig dnsServers is not set or is empty",
+		},
+		{
+			name:    "invalid dns server",
+			update:  func(c *v1alpha1.ProxmoxDatacenterConfig) { c.Spec.DNSServers = []string{"dns"} },
+			wantErr: "ProxmoxDatacenterConfig dnsServers contains an invalid IP dns",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			config := validProxmoxDatacenterConfig()
+			tt.update(config)
+
+			err := config.Validate()
+			if tt.wantErr == "" {
+				g.Expect(err).NotTo(HaveOccurred())
+			} else {
+				g.Expect(err).To(MatchError(tt.wantErr))
+			}
+		})
+	}
+}
+
+func TestNewProxmoxDatacenterConfigGenerate(t *testing.T) {
+	g := NewWithT(t)
+	config := v1alpha1.NewProxmoxDatacenterConfigGenerate("test")
+
+	g.Expect(config.Kind()).To(Equal(v1alpha1.ProxmoxDatacenterKind))
+	g.Expect(config.APIVersion()).To(Equal(v1alpha1.SchemeBuilder.GroupVersion.String()))
+	g.Expect(config.Name()).To(Equal("test"))
+}
@@ -0,0 +1,235 @@
+// Important: Run "make generate" to regenerate code after modifying this file
+// json tags are required; new fields must have json tags for the fields to be serialized
+
+package v1alpha1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ProxmoxDatacenterConfigSpec defines the desired state of ProxmoxDatacenterConfig.
+type ProxmoxDatacenterConfigSpec struct {
+	// Endpoint is the URL of the Proxmox VE API, e.g. https://pve.example.com:8006.
+	// +kubebuilder:validation:Required
+	Endpoint string `json:"endpoint"`
+
+	// Insecure is the optional flag to skip TLS verification of the Proxmox VE API.
+	// Proxmox VE ships with a self-signed certificate. This is not recommended for production use.
+	Insecure bool `json:"insecure,omitempty"`
+
+	// Nodes is the list of Proxmox VE nodes the cluster VMs can be scheduled on.
+	// If empty, VMs are only created on the node their template lives on.
+	// +optional
+	Nodes []string `json:"nodes,omitempty"`
+
+	// IPv4Config is the pool of addresses the cluster nodes are statically assigned from.
+	// +kubebuilder:validation:Required
+	IPv4Config ProxmoxIPv4Config `json:"ipv4Config"`
+
+	// DNSServers is the list of DNS servers configured on the cluster nodes.
+	// +kubebuilder:validation:Required
+	DNSServers []string `json:"dnsServers"`
+}
+
+// ProxmoxIPv4Config defines the IPv4 addresses assigned to the cluster nodes.
+type ProxmoxIPv4Config struct {
+	// Addresses is the list of IP addresses and IP address ranges, e.g. 10.0.0.10-10.0.0.50,
+	// that node IPs are allocated from. It must not include the control plane endpoint.
+	// +kubebuilder:validation:Required
+	Addresses []string `json:"addresses"`
+
+	// Prefix is the network prefix length of the node addresses.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=32
+	Prefix int `json:"prefix"`
+
+	// Gateway is the default gateway of the node network.
+	// +kubebuilder:validation:Required
+	Gateway string `json:"gateway"`
+}
+
+// ProxmoxDatacenterConfigStatus defines the observed state of ProxmoxDatacenterConfig.
+type ProxmoxDatacenterConfigStatus struct{}
+
+// ProxmoxDatacenterConfig is the Schema for the ProxmoxDatacenterConfigs API
+//
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+type ProxmoxDatacenterConfig struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ProxmoxDatacenterConfigSpec   `json:"spec,omitempty"`
+	Status ProxmoxDatacenterConfigStatus `json:"status,omitempty"`
+}
+
+// Kind returns the kind of the ProxmoxDatacenterConfig.
+func (in *ProxmoxDatacenterConfig) Kind() string {
+	return in.TypeMeta.Kind
+}
+
+// ExpectedKind returns the kind the ProxmoxDatacenterConfig is expected to have.
+func (in *ProxmoxDatacenterConfig) ExpectedKind() string {
+	return ProxmoxDatacenterKind
+}
+
+// PauseReconcile pauses the reconciliation of the ProxmoxDatacenterConfig.
+func (in *ProxmoxDatacenterConfig) PauseReconcile() {
+	if in.Annotations == nil {
+		in.Annotations = map[string]string{}
+	}
+	in.Annotations[pausedAnnotation] = "true"
+}
+
+// IsReconcilePaused returns true if the ProxmoxDatacenterConfig is paused.
+func (in *ProxmoxDatacenterConfig) IsReconcilePaused() bool {
+	if s, ok := in.Annotations[pausedAnnotation]; ok {
+		return s == "true"
+	}
+	return false
+}
+
+// ClearPauseAnnotation removes the pause annotation from the ProxmoxDatacenterConfig.
+func (in *ProxmoxDatacenterConfig) ClearPauseAnnotation() {
+	if in.Annotations != nil {
+		delete(in.Annotations, pausedAnnotation)
+	}
+}
+
+// ConvertConfigToConfigGenerateStruct converts the ProxmoxDatacenterConfig to ProxmoxDatacenterConfigGenerate.
+func (in *ProxmoxDatacenterConfig) ConvertConfigToConfigGenerateStruct() *ProxmoxDatacenterConfigGenerate {
+	namespace := defaultEksaNamespace
+	if in.Namespace != "" {
+		namespace = in.Namespace
+	}
+	config := &ProxmoxDatacenterConfigGenerate{
+		TypeMeta: in.TypeMeta,
+		ObjectMeta: ObjectMeta{
+			Name:        in.Name,
+			Annotations: in.Annotations,
+			Namespace:   namespace,
+		},
+		Spec: in.Spec,
+	}
+
+	return config
+}
+
+// Marshallable returns a Marshallable version of the ProxmoxDatacenterConfig.
+func (in *ProxmoxDatacenterConfig) Marshallable() Marshallable {
+	return in.ConvertConfigToConfigGenerateStruct()
+}
+
+// Validate validates the ProxmoxDatacenterConfig.
+func (in *ProxmoxDatacenterConfig) Validate() error {
+	if len(in.Spec.Endpoint) <= 0 {
+		return errors.New("ProxmoxDatacenterConfig endpoint is not set or is empty")
+	}
+
+	endpoint, err := url.ParseRequestURI(in.Spec.Endpoint)
+	if err != nil || endpoint.Host == "" || (endpoint.Scheme != "https" && endpoint.Scheme != "http") {
+		return fmt.Errorf("ProxmoxDatacenterConfig endpoint %s is not a valid URL", in.Spec.Endpoint)
+	}
+
+	for _, node := range in.Spec.Nodes {
+		if node == "" {
+			return errors.New("ProxmoxDatacenterConfig nodes must not contain empty names")
+		}
+	}
+
+	if err := validateProxmoxIPv4Config(in.Spec.IPv4Config); err != nil {
+		return fmt.Errorf("ProxmoxDatacenterConfig ipv4Config is not valid: %v", err)
+	}
+
+	if len(in.Spec.DNSServers) == 0 {
+		return errors.New("ProxmoxDatacenterConfig dnsServers is not set or is empty")
+	}
+
+	for _, server := range in.Spec.DNSServers {
+		if net.ParseIP(server) == nil {
+			return fmt.Errorf("ProxmoxDatacenterConfig dnsServers contains an invalid IP %s", server)
+		}
+	}
+
+	return nil
+}
+
+func validateProxmoxIPv4Config(c ProxmoxIPv4Config) error {
+	if len(c.Addresses) == 0 {
+		return errors.New("addresses is not set or is empty")
+	}
+
+	for _, address := range c.Addresses {
+		if err := validateProxmoxIPv4Address(address); err != nil {
+			return err
+		}
+	}
+
+	if c.Prefix < 1 || c.Prefix > 32 {
+		return fmt.Errorf("prefix %d must be between 1 and 32", c.Prefix)
+	}
+
+	if ip := net.ParseIP(c.Gateway); ip == nil || ip.To4() == nil {
+		return fmt.Errorf("gateway %s is not a valid IPv4 address", c.Gateway)
+	}
+
+	return nil
+}
+
+// validateProxmoxIPv4Address validates a single IPv4 address, a CIDR or a range
+// in the start-end format, as accepted by the CAPI in-cluster IPAM provider.
+func validateProxmoxIPv4Address(address string) error {
+	if _, _, err := net.ParseCIDR(address); err == nil {
+		return nil
+	}
+
+	start, end, isRange := strings.Cut(address, "-")
+	if !isRange {
+		if ip := net.ParseIP(address); ip == nil || ip.To4() == nil {
+			return fmt.Errorf("address %s is not a valid IPv4 address, CIDR or range", address)
+		}
+		return nil
+	}
+
+	startIP, endIP := net.ParseIP(start).To4(), net.ParseIP(end).To4()
+	if startIP == nil || endIP == nil {
+		return fmt.Errorf("address range %s is not a valid IPv4 range", address)
+	}
+
+	if bytes.Compare(startIP, endIP) > 0 {
+		return fmt.Errorf("address range %s starts after it ends", address)
+	}
+
+	return nil
+}
+
+// ProxmoxDatacenterConfigGenerate is same as ProxmoxDatacenterConfig except stripped down for generation of yaml file during generate clusterconfig
+//
+// +kubebuilder:object:generate=false
+type ProxmoxDatacenterConfigGenerate struct {
+	metav1.TypeMeta `json:",inline"`
+	ObjectMeta      `json:"metadata,omitempty"`
+
+	Spec ProxmoxDatacenterConfigSpec `json:"spec,omitempty"`
+}
+
+// ProxmoxDatacenterConfigList contains a list of ProxmoxDatacenterConfig
+//
+// +kubebuilder:object:root=true
+type ProxmoxDatacenterConfigList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ProxmoxDatacenterConfig `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ProxmoxDatacenterConfig{}, &ProxmoxDatacenterConfigList{})
+}
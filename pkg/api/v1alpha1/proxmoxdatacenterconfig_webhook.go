@@ -0,0 +1,123 @@
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// proxmoxdatacenterconfiglog is for logging in this package.
+var proxmoxdatacenterconfiglog = logf.Log.WithName("proxmoxdatacenterconfig-resource")
+
+// SetupWebhookWithManager sets up the webhook with the manager.
+func (in *ProxmoxDatacenterConfig) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(in).
+		WithValidator(in).
+		Complete()
+}
+
+//+kubebuilder:webhook:path=/validate-anywhere-eks-amazonaws-com-v1alpha1-proxmoxdatacenterconfig,mutating=false,failurePolicy=fail,sideEffects=None,groups=anywhere.eks.amazonaws.com,resources=proxmoxdatacenterconfigs,verbs=create;update,versions=v1alpha1,name=validation.proxmoxdatacenterconfig.anywhere.amazonaws.com,admissionReviewVersions={v1,v1beta1}
+
+var _ webhook.CustomValidator = &ProxmoxDatacenterConfig{}
+
+// ValidateCreate implements webhook.CustomValidator so a webhook will be registered for the type.
+func (in *ProxmoxDatacenterConfig) ValidateCreate(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
+	proxmoxConfig, ok := obj.(*ProxmoxDatacenterConfig)
+	if !ok {
+		return nil, fmt.Errorf("expected a ProxmoxDatacenterConfig but got %T", obj)
+	}
+
+	proxmoxdatacenterconfiglog.Info("validate create", "name", proxmoxConfig.Name)
+	if proxmoxConfig.IsReconcilePaused() {
+		proxmoxdatacenterconfiglog.Info("ProxmoxDatacenterConfig is paused, allowing create", "name", proxmoxConfig.Name)
+		return nil, nil
+	}
+
+	if err := proxmoxConfig.Validate(); err != nil {
+		return nil, apierrors.NewInvalid(
+			GroupVersion.WithKind(ProxmoxDatacenterKind).GroupKind(),
+			proxmoxConfig.Name,
+			field.ErrorList{
+				field.Invalid(field.NewPath("spec"), proxmoxConfig.Spec, err.Error()),
+			})
+	}
+
+	return nil, nil
+}
+
+// ValidateUpdate implements webhook.CustomValidator so a webhook will be registered for the type.
+func (in *ProxmoxDatacenterConfig) ValidateUpdate(_ context.Context, old, obj runtime.Object) (admission.Warnings, error) {
+	proxmoxConfig, ok := obj.(*ProxmoxDatacenterConfig)
+	if !ok {
+		return nil, fmt.Errorf("expected a ProxmoxDatacenterConfig but got %T", obj)
+	}
+
+	proxmoxdatacenterconfiglog.Info("validate update", "name", proxmoxConfig.Name)
+	oldDatacenterConfig, ok := old.(*ProxmoxDatacenterConfig)
+	if !ok {
+		return nil, apierrors.NewBadRequest(fmt.Sprintf("expected a ProxmoxDatacenterConfig but got a %T", old))
+	}
+
+	if oldDatacenterConfig.IsReconcilePaused() {
+		proxmoxdatacenterconfiglog.Info("ProxmoxDatacenterConfig is paused, allowing update", "name", proxmoxConfig.Name)
+		return nil, nil
+	}
+
+	var allErrs field.ErrorList
+	allErrs = append(allErrs, validateImmutableFieldsProxmoxDatacenterConfig(proxmoxConfig, oldDatacenterConfig)...)
+
+	if err := proxmoxConfig.Validate(); err != nil {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("spec"), proxmoxConfig.Spec, err.Error()))
+	}
+
+	if len(allErrs) > 0 {
+		return nil, apierrors.NewInvalid(
+			GroupVersion.WithKind(ProxmoxDatacenterKind).GroupKind(),
+			proxmoxConfig.Name,
+			allErrs)
+	}
+
+	return nil, nil
+}
+
+// ValidateDelete implements webhook.CustomValidator so a webhook will be registered for the type.
+func (in *ProxmoxDatacenterConfig) ValidateDelete(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
+	proxmoxConfig, ok := obj.(*ProxmoxDatacenterConfig)
+	if !ok {
+		return nil, fmt.Errorf("expected a ProxmoxDatacenterConfig but got %T", obj)
+	}
+
+	proxmoxdatacenterconfiglog.Info("validate delete", "name", proxmoxConfig.Name)
+
+	return nil, nil
+}
+
+func validateImmutableFieldsProxmoxDatacenterConfig(new, old *ProxmoxDatacenterConfig) field.ErrorList {
+	var allErrs field.ErrorList
+	specPath := field.NewPath("spec")
+
+	if new.Spec.Endpoint != old.Spec.Endpoint {
+		allErrs = append(allErrs, field.Forbidden(specPath.Child("endpoint"), "field is immutable"))
+	}
+
+	// The node addresses are allocated from the pool once, changing the network they live on
+	// would leave the existing nodes unreachable.
+	ipv4Path := specPath.Child("ipv4Config")
+	if new.Spec.IPv4Config.Prefix != old.Spec.IPv4Config.Prefix {
+		allErrs = append(allErrs, field.Forbidden(ipv4Path.Child("prefix"), "field is immutable"))
+	}
+
+	if new.Spec.IPv4Config.Gateway != old.Spec.IPv4Config.Gateway {
+		allErrs = append(allErrs, field.Forbidden(ipv4Path.Child("gateway"), "field is immutable"))
+	}
+
+	return allErrs
+}
@@ -0,0 +1,112 @@
+package v1alpha1_test
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+)
+
+func TestProxmoxDatacenterConfigValidateCreate(t *testing.T) {
+	g := NewWithT(t)
+	dcConf := validProxmoxDatacenterConfig()
+	g.Expect(dcConf.ValidateCreate(context.Background(), dcConf)).Error().To(Succeed())
+}
+
+func TestProxmoxDatacenterConfigValidateCreateReconcilePaused(t *testing.T) {
+	g := NewWithT(t)
+	dcConf := validProxmoxDatacenterConfig()
+	dcConf.Spec.Endpoint = ""
+	dcConf.PauseReconcile()
+	g.Expect(dcConf.ValidateCreate(context.Background(), dcConf)).Error().To(Succeed())
+}
+
+func TestProxmoxDatacenterConfigValidateCreateInvalid(t *testing.T) {
+	g := NewWithT(t)
+	dcConf := validProxmoxDatacenterConfig()
+	dcConf.Spec.Endpoint = ""
+	g.Expect(dcConf.ValidateCreate(context.Background(), dcConf)).Error().To(MatchError(ContainSubstring("endpoint is not set or is empty")))
+}
+
+func TestProxmoxDatacenterConfigValidateCreateCastFail(t *testing.T) {
+	g := NewWithT(t)
+	dcConf := validProxmoxDatacenterConfig()
+	g.Expect(dcConf.ValidateCreate(context.Background(), &v1alpha1.Cluster{})).Error().To(MatchError(ContainSubstring("expected a ProxmoxDatacenterConfig")))
+}
+
+func TestProxmoxDatacenterConfigValidateUpdate(t *testing.T) {
+	tests := []struct {
+		name    string
+		update  func(*v1alpha1.ProxmoxDatacenterConfig)
+		wantErr string
+	}{
+		{
+			name: "mutable nodes and addresses",
+			update: func(c *v1alpha1.ProxmoxDatacenterConfig) {
+				c.Spec.Nodes = []string{"pve1", "pve2"}
+				c.Spec.IPv4Config.Addresses = []string{"10.0.0.10-10.0.0.80"}
+			},
+		},
+		{
+			name:    "immutable endpoint",
+			update:  func(c *v1alpha1.ProxmoxDatacenterConfig) { c.Spec.Endpoint = "https://pve2.example.com:8006" },
+			wantErr: "spec.endpoint: Forbidden: field is immutable",
+		},
+		{
+			name:    "immutable prefix",
+			update:  func(c *v1alpha1.ProxmoxDatacenterConfig) { c.Spec.IPv4Config.Prefix = 16 },
+			wantErr: "spec.ipv4Config.prefix: Forbidden: field is immutable",
+		},
+		{
+			name:    "immutable gateway",
+			update:  func(c *v1alpha1.ProxmoxDatacenterConfig) { c.Spec.IPv4Config.Gateway = "10.0.0.254" },
+			wantErr: "spec.ipv4Config.gateway: Forbidden: field is immutable",
+		},
+		{
+			name:    "invalid spec",
+			update:  func(c *v1alpha1.ProxmoxDatacenterConfig) { c.Spec.DNSServers = nil },
+			wantErr: "dnsServers is not set or is empty",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			oldConf := validProxmoxDatacenterConfig()
+			newConf := validProxmoxDatacenterConfig()
+			tt.update(newConf)
+
+			_, err := newConf.ValidateUpdate(context.Background(), oldConf, newConf)
+			if tt.wantErr == "" {
+				g.Expect(err).NotTo(HaveOccurred())
+			} else {
+				g.Expect(err).To(MatchError(ContainSubstring(tt.wantErr)))
+			}
+		})
+	}
+}
+
+func TestProxmoxDatacenterConfigValidateUpdateReconcilePaused(t *testing.T) {
+	g := NewWithT(t)
+	oldConf := validProxmoxDatacenterConfig()
+	oldConf.PauseReconcile()
+	newConf := validProxmoxDatacenterConfig()
+	newConf.Spec.Endpoint = "https://pve2.example.com:8006"
+	g.Expect(newConf.ValidateUpdate(context.Background(), oldConf, newConf)).Error().To(Succeed())
+}
+
+func TestProxmoxDatacenterConfigValidateUpdateCastFail(t *testing.T) {
+	g := NewWithT(t)
+	dcConf := validProxmoxDatacenterConfig()
+	g.Expect(dcConf.ValidateUpdate(context.Background(), &v1alpha1.Cluster{}, dcConf)).Error().To(MatchError(ContainSubstring("expected a ProxmoxDatacenterConfig")))
+	g.Expect(dcConf.ValidateUpdate(context.Background(), dcConf, &v1alpha1.Cluster{})).Error().To(MatchError(ContainSubstring("expected a ProxmoxDatacenterConfig")))
+}
+
+func TestProxmoxDatacenterConfigValidateDelete(t *testing.T) {
+	g := NewWithT(t)
+	dcConf := validProxmoxDatacenterConfig()
+	g.Expect(dcConf.ValidateDelete(context.Background(), dcConf)).Error().To(Succeed())
+	g.Expect(dcConf.ValidateDelete(context.Background(), &v1alpha1.Cluster{})).Error().To(HaveOccurred())
+}
@@ -0,0 +1,145 @@
+package v1alpha1
+
+import (
+	"errors"
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// ProxmoxMachineConfigKind is the kind for a ProxmoxMachineConfig.
+	ProxmoxMachineConfigKind = "ProxmoxMachineConfig"
+
+	// DefaultProxmoxMachineConfigUser is the default username we set in machine config.
+	DefaultProxmoxMachineConfigUser string = "eksa"
+
+	defaultProxmoxOSFamily   = Ubuntu
+	defaultProxmoxNumSockets = 1
+	defaultProxmoxNumCores   = 2
+	defaultProxmoxMemoryMiB  = 4096
+	defaultProxmoxDiskGiB    = 25
+
+	minProxmoxMemoryMiB = 2048
+	minProxmoxDiskGiB   = 20
+)
+
+// NewProxmoxMachineConfigGenerate returns a new instance of ProxmoxMachineConfigGenerate
+// used for generating yaml for generate clusterconfig command.
+func NewProxmoxMachineConfigGenerate(name string) *ProxmoxMachineConfigGenerate {
+	return &ProxmoxMachineConfigGenerate{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       ProxmoxMachineConfigKind,
+			APIVersion: SchemeBuilder.GroupVersion.String(),
+		},
+		ObjectMeta: ObjectMeta{
+			Name: name,
+		},
+		Spec: ProxmoxMachineConfigSpec{
+			OSFamily: defaultProxmoxOSFamily,
+			Users: []UserConfiguration{
+				{
+					Name:              DefaultProxmoxMachineConfigUser,
+					SshAuthorizedKeys: []string{"ssh-rsa AAAA..."},
+				},
+			},
+			SourceNode: "<enter Proxmox VE node name here>",
+			TemplateID: 100,
+			NumSockets: defaultProxmoxNumSockets,
+			NumCores:   defaultProxmoxNumCores,
+			MemoryMiB:  defaultProxmoxMemoryMiB,
+			DiskGiB:    defaultProxmoxDiskGiB,
+			Bridge:     "vmbr0",
+		},
+	}
+}
+
+func (c *ProxmoxMachineConfigGenerate) APIVersion() string {
+	return c.TypeMeta.APIVersion
+}
+
+func (c *ProxmoxMachineConfigGenerate) Kind() string {
+	return c.TypeMeta.Kind
+}
+
+func (c *ProxmoxMachineConfigGenerate) Name() string {
+	return c.ObjectMeta.Name
+}
+
+func setProxmoxMachineConfigDefaults(machineConfig *ProxmoxMachineConfig) {
+	if len(machineConfig.Spec.Users) == 0 {
+		machineConfig.Spec.Users = []UserConfiguration{{}}
+	}
+
+	if machineConfig.Spec.Users[0].Name == "" {
+		machineConfig.Spec.Users[0].Name = DefaultProxmoxMachineConfigUser
+	}
+
+	if len(machineConfig.Spec.Users[0].SshAuthorizedKeys) == 0 {
+		machineConfig.Spec.Users[0].SshAuthorizedKeys = []string{""}
+	}
+
+	if machineConfig.Spec.OSFamily == "" {
+		machineConfig.Spec.OSFamily = defaultProxmoxOSFamily
+	}
+
+	if machineConfig.Spec.NumSockets == 0 {
+		machineConfig.Spec.NumSockets = defaultProxmoxNumSockets
+	}
+
+	if machineConfig.Spec.NumCores == 0 {
+		machineConfig.Spec.NumCores = defaultProxmoxNumCores
+	}
+
+	if machineConfig.Spec.MemoryMiB == 0 {
+		machineConfig.Spec.MemoryMiB = defaultProxmoxMemoryMiB
+	}
+
+	if machineConfig.Spec.DiskGiB == 0 {
+		machineConfig.Spec.DiskGiB = defaultProxmoxDiskGiB
+	}
+}
+
+func validateProxmoxMachineConfig(c *ProxmoxMachineConfig) error {
+	if err := validateObjectMeta(c.ObjectMeta); err != nil {
+		return fmt.Errorf("ProxmoxMachineConfig: %v", err)
+	}
+
+	if c.Spec.OSFamily != Ubuntu {
+		return fmt.Errorf("ProxmoxMachineConfig: unsupported spec.osFamily (%v); Please use one of the following: %s", c.Spec.OSFamily, Ubuntu)
+	}
+
+	if c.Spec.SourceNode == "" {
+		return errors.New("ProxmoxMachineConfig: sourceNode is not set or is empty")
+	}
+
+	if c.Spec.TemplateID < 100 {
+		return fmt.Errorf("ProxmoxMachineConfig: templateID %d is not a valid VM ID", c.Spec.TemplateID)
+	}
+
+	if c.Spec.Bridge == "" {
+		return errors.New("ProxmoxMachineConfig: bridge is not set or is empty")
+	}
+
+	if c.Spec.NumSockets < 1 {
+		return errors.New("ProxmoxMachineConfig: numSockets must be greater than or equal to 1")
+	}
+
+	if c.Spec.NumCores < 1 {
+		return errors.New("ProxmoxMachineConfig: numCores must be greater than or equal to 1")
+	}
+
+	if c.Spec.MemoryMiB < minProxmoxMemoryMiB {
+		return fmt.Errorf("ProxmoxMachineConfig: memoryMiB must be greater than or equal to %d", minProxmoxMemoryMiB)
+	}
+
+	if c.Spec.DiskGiB < minProxmoxDiskGiB {
+		return fmt.Errorf("ProxmoxMachineConfig: diskGiB must be greater than or equal to %d", minProxmoxDiskGiB)
+	}
+
+	if len(c.Spec.Users) == 0 || c.Spec.Users[0].Name == "" {
+		return fmt.Errorf("ProxmoxMachineConfig: users[0].name is not set or is empty for %s", c.Name)
+	}
+
+	return nil
+}
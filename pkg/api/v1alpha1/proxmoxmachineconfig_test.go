@@ -0,0 +1,102 @@
+package v1alpha1_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+)
+
+func TestProxmoxMachineConfigSetDefaults(t *testing.T) {
+	g := NewWithT(t)
+	config := &v1alpha1.ProxmoxMachineConfig{}
+	config.SetDefaults()
+
+	g.Expect(config.Spec).To(Equal(v1alpha1.ProxmoxMachineConfigSpec{
+		OSFamily: v1alpha1.Ubuntu,
+		Users: []v1alpha1.UserConfiguration{
+			{Name: v1alpha1.DefaultProxmoxMachineConfigUser, SshAuthorizedKeys: []string{""}},
+		},
+		NumSockets: 1,
+		NumCores:   2,
+		MemoryMiB:  4096,
+		DiskGiB:    25,
+	}))
+}
+
+func TestProxmoxMachineConfigValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		update  func(*v1alpha1.ProxmoxMachineConfig)
+		wantErr string
+	}{
+		{
+			name:   "valid",
+			update: func(*v1alpha1.ProxmoxMachineConfig) {},
+		},
+		{
+			name:    "unsupported os family",
+			update:  func(c *v1alpha1.ProxmoxMachineConfig) { c.Spec.OSFamily = v1alpha1.Bottlerocket },
+			wantErr: "ProxmoxMachineConfig: unsupported spec.osFamily (bottlerocket); Please use one of the following: ubuntu",
+		},
+		{
+			name:    "empty source node",
+			update:  func(c *v1alpha1.ProxmoxMachineConfig) { c.Spec.SourceNode = "" },
+			wantErr: "ProxmoxMachineConfig: sourceNode is not set or is empty",
+		},
+		{
+			name:    "invalid template id",
+			update:  func(c *v1alpha1.ProxmoxMachineConfig) { c.Spec.TemplateID = 99 },
+			wantErr: "ProxmoxMachineConfig: templateID 99 is not a valid VM ID",
+		},
+		{
+			name:    "empty bridge",
+			update:  func(c *v1alpha1.ProxmoxMachineConfig) { c.Spec.Bridge = "" },
+			wantErr: "ProxmoxMachineConfig: bridge is not set or is empty",
+		},
+		{
+			name:    "memory too small",
+			update:  func(c *v1alpha1.ProxmoxMachineConfig) { c.Spec.MemoryMiB = 1024 },
+			wantErr: "ProxmoxMachineConfig: memoryMiB must be greater than or equal to 2048",
+		},
+		{
+			name:    "disk too small",
+			update:  func(c *v1alpha1.ProxmoxMachineConfig) { c.Spec.DiskGiB = 10 },
+			wantErr: "ProxmoxMachineConfig: diskGiB must be greater than or equal to 20",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			config := &v1alpha1.ProxmoxMachineConfig{
+				Spec: v1alpha1.ProxmoxMachineConfigSpec{
+					SourceNode: "pve1",
+					TemplateID: 9000,
+					Bridge:     "vmbr0",
+				},
+			}
+			config.Name = "test"
+			config.SetDefaults()
+			tt.update(config)
+
+			err := config.Validate()
+			if tt.wantErr == "" {
+				g.Expect(err).NotTo(HaveOccurred())
+			} else {
+				g.Expect(err).To(MatchError(tt.wantErr))
+			}
+		})
+	}
+}
+
+func TestNewProxmoxMachineConfigGenerate(t *testing.T) {
+	g := NewWithT(t)
+	config := v1alpha1.NewProxmoxMachineConfigGenerate("test-cp")
+
+	g.Expect(config.Kind()).To(Equal(v1alpha1.ProxmoxMachineConfigKind))
+	g.Expect(config.Name()).To(Equal("test-cp"))
+	g.Expect(config.Spec.OSFamily).To(Equal(v1alpha1.Ubuntu))
+	g.Expect(config.Spec.Users[0].Name).To(Equal(v1alpha1.DefaultProxmoxMachineConfigUser))
+}
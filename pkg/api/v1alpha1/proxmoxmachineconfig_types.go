@@ -0,0 +1,206 @@
+// Important: Run "make generate" to regenerate code after modifying this file
+// json tags are required; new fields must have json tags for the fields to be serialized
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ProxmoxMachineConfigSpec defines the desired state of ProxmoxMachineConfig.
+type ProxmoxMachineConfigSpec struct {
+	OSFamily OSFamily            `json:"osFamily"`
+	Users    []UserConfiguration `json:"users,omitempty"`
+
+	// SourceNode is the Proxmox VE node the VM template lives on.
+	// +kubebuilder:validation:Required
+	SourceNode string `json:"sourceNode"`
+
+	// TemplateID is the VM ID of the template the VMs are cloned from.
+	// The template must be built for the cluster's Kubernetes version.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Minimum=100
+	TemplateID int32 `json:"templateID"`
+
+	// Storage is the storage the cloned VM disks are created on. If not set,
+	// the disks are created on the same storage as the template's.
+	// +optional
+	Storage string `json:"storage,omitempty"`
+
+	// NumSockets is the number of CPU sockets of the VM.
+	// +kubebuilder:validation:Minimum=1
+	NumSockets int32 `json:"numSockets"`
+
+	// NumCores is the number of cores per CPU socket of the VM.
+	// +kubebuilder:validation:Minimum=1
+	NumCores int32 `json:"numCores"`
+
+	// MemoryMiB is the memory size of the VM in MiB.
+	// +kubebuilder:validation:Minimum=2048
+	MemoryMiB int32 `json:"memoryMiB"`
+
+	// DiskGiB is the size of the VM boot disk in GiB.
+	// +kubebuilder:validation:Minimum=20
+	DiskGiB int32 `json:"diskGiB"`
+
+	// Bridge is the network bridge the VM's network interface is attached to, e.g. vmbr0.
+	// +kubebuilder:validation:Required
+	Bridge string `json:"bridge"`
+}
+
+// SetDefaults sets defaults to ProxmoxMachineConfig if user has not provided.
+func (in *ProxmoxMachineConfig) SetDefaults() {
+	setProxmoxMachineConfigDefaults(in)
+}
+
+// PauseReconcile pauses the reconciliation of the ProxmoxMachineConfig.
+func (in *ProxmoxMachineConfig) PauseReconcile() {
+	if in.Annotations == nil {
+		in.Annotations = map[string]string{}
+	}
+	in.Annotations[pausedAnnotation] = "true"
+}
+
+// IsReconcilePaused returns true if the ProxmoxMachineConfig is paused.
+func (in *ProxmoxMachineConfig) IsReconcilePaused() bool {
+	if s, ok := in.Annotations[pausedAnnotation]; ok {
+		return s == "true"
+	}
+	return false
+}
+
+// SetControlPlane sets the ProxmoxMachineConfig as a control plane node.
+func (in *ProxmoxMachineConfig) SetControlPlane() {
+	if in.Annotations == nil {
+		in.Annotations = map[string]string{}
+	}
+	in.Annotations[controlPlaneAnnotation] = "true"
+}
+
+// IsControlPlane returns true if the ProxmoxMachineConfig is a control plane node.
+func (in *ProxmoxMachineConfig) IsControlPlane() bool {
+	if s, ok := in.Annotations[controlPlaneAnnotation]; ok {
+		return s == "true"
+	}
+	return false
+}
+
+// SetEtcd sets the ProxmoxMachineConfig as an etcd node.
+func (in *ProxmoxMachineConfig) SetEtcd() {
+	if in.Annotations == nil {
+		in.Annotations = map[string]string{}
+	}
+	in.Annotations[etcdAnnotation] = "true"
+}
+
+// IsEtcd returns true if the ProxmoxMachineConfig is an etcd node.
+func (in *ProxmoxMachineConfig) IsEtcd() bool {
+	if s, ok := in.Annotations[etcdAnnotation]; ok {
+		return s == "true"
+	}
+	return false
+}
+
+// SetManagedBy sets the cluster name that manages the ProxmoxMachineConfig.
+func (in *ProxmoxMachineConfig) SetManagedBy(clusterName string) {
+	if in.Annotations == nil {
+		in.Annotations = map[string]string{}
+	}
+	in.Annotations[managementAnnotation] = clusterName
+}
+
+// IsManaged returns true if the ProxmoxMachineConfig is managed by a cluster.
+func (in *ProxmoxMachineConfig) IsManaged() bool {
+	if s, ok := in.Annotations[managementAnnotation]; ok {
+		return s != ""
+	}
+	return false
+}
+
+// OSFamily returns the OSFamily of the ProxmoxMachineConfig.
+func (in *ProxmoxMachineConfig) OSFamily() OSFamily {
+	return in.Spec.OSFamily
+}
+
+// Users returns a list of configuration for OS users.
+func (in *ProxmoxMachineConfig) Users() []UserConfiguration {
+	return in.Spec.Users
+}
+
+// GetNamespace returns the namespace of the ProxmoxMachineConfig.
+func (in *ProxmoxMachineConfig) GetNamespace() string {
+	return in.Namespace
+}
+
+// GetName returns the name of the ProxmoxMachineConfig.
+func (in *ProxmoxMachineConfig) GetName() string {
+	return in.Name
+}
+
+// ProxmoxMachineConfigStatus defines the observed state of ProxmoxMachineConfig.
+type ProxmoxMachineConfigStatus struct{}
+
+// ProxmoxMachineConfig is the Schema for the proxmox machine configs API
+//
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+type ProxmoxMachineConfig struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ProxmoxMachineConfigSpec   `json:"spec,omitempty"`
+	Status ProxmoxMachineConfigStatus `json:"status,omitempty"`
+}
+
+// ConvertConfigToConfigGenerateStruct converts the ProxmoxMachineConfig to ProxmoxMachineConfigGenerate.
+func (in *ProxmoxMachineConfig) ConvertConfigToConfigGenerateStruct() *ProxmoxMachineConfigGenerate {
+	namespace := defaultEksaNamespace
+	if in.Namespace != "" {
+		namespace = in.Namespace
+	}
+	config := &ProxmoxMachineConfigGenerate{
+		TypeMeta: in.TypeMeta,
+		ObjectMeta: ObjectMeta{
+			Name:        in.Name,
+			Annotations: in.Annotations,
+			Namespace:   namespace,
+		},
+		Spec: in.Spec,
+	}
+
+	return config
+}
+
+// Marshallable returns a Marshallable version of the ProxmoxMachineConfig.
+func (in *ProxmoxMachineConfig) Marshallable() Marshallable {
+	return in.ConvertConfigToConfigGenerateStruct()
+}
+
+// Validate validates the ProxmoxMachineConfig.
+func (in *ProxmoxMachineConfig) Validate() error {
+	return validateProxmoxMachineConfig(in)
+}
+
+// ProxmoxMachineConfigGenerate is same as ProxmoxMachineConfig except stripped down for generation of yaml file during
+// generate clusterconfig
+//
+// +kubebuilder:object:generate=false
+type ProxmoxMachineConfigGenerate struct {
+	metav1.TypeMeta `json:",inline"`
+	ObjectMeta      `json:"metadata,omitempty"`
+
+	Spec ProxmoxMachineConfigSpec `json:"spec,omitempty"`
+}
+
+// ProxmoxMachineConfigList contains a list of ProxmoxMachineConfig
+//
+// +kubebuilder:object:root=true
+type ProxmoxMachineConfigList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ProxmoxMachineConfig `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ProxmoxMachineConfig{}, &ProxmoxMachineConfigList{})
+}
@@ -0,0 +1,127 @@
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// proxmoxmachineconfiglog is for logging in this package.
+var proxmoxmachineconfiglog = logf.Log.WithName("proxmoxmachineconfig-resource")
+
+// SetupWebhookWithManager sets up and registers the webhook with the manager.
+func (in *ProxmoxMachineConfig) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(in).
+		WithValidator(in).
+		Complete()
+}
+
+//+kubebuilder:webhook:path=/validate-anywhere-eks-amazonaws-com-v1alpha1-proxmoxmachineconfig,mutating=false,failurePolicy=fail,sideEffects=None,groups=anywhere.eks.amazonaws.com,resources=proxmoxmachineconfigs,verbs=create;update,versions=v1alpha1,name=validation.proxmoxmachineconfig.anywhere.amazonaws.com,admissionReviewVersions={v1,v1beta1}
+
+var _ webhook.CustomValidator = &ProxmoxMachineConfig{}
+
+// ValidateCreate implements webhook.CustomValidator so a webhook will be registered for the type.
+func (in *ProxmoxMachineConfig) ValidateCreate(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
+	proxmoxConfig, ok := obj.(*ProxmoxMachineConfig)
+	if !ok {
+		return nil, fmt.Errorf("expected a ProxmoxMachineConfig but got %T", obj)
+	}
+
+	proxmoxmachineconfiglog.Info("validate create", "name", proxmoxConfig.Name)
+	if err := proxmoxConfig.Validate(); err != nil {
+		return nil, apierrors.NewInvalid(
+			GroupVersion.WithKind(ProxmoxMachineConfigKind).GroupKind(),
+			proxmoxConfig.Name,
+			field.ErrorList{
+				field.Invalid(field.NewPath("spec"), proxmoxConfig.Spec, err.Error()),
+			},
+		)
+	}
+
+	return nil, nil
+}
+
+// ValidateUpdate implements webhook.CustomValidator so a webhook will be registered for the type.
+func (in *ProxmoxMachineConfig) ValidateUpdate(_ context.Context, old, obj runtime.Object) (admission.Warnings, error) {
+	proxmoxConfig, ok := obj.(*ProxmoxMachineConfig)
+	if !ok {
+		return nil, fmt.Errorf("expected a ProxmoxMachineConfig but got %T", obj)
+	}
+
+	proxmoxmachineconfiglog.Info("validate update", "name", proxmoxConfig.Name)
+
+	oldProxmoxMachineConfig, ok := old.(*ProxmoxMachineConfig)
+	if !ok {
+		return nil, apierrors.NewBadRequest(fmt.Sprintf("expected a ProxmoxMachineConfig but got a %T", old))
+	}
+
+	var allErrs field.ErrorList
+	allErrs = append(allErrs, validateImmutableFieldsProxmoxMachineConfig(proxmoxConfig, oldProxmoxMachineConfig)...)
+
+	if err := proxmoxConfig.Validate(); err != nil {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("spec"), proxmoxConfig.Spec, err.Error()))
+	}
+
+	if len(allErrs) > 0 {
+		return nil, apierrors.NewInvalid(
+			GroupVersion.WithKind(ProxmoxMachineConfigKind).GroupKind(),
+			proxmoxConfig.Name,
+			allErrs,
+		)
+	}
+
+	return nil, nil
+}
+
+// ValidateDelete implements webhook.CustomValidator so a webhook will be registered for the type.
+func (in *ProxmoxMachineConfig) ValidateDelete(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
+	proxmoxConfig, ok := obj.(*ProxmoxMachineConfig)
+	if !ok {
+		return nil, fmt.Errorf("expected a ProxmoxMachineConfig but got %T", obj)
+	}
+
+	proxmoxmachineconfiglog.Info("validate delete", "name", proxmoxConfig.Name)
+
+	return nil, nil
+}
+
+func validateImmutableFieldsProxmoxMachineConfig(new, old *ProxmoxMachineConfig) field.ErrorList {
+	if old.IsReconcilePaused() {
+		proxmoxmachineconfiglog.Info("Reconciliation is paused")
+		return nil
+	}
+
+	var allErrs field.ErrorList
+	specPath := field.NewPath("spec")
+
+	if new.Spec.OSFamily != old.Spec.OSFamily {
+		allErrs = append(allErrs, field.Forbidden(specPath.Child("osFamily"), "field is immutable"))
+	}
+
+	if old.IsManaged() {
+		proxmoxmachineconfiglog.Info("Machine config is associated with workload cluster", "name", old.Name)
+		return allErrs
+	}
+
+	if !old.IsEtcd() && !old.IsControlPlane() {
+		proxmoxmachineconfiglog.Info("Machine config is associated with management cluster's worker nodes", "name", old.Name)
+		return allErrs
+	}
+
+	proxmoxmachineconfiglog.Info("Machine config is associated with management cluster's control plane or etcd", "name", old.Name)
+
+	if !reflect.DeepEqual(new.Spec.Users, old.Spec.Users) {
+		allErrs = append(allErrs, field.Forbidden(specPath.Child("users"), "field is immutable"))
+	}
+
+	return allErrs
+}
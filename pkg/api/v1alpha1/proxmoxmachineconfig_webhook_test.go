@@ -0,0 +1,130 @@
+package v1alpha1_test
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+)
+
+func proxmoxMachineConfig() *v1alpha1.ProxmoxMachineConfig {
+	config := &v1alpha1.ProxmoxMachineConfig{
+		Spec: v1alpha1.ProxmoxMachineConfigSpec{
+			SourceNode: "pve1",
+			TemplateID: 9000,
+			Bridge:     "vmbr0",
+		},
+	}
+	config.Name = "test"
+	config.SetDefaults()
+	return config
+}
+
+func TestProxmoxMachineConfigValidateCreate(t *testing.T) {
+	g := NewWithT(t)
+	config := proxmoxMachineConfig()
+	g.Expect(config.ValidateCreate(context.Background(), config)).Error().To(Succeed())
+}
+
+func TestProxmoxMachineConfigValidateCreateInvalid(t *testing.T) {
+	g := NewWithT(t)
+	config := proxmoxMachineConfig()
+	config.Spec.Bridge = ""
+	g.Expect(config.ValidateCreate(context.Background(), config)).Error().To(MatchError(ContainSubstring("bridge is not set or is empty")))
+}
+
+func TestProxmoxMachineConfigValidateCreateCastFail(t *testing.T) {
+	g := NewWithT(t)
+	config := proxmoxMachineConfig()
+	g.Expect(config.ValidateCreate(context.Background(), &v1alpha1.Cluster{})).Error().To(MatchError(ContainSubstring("expected a ProxmoxMachineConfig")))
+}
+
+func TestProxmoxMachineConfigValidateUpdate(t *testing.T) {
+	tests := []struct {
+		name    string
+		old     func(*v1alpha1.ProxmoxMachineConfig)
+		update  func(*v1alpha1.ProxmoxMachineConfig)
+		wantErr string
+	}{
+		{
+			name: "mutable template and size",
+			old:  func(*v1alpha1.ProxmoxMachineConfig) {},
+			update: func(c *v1alpha1.ProxmoxMachineConfig) {
+				c.Spec.TemplateID = 9001
+				c.Spec.MemoryMiB = 8192
+			},
+		},
+		{
+			name:    "immutable os family",
+			old:     func(*v1alpha1.ProxmoxMachineConfig) {},
+			update:  func(c *v1alpha1.ProxmoxMachineConfig) { c.Spec.OSFamily = v1alpha1.Bottlerocket },
+			wantErr: "spec.osFamily: Forbidden: field is immutable",
+		},
+		{
+			name:    "immutable users of management control plane",
+			old:     func(c *v1alpha1.ProxmoxMachineConfig) { c.SetControlPlane() },
+			update:  func(c *v1alpha1.ProxmoxMachineConfig) { c.Spec.Users[0].Name = "admin" },
+			wantErr: "spec.users: Forbidden: field is immutable",
+		},
+		{
+			name: "mutable users of workload control plane",
+			old: func(c *v1alpha1.ProxmoxMachineConfig) {
+				c.SetControlPlane()
+				c.SetManagedBy("mgmt")
+			},
+			update: func(c *v1alpha1.ProxmoxMachineConfig) { c.Spec.Users[0].Name = "admin" },
+		},
+		{
+			name:   "mutable users of management workers",
+			old:    func(*v1alpha1.ProxmoxMachineConfig) {},
+			update: func(c *v1alpha1.ProxmoxMachineConfig) { c.Spec.Users[0].Name = "admin" },
+		},
+		{
+			name: "paused",
+			old: func(c *v1alpha1.ProxmoxMachineConfig) {
+				c.SetControlPlane()
+				c.PauseReconcile()
+			},
+			update: func(c *v1alpha1.ProxmoxMachineConfig) { c.Spec.Users[0].Name = "admin" },
+		},
+		{
+			name:    "invalid spec",
+			old:     func(*v1alpha1.ProxmoxMachineConfig) {},
+			update:  func(c *v1alpha1.ProxmoxMachineConfig) { c.Spec.DiskGiB = 10 },
+			wantErr: "diskGiB must be greater than or equal to 20",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			oldConf := proxmoxMachineConfig()
+			tt.old(oldConf)
+			newConf := oldConf.DeepCopy()
+			tt.update(newConf)
+
+			_, err := newConf.ValidateUpdate(context.Background(), oldConf, newConf)
+			if tt.wantErr == "" {
+				g.Expect(err).NotTo(HaveOccurred())
+			} else {
+				g.Expect(err).To(MatchError(ContainSubstring(tt.wantErr)))
+			}
+		})
+	}
+}
+
+func TestProxmoxMachineConfigValidateUpdateCastFail(t *testing.T) {
+	g := NewWithT(t)
+	config := proxmoxMachineConfig()
+	g.Expect(config.ValidateUpdate(context.Background(), &v1alpha1.Cluster{}, config)).Error().To(MatchError(ContainSubstring("expected a ProxmoxMachineConfig")))
+	g.Expect(config.ValidateUpdate(context.Background(), config, &v1alpha1.Cluster{})).Error().To(MatchError(ContainSubstring("expected a ProxmoxMachineConfig")))
+}
+
+func TestProxmoxMachineConfigValidateDelete(t *testing.T) {
+	g := NewWithT(t)
+	config := proxmoxMachineConfig()
+	g.Expect(config.ValidateDelete(context.Background(), config)).Error().To(Succeed())
+	g.Expect(config.ValidateDelete(context.Background(), &v1alpha1.Cluster{})).Error().To(HaveOccurred())
+}
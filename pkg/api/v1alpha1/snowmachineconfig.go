@@ -100,6 +100,15 @@ func validateSnowMachineConfig(config *SnowMachineConfig) error {
 		return fmt.Errorf("SnowMachineConfig HostOSConfiguration is invalid: %v", err)
 	}
 
+	if hostOS := config.Spec.HostOSConfiguration; hostOS != nil && hostOS.BottlerocketConfiguration != nil &&
+		(len(hostOS.BottlerocketConfiguration.BootstrapContainers) > 0 || len(hostOS.BottlerocketConfiguration.HostContainers) > 0) {
+		return errors.New("SnowMachineConfig HostOSConfiguration bottlerocket bootstrapContainers and hostContainers are not supported")
+	}
+
+	if hostOS := config.Spec.HostOSConfiguration; hostOS != nil && hostOS.ContainerdConfiguration != nil {
+		return errors.New("SnowMachineConfig HostOSConfiguration containerdConfiguration is not supported")
+	}
+
 	return validateSnowMachineConfigNonRootVolumes(config.Spec.NonRootVolumes)
 }
 
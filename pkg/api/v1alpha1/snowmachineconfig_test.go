@@ -594,6 +594,70 @@ func TestSnowMachineConfigValidate(t *testing.T) {
 			},
 			wantErr: "SnowMachineConfig HostOSConfiguration is invalid: BottlerocketConfiguration can only be used with osFamily: \"bottlerocket\"",
 		},
+		{
+			name: "bottlerocket host containers",
+			obj: &SnowMachineConfig{
+				Spec: SnowMachineConfigSpec{
+					AMIID:                    "ami-1",
+					InstanceType:             DefaultSnowInstanceType,
+					PhysicalNetworkConnector: DefaultSnowPhysicalNetworkConnectorType,
+					Devices:                  []string{"1.2.3.4"},
+					OSFamily:                 Bottlerocket,
+					HostOSConfiguration: &HostOSConfiguration{
+						BottlerocketConfiguration: &BottlerocketConfiguration{
+							HostContainers: []BottlerocketHostContainer{
+								{Name: "agent", Image: "public.ecr.aws/agent:v1"},
+							},
+						},
+					},
+					ContainersVolume: &snowv1.Volume{
+						Size: 25,
+					},
+					Network: SnowNetwork{
+						DirectNetworkInterfaces: []SnowDirectNetworkInterface{
+							{
+								Index:   1,
+								DHCP:    true,
+								Primary: true,
+							},
+						},
+					},
+				},
+			},
+			wantErr: "SnowMachineConfig HostOSConfiguration bottlerocket bootstrapContainers and hostContainers are not supported",
+		},
+		{
+			name: "containerd config drop-ins",
+			obj: &SnowMachineConfig{
+				Spec: SnowMachineConfigSpec{
+					AMIID:                    "ami-1",
+					InstanceType:             DefaultSnowInstanceType,
+					PhysicalNetworkConnector: DefaultSnowPhysicalNetworkConnectorType,
+					Devices:                  []string{"1.2.3.4"},
+					OSFamily:                 Ubuntu,
+					HostOSConfiguration: &HostOSConfiguration{
+						ContainerdConfiguration: &ContainerdConfiguration{
+							ConfigDropIns: []ContainerdConfigDropIn{
+								{Name: "nri", Content: "[plugins.\"io.containerd.nri.v1.nri\"]\ndisable = false\n"},
+							},
+						},
+					},
+					ContainersVolume: &snowv1.Volume{
+						Size: 25,
+					},
+					Network: SnowNetwork{
+						DirectNetworkInterfaces: []SnowDirectNetworkInterface{
+							{
+								Index:   1,
+								DHCP:    true,
+								Primary: true,
+							},
+						},
+					},
+				},
+			},
+			wantErr: "SnowMachineConfig HostOSConfiguration containerdConfiguration is not supported",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -84,6 +84,10 @@ func validateDatacenterConfig(config *TinkerbellDatacenterConfig) error {
 		if config.Spec.HookIsoURL != "" {
 			return fmt.Errorf("isoURL can be set, only when isoBoot is set to true")
 		}
+
+		if config.Spec.DisableDHCP {
+			return fmt.Errorf("disableDHCP can be set, only when isoBoot is set to true")
+		}
 	}
 
 	return nil
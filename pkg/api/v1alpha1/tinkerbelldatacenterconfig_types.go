@@ -34,6 +34,11 @@ type TinkerbellDatacenterConfigSpec struct {
 	// It can be used to override the default Hook OS ISO image to pull from a local server.
 	//+optional
 	HookIsoURL string `json:"hookIsoURL,omitempty"`
+	// DisableDHCP disables the DHCP server of the Tinkerbell stack, for environments where DHCP is not
+	// allowed on the provisioning network. It requires IsoBoot: machines boot HookOS from virtual media
+	// mounted through their BMC and get a static network configuration from their Hardware object.
+	//+optional
+	DisableDHCP bool `json:"disableDHCP,omitempty"`
 }
 
 // TinkerbellDatacenterConfigStatus defines the observed state of TinkerbellDatacenterConfig
@@ -51,6 +51,13 @@ func TestTinkerbellDatacenterConfigValidateFail(t *testing.T) {
 			}),
 			wantErr: "parsing hookIsoURL: parse \"test\": invalid URI for request",
 		},
+		{
+			name: "DHCP disabled, isoBoot not enabled",
+			tinkDC: newTinkerbellDatacenterConfig(func(dc *v1alpha1.TinkerbellDatacenterConfig) {
+				dc.Spec.DisableDHCP = true
+			}),
+			wantErr: "disableDHCP can be set, only when isoBoot is set to true",
+		},
 		{
 			name: "Invalid hook Image URL",
 			tinkDC: newTinkerbellDatacenterConfig(func(dc *v1alpha1.TinkerbellDatacenterConfig) {
@@ -98,6 +105,15 @@ func TestTinkerbellDatacenterConfigIsoURLValidateSuccess(t *testing.T) {
 	g.Expect(tinkDC.Validate()).To(Succeed())
 }
 
+func TestTinkerbellDatacenterConfigIsoBootDisableDHCPValidateSuccess(t *testing.T) {
+	tinkDC := createTinkerbellDatacenterConfig()
+	tinkDC.Spec.IsoBoot = true
+	tinkDC.Spec.DisableDHCP = true
+
+	g := NewWithT(t)
+	g.Expect(tinkDC.Validate()).To(Succeed())
+}
+
 func newTinkerbellDatacenterConfig(opts ...func(*v1alpha1.TinkerbellDatacenterConfig)) *v1alpha1.TinkerbellDatacenterConfig {
 	c := createTinkerbellDatacenterConfig()
 	for _, o := range opts {
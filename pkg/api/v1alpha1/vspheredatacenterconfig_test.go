@@ -291,6 +291,103 @@ func TestValidateVSphereDatacenterConfig(t *testing.T) {
 			},
 			expectedError: "invalid path",
 		},
+		{
+			testName:              "Invalid VSphereDatacenterConfig with same region and zone tag categories in FailureDomain",
+			modifyFunc: func(v *VSphereDatacenterConfig) {
+				v.Spec.FailureDomains[0].RegionTagCategory = "placement"
+				v.Spec.FailureDomains[0].ZoneTagCategory = "placement"
+			},
+			expectedError: "regionTagCategory and zoneTagCategory must be different",
+		},
+		{
+			testName:              "valid VSphereDatacenterConfig with topologyCategories",
+			modifyFunc: func(v *VSphereDatacenterConfig) {
+				v.Spec.TopologyCategories = &VSphereTopologyCategories{
+					Region: "k8s-region",
+					Zone:   "k8s-zone",
+				}
+				v.Spec.FailureDomains[0].ZoneTagCategory = "k8s-zone"
+			},
+		},
+		{
+			testName:              "Invalid VSphereDatacenterConfig with missing region in topologyCategories",
+			modifyFunc: func(v *VSphereDatacenterConfig) {
+				v.Spec.TopologyCategories = &VSphereTopologyCategories{
+					Zone: "k8s-zone",
+				}
+			},
+			expectedError: "VSphereDatacenterConfig topologyCategories is invalid: region is not set or is empty",
+		},
+		{
+			testName:              "Invalid VSphereDatacenterConfig with missing zone in topologyCategories",
+			modifyFunc: func(v *VSphereDatacenterConfig) {
+				v.Spec.TopologyCategories = &VSphereTopologyCategories{
+					Region: "k8s-region",
+				}
+			},
+			expectedError: "VSphereDatacenterConfig topologyCategories is invalid: zone is not set or is empty",
+		},
+		{
+			testName:              "Invalid VSphereDatacenterConfig with same region and zone in topologyCategories",
+			modifyFunc: func(v *VSphereDatacenterConfig) {
+				v.Spec.TopologyCategories = &VSphereTopologyCategories{
+					Region: "k8s",
+					Zone:   "k8s",
+				}
+			},
+			expectedError: "VSphereDatacenterConfig topologyCategories is invalid: region and zone must be different",
+		},
+		{
+			testName:              "Invalid VSphereDatacenterConfig with FailureDomain region tag category different from topologyCategories",
+			modifyFunc: func(v *VSphereDatacenterConfig) {
+				v.Spec.TopologyCategories = &VSphereTopologyCategories{
+					Region: "k8s-region",
+					Zone:   "k8s-zone",
+				}
+				v.Spec.FailureDomains[0].RegionTagCategory = "placement-region"
+			},
+			expectedError: "regionTagCategory must match topologyCategories region k8s-region",
+		},
+		{
+			testName:              "Invalid VSphereDatacenterConfig with FailureDomain zone tag category different from topologyCategories",
+			modifyFunc: func(v *VSphereDatacenterConfig) {
+				v.Spec.TopologyCategories = &VSphereTopologyCategories{
+					Region: "k8s-region",
+					Zone:   "k8s-zone",
+				}
+				v.Spec.FailureDomains[0].ZoneTagCategory = "placement-zone"
+			},
+			expectedError: "zoneTagCategory must match topologyCategories zone k8s-zone",
+		},
+		{
+			testName:              "valid VSphereDatacenterConfig with providerProxyConfiguration",
+			modifyFunc: func(v *VSphereDatacenterConfig) {
+				v.Spec.ProviderProxyConfiguration = &ProxyConfiguration{
+					HttpProxy:  "http://10.0.0.1:3128",
+					HttpsProxy: "http://10.0.0.1:3128",
+					NoProxy:    []string{"10.0.0.0/8"},
+				}
+			},
+		},
+		{
+			testName:              "Invalid VSphereDatacenterConfig with missing httpsProxy in providerProxyConfiguration",
+			modifyFunc: func(v *VSphereDatacenterConfig) {
+				v.Spec.ProviderProxyConfiguration = &ProxyConfiguration{
+					HttpProxy: "http://10.0.0.1:3128",
+				}
+			},
+			expectedError: "VSphereDatacenterConfig providerProxyConfiguration is invalid: no value set for httpsProxy",
+		},
+		{
+			testName:              "Invalid VSphereDatacenterConfig with invalid port in providerProxyConfiguration",
+			modifyFunc: func(v *VSphereDatacenterConfig) {
+				v.Spec.ProviderProxyConfiguration = &ProxyConfiguration{
+					HttpProxy:  "http://10.0.0.1:3128",
+					HttpsProxy: "10.0.0.1:70000",
+				}
+			},
+			expectedError: "proxy port 70000 is invalid",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.testName, func(t *testing.T) {
@@ -21,6 +21,31 @@ type VSphereDatacenterConfigSpec struct {
 	Thumbprint     string          `json:"thumbprint"`
 	Insecure       bool            `json:"insecure"`
 	FailureDomains []FailureDomain `json:"failureDomains,omitempty"`
+
+	// CreateMissingResources enables the CLI to create the folders and resource pools referenced by
+	// the cluster machine configs and failure domains when they don't exist in vCenter.
+	// +optional
+	CreateMissingResources bool `json:"createMissingResources,omitempty"`
+
+	// ProviderProxyConfiguration is the optional proxy the CLI uses for its calls to vCenter, for
+	// environments where vCenter sits behind a different proxy than the one set for the cluster.
+	// +optional
+	ProviderProxyConfiguration *ProxyConfiguration `json:"providerProxyConfiguration,omitempty"`
+
+	// TopologyCategories are the vSphere tag categories used to describe the region and zone topology
+	// of the datacenter. They are configured in the vSphere cloud provider, so nodes are labeled with
+	// the region and zone they run in, and are the default tag categories for the failure domains.
+	// +optional
+	TopologyCategories *VSphereTopologyCategories `json:"topologyCategories,omitempty"`
+}
+
+// VSphereTopologyCategories defines the vSphere tag categories used for the region and zone topology.
+type VSphereTopologyCategories struct {
+	// Region is the tag category for the regions, usually attached to datacenters.
+	Region string `json:"region"`
+
+	// Zone is the tag category for the zones, usually attached to compute clusters.
+	Zone string `json:"zone"`
 }
 
 // FailureDomain defines the list of failure domains to spread the VMs across.
@@ -48,6 +73,16 @@ type FailureDomain struct {
 	// +kubebuilder:validation:Required
 	// Network is the name or inventory path of the network which will be added to the VM
 	Network string `json:"network"`
+
+	// RegionTagCategory is the vSphere tag category used to tag the datacenter with the failure domain region.
+	// Set it to reuse an existing tag category for VM placement. Defaults to k8s-region.
+	// +optional
+	RegionTagCategory string `json:"regionTagCategory,omitempty"`
+
+	// ZoneTagCategory is the vSphere tag category used to tag the compute cluster with the failure domain zone.
+	// Defaults to k8s-zone.
+	// +optional
+	ZoneTagCategory string `json:"zoneTagCategory,omitempty"`
 }
 
 // ResourcePaths returns a map of vSphere resource paths defined in the FailureDomain.
@@ -141,6 +176,12 @@ func (v *VSphereDatacenterConfig) Validate() error {
 		return err
 	}
 
+	if v.Spec.TopologyCategories != nil {
+		if err := validateTopologyCategories(v.Spec.TopologyCategories); err != nil {
+			return fmt.Errorf("VSphereDatacenterConfig topologyCategories is invalid: %v", err)
+		}
+	}
+
 	if len(v.Spec.FailureDomains) > 0 {
 		failureDomains := v.Spec.FailureDomains
 		for _, fd := range failureDomains {
@@ -170,12 +211,44 @@ func (v *VSphereDatacenterConfig) Validate() error {
 			if err := validatePath(networkFolderType, fd.Network, v.Spec.Datacenter); err != nil {
 				return err
 			}
+
+			if fd.RegionTagCategory != "" && fd.RegionTagCategory == fd.ZoneTagCategory {
+				return fmt.Errorf("regionTagCategory and zoneTagCategory must be different in the FailureDomain: %v", fd)
+			}
+
+			if topology := v.Spec.TopologyCategories; topology != nil {
+				if fd.RegionTagCategory != "" && fd.RegionTagCategory != topology.Region {
+					return fmt.Errorf("regionTagCategory must match topologyCategories region %s in the FailureDomain: %v", topology.Region, fd)
+				}
+				if fd.ZoneTagCategory != "" && fd.ZoneTagCategory != topology.Zone {
+					return fmt.Errorf("zoneTagCategory must match topologyCategories zone %s in the FailureDomain: %v", topology.Zone, fd)
+				}
+			}
+		}
+	}
+
+	if v.Spec.ProviderProxyConfiguration != nil {
+		if err := validateProxyConfiguration(v.Spec.ProviderProxyConfiguration); err != nil {
+			return fmt.Errorf("VSphereDatacenterConfig providerProxyConfiguration is invalid: %v", err)
 		}
 	}
 
 	return nil
 }
 
+func validateTopologyCategories(topology *VSphereTopologyCategories) error {
+	if topology.Region == "" {
+		return errors.New("region is not set or is empty")
+	}
+	if topology.Zone == "" {
+		return errors.New("zone is not set or is empty")
+	}
+	if topology.Region == topology.Zone {
+		return errors.New("region and zone must be different")
+	}
+	return nil
+}
+
 func (v *VSphereDatacenterConfig) ConvertConfigToConfigGenerateStruct() *VSphereDatacenterConfigGenerate {
 	namespace := defaultEksaNamespace
 	if v.Namespace != "" {
@@ -1,7 +1,11 @@
 package v1alpha1
 
 import (
+	"encoding/hex"
 	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
@@ -14,8 +18,28 @@ const (
 	DefaultVSphereNumCPUs    = 2
 	DefaultVSphereMemoryMiB  = 8192
 	DefaultVSphereOSFamily   = Bottlerocket
+
+	// DefaultVSphereIPPoolKind is the IPAM pool kind used when a network device ipPool does not set one.
+	DefaultVSphereIPPoolKind = "InClusterIPPool"
+	// DefaultVSphereIPPoolAPIGroup is the IPAM pool API group used when a network device ipPool does not set one.
+	DefaultVSphereIPPoolAPIGroup = "ipam.cluster.x-k8s.io"
+
+	// VSphereContentLibraryTemplatePrefix is the prefix of the templates that reference
+	// a content library item, as in library://<library>/<item>.
+	VSphereContentLibraryTemplatePrefix = "library://"
+
+	minVSphereNetworkDeviceMTU = 576
+	maxVSphereNetworkDeviceMTU = 9000
+
+	vsphereGuestInfoPrefix = "guestinfo."
 )
 
+// vsphereReservedGuestInfoKeys are the guestinfo variables, and their subkeys, CAPV, cloud-init
+// and ignition use to pass the bootstrap data to the VMs.
+var vsphereReservedGuestInfoKeys = []string{"userdata", "metadata", "vendordata", "ignition"}
+
+var vsphereGuestInfoKeyRegex = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9._-]*$`)
+
 // Used for generating yaml for generate clusterconfig command.
 func NewVSphereMachineConfigGenerate(name string) *VSphereMachineConfigGenerate {
 	return &VSphereMachineConfigGenerate{
@@ -75,6 +99,19 @@ func setVSphereMachineConfigDefaults(machineConfig *VSphereMachineConfig) {
 		logger.Info("Warning: OS family not specified in machine config specification. Defaulting to Bottlerocket.")
 		machineConfig.Spec.OSFamily = Bottlerocket
 	}
+
+	for i := range machineConfig.Spec.NetworkDevices {
+		pool := machineConfig.Spec.NetworkDevices[i].IPPool
+		if pool == nil {
+			continue
+		}
+		if pool.Kind == "" {
+			pool.Kind = DefaultVSphereIPPoolKind
+		}
+		if pool.APIGroup == "" {
+			pool.APIGroup = DefaultVSphereIPPoolAPIGroup
+		}
+	}
 }
 
 func validateVSphereMachineConfig(config *VSphereMachineConfig) error {
@@ -93,6 +130,107 @@ func validateVSphereMachineConfig(config *VSphereMachineConfig) error {
 	if err := validateHostOSConfig(config.Spec.HostOSConfiguration, config.Spec.OSFamily); err != nil {
 		return fmt.Errorf("HostOSConfiguration is invalid for VSphereMachineConfig %s: %v", config.Name, err)
 	}
+	if err := validateVSphereNetworkDevices(config); err != nil {
+		return fmt.Errorf("VSphereMachineConfig %s networkDevices is invalid: %v", config.Name, err)
+	}
+	if err := validateVSphereTemplateOVA(config.Spec.OVA); err != nil {
+		return fmt.Errorf("VSphereMachineConfig %s ova is invalid: %v", config.Name, err)
+	}
+	if err := validateVSphereContentLibraryTemplate(config); err != nil {
+		return fmt.Errorf("VSphereMachineConfig %s template is invalid: %v", config.Name, err)
+	}
+	if err := validateVSphereGuestInfo(config.Spec.GuestInfo); err != nil {
+		return fmt.Errorf("VSphereMachineConfig %s guestInfo is invalid: %v", config.Name, err)
+	}
+
+	return nil
+}
+
+func validateVSphereGuestInfo(guestInfo map[string]string) error {
+	for key := range guestInfo {
+		if strings.HasPrefix(key, vsphereGuestInfoPrefix) {
+			return fmt.Errorf("key %s must not include the %s prefix", key, vsphereGuestInfoPrefix)
+		}
+		if !vsphereGuestInfoKeyRegex.MatchString(key) {
+			return fmt.Errorf("key %s must start with an alphanumeric character and only contain alphanumeric characters, '.', '_' or '-'", key)
+		}
+		for _, reserved := range vsphereReservedGuestInfoKeys {
+			if key == reserved || strings.HasPrefix(key, reserved+".") {
+				return fmt.Errorf("key %s is reserved for the bootstrap data", key)
+			}
+		}
+	}
+
+	return nil
+}
+
+func validateVSphereTemplateOVA(ova *VSphereTemplateOVA) error {
+	if ova == nil {
+		return nil
+	}
+	u, err := url.Parse(ova.URL)
+	if err != nil {
+		return fmt.Errorf("parsing url: %v", err)
+	}
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("url %s must be an http or https url", ova.URL)
+	}
+	if checksum, err := hex.DecodeString(ova.SHA256); err != nil || len(checksum) != 32 {
+		return fmt.Errorf("sha256 %s must be a hex encoded sha256 checksum", ova.SHA256)
+	}
+
+	return nil
+}
+
+func validateVSphereContentLibraryTemplate(config *VSphereMachineConfig) error {
+	if !strings.HasPrefix(config.Spec.Template, VSphereContentLibraryTemplatePrefix) {
+		return nil
+	}
+	if _, _, ok := config.TemplateLibraryItem(); !ok {
+		return fmt.Errorf("content library template %s must have the format %s<library>/<item>", config.Spec.Template, VSphereContentLibraryTemplatePrefix)
+	}
+	if config.Spec.OVA != nil {
+		return fmt.Errorf("ova can't be set when the template references a content library item")
+	}
+
+	return nil
+}
+
+func validateVSphereNetworkDevices(config *VSphereMachineConfig) error {
+	devices := config.Spec.NetworkDevices
+	if len(devices) == 0 {
+		return nil
+	}
+	if len(config.Spec.Networks) > 0 {
+		return fmt.Errorf("networks and networkDevices are mutually exclusive")
+	}
+	if config.Spec.OSFamily == Bottlerocket && len(devices) > 2 {
+		return fmt.Errorf("at most 2 network devices are supported for %s", Bottlerocket)
+	}
+
+	seen := make(map[string]struct{}, len(devices))
+	for i, device := range devices {
+		if device.NetworkName == "" {
+			return fmt.Errorf("networkDevices[%d].networkName is required", i)
+		}
+		if _, ok := seen[device.NetworkName]; ok {
+			return fmt.Errorf("networkDevices[%d].networkName %s is duplicated", i, device.NetworkName)
+		}
+		seen[device.NetworkName] = struct{}{}
+
+		if device.IPPool != nil {
+			if device.IPPool.Name == "" {
+				return fmt.Errorf("networkDevices[%d].ipPool.name is required", i)
+			}
+			if config.Spec.OSFamily == Bottlerocket {
+				return fmt.Errorf("networkDevices[%d].ipPool is not supported for %s", i, Bottlerocket)
+			}
+		}
+
+		if device.MTU != nil && (*device.MTU < minVSphereNetworkDeviceMTU || *device.MTU > maxVSphereNetworkDeviceMTU) {
+			return fmt.Errorf("networkDevices[%d].mtu %d must be between %d and %d", i, *device.MTU, minVSphereNetworkDeviceMTU, maxVSphereNetworkDeviceMTU)
+		}
+	}
 
 	return nil
 }
@@ -5,6 +5,8 @@ import (
 
 	. "github.com/onsi/gomega"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/aws/eks-anywhere/pkg/utils/ptr"
 )
 
 func TestVSphereMachineConfigValidate(t *testing.T) {
@@ -158,6 +160,37 @@ func TestVSphereMachineConfigValidate(t *testing.T) {
 			},
 			wantErr: "users[0].name test is invalid. Please use 'ec2-user' for Bottlerocket",
 		},
+		{
+			name: "multiple users for bottlerocket",
+			obj: &VSphereMachineConfig{
+				ObjectMeta: metav1.ObjectMeta{Name: "test"},
+				Spec: VSphereMachineConfigSpec{
+					MemoryMiB:    64,
+					DiskGiB:      100,
+					NumCPUs:      3,
+					Template:     "templateA",
+					ResourcePool: "poolA",
+					Datastore:    "ds-aaa",
+					Folder:       "folder/A",
+					OSFamily:     "bottlerocket",
+					Users: []UserConfiguration{
+						{
+							Name: "ec2-user",
+							SshAuthorizedKeys: []string{
+								"ssh_rsa",
+							},
+						},
+						{
+							Name: "ops",
+							SshAuthorizedKeys: []string{
+								"ssh_rsa",
+							},
+						},
+					},
+				},
+			},
+			wantErr: "only one user is supported for Bottlerocket, please add additional keys to the ec2-user user",
+		},
 		{
 			name: "invalid hostOSConfiguration",
 			obj: &VSphereMachineConfig{
@@ -186,6 +219,426 @@ func TestVSphereMachineConfigValidate(t *testing.T) {
 			},
 			wantErr: "HostOSConfiguration is invalid for VSphereMachineConfig test: NTPConfiguration.Servers can not be empty",
 		},
+		{
+			name: "valid network devices",
+			obj: &VSphereMachineConfig{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test",
+				},
+				Spec: VSphereMachineConfigSpec{
+					MemoryMiB:    64,
+					DiskGiB:      100,
+					NumCPUs:      3,
+					Template:     "templateA",
+					ResourcePool: "poolA",
+					Datastore:    "ds-aaa",
+					Folder:       "folder/A",
+					OSFamily:     "ubuntu",
+					Users: []UserConfiguration{
+						{
+							Name: "ec2-user",
+							SshAuthorizedKeys: []string{
+								"ssh_rsa",
+							},
+						},
+					},
+					NetworkDevices: []VSphereNetworkDevice{
+						{NetworkName: "net1"},
+						{NetworkName: "net2", IPPool: &VSphereIPPoolReference{Name: "pool"}, MTU: ptr.Int64(9000)},
+					},
+				},
+			},
+			wantErr: "",
+		},
+		{
+			name: "networks and network devices",
+			obj: &VSphereMachineConfig{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test",
+				},
+				Spec: VSphereMachineConfigSpec{
+					MemoryMiB:    64,
+					DiskGiB:      100,
+					NumCPUs:      3,
+					Template:     "templateA",
+					ResourcePool: "poolA",
+					Datastore:    "ds-aaa",
+					Folder:       "folder/A",
+					OSFamily:     "ubuntu",
+					Users: []UserConfiguration{
+						{
+							Name: "ec2-user",
+							SshAuthorizedKeys: []string{
+								"ssh_rsa",
+							},
+						},
+					},
+					Networks: []string{"net1"},
+					NetworkDevices: []VSphereNetworkDevice{
+						{NetworkName: "net1"},
+					},
+				},
+			},
+			wantErr: "networks and networkDevices are mutually exclusive",
+		},
+		{
+			name: "network device without network name",
+			obj: &VSphereMachineConfig{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test",
+				},
+				Spec: VSphereMachineConfigSpec{
+					MemoryMiB:    64,
+					DiskGiB:      100,
+					NumCPUs:      3,
+					Template:     "templateA",
+					ResourcePool: "poolA",
+					Datastore:    "ds-aaa",
+					Folder:       "folder/A",
+					OSFamily:     "ubuntu",
+					Users: []UserConfiguration{
+						{
+							Name: "ec2-user",
+							SshAuthorizedKeys: []string{
+								"ssh_rsa",
+							},
+						},
+					},
+					NetworkDevices: []VSphereNetworkDevice{
+						{MTU: ptr.Int64(1500)},
+					},
+				},
+			},
+			wantErr: "networkDevices[0].networkName is required",
+		},
+		{
+			name: "duplicated network device",
+			obj: &VSphereMachineConfig{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test",
+				},
+				Spec: VSphereMachineConfigSpec{
+					MemoryMiB:    64,
+					DiskGiB:      100,
+					NumCPUs:      3,
+					Template:     "templateA",
+					ResourcePool: "poolA",
+					Datastore:    "ds-aaa",
+					Folder:       "folder/A",
+					OSFamily:     "ubuntu",
+					Users: []UserConfiguration{
+						{
+							Name: "ec2-user",
+							SshAuthorizedKeys: []string{
+								"ssh_rsa",
+							},
+						},
+					},
+					NetworkDevices: []VSphereNetworkDevice{
+						{NetworkName: "net1"},
+						{NetworkName: "net1"},
+					},
+				},
+			},
+			wantErr: "networkDevices[1].networkName net1 is duplicated",
+		},
+		{
+			name: "network device ip pool without name",
+			obj: &VSphereMachineConfig{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test",
+				},
+				Spec: VSphereMachineConfigSpec{
+					MemoryMiB:    64,
+					DiskGiB:      100,
+					NumCPUs:      3,
+					Template:     "templateA",
+					ResourcePool: "poolA",
+					Datastore:    "ds-aaa",
+					Folder:       "folder/A",
+					OSFamily:     "ubuntu",
+					Users: []UserConfiguration{
+						{
+							Name: "ec2-user",
+							SshAuthorizedKeys: []string{
+								"ssh_rsa",
+							},
+						},
+					},
+					NetworkDevices: []VSphereNetworkDevice{
+						{NetworkName: "net1", IPPool: &VSphereIPPoolReference{}},
+					},
+				},
+			},
+			wantErr: "networkDevices[0].ipPool.name is required",
+		},
+		{
+			name: "network device ip pool with bottlerocket",
+			obj: &VSphereMachineConfig{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test",
+				},
+				Spec: VSphereMachineConfigSpec{
+					MemoryMiB:    64,
+					DiskGiB:      100,
+					NumCPUs:      3,
+					Template:     "templateA",
+					ResourcePool: "poolA",
+					Datastore:    "ds-aaa",
+					Folder:       "folder/A",
+					OSFamily:     "bottlerocket",
+					Users: []UserConfiguration{
+						{
+							Name: "ec2-user",
+							SshAuthorizedKeys: []string{
+								"ssh_rsa",
+							},
+						},
+					},
+					NetworkDevices: []VSphereNetworkDevice{
+						{NetworkName: "net1", IPPool: &VSphereIPPoolReference{Name: "pool"}},
+					},
+				},
+			},
+			wantErr: "networkDevices[0].ipPool is not supported for bottlerocket",
+		},
+		{
+			name: "too many network devices with bottlerocket",
+			obj: &VSphereMachineConfig{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test",
+				},
+				Spec: VSphereMachineConfigSpec{
+					MemoryMiB:    64,
+					DiskGiB:      100,
+					NumCPUs:      3,
+					Template:     "templateA",
+					ResourcePool: "poolA",
+					Datastore:    "ds-aaa",
+					Folder:       "folder/A",
+					OSFamily:     "bottlerocket",
+					Users: []UserConfiguration{
+						{
+							Name: "ec2-user",
+							SshAuthorizedKeys: []string{
+								"ssh_rsa",
+							},
+						},
+					},
+					NetworkDevices: []VSphereNetworkDevice{
+						{NetworkName: "net1"},
+						{NetworkName: "net2"},
+						{NetworkName: "net3"},
+					},
+				},
+			},
+			wantErr: "at most 2 network devices are supported for bottlerocket",
+		},
+		{
+			name: "network device mtu out of range",
+			obj: &VSphereMachineConfig{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test",
+				},
+				Spec: VSphereMachineConfigSpec{
+					MemoryMiB:    64,
+					DiskGiB:      100,
+					NumCPUs:      3,
+					Template:     "templateA",
+					ResourcePool: "poolA",
+					Datastore:    "ds-aaa",
+					Folder:       "folder/A",
+					OSFamily:     "ubuntu",
+					Users: []UserConfiguration{
+						{
+							Name: "ec2-user",
+							SshAuthorizedKeys: []string{
+								"ssh_rsa",
+							},
+						},
+					},
+					NetworkDevices: []VSphereNetworkDevice{
+						{NetworkName: "net1", MTU: ptr.Int64(10000)},
+					},
+				},
+			},
+			wantErr: "networkDevices[0].mtu 10000 must be between 576 and 9000",
+		},
+		{
+			name: "valid ova",
+			obj: &VSphereMachineConfig{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test",
+				},
+				Spec: VSphereMachineConfigSpec{
+					MemoryMiB:    64,
+					DiskGiB:      100,
+					NumCPUs:      3,
+					ResourcePool: "poolA",
+					Datastore:    "ds-aaa",
+					Folder:       "folder/A",
+					OSFamily:     "ubuntu",
+					Users: []UserConfiguration{
+						{
+							Name: "ec2-user",
+							SshAuthorizedKeys: []string{
+								"ssh_rsa",
+							},
+						},
+					},
+					OVA: &VSphereTemplateOVA{
+						URL:    "https://example.com/ubuntu.ova",
+						SHA256: "a0b4c2b6a4f0f1b9a9e1d1c2e6a4b1d3c9e2f5a7b8c6d4e2f1a3b5c7d9e1f3a5",
+					},
+				},
+			},
+			wantErr: "",
+		},
+		{
+			name: "ova with invalid url scheme",
+			obj: &VSphereMachineConfig{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test",
+				},
+				Spec: VSphereMachineConfigSpec{
+					MemoryMiB:    64,
+					DiskGiB:      100,
+					NumCPUs:      3,
+					ResourcePool: "poolA",
+					Datastore:    "ds-aaa",
+					Folder:       "folder/A",
+					OSFamily:     "ubuntu",
+					Users: []UserConfiguration{
+						{
+							Name: "ec2-user",
+							SshAuthorizedKeys: []string{
+								"ssh_rsa",
+							},
+						},
+					},
+					OVA: &VSphereTemplateOVA{
+						URL:    "ftp://example.com/ubuntu.ova",
+						SHA256: "a0b4c2b6a4f0f1b9a9e1d1c2e6a4b1d3c9e2f5a7b8c6d4e2f1a3b5c7d9e1f3a5",
+					},
+				},
+			},
+			wantErr: "VSphereMachineConfig test ova is invalid: url ftp://example.com/ubuntu.ova must be an http or https url",
+		},
+		{
+			name: "ova with invalid checksum",
+			obj: &VSphereMachineConfig{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test",
+				},
+				Spec: VSphereMachineConfigSpec{
+					MemoryMiB:    64,
+					DiskGiB:      100,
+					NumCPUs:      3,
+					ResourcePool: "poolA",
+					Datastore:    "ds-aaa",
+					Folder:       "folder/A",
+					OSFamily:     "ubuntu",
+					Users: []UserConfiguration{
+						{
+							Name: "ec2-user",
+							SshAuthorizedKeys: []string{
+								"ssh_rsa",
+							},
+						},
+					},
+					OVA: &VSphereTemplateOVA{
+						URL:    "https://example.com/ubuntu.ova",
+						SHA256: "abc",
+					},
+				},
+			},
+			wantErr: "VSphereMachineConfig test ova is invalid: sha256 abc must be a hex encoded sha256 checksum",
+		},
+		{
+			name: "valid content library template",
+			obj: &VSphereMachineConfig{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test",
+				},
+				Spec: VSphereMachineConfigSpec{
+					MemoryMiB:    64,
+					DiskGiB:      100,
+					NumCPUs:      3,
+					ResourcePool: "poolA",
+					Datastore:    "ds-aaa",
+					Folder:       "folder/A",
+					OSFamily:     "ubuntu",
+					Template:     "library://eksa-templates/ubuntu-2204-kube-v1-30",
+					Users: []UserConfiguration{
+						{
+							Name: "ec2-user",
+							SshAuthorizedKeys: []string{
+								"ssh_rsa",
+							},
+						},
+					},
+				},
+			},
+			wantErr: "",
+		},
+		{
+			name: "content library template without item",
+			obj: &VSphereMachineConfig{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test",
+				},
+				Spec: VSphereMachineConfigSpec{
+					MemoryMiB:    64,
+					DiskGiB:      100,
+					NumCPUs:      3,
+					ResourcePool: "poolA",
+					Datastore:    "ds-aaa",
+					Folder:       "folder/A",
+					OSFamily:     "ubuntu",
+					Template:     "library://eksa-templates",
+					Users: []UserConfiguration{
+						{
+							Name: "ec2-user",
+							SshAuthorizedKeys: []string{
+								"ssh_rsa",
+							},
+						},
+					},
+				},
+			},
+			wantErr: "VSphereMachineConfig test template is invalid: content library template library://eksa-templates must have the format library://<library>/<item>",
+		},
+		{
+			name: "content library template with ova",
+			obj: &VSphereMachineConfig{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test",
+				},
+				Spec: VSphereMachineConfigSpec{
+					MemoryMiB:    64,
+					DiskGiB:      100,
+					NumCPUs:      3,
+					ResourcePool: "poolA",
+					Datastore:    "ds-aaa",
+					Folder:       "folder/A",
+					OSFamily:     "ubuntu",
+					Template:     "library://eksa-templates/ubuntu-2204-kube-v1-30",
+					Users: []UserConfiguration{
+						{
+							Name: "ec2-user",
+							SshAuthorizedKeys: []string{
+								"ssh_rsa",
+							},
+						},
+					},
+					OVA: &VSphereTemplateOVA{
+						URL:    "https://example.com/ubuntu.ova",
+						SHA256: "0c2b5c3a1a4f5d7e8b9f0a1b2c3d4e5f60718293a4b5c6d7e8f9a0b1c2d3e4f5",
+					},
+				},
+			},
+			wantErr: "VSphereMachineConfig test template is invalid: ova can't be set when the template references a content library item",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -200,6 +653,31 @@ func TestVSphereMachineConfigValidate(t *testing.T) {
 	}
 }
 
+func TestVSphereMachineConfigTemplateLibraryItem(t *testing.T) {
+	tests := []struct {
+		template    string
+		wantLibrary string
+		wantItem    string
+		wantOK      bool
+	}{
+		{template: "library://eksa-templates/ubuntu-2204-kube-v1-30", wantLibrary: "eksa-templates", wantItem: "ubuntu-2204-kube-v1-30", wantOK: true},
+		{template: "/SDDC-Datacenter/vm/Templates/ubuntu-2204-kube-v1-30"},
+		{template: "library://eksa-templates"},
+		{template: "library:///ubuntu-2204-kube-v1-30"},
+		{template: "library://eksa-templates/folder/ubuntu-2204-kube-v1-30"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.template, func(t *testing.T) {
+			g := NewWithT(t)
+			c := &VSphereMachineConfig{Spec: VSphereMachineConfigSpec{Template: tt.template}}
+			library, item, ok := c.TemplateLibraryItem()
+			g.Expect(ok).To(Equal(tt.wantOK))
+			g.Expect(library).To(Equal(tt.wantLibrary))
+			g.Expect(item).To(Equal(tt.wantItem))
+		})
+	}
+}
+
 func TestVSphereMachineConfigValidateUsers(t *testing.T) {
 	g := NewWithT(t)
 	tests := []struct {
@@ -359,3 +837,68 @@ func TestVSphereMachineConfigSetDefaultUsers(t *testing.T) {
 		})
 	}
 }
+
+func TestVSphereMachineConfigValidateGuestInfo(t *testing.T) {
+	tests := []struct {
+		name      string
+		guestInfo map[string]string
+		wantErr   string
+	}{
+		{
+			name:      "valid",
+			guestInfo: map[string]string{"agent.token": "abc", "site_id": "42"},
+		},
+		{
+			name:      "guestinfo prefix",
+			guestInfo: map[string]string{"guestinfo.agent.token": "abc"},
+			wantErr:   "VSphereMachineConfig test guestInfo is invalid: key guestinfo.agent.token must not include the guestinfo. prefix",
+		},
+		{
+			name:      "invalid characters",
+			guestInfo: map[string]string{"agent token": "abc"},
+			wantErr:   "VSphereMachineConfig test guestInfo is invalid: key agent token must start with an alphanumeric character",
+		},
+		{
+			name:      "reserved key",
+			guestInfo: map[string]string{"userdata": "abc"},
+			wantErr:   "VSphereMachineConfig test guestInfo is invalid: key userdata is reserved for the bootstrap data",
+		},
+		{
+			name:      "reserved subkey",
+			guestInfo: map[string]string{"metadata.encoding": "base64"},
+			wantErr:   "VSphereMachineConfig test guestInfo is invalid: key metadata.encoding is reserved for the bootstrap data",
+		},
+		{
+			name:      "key starting with a reserved name",
+			guestInfo: map[string]string{"metadataservice": "http://10.0.0.1"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			c := &VSphereMachineConfig{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test",
+				},
+				Spec: VSphereMachineConfigSpec{
+					ResourcePool: "poolA",
+					Datastore:    "ds-aaa",
+					OSFamily:     "ubuntu",
+					Users: []UserConfiguration{
+						{
+							Name:              "capv",
+							SshAuthorizedKeys: []string{"ssh_rsa"},
+						},
+					},
+					GuestInfo: tt.guestInfo,
+				},
+			}
+			err := c.Validate()
+			if tt.wantErr == "" {
+				g.Expect(err).To(BeNil())
+			} else {
+				g.Expect(err).To(MatchError(ContainSubstring(tt.wantErr)))
+			}
+		})
+	}
+}
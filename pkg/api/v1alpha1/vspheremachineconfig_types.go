@@ -2,6 +2,7 @@ package v1alpha1
 
 import (
 	"fmt"
+	"strings"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
@@ -25,13 +26,61 @@ type VSphereMachineConfigSpec struct {
 	// +kubebuilder:validation:Optional
 	// +kubebuilder:validation:MaxItems=2
 	Networks []string `json:"networks,omitempty"`
+	// NetworkDevices configures the network interfaces attached to worker nodes, one per entry, each with
+	// its own addressing settings. It is mutually exclusive with Networks.
+	// +kubebuilder:validation:Optional
+	NetworkDevices []VSphereNetworkDevice `json:"networkDevices,omitempty"`
 	// Template field is the template to use for provisioning the VM. It must include the Kubernetes
 	// version(s). For example, a template used for Kubernetes 1.27 could be ubuntu-2204-1.27.
-	Template            string               `json:"template,omitempty"`
+	// It can also reference a content library item as library://<library>/<item>, in which case
+	// the CLI deploys the item as a VM template before using it.
+	Template string `json:"template,omitempty"`
+	// OVA is an optional OVA the template is imported from when it doesn't exist in vCenter.
+	// If template is not set, the template name is derived from the OVA.
+	// +optional
+	OVA                 *VSphereTemplateOVA  `json:"ova,omitempty"`
 	Users               []UserConfiguration  `json:"users,omitempty"`
 	TagIDs              []string             `json:"tags,omitempty"`
 	CloneMode           CloneMode            `json:"cloneMode,omitempty"`
 	HostOSConfiguration *HostOSConfiguration `json:"hostOSConfiguration,omitempty"`
+	// GuestInfo sets custom guestinfo variables on the VMs, for in-guest agents to read through VMware Tools.
+	// Keys are the variable names without the guestinfo. prefix, for example agent.token is exposed as
+	// guestinfo.agent.token. Keys used by cloud-init and ignition, like userdata or metadata, are reserved.
+	// +optional
+	GuestInfo map[string]string `json:"guestInfo,omitempty"`
+}
+
+// VSphereTemplateOVA defines an OVA a VM template is imported from.
+type VSphereTemplateOVA struct {
+	// URL is the http(s) location of the OVA.
+	URL string `json:"url"`
+	// SHA256 is the hex encoded sha256 checksum of the OVA. The download is verified against it before importing.
+	SHA256 string `json:"sha256"`
+}
+
+// VSphereNetworkDevice defines a network interface attached to a VM.
+type VSphereNetworkDevice struct {
+	// NetworkName is the vSphere network (port group) path the interface is attached to.
+	NetworkName string `json:"networkName"`
+	// IPPool references an IPAM pool used to allocate static addresses for this interface.
+	// When omitted, the interface is configured with DHCP.
+	// +optional
+	IPPool *VSphereIPPoolReference `json:"ipPool,omitempty"`
+	// MTU is the interface Maximum Transmission Unit size in bytes.
+	// +optional
+	MTU *int64 `json:"mtu,omitempty"`
+}
+
+// VSphereIPPoolReference references a cluster-api IPAM pool.
+type VSphereIPPoolReference struct {
+	// Name of the IPAM pool.
+	Name string `json:"name"`
+	// Kind of the IPAM pool. Defaults to InClusterIPPool.
+	// +optional
+	Kind string `json:"kind,omitempty"`
+	// APIGroup of the IPAM pool. Defaults to ipam.cluster.x-k8s.io.
+	// +optional
+	APIGroup string `json:"apiGroup,omitempty"`
 }
 
 // ResourcePaths returns a map of vSphere resource paths defined in the VSphereMachineConfig.
@@ -46,6 +95,35 @@ func (c *VSphereMachineConfig) ResourcePaths() map[string]string {
 	}
 }
 
+// NetworkNames returns the names of the networks the machines are attached to,
+// whether they are configured through Networks or NetworkDevices.
+func (c *VSphereMachineConfig) NetworkNames() []string {
+	if len(c.Spec.NetworkDevices) == 0 {
+		return c.Spec.Networks
+	}
+	names := make([]string, 0, len(c.Spec.NetworkDevices))
+	for _, device := range c.Spec.NetworkDevices {
+		names = append(names, device.NetworkName)
+	}
+	return names
+}
+
+// TemplateLibraryItem returns the content library and item referenced by the template,
+// and whether the template references a content library item at all.
+func (c *VSphereMachineConfig) TemplateLibraryItem() (library, item string, ok bool) {
+	ref, found := strings.CutPrefix(c.Spec.Template, VSphereContentLibraryTemplatePrefix)
+	if !found {
+		return "", "", false
+	}
+
+	library, item, found = strings.Cut(ref, "/")
+	if !found || library == "" || item == "" || strings.Contains(item, "/") {
+		return "", "", false
+	}
+
+	return library, item, true
+}
+
 func (c *VSphereMachineConfig) PauseReconcile() {
 	c.Annotations[pausedAnnotation] = "true"
 }
@@ -191,6 +269,9 @@ func validateVSphereMachineConfigOSFamilyUser(machineConfig *VSphereMachineConfi
 	if machineConfig.Spec.Users[0].Name != constants.BottlerocketDefaultUser {
 		return fmt.Errorf("users[0].name %s is invalid. Please use 'ec2-user' for Bottlerocket", machineConfig.Spec.Users[0].Name)
 	}
+	if len(machineConfig.Spec.Users) > 1 {
+		return fmt.Errorf("only one user is supported for Bottlerocket, please add additional keys to the %s user", constants.BottlerocketDefaultUser)
+	}
 	return nil
 }
 
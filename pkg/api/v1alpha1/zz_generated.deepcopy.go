@@ -226,6 +226,36 @@ func (in *AWSIamConfigStatus) DeepCopy() *AWSIamConfigStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuditLogConfiguration) DeepCopyInto(out *AuditLogConfiguration) {
+	*out = *in
+	if in.MaxAge != nil {
+		in, out := &in.MaxAge, &out.MaxAge
+		*out = new(int)
+		**out = **in
+	}
+	if in.MaxBackup != nil {
+		in, out := &in.MaxBackup, &out.MaxBackup
+		*out = new(int)
+		**out = **in
+	}
+	if in.MaxSize != nil {
+		in, out := &in.MaxSize, &out.MaxSize
+		*out = new(int)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuditLogConfiguration.
+func (in *AuditLogConfiguration) DeepCopy() *AuditLogConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(AuditLogConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AutoScalingConfiguration) DeepCopyInto(out *AutoScalingConfiguration) {
 	*out = *in
@@ -241,6 +271,206 @@ func (in *AutoScalingConfiguration) DeepCopy() *AutoScalingConfiguration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureStackHCIDatacenterConfig) DeepCopyInto(out *AzureStackHCIDatacenterConfig) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	out.Status = in.Status
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureStackHCIDatacenterConfig.
+func (in *AzureStackHCIDatacenterConfig) DeepCopy() *AzureStackHCIDatacenterConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureStackHCIDatacenterConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AzureStackHCIDatacenterConfig) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureStackHCIDatacenterConfigList) DeepCopyInto(out *AzureStackHCIDatacenterConfigList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AzureStackHCIDatacenterConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureStackHCIDatacenterConfigList.
+func (in *AzureStackHCIDatacenterConfigList) DeepCopy() *AzureStackHCIDatacenterConfigList {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureStackHCIDatacenterConfigList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AzureStackHCIDatacenterConfigList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureStackHCIDatacenterConfigSpec) DeepCopyInto(out *AzureStackHCIDatacenterConfigSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureStackHCIDatacenterConfigSpec.
+func (in *AzureStackHCIDatacenterConfigSpec) DeepCopy() *AzureStackHCIDatacenterConfigSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureStackHCIDatacenterConfigSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureStackHCIDatacenterConfigStatus) DeepCopyInto(out *AzureStackHCIDatacenterConfigStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureStackHCIDatacenterConfigStatus.
+func (in *AzureStackHCIDatacenterConfigStatus) DeepCopy() *AzureStackHCIDatacenterConfigStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureStackHCIDatacenterConfigStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureStackHCIMachineConfig) DeepCopyInto(out *AzureStackHCIMachineConfig) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	out.Status = in.Status
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureStackHCIMachineConfig.
+func (in *AzureStackHCIMachineConfig) DeepCopy() *AzureStackHCIMachineConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureStackHCIMachineConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AzureStackHCIMachineConfig) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureStackHCIMachineConfigList) DeepCopyInto(out *AzureStackHCIMachineConfigList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AzureStackHCIMachineConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureStackHCIMachineConfigList.
+func (in *AzureStackHCIMachineConfigList) DeepCopy() *AzureStackHCIMachineConfigList {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureStackHCIMachineConfigList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AzureStackHCIMachineConfigList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureStackHCIMachineConfigSpec) DeepCopyInto(out *AzureStackHCIMachineConfigSpec) {
+	*out = *in
+	if in.Users != nil {
+		in, out := &in.Users, &out.Users
+		*out = make([]UserConfiguration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureStackHCIMachineConfigSpec.
+func (in *AzureStackHCIMachineConfigSpec) DeepCopy() *AzureStackHCIMachineConfigSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureStackHCIMachineConfigSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureStackHCIMachineConfigStatus) DeepCopyInto(out *AzureStackHCIMachineConfigStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureStackHCIMachineConfigStatus.
+func (in *AzureStackHCIMachineConfigStatus) DeepCopy() *AzureStackHCIMachineConfigStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureStackHCIMachineConfigStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BottlerocketBootstrapContainer) DeepCopyInto(out *BottlerocketBootstrapContainer) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BottlerocketBootstrapContainer.
+func (in *BottlerocketBootstrapContainer) DeepCopy() *BottlerocketBootstrapContainer {
+	if in == nil {
+		return nil
+	}
+	out := new(BottlerocketBootstrapContainer)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BottlerocketConfiguration) DeepCopyInto(out *BottlerocketConfiguration) {
 	*out = *in
@@ -259,6 +489,16 @@ func (in *BottlerocketConfiguration) DeepCopyInto(out *BottlerocketConfiguration
 		*out = new(v1beta2.BottlerocketBootSettings)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.BootstrapContainers != nil {
+		in, out := &in.BootstrapContainers, &out.BootstrapContainers
+		*out = make([]BottlerocketBootstrapContainer, len(*in))
+		copy(*out, *in)
+	}
+	if in.HostContainers != nil {
+		in, out := &in.HostContainers, &out.HostContainers
+		*out = make([]BottlerocketHostContainer, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BottlerocketConfiguration.
@@ -271,6 +511,21 @@ func (in *BottlerocketConfiguration) DeepCopy() *BottlerocketConfiguration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BottlerocketHostContainer) DeepCopyInto(out *BottlerocketHostContainer) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BottlerocketHostContainer.
+func (in *BottlerocketHostContainer) DeepCopy() *BottlerocketHostContainer {
+	if in == nil {
+		return nil
+	}
+	out := new(BottlerocketHostContainer)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BundlesRef) DeepCopyInto(out *BundlesRef) {
 	*out = *in
@@ -299,6 +554,11 @@ func (in *CNIConfig) DeepCopyInto(out *CNIConfig) {
 		*out = new(KindnetdConfig)
 		**out = **in
 	}
+	if in.Calico != nil {
+		in, out := &in.Calico, &out.Calico
+		*out = new(CalicoConfig)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CNIConfig.
@@ -311,6 +571,26 @@ func (in *CNIConfig) DeepCopy() *CNIConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CalicoConfig) DeepCopyInto(out *CalicoConfig) {
+	*out = *in
+	if in.SkipUpgrade != nil {
+		in, out := &in.SkipUpgrade, &out.SkipUpgrade
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CalicoConfig.
+func (in *CalicoConfig) DeepCopy() *CalicoConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(CalicoConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CiliumConfig) DeepCopyInto(out *CiliumConfig) {
 	*out = *in
@@ -324,6 +604,16 @@ func (in *CiliumConfig) DeepCopyInto(out *CiliumConfig) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.KubeProxyReplacement != nil {
+		in, out := &in.KubeProxyReplacement, &out.KubeProxyReplacement
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Hubble != nil {
+		in, out := &in.Hubble, &out.Hubble
+		*out = new(CiliumHubbleConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.HelmValues != nil {
 		in, out := &in.HelmValues, &out.HelmValues
 		*out = new(apiextensionsv1.JSON)
@@ -341,6 +631,26 @@ func (in *CiliumConfig) DeepCopy() *CiliumConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CiliumHubbleConfig) DeepCopyInto(out *CiliumHubbleConfig) {
+	*out = *in
+	if in.Metrics != nil {
+		in, out := &in.Metrics, &out.Metrics
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CiliumHubbleConfig.
+func (in *CiliumHubbleConfig) DeepCopy() *CiliumHubbleConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(CiliumHubbleConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CloudStackAvailabilityZone) DeepCopyInto(out *CloudStackAvailabilityZone) {
 	*out = *in
@@ -429,6 +739,13 @@ func (in *CloudStackDatacenterConfigSpec) DeepCopyInto(out *CloudStackDatacenter
 		*out = make([]CloudStackAvailabilityZone, len(*in))
 		copy(*out, *in)
 	}
+	if in.ResourceTags != nil {
+		in, out := &in.ResourceTags, &out.ResourceTags
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudStackDatacenterConfigSpec.
@@ -678,31 +995,25 @@ func (in *ClusterCertificateInfo) DeepCopy() *ClusterCertificateInfo {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClusterList) DeepCopyInto(out *ClusterList) {
+func (in *ClusterHistory) DeepCopyInto(out *ClusterHistory) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]Cluster, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterList.
-func (in *ClusterList) DeepCopy() *ClusterList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterHistory.
+func (in *ClusterHistory) DeepCopy() *ClusterHistory {
 	if in == nil {
 		return nil
 	}
-	out := new(ClusterList)
+	out := new(ClusterHistory)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ClusterList) DeepCopyObject() runtime.Object {
+func (in *ClusterHistory) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -710,13 +1021,157 @@ func (in *ClusterList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClusterNetwork) DeepCopyInto(out *ClusterNetwork) {
+func (in *ClusterHistoryEvent) DeepCopyInto(out *ClusterHistoryEvent) {
 	*out = *in
-	in.Pods.DeepCopyInto(&out.Pods)
-	in.Services.DeepCopyInto(&out.Services)
-	if in.CNIConfig != nil {
-		in, out := &in.CNIConfig, &out.CNIConfig
-		*out = new(CNIConfig)
+	in.Time.DeepCopyInto(&out.Time)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterHistoryEvent.
+func (in *ClusterHistoryEvent) DeepCopy() *ClusterHistoryEvent {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterHistoryEvent)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterHistoryList) DeepCopyInto(out *ClusterHistoryList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ClusterHistory, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterHistoryList.
+func (in *ClusterHistoryList) DeepCopy() *ClusterHistoryList {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterHistoryList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterHistoryList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterHistoryObservedState) DeepCopyInto(out *ClusterHistoryObservedState) {
+	*out = *in
+	if in.WorkerNodeGroups != nil {
+		in, out := &in.WorkerNodeGroups, &out.WorkerNodeGroups
+		*out = make(map[string]int, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Packages != nil {
+		in, out := &in.Packages, &out.Packages
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Spec != nil {
+		in, out := &in.Spec, &out.Spec
+		*out = new(ClusterSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterHistoryObservedState.
+func (in *ClusterHistoryObservedState) DeepCopy() *ClusterHistoryObservedState {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterHistoryObservedState)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterHistoryStatus) DeepCopyInto(out *ClusterHistoryStatus) {
+	*out = *in
+	if in.Events != nil {
+		in, out := &in.Events, &out.Events
+		*out = make([]ClusterHistoryEvent, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Observed != nil {
+		in, out := &in.Observed, &out.Observed
+		*out = new(ClusterHistoryObservedState)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PreviousSpec != nil {
+		in, out := &in.PreviousSpec, &out.PreviousSpec
+		*out = new(ClusterSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterHistoryStatus.
+func (in *ClusterHistoryStatus) DeepCopy() *ClusterHistoryStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterHistoryStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterList) DeepCopyInto(out *ClusterList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Cluster, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterList.
+func (in *ClusterList) DeepCopy() *ClusterList {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterNetwork) DeepCopyInto(out *ClusterNetwork) {
+	*out = *in
+	in.Pods.DeepCopyInto(&out.Pods)
+	in.Services.DeepCopyInto(&out.Services)
+	if in.CNIConfig != nil {
+		in, out := &in.CNIConfig, &out.CNIConfig
+		*out = new(CNIConfig)
 		(*in).DeepCopyInto(*out)
 	}
 	in.DNS.DeepCopyInto(&out.DNS)
@@ -812,6 +1267,36 @@ func (in *ClusterSpec) DeepCopyInto(out *ClusterSpec) {
 			}
 		}
 	}
+	if in.ExternalDNS != nil {
+		in, out := &in.ExternalDNS, &out.ExternalDNS
+		*out = new(ExternalDNSConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DefaultStorageClass != nil {
+		in, out := &in.DefaultStorageClass, &out.DefaultStorageClass
+		*out = new(DefaultStorageClass)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ServiceLoadBalancer != nil {
+		in, out := &in.ServiceLoadBalancer, &out.ServiceLoadBalancer
+		*out = new(ServiceLoadBalancerConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DNS != nil {
+		in, out := &in.DNS, &out.DNS
+		*out = new(CoreDNSConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NodeOSConfiguration != nil {
+		in, out := &in.NodeOSConfiguration, &out.NodeOSConfiguration
+		*out = new(NodeOSConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Spire != nil {
+		in, out := &in.Spire, &out.Spire
+		*out = new(SpireConfiguration)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterSpec.
@@ -866,13 +1351,48 @@ func (in *ClusterStatus) DeepCopy() *ClusterStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ContainerdConfigDropIn) DeepCopyInto(out *ContainerdConfigDropIn) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContainerdConfigDropIn.
+func (in *ContainerdConfigDropIn) DeepCopy() *ContainerdConfigDropIn {
+	if in == nil {
+		return nil
+	}
+	out := new(ContainerdConfigDropIn)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ContainerdConfiguration) DeepCopyInto(out *ContainerdConfiguration) {
+	*out = *in
+	if in.ConfigDropIns != nil {
+		in, out := &in.ConfigDropIns, &out.ConfigDropIns
+		*out = make([]ContainerdConfigDropIn, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContainerdConfiguration.
+func (in *ContainerdConfiguration) DeepCopy() *ContainerdConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(ContainerdConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ControlPlaneConfiguration) DeepCopyInto(out *ControlPlaneConfiguration) {
 	*out = *in
 	if in.Endpoint != nil {
 		in, out := &in.Endpoint, &out.Endpoint
 		*out = new(Endpoint)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.MachineGroupRef != nil {
 		in, out := &in.MachineGroupRef, &out.MachineGroupRef
@@ -915,15 +1435,54 @@ func (in *ControlPlaneConfiguration) DeepCopyInto(out *ControlPlaneConfiguration
 			(*out)[key] = val
 		}
 	}
+	if in.ControllerManagerExtraArgs != nil {
+		in, out := &in.ControllerManagerExtraArgs, &out.ControllerManagerExtraArgs
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.SchedulerExtraArgs != nil {
+		in, out := &in.SchedulerExtraArgs, &out.SchedulerExtraArgs
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 	if in.KubeletConfiguration != nil {
 		in, out := &in.KubeletConfiguration, &out.KubeletConfiguration
 		*out = (*in).DeepCopy()
 	}
+	if in.AuditLog != nil {
+		in, out := &in.AuditLog, &out.AuditLog
+		*out = new(AuditLogConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.SkipAdmissionForSystemResources != nil {
 		in, out := &in.SkipAdmissionForSystemResources, &out.SkipAdmissionForSystemResources
 		*out = new(bool)
 		**out = **in
 	}
+	if in.FailureDomains != nil {
+		in, out := &in.FailureDomains, &out.FailureDomains
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.PodSecurityAdmission != nil {
+		in, out := &in.PodSecurityAdmission, &out.PodSecurityAdmission
+		*out = new(PodSecurityAdmissionConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MachineNaming != nil {
+		in, out := &in.MachineNaming, &out.MachineNaming
+		*out = new(MachineNamingConfiguration)
+		**out = **in
+	}
+	if in.Konnectivity != nil {
+		in, out := &in.Konnectivity, &out.Konnectivity
+		*out = new(KonnectivityConfiguration)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ControlPlaneConfiguration.
@@ -1066,6 +1625,43 @@ func (in *ControlPlaneUpgradeStatus) DeepCopy() *ControlPlaneUpgradeStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CoreDNSConfiguration) DeepCopyInto(out *CoreDNSConfiguration) {
+	*out = *in
+	if in.StubDomains != nil {
+		in, out := &in.StubDomains, &out.StubDomains
+		*out = make([]DNSStubDomain, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.UpstreamNameservers != nil {
+		in, out := &in.UpstreamNameservers, &out.UpstreamNameservers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Autoscaling != nil {
+		in, out := &in.Autoscaling, &out.Autoscaling
+		*out = new(DNSAutoscaling)
+		**out = **in
+	}
+	if in.NodeLocalDNSCache != nil {
+		in, out := &in.NodeLocalDNSCache, &out.NodeLocalDNSCache
+		*out = new(NodeLocalDNSCache)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CoreDNSConfiguration.
+func (in *CoreDNSConfiguration) DeepCopy() *CoreDNSConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(CoreDNSConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DNS) DeepCopyInto(out *DNS) {
 	*out = *in
@@ -1086,12 +1682,69 @@ func (in *DNS) DeepCopy() *DNS {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DNSAutoscaling) DeepCopyInto(out *DNSAutoscaling) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSAutoscaling.
+func (in *DNSAutoscaling) DeepCopy() *DNSAutoscaling {
+	if in == nil {
+		return nil
+	}
+	out := new(DNSAutoscaling)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DNSStubDomain) DeepCopyInto(out *DNSStubDomain) {
+	*out = *in
+	if in.Nameservers != nil {
+		in, out := &in.Nameservers, &out.Nameservers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSStubDomain.
+func (in *DNSStubDomain) DeepCopy() *DNSStubDomain {
+	if in == nil {
+		return nil
+	}
+	out := new(DNSStubDomain)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DefaultStorageClass) DeepCopyInto(out *DefaultStorageClass) {
+	*out = *in
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DefaultStorageClass.
+func (in *DefaultStorageClass) DeepCopy() *DefaultStorageClass {
+	if in == nil {
+		return nil
+	}
+	out := new(DefaultStorageClass)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DockerDatacenterConfig) DeepCopyInto(out *DockerDatacenterConfig) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
+	in.Spec.DeepCopyInto(&out.Spec)
 	out.Status = in.Status
 }
 
@@ -1148,6 +1801,11 @@ func (in *DockerDatacenterConfigList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DockerDatacenterConfigSpec) DeepCopyInto(out *DockerDatacenterConfigSpec) {
 	*out = *in
+	if in.LoadBalancer != nil {
+		in, out := &in.LoadBalancer, &out.LoadBalancer
+		*out = new(DockerLoadBalancer)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DockerDatacenterConfigSpec.
@@ -1176,31 +1834,66 @@ func (in *DockerDatacenterConfigStatus) DeepCopy() *DockerDatacenterConfigStatus
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *EksdReleaseRef) DeepCopyInto(out *EksdReleaseRef) {
+func (in *DockerLoadBalancer) DeepCopyInto(out *DockerLoadBalancer) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EksdReleaseRef.
-func (in *EksdReleaseRef) DeepCopy() *EksdReleaseRef {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DockerLoadBalancer.
+func (in *DockerLoadBalancer) DeepCopy() *DockerLoadBalancer {
 	if in == nil {
 		return nil
 	}
-	out := new(EksdReleaseRef)
+	out := new(DockerLoadBalancer)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Endpoint) DeepCopyInto(out *Endpoint) {
+func (in *EksdReleaseRef) DeepCopyInto(out *EksdReleaseRef) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Endpoint.
-func (in *Endpoint) DeepCopy() *Endpoint {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EksdReleaseRef.
+func (in *EksdReleaseRef) DeepCopy() *EksdReleaseRef {
 	if in == nil {
 		return nil
 	}
-	out := new(Endpoint)
+	out := new(EksdReleaseRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Endpoint) DeepCopyInto(out *Endpoint) {
+	*out = *in
+	if in.DNS != nil {
+		in, out := &in.DNS, &out.DNS
+		*out = new(EndpointDNS)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Endpoint.
+func (in *Endpoint) DeepCopy() *Endpoint {
+	if in == nil {
+		return nil
+	}
+	out := new(Endpoint)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EndpointDNS) DeepCopyInto(out *EndpointDNS) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EndpointDNS.
+func (in *EndpointDNS) DeepCopy() *EndpointDNS {
+	if in == nil {
+		return nil
+	}
+	out := new(EndpointDNS)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -1252,6 +1945,26 @@ func (in *EtcdEncryptionProvider) DeepCopy() *EtcdEncryptionProvider {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExternalDNSConfiguration) DeepCopyInto(out *ExternalDNSConfiguration) {
+	*out = *in
+	if in.DomainFilters != nil {
+		in, out := &in.DomainFilters, &out.DomainFilters
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalDNSConfiguration.
+func (in *ExternalDNSConfiguration) DeepCopy() *ExternalDNSConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(ExternalDNSConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ExternalEtcdConfiguration) DeepCopyInto(out *ExternalEtcdConfiguration) {
 	*out = *in
@@ -1402,6 +2115,26 @@ func (in *FluxConfigStatus) DeepCopy() *FluxConfigStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GPUConfiguration) DeepCopyInto(out *GPUConfiguration) {
+	*out = *in
+	if in.Taint != nil {
+		in, out := &in.Taint, &out.Taint
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GPUConfiguration.
+func (in *GPUConfiguration) DeepCopy() *GPUConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(GPUConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GitOpsConfig) DeepCopyInto(out *GitOpsConfig) {
 	*out = *in
@@ -1603,6 +2336,196 @@ func (in HardwareSelector) DeepCopy() HardwareSelector {
 	return *out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HarvesterDatacenterConfig) DeepCopyInto(out *HarvesterDatacenterConfig) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	out.Status = in.Status
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HarvesterDatacenterConfig.
+func (in *HarvesterDatacenterConfig) DeepCopy() *HarvesterDatacenterConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(HarvesterDatacenterConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *HarvesterDatacenterConfig) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HarvesterDatacenterConfigList) DeepCopyInto(out *HarvesterDatacenterConfigList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]HarvesterDatacenterConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HarvesterDatacenterConfigList.
+func (in *HarvesterDatacenterConfigList) DeepCopy() *HarvesterDatacenterConfigList {
+	if in == nil {
+		return nil
+	}
+	out := new(HarvesterDatacenterConfigList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *HarvesterDatacenterConfigList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HarvesterDatacenterConfigSpec) DeepCopyInto(out *HarvesterDatacenterConfigSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HarvesterDatacenterConfigSpec.
+func (in *HarvesterDatacenterConfigSpec) DeepCopy() *HarvesterDatacenterConfigSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(HarvesterDatacenterConfigSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HarvesterDatacenterConfigStatus) DeepCopyInto(out *HarvesterDatacenterConfigStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HarvesterDatacenterConfigStatus.
+func (in *HarvesterDatacenterConfigStatus) DeepCopy() *HarvesterDatacenterConfigStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(HarvesterDatacenterConfigStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HarvesterMachineConfig) DeepCopyInto(out *HarvesterMachineConfig) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	out.Status = in.Status
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HarvesterMachineConfig.
+func (in *HarvesterMachineConfig) DeepCopy() *HarvesterMachineConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(HarvesterMachineConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *HarvesterMachineConfig) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HarvesterMachineConfigList) DeepCopyInto(out *HarvesterMachineConfigList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]HarvesterMachineConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HarvesterMachineConfigList.
+func (in *HarvesterMachineConfigList) DeepCopy() *HarvesterMachineConfigList {
+	if in == nil {
+		return nil
+	}
+	out := new(HarvesterMachineConfigList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *HarvesterMachineConfigList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HarvesterMachineConfigSpec) DeepCopyInto(out *HarvesterMachineConfigSpec) {
+	*out = *in
+	if in.Users != nil {
+		in, out := &in.Users, &out.Users
+		*out = make([]UserConfiguration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Networks != nil {
+		in, out := &in.Networks, &out.Networks
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HarvesterMachineConfigSpec.
+func (in *HarvesterMachineConfigSpec) DeepCopy() *HarvesterMachineConfigSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(HarvesterMachineConfigSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HarvesterMachineConfigStatus) DeepCopyInto(out *HarvesterMachineConfigStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HarvesterMachineConfigStatus.
+func (in *HarvesterMachineConfigStatus) DeepCopy() *HarvesterMachineConfigStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(HarvesterMachineConfigStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HostOSConfiguration) DeepCopyInto(out *HostOSConfiguration) {
 	*out = *in
@@ -1621,6 +2544,11 @@ func (in *HostOSConfiguration) DeepCopyInto(out *HostOSConfiguration) {
 		*out = make([]certBundle, len(*in))
 		copy(*out, *in)
 	}
+	if in.ContainerdConfiguration != nil {
+		in, out := &in.ContainerdConfiguration, &out.ContainerdConfiguration
+		*out = new(ContainerdConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HostOSConfiguration.
@@ -1703,6 +2631,41 @@ func (in *KindnetdConfig) DeepCopy() *KindnetdConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KonnectivityConfiguration) DeepCopyInto(out *KonnectivityConfiguration) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KonnectivityConfiguration.
+func (in *KonnectivityConfiguration) DeepCopy() *KonnectivityConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(KonnectivityConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubeVipServiceLoadBalancer) DeepCopyInto(out *KubeVipServiceLoadBalancer) {
+	*out = *in
+	if in.AddressPool != nil {
+		in, out := &in.AddressPool, &out.AddressPool
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeVipServiceLoadBalancer.
+func (in *KubeVipServiceLoadBalancer) DeepCopy() *KubeVipServiceLoadBalancer {
+	if in == nil {
+		return nil
+	}
+	out := new(KubeVipServiceLoadBalancer)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MachineDeploymentUpgrade) DeepCopyInto(out *MachineDeploymentUpgrade) {
 	*out = *in
@@ -1828,6 +2791,21 @@ func (in *MachineHealthCheck) DeepCopy() *MachineHealthCheck {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineNamingConfiguration) DeepCopyInto(out *MachineNamingConfiguration) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineNamingConfiguration.
+func (in *MachineNamingConfiguration) DeepCopy() *MachineNamingConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineNamingConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ManagementCluster) DeepCopyInto(out *ManagementCluster) {
 	*out = *in
@@ -1904,26 +2882,68 @@ func (in *NTPConfiguration) DeepCopy() *NTPConfiguration {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NodeUpgrade) DeepCopyInto(out *NodeUpgrade) {
+func (in *NodeLocalDNSCache) DeepCopyInto(out *NodeLocalDNSCache) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeUpgrade.
-func (in *NodeUpgrade) DeepCopy() *NodeUpgrade {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeLocalDNSCache.
+func (in *NodeLocalDNSCache) DeepCopy() *NodeLocalDNSCache {
 	if in == nil {
 		return nil
 	}
-	out := new(NodeUpgrade)
+	out := new(NodeLocalDNSCache)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *NodeUpgrade) DeepCopyObject() runtime.Object {
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeOSConfiguration) DeepCopyInto(out *NodeOSConfiguration) {
+	*out = *in
+	if in.Sysctls != nil {
+		in, out := &in.Sysctls, &out.Sysctls
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.KernelModules != nil {
+		in, out := &in.KernelModules, &out.KernelModules
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeOSConfiguration.
+func (in *NodeOSConfiguration) DeepCopy() *NodeOSConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeOSConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeUpgrade) DeepCopyInto(out *NodeUpgrade) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeUpgrade.
+func (in *NodeUpgrade) DeepCopy() *NodeUpgrade {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeUpgrade)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NodeUpgrade) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -2119,6 +3139,18 @@ func (in *NutanixDatacenterConfigSpec) DeepCopyInto(out *NutanixDatacenterConfig
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.ProviderProxyConfiguration != nil {
+		in, out := &in.ProviderProxyConfiguration, &out.ProviderProxyConfiguration
+		*out = new(ProxyConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ResourceTags != nil {
+		in, out := &in.ResourceTags, &out.ResourceTags
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NutanixDatacenterConfigSpec.
@@ -2349,33 +3381,522 @@ func (in *NutanixResourceIdentifier) DeepCopyInto(out *NutanixResourceIdentifier
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NutanixResourceIdentifier.
-func (in *NutanixResourceIdentifier) DeepCopy() *NutanixResourceIdentifier {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NutanixResourceIdentifier.
+func (in *NutanixResourceIdentifier) DeepCopy() *NutanixResourceIdentifier {
+	if in == nil {
+		return nil
+	}
+	out := new(NutanixResourceIdentifier)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OCINamespace) DeepCopyInto(out *OCINamespace) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OCINamespace.
+func (in *OCINamespace) DeepCopy() *OCINamespace {
+	if in == nil {
+		return nil
+	}
+	out := new(OCINamespace)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OIDCConfig) DeepCopyInto(out *OIDCConfig) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	out.Status = in.Status
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OIDCConfig.
+func (in *OIDCConfig) DeepCopy() *OIDCConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(OIDCConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OIDCConfig) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OIDCConfigList) DeepCopyInto(out *OIDCConfigList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]OIDCConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OIDCConfigList.
+func (in *OIDCConfigList) DeepCopy() *OIDCConfigList {
+	if in == nil {
+		return nil
+	}
+	out := new(OIDCConfigList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OIDCConfigList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OIDCConfigRequiredClaim) DeepCopyInto(out *OIDCConfigRequiredClaim) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OIDCConfigRequiredClaim.
+func (in *OIDCConfigRequiredClaim) DeepCopy() *OIDCConfigRequiredClaim {
+	if in == nil {
+		return nil
+	}
+	out := new(OIDCConfigRequiredClaim)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OIDCConfigSpec) DeepCopyInto(out *OIDCConfigSpec) {
+	*out = *in
+	if in.RequiredClaims != nil {
+		in, out := &in.RequiredClaims, &out.RequiredClaims
+		*out = make([]OIDCConfigRequiredClaim, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OIDCConfigSpec.
+func (in *OIDCConfigSpec) DeepCopy() *OIDCConfigSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OIDCConfigSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OIDCConfigStatus) DeepCopyInto(out *OIDCConfigStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OIDCConfigStatus.
+func (in *OIDCConfigStatus) DeepCopy() *OIDCConfigStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(OIDCConfigStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObjectMeta) DeepCopyInto(out *ObjectMeta) {
+	*out = *in
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectMeta.
+func (in *ObjectMeta) DeepCopy() *ObjectMeta {
+	if in == nil {
+		return nil
+	}
+	out := new(ObjectMeta)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenStackDatacenterConfig) DeepCopyInto(out *OpenStackDatacenterConfig) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	out.Status = in.Status
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenStackDatacenterConfig.
+func (in *OpenStackDatacenterConfig) DeepCopy() *OpenStackDatacenterConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenStackDatacenterConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OpenStackDatacenterConfig) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenStackDatacenterConfigList) DeepCopyInto(out *OpenStackDatacenterConfigList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]OpenStackDatacenterConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenStackDatacenterConfigList.
+func (in *OpenStackDatacenterConfigList) DeepCopy() *OpenStackDatacenterConfigList {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenStackDatacenterConfigList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OpenStackDatacenterConfigList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenStackDatacenterConfigSpec) DeepCopyInto(out *OpenStackDatacenterConfigSpec) {
+	*out = *in
+	if in.FailureDomains != nil {
+		in, out := &in.FailureDomains, &out.FailureDomains
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenStackDatacenterConfigSpec.
+func (in *OpenStackDatacenterConfigSpec) DeepCopy() *OpenStackDatacenterConfigSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenStackDatacenterConfigSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenStackDatacenterConfigStatus) DeepCopyInto(out *OpenStackDatacenterConfigStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenStackDatacenterConfigStatus.
+func (in *OpenStackDatacenterConfigStatus) DeepCopy() *OpenStackDatacenterConfigStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenStackDatacenterConfigStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenStackMachineConfig) DeepCopyInto(out *OpenStackMachineConfig) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	out.Status = in.Status
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenStackMachineConfig.
+func (in *OpenStackMachineConfig) DeepCopy() *OpenStackMachineConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenStackMachineConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OpenStackMachineConfig) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenStackMachineConfigList) DeepCopyInto(out *OpenStackMachineConfigList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]OpenStackMachineConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenStackMachineConfigList.
+func (in *OpenStackMachineConfigList) DeepCopy() *OpenStackMachineConfigList {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenStackMachineConfigList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OpenStackMachineConfigList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenStackMachineConfigSpec) DeepCopyInto(out *OpenStackMachineConfigSpec) {
+	*out = *in
+	if in.Users != nil {
+		in, out := &in.Users, &out.Users
+		*out = make([]UserConfiguration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.SecurityGroups != nil {
+		in, out := &in.SecurityGroups, &out.SecurityGroups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenStackMachineConfigSpec.
+func (in *OpenStackMachineConfigSpec) DeepCopy() *OpenStackMachineConfigSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenStackMachineConfigSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenStackMachineConfigStatus) DeepCopyInto(out *OpenStackMachineConfigStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenStackMachineConfigStatus.
+func (in *OpenStackMachineConfigStatus) DeepCopy() *OpenStackMachineConfigStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenStackMachineConfigStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PackageConfiguration) DeepCopyInto(out *PackageConfiguration) {
+	*out = *in
+	if in.Controller != nil {
+		in, out := &in.Controller, &out.Controller
+		*out = new(PackageControllerConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CronJob != nil {
+		in, out := &in.CronJob, &out.CronJob
+		*out = new(PackageControllerCronJob)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PackageConfiguration.
+func (in *PackageConfiguration) DeepCopy() *PackageConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(PackageConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PackageControllerConfiguration) DeepCopyInto(out *PackageControllerConfiguration) {
+	*out = *in
+	if in.Env != nil {
+		in, out := &in.Env, &out.Env
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	out.Resources = in.Resources
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PackageControllerConfiguration.
+func (in *PackageControllerConfiguration) DeepCopy() *PackageControllerConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(PackageControllerConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PackageControllerCronJob) DeepCopyInto(out *PackageControllerCronJob) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PackageControllerCronJob.
+func (in *PackageControllerCronJob) DeepCopy() *PackageControllerCronJob {
+	if in == nil {
+		return nil
+	}
+	out := new(PackageControllerCronJob)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PackageControllerResources) DeepCopyInto(out *PackageControllerResources) {
+	*out = *in
+	out.Requests = in.Requests
+	out.Limits = in.Limits
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PackageControllerResources.
+func (in *PackageControllerResources) DeepCopy() *PackageControllerResources {
+	if in == nil {
+		return nil
+	}
+	out := new(PackageControllerResources)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodIAMConfig) DeepCopyInto(out *PodIAMConfig) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodIAMConfig.
+func (in *PodIAMConfig) DeepCopy() *PodIAMConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(PodIAMConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodSecurityAdmissionConfiguration) DeepCopyInto(out *PodSecurityAdmissionConfiguration) {
+	*out = *in
+	if in.Exemptions != nil {
+		in, out := &in.Exemptions, &out.Exemptions
+		*out = new(PodSecurityAdmissionExemptions)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodSecurityAdmissionConfiguration.
+func (in *PodSecurityAdmissionConfiguration) DeepCopy() *PodSecurityAdmissionConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(PodSecurityAdmissionConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodSecurityAdmissionExemptions) DeepCopyInto(out *PodSecurityAdmissionExemptions) {
+	*out = *in
+	if in.Usernames != nil {
+		in, out := &in.Usernames, &out.Usernames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.RuntimeClasses != nil {
+		in, out := &in.RuntimeClasses, &out.RuntimeClasses
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Namespaces != nil {
+		in, out := &in.Namespaces, &out.Namespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodSecurityAdmissionExemptions.
+func (in *PodSecurityAdmissionExemptions) DeepCopy() *PodSecurityAdmissionExemptions {
 	if in == nil {
 		return nil
 	}
-	out := new(NutanixResourceIdentifier)
+	out := new(PodSecurityAdmissionExemptions)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OCINamespace) DeepCopyInto(out *OCINamespace) {
+func (in *Pods) DeepCopyInto(out *Pods) {
 	*out = *in
+	if in.CidrBlocks != nil {
+		in, out := &in.CidrBlocks, &out.CidrBlocks
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OCINamespace.
-func (in *OCINamespace) DeepCopy() *OCINamespace {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Pods.
+func (in *Pods) DeepCopy() *Pods {
 	if in == nil {
 		return nil
 	}
-	out := new(OCINamespace)
+	out := new(Pods)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OIDCConfig) DeepCopyInto(out *OIDCConfig) {
+func (in *ProxmoxDatacenterConfig) DeepCopyInto(out *ProxmoxDatacenterConfig) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -2383,18 +3904,18 @@ func (in *OIDCConfig) DeepCopyInto(out *OIDCConfig) {
 	out.Status = in.Status
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OIDCConfig.
-func (in *OIDCConfig) DeepCopy() *OIDCConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProxmoxDatacenterConfig.
+func (in *ProxmoxDatacenterConfig) DeepCopy() *ProxmoxDatacenterConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(OIDCConfig)
+	out := new(ProxmoxDatacenterConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *OIDCConfig) DeepCopyObject() runtime.Object {
+func (in *ProxmoxDatacenterConfig) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -2402,31 +3923,31 @@ func (in *OIDCConfig) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OIDCConfigList) DeepCopyInto(out *OIDCConfigList) {
+func (in *ProxmoxDatacenterConfigList) DeepCopyInto(out *ProxmoxDatacenterConfigList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]OIDCConfig, len(*in))
+		*out = make([]ProxmoxDatacenterConfig, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OIDCConfigList.
-func (in *OIDCConfigList) DeepCopy() *OIDCConfigList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProxmoxDatacenterConfigList.
+func (in *ProxmoxDatacenterConfigList) DeepCopy() *ProxmoxDatacenterConfigList {
 	if in == nil {
 		return nil
 	}
-	out := new(OIDCConfigList)
+	out := new(ProxmoxDatacenterConfigList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *OIDCConfigList) DeepCopyObject() runtime.Object {
+func (in *ProxmoxDatacenterConfigList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -2434,186 +3955,158 @@ func (in *OIDCConfigList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OIDCConfigRequiredClaim) DeepCopyInto(out *OIDCConfigRequiredClaim) {
+func (in *ProxmoxDatacenterConfigSpec) DeepCopyInto(out *ProxmoxDatacenterConfigSpec) {
 	*out = *in
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OIDCConfigRequiredClaim.
-func (in *OIDCConfigRequiredClaim) DeepCopy() *OIDCConfigRequiredClaim {
-	if in == nil {
-		return nil
+	if in.Nodes != nil {
+		in, out := &in.Nodes, &out.Nodes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
-	out := new(OIDCConfigRequiredClaim)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OIDCConfigSpec) DeepCopyInto(out *OIDCConfigSpec) {
-	*out = *in
-	if in.RequiredClaims != nil {
-		in, out := &in.RequiredClaims, &out.RequiredClaims
-		*out = make([]OIDCConfigRequiredClaim, len(*in))
+	in.IPv4Config.DeepCopyInto(&out.IPv4Config)
+	if in.DNSServers != nil {
+		in, out := &in.DNSServers, &out.DNSServers
+		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OIDCConfigSpec.
-func (in *OIDCConfigSpec) DeepCopy() *OIDCConfigSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProxmoxDatacenterConfigSpec.
+func (in *ProxmoxDatacenterConfigSpec) DeepCopy() *ProxmoxDatacenterConfigSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(OIDCConfigSpec)
+	out := new(ProxmoxDatacenterConfigSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OIDCConfigStatus) DeepCopyInto(out *OIDCConfigStatus) {
+func (in *ProxmoxDatacenterConfigStatus) DeepCopyInto(out *ProxmoxDatacenterConfigStatus) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OIDCConfigStatus.
-func (in *OIDCConfigStatus) DeepCopy() *OIDCConfigStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProxmoxDatacenterConfigStatus.
+func (in *ProxmoxDatacenterConfigStatus) DeepCopy() *ProxmoxDatacenterConfigStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(OIDCConfigStatus)
+	out := new(ProxmoxDatacenterConfigStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ObjectMeta) DeepCopyInto(out *ObjectMeta) {
+func (in *ProxmoxIPv4Config) DeepCopyInto(out *ProxmoxIPv4Config) {
 	*out = *in
-	if in.Annotations != nil {
-		in, out := &in.Annotations, &out.Annotations
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
+	if in.Addresses != nil {
+		in, out := &in.Addresses, &out.Addresses
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectMeta.
-func (in *ObjectMeta) DeepCopy() *ObjectMeta {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProxmoxIPv4Config.
+func (in *ProxmoxIPv4Config) DeepCopy() *ProxmoxIPv4Config {
 	if in == nil {
 		return nil
 	}
-	out := new(ObjectMeta)
+	out := new(ProxmoxIPv4Config)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PackageConfiguration) DeepCopyInto(out *PackageConfiguration) {
+func (in *ProxmoxMachineConfig) DeepCopyInto(out *ProxmoxMachineConfig) {
 	*out = *in
-	if in.Controller != nil {
-		in, out := &in.Controller, &out.Controller
-		*out = new(PackageControllerConfiguration)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.CronJob != nil {
-		in, out := &in.CronJob, &out.CronJob
-		*out = new(PackageControllerCronJob)
-		**out = **in
-	}
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	out.Status = in.Status
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PackageConfiguration.
-func (in *PackageConfiguration) DeepCopy() *PackageConfiguration {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProxmoxMachineConfig.
+func (in *ProxmoxMachineConfig) DeepCopy() *ProxmoxMachineConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(PackageConfiguration)
+	out := new(ProxmoxMachineConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PackageControllerConfiguration) DeepCopyInto(out *PackageControllerConfiguration) {
-	*out = *in
-	if in.Env != nil {
-		in, out := &in.Env, &out.Env
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	out.Resources = in.Resources
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PackageControllerConfiguration.
-func (in *PackageControllerConfiguration) DeepCopy() *PackageControllerConfiguration {
-	if in == nil {
-		return nil
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ProxmoxMachineConfig) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
 	}
-	out := new(PackageControllerConfiguration)
-	in.DeepCopyInto(out)
-	return out
+	return nil
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PackageControllerCronJob) DeepCopyInto(out *PackageControllerCronJob) {
+func (in *ProxmoxMachineConfigList) DeepCopyInto(out *ProxmoxMachineConfigList) {
 	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ProxmoxMachineConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PackageControllerCronJob.
-func (in *PackageControllerCronJob) DeepCopy() *PackageControllerCronJob {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProxmoxMachineConfigList.
+func (in *ProxmoxMachineConfigList) DeepCopy() *ProxmoxMachineConfigList {
 	if in == nil {
 		return nil
 	}
-	out := new(PackageControllerCronJob)
+	out := new(ProxmoxMachineConfigList)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PackageControllerResources) DeepCopyInto(out *PackageControllerResources) {
-	*out = *in
-	out.Requests = in.Requests
-	out.Limits = in.Limits
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PackageControllerResources.
-func (in *PackageControllerResources) DeepCopy() *PackageControllerResources {
-	if in == nil {
-		return nil
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ProxmoxMachineConfigList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
 	}
-	out := new(PackageControllerResources)
-	in.DeepCopyInto(out)
-	return out
+	return nil
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PodIAMConfig) DeepCopyInto(out *PodIAMConfig) {
+func (in *ProxmoxMachineConfigSpec) DeepCopyInto(out *ProxmoxMachineConfigSpec) {
 	*out = *in
+	if in.Users != nil {
+		in, out := &in.Users, &out.Users
+		*out = make([]UserConfiguration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodIAMConfig.
-func (in *PodIAMConfig) DeepCopy() *PodIAMConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProxmoxMachineConfigSpec.
+func (in *ProxmoxMachineConfigSpec) DeepCopy() *ProxmoxMachineConfigSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(PodIAMConfig)
+	out := new(ProxmoxMachineConfigSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Pods) DeepCopyInto(out *Pods) {
+func (in *ProxmoxMachineConfigStatus) DeepCopyInto(out *ProxmoxMachineConfigStatus) {
 	*out = *in
-	if in.CidrBlocks != nil {
-		in, out := &in.CidrBlocks, &out.CidrBlocks
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Pods.
-func (in *Pods) DeepCopy() *Pods {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProxmoxMachineConfigStatus.
+func (in *ProxmoxMachineConfigStatus) DeepCopy() *ProxmoxMachineConfigStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(Pods)
+	out := new(ProxmoxMachineConfigStatus)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -2688,6 +4181,26 @@ func (in *ResolvConf) DeepCopy() *ResolvConf {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceLoadBalancerConfiguration) DeepCopyInto(out *ServiceLoadBalancerConfiguration) {
+	*out = *in
+	if in.KubeVip != nil {
+		in, out := &in.KubeVip, &out.KubeVip
+		*out = new(KubeVipServiceLoadBalancer)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceLoadBalancerConfiguration.
+func (in *ServiceLoadBalancerConfiguration) DeepCopy() *ServiceLoadBalancerConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceLoadBalancerConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Services) DeepCopyInto(out *Services) {
 	*out = *in
@@ -3081,6 +4594,21 @@ func (in SymlinkMaps) DeepCopy() SymlinkMaps {
 	return *out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SpireConfiguration) DeepCopyInto(out *SpireConfiguration) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SpireConfiguration.
+func (in *SpireConfiguration) DeepCopy() *SpireConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(SpireConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TinkerbellDatacenterConfig) DeepCopyInto(out *TinkerbellDatacenterConfig) {
 	*out = *in
@@ -3461,6 +4989,16 @@ func (in *VSphereDatacenterConfigSpec) DeepCopyInto(out *VSphereDatacenterConfig
 		*out = make([]FailureDomain, len(*in))
 		copy(*out, *in)
 	}
+	if in.ProviderProxyConfiguration != nil {
+		in, out := &in.ProviderProxyConfiguration, &out.ProviderProxyConfiguration
+		*out = new(ProxyConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TopologyCategories != nil {
+		in, out := &in.TopologyCategories, &out.TopologyCategories
+		*out = new(VSphereTopologyCategories)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VSphereDatacenterConfigSpec.
@@ -3493,6 +5031,21 @@ func (in *VSphereDatacenterConfigStatus) DeepCopy() *VSphereDatacenterConfigStat
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VSphereIPPoolReference) DeepCopyInto(out *VSphereIPPoolReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VSphereIPPoolReference.
+func (in *VSphereIPPoolReference) DeepCopy() *VSphereIPPoolReference {
+	if in == nil {
+		return nil
+	}
+	out := new(VSphereIPPoolReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VSphereMachineConfig) DeepCopyInto(out *VSphereMachineConfig) {
 	*out = *in
@@ -3560,6 +5113,18 @@ func (in *VSphereMachineConfigSpec) DeepCopyInto(out *VSphereMachineConfigSpec)
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.NetworkDevices != nil {
+		in, out := &in.NetworkDevices, &out.NetworkDevices
+		*out = make([]VSphereNetworkDevice, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.OVA != nil {
+		in, out := &in.OVA, &out.OVA
+		*out = new(VSphereTemplateOVA)
+		**out = **in
+	}
 	if in.Users != nil {
 		in, out := &in.Users, &out.Users
 		*out = make([]UserConfiguration, len(*in))
@@ -3577,6 +5142,13 @@ func (in *VSphereMachineConfigSpec) DeepCopyInto(out *VSphereMachineConfigSpec)
 		*out = new(HostOSConfiguration)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.GuestInfo != nil {
+		in, out := &in.GuestInfo, &out.GuestInfo
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VSphereMachineConfigSpec.
@@ -3604,6 +5176,61 @@ func (in *VSphereMachineConfigStatus) DeepCopy() *VSphereMachineConfigStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VSphereNetworkDevice) DeepCopyInto(out *VSphereNetworkDevice) {
+	*out = *in
+	if in.IPPool != nil {
+		in, out := &in.IPPool, &out.IPPool
+		*out = new(VSphereIPPoolReference)
+		**out = **in
+	}
+	if in.MTU != nil {
+		in, out := &in.MTU, &out.MTU
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VSphereNetworkDevice.
+func (in *VSphereNetworkDevice) DeepCopy() *VSphereNetworkDevice {
+	if in == nil {
+		return nil
+	}
+	out := new(VSphereNetworkDevice)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VSphereTemplateOVA) DeepCopyInto(out *VSphereTemplateOVA) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VSphereTemplateOVA.
+func (in *VSphereTemplateOVA) DeepCopy() *VSphereTemplateOVA {
+	if in == nil {
+		return nil
+	}
+	out := new(VSphereTemplateOVA)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VSphereTopologyCategories) DeepCopyInto(out *VSphereTopologyCategories) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VSphereTopologyCategories.
+func (in *VSphereTopologyCategories) DeepCopy() *VSphereTopologyCategories {
+	if in == nil {
+		return nil
+	}
+	out := new(VSphereTopologyCategories)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WeightedHardwareAffinityTerm) DeepCopyInto(out *WeightedHardwareAffinityTerm) {
 	*out = *in
@@ -3676,6 +5303,16 @@ func (in *WorkerNodeGroupConfiguration) DeepCopyInto(out *WorkerNodeGroupConfigu
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.GPU != nil {
+		in, out := &in.GPU, &out.GPU
+		*out = new(GPUConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MachineNaming != nil {
+		in, out := &in.MachineNaming, &out.MachineNaming
+		*out = new(MachineNamingConfiguration)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkerNodeGroupConfiguration.
@@ -78,6 +78,7 @@ func (c *Client) EC2ImportKeyPair(ctx context.Context, keyName string, keyMateri
 type EC2InstanceType struct {
 	Name        string
 	DefaultVCPU *int32
+	MemoryMiB   *int64
 }
 
 // EC2InstanceTypes calls aws sdk ec2.DescribeInstanceTypes to get a list of supported instance type for a device.
@@ -89,10 +90,14 @@ func (c *Client) EC2InstanceTypes(ctx context.Context) ([]EC2InstanceType, error
 
 	instanceTypes := make([]EC2InstanceType, 0, len(out.InstanceTypes))
 	for _, it := range out.InstanceTypes {
-		instanceTypes = append(instanceTypes, EC2InstanceType{
+		instanceType := EC2InstanceType{
 			Name:        string(it.InstanceType),
 			DefaultVCPU: it.VCpuInfo.DefaultVCpus,
-		})
+		}
+		if it.MemoryInfo != nil {
+			instanceType.MemoryMiB = it.MemoryInfo.SizeInMiB
+		}
+		instanceTypes = append(instanceTypes, instanceType)
 	}
 	return instanceTypes, nil
 }
@@ -127,6 +127,9 @@ func TestEC2InstanceTypes(t *testing.T) {
 				VCpuInfo: &types.VCpuInfo{
 					DefaultVCpus: ptr.Int32(8),
 				},
+				MemoryInfo: &types.MemoryInfo{
+					SizeInMiB: ptr.Int64(16384),
+				},
 			},
 			{
 				InstanceType: types.InstanceTypeA1Large,
@@ -140,6 +143,7 @@ func TestEC2InstanceTypes(t *testing.T) {
 		{
 			Name:        "c1.medium",
 			DefaultVCPU: ptr.Int32(8),
+			MemoryMiB:   ptr.Int64(16384),
 		},
 		{
 			Name:        "a1.large",
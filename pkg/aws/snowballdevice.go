@@ -34,3 +34,33 @@ func (c *Client) SnowballDeviceSoftwareVersion(ctx context.Context) (string, err
 	}
 	return *out.InstalledVersion, nil
 }
+
+// SnowballDeviceCapacity is the capacity of a snowball device resource, like vCPU or Memory.
+type SnowballDeviceCapacity struct {
+	Name      string
+	Unit      string
+	Total     int64
+	Used      int64
+	Available int64
+}
+
+// SnowballDeviceCapacities returns the capacities of the snowball device resources.
+func (c *Client) SnowballDeviceCapacities(ctx context.Context) ([]SnowballDeviceCapacity, error) {
+	out, err := c.snowballDevice.DescribeDevice(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("describing snowball device: %v", err)
+	}
+
+	capacities := make([]SnowballDeviceCapacity, 0, len(out.DeviceCapacities))
+	for _, dc := range out.DeviceCapacities {
+		capacities = append(capacities, SnowballDeviceCapacity{
+			Name:      aws.ToString(dc.Name),
+			Unit:      aws.ToString(dc.Unit),
+			Total:     aws.ToInt64(dc.Total),
+			Used:      aws.ToInt64(dc.Used),
+			Available: aws.ToInt64(dc.Available),
+		})
+	}
+
+	return capacities, nil
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/aws/eks-anywhere/internal/aws-sdk-go-v2/service/snowballdevice/types"
 	"github.com/aws/eks-anywhere/pkg/aws"
 	"github.com/aws/eks-anywhere/pkg/aws/mocks"
+	"github.com/aws/eks-anywhere/pkg/utils/ptr"
 )
 
 type snowballDeviceTest struct {
@@ -86,3 +87,50 @@ func TestSnowballDeviceSoftwareVersionDescribeDeviceSoftwareError(t *testing.T)
 	g.Expect(err).NotTo(Succeed())
 	g.Expect(got).To(Equal(""))
 }
+
+func TestSnowballDeviceCapacitiesSuccess(t *testing.T) {
+	g := newSnowballDeviceTest(t)
+	out := &snowballdevice.DescribeDeviceOutput{
+		DeviceCapacities: []types.Capacity{
+			{
+				Name:      ptr.String("vCPU"),
+				Unit:      ptr.String("Number"),
+				Total:     ptr.Int64(104),
+				Used:      ptr.Int64(8),
+				Available: ptr.Int64(96),
+			},
+			{
+				Name:      ptr.String("Memory"),
+				Unit:      ptr.String("Byte"),
+				Total:     ptr.Int64(446676598784),
+				Available: ptr.Int64(412316860416),
+			},
+		},
+	}
+	want := []aws.SnowballDeviceCapacity{
+		{
+			Name:      "vCPU",
+			Unit:      "Number",
+			Total:     104,
+			Used:      8,
+			Available: 96,
+		},
+		{
+			Name:      "Memory",
+			Unit:      "Byte",
+			Total:     446676598784,
+			Available: 412316860416,
+		},
+	}
+	g.snowballDevice.EXPECT().DescribeDevice(g.ctx, nil).Return(out, nil)
+	got, err := g.client.SnowballDeviceCapacities(g.ctx)
+	g.Expect(err).To(Succeed())
+	g.Expect(got).To(Equal(want))
+}
+
+func TestSnowballDeviceCapacitiesDescribeDeviceError(t *testing.T) {
+	g := newSnowballDeviceTest(t)
+	g.snowballDevice.EXPECT().DescribeDevice(g.ctx, nil).Return(nil, errors.New("error"))
+	_, err := g.client.SnowballDeviceCapacities(g.ctx)
+	g.Expect(err).To(MatchError(ContainSubstring("describing snowball device")))
+}
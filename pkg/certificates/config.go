@@ -5,6 +5,7 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"slices"
 
 	"gopkg.in/yaml.v2"
 	clusterv1beta2 "sigs.k8s.io/cluster-api/api/core/v1beta2"
@@ -131,6 +132,17 @@ func ValidateComponentWithConfig(component string, config *RenewalConfig) error
 	return nil
 }
 
+// ValidateNodes validates that the nodes selected for the renewal are control plane or etcd nodes of the config.
+func ValidateNodes(config *RenewalConfig, nodes []string) error {
+	for _, node := range nodes {
+		if !slices.Contains(config.ControlPlane.Nodes, node) && !slices.Contains(config.Etcd.Nodes, node) {
+			return fmt.Errorf("node %s is not a control plane or etcd node of cluster %s", node, config.ClusterName)
+		}
+	}
+
+	return nil
+}
+
 // PopulateConfig fills in the configuration with control plane and etcd node IPs from the Kubernetes cluster.
 func PopulateConfig(ctx context.Context, cfg *RenewalConfig, kubeClient kubernetes.Client, cluster *types.Cluster) error {
 	if len(cfg.ControlPlane.Nodes) > 0 {
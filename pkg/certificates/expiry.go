@@ -0,0 +1,79 @@
+package certificates
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/constants"
+)
+
+// Components whose serving certificates are inspected on the machines of a cluster.
+const (
+	APIServerComponent  = "kube-apiserver"
+	EtcdServerComponent = "etcd"
+	KubeletComponent    = "kubelet"
+)
+
+// ComponentCertificate is the serving certificate of a component on a machine of a cluster.
+type ComponentCertificate struct {
+	Machine       string    `json:"machine"`
+	IP            string    `json:"ip"`
+	Role          string    `json:"role"`
+	Component     string    `json:"component"`
+	NotAfter      time.Time `json:"notAfter"`
+	ExpiresInDays int       `json:"expiresInDays"`
+	Error         string    `json:"error,omitempty"`
+}
+
+// ComponentCertificates reads the serving certificates of the components of the control plane
+// machines and, when the cluster has one, of the external etcd machines. A certificate that can't
+// be read is returned with its error, so one unreachable machine doesn't hide the others.
+func (s *Scanner) ComponentCertificates(ctx context.Context, cluster *anywherev1.Cluster) ([]ComponentCertificate, error) {
+	controlPlaneMachines, err := s.getControlPlaneMachines(ctx, cluster)
+	if err != nil {
+		return nil, fmt.Errorf("getting control plane machines: %w", err)
+	}
+
+	controlPlaneComponents := []string{APIServerComponent, KubeletComponent}
+	if cluster.Spec.ExternalEtcdConfiguration == nil {
+		controlPlaneComponents = []string{APIServerComponent, EtcdServerComponent, KubeletComponent}
+	}
+	certs := s.componentCertificates(controlPlaneMachines, constants.ControlPlaneComponent, controlPlaneComponents)
+
+	if cluster.Spec.ExternalEtcdConfiguration != nil {
+		etcdMachines, err := s.getEtcdMachines(ctx, cluster)
+		if err != nil {
+			return nil, fmt.Errorf("getting etcd machines: %w", err)
+		}
+		certs = append(certs, s.componentCertificates(etcdMachines, constants.EtcdComponent, []string{EtcdServerComponent})...)
+	}
+
+	return certs, nil
+}
+
+func (s *Scanner) componentCertificates(machines []MachineInfo, role string, components []string) []ComponentCertificate {
+	var certs []ComponentCertificate
+	for _, machine := range machines {
+		for _, component := range components {
+			info := ComponentCertificate{
+				Machine:   machine.Name,
+				IP:        machine.IP,
+				Role:      role,
+				Component: component,
+			}
+			cert, err := servingCertificate(machine.IP, s.componentPorts[component])
+			if err != nil {
+				s.logger.V(4).Info("Failed reading certificate", "machine", machine.Name, "component", component, "error", err.Error())
+				info.Error = err.Error()
+			} else {
+				info.NotAfter = cert.NotAfter.UTC()
+				info.ExpiresInDays = daysUntil(cert.NotAfter)
+			}
+			certs = append(certs, info)
+		}
+	}
+
+	return certs
+}
@@ -0,0 +1,124 @@
+package certificates_test
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/go-logr/logr/testr"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clusterv1beta2 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/certificates"
+	"github.com/aws/eks-anywhere/pkg/constants"
+)
+
+func tlsServerPort(t *testing.T) string {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {}))
+	t.Cleanup(server.Close)
+	u, err := url.Parse(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, port, err := net.SplitHostPort(u.Host)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return port
+}
+
+func closedPort(t *testing.T) string {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, port, _ := net.SplitHostPort(l.Addr().String())
+	l.Close()
+
+	return port
+}
+
+func capiMachine(name string, labels map[string]string) *clusterv1beta2.Machine {
+	return &clusterv1beta2.Machine{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: constants.EksaSystemNamespace,
+			Labels:    labels,
+		},
+		Status: clusterv1beta2.MachineStatus{
+			Addresses: []clusterv1beta2.MachineAddress{
+				{Type: clusterv1beta2.MachineExternalIP, Address: endpoint},
+			},
+		},
+	}
+}
+
+func TestScannerComponentCertificatesStackedEtcd(t *testing.T) {
+	g := NewWithT(t)
+	port := tlsServerPort(t)
+	client := newFakeClientBuilder().WithObjects(
+		capiMachine("test-cluster-cp-1", map[string]string{clusterv1beta2.ClusterNameLabel: "test-cluster", clusterv1beta2.MachineControlPlaneLabel: ""}),
+	).Build()
+	cluster := &anywherev1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: "test-cluster"}}
+
+	scanner := certificates.NewCertificateScanner(client, testr.New(t),
+		certificates.WithComponentPort(certificates.APIServerComponent, port),
+		certificates.WithComponentPort(certificates.EtcdServerComponent, port),
+		certificates.WithComponentPort(certificates.KubeletComponent, closedPort(t)),
+	)
+	certs, err := scanner.ComponentCertificates(context.Background(), cluster)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(certs).To(HaveLen(3))
+
+	for _, c := range certs[:2] {
+		g.Expect(c.Machine).To(Equal("test-cluster-cp-1"))
+		g.Expect(c.Role).To(Equal(constants.ControlPlaneComponent))
+		g.Expect(c.Error).To(BeEmpty())
+		g.Expect(c.NotAfter.IsZero()).To(BeFalse())
+		g.Expect(c.ExpiresInDays).To(BeNumerically(">", 0))
+	}
+	g.Expect(certs[0].Component).To(Equal(certificates.APIServerComponent))
+	g.Expect(certs[1].Component).To(Equal(certificates.EtcdServerComponent))
+	g.Expect(certs[2].Component).To(Equal(certificates.KubeletComponent))
+	g.Expect(certs[2].Error).To(ContainSubstring("connecting to"))
+}
+
+func TestScannerComponentCertificatesExternalEtcd(t *testing.T) {
+	g := NewWithT(t)
+	port := tlsServerPort(t)
+	client := newFakeClientBuilder().WithObjects(
+		capiMachine("test-cluster-cp-1", map[string]string{clusterv1beta2.ClusterNameLabel: "test-cluster", clusterv1beta2.MachineControlPlaneLabel: ""}),
+		capiMachine("test-cluster-etcd-1", map[string]string{clusterv1beta2.ClusterNameLabel: "test-cluster", "cluster.x-k8s.io/etcd-cluster": "test-cluster-etcd"}),
+	).Build()
+	cluster := &anywherev1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-cluster"},
+		Spec: anywherev1.ClusterSpec{
+			ExternalEtcdConfiguration: &anywherev1.ExternalEtcdConfiguration{Count: 1},
+		},
+	}
+
+	scanner := certificates.NewCertificateScanner(client, testr.New(t),
+		certificates.WithComponentPort(certificates.APIServerComponent, port),
+		certificates.WithComponentPort(certificates.EtcdServerComponent, port),
+		certificates.WithComponentPort(certificates.KubeletComponent, port),
+	)
+	certs, err := scanner.ComponentCertificates(context.Background(), cluster)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	var got []string
+	for _, c := range certs {
+		g.Expect(c.Error).To(BeEmpty())
+		got = append(got, c.Machine+"/"+c.Role+"/"+c.Component)
+	}
+	g.Expect(got).To(Equal([]string{
+		"test-cluster-cp-1/control-plane/kube-apiserver",
+		"test-cluster-cp-1/control-plane/kubelet",
+		"test-cluster-etcd-1/etcd/etcd",
+	}))
+}
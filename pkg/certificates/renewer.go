@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"slices"
 	"time"
 
 	corev1 "k8s.io/api/core/v1"
@@ -28,6 +29,9 @@ type Renewer struct {
 	SSHEtcd         SSHRunner
 	SSHControlPlane SSHRunner
 	OS              OSRenewer
+	// Nodes restricts the renewal to these control plane and etcd nodes. All the nodes of the
+	// config are renewed when it's empty.
+	Nodes []string
 }
 
 // NewRenewer creates a new certificate renewer instance with a timestamped backup directory.
@@ -71,20 +75,27 @@ func (r *Renewer) RenewCertificates(ctx context.Context, cfg *RenewalConfig, com
 		return err
 	}
 
+	etcdNodes := r.selectNodes(cfg.Etcd.Nodes)
+	controlPlaneNodes := r.selectNodes(cfg.ControlPlane.Nodes)
+	processEtcd = processEtcd && len(etcdNodes) > 0
+	processControlPlane = processControlPlane && len(controlPlaneNodes) > 0
+
 	if processEtcd {
-		if err := r.renewEtcdCerts(ctx, cfg); err != nil {
+		if err := r.renewEtcdCerts(ctx, etcdNodes); err != nil {
 			return err
 		}
 	}
 
 	if processControlPlane {
-		if err := r.renewControlPlaneCerts(ctx, cfg, component); err != nil {
+		if err := r.renewControlPlaneCerts(ctx, cfg, controlPlaneNodes, component); err != nil {
 			return err
 		}
 	}
 
+	// The API server etcd client certificate is issued again on every renewed etcd node, so it's
+	// copied to all the control plane nodes even when only some of them are renewed.
 	if processEtcd {
-		if err := r.processEtcdCertificateTransfer(ctx, cfg); err != nil {
+		if err := r.processEtcdCertificateTransfer(ctx, cfg, etcdNodes[0]); err != nil {
 			return err
 		}
 	}
@@ -94,8 +105,24 @@ func (r *Renewer) RenewCertificates(ctx context.Context, cfg *RenewalConfig, com
 	return nil
 }
 
-func (r *Renewer) renewEtcdCerts(ctx context.Context, cfg *RenewalConfig) error {
-	for _, node := range cfg.Etcd.Nodes {
+// selectNodes returns the nodes of a group that are part of the renewal.
+func (r *Renewer) selectNodes(nodes []string) []string {
+	if len(r.Nodes) == 0 {
+		return nodes
+	}
+
+	var selected []string
+	for _, node := range nodes {
+		if slices.Contains(r.Nodes, node) {
+			selected = append(selected, node)
+		}
+	}
+
+	return selected
+}
+
+func (r *Renewer) renewEtcdCerts(ctx context.Context, nodes []string) error {
+	for _, node := range nodes {
 		if err := r.OS.RenewEtcdCerts(ctx, node, r.SSHEtcd); err != nil {
 			return fmt.Errorf("renewing certificates for etcd node %s: %v", node, err)
 		}
@@ -105,8 +132,8 @@ func (r *Renewer) renewEtcdCerts(ctx context.Context, cfg *RenewalConfig) error
 	return nil
 }
 
-func (r *Renewer) renewControlPlaneCerts(ctx context.Context, cfg *RenewalConfig, component string) error {
-	for _, node := range cfg.ControlPlane.Nodes {
+func (r *Renewer) renewControlPlaneCerts(ctx context.Context, cfg *RenewalConfig, nodes []string, component string) error {
+	for _, node := range nodes {
 		if err := r.OS.RenewControlPlaneCerts(ctx, node, cfg, component, r.SSHControlPlane); err != nil {
 			return fmt.Errorf("renewing certificates for control-plane node %s: %v", node, err)
 		}
@@ -177,8 +204,7 @@ func (r *Renewer) validateRenewalConfig(
 	return processEtcd, processControlPlane, nil
 }
 
-func (r *Renewer) processEtcdCertificateTransfer(ctx context.Context, cfg *RenewalConfig) error {
-	firstNode := cfg.Etcd.Nodes[0]
+func (r *Renewer) processEtcdCertificateTransfer(ctx context.Context, cfg *RenewalConfig, firstNode string) error {
 	logger.V(4).Info("Transferring external ETCD certificate to control plane nodes")
 
 	if err := r.OS.CopyEtcdCertsToLocal(ctx, firstNode, r.SSHEtcd); err != nil {
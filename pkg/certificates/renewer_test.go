@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"slices"
 	"testing"
 
 	"github.com/golang/mock/gomock"
@@ -627,3 +628,103 @@ func TestNewRenewerControlPlaneSSHError(t *testing.T) {
 		t.Fatal("NewRenewer() expected error, got nil")
 	}
 }
+
+func TestRenewCertificatesSelectedNodes(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	cfg := &certificates.RenewalConfig{
+		ClusterName: "test-cluster",
+		OS:          string(certificates.OSTypeLinux),
+		Etcd: certificates.NodeConfig{
+			Nodes: []string{"etcd-1", "etcd-2"},
+		},
+		ControlPlane: certificates.NodeConfig{
+			Nodes: []string{"cp-1", "cp-2"},
+		},
+	}
+
+	sshEtcd := mocks.NewMockSSHRunner(ctrl)
+	sshCP := mocks.NewMockSSHRunner(ctrl)
+	kubeClient := kubemocks.NewMockClient(ctrl)
+
+	var cpNodes, etcdNodes []string
+	sshCP.EXPECT().
+		RunCommand(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, node, _ string, _ ...certificates.SSHOption) (string, error) {
+			cpNodes = append(cpNodes, node)
+			return "", nil
+		}).
+		AnyTimes()
+	sshCP.EXPECT().
+		RunCommand(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, node, _ string, _ ...certificates.SSHOption) (string, error) {
+			cpNodes = append(cpNodes, node)
+			return "", nil
+		}).
+		AnyTimes()
+	sshEtcd.EXPECT().
+		RunCommand(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, node, _ string, _ ...certificates.SSHOption) (string, error) {
+			etcdNodes = append(etcdNodes, node)
+			return "dummy-certificate-content", nil
+		}).
+		AnyTimes()
+	sshEtcd.EXPECT().
+		RunCommand(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, node, _ string, _ ...certificates.SSHOption) (string, error) {
+			etcdNodes = append(etcdNodes, node)
+			return "", nil
+		}).
+		AnyTimes()
+	kubeClient.EXPECT().
+		Get(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(apierrors.NewNotFound(schema.GroupResource{}, "")).
+		AnyTimes()
+
+	renewer := &certificates.Renewer{
+		BackupDir:       t.TempDir(),
+		Kubectl:         kubeClient,
+		OS:              certificates.BuildOSRenewer(cfg.OS, t.TempDir()),
+		SSHEtcd:         sshEtcd,
+		SSHControlPlane: sshCP,
+		Nodes:           []string{"etcd-2"},
+	}
+	writeDummyEtcdCerts(t, renewer.BackupDir)
+
+	if err := renewer.RenewCertificates(context.Background(), cfg, ""); err != nil {
+		t.Fatalf("RenewCertificates() expected no error, got: %v", err)
+	}
+
+	for _, node := range etcdNodes {
+		if node != "etcd-2" {
+			t.Fatalf("RenewCertificates() ran a command on etcd node %s, which isn't selected", node)
+		}
+	}
+	if len(etcdNodes) == 0 {
+		t.Fatal("RenewCertificates() didn't renew the selected etcd node")
+	}
+	// The new API server etcd client certificate is still copied to every control plane node.
+	for _, node := range []string{"cp-1", "cp-2"} {
+		if !slices.Contains(cpNodes, node) {
+			t.Fatalf("RenewCertificates() didn't copy the etcd client certificate to %s", node)
+		}
+	}
+}
+
+func TestValidateNodes(t *testing.T) {
+	cfg := &certificates.RenewalConfig{
+		ClusterName:  "test-cluster",
+		ControlPlane: certificates.NodeConfig{Nodes: []string{"cp-1"}},
+		Etcd:         certificates.NodeConfig{Nodes: []string{"etcd-1"}},
+	}
+
+	if err := certificates.ValidateNodes(cfg, []string{"cp-1", "etcd-1"}); err != nil {
+		t.Fatalf("ValidateNodes() expected no error, got: %v", err)
+	}
+
+	err := certificates.ValidateNodes(cfg, []string{"worker-1"})
+	if err == nil || err.Error() != "node worker-1 is not a control plane or etcd node of cluster test-cluster" {
+		t.Fatalf("ValidateNodes() expected unknown node error, got: %v", err)
+	}
+}
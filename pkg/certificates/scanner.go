@@ -3,6 +3,7 @@ package certificates
 import (
 	"context"
 	"crypto/tls"
+	"crypto/x509"
 	"fmt"
 	"net"
 	"time"
@@ -28,16 +29,37 @@ type CertificateScanner interface {
 
 // Scanner implements the CertificateScanner interface and provides certificate checking functionality.
 type Scanner struct {
-	client client.Client
-	logger logr.Logger
+	client         client.Client
+	logger         logr.Logger
+	componentPorts map[string]string
+}
+
+// ScannerOpt configures a Scanner.
+type ScannerOpt func(*Scanner)
+
+// WithComponentPort sets the port the serving certificate of a component is read from.
+func WithComponentPort(component, port string) ScannerOpt {
+	return func(s *Scanner) {
+		s.componentPorts[component] = port
+	}
 }
 
 // NewCertificateScanner creates a new certificate service.
-func NewCertificateScanner(client client.Client, logger logr.Logger) *Scanner {
-	return &Scanner{
+func NewCertificateScanner(client client.Client, logger logr.Logger, opts ...ScannerOpt) *Scanner {
+	s := &Scanner{
 		client: client,
 		logger: logger,
+		componentPorts: map[string]string{
+			APIServerComponent:  "6443",
+			EtcdServerComponent: "2379",
+			KubeletComponent:    "10250",
+		},
+	}
+	for _, opt := range opts {
+		opt(s)
 	}
+
+	return s
 }
 
 // MachineInfo holds machine name and IP information.
@@ -147,30 +169,52 @@ func (s *Scanner) getMachinesCertificateInfo(machines []MachineInfo, port string
 }
 
 func (s *Scanner) checkMachineCertificateExpiry(ip, port string) (int, error) {
+	cert, err := servingCertificate(ip, port)
+	if err != nil {
+		return 0, err
+	}
+
+	return daysUntil(cert.NotAfter), nil
+}
+
+// servingCertificate returns the leaf certificate served on the address. The certificate is read
+// during the handshake, so it's returned even if the server then rejects the connection for not
+// presenting a client certificate, as etcd does.
+func servingCertificate(ip, port string) (*x509.Certificate, error) {
 	dialer := &net.Dialer{
 		Timeout: 10 * time.Second,
 	}
 
+	var leaf *x509.Certificate
 	conn, err := tls.DialWithDialer(dialer, "tcp", net.JoinHostPort(ip, port), &tls.Config{
 		InsecureSkipVerify: true, // We just want to get the certificate, not verify it
+		VerifyPeerCertificate: func(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+			if len(rawCerts) == 0 {
+				return nil
+			}
+			cert, err := x509.ParseCertificate(rawCerts[0])
+			if err != nil {
+				return err
+			}
+			leaf = cert
+			return nil
+		},
 	})
-	if err != nil {
-		return 0, fmt.Errorf("connecting to %s:%s: %w", ip, port, err)
+	if err == nil {
+		conn.Close()
 	}
-	defer conn.Close()
-
-	certs := conn.ConnectionState().PeerCertificates
-	if len(certs) == 0 {
-		return 0, fmt.Errorf("no certificates found for %s:%s", ip, port)
+	if leaf != nil {
+		return leaf, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("connecting to %s: %w", net.JoinHostPort(ip, port), err)
 	}
 
-	// Use the first certificate (leaf certificate)
-	cert := certs[0]
-
-	now := time.Now()
-	daysUntilExpiry := int(cert.NotAfter.Sub(now).Hours() / 24)
+	return nil, fmt.Errorf("no certificates found for %s", net.JoinHostPort(ip, port))
+}
 
-	return daysUntilExpiry, nil
+func daysUntil(t time.Time) int {
+	return int(time.Until(t).Hours() / 24)
 }
 
 // UpdateClusterCertificateStatus updates the cluster status with certificate information.
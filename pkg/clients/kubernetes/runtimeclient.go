@@ -30,6 +30,11 @@ func NewRuntimeClientFromFileName(kubeConfigFilename string) (client.Client, err
 	return newRuntimeClient(data, nil, runtime.NewScheme())
 }
 
+// NewRuntimeClientFromKubeconfig creates a new controller runtime client given the content of a kubeconfig.
+func NewRuntimeClientFromKubeconfig(kubeconfig []byte) (client.Client, error) {
+	return newRuntimeClient(kubeconfig, nil, runtime.NewScheme())
+}
+
 func initScheme(scheme *runtime.Scheme) error {
 	adders := append([]schemeAdder{
 		clientgoscheme.AddToScheme,
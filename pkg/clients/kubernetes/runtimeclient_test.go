@@ -64,6 +64,12 @@ func TestNewRuntimeClientFromFilename(t *testing.T) {
 	g.Expect(err).To(MatchError(ContainSubstring("open file-does-not-exist.txt: no such file or directory")))
 }
 
+func TestNewRuntimeClientFromKubeconfigInvalid(t *testing.T) {
+	g := NewWithT(t)
+	_, err := kubernetes.NewRuntimeClientFromKubeconfig([]byte("not a kubeconfig"))
+	g.Expect(err).To(HaveOccurred())
+}
+
 func TestClientFactoryBuildClientFromKubeconfigNoFile(t *testing.T) {
 	g := NewWithT(t)
 	f := kubernetes.ClientFactory{}
@@ -0,0 +1,142 @@
+package cluster
+
+import (
+	"context"
+	"fmt"
+
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+)
+
+func azurestackhciEntry() *ConfigManagerEntry {
+	return &ConfigManagerEntry{
+		APIObjectMapping: map[string]APIObjectGenerator{
+			anywherev1.AzureStackHCIDatacenterKind: func() APIObject {
+				return &anywherev1.AzureStackHCIDatacenterConfig{}
+			},
+			anywherev1.AzureStackHCIMachineConfigKind: func() APIObject {
+				return &anywherev1.AzureStackHCIMachineConfig{}
+			},
+		},
+		Processors: []ParsedProcessor{
+			processAzureStackHCIDatacenter,
+			machineConfigsProcessor(processAzureStackHCIMachineConfig),
+		},
+		Defaulters: []Defaulter{
+			func(c *Config) error {
+				for _, mc := range c.AzureStackHCIMachineConfigs {
+					mc.SetDefaults()
+				}
+				return nil
+			},
+		},
+		Validations: []Validation{
+			func(c *Config) error {
+				if c.AzureStackHCIDatacenter != nil {
+					return c.AzureStackHCIDatacenter.Validate()
+				} else if c.Cluster.Spec.DatacenterRef.Kind == anywherev1.AzureStackHCIDatacenterKind { // We need this conditional check as AzureStackHCIDatacenter will be nil for other providers
+					return fmt.Errorf("AzureStackHCIDatacenterConfig %s not found", c.Cluster.Spec.DatacenterRef.Name)
+				}
+				return nil
+			},
+			func(c *Config) error {
+				if c.AzureStackHCIMachineConfigs != nil { // We need this conditional check as AzureStackHCIMachineConfigs will be nil for other providers
+					for _, mcRef := range c.Cluster.MachineConfigRefs() {
+						m, ok := c.AzureStackHCIMachineConfigs[mcRef.Name]
+						if !ok {
+							return fmt.Errorf("AzureStackHCIMachineConfig %s not found", mcRef.Name)
+						}
+						if err := m.Validate(); err != nil {
+							return err
+						}
+					}
+				}
+				return nil
+			},
+			func(c *Config) error {
+				if c.AzureStackHCIDatacenter != nil {
+					if err := validateSameNamespace(c, c.AzureStackHCIDatacenter); err != nil {
+						return err
+					}
+				}
+				return nil
+			},
+			func(c *Config) error {
+				for _, v := range c.AzureStackHCIMachineConfigs {
+					if err := validateSameNamespace(c, v); err != nil {
+						return err
+					}
+				}
+				return nil
+			},
+		},
+	}
+}
+
+func processAzureStackHCIDatacenter(c *Config, objects ObjectLookup) {
+	if c.Cluster.Spec.DatacenterRef.Kind == anywherev1.AzureStackHCIDatacenterKind {
+		datacenter := objects.GetFromRef(c.Cluster.APIVersion, c.Cluster.Spec.DatacenterRef)
+		if datacenter != nil {
+			c.AzureStackHCIDatacenter = datacenter.(*anywherev1.AzureStackHCIDatacenterConfig)
+		}
+	}
+}
+
+func processAzureStackHCIMachineConfig(c *Config, objects ObjectLookup, machineRef *anywherev1.Ref) {
+	if machineRef == nil {
+		return
+	}
+
+	if machineRef.Kind != anywherev1.AzureStackHCIMachineConfigKind {
+		return
+	}
+
+	if c.AzureStackHCIMachineConfigs == nil {
+		c.AzureStackHCIMachineConfigs = map[string]*anywherev1.AzureStackHCIMachineConfig{}
+	}
+
+	m := objects.GetFromRef(c.Cluster.APIVersion, *machineRef)
+	if m == nil {
+		return
+	}
+
+	c.AzureStackHCIMachineConfigs[m.GetName()] = m.(*anywherev1.AzureStackHCIMachineConfig)
+}
+
+func getAzureStackHCIDatacenter(ctx context.Context, client Client, c *Config) error {
+	if c.Cluster.Spec.DatacenterRef.Kind != anywherev1.AzureStackHCIDatacenterKind {
+		return nil
+	}
+
+	datacenter := &anywherev1.AzureStackHCIDatacenterConfig{}
+	if err := client.Get(ctx, c.Cluster.Spec.DatacenterRef.Name, c.Cluster.Namespace, datacenter); err != nil {
+		return err
+	}
+
+	c.AzureStackHCIDatacenter = datacenter
+	return nil
+}
+
+func getAzureStackHCIMachineConfigs(ctx context.Context, client Client, c *Config) error {
+	if c.Cluster.Spec.DatacenterRef.Kind != anywherev1.AzureStackHCIDatacenterKind {
+		return nil
+	}
+
+	if c.AzureStackHCIMachineConfigs == nil {
+		c.AzureStackHCIMachineConfigs = map[string]*anywherev1.AzureStackHCIMachineConfig{}
+	}
+
+	for _, machineConfigRef := range c.Cluster.MachineConfigRefs() {
+		if machineConfigRef.Kind != anywherev1.AzureStackHCIMachineConfigKind {
+			continue
+		}
+
+		machineConfig := &anywherev1.AzureStackHCIMachineConfig{}
+		if err := client.Get(ctx, machineConfigRef.Name, c.Cluster.Namespace, machineConfig); err != nil {
+			return err
+		}
+
+		c.AzureStackHCIMachineConfigs[machineConfig.GetName()] = machineConfig
+	}
+
+	return nil
+}
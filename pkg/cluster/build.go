@@ -16,6 +16,14 @@ func NewDefaultConfigClientBuilder() *ConfigClientBuilder {
 		getSnowIdentitySecret,
 		getNutanixDatacenter,
 		getNutanixMachineConfigs,
+		getProxmoxDatacenter,
+		getProxmoxMachineConfigs,
+		getOpenStackDatacenter,
+		getOpenStackMachineConfigs,
+		getAzureStackHCIDatacenter,
+		getAzureStackHCIMachineConfigs,
+		getHarvesterDatacenter,
+		getHarvesterMachineConfigs,
 		getOIDC,
 		getAWSIam,
 		getGitOps,
@@ -82,6 +82,18 @@ func (b FileSpecBuilder) Build(clusterConfigURL string) (*Spec, error) {
 	case v1alpha1.NutanixDatacenterKind:
 		infraProviderName = "Cluster API Provider Nutanix"
 		infraProviderVersion = bundle.Nutanix.Version
+	case v1alpha1.ProxmoxDatacenterKind:
+		infraProviderName = "Cluster API Provider Proxmox"
+		infraProviderVersion = bundle.Proxmox.Version
+	case v1alpha1.OpenStackDatacenterKind:
+		infraProviderName = "Cluster API Provider OpenStack"
+		infraProviderVersion = bundle.OpenStack.Version
+	case v1alpha1.AzureStackHCIDatacenterKind:
+		infraProviderName = "Cluster API Provider Azure Stack HCI"
+		infraProviderVersion = bundle.AzureStackHCI.Version
+	case v1alpha1.HarvesterDatacenterKind:
+		infraProviderName = "Cluster API Provider Harvester"
+		infraProviderVersion = bundle.Harvester.Version
 	case v1alpha1.SnowDatacenterKind:
 		infraProviderName = "Cluster API Provider AWS Snow"
 		infraProviderVersion = bundle.Snow.Version
@@ -10,25 +10,33 @@ import (
 )
 
 type Config struct {
-	Cluster                   *anywherev1.Cluster
-	CloudStackDatacenter      *anywherev1.CloudStackDatacenterConfig
-	VSphereDatacenter         *anywherev1.VSphereDatacenterConfig
-	DockerDatacenter          *anywherev1.DockerDatacenterConfig
-	SnowDatacenter            *anywherev1.SnowDatacenterConfig
-	NutanixDatacenter         *anywherev1.NutanixDatacenterConfig
-	TinkerbellDatacenter      *anywherev1.TinkerbellDatacenterConfig
-	VSphereMachineConfigs     map[string]*anywherev1.VSphereMachineConfig
-	CloudStackMachineConfigs  map[string]*anywherev1.CloudStackMachineConfig
-	SnowMachineConfigs        map[string]*anywherev1.SnowMachineConfig
-	NutanixMachineConfigs     map[string]*anywherev1.NutanixMachineConfig
-	TinkerbellMachineConfigs  map[string]*anywherev1.TinkerbellMachineConfig
-	TinkerbellTemplateConfigs map[string]*anywherev1.TinkerbellTemplateConfig
-	OIDCConfigs               map[string]*anywherev1.OIDCConfig
-	AWSIAMConfigs             map[string]*anywherev1.AWSIamConfig
-	GitOpsConfig              *anywherev1.GitOpsConfig
-	FluxConfig                *anywherev1.FluxConfig
-	SnowCredentialsSecret     *v1.Secret
-	SnowIPPools               map[string]*anywherev1.SnowIPPool
+	Cluster                     *anywherev1.Cluster
+	CloudStackDatacenter        *anywherev1.CloudStackDatacenterConfig
+	VSphereDatacenter           *anywherev1.VSphereDatacenterConfig
+	DockerDatacenter            *anywherev1.DockerDatacenterConfig
+	SnowDatacenter              *anywherev1.SnowDatacenterConfig
+	NutanixDatacenter           *anywherev1.NutanixDatacenterConfig
+	TinkerbellDatacenter        *anywherev1.TinkerbellDatacenterConfig
+	ProxmoxDatacenter           *anywherev1.ProxmoxDatacenterConfig
+	OpenStackDatacenter         *anywherev1.OpenStackDatacenterConfig
+	AzureStackHCIDatacenter     *anywherev1.AzureStackHCIDatacenterConfig
+	HarvesterDatacenter         *anywherev1.HarvesterDatacenterConfig
+	VSphereMachineConfigs       map[string]*anywherev1.VSphereMachineConfig
+	CloudStackMachineConfigs    map[string]*anywherev1.CloudStackMachineConfig
+	SnowMachineConfigs          map[string]*anywherev1.SnowMachineConfig
+	NutanixMachineConfigs       map[string]*anywherev1.NutanixMachineConfig
+	TinkerbellMachineConfigs    map[string]*anywherev1.TinkerbellMachineConfig
+	TinkerbellTemplateConfigs   map[string]*anywherev1.TinkerbellTemplateConfig
+	ProxmoxMachineConfigs       map[string]*anywherev1.ProxmoxMachineConfig
+	OpenStackMachineConfigs     map[string]*anywherev1.OpenStackMachineConfig
+	AzureStackHCIMachineConfigs map[string]*anywherev1.AzureStackHCIMachineConfig
+	HarvesterMachineConfigs     map[string]*anywherev1.HarvesterMachineConfig
+	OIDCConfigs                 map[string]*anywherev1.OIDCConfig
+	AWSIAMConfigs               map[string]*anywherev1.AWSIamConfig
+	GitOpsConfig                *anywherev1.GitOpsConfig
+	FluxConfig                  *anywherev1.FluxConfig
+	SnowCredentialsSecret       *v1.Secret
+	SnowIPPools                 map[string]*anywherev1.SnowIPPool
 }
 
 func (c *Config) VsphereMachineConfig(name string) *anywherev1.VSphereMachineConfig {
@@ -60,17 +68,41 @@ func (c *Config) NutanixMachineConfig(name string) *anywherev1.NutanixMachineCon
 	return c.NutanixMachineConfigs[name]
 }
 
+// ProxmoxMachineConfig returns a ProxmoxMachineConfig based on a name.
+func (c *Config) ProxmoxMachineConfig(name string) *anywherev1.ProxmoxMachineConfig {
+	return c.ProxmoxMachineConfigs[name]
+}
+
+// OpenStackMachineConfig returns an OpenStackMachineConfig based on a name.
+func (c *Config) OpenStackMachineConfig(name string) *anywherev1.OpenStackMachineConfig {
+	return c.OpenStackMachineConfigs[name]
+}
+
+// AzureStackHCIMachineConfig returns an AzureStackHCIMachineConfig based on a name.
+func (c *Config) AzureStackHCIMachineConfig(name string) *anywherev1.AzureStackHCIMachineConfig {
+	return c.AzureStackHCIMachineConfigs[name]
+}
+
+// HarvesterMachineConfig returns a HarvesterMachineConfig based on a name.
+func (c *Config) HarvesterMachineConfig(name string) *anywherev1.HarvesterMachineConfig {
+	return c.HarvesterMachineConfigs[name]
+}
+
 func (c *Config) DeepCopy() *Config {
 	c2 := &Config{
-		Cluster:              c.Cluster.DeepCopy(),
-		CloudStackDatacenter: c.CloudStackDatacenter.DeepCopy(),
-		VSphereDatacenter:    c.VSphereDatacenter.DeepCopy(),
-		NutanixDatacenter:    c.NutanixDatacenter.DeepCopy(),
-		DockerDatacenter:     c.DockerDatacenter.DeepCopy(),
-		SnowDatacenter:       c.SnowDatacenter.DeepCopy(),
-		TinkerbellDatacenter: c.TinkerbellDatacenter.DeepCopy(),
-		GitOpsConfig:         c.GitOpsConfig.DeepCopy(),
-		FluxConfig:           c.FluxConfig.DeepCopy(),
+		Cluster:                 c.Cluster.DeepCopy(),
+		CloudStackDatacenter:    c.CloudStackDatacenter.DeepCopy(),
+		VSphereDatacenter:       c.VSphereDatacenter.DeepCopy(),
+		NutanixDatacenter:       c.NutanixDatacenter.DeepCopy(),
+		DockerDatacenter:        c.DockerDatacenter.DeepCopy(),
+		SnowDatacenter:          c.SnowDatacenter.DeepCopy(),
+		TinkerbellDatacenter:    c.TinkerbellDatacenter.DeepCopy(),
+		ProxmoxDatacenter:       c.ProxmoxDatacenter.DeepCopy(),
+		OpenStackDatacenter:     c.OpenStackDatacenter.DeepCopy(),
+		AzureStackHCIDatacenter: c.AzureStackHCIDatacenter.DeepCopy(),
+		HarvesterDatacenter:     c.HarvesterDatacenter.DeepCopy(),
+		GitOpsConfig:            c.GitOpsConfig.DeepCopy(),
+		FluxConfig:              c.FluxConfig.DeepCopy(),
 	}
 
 	if c.VSphereMachineConfigs != nil {
@@ -137,6 +169,34 @@ func (c *Config) DeepCopy() *Config {
 		c2.TinkerbellTemplateConfigs[k] = v.DeepCopy()
 	}
 
+	if c.ProxmoxMachineConfigs != nil {
+		c2.ProxmoxMachineConfigs = make(map[string]*anywherev1.ProxmoxMachineConfig, len(c.ProxmoxMachineConfigs))
+	}
+	for k, v := range c.ProxmoxMachineConfigs {
+		c2.ProxmoxMachineConfigs[k] = v.DeepCopy()
+	}
+
+	if c.OpenStackMachineConfigs != nil {
+		c2.OpenStackMachineConfigs = make(map[string]*anywherev1.OpenStackMachineConfig, len(c.OpenStackMachineConfigs))
+	}
+	for k, v := range c.OpenStackMachineConfigs {
+		c2.OpenStackMachineConfigs[k] = v.DeepCopy()
+	}
+
+	if c.AzureStackHCIMachineConfigs != nil {
+		c2.AzureStackHCIMachineConfigs = make(map[string]*anywherev1.AzureStackHCIMachineConfig, len(c.AzureStackHCIMachineConfigs))
+	}
+	for k, v := range c.AzureStackHCIMachineConfigs {
+		c2.AzureStackHCIMachineConfigs[k] = v.DeepCopy()
+	}
+
+	if c.HarvesterMachineConfigs != nil {
+		c2.HarvesterMachineConfigs = make(map[string]*anywherev1.HarvesterMachineConfig, len(c.HarvesterMachineConfigs))
+	}
+	for k, v := range c.HarvesterMachineConfigs {
+		c2.HarvesterMachineConfigs[k] = v.DeepCopy()
+	}
+
 	return c2
 }
 
@@ -156,6 +216,10 @@ func (c *Config) ChildObjects() []kubernetes.Object {
 		c.DockerDatacenter,
 		c.SnowDatacenter,
 		c.TinkerbellDatacenter,
+		c.ProxmoxDatacenter,
+		c.OpenStackDatacenter,
+		c.AzureStackHCIDatacenter,
+		c.HarvesterDatacenter,
 		c.GitOpsConfig,
 		c.FluxConfig,
 	)
@@ -188,6 +252,22 @@ func (c *Config) ChildObjects() []kubernetes.Object {
 		objs = appendIfNotNil(objs, e)
 	}
 
+	for _, e := range c.ProxmoxMachineConfigs {
+		objs = appendIfNotNil(objs, e)
+	}
+
+	for _, e := range c.OpenStackMachineConfigs {
+		objs = appendIfNotNil(objs, e)
+	}
+
+	for _, e := range c.AzureStackHCIMachineConfigs {
+		objs = appendIfNotNil(objs, e)
+	}
+
+	for _, e := range c.HarvesterMachineConfigs {
+		objs = appendIfNotNil(objs, e)
+	}
+
 	for _, e := range c.OIDCConfigs {
 		objs = appendIfNotNil(objs, e)
 	}
@@ -28,6 +28,10 @@ func NewDefaultConfigManager() (*ConfigManager, error) {
 		snowEntry(),
 		tinkerbellEntry(),
 		nutanixEntry(),
+		proxmoxEntry(),
+		openstackEntry(),
+		azurestackhciEntry(),
+		harvesterEntry(),
 	)
 	if err != nil {
 		return nil, err
@@ -28,6 +28,10 @@ type ManagementComponents struct {
 	Tinkerbell             v1alpha1release.TinkerbellBundle
 	Snow                   v1alpha1release.SnowBundle
 	Nutanix                v1alpha1release.NutanixBundle
+	Proxmox                v1alpha1release.ProxmoxBundle
+	OpenStack              v1alpha1release.OpenStackBundle
+	AzureStackHCI          v1alpha1release.AzureStackHCIBundle
+	Harvester              v1alpha1release.HarvesterBundle
 }
 
 // ManagementComponentsFromBundles returns ManagementComponents built from a VersionsBundle.
@@ -62,6 +66,10 @@ func newManagementComponents(vb *v1alpha1release.VersionsBundle) *ManagementComp
 		Tinkerbell:             vb.Tinkerbell,
 		Snow:                   vb.Snow,
 		Nutanix:                vb.Nutanix,
+		Proxmox:                vb.Proxmox,
+		OpenStack:              vb.OpenStack,
+		AzureStackHCI:          vb.AzureStackHCI,
+		Harvester:              vb.Harvester,
 	}
 }
 
@@ -0,0 +1,142 @@
+package cluster
+
+import (
+	"context"
+	"fmt"
+
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+)
+
+func harvesterEntry() *ConfigManagerEntry {
+	return &ConfigManagerEntry{
+		APIObjectMapping: map[string]APIObjectGenerator{
+			anywherev1.HarvesterDatacenterKind: func() APIObject {
+				return &anywherev1.HarvesterDatacenterConfig{}
+			},
+			anywherev1.HarvesterMachineConfigKind: func() APIObject {
+				return &anywherev1.HarvesterMachineConfig{}
+			},
+		},
+		Processors: []ParsedProcessor{
+			processHarvesterDatacenter,
+			machineConfigsProcessor(processHarvesterMachineConfig),
+		},
+		Defaulters: []Defaulter{
+			func(c *Config) error {
+				for _, mc := range c.HarvesterMachineConfigs {
+					mc.SetDefaults()
+				}
+				return nil
+			},
+		},
+		Validations: []Validation{
+			func(c *Config) error {
+				if c.HarvesterDatacenter != nil {
+					return c.HarvesterDatacenter.Validate()
+				} else if c.Cluster.Spec.DatacenterRef.Kind == anywherev1.HarvesterDatacenterKind { // We need this conditional check as HarvesterDatacenter will be nil for other providers
+					return fmt.Errorf("HarvesterDatacenterConfig %s not found", c.Cluster.Spec.DatacenterRef.Name)
+				}
+				return nil
+			},
+			func(c *Config) error {
+				if c.HarvesterMachineConfigs != nil { // We need this conditional check as HarvesterMachineConfigs will be nil for other providers
+					for _, mcRef := range c.Cluster.MachineConfigRefs() {
+						m, ok := c.HarvesterMachineConfigs[mcRef.Name]
+						if !ok {
+							return fmt.Errorf("HarvesterMachineConfig %s not found", mcRef.Name)
+						}
+						if err := m.Validate(); err != nil {
+							return err
+						}
+					}
+				}
+				return nil
+			},
+			func(c *Config) error {
+				if c.HarvesterDatacenter != nil {
+					if err := validateSameNamespace(c, c.HarvesterDatacenter); err != nil {
+						return err
+					}
+				}
+				return nil
+			},
+			func(c *Config) error {
+				for _, v := range c.HarvesterMachineConfigs {
+					if err := validateSameNamespace(c, v); err != nil {
+						return err
+					}
+				}
+				return nil
+			},
+		},
+	}
+}
+
+func processHarvesterDatacenter(c *Config, objects ObjectLookup) {
+	if c.Cluster.Spec.DatacenterRef.Kind == anywherev1.HarvesterDatacenterKind {
+		datacenter := objects.GetFromRef(c.Cluster.APIVersion, c.Cluster.Spec.DatacenterRef)
+		if datacenter != nil {
+			c.HarvesterDatacenter = datacenter.(*anywherev1.HarvesterDatacenterConfig)
+		}
+	}
+}
+
+func processHarvesterMachineConfig(c *Config, objects ObjectLookup, machineRef *anywherev1.Ref) {
+	if machineRef == nil {
+		return
+	}
+
+	if machineRef.Kind != anywherev1.HarvesterMachineConfigKind {
+		return
+	}
+
+	if c.HarvesterMachineConfigs == nil {
+		c.HarvesterMachineConfigs = map[string]*anywherev1.HarvesterMachineConfig{}
+	}
+
+	m := objects.GetFromRef(c.Cluster.APIVersion, *machineRef)
+	if m == nil {
+		return
+	}
+
+	c.HarvesterMachineConfigs[m.GetName()] = m.(*anywherev1.HarvesterMachineConfig)
+}
+
+func getHarvesterDatacenter(ctx context.Context, client Client, c *Config) error {
+	if c.Cluster.Spec.DatacenterRef.Kind != anywherev1.HarvesterDatacenterKind {
+		return nil
+	}
+
+	datacenter := &anywherev1.HarvesterDatacenterConfig{}
+	if err := client.Get(ctx, c.Cluster.Spec.DatacenterRef.Name, c.Cluster.Namespace, datacenter); err != nil {
+		return err
+	}
+
+	c.HarvesterDatacenter = datacenter
+	return nil
+}
+
+func getHarvesterMachineConfigs(ctx context.Context, client Client, c *Config) error {
+	if c.Cluster.Spec.DatacenterRef.Kind != anywherev1.HarvesterDatacenterKind {
+		return nil
+	}
+
+	if c.HarvesterMachineConfigs == nil {
+		c.HarvesterMachineConfigs = map[string]*anywherev1.HarvesterMachineConfig{}
+	}
+
+	for _, machineConfigRef := range c.Cluster.MachineConfigRefs() {
+		if machineConfigRef.Kind != anywherev1.HarvesterMachineConfigKind {
+			continue
+		}
+
+		machineConfig := &anywherev1.HarvesterMachineConfig{}
+		if err := client.Get(ctx, machineConfigRef.Name, c.Cluster.Namespace, machineConfig); err != nil {
+			return err
+		}
+
+		c.HarvesterMachineConfigs[machineConfig.GetName()] = machineConfig
+	}
+
+	return nil
+}
@@ -0,0 +1,142 @@
+package cluster
+
+import (
+	"context"
+	"fmt"
+
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+)
+
+func openstackEntry() *ConfigManagerEntry {
+	return &ConfigManagerEntry{
+		APIObjectMapping: map[string]APIObjectGenerator{
+			anywherev1.OpenStackDatacenterKind: func() APIObject {
+				return &anywherev1.OpenStackDatacenterConfig{}
+			},
+			anywherev1.OpenStackMachineConfigKind: func() APIObject {
+				return &anywherev1.OpenStackMachineConfig{}
+			},
+		},
+		Processors: []ParsedProcessor{
+			processOpenStackDatacenter,
+			machineConfigsProcessor(processOpenStackMachineConfig),
+		},
+		Defaulters: []Defaulter{
+			func(c *Config) error {
+				for _, mc := range c.OpenStackMachineConfigs {
+					mc.SetDefaults()
+				}
+				return nil
+			},
+		},
+		Validations: []Validation{
+			func(c *Config) error {
+				if c.OpenStackDatacenter != nil {
+					return c.OpenStackDatacenter.Validate()
+				} else if c.Cluster.Spec.DatacenterRef.Kind == anywherev1.OpenStackDatacenterKind { // We need this conditional check as OpenStackDatacenter will be nil for other providers
+					return fmt.Errorf("OpenStackDatacenterConfig %s not found", c.Cluster.Spec.DatacenterRef.Name)
+				}
+				return nil
+			},
+			func(c *Config) error {
+				if c.OpenStackMachineConfigs != nil { // We need this conditional check as OpenStackMachineConfigs will be nil for other providers
+					for _, mcRef := range c.Cluster.MachineConfigRefs() {
+						m, ok := c.OpenStackMachineConfigs[mcRef.Name]
+						if !ok {
+							return fmt.Errorf("OpenStackMachineConfig %s not found", mcRef.Name)
+						}
+						if err := m.Validate(); err != nil {
+							return err
+						}
+					}
+				}
+				return nil
+			},
+			func(c *Config) error {
+				if c.OpenStackDatacenter != nil {
+					if err := validateSameNamespace(c, c.OpenStackDatacenter); err != nil {
+						return err
+					}
+				}
+				return nil
+			},
+			func(c *Config) error {
+				for _, v := range c.OpenStackMachineConfigs {
+					if err := validateSameNamespace(c, v); err != nil {
+						return err
+					}
+				}
+				return nil
+			},
+		},
+	}
+}
+
+func processOpenStackDatacenter(c *Config, objects ObjectLookup) {
+	if c.Cluster.Spec.DatacenterRef.Kind == anywherev1.OpenStackDatacenterKind {
+		datacenter := objects.GetFromRef(c.Cluster.APIVersion, c.Cluster.Spec.DatacenterRef)
+		if datacenter != nil {
+			c.OpenStackDatacenter = datacenter.(*anywherev1.OpenStackDatacenterConfig)
+		}
+	}
+}
+
+func processOpenStackMachineConfig(c *Config, objects ObjectLookup, machineRef *anywherev1.Ref) {
+	if machineRef == nil {
+		return
+	}
+
+	if machineRef.Kind != anywherev1.OpenStackMachineConfigKind {
+		return
+	}
+
+	if c.OpenStackMachineConfigs == nil {
+		c.OpenStackMachineConfigs = map[string]*anywherev1.OpenStackMachineConfig{}
+	}
+
+	m := objects.GetFromRef(c.Cluster.APIVersion, *machineRef)
+	if m == nil {
+		return
+	}
+
+	c.OpenStackMachineConfigs[m.GetName()] = m.(*anywherev1.OpenStackMachineConfig)
+}
+
+func getOpenStackDatacenter(ctx context.Context, client Client, c *Config) error {
+	if c.Cluster.Spec.DatacenterRef.Kind != anywherev1.OpenStackDatacenterKind {
+		return nil
+	}
+
+	datacenter := &anywherev1.OpenStackDatacenterConfig{}
+	if err := client.Get(ctx, c.Cluster.Spec.DatacenterRef.Name, c.Cluster.Namespace, datacenter); err != nil {
+		return err
+	}
+
+	c.OpenStackDatacenter = datacenter
+	return nil
+}
+
+func getOpenStackMachineConfigs(ctx context.Context, client Client, c *Config) error {
+	if c.Cluster.Spec.DatacenterRef.Kind != anywherev1.OpenStackDatacenterKind {
+		return nil
+	}
+
+	if c.OpenStackMachineConfigs == nil {
+		c.OpenStackMachineConfigs = map[string]*anywherev1.OpenStackMachineConfig{}
+	}
+
+	for _, machineConfigRef := range c.Cluster.MachineConfigRefs() {
+		if machineConfigRef.Kind != anywherev1.OpenStackMachineConfigKind {
+			continue
+		}
+
+		machineConfig := &anywherev1.OpenStackMachineConfig{}
+		if err := client.Get(ctx, machineConfigRef.Name, c.Cluster.Namespace, machineConfig); err != nil {
+			return err
+		}
+
+		c.OpenStackMachineConfigs[machineConfig.GetName()] = machineConfig
+	}
+
+	return nil
+}
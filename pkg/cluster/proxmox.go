@@ -0,0 +1,142 @@
+package cluster
+
+import (
+	"context"
+	"fmt"
+
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+)
+
+func proxmoxEntry() *ConfigManagerEntry {
+	return &ConfigManagerEntry{
+		APIObjectMapping: map[string]APIObjectGenerator{
+			anywherev1.ProxmoxDatacenterKind: func() APIObject {
+				return &anywherev1.ProxmoxDatacenterConfig{}
+			},
+			anywherev1.ProxmoxMachineConfigKind: func() APIObject {
+				return &anywherev1.ProxmoxMachineConfig{}
+			},
+		},
+		Processors: []ParsedProcessor{
+			processProxmoxDatacenter,
+			machineConfigsProcessor(processProxmoxMachineConfig),
+		},
+		Defaulters: []Defaulter{
+			func(c *Config) error {
+				for _, mc := range c.ProxmoxMachineConfigs {
+					mc.SetDefaults()
+				}
+				return nil
+			},
+		},
+		Validations: []Validation{
+			func(c *Config) error {
+				if c.ProxmoxDatacenter != nil {
+					return c.ProxmoxDatacenter.Validate()
+				} else if c.Cluster.Spec.DatacenterRef.Kind == anywherev1.ProxmoxDatacenterKind { // We need this conditional check as ProxmoxDatacenter will be nil for other providers
+					return fmt.Errorf("ProxmoxDatacenterConfig %s not found", c.Cluster.Spec.DatacenterRef.Name)
+				}
+				return nil
+			},
+			func(c *Config) error {
+				if c.ProxmoxMachineConfigs != nil { // We need this conditional check as ProxmoxMachineConfigs will be nil for other providers
+					for _, mcRef := range c.Cluster.MachineConfigRefs() {
+						m, ok := c.ProxmoxMachineConfigs[mcRef.Name]
+						if !ok {
+							return fmt.Errorf("ProxmoxMachineConfig %s not found", mcRef.Name)
+						}
+						if err := m.Validate(); err != nil {
+							return err
+						}
+					}
+				}
+				return nil
+			},
+			func(c *Config) error {
+				if c.ProxmoxDatacenter != nil {
+					if err := validateSameNamespace(c, c.ProxmoxDatacenter); err != nil {
+						return err
+					}
+				}
+				return nil
+			},
+			func(c *Config) error {
+				for _, v := range c.ProxmoxMachineConfigs {
+					if err := validateSameNamespace(c, v); err != nil {
+						return err
+					}
+				}
+				return nil
+			},
+		},
+	}
+}
+
+func processProxmoxDatacenter(c *Config, objects ObjectLookup) {
+	if c.Cluster.Spec.DatacenterRef.Kind == anywherev1.ProxmoxDatacenterKind {
+		datacenter := objects.GetFromRef(c.Cluster.APIVersion, c.Cluster.Spec.DatacenterRef)
+		if datacenter != nil {
+			c.ProxmoxDatacenter = datacenter.(*anywherev1.ProxmoxDatacenterConfig)
+		}
+	}
+}
+
+func processProxmoxMachineConfig(c *Config, objects ObjectLookup, machineRef *anywherev1.Ref) {
+	if machineRef == nil {
+		return
+	}
+
+	if machineRef.Kind != anywherev1.ProxmoxMachineConfigKind {
+		return
+	}
+
+	if c.ProxmoxMachineConfigs == nil {
+		c.ProxmoxMachineConfigs = map[string]*anywherev1.ProxmoxMachineConfig{}
+	}
+
+	m := objects.GetFromRef(c.Cluster.APIVersion, *machineRef)
+	if m == nil {
+		return
+	}
+
+	c.ProxmoxMachineConfigs[m.GetName()] = m.(*anywherev1.ProxmoxMachineConfig)
+}
+
+func getProxmoxDatacenter(ctx context.Context, client Client, c *Config) error {
+	if c.Cluster.Spec.DatacenterRef.Kind != anywherev1.ProxmoxDatacenterKind {
+		return nil
+	}
+
+	datacenter := &anywherev1.ProxmoxDatacenterConfig{}
+	if err := client.Get(ctx, c.Cluster.Spec.DatacenterRef.Name, c.Cluster.Namespace, datacenter); err != nil {
+		return err
+	}
+
+	c.ProxmoxDatacenter = datacenter
+	return nil
+}
+
+func getProxmoxMachineConfigs(ctx context.Context, client Client, c *Config) error {
+	if c.Cluster.Spec.DatacenterRef.Kind != anywherev1.ProxmoxDatacenterKind {
+		return nil
+	}
+
+	if c.ProxmoxMachineConfigs == nil {
+		c.ProxmoxMachineConfigs = map[string]*anywherev1.ProxmoxMachineConfig{}
+	}
+
+	for _, machineConfigRef := range c.Cluster.MachineConfigRefs() {
+		if machineConfigRef.Kind != anywherev1.ProxmoxMachineConfigKind {
+			continue
+		}
+
+		machineConfig := &anywherev1.ProxmoxMachineConfig{}
+		if err := client.Get(ctx, machineConfigRef.Name, c.Cluster.Namespace, machineConfig); err != nil {
+			return err
+		}
+
+		c.ProxmoxMachineConfigs[machineConfig.GetName()] = machineConfig
+	}
+
+	return nil
+}
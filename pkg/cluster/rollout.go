@@ -0,0 +1,59 @@
+package cluster
+
+import (
+	"reflect"
+	"sort"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+)
+
+// FieldChanges compares two versions of a cluster config and classifies every changed field of the
+// cluster, its datacenter config and its machine configs by the impact on the machines of the cluster.
+// Any change to the spec of the datacenter config or of a machine config rolls out new machines.
+func FieldChanges(old, new *Config) []anywherev1.FieldChange {
+	changes := anywherev1.ClusterFieldChanges(old.Cluster, new.Cluster)
+
+	oldSpecs := childObjectSpecs(old)
+	newSpecs := childObjectSpecs(new)
+	refs := append([]anywherev1.Ref{new.Cluster.Spec.DatacenterRef}, new.Cluster.MachineConfigRefs()...)
+	var configChanges []anywherev1.FieldChange
+	for _, ref := range refs {
+		key := ref.Kind + "/" + ref.Name
+		newSpec, ok := newSpecs[key]
+		if !ok {
+			continue
+		}
+		// A new machine config is already classified by the change of the machine group ref using it.
+		oldSpec, ok := oldSpecs[key]
+		if !ok {
+			continue
+		}
+		if !equality.Semantic.DeepEqual(oldSpec, newSpec) {
+			configChanges = append(configChanges, anywherev1.FieldChange{Path: key + ".spec", Impact: anywherev1.RolloutImpactRollout})
+		}
+	}
+
+	sort.Slice(configChanges, func(i, j int) bool {
+		return configChanges[i].Path < configChanges[j].Path
+	})
+
+	return append(changes, configChanges...)
+}
+
+// childObjectSpecs returns the specs of the child objects of the config by kind and name. The kind
+// is taken from the Go type, since objects read from the API server don't always have their TypeMeta set.
+func childObjectSpecs(c *Config) map[string]interface{} {
+	specs := map[string]interface{}{}
+	for _, obj := range c.ChildObjects() {
+		u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+		if err != nil {
+			continue
+		}
+		specs[reflect.TypeOf(obj).Elem().Name()+"/"+obj.GetName()] = u["spec"]
+	}
+
+	return specs
+}
@@ -0,0 +1,71 @@
+package cluster_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/cluster"
+)
+
+func rolloutTestConfig() *cluster.Config {
+	return &cluster.Config{
+		Cluster: &anywherev1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-cluster"},
+			Spec: anywherev1.ClusterSpec{
+				DatacenterRef: anywherev1.Ref{Kind: anywherev1.VSphereDatacenterKind, Name: "my-datacenter"},
+				ControlPlaneConfiguration: anywherev1.ControlPlaneConfiguration{
+					MachineGroupRef: &anywherev1.Ref{Kind: anywherev1.VSphereMachineConfigKind, Name: "cp"},
+				},
+				WorkerNodeGroupConfigurations: []anywherev1.WorkerNodeGroupConfiguration{
+					{Name: "md-0", MachineGroupRef: &anywherev1.Ref{Kind: anywherev1.VSphereMachineConfigKind, Name: "worker"}},
+				},
+			},
+		},
+		VSphereDatacenter: &anywherev1.VSphereDatacenterConfig{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-datacenter"},
+			Spec:       anywherev1.VSphereDatacenterConfigSpec{Network: "network"},
+		},
+		VSphereMachineConfigs: map[string]*anywherev1.VSphereMachineConfig{
+			"cp": {
+				ObjectMeta: metav1.ObjectMeta{Name: "cp"},
+				Spec:       anywherev1.VSphereMachineConfigSpec{NumCPUs: 2},
+			},
+			"worker": {
+				ObjectMeta: metav1.ObjectMeta{Name: "worker"},
+				Spec:       anywherev1.VSphereMachineConfigSpec{NumCPUs: 2},
+			},
+		},
+	}
+}
+
+func TestFieldChanges(t *testing.T) {
+	g := NewWithT(t)
+	old := rolloutTestConfig()
+	new := rolloutTestConfig()
+	new.VSphereDatacenter.Spec.Network = "other-network"
+	new.VSphereMachineConfigs["worker"].Spec.NumCPUs = 4
+	new.VSphereMachineConfigs["cp"].Annotations = map[string]string{"key": "value"}
+
+	g.Expect(cluster.FieldChanges(old, new)).To(Equal([]anywherev1.FieldChange{
+		{Path: "VSphereDatacenterConfig/my-datacenter.spec", Impact: anywherev1.RolloutImpactRollout},
+		{Path: "VSphereMachineConfig/worker.spec", Impact: anywherev1.RolloutImpactRollout},
+	}))
+}
+
+func TestFieldChangesNewMachineConfig(t *testing.T) {
+	g := NewWithT(t)
+	old := rolloutTestConfig()
+	new := rolloutTestConfig()
+	new.Cluster.Spec.WorkerNodeGroupConfigurations[0].MachineGroupRef.Name = "new-worker"
+	new.VSphereMachineConfigs["new-worker"] = &anywherev1.VSphereMachineConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: "new-worker"},
+		Spec:       anywherev1.VSphereMachineConfigSpec{NumCPUs: 8},
+	}
+
+	g.Expect(cluster.FieldChanges(old, new)).To(Equal([]anywherev1.FieldChange{
+		{Path: "spec.workerNodeGroupConfigurations[md-0].machineGroupRef", Impact: anywherev1.RolloutImpactRollout},
+	}))
+}
@@ -200,6 +200,8 @@ func KubeadmControlPlane(clusterSpec *cluster.Spec, infrastructureObject APIObje
 
 	SetUpgradeRolloutStrategyInKubeadmControlPlane(kcp, clusterSpec.Cluster.Spec.ControlPlaneConfiguration.UpgradeRolloutStrategy)
 
+	SetKubeProxyReplacementInKubeadmControlPlane(kcp, clusterSpec.Cluster.Spec.ClusterNetwork.CNIConfig)
+
 	return kcp, nil
 }
 
@@ -14,6 +14,13 @@ const (
 	NodeGroupMaxSizeAnnotation = "cluster.x-k8s.io/cluster-api-autoscaler-node-group-max-size"
 )
 
+// On-demand provisioner annotation constants. They replace the autoscaler annotations so the
+// Cluster Autoscaler ignores the worker node groups scaled by the on-demand provisioner.
+const (
+	OnDemandMinSizeAnnotation = "anywhere.eks.amazonaws.com/on-demand-node-group-min-size"
+	OnDemandMaxSizeAnnotation = "anywhere.eks.amazonaws.com/on-demand-node-group-max-size"
+)
+
 func ConfigureAutoscalingInMachineDeployment(md *clusterv1beta2.MachineDeployment, autoscalingConfig *anywherev1.AutoScalingConfiguration) {
 	if autoscalingConfig == nil {
 		return
@@ -23,6 +30,20 @@ func ConfigureAutoscalingInMachineDeployment(md *clusterv1beta2.MachineDeploymen
 		md.ObjectMeta.Annotations = map[string]string{}
 	}
 
+	if autoscalingConfig.OnDemand() {
+		md.ObjectMeta.Annotations[OnDemandMinSizeAnnotation] = strconv.Itoa(autoscalingConfig.MinCount)
+		md.ObjectMeta.Annotations[OnDemandMaxSizeAnnotation] = strconv.Itoa(autoscalingConfig.MaxCount)
+		return
+	}
+
 	md.ObjectMeta.Annotations[NodeGroupMinSizeAnnotation] = strconv.Itoa(autoscalingConfig.MinCount)
 	md.ObjectMeta.Annotations[NodeGroupMaxSizeAnnotation] = strconv.Itoa(autoscalingConfig.MaxCount)
 }
+
+// IsAutoscaled returns whether the replicas of the MachineDeployment are managed by the Cluster Autoscaler
+// or by the on-demand provisioner instead of the worker node group count.
+func IsAutoscaled(md *clusterv1beta2.MachineDeployment) bool {
+	_, autoscaler := md.ObjectMeta.Annotations[NodeGroupMinSizeAnnotation]
+	_, onDemand := md.ObjectMeta.Annotations[OnDemandMinSizeAnnotation]
+	return autoscaler || onDemand
+}
@@ -90,3 +90,28 @@ func TestConfigureAutoscalingInMachineDeployment(t *testing.T) {
 		})
 	}
 }
+
+func TestConfigureAutoscalingInMachineDeploymentOnDemand(t *testing.T) {
+	g := NewWithT(t)
+	md := wantMachineDeployment()
+	clusterapi.ConfigureAutoscalingInMachineDeployment(md, &v1alpha1.AutoScalingConfiguration{
+		MinCount:    0,
+		MaxCount:    5,
+		Provisioner: v1alpha1.OnDemandProvisioner,
+	})
+
+	g.Expect(md.Annotations).To(Equal(map[string]string{
+		"anywhere.eks.amazonaws.com/on-demand-node-group-min-size": "0",
+		"anywhere.eks.amazonaws.com/on-demand-node-group-max-size": "5",
+	}))
+	g.Expect(clusterapi.IsAutoscaled(md)).To(BeTrue())
+}
+
+func TestIsAutoscaled(t *testing.T) {
+	g := NewWithT(t)
+	md := wantMachineDeployment()
+	g.Expect(clusterapi.IsAutoscaled(md)).To(BeFalse())
+
+	clusterapi.ConfigureAutoscalingInMachineDeployment(md, &v1alpha1.AutoScalingConfiguration{MinCount: 1, MaxCount: 3})
+	g.Expect(clusterapi.IsAutoscaled(md)).To(BeTrue())
+}
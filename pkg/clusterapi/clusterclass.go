@@ -0,0 +1,238 @@
+package clusterapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"slices"
+	"strings"
+
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	bootstrapv1beta2 "sigs.k8s.io/cluster-api/api/bootstrap/kubeadm/v1beta2"
+	controlplanev1beta2 "sigs.k8s.io/cluster-api/api/controlplane/kubeadm/v1beta2"
+	clusterv1beta2 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+
+	"github.com/aws/eks-anywhere/pkg/clients/kubernetes"
+)
+
+const (
+	// clusterClassNamingTemplate keeps the name of the objects created by the CAPI topology controller
+	// the same as the ones created without a ClusterClass, so the rest of the eks-a controllers can
+	// keep finding them by name.
+	clusterClassNamingTemplate         = "{{ .cluster.name }}"
+	machineDeploymentClassNameTemplate = "{{ .cluster.name }}-{{ .machineDeployment.topologyName }}"
+	kubeadmControlPlaneTemplateKind    = "KubeadmControlPlaneTemplate"
+)
+
+// ClusterClass represents the provider-specific CAPI spec for a cluster with a managed topology
+// defined by a ClusterClass, using the kubeadm CP provider.
+type ClusterClass[CT Object[CT], M Object[M]] struct {
+	ClusterClass *clusterv1beta2.ClusterClass
+
+	// Cluster references ClusterClass in its topology.
+	Cluster *clusterv1beta2.Cluster
+
+	// ProviderClusterTemplate is the provider-specific template for the infrastructure cluster,
+	// referenced in ClusterClass.Spec.Infrastructure.
+	ProviderClusterTemplate CT
+
+	KubeadmControlPlaneTemplate *controlplanev1beta2.KubeadmControlPlaneTemplate
+
+	// ControlPlaneMachineTemplate is the provider-specific machine template referenced
+	// in ClusterClass.Spec.ControlPlane.MachineInfrastructure.
+	ControlPlaneMachineTemplate M
+
+	Workers []WorkerClass[M]
+}
+
+// WorkerClass represents the templates for a MachineDeploymentClass of a ClusterClass.
+type WorkerClass[M Object[M]] struct {
+	KubeadmConfigTemplate   *bootstrapv1beta2.KubeadmConfigTemplate
+	ProviderMachineTemplate M
+}
+
+// Objects returns all API objects that form a concrete provider-specific cluster with a managed topology.
+// Templates come first, so they exist before the ClusterClass and Cluster referencing them.
+func (c *ClusterClass[CT, M]) Objects() []kubernetes.Object {
+	objs := make([]kubernetes.Object, 0, 5+len(c.Workers)*2)
+	objs = append(objs, c.ProviderClusterTemplate, c.KubeadmControlPlaneTemplate, c.ControlPlaneMachineTemplate)
+	for _, w := range c.Workers {
+		objs = append(objs, w.KubeadmConfigTemplate, w.ProviderMachineTemplate)
+	}
+	objs = append(objs, c.ClusterClass, c.Cluster)
+
+	return objs
+}
+
+// NewClusterClass builds a ClusterClass and a Cluster with a managed topology equivalent to the given
+// control plane and workers. providerClusterTemplate is the provider-specific template equivalent to
+// cp.ProviderCluster.
+//
+// Templates referenced by a ClusterClass are treated as immutable, so their names include a hash of their
+// content: any change generates new templates and updates the ClusterClass, which triggers a rollout
+// through the CAPI topology controller.
+func NewClusterClass[C Object[C], CT Object[CT], M Object[M]](cp *ControlPlane[C, M], workers *Workers[M], providerClusterTemplate CT) (*ClusterClass[CT, M], error) {
+	if cp.EtcdCluster != nil {
+		return nil, errors.New("external etcd is not supported for clusters with a ClusterClass")
+	}
+
+	kcp := cp.KubeadmControlPlane
+	c := &ClusterClass[CT, M]{
+		ProviderClusterTemplate:     providerClusterTemplate,
+		KubeadmControlPlaneTemplate: kubeadmControlPlaneTemplate(kcp),
+		ControlPlaneMachineTemplate: cp.ControlPlaneMachineTemplate.DeepCopy(),
+	}
+
+	for _, obj := range []kubernetes.Object{c.ProviderClusterTemplate, c.KubeadmControlPlaneTemplate, c.ControlPlaneMachineTemplate} {
+		if err := setNameWithContentHash(obj); err != nil {
+			return nil, err
+		}
+	}
+
+	clusterName := cp.Cluster.Name
+	namespace := cp.Cluster.Namespace
+	c.ClusterClass = &clusterv1beta2.ClusterClass{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: clusterv1beta2.GroupVersion.String(),
+			Kind:       "ClusterClass",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      clusterName,
+			Namespace: namespace,
+		},
+		Spec: clusterv1beta2.ClusterClassSpec{
+			Infrastructure: clusterv1beta2.InfrastructureClass{
+				TemplateRef: templateReference(c.ProviderClusterTemplate),
+				Naming:      clusterv1beta2.InfrastructureClassNamingSpec{Template: clusterClassNamingTemplate},
+			},
+			ControlPlane: clusterv1beta2.ControlPlaneClass{
+				TemplateRef: templateReference(c.KubeadmControlPlaneTemplate),
+				MachineInfrastructure: clusterv1beta2.ControlPlaneClassMachineInfrastructureTemplate{
+					TemplateRef: templateReference(c.ControlPlaneMachineTemplate),
+				},
+				Naming: clusterv1beta2.ControlPlaneClassNamingSpec{Template: clusterClassNamingTemplate},
+			},
+		},
+	}
+
+	cluster := cp.Cluster.DeepCopy()
+	cluster.Spec.ControlPlaneRef = clusterv1beta2.ContractVersionedObjectReference{}
+	cluster.Spec.InfrastructureRef = clusterv1beta2.ContractVersionedObjectReference{}
+	cluster.Spec.Topology = clusterv1beta2.Topology{
+		ClassRef: clusterv1beta2.ClusterClassRef{Name: c.ClusterClass.Name},
+		Version:  kcp.Spec.Version,
+		ControlPlane: clusterv1beta2.ControlPlaneTopology{
+			Metadata: kcp.Spec.MachineTemplate.ObjectMeta,
+			Replicas: kcp.Spec.Replicas,
+		},
+	}
+	c.Cluster = cluster
+
+	// Sort the worker groups so the generated ClusterClass and topology are stable between reconciliations.
+	groups := slices.Clone(workers.Groups)
+	slices.SortFunc(groups, func(a, b WorkerGroup[M]) int {
+		return strings.Compare(a.MachineDeployment.Name, b.MachineDeployment.Name)
+	})
+
+	for _, g := range groups {
+		md := g.MachineDeployment
+		if md.Spec.Template.Spec.Version != kcp.Spec.Version {
+			return nil, errors.Errorf("machine deployment %s: worker node groups with a kubernetes version different from the control plane are not supported for clusters with a ClusterClass", md.Name)
+		}
+
+		w := WorkerClass[M]{
+			KubeadmConfigTemplate:   g.KubeadmConfigTemplate.DeepCopy(),
+			ProviderMachineTemplate: g.ProviderMachineTemplate.DeepCopy(),
+		}
+		for _, obj := range []kubernetes.Object{w.KubeadmConfigTemplate, w.ProviderMachineTemplate} {
+			if err := setNameWithContentHash(obj); err != nil {
+				return nil, err
+			}
+		}
+		c.Workers = append(c.Workers, w)
+
+		topologyName := strings.TrimPrefix(md.Name, clusterName+"-")
+		c.ClusterClass.Spec.Workers.MachineDeployments = append(c.ClusterClass.Spec.Workers.MachineDeployments,
+			clusterv1beta2.MachineDeploymentClass{
+				Class: topologyName,
+				Bootstrap: clusterv1beta2.MachineDeploymentClassBootstrapTemplate{
+					TemplateRef: templateReference(w.KubeadmConfigTemplate),
+				},
+				Infrastructure: clusterv1beta2.MachineDeploymentClassInfrastructureTemplate{
+					TemplateRef: templateReference(w.ProviderMachineTemplate),
+				},
+				Naming: clusterv1beta2.MachineDeploymentClassNamingSpec{Template: machineDeploymentClassNameTemplate},
+				Rollout: clusterv1beta2.MachineDeploymentClassRolloutSpec{
+					Strategy: clusterv1beta2.MachineDeploymentClassRolloutStrategy{
+						Type: md.Spec.Rollout.Strategy.Type,
+						RollingUpdate: clusterv1beta2.MachineDeploymentClassRolloutStrategyRollingUpdate{
+							MaxUnavailable: md.Spec.Rollout.Strategy.RollingUpdate.MaxUnavailable,
+							MaxSurge:       md.Spec.Rollout.Strategy.RollingUpdate.MaxSurge,
+						},
+					},
+				},
+			},
+		)
+
+		c.Cluster.Spec.Topology.Workers.MachineDeployments = append(c.Cluster.Spec.Topology.Workers.MachineDeployments,
+			clusterv1beta2.MachineDeploymentTopology{
+				Metadata: clusterv1beta2.ObjectMeta{
+					Labels:      md.Spec.Template.ObjectMeta.Labels,
+					Annotations: md.Annotations,
+				},
+				Class:    topologyName,
+				Name:     topologyName,
+				Replicas: md.Spec.Replicas,
+			},
+		)
+	}
+
+	return c, nil
+}
+
+func kubeadmControlPlaneTemplate(kcp *controlplanev1beta2.KubeadmControlPlane) *controlplanev1beta2.KubeadmControlPlaneTemplate {
+	return &controlplanev1beta2.KubeadmControlPlaneTemplate{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: controlplanev1beta2.GroupVersion.String(),
+			Kind:       kubeadmControlPlaneTemplateKind,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      kcp.Name,
+			Namespace: kcp.Namespace,
+		},
+		Spec: controlplanev1beta2.KubeadmControlPlaneTemplateSpec{
+			Template: controlplanev1beta2.KubeadmControlPlaneTemplateResource{
+				Spec: controlplanev1beta2.KubeadmControlPlaneTemplateResourceSpec{
+					KubeadmConfigSpec: *kcp.Spec.KubeadmConfigSpec.DeepCopy(),
+					Rollout:           *kcp.Spec.Rollout.DeepCopy(),
+					Remediation:       *kcp.Spec.Remediation.DeepCopy(),
+					MachineNaming:     kcp.Spec.MachineNaming,
+				},
+			},
+		},
+	}
+}
+
+func templateReference(obj kubernetes.Object) clusterv1beta2.ClusterClassTemplateReference {
+	gvk := obj.GetObjectKind().GroupVersionKind()
+	return clusterv1beta2.ClusterClassTemplateReference{
+		APIVersion: gvk.GroupVersion().String(),
+		Kind:       gvk.Kind,
+		Name:       obj.GetName(),
+	}
+}
+
+// setNameWithContentHash appends to the object name a hash of its content.
+func setNameWithContentHash(obj kubernetes.Object) error {
+	content, err := json.Marshal(obj)
+	if err != nil {
+		return errors.Wrapf(err, "marshalling %s to calculate its name", obj.GetName())
+	}
+
+	h := fnv.New32a()
+	_, _ = h.Write(content)
+	obj.SetName(fmt.Sprintf("%s-%08x", obj.GetName(), h.Sum32()))
+
+	return nil
+}
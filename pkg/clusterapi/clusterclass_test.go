@@ -0,0 +1,268 @@
+package clusterapi_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+	bootstrapv1beta2 "sigs.k8s.io/cluster-api/api/bootstrap/kubeadm/v1beta2"
+	controlplanev1beta2 "sigs.k8s.io/cluster-api/api/controlplane/kubeadm/v1beta2"
+	clusterv1beta2 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	dockerv1beta2 "sigs.k8s.io/cluster-api/test/infrastructure/docker/api/v1beta2"
+
+	"github.com/aws/eks-anywhere/pkg/clusterapi"
+	"github.com/aws/eks-anywhere/pkg/constants"
+)
+
+func TestNewClusterClass(t *testing.T) {
+	g := NewWithT(t)
+	cp := clusterClassControlPlane()
+	workers := clusterClassWorkers()
+
+	got, err := clusterapi.NewClusterClass(cp, workers, dockerClusterTemplate())
+	g.Expect(err).NotTo(HaveOccurred())
+
+	g.Expect(got.ProviderClusterTemplate.Name).To(HavePrefix("my-cluster-"))
+	g.Expect(got.KubeadmControlPlaneTemplate.Name).To(HavePrefix("my-cluster-"))
+	g.Expect(got.KubeadmControlPlaneTemplate.Spec.Template.Spec.KubeadmConfigSpec).To(Equal(cp.KubeadmControlPlane.Spec.KubeadmConfigSpec))
+	g.Expect(got.ControlPlaneMachineTemplate.Name).To(HavePrefix("my-cluster-control-plane-1-"))
+	g.Expect(got.Workers).To(HaveLen(1))
+	g.Expect(got.Workers[0].KubeadmConfigTemplate.Name).To(HavePrefix("my-cluster-md-0-1-"))
+	g.Expect(got.Workers[0].ProviderMachineTemplate.Name).To(HavePrefix("my-cluster-md-0-1-"))
+
+	g.Expect(got.ClusterClass.Name).To(Equal("my-cluster"))
+	g.Expect(got.ClusterClass.Namespace).To(Equal(constants.EksaSystemNamespace))
+	g.Expect(got.ClusterClass.Spec.Infrastructure.TemplateRef).To(Equal(clusterv1beta2.ClusterClassTemplateReference{
+		APIVersion: dockerv1beta2.GroupVersion.String(),
+		Kind:       "DockerClusterTemplate",
+		Name:       got.ProviderClusterTemplate.Name,
+	}))
+	g.Expect(got.ClusterClass.Spec.ControlPlane.TemplateRef).To(Equal(clusterv1beta2.ClusterClassTemplateReference{
+		APIVersion: controlplanev1beta2.GroupVersion.String(),
+		Kind:       "KubeadmControlPlaneTemplate",
+		Name:       got.KubeadmControlPlaneTemplate.Name,
+	}))
+	g.Expect(got.ClusterClass.Spec.ControlPlane.MachineInfrastructure.TemplateRef.Name).To(Equal(got.ControlPlaneMachineTemplate.Name))
+	g.Expect(got.ClusterClass.Spec.Workers.MachineDeployments).To(HaveLen(1))
+	mdClass := got.ClusterClass.Spec.Workers.MachineDeployments[0]
+	g.Expect(mdClass.Class).To(Equal("md-0"))
+	g.Expect(mdClass.Bootstrap.TemplateRef.Name).To(Equal(got.Workers[0].KubeadmConfigTemplate.Name))
+	g.Expect(mdClass.Infrastructure.TemplateRef.Name).To(Equal(got.Workers[0].ProviderMachineTemplate.Name))
+	g.Expect(mdClass.Rollout.Strategy.RollingUpdate.MaxSurge).To(Equal(workers.Groups[0].MachineDeployment.Spec.Rollout.Strategy.RollingUpdate.MaxSurge))
+
+	g.Expect(got.Cluster.Spec.ControlPlaneRef.IsDefined()).To(BeFalse())
+	g.Expect(got.Cluster.Spec.InfrastructureRef.IsDefined()).To(BeFalse())
+	g.Expect(got.Cluster.Spec.ClusterNetwork).To(Equal(cp.Cluster.Spec.ClusterNetwork))
+	g.Expect(got.Cluster.Spec.Topology).To(Equal(clusterv1beta2.Topology{
+		ClassRef: clusterv1beta2.ClusterClassRef{Name: "my-cluster"},
+		Version:  "v1.33.1-eks-1-33-5",
+		ControlPlane: clusterv1beta2.ControlPlaneTopology{
+			Replicas: ptr.To[int32](3),
+		},
+		Workers: clusterv1beta2.WorkersTopology{
+			MachineDeployments: []clusterv1beta2.MachineDeploymentTopology{
+				{
+					Metadata: clusterv1beta2.ObjectMeta{
+						Labels: map[string]string{"label": "value"},
+					},
+					Class:    "md-0",
+					Name:     "md-0",
+					Replicas: ptr.To[int32](2),
+				},
+			},
+		},
+	}))
+
+	// Original objects are not modified
+	g.Expect(cp.ControlPlaneMachineTemplate.Name).To(Equal("my-cluster-control-plane-1"))
+	g.Expect(workers.Groups[0].KubeadmConfigTemplate.Name).To(Equal("my-cluster-md-0-1"))
+	g.Expect(cp.Cluster.Spec.ControlPlaneRef.IsDefined()).To(BeTrue())
+}
+
+func TestNewClusterClassTemplateNamesChangeWithContent(t *testing.T) {
+	g := NewWithT(t)
+	cp := clusterClassControlPlane()
+
+	c1, err := clusterapi.NewClusterClass(cp, clusterClassWorkers(), dockerClusterTemplate())
+	g.Expect(err).NotTo(HaveOccurred())
+	c2, err := clusterapi.NewClusterClass(cp, clusterClassWorkers(), dockerClusterTemplate())
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(c2.Objects()).To(Equal(c1.Objects()))
+
+	workers := clusterClassWorkers()
+	workers.Groups[0].KubeadmConfigTemplate.Spec.Template.Spec.Files[0].Owner = "someone-else"
+	c3, err := clusterapi.NewClusterClass(cp, workers, dockerClusterTemplate())
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(c3.Workers[0].KubeadmConfigTemplate.Name).NotTo(Equal(c1.Workers[0].KubeadmConfigTemplate.Name))
+	g.Expect(c3.Workers[0].ProviderMachineTemplate.Name).To(Equal(c1.Workers[0].ProviderMachineTemplate.Name))
+	g.Expect(c3.ClusterClass.Spec.Workers.MachineDeployments[0].Bootstrap.TemplateRef.Name).To(Equal(c3.Workers[0].KubeadmConfigTemplate.Name))
+}
+
+func TestNewClusterClassErrorExternalEtcd(t *testing.T) {
+	g := NewWithT(t)
+	cp := clusterClassControlPlane()
+	cp.EtcdCluster = etcdCluster()
+
+	_, err := clusterapi.NewClusterClass(cp, clusterClassWorkers(), dockerClusterTemplate())
+	g.Expect(err).To(MatchError(ContainSubstring("external etcd is not supported")))
+}
+
+func TestNewClusterClassErrorWorkerVersion(t *testing.T) {
+	g := NewWithT(t)
+	workers := clusterClassWorkers()
+	workers.Groups[0].MachineDeployment.Spec.Template.Spec.Version = "v1.32.5-eks-1-32-15"
+
+	_, err := clusterapi.NewClusterClass(clusterClassControlPlane(), workers, dockerClusterTemplate())
+	g.Expect(err).To(MatchError(ContainSubstring("machine deployment my-cluster-md-0: worker node groups with a kubernetes version different")))
+}
+
+func TestClusterClassObjects(t *testing.T) {
+	g := NewWithT(t)
+	c, err := clusterapi.NewClusterClass(clusterClassControlPlane(), clusterClassWorkers(), dockerClusterTemplate())
+	g.Expect(err).NotTo(HaveOccurred())
+
+	objs := c.Objects()
+	g.Expect(objs).To(HaveLen(7))
+	g.Expect(objs[0]).To(Equal(c.ProviderClusterTemplate))
+	g.Expect(objs[1]).To(Equal(c.KubeadmControlPlaneTemplate))
+	g.Expect(objs[2]).To(Equal(c.ControlPlaneMachineTemplate))
+	g.Expect(objs[3]).To(Equal(c.Workers[0].KubeadmConfigTemplate))
+	g.Expect(objs[4]).To(Equal(c.Workers[0].ProviderMachineTemplate))
+	g.Expect(objs[5]).To(Equal(c.ClusterClass))
+	g.Expect(objs[6]).To(Equal(c.Cluster))
+}
+
+func clusterClassControlPlane() *dockerControlPlane {
+	return &dockerControlPlane{
+		Cluster: &clusterv1beta2.Cluster{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: clusterv1beta2.GroupVersion.String(),
+				Kind:       "Cluster",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "my-cluster",
+				Namespace: constants.EksaSystemNamespace,
+			},
+			Spec: clusterv1beta2.ClusterSpec{
+				ClusterNetwork: clusterv1beta2.ClusterNetwork{
+					Pods: clusterv1beta2.NetworkRanges{CIDRBlocks: []string{"192.168.0.0/16"}},
+				},
+				ControlPlaneRef: clusterv1beta2.ContractVersionedObjectReference{
+					APIGroup: controlplanev1beta2.GroupVersion.Group,
+					Kind:     "KubeadmControlPlane",
+					Name:     "my-cluster",
+				},
+				InfrastructureRef: clusterv1beta2.ContractVersionedObjectReference{
+					APIGroup: dockerv1beta2.GroupVersion.Group,
+					Kind:     "DockerCluster",
+					Name:     "my-cluster",
+				},
+			},
+		},
+		ProviderCluster: &dockerv1beta2.DockerCluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "my-cluster",
+				Namespace: constants.EksaSystemNamespace,
+			},
+		},
+		KubeadmControlPlane: &controlplanev1beta2.KubeadmControlPlane{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "my-cluster",
+				Namespace: constants.EksaSystemNamespace,
+			},
+			Spec: controlplanev1beta2.KubeadmControlPlaneSpec{
+				Replicas: ptr.To[int32](3),
+				Version:  "v1.33.1-eks-1-33-5",
+				KubeadmConfigSpec: bootstrapv1beta2.KubeadmConfigSpec{
+					PreKubeadmCommands: []string{"echo hello"},
+				},
+			},
+		},
+		ControlPlaneMachineTemplate: &dockerv1beta2.DockerMachineTemplate{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: dockerv1beta2.GroupVersion.String(),
+				Kind:       "DockerMachineTemplate",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "my-cluster-control-plane-1",
+				Namespace: constants.EksaSystemNamespace,
+			},
+		},
+	}
+}
+
+func clusterClassWorkers() *dockerWorkers {
+	return &dockerWorkers{
+		Groups: []dockerGroup{
+			{
+				MachineDeployment: &clusterv1beta2.MachineDeployment{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "my-cluster-md-0",
+						Namespace: constants.EksaSystemNamespace,
+					},
+					Spec: clusterv1beta2.MachineDeploymentSpec{
+						Replicas: ptr.To[int32](2),
+						Template: clusterv1beta2.MachineTemplateSpec{
+							ObjectMeta: clusterv1beta2.ObjectMeta{
+								Labels: map[string]string{"label": "value"},
+							},
+							Spec: clusterv1beta2.MachineSpec{
+								Version: "v1.33.1-eks-1-33-5",
+							},
+						},
+						Rollout: clusterv1beta2.MachineDeploymentRolloutSpec{
+							Strategy: clusterv1beta2.MachineDeploymentRolloutStrategy{
+								Type: clusterv1beta2.RollingUpdateMachineDeploymentStrategyType,
+								RollingUpdate: clusterv1beta2.MachineDeploymentRolloutStrategyRollingUpdate{
+									MaxSurge: ptr.To(intstr.FromInt(1)),
+								},
+							},
+						},
+					},
+				},
+				KubeadmConfigTemplate: &bootstrapv1beta2.KubeadmConfigTemplate{
+					TypeMeta: metav1.TypeMeta{
+						APIVersion: bootstrapv1beta2.GroupVersion.String(),
+						Kind:       "KubeadmConfigTemplate",
+					},
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "my-cluster-md-0-1",
+						Namespace: constants.EksaSystemNamespace,
+					},
+					Spec: bootstrapv1beta2.KubeadmConfigTemplateSpec{
+						Template: bootstrapv1beta2.KubeadmConfigTemplateResource{
+							Spec: bootstrapv1beta2.KubeadmConfigSpec{
+								Files: []bootstrapv1beta2.File{{Owner: "me"}},
+							},
+						},
+					},
+				},
+				ProviderMachineTemplate: &dockerv1beta2.DockerMachineTemplate{
+					TypeMeta: metav1.TypeMeta{
+						APIVersion: dockerv1beta2.GroupVersion.String(),
+						Kind:       "DockerMachineTemplate",
+					},
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "my-cluster-md-0-1",
+						Namespace: constants.EksaSystemNamespace,
+					},
+				},
+			},
+		},
+	}
+}
+
+func dockerClusterTemplate() *dockerv1beta2.DockerClusterTemplate {
+	return &dockerv1beta2.DockerClusterTemplate{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: dockerv1beta2.GroupVersion.String(),
+			Kind:       "DockerClusterTemplate",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-cluster",
+			Namespace: constants.EksaSystemNamespace,
+		},
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"strconv"
 	"strings"
 
+	corev1 "k8s.io/api/core/v1"
 	bootstrapv1beta2 "sigs.k8s.io/cluster-api/api/bootstrap/kubeadm/v1beta2"
 
 	"github.com/aws/eks-anywhere/pkg/api/v1alpha1"
@@ -87,6 +88,24 @@ func APIServerExtraArgs(apiServerExtraArgs map[string]string) ExtraArgs {
 	return args
 }
 
+// ControllerManagerExtraArgs takes a map of controller manager extra args and returns the relevant controller manager extra args if it's not nil or empty.
+func ControllerManagerExtraArgs(controllerManagerExtraArgs map[string]string) ExtraArgs {
+	args := ExtraArgs{}
+	for k, v := range controllerManagerExtraArgs {
+		args.AddIfNotEmpty(k, v)
+	}
+	return args
+}
+
+// SchedulerExtraArgs takes a map of scheduler extra args and returns the relevant scheduler extra args if it's not nil or empty.
+func SchedulerExtraArgs(schedulerExtraArgs map[string]string) ExtraArgs {
+	args := ExtraArgs{}
+	for k, v := range schedulerExtraArgs {
+		args.AddIfNotEmpty(k, v)
+	}
+	return args
+}
+
 func PodIAMAuthExtraArgs(podIAMConfig *v1alpha1.PodIAMConfig) ExtraArgs {
 	if podIAMConfig == nil {
 		return nil
@@ -96,15 +115,31 @@ func PodIAMAuthExtraArgs(podIAMConfig *v1alpha1.PodIAMConfig) ExtraArgs {
 	return args
 }
 
+// NodeCIDRMaskExtraArgs returns the controller manager node CIDR mask size extra arg. The
+// controller manager doesn't accept node-cidr-mask-size for dual-stack clusters, where the mask
+// size of the cluster network applies to the IPv4 node CIDR blocks.
 func NodeCIDRMaskExtraArgs(clusterNetwork *v1alpha1.ClusterNetwork) ExtraArgs {
 	if clusterNetwork == nil || clusterNetwork.Nodes == nil || clusterNetwork.Nodes.CIDRMaskSize == nil {
 		return nil
 	}
 	args := ExtraArgs{}
+	if clusterNetwork.IsDualStack() {
+		args.AddIfNotEmpty("node-cidr-mask-size-ipv4", strconv.Itoa(*clusterNetwork.Nodes.CIDRMaskSize))
+		return args
+	}
 	args.AddIfNotEmpty("node-cidr-mask-size", strconv.Itoa(*clusterNetwork.Nodes.CIDRMaskSize))
 	return args
 }
 
+// NodeIPExtraArgs returns the kubelet node-ip extra arg for clusters whose primary IP family is
+// IPv6, so kubelet registers the node with its IPv6 address even when the host has IPv4 addresses too.
+func NodeIPExtraArgs(clusterNetwork *v1alpha1.ClusterNetwork) ExtraArgs {
+	if clusterNetwork.PrimaryIPFamily() != v1alpha1.IPv6IPFamily {
+		return nil
+	}
+	return ExtraArgs{"node-ip": "::"}
+}
+
 func ResolvConfExtraArgs(resolvConf *v1alpha1.ResolvConf) ExtraArgs {
 	if resolvConf == nil {
 		return nil
@@ -131,6 +166,20 @@ func WorkerNodeLabelsExtraArgs(wnc v1alpha1.WorkerNodeGroupConfiguration) ExtraA
 	return nodeLabelsExtraArgs(wnc.Labels)
 }
 
+// WorkerNodeTopologyLabelsExtraArgs returns the node labels args for a worker node group whose nodes
+// all run in the given failure domain zone. It adds the standard topology zone label, unless the
+// worker node group already sets it.
+func WorkerNodeTopologyLabelsExtraArgs(wnc v1alpha1.WorkerNodeGroupConfiguration, zone string) ExtraArgs {
+	labels := make(map[string]string, len(wnc.Labels)+1)
+	if zone != "" {
+		labels[corev1.LabelTopologyZone] = zone
+	}
+	for k, v := range wnc.Labels {
+		labels[k] = v
+	}
+	return nodeLabelsExtraArgs(labels)
+}
+
 func ControlPlaneNodeLabelsExtraArgs(cpc v1alpha1.ControlPlaneConfiguration) ExtraArgs {
 	return nodeLabelsExtraArgs(cpc.Labels)
 }
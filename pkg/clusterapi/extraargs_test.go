@@ -172,6 +172,70 @@ func TestAPIServerExtraArgs(t *testing.T) {
 	}
 }
 
+func TestControllerManagerExtraArgs(t *testing.T) {
+	tests := []struct {
+		testName                   string
+		controllerManagerExtraArgs map[string]string
+		want                       clusterapi.ExtraArgs
+	}{
+		{
+			testName:                   "no args",
+			controllerManagerExtraArgs: nil,
+			want:                       clusterapi.ExtraArgs{},
+		},
+		{
+			testName: "with args",
+			controllerManagerExtraArgs: map[string]string{
+				"profiling":    "true",
+				"bind-address": "0.0.0.0",
+			},
+			want: clusterapi.ExtraArgs{
+				"profiling":    "true",
+				"bind-address": "0.0.0.0",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			if got := clusterapi.ControllerManagerExtraArgs(tt.controllerManagerExtraArgs); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ControllerManagerExtraArgs() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSchedulerExtraArgs(t *testing.T) {
+	tests := []struct {
+		testName           string
+		schedulerExtraArgs map[string]string
+		want               clusterapi.ExtraArgs
+	}{
+		{
+			testName:           "no args",
+			schedulerExtraArgs: map[string]string{},
+			want:               clusterapi.ExtraArgs{},
+		},
+		{
+			testName: "with args",
+			schedulerExtraArgs: map[string]string{
+				"bind-address": "0.0.0.0",
+			},
+			want: clusterapi.ExtraArgs{
+				"bind-address": "0.0.0.0",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			if got := clusterapi.SchedulerExtraArgs(tt.schedulerExtraArgs); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("SchedulerExtraArgs() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestAwsIamAuthExtraArgs(t *testing.T) {
 	tests := []struct {
 		testName string
@@ -426,6 +490,63 @@ func TestNodeLabelsExtraArgs(t *testing.T) {
 	}
 }
 
+func TestWorkerNodeTopologyLabelsExtraArgs(t *testing.T) {
+	tests := []struct {
+		testName string
+		wnc      v1alpha1.WorkerNodeGroupConfiguration
+		zone     string
+		want     clusterapi.ExtraArgs
+	}{
+		{
+			testName: "no labels no zone",
+			wnc: v1alpha1.WorkerNodeGroupConfiguration{
+				Count: ptr.Int(3),
+			},
+			want: clusterapi.ExtraArgs{},
+		},
+		{
+			testName: "zone only",
+			wnc: v1alpha1.WorkerNodeGroupConfiguration{
+				Count: ptr.Int(3),
+			},
+			zone: "fd-1",
+			want: clusterapi.ExtraArgs{
+				"node-labels": "topology.kubernetes.io/zone=fd-1",
+			},
+		},
+		{
+			testName: "zone and labels",
+			wnc: v1alpha1.WorkerNodeGroupConfiguration{
+				Count:  ptr.Int(3),
+				Labels: map[string]string{"label1": "foo"},
+			},
+			zone: "fd-1",
+			want: clusterapi.ExtraArgs{
+				"node-labels": "label1=foo,topology.kubernetes.io/zone=fd-1",
+			},
+		},
+		{
+			testName: "labels override zone",
+			wnc: v1alpha1.WorkerNodeGroupConfiguration{
+				Count:  ptr.Int(3),
+				Labels: map[string]string{"topology.kubernetes.io/zone": "custom"},
+			},
+			zone: "fd-1",
+			want: clusterapi.ExtraArgs{
+				"node-labels": "topology.kubernetes.io/zone=custom",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			if got := clusterapi.WorkerNodeTopologyLabelsExtraArgs(tt.wnc, tt.zone); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("WorkerNodeTopologyLabelsExtraArgs() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestCpNodeLabelsExtraArgs(t *testing.T) {
 	tests := []struct {
 		testName string
@@ -539,6 +660,16 @@ func TestNodeCIDRMaskExtraArgs(t *testing.T) {
 				"node-cidr-mask-size": "28",
 			},
 		},
+		{
+			testName: "with nodes config dual-stack",
+			clusterNetwork: &v1alpha1.ClusterNetwork{
+				Pods:  v1alpha1.Pods{CidrBlocks: []string{"192.168.0.0/16", "fd00:100::/48"}},
+				Nodes: &v1alpha1.Nodes{CIDRMaskSize: nodeCidrMaskSize},
+			},
+			want: clusterapi.ExtraArgs{
+				"node-cidr-mask-size-ipv4": "28",
+			},
+		},
 		{
 			testName: "with nodes config empty",
 			clusterNetwork: &v1alpha1.ClusterNetwork{
@@ -557,6 +688,60 @@ func TestNodeCIDRMaskExtraArgs(t *testing.T) {
 	}
 }
 
+func TestNodeIPExtraArgs(t *testing.T) {
+	tests := []struct {
+		testName       string
+		clusterNetwork *v1alpha1.ClusterNetwork
+		want           clusterapi.ExtraArgs
+	}{
+		{
+			testName:       "no cluster network",
+			clusterNetwork: nil,
+			want:           nil,
+		},
+		{
+			testName: "ipv4",
+			clusterNetwork: &v1alpha1.ClusterNetwork{
+				Pods: v1alpha1.Pods{CidrBlocks: []string{"192.168.0.0/16"}},
+			},
+			want: nil,
+		},
+		{
+			testName: "ipv6",
+			clusterNetwork: &v1alpha1.ClusterNetwork{
+				Pods: v1alpha1.Pods{CidrBlocks: []string{"fd00:100::/48"}},
+			},
+			want: clusterapi.ExtraArgs{
+				"node-ip": "::",
+			},
+		},
+		{
+			testName: "dual-stack ipv4 primary",
+			clusterNetwork: &v1alpha1.ClusterNetwork{
+				Pods: v1alpha1.Pods{CidrBlocks: []string{"192.168.0.0/16", "fd00:100::/48"}},
+			},
+			want: nil,
+		},
+		{
+			testName: "dual-stack ipv6 primary",
+			clusterNetwork: &v1alpha1.ClusterNetwork{
+				Pods: v1alpha1.Pods{CidrBlocks: []string{"fd00:100::/48", "192.168.0.0/16"}},
+			},
+			want: clusterapi.ExtraArgs{
+				"node-ip": "::",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			if got := clusterapi.NodeIPExtraArgs(tt.clusterNetwork); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("NodeIPExtraArgs() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestEtcdEncryptionExtraArgs(t *testing.T) {
 	tests := []struct {
 		name           string
@@ -0,0 +1,24 @@
+package clusterapi
+
+import (
+	controlplanev1beta2 "sigs.k8s.io/cluster-api/api/controlplane/kubeadm/v1beta2"
+
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+)
+
+// KubeProxyAddonPhase is the kubeadm init phase that deploys kube-proxy.
+const KubeProxyAddonPhase = "addon/kube-proxy"
+
+// SetKubeProxyReplacementInKubeadmControlPlane skips the kube-proxy deployment when creating the
+// cluster and its reconciliation by KCP during upgrades when the CNI replaces kube-proxy.
+func SetKubeProxyReplacementInKubeadmControlPlane(kcp *controlplanev1beta2.KubeadmControlPlane, cniConfig *anywherev1.CNIConfig) {
+	if !cniConfig.KubeProxyReplacementEnabled() {
+		return
+	}
+
+	if kcp.Annotations == nil {
+		kcp.Annotations = map[string]string{}
+	}
+	kcp.Annotations[controlplanev1beta2.SkipKubeProxyAnnotation] = ""
+	kcp.Spec.KubeadmConfigSpec.InitConfiguration.SkipPhases = append(kcp.Spec.KubeadmConfigSpec.InitConfiguration.SkipPhases, KubeProxyAddonPhase)
+}
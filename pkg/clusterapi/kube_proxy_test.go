@@ -0,0 +1,47 @@
+package clusterapi_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	controlplanev1beta2 "sigs.k8s.io/cluster-api/api/controlplane/kubeadm/v1beta2"
+
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/clusterapi"
+	"github.com/aws/eks-anywhere/pkg/utils/ptr"
+)
+
+func TestSetKubeProxyReplacementInKubeadmControlPlane(t *testing.T) {
+	tests := []struct {
+		name      string
+		cniConfig *anywherev1.CNIConfig
+		want      *controlplanev1beta2.KubeadmControlPlane
+	}{
+		{
+			name:      "no cni config",
+			cniConfig: nil,
+			want:      wantKubeadmControlPlane(),
+		},
+		{
+			name:      "kube-proxy replacement disabled",
+			cniConfig: &anywherev1.CNIConfig{Cilium: &anywherev1.CiliumConfig{KubeProxyReplacement: ptr.Bool(false)}},
+			want:      wantKubeadmControlPlane(),
+		},
+		{
+			name:      "kube-proxy replacement enabled",
+			cniConfig: &anywherev1.CNIConfig{Cilium: &anywherev1.CiliumConfig{KubeProxyReplacement: ptr.Bool(true)}},
+			want: wantKubeadmControlPlane(func(k *controlplanev1beta2.KubeadmControlPlane) {
+				k.Annotations = map[string]string{controlplanev1beta2.SkipKubeProxyAnnotation: ""}
+				k.Spec.KubeadmConfigSpec.InitConfiguration.SkipPhases = []string{"addon/kube-proxy"}
+			}),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			got := wantKubeadmControlPlane()
+			clusterapi.SetKubeProxyReplacementInKubeadmControlPlane(got, tt.cniConfig)
+			g.Expect(got).To(Equal(tt.want))
+		})
+	}
+}
@@ -106,7 +106,17 @@ func machineHealthCheckForWorker(cluster *v1alpha1.Cluster, workerNodeGroupConfi
 	}
 	mhc := machineHealthCheck(ClusterName(cluster), unhealthyMachineTimeout, nodeStartupTimeout)
 	mhc.SetName(WorkerMachineHealthCheckName(cluster, workerNodeGroupConfig))
-	mhc.Spec.Selector.MatchLabels[clusterv1beta2.MachineDeploymentNameLabel] = MachineDeploymentName(cluster, workerNodeGroupConfig)
+	if mdNames := MachineDeploymentNames(cluster, workerNodeGroupConfig); len(mdNames) == 1 {
+		mhc.Spec.Selector.MatchLabels[clusterv1beta2.MachineDeploymentNameLabel] = mdNames[0]
+	} else {
+		mhc.Spec.Selector.MatchExpressions = []metav1.LabelSelectorRequirement{
+			{
+				Key:      clusterv1beta2.MachineDeploymentNameLabel,
+				Operator: metav1.LabelSelectorOpIn,
+				Values:   mdNames,
+			},
+		}
+	}
 	maxUnhealthy := cluster.Spec.MachineHealthCheck.MaxUnhealthy
 	if workerNodeGroupConfig.MachineHealthCheck != nil && workerNodeGroupConfig.MachineHealthCheck.MaxUnhealthy != nil {
 		maxUnhealthy = workerNodeGroupConfig.MachineHealthCheck.MaxUnhealthy
@@ -154,6 +154,38 @@ func TestMachineHealthCheckForWorkers(t *testing.T) {
 	}
 }
 
+func TestMachineHealthCheckForWorkersWithFailureDomains(t *testing.T) {
+	maxUnhealthy := intstr.Parse("40%")
+	timeout := 5 * time.Minute
+	tt := newApiBuilerTest(t)
+	tt.workerNodeGroupConfig.FailureDomains = []string{"az-1", "az-2"}
+	tt.clusterSpec.Cluster.Spec.WorkerNodeGroupConfigurations = []v1alpha1.WorkerNodeGroupConfiguration{*tt.workerNodeGroupConfig}
+	tt.clusterSpec.Cluster.Spec.MachineHealthCheck = &v1alpha1.MachineHealthCheck{
+		NodeStartupTimeout: &metav1.Duration{
+			Duration: timeout,
+		},
+		UnhealthyMachineTimeout: &metav1.Duration{
+			Duration: timeout,
+		},
+		MaxUnhealthy: &maxUnhealthy,
+	}
+
+	want := expectedMachineHealthCheckForWorkers(timeout, maxUnhealthy)
+	want[0].Spec.Selector = metav1.LabelSelector{
+		MatchLabels: map[string]string{},
+		MatchExpressions: []metav1.LabelSelectorRequirement{
+			{
+				Key:      "cluster.x-k8s.io/deployment-name",
+				Operator: metav1.LabelSelectorOpIn,
+				Values:   []string{"test-cluster-wng-1-az-1", "test-cluster-wng-1-az-2"},
+			},
+		},
+	}
+
+	got := clusterapi.MachineHealthCheckForWorkers(tt.clusterSpec.Cluster)
+	tt.Expect(got).To(Equal(want))
+}
+
 func TestMachineHealthCheckForWorkersWithTimeoutOverride(t *testing.T) {
 	defaultTimeout := 30 * time.Minute
 	workerTimeout := 60 * time.Minute
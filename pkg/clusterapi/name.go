@@ -77,6 +77,33 @@ func KubeadmControlPlaneName(cluster *v1alpha1.Cluster) string {
 	return cluster.GetName()
 }
 
+// ControlPlaneMachineNamingTemplate returns the Cluster API machine naming template of the control
+// plane machines of an EKS-A cluster, or an empty string when they use the default names.
+func ControlPlaneMachineNamingTemplate(cluster *v1alpha1.Cluster) (string, error) {
+	naming := cluster.Spec.ControlPlaneConfiguration.MachineNaming
+	if naming == nil {
+		return "", nil
+	}
+
+	return naming.CAPITemplate(v1alpha1.ControlPlaneNodeGroupName, "")
+}
+
+// WorkerMachineNamingTemplate returns the Cluster API machine naming template of the machines of an
+// EKS-A worker node group in a zone, or an empty string when they use the default names.
+func WorkerMachineNamingTemplate(workerNodeGroupConfig v1alpha1.WorkerNodeGroupConfiguration, zone string) (string, error) {
+	naming := workerNodeGroupConfig.MachineNaming
+	if naming == nil {
+		return "", nil
+	}
+
+	template, err := naming.CAPITemplate(workerNodeGroupConfig.Name, zone)
+	if err != nil {
+		return "", fmt.Errorf("worker node group %s: %v", workerNodeGroupConfig.Name, err)
+	}
+
+	return template, nil
+}
+
 // EtcdClusterName sets the default EtcdCluster object name.
 func EtcdClusterName(clusterName string) string {
 	return fmt.Sprintf("%s-etcd", clusterName)
@@ -89,6 +116,22 @@ func MachineDeploymentName(cluster *v1alpha1.Cluster, workerNodeGroupConfig v1al
 	return clusterWorkerNodeGroupName(cluster, workerNodeGroupConfig)
 }
 
+// MachineDeploymentNames returns the names of the MachineDeployments for an EKS-A worker node group.
+// Worker node groups spread across more than one failure domain have one MachineDeployment per failure domain.
+func MachineDeploymentNames(cluster *v1alpha1.Cluster, workerNodeGroupConfig v1alpha1.WorkerNodeGroupConfiguration) []string {
+	name := MachineDeploymentName(cluster, workerNodeGroupConfig)
+	if len(workerNodeGroupConfig.FailureDomains) <= 1 {
+		return []string{name}
+	}
+
+	names := make([]string, 0, len(workerNodeGroupConfig.FailureDomains))
+	for _, failureDomain := range workerNodeGroupConfig.FailureDomains {
+		names = append(names, fmt.Sprintf("%s-%s", name, failureDomain))
+	}
+
+	return names
+}
+
 func DefaultKubeadmConfigTemplateName(clusterSpec *cluster.Spec, workerNodeGroupConfig v1alpha1.WorkerNodeGroupConfiguration) string {
 	return DefaultObjectName(clusterWorkerNodeGroupName(clusterSpec.Cluster, workerNodeGroupConfig))
 }
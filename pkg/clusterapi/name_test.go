@@ -11,6 +11,7 @@ import (
 	dockerv1beta2 "sigs.k8s.io/cluster-api/test/infrastructure/docker/api/v1beta2"
 
 	"github.com/aws/eks-anywhere/internal/test"
+	"github.com/aws/eks-anywhere/pkg/api/v1alpha1"
 	"github.com/aws/eks-anywhere/pkg/clients/kubernetes"
 	"github.com/aws/eks-anywhere/pkg/clusterapi"
 )
@@ -156,6 +157,59 @@ func TestMachineDeploymentName(t *testing.T) {
 	}
 }
 
+func TestMachineDeploymentNames(t *testing.T) {
+	tests := []struct {
+		name           string
+		failureDomains []string
+		want           []string
+	}{
+		{
+			name: "no failure domains",
+			want: []string{"test-cluster-wng-1"},
+		},
+		{
+			name:           "one failure domain",
+			failureDomains: []string{"az-1"},
+			want:           []string{"test-cluster-wng-1"},
+		},
+		{
+			name:           "multiple failure domains",
+			failureDomains: []string{"az-1", "az-2"},
+			want:           []string{"test-cluster-wng-1-az-1", "test-cluster-wng-1-az-2"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := newApiBuilerTest(t)
+			g.workerNodeGroupConfig.FailureDomains = tt.failureDomains
+			g.Expect(clusterapi.MachineDeploymentNames(g.clusterSpec.Cluster, *g.workerNodeGroupConfig)).To(Equal(tt.want))
+		})
+	}
+}
+
+func TestControlPlaneMachineNamingTemplate(t *testing.T) {
+	g := newApiBuilerTest(t)
+	g.Expect(clusterapi.ControlPlaneMachineNamingTemplate(g.clusterSpec.Cluster)).To(BeEmpty())
+
+	g.clusterSpec.Cluster.Spec.ControlPlaneConfiguration.MachineNaming = &v1alpha1.MachineNamingConfiguration{
+		Template: "corp-{{ .cluster.name }}-{{ .nodeGroup.name }}-{{ .random }}",
+	}
+	g.Expect(clusterapi.ControlPlaneMachineNamingTemplate(g.clusterSpec.Cluster)).To(Equal("corp-{{ .cluster.name }}-control-plane-{{ .random }}"))
+}
+
+func TestWorkerMachineNamingTemplate(t *testing.T) {
+	g := newApiBuilerTest(t)
+	g.Expect(clusterapi.WorkerMachineNamingTemplate(*g.workerNodeGroupConfig, "")).To(BeEmpty())
+
+	g.workerNodeGroupConfig.MachineNaming = &v1alpha1.MachineNamingConfiguration{
+		Template: "corp-{{ .nodeGroup.name }}-{{ .zone }}-{{ .random }}",
+	}
+	g.Expect(clusterapi.WorkerMachineNamingTemplate(*g.workerNodeGroupConfig, "az-1")).To(Equal("corp-wng-1-az-1-{{ .random }}"))
+
+	_, err := clusterapi.WorkerMachineNamingTemplate(*g.workerNodeGroupConfig, "")
+	g.Expect(err).To(MatchError(ContainSubstring("worker node group wng-1: rendering machine naming template")))
+}
+
 func TestDefaultKubeadmConfigTemplateName(t *testing.T) {
 	tests := []struct {
 		name string
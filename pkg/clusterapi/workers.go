@@ -128,9 +128,20 @@ func KubeadmConfigTemplateEqual(new, old *bootstrapv1beta2.KubeadmConfigTemplate
 	// such as taints, so that setting it to empty will trigger machine recreate
 	// The file check with deep equal has been added since the introduction of kubelet configuration in case users
 	// want to get rid of the files with that context.
+	// Users are ignored so changes to ssh keys and accounts update the template in place instead of rolling
+	// out new machines. Existing nodes are patched separately. Bottlerocket nodes can't be patched, so they
+	// still get new machines.
 	return kubeadmConfigTemplateTaintsEqual(new, old) && kubeadmConfigTemplateExtraArgsEqual(new, old) &&
 		reflect.DeepEqual(new.Spec.Template.Spec.Files, old.Spec.Template.Spec.Files) &&
-		equality.Semantic.DeepDerivative(new.Spec, old.Spec)
+		equality.Semantic.DeepDerivative(kubeadmConfigTemplateSpecForComparison(new), kubeadmConfigTemplateSpecForComparison(old))
+}
+
+func kubeadmConfigTemplateSpecForComparison(k *bootstrapv1beta2.KubeadmConfigTemplate) bootstrapv1beta2.KubeadmConfigTemplateSpec {
+	spec := *k.Spec.DeepCopy()
+	if spec.Template.Spec.Format != bootstrapv1beta2.Bottlerocket {
+		spec.Template.Spec.Users = nil
+	}
+	return spec
 }
 
 func kubeadmConfigTemplateTaintsEqual(new, old *bootstrapv1beta2.KubeadmConfigTemplate) bool {
@@ -512,6 +512,76 @@ func TestKubeadmConfigTemplateEqual(t *testing.T) {
 			},
 			want: false,
 		},
+		{
+			name: "diff users",
+			new: &bootstrapv1beta2.KubeadmConfigTemplate{
+				Spec: bootstrapv1beta2.KubeadmConfigTemplateSpec{
+					Template: bootstrapv1beta2.KubeadmConfigTemplateResource{
+						Spec: bootstrapv1beta2.KubeadmConfigSpec{
+							Users: []bootstrapv1beta2.User{
+								{
+									Name:              "ec2-user",
+									SSHAuthorizedKeys: []string{"ssh-rsa AAAA", "ssh-rsa BBBB"},
+								},
+								{
+									Name:              "admin",
+									SSHAuthorizedKeys: []string{"ssh-rsa CCCC"},
+								},
+							},
+						},
+					},
+				},
+			},
+			old: &bootstrapv1beta2.KubeadmConfigTemplate{
+				Spec: bootstrapv1beta2.KubeadmConfigTemplateSpec{
+					Template: bootstrapv1beta2.KubeadmConfigTemplateResource{
+						Spec: bootstrapv1beta2.KubeadmConfigSpec{
+							Users: []bootstrapv1beta2.User{
+								{
+									Name:              "ec2-user",
+									SSHAuthorizedKeys: []string{"ssh-rsa AAAA"},
+								},
+							},
+						},
+					},
+				},
+			},
+			want: true,
+		},
+		{
+			name: "diff users bottlerocket",
+			new: &bootstrapv1beta2.KubeadmConfigTemplate{
+				Spec: bootstrapv1beta2.KubeadmConfigTemplateSpec{
+					Template: bootstrapv1beta2.KubeadmConfigTemplateResource{
+						Spec: bootstrapv1beta2.KubeadmConfigSpec{
+							Format: bootstrapv1beta2.Bottlerocket,
+							Users: []bootstrapv1beta2.User{
+								{
+									Name:              "ec2-user",
+									SSHAuthorizedKeys: []string{"ssh-rsa BBBB"},
+								},
+							},
+						},
+					},
+				},
+			},
+			old: &bootstrapv1beta2.KubeadmConfigTemplate{
+				Spec: bootstrapv1beta2.KubeadmConfigTemplateSpec{
+					Template: bootstrapv1beta2.KubeadmConfigTemplateResource{
+						Spec: bootstrapv1beta2.KubeadmConfigSpec{
+							Format: bootstrapv1beta2.Bottlerocket,
+							Users: []bootstrapv1beta2.User{
+								{
+									Name:              "ec2-user",
+									SSHAuthorizedKeys: []string{"ssh-rsa AAAA"},
+								},
+							},
+						},
+					},
+				},
+			},
+			want: false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
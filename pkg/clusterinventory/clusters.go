@@ -0,0 +1,83 @@
+package clusterinventory
+
+import (
+	"context"
+	"sort"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	clusterv1beta2 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	v1beta1conditions "sigs.k8s.io/cluster-api/util/deprecated/v1beta1/conditions"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/clusterpause"
+	"github.com/aws/eks-anywhere/pkg/constants"
+)
+
+// Cluster is an EKS Anywhere cluster with its reconciliation status.
+type Cluster struct {
+	Name              string `json:"name"`
+	Namespace         string `json:"namespace"`
+	KubernetesVersion string `json:"kubernetesVersion"`
+	EksaVersion       string `json:"eksaVersion"`
+	ManagementCluster string `json:"managementCluster"`
+	Ready             string `json:"ready"`
+	Paused            bool   `json:"paused"`
+}
+
+// Clusters lists the EKS Anywhere clusters of the namespace in the management cluster, or of all
+// namespaces when the namespace is empty.
+func Clusters(ctx context.Context, management client.Reader, namespace string) ([]Cluster, error) {
+	clusters := &anywherev1.ClusterList{}
+	if err := management.List(ctx, clusters, client.InNamespace(namespace)); err != nil {
+		return nil, errors.Wrap(err, "listing clusters")
+	}
+
+	capiClusters := &clusterv1beta2.ClusterList{}
+	if err := management.List(ctx, capiClusters, client.InNamespace(constants.EksaSystemNamespace)); err != nil {
+		return nil, errors.Wrap(err, "listing CAPI clusters")
+	}
+	capiClustersByName := make(map[string]*clusterv1beta2.Cluster, len(capiClusters.Items))
+	for i := range capiClusters.Items {
+		capiClustersByName[capiClusters.Items[i].Name] = &capiClusters.Items[i]
+	}
+
+	list := make([]Cluster, 0, len(clusters.Items))
+	for i := range clusters.Items {
+		c := &clusters.Items[i]
+		cluster := Cluster{
+			Name:              c.Name,
+			Namespace:         c.Namespace,
+			KubernetesVersion: string(c.Spec.KubernetesVersion),
+			ManagementCluster: c.ManagedBy(),
+			Ready:             readyStatus(c),
+			Paused:            clusterpause.IsPaused(c, capiClustersByName[c.Name]),
+		}
+		if c.Spec.EksaVersion != nil {
+			cluster.EksaVersion = string(*c.Spec.EksaVersion)
+		}
+		if cluster.ManagementCluster == "" {
+			cluster.ManagementCluster = c.Name
+		}
+		list = append(list, cluster)
+	}
+
+	sort.SliceStable(list, func(i, j int) bool {
+		if list[i].Namespace != list[j].Namespace {
+			return list[i].Namespace < list[j].Namespace
+		}
+		return list[i].Name < list[j].Name
+	})
+
+	return list, nil
+}
+
+func readyStatus(cluster *anywherev1.Cluster) string {
+	condition := v1beta1conditions.Get(cluster, anywherev1.ReadyCondition)
+	if condition == nil {
+		return string(corev1.ConditionUnknown)
+	}
+
+	return string(condition.Status)
+}
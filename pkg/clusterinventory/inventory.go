@@ -0,0 +1,228 @@
+package clusterinventory
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	clusterv1beta2 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/aws/eks-anywhere/pkg/clients/kubernetes"
+	"github.com/aws/eks-anywhere/pkg/clusterapi"
+	"github.com/aws/eks-anywhere/pkg/constants"
+)
+
+const (
+	// ControlPlaneNodeGroup is the node group of the control plane machines.
+	ControlPlaneNodeGroup = "control-plane"
+	// EtcdNodeGroup is the node group of the external etcd machines.
+	EtcdNodeGroup = "etcd"
+
+	nodeRoleLabelPrefix = "node-role.kubernetes.io/"
+)
+
+// Machine is a CAPI Machine of a cluster with the status of its node.
+type Machine struct {
+	Name       string `json:"name"`
+	NodeGroup  string `json:"nodeGroup"`
+	Phase      string `json:"phase"`
+	Version    string `json:"version"`
+	ProviderID string `json:"providerID"`
+	IP         string `json:"ip"`
+	Node       string `json:"node"`
+	NodeStatus string `json:"nodeStatus"`
+}
+
+// Node is a node of a cluster with the CAPI Machine backing it.
+type Node struct {
+	Name         string `json:"name"`
+	Status       string `json:"status"`
+	Roles        string `json:"roles"`
+	Version      string `json:"version"`
+	InternalIP   string `json:"internalIP"`
+	Machine      string `json:"machine"`
+	MachinePhase string `json:"machinePhase"`
+	NodeGroup    string `json:"nodeGroup"`
+}
+
+// Inventory is the list of machines and nodes of a cluster.
+type Inventory struct {
+	Machines []Machine `json:"machines"`
+	Nodes    []Node    `json:"nodes"`
+}
+
+// WorkloadClient builds a client for a cluster from the kubeconfig CAPI stores for it
+// in the management cluster.
+func WorkloadClient(ctx context.Context, management client.Reader, clusterName string) (client.Client, error) {
+	secret := &corev1.Secret{}
+	key := client.ObjectKey{Name: clusterapi.ClusterKubeconfigSecretName(clusterName), Namespace: constants.EksaSystemNamespace}
+	if err := management.Get(ctx, key, secret); err != nil {
+		return nil, errors.Wrapf(err, "reading kubeconfig secret for cluster %s", clusterName)
+	}
+
+	c, err := kubernetes.NewRuntimeClientFromKubeconfig(secret.Data["value"])
+	if err != nil {
+		return nil, errors.Wrapf(err, "building client for cluster %s", clusterName)
+	}
+
+	return c, nil
+}
+
+// Collect reads the CAPI Machines of the cluster from the management cluster and the nodes
+// from the cluster itself, and joins them. The workload client can be nil, when the
+// cluster API server is not reachable, in which case only the machines are listed.
+func Collect(ctx context.Context, management, workload client.Reader, clusterName string) (*Inventory, error) {
+	machines := &clusterv1beta2.MachineList{}
+	if err := management.List(ctx, machines,
+		client.InNamespace(constants.EksaSystemNamespace),
+		client.MatchingLabels{clusterv1beta2.ClusterNameLabel: clusterName},
+	); err != nil {
+		return nil, errors.Wrapf(err, "listing machines of cluster %s", clusterName)
+	}
+
+	nodes := &corev1.NodeList{}
+	if workload != nil {
+		if err := workload.List(ctx, nodes); err != nil {
+			return nil, errors.Wrapf(err, "listing nodes of cluster %s", clusterName)
+		}
+	}
+
+	nodesByName := make(map[string]*corev1.Node, len(nodes.Items))
+	for i := range nodes.Items {
+		nodesByName[nodes.Items[i].Name] = &nodes.Items[i]
+	}
+	machinesByNode := make(map[string]*clusterv1beta2.Machine, len(machines.Items))
+
+	inventory := &Inventory{
+		Machines: make([]Machine, 0, len(machines.Items)),
+		Nodes:    make([]Node, 0, len(nodes.Items)),
+	}
+	for i := range machines.Items {
+		m := &machines.Items[i]
+		machine := Machine{
+			Name:       m.Name,
+			NodeGroup:  nodeGroup(m, clusterName),
+			Phase:      m.Status.Phase,
+			Version:    m.Spec.Version,
+			ProviderID: m.Spec.ProviderID,
+			IP:         machineIP(m),
+			Node:       m.Status.NodeRef.Name,
+		}
+		if node, ok := nodesByName[machine.Node]; ok {
+			machine.NodeStatus = nodeStatus(node)
+		}
+		if machine.Node != "" {
+			machinesByNode[machine.Node] = m
+		}
+		inventory.Machines = append(inventory.Machines, machine)
+	}
+
+	for i := range nodes.Items {
+		n := &nodes.Items[i]
+		node := Node{
+			Name:       n.Name,
+			Status:     nodeStatus(n),
+			Roles:      nodeRoles(n),
+			Version:    n.Status.NodeInfo.KubeletVersion,
+			InternalIP: nodeAddress(n, corev1.NodeInternalIP),
+		}
+		if m, ok := machinesByNode[n.Name]; ok {
+			node.Machine = m.Name
+			node.MachinePhase = m.Status.Phase
+			node.NodeGroup = nodeGroup(m, clusterName)
+		}
+		inventory.Nodes = append(inventory.Nodes, node)
+	}
+
+	sort.SliceStable(inventory.Machines, func(i, j int) bool {
+		a, b := inventory.Machines[i], inventory.Machines[j]
+		if a.NodeGroup != b.NodeGroup {
+			return a.NodeGroup < b.NodeGroup
+		}
+		return a.Name < b.Name
+	})
+	sort.SliceStable(inventory.Nodes, func(i, j int) bool {
+		return inventory.Nodes[i].Name < inventory.Nodes[j].Name
+	})
+
+	return inventory, nil
+}
+
+// nodeGroup returns the EKS Anywhere node group of the machine: control-plane, etcd
+// or the name of its worker node group.
+func nodeGroup(m *clusterv1beta2.Machine, clusterName string) string {
+	if _, ok := m.Labels[clusterv1beta2.MachineControlPlaneLabel]; ok {
+		return ControlPlaneNodeGroup
+	}
+	if md, ok := m.Labels[clusterv1beta2.MachineDeploymentNameLabel]; ok {
+		return strings.TrimPrefix(md, clusterName+"-")
+	}
+	for _, ref := range m.OwnerReferences {
+		if ref.Kind == "EtcdadmCluster" {
+			return EtcdNodeGroup
+		}
+	}
+
+	return ""
+}
+
+// machineIP returns the first internal IP of the machine, or its first external IP.
+func machineIP(m *clusterv1beta2.Machine) string {
+	for _, addressType := range []clusterv1beta2.MachineAddressType{clusterv1beta2.MachineInternalIP, clusterv1beta2.MachineExternalIP} {
+		for _, a := range m.Status.Addresses {
+			if a.Type == addressType {
+				return a.Address
+			}
+		}
+	}
+
+	return ""
+}
+
+func nodeAddress(n *corev1.Node, addressType corev1.NodeAddressType) string {
+	for _, a := range n.Status.Addresses {
+		if a.Type == addressType {
+			return a.Address
+		}
+	}
+
+	return ""
+}
+
+// nodeStatus returns the node status the way kubectl shows it.
+func nodeStatus(n *corev1.Node) string {
+	status := "Unknown"
+	for _, c := range n.Status.Conditions {
+		if c.Type != corev1.NodeReady {
+			continue
+		}
+		if c.Status == corev1.ConditionTrue {
+			status = "Ready"
+		} else if c.Status == corev1.ConditionFalse {
+			status = "NotReady"
+		}
+	}
+	if n.Spec.Unschedulable {
+		status += ",SchedulingDisabled"
+	}
+
+	return status
+}
+
+func nodeRoles(n *corev1.Node) string {
+	var roles []string
+	for label := range n.Labels {
+		if role, ok := strings.CutPrefix(label, nodeRoleLabelPrefix); ok && role != "" {
+			roles = append(roles, role)
+		}
+	}
+	if len(roles) == 0 {
+		return "<none>"
+	}
+	sort.Strings(roles)
+
+	return strings.Join(roles, ",")
+}
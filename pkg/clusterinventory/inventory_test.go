@@ -0,0 +1,222 @@
+package clusterinventory_test
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	clusterv1beta2 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/clusterinventory"
+	"github.com/aws/eks-anywhere/pkg/constants"
+)
+
+func newClient(objs ...runtime.Object) client.Client {
+	scheme := runtime.NewScheme()
+	_ = corev1.AddToScheme(scheme)
+	_ = clusterv1beta2.AddToScheme(scheme)
+	return fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(objs...).Build()
+}
+
+func machine(name, nodeName, phase string, labels map[string]string) *clusterv1beta2.Machine {
+	l := map[string]string{clusterv1beta2.ClusterNameLabel: "my-cluster"}
+	for k, v := range labels {
+		l[k] = v
+	}
+	return &clusterv1beta2.Machine{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: constants.EksaSystemNamespace,
+			Labels:    l,
+		},
+		Spec: clusterv1beta2.MachineSpec{
+			ClusterName: "my-cluster",
+			Version:     "v1.33.1-eks-1-33-5",
+			ProviderID:  "vsphere://" + name,
+		},
+		Status: clusterv1beta2.MachineStatus{
+			Phase:   phase,
+			NodeRef: clusterv1beta2.MachineNodeReference{Name: nodeName},
+			Addresses: clusterv1beta2.MachineAddresses{
+				{Type: clusterv1beta2.MachineExternalIP, Address: "10.0.0.1"},
+				{Type: clusterv1beta2.MachineInternalIP, Address: "192.168.0.1"},
+			},
+		},
+	}
+}
+
+func node(name string, ready corev1.ConditionStatus, labels map[string]string) *corev1.Node {
+	return &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   name,
+			Labels: labels,
+		},
+		Status: corev1.NodeStatus{
+			Conditions: []corev1.NodeCondition{{Type: corev1.NodeReady, Status: ready}},
+			Addresses:  []corev1.NodeAddress{{Type: corev1.NodeInternalIP, Address: "192.168.0.1"}},
+			NodeInfo:   corev1.NodeSystemInfo{KubeletVersion: "v1.33.1-eks-1-33-5"},
+		},
+	}
+}
+
+func TestCollect(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	management := newClient(
+		machine("my-cluster-md-0-abc", "node-2", "Running", map[string]string{clusterv1beta2.MachineDeploymentNameLabel: "my-cluster-md-0"}),
+		machine("my-cluster-cp-xyz", "node-1", "Running", map[string]string{clusterv1beta2.MachineControlPlaneLabel: ""}),
+		machine("my-cluster-md-0-new", "", "Provisioning", map[string]string{clusterv1beta2.MachineDeploymentNameLabel: "my-cluster-md-0"}),
+		machine("other-cluster-cp", "node-9", "Running", map[string]string{clusterv1beta2.ClusterNameLabel: "other-cluster"}),
+	)
+	cordoned := node("node-2", corev1.ConditionFalse, nil)
+	cordoned.Spec.Unschedulable = true
+	workload := newClient(
+		node("node-1", corev1.ConditionTrue, map[string]string{"node-role.kubernetes.io/control-plane": ""}),
+		cordoned,
+		node("node-3", corev1.ConditionUnknown, nil),
+	)
+
+	inventory, err := clusterinventory.Collect(ctx, management, workload, "my-cluster")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(inventory.Machines).To(Equal([]clusterinventory.Machine{
+		{
+			Name:       "my-cluster-cp-xyz",
+			NodeGroup:  clusterinventory.ControlPlaneNodeGroup,
+			Phase:      "Running",
+			Version:    "v1.33.1-eks-1-33-5",
+			ProviderID: "vsphere://my-cluster-cp-xyz",
+			IP:         "192.168.0.1",
+			Node:       "node-1",
+			NodeStatus: "Ready",
+		},
+		{
+			Name:       "my-cluster-md-0-abc",
+			NodeGroup:  "md-0",
+			Phase:      "Running",
+			Version:    "v1.33.1-eks-1-33-5",
+			ProviderID: "vsphere://my-cluster-md-0-abc",
+			IP:         "192.168.0.1",
+			Node:       "node-2",
+			NodeStatus: "NotReady,SchedulingDisabled",
+		},
+		{
+			Name:       "my-cluster-md-0-new",
+			NodeGroup:  "md-0",
+			Phase:      "Provisioning",
+			Version:    "v1.33.1-eks-1-33-5",
+			ProviderID: "vsphere://my-cluster-md-0-new",
+			IP:         "192.168.0.1",
+		},
+	}))
+	g.Expect(inventory.Nodes).To(Equal([]clusterinventory.Node{
+		{
+			Name:         "node-1",
+			Status:       "Ready",
+			Roles:        "control-plane",
+			Version:      "v1.33.1-eks-1-33-5",
+			InternalIP:   "192.168.0.1",
+			Machine:      "my-cluster-cp-xyz",
+			MachinePhase: "Running",
+			NodeGroup:    clusterinventory.ControlPlaneNodeGroup,
+		},
+		{
+			Name:         "node-2",
+			Status:       "NotReady,SchedulingDisabled",
+			Roles:        "<none>",
+			Version:      "v1.33.1-eks-1-33-5",
+			InternalIP:   "192.168.0.1",
+			Machine:      "my-cluster-md-0-abc",
+			MachinePhase: "Running",
+			NodeGroup:    "md-0",
+		},
+		{
+			Name:       "node-3",
+			Status:     "Unknown",
+			Roles:      "<none>",
+			Version:    "v1.33.1-eks-1-33-5",
+			InternalIP: "192.168.0.1",
+		},
+	}))
+}
+
+func TestCollectWithoutWorkloadClient(t *testing.T) {
+	g := NewWithT(t)
+	etcd := machine("my-cluster-etcd-abc", "", "Running", nil)
+	etcd.OwnerReferences = []metav1.OwnerReference{{Kind: "EtcdadmCluster", Name: "my-cluster-etcd"}}
+
+	inventory, err := clusterinventory.Collect(context.Background(), newClient(etcd), nil, "my-cluster")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(inventory.Machines).To(HaveLen(1))
+	g.Expect(inventory.Machines[0].NodeGroup).To(Equal(clusterinventory.EtcdNodeGroup))
+	g.Expect(inventory.Nodes).To(BeEmpty())
+}
+
+func TestWorkloadClientMissingSecret(t *testing.T) {
+	g := NewWithT(t)
+	_, err := clusterinventory.WorkloadClient(context.Background(), newClient(), "my-cluster")
+	g.Expect(err).To(MatchError(ContainSubstring("reading kubeconfig secret for cluster my-cluster")))
+}
+
+func TestWorkloadClientInvalidKubeconfig(t *testing.T) {
+	g := NewWithT(t)
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-cluster-kubeconfig",
+			Namespace: constants.EksaSystemNamespace,
+		},
+		Data: map[string][]byte{"value": []byte("not a kubeconfig")},
+	}
+
+	_, err := clusterinventory.WorkloadClient(context.Background(), newClient(secret), "my-cluster")
+	g.Expect(err).To(MatchError(ContainSubstring("building client for cluster my-cluster")))
+}
+
+func TestClusters(t *testing.T) {
+	g := NewWithT(t)
+	scheme := runtime.NewScheme()
+	_ = anywherev1.AddToScheme(scheme)
+	_ = clusterv1beta2.AddToScheme(scheme)
+	eksaVersion := anywherev1.EksaVersion("v0.24.0")
+	management := fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(
+		&anywherev1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{Name: "w01", Namespace: "default"},
+			Spec: anywherev1.ClusterSpec{
+				KubernetesVersion: anywherev1.Kube132,
+				ManagementCluster: anywherev1.ManagementCluster{Name: "mgmt"},
+			},
+		},
+		&anywherev1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{Name: "mgmt", Namespace: "default"},
+			Spec: anywherev1.ClusterSpec{
+				KubernetesVersion: anywherev1.Kube133,
+				EksaVersion:       &eksaVersion,
+			},
+			Status: anywherev1.ClusterStatus{
+				Conditions: []anywherev1.Condition{{Type: anywherev1.ReadyCondition, Status: corev1.ConditionTrue}},
+			},
+		},
+		&anywherev1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: "other"}},
+		&clusterv1beta2.Cluster{
+			ObjectMeta: metav1.ObjectMeta{Name: "w01", Namespace: constants.EksaSystemNamespace},
+			Spec:       clusterv1beta2.ClusterSpec{Paused: ptr.To(true)},
+		},
+	).Build()
+
+	clusters, err := clusterinventory.Clusters(context.Background(), management, "default")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(clusters).To(Equal([]clusterinventory.Cluster{
+		{Name: "mgmt", Namespace: "default", KubernetesVersion: "1.33", EksaVersion: "v0.24.0", ManagementCluster: "mgmt", Ready: "True"},
+		{Name: "w01", Namespace: "default", KubernetesVersion: "1.32", ManagementCluster: "mgmt", Ready: "Unknown", Paused: true},
+	}))
+
+	clusters, err = clusterinventory.Clusters(context.Background(), management, "")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(clusters).To(HaveLen(3))
+}
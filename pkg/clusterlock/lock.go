@@ -0,0 +1,282 @@
+package clusterlock
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/user"
+	"sync"
+	"time"
+
+	coordinationv1 "k8s.io/api/coordination/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/aws/eks-anywhere/pkg/clients/kubernetes"
+	"github.com/aws/eks-anywhere/pkg/constants"
+	"github.com/aws/eks-anywhere/pkg/logger"
+)
+
+const (
+	// OperationAnnotation is the annotation on the lock Lease with the operation holding it.
+	OperationAnnotation = "anywhere.eks.amazonaws.com/cli-operation"
+
+	// DefaultLeaseDuration is the time after which a lock that hasn't been renewed is considered stale.
+	DefaultLeaseDuration = 10 * time.Minute
+
+	leaseNameSuffix = "-cli-operation-lock"
+)
+
+// Lock is an operation lock for a cluster, stored as a Lease in the management cluster. It prevents
+// two CLI operations from running concurrently against the same cluster. While held, the lock is
+// renewed in the background so long running operations don't lose it.
+type Lock struct {
+	client        kubernetes.Client
+	clusterName   string
+	operation     string
+	holder        string
+	leaseDuration time.Duration
+	now           func() time.Time
+
+	stop chan struct{}
+	done sync.WaitGroup
+}
+
+// Opt allows to customize a Lock.
+type Opt func(*Lock)
+
+// WithLeaseDuration configures the time after which the lock is considered stale if not renewed.
+func WithLeaseDuration(d time.Duration) Opt {
+	return func(l *Lock) {
+		l.leaseDuration = d
+	}
+}
+
+// WithHolder configures the identity of the holder of the lock.
+func WithHolder(holder string) Opt {
+	return func(l *Lock) {
+		l.holder = holder
+	}
+}
+
+// WithClock configures the function used to get the current time.
+func WithClock(now func() time.Time) Opt {
+	return func(l *Lock) {
+		l.now = now
+	}
+}
+
+// New builds a Lock for the given cluster and operation. The client must point to
+// the management cluster of the cluster.
+func New(client kubernetes.Client, clusterName, operation string, opts ...Opt) *Lock {
+	l := &Lock{
+		client:        client,
+		clusterName:   clusterName,
+		operation:     operation,
+		holder:        defaultHolder(),
+		leaseDuration: DefaultLeaseDuration,
+		now:           time.Now,
+	}
+
+	for _, opt := range opts {
+		opt(l)
+	}
+
+	return l
+}
+
+// LeaseName returns the name of the Lease storing the lock of a cluster.
+func LeaseName(clusterName string) string {
+	return clusterName + leaseNameSuffix
+}
+
+// Acquire takes the lock, failing if another operation holds it. A lock that hasn't been renewed
+// for longer than its lease duration is stale and is taken over.
+func (l *Lock) Acquire(ctx context.Context) error {
+	lease := &coordinationv1.Lease{}
+	err := l.client.Get(ctx, LeaseName(l.clusterName), constants.EksaSystemNamespace, lease)
+	if apierrors.IsNotFound(err) {
+		if err := l.client.Create(ctx, l.newLease()); err != nil {
+			if apierrors.IsAlreadyExists(err) {
+				return fmt.Errorf("cluster %s is locked by another operation started at the same time, retry later", l.clusterName)
+			}
+			return fmt.Errorf("creating operation lock for cluster %s: %v", l.clusterName, err)
+		}
+		l.startRenewal()
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("reading operation lock for cluster %s: %v", l.clusterName, err)
+	}
+
+	if !l.isStale(lease) && holder(lease) != l.holder {
+		return fmt.Errorf(
+			"cluster %s is locked by operation %q of %s since %s, wait for it to finish or use --force-unlock if it's no longer running",
+			l.clusterName, lease.Annotations[OperationAnnotation], holder(lease), acquireTime(lease),
+		)
+	}
+
+	if l.isStale(lease) {
+		logger.V(2).Info("Taking over stale operation lock", "cluster", l.clusterName, "holder", holder(lease))
+	}
+
+	newLease := l.newLease()
+	newLease.ResourceVersion = lease.ResourceVersion
+	if err := l.client.Update(ctx, newLease); err != nil {
+		if apierrors.IsConflict(err) {
+			return fmt.Errorf("cluster %s is locked by another operation started at the same time, retry later", l.clusterName)
+		}
+		return fmt.Errorf("taking over operation lock for cluster %s: %v", l.clusterName, err)
+	}
+
+	l.startRenewal()
+	return nil
+}
+
+// Release stops renewing the lock and deletes it.
+func (l *Lock) Release(ctx context.Context) error {
+	l.stopRenewal()
+
+	lease := &coordinationv1.Lease{}
+	if err := l.client.Get(ctx, LeaseName(l.clusterName), constants.EksaSystemNamespace, lease); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("reading operation lock for cluster %s: %v", l.clusterName, err)
+	}
+
+	if holder(lease) != l.holder {
+		// The lock was forced open and taken by another operation, it's not ours to delete.
+		return nil
+	}
+
+	if err := l.client.Delete(ctx, lease); err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("releasing operation lock for cluster %s: %v", l.clusterName, err)
+	}
+
+	return nil
+}
+
+// ForceUnlock deletes the lock of a cluster, whoever holds it.
+func ForceUnlock(ctx context.Context, client kubernetes.Client, clusterName string) error {
+	lease := &coordinationv1.Lease{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: coordinationv1.SchemeGroupVersion.String(),
+			Kind:       "Lease",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      LeaseName(clusterName),
+			Namespace: constants.EksaSystemNamespace,
+		},
+	}
+	if err := client.Delete(ctx, lease); err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("force unlocking cluster %s: %v", clusterName, err)
+	}
+
+	return nil
+}
+
+func (l *Lock) renew(ctx context.Context) error {
+	lease := &coordinationv1.Lease{}
+	if err := l.client.Get(ctx, LeaseName(l.clusterName), constants.EksaSystemNamespace, lease); err != nil {
+		return err
+	}
+
+	if holder(lease) != l.holder {
+		return fmt.Errorf("operation lock is now held by %s", holder(lease))
+	}
+
+	renewTime := metav1.NewMicroTime(l.now())
+	lease.Spec.RenewTime = &renewTime
+	return l.client.Update(ctx, lease)
+}
+
+func (l *Lock) startRenewal() {
+	l.stop = make(chan struct{})
+	l.done.Add(1)
+	go func() {
+		defer l.done.Done()
+		ticker := time.NewTicker(l.leaseDuration / 3)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-l.stop:
+				return
+			case <-ticker.C:
+				if err := l.renew(context.Background()); err != nil {
+					logger.V(2).Info("Failed renewing operation lock", "cluster", l.clusterName, "error", err)
+				}
+			}
+		}
+	}()
+}
+
+func (l *Lock) stopRenewal() {
+	if l.stop == nil {
+		return
+	}
+	close(l.stop)
+	l.done.Wait()
+	l.stop = nil
+}
+
+func (l *Lock) newLease() *coordinationv1.Lease {
+	now := metav1.NewMicroTime(l.now())
+	leaseDurationSeconds := int32(l.leaseDuration.Seconds())
+	return &coordinationv1.Lease{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: coordinationv1.SchemeGroupVersion.String(),
+			Kind:       "Lease",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      LeaseName(l.clusterName),
+			Namespace: constants.EksaSystemNamespace,
+			Annotations: map[string]string{
+				OperationAnnotation: l.operation,
+			},
+		},
+		Spec: coordinationv1.LeaseSpec{
+			HolderIdentity:       &l.holder,
+			LeaseDurationSeconds: &leaseDurationSeconds,
+			AcquireTime:          &now,
+			RenewTime:            &now,
+		},
+	}
+}
+
+func (l *Lock) isStale(lease *coordinationv1.Lease) bool {
+	if lease.Spec.RenewTime == nil || lease.Spec.LeaseDurationSeconds == nil {
+		return true
+	}
+
+	expiration := lease.Spec.RenewTime.Add(time.Duration(*lease.Spec.LeaseDurationSeconds) * time.Second)
+	return l.now().After(expiration)
+}
+
+func holder(lease *coordinationv1.Lease) string {
+	if lease.Spec.HolderIdentity == nil {
+		return ""
+	}
+	return *lease.Spec.HolderIdentity
+}
+
+func acquireTime(lease *coordinationv1.Lease) string {
+	if lease.Spec.AcquireTime == nil {
+		return "unknown time"
+	}
+	return lease.Spec.AcquireTime.UTC().Format(time.RFC3339)
+}
+
+func defaultHolder() string {
+	hostname, err := os.Hostname()
+	if err != nil {
+		hostname = "unknown"
+	}
+
+	username := "unknown"
+	if u, err := user.Current(); err == nil {
+		username = u.Username
+	}
+
+	return fmt.Sprintf("%s@%s/%d", username, hostname, os.Getpid())
+}
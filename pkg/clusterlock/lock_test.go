@@ -0,0 +1,124 @@
+package clusterlock_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/aws/eks-anywhere/internal/test"
+	"github.com/aws/eks-anywhere/pkg/clients/kubernetes"
+	"github.com/aws/eks-anywhere/pkg/clusterlock"
+	"github.com/aws/eks-anywhere/pkg/constants"
+)
+
+var now = time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+
+func newLock(c kubernetes.Client, holder string, at time.Time) *clusterlock.Lock {
+	return clusterlock.New(c, "my-cluster", "upgrade cluster",
+		clusterlock.WithHolder(holder),
+		clusterlock.WithClock(func() time.Time { return at }),
+		clusterlock.WithLeaseDuration(time.Minute),
+	)
+}
+
+func getLease(ctx context.Context, g *WithT, c client.Client) *coordinationv1.Lease {
+	lease := &coordinationv1.Lease{}
+	g.Expect(c.Get(ctx, client.ObjectKey{Name: "my-cluster-cli-operation-lock", Namespace: constants.EksaSystemNamespace}, lease)).To(Succeed())
+	return lease
+}
+
+func TestLockAcquireRelease(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	c := fake.NewClientBuilder().Build()
+	lock := newLock(test.NewKubeClient(c), "alice", now)
+
+	g.Expect(lock.Acquire(ctx)).To(Succeed())
+	lease := getLease(ctx, g, c)
+	g.Expect(*lease.Spec.HolderIdentity).To(Equal("alice"))
+	g.Expect(*lease.Spec.LeaseDurationSeconds).To(BeEquivalentTo(60))
+	g.Expect(lease.Annotations).To(HaveKeyWithValue(clusterlock.OperationAnnotation, "upgrade cluster"))
+
+	g.Expect(lock.Release(ctx)).To(Succeed())
+	err := c.Get(ctx, client.ObjectKeyFromObject(lease), &coordinationv1.Lease{})
+	g.Expect(apierrors.IsNotFound(err)).To(BeTrue())
+}
+
+func TestLockAcquireHeldByOther(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	c := fake.NewClientBuilder().Build()
+	kubeClient := test.NewKubeClient(c)
+	lock := newLock(kubeClient, "alice", now)
+	g.Expect(lock.Acquire(ctx)).To(Succeed())
+	defer lock.Release(ctx)
+
+	err := newLock(kubeClient, "bob", now.Add(30*time.Second)).Acquire(ctx)
+	g.Expect(err).To(MatchError(ContainSubstring("cluster my-cluster is locked by operation \"upgrade cluster\" of alice since 2024-01-01T10:00:00Z")))
+}
+
+func TestLockAcquireStale(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	renewTime := metav1.NewMicroTime(now)
+	leaseDuration := int32(60)
+	holder := "alice"
+	c := fake.NewClientBuilder().WithObjects(&coordinationv1.Lease{
+		ObjectMeta: metav1.ObjectMeta{Name: clusterlock.LeaseName("my-cluster"), Namespace: constants.EksaSystemNamespace},
+		Spec: coordinationv1.LeaseSpec{
+			HolderIdentity:       &holder,
+			LeaseDurationSeconds: &leaseDuration,
+			AcquireTime:          &renewTime,
+			RenewTime:            &renewTime,
+		},
+	}).Build()
+	lock := newLock(test.NewKubeClient(c), "bob", now.Add(2*time.Minute))
+
+	g.Expect(lock.Acquire(ctx)).To(Succeed())
+	defer lock.Release(ctx)
+	g.Expect(*getLease(ctx, g, c).Spec.HolderIdentity).To(Equal("bob"))
+}
+
+func TestLockReleaseTakenByOther(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	c := fake.NewClientBuilder().Build()
+	kubeClient := test.NewKubeClient(c)
+	lock := newLock(kubeClient, "alice", now)
+	g.Expect(lock.Acquire(ctx)).To(Succeed())
+
+	g.Expect(clusterlock.ForceUnlock(ctx, kubeClient, "my-cluster")).To(Succeed())
+	other := newLock(kubeClient, "bob", now)
+	g.Expect(other.Acquire(ctx)).To(Succeed())
+	defer other.Release(ctx)
+
+	g.Expect(lock.Release(ctx)).To(Succeed())
+	g.Expect(*getLease(ctx, g, c).Spec.HolderIdentity).To(Equal("bob"))
+}
+
+func TestLockReleaseNotAcquired(t *testing.T) {
+	g := NewWithT(t)
+	lock := newLock(test.NewFakeKubeClient(), "alice", now)
+
+	g.Expect(lock.Release(context.Background())).To(Succeed())
+}
+
+func TestForceUnlockNoLock(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect(clusterlock.ForceUnlock(context.Background(), test.NewFakeKubeClient(), "my-cluster")).To(Succeed())
+}
+
+func TestLockAcquireError(t *testing.T) {
+	g := NewWithT(t)
+	lock := newLock(test.NewFakeKubeClientAlwaysError(), "alice", now)
+
+	g.Expect(lock.Acquire(context.Background())).To(MatchError(ContainSubstring("reading operation lock for cluster my-cluster")))
+}
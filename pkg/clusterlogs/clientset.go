@@ -0,0 +1,41 @@
+package clusterlogs
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/clientcmd"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/aws/eks-anywhere/pkg/clusterapi"
+	"github.com/aws/eks-anywhere/pkg/constants"
+)
+
+// NewClientset builds a clientset, able to read and stream pod logs, from the content of a kubeconfig.
+func NewClientset(kubeconfig []byte) (kubernetes.Interface, error) {
+	config, err := clientcmd.RESTConfigFromKubeConfig(kubeconfig)
+	if err != nil {
+		return nil, errors.Wrap(err, "building rest config")
+	}
+
+	return kubernetes.NewForConfig(config)
+}
+
+// WorkloadClientset builds a clientset for a cluster from the kubeconfig CAPI stores for it
+// in the management cluster.
+func WorkloadClientset(ctx context.Context, management client.Reader, clusterName string) (kubernetes.Interface, error) {
+	secret := &corev1.Secret{}
+	key := client.ObjectKey{Name: clusterapi.ClusterKubeconfigSecretName(clusterName), Namespace: constants.EksaSystemNamespace}
+	if err := management.Get(ctx, key, secret); err != nil {
+		return nil, errors.Wrapf(err, "reading kubeconfig secret for cluster %s", clusterName)
+	}
+
+	c, err := NewClientset(secret.Data["value"])
+	if err != nil {
+		return nil, errors.Wrapf(err, "building clientset for cluster %s", clusterName)
+	}
+
+	return c, nil
+}
@@ -0,0 +1,281 @@
+package clusterlogs
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/aws/eks-anywhere/pkg/logger"
+)
+
+// Options configures how logs are read.
+type Options struct {
+	// Window filters the log lines by their timestamp.
+	Window Window
+	// Follow keeps streaming the new log lines until the context is cancelled.
+	Follow bool
+}
+
+// Sink receives the log lines of the pods of the components.
+type Sink interface {
+	// Open returns the writer the logs of a pod are written to.
+	Open(component Component, pod string) (io.WriteCloser, error)
+}
+
+// Collector reads the logs of the lifecycle components of a cluster from its management cluster
+// and, for the components running on its nodes, from the cluster itself.
+type Collector struct {
+	management kubernetes.Interface
+	workload   kubernetes.Interface
+}
+
+// NewCollector returns a new Collector. The workload clientset can be nil when the cluster API
+// server is not reachable, in which case the logs of the components running on it are skipped.
+func NewCollector(management, workload kubernetes.Interface) *Collector {
+	return &Collector{
+		management: management,
+		workload:   workload,
+	}
+}
+
+// Collect writes the logs of every pod of the components to the sink. When following, the
+// logs of all the pods are streamed concurrently.
+func (c *Collector) Collect(ctx context.Context, components []Component, opts Options, sink Sink) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		firstErr error
+	)
+	streamErr := func(err error) {
+		mu.Lock()
+		defer mu.Unlock()
+		if firstErr == nil {
+			firstErr = err
+			cancel()
+		}
+	}
+
+	for _, component := range components {
+		client := c.clientFor(component)
+		if client == nil {
+			logger.Info("Warning: skipping logs, the cluster is not reachable", "component", component.Name)
+			continue
+		}
+
+		pods, err := Pods(ctx, client, component)
+		if err != nil {
+			streamErr(err)
+			break
+		}
+		if len(pods) == 0 {
+			logger.Info("Warning: no pods found", "component", component.Name, "namespace", component.Namespace)
+			continue
+		}
+
+		for _, pod := range pods {
+			if !opts.Follow {
+				if err := copyLogs(ctx, client, component, pod, opts, sink); err != nil {
+					return err
+				}
+				continue
+			}
+
+			wg.Add(1)
+			go func(component Component, pod corev1.Pod) {
+				defer wg.Done()
+				if err := copyLogs(ctx, client, component, pod, opts, sink); err != nil {
+					streamErr(err)
+				}
+			}(component, pod)
+		}
+	}
+
+	wg.Wait()
+
+	return firstErr
+}
+
+func (c *Collector) clientFor(component Component) kubernetes.Interface {
+	if component.Workload {
+		return c.workload
+	}
+	return c.management
+}
+
+// Pods returns the pods of a component, the pods of its deployment or the ones matching its
+// pod prefix. It returns no pods when the deployment doesn't exist.
+func Pods(ctx context.Context, client kubernetes.Interface, component Component) ([]corev1.Pod, error) {
+	if component.Deployment == "" {
+		pods, err := client.CoreV1().Pods(component.Namespace).List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return nil, errors.Wrapf(err, "listing %s pods", component.Name)
+		}
+
+		matching := make([]corev1.Pod, 0, len(pods.Items))
+		for _, pod := range pods.Items {
+			if strings.HasPrefix(pod.Name, component.PodPrefix) {
+				matching = append(matching, pod)
+			}
+		}
+		return matching, nil
+	}
+
+	deployment, err := client.AppsV1().Deployments(component.Namespace).Get(ctx, component.Deployment, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "reading %s deployment", component.Name)
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(deployment.Spec.Selector)
+	if err != nil {
+		return nil, errors.Wrapf(err, "parsing selector of %s deployment", component.Name)
+	}
+
+	pods, err := client.CoreV1().Pods(component.Namespace).List(ctx, metav1.ListOptions{LabelSelector: selector.String()})
+	if err != nil {
+		return nil, errors.Wrapf(err, "listing %s pods", component.Name)
+	}
+
+	return pods.Items, nil
+}
+
+func copyLogs(ctx context.Context, client kubernetes.Interface, component Component, pod corev1.Pod, opts Options, sink Sink) error {
+	logOpts := &corev1.PodLogOptions{
+		Container:  component.Container,
+		Follow:     opts.Follow,
+		Timestamps: true,
+	}
+	if !opts.Window.Since.IsZero() {
+		since := metav1.NewTime(opts.Window.Since)
+		logOpts.SinceTime = &since
+	}
+
+	stream, err := client.CoreV1().Pods(pod.Namespace).GetLogs(pod.Name, logOpts).Stream(ctx)
+	if err != nil {
+		return errors.Wrapf(err, "reading logs of %s pod %s", component.Name, pod.Name)
+	}
+	defer stream.Close()
+
+	w, err := sink.Open(component, pod.Name)
+	if err != nil {
+		return err
+	}
+	defer w.Close()
+
+	return filterLines(stream, w, opts.Window)
+}
+
+// filterLines copies the log lines in the window, dropping the ones after its end. Lines
+// without a timestamp, like the continuation of a multiline message, are kept.
+func filterLines(r io.Reader, w io.Writer, window Window) error {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if t, ok := lineTime(line); ok {
+			if !window.Until.IsZero() && !t.Before(window.Until) {
+				return nil
+			}
+			if !window.Contains(t) {
+				continue
+			}
+		}
+		if _, err := fmt.Fprintln(w, line); err != nil {
+			return errors.Wrap(err, "writing logs")
+		}
+	}
+
+	return scanner.Err()
+}
+
+func lineTime(line string) (time.Time, bool) {
+	timestamp, _, found := strings.Cut(line, " ")
+	if !found {
+		return time.Time{}, false
+	}
+
+	t, err := time.Parse(time.RFC3339Nano, timestamp)
+	if err != nil {
+		return time.Time{}, false
+	}
+
+	return t, true
+}
+
+// WriterSink writes the log lines of all the pods to a single writer, each prefixed with the
+// name of its component and pod.
+type WriterSink struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+// NewWriterSink returns a new WriterSink.
+func NewWriterSink(w io.Writer) *WriterSink {
+	return &WriterSink{w: w}
+}
+
+// Open returns a writer prefixing the lines of the pod.
+func (s *WriterSink) Open(component Component, pod string) (io.WriteCloser, error) {
+	return &prefixedWriter{sink: s, prefix: fmt.Sprintf("[%s/%s] ", component.Name, pod)}, nil
+}
+
+type prefixedWriter struct {
+	sink   *WriterSink
+	prefix string
+}
+
+// Write receives a single line at a time from filterLines.
+func (p *prefixedWriter) Write(line []byte) (int, error) {
+	p.sink.mu.Lock()
+	defer p.sink.mu.Unlock()
+	if _, err := io.WriteString(p.sink.w, p.prefix); err != nil {
+		return 0, err
+	}
+	return p.sink.w.Write(line)
+}
+
+func (p *prefixedWriter) Close() error {
+	return nil
+}
+
+// DirSink writes the logs of every pod to its own file, <dir>/<component>/<pod>.log.
+type DirSink struct {
+	dir string
+}
+
+// NewDirSink returns a new DirSink.
+func NewDirSink(dir string) *DirSink {
+	return &DirSink{dir: dir}
+}
+
+// Open creates the log file of the pod.
+func (s *DirSink) Open(component Component, pod string) (io.WriteCloser, error) {
+	dir := filepath.Join(s.dir, component.Name)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, errors.Wrapf(err, "creating logs directory for %s", component.Name)
+	}
+
+	f, err := os.Create(filepath.Join(dir, pod+".log"))
+	if err != nil {
+		return nil, errors.Wrapf(err, "creating log file for pod %s", pod)
+	}
+
+	return f, nil
+}
@@ -0,0 +1,128 @@
+package clusterlogs_test
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/aws/eks-anywhere/pkg/clusterlogs"
+	"github.com/aws/eks-anywhere/pkg/constants"
+)
+
+func pod(name, namespace string, labels map[string]string) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace, Labels: labels},
+	}
+}
+
+func deployment(name, namespace string, labels map[string]string) *appsv1.Deployment {
+	return &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+		Spec: appsv1.DeploymentSpec{
+			Selector: &metav1.LabelSelector{MatchLabels: labels},
+		},
+	}
+}
+
+var (
+	capiComponent = clusterlogs.Component{
+		Name:       clusterlogs.CAPIComponent,
+		Namespace:  constants.CapiSystemNamespace,
+		Deployment: "capi-controller-manager",
+		Container:  "manager",
+	}
+	kubeVipComponent = clusterlogs.Component{
+		Name:      clusterlogs.KubeVipComponent,
+		Namespace: constants.KubeSystemNamespace,
+		PodPrefix: "kube-vip-",
+		Container: "kube-vip",
+		Workload:  true,
+	}
+)
+
+func managementObjects() []runtime.Object {
+	capiLabels := map[string]string{"control-plane": "controller-manager"}
+	return []runtime.Object{
+		deployment("capi-controller-manager", constants.CapiSystemNamespace, capiLabels),
+		pod("capi-controller-manager-abc", constants.CapiSystemNamespace, capiLabels),
+		pod("other", constants.CapiSystemNamespace, map[string]string{"app": "other"}),
+	}
+}
+
+func TestPodsDeployment(t *testing.T) {
+	g := NewWithT(t)
+	client := fake.NewClientset(managementObjects()...)
+
+	pods, err := clusterlogs.Pods(context.Background(), client, capiComponent)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(pods).To(HaveLen(1))
+	g.Expect(pods[0].Name).To(Equal("capi-controller-manager-abc"))
+}
+
+func TestPodsDeploymentNotFound(t *testing.T) {
+	g := NewWithT(t)
+	client := fake.NewClientset()
+
+	pods, err := clusterlogs.Pods(context.Background(), client, capiComponent)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(pods).To(BeEmpty())
+}
+
+func TestPodsPrefix(t *testing.T) {
+	g := NewWithT(t)
+	client := fake.NewClientset(
+		pod("kube-vip-cp-1", constants.KubeSystemNamespace, nil),
+		pod("kube-vip-cp-2", constants.KubeSystemNamespace, nil),
+		pod("coredns-xyz", constants.KubeSystemNamespace, nil),
+	)
+
+	pods, err := clusterlogs.Pods(context.Background(), client, kubeVipComponent)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(pods).To(HaveLen(2))
+}
+
+func TestCollectorCollectWriterSink(t *testing.T) {
+	g := NewWithT(t)
+	management := fake.NewClientset(managementObjects()...)
+	workload := fake.NewClientset(pod("kube-vip-cp-1", constants.KubeSystemNamespace, nil))
+	out := &bytes.Buffer{}
+
+	err := clusterlogs.NewCollector(management, workload).Collect(context.Background(),
+		[]clusterlogs.Component{capiComponent, kubeVipComponent}, clusterlogs.Options{}, clusterlogs.NewWriterSink(out))
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(out.String()).To(Equal("[capi/capi-controller-manager-abc] fake logs\n[kube-vip/kube-vip-cp-1] fake logs\n"))
+}
+
+func TestCollectorCollectFollow(t *testing.T) {
+	g := NewWithT(t)
+	management := fake.NewClientset(managementObjects()...)
+	out := &bytes.Buffer{}
+
+	err := clusterlogs.NewCollector(management, nil).Collect(context.Background(),
+		[]clusterlogs.Component{capiComponent, kubeVipComponent}, clusterlogs.Options{Follow: true}, clusterlogs.NewWriterSink(out))
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(out.String()).To(Equal("[capi/capi-controller-manager-abc] fake logs\n"))
+}
+
+func TestCollectorCollectDirSink(t *testing.T) {
+	g := NewWithT(t)
+	management := fake.NewClientset(managementObjects()...)
+	dir := t.TempDir()
+
+	err := clusterlogs.NewCollector(management, nil).Collect(context.Background(),
+		[]clusterlogs.Component{capiComponent}, clusterlogs.Options{}, clusterlogs.NewDirSink(dir))
+	g.Expect(err).NotTo(HaveOccurred())
+
+	content, err := os.ReadFile(filepath.Join(dir, clusterlogs.CAPIComponent, "capi-controller-manager-abc.log"))
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(string(content)).To(Equal("fake logs\n"))
+}
@@ -0,0 +1,27 @@
+package clusterlogs
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestFilterLines(t *testing.T) {
+	g := NewWithT(t)
+	logs := strings.Join([]string{
+		"2024-01-01T09:59:59.000000000Z before",
+		"2024-01-01T10:00:00.000000000Z first",
+		"  continuation of first",
+		"2024-01-01T10:30:00.500000000Z second",
+		"2024-01-01T11:00:00.000000000Z after",
+		"2024-01-01T11:30:00.000000000Z later",
+	}, "\n")
+	since := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+	out := &bytes.Buffer{}
+
+	g.Expect(filterLines(strings.NewReader(logs), out, Window{Since: since, Until: since.Add(time.Hour)})).To(Succeed())
+	g.Expect(out.String()).To(Equal("2024-01-01T10:00:00.000000000Z first\n  continuation of first\n2024-01-01T10:30:00.500000000Z second\n"))
+}
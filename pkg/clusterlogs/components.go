@@ -0,0 +1,165 @@
+package clusterlogs
+
+import (
+	"fmt"
+	"strings"
+
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/constants"
+)
+
+const (
+	// EksaControllerComponent is the EKS Anywhere cluster controller.
+	EksaControllerComponent = "eksa-controller"
+	// CAPIComponent is the CAPI core controller.
+	CAPIComponent = "capi"
+	// KubeadmBootstrapComponent is the CAPI kubeadm bootstrap provider.
+	KubeadmBootstrapComponent = "kubeadm-bootstrap"
+	// KubeadmControlPlaneComponent is the CAPI kubeadm control plane provider.
+	KubeadmControlPlaneComponent = "kubeadm-control-plane"
+	// InfrastructureComponent is the CAPI infrastructure provider of the cluster.
+	InfrastructureComponent = "infrastructure"
+	// EtcdadmBootstrapComponent is the etcdadm bootstrap provider of clusters with external etcd.
+	EtcdadmBootstrapComponent = "etcdadm-bootstrap"
+	// EtcdadmControllerComponent is the etcdadm controller of clusters with external etcd.
+	EtcdadmControllerComponent = "etcdadm-controller"
+	// KubeVipComponent is the kube-vip running the control plane endpoint on the control plane nodes.
+	KubeVipComponent = "kube-vip"
+
+	managerContainer = "manager"
+)
+
+// Component is a lifecycle component of a cluster whose logs can be read.
+type Component struct {
+	// Name identifies the component in the command line and the output, like capi or kube-vip.
+	Name string
+	// Namespace is the namespace the pods of the component run in.
+	Namespace string
+	// Deployment is the name of the deployment running the component. Empty for static pods.
+	Deployment string
+	// PodPrefix selects the pods of the component by name when it doesn't run in a deployment.
+	PodPrefix string
+	// Container is the container the logs are read from.
+	Container string
+	// Workload is true for the components running in the cluster itself instead of its
+	// management cluster.
+	Workload bool
+}
+
+// infrastructureProviders are the namespace and deployment of the CAPI infrastructure providers,
+// indexed by datacenter kind.
+var infrastructureProviders = map[string]struct{ namespace, deployment string }{
+	anywherev1.VSphereDatacenterKind:       {constants.CapvSystemNamespace, "capv-controller-manager"},
+	anywherev1.TinkerbellDatacenterKind:    {constants.CaptSystemNamespace, "capt-controller-manager"},
+	anywherev1.CloudStackDatacenterKind:    {constants.CapcSystemNamespace, "capc-controller-manager"},
+	anywherev1.DockerDatacenterKind:        {constants.CapdSystemNamespace, "capd-controller-manager"},
+	anywherev1.SnowDatacenterKind:          {constants.CapasSystemNamespace, "capas-controller-manager"},
+	anywherev1.NutanixDatacenterKind:       {constants.CapxSystemNamespace, "capx-controller-manager"},
+	anywherev1.ProxmoxDatacenterKind:       {constants.CapmoxSystemNamespace, "capmox-controller-manager"},
+	anywherev1.OpenStackDatacenterKind:     {constants.CapoSystemNamespace, "capo-controller-manager"},
+	anywherev1.AzureStackHCIDatacenterKind: {constants.CaphSystemNamespace, "caph-controller-manager"},
+	anywherev1.HarvesterDatacenterKind:     {constants.CaphvSystemNamespace, "caphv-controller-manager"},
+}
+
+// Components returns the lifecycle components of a cluster: the EKS Anywhere and CAPI
+// controllers of its management cluster, the etcdadm controllers when it has an external etcd,
+// and the kube-vip of its control plane for the providers that run one.
+func Components(cluster *anywherev1.Cluster) []Component {
+	components := []Component{
+		{
+			Name:       EksaControllerComponent,
+			Namespace:  constants.EksaSystemNamespace,
+			Deployment: constants.EksaControllerManagerDeployment,
+			Container:  managerContainer,
+		},
+		{
+			Name:       CAPIComponent,
+			Namespace:  constants.CapiSystemNamespace,
+			Deployment: "capi-controller-manager",
+			Container:  managerContainer,
+		},
+		{
+			Name:       KubeadmBootstrapComponent,
+			Namespace:  constants.CapiKubeadmBootstrapSystemNamespace,
+			Deployment: "capi-kubeadm-bootstrap-controller-manager",
+			Container:  managerContainer,
+		},
+		{
+			Name:       KubeadmControlPlaneComponent,
+			Namespace:  constants.CapiKubeadmControlPlaneSystemNamespace,
+			Deployment: "capi-kubeadm-control-plane-controller-manager",
+			Container:  managerContainer,
+		},
+	}
+
+	kind := cluster.Spec.DatacenterRef.Kind
+	if p, ok := infrastructureProviders[kind]; ok {
+		components = append(components, Component{
+			Name:       InfrastructureComponent,
+			Namespace:  p.namespace,
+			Deployment: p.deployment,
+			Container:  managerContainer,
+		})
+	}
+
+	if cluster.Spec.ExternalEtcdConfiguration != nil {
+		components = append(components,
+			Component{
+				Name:       EtcdadmBootstrapComponent,
+				Namespace:  constants.EtcdAdmBootstrapProviderSystemNamespace,
+				Deployment: "etcdadm-bootstrap-provider-controller-manager",
+				Container:  managerContainer,
+			},
+			Component{
+				Name:       EtcdadmControllerComponent,
+				Namespace:  constants.EtcdAdmControllerSystemNamespace,
+				Deployment: "etcdadm-controller-controller-manager",
+				Container:  managerContainer,
+			},
+		)
+	}
+
+	if _, ok := infrastructureProviders[kind]; ok && kind != anywherev1.DockerDatacenterKind {
+		components = append(components, Component{
+			Name:      KubeVipComponent,
+			Namespace: constants.KubeSystemNamespace,
+			PodPrefix: "kube-vip-",
+			Container: "kube-vip",
+			Workload:  true,
+		})
+	}
+
+	return components
+}
+
+// Select returns the components with the given names, in the order of the components. It
+// returns all of them when no name is given.
+func Select(components []Component, names []string) ([]Component, error) {
+	if len(names) == 0 {
+		return components, nil
+	}
+
+	available := make([]string, 0, len(components))
+	byName := make(map[string]bool, len(components))
+	for _, c := range components {
+		available = append(available, c.Name)
+		byName[c.Name] = true
+	}
+
+	selected := make(map[string]bool, len(names))
+	for _, n := range names {
+		if !byName[n] {
+			return nil, fmt.Errorf("component %s is not available for the cluster, available components: %s", n, strings.Join(available, ", "))
+		}
+		selected[n] = true
+	}
+
+	filtered := make([]Component, 0, len(names))
+	for _, c := range components {
+		if selected[c.Name] {
+			filtered = append(filtered, c)
+		}
+	}
+
+	return filtered, nil
+}
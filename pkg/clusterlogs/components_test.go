@@ -0,0 +1,79 @@
+package clusterlogs_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/clusterlogs"
+	"github.com/aws/eks-anywhere/pkg/constants"
+)
+
+func componentNames(components []clusterlogs.Component) []string {
+	names := make([]string, 0, len(components))
+	for _, c := range components {
+		names = append(names, c.Name)
+	}
+	return names
+}
+
+func TestComponentsVSphereExternalEtcd(t *testing.T) {
+	g := NewWithT(t)
+	cluster := &anywherev1.Cluster{}
+	cluster.Spec.DatacenterRef.Kind = anywherev1.VSphereDatacenterKind
+	cluster.Spec.ExternalEtcdConfiguration = &anywherev1.ExternalEtcdConfiguration{Count: 3}
+
+	components := clusterlogs.Components(cluster)
+	g.Expect(componentNames(components)).To(Equal([]string{
+		clusterlogs.EksaControllerComponent,
+		clusterlogs.CAPIComponent,
+		clusterlogs.KubeadmBootstrapComponent,
+		clusterlogs.KubeadmControlPlaneComponent,
+		clusterlogs.InfrastructureComponent,
+		clusterlogs.EtcdadmBootstrapComponent,
+		clusterlogs.EtcdadmControllerComponent,
+		clusterlogs.KubeVipComponent,
+	}))
+	g.Expect(components[4].Namespace).To(Equal(constants.CapvSystemNamespace))
+	g.Expect(components[4].Deployment).To(Equal("capv-controller-manager"))
+	g.Expect(components[7].Workload).To(BeTrue())
+}
+
+func TestComponentsDocker(t *testing.T) {
+	g := NewWithT(t)
+	cluster := &anywherev1.Cluster{}
+	cluster.Spec.DatacenterRef.Kind = anywherev1.DockerDatacenterKind
+
+	g.Expect(componentNames(clusterlogs.Components(cluster))).To(Equal([]string{
+		clusterlogs.EksaControllerComponent,
+		clusterlogs.CAPIComponent,
+		clusterlogs.KubeadmBootstrapComponent,
+		clusterlogs.KubeadmControlPlaneComponent,
+		clusterlogs.InfrastructureComponent,
+	}))
+}
+
+func TestSelect(t *testing.T) {
+	g := NewWithT(t)
+	cluster := &anywherev1.Cluster{}
+	cluster.Spec.DatacenterRef.Kind = anywherev1.TinkerbellDatacenterKind
+	components := clusterlogs.Components(cluster)
+
+	all, err := clusterlogs.Select(components, nil)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(all).To(Equal(components))
+
+	selected, err := clusterlogs.Select(components, []string{clusterlogs.KubeVipComponent, clusterlogs.CAPIComponent})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(componentNames(selected)).To(Equal([]string{clusterlogs.CAPIComponent, clusterlogs.KubeVipComponent}))
+}
+
+func TestSelectUnavailableComponent(t *testing.T) {
+	g := NewWithT(t)
+	cluster := &anywherev1.Cluster{}
+	cluster.Spec.DatacenterRef.Kind = anywherev1.DockerDatacenterKind
+
+	_, err := clusterlogs.Select(clusterlogs.Components(cluster), []string{clusterlogs.EtcdadmControllerComponent})
+	g.Expect(err).To(MatchError(ContainSubstring("component etcdadm-controller is not available for the cluster")))
+}
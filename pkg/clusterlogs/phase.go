@@ -0,0 +1,53 @@
+package clusterlogs
+
+import (
+	"fmt"
+	"time"
+
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+)
+
+// Window is the time range logs are read in. A zero Since reads the logs from the start of the
+// pods and a zero Until up to now.
+type Window struct {
+	Since time.Time
+	Until time.Time
+}
+
+// Contains returns true if the time is in the window.
+func (w Window) Contains(t time.Time) bool {
+	if !w.Since.IsZero() && t.Before(w.Since) {
+		return false
+	}
+	if !w.Until.IsZero() && !t.Before(w.Until) {
+		return false
+	}
+
+	return true
+}
+
+// PhaseWindow returns the time range of the last lifecycle event of a type recorded in the history
+// of a cluster, like its last Kubernetes version upgrade. The range ends with the next event of
+// the cluster, if any, so the logs only show the operations of that phase.
+func PhaseWindow(history *anywherev1.ClusterHistory, phase anywherev1.ClusterHistoryEventType) (Window, error) {
+	events := history.Status.Events
+	last := -1
+	for i := range events {
+		if events[i].Type == phase {
+			last = i
+		}
+	}
+	if last < 0 {
+		return Window{}, fmt.Errorf("no %s event recorded for cluster %s", phase, history.Name)
+	}
+
+	w := Window{Since: events[last].Time.Time}
+	for _, e := range events[last+1:] {
+		if e.Time.After(w.Since) {
+			w.Until = e.Time.Time
+			break
+		}
+	}
+
+	return w, nil
+}
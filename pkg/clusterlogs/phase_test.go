@@ -0,0 +1,86 @@
+package clusterlogs_test
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/clusterlogs"
+)
+
+func historyEvent(eventType anywherev1.ClusterHistoryEventType, day int) anywherev1.ClusterHistoryEvent {
+	return anywherev1.ClusterHistoryEvent{
+		Type: eventType,
+		Time: metav1.NewTime(time.Date(2024, 1, day, 10, 0, 0, 0, time.UTC)),
+	}
+}
+
+func TestPhaseWindow(t *testing.T) {
+	history := &anywherev1.ClusterHistory{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-cluster"},
+		Status: anywherev1.ClusterHistoryStatus{
+			Events: []anywherev1.ClusterHistoryEvent{
+				historyEvent(anywherev1.ClusterCreatedEvent, 1),
+				historyEvent(anywherev1.KubernetesVersionUpgradedEvent, 2),
+				historyEvent(anywherev1.NodeGroupScaledEvent, 3),
+				historyEvent(anywherev1.KubernetesVersionUpgradedEvent, 4),
+			},
+		},
+	}
+
+	tests := []struct {
+		name    string
+		phase   anywherev1.ClusterHistoryEventType
+		want    clusterlogs.Window
+		wantErr string
+	}{
+		{
+			name:  "phase followed by another event",
+			phase: anywherev1.NodeGroupScaledEvent,
+			want: clusterlogs.Window{
+				Since: time.Date(2024, 1, 3, 10, 0, 0, 0, time.UTC),
+				Until: time.Date(2024, 1, 4, 10, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name:  "last event of the phase",
+			phase: anywherev1.KubernetesVersionUpgradedEvent,
+			want: clusterlogs.Window{
+				Since: time.Date(2024, 1, 4, 10, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name:    "phase not recorded",
+			phase:   anywherev1.PackageInstalledEvent,
+			wantErr: "no PackageInstalled event recorded for cluster my-cluster",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			w, err := clusterlogs.PhaseWindow(history, tt.phase)
+			if tt.wantErr != "" {
+				g.Expect(err).To(MatchError(tt.wantErr))
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(w.Since).To(BeTemporally("==", tt.want.Since))
+			g.Expect(w.Until).To(BeTemporally("==", tt.want.Until))
+		})
+	}
+}
+
+func TestWindowContains(t *testing.T) {
+	g := NewWithT(t)
+	since := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+	until := since.Add(time.Hour)
+	w := clusterlogs.Window{Since: since, Until: until}
+
+	g.Expect(w.Contains(since)).To(BeTrue())
+	g.Expect(w.Contains(since.Add(-time.Second))).To(BeFalse())
+	g.Expect(w.Contains(until)).To(BeFalse())
+	g.Expect(clusterlogs.Window{}.Contains(until)).To(BeTrue())
+}
@@ -50,7 +50,7 @@ type KubernetesClient interface {
 	GetEksaVSphereMachineConfig(ctx context.Context, VSphereDatacenterName string, kubeconfigFile string, namespace string) (*v1alpha1.VSphereMachineConfig, error)
 	GetEksaCloudStackMachineConfig(ctx context.Context, cloudstackMachineConfigName string, kubeconfigFile string, namespace string) (*v1alpha1.CloudStackMachineConfig, error)
 	SetEksaControllerEnvVar(ctx context.Context, envVar, envVarVal, kubeconfig string) error
-	ValidateControlPlaneNodes(ctx context.Context, cluster *types.Cluster, clusterName string) error
+	CountControlPlaneReplicasReady(ctx context.Context, cluster *types.Cluster, clusterName string) (ready, total int, err error)
 	ValidateWorkerNodes(ctx context.Context, clusterName string, kubeconfigFile string) error
 	CountMachineDeploymentReplicasReady(ctx context.Context, clusterName string, kubeconfigFile string) (int, int, error)
 	GetBundles(ctx context.Context, kubeconfigFile, name, namespace string) (*releasev1alpha1.Bundles, error)
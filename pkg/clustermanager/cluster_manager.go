@@ -2,7 +2,6 @@ package clustermanager
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"math"
 	"net/url"
@@ -14,7 +13,6 @@ import (
 	"github.com/go-logr/logr"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/utils/integer"
 	clusterv1beta2 "sigs.k8s.io/cluster-api/api/core/v1beta2"
 	"sigs.k8s.io/yaml"
 
@@ -33,6 +31,7 @@ import (
 	"github.com/aws/eks-anywhere/pkg/providers/tinkerbell"
 	"github.com/aws/eks-anywhere/pkg/retrier"
 	"github.com/aws/eks-anywhere/pkg/types"
+	"github.com/aws/eks-anywhere/pkg/wait"
 )
 
 const (
@@ -471,54 +470,39 @@ func collectDiagnosticBundle(ctx context.Context, bundle diagnostics.DiagnosticB
 }
 
 func (c *ClusterManager) waitForControlPlaneReplicasReady(ctx context.Context, managementCluster *types.Cluster, clusterSpec *cluster.Spec) error {
-	isCpReady := func() error {
-		return c.clusterClient.ValidateControlPlaneNodes(ctx, managementCluster, clusterSpec.Cluster.Name)
-	}
-
-	err := isCpReady()
-	if err == nil {
-		return nil
-	}
-
 	timeout := c.totalTimeoutForMachinesReadyWait(clusterSpec.Cluster.Spec.ControlPlaneConfiguration.Count)
-	r := retrier.New(timeout)
-	if err := r.Retry(isCpReady); err != nil {
+	err := c.waiter(timeout).For("control plane replicas", func() (wait.Progress, error) {
+		ready, total, err := c.clusterClient.CountControlPlaneReplicasReady(ctx, managementCluster, clusterSpec.Cluster.Name)
+		return wait.Progress{Ready: ready, Total: total}, err
+	})
+	if err != nil {
 		return fmt.Errorf("retries exhausted waiting for controlplane replicas to be ready: %v", err)
 	}
 	return nil
 }
 
 func (c *ClusterManager) waitForMachineDeploymentReplicasReady(ctx context.Context, managementCluster *types.Cluster, clusterSpec *cluster.Spec) error {
-	ready, total := 0, 0
-	policy := func(_ int, _ error) (bool, time.Duration) {
-		return true, c.machineBackoff * time.Duration(integer.IntMax(1, total-ready))
-	}
-
 	var machineDeploymentReplicasCount int
 	for _, workerNodeGroupConfiguration := range clusterSpec.Cluster.Spec.WorkerNodeGroupConfigurations {
 		machineDeploymentReplicasCount += *workerNodeGroupConfiguration.Count
 	}
 
-	areMdReplicasReady := func() error {
-		var err error
-		ready, total, err = c.clusterClient.CountMachineDeploymentReplicasReady(ctx, clusterSpec.Cluster.Name, managementCluster.KubeconfigFile)
-		if err != nil {
-			return err
-		}
-		if ready != total {
-			return fmt.Errorf("%d machine deployment replicas are not ready", total-ready)
-		}
-		return nil
-	}
-
 	timeout := c.totalTimeoutForMachinesReadyWait(machineDeploymentReplicasCount)
-	r := retrier.New(timeout, retrier.WithRetryPolicy(policy))
-	if err := r.Retry(areMdReplicasReady); err != nil {
+	err := c.waiter(timeout).For("machine deployment replicas", func() (wait.Progress, error) {
+		ready, total, err := c.clusterClient.CountMachineDeploymentReplicasReady(ctx, clusterSpec.Cluster.Name, managementCluster.KubeconfigFile)
+		return wait.Progress{Ready: ready, Total: total}, err
+	})
+	if err != nil {
 		return fmt.Errorf("retries exhausted waiting for machinedeployment replicas to be ready: %v", err)
 	}
 	return nil
 }
 
+// waiter builds a waiter for machines that polls less often the more machines are pending.
+func (c *ClusterManager) waiter(timeout time.Duration) *wait.Waiter {
+	return wait.New(retrier.New(timeout, retrier.WithRetryPolicy(wait.PendingBackoffPolicy(c.machineBackoff))))
+}
+
 // totalTimeoutForMachinesReadyWait calculates the total timeout when waiting for machines to be ready.
 // The timeout increases linearly with the number of machines but can never be less than the configured
 // minimun.
@@ -537,35 +521,12 @@ func (c *ClusterManager) waitForNodesReady(ctx context.Context, managementCluste
 		return fmt.Errorf("getting the total count of nodes: %v", err)
 	}
 
-	readyNodes := 0
-	policy := func(_ int, _ error) (bool, time.Duration) {
-		return true, c.machineBackoff * time.Duration(integer.IntMax(1, totalNodes-readyNodes))
-	}
-
-	areNodesReady := func() error {
-		var err error
-		readyNodes, err = c.countNodesReady(ctx, managementCluster, clusterName, labels, checkers...)
-		if err != nil {
-			return err
-		}
-
-		if readyNodes != totalNodes {
-			logger.V(4).Info("Nodes are not ready yet", "total", totalNodes, "ready", readyNodes, "cluster name", clusterName)
-			return errors.New("nodes are not ready yet")
-		}
-
-		logger.V(4).Info("Nodes ready", "total", totalNodes)
-		return nil
-	}
-
-	err = areNodesReady()
-	if err == nil {
-		return nil
-	}
-
 	timeout := c.totalTimeoutForMachinesReadyWait(totalNodes)
-	r := retrier.New(timeout, retrier.WithRetryPolicy(policy))
-	if err := r.Retry(areNodesReady); err != nil {
+	err = c.waiter(timeout).For("nodes", func() (wait.Progress, error) {
+		readyNodes, err := c.countNodesReady(ctx, managementCluster, clusterName, labels, checkers...)
+		return wait.Progress{Ready: readyNodes, Total: totalNodes}, err
+	})
+	if err != nil {
 		return fmt.Errorf("retries exhausted waiting for machines to be ready: %v", err)
 	}
 
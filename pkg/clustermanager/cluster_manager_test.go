@@ -456,7 +456,7 @@ func TestClusterManagerMoveCAPISuccess(t *testing.T) {
 	m.client.EXPECT().WaitForClusterReady(ctx, from, "1h0m0s", to.Name)
 	m.client.EXPECT().MoveManagement(ctx, from, to, to.Name)
 	m.client.EXPECT().WaitForControlPlaneReady(ctx, to, "15m0s", to.Name)
-	m.client.EXPECT().ValidateControlPlaneNodes(ctx, to, to.Name)
+	m.client.EXPECT().CountControlPlaneReplicasReady(ctx, to, to.Name)
 	m.client.EXPECT().CountMachineDeploymentReplicasReady(ctx, to.Name, to.KubeconfigFile)
 	m.client.EXPECT().GetKubeadmControlPlane(ctx,
 		to,
@@ -512,7 +512,7 @@ func TestClusterManagerMoveCAPIRetrySuccess(t *testing.T) {
 		secondTry,
 	)
 	m.client.EXPECT().WaitForControlPlaneReady(ctx, to, "15m0s", to.Name)
-	m.client.EXPECT().ValidateControlPlaneNodes(ctx, to, to.Name)
+	m.client.EXPECT().CountControlPlaneReplicasReady(ctx, to, to.Name)
 	m.client.EXPECT().CountMachineDeploymentReplicasReady(ctx, to.Name, to.KubeconfigFile)
 	m.client.EXPECT().GetKubeadmControlPlane(ctx,
 		to,
@@ -568,7 +568,7 @@ func TestClusterManagerMoveKubectlWaitRetrySuccess(t *testing.T) {
 		firstTry,
 		secondTry,
 	)
-	m.client.EXPECT().ValidateControlPlaneNodes(ctx, to, to.Name)
+	m.client.EXPECT().CountControlPlaneReplicasReady(ctx, to, to.Name)
 	m.client.EXPECT().CountMachineDeploymentReplicasReady(ctx, to.Name, to.KubeconfigFile)
 	m.client.EXPECT().GetKubeadmControlPlane(ctx,
 		to,
@@ -727,7 +727,7 @@ func TestClusterManagerMoveCAPIErrorGetMachines(t *testing.T) {
 	m.client.EXPECT().WaitForClusterReady(ctx, from, "1h0m0s", from.Name)
 	m.client.EXPECT().MoveManagement(ctx, from, to, from.Name)
 	m.client.EXPECT().WaitForControlPlaneReady(ctx, to, "15m0s", from.Name)
-	m.client.EXPECT().ValidateControlPlaneNodes(ctx, to, to.Name)
+	m.client.EXPECT().CountControlPlaneReplicasReady(ctx, to, to.Name)
 	m.client.EXPECT().CountMachineDeploymentReplicasReady(ctx, to.Name, to.KubeconfigFile)
 	m.client.EXPECT().GetKubeadmControlPlane(ctx,
 		to,
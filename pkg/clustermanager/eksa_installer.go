@@ -248,6 +248,15 @@ func setManagerEnvVars(d *appsv1.Deployment, spec *cluster.Spec) {
 		envVars = append(envVars, v1.EnvVar{Name: features.APIServerExtraArgsEnabledEnvVar, Value: "true"})
 	}
 
+	// TODO: remove this feature flag when the on-demand provisioner is no longer experimental.
+	if features.IsActive(features.VSphereOnDemandProvisionerEnabled()) {
+		envVars = append(envVars, v1.EnvVar{Name: features.VSphereOnDemandProvisionerEnvVar, Value: "true"})
+	}
+
+	if features.IsActive(features.RolloutGuardrailsEnabled()) {
+		envVars = append(envVars, v1.EnvVar{Name: features.RolloutGuardrailsEnvVar, Value: "true"})
+	}
+
 	d.Spec.Template.Spec.Containers[0].Env = envVars
 }
 
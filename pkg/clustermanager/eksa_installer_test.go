@@ -444,6 +444,46 @@ func TestSetManagerEnvVarsAPIServerExtraArgs(t *testing.T) {
 	g.Expect(deploy).To(Equal(want))
 }
 
+func TestSetManagerEnvVarsVSphereOnDemandProvisioner(t *testing.T) {
+	g := NewWithT(t)
+	features.ClearCache()
+	t.Setenv(features.VSphereOnDemandProvisionerEnvVar, "true")
+
+	deploy := deployment()
+	spec := test.NewClusterSpec()
+	want := deployment(func(d *appsv1.Deployment) {
+		d.Spec.Template.Spec.Containers[0].Env = []corev1.EnvVar{
+			{
+				Name:  "VSPHERE_ON_DEMAND_PROVISIONER",
+				Value: "true",
+			},
+		}
+	})
+
+	clustermanager.SetManagerEnvVars(deploy, spec)
+	g.Expect(deploy).To(Equal(want))
+}
+
+func TestSetManagerEnvVarsRolloutGuardrails(t *testing.T) {
+	g := NewWithT(t)
+	features.ClearCache()
+	t.Setenv(features.RolloutGuardrailsEnvVar, "true")
+
+	deploy := deployment()
+	spec := test.NewClusterSpec()
+	want := deployment(func(d *appsv1.Deployment) {
+		d.Spec.Template.Spec.Containers[0].Env = []corev1.EnvVar{
+			{
+				Name:  "ROLLOUT_GUARDRAILS_ENABLED",
+				Value: "true",
+			},
+		}
+	})
+
+	clustermanager.SetManagerEnvVars(deploy, spec)
+	g.Expect(deploy).To(Equal(want))
+}
+
 func TestEKSAInstallerNewUpgraderConfigMap(t *testing.T) {
 	tt := newInstallerTest(t)
 
@@ -123,6 +123,22 @@ func (mr *MockClusterClientMockRecorder) BackupManagement(arg0, arg1, arg2, arg3
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BackupManagement", reflect.TypeOf((*MockClusterClient)(nil).BackupManagement), arg0, arg1, arg2, arg3)
 }
 
+// CountControlPlaneReplicasReady mocks base method.
+func (m *MockClusterClient) CountControlPlaneReplicasReady(arg0 context.Context, arg1 *types.Cluster, arg2 string) (int, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountControlPlaneReplicasReady", arg0, arg1, arg2)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CountControlPlaneReplicasReady indicates an expected call of CountControlPlaneReplicasReady.
+func (mr *MockClusterClientMockRecorder) CountControlPlaneReplicasReady(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountControlPlaneReplicasReady", reflect.TypeOf((*MockClusterClient)(nil).CountControlPlaneReplicasReady), arg0, arg1, arg2)
+}
+
 // CountMachineDeploymentReplicasReady mocks base method.
 func (m *MockClusterClient) CountMachineDeploymentReplicasReady(arg0 context.Context, arg1, arg2 string) (int, int, error) {
 	m.ctrl.T.Helper()
@@ -677,20 +693,6 @@ func (mr *MockClusterClientMockRecorder) UpdateEnvironmentVariablesInNamespace(a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEnvironmentVariablesInNamespace", reflect.TypeOf((*MockClusterClient)(nil).UpdateEnvironmentVariablesInNamespace), arg0, arg1, arg2, arg3, arg4, arg5)
 }
 
-// ValidateControlPlaneNodes mocks base method.
-func (m *MockClusterClient) ValidateControlPlaneNodes(arg0 context.Context, arg1 *types.Cluster, arg2 string) error {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ValidateControlPlaneNodes", arg0, arg1, arg2)
-	ret0, _ := ret[0].(error)
-	return ret0
-}
-
-// ValidateControlPlaneNodes indicates an expected call of ValidateControlPlaneNodes.
-func (mr *MockClusterClientMockRecorder) ValidateControlPlaneNodes(arg0, arg1, arg2 interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateControlPlaneNodes", reflect.TypeOf((*MockClusterClient)(nil).ValidateControlPlaneNodes), arg0, arg1, arg2)
-}
-
 // ValidateWorkerNodes mocks base method.
 func (m *MockClusterClient) ValidateWorkerNodes(arg0 context.Context, arg1, arg2 string) error {
 	m.ctrl.T.Helper()
@@ -883,6 +885,22 @@ func (mr *MockKubernetesClientMockRecorder) ApplyKubeSpecFromBytesWithNamespace(
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplyKubeSpecFromBytesWithNamespace", reflect.TypeOf((*MockKubernetesClient)(nil).ApplyKubeSpecFromBytesWithNamespace), arg0, arg1, arg2, arg3)
 }
 
+// CountControlPlaneReplicasReady mocks base method.
+func (m *MockKubernetesClient) CountControlPlaneReplicasReady(arg0 context.Context, arg1 *types.Cluster, arg2 string) (int, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountControlPlaneReplicasReady", arg0, arg1, arg2)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CountControlPlaneReplicasReady indicates an expected call of CountControlPlaneReplicasReady.
+func (mr *MockKubernetesClientMockRecorder) CountControlPlaneReplicasReady(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountControlPlaneReplicasReady", reflect.TypeOf((*MockKubernetesClient)(nil).CountControlPlaneReplicasReady), arg0, arg1, arg2)
+}
+
 // CountMachineDeploymentReplicasReady mocks base method.
 func (m *MockKubernetesClient) CountMachineDeploymentReplicasReady(arg0 context.Context, arg1, arg2 string) (int, int, error) {
 	m.ctrl.T.Helper()
@@ -1394,20 +1412,6 @@ func (mr *MockKubernetesClientMockRecorder) UpdateEnvironmentVariablesInNamespac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEnvironmentVariablesInNamespace", reflect.TypeOf((*MockKubernetesClient)(nil).UpdateEnvironmentVariablesInNamespace), arg0, arg1, arg2, arg3, arg4, arg5)
 }
 
-// ValidateControlPlaneNodes mocks base method.
-func (m *MockKubernetesClient) ValidateControlPlaneNodes(arg0 context.Context, arg1 *types.Cluster, arg2 string) error {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ValidateControlPlaneNodes", arg0, arg1, arg2)
-	ret0, _ := ret[0].(error)
-	return ret0
-}
-
-// ValidateControlPlaneNodes indicates an expected call of ValidateControlPlaneNodes.
-func (mr *MockKubernetesClientMockRecorder) ValidateControlPlaneNodes(arg0, arg1, arg2 interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateControlPlaneNodes", reflect.TypeOf((*MockKubernetesClient)(nil).ValidateControlPlaneNodes), arg0, arg1, arg2)
-}
-
 // ValidateWorkerNodes mocks base method.
 func (m *MockKubernetesClient) ValidateWorkerNodes(arg0 context.Context, arg1, arg2 string) error {
 	m.ctrl.T.Helper()
@@ -0,0 +1,120 @@
+package clusterpause
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	clusterv1beta2 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/constants"
+	"github.com/aws/eks-anywhere/pkg/logger"
+)
+
+var (
+	pauseCAPIClusterPatch  = []byte(`{"spec":{"paused":true}}`)
+	resumeCAPIClusterPatch = []byte(`{"spec":{"paused":null}}`)
+)
+
+// Pause stops the reconciliation of a cluster. It adds the paused annotation to the EKS Anywhere
+// Cluster, its datacenter config and machine configs, so the EKS Anywhere controller doesn't
+// change the CAPI objects, and then pauses the CAPI Cluster, so the CAPI and provider controllers
+// don't touch the machines of the cluster.
+func Pause(ctx context.Context, c client.Client, clusterName, namespace string) error {
+	cluster := &anywherev1.Cluster{}
+	if err := c.Get(ctx, client.ObjectKey{Name: clusterName, Namespace: namespace}, cluster); err != nil {
+		return errors.Wrapf(err, "reading cluster %s", clusterName)
+	}
+
+	logger.V(3).Info("Pausing EKS Anywhere reconciliation", "cluster", cluster.Name)
+	if err := patchPausedAnnotation(ctx, c, cluster, pausedAnnotationPatch(cluster, true)); err != nil {
+		return err
+	}
+
+	logger.V(3).Info("Pausing CAPI reconciliation", "cluster", cluster.Name)
+	if err := patchCAPICluster(ctx, c, cluster.Name, pauseCAPIClusterPatch); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Resume restarts the reconciliation of a paused cluster, in the reverse order of Pause: the CAPI
+// Cluster first, and then the EKS Anywhere objects, so the EKS Anywhere controller reconciles a
+// cluster CAPI is already reconciling.
+func Resume(ctx context.Context, c client.Client, clusterName, namespace string) error {
+	cluster := &anywherev1.Cluster{}
+	if err := c.Get(ctx, client.ObjectKey{Name: clusterName, Namespace: namespace}, cluster); err != nil {
+		return errors.Wrapf(err, "reading cluster %s", clusterName)
+	}
+
+	logger.V(3).Info("Resuming CAPI reconciliation", "cluster", cluster.Name)
+	if err := patchCAPICluster(ctx, c, cluster.Name, resumeCAPIClusterPatch); err != nil {
+		return err
+	}
+
+	logger.V(3).Info("Resuming EKS Anywhere reconciliation", "cluster", cluster.Name)
+	if err := patchPausedAnnotation(ctx, c, cluster, pausedAnnotationPatch(cluster, false)); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// IsPaused returns whether the reconciliation of the cluster is paused, either by the EKS Anywhere
+// paused annotation or on the CAPI Cluster. The CAPI Cluster can be nil when it doesn't exist yet.
+func IsPaused(cluster *anywherev1.Cluster, capiCluster *clusterv1beta2.Cluster) bool {
+	if cluster.IsReconcilePaused() {
+		return true
+	}
+
+	return capiCluster != nil && capiCluster.Spec.Paused != nil && *capiCluster.Spec.Paused
+}
+
+// patchPausedAnnotation patches the datacenter and machine configs of the cluster before the
+// cluster itself, so the cluster is only paused, or resumed, once all its configs are.
+func patchPausedAnnotation(ctx context.Context, c client.Client, cluster *anywherev1.Cluster, patch []byte) error {
+	refs := []anywherev1.Ref{cluster.Spec.DatacenterRef}
+	refs = append(refs, cluster.MachineConfigRefs()...)
+	for _, ref := range refs {
+		if ref.Kind == "" || ref.Name == "" {
+			continue
+		}
+		obj := &unstructured.Unstructured{}
+		obj.SetGroupVersionKind(anywherev1.GroupVersion.WithKind(ref.Kind))
+		obj.SetName(ref.Name)
+		obj.SetNamespace(cluster.Namespace)
+		if err := c.Patch(ctx, obj, client.RawPatch(types.MergePatchType, patch)); err != nil {
+			return errors.Wrapf(err, "patching paused annotation of %s %s", ref.Kind, ref.Name)
+		}
+	}
+
+	if err := c.Patch(ctx, cluster, client.RawPatch(types.MergePatchType, patch)); err != nil {
+		return errors.Wrapf(err, "patching paused annotation of cluster %s", cluster.Name)
+	}
+
+	return nil
+}
+
+func pausedAnnotationPatch(cluster *anywherev1.Cluster, paused bool) []byte {
+	if paused {
+		return []byte(fmt.Sprintf(`{"metadata":{"annotations":{%q:"true"}}}`, cluster.PausedAnnotation()))
+	}
+
+	return []byte(fmt.Sprintf(`{"metadata":{"annotations":{%q:null}}}`, cluster.PausedAnnotation()))
+}
+
+func patchCAPICluster(ctx context.Context, c client.Client, clusterName string, patch []byte) error {
+	capiCluster := &clusterv1beta2.Cluster{}
+	capiCluster.SetName(clusterName)
+	capiCluster.SetNamespace(constants.EksaSystemNamespace)
+	if err := c.Patch(ctx, capiCluster, client.RawPatch(types.MergePatchType, patch)); err != nil {
+		return errors.Wrapf(err, "patching CAPI cluster %s", clusterName)
+	}
+
+	return nil
+}
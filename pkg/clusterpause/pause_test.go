@@ -0,0 +1,124 @@
+package clusterpause_test
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	clusterv1beta2 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/clusterpause"
+	"github.com/aws/eks-anywhere/pkg/constants"
+)
+
+const pausedAnnotation = "anywhere.eks.amazonaws.com/paused"
+
+func newClient(objs ...client.Object) client.Client {
+	scheme := runtime.NewScheme()
+	_ = anywherev1.AddToScheme(scheme)
+	_ = clusterv1beta2.AddToScheme(scheme)
+	return fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).Build()
+}
+
+func objects() []client.Object {
+	return []client.Object{
+		&anywherev1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-cluster", Namespace: "default"},
+			Spec: anywherev1.ClusterSpec{
+				DatacenterRef: anywherev1.Ref{Kind: anywherev1.VSphereDatacenterKind, Name: "my-dc"},
+				ControlPlaneConfiguration: anywherev1.ControlPlaneConfiguration{
+					MachineGroupRef: &anywherev1.Ref{Kind: anywherev1.VSphereMachineConfigKind, Name: "my-cp"},
+				},
+				WorkerNodeGroupConfigurations: []anywherev1.WorkerNodeGroupConfiguration{
+					{Name: "md-0", MachineGroupRef: &anywherev1.Ref{Kind: anywherev1.VSphereMachineConfigKind, Name: "my-worker"}},
+				},
+			},
+		},
+		&anywherev1.VSphereDatacenterConfig{ObjectMeta: metav1.ObjectMeta{Name: "my-dc", Namespace: "default"}},
+		&anywherev1.VSphereMachineConfig{ObjectMeta: metav1.ObjectMeta{Name: "my-cp", Namespace: "default"}},
+		&anywherev1.VSphereMachineConfig{ObjectMeta: metav1.ObjectMeta{Name: "my-worker", Namespace: "default"}},
+		&clusterv1beta2.Cluster{ObjectMeta: metav1.ObjectMeta{Name: "my-cluster", Namespace: constants.EksaSystemNamespace}},
+	}
+}
+
+func expectPaused(g Gomega, c client.Client, paused bool) {
+	ctx := context.Background()
+	for _, obj := range []client.Object{
+		&anywherev1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: "my-cluster", Namespace: "default"}},
+		&anywherev1.VSphereDatacenterConfig{ObjectMeta: metav1.ObjectMeta{Name: "my-dc", Namespace: "default"}},
+		&anywherev1.VSphereMachineConfig{ObjectMeta: metav1.ObjectMeta{Name: "my-cp", Namespace: "default"}},
+		&anywherev1.VSphereMachineConfig{ObjectMeta: metav1.ObjectMeta{Name: "my-worker", Namespace: "default"}},
+	} {
+		g.Expect(c.Get(ctx, client.ObjectKeyFromObject(obj), obj)).To(Succeed())
+		if paused {
+			g.Expect(obj.GetAnnotations()).To(HaveKeyWithValue(pausedAnnotation, "true"), obj.GetName())
+		} else {
+			g.Expect(obj.GetAnnotations()).NotTo(HaveKey(pausedAnnotation), obj.GetName())
+		}
+	}
+
+	cluster := &anywherev1.Cluster{}
+	g.Expect(c.Get(ctx, client.ObjectKey{Name: "my-cluster", Namespace: "default"}, cluster)).To(Succeed())
+	capiCluster := &clusterv1beta2.Cluster{}
+	g.Expect(c.Get(ctx, client.ObjectKey{Name: "my-cluster", Namespace: constants.EksaSystemNamespace}, capiCluster)).To(Succeed())
+	if paused {
+		g.Expect(capiCluster.Spec.Paused).To(Equal(ptr.To(true)))
+	} else {
+		g.Expect(capiCluster.Spec.Paused).To(BeNil())
+	}
+	g.Expect(clusterpause.IsPaused(cluster, capiCluster)).To(Equal(paused))
+}
+
+func TestPauseAndResume(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	c := newClient(objects()...)
+
+	g.Expect(clusterpause.Pause(ctx, c, "my-cluster", "default")).To(Succeed())
+	expectPaused(g, c, true)
+
+	g.Expect(clusterpause.Resume(ctx, c, "my-cluster", "default")).To(Succeed())
+	expectPaused(g, c, false)
+}
+
+func TestPauseClusterNotFound(t *testing.T) {
+	g := NewWithT(t)
+	g.Expect(clusterpause.Pause(context.Background(), newClient(), "my-cluster", "default")).To(
+		MatchError(ContainSubstring("reading cluster my-cluster")),
+	)
+}
+
+func TestPauseMissingCAPICluster(t *testing.T) {
+	g := NewWithT(t)
+	objs := objects()
+	c := newClient(objs[:len(objs)-1]...)
+
+	g.Expect(clusterpause.Pause(context.Background(), c, "my-cluster", "default")).To(
+		MatchError(ContainSubstring("patching CAPI cluster my-cluster")),
+	)
+}
+
+func TestPauseMissingMachineConfig(t *testing.T) {
+	g := NewWithT(t)
+	objs := objects()
+	c := newClient(append(objs[:3:3], objs[4])...)
+
+	g.Expect(clusterpause.Pause(context.Background(), c, "my-cluster", "default")).To(
+		MatchError(ContainSubstring("patching paused annotation of VSphereMachineConfig my-worker")),
+	)
+}
+
+func TestIsPaused(t *testing.T) {
+	g := NewWithT(t)
+	cluster := &anywherev1.Cluster{}
+	g.Expect(clusterpause.IsPaused(cluster, nil)).To(BeFalse())
+	g.Expect(clusterpause.IsPaused(cluster, &clusterv1beta2.Cluster{Spec: clusterv1beta2.ClusterSpec{Paused: ptr.To(true)}})).To(BeTrue())
+	cluster.PauseReconcile()
+	g.Expect(clusterpause.IsPaused(cluster, nil)).To(BeTrue())
+}
@@ -10,6 +10,15 @@ const (
 	NoProxyKey    = "NO_PROXY"
 )
 
+// Proxy settings the CLI uses for its own calls to vCenter. They are populated from the
+// VSphereDatacenterConfig providerProxyConfiguration and override the standard proxy variables
+// for govc only.
+const (
+	EksavSphereHttpsProxyKey = "EKSA_VSPHERE_HTTPS_PROXY"
+	EksavSphereHttpProxyKey  = "EKSA_VSPHERE_HTTP_PROXY"
+	EksavSphereNoProxyKey    = "EKSA_VSPHERE_NO_PROXY"
+)
+
 func GetProxyConfigFromEnv() map[string]string {
 	return map[string]string{
 		HttpsProxyKey: os.Getenv(HttpsProxyKey),
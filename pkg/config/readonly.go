@@ -0,0 +1,74 @@
+package config
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/aws/eks-anywhere/pkg/constants"
+)
+
+// EksaReadOnlyCredentialsInUseKey is set once the provider credentials of the current process
+// have been replaced with the read-only ones.
+const EksaReadOnlyCredentialsInUseKey = "EKSA_READONLY_CREDENTIALS_IN_USE"
+
+type readOnlyCredentialsEnv struct {
+	usernameKey, passwordKey string
+	usernameTargets          []string
+	passwordTargets          []string
+}
+
+var readOnlyCredentialsEnvs = []readOnlyCredentialsEnv{
+	{
+		usernameKey:     EksavSphereReadOnlyUsernameKey,
+		passwordKey:     EksavSphereReadOnlyPasswordKey,
+		usernameTargets: []string{EksavSphereUsernameKey, EksavSphereCPUsernameKey},
+		passwordTargets: []string{EksavSpherePasswordKey, EksavSphereCPPasswordKey},
+	},
+	{
+		usernameKey:     constants.EksaNutanixReadOnlyUsernameKey,
+		passwordKey:     constants.EksaNutanixReadOnlyPasswordKey,
+		usernameTargets: []string{constants.EksaNutanixUsernameKey},
+		passwordTargets: []string{constants.EksaNutanixPasswordKey},
+	},
+}
+
+// UseReadOnlyCredentials replaces the provider credentials of the current process with the
+// read-only ones when they are set, so commands that only read from the infrastructure never use
+// write capable secrets. It returns true if any read-only credentials were found.
+func UseReadOnlyCredentials() (bool, error) {
+	used := false
+	for _, env := range readOnlyCredentialsEnvs {
+		username, password := os.Getenv(env.usernameKey), os.Getenv(env.passwordKey)
+		if username == "" && password == "" {
+			continue
+		}
+		if username == "" || password == "" {
+			return false, fmt.Errorf("both %s and %s must be set to use read-only credentials", env.usernameKey, env.passwordKey)
+		}
+
+		for _, k := range env.usernameTargets {
+			if err := os.Setenv(k, username); err != nil {
+				return false, fmt.Errorf("unable to set %s: %v", k, err)
+			}
+		}
+		for _, k := range env.passwordTargets {
+			if err := os.Setenv(k, password); err != nil {
+				return false, fmt.Errorf("unable to set %s: %v", k, err)
+			}
+		}
+		used = true
+	}
+
+	if used {
+		if err := os.Setenv(EksaReadOnlyCredentialsInUseKey, "true"); err != nil {
+			return false, fmt.Errorf("unable to set %s: %v", EksaReadOnlyCredentialsInUseKey, err)
+		}
+	}
+
+	return used, nil
+}
+
+// ReadOnlyCredentialsInUse returns true if the provider credentials have been replaced with read-only ones.
+func ReadOnlyCredentialsInUse() bool {
+	return os.Getenv(EksaReadOnlyCredentialsInUseKey) == "true"
+}
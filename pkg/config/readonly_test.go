@@ -0,0 +1,93 @@
+package config_test
+
+import (
+	"os"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/aws/eks-anywhere/pkg/config"
+	"github.com/aws/eks-anywhere/pkg/constants"
+)
+
+func setReadOnlyEnv(t *testing.T, env map[string]string) {
+	for _, k := range []string{
+		config.EksavSphereUsernameKey,
+		config.EksavSpherePasswordKey,
+		config.EksavSphereCPUsernameKey,
+		config.EksavSphereCPPasswordKey,
+		config.EksavSphereReadOnlyUsernameKey,
+		config.EksavSphereReadOnlyPasswordKey,
+		constants.EksaNutanixUsernameKey,
+		constants.EksaNutanixPasswordKey,
+		constants.EksaNutanixReadOnlyUsernameKey,
+		constants.EksaNutanixReadOnlyPasswordKey,
+		config.EksaReadOnlyCredentialsInUseKey,
+	} {
+		t.Setenv(k, env[k])
+	}
+}
+
+func TestUseReadOnlyCredentialsNotSet(t *testing.T) {
+	g := NewWithT(t)
+	setReadOnlyEnv(t, map[string]string{
+		config.EksavSphereUsernameKey: "admin",
+		config.EksavSpherePasswordKey: "admin-pass",
+	})
+
+	used, err := config.UseReadOnlyCredentials()
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(used).To(BeFalse())
+	g.Expect(config.ReadOnlyCredentialsInUse()).To(BeFalse())
+	g.Expect(os.Getenv(config.EksavSphereUsernameKey)).To(Equal("admin"))
+}
+
+func TestUseReadOnlyCredentialsVSphere(t *testing.T) {
+	g := NewWithT(t)
+	setReadOnlyEnv(t, map[string]string{
+		config.EksavSphereUsernameKey:         "admin",
+		config.EksavSpherePasswordKey:         "admin-pass",
+		config.EksavSphereCPUsernameKey:       "cp",
+		config.EksavSphereCPPasswordKey:       "cp-pass",
+		config.EksavSphereReadOnlyUsernameKey: "reader",
+		config.EksavSphereReadOnlyPasswordKey: "reader-pass",
+	})
+
+	used, err := config.UseReadOnlyCredentials()
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(used).To(BeTrue())
+	g.Expect(config.ReadOnlyCredentialsInUse()).To(BeTrue())
+
+	vuc := config.NewVsphereUserConfig()
+	g.Expect(vuc.EksaVsphereUsername).To(Equal("reader"))
+	g.Expect(vuc.EksaVspherePassword).To(Equal("reader-pass"))
+	g.Expect(vuc.EksaVsphereCPUsername).To(Equal("reader"))
+	g.Expect(vuc.EksaVsphereCPPassword).To(Equal("reader-pass"))
+}
+
+func TestUseReadOnlyCredentialsNutanix(t *testing.T) {
+	g := NewWithT(t)
+	setReadOnlyEnv(t, map[string]string{
+		constants.EksaNutanixUsernameKey:         "admin",
+		constants.EksaNutanixPasswordKey:         "admin-pass",
+		constants.EksaNutanixReadOnlyUsernameKey: "reader",
+		constants.EksaNutanixReadOnlyPasswordKey: "reader-pass",
+	})
+
+	used, err := config.UseReadOnlyCredentials()
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(used).To(BeTrue())
+	g.Expect(os.Getenv(constants.EksaNutanixUsernameKey)).To(Equal("reader"))
+	g.Expect(os.Getenv(constants.EksaNutanixPasswordKey)).To(Equal("reader-pass"))
+}
+
+func TestUseReadOnlyCredentialsMissingPassword(t *testing.T) {
+	g := NewWithT(t)
+	setReadOnlyEnv(t, map[string]string{
+		config.EksavSphereReadOnlyUsernameKey: "reader",
+	})
+
+	_, err := config.UseReadOnlyCredentials()
+	g.Expect(err).To(MatchError(ContainSubstring("both EKSA_VSPHERE_READONLY_USERNAME and EKSA_VSPHERE_READONLY_PASSWORD must be set")))
+	g.Expect(config.ReadOnlyCredentialsInUse()).To(BeFalse())
+}
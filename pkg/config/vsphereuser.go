@@ -12,6 +12,10 @@ const (
 	EksavSphereCPUsernameKey = "EKSA_VSPHERE_CP_USERNAME"
 	// EksavSphereCPPasswordKey holds Password for cloud provider.
 	EksavSphereCPPasswordKey = "EKSA_VSPHERE_CP_PASSWORD"
+	// EksavSphereReadOnlyUsernameKey holds Username for a read-only user used by validation-only commands.
+	EksavSphereReadOnlyUsernameKey = "EKSA_VSPHERE_READONLY_USERNAME"
+	// EksavSphereReadOnlyPasswordKey holds Password for a read-only user used by validation-only commands.
+	EksavSphereReadOnlyPasswordKey = "EKSA_VSPHERE_READONLY_PASSWORD"
 )
 
 type VSphereUserConfig struct {
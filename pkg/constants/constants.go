@@ -18,6 +18,10 @@ const (
 	CapaSystemNamespace                     = "capa-system"
 	CapasSystemNamespace                    = "capas-system"
 	CapxSystemNamespace                     = "capx-system"
+	CapmoxSystemNamespace                   = "capmox-system"
+	CapoSystemNamespace                     = "capo-system"
+	CaphSystemNamespace                     = "caph-system"
+	CaphvSystemNamespace                    = "caphv-system"
 	CertManagerNamespace                    = "cert-manager"
 	DefaultNamespace                        = "default"
 	EtcdAdmBootstrapProviderSystemNamespace = "etcdadm-bootstrap-provider-system"
@@ -35,6 +39,7 @@ const (
 	DefaultHttpsPort                        = "443"
 	DefaultWorkerNodeGroupName              = "md-0"
 	DefaultNodeCidrMaskSize                 = 24
+	DefaultNodeCidrMaskSizeIPv6             = 64
 
 	// Certificate renewal component types.
 	EtcdComponent         = "etcd"
@@ -47,6 +52,12 @@ const (
 	TinkerbellProviderName = "tinkerbell"
 	CloudStackProviderName = "cloudstack"
 	NutanixProviderName    = "nutanix"
+	ProxmoxProviderName    = "proxmox"
+	OpenStackProviderName  = "openstack"
+	// AzureStackHCIProviderName is the name of the Azure Stack HCI provider.
+	AzureStackHCIProviderName = "azurestackhci"
+	// HarvesterProviderName is the name of the Harvester provider.
+	HarvesterProviderName = "harvester"
 	// DefaultNutanixPrismCentralPort is the default port for Nutanix Prism Central.
 	DefaultNutanixPrismCentralPort = 9440
 
@@ -95,8 +106,32 @@ const (
 	NutanixPasswordKey     = "NUTANIX_PASSWORD"
 	EksaNutanixUsernameKey = "EKSA_NUTANIX_USERNAME"
 	EksaNutanixPasswordKey = "EKSA_NUTANIX_PASSWORD"
-	RegistryUsername       = "REGISTRY_USERNAME"
-	RegistryPassword       = "REGISTRY_PASSWORD"
+	// EksaNutanixReadOnlyUsernameKey holds the username of a read-only Prism Central user for validation-only commands.
+	EksaNutanixReadOnlyUsernameKey = "EKSA_NUTANIX_READONLY_USERNAME"
+	// EksaNutanixReadOnlyPasswordKey holds the password of a read-only Prism Central user for validation-only commands.
+	EksaNutanixReadOnlyPasswordKey = "EKSA_NUTANIX_READONLY_PASSWORD"
+	// EksaProxmoxTokenIDKey holds the ID of the Proxmox VE API token, in the user@realm!tokenname format.
+	EksaProxmoxTokenIDKey = "EKSA_PROXMOX_TOKEN_ID"
+	// EksaProxmoxTokenSecretKey holds the secret of the Proxmox VE API token.
+	EksaProxmoxTokenSecretKey = "EKSA_PROXMOX_TOKEN_SECRET"
+	// EksaOpenStackApplicationCredentialIDKey holds the ID of the OpenStack application credential.
+	EksaOpenStackApplicationCredentialIDKey = "EKSA_OPENSTACK_APPLICATION_CREDENTIAL_ID"
+	// EksaOpenStackApplicationCredentialSecretKey holds the secret of the OpenStack application credential.
+	EksaOpenStackApplicationCredentialSecretKey = "EKSA_OPENSTACK_APPLICATION_CREDENTIAL_SECRET"
+	// EksaAzureStackHCILoginConfigKey holds the path to the Azure Stack HCI cloud agent login config file.
+	EksaAzureStackHCILoginConfigKey = "EKSA_AZURESTACKHCI_LOGIN_CONFIG"
+	// EksaHarvesterKubeconfigKey holds the path to the kubeconfig file of the Harvester cluster.
+	EksaHarvesterKubeconfigKey = "EKSA_HARVESTER_KUBECONFIG"
+	// EksaInfobloxURLKey holds the Infoblox WAPI base URL, for example https://infoblox.example.com/wapi/v2.12.
+	EksaInfobloxURLKey = "EKSA_INFOBLOX_URL"
+	// EksaInfobloxUsernameKey holds the username of the Infoblox WAPI user.
+	EksaInfobloxUsernameKey = "EKSA_INFOBLOX_USERNAME"
+	// EksaInfobloxPasswordKey holds the password of the Infoblox WAPI user.
+	EksaInfobloxPasswordKey = "EKSA_INFOBLOX_PASSWORD"
+	// EksaInfobloxInsecureKey skips the TLS verification of the Infoblox WAPI when set to true.
+	EksaInfobloxInsecureKey = "EKSA_INFOBLOX_INSECURE"
+	RegistryUsername        = "REGISTRY_USERNAME"
+	RegistryPassword        = "REGISTRY_PASSWORD"
 
 	SecretKind             = "Secret"
 	ConfigMapKind          = "ConfigMap"
@@ -134,7 +169,7 @@ const (
 	// fields depending on your signing requirements.
 	// We are excluding some fields from the versionbundle object from signing/verifying the signature to allow users to override images.
 	// To check the fields we are excluding for signing/verifying the signature base64 decode the Excludes field.
-	Excludes = "LnNwZWMudmVyc2lvbnNCdW5kbGVzW10uYm9vdHN0cmFwCi5zcGVjLnZlcnNpb25zQnVuZGxlc1tdLmJvdHRsZXJvY2tldEJvb3RzdHJhcENvbnRhaW5lcnMKLnNwZWMudmVyc2lvbnNCdW5kbGVzW10uYm90dGxlcm9ja2V0SG9zdENvbnRhaW5lcnMKLnNwZWMudmVyc2lvbnNCdW5kbGVzW10uY2VydE1hbmFnZXIKLnNwZWMudmVyc2lvbnNCdW5kbGVzW10uY2lsaXVtCi5zcGVjLnZlcnNpb25zQnVuZGxlc1tdLmNsb3VkU3RhY2sKLnNwZWMudmVyc2lvbnNCdW5kbGVzW10uY2x1c3RlckFQSQouc3BlYy52ZXJzaW9uc0J1bmRsZXNbXS5jb250cm9sUGxhbmUKLnNwZWMudmVyc2lvbnNCdW5kbGVzW10uZG9ja2VyCi5zcGVjLnZlcnNpb25zQnVuZGxlc1tdLmVrc2EKLnNwZWMudmVyc2lvbnNCdW5kbGVzW10uZWtzRC5jb21wb25lbnRzCi5zcGVjLnZlcnNpb25zQnVuZGxlc1tdLmVrc0QubWFuaWZlc3RVcmwKLnNwZWMudmVyc2lvbnNCdW5kbGVzW10uZXRjZGFkbUJvb3RzdHJhcAouc3BlYy52ZXJzaW9uc0J1bmRsZXNbXS5ldGNkYWRtQ29udHJvbGxlcgouc3BlYy52ZXJzaW9uc0J1bmRsZXNbXS5mbHV4Ci5zcGVjLnZlcnNpb25zQnVuZGxlc1tdLmhhcHJveHkKLnNwZWMudmVyc2lvbnNCdW5kbGVzW10ua2luZG5ldGQKLnNwZWMudmVyc2lvbnNCdW5kbGVzW10ubnV0YW5peAouc3BlYy52ZXJzaW9uc0J1bmRsZXNbXS5wYWNrYWdlQ29udHJvbGxlcgouc3BlYy52ZXJzaW9uc0J1bmRsZXNbXS5zbm93Ci5zcGVjLnZlcnNpb25zQnVuZGxlc1tdLnRpbmtlcmJlbGwKLnNwZWMudmVyc2lvbnNCdW5kbGVzW10udXBncmFkZXIKLnNwZWMudmVyc2lvbnNCdW5kbGVzW10udlNwaGVyZQ=="
+	Excludes = "LnNwZWMudmVyc2lvbnNCdW5kbGVzW10uYXp1cmVzdGFja2hjaQouc3BlYy52ZXJzaW9uc0J1bmRsZXNbXS5ib290c3RyYXAKLnNwZWMudmVyc2lvbnNCdW5kbGVzW10uYm90dGxlcm9ja2V0Qm9vdHN0cmFwQ29udGFpbmVycwouc3BlYy52ZXJzaW9uc0J1bmRsZXNbXS5ib3R0bGVyb2NrZXRIb3N0Q29udGFpbmVycwouc3BlYy52ZXJzaW9uc0J1bmRsZXNbXS5jZXJ0TWFuYWdlcgouc3BlYy52ZXJzaW9uc0J1bmRsZXNbXS5jaWxpdW0KLnNwZWMudmVyc2lvbnNCdW5kbGVzW10uY2xvdWRTdGFjawouc3BlYy52ZXJzaW9uc0J1bmRsZXNbXS5jbHVzdGVyQVBJCi5zcGVjLnZlcnNpb25zQnVuZGxlc1tdLmNvbnRyb2xQbGFuZQouc3BlYy52ZXJzaW9uc0J1bmRsZXNbXS5kb2NrZXIKLnNwZWMudmVyc2lvbnNCdW5kbGVzW10uZWtzYQouc3BlYy52ZXJzaW9uc0J1bmRsZXNbXS5la3NELmNvbXBvbmVudHMKLnNwZWMudmVyc2lvbnNCdW5kbGVzW10uZWtzRC5tYW5pZmVzdFVybAouc3BlYy52ZXJzaW9uc0J1bmRsZXNbXS5ldGNkYWRtQm9vdHN0cmFwCi5zcGVjLnZlcnNpb25zQnVuZGxlc1tdLmV0Y2RhZG1Db250cm9sbGVyCi5zcGVjLnZlcnNpb25zQnVuZGxlc1tdLmZsdXgKLnNwZWMudmVyc2lvbnNCdW5kbGVzW10uaGFwcm94eQouc3BlYy52ZXJzaW9uc0J1bmRsZXNbXS5oYXJ2ZXN0ZXIKLnNwZWMudmVyc2lvbnNCdW5kbGVzW10ua2luZG5ldGQKLnNwZWMudmVyc2lvbnNCdW5kbGVzW10ubnV0YW5peAouc3BlYy52ZXJzaW9uc0J1bmRsZXNbXS5vcGVuc3RhY2sKLnNwZWMudmVyc2lvbnNCdW5kbGVzW10ucGFja2FnZUNvbnRyb2xsZXIKLnNwZWMudmVyc2lvbnNCdW5kbGVzW10ucHJveG1veAouc3BlYy52ZXJzaW9uc0J1bmRsZXNbXS5zbm93Ci5zcGVjLnZlcnNpb25zQnVuZGxlc1tdLnRpbmtlcmJlbGwKLnNwZWMudmVyc2lvbnNCdW5kbGVzW10udXBncmFkZXIKLnNwZWMudmVyc2lvbnNCdW5kbGVzW10udlNwaGVyZQ=="
 	// EKSDistroExcludes is a base64-encoded, newline-delimited list of JSON/YAML paths to remove
 	// from the EKS Distro manifest prior to computing the digest. You can add or remove
 	// fields depending on your signing requirements.
@@ -164,6 +199,10 @@ var SupportedProviders = []string{
 	DockerProviderName,
 	NutanixProviderName,
 	SnowProviderName,
+	ProxmoxProviderName,
+	OpenStackProviderName,
+	AzureStackHCIProviderName,
+	HarvesterProviderName,
 }
 
 // AlwaysExcludedFields contains a list of string that need to be excluded while getting a digest of bundle to check the signature validation.
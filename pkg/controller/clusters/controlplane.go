@@ -11,6 +11,7 @@ import (
 	"k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/klog/v2"
+	bootstrapv1beta2 "sigs.k8s.io/cluster-api/api/bootstrap/kubeadm/v1beta2"
 	controlplanev1beta2 "sigs.k8s.io/cluster-api/api/controlplane/kubeadm/v1beta2"
 	clusterv1beta2 "sigs.k8s.io/cluster-api/api/core/v1beta2"
 	"sigs.k8s.io/cluster-api/util/annotations"
@@ -104,6 +105,8 @@ func ReconcileControlPlane(ctx context.Context, log logr.Logger, c client.Client
 		cp.Cluster.Spec.ControlPlaneEndpoint = currentCPEndpoint
 	}
 
+	preserveCurrentKCPUsers(cp.KubeadmControlPlane, kcp)
+
 	if cp.EtcdCluster == nil {
 		// For stacked etcd, we don't need orchestration, apply directly
 		return controller.Result{}, applyAllControlPlaneObjects(ctx, c, cp)
@@ -236,6 +239,26 @@ func reconcileControlPlaneNodeChanges(ctx context.Context, log logr.Logger, c cl
 	return controller.Result{}, nil
 }
 
+// preserveCurrentKCPUsers keeps the users of the current KubeadmControlPlane in the desired one when
+// they are the only change in the kubeadm config spec. Changing the users in the KCP triggers a rollout
+// of all control plane nodes, so instead existing nodes are patched in place and the new users are only
+// added to the KCP together with another change that already requires new machines.
+// Bottlerocket nodes can't be patched, so their users are always applied.
+func preserveCurrentKCPUsers(desiredKCP, currentKCP *controlplanev1beta2.KubeadmControlPlane) {
+	if desiredKCP.Spec.KubeadmConfigSpec.Format == bootstrapv1beta2.Bottlerocket {
+		return
+	}
+
+	desiredSpec := desiredKCP.Spec.KubeadmConfigSpec.DeepCopy()
+	desiredSpec.Users = nil
+	// The external etcd endpoints are also ignored since they are preserved separately.
+	desiredSpec.ClusterConfiguration.Etcd.External.Endpoints = nil
+
+	if equality.Semantic.DeepDerivative(*desiredSpec, currentKCP.Spec.KubeadmConfigSpec) {
+		desiredKCP.Spec.KubeadmConfigSpec.Users = currentKCP.Spec.KubeadmConfigSpec.Users
+	}
+}
+
 // isPlaceholderEndpoint checks if endpoints are placeholder values that we set by default.
 func isPlaceholderEndpoint(endpoints []string) bool {
 	if len(endpoints) == 1 && endpoints[0] == "https://placeholder:2379" {
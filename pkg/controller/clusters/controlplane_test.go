@@ -73,6 +73,57 @@ func TestReconcileControlPlaneUpdateAfterClusterCreation(t *testing.T) {
 	api.ShouldEventuallyExist(ctx, cp.ProviderCluster)
 }
 
+func TestReconcileControlPlaneUsersOnlyChangePreservesCurrentUsers(t *testing.T) {
+	g := NewWithT(t)
+	c := env.Client()
+	api := envtest.NewAPIExpecter(t, c)
+	ctx := context.Background()
+	ns := env.CreateNamespaceForTest(ctx, t)
+	log := test.NewNullLogger()
+	cp := controlPlaneStackedEtcd(ns)
+	originalUsers := []bootstrapv1beta2.User{
+		{Name: "capv", SSHAuthorizedKeys: []string{"ssh-rsa AAAA"}},
+	}
+	cp.KubeadmControlPlane.Spec.KubeadmConfigSpec.Users = originalUsers
+
+	envtest.CreateObjs(ctx, t, c, cp.AllObjects()...)
+
+	cp.KubeadmControlPlane.Spec.KubeadmConfigSpec.Users = []bootstrapv1beta2.User{
+		{Name: "capv", SSHAuthorizedKeys: []string{"ssh-rsa AAAA", "ssh-rsa BBBB"}},
+	}
+
+	g.Expect(clusters.ReconcileControlPlane(ctx, log, c, cp)).To(Equal(controller.Result{}))
+	api.ShouldEventuallyMatch(ctx, cp.KubeadmControlPlane, func(g Gomega) {
+		g.Expect(cp.KubeadmControlPlane.Spec.KubeadmConfigSpec.Users).To(Equal(originalUsers))
+	})
+}
+
+func TestReconcileControlPlaneUsersChangeWithRolloutUpdatesUsers(t *testing.T) {
+	g := NewWithT(t)
+	c := env.Client()
+	api := envtest.NewAPIExpecter(t, c)
+	ctx := context.Background()
+	ns := env.CreateNamespaceForTest(ctx, t)
+	log := test.NewNullLogger()
+	cp := controlPlaneStackedEtcd(ns)
+	cp.KubeadmControlPlane.Spec.KubeadmConfigSpec.Users = []bootstrapv1beta2.User{
+		{Name: "capv", SSHAuthorizedKeys: []string{"ssh-rsa AAAA"}},
+	}
+
+	envtest.CreateObjs(ctx, t, c, cp.AllObjects()...)
+
+	newUsers := []bootstrapv1beta2.User{
+		{Name: "capv", SSHAuthorizedKeys: []string{"ssh-rsa BBBB"}},
+	}
+	cp.KubeadmControlPlane.Spec.KubeadmConfigSpec.Users = newUsers
+	cp.KubeadmControlPlane.Spec.KubeadmConfigSpec.PreKubeadmCommands = []string{"echo hello"}
+
+	g.Expect(clusters.ReconcileControlPlane(ctx, log, c, cp)).To(Equal(controller.Result{}))
+	api.ShouldEventuallyMatch(ctx, cp.KubeadmControlPlane, func(g Gomega) {
+		g.Expect(cp.KubeadmControlPlane.Spec.KubeadmConfigSpec.Users).To(Equal(newUsers))
+	})
+}
+
 func TestReconcileControlPlaneExternalEtcdNewCluster(t *testing.T) {
 	g := NewWithT(t)
 	c := env.Client()
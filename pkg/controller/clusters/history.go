@@ -0,0 +1,267 @@
+package clusters
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	v1beta1conditions "sigs.k8s.io/cluster-api/util/deprecated/v1beta1/conditions"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+)
+
+// autoscaledNodeGroupCount is the count tracked for worker node groups managed by the cluster autoscaler,
+// since their size is not driven by the cluster spec.
+const autoscaledNodeGroupCount = -1
+
+var packageListGVK = schema.GroupVersionKind{
+	Group:   "packages.eks.amazonaws.com",
+	Version: "v1alpha1",
+	Kind:    "PackageList",
+}
+
+// UpdateClusterHistory records in the cluster's ClusterHistory the lifecycle events that happened since
+// the last time it was updated, creating it if it doesn't exist. Events are only recorded once the cluster
+// is ready, so upgrades and scaling operations show up in the timeline when they are completed.
+// previousStatus is the status of the cluster before the reconciliation, nil if unknown. The history is only
+// updated when the cluster spec hasn't been observed yet or the status changed, so unchanged clusters don't
+// cost any API call. Paused and frozen clusters are skipped. The spec the cluster is ready with, and the one
+// it had before the last EKS Anywhere version upgrade, are kept to roll back upgrades.
+func UpdateClusterHistory(ctx context.Context, client client.Client, cluster *anywherev1.Cluster, previousStatus *anywherev1.ClusterStatus) error {
+	if !cluster.DeletionTimestamp.IsZero() || cluster.IsReconcilePaused() || cluster.IsFrozen() ||
+		!v1beta1conditions.IsTrue(cluster, anywherev1.ReadyCondition) {
+		return nil
+	}
+
+	if cluster.Status.ObservedGeneration == cluster.Generation && equality.Semantic.DeepEqual(previousStatus, &cluster.Status) {
+		return nil
+	}
+
+	history := &anywherev1.ClusterHistory{}
+	err := client.Get(ctx, types.NamespacedName{Name: cluster.Name, Namespace: cluster.Namespace}, history)
+	notFound := apierrors.IsNotFound(err)
+	if err != nil && !notFound {
+		return errors.Wrap(err, "reading cluster history")
+	}
+
+	packages, err := installedPackages(ctx, client, cluster)
+	if err != nil {
+		return err
+	}
+
+	observed := observedClusterState(cluster, packages)
+	events := clusterHistoryEvents(history.Status.Observed, observed, cluster, packages)
+	if !notFound && len(events) == 0 && equality.Semantic.DeepEqual(history.Status.Observed, observed) {
+		return nil
+	}
+
+	if hasEvent(events, anywherev1.EksaVersionUpgradedEvent) {
+		history.Status.PreviousSpec = history.Status.Observed.Spec
+	}
+
+	history.AddEvents(events...)
+	history.Status.Observed = observed
+
+	if notFound {
+		history.Name = cluster.Name
+		history.Namespace = cluster.Namespace
+		if err := controllerutil.SetOwnerReference(cluster, history, client.Scheme()); err != nil {
+			return errors.Wrap(err, "setting cluster history owner reference")
+		}
+		if err := client.Create(ctx, history); err != nil {
+			return errors.Wrap(err, "creating cluster history")
+		}
+		return nil
+	}
+
+	if err := client.Update(ctx, history); err != nil {
+		return errors.Wrap(err, "updating cluster history")
+	}
+
+	return nil
+}
+
+// installedPackages returns the curated packages installed in the cluster, indexed by name.
+// The packages API is optional, so no packages are returned when it's not installed.
+func installedPackages(ctx context.Context, c client.Client, cluster *anywherev1.Cluster) (map[string]metav1.Time, error) {
+	list := &unstructured.UnstructuredList{}
+	list.SetGroupVersionKind(packageListGVK)
+	if err := c.List(ctx, list, client.InNamespace("eksa-packages-"+cluster.Name)); err != nil {
+		if meta.IsNoMatchError(err) || apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(err, "listing curated packages")
+	}
+
+	packages := make(map[string]metav1.Time, len(list.Items))
+	for _, p := range list.Items {
+		packages[p.GetName()] = p.GetCreationTimestamp()
+	}
+
+	return packages, nil
+}
+
+func observedClusterState(cluster *anywherev1.Cluster, packages map[string]metav1.Time) *anywherev1.ClusterHistoryObservedState {
+	observed := &anywherev1.ClusterHistoryObservedState{
+		KubernetesVersion: cluster.Spec.KubernetesVersion,
+		WorkerNodeGroups:  make(map[string]int, len(cluster.Spec.WorkerNodeGroupConfigurations)),
+		Spec:              cluster.Spec.DeepCopy(),
+	}
+
+	if cluster.Spec.EksaVersion != nil {
+		observed.EksaVersion = string(*cluster.Spec.EksaVersion)
+	}
+
+	for _, w := range cluster.Spec.WorkerNodeGroupConfigurations {
+		count := autoscaledNodeGroupCount
+		if w.AutoScalingConfiguration == nil && w.Count != nil {
+			count = *w.Count
+		}
+		observed.WorkerNodeGroups[w.Name] = count
+	}
+
+	for name := range packages {
+		observed.Packages = append(observed.Packages, name)
+	}
+	sort.Strings(observed.Packages)
+
+	return observed
+}
+
+func clusterHistoryEvents(previous, current *anywherev1.ClusterHistoryObservedState, cluster *anywherev1.Cluster, packages map[string]metav1.Time) []anywherev1.ClusterHistoryEvent {
+	now := metav1.Now()
+	if previous == nil {
+		events := []anywherev1.ClusterHistoryEvent{{
+			Type:    anywherev1.ClusterCreatedEvent,
+			Time:    cluster.CreationTimestamp,
+			To:      string(current.KubernetesVersion),
+			Message: fmt.Sprintf("Cluster created with Kubernetes version %s", current.KubernetesVersion),
+		}}
+		return append(events, packageEvents(nil, current.Packages, packages)...)
+	}
+
+	var events []anywherev1.ClusterHistoryEvent
+	if previous.KubernetesVersion != current.KubernetesVersion {
+		events = append(events, anywherev1.ClusterHistoryEvent{
+			Type:    anywherev1.KubernetesVersionUpgradedEvent,
+			Time:    now,
+			From:    string(previous.KubernetesVersion),
+			To:      string(current.KubernetesVersion),
+			Message: fmt.Sprintf("Kubernetes version upgraded from %s to %s", previous.KubernetesVersion, current.KubernetesVersion),
+		})
+	}
+
+	if previous.EksaVersion != current.EksaVersion && previous.EksaVersion != "" {
+		events = append(events, anywherev1.ClusterHistoryEvent{
+			Type:    anywherev1.EksaVersionUpgradedEvent,
+			Time:    now,
+			From:    previous.EksaVersion,
+			To:      current.EksaVersion,
+			Message: fmt.Sprintf("EKS Anywhere version upgraded from %s to %s", previous.EksaVersion, current.EksaVersion),
+		})
+	}
+
+	events = append(events, nodeGroupEvents(previous.WorkerNodeGroups, current.WorkerNodeGroups, now)...)
+	events = append(events, packageEvents(previous.Packages, current.Packages, packages)...)
+
+	return events
+}
+
+func nodeGroupEvents(previous, current map[string]int, now metav1.Time) []anywherev1.ClusterHistoryEvent {
+	var events []anywherev1.ClusterHistoryEvent
+	for _, name := range sortedKeys(current) {
+		count := current[name]
+		previousCount, ok := previous[name]
+		switch {
+		case !ok:
+			events = append(events, anywherev1.ClusterHistoryEvent{
+				Type:    anywherev1.NodeGroupAddedEvent,
+				Time:    now,
+				Object:  name,
+				To:      nodeGroupCount(count),
+				Message: fmt.Sprintf("Worker node group %s added with %s nodes", name, nodeGroupCount(count)),
+			})
+		case previousCount != count:
+			events = append(events, anywherev1.ClusterHistoryEvent{
+				Type:    anywherev1.NodeGroupScaledEvent,
+				Time:    now,
+				Object:  name,
+				From:    nodeGroupCount(previousCount),
+				To:      nodeGroupCount(count),
+				Message: fmt.Sprintf("Worker node group %s scaled from %s to %s nodes", name, nodeGroupCount(previousCount), nodeGroupCount(count)),
+			})
+		}
+	}
+
+	for _, name := range sortedKeys(previous) {
+		if _, ok := current[name]; !ok {
+			events = append(events, anywherev1.ClusterHistoryEvent{
+				Type:    anywherev1.NodeGroupRemovedEvent,
+				Time:    now,
+				Object:  name,
+				From:    nodeGroupCount(previous[name]),
+				Message: fmt.Sprintf("Worker node group %s removed", name),
+			})
+		}
+	}
+
+	return events
+}
+
+func packageEvents(previous, current []string, packages map[string]metav1.Time) []anywherev1.ClusterHistoryEvent {
+	installed := make(map[string]struct{}, len(previous))
+	for _, p := range previous {
+		installed[p] = struct{}{}
+	}
+
+	var events []anywherev1.ClusterHistoryEvent
+	for _, p := range current {
+		if _, ok := installed[p]; ok {
+			continue
+		}
+		events = append(events, anywherev1.ClusterHistoryEvent{
+			Type:    anywherev1.PackageInstalledEvent,
+			Time:    packages[p],
+			Object:  p,
+			Message: fmt.Sprintf("Curated package %s installed", p),
+		})
+	}
+
+	return events
+}
+
+func hasEvent(events []anywherev1.ClusterHistoryEvent, eventType anywherev1.ClusterHistoryEventType) bool {
+	for _, e := range events {
+		if e.Type == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+func nodeGroupCount(count int) string {
+	if count == autoscaledNodeGroupCount {
+		return "autoscaled"
+	}
+	return strconv.Itoa(count)
+}
+
+func sortedKeys(m map[string]int) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
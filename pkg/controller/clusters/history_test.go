@@ -0,0 +1,265 @@
+package clusters_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/aws/eks-anywhere/internal/test"
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/controller/clusters"
+)
+
+func readyClusterForHistory() *anywherev1.Cluster {
+	eksaVersion := anywherev1.EksaVersion("v0.22.0")
+	return test.Cluster(func(c *anywherev1.Cluster) {
+		c.Name = "my-cluster"
+		c.Namespace = "default"
+		c.UID = "cluster-uid"
+		c.CreationTimestamp = metav1.NewTime(time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC))
+		c.Spec.KubernetesVersion = anywherev1.Kube133
+		c.Spec.EksaVersion = &eksaVersion
+		c.Spec.WorkerNodeGroupConfigurations = []anywherev1.WorkerNodeGroupConfiguration{
+			{Name: "md-0", Count: ptrInt(3)},
+		}
+		c.Status.Conditions = []anywherev1.Condition{
+			{Type: anywherev1.ReadyCondition, Status: corev1.ConditionTrue},
+		}
+	})
+}
+
+func historyTestClient(objs ...client.Object) client.Client {
+	scheme := runtime.NewScheme()
+	_ = anywherev1.AddToScheme(scheme)
+	scheme.AddKnownTypeWithName(schema.GroupVersionKind{Group: "packages.eks.amazonaws.com", Version: "v1alpha1", Kind: "Package"}, &unstructured.Unstructured{})
+	scheme.AddKnownTypeWithName(schema.GroupVersionKind{Group: "packages.eks.amazonaws.com", Version: "v1alpha1", Kind: "PackageList"}, &unstructured.UnstructuredList{})
+	return fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).Build()
+}
+
+func curatedPackage(name, namespace string, created time.Time) *unstructured.Unstructured {
+	p := &unstructured.Unstructured{}
+	p.SetGroupVersionKind(schema.GroupVersionKind{Group: "packages.eks.amazonaws.com", Version: "v1alpha1", Kind: "Package"})
+	p.SetName(name)
+	p.SetNamespace(namespace)
+	p.SetCreationTimestamp(metav1.NewTime(created))
+	return p
+}
+
+func getClusterHistory(ctx context.Context, t *testing.T, c client.Client, cluster *anywherev1.Cluster) *anywherev1.ClusterHistory {
+	t.Helper()
+	history := &anywherev1.ClusterHistory{}
+	NewWithT(t).Expect(c.Get(ctx, types.NamespacedName{Name: cluster.Name, Namespace: cluster.Namespace}, history)).To(Succeed())
+	return history
+}
+
+func ptrInt(i int) *int {
+	return &i
+}
+
+func TestUpdateClusterHistoryCreated(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	cluster := readyClusterForHistory()
+	c := historyTestClient(cluster)
+
+	g.Expect(clusters.UpdateClusterHistory(ctx, c, cluster, nil)).To(Succeed())
+
+	history := getClusterHistory(ctx, t, c, cluster)
+	g.Expect(history.OwnerReferences).To(HaveLen(1))
+	g.Expect(history.OwnerReferences[0].Name).To(Equal(cluster.Name))
+	g.Expect(history.Status.Events).To(HaveLen(1))
+	g.Expect(history.Status.Events[0].Type).To(Equal(anywherev1.ClusterCreatedEvent))
+	g.Expect(history.Status.Events[0].Time.Time).To(BeTemporally("==", cluster.CreationTimestamp.Time))
+	g.Expect(history.Status.Events[0].To).To(Equal("1.33"))
+	g.Expect(history.Status.Observed.WorkerNodeGroups).To(Equal(map[string]int{"md-0": 3}))
+}
+
+func TestUpdateClusterHistoryNotReady(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	cluster := readyClusterForHistory()
+	cluster.Status.Conditions = nil
+	c := historyTestClient(cluster)
+
+	g.Expect(clusters.UpdateClusterHistory(ctx, c, cluster, nil)).To(Succeed())
+
+	history := &anywherev1.ClusterHistory{}
+	err := c.Get(ctx, types.NamespacedName{Name: cluster.Name, Namespace: cluster.Namespace}, history)
+	g.Expect(err).To(MatchError(ContainSubstring("not found")))
+}
+
+func TestUpdateClusterHistoryUpgradeAndScale(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	cluster := readyClusterForHistory()
+	c := historyTestClient(cluster)
+	g.Expect(clusters.UpdateClusterHistory(ctx, c, cluster, nil)).To(Succeed())
+
+	eksaVersion := anywherev1.EksaVersion("v0.23.0")
+	cluster.Spec.EksaVersion = &eksaVersion
+	cluster.Spec.KubernetesVersion = anywherev1.Kube134
+	cluster.Spec.WorkerNodeGroupConfigurations = []anywherev1.WorkerNodeGroupConfiguration{
+		{Name: "md-0", Count: ptrInt(5)},
+		{Name: "md-1", AutoScalingConfiguration: &anywherev1.AutoScalingConfiguration{MinCount: 1, MaxCount: 3}},
+	}
+	g.Expect(clusters.UpdateClusterHistory(ctx, c, cluster, nil)).To(Succeed())
+
+	history := getClusterHistory(ctx, t, c, cluster)
+	g.Expect(history.Status.Events).To(HaveLen(5))
+	events := history.Status.Events[1:]
+	g.Expect(events[0].Type).To(Equal(anywherev1.KubernetesVersionUpgradedEvent))
+	g.Expect(events[0].From).To(Equal("1.33"))
+	g.Expect(events[0].To).To(Equal("1.34"))
+	g.Expect(events[1].Type).To(Equal(anywherev1.EksaVersionUpgradedEvent))
+	g.Expect(events[1].Message).To(Equal("EKS Anywhere version upgraded from v0.22.0 to v0.23.0"))
+	g.Expect(events[2].Type).To(Equal(anywherev1.NodeGroupScaledEvent))
+	g.Expect(events[2].Message).To(Equal("Worker node group md-0 scaled from 3 to 5 nodes"))
+	g.Expect(events[3].Type).To(Equal(anywherev1.NodeGroupAddedEvent))
+	g.Expect(events[3].Object).To(Equal("md-1"))
+	g.Expect(events[3].To).To(Equal("autoscaled"))
+
+	cluster.Spec.WorkerNodeGroupConfigurations = cluster.Spec.WorkerNodeGroupConfigurations[:1]
+	g.Expect(clusters.UpdateClusterHistory(ctx, c, cluster, nil)).To(Succeed())
+
+	history = getClusterHistory(ctx, t, c, cluster)
+	g.Expect(history.Status.Events).To(HaveLen(6))
+	g.Expect(history.Status.Events[5].Type).To(Equal(anywherev1.NodeGroupRemovedEvent))
+	g.Expect(history.Status.Events[5].Object).To(Equal("md-1"))
+}
+
+func TestUpdateClusterHistoryRecordsSpecs(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	cluster := readyClusterForHistory()
+	c := historyTestClient(cluster)
+	g.Expect(clusters.UpdateClusterHistory(ctx, c, cluster, nil)).To(Succeed())
+
+	cluster.Spec.WorkerNodeGroupConfigurations[0].Labels = map[string]string{"team": "a"}
+	g.Expect(clusters.UpdateClusterHistory(ctx, c, cluster, nil)).To(Succeed())
+
+	history := getClusterHistory(ctx, t, c, cluster)
+	g.Expect(history.Status.Events).To(HaveLen(1))
+	g.Expect(history.Status.Observed.Spec.WorkerNodeGroupConfigurations[0].Labels).To(HaveKeyWithValue("team", "a"))
+	g.Expect(history.Status.PreviousSpec).To(BeNil())
+
+	beforeUpgrade := cluster.Spec.DeepCopy()
+	eksaVersion := anywherev1.EksaVersion("v0.23.0")
+	cluster.Spec.EksaVersion = &eksaVersion
+	g.Expect(clusters.UpdateClusterHistory(ctx, c, cluster, nil)).To(Succeed())
+
+	history = getClusterHistory(ctx, t, c, cluster)
+	g.Expect(*history.Status.Observed.Spec.EksaVersion).To(Equal(eksaVersion))
+	g.Expect(history.Status.PreviousSpec).To(Equal(beforeUpgrade))
+}
+
+func TestUpdateClusterHistoryNoChanges(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	cluster := readyClusterForHistory()
+	c := historyTestClient(cluster)
+	g.Expect(clusters.UpdateClusterHistory(ctx, c, cluster, nil)).To(Succeed())
+	before := getClusterHistory(ctx, t, c, cluster)
+
+	g.Expect(clusters.UpdateClusterHistory(ctx, c, cluster, nil)).To(Succeed())
+
+	after := getClusterHistory(ctx, t, c, cluster)
+	g.Expect(after.ResourceVersion).To(Equal(before.ResourceVersion))
+	g.Expect(after.Status.Events).To(HaveLen(1))
+}
+
+func TestUpdateClusterHistoryPackageInstalled(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	cluster := readyClusterForHistory()
+	c := historyTestClient(cluster)
+	g.Expect(clusters.UpdateClusterHistory(ctx, c, cluster, nil)).To(Succeed())
+
+	installed := time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
+	g.Expect(c.Create(ctx, curatedPackage("harbor", "eksa-packages-my-cluster", installed))).To(Succeed())
+	g.Expect(clusters.UpdateClusterHistory(ctx, c, cluster, nil)).To(Succeed())
+
+	history := getClusterHistory(ctx, t, c, cluster)
+	g.Expect(history.Status.Events).To(HaveLen(2))
+	g.Expect(history.Status.Events[1].Type).To(Equal(anywherev1.PackageInstalledEvent))
+	g.Expect(history.Status.Events[1].Object).To(Equal("harbor"))
+	g.Expect(history.Status.Observed.Packages).To(ConsistOf("harbor"))
+}
+
+func TestUpdateClusterHistoryWithoutPackagesAPI(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	cluster := readyClusterForHistory()
+	scheme := runtime.NewScheme()
+	g.Expect(anywherev1.AddToScheme(scheme)).To(Succeed())
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(cluster).Build()
+
+	g.Expect(clusters.UpdateClusterHistory(ctx, c, cluster, nil)).To(Succeed())
+
+	history := getClusterHistory(ctx, t, c, cluster)
+	g.Expect(history.Status.Events).To(HaveLen(1))
+	g.Expect(history.Status.Observed.Packages).To(BeEmpty())
+}
+
+func TestUpdateClusterHistoryClusterUnchanged(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	cluster := readyClusterForHistory()
+	c := historyTestClient(cluster)
+
+	g.Expect(clusters.UpdateClusterHistory(ctx, c, cluster, cluster.Status.DeepCopy())).To(Succeed())
+
+	history := &anywherev1.ClusterHistory{}
+	err := c.Get(ctx, types.NamespacedName{Name: cluster.Name, Namespace: cluster.Namespace}, history)
+	g.Expect(err).To(MatchError(ContainSubstring("not found")))
+
+	cluster.Generation = 2
+	cluster.Status.ObservedGeneration = 1
+	g.Expect(clusters.UpdateClusterHistory(ctx, c, cluster, cluster.Status.DeepCopy())).To(Succeed())
+
+	history = getClusterHistory(ctx, t, c, cluster)
+	g.Expect(history.Status.Events).To(HaveLen(1))
+}
+
+func TestUpdateClusterHistoryPausedOrFrozen(t *testing.T) {
+	tests := []struct {
+		name   string
+		update func(*anywherev1.Cluster)
+	}{
+		{
+			name:   "paused",
+			update: func(c *anywherev1.Cluster) { c.PauseReconcile() },
+		},
+		{
+			name: "frozen",
+			update: func(c *anywherev1.Cluster) {
+				c.Annotations = map[string]string{anywherev1.FrozenAnnotation: "manual etcd repair"}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			ctx := context.Background()
+			cluster := readyClusterForHistory()
+			tt.update(cluster)
+			c := historyTestClient(cluster)
+
+			g.Expect(clusters.UpdateClusterHistory(ctx, c, cluster, nil)).To(Succeed())
+
+			history := &anywherev1.ClusterHistory{}
+			err := c.Get(ctx, types.NamespacedName{Name: cluster.Name, Namespace: cluster.Namespace}, history)
+			g.Expect(err).To(MatchError(ContainSubstring("not found")))
+		})
+	}
+}
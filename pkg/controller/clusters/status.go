@@ -259,10 +259,8 @@ func updateWorkersReadyCondition(cluster *anywherev1.Cluster, machineDeployments
 		}
 
 		// Skip updating the replicas for the machine deployments which have autoscaling configuration annotation
-		if md.ObjectMeta.Annotations != nil {
-			if _, ok := md.ObjectMeta.Annotations[clusterapi.NodeGroupMinSizeAnnotation]; ok {
-				continue
-			}
+		if clusterapi.IsAutoscaled(&md) {
+			continue
 		}
 
 		if md.Status.ReadyReplicas != nil {
@@ -0,0 +1,152 @@
+package coredns
+
+import (
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/cluster"
+	"github.com/aws/eks-anywhere/pkg/registrymirror"
+	"github.com/aws/eks-anywhere/pkg/templater"
+	releasev1 "github.com/aws/eks-anywhere/release/api/v1alpha1"
+)
+
+const (
+	// AutoscalerName is the name of the cluster-proportional-autoscaler Deployment and ConfigMap
+	// that scale CoreDNS.
+	AutoscalerName = "dns-autoscaler"
+
+	// NodeLocalDNSName is the name of the NodeLocal DNSCache DaemonSet and ConfigMap.
+	NodeLocalDNSName = "node-local-dns"
+
+	// KubeDNSServiceName is the name of the Service in front of CoreDNS.
+	KubeDNSServiceName = "kube-dns"
+
+	nodeLocalDNSUpstreamService = "kube-dns-upstream"
+	defaultNodeLocalDNSIP       = "169.254.20.10"
+)
+
+var (
+	//go:embed config/dns-autoscaler.yaml
+	autoscalerTemplate string
+
+	//go:embed config/node-local-dns.yaml
+	nodeLocalDNSTemplate string
+
+	//go:embed config/node-local-dns-Corefile
+	nodeLocalDNSCorefileTemplate string
+)
+
+// linearParams are the parameters of the linear mode of the cluster-proportional-autoscaler.
+type linearParams struct {
+	CoresPerReplica           int  `json:"coresPerReplica"`
+	NodesPerReplica           int  `json:"nodesPerReplica"`
+	Min                       int  `json:"min"`
+	Max                       int  `json:"max,omitempty"`
+	PreventSinglePointFailure bool `json:"preventSinglePointFailure"`
+	IncludeUnschedulableNodes bool `json:"includeUnschedulableNodes"`
+}
+
+// GenerateAutoscalerManifest generates the manifest of the cluster-proportional-autoscaler that
+// scales the CoreDNS Deployment with the same parameters the controller uses.
+func GenerateAutoscalerManifest(spec *cluster.Spec) ([]byte, error) {
+	bundle, err := dnsBundle(spec)
+	if err != nil {
+		return nil, err
+	}
+
+	autoscaling := spec.Cluster.Spec.DNS.Autoscaling
+	params := linearParams{
+		CoresPerReplica:           valueOrDefault(autoscaling.CoresPerReplica, defaultCoresPerReplica),
+		NodesPerReplica:           valueOrDefault(autoscaling.NodesPerReplica, defaultNodesPerReplica),
+		Min:                       valueOrDefault(autoscaling.MinReplicas, defaultMinReplicas),
+		Max:                       autoscaling.MaxReplicas,
+		PreventSinglePointFailure: true,
+	}
+	linear, err := json.Marshal(params)
+	if err != nil {
+		return nil, fmt.Errorf("generating dns autoscaler parameters: %v", err)
+	}
+
+	values := map[string]interface{}{
+		"name":      AutoscalerName,
+		"namespace": Namespace,
+		"target":    Name,
+		"linear":    string(linear),
+		"image":     registrymirror.FromCluster(spec.Cluster).ReplaceRegistry(bundle.ClusterProportionalAutoscaler.VersionedImage()),
+	}
+
+	manifest, err := templater.Execute(autoscalerTemplate, values)
+	if err != nil {
+		return nil, fmt.Errorf("generating dns autoscaler manifest: %v", err)
+	}
+
+	return manifest, nil
+}
+
+// GenerateNodeLocalDNSManifest generates the manifest of the NodeLocal DNSCache for the cluster.
+// The cache listens on its local IP and on the IP of the kube-dns Service, so the pods use it
+// without changing the kubelet configuration, and forwards every query it can't answer to
+// CoreDNS, so the stub domains and upstream nameservers of the DNS configuration still apply.
+func GenerateNodeLocalDNSManifest(spec *cluster.Spec, kubeDNSIP string) ([]byte, error) {
+	bundle, err := dnsBundle(spec)
+	if err != nil {
+		return nil, err
+	}
+
+	localIP := NodeLocalDNSIP(spec.Cluster.Spec.DNS.NodeLocalDNSCache)
+	corefile, err := templater.Execute(nodeLocalDNSCorefileTemplate, map[string]interface{}{
+		"clusterDomain": clusterDomain,
+		"localIP":       localIP,
+		"dnsServer":     kubeDNSIP,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("generating node-local-dns Corefile: %v", err)
+	}
+
+	values := map[string]interface{}{
+		"name":            NodeLocalDNSName,
+		"namespace":       Namespace,
+		"upstreamService": nodeLocalDNSUpstreamService,
+		"corefile":        strings.TrimRight(string(corefile), "\n"),
+		"localIP":         localIP,
+		"dnsServer":       kubeDNSIP,
+		"image":           registrymirror.FromCluster(spec.Cluster).ReplaceRegistry(bundle.NodeLocalDNS.VersionedImage()),
+	}
+
+	manifest, err := templater.Execute(nodeLocalDNSTemplate, values)
+	if err != nil {
+		return nil, fmt.Errorf("generating node-local-dns manifest: %v", err)
+	}
+
+	return manifest, nil
+}
+
+// NodeLocalDNSIP returns the link-local IP the NodeLocal DNSCache listens on.
+func NodeLocalDNSIP(config *anywherev1.NodeLocalDNSCache) string {
+	if config == nil || config.LocalIP == "" {
+		return defaultNodeLocalDNSIP
+	}
+
+	return config.LocalIP
+}
+
+func dnsBundle(spec *cluster.Spec) (*releasev1.DNSBundle, error) {
+	bundle := spec.RootVersionsBundle()
+	if bundle == nil || bundle.DNS == nil {
+		return nil, errors.New("the EKS Anywhere bundle for the cluster doesn't include the DNS add-ons, please upgrade to a release that supports them")
+	}
+
+	return bundle.DNS, nil
+}
+
+func valueOrDefault(value, defaultValue int) int {
+	if value == 0 {
+		return defaultValue
+	}
+
+	return value
+}
@@ -0,0 +1,78 @@
+package coredns_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/aws/eks-anywhere/internal/test"
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/cluster"
+	"github.com/aws/eks-anywhere/pkg/coredns"
+	releasev1 "github.com/aws/eks-anywhere/release/api/v1alpha1"
+)
+
+func dnsBundle() *releasev1.DNSBundle {
+	return &releasev1.DNSBundle{
+		ClusterProportionalAutoscaler: releasev1.Image{
+			URI: "public.ecr.aws/eks-anywhere/kubernetes-sigs/cluster-proportional-autoscaler:v1.9.0",
+		},
+		NodeLocalDNS: releasev1.Image{
+			URI: "public.ecr.aws/eks-anywhere/kubernetes/dns/k8s-dns-node-cache:1.25.0",
+		},
+	}
+}
+
+func dnsSpec(dns *anywherev1.CoreDNSConfiguration) *cluster.Spec {
+	return test.NewClusterSpec(func(s *cluster.Spec) {
+		s.Cluster.Spec.DNS = dns
+		s.VersionsBundles["1.19"].DNS = dnsBundle()
+	})
+}
+
+func TestGenerateAutoscalerManifest(t *testing.T) {
+	g := NewWithT(t)
+	spec := dnsSpec(&anywherev1.CoreDNSConfiguration{
+		Autoscaling: &anywherev1.DNSAutoscaling{
+			MaxReplicas:     8,
+			NodesPerReplica: 4,
+			Autoscaler:      anywherev1.ClusterProportionalDNSAutoscaler,
+		},
+	})
+
+	manifest, err := coredns.GenerateAutoscalerManifest(spec)
+	g.Expect(err).NotTo(HaveOccurred())
+	test.AssertContentToFile(t, string(manifest), "testdata/expected_results_dns_autoscaler.yaml")
+}
+
+func TestGenerateNodeLocalDNSManifestRegistryMirror(t *testing.T) {
+	g := NewWithT(t)
+	spec := dnsSpec(&anywherev1.CoreDNSConfiguration{
+		NodeLocalDNSCache: &anywherev1.NodeLocalDNSCache{},
+	})
+	spec.Cluster.Spec.RegistryMirrorConfiguration = &anywherev1.RegistryMirrorConfiguration{
+		Endpoint: "1.2.3.4",
+		Port:     "443",
+	}
+
+	manifest, err := coredns.GenerateNodeLocalDNSManifest(spec, "10.96.0.10")
+	g.Expect(err).NotTo(HaveOccurred())
+	test.AssertContentToFile(t, string(manifest), "testdata/expected_results_node_local_dns_mirror.yaml")
+}
+
+func TestGenerateNodeLocalDNSManifestMissingBundle(t *testing.T) {
+	g := NewWithT(t)
+	spec := dnsSpec(&anywherev1.CoreDNSConfiguration{
+		NodeLocalDNSCache: &anywherev1.NodeLocalDNSCache{},
+	})
+	spec.VersionsBundles["1.19"].DNS = nil
+
+	_, err := coredns.GenerateNodeLocalDNSManifest(spec, "10.96.0.10")
+	g.Expect(err).To(MatchError(ContainSubstring("doesn't include the DNS add-ons")))
+}
+
+func TestNodeLocalDNSIP(t *testing.T) {
+	g := NewWithT(t)
+	g.Expect(coredns.NodeLocalDNSIP(nil)).To(Equal("169.254.20.10"))
+	g.Expect(coredns.NodeLocalDNSIP(&anywherev1.NodeLocalDNSCache{LocalIP: "169.254.25.10"})).To(Equal("169.254.25.10"))
+}
@@ -0,0 +1,87 @@
+// Package coredns manages the CoreDNS Corefile and replicas of EKS Anywhere clusters, so the
+// DNS configuration in the cluster spec survives the upgrades that reset the coredns ConfigMap,
+// and the DNS add-ons deployed next to CoreDNS: its cluster-proportional-autoscaler and the
+// NodeLocal DNSCache.
+package coredns
+
+import (
+	_ "embed"
+	"fmt"
+	"math"
+	"strings"
+
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/templater"
+)
+
+const (
+	// Namespace is the namespace CoreDNS runs in.
+	Namespace = "kube-system"
+
+	// Name is the name of the CoreDNS Deployment and ConfigMap.
+	Name = "coredns"
+
+	// CorefileKey is the key of the Corefile in the CoreDNS ConfigMap.
+	CorefileKey = "Corefile"
+
+	clusterDomain          = "cluster.local"
+	resolvConfUpstream     = "/etc/resolv.conf"
+	defaultMinReplicas     = 2
+	defaultNodesPerReplica = 16
+	defaultCoresPerReplica = 256
+)
+
+//go:embed config/Corefile
+var corefileTemplate string
+
+// Corefile generates the CoreDNS Corefile for a DNS configuration. It keeps the plugins of the
+// default kubeadm Corefile and adds a server block for each stub domain.
+func Corefile(config *anywherev1.CoreDNSConfiguration) (string, error) {
+	upstream := resolvConfUpstream
+	if len(config.UpstreamNameservers) > 0 {
+		upstream = strings.Join(config.UpstreamNameservers, " ")
+	}
+
+	stubDomains := make([]map[string]string, 0, len(config.StubDomains))
+	for _, stub := range config.StubDomains {
+		stubDomains = append(stubDomains, map[string]string{
+			"domain":      strings.TrimSuffix(stub.Domain, "."),
+			"nameservers": strings.Join(stub.Nameservers, " "),
+		})
+	}
+
+	values := map[string]interface{}{
+		"clusterDomain":       clusterDomain,
+		"stubDomains":         stubDomains,
+		"upstreamNameservers": upstream,
+	}
+
+	corefile, err := templater.Execute(corefileTemplate, values)
+	if err != nil {
+		return "", fmt.Errorf("generating Corefile: %v", err)
+	}
+
+	return strings.TrimLeft(string(corefile), "\n"), nil
+}
+
+// Replicas returns the number of CoreDNS replicas for a cluster with the given schedulable
+// nodes and cores: the largest of nodes/nodesPerReplica and cores/coresPerReplica, rounded up
+// and bounded by the min and max replicas.
+func Replicas(config *anywherev1.DNSAutoscaling, nodes, cores int) int32 {
+	minReplicas := valueOrDefault(config.MinReplicas, defaultMinReplicas)
+	nodesPerReplica := valueOrDefault(config.NodesPerReplica, defaultNodesPerReplica)
+	coresPerReplica := valueOrDefault(config.CoresPerReplica, defaultCoresPerReplica)
+
+	replicas := int(math.Max(
+		math.Ceil(float64(nodes)/float64(nodesPerReplica)),
+		math.Ceil(float64(cores)/float64(coresPerReplica)),
+	))
+	if replicas < minReplicas {
+		replicas = minReplicas
+	}
+	if config.MaxReplicas > 0 && replicas > config.MaxReplicas {
+		replicas = config.MaxReplicas
+	}
+
+	return int32(replicas)
+}
@@ -0,0 +1,89 @@
+package coredns_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/coredns"
+)
+
+func TestCorefileDefaultUpstream(t *testing.T) {
+	g := NewWithT(t)
+	corefile, err := coredns.Corefile(&anywherev1.CoreDNSConfiguration{})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(corefile).To(HavePrefix(".:53 {"))
+	g.Expect(corefile).To(ContainSubstring("kubernetes cluster.local in-addr.arpa ip6.arpa {"))
+	g.Expect(corefile).To(ContainSubstring("forward . /etc/resolv.conf {"))
+}
+
+func TestCorefileStubDomainsAndUpstreams(t *testing.T) {
+	g := NewWithT(t)
+	config := &anywherev1.CoreDNSConfiguration{
+		StubDomains: []anywherev1.DNSStubDomain{
+			{Domain: "corp.example.com.", Nameservers: []string{"10.0.0.53", "10.0.1.53:5353"}},
+			{Domain: "lab.example.com", Nameservers: []string{"10.0.2.53"}},
+		},
+		UpstreamNameservers: []string{"1.1.1.1", "8.8.8.8"},
+	}
+
+	corefile, err := coredns.Corefile(config)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(corefile).To(HavePrefix("corp.example.com:53 {\n    errors\n    cache 30\n    forward . 10.0.0.53 10.0.1.53:5353\n}\n"))
+	g.Expect(corefile).To(ContainSubstring("lab.example.com:53 {\n    errors\n    cache 30\n    forward . 10.0.2.53\n}\n.:53 {"))
+	g.Expect(corefile).To(ContainSubstring("forward . 1.1.1.1 8.8.8.8 {"))
+	g.Expect(corefile).NotTo(ContainSubstring("/etc/resolv.conf"))
+}
+
+func TestReplicas(t *testing.T) {
+	tests := []struct {
+		name   string
+		config *anywherev1.DNSAutoscaling
+		nodes  int
+		cores  int
+		want   int32
+	}{
+		{
+			name:   "defaults small cluster",
+			config: &anywherev1.DNSAutoscaling{},
+			nodes:  3,
+			cores:  12,
+			want:   2,
+		},
+		{
+			name:   "defaults scale with nodes",
+			config: &anywherev1.DNSAutoscaling{},
+			nodes:  50,
+			cores:  200,
+			want:   4,
+		},
+		{
+			name:   "scale with cores",
+			config: &anywherev1.DNSAutoscaling{CoresPerReplica: 8},
+			nodes:  4,
+			cores:  64,
+			want:   8,
+		},
+		{
+			name:   "custom min",
+			config: &anywherev1.DNSAutoscaling{MinReplicas: 3},
+			nodes:  1,
+			cores:  2,
+			want:   3,
+		},
+		{
+			name:   "bounded by max",
+			config: &anywherev1.DNSAutoscaling{NodesPerReplica: 1, MaxReplicas: 5},
+			nodes:  10,
+			cores:  20,
+			want:   5,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(coredns.Replicas(tt.config, tt.nodes, tt.cores)).To(Equal(tt.want))
+		})
+	}
+}
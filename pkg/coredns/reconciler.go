@@ -0,0 +1,211 @@
+package coredns
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/cluster"
+	"github.com/aws/eks-anywhere/pkg/controller"
+	"github.com/aws/eks-anywhere/pkg/controller/clientutil"
+	"github.com/aws/eks-anywhere/pkg/controller/clusters"
+	"github.com/aws/eks-anywhere/pkg/controller/serverside"
+)
+
+// missingCoreDNSRequeueTime is how long to wait before checking again for the CoreDNS addon.
+const missingCoreDNSRequeueTime = time.Minute
+
+// RemoteClientRegistry gets clients for remote clusters.
+type RemoteClientRegistry interface {
+	GetClient(ctx context.Context, cluster client.ObjectKey) (client.Client, error)
+}
+
+// Reconciler keeps the CoreDNS Corefile and replicas, and the DNS add-ons, of the clusters with
+// a DNS configuration in sync with it.
+type Reconciler struct {
+	client               client.Client
+	remoteClientRegistry RemoteClientRegistry
+}
+
+// New returns a new Reconciler.
+func New(client client.Client, remoteClientRegistry RemoteClientRegistry) *Reconciler {
+	return &Reconciler{
+		client:               client,
+		remoteClientRegistry: remoteClientRegistry,
+	}
+}
+
+// Reconcile applies the Corefile generated from the DNS configuration to the coredns ConfigMap,
+// once the cluster control plane is ready, and scales the CoreDNS Deployment when autoscaling is
+// configured, either directly or through the cluster-proportional-autoscaler. CoreDNS reloads the
+// Corefile by itself, so it doesn't restart the pods. It also deploys the NodeLocal DNSCache when
+// configured and removes the DNS add-ons that aren't configured anymore. It's a no-op for clusters
+// without a DNS configuration.
+func (r *Reconciler) Reconcile(ctx context.Context, log logr.Logger, eksaCluster *anywherev1.Cluster) (controller.Result, error) {
+	config := eksaCluster.Spec.DNS
+	if config == nil {
+		return controller.Result{}, nil
+	}
+
+	result, err := clusters.CheckControlPlaneReady(ctx, r.client, log, eksaCluster)
+	if err != nil {
+		return controller.Result{}, errors.Wrap(err, "checking controlplane ready")
+	}
+	if result.Return() {
+		return result, nil
+	}
+
+	remoteClient, err := r.remoteClientRegistry.GetClient(ctx, controller.CapiClusterObjectKey(eksaCluster))
+	if err != nil {
+		return controller.Result{}, errors.Wrap(err, "getting workload cluster's client to reconcile CoreDNS")
+	}
+
+	configMap := &corev1.ConfigMap{}
+	err = remoteClient.Get(ctx, client.ObjectKey{Namespace: Namespace, Name: Name}, configMap)
+	if apierrors.IsNotFound(err) {
+		log.Info("Waiting for CoreDNS to be installed before configuring it")
+		return controller.ResultWithRequeue(missingCoreDNSRequeueTime), nil
+	}
+	if err != nil {
+		return controller.Result{}, errors.Wrap(err, "reading CoreDNS ConfigMap")
+	}
+
+	corefile, err := Corefile(config)
+	if err != nil {
+		return controller.Result{}, err
+	}
+
+	if configMap.Data[CorefileKey] != corefile {
+		log.Info("Updating CoreDNS Corefile")
+		patch := client.MergeFrom(configMap.DeepCopy())
+		if configMap.Data == nil {
+			configMap.Data = map[string]string{}
+		}
+		configMap.Data[CorefileKey] = corefile
+		if err := remoteClient.Patch(ctx, configMap, patch); err != nil {
+			return controller.Result{}, errors.Wrap(err, "updating CoreDNS Corefile")
+		}
+	}
+
+	if config.Autoscaling != nil && !config.Autoscaling.ClusterProportional() {
+		if err := reconcileReplicas(ctx, log, remoteClient, config.Autoscaling); err != nil {
+			return controller.Result{}, err
+		}
+	}
+
+	if err := r.reconcileAddons(ctx, log, remoteClient, eksaCluster); err != nil {
+		return controller.Result{}, err
+	}
+
+	return controller.Result{}, nil
+}
+
+// reconcileAddons applies the manifests of the configured DNS add-ons and deletes the workloads
+// of the ones that aren't configured.
+func (r *Reconciler) reconcileAddons(ctx context.Context, log logr.Logger, remoteClient client.Client, eksaCluster *anywherev1.Cluster) error {
+	config := eksaCluster.Spec.DNS
+	autoscaler := config.Autoscaling.ClusterProportional()
+	nodeLocalDNS := config.NodeLocalDNSCache != nil
+
+	if !autoscaler {
+		if err := deleteIfExists(ctx, remoteClient, &appsv1.Deployment{}, AutoscalerName); err != nil {
+			return errors.Wrap(err, "deleting dns autoscaler")
+		}
+	}
+	if !nodeLocalDNS {
+		if err := deleteIfExists(ctx, remoteClient, &appsv1.DaemonSet{}, NodeLocalDNSName); err != nil {
+			return errors.Wrap(err, "deleting node-local-dns")
+		}
+	}
+	if !autoscaler && !nodeLocalDNS {
+		return nil
+	}
+
+	clusterSpec, err := cluster.BuildSpec(ctx, clientutil.NewKubeClient(r.client), eksaCluster)
+	if err != nil {
+		return err
+	}
+
+	if autoscaler {
+		manifest, err := GenerateAutoscalerManifest(clusterSpec)
+		if err != nil {
+			return err
+		}
+
+		log.Info("Applying dns autoscaler manifest")
+		if err := serverside.ReconcileYaml(ctx, remoteClient, manifest); err != nil {
+			return errors.Wrap(err, "applying dns autoscaler manifest")
+		}
+	}
+
+	if nodeLocalDNS {
+		kubeDNS := &corev1.Service{}
+		if err := remoteClient.Get(ctx, client.ObjectKey{Namespace: Namespace, Name: KubeDNSServiceName}, kubeDNS); err != nil {
+			return errors.Wrap(err, "reading kube-dns Service")
+		}
+
+		manifest, err := GenerateNodeLocalDNSManifest(clusterSpec, kubeDNS.Spec.ClusterIP)
+		if err != nil {
+			return err
+		}
+
+		log.Info("Applying node-local-dns manifest", "localIP", NodeLocalDNSIP(config.NodeLocalDNSCache))
+		if err := serverside.ReconcileYaml(ctx, remoteClient, manifest); err != nil {
+			return errors.Wrap(err, "applying node-local-dns manifest")
+		}
+	}
+
+	return nil
+}
+
+func deleteIfExists(ctx context.Context, c client.Client, obj client.Object, name string) error {
+	obj.SetName(name)
+	obj.SetNamespace(Namespace)
+	if err := c.Delete(ctx, obj); err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+
+	return nil
+}
+
+func reconcileReplicas(ctx context.Context, log logr.Logger, c client.Client, autoscaling *anywherev1.DNSAutoscaling) error {
+	nodes := &corev1.NodeList{}
+	if err := c.List(ctx, nodes); err != nil {
+		return errors.Wrap(err, "listing nodes to scale CoreDNS")
+	}
+
+	schedulableNodes, cores := 0, 0
+	for _, node := range nodes.Items {
+		if node.Spec.Unschedulable {
+			continue
+		}
+		schedulableNodes++
+		cores += int(node.Status.Capacity.Cpu().Value())
+	}
+
+	deployment := &appsv1.Deployment{}
+	if err := c.Get(ctx, client.ObjectKey{Namespace: Namespace, Name: Name}, deployment); err != nil {
+		return errors.Wrap(err, "reading CoreDNS Deployment")
+	}
+
+	replicas := Replicas(autoscaling, schedulableNodes, cores)
+	if deployment.Spec.Replicas != nil && *deployment.Spec.Replicas == replicas {
+		return nil
+	}
+
+	log.Info("Scaling CoreDNS", "replicas", replicas, "nodes", schedulableNodes, "cores", cores)
+	patch := client.MergeFrom(deployment.DeepCopy())
+	deployment.Spec.Replicas = &replicas
+	if err := c.Patch(ctx, deployment, patch); err != nil {
+		return errors.Wrap(err, "scaling CoreDNS Deployment")
+	}
+
+	return nil
+}
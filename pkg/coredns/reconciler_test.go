@@ -0,0 +1,363 @@
+package coredns_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	eksdv1 "github.com/aws/eks-distro-build-tooling/release/api/v1alpha1"
+	"github.com/go-logr/logr"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	controlplanev1beta2 "sigs.k8s.io/cluster-api/api/controlplane/kubeadm/v1beta2"
+	clusterv1beta2 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/aws/eks-anywhere/internal/test"
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/controller"
+	"github.com/aws/eks-anywhere/pkg/coredns"
+	"github.com/aws/eks-anywhere/pkg/coredns/mocks"
+	releasev1 "github.com/aws/eks-anywhere/release/api/v1alpha1"
+)
+
+type reconcilerTest struct {
+	*WithT
+	ctx                  context.Context
+	remoteClientRegistry *mocks.MockRemoteClientRegistry
+	cluster              *anywherev1.Cluster
+	kcp                  *controlplanev1beta2.KubeadmControlPlane
+	configMap            *corev1.ConfigMap
+	deployment           *appsv1.Deployment
+}
+
+func newReconcilerTest(t testing.TB) *reconcilerTest {
+	ctrl := gomock.NewController(t)
+	cluster := &anywherev1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-cluster",
+			Namespace: "eksa-system",
+		},
+		Spec: anywherev1.ClusterSpec{
+			DNS: &anywherev1.CoreDNSConfiguration{
+				StubDomains: []anywherev1.DNSStubDomain{
+					{Domain: "corp.example.com", Nameservers: []string{"10.0.0.53"}},
+				},
+			},
+		},
+	}
+	kcp := test.KubeadmControlPlane(func(kcp *controlplanev1beta2.KubeadmControlPlane) {
+		kcp.Name = cluster.Name
+		kcp.Spec.Version = "test"
+		kcp.Status = controlplanev1beta2.KubeadmControlPlaneStatus{
+			Conditions: []metav1.Condition{
+				{
+					Type:               clusterv1beta2.AvailableCondition,
+					Status:             metav1.ConditionTrue,
+					LastTransitionTime: metav1.NewTime(time.Now()),
+				},
+			},
+			Version:            "test",
+			ReadyReplicas:      ptr.To(int32(1)),
+			Replicas:           ptr.To(int32(1)),
+			ObservedGeneration: 1,
+		}
+		kcp.Generation = 1
+	})
+	configMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      coredns.Name,
+			Namespace: coredns.Namespace,
+		},
+		Data: map[string]string{
+			coredns.CorefileKey: ".:53 {\n    forward . /etc/resolv.conf\n}\n",
+		},
+	}
+	deployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      coredns.Name,
+			Namespace: coredns.Namespace,
+		},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: ptr.To(int32(2)),
+		},
+	}
+
+	return &reconcilerTest{
+		WithT:                NewWithT(t),
+		ctx:                  context.Background(),
+		remoteClientRegistry: mocks.NewMockRemoteClientRegistry(ctrl),
+		cluster:              cluster,
+		kcp:                  kcp,
+		configMap:            configMap,
+		deployment:           deployment,
+	}
+}
+
+func (tt *reconcilerTest) managementClient(objs ...runtime.Object) client.Client {
+	scheme := runtime.NewScheme()
+	_ = controlplanev1beta2.AddToScheme(scheme)
+	return fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(objs...).Build()
+}
+
+func (tt *reconcilerTest) remoteClient(objs ...runtime.Object) client.Client {
+	scheme := runtime.NewScheme()
+	_ = corev1.AddToScheme(scheme)
+	_ = appsv1.AddToScheme(scheme)
+	_ = rbacv1.AddToScheme(scheme)
+	remoteClient := fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(objs...).Build()
+	tt.remoteClientRegistry.EXPECT().
+		GetClient(tt.ctx, client.ObjectKey{Name: "my-cluster", Namespace: "eksa-system"}).
+		Return(remoteClient, nil)
+	return remoteClient
+}
+
+// withBundle references a Bundle with the DNS add-ons from the cluster and returns the objects
+// the management cluster needs to build its spec.
+func (tt *reconcilerTest) withBundle() []runtime.Object {
+	bundle := test.Bundle()
+	for i := range bundle.Spec.VersionsBundles {
+		bundle.Spec.VersionsBundles[i].DNS = dnsBundle()
+	}
+	version := test.DevEksaVersion()
+	tt.cluster.Spec.KubernetesVersion = "1.22"
+	tt.cluster.Spec.BundlesRef = &anywherev1.BundlesRef{
+		Name:       bundle.Name,
+		Namespace:  bundle.Namespace,
+		APIVersion: bundle.APIVersion,
+	}
+	tt.cluster.Spec.EksaVersion = &version
+
+	return []runtime.Object{tt.kcp, bundle, test.EksdRelease("1-22"), test.EKSARelease()}
+}
+
+func (tt *reconcilerTest) bundleManagementClient() client.Client {
+	scheme := runtime.NewScheme()
+	_ = controlplanev1beta2.AddToScheme(scheme)
+	_ = releasev1.AddToScheme(scheme)
+	_ = eksdv1.AddToScheme(scheme)
+	return fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(tt.withBundle()...).Build()
+}
+
+func kubeDNSService() *corev1.Service {
+	return &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      coredns.KubeDNSServiceName,
+			Namespace: coredns.Namespace,
+		},
+		Spec: corev1.ServiceSpec{
+			ClusterIP: "10.96.0.10",
+		},
+	}
+}
+
+func (tt *reconcilerTest) getCorefile(c client.Client) string {
+	configMap := &corev1.ConfigMap{}
+	tt.Expect(c.Get(tt.ctx, client.ObjectKey{Namespace: coredns.Namespace, Name: coredns.Name}, configMap)).To(Succeed())
+	return configMap.Data[coredns.CorefileKey]
+}
+
+func (tt *reconcilerTest) getReplicas(c client.Client) int32 {
+	deployment := &appsv1.Deployment{}
+	tt.Expect(c.Get(tt.ctx, client.ObjectKey{Namespace: coredns.Namespace, Name: coredns.Name}, deployment)).To(Succeed())
+	return *deployment.Spec.Replicas
+}
+
+func node(name string, cpu string, unschedulable bool) *corev1.Node {
+	return &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+		},
+		Spec: corev1.NodeSpec{
+			Unschedulable: unschedulable,
+		},
+		Status: corev1.NodeStatus{
+			Capacity: corev1.ResourceList{
+				corev1.ResourceCPU: resource.MustParse(cpu),
+			},
+		},
+	}
+}
+
+func nullLog() logr.Logger {
+	return logr.New(logf.NullLogSink{})
+}
+
+func TestReconcileNoDNS(t *testing.T) {
+	tt := newReconcilerTest(t)
+	tt.cluster.Spec.DNS = nil
+	r := coredns.New(tt.managementClient(), tt.remoteClientRegistry)
+
+	result, err := r.Reconcile(tt.ctx, nullLog(), tt.cluster)
+	tt.Expect(err).NotTo(HaveOccurred())
+	tt.Expect(result).To(Equal(controller.Result{}))
+}
+
+func TestReconcileControlPlaneNotReady(t *testing.T) {
+	tt := newReconcilerTest(t)
+	r := coredns.New(tt.managementClient(), tt.remoteClientRegistry)
+
+	result, err := r.Reconcile(tt.ctx, nullLog(), tt.cluster)
+	tt.Expect(err).NotTo(HaveOccurred())
+	tt.Expect(result).To(Equal(controller.ResultWithRequeue(5 * time.Second)))
+}
+
+func TestReconcileRemoteGetClientError(t *testing.T) {
+	tt := newReconcilerTest(t)
+	r := coredns.New(tt.managementClient(tt.kcp), tt.remoteClientRegistry)
+	tt.remoteClientRegistry.EXPECT().
+		GetClient(tt.ctx, gomock.AssignableToTypeOf(client.ObjectKey{})).
+		Return(nil, errors.New("client error"))
+
+	result, err := r.Reconcile(tt.ctx, nullLog(), tt.cluster)
+	tt.Expect(err).To(MatchError(ContainSubstring("client error")))
+	tt.Expect(result).To(Equal(controller.Result{}))
+}
+
+func TestReconcileMissingConfigMap(t *testing.T) {
+	tt := newReconcilerTest(t)
+	r := coredns.New(tt.managementClient(tt.kcp), tt.remoteClientRegistry)
+	tt.remoteClient()
+
+	result, err := r.Reconcile(tt.ctx, nullLog(), tt.cluster)
+	tt.Expect(err).NotTo(HaveOccurred())
+	tt.Expect(result).To(Equal(controller.ResultWithRequeue(time.Minute)))
+}
+
+func TestReconcileUpdatesCorefile(t *testing.T) {
+	tt := newReconcilerTest(t)
+	r := coredns.New(tt.managementClient(tt.kcp), tt.remoteClientRegistry)
+	remoteClient := tt.remoteClient(tt.configMap, tt.deployment)
+
+	result, err := r.Reconcile(tt.ctx, nullLog(), tt.cluster)
+	tt.Expect(err).NotTo(HaveOccurred())
+	tt.Expect(result).To(Equal(controller.Result{}))
+
+	want, err := coredns.Corefile(tt.cluster.Spec.DNS)
+	tt.Expect(err).NotTo(HaveOccurred())
+	tt.Expect(tt.getCorefile(remoteClient)).To(Equal(want))
+	tt.Expect(tt.getReplicas(remoteClient)).To(Equal(int32(2)))
+}
+
+func TestReconcileCorefileUpToDate(t *testing.T) {
+	tt := newReconcilerTest(t)
+	r := coredns.New(tt.managementClient(tt.kcp), tt.remoteClientRegistry)
+	corefile, err := coredns.Corefile(tt.cluster.Spec.DNS)
+	tt.Expect(err).NotTo(HaveOccurred())
+	tt.configMap.Data[coredns.CorefileKey] = corefile
+	remoteClient := tt.remoteClient(tt.configMap)
+
+	result, err := r.Reconcile(tt.ctx, nullLog(), tt.cluster)
+	tt.Expect(err).NotTo(HaveOccurred())
+	tt.Expect(result).To(Equal(controller.Result{}))
+	tt.Expect(tt.getCorefile(remoteClient)).To(Equal(corefile))
+}
+
+func TestReconcileScalesDeployment(t *testing.T) {
+	tt := newReconcilerTest(t)
+	tt.cluster.Spec.DNS.Autoscaling = &anywherev1.DNSAutoscaling{
+		NodesPerReplica: 1,
+		MaxReplicas:     3,
+	}
+	r := coredns.New(tt.managementClient(tt.kcp), tt.remoteClientRegistry)
+	remoteClient := tt.remoteClient(
+		tt.configMap,
+		tt.deployment,
+		node("node-1", "4", false),
+		node("node-2", "4", false),
+		node("node-3", "4", false),
+		node("node-4", "4", false),
+		node("node-5", "4", true),
+	)
+
+	result, err := r.Reconcile(tt.ctx, nullLog(), tt.cluster)
+	tt.Expect(err).NotTo(HaveOccurred())
+	tt.Expect(result).To(Equal(controller.Result{}))
+	tt.Expect(tt.getReplicas(remoteClient)).To(Equal(int32(3)))
+}
+
+func TestReconcileScalesDeploymentMissingDeployment(t *testing.T) {
+	tt := newReconcilerTest(t)
+	tt.cluster.Spec.DNS.Autoscaling = &anywherev1.DNSAutoscaling{}
+	r := coredns.New(tt.managementClient(tt.kcp), tt.remoteClientRegistry)
+	tt.remoteClient(tt.configMap)
+
+	result, err := r.Reconcile(tt.ctx, nullLog(), tt.cluster)
+	tt.Expect(err).To(MatchError(ContainSubstring("reading CoreDNS Deployment")))
+	tt.Expect(result).To(Equal(controller.Result{}))
+}
+
+func TestReconcileClusterProportionalAutoscaler(t *testing.T) {
+	tt := newReconcilerTest(t)
+	tt.cluster.Spec.DNS.Autoscaling = &anywherev1.DNSAutoscaling{
+		NodesPerReplica: 1,
+		Autoscaler:      anywherev1.ClusterProportionalDNSAutoscaler,
+	}
+	r := coredns.New(tt.bundleManagementClient(), tt.remoteClientRegistry)
+	remoteClient := tt.remoteClient(tt.configMap, tt.deployment, node("node-1", "4", false), node("node-2", "4", false), node("node-3", "4", false))
+
+	result, err := r.Reconcile(tt.ctx, nullLog(), tt.cluster)
+	tt.Expect(err).NotTo(HaveOccurred())
+	tt.Expect(result).To(Equal(controller.Result{}))
+	tt.Expect(tt.getReplicas(remoteClient)).To(Equal(int32(2)), "the controller shouldn't scale CoreDNS")
+
+	autoscaler := &appsv1.Deployment{}
+	tt.Expect(remoteClient.Get(tt.ctx, client.ObjectKey{Namespace: coredns.Namespace, Name: coredns.AutoscalerName}, autoscaler)).To(Succeed())
+	tt.Expect(autoscaler.Spec.Template.Spec.Containers[0].Image).To(Equal("public.ecr.aws/eks-anywhere/kubernetes-sigs/cluster-proportional-autoscaler:v1.9.0"))
+}
+
+func TestReconcileNodeLocalDNSCache(t *testing.T) {
+	tt := newReconcilerTest(t)
+	tt.cluster.Spec.DNS.NodeLocalDNSCache = &anywherev1.NodeLocalDNSCache{LocalIP: "169.254.25.10"}
+	r := coredns.New(tt.bundleManagementClient(), tt.remoteClientRegistry)
+	remoteClient := tt.remoteClient(tt.configMap, tt.deployment, kubeDNSService())
+
+	result, err := r.Reconcile(tt.ctx, nullLog(), tt.cluster)
+	tt.Expect(err).NotTo(HaveOccurred())
+	tt.Expect(result).To(Equal(controller.Result{}))
+
+	ds := &appsv1.DaemonSet{}
+	tt.Expect(remoteClient.Get(tt.ctx, client.ObjectKey{Namespace: coredns.Namespace, Name: coredns.NodeLocalDNSName}, ds)).To(Succeed())
+	tt.Expect(ds.Spec.Template.Spec.Containers[0].Args).To(ContainElement("169.254.25.10,10.96.0.10"))
+}
+
+func TestReconcileNodeLocalDNSCacheMissingKubeDNSService(t *testing.T) {
+	tt := newReconcilerTest(t)
+	tt.cluster.Spec.DNS.NodeLocalDNSCache = &anywherev1.NodeLocalDNSCache{}
+	r := coredns.New(tt.bundleManagementClient(), tt.remoteClientRegistry)
+	tt.remoteClient(tt.configMap, tt.deployment)
+
+	_, err := r.Reconcile(tt.ctx, nullLog(), tt.cluster)
+	tt.Expect(err).To(MatchError(ContainSubstring("reading kube-dns Service")))
+}
+
+func TestReconcileRemovesAddons(t *testing.T) {
+	tt := newReconcilerTest(t)
+	r := coredns.New(tt.managementClient(tt.kcp), tt.remoteClientRegistry)
+	remoteClient := tt.remoteClient(
+		tt.configMap,
+		tt.deployment,
+		&appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: coredns.AutoscalerName, Namespace: coredns.Namespace}},
+		&appsv1.DaemonSet{ObjectMeta: metav1.ObjectMeta{Name: coredns.NodeLocalDNSName, Namespace: coredns.Namespace}},
+	)
+
+	result, err := r.Reconcile(tt.ctx, nullLog(), tt.cluster)
+	tt.Expect(err).NotTo(HaveOccurred())
+	tt.Expect(result).To(Equal(controller.Result{}))
+
+	err = remoteClient.Get(tt.ctx, client.ObjectKey{Namespace: coredns.Namespace, Name: coredns.AutoscalerName}, &appsv1.Deployment{})
+	tt.Expect(apierrors.IsNotFound(err)).To(BeTrue())
+	err = remoteClient.Get(tt.ctx, client.ObjectKey{Namespace: coredns.Namespace, Name: coredns.NodeLocalDNSName}, &appsv1.DaemonSet{})
+	tt.Expect(apierrors.IsNotFound(err)).To(BeTrue())
+}
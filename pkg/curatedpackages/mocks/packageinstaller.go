@@ -8,6 +8,7 @@ import (
 	context "context"
 	reflect "reflect"
 
+	cluster "github.com/aws/eks-anywhere/pkg/cluster"
 	gomock "github.com/golang/mock/gomock"
 )
 
@@ -98,3 +99,40 @@ func (mr *MockPackageHandlerMockRecorder) CreatePackages(ctx, fileName, kubeConf
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePackages", reflect.TypeOf((*MockPackageHandler)(nil).CreatePackages), ctx, fileName, kubeConfig)
 }
+
+// MockGitOpsPackagesWriter is a mock of GitOpsPackagesWriter interface.
+type MockGitOpsPackagesWriter struct {
+	ctrl     *gomock.Controller
+	recorder *MockGitOpsPackagesWriterMockRecorder
+}
+
+// MockGitOpsPackagesWriterMockRecorder is the mock recorder for MockGitOpsPackagesWriter.
+type MockGitOpsPackagesWriterMockRecorder struct {
+	mock *MockGitOpsPackagesWriter
+}
+
+// NewMockGitOpsPackagesWriter creates a new mock instance.
+func NewMockGitOpsPackagesWriter(ctrl *gomock.Controller) *MockGitOpsPackagesWriter {
+	mock := &MockGitOpsPackagesWriter{ctrl: ctrl}
+	mock.recorder = &MockGitOpsPackagesWriterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGitOpsPackagesWriter) EXPECT() *MockGitOpsPackagesWriterMockRecorder {
+	return m.recorder
+}
+
+// UpdateGitPackages mocks base method.
+func (m *MockGitOpsPackagesWriter) UpdateGitPackages(ctx context.Context, clusterSpec *cluster.Spec, packages []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateGitPackages", ctx, clusterSpec, packages)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateGitPackages indicates an expected call of UpdateGitPackages.
+func (mr *MockGitOpsPackagesWriterMockRecorder) UpdateGitPackages(ctx, clusterSpec, packages interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateGitPackages", reflect.TypeOf((*MockGitOpsPackagesWriter)(nil).UpdateGitPackages), ctx, clusterSpec, packages)
+}
@@ -0,0 +1,68 @@
+package curatedpackages
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/eks-anywhere/pkg/helm"
+	"github.com/aws/eks-anywhere/pkg/logger"
+)
+
+// ReleaseClient lists and removes the Helm releases installed in a cluster.
+type ReleaseClient interface {
+	ListReleases(ctx context.Context, kubeconfigFilePath string) ([]helm.Release, error)
+	Delete(ctx context.Context, kubeconfigFilePath, installName, namespace string) error
+}
+
+// OrphanedReleases returns the Helm releases that are still installed for the given
+// deleted packages. The package controller installs each package as a release named
+// after the Package object, so any release left with one of those names once the
+// Package is gone was not cleaned up.
+func (pc *PackageClient) OrphanedReleases(ctx context.Context, deleted []string, kubeConfig, clusterName string) ([]helm.Release, error) {
+	if pc.releases == nil {
+		return nil, fmt.Errorf("no helm client configured to detect orphaned releases")
+	}
+
+	remaining, err := pc.ListPackageNames(ctx, kubeConfig, clusterName)
+	if err != nil {
+		return nil, err
+	}
+	existing := make(map[string]struct{}, len(remaining))
+	for _, name := range remaining {
+		existing[name] = struct{}{}
+	}
+	candidates := make(map[string]struct{}, len(deleted))
+	for _, name := range deleted {
+		if _, ok := existing[name]; !ok {
+			candidates[name] = struct{}{}
+		}
+	}
+
+	releases, err := pc.releases.ListReleases(ctx, kubeConfig)
+	if err != nil {
+		return nil, fmt.Errorf("listing helm releases: %v", err)
+	}
+
+	orphans := []helm.Release{}
+	for _, r := range releases {
+		if _, ok := candidates[r.Name]; ok {
+			orphans = append(orphans, r)
+		}
+	}
+	return orphans, nil
+}
+
+// RemoveReleases uninstalls the given Helm releases from the cluster.
+func (pc *PackageClient) RemoveReleases(ctx context.Context, releases []helm.Release, kubeConfig string) error {
+	if pc.releases == nil {
+		return fmt.Errorf("no helm client configured to remove releases")
+	}
+
+	for _, r := range releases {
+		logger.V(4).Info("Removing orphaned helm release", "name", r.Name, "namespace", r.Namespace)
+		if err := pc.releases.Delete(ctx, kubeConfig, r.Name, r.Namespace); err != nil {
+			return fmt.Errorf("removing helm release %s/%s: %v", r.Namespace, r.Name, err)
+		}
+	}
+	return nil
+}
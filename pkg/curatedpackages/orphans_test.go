@@ -0,0 +1,98 @@
+package curatedpackages_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	packagesv1 "github.com/aws/eks-anywhere-packages/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/constants"
+	"github.com/aws/eks-anywhere/pkg/curatedpackages"
+	"github.com/aws/eks-anywhere/pkg/curatedpackages/mocks"
+	"github.com/aws/eks-anywhere/pkg/helm"
+)
+
+type orphansTest struct {
+	*WithT
+	ctx        context.Context
+	kubectl    *mocks.MockKubectlRunner
+	releases   *mocks.MockReleaseClient
+	client     *curatedpackages.PackageClient
+	kubeConfig string
+	getParams  []string
+}
+
+func newOrphansTest(t *testing.T) *orphansTest {
+	ctrl := gomock.NewController(t)
+	k := mocks.NewMockKubectlRunner(ctrl)
+	r := mocks.NewMockReleaseClient(ctrl)
+	kubeConfig := "kubeconfig.kubeconfig"
+	return &orphansTest{
+		WithT:      NewWithT(t),
+		ctx:        context.Background(),
+		kubectl:    k,
+		releases:   r,
+		client:     curatedpackages.NewPackageClient(k, curatedpackages.WithReleaseClient(r)),
+		kubeConfig: kubeConfig,
+		getParams:  []string{"get", "packages", "-o", "json", "--kubeconfig", kubeConfig, "--namespace", constants.EksaPackagesName + "-susie"},
+	}
+}
+
+func TestOrphanedReleases(t *testing.T) {
+	tt := newOrphansTest(t)
+	remaining := packagesv1.PackageList{Items: []packagesv1.Package{{ObjectMeta: metav1.ObjectMeta{Name: "redis"}}}}
+	releases := []helm.Release{
+		{Name: "harbor", Namespace: "harbor"},
+		{Name: "redis", Namespace: "redis"},
+		{Name: "eks-anywhere-packages", Namespace: "eksa-packages"},
+	}
+
+	tt.kubectl.EXPECT().ExecuteCommand(tt.ctx, tt.getParams).Return(convertJsonToBytes(remaining), nil)
+	tt.releases.EXPECT().ListReleases(tt.ctx, tt.kubeConfig).Return(releases, nil)
+
+	orphans, err := tt.client.OrphanedReleases(tt.ctx, []string{"harbor", "redis"}, tt.kubeConfig, "susie")
+	tt.Expect(err).To(BeNil())
+	tt.Expect(orphans).To(Equal([]helm.Release{{Name: "harbor", Namespace: "harbor"}}))
+}
+
+func TestOrphanedReleasesListReleasesFail(t *testing.T) {
+	tt := newOrphansTest(t)
+
+	tt.kubectl.EXPECT().ExecuteCommand(tt.ctx, tt.getParams).Return(convertJsonToBytes(packagesv1.PackageList{}), nil)
+	tt.releases.EXPECT().ListReleases(tt.ctx, tt.kubeConfig).Return(nil, errors.New("helm failed"))
+
+	_, err := tt.client.OrphanedReleases(tt.ctx, []string{"harbor"}, tt.kubeConfig, "susie")
+	tt.Expect(err).To(MatchError(ContainSubstring("listing helm releases: helm failed")))
+}
+
+func TestOrphanedReleasesNoReleaseClient(t *testing.T) {
+	tt := newOrphansTest(t)
+	tt.client = curatedpackages.NewPackageClient(tt.kubectl)
+
+	_, err := tt.client.OrphanedReleases(tt.ctx, []string{"harbor"}, tt.kubeConfig, "susie")
+	tt.Expect(err).To(HaveOccurred())
+}
+
+func TestRemoveReleases(t *testing.T) {
+	tt := newOrphansTest(t)
+	orphans := []helm.Release{{Name: "harbor", Namespace: "harbor"}, {Name: "redis", Namespace: "redis"}}
+
+	tt.releases.EXPECT().Delete(tt.ctx, tt.kubeConfig, "harbor", "harbor").Return(nil)
+	tt.releases.EXPECT().Delete(tt.ctx, tt.kubeConfig, "redis", "redis").Return(nil)
+
+	tt.Expect(tt.client.RemoveReleases(tt.ctx, orphans, tt.kubeConfig)).To(Succeed())
+}
+
+func TestRemoveReleasesFail(t *testing.T) {
+	tt := newOrphansTest(t)
+	orphans := []helm.Release{{Name: "harbor", Namespace: "harbor"}}
+
+	tt.releases.EXPECT().Delete(tt.ctx, tt.kubeConfig, "harbor", "harbor").Return(errors.New("release not found"))
+
+	err := tt.client.RemoveReleases(tt.ctx, orphans, tt.kubeConfig)
+	tt.Expect(err).To(MatchError(ContainSubstring("removing helm release harbor/harbor: release not found")))
+}
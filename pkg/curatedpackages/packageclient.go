@@ -2,6 +2,7 @@ package curatedpackages
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
@@ -27,6 +28,7 @@ type PackageClient struct {
 	customPackages []string
 	kubectl        KubectlRunner
 	customConfigs  []string
+	releases       ReleaseClient
 }
 
 func NewPackageClient(kubectl KubectlRunner, options ...PackageClientOpt) *PackageClient {
@@ -180,6 +182,42 @@ func (pc *PackageClient) DeletePackages(ctx context.Context, packages []string,
 	return nil
 }
 
+// DeleteAllPackages deletes every package of the cluster and returns the names of
+// the deleted packages.
+func (pc *PackageClient) DeleteAllPackages(ctx context.Context, kubeConfig string, clusterName string) ([]string, error) {
+	packages, err := pc.ListPackageNames(ctx, kubeConfig, clusterName)
+	if err != nil {
+		return nil, err
+	}
+	if len(packages) == 0 {
+		return packages, nil
+	}
+
+	if err := pc.DeletePackages(ctx, packages, kubeConfig, clusterName); err != nil {
+		return nil, err
+	}
+	return packages, nil
+}
+
+// ListPackageNames returns the names of the packages installed for the cluster.
+func (pc *PackageClient) ListPackageNames(ctx context.Context, kubeConfig string, clusterName string) ([]string, error) {
+	params := []string{"get", "packages", "-o", "json", "--kubeconfig", kubeConfig, "--namespace", constants.EksaPackagesName + "-" + clusterName}
+	stdOut, err := pc.kubectl.ExecuteCommand(ctx, params...)
+	if err != nil {
+		return nil, err
+	}
+	list := &packagesv1.PackageList{}
+	if err := json.Unmarshal(stdOut.Bytes(), list); err != nil {
+		return nil, fmt.Errorf("unmarshaling packages: %w", err)
+	}
+
+	names := make([]string, 0, len(list.Items))
+	for _, p := range list.Items {
+		names = append(names, p.Name)
+	}
+	return names, nil
+}
+
 func (pc *PackageClient) DescribePackages(ctx context.Context, packages []string, kubeConfig string, clusterName string) error {
 	params := []string{"describe", "packages", "--kubeconfig", kubeConfig, "--namespace", constants.EksaPackagesName + "-" + clusterName}
 	params = append(params, packages...)
@@ -225,6 +263,13 @@ func WithCustomPackages(customPackages []string) func(*PackageClient) {
 	}
 }
 
+// WithReleaseClient sets the Helm client used to detect and remove orphaned releases.
+func WithReleaseClient(releases ReleaseClient) func(*PackageClient) {
+	return func(config *PackageClient) {
+		config.releases = releases
+	}
+}
+
 func WithCustomConfigs(customConfigs []string) func(*PackageClient) {
 	return func(config *PackageClient) {
 		config.customConfigs = customConfigs
@@ -10,6 +10,7 @@ import (
 
 	"github.com/golang/mock/gomock"
 	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	packagesv1 "github.com/aws/eks-anywhere-packages/api/v1alpha1"
 	"github.com/aws/eks-anywhere/pkg/constants"
@@ -270,3 +271,42 @@ func TestDisplayPackages(t *testing.T) {
 	expected := "Package\t\tVersion(s)\t\n-------\t\t----------\t\nharbor-test\t0.0.1, 0.0.2\t\nredis-test\t0.0.3, 0.0.4\t\n"
 	tt.Expect(buf.String()).To(Equal(expected))
 }
+
+func TestDeleteAllPackagesPass(t *testing.T) {
+	tt := newPackageTest(t)
+	namespace := constants.EksaPackagesName + "-susie"
+	getParams := []string{"get", "packages", "-o", "json", "--kubeconfig", tt.kubeConfig, "--namespace", namespace}
+	list := packagesv1.PackageList{Items: []packagesv1.Package{{ObjectMeta: metav1.ObjectMeta{Name: "harbor"}}, {ObjectMeta: metav1.ObjectMeta{Name: "redis"}}}}
+	deleteParams := []string{"delete", "packages", "--kubeconfig", tt.kubeConfig, "--namespace", namespace, "harbor", "redis"}
+
+	tt.kubectl.EXPECT().ExecuteCommand(tt.ctx, getParams).Return(convertJsonToBytes(list), nil)
+	tt.kubectl.EXPECT().ExecuteCommand(tt.ctx, deleteParams).Return(bytes.Buffer{}, nil)
+
+	tt.command = curatedpackages.NewPackageClient(tt.kubectl)
+	deleted, err := tt.command.DeleteAllPackages(tt.ctx, tt.kubeConfig, "susie")
+	tt.Expect(err).To(BeNil())
+	tt.Expect(deleted).To(Equal([]string{"harbor", "redis"}))
+}
+
+func TestDeleteAllPackagesNoPackages(t *testing.T) {
+	tt := newPackageTest(t)
+	getParams := []string{"get", "packages", "-o", "json", "--kubeconfig", tt.kubeConfig, "--namespace", constants.EksaPackagesName + "-susie"}
+
+	tt.kubectl.EXPECT().ExecuteCommand(tt.ctx, getParams).Return(convertJsonToBytes(packagesv1.PackageList{}), nil)
+
+	tt.command = curatedpackages.NewPackageClient(tt.kubectl)
+	deleted, err := tt.command.DeleteAllPackages(tt.ctx, tt.kubeConfig, "susie")
+	tt.Expect(err).To(BeNil())
+	tt.Expect(deleted).To(BeEmpty())
+}
+
+func TestDeleteAllPackagesListFail(t *testing.T) {
+	tt := newPackageTest(t)
+	getParams := []string{"get", "packages", "-o", "json", "--kubeconfig", tt.kubeConfig, "--namespace", constants.EksaPackagesName + "-susie"}
+
+	tt.kubectl.EXPECT().ExecuteCommand(tt.ctx, getParams).Return(bytes.Buffer{}, errors.New("connection refused"))
+
+	tt.command = curatedpackages.NewPackageClient(tt.kubectl)
+	_, err := tt.command.DeleteAllPackages(tt.ctx, tt.kubeConfig, "susie")
+	tt.Expect(err).To(MatchError(ContainSubstring("connection refused")))
+}
@@ -2,6 +2,8 @@ package curatedpackages
 
 import (
 	"context"
+	"fmt"
+	"os"
 	"time"
 
 	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
@@ -20,10 +22,16 @@ type PackageHandler interface {
 	CreatePackages(ctx context.Context, fileName string, kubeConfig string) error
 }
 
+// GitOpsPackagesWriter writes curated packages to the GitOps repository of a cluster.
+type GitOpsPackagesWriter interface {
+	UpdateGitPackages(ctx context.Context, clusterSpec *cluster.Spec, packages []byte) error
+}
+
 type Installer struct {
 	packageController PackageController
 	spec              *cluster.Spec
 	packageClient     PackageHandler
+	gitOps            GitOpsPackagesWriter
 	kubectl           KubectlRunner
 	packagesLocation  string
 	mgmtKubeconfig    string
@@ -57,6 +65,13 @@ func (pi *Installer) WithRetries(maxRetries int, backoff time.Duration) *Install
 	return pi
 }
 
+// WithGitOps makes the installer commit the curated packages to the cluster GitOps repository
+// instead of creating them directly, when the cluster has GitOps enabled.
+func (pi *Installer) WithGitOps(gitOps GitOpsPackagesWriter) *Installer {
+	pi.gitOps = gitOps
+	return pi
+}
+
 // InstallCuratedPackages installs curated packages as part of the cluster creation.
 func (pi *Installer) InstallCuratedPackages(ctx context.Context) {
 	if IsPackageControllerDisabled(pi.spec.Cluster) {
@@ -108,9 +123,22 @@ func (pi *Installer) installPackages(ctx context.Context) error {
 	if pi.packagesLocation == "" {
 		return nil
 	}
+	if pi.gitOps != nil && pi.spec.FluxConfig != nil {
+		return pi.commitPackagesToGit(ctx)
+	}
 	err := pi.packageClient.CreatePackages(ctx, pi.packagesLocation, pi.mgmtKubeconfig)
 	if err != nil {
 		return err
 	}
 	return nil
 }
+
+func (pi *Installer) commitPackagesToGit(ctx context.Context) error {
+	packages, err := os.ReadFile(pi.packagesLocation)
+	if err != nil {
+		return fmt.Errorf("reading curated packages file: %v", err)
+	}
+
+	logger.Info("Committing curated packages to the GitOps repository")
+	return pi.gitOps.UpdateGitPackages(ctx, pi.spec, packages)
+}
@@ -3,6 +3,8 @@ package curatedpackages_test
 import (
 	"context"
 	"errors"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/golang/mock/gomock"
@@ -84,6 +86,32 @@ func TestPackageInstallerFailWhenPackageFails(t *testing.T) {
 	tt.command.InstallCuratedPackages(tt.ctx)
 }
 
+func TestPackageInstallerWithGitOpsCommitsPackagesToGit(t *testing.T) {
+	tt := newPackageInstallerTest(t)
+	packagesPath := filepath.Join(t.TempDir(), "packages.yaml")
+	packages := []byte("apiVersion: packages.eks.amazonaws.com/v1alpha1\nkind: Package\n")
+	tt.Expect(os.WriteFile(packagesPath, packages, 0o644)).To(Succeed())
+	tt.spec.FluxConfig = &anywherev1.FluxConfig{}
+	gitOps := mocks.NewMockGitOpsPackagesWriter(gomock.NewController(t))
+	command := curatedpackages.NewInstaller(tt.kubectlRunner, tt.packageClient, tt.packageControllerClient, tt.spec, packagesPath, tt.kubeConfigPath).WithGitOps(gitOps)
+
+	tt.packageControllerClient.EXPECT().Enable(tt.ctx).Return(nil)
+	gitOps.EXPECT().UpdateGitPackages(tt.ctx, tt.spec, packages).Return(nil)
+
+	command.InstallCuratedPackages(tt.ctx)
+}
+
+func TestPackageInstallerWithGitOpsWithoutFluxConfigCreatesPackages(t *testing.T) {
+	tt := newPackageInstallerTest(t)
+	gitOps := mocks.NewMockGitOpsPackagesWriter(gomock.NewController(t))
+	tt.command.WithGitOps(gitOps)
+
+	tt.packageControllerClient.EXPECT().Enable(tt.ctx).Return(nil)
+	tt.packageClient.EXPECT().CreatePackages(tt.ctx, tt.packagePath, tt.kubeConfigPath).Return(nil)
+
+	tt.command.InstallCuratedPackages(tt.ctx)
+}
+
 func TestPackageInstallerDisabled(t *testing.T) {
 	tt := newPackageInstallerTest(t)
 	tt.spec.Cluster.Spec.Packages = &anywherev1.PackageConfiguration{
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"net/http"
 	"os"
+	"path/filepath"
 	"time"
 
 	"github.com/go-logr/logr"
@@ -39,10 +40,15 @@ import (
 	"github.com/aws/eks-anywhere/pkg/networking/cilium"
 	"github.com/aws/eks-anywhere/pkg/networkutils"
 	"github.com/aws/eks-anywhere/pkg/providers"
+	"github.com/aws/eks-anywhere/pkg/providers/azurestackhci"
 	"github.com/aws/eks-anywhere/pkg/providers/cloudstack"
 	"github.com/aws/eks-anywhere/pkg/providers/cloudstack/decoder"
 	"github.com/aws/eks-anywhere/pkg/providers/docker"
+	"github.com/aws/eks-anywhere/pkg/providers/harvester"
 	"github.com/aws/eks-anywhere/pkg/providers/nutanix"
+	"github.com/aws/eks-anywhere/pkg/providers/openstack"
+	"github.com/aws/eks-anywhere/pkg/providers/plugin"
+	"github.com/aws/eks-anywhere/pkg/providers/proxmox"
 	"github.com/aws/eks-anywhere/pkg/providers/snow"
 	"github.com/aws/eks-anywhere/pkg/providers/tinkerbell"
 	"github.com/aws/eks-anywhere/pkg/providers/tinkerbell/hardware"
@@ -53,6 +59,7 @@ import (
 	"github.com/aws/eks-anywhere/pkg/types"
 	"github.com/aws/eks-anywhere/pkg/version"
 	"github.com/aws/eks-anywhere/pkg/workflows/interfaces"
+	releasev1alpha1 "github.com/aws/eks-anywhere/release/api/v1alpha1"
 )
 
 type Dependencies struct {
@@ -96,6 +103,7 @@ type Dependencies struct {
 	PackageControllerClient     *curatedpackages.PackageControllerClient
 	PackageClient               curatedpackages.PackageHandler
 	VSphereValidator            *vsphere.Validator
+	VSphereSessionPool          *govmomi.SessionPool
 	VSphereDefaulter            *vsphere.Defaulter
 	NutanixClientCache          *nutanix.ClientCache
 	NutanixDefaulter            *nutanix.Defaulter
@@ -152,6 +160,7 @@ type Factory struct {
 	proxyConfiguration       map[string]string
 	writerFolder             string
 	diagnosticCollectorImage string
+	redactionProfile         *diagnostics.RedactionProfile
 	buildSteps               []buildStep
 	dependencies             Dependencies
 }
@@ -159,6 +168,7 @@ type Factory struct {
 type executablesConfig struct {
 	builder            *executables.ExecutablesBuilder
 	image              string
+	platform           string
 	useDockerContainer bool
 	dockerClient       executables.DockerClient
 	mountDirs          []string
@@ -250,11 +260,11 @@ func (f *Factory) WithExecutableImage() *Factory {
 		}
 
 		if f.config.bundlesOverride != "" {
-			image, err := f.selectImageFromBundleOverride(f.config.bundlesOverride)
+			toolsImage, err := f.selectImageFromBundleOverride(f.config.bundlesOverride)
 			if err != nil {
 				return err
 			}
-			f.executablesConfig.image = image
+			f.useToolsImage(toolsImage)
 			return nil
 		}
 		bundles, err := f.dependencies.ManifestReader.ReadBundlesForVersion(version.Get().GitVersion)
@@ -262,25 +272,33 @@ func (f *Factory) WithExecutableImage() *Factory {
 			return fmt.Errorf("retrieving executable tools image from bundle in dependency factory: %v", err)
 		}
 
-		f.executablesConfig.image = bundles.DefaultEksAToolsImage().VersionedImage()
+		f.useToolsImage(bundles.DefaultEksAToolsImage())
 		return nil
 	})
 
 	return f
 }
 
+// useToolsImage runs the executables from the tools image, pulled for the admin machine
+// architecture when the image is published for it.
+func (f *Factory) useToolsImage(toolsImage releasev1alpha1.Image) {
+	executables.WarnIfEmulated(toolsImage)
+	f.executablesConfig.image = toolsImage.VersionedImage()
+	f.executablesConfig.platform = executables.ImagePlatform(toolsImage)
+}
+
 // selectImageFromBundleOverride retrieves an image from a bundles override.
 //
 // Handles cases where the bundle is configured with an override.
-func (f *Factory) selectImageFromBundleOverride(bundlesOverride string) (string, error) {
+func (f *Factory) selectImageFromBundleOverride(bundlesOverride string) (releasev1alpha1.Image, error) {
 	releaseBundles, err := bundles.Read(f.dependencies.ManifestReader, bundlesOverride)
 	if err != nil {
-		return "", fmt.Errorf("retrieving executable tools image from overridden bundle in dependency factory %v", err)
+		return releasev1alpha1.Image{}, fmt.Errorf("retrieving executable tools image from overridden bundle in dependency factory %v", err)
 	}
 	// Note: Currently using the first available version of the cli tools
 	// This is because the binaries bundled are all the same version hence no compatibility concerns
 	// In case, there is a change to this behavior, there might be a need to reassess this item
-	return releaseBundles.DefaultEksAToolsImage().VersionedImage(), nil
+	return releaseBundles.DefaultEksAToolsImage(), nil
 }
 
 // WithCustomBundles allows configuring a bundle override.
@@ -361,9 +379,10 @@ func (f *Factory) WithExecutableBuilder() *Factory {
 			if f.registryMirror != nil {
 				image = f.registryMirror.ReplaceRegistry(image)
 			}
-			b, err := executables.NewInDockerExecutablesBuilder(
+			b, err := executables.NewInDockerExecutablesBuilderForPlatform(
 				f.executablesConfig.dockerClient,
 				image,
+				f.executablesConfig.platform,
 				f.executablesConfig.mountDirs...,
 			)
 			if err != nil {
@@ -426,7 +445,7 @@ type TinkerbellOptions struct {
 func (f *Factory) WithProvider(clusterConfigFile string, clusterConfig *v1alpha1.Cluster, skipIPCheck bool, hardwareCSVPath string, force bool, tinkerbellBootstrapIP string, skippedValidations map[string]bool, opts *ProviderOptions) *Factory { // nolint:gocyclo
 	switch clusterConfig.Spec.DatacenterRef.Kind {
 	case v1alpha1.VSphereDatacenterKind:
-		f.WithKubectl().WithGovc().WithWriter().WithIPValidator()
+		f.WithKubectl().WithGovc().WithWriter().WithIPValidator().WithVSphereValidator()
 	case v1alpha1.CloudStackDatacenterKind:
 		f.WithKubectl().WithCloudStackValidatorRegistry(skipIPCheck).WithWriter()
 	case v1alpha1.DockerDatacenterKind:
@@ -441,6 +460,14 @@ func (f *Factory) WithProvider(clusterConfigFile string, clusterConfig *v1alpha1
 		f.WithUnAuthKubeClient().WithSnowConfigManager()
 	case v1alpha1.NutanixDatacenterKind:
 		f.WithKubectl().WithNutanixClientCache().WithNutanixDefaulter().WithNutanixValidator().WithIPValidator()
+	case v1alpha1.ProxmoxDatacenterKind:
+		f.WithWriter().WithIPValidator()
+	case v1alpha1.OpenStackDatacenterKind:
+		f.WithKubectl().WithWriter().WithIPValidator()
+	case v1alpha1.AzureStackHCIDatacenterKind:
+		f.WithWriter().WithIPValidator()
+	case v1alpha1.HarvesterDatacenterKind:
+		f.WithKubectl().WithWriter().WithIPValidator()
 	}
 
 	f.buildSteps = append(f.buildSteps, func(ctx context.Context) error {
@@ -455,7 +482,7 @@ func (f *Factory) WithProvider(clusterConfigFile string, clusterConfig *v1alpha1
 				return fmt.Errorf("unable to get datacenter config from file %s: %v", clusterConfigFile, err)
 			}
 
-			f.dependencies.Provider = vsphere.NewProvider(
+			f.dependencies.Provider = vsphere.NewProviderCustomNet(
 				datacenterConfig,
 				clusterConfig,
 				f.dependencies.Govc,
@@ -464,6 +491,7 @@ func (f *Factory) WithProvider(clusterConfigFile string, clusterConfig *v1alpha1
 				f.dependencies.IPValidator,
 				time.Now,
 				skipIPCheck,
+				f.dependencies.VSphereValidator,
 				skippedValidations,
 			)
 
@@ -584,8 +612,121 @@ func (f *Factory) WithProvider(clusterConfigFile string, clusterConfig *v1alpha1
 				skipIPCheck,
 			)
 			f.dependencies.Provider = provider
+		case v1alpha1.ProxmoxDatacenterKind:
+			datacenterConfig, err := v1alpha1.GetProxmoxDatacenterConfig(clusterConfigFile)
+			if err != nil {
+				return fmt.Errorf("unable to get datacenter config from file %s: %v", clusterConfigFile, err)
+			}
+
+			config, err := cluster.ParseConfigFromFile(clusterConfigFile)
+			if err != nil {
+				return fmt.Errorf("unable to get machine config from file %s: %v", clusterConfigFile, err)
+			}
+
+			creds, err := proxmox.GetCredsFromEnv()
+			if err != nil {
+				return err
+			}
+
+			client := proxmox.NewClient(datacenterConfig.Spec.Endpoint, datacenterConfig.Spec.Insecure, creds)
+			f.dependencies.Provider = proxmox.NewProvider(
+				datacenterConfig,
+				config.ProxmoxMachineConfigs,
+				clusterConfig,
+				proxmox.NewValidator(client),
+				f.dependencies.Writer,
+				f.dependencies.IPValidator,
+				skipIPCheck,
+			)
+		case v1alpha1.OpenStackDatacenterKind:
+			datacenterConfig, err := v1alpha1.GetOpenStackDatacenterConfig(clusterConfigFile)
+			if err != nil {
+				return fmt.Errorf("unable to get datacenter config from file %s: %v", clusterConfigFile, err)
+			}
+
+			config, err := cluster.ParseConfigFromFile(clusterConfigFile)
+			if err != nil {
+				return fmt.Errorf("unable to get machine config from file %s: %v", clusterConfigFile, err)
+			}
+
+			creds, err := openstack.GetCredsFromEnv()
+			if err != nil {
+				return err
+			}
+
+			client, err := openstack.NewClient(datacenterConfig, creds)
+			if err != nil {
+				return err
+			}
+
+			f.dependencies.Provider = openstack.NewProvider(
+				datacenterConfig,
+				config.OpenStackMachineConfigs,
+				clusterConfig,
+				f.dependencies.Kubectl,
+				openstack.NewValidator(client),
+				f.dependencies.Writer,
+				f.dependencies.IPValidator,
+				skipIPCheck,
+			)
+		case v1alpha1.AzureStackHCIDatacenterKind:
+			datacenterConfig, err := v1alpha1.GetAzureStackHCIDatacenterConfig(clusterConfigFile)
+			if err != nil {
+				return fmt.Errorf("unable to get datacenter config from file %s: %v", clusterConfigFile, err)
+			}
+
+			config, err := cluster.ParseConfigFromFile(clusterConfigFile)
+			if err != nil {
+				return fmt.Errorf("unable to get machine config from file %s: %v", clusterConfigFile, err)
+			}
+
+			f.dependencies.Provider = azurestackhci.NewProvider(
+				datacenterConfig,
+				config.AzureStackHCIMachineConfigs,
+				clusterConfig,
+				azurestackhci.NewValidator(azurestackhci.NewClient()),
+				f.dependencies.Writer,
+				f.dependencies.IPValidator,
+				skipIPCheck,
+			)
+		case v1alpha1.HarvesterDatacenterKind:
+			datacenterConfig, err := v1alpha1.GetHarvesterDatacenterConfig(clusterConfigFile)
+			if err != nil {
+				return fmt.Errorf("unable to get datacenter config from file %s: %v", clusterConfigFile, err)
+			}
+
+			config, err := cluster.ParseConfigFromFile(clusterConfigFile)
+			if err != nil {
+				return fmt.Errorf("unable to get machine config from file %s: %v", clusterConfigFile, err)
+			}
+
+			kubeconfig, err := harvester.GetKubeconfigFromEnv()
+			if err != nil {
+				return err
+			}
+
+			client, err := harvester.NewClient(kubeconfig)
+			if err != nil {
+				return err
+			}
+
+			f.dependencies.Provider = harvester.NewProvider(
+				datacenterConfig,
+				config.HarvesterMachineConfigs,
+				clusterConfig,
+				f.dependencies.Kubectl,
+				harvester.NewValidator(client),
+				f.dependencies.Writer,
+				f.dependencies.IPValidator,
+				skipIPCheck,
+			)
 		default:
-			return fmt.Errorf("no provider support for datacenter kind: %s", clusterConfig.Spec.DatacenterRef.Kind)
+			provider, err := f.pluginProvider(ctx, clusterConfigFile, clusterConfig.Spec.DatacenterRef.Kind)
+			if err != nil {
+				return err
+			}
+
+			f.dependencies.Provider = provider
 		}
 
 		return nil
@@ -594,6 +735,27 @@ func (f *Factory) WithProvider(clusterConfigFile string, clusterConfig *v1alpha1
 	return f
 }
 
+// pluginProvider starts the provider plugin that handles the datacenter kind.
+func (f *Factory) pluginProvider(ctx context.Context, clusterConfigFile, datacenterKind string) (providers.Provider, error) {
+	dir, err := plugin.Dir()
+	if err != nil {
+		return nil, err
+	}
+
+	p, err := plugin.ForDatacenterKind(ctx, dir, datacenterKind)
+	if err != nil {
+		return nil, fmt.Errorf("no provider support for datacenter kind %s: %v", datacenterKind, err)
+	}
+	f.dependencies.closers = append(f.dependencies.closers, p)
+
+	content, err := os.ReadFile(clusterConfigFile)
+	if err != nil {
+		return nil, fmt.Errorf("reading cluster config file %s: %v", clusterConfigFile, err)
+	}
+
+	return plugin.NewAdapter(p.Provider, p.Metadata, content)
+}
+
 // WithKubeconfigWriter adds the KubeconfigReader dependency depending on the provider.
 func (f *Factory) WithKubeconfigWriter(clusterConfig *v1alpha1.Cluster) *Factory {
 	f.WithUnAuthKubeClient()
@@ -608,7 +770,11 @@ func (f *Factory) WithKubeconfigWriter(clusterConfig *v1alpha1.Cluster) *Factory
 		writer := kubeconfig.NewClusterAPIKubeconfigSecretWriter(f.dependencies.UnAuthKubeClient)
 		switch clusterConfig.Spec.DatacenterRef.Kind {
 		case v1alpha1.DockerDatacenterKind:
-			f.dependencies.KubeconfigWriter = docker.NewKubeconfigWriter(f.dependencies.DockerClient, writer)
+			var opts []docker.KubeconfigWriterOpt
+			if p, ok := f.dependencies.Provider.(*docker.Provider); ok {
+				opts = append(opts, docker.WithLoadBalancer(p.LoadBalancer()))
+			}
+			f.dependencies.KubeconfigWriter = docker.NewKubeconfigWriter(f.dependencies.DockerClient, writer, opts...)
 		default:
 			f.dependencies.KubeconfigWriter = writer
 		}
@@ -673,7 +839,8 @@ func (f *Factory) WithGovc() *Factory {
 			return nil
 		}
 
-		f.dependencies.Govc = f.executablesConfig.builder.BuildGovcExecutable(f.dependencies.Writer)
+		sessionDir := filepath.Join(f.dependencies.Writer.TempDir(), executables.GovcSessionDir)
+		f.dependencies.Govc = f.executablesConfig.builder.BuildGovcExecutable(f.dependencies.Writer, executables.WithGovcSessionDir(sessionDir))
 		f.dependencies.closers = append(f.dependencies.closers, f.dependencies.Govc)
 
 		return nil
@@ -1331,7 +1498,7 @@ func (f *Factory) WithPackageManager(spec *cluster.Spec, packagesLocation, kubeC
 		managementClusterName := getManagementClusterName(spec)
 		mgmtKubeConfig := kubeconfig.ResolveFilename(kubeConfig, managementClusterName)
 
-		f.dependencies.PackageManager = curatedpackages.NewInstaller(
+		installer := curatedpackages.NewInstaller(
 			f.dependencies.Kubectl,
 			f.dependencies.PackageClient,
 			f.dependencies.PackageControllerClient,
@@ -1339,6 +1506,10 @@ func (f *Factory) WithPackageManager(spec *cluster.Spec, packagesLocation, kubeC
 			packagesLocation,
 			mgmtKubeConfig,
 		)
+		if f.dependencies.GitOpsFlux != nil {
+			installer.WithGitOps(f.dependencies.GitOpsFlux)
+		}
+		f.dependencies.PackageManager = installer
 		return nil
 	})
 	return f
@@ -1465,12 +1636,18 @@ func (f *Factory) WithDiagnosticBundleFactory() *Factory {
 			return nil
 		}
 
+		redactor, err := diagnostics.NewRedactor(f.redactionProfile)
+		if err != nil {
+			return err
+		}
+
 		opts := diagnostics.EksaDiagnosticBundleFactoryOpts{
 			AnalyzerFactory:  f.dependencies.AnalyzerFactory,
 			Client:           f.dependencies.Troubleshoot,
 			CollectorFactory: f.dependencies.CollectorFactory,
 			Kubectl:          f.dependencies.Kubectl,
 			Writer:           f.dependencies.Writer,
+			Redactor:         redactor,
 		}
 
 		f.dependencies.DignosticCollectorFactory = diagnostics.NewFactory(opts)
@@ -1498,6 +1675,13 @@ func (f *Factory) WithDiagnosticCollectorImage(diagnosticCollectorImage string)
 	return f
 }
 
+// WithDiagnosticRedactionProfile configures the diagnostic bundle factory to redact the rules of
+// profile from collected bundles, on top of the built-in ones.
+func (f *Factory) WithDiagnosticRedactionProfile(profile *diagnostics.RedactionProfile) *Factory {
+	f.redactionProfile = profile
+	return f
+}
+
 func (f *Factory) WithCollectorFactory() *Factory {
 	f.WithFileReader()
 	f.buildSteps = append(f.buildSteps, func(ctx context.Context) error {
@@ -1579,14 +1763,31 @@ func (f *Factory) WithUnAuthKubeClient() *Factory {
 	return f
 }
 
+// WithVSphereSessionPool initializes the pool of vSphere sessions shared by all govmomi clients.
+// Pooled sessions are logged out when the dependencies are closed.
+func (f *Factory) WithVSphereSessionPool() *Factory {
+	f.buildSteps = append(f.buildSteps, func(ctx context.Context) error {
+		if f.dependencies.VSphereSessionPool != nil {
+			return nil
+		}
+
+		f.dependencies.VSphereSessionPool = govmomi.NewSessionPool()
+		f.dependencies.closers = append(f.dependencies.closers, f.dependencies.VSphereSessionPool)
+
+		return nil
+	})
+
+	return f
+}
+
 func (f *Factory) WithVSphereValidator() *Factory {
-	f.WithGovc()
+	f.WithGovc().WithVSphereSessionPool()
 
 	f.buildSteps = append(f.buildSteps, func(ctx context.Context) error {
 		if f.dependencies.VSphereValidator != nil {
 			return nil
 		}
-		vcb := govmomi.NewVMOMIClientBuilder()
+		vcb := govmomi.NewVMOMIClientBuilderWithSessionPool(f.dependencies.VSphereSessionPool)
 		v := vsphere.NewValidator(
 			f.dependencies.Govc,
 			vcb,
@@ -1607,7 +1808,8 @@ func (f *Factory) WithVSphereDefaulter() *Factory {
 			return nil
 		}
 
-		f.dependencies.VSphereDefaulter = vsphere.NewDefaulter(f.dependencies.Govc)
+		ovaDownloadDir := filepath.Join(f.dependencies.Writer.TempDir(), vsphere.OVADownloadDir)
+		f.dependencies.VSphereDefaulter = vsphere.NewDefaulter(f.dependencies.Govc, vsphere.WithOVADownloadDir(ovaDownloadDir))
 
 		return nil
 	})
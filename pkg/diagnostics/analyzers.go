@@ -167,6 +167,14 @@ func (a *analyzerFactory) DataCenterConfigAnalyzers(datacenter v1alpha1.Ref) []*
 		return a.eksaSnowAnalyzers()
 	case v1alpha1.NutanixDatacenterKind:
 		return a.eksaNutanixAnalyzers()
+	case v1alpha1.ProxmoxDatacenterKind:
+		return a.eksaProxmoxAnalyzers()
+	case v1alpha1.OpenStackDatacenterKind:
+		return a.eksaOpenStackAnalyzers()
+	case v1alpha1.AzureStackHCIDatacenterKind:
+		return a.eksaAzureStackHCIAnalyzers()
+	case v1alpha1.HarvesterDatacenterKind:
+		return a.eksaHarvesterAnalyzers()
 	default:
 		return nil
 	}
@@ -229,6 +237,42 @@ func (a *analyzerFactory) eksaNutanixAnalyzers() []*Analyze {
 	return append(analyzers, a.validControlPlaneIPAnalyzer())
 }
 
+func (a *analyzerFactory) eksaProxmoxAnalyzers() []*Analyze {
+	crds := []string{
+		fmt.Sprintf("proxmoxdatacenterconfigs.%s", v1alpha1.GroupVersion.Group),
+		fmt.Sprintf("proxmoxmachineconfigs.%s", v1alpha1.GroupVersion.Group),
+	}
+	analyzers := a.generateCrdAnalyzers(crds)
+	return append(analyzers, a.validControlPlaneIPAnalyzer())
+}
+
+func (a *analyzerFactory) eksaOpenStackAnalyzers() []*Analyze {
+	crds := []string{
+		fmt.Sprintf("openstackdatacenterconfigs.%s", v1alpha1.GroupVersion.Group),
+		fmt.Sprintf("openstackmachineconfigs.%s", v1alpha1.GroupVersion.Group),
+	}
+	analyzers := a.generateCrdAnalyzers(crds)
+	return append(analyzers, a.validControlPlaneIPAnalyzer())
+}
+
+func (a *analyzerFactory) eksaAzureStackHCIAnalyzers() []*Analyze {
+	crds := []string{
+		fmt.Sprintf("azurestackhcidatacenterconfigs.%s", v1alpha1.GroupVersion.Group),
+		fmt.Sprintf("azurestackhcimachineconfigs.%s", v1alpha1.GroupVersion.Group),
+	}
+	analyzers := a.generateCrdAnalyzers(crds)
+	return append(analyzers, a.validControlPlaneIPAnalyzer())
+}
+
+func (a *analyzerFactory) eksaHarvesterAnalyzers() []*Analyze {
+	crds := []string{
+		fmt.Sprintf("harvesterdatacenterconfigs.%s", v1alpha1.GroupVersion.Group),
+		fmt.Sprintf("harvestermachineconfigs.%s", v1alpha1.GroupVersion.Group),
+	}
+	analyzers := a.generateCrdAnalyzers(crds)
+	return append(analyzers, a.validControlPlaneIPAnalyzer())
+}
+
 // EksaLogTextAnalyzers given a slice of Collectors will check which namespaced log collectors are present
 // and return the log analyzers associated with the namespace in the namespaceLogTextAnalyzersMap.
 func (a *analyzerFactory) EksaLogTextAnalyzers(collectors []*Collect) []*Analyze {
@@ -147,6 +147,14 @@ func (c *EKSACollectorFactory) DataCenterConfigCollectors(datacenter v1alpha1.Re
 		return c.eksaSnowCollectors()
 	case v1alpha1.NutanixDatacenterKind:
 		return c.eksaNutanixCollectors()
+	case v1alpha1.ProxmoxDatacenterKind:
+		return c.eksaProxmoxCollectors()
+	case v1alpha1.OpenStackDatacenterKind:
+		return c.eksaOpenStackCollectors()
+	case v1alpha1.AzureStackHCIDatacenterKind:
+		return c.eksaAzureStackHCICollectors()
+	case v1alpha1.HarvesterDatacenterKind:
+		return c.eksaHarvesterCollectors()
 	default:
 		return nil
 	}
@@ -163,6 +171,50 @@ func (c *EKSACollectorFactory) eksaNutanixCollectors() []*Collect {
 	}
 }
 
+func (c *EKSACollectorFactory) eksaProxmoxCollectors() []*Collect {
+	return []*Collect{
+		{
+			Logs: &logs{
+				Namespace: constants.CapmoxSystemNamespace,
+				Name:      logpath(constants.CapmoxSystemNamespace),
+			},
+		},
+	}
+}
+
+func (c *EKSACollectorFactory) eksaOpenStackCollectors() []*Collect {
+	return []*Collect{
+		{
+			Logs: &logs{
+				Namespace: constants.CapoSystemNamespace,
+				Name:      logpath(constants.CapoSystemNamespace),
+			},
+		},
+	}
+}
+
+func (c *EKSACollectorFactory) eksaAzureStackHCICollectors() []*Collect {
+	return []*Collect{
+		{
+			Logs: &logs{
+				Namespace: constants.CaphSystemNamespace,
+				Name:      logpath(constants.CaphSystemNamespace),
+			},
+		},
+	}
+}
+
+func (c *EKSACollectorFactory) eksaHarvesterCollectors() []*Collect {
+	return []*Collect{
+		{
+			Logs: &logs{
+				Namespace: constants.CaphvSystemNamespace,
+				Name:      logpath(constants.CaphvSystemNamespace),
+			},
+		},
+	}
+}
+
 func (c *EKSACollectorFactory) eksaSnowCollectors() []*Collect {
 	return []*Collect{
 		{
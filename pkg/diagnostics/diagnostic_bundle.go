@@ -45,6 +45,7 @@ type EksaDiagnosticBundle struct {
 	retrier          *retrier.Retrier
 	writer           filewriter.FileWriter
 	analysis         []*executables.SupportBundleAnalysis
+	redactor         *Redactor
 }
 
 func newDiagnosticBundleManagementCluster(af AnalyzerFactory, cf CollectorFactory, spec *cluster.Spec, client BundleClient,
@@ -178,6 +179,15 @@ func (e *EksaDiagnosticBundle) CollectAndAnalyze(ctx context.Context, sinceTimeV
 		return fmt.Errorf("failed to Collect support bundle: %v", err)
 	}
 
+	if e.redactor != nil {
+		logger.Info("Redacting sensitive data from support bundle", "archive", archivePath)
+		summary, err := e.redactor.RedactArchive(archivePath)
+		if err != nil {
+			return fmt.Errorf("redacting support bundle: %v", err)
+		}
+		summary.Log()
+	}
+
 	logger.Info("Support bundle archive created", "path", archivePath)
 
 	logger.Info("Analyzing support bundle", "bundle", e.bundlePath, "archive", archivePath)
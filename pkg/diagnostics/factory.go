@@ -15,6 +15,8 @@ type EksaDiagnosticBundleFactoryOpts struct {
 	CollectorFactory CollectorFactory
 	Kubectl          *executables.Kubectl
 	Writer           filewriter.FileWriter
+	// Redactor masks sensitive data in collected bundles. Bundles are not redacted if nil.
+	Redactor *Redactor
 }
 
 type eksaDiagnosticBundleFactory struct {
@@ -23,6 +25,7 @@ type eksaDiagnosticBundleFactory struct {
 	collectorFactory CollectorFactory
 	kubectl          *executables.Kubectl
 	writer           filewriter.FileWriter
+	redactor         *Redactor
 }
 
 func NewFactory(opts EksaDiagnosticBundleFactoryOpts) *eksaDiagnosticBundleFactory {
@@ -32,6 +35,7 @@ func NewFactory(opts EksaDiagnosticBundleFactoryOpts) *eksaDiagnosticBundleFacto
 		collectorFactory: opts.CollectorFactory,
 		kubectl:          opts.Kubectl,
 		writer:           opts.Writer,
+		redactor:         opts.Redactor,
 	}
 }
 
@@ -44,11 +48,21 @@ func (f *eksaDiagnosticBundleFactory) DiagnosticBundle(spec *cluster.Spec, provi
 }
 
 func (f *eksaDiagnosticBundleFactory) DiagnosticBundleManagementCluster(spec *cluster.Spec, kubeconfig string) (DiagnosticBundle, error) {
-	return newDiagnosticBundleManagementCluster(f.analyzerFactory, f.collectorFactory, spec, f.client, f.kubectl, kubeconfig, f.writer)
+	b, err := newDiagnosticBundleManagementCluster(f.analyzerFactory, f.collectorFactory, spec, f.client, f.kubectl, kubeconfig, f.writer)
+	if err != nil {
+		return nil, err
+	}
+	b.redactor = f.redactor
+	return b, nil
 }
 
 func (f *eksaDiagnosticBundleFactory) DiagnosticBundleWorkloadCluster(spec *cluster.Spec, provider providers.Provider, kubeconfig string, auditLogs bool) (DiagnosticBundle, error) {
-	return newDiagnosticBundleFromSpec(f.analyzerFactory, f.collectorFactory, spec, provider, f.client, f.kubectl, kubeconfig, f.writer, auditLogs)
+	b, err := newDiagnosticBundleFromSpec(f.analyzerFactory, f.collectorFactory, spec, provider, f.client, f.kubectl, kubeconfig, f.writer, auditLogs)
+	if err != nil {
+		return nil, err
+	}
+	b.redactor = f.redactor
+	return b, nil
 }
 
 func (f *eksaDiagnosticBundleFactory) DiagnosticBundleDefault() DiagnosticBundle {
@@ -56,5 +70,7 @@ func (f *eksaDiagnosticBundleFactory) DiagnosticBundleDefault() DiagnosticBundle
 }
 
 func (f *eksaDiagnosticBundleFactory) DiagnosticBundleCustom(kubeconfig string, bundlePath string) DiagnosticBundle {
-	return newDiagnosticBundleCustom(f.analyzerFactory, f.collectorFactory, f.client, f.kubectl, bundlePath, kubeconfig, f.writer)
+	b := newDiagnosticBundleCustom(f.analyzerFactory, f.collectorFactory, f.client, f.kubectl, bundlePath, kubeconfig, f.writer)
+	b.redactor = f.redactor
+	return b
 }
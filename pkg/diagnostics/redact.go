@@ -0,0 +1,245 @@
+package diagnostics
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+
+	"sigs.k8s.io/yaml"
+
+	"github.com/aws/eks-anywhere/pkg/logger"
+)
+
+const redactionMask = "***HIDDEN***"
+
+// RedactionRule redacts every match of Regex in the files of a support bundle. If Regex has
+// capture groups only the first one is masked, which allows keeping keys and masking values.
+type RedactionRule struct {
+	Name  string `json:"name"`
+	Regex string `json:"regex"`
+}
+
+// RedactionProfile is a user provided list of redaction rules, applied on top of the built-in ones.
+type RedactionProfile struct {
+	Rules []RedactionRule `json:"rules"`
+}
+
+// builtInRedactionRules mask credentials that commonly end up in logs and collected objects.
+var builtInRedactionRules = []RedactionRule{
+	{Name: "private-key", Regex: `-----BEGIN [A-Z ]*PRIVATE KEY-----[\s\S]*?-----END [A-Z ]*PRIVATE KEY-----`},
+	{Name: "kubeconfig-key-data", Regex: `client-key-data"?\s*:\s*"?([A-Za-z0-9+/=]+)`},
+	{Name: "password", Regex: `(?i)"?(?:password|passwd|pwd)"?\s*[:=]\s*"?([^\s"',}]+)`},
+	{Name: "token", Regex: `(?i)"?(?:token|secret|api[_-]?key|secret[_-]?access[_-]?key)"?\s*[:=]\s*"?([^\s"',}]+)`},
+	{Name: "bearer-token", Regex: `\bBearer\s+([A-Za-z0-9\-._~+/]+=*)`},
+	{Name: "aws-access-key-id", Regex: `\b((?:AKIA|ASIA)[A-Z0-9]{16})\b`},
+}
+
+// ParseRedactionProfile parses a yaml redaction profile, validating its rules.
+func ParseRedactionProfile(content []byte) (*RedactionProfile, error) {
+	profile := &RedactionProfile{}
+	if err := yaml.UnmarshalStrict(content, profile); err != nil {
+		return nil, fmt.Errorf("parsing redaction profile: %v", err)
+	}
+
+	for i, rule := range profile.Rules {
+		if rule.Regex == "" {
+			return nil, fmt.Errorf("redaction profile rule %d has an empty regex", i)
+		}
+		if _, err := regexp.Compile(rule.Regex); err != nil {
+			return nil, fmt.Errorf("invalid regex in redaction profile rule %d: %v", i, err)
+		}
+		if rule.Name == "" {
+			profile.Rules[i].Name = fmt.Sprintf("custom-%d", i)
+		}
+	}
+
+	return profile, nil
+}
+
+type redactionRegex struct {
+	name  string
+	regex *regexp.Regexp
+}
+
+// Redactor masks sensitive data in support bundle archives.
+type Redactor struct {
+	rules []redactionRegex
+}
+
+// NewRedactor returns a Redactor with the built-in rules and the rules of profile, which can be nil.
+func NewRedactor(profile *RedactionProfile) (*Redactor, error) {
+	rules := append([]RedactionRule{}, builtInRedactionRules...)
+	if profile != nil {
+		rules = append(rules, profile.Rules...)
+	}
+
+	r := &Redactor{}
+	for _, rule := range rules {
+		regex, err := regexp.Compile(rule.Regex)
+		if err != nil {
+			return nil, fmt.Errorf("compiling redaction rule %s: %v", rule.Name, err)
+		}
+		r.rules = append(r.rules, redactionRegex{name: rule.Name, regex: regex})
+	}
+
+	return r, nil
+}
+
+// RedactionSummary counts what was redacted from a support bundle.
+type RedactionSummary struct {
+	// Matches is the number of redacted matches per rule name.
+	Matches map[string]int
+	// Files are the files in the archive that had at least one match.
+	Files []string
+}
+
+// Log prints the summary.
+func (s RedactionSummary) Log() {
+	if len(s.Files) == 0 {
+		logger.Info("No sensitive data found to redact in support bundle")
+		return
+	}
+
+	logger.Info("Redacted sensitive data from support bundle", "files", len(s.Files))
+	names := make([]string, 0, len(s.Matches))
+	for name := range s.Matches {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		logger.Info("Redaction", "rule", name, "matches", s.Matches[name])
+	}
+	for _, file := range s.Files {
+		logger.V(3).Info("Redacted file", "path", file)
+	}
+}
+
+// Redact masks the matches of all rules in content, counting them in summary.
+func (r *Redactor) Redact(content []byte, summary *RedactionSummary) []byte {
+	for _, rule := range r.rules {
+		matches := 0
+		content = rule.regex.ReplaceAllFunc(content, func(match []byte) []byte {
+			matches++
+			if rule.regex.NumSubexp() == 0 {
+				return []byte(redactionMask)
+			}
+			loc := rule.regex.FindSubmatchIndex(match)
+			if len(loc) < 4 || loc[2] < 0 {
+				return match
+			}
+			redacted := append([]byte{}, match[:loc[2]]...)
+			redacted = append(redacted, redactionMask...)
+			return append(redacted, match[loc[3]:]...)
+		})
+		if matches > 0 {
+			summary.Matches[rule.name] += matches
+		}
+	}
+
+	return content
+}
+
+// RedactArchive rewrites the gzipped tar archive at archivePath masking the sensitive data of
+// its text files.
+func (r *Redactor) RedactArchive(archivePath string) (RedactionSummary, error) {
+	summary := RedactionSummary{Matches: map[string]int{}}
+
+	in, err := os.Open(archivePath)
+	if err != nil {
+		return summary, fmt.Errorf("opening support bundle archive: %v", err)
+	}
+	defer in.Close()
+
+	info, err := in.Stat()
+	if err != nil {
+		return summary, fmt.Errorf("reading support bundle archive: %v", err)
+	}
+
+	gzipReader, err := gzip.NewReader(in)
+	if err != nil {
+		return summary, fmt.Errorf("reading support bundle archive: %v", err)
+	}
+	defer gzipReader.Close()
+
+	out, err := os.CreateTemp(filepath.Dir(archivePath), filepath.Base(archivePath)+".redacted-*")
+	if err != nil {
+		return summary, fmt.Errorf("creating redacted support bundle archive: %v", err)
+	}
+	defer os.Remove(out.Name())
+	defer out.Close()
+
+	gzipWriter := gzip.NewWriter(out)
+	tarWriter := tar.NewWriter(gzipWriter)
+	tarReader := tar.NewReader(gzipReader)
+	for {
+		header, err := tarReader.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return summary, fmt.Errorf("reading support bundle archive: %v", err)
+		}
+
+		if header.Typeflag != tar.TypeReg {
+			if err := tarWriter.WriteHeader(header); err != nil {
+				return summary, fmt.Errorf("writing redacted support bundle archive: %v", err)
+			}
+			continue
+		}
+
+		content, err := io.ReadAll(tarReader)
+		if err != nil {
+			return summary, fmt.Errorf("reading %s from support bundle archive: %v", header.Name, err)
+		}
+
+		// Binary files are copied as is.
+		if !bytes.Contains(content, []byte{0}) {
+			total := summary.totalMatches()
+			content = r.Redact(content, &summary)
+			if summary.totalMatches() > total {
+				summary.Files = append(summary.Files, header.Name)
+			}
+		}
+
+		header.Size = int64(len(content))
+		if err := tarWriter.WriteHeader(header); err != nil {
+			return summary, fmt.Errorf("writing redacted support bundle archive: %v", err)
+		}
+		if _, err := tarWriter.Write(content); err != nil {
+			return summary, fmt.Errorf("writing redacted support bundle archive: %v", err)
+		}
+	}
+
+	if err := tarWriter.Close(); err != nil {
+		return summary, fmt.Errorf("writing redacted support bundle archive: %v", err)
+	}
+	if err := gzipWriter.Close(); err != nil {
+		return summary, fmt.Errorf("writing redacted support bundle archive: %v", err)
+	}
+	if err := out.Chmod(info.Mode()); err != nil {
+		return summary, fmt.Errorf("writing redacted support bundle archive: %v", err)
+	}
+	if err := out.Close(); err != nil {
+		return summary, fmt.Errorf("writing redacted support bundle archive: %v", err)
+	}
+
+	if err := os.Rename(out.Name(), archivePath); err != nil {
+		return summary, fmt.Errorf("replacing support bundle archive with redacted one: %v", err)
+	}
+
+	return summary, nil
+}
+
+func (s RedactionSummary) totalMatches() int {
+	total := 0
+	for _, n := range s.Matches {
+		total += n
+	}
+	return total
+}
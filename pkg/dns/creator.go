@@ -0,0 +1,20 @@
+package dns
+
+import (
+	"context"
+	"fmt"
+
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+)
+
+// NewRecordCreator returns the RecordCreator for the provider of the endpoint DNS configuration.
+func NewRecordCreator(_ context.Context, config *anywherev1.EndpointDNS) (RecordCreator, error) {
+	switch config.Provider {
+	case anywherev1.Route53DNSProvider:
+		return NewRoute53RecordCreatorFromEnv(config.Zone)
+	case anywherev1.InfobloxDNSProvider:
+		return NewInfobloxRecordCreatorFromEnv(config.Zone)
+	default:
+		return nil, fmt.Errorf("dns provider %s is not supported", config.Provider)
+	}
+}
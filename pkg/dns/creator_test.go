@@ -0,0 +1,30 @@
+package dns_test
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/constants"
+	"github.com/aws/eks-anywhere/pkg/dns"
+)
+
+func TestNewRecordCreatorInfoblox(t *testing.T) {
+	g := NewWithT(t)
+	t.Setenv(constants.EksaInfobloxURLKey, "https://infoblox.example.com/wapi/v2.12")
+	t.Setenv(constants.EksaInfobloxUsernameKey, "admin")
+	t.Setenv(constants.EksaInfobloxPasswordKey, "secret")
+
+	c, err := dns.NewRecordCreator(context.Background(), &anywherev1.EndpointDNS{Provider: anywherev1.InfobloxDNSProvider})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(c).To(BeAssignableToTypeOf(&dns.InfobloxRecordCreator{}))
+}
+
+func TestNewRecordCreatorUnsupportedProvider(t *testing.T) {
+	g := NewWithT(t)
+
+	_, err := dns.NewRecordCreator(context.Background(), &anywherev1.EndpointDNS{Provider: "bind"})
+	g.Expect(err).To(MatchError("dns provider bind is not supported"))
+}
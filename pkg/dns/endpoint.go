@@ -0,0 +1,146 @@
+package dns
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/logger"
+	"github.com/aws/eks-anywhere/pkg/retrier"
+)
+
+const (
+	propagationTimeout = 5 * time.Minute
+	propagationBackoff = 10 * time.Second
+)
+
+// Resolver resolves hostnames and addresses. net.Resolver implements it.
+type Resolver interface {
+	LookupHost(ctx context.Context, host string) ([]string, error)
+	LookupAddr(ctx context.Context, addr string) ([]string, error)
+}
+
+// RecordCreator creates the A record of a hostname.
+type RecordCreator interface {
+	EnsureRecord(ctx context.Context, hostname, address string) error
+}
+
+// RecordCreatorFactory builds the RecordCreator for an endpoint DNS configuration.
+type RecordCreatorFactory func(ctx context.Context, config *anywherev1.EndpointDNS) (RecordCreator, error)
+
+// EndpointValidator checks a control plane endpoint hostname resolves from the admin machine,
+// creating its record first when the endpoint configures one.
+type EndpointValidator struct {
+	resolver           Resolver
+	newRecordCreator   RecordCreatorFactory
+	propagationRetrier *retrier.Retrier
+}
+
+// EndpointValidatorOpt configures an EndpointValidator.
+type EndpointValidatorOpt func(*EndpointValidator)
+
+// WithRecordCreatorFactory sets the factory used to build the RecordCreator for the endpoint DNS provider.
+func WithRecordCreatorFactory(f RecordCreatorFactory) EndpointValidatorOpt {
+	return func(v *EndpointValidator) {
+		v.newRecordCreator = f
+	}
+}
+
+// WithPropagationRetrier sets the retrier used to wait for a new record to resolve.
+func WithPropagationRetrier(r *retrier.Retrier) EndpointValidatorOpt {
+	return func(v *EndpointValidator) {
+		v.propagationRetrier = r
+	}
+}
+
+// NewEndpointValidator returns a new EndpointValidator.
+func NewEndpointValidator(resolver Resolver, opts ...EndpointValidatorOpt) *EndpointValidator {
+	v := &EndpointValidator{
+		resolver:           resolver,
+		newRecordCreator:   NewRecordCreator,
+		propagationRetrier: retrier.New(propagationTimeout, retrier.WithRetryPolicy(retrier.BackOffPolicy(propagationBackoff))),
+	}
+	for _, opt := range opts {
+		opt(v)
+	}
+
+	return v
+}
+
+// ValidateEndpoint creates the record of the endpoint hostname if the endpoint configures one and
+// checks the hostname resolves to the record address, and back to the hostname. Endpoints set to an
+// IP address are not validated.
+func (v *EndpointValidator) ValidateEndpoint(ctx context.Context, endpoint *anywherev1.Endpoint) error {
+	if endpoint == nil {
+		return nil
+	}
+
+	hostname := endpoint.Hostname()
+	if net.ParseIP(hostname) != nil {
+		return nil
+	}
+
+	if endpoint.DNS == nil {
+		return v.validateResolution(ctx, hostname, "")
+	}
+
+	creator, err := v.newRecordCreator(ctx, endpoint.DNS)
+	if err != nil {
+		return err
+	}
+
+	logger.Info("Creating control plane endpoint DNS record", "hostname", hostname, "address", endpoint.DNS.Address, "provider", endpoint.DNS.Provider)
+	if err := creator.EnsureRecord(ctx, hostname, endpoint.DNS.Address); err != nil {
+		return fmt.Errorf("creating %s DNS record for %s: %v", endpoint.DNS.Provider, hostname, err)
+	}
+
+	return v.propagationRetrier.Retry(func() error {
+		return v.validateResolution(ctx, hostname, endpoint.DNS.Address)
+	})
+}
+
+func (v *EndpointValidator) validateResolution(ctx context.Context, hostname, address string) error {
+	addresses, err := v.resolver.LookupHost(ctx, hostname)
+	if err != nil {
+		return fmt.Errorf("resolving control plane endpoint %s: %v", hostname, err)
+	}
+
+	if address != "" && !contains(addresses, address) {
+		return fmt.Errorf("control plane endpoint %s resolves to %s, expected %s", hostname, strings.Join(addresses, ", "), address)
+	}
+
+	if address == "" {
+		address = addresses[0]
+	}
+
+	names, err := v.resolver.LookupAddr(ctx, address)
+	var dnsErr *net.DNSError
+	if errors.As(err, &dnsErr) && dnsErr.IsNotFound {
+		logger.Info("Warning: control plane endpoint address has no reverse DNS record", "address", address, "hostname", hostname)
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("reverse resolving control plane endpoint address %s: %v", address, err)
+	}
+
+	for _, name := range names {
+		if strings.EqualFold(strings.TrimSuffix(name, "."), hostname) {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("control plane endpoint address %s reverse resolves to %s, expected %s", address, strings.Join(names, ", "), hostname)
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
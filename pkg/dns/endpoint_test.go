@@ -0,0 +1,172 @@
+package dns_test
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/dns"
+	"github.com/aws/eks-anywhere/pkg/retrier"
+)
+
+type fakeResolver struct {
+	hosts map[string][]string
+	addrs map[string][]string
+}
+
+func (r *fakeResolver) LookupHost(_ context.Context, host string) ([]string, error) {
+	addresses, ok := r.hosts[host]
+	if !ok {
+		return nil, &net.DNSError{Err: "no such host", Name: host, IsNotFound: true}
+	}
+	return addresses, nil
+}
+
+func (r *fakeResolver) LookupAddr(_ context.Context, addr string) ([]string, error) {
+	names, ok := r.addrs[addr]
+	if !ok {
+		return nil, &net.DNSError{Err: "no such host", Name: addr, IsNotFound: true}
+	}
+	return names, nil
+}
+
+type fakeRecordCreator struct {
+	resolver *fakeResolver
+	err      error
+	records  map[string]string
+}
+
+func (c *fakeRecordCreator) EnsureRecord(_ context.Context, hostname, address string) error {
+	if c.err != nil {
+		return c.err
+	}
+	c.records[hostname] = address
+	c.resolver.hosts[hostname] = []string{address}
+	return nil
+}
+
+func newValidator(resolver *fakeResolver, creator dns.RecordCreator) *dns.EndpointValidator {
+	return dns.NewEndpointValidator(resolver,
+		dns.WithRecordCreatorFactory(func(context.Context, *anywherev1.EndpointDNS) (dns.RecordCreator, error) {
+			return creator, nil
+		}),
+		dns.WithPropagationRetrier(retrier.NewWithMaxRetries(2, time.Millisecond)),
+	)
+}
+
+func TestEndpointValidatorValidateEndpoint(t *testing.T) {
+	tests := []struct {
+		name     string
+		endpoint *anywherev1.Endpoint
+		hosts    map[string][]string
+		addrs    map[string][]string
+		wantErr  string
+	}{
+		{
+			name:     "nil endpoint",
+			endpoint: nil,
+		},
+		{
+			name:     "ip endpoint",
+			endpoint: &anywherev1.Endpoint{Host: "1.2.3.4"},
+		},
+		{
+			name:     "hostname resolves forward and reverse",
+			endpoint: &anywherev1.Endpoint{Host: "api.example.com:6443"},
+			hosts:    map[string][]string{"api.example.com": {"1.2.3.4"}},
+			addrs:    map[string][]string{"1.2.3.4": {"API.example.com."}},
+		},
+		{
+			name:     "hostname without reverse record",
+			endpoint: &anywherev1.Endpoint{Host: "api.example.com"},
+			hosts:    map[string][]string{"api.example.com": {"1.2.3.4"}},
+		},
+		{
+			name:     "hostname does not resolve",
+			endpoint: &anywherev1.Endpoint{Host: "api.example.com"},
+			wantErr:  "resolving control plane endpoint api.example.com",
+		},
+		{
+			name:     "reverse record points to another hostname",
+			endpoint: &anywherev1.Endpoint{Host: "api.example.com"},
+			hosts:    map[string][]string{"api.example.com": {"1.2.3.4"}},
+			addrs:    map[string][]string{"1.2.3.4": {"other.example.com."}},
+			wantErr:  "control plane endpoint address 1.2.3.4 reverse resolves to other.example.com., expected api.example.com",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			resolver := &fakeResolver{hosts: tt.hosts, addrs: tt.addrs}
+			v := newValidator(resolver, nil)
+
+			err := v.ValidateEndpoint(context.Background(), tt.endpoint)
+			if tt.wantErr == "" {
+				g.Expect(err).To(Succeed())
+			} else {
+				g.Expect(err).To(MatchError(ContainSubstring(tt.wantErr)))
+			}
+		})
+	}
+}
+
+func TestEndpointValidatorValidateEndpointCreatesRecord(t *testing.T) {
+	g := NewWithT(t)
+	resolver := &fakeResolver{hosts: map[string][]string{}, addrs: map[string][]string{}}
+	creator := &fakeRecordCreator{resolver: resolver, records: map[string]string{}}
+	v := newValidator(resolver, creator)
+	endpoint := &anywherev1.Endpoint{
+		Host: "api.example.com",
+		DNS: &anywherev1.EndpointDNS{
+			Provider: anywherev1.InfobloxDNSProvider,
+			Address:  "1.2.3.4",
+		},
+	}
+
+	g.Expect(v.ValidateEndpoint(context.Background(), endpoint)).To(Succeed())
+	g.Expect(creator.records).To(Equal(map[string]string{"api.example.com": "1.2.3.4"}))
+}
+
+func TestEndpointValidatorValidateEndpointCreateRecordError(t *testing.T) {
+	g := NewWithT(t)
+	resolver := &fakeResolver{hosts: map[string][]string{}, addrs: map[string][]string{}}
+	creator := &fakeRecordCreator{resolver: resolver, err: errors.New("unauthorized")}
+	v := newValidator(resolver, creator)
+	endpoint := &anywherev1.Endpoint{
+		Host: "api.example.com",
+		DNS: &anywherev1.EndpointDNS{
+			Provider: anywherev1.Route53DNSProvider,
+			Address:  "1.2.3.4",
+			Zone:     "Z123",
+		},
+	}
+
+	g.Expect(v.ValidateEndpoint(context.Background(), endpoint)).To(MatchError("creating route53 DNS record for api.example.com: unauthorized"))
+}
+
+func TestEndpointValidatorValidateEndpointRecordResolvesToOtherAddress(t *testing.T) {
+	g := NewWithT(t)
+	resolver := &fakeResolver{hosts: map[string][]string{"api.example.com": {"5.6.7.8"}}}
+	v := newValidator(resolver, noopRecordCreator{})
+	endpoint := &anywherev1.Endpoint{
+		Host: "api.example.com",
+		DNS: &anywherev1.EndpointDNS{
+			Provider: anywherev1.InfobloxDNSProvider,
+			Address:  "1.2.3.4",
+		},
+	}
+
+	g.Expect(v.ValidateEndpoint(context.Background(), endpoint)).To(MatchError("control plane endpoint api.example.com resolves to 5.6.7.8, expected 1.2.3.4"))
+}
+
+type noopRecordCreator struct{}
+
+func (noopRecordCreator) EnsureRecord(context.Context, string, string) error {
+	return nil
+}
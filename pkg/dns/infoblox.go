@@ -0,0 +1,165 @@
+package dns
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/aws/eks-anywhere/pkg/constants"
+)
+
+const defaultInfobloxView = "default"
+
+// InfobloxRecordCreator creates host records through the Infoblox WAPI.
+type InfobloxRecordCreator struct {
+	client   *http.Client
+	baseURL  string
+	username string
+	password string
+	view     string
+}
+
+type infobloxHostRecord struct {
+	Name            string             `json:"name,omitempty"`
+	View            string             `json:"view,omitempty"`
+	ConfigureForDNS bool               `json:"configure_for_dns"`
+	IPv4Addrs       []infobloxIPv4Addr `json:"ipv4addrs"`
+}
+
+type infobloxIPv4Addr struct {
+	IPv4Addr string `json:"ipv4addr"`
+}
+
+// NewInfobloxRecordCreator returns an InfobloxRecordCreator for the WAPI at baseURL. An empty view
+// uses the Infoblox default view.
+func NewInfobloxRecordCreator(client *http.Client, baseURL, username, password, view string) *InfobloxRecordCreator {
+	if view == "" {
+		view = defaultInfobloxView
+	}
+
+	return &InfobloxRecordCreator{
+		client:   client,
+		baseURL:  strings.TrimSuffix(baseURL, "/"),
+		username: username,
+		password: password,
+		view:     view,
+	}
+}
+
+// NewInfobloxRecordCreatorFromEnv returns an InfobloxRecordCreator configured from the
+// EKSA_INFOBLOX_* environment variables.
+func NewInfobloxRecordCreatorFromEnv(view string) (*InfobloxRecordCreator, error) {
+	baseURL, ok := os.LookupEnv(constants.EksaInfobloxURLKey)
+	if !ok || baseURL == "" {
+		return nil, fmt.Errorf("%s is not set or is empty", constants.EksaInfobloxURLKey)
+	}
+	username, ok := os.LookupEnv(constants.EksaInfobloxUsernameKey)
+	if !ok || username == "" {
+		return nil, fmt.Errorf("%s is not set or is empty", constants.EksaInfobloxUsernameKey)
+	}
+	password, ok := os.LookupEnv(constants.EksaInfobloxPasswordKey)
+	if !ok || password == "" {
+		return nil, fmt.Errorf("%s is not set or is empty", constants.EksaInfobloxPasswordKey)
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if os.Getenv(constants.EksaInfobloxInsecureKey) == "true" {
+		transport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
+	}
+
+	return NewInfobloxRecordCreator(&http.Client{Transport: transport}, baseURL, username, password, view), nil
+}
+
+// EnsureRecord creates a host record for hostname pointing to address. It succeeds if the record
+// already exists with the same address and fails if it exists with a different one.
+func (c *InfobloxRecordCreator) EnsureRecord(ctx context.Context, hostname, address string) error {
+	existing, err := c.getHostRecords(ctx, hostname)
+	if err != nil {
+		return err
+	}
+
+	for _, record := range existing {
+		for _, addr := range record.IPv4Addrs {
+			if addr.IPv4Addr == address {
+				return nil
+			}
+		}
+		return fmt.Errorf("infoblox host record %s already exists in view %s with a different address", hostname, c.view)
+	}
+
+	body, err := json.Marshal(infobloxHostRecord{
+		Name:            hostname,
+		View:            c.view,
+		ConfigureForDNS: true,
+		IPv4Addrs:       []infobloxIPv4Addr{{IPv4Addr: address}},
+	})
+	if err != nil {
+		return fmt.Errorf("marshalling infoblox host record: %v", err)
+	}
+
+	resp, err := c.do(ctx, http.MethodPost, c.baseURL+"/record:host", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusCreated {
+		return infobloxResponseError("creating host record", resp)
+	}
+
+	return nil
+}
+
+func (c *InfobloxRecordCreator) getHostRecords(ctx context.Context, hostname string) ([]infobloxHostRecord, error) {
+	query := url.Values{}
+	query.Set("name", hostname)
+	query.Set("view", c.view)
+	query.Set("_return_fields", "ipv4addrs")
+
+	resp, err := c.do(ctx, http.MethodGet, c.baseURL+"/record:host?"+query.Encode(), nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, infobloxResponseError("getting host records", resp)
+	}
+
+	records := []infobloxHostRecord{}
+	if err := json.NewDecoder(resp.Body).Decode(&records); err != nil {
+		return nil, fmt.Errorf("decoding infoblox host records: %v", err)
+	}
+
+	return records, nil
+}
+
+func (c *InfobloxRecordCreator) do(ctx context.Context, method, url string, body io.Reader) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, method, url, body)
+	if err != nil {
+		return nil, fmt.Errorf("building infoblox request: %v", err)
+	}
+	req.SetBasicAuth(c.username, c.password)
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("calling infoblox: %v", err)
+	}
+
+	return resp, nil
+}
+
+func infobloxResponseError(action string, resp *http.Response) error {
+	body, _ := io.ReadAll(resp.Body)
+	return fmt.Errorf("%s in infoblox: status %d: %s", action, resp.StatusCode, strings.TrimSpace(string(body)))
+}
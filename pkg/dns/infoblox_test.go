@@ -0,0 +1,112 @@
+package dns_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/aws/eks-anywhere/pkg/constants"
+	"github.com/aws/eks-anywhere/pkg/dns"
+)
+
+type infobloxServer struct {
+	existing string
+	created  map[string]interface{}
+}
+
+func (s *infobloxServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if user, pass, ok := r.BasicAuth(); !ok || user != "admin" || pass != "secret" {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		if r.URL.Query().Get("view") != "default" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(s.existing))
+	case http.MethodPost:
+		_ = json.NewDecoder(r.Body).Decode(&s.created)
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`"record:host/ZG5z:api.example.com/default"`))
+	}
+}
+
+func TestInfobloxRecordCreatorEnsureRecordCreates(t *testing.T) {
+	g := NewWithT(t)
+	s := &infobloxServer{existing: "[]"}
+	server := httptest.NewServer(s)
+	defer server.Close()
+
+	c := dns.NewInfobloxRecordCreator(server.Client(), server.URL+"/wapi/v2.12/", "admin", "secret", "")
+
+	g.Expect(c.EnsureRecord(context.Background(), "api.example.com", "1.2.3.4")).To(Succeed())
+	g.Expect(s.created).To(Equal(map[string]interface{}{
+		"name":              "api.example.com",
+		"view":              "default",
+		"configure_for_dns": true,
+		"ipv4addrs":         []interface{}{map[string]interface{}{"ipv4addr": "1.2.3.4"}},
+	}))
+}
+
+func TestInfobloxRecordCreatorEnsureRecordExists(t *testing.T) {
+	g := NewWithT(t)
+	s := &infobloxServer{existing: `[{"ipv4addrs":[{"ipv4addr":"1.2.3.4"}]}]`}
+	server := httptest.NewServer(s)
+	defer server.Close()
+
+	c := dns.NewInfobloxRecordCreator(server.Client(), server.URL, "admin", "secret", "default")
+
+	g.Expect(c.EnsureRecord(context.Background(), "api.example.com", "1.2.3.4")).To(Succeed())
+	g.Expect(s.created).To(BeNil())
+}
+
+func TestInfobloxRecordCreatorEnsureRecordExistsWithOtherAddress(t *testing.T) {
+	g := NewWithT(t)
+	s := &infobloxServer{existing: `[{"ipv4addrs":[{"ipv4addr":"5.6.7.8"}]}]`}
+	server := httptest.NewServer(s)
+	defer server.Close()
+
+	c := dns.NewInfobloxRecordCreator(server.Client(), server.URL, "admin", "secret", "")
+
+	g.Expect(c.EnsureRecord(context.Background(), "api.example.com", "1.2.3.4")).To(
+		MatchError("infoblox host record api.example.com already exists in view default with a different address"),
+	)
+}
+
+func TestInfobloxRecordCreatorEnsureRecordUnauthorized(t *testing.T) {
+	g := NewWithT(t)
+	server := httptest.NewServer(&infobloxServer{})
+	defer server.Close()
+
+	c := dns.NewInfobloxRecordCreator(server.Client(), server.URL, "admin", "wrong", "")
+
+	g.Expect(c.EnsureRecord(context.Background(), "api.example.com", "1.2.3.4")).To(
+		MatchError(ContainSubstring("getting host records in infoblox: status 401")),
+	)
+}
+
+func TestNewInfobloxRecordCreatorFromEnvMissingURL(t *testing.T) {
+	g := NewWithT(t)
+	t.Setenv(constants.EksaInfobloxURLKey, "")
+
+	_, err := dns.NewInfobloxRecordCreatorFromEnv("")
+	g.Expect(err).To(MatchError("EKSA_INFOBLOX_URL is not set or is empty"))
+}
+
+func TestNewInfobloxRecordCreatorFromEnvMissingPassword(t *testing.T) {
+	g := NewWithT(t)
+	t.Setenv(constants.EksaInfobloxURLKey, "https://infoblox.example.com/wapi/v2.12")
+	t.Setenv(constants.EksaInfobloxUsernameKey, "admin")
+	t.Setenv(constants.EksaInfobloxPasswordKey, "")
+
+	_, err := dns.NewInfobloxRecordCreatorFromEnv("")
+	g.Expect(err).To(MatchError("EKSA_INFOBLOX_PASSWORD is not set or is empty"))
+}
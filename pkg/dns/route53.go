@@ -0,0 +1,73 @@
+package dns
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/route53"
+)
+
+const recordTTL = 300
+
+// Route53Client is the subset of the route53 API used to create records.
+type Route53Client interface {
+	ChangeResourceRecordSetsWithContext(ctx aws.Context, input *route53.ChangeResourceRecordSetsInput, opts ...request.Option) (*route53.ChangeResourceRecordSetsOutput, error)
+}
+
+// Route53RecordCreator upserts A records in a route53 hosted zone.
+type Route53RecordCreator struct {
+	client       Route53Client
+	hostedZoneID string
+}
+
+// NewRoute53RecordCreator returns a Route53RecordCreator for the given hosted zone.
+func NewRoute53RecordCreator(client Route53Client, hostedZoneID string) *Route53RecordCreator {
+	return &Route53RecordCreator{
+		client:       client,
+		hostedZoneID: hostedZoneID,
+	}
+}
+
+// NewRoute53RecordCreatorFromEnv returns a Route53RecordCreator using the credentials and region
+// of the default AWS credential chain and shared config.
+func NewRoute53RecordCreatorFromEnv(hostedZoneID string) (*Route53RecordCreator, error) {
+	sess, err := session.NewSessionWithOptions(session.Options{
+		SharedConfigState: session.SharedConfigEnable,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("creating aws session for route53: %v", err)
+	}
+
+	return NewRoute53RecordCreator(route53.New(sess), hostedZoneID), nil
+}
+
+// EnsureRecord upserts an A record for hostname pointing to address.
+func (r *Route53RecordCreator) EnsureRecord(ctx context.Context, hostname, address string) error {
+	_, err := r.client.ChangeResourceRecordSetsWithContext(ctx, &route53.ChangeResourceRecordSetsInput{
+		HostedZoneId: aws.String(r.hostedZoneID),
+		ChangeBatch: &route53.ChangeBatch{
+			Comment: aws.String("EKS Anywhere control plane endpoint"),
+			Changes: []*route53.Change{
+				{
+					Action: aws.String(route53.ChangeActionUpsert),
+					ResourceRecordSet: &route53.ResourceRecordSet{
+						Name: aws.String(hostname),
+						Type: aws.String(route53.RRTypeA),
+						TTL:  aws.Int64(recordTTL),
+						ResourceRecords: []*route53.ResourceRecord{
+							{Value: aws.String(address)},
+						},
+					},
+				},
+			},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("upserting route53 record in hosted zone %s: %v", r.hostedZoneID, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,49 @@
+package dns_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/route53"
+	. "github.com/onsi/gomega"
+
+	"github.com/aws/eks-anywhere/pkg/dns"
+)
+
+type fakeRoute53Client struct {
+	input *route53.ChangeResourceRecordSetsInput
+	err   error
+}
+
+func (c *fakeRoute53Client) ChangeResourceRecordSetsWithContext(_ aws.Context, input *route53.ChangeResourceRecordSetsInput, _ ...request.Option) (*route53.ChangeResourceRecordSetsOutput, error) {
+	c.input = input
+	return &route53.ChangeResourceRecordSetsOutput{}, c.err
+}
+
+func TestRoute53RecordCreatorEnsureRecord(t *testing.T) {
+	g := NewWithT(t)
+	client := &fakeRoute53Client{}
+	c := dns.NewRoute53RecordCreator(client, "Z123")
+
+	g.Expect(c.EnsureRecord(context.Background(), "api.example.com", "1.2.3.4")).To(Succeed())
+	g.Expect(aws.StringValue(client.input.HostedZoneId)).To(Equal("Z123"))
+	g.Expect(client.input.ChangeBatch.Changes).To(HaveLen(1))
+	change := client.input.ChangeBatch.Changes[0]
+	g.Expect(aws.StringValue(change.Action)).To(Equal(route53.ChangeActionUpsert))
+	g.Expect(aws.StringValue(change.ResourceRecordSet.Name)).To(Equal("api.example.com"))
+	g.Expect(aws.StringValue(change.ResourceRecordSet.Type)).To(Equal(route53.RRTypeA))
+	g.Expect(aws.Int64Value(change.ResourceRecordSet.TTL)).To(Equal(int64(300)))
+	g.Expect(aws.StringValue(change.ResourceRecordSet.ResourceRecords[0].Value)).To(Equal("1.2.3.4"))
+}
+
+func TestRoute53RecordCreatorEnsureRecordError(t *testing.T) {
+	g := NewWithT(t)
+	c := dns.NewRoute53RecordCreator(&fakeRoute53Client{err: errors.New("access denied")}, "Z123")
+
+	g.Expect(c.EnsureRecord(context.Background(), "api.example.com", "1.2.3.4")).To(
+		MatchError("upserting route53 record in hosted zone Z123: access denied"),
+	)
+}
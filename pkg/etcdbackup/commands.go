@@ -0,0 +1,135 @@
+package etcdbackup
+
+import (
+	"fmt"
+	"net"
+)
+
+const (
+	etcdDataDir       = "/var/lib/etcd"
+	remoteSnapshot    = etcdDataDir + "/eksa-snapshot.db"
+	restoreDataDir    = etcdDataDir + "/eksa-restore"
+	staticPodsDir     = "/etc/kubernetes/manifests"
+	stoppedPodsDir    = "/etc/kubernetes/eksa-stopped-manifests"
+	stackedEtcdPKIDir = "/etc/kubernetes/pki/etcd"
+	externalEtcdEnv   = "/etc/etcd/etcd.env"
+	externalEtcdctl   = "/etc/etcd/etcdctl.env"
+)
+
+// topology builds the commands to snapshot and restore etcd, which depend on whether etcd runs
+// as a static pod of the control plane or as a service of dedicated machines. All commands are
+// shell commands run as root by a Runner.
+type topology interface {
+	// snapshotSave takes a snapshot of the member at the ip to remoteSnapshot.
+	snapshotSave(ip string) string
+	// memberConfig prints the name and peer URL of the member as key=value lines.
+	memberConfig() string
+	// memberKeys returns the keys of the name and peer URL printed by memberConfig.
+	memberKeys() (name, peerURLs string)
+	// restore restores remoteSnapshot to restoreDataDir for the member.
+	restore(m member, initialCluster, token string) string
+	// stopEtcd and startEtcd stop and start the etcd of a member, if it doesn't run as a static pod.
+	stopEtcd() string
+	startEtcd() string
+}
+
+// member is an etcd member, identified the way it was started.
+type member struct {
+	name     string
+	peerURLs string
+}
+
+// stackedTopology runs etcdctl and etcdutl in the etcd static pod created by kubeadm, which
+// mounts the etcd data dir of the host.
+type stackedTopology struct{}
+
+func (stackedTopology) etcdContainer(cmd string) string {
+	return "crictl exec $(crictl ps -q --name '^etcd$' --state running) " + cmd
+}
+
+func (t stackedTopology) snapshotSave(ip string) string {
+	return t.etcdContainer(fmt.Sprintf("etcdctl --endpoints=https://%[1]s --cacert=%[2]s/ca.crt --cert=%[2]s/server.crt --key=%[2]s/server.key snapshot save %[3]s",
+		net.JoinHostPort(ip, "2379"), stackedEtcdPKIDir, remoteSnapshot))
+}
+
+func (stackedTopology) memberConfig() string {
+	return fmt.Sprintf("grep -oE -- '--(name|initial-advertise-peer-urls)=.*' %s/etcd.yaml", staticPodsDir)
+}
+
+func (stackedTopology) memberKeys() (name, peerURLs string) {
+	return "--name", "--initial-advertise-peer-urls"
+}
+
+func (t stackedTopology) restore(m member, initialCluster, token string) string {
+	return t.etcdContainer(restoreCommand(m, initialCluster, token))
+}
+
+func (stackedTopology) stopEtcd() string {
+	return ""
+}
+
+func (stackedTopology) startEtcd() string {
+	return ""
+}
+
+// externalTopology runs etcdctl and etcdutl on the etcd machines created by etcdadm, where etcd
+// runs as a systemd service.
+type externalTopology struct{}
+
+func (externalTopology) snapshotSave(_ string) string {
+	return fmt.Sprintf(". %s && etcdctl snapshot save %s", externalEtcdctl, remoteSnapshot)
+}
+
+func (externalTopology) memberConfig() string {
+	return fmt.Sprintf("grep -E '^(ETCD_NAME|ETCD_INITIAL_ADVERTISE_PEER_URLS)=' %s", externalEtcdEnv)
+}
+
+func (externalTopology) memberKeys() (name, peerURLs string) {
+	return "ETCD_NAME", "ETCD_INITIAL_ADVERTISE_PEER_URLS"
+}
+
+func (externalTopology) restore(m member, initialCluster, token string) string {
+	return restoreCommand(m, initialCluster, token)
+}
+
+func (externalTopology) stopEtcd() string {
+	return "systemctl stop etcd"
+}
+
+func (externalTopology) startEtcd() string {
+	return "systemctl start etcd"
+}
+
+func restoreCommand(m member, initialCluster, token string) string {
+	return fmt.Sprintf("etcdutl snapshot restore %s --name=%s --initial-cluster=%s --initial-cluster-token=%s --initial-advertise-peer-urls=%s --data-dir=%s",
+		remoteSnapshot, m.name, initialCluster, token, m.peerURLs, restoreDataDir)
+}
+
+func readSnapshotCommand() string {
+	return "cat " + remoteSnapshot
+}
+
+func writeSnapshotCommand() string {
+	return fmt.Sprintf("rm -rf %s && cat > %s", restoreDataDir, remoteSnapshot)
+}
+
+func removeSnapshotCommand() string {
+	return "rm -f " + remoteSnapshot
+}
+
+// stopControlPlaneCommand stops the static pods of a control plane machine by moving their
+// manifests out of the kubelet manifests dir, and waits for the kubelet to stop them.
+func stopControlPlaneCommand() string {
+	return fmt.Sprintf("mkdir -p %[2]s && mv %[1]s/*.yaml %[2]s/ && sleep 20", staticPodsDir, stoppedPodsDir)
+}
+
+func startControlPlaneCommand() string {
+	return fmt.Sprintf("mv %[2]s/*.yaml %[1]s/ && rmdir %[2]s", staticPodsDir, stoppedPodsDir)
+}
+
+// swapDataDirCommand replaces the data of the member with the restored one, keeping the
+// previous data next to it.
+func swapDataDirCommand(backupSuffix string) string {
+	return fmt.Sprintf("mv %[1]s/member %[1]s/member.bak_%[3]s && mv %[2]s/member %[1]s/member && rm -rf %[2]s %[4]s",
+		etcdDataDir, restoreDataDir, backupSuffix, remoteSnapshot)
+}
@@ -0,0 +1,213 @@
+// Package etcdbackup takes snapshots of the etcd of a cluster and restores a cluster from them, running
+// commands on its etcd machines over SSH, or in their containers for Docker clusters, for both the
+// stacked and the external etcd topologies.
+package etcdbackup
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/clusterinventory"
+	"github.com/aws/eks-anywhere/pkg/logger"
+	"github.com/aws/eks-anywhere/pkg/nodessh"
+)
+
+const snapshotTimeFormat = "2006-01-02T15_04_05"
+
+// Machines are the machines of a cluster an etcd snapshot is taken on or restored to.
+type Machines struct {
+	// Etcd are the machines running the etcd members.
+	Etcd []*nodessh.Target
+	// ControlPlane are the control plane machines, which are also the Etcd machines with stacked etcd.
+	ControlPlane []*nodessh.Target
+	// External is true when etcd runs on dedicated machines.
+	External bool
+}
+
+// ResolveMachines finds the running etcd and control plane machines of the cluster.
+func ResolveMachines(ctx context.Context, management client.Reader, cluster *anywherev1.Cluster) (*Machines, error) {
+	controlPlane, err := nodessh.ResolveNodeGroup(ctx, management, cluster, clusterinventory.ControlPlaneNodeGroup)
+	if err != nil {
+		return nil, err
+	}
+
+	machines := &Machines{Etcd: controlPlane, ControlPlane: controlPlane}
+	if cluster.Spec.ExternalEtcdConfiguration != nil {
+		machines.External = true
+		machines.Etcd, err = nodessh.ResolveNodeGroup(ctx, management, cluster, clusterinventory.EtcdNodeGroup)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for _, m := range machines.all() {
+		if m.OSFamily == anywherev1.Bottlerocket {
+			return nil, errors.New("etcd backup and restore are not supported for Bottlerocket machines")
+		}
+	}
+
+	return machines, nil
+}
+
+// SetUser overrides the user to log in to all the machines with.
+func (m *Machines) SetUser(user string) {
+	for _, t := range m.all() {
+		t.User = user
+	}
+}
+
+func (m *Machines) all() []*nodessh.Target {
+	all := make([]*nodessh.Target, 0, len(m.Etcd)+len(m.ControlPlane))
+	all = append(all, m.ControlPlane...)
+	if m.External {
+		all = append(all, m.Etcd...)
+	}
+
+	return all
+}
+
+// SnapshotName returns the name of a snapshot of the cluster taken at a time.
+func SnapshotName(clusterName string, t time.Time) string {
+	return fmt.Sprintf("%s-etcd-%s.db", clusterName, t.Format(snapshotTimeFormat))
+}
+
+// Snapshotter takes and restores etcd snapshots, running commands on the machines with a Runner.
+type Snapshotter struct {
+	runner Runner
+}
+
+// NewSnapshotter builds a Snapshotter.
+func NewSnapshotter(runner Runner) *Snapshotter {
+	return &Snapshotter{runner: runner}
+}
+
+// Snapshot takes a snapshot of etcd on its first machine and downloads it.
+func (s *Snapshotter) Snapshot(ctx context.Context, machines *Machines) ([]byte, error) {
+	topology := topologyFor(machines)
+	target := machines.Etcd[0]
+
+	logger.V(3).Info("Taking etcd snapshot", "machine", target.Machine)
+	if _, err := s.run(ctx, target, topology.snapshotSave(target.IP)); err != nil {
+		return nil, fmt.Errorf("taking etcd snapshot on machine %s: %v", target.Machine, err)
+	}
+
+	snapshot, err := s.run(ctx, target, readSnapshotCommand())
+	if err != nil {
+		return nil, fmt.Errorf("downloading etcd snapshot from machine %s: %v", target.Machine, err)
+	}
+
+	if _, err := s.run(ctx, target, removeSnapshotCommand()); err != nil {
+		return nil, fmt.Errorf("removing etcd snapshot from machine %s: %v", target.Machine, err)
+	}
+
+	if len(snapshot) == 0 {
+		return nil, fmt.Errorf("etcd snapshot from machine %s is empty", target.Machine)
+	}
+
+	return []byte(snapshot), nil
+}
+
+// Restore restores etcd from the snapshot on all the etcd machines. It restores the snapshot to a
+// new data dir on every member first, then stops the control plane and etcd, replaces the data of
+// every member with the restored one, and starts etcd and the control plane again. The previous data
+// of every member is kept in a member.bak_<timestamp> dir next to it.
+func (s *Snapshotter) Restore(ctx context.Context, machines *Machines, snapshot []byte) error {
+	topology := topologyFor(machines)
+	timestamp := time.Now().Format(snapshotTimeFormat)
+	token := "eksa-restore-" + timestamp
+
+	members := make([]member, 0, len(machines.Etcd))
+	initialCluster := make([]string, 0, len(machines.Etcd))
+	for _, target := range machines.Etcd {
+		m, err := s.member(ctx, topology, target)
+		if err != nil {
+			return err
+		}
+		members = append(members, m)
+		initialCluster = append(initialCluster, m.name+"="+m.peerURLs)
+	}
+
+	for i, target := range machines.Etcd {
+		logger.V(3).Info("Restoring etcd snapshot", "machine", target.Machine)
+		if _, err := s.runner.RunWithStdin(ctx, snapshot, target, writeSnapshotCommand()); err != nil {
+			return fmt.Errorf("uploading etcd snapshot to machine %s: %v", target.Machine, err)
+		}
+		if _, err := s.run(ctx, target, topology.restore(members[i], strings.Join(initialCluster, ","), token)); err != nil {
+			return fmt.Errorf("restoring etcd snapshot on machine %s: %v", target.Machine, err)
+		}
+	}
+
+	logger.Info("Stopping the control plane to replace the etcd data")
+	if err := s.runAll(ctx, machines.ControlPlane, stopControlPlaneCommand(), "stopping control plane"); err != nil {
+		return err
+	}
+	if err := s.runAll(ctx, machines.Etcd, topology.stopEtcd(), "stopping etcd"); err != nil {
+		return err
+	}
+	if err := s.runAll(ctx, machines.Etcd, swapDataDirCommand(timestamp), "replacing etcd data"); err != nil {
+		return err
+	}
+	if err := s.runAll(ctx, machines.Etcd, topology.startEtcd(), "starting etcd"); err != nil {
+		return err
+	}
+	if err := s.runAll(ctx, machines.ControlPlane, startControlPlaneCommand(), "starting control plane"); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// member reads the name and peer URLs etcd was started with on a machine.
+func (s *Snapshotter) member(ctx context.Context, topology topology, target *nodessh.Target) (member, error) {
+	out, err := s.run(ctx, target, topology.memberConfig())
+	if err != nil {
+		return member{}, fmt.Errorf("reading etcd member config on machine %s: %v", target.Machine, err)
+	}
+
+	nameKey, peerURLsKey := topology.memberKeys()
+	m := member{}
+	for _, line := range strings.Split(out, "\n") {
+		key, value, _ := strings.Cut(strings.TrimSpace(line), "=")
+		switch key {
+		case nameKey:
+			m.name = value
+		case peerURLsKey:
+			m.peerURLs = value
+		}
+	}
+	if m.name == "" || m.peerURLs == "" {
+		return member{}, fmt.Errorf("etcd member config on machine %s doesn't have a name and peer URLs", target.Machine)
+	}
+
+	return m, nil
+}
+
+func (s *Snapshotter) runAll(ctx context.Context, targets []*nodessh.Target, command, action string) error {
+	if command == "" {
+		return nil
+	}
+	for _, target := range targets {
+		if _, err := s.run(ctx, target, command); err != nil {
+			return fmt.Errorf("%s on machine %s: %v", action, target.Machine, err)
+		}
+	}
+
+	return nil
+}
+
+func (s *Snapshotter) run(ctx context.Context, target *nodessh.Target, command string) (string, error) {
+	return s.runner.Run(ctx, target, command)
+}
+
+func topologyFor(machines *Machines) topology {
+	if machines.External {
+		return externalTopology{}
+	}
+	return stackedTopology{}
+}
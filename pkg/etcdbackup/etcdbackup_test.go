@@ -0,0 +1,274 @@
+package etcdbackup_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clusterv1beta2 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/constants"
+	"github.com/aws/eks-anywhere/pkg/etcdbackup"
+	"github.com/aws/eks-anywhere/pkg/nodessh"
+)
+
+type command struct {
+	ip    string
+	cmd   string
+	stdin string
+}
+
+// fakeRunner records the commands it runs and answers them with the first output whose key the
+// command contains.
+type fakeRunner struct {
+	commands []command
+	outputs  map[string]string
+	failOn   string
+}
+
+func (f *fakeRunner) Run(ctx context.Context, target *nodessh.Target, cmd string) (string, error) {
+	return f.RunWithStdin(ctx, nil, target, cmd)
+}
+
+func (f *fakeRunner) RunWithStdin(_ context.Context, in []byte, target *nodessh.Target, cmd string) (string, error) {
+	f.commands = append(f.commands, command{ip: target.IP, cmd: cmd, stdin: string(in)})
+	if f.failOn != "" && strings.Contains(cmd, f.failOn) {
+		return "", errors.New("command failed")
+	}
+	for key, out := range f.outputs {
+		if strings.Contains(cmd, key) {
+			return out, nil
+		}
+	}
+
+	return "", nil
+}
+
+func targets(ips ...string) []*nodessh.Target {
+	var t []*nodessh.Target
+	for _, ip := range ips {
+		t = append(t, &nodessh.Target{Machine: "machine-" + ip, IP: ip, User: "ec2-user"})
+	}
+	return t
+}
+
+func TestSnapshotStacked(t *testing.T) {
+	g := NewWithT(t)
+	runner := &fakeRunner{outputs: map[string]string{"cat ": "snapshot-data"}}
+	cp := targets("10.0.0.1", "10.0.0.2")
+
+	snapshot, err := etcdbackup.NewSnapshotter(runner).Snapshot(context.Background(), &etcdbackup.Machines{Etcd: cp, ControlPlane: cp})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(string(snapshot)).To(Equal("snapshot-data"))
+	g.Expect(runner.commands).To(HaveLen(3))
+	g.Expect(runner.commands[0].ip).To(Equal("10.0.0.1"))
+	g.Expect(runner.commands[0].cmd).To(Equal("crictl exec $(crictl ps -q --name '^etcd$' --state running) etcdctl --endpoints=https://10.0.0.1:2379 " +
+		"--cacert=/etc/kubernetes/pki/etcd/ca.crt --cert=/etc/kubernetes/pki/etcd/server.crt --key=/etc/kubernetes/pki/etcd/server.key snapshot save /var/lib/etcd/eksa-snapshot.db"))
+	g.Expect(runner.commands[1].cmd).To(Equal("cat /var/lib/etcd/eksa-snapshot.db"))
+	g.Expect(runner.commands[2].cmd).To(Equal("rm -f /var/lib/etcd/eksa-snapshot.db"))
+}
+
+func TestSnapshotExternal(t *testing.T) {
+	g := NewWithT(t)
+	runner := &fakeRunner{outputs: map[string]string{"cat ": "snapshot-data"}}
+
+	_, err := etcdbackup.NewSnapshotter(runner).Snapshot(context.Background(), &etcdbackup.Machines{
+		Etcd:         targets("10.0.0.10"),
+		ControlPlane: targets("10.0.0.1"),
+		External:     true,
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(runner.commands[0].ip).To(Equal("10.0.0.10"))
+	g.Expect(runner.commands[0].cmd).To(Equal(". /etc/etcd/etcdctl.env && etcdctl snapshot save /var/lib/etcd/eksa-snapshot.db"))
+}
+
+func TestSnapshotEmpty(t *testing.T) {
+	g := NewWithT(t)
+	cp := targets("10.0.0.1")
+
+	_, err := etcdbackup.NewSnapshotter(&fakeRunner{}).Snapshot(context.Background(), &etcdbackup.Machines{Etcd: cp, ControlPlane: cp})
+	g.Expect(err).To(MatchError("etcd snapshot from machine machine-10.0.0.1 is empty"))
+}
+
+func TestSnapshotError(t *testing.T) {
+	g := NewWithT(t)
+	cp := targets("10.0.0.1")
+
+	_, err := etcdbackup.NewSnapshotter(&fakeRunner{failOn: "snapshot save"}).Snapshot(context.Background(), &etcdbackup.Machines{Etcd: cp, ControlPlane: cp})
+	g.Expect(err).To(MatchError("taking etcd snapshot on machine machine-10.0.0.1: command failed"))
+}
+
+func TestRestoreExternal(t *testing.T) {
+	g := NewWithT(t)
+	runner := &fakeRunner{outputs: map[string]string{
+		"etcd.env": "ETCD_NAME=etcd-a\nETCD_INITIAL_ADVERTISE_PEER_URLS=https://10.0.0.10:2380\n",
+	}}
+	machines := &etcdbackup.Machines{
+		Etcd:         targets("10.0.0.10"),
+		ControlPlane: targets("10.0.0.1"),
+		External:     true,
+	}
+
+	g.Expect(etcdbackup.NewSnapshotter(runner).Restore(context.Background(), machines, []byte("snapshot-data"))).To(Succeed())
+
+	var got []string
+	for _, c := range runner.commands {
+		got = append(got, c.ip+" "+c.cmd)
+	}
+	g.Expect(got).To(HaveLen(8))
+	g.Expect(got[0]).To(Equal("10.0.0.10 grep -E '^(ETCD_NAME|ETCD_INITIAL_ADVERTISE_PEER_URLS)=' /etc/etcd/etcd.env"))
+	g.Expect(got[1]).To(Equal("10.0.0.10 rm -rf /var/lib/etcd/eksa-restore && cat > /var/lib/etcd/eksa-snapshot.db"))
+	g.Expect(runner.commands[1].stdin).To(Equal("snapshot-data"))
+	g.Expect(got[2]).To(MatchRegexp(`^10\.0\.0\.10 etcdutl snapshot restore /var/lib/etcd/eksa-snapshot.db --name=etcd-a ` +
+		`--initial-cluster=etcd-a=https://10.0.0.10:2380 --initial-cluster-token=eksa-restore-\S+ ` +
+		`--initial-advertise-peer-urls=https://10.0.0.10:2380 --data-dir=/var/lib/etcd/eksa-restore$`))
+	g.Expect(got[3]).To(HavePrefix("10.0.0.1 mkdir -p /etc/kubernetes/eksa-stopped-manifests && mv /etc/kubernetes/manifests/*.yaml"))
+	g.Expect(got[4]).To(Equal("10.0.0.10 systemctl stop etcd"))
+	g.Expect(got[5]).To(HavePrefix("10.0.0.10 mv /var/lib/etcd/member /var/lib/etcd/member.bak_"))
+	g.Expect(got[6]).To(Equal("10.0.0.10 systemctl start etcd"))
+	g.Expect(got[7]).To(HavePrefix("10.0.0.1 mv /etc/kubernetes/eksa-stopped-manifests/*.yaml /etc/kubernetes/manifests/"))
+}
+
+func TestRestoreStacked(t *testing.T) {
+	g := NewWithT(t)
+	runner := &fakeRunner{outputs: map[string]string{
+		"etcd.yaml": "--name=cp-a\n--initial-advertise-peer-urls=https://10.0.0.1:2380\n",
+	}}
+	cp := targets("10.0.0.1")
+
+	g.Expect(etcdbackup.NewSnapshotter(runner).Restore(context.Background(), &etcdbackup.Machines{Etcd: cp, ControlPlane: cp}, []byte("snapshot-data"))).To(Succeed())
+
+	g.Expect(runner.commands).To(HaveLen(6))
+	g.Expect(runner.commands[0].cmd).To(Equal("grep -oE -- '--(name|initial-advertise-peer-urls)=.*' /etc/kubernetes/manifests/etcd.yaml"))
+	g.Expect(runner.commands[2].cmd).To(HavePrefix("crictl exec $(crictl ps -q --name '^etcd$' --state running) etcdutl snapshot restore /var/lib/etcd/eksa-snapshot.db --name=cp-a"))
+	g.Expect(runner.commands[3].cmd).To(ContainSubstring("eksa-stopped-manifests"))
+	g.Expect(runner.commands[4].cmd).To(ContainSubstring("member.bak_"))
+	g.Expect(runner.commands[5].cmd).To(ContainSubstring("rmdir /etc/kubernetes/eksa-stopped-manifests"))
+}
+
+func TestRestoreMemberConfigMissing(t *testing.T) {
+	g := NewWithT(t)
+	cp := targets("10.0.0.1")
+
+	err := etcdbackup.NewSnapshotter(&fakeRunner{}).Restore(context.Background(), &etcdbackup.Machines{Etcd: cp, ControlPlane: cp}, []byte("snapshot-data"))
+	g.Expect(err).To(MatchError("etcd member config on machine machine-10.0.0.1 doesn't have a name and peer URLs"))
+}
+
+func TestRestoreStopControlPlaneError(t *testing.T) {
+	g := NewWithT(t)
+	runner := &fakeRunner{
+		outputs: map[string]string{"etcd.yaml": "--name=cp-a\n--initial-advertise-peer-urls=https://10.0.0.1:2380\n"},
+		failOn:  "mkdir -p /etc/kubernetes/eksa-stopped-manifests",
+	}
+	cp := targets("10.0.0.1")
+
+	err := etcdbackup.NewSnapshotter(runner).Restore(context.Background(), &etcdbackup.Machines{Etcd: cp, ControlPlane: cp}, []byte("snapshot-data"))
+	g.Expect(err).To(MatchError("stopping control plane on machine machine-10.0.0.1: command failed"))
+}
+
+func TestSnapshotName(t *testing.T) {
+	g := NewWithT(t)
+	g.Expect(etcdbackup.SnapshotName("my-cluster", time.Date(2026, 10, 16, 9, 30, 0, 0, time.UTC))).To(Equal("my-cluster-etcd-2026-10-16T09_30_00.db"))
+}
+
+func machine(name, ip string, labels map[string]string, owners ...metav1.OwnerReference) *clusterv1beta2.Machine {
+	l := map[string]string{clusterv1beta2.ClusterNameLabel: "my-cluster"}
+	for k, v := range labels {
+		l[k] = v
+	}
+	return &clusterv1beta2.Machine{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: constants.EksaSystemNamespace, Labels: l, OwnerReferences: owners},
+		Spec:       clusterv1beta2.MachineSpec{ClusterName: "my-cluster"},
+		Status: clusterv1beta2.MachineStatus{
+			Phase:     "Running",
+			Addresses: clusterv1beta2.MachineAddresses{{Type: clusterv1beta2.MachineInternalIP, Address: ip}},
+		},
+	}
+}
+
+func TestResolveMachines(t *testing.T) {
+	tests := []struct {
+		name         string
+		externalEtcd bool
+		docker       bool
+		osFamily     anywherev1.OSFamily
+		wantEtcd     []string
+		wantErr      string
+	}{
+		{
+			name:     "stacked",
+			osFamily: anywherev1.Ubuntu,
+			wantEtcd: []string{"my-cluster-cp-a"},
+		},
+		{
+			name:         "external",
+			externalEtcd: true,
+			osFamily:     anywherev1.RedHat,
+			wantEtcd:     []string{"my-cluster-etcd-a"},
+		},
+		{
+			name:     "docker",
+			docker:   true,
+			wantEtcd: []string{"my-cluster-cp-a"},
+		},
+		{
+			name:     "bottlerocket",
+			osFamily: anywherev1.Bottlerocket,
+			wantErr:  "etcd backup and restore are not supported for Bottlerocket machines",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			cluster := &anywherev1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{Name: "my-cluster", Namespace: "default"},
+				Spec: anywherev1.ClusterSpec{
+					ControlPlaneConfiguration: anywherev1.ControlPlaneConfiguration{
+						MachineGroupRef: &anywherev1.Ref{Kind: anywherev1.VSphereMachineConfigKind, Name: "mc"},
+					},
+				},
+			}
+			if tt.docker {
+				cluster.Spec.DatacenterRef = anywherev1.Ref{Kind: anywherev1.DockerDatacenterKind, Name: "my-cluster"}
+				cluster.Spec.ControlPlaneConfiguration.MachineGroupRef = nil
+			}
+			if tt.externalEtcd {
+				cluster.Spec.ExternalEtcdConfiguration = &anywherev1.ExternalEtcdConfiguration{
+					MachineGroupRef: &anywherev1.Ref{Kind: anywherev1.VSphereMachineConfigKind, Name: "mc"},
+				}
+			}
+			scheme := runtime.NewScheme()
+			g.Expect(anywherev1.AddToScheme(scheme)).To(Succeed())
+			g.Expect(clusterv1beta2.AddToScheme(scheme)).To(Succeed())
+			c := fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(
+				machine("my-cluster-cp-a", "10.0.0.1", map[string]string{clusterv1beta2.MachineControlPlaneLabel: ""}),
+				machine("my-cluster-etcd-a", "10.0.0.10", nil, metav1.OwnerReference{Kind: "EtcdadmCluster", Name: "my-cluster-etcd"}),
+				&anywherev1.VSphereMachineConfig{
+					ObjectMeta: metav1.ObjectMeta{Name: "mc", Namespace: "default"},
+					Spec:       anywherev1.VSphereMachineConfigSpec{OSFamily: tt.osFamily},
+				},
+			).Build()
+
+			got, err := etcdbackup.ResolveMachines(context.Background(), c, cluster)
+			if tt.wantErr != "" {
+				g.Expect(err).To(MatchError(tt.wantErr))
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(got.External).To(Equal(tt.externalEtcd))
+			var etcd []string
+			for _, m := range got.Etcd {
+				etcd = append(etcd, m.Machine)
+			}
+			g.Expect(etcd).To(Equal(tt.wantEtcd))
+			g.Expect(got.ControlPlane[0].Machine).To(Equal("my-cluster-cp-a"))
+		})
+	}
+}
@@ -0,0 +1,85 @@
+package etcdbackup
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/eks-anywhere/pkg/nodessh"
+)
+
+const dockerProviderIDPrefix = "docker:////"
+
+// Runner runs shell commands as root on the machines of a cluster.
+type Runner interface {
+	Run(ctx context.Context, target *nodessh.Target, command string) (string, error)
+	RunWithStdin(ctx context.Context, in []byte, target *nodessh.Target, command string) (string, error)
+}
+
+// SSH runs commands on the machines of a cluster over SSH.
+type SSH interface {
+	RunCommand(ctx context.Context, privateKeyPath, username, IP string, command ...string) (string, error)
+	RunCommandWithStdin(ctx context.Context, in []byte, privateKeyPath, username, IP string, command ...string) (string, error)
+}
+
+// SSHRunner runs commands over SSH with sudo.
+type SSHRunner struct {
+	ssh            SSH
+	privateKeyPath string
+}
+
+// NewSSHRunner builds an SSHRunner logging in to the machines with the private key.
+func NewSSHRunner(ssh SSH, privateKeyPath string) *SSHRunner {
+	return &SSHRunner{
+		ssh:            ssh,
+		privateKeyPath: privateKeyPath,
+	}
+}
+
+// Run runs the command on the target.
+func (r *SSHRunner) Run(ctx context.Context, target *nodessh.Target, command string) (string, error) {
+	return r.ssh.RunCommand(ctx, r.privateKeyPath, target.User, target.IP, sudo(command))
+}
+
+// RunWithStdin runs the command on the target, passing in as its standard input.
+func (r *SSHRunner) RunWithStdin(ctx context.Context, in []byte, target *nodessh.Target, command string) (string, error) {
+	return r.ssh.RunCommandWithStdin(ctx, in, r.privateKeyPath, target.User, target.IP, sudo(command))
+}
+
+// Docker runs commands in the containers of the machines of a Docker cluster.
+type Docker interface {
+	Exec(ctx context.Context, container string, command ...string) (string, error)
+	ExecWithStdin(ctx context.Context, in []byte, container string, command ...string) (string, error)
+}
+
+// DockerRunner runs commands in the containers of Docker machines, which run as root.
+type DockerRunner struct {
+	docker Docker
+}
+
+// NewDockerRunner builds a DockerRunner.
+func NewDockerRunner(docker Docker) *DockerRunner {
+	return &DockerRunner{docker: docker}
+}
+
+// Run runs the command in the container of the target.
+func (r *DockerRunner) Run(ctx context.Context, target *nodessh.Target, command string) (string, error) {
+	return r.docker.Exec(ctx, container(target), "sh", "-c", command)
+}
+
+// RunWithStdin runs the command in the container of the target, passing in as its standard input.
+func (r *DockerRunner) RunWithStdin(ctx context.Context, in []byte, target *nodessh.Target, command string) (string, error) {
+	return r.docker.ExecWithStdin(ctx, in, container(target), "sh", "-c", command)
+}
+
+// container returns the name of the container of a Docker machine, which CAPD keeps in its provider ID.
+func container(target *nodessh.Target) string {
+	if name := strings.TrimPrefix(target.ProviderID, dockerProviderIDPrefix); name != "" && name != target.ProviderID {
+		return name
+	}
+	return target.Machine
+}
+
+// sudo wraps a shell command to run it as root through a single sudo call.
+func sudo(command string) string {
+	return "sudo sh -c '" + strings.ReplaceAll(command, "'", `'\''`) + "'"
+}
@@ -0,0 +1,102 @@
+package etcdbackup_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/aws/eks-anywhere/pkg/etcdbackup"
+	"github.com/aws/eks-anywhere/pkg/nodessh"
+)
+
+type fakeSSH struct {
+	args  []string
+	stdin string
+}
+
+func (f *fakeSSH) RunCommand(ctx context.Context, privateKeyPath, username, IP string, cmd ...string) (string, error) {
+	return f.RunCommandWithStdin(ctx, nil, privateKeyPath, username, IP, cmd...)
+}
+
+func (f *fakeSSH) RunCommandWithStdin(_ context.Context, in []byte, privateKeyPath, username, IP string, cmd ...string) (string, error) {
+	f.args = append([]string{privateKeyPath, username, IP}, cmd...)
+	f.stdin = string(in)
+	return "out", nil
+}
+
+type fakeDocker struct {
+	container string
+	command   []string
+	stdin     string
+}
+
+func (f *fakeDocker) Exec(ctx context.Context, container string, command ...string) (string, error) {
+	return f.ExecWithStdin(ctx, nil, container, command...)
+}
+
+func (f *fakeDocker) ExecWithStdin(_ context.Context, in []byte, container string, command ...string) (string, error) {
+	f.container = container
+	f.command = command
+	f.stdin = string(in)
+	return "out", nil
+}
+
+func TestSSHRunnerRun(t *testing.T) {
+	g := NewWithT(t)
+	ssh := &fakeSSH{}
+	target := &nodessh.Target{Machine: "m", IP: "10.0.0.1", User: "capv"}
+
+	out, err := etcdbackup.NewSSHRunner(ssh, "key").Run(context.Background(), target, "grep -E '^ETCD_NAME=' /etc/etcd/etcd.env")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(out).To(Equal("out"))
+	g.Expect(ssh.args).To(Equal([]string{"key", "capv", "10.0.0.1", `sudo sh -c 'grep -E '\''^ETCD_NAME='\'' /etc/etcd/etcd.env'`}))
+}
+
+func TestSSHRunnerRunWithStdin(t *testing.T) {
+	g := NewWithT(t)
+	ssh := &fakeSSH{}
+	target := &nodessh.Target{Machine: "m", IP: "10.0.0.1", User: "capv"}
+
+	_, err := etcdbackup.NewSSHRunner(ssh, "key").RunWithStdin(context.Background(), []byte("data"), target, "cat > file")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(ssh.args).To(Equal([]string{"key", "capv", "10.0.0.1", "sudo sh -c 'cat > file'"}))
+	g.Expect(ssh.stdin).To(Equal("data"))
+}
+
+func TestDockerRunner(t *testing.T) {
+	tests := []struct {
+		name          string
+		target        *nodessh.Target
+		wantContainer string
+	}{
+		{
+			name:          "container from provider ID",
+			target:        &nodessh.Target{Machine: "my-cluster-cp-abc", ProviderID: "docker:////my-cluster-cp-xyz"},
+			wantContainer: "my-cluster-cp-xyz",
+		},
+		{
+			name:          "container from machine name",
+			target:        &nodessh.Target{Machine: "my-cluster-cp-abc"},
+			wantContainer: "my-cluster-cp-abc",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			docker := &fakeDocker{}
+			runner := etcdbackup.NewDockerRunner(docker)
+
+			_, err := runner.RunWithStdin(context.Background(), []byte("data"), tt.target, "cat > file")
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(docker.container).To(Equal(tt.wantContainer))
+			g.Expect(strings.Join(docker.command, " ")).To(Equal("sh -c cat > file"))
+			g.Expect(docker.stdin).To(Equal("data"))
+
+			_, err = runner.Run(context.Background(), tt.target, "uptime")
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(docker.command).To(Equal([]string{"sh", "-c", "uptime"}))
+		})
+	}
+}
@@ -0,0 +1,154 @@
+package etcdbackup
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+)
+
+const s3Scheme = "s3://"
+
+// Store keeps etcd snapshots.
+type Store interface {
+	// Save writes a snapshot with the given name and returns its location.
+	Save(ctx context.Context, name string, snapshot []byte) (location string, err error)
+	// Read reads the snapshot at a location returned by Save.
+	Read(ctx context.Context, location string) ([]byte, error)
+}
+
+// IsS3Location returns true when the location is an S3 URL, like s3://bucket/prefix.
+func IsS3Location(location string) bool {
+	return strings.HasPrefix(location, s3Scheme)
+}
+
+// NewStore builds the store for a location, which is either an S3 URL or a local path.
+// For S3, the credentials and region are read from the default AWS config of the environment.
+func NewStore(location string) (Store, error) {
+	if !IsS3Location(location) {
+		return &LocalStore{Dir: location}, nil
+	}
+
+	bucket, prefix, err := ParseS3Location(location)
+	if err != nil {
+		return nil, err
+	}
+
+	sess, err := session.NewSessionWithOptions(session.Options{SharedConfigState: session.SharedConfigEnable})
+	if err != nil {
+		return nil, fmt.Errorf("building AWS session: %v", err)
+	}
+
+	return NewS3Store(s3.New(sess), bucket, prefix), nil
+}
+
+// ParseS3Location splits an S3 URL into its bucket and key.
+func ParseS3Location(location string) (bucket, key string, err error) {
+	bucket, key, _ = strings.Cut(strings.TrimPrefix(location, s3Scheme), "/")
+	if bucket == "" {
+		return "", "", fmt.Errorf("invalid S3 location %s, expected s3://<bucket>/<key>", location)
+	}
+
+	return bucket, key, nil
+}
+
+// LocalStore keeps snapshots in a directory of the local file system.
+type LocalStore struct {
+	Dir string
+}
+
+// Save writes the snapshot to the directory of the store, creating it if needed. Snapshots hold
+// all the data of the cluster, including secrets, so they are only readable by the current user.
+func (l *LocalStore) Save(_ context.Context, name string, snapshot []byte) (string, error) {
+	if err := os.MkdirAll(l.Dir, 0o700); err != nil {
+		return "", fmt.Errorf("creating snapshot directory: %v", err)
+	}
+
+	location := filepath.Join(l.Dir, name)
+	if err := os.WriteFile(location, snapshot, 0o600); err != nil {
+		return "", fmt.Errorf("writing snapshot: %v", err)
+	}
+
+	return location, nil
+}
+
+// Read reads the snapshot file at the location.
+func (l *LocalStore) Read(_ context.Context, location string) ([]byte, error) {
+	snapshot, err := os.ReadFile(location)
+	if err != nil {
+		return nil, fmt.Errorf("reading snapshot: %v", err)
+	}
+
+	return snapshot, nil
+}
+
+// S3Client is the part of the S3 API the S3Store uses.
+type S3Client interface {
+	PutObjectWithContext(ctx aws.Context, input *s3.PutObjectInput, opts ...request.Option) (*s3.PutObjectOutput, error)
+	GetObjectWithContext(ctx aws.Context, input *s3.GetObjectInput, opts ...request.Option) (*s3.GetObjectOutput, error)
+}
+
+// S3Store keeps snapshots in an S3 bucket, under a prefix.
+type S3Store struct {
+	client S3Client
+	bucket string
+	prefix string
+}
+
+// NewS3Store builds an S3Store.
+func NewS3Store(client S3Client, bucket, prefix string) *S3Store {
+	return &S3Store{
+		client: client,
+		bucket: bucket,
+		prefix: prefix,
+	}
+}
+
+// Save uploads the snapshot under the prefix of the store, encrypted at rest.
+func (s *S3Store) Save(ctx context.Context, name string, snapshot []byte) (string, error) {
+	key := path.Join(s.prefix, name)
+	_, err := s.client.PutObjectWithContext(ctx, &s3.PutObjectInput{
+		Bucket:               aws.String(s.bucket),
+		Key:                  aws.String(key),
+		Body:                 bytes.NewReader(snapshot),
+		ServerSideEncryption: aws.String(s3.ServerSideEncryptionAes256),
+	})
+	if err != nil {
+		return "", fmt.Errorf("uploading snapshot to bucket %s: %v", s.bucket, err)
+	}
+
+	return s3Scheme + s.bucket + "/" + key, nil
+}
+
+// Read downloads the snapshot at the S3 location.
+func (s *S3Store) Read(ctx context.Context, location string) ([]byte, error) {
+	bucket, key, err := ParseS3Location(location)
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := s.client.GetObjectWithContext(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("downloading snapshot %s: %v", location, err)
+	}
+	defer out.Body.Close()
+
+	snapshot, err := io.ReadAll(out.Body)
+	if err != nil {
+		return nil, fmt.Errorf("downloading snapshot %s: %v", location, err)
+	}
+
+	return snapshot, nil
+}
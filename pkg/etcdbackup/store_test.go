@@ -0,0 +1,125 @@
+package etcdbackup_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/s3"
+	. "github.com/onsi/gomega"
+
+	"github.com/aws/eks-anywhere/pkg/etcdbackup"
+)
+
+type fakeS3 struct {
+	objects map[string][]byte
+	put     *s3.PutObjectInput
+}
+
+func (f *fakeS3) PutObjectWithContext(_ aws.Context, input *s3.PutObjectInput, _ ...request.Option) (*s3.PutObjectOutput, error) {
+	body, err := io.ReadAll(input.Body)
+	if err != nil {
+		return nil, err
+	}
+	f.put = input
+	f.objects[*input.Bucket+"/"+*input.Key] = body
+	return &s3.PutObjectOutput{}, nil
+}
+
+func (f *fakeS3) GetObjectWithContext(_ aws.Context, input *s3.GetObjectInput, _ ...request.Option) (*s3.GetObjectOutput, error) {
+	body, ok := f.objects[*input.Bucket+"/"+*input.Key]
+	if !ok {
+		return nil, errors.New("NoSuchKey")
+	}
+	return &s3.GetObjectOutput{Body: io.NopCloser(bytes.NewReader(body))}, nil
+}
+
+func TestLocalStoreSaveAndRead(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	dir := filepath.Join(t.TempDir(), "snapshots")
+	store := &etcdbackup.LocalStore{Dir: dir}
+
+	location, err := store.Save(ctx, "snapshot.db", []byte("data"))
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(location).To(Equal(filepath.Join(dir, "snapshot.db")))
+
+	info, err := os.Stat(location)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(info.Mode().Perm()).To(Equal(os.FileMode(0o600)))
+
+	got, err := store.Read(ctx, location)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(string(got)).To(Equal("data"))
+}
+
+func TestLocalStoreReadMissing(t *testing.T) {
+	g := NewWithT(t)
+	store := &etcdbackup.LocalStore{}
+
+	_, err := store.Read(context.Background(), filepath.Join(t.TempDir(), "missing.db"))
+	g.Expect(err).To(MatchError(ContainSubstring("reading snapshot")))
+}
+
+func TestS3StoreSaveAndRead(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	client := &fakeS3{objects: map[string][]byte{}}
+	store := etcdbackup.NewS3Store(client, "my-bucket", "backups/my-cluster")
+
+	location, err := store.Save(ctx, "snapshot.db", []byte("data"))
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(location).To(Equal("s3://my-bucket/backups/my-cluster/snapshot.db"))
+	g.Expect(*client.put.ServerSideEncryption).To(Equal(s3.ServerSideEncryptionAes256))
+
+	got, err := store.Read(ctx, location)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(string(got)).To(Equal("data"))
+}
+
+func TestS3StoreReadError(t *testing.T) {
+	g := NewWithT(t)
+	store := etcdbackup.NewS3Store(&fakeS3{objects: map[string][]byte{}}, "my-bucket", "")
+
+	_, err := store.Read(context.Background(), "s3://my-bucket/missing.db")
+	g.Expect(err).To(MatchError("downloading snapshot s3://my-bucket/missing.db: NoSuchKey"))
+}
+
+func TestParseS3Location(t *testing.T) {
+	tests := []struct {
+		location   string
+		wantBucket string
+		wantKey    string
+		wantErr    string
+	}{
+		{location: "s3://bucket/prefix/snapshot.db", wantBucket: "bucket", wantKey: "prefix/snapshot.db"},
+		{location: "s3://bucket", wantBucket: "bucket"},
+		{location: "s3:///key", wantErr: "invalid S3 location s3:///key, expected s3://<bucket>/<key>"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.location, func(t *testing.T) {
+			g := NewWithT(t)
+			bucket, key, err := etcdbackup.ParseS3Location(tt.location)
+			if tt.wantErr != "" {
+				g.Expect(err).To(MatchError(tt.wantErr))
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(bucket).To(Equal(tt.wantBucket))
+			g.Expect(key).To(Equal(tt.wantKey))
+		})
+	}
+}
+
+func TestNewStoreLocal(t *testing.T) {
+	g := NewWithT(t)
+	store, err := etcdbackup.NewStore("/backups")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(store).To(Equal(&etcdbackup.LocalStore{Dir: "/backups"}))
+}
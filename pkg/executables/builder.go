@@ -112,6 +112,10 @@ func BuildDockerExecutable() *Docker {
 // It reads MR_TOOLS_DISABLE variable.
 func ExecutablesInDocker() bool {
 	if env, ok := os.LookupEnv("MR_TOOLS_DISABLE"); ok && strings.EqualFold(env, "true") {
+		if isWindowsHost() {
+			logger.Info("Warning: client's executables are not supported on Windows, using eks-a tools image")
+			return true
+		}
 		logger.Info("Warning: eks-a tools image disabled, using client's executables")
 		return false
 	}
@@ -136,13 +140,19 @@ func InitInDockerExecutablesBuilder(ctx context.Context, image string, mountDirs
 
 // NewInDockerExecutablesBuilder builds an executables builder for docker.
 func NewInDockerExecutablesBuilder(dockerClient DockerClient, image string, mountDirs ...string) (*ExecutablesBuilder, error) {
+	return NewInDockerExecutablesBuilderForPlatform(dockerClient, image, "", mountDirs...)
+}
+
+// NewInDockerExecutablesBuilderForPlatform builds an executables builder for docker that pulls
+// and runs image for platform, like linux/arm64. An empty platform leaves the choice to docker.
+func NewInDockerExecutablesBuilderForPlatform(dockerClient DockerClient, image, platform string, mountDirs ...string) (*ExecutablesBuilder, error) {
 	currentDir, err := os.Getwd()
 	if err != nil {
 		return nil, fmt.Errorf("getting current directory: %v", err)
 	}
 	mountDirs = append(mountDirs, currentDir)
 
-	dockerContainer := newDockerContainer(image, currentDir, mountDirs, dockerClient)
+	dockerContainer := newDockerContainer(image, platform, currentDir, mountDirs, dockerClient)
 	dockerExecutableBuilder := NewDockerExecutableBuilder(dockerContainer)
 
 	return NewExecutablesBuilder(dockerExecutableBuilder), nil
@@ -88,6 +88,13 @@ func TestExecutablesInDocker(t *testing.T) {
 	}
 }
 
+func TestExecutablesInDockerWindowsHost(t *testing.T) {
+	g := NewWithT(t)
+	executables.SetHostOS(t, "windows")
+	t.Setenv("MR_TOOLS_DISABLE", "true")
+	g.Expect(executables.ExecutablesInDocker()).To(BeTrue())
+}
+
 func TestInDockerExecutablesBuilder(t *testing.T) {
 	g := NewWithT(t)
 	ctx := context.Background()
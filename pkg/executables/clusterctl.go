@@ -16,6 +16,7 @@ import (
 	"github.com/aws/eks-anywhere/pkg/manifests"
 	"github.com/aws/eks-anywhere/pkg/manifests/bundles"
 	"github.com/aws/eks-anywhere/pkg/providers"
+	"github.com/aws/eks-anywhere/pkg/providers/plugin"
 	"github.com/aws/eks-anywhere/pkg/templater"
 	"github.com/aws/eks-anywhere/pkg/types"
 	"github.com/aws/eks-anywhere/release/api/v1alpha1"
@@ -177,6 +178,20 @@ func (c *Clusterctl) BackupManagement(ctx context.Context, cluster *types.Cluste
 	return nil
 }
 
+// RestoreManagement restores the CAPI resources saved by BackupManagement in the provided path to a cluster.
+func (c *Clusterctl) RestoreManagement(ctx context.Context, cluster *types.Cluster, backupPath string) error {
+	_, err := c.Execute(
+		ctx, "move",
+		"--from-directory", backupPath,
+		"--kubeconfig", cluster.KubeconfigFile,
+		"--namespace", constants.EksaSystemNamespace,
+	)
+	if err != nil {
+		return fmt.Errorf("failed restoring backup of CAPI objects: %v", err)
+	}
+	return nil
+}
+
 // MoveManagement moves management components `from` cluster `to` cluster
 // If `clusterName` is provided, it filters and moves only the provided cluster.
 func (c *Clusterctl) MoveManagement(ctx context.Context, from, to *types.Cluster, clusterName string) error {
@@ -302,12 +317,16 @@ func (c *Clusterctl) buildConfig(managementComponents *anywherecluster.Managemen
 		"SnowProviderVersion":                             managementComponents.Snow.Version,
 		"TinkerbellProviderVersion":                       managementComponents.Tinkerbell.Version,
 		"NutanixProviderVersion":                          managementComponents.Nutanix.Version,
+		"ProxmoxProviderVersion":                          managementComponents.Proxmox.Version,
+		"OpenStackProviderVersion":                        managementComponents.OpenStack.Version,
+		"AzureStackHCIProviderVersion":                    managementComponents.AzureStackHCI.Version,
+		"HarvesterProviderVersion":                        managementComponents.Harvester.Version,
 		"ClusterApiProviderVersion":                       managementComponents.ClusterAPI.Version,
 		"KubeadmControlPlaneProviderVersion":              managementComponents.ControlPlane.Version,
 		"KubeadmBootstrapProviderVersion":                 managementComponents.Bootstrap.Version,
 		"EtcdadmBootstrapProviderVersion":                 managementComponents.ExternalEtcdBootstrap.Version,
 		"EtcdadmControllerProviderVersion":                managementComponents.ExternalEtcdController.Version,
-		"dir":                                             path + "/" + clusterName + capiPrefix,
+		"dir":                                             ContainerPath(path) + "/" + clusterName + capiPrefix,
 	}
 
 	// CloudStack is deprecated — only set image overrides if URIs are non-empty/non-placeholder.
@@ -319,6 +338,27 @@ func (c *Clusterctl) buildConfig(managementComponents *anywherecluster.Managemen
 		data["ClusterApiCloudStackKubeRbacProxyRepository"] = imageRepository(managementComponents.CloudStack.KubeRbacProxy)
 		data["ClusterApiCloudStackKubeRbacProxyTag"] = managementComponents.CloudStack.KubeRbacProxy.Tag()
 	}
+	if managementComponents.Proxmox.ClusterAPIController.URI != "" {
+		data["ClusterApiProxmoxControllerRepository"] = imageRepository(managementComponents.Proxmox.ClusterAPIController)
+		data["ClusterApiProxmoxControllerTag"] = managementComponents.Proxmox.ClusterAPIController.Tag()
+	}
+	if managementComponents.OpenStack.ClusterAPIController.URI != "" {
+		data["ClusterApiOpenStackControllerRepository"] = imageRepository(managementComponents.OpenStack.ClusterAPIController)
+		data["ClusterApiOpenStackControllerTag"] = managementComponents.OpenStack.ClusterAPIController.Tag()
+	}
+	if managementComponents.AzureStackHCI.ClusterAPIController.URI != "" {
+		data["ClusterApiAzureStackHCIControllerRepository"] = imageRepository(managementComponents.AzureStackHCI.ClusterAPIController)
+		data["ClusterApiAzureStackHCIControllerTag"] = managementComponents.AzureStackHCI.ClusterAPIController.Tag()
+	}
+	if managementComponents.Harvester.ClusterAPIController.URI != "" {
+		data["ClusterApiHarvesterControllerRepository"] = imageRepository(managementComponents.Harvester.ClusterAPIController)
+		data["ClusterApiHarvesterControllerTag"] = managementComponents.Harvester.ClusterAPIController.Tag()
+	}
+	// Provider plugins aren't part of the bundle, they bring their own infrastructure provider.
+	if p, ok := provider.(*plugin.Adapter); ok {
+		data["PluginProviderName"] = p.Name()
+		data["PluginProviderVersion"] = p.Version(managementComponents)
+	}
 
 	filePath, err := t.WriteToFile(clusterctlConfigTemplate, data, clusterctlConfigFile)
 	if err != nil {
@@ -339,16 +379,20 @@ func (c *Clusterctl) buildConfig(managementComponents *anywherecluster.Managemen
 }
 
 var providerNamespaces = map[string]string{
-	constants.VSphereProviderName:    constants.CapvSystemNamespace,
-	constants.DockerProviderName:     constants.CapdSystemNamespace,
-	constants.CloudStackProviderName: constants.CapcSystemNamespace,
-	constants.AWSProviderName:        constants.CapaSystemNamespace,
-	constants.SnowProviderName:       constants.CapasSystemNamespace,
-	constants.NutanixProviderName:    constants.CapxSystemNamespace,
-	constants.TinkerbellProviderName: constants.CaptSystemNamespace,
-	etcdadmBootstrapProviderName:     constants.EtcdAdmBootstrapProviderSystemNamespace,
-	etcdadmControllerProviderName:    constants.EtcdAdmControllerSystemNamespace,
-	kubeadmBootstrapProviderName:     constants.CapiKubeadmBootstrapSystemNamespace,
+	constants.VSphereProviderName:       constants.CapvSystemNamespace,
+	constants.DockerProviderName:        constants.CapdSystemNamespace,
+	constants.CloudStackProviderName:    constants.CapcSystemNamespace,
+	constants.AWSProviderName:           constants.CapaSystemNamespace,
+	constants.SnowProviderName:          constants.CapasSystemNamespace,
+	constants.NutanixProviderName:       constants.CapxSystemNamespace,
+	constants.ProxmoxProviderName:       constants.CapmoxSystemNamespace,
+	constants.OpenStackProviderName:     constants.CapoSystemNamespace,
+	constants.AzureStackHCIProviderName: constants.CaphSystemNamespace,
+	constants.HarvesterProviderName:     constants.CaphvSystemNamespace,
+	constants.TinkerbellProviderName:    constants.CaptSystemNamespace,
+	etcdadmBootstrapProviderName:        constants.EtcdAdmBootstrapProviderSystemNamespace,
+	etcdadmControllerProviderName:       constants.EtcdAdmControllerSystemNamespace,
+	kubeadmBootstrapProviderName:        constants.CapiKubeadmBootstrapSystemNamespace,
 }
 
 // Upgrade executes an upgrade of the cluster to the new management components and the spec.
@@ -338,6 +338,33 @@ func TestClusterctlBackupManagementFailed(t *testing.T) {
 	}
 }
 
+func TestClusterctlRestoreManagement(t *testing.T) {
+	tt := newClusterctlTest(t)
+	cluster := &types.Cluster{
+		Name:           "mgmt",
+		KubeconfigFile: "mgmt.kubeconfig",
+	}
+
+	tt.e.EXPECT().Execute(tt.ctx, "move", "--from-directory", "mgmt/restore/capi/w01", "--kubeconfig", "mgmt.kubeconfig", "--namespace", constants.EksaSystemNamespace)
+	if err := tt.clusterctl.RestoreManagement(tt.ctx, cluster, "mgmt/restore/capi/w01"); err != nil {
+		t.Fatalf("Clusterctl.RestoreManagement() error = %v, want nil", err)
+	}
+}
+
+func TestClusterctlRestoreManagementFailed(t *testing.T) {
+	tt := newClusterctlTest(t)
+	cluster := &types.Cluster{
+		Name:           "mgmt",
+		KubeconfigFile: "mgmt.kubeconfig",
+	}
+
+	tt.e.EXPECT().Execute(tt.ctx, "move", "--from-directory", "mgmt/restore/capi/w01", "--kubeconfig", "mgmt.kubeconfig", "--namespace", constants.EksaSystemNamespace).
+		Return(bytes.Buffer{}, fmt.Errorf("error restoring"))
+	if err := tt.clusterctl.RestoreManagement(tt.ctx, cluster, "mgmt/restore/capi/w01"); err == nil {
+		t.Fatal("Clusterctl.RestoreManagement() error = nil, want not nil")
+	}
+}
+
 func TestClusterctlMoveManagement(t *testing.T) {
 	tests := []struct {
 		testName     string
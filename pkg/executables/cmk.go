@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"sort"
 	"strconv"
 	"strings"
 
@@ -399,6 +400,45 @@ func (c *Cmk) ValidateAccountPresent(ctx context.Context, profile string, accoun
 	return nil
 }
 
+// ValidateProjectMembership validates the project exists in the domain and the account is a member of it.
+// If no account is provided, CloudStack checks the membership of the account owning the profile credentials.
+func (c *Cmk) ValidateProjectMembership(ctx context.Context, profile string, project string, account string, domainId string) error {
+	// If project is not specified then no need to check its presence
+	if len(project) == 0 {
+		return nil
+	}
+
+	command := newCmkCommand("list projects")
+	applyCmkArgs(&command, withCloudStackName(project), withCloudStackDomainId(domainId))
+	if len(account) > 0 {
+		applyCmkArgs(&command, withCloudStackAccount(account))
+	}
+	result, err := c.exec(ctx, profile, command...)
+	if err != nil {
+		return fmt.Errorf("getting projects info - %s: %v", result.String(), err)
+	}
+	if result.Len() == 0 {
+		return fmt.Errorf("project %s not found or account is not a member of it", project)
+	}
+
+	response := struct {
+		CmkProjects []cmkProject `json:"project"`
+	}{}
+	if err = json.Unmarshal(result.Bytes(), &response); err != nil {
+		return fmt.Errorf("parsing response into json: %v", err)
+	}
+	projects := response.CmkProjects
+	if len(projects) > 1 {
+		return fmt.Errorf("duplicate project %s found", project)
+	} else if len(projects) == 0 {
+		return fmt.Errorf("project %s not found or account is not a member of it", project)
+	}
+	if projects[0].State != "" && projects[0].State != "Active" {
+		return fmt.Errorf("project %s is not active: %s", project, projects[0].State)
+	}
+	return nil
+}
+
 // NewCmk initializes CloudMonkey executable to query CloudStack via CLI.
 func NewCmk(executable Executable, writer filewriter.FileWriter, config *decoder.CloudStackExecConfig) (*Cmk, error) {
 	if config == nil {
@@ -464,6 +504,77 @@ func (c *Cmk) CleanupVms(ctx context.Context, profile string, clusterName string
 	return nil
 }
 
+// TagVirtualMachines adds the tags to all the virtual machines of a cluster. Tags that already exist in a virtual machine
+// with a different value are replaced, while tags not present in the given set are left untouched.
+func (c *Cmk) TagVirtualMachines(ctx context.Context, profile string, clusterName string, tags map[string]string) error {
+	command := newCmkCommand("list virtualmachines")
+	applyCmkArgs(&command, withCloudStackKeyword(clusterName), appendArgs("listall=true"))
+	result, err := c.exec(ctx, profile, command...)
+	if err != nil {
+		return fmt.Errorf("listing virtual machines in cluster %s: %s: %v", clusterName, result.String(), err)
+	}
+	if result.Len() == 0 {
+		logger.V(4).Info("virtual machines not found", "cluster", clusterName)
+		return nil
+	}
+	response := struct {
+		CmkVirtualMachines []cmkVirtualMachine `json:"virtualmachine"`
+	}{}
+	if err = json.Unmarshal(result.Bytes(), &response); err != nil {
+		return fmt.Errorf("parsing response into json: %v", err)
+	}
+
+	keys := make([]string, 0, len(tags))
+	for key := range tags {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, vm := range response.CmkVirtualMachines {
+		current := make(map[string]string, len(vm.Tags))
+		for _, tag := range vm.Tags {
+			current[tag.Key] = tag.Value
+		}
+
+		var outdated, missing []string
+		for _, key := range keys {
+			value, found := current[key]
+			if found && value == tags[key] {
+				continue
+			}
+			if found {
+				outdated = append(outdated, key)
+			}
+			missing = append(missing, key)
+		}
+
+		if len(outdated) > 0 {
+			deleteCommand := newCmkCommand("delete tags")
+			applyCmkArgs(&deleteCommand, withCloudStackUserVmResource(vm.Id))
+			for i, key := range outdated {
+				applyCmkArgs(&deleteCommand, appendArgs(fmt.Sprintf("tags[%d].key=\"%s\"", i, key)))
+			}
+			if deleteResult, err := c.exec(ctx, profile, deleteCommand...); err != nil {
+				return fmt.Errorf("deleting outdated tags from virtual machine %s: %s: %v", vm.Name, deleteResult.String(), err)
+			}
+		}
+
+		if len(missing) > 0 {
+			createCommand := newCmkCommand("create tags")
+			applyCmkArgs(&createCommand, withCloudStackUserVmResource(vm.Id))
+			for i, key := range missing {
+				applyCmkArgs(&createCommand, appendArgs(fmt.Sprintf("tags[%d].key=\"%s\"", i, key), fmt.Sprintf("tags[%d].value=\"%s\"", i, tags[key])))
+			}
+			if createResult, err := c.exec(ctx, profile, createCommand...); err != nil {
+				return fmt.Errorf("tagging virtual machine %s: %s: %v", vm.Name, createResult.String(), err)
+			}
+			logger.V(4).Info("Tagged virtual machine", "vm_name", vm.Name, "tags", missing)
+		}
+	}
+
+	return nil
+}
+
 func (c *Cmk) exec(ctx context.Context, profile string, args ...string) (stdout bytes.Buffer, err error) {
 	configFile, err := c.buildCmkConfigFile(profile)
 	if err != nil {
@@ -526,6 +637,17 @@ type cmkResourceIdentifier struct {
 	Name string `json:"name"`
 }
 
+type cmkVirtualMachine struct {
+	Id   string           `json:"id"`
+	Name string           `json:"name"`
+	Tags []cmkResourceTag `json:"tags"`
+}
+
+type cmkResourceTag struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
 type cmkDiskOffering struct {
 	Id         string `json:"id"`
 	Name       string `json:"name"`
@@ -544,6 +666,12 @@ type cmkDomain struct {
 	Path string `json:"path"`
 }
 
+type cmkProject struct {
+	Id    string `json:"id"`
+	Name  string `json:"name"`
+	State string `json:"state"`
+}
+
 type cmkAccount struct {
 	RoleType string `json:"roletype"`
 	Domain   string `json:"domain"`
@@ -43,6 +43,10 @@ func withCloudStackName(name string) cmkCommandArgs {
 	return appendArgs(fmt.Sprintf("name=\"%s\"", name))
 }
 
+func withCloudStackUserVmResource(id string) cmkCommandArgs {
+	return appendArgs("resourcetype=\"UserVm\"", fmt.Sprintf("resourceids=\"%s\"", id))
+}
+
 func withCloudStackKeyword(keyword string) cmkCommandArgs {
 	return appendArgs(fmt.Sprintf("keyword=\"%s\"", keyword))
 }
@@ -22,6 +22,7 @@ import (
 const (
 	cmkConfigFileName = "cmk_test_name.ini"
 	accountName       = "account1"
+	projectName       = "project1"
 	rootDomain        = "ROOT"
 	rootDomainID      = "5300cdac-74d5-11ec-8696-c81f66d3e965"
 	domain            = "foo/domain1"
@@ -215,6 +216,102 @@ func TestCmkCleanupVms(t *testing.T) {
 	}
 }
 
+func TestCmkTagVirtualMachines(t *testing.T) {
+	_, writer := test.NewWriter(t)
+	configFilePath, _ := filepath.Abs(filepath.Join(writer.Dir(), "generated", cmkConfigFileName))
+	clusterName := "test"
+	vmID := "30e8b0b1-f286-4372-9f1f-441e199a3f49"
+	listArgs := []string{
+		"-c", configFilePath,
+		"list", "virtualmachines", fmt.Sprintf("keyword=\"%s\"", clusterName), "listall=true",
+	}
+	tests := []struct {
+		testName           string
+		argumentsExecCalls [][]string
+		jsonResponseFile   string
+		tags               map[string]string
+		cmkResponseError   error
+		wantErr            bool
+	}{
+		{
+			testName:           "listvirtualmachines json parse exception",
+			jsonResponseFile:   "testdata/cmk_non_json_response.txt",
+			argumentsExecCalls: [][]string{listArgs},
+			tags:               map[string]string{"env": "prod"},
+			wantErr:            true,
+		},
+		{
+			testName:           "listvirtualmachines no results",
+			jsonResponseFile:   "testdata/cmk_list_empty_response.json",
+			argumentsExecCalls: [][]string{listArgs},
+			tags:               map[string]string{"env": "prod"},
+		},
+		{
+			testName:         "untagged virtual machine",
+			jsonResponseFile: "testdata/cmk_list_virtualmachine_singular.json",
+			argumentsExecCalls: [][]string{
+				listArgs,
+				{
+					"-c", configFilePath, "create", "tags", "resourcetype=\"UserVm\"", fmt.Sprintf("resourceids=\"%s\"", vmID),
+					"tags[0].key=\"env\"", "tags[0].value=\"prod\"", "tags[1].key=\"owner\"", "tags[1].value=\"me\"",
+				},
+			},
+			tags: map[string]string{"owner": "me", "env": "prod"},
+		},
+		{
+			testName:         "replaces outdated tags",
+			jsonResponseFile: "testdata/cmk_list_virtualmachine_tagged.json",
+			argumentsExecCalls: [][]string{
+				listArgs,
+				{
+					"-c", configFilePath, "delete", "tags", "resourcetype=\"UserVm\"", fmt.Sprintf("resourceids=\"%s\"", vmID),
+					"tags[0].key=\"env\"",
+				},
+				{
+					"-c", configFilePath, "create", "tags", "resourcetype=\"UserVm\"", fmt.Sprintf("resourceids=\"%s\"", vmID),
+					"tags[0].key=\"env\"", "tags[0].value=\"prod\"",
+				},
+			},
+			tags: map[string]string{"env": "prod", "team": "platform"},
+		},
+		{
+			testName:           "tags up to date",
+			jsonResponseFile:   "testdata/cmk_list_virtualmachine_tagged.json",
+			argumentsExecCalls: [][]string{listArgs},
+			tags:               map[string]string{"env": "dev", "team": "platform"},
+		},
+		{
+			testName:           "listvirtualmachines error",
+			jsonResponseFile:   "testdata/cmk_list_empty_response.json",
+			argumentsExecCalls: [][]string{listArgs},
+			tags:               map[string]string{"env": "prod"},
+			cmkResponseError:   errors.New("cmk calls failed"),
+			wantErr:            true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			fileContent := test.ReadFile(t, tt.jsonResponseFile)
+
+			ctx := context.Background()
+			mockCtrl := gomock.NewController(t)
+
+			executable := mockexecutables.NewMockExecutable(mockCtrl)
+			for _, argsList := range tt.argumentsExecCalls {
+				executable.EXPECT().Execute(ctx, argsList).
+					Return(*bytes.NewBufferString(fileContent), tt.cmkResponseError)
+			}
+			cmk, _ := executables.NewCmk(executable, writer, execConfig)
+			err := cmk.TagVirtualMachines(ctx, execConfig.Profiles[0].Name, clusterName, tt.tags)
+			if tt.wantErr && err != nil || !tt.wantErr && err == nil {
+				return
+			}
+			t.Fatalf("Cmk error: %v", err)
+		})
+	}
+}
+
 func TestCmkEnsureNoDuplicateNetwork(t *testing.T) {
 	_, writer := test.NewWriter(t)
 	configFilePath, _ := filepath.Abs(filepath.Join(writer.Dir(), "generated", cmkConfigFileName))
@@ -448,6 +545,76 @@ func TestCmkListOperations(t *testing.T) {
 			wantErr:          true,
 			wantResultCount:  0,
 		},
+		{
+			testName:         "listprojects success on name and account filter",
+			jsonResponseFile: "testdata/cmk_list_project_singular.json",
+			argumentsExecCall: []string{
+				"-c", configFilePath,
+				"list", "projects", fmt.Sprintf("name=\"%s\"", projectName), fmt.Sprintf("domainid=\"%s\"", domainID), fmt.Sprintf("account=\"%s\"", accountName),
+			},
+			cmkFunc: func(cmk executables.Cmk, ctx context.Context) error {
+				return cmk.ValidateProjectMembership(ctx, execConfig.Profiles[0].Name, projectName, accountName, domainID)
+			},
+			cmkResponseError: nil,
+			wantErr:          false,
+			wantResultCount:  0,
+		},
+		{
+			testName:         "listprojects success without account",
+			jsonResponseFile: "testdata/cmk_list_project_singular.json",
+			argumentsExecCall: []string{
+				"-c", configFilePath,
+				"list", "projects", fmt.Sprintf("name=\"%s\"", projectName), fmt.Sprintf("domainid=\"%s\"", domainID),
+			},
+			cmkFunc: func(cmk executables.Cmk, ctx context.Context) error {
+				return cmk.ValidateProjectMembership(ctx, execConfig.Profiles[0].Name, projectName, "", domainID)
+			},
+			cmkResponseError: nil,
+			wantErr:          false,
+			wantResultCount:  0,
+		},
+		{
+			testName:         "listprojects no results",
+			jsonResponseFile: "testdata/cmk_list_empty_response.json",
+			argumentsExecCall: []string{
+				"-c", configFilePath,
+				"list", "projects", fmt.Sprintf("name=\"%s\"", projectName), fmt.Sprintf("domainid=\"%s\"", domainID), fmt.Sprintf("account=\"%s\"", accountName),
+			},
+			cmkFunc: func(cmk executables.Cmk, ctx context.Context) error {
+				return cmk.ValidateProjectMembership(ctx, execConfig.Profiles[0].Name, projectName, accountName, domainID)
+			},
+			cmkResponseError: nil,
+			wantErr:          true,
+			wantResultCount:  0,
+		},
+		{
+			testName:         "listprojects project not active",
+			jsonResponseFile: "testdata/cmk_list_project_suspended.json",
+			argumentsExecCall: []string{
+				"-c", configFilePath,
+				"list", "projects", fmt.Sprintf("name=\"%s\"", projectName), fmt.Sprintf("domainid=\"%s\"", domainID), fmt.Sprintf("account=\"%s\"", accountName),
+			},
+			cmkFunc: func(cmk executables.Cmk, ctx context.Context) error {
+				return cmk.ValidateProjectMembership(ctx, execConfig.Profiles[0].Name, projectName, accountName, domainID)
+			},
+			cmkResponseError: nil,
+			wantErr:          true,
+			wantResultCount:  0,
+		},
+		{
+			testName:         "listprojects json parse exception",
+			jsonResponseFile: "testdata/cmk_non_json_response.txt",
+			argumentsExecCall: []string{
+				"-c", configFilePath,
+				"list", "projects", fmt.Sprintf("name=\"%s\"", projectName), fmt.Sprintf("domainid=\"%s\"", domainID), fmt.Sprintf("account=\"%s\"", accountName),
+			},
+			cmkFunc: func(cmk executables.Cmk, ctx context.Context) error {
+				return cmk.ValidateProjectMembership(ctx, execConfig.Profiles[0].Name, projectName, accountName, domainID)
+			},
+			cmkResponseError: nil,
+			wantErr:          true,
+			wantResultCount:  0,
+		},
 		{
 			testName:         "listzones success on name filter",
 			jsonResponseFile: "testdata/cmk_list_zone_singular.json",
@@ -151,3 +151,20 @@ func (d *Docker) CheckContainerExistence(ctx context.Context, name string) (bool
 
 	return false, fmt.Errorf("checking if a docker container with name %s exists: %v", name, err)
 }
+
+// Exec runs a command in a running container and returns its output.
+func (d *Docker) Exec(ctx context.Context, container string, command ...string) (string, error) {
+	return d.ExecWithStdin(ctx, nil, container, command...)
+}
+
+// ExecWithStdin runs a command in a running container, passing in as its standard input.
+func (d *Docker) ExecWithStdin(ctx context.Context, in []byte, container string, command ...string) (string, error) {
+	params := append([]string{"exec", "-i", container}, command...)
+
+	out, err := d.ExecuteWithStdin(ctx, in, params...)
+	if err != nil {
+		return "", fmt.Errorf("executing command in docker container %s: %v", container, err)
+	}
+
+	return out.String(), nil
+}
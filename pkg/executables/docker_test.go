@@ -281,3 +281,31 @@ func TestDockerCheckContainerExistenceOtherError(t *testing.T) {
 	assert.False(t, exists)
 	assert.EqualError(t, err, expectedError, "Error should be: %v, got: %v", expectedError, err)
 }
+
+func TestDockerExec(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	mockCtrl := gomock.NewController(t)
+
+	executable := mockexecutables.NewMockExecutable(mockCtrl)
+	executable.EXPECT().ExecuteWithStdin(ctx, nil, "exec", "-i", "my-cluster-cp", "cat", "/etc/hostname").Return(*bytes.NewBufferString("my-cluster-cp"), nil)
+	d := executables.NewDocker(executable)
+
+	out, err := d.Exec(ctx, "my-cluster-cp", "cat", "/etc/hostname")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(out).To(Equal("my-cluster-cp"))
+}
+
+func TestDockerExecWithStdinError(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	mockCtrl := gomock.NewController(t)
+	in := []byte("data")
+
+	executable := mockexecutables.NewMockExecutable(mockCtrl)
+	executable.EXPECT().ExecuteWithStdin(ctx, in, "exec", "-i", "my-cluster-cp", "tee", "/tmp/data").Return(bytes.Buffer{}, errors.New("no such container"))
+	d := executables.NewDocker(executable)
+
+	_, err := d.ExecWithStdin(ctx, in, "my-cluster-cp", "tee", "/tmp/data")
+	g.Expect(err).To(MatchError("executing command in docker container my-cluster-cp: no such container"))
+}
@@ -21,6 +21,7 @@ type DockerClient interface {
 
 type dockerContainer struct {
 	image               string
+	platform            string
 	workingDir          string
 	mountDirs           []string
 	containerName       string
@@ -29,9 +30,10 @@ type dockerContainer struct {
 	*retrier.Retrier
 }
 
-func newDockerContainer(image, workingDir string, mountDirs []string, dockerClient DockerClient) *dockerContainer {
+func newDockerContainer(image, platform, workingDir string, mountDirs []string, dockerClient DockerClient) *dockerContainer {
 	return &dockerContainer{
 		image:         image,
+		platform:      platform,
 		workingDir:    workingDir,
 		mountDirs:     mountDirs,
 		containerName: containerNamePrefix + strconv.FormatInt(time.Now().UnixNano(), 10),
@@ -50,7 +52,13 @@ func (d *dockerContainer) Init(ctx context.Context) error {
 	var err error
 	d.initOnce.Do(func() {
 		err = d.Retry(func() error {
-			return d.dockerClient.PullImage(ctx, d.image)
+			if d.platform == "" {
+				return d.dockerClient.PullImage(ctx, d.image)
+			}
+			// Pulling for the platform replaces a cached image for another architecture.
+			logger.V(2).Info("Pulling docker image", "image", d.image, "platform", d.platform)
+			_, err := d.dockerClient.Execute(ctx, "pull", "--platform", d.platform, d.image)
+			return err
 		})
 		if err != nil {
 			return
@@ -63,7 +71,10 @@ func (d *dockerContainer) Init(ctx context.Context) error {
 			return
 		}
 
-		params := []string{"run", "-d", "--name", d.containerName, "--network", "host", "-w", absWorkingDir, "-v", "/var/run/docker.sock:/var/run/docker.sock"}
+		params := []string{"run", "-d", "--name", d.containerName, "--network", "host", "-w", ContainerPath(absWorkingDir), "-v", dockerSocketMount()}
+		if d.platform != "" {
+			params = append(params, "--platform", d.platform)
+		}
 
 		for _, m := range d.mountDirs {
 			var absMountDir string
@@ -72,7 +83,7 @@ func (d *dockerContainer) Init(ctx context.Context) error {
 				err = fmt.Errorf("getting abs path for mount dir: %v", err)
 				return
 			}
-			params = append(params, "-v", fmt.Sprintf("%[1]s:%[1]s", absMountDir))
+			params = append(params, "-v", fmt.Sprintf("%s:%s", absMountDir, ContainerPath(absMountDir)))
 		}
 
 		// start container and keep it running in the background
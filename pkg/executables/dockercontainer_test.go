@@ -45,3 +45,35 @@ func TestDockerContainerInitErrorPullImage(t *testing.T) {
 	d.Retrier = retrier.NewWithMaxRetries(5, 0)
 	g.Expect(d.Init(context.Background())).To(MatchError(ContainSubstring("error in pull")))
 }
+
+func TestDockerContainerInitWindowsHost(t *testing.T) {
+	g := newDockerContainerTest(t)
+	executables.SetHostOS(t, "windows")
+	g.c.EXPECT().PullImage(g.ctx, "").Return(nil)
+	g.c.EXPECT().Execute(g.ctx, gomock.Any()).DoAndReturn(func(_ context.Context, args ...string) (bytes.Buffer, error) {
+		g.Expect(args).To(ContainElements("-v", "//var/run/docker.sock:/var/run/docker.sock"))
+		return bytes.Buffer{}, nil
+	})
+	d := executables.NewDockerContainerCustomBinary(g.c)
+	g.Expect(d.Init(context.Background())).To(Succeed())
+}
+
+func TestDockerContainerInitForPlatform(t *testing.T) {
+	g := newDockerContainerTest(t)
+	image := "public.ecr.aws/eks-anywhere/cli-tools:v0.0.1"
+	pull := g.c.EXPECT().Execute(g.ctx, "pull", "--platform", "linux/arm64", image).Return(bytes.Buffer{}, nil)
+	g.c.EXPECT().Execute(g.ctx, gomock.Any()).DoAndReturn(func(_ context.Context, args ...string) (bytes.Buffer, error) {
+		g.Expect(args).To(ContainElements("--platform", "linux/arm64", image))
+		return bytes.Buffer{}, nil
+	}).After(pull)
+	d := executables.NewDockerContainerForPlatform(g.c, image, "linux/arm64")
+	g.Expect(d.Init(context.Background())).To(Succeed())
+}
+
+func TestDockerContainerInitForPlatformErrorPullImage(t *testing.T) {
+	g := newDockerContainerTest(t)
+	g.c.EXPECT().Execute(g.ctx, "pull", "--platform", "linux/arm64", "image").Return(bytes.Buffer{}, errors.New("error in pull")).Times(5)
+	d := executables.NewDockerContainerForPlatform(g.c, "image", "linux/arm64")
+	d.Retrier = retrier.NewWithMaxRetries(5, 0)
+	g.Expect(d.Init(context.Background())).To(MatchError(ContainSubstring("error in pull")))
+}
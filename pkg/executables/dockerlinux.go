@@ -52,7 +52,7 @@ func (e *linuxDockerExecutable) buildCommand(envs map[string]string, cli string,
 	dockerCommands = append(dockerCommands, envVars...)
 
 	dockerCommands = append(dockerCommands, e.containerName, e.cli)
-	dockerCommands = append(dockerCommands, args...)
+	dockerCommands = append(dockerCommands, containerArgs(args)...)
 
 	return dockerCommands
 }
@@ -2,6 +2,7 @@ package executables
 
 import (
 	"context"
+	"testing"
 	"time"
 )
 
@@ -12,3 +13,26 @@ func KubectlWaitRetryPolicy(k *Kubectl, totalRetries int, err error) (retry bool
 func CallKubectlPrivateWait(k *Kubectl, ctx context.Context, kubeconfig string, timeoutTime time.Time, forCondition string, property string, namespace string) error {
 	return k.wait(ctx, kubeconfig, timeoutTime, forCondition, property, namespace)
 }
+
+// SetHostOS overrides the operating system the executables consider the CLI to be running on.
+func SetHostOS(t *testing.T, os string) {
+	original := hostOS
+	hostOS = os
+	t.Cleanup(func() { hostOS = original })
+}
+
+func ContainerArgs(args []string) []string {
+	return containerArgs(args)
+}
+
+// SetHostArch overrides the architecture the executables consider the admin machine to have.
+func SetHostArch(t *testing.T, arch string) {
+	original := hostArch
+	hostArch = arch
+	t.Cleanup(func() { hostArch = original })
+}
+
+// NewDockerContainerForPlatform builds a docker container that pulls and runs image for platform.
+func NewDockerContainerForPlatform(docker DockerClient, image, platform string) *dockerContainer {
+	return newDockerContainer(image, platform, "", nil, docker)
+}
@@ -48,7 +48,7 @@ func (f *Flux) BootstrapGithub(ctx context.Context, cluster *types.Cluster, flux
 		params = append(params, "--personal")
 	}
 
-	token, err := github.GetGithubAccessTokenFromEnv()
+	token, err := github.GetGithubAccessTokenFromEnv(ctx)
 	if err != nil {
 		return fmt.Errorf("setting token env: %v", err)
 	}
@@ -7,6 +7,7 @@ import (
 	"crypto/tls"
 	_ "embed"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"os"
@@ -21,6 +22,7 @@ import (
 	"github.com/aws/eks-anywhere/pkg/api/v1alpha1"
 	"github.com/aws/eks-anywhere/pkg/config"
 	"github.com/aws/eks-anywhere/pkg/filewriter"
+	"github.com/aws/eks-anywhere/pkg/govmomi"
 	"github.com/aws/eks-anywhere/pkg/logger"
 	"github.com/aws/eks-anywhere/pkg/retrier"
 	"github.com/aws/eks-anywhere/pkg/types"
@@ -35,6 +37,9 @@ const (
 	govcDatacenterKey    = "GOVC_DATACENTER"
 	govcTlsHostsFile     = "govc_known_hosts"
 	govcTlsKnownHostsKey = "GOVC_TLS_KNOWN_HOSTS"
+	govcPersistSession   = "GOVC_PERSIST_SESSION"
+	govmomiHomeKey       = "GOVMOMI_HOME"
+	GovcSessionDir       = "govc-sessions"
 	vSphereServerKey     = "VSPHERE_SERVER"
 	byteToGiB            = 1073741824.0
 	DeployOptsFile       = "deploy-opts.json"
@@ -60,6 +65,7 @@ type FolderType string
 const (
 	datastore     FolderType = "datastore"
 	vm            FolderType = "vm"
+	host          FolderType = "host"
 	maxRetries               = 5
 	backOffPeriod            = 5 * time.Second
 )
@@ -70,6 +76,7 @@ type Govc struct {
 	*retrier.Retrier
 	requiredEnvs *syncSlice
 	envMap       map[string]string
+	sessionDir   string
 }
 
 type GovcOpt func(*Govc)
@@ -98,13 +105,26 @@ func WithGovcEnvMap(envMap map[string]string) GovcOpt {
 	}
 }
 
+// WithGovcSessionDir makes all govc commands persist and reuse their vCenter session from dir,
+// so a single CLI run logs in once instead of once per command.
+func WithGovcSessionDir(dir string) GovcOpt {
+	return func(g *Govc) {
+		g.sessionDir = dir
+	}
+}
+
 func (g *Govc) exec(ctx context.Context, args ...string) (stdout bytes.Buffer, err error) {
 	envMap, err := g.validateAndSetupCreds()
 	if err != nil {
 		return bytes.Buffer{}, fmt.Errorf("failed govc validations: %v", err)
 	}
 
-	return g.ExecuteWithEnv(ctx, envMap, args...)
+	stdout, err = g.ExecuteWithEnv(ctx, envMap, args...)
+	if govmomi.IsSessionLimitError(err) {
+		return stdout, &govmomi.SessionLimitError{Err: err}
+	}
+
+	return stdout, err
 }
 
 func (g *Govc) Close(ctx context.Context) error {
@@ -214,6 +234,36 @@ func (g *Govc) GetLibraryElementContentVersion(ctx context.Context, element stri
 	return elementInfo[0].ContentVersion, nil
 }
 
+// LibraryItem is an item of a vSphere content library.
+type LibraryItem struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+// GetLibraryItem returns the item of the content library with the given name, or nil if it doesn't exist.
+func (g *Govc) GetLibraryItem(ctx context.Context, library, item string) (*LibraryItem, error) {
+	response, err := g.exec(ctx, "library.info", "-json", filepath.Join("/", library, item))
+	if err != nil {
+		return nil, fmt.Errorf("govc failed getting content library item %s/%s: %v", library, item, err)
+	}
+
+	itemJson := strings.TrimSuffix(response.String(), "\n")
+	if itemJson == "null" || itemJson == "" {
+		return nil, nil
+	}
+
+	items := make([]LibraryItem, 0)
+	if err = json.Unmarshal([]byte(itemJson), &items); err != nil {
+		return nil, fmt.Errorf("unmarshalling content library item info: %v", err)
+	}
+
+	if len(items) == 0 {
+		return nil, nil
+	}
+
+	return &items[0], nil
+}
+
 func (g *Govc) DeleteLibraryElement(ctx context.Context, element string) error {
 	_, err := g.exec(ctx, "library.rm", element)
 	if err != nil {
@@ -426,6 +476,16 @@ func (g *Govc) ImportTemplate(ctx context.Context, library, ovaURL, name string)
 	return nil
 }
 
+// ImportTemplateFromFile uploads the OVA at ovaPath in the host to the content library as a template named name.
+// ovaPath must be inside a folder mounted in the tools container, like the cluster folder.
+func (g *Govc) ImportTemplateFromFile(ctx context.Context, library, ovaPath, name string) error {
+	logger.V(4).Info("Importing template from file", "ova", ovaPath, "templateName", name)
+	if _, err := g.exec(ctx, "library.import", "-k", "-n", name, library, ovaPath); err != nil {
+		return fmt.Errorf("importing template from file: %v", err)
+	}
+	return nil
+}
+
 func (g *Govc) DeployTemplate(ctx context.Context, library, templateName, vmName, deployFolder, datacenter, datastore, network, resourcePool string, deployOptionsOverride []byte) error {
 	envMap, err := g.validateAndSetupCreds()
 	if err != nil {
@@ -564,10 +624,32 @@ func (g *Govc) getEnvMap() (map[string]string, error) {
 			}
 		}
 	}
+	addProviderProxyEnvs(envMap)
+	g.addSessionEnvs(envMap)
 
 	return envMap, nil
 }
 
+func (g *Govc) addSessionEnvs(envMap map[string]string) {
+	if g.sessionDir == "" {
+		return
+	}
+	envMap[govcPersistSession] = "true"
+	envMap[govmomiHomeKey] = ContainerPath(g.sessionDir)
+}
+
+// addProviderProxyEnvs points govc to the vCenter proxy when one is configured, replacing
+// whatever proxy settings govc would otherwise inherit.
+func addProviderProxyEnvs(envMap map[string]string) {
+	httpsProxy, ok := os.LookupEnv(config.EksavSphereHttpsProxyKey)
+	if !ok || len(httpsProxy) == 0 {
+		return
+	}
+	envMap[config.HttpsProxyKey] = httpsProxy
+	envMap[config.HttpProxyKey] = os.Getenv(config.EksavSphereHttpProxyKey)
+	envMap[config.NoProxyKey] = os.Getenv(config.EksavSphereNoProxyKey)
+}
+
 func (g *Govc) validateAndSetupCreds() (map[string]string, error) {
 	if g.envMap != nil {
 		return g.envMap, nil
@@ -827,6 +909,24 @@ func (g *Govc) GetFolderPath(ctx context.Context, datacenter string, folder stri
 // GetResourcePoolPath finds and validates a resource pool in the specified datacenter.
 // Returns an error if the pool doesn't exist or if multiple matching pools are found.
 func (g *Govc) GetResourcePoolPath(ctx context.Context, datacenter string, resourcePool string, envMap map[string]string) (string, error) {
+	foundPool, err := g.findResourcePool(ctx, datacenter, resourcePool, envMap)
+	if err != nil {
+		return "", err
+	}
+
+	logger.MarkPass("Resource pool validated")
+	return foundPool, nil
+}
+
+type resourcePoolNotFoundError struct {
+	resourcePool string
+}
+
+func (e *resourcePoolNotFoundError) Error() string {
+	return fmt.Sprintf("resource pool '%s' not found", e.resourcePool)
+}
+
+func (g *Govc) findResourcePool(ctx context.Context, datacenter string, resourcePool string, envMap map[string]string) (string, error) {
 	var poolInfoResponse bytes.Buffer
 	params := []string{"find", "-json", "/" + datacenter, "-type", "p", "-name", filepath.Base(resourcePool)}
 
@@ -842,7 +942,7 @@ func (g *Govc) GetResourcePoolPath(ctx context.Context, datacenter string, resou
 	poolInfoJson := poolInfoResponse.String()
 	poolInfoJson = strings.TrimSuffix(poolInfoJson, "\n")
 	if poolInfoJson == "null" || poolInfoJson == "" {
-		return "", fmt.Errorf("resource pool '%s' not found", resourcePool)
+		return "", &resourcePoolNotFoundError{resourcePool: resourcePool}
 	}
 
 	poolInfo := make([]string, 0)
@@ -863,13 +963,138 @@ func (g *Govc) GetResourcePoolPath(ctx context.Context, datacenter string, resou
 		}
 	}
 	if !bPoolFound {
-		return "", fmt.Errorf("resource pool '%s' not found", resourcePool)
+		return "", &resourcePoolNotFoundError{resourcePool: resourcePool}
 	}
 
-	logger.MarkPass("Resource pool validated")
 	return foundPool, nil
 }
 
+// ResourcePoolExists checks if a resource pool exists in the specified datacenter.
+func (g *Govc) ResourcePoolExists(ctx context.Context, datacenter, resourcePool string) (bool, error) {
+	envMap, err := g.validateAndSetupCreds()
+	if err != nil {
+		return false, fmt.Errorf("failed govc validations: %v", err)
+	}
+
+	_, err = g.findResourcePool(ctx, datacenter, resourcePool, envMap)
+	notFound := &resourcePoolNotFoundError{}
+	if errors.As(err, &notFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// CreateResourcePool creates a resource pool in the specified datacenter, including any missing parent pools.
+// The resource pool must be an inventory path, either absolute or relative to the datacenter host folder,
+// that goes through the compute cluster root pool. For example, Cluster-1/Resources/eksa.
+func (g *Govc) CreateResourcePool(ctx context.Context, datacenter, resourcePool string) error {
+	if strings.Contains(resourcePool, "*") {
+		return fmt.Errorf("can't create resource pool '%s': path contains a wildcard, please provide the full inventory path", resourcePool)
+	}
+
+	fullPath, err := prependPath(host, resourcePool, datacenter)
+	if err != nil {
+		return err
+	}
+
+	root := strings.Index(fullPath, "/Resources/")
+	if root < 0 {
+		return fmt.Errorf("can't create resource pool '%s': path must be under a compute cluster Resources pool", resourcePool)
+	}
+
+	currPath := fullPath[:root] + "/Resources"
+	for _, pool := range strings.Split(strings.Trim(fullPath[root+len("/Resources/"):], "/"), "/") {
+		currPath += "/" + pool
+		exists, err := g.inventoryObjectExists(ctx, currPath, "p")
+		if err != nil {
+			return err
+		}
+		if exists {
+			continue
+		}
+
+		if err := g.Retry(func() error {
+			if _, err := g.exec(ctx, "pool.create", currPath); err != nil {
+				return fmt.Errorf("creating resource pool %s: %v", currPath, err)
+			}
+			return nil
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// FolderExists checks if a VM folder exists in the specified datacenter.
+func (g *Govc) FolderExists(ctx context.Context, datacenter, folder string) (bool, error) {
+	fullPath, err := prependPath(vm, folder, datacenter)
+	if err != nil {
+		return false, err
+	}
+
+	return g.inventoryObjectExists(ctx, fullPath, "f")
+}
+
+// CreateFolder creates a VM folder in the specified datacenter, including any missing parent folders.
+func (g *Govc) CreateFolder(ctx context.Context, datacenter, folder string) error {
+	fullPath, err := prependPath(vm, folder, datacenter)
+	if err != nil {
+		return err
+	}
+
+	envMap, err := g.validateAndSetupCreds()
+	if err != nil {
+		return fmt.Errorf("failed govc validations: %v", err)
+	}
+
+	currPath := fmt.Sprintf("/%s/%s", datacenter, vm)
+	for _, dir := range strings.Split(strings.Trim(strings.TrimPrefix(fullPath, currPath), "/"), "/") {
+		currPath += "/" + dir
+		exists, err := g.inventoryObjectExists(ctx, currPath, "f")
+		if err != nil {
+			return err
+		}
+		if exists {
+			continue
+		}
+
+		if err := g.createFolder(ctx, envMap, currPath); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (g *Govc) inventoryObjectExists(ctx context.Context, path, objectType string) (bool, error) {
+	exists := false
+
+	err := g.Retry(func() error {
+		response, err := g.exec(ctx, "find", "-maxdepth=1", filepath.Dir(path), "-type", objectType, "-name", filepath.Base(path))
+		if err != nil && strings.Contains(err.Error(), "not found") {
+			// The parent doesn't exist either.
+			exists = false
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		exists = response.String() != ""
+		return nil
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed checking if '%s' exists: %v", path, err)
+	}
+
+	return exists, nil
+}
+
 // GetComputeClusterPath finds and validates a compute cluster in the specified datacenter.
 // Returns an error if the compute cluster doesn't exist or if multiple matching compute clusters are found.
 func (g *Govc) GetComputeClusterPath(ctx context.Context, datacenter string, computeCluster string, envMap map[string]string) (string, error) {
@@ -1241,6 +1466,18 @@ func (g *Govc) RoleExists(ctx context.Context, name string) (bool, error) {
 	return true, nil
 }
 
+// StoragePolicyExists checks if a VM storage policy exists in vCenter.
+func (g *Govc) StoragePolicyExists(ctx context.Context, name string) (bool, error) {
+	_, err := g.exec(ctx, "storage.policy.ls", "-i", name)
+	if err != nil && strings.Contains(err.Error(), fmt.Sprintf("profile %q not found", name)) {
+		return false, nil
+	} else if err != nil {
+		return false, fmt.Errorf("failed checking if storage policy '%s' exists: %v", name, err)
+	}
+
+	return true, nil
+}
+
 // CreateRole creates a role with specified privileges.
 func (g *Govc) CreateRole(ctx context.Context, name string, privileges []string) error {
 	params := append([]string{"role.create", name}, privileges...)
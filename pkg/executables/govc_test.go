@@ -20,6 +20,7 @@ import (
 
 	"github.com/aws/eks-anywhere/internal/test"
 	"github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/config"
 	"github.com/aws/eks-anywhere/pkg/executables"
 	mockexecutables "github.com/aws/eks-anywhere/pkg/executables/mocks"
 	"github.com/aws/eks-anywhere/pkg/retrier"
@@ -269,6 +270,45 @@ func TestLibraryElementExistsError(t *testing.T) {
 	}
 }
 
+func TestGovcGetLibraryItemSuccess(t *testing.T) {
+	ctx := context.Background()
+	response := `[
+		{
+			"name": "ubuntu-2204-kube-v1-30",
+			"type": "ovf",
+			"content_version": "2"
+		}
+	]`
+	_, g, executable, env := setup(t)
+	executable.EXPECT().ExecuteWithEnv(ctx, env, "library.info", "-json", "/eksa-templates/ubuntu-2204-kube-v1-30").Return(*bytes.NewBufferString(response), nil)
+
+	item, err := g.GetLibraryItem(ctx, "eksa-templates", "ubuntu-2204-kube-v1-30")
+	gt := NewWithT(t)
+	gt.Expect(err).To(BeNil())
+	gt.Expect(item).To(Equal(&executables.LibraryItem{Name: "ubuntu-2204-kube-v1-30", Type: "ovf"}))
+}
+
+func TestGovcGetLibraryItemNotFound(t *testing.T) {
+	ctx := context.Background()
+	_, g, executable, env := setup(t)
+	executable.EXPECT().ExecuteWithEnv(ctx, env, "library.info", "-json", "/eksa-templates/ubuntu-2204-kube-v1-30").Return(*bytes.NewBufferString("null\n"), nil)
+
+	item, err := g.GetLibraryItem(ctx, "eksa-templates", "ubuntu-2204-kube-v1-30")
+	gt := NewWithT(t)
+	gt.Expect(err).To(BeNil())
+	gt.Expect(item).To(BeNil())
+}
+
+func TestGovcGetLibraryItemError(t *testing.T) {
+	ctx := context.Background()
+	_, g, executable, env := setup(t)
+	executable.EXPECT().ExecuteWithEnv(ctx, env, "library.info", "-json", "/eksa-templates/ubuntu-2204-kube-v1-30").Return(bytes.Buffer{}, errors.New("error from execute with env"))
+
+	_, err := g.GetLibraryItem(ctx, "eksa-templates", "ubuntu-2204-kube-v1-30")
+	gt := NewWithT(t)
+	gt.Expect(err).To(MatchError(ContainSubstring("govc failed getting content library item eksa-templates/ubuntu-2204-kube-v1-30")))
+}
+
 func TestGetLibraryElementContentVersionSuccess(t *testing.T) {
 	ctx := context.Background()
 	response := `[
@@ -1530,6 +1570,52 @@ func TestEnvMapOverride(t *testing.T) {
 	}
 }
 
+func TestProviderProxyEnvs(t *testing.T) {
+	category := "category"
+	tag := "tag"
+	ctx := context.Background()
+
+	_, g, executable, env := setup(t)
+	t.Setenv(config.EksavSphereHttpsProxyKey, "http://10.0.0.1:3129")
+	t.Setenv(config.EksavSphereHttpProxyKey, "http://10.0.0.1:3128")
+	t.Setenv(config.EksavSphereNoProxyKey, "10.0.0.0/8")
+	wantEnv := map[string]string{
+		config.HttpsProxyKey: "http://10.0.0.1:3129",
+		config.HttpProxyKey:  "http://10.0.0.1:3128",
+		config.NoProxyKey:    "10.0.0.0/8",
+	}
+	for k, v := range env {
+		wantEnv[k] = v
+	}
+	executable.EXPECT().ExecuteWithEnv(ctx, wantEnv, "tags.create", "-c", category, tag).Return(*bytes.NewBufferString(""), nil)
+
+	if err := g.CreateTag(ctx, tag, category); err != nil {
+		t.Fatalf("Govc.CreateTag() with provider proxy err = %v, want err nil", err)
+	}
+}
+
+func TestGovcSessionDir(t *testing.T) {
+	category := "category"
+	tag := "tag"
+	ctx := context.Background()
+
+	_, g, executable, env := setup(t, executables.WithGovcSessionDir("cluster/generated/govc-sessions"))
+	wantEnv := map[string]string{
+		"GOVC_PERSIST_SESSION": "true",
+		"GOVMOMI_HOME":         "cluster/generated/govc-sessions",
+	}
+	for k, v := range env {
+		wantEnv[k] = v
+	}
+	executable.EXPECT().ExecuteWithEnv(ctx, wantEnv, "tags.create", "-c", category, tag).Return(*bytes.NewBufferString(""), nil).Times(2)
+
+	for i := 0; i < 2; i++ {
+		if err := g.CreateTag(ctx, tag, category); err != nil {
+			t.Fatalf("Govc.CreateTag() with session dir err = %v, want err nil", err)
+		}
+	}
+}
+
 func TestAddTagError(t *testing.T) {
 	tag := "tag"
 	path := "/SDDC-Datacenter/vm/Templates/ubuntu-2004-kube-v1.19.6"
@@ -1701,6 +1787,32 @@ func TestImportTemplateError(t *testing.T) {
 	}
 }
 
+func TestImportTemplateFromFileSuccess(t *testing.T) {
+	ovaPath := "cluster/generated/ubuntu.ova"
+	name := "name"
+	ctx := context.Background()
+
+	_, g, executable, env := setup(t)
+	executable.EXPECT().ExecuteWithEnv(ctx, env, "library.import", "-k", "-n", name, templateLibrary, ovaPath).Return(*bytes.NewBufferString(""), nil)
+
+	if err := g.ImportTemplateFromFile(ctx, templateLibrary, ovaPath, name); err != nil {
+		t.Fatalf("Govc.ImportTemplateFromFile() err = %v, want err nil", err)
+	}
+}
+
+func TestImportTemplateFromFileError(t *testing.T) {
+	ovaPath := "cluster/generated/ubuntu.ova"
+	name := "name"
+	ctx := context.Background()
+
+	_, g, executable, env := setup(t)
+	executable.EXPECT().ExecuteWithEnv(ctx, env, "library.import", "-k", "-n", name, templateLibrary, ovaPath).Return(bytes.Buffer{}, errors.New("error from execute with env"))
+
+	if err := g.ImportTemplateFromFile(ctx, templateLibrary, ovaPath, name); err == nil {
+		t.Fatal("Govc.ImportTemplateFromFile() err = nil, want err not nil")
+	}
+}
+
 func TestDeleteTemplateSuccess(t *testing.T) {
 	template := "template"
 	resourcePool := "resourcePool"
@@ -1770,6 +1882,18 @@ func TestGovcLogoutSuccess(t *testing.T) {
 	}
 }
 
+func TestGovcSessionLimitError(t *testing.T) {
+	ctx := context.Background()
+	_, g, executable, env := setup(t)
+
+	executable.EXPECT().ExecuteWithEnv(ctx, env, "about.cert", "-thumbprint", "-k").Return(bytes.Buffer{}, errors.New("ServerFaultCode: The maximum number of sessions has been exceeded"))
+
+	_, err := g.GetCertThumbprint(ctx)
+	if err == nil || !strings.Contains(err.Error(), "vCenter session limit reached") {
+		t.Fatalf("Govc.GetCertThumbprint() err = %v, want session limit error", err)
+	}
+}
+
 func TestGovcValidateVCenterConnectionSuccess(t *testing.T) {
 	ctx := context.Background()
 	ts := newHTTPSServer(t)
@@ -2127,6 +2251,44 @@ func TestGovcGroupExistsError(t *testing.T) {
 	gt.Expect(err).ToNot(BeNil())
 }
 
+func TestGovcStoragePolicyExistsTrue(t *testing.T) {
+	ctx := context.Background()
+	_, g, executable, env := setup(t)
+	policy := "vSAN RAID-1"
+
+	executable.EXPECT().ExecuteWithEnv(ctx, env, "storage.policy.ls", "-i", policy).Return(*bytes.NewBufferString("aa6d5a82-1c88-45da-85d3-3d74b91a5bad"), nil)
+
+	exists, err := g.StoragePolicyExists(ctx, policy)
+	gt := NewWithT(t)
+	gt.Expect(err).To(BeNil())
+	gt.Expect(exists).To(BeTrue())
+}
+
+func TestGovcStoragePolicyExistsFalse(t *testing.T) {
+	ctx := context.Background()
+	_, g, executable, env := setup(t)
+	policy := "vSAN RAID-1"
+
+	executable.EXPECT().ExecuteWithEnv(ctx, env, "storage.policy.ls", "-i", policy).Return(*bytes.NewBufferString(""), fmt.Errorf("govc: profile %q not found", policy))
+
+	exists, err := g.StoragePolicyExists(ctx, policy)
+	gt := NewWithT(t)
+	gt.Expect(err).To(BeNil())
+	gt.Expect(exists).To(BeFalse())
+}
+
+func TestGovcStoragePolicyExistsError(t *testing.T) {
+	ctx := context.Background()
+	_, g, executable, env := setup(t)
+	policy := "vSAN RAID-1"
+
+	executable.EXPECT().ExecuteWithEnv(ctx, env, "storage.policy.ls", "-i", policy).Return(*bytes.NewBufferString(""), errors.New("operation failed"))
+
+	_, err := g.StoragePolicyExists(ctx, policy)
+	gt := NewWithT(t)
+	gt.Expect(err).To(MatchError(ContainSubstring("failed checking if storage policy 'vSAN RAID-1' exists")))
+}
+
 func TestGovcRoleExistsTrue(t *testing.T) {
 	ctx := context.Background()
 	_, g, executable, env := setup(t)
@@ -2487,3 +2649,96 @@ func TestGovcGetResourcePoolInfo(t *testing.T) {
 		})
 	}
 }
+
+func TestGovcFolderExists(t *testing.T) {
+	ctx := context.Background()
+	g := NewWithT(t)
+	_, govc, executable, env := setup(t)
+
+	executable.EXPECT().ExecuteWithEnv(ctx, env, "find", "-maxdepth=1", "/SDDC-Datacenter/vm/eksa", "-type", "f", "-name", "test").Return(*bytes.NewBufferString("/SDDC-Datacenter/vm/eksa/test"), nil)
+
+	exists, err := govc.FolderExists(ctx, "SDDC-Datacenter", "eksa/test")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(exists).To(BeTrue())
+}
+
+func TestGovcFolderExistsParentNotFound(t *testing.T) {
+	ctx := context.Background()
+	g := NewWithT(t)
+	_, govc, executable, env := setup(t)
+
+	executable.EXPECT().ExecuteWithEnv(ctx, env, "find", "-maxdepth=1", "/SDDC-Datacenter/vm/eksa", "-type", "f", "-name", "test").Return(bytes.Buffer{}, errors.New("govc: folder '/SDDC-Datacenter/vm/eksa' not found"))
+
+	exists, err := govc.FolderExists(ctx, "SDDC-Datacenter", "/SDDC-Datacenter/vm/eksa/test")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(exists).To(BeFalse())
+}
+
+func TestGovcCreateFolder(t *testing.T) {
+	ctx := context.Background()
+	g := NewWithT(t)
+	_, govc, executable, env := setup(t)
+
+	gomock.InOrder(
+		executable.EXPECT().ExecuteWithEnv(ctx, env, "find", "-maxdepth=1", "/SDDC-Datacenter/vm", "-type", "f", "-name", "eksa").Return(*bytes.NewBufferString("/SDDC-Datacenter/vm/eksa"), nil),
+		executable.EXPECT().ExecuteWithEnv(ctx, env, "find", "-maxdepth=1", "/SDDC-Datacenter/vm/eksa", "-type", "f", "-name", "test").Return(bytes.Buffer{}, nil),
+		executable.EXPECT().ExecuteWithEnv(ctx, env, "folder.create", "/SDDC-Datacenter/vm/eksa/test").Return(bytes.Buffer{}, nil),
+	)
+
+	g.Expect(govc.CreateFolder(ctx, "SDDC-Datacenter", "eksa/test")).To(Succeed())
+}
+
+func TestGovcResourcePoolExists(t *testing.T) {
+	ctx := context.Background()
+	g := NewWithT(t)
+	_, govc, executable, env := setup(t)
+
+	executable.EXPECT().ExecuteWithEnv(ctx, env, "find", "-json", "/SDDC-Datacenter", "-type", "p", "-name", "eksa").Return(*bytes.NewBufferString(`["/SDDC-Datacenter/host/Cluster-1/Resources/eksa"]`), nil)
+
+	exists, err := govc.ResourcePoolExists(ctx, "SDDC-Datacenter", "*/Resources/eksa")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(exists).To(BeTrue())
+}
+
+func TestGovcResourcePoolExistsNotFound(t *testing.T) {
+	ctx := context.Background()
+	g := NewWithT(t)
+	_, govc, executable, env := setup(t)
+
+	executable.EXPECT().ExecuteWithEnv(ctx, env, "find", "-json", "/SDDC-Datacenter", "-type", "p", "-name", "eksa").Return(*bytes.NewBufferString("null"), nil)
+
+	exists, err := govc.ResourcePoolExists(ctx, "SDDC-Datacenter", "*/Resources/eksa")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(exists).To(BeFalse())
+}
+
+func TestGovcCreateResourcePool(t *testing.T) {
+	ctx := context.Background()
+	g := NewWithT(t)
+	_, govc, executable, env := setup(t)
+
+	gomock.InOrder(
+		executable.EXPECT().ExecuteWithEnv(ctx, env, "find", "-maxdepth=1", "/SDDC-Datacenter/host/Cluster-1/Resources", "-type", "p", "-name", "eksa").Return(bytes.Buffer{}, nil),
+		executable.EXPECT().ExecuteWithEnv(ctx, env, "pool.create", "/SDDC-Datacenter/host/Cluster-1/Resources/eksa").Return(bytes.Buffer{}, nil),
+		executable.EXPECT().ExecuteWithEnv(ctx, env, "find", "-maxdepth=1", "/SDDC-Datacenter/host/Cluster-1/Resources/eksa", "-type", "p", "-name", "test").Return(bytes.Buffer{}, nil),
+		executable.EXPECT().ExecuteWithEnv(ctx, env, "pool.create", "/SDDC-Datacenter/host/Cluster-1/Resources/eksa/test").Return(bytes.Buffer{}, nil),
+	)
+
+	g.Expect(govc.CreateResourcePool(ctx, "SDDC-Datacenter", "Cluster-1/Resources/eksa/test")).To(Succeed())
+}
+
+func TestGovcCreateResourcePoolWildcard(t *testing.T) {
+	ctx := context.Background()
+	g := NewWithT(t)
+	_, govc, _, _ := setup(t)
+
+	g.Expect(govc.CreateResourcePool(ctx, "SDDC-Datacenter", "*/Resources/eksa")).To(MatchError(ContainSubstring("path contains a wildcard")))
+}
+
+func TestGovcCreateResourcePoolNotUnderResources(t *testing.T) {
+	ctx := context.Background()
+	g := NewWithT(t)
+	_, govc, _, _ := setup(t)
+
+	g.Expect(govc.CreateResourcePool(ctx, "SDDC-Datacenter", "Cluster-1/eksa")).To(MatchError(ContainSubstring("must be under a compute cluster Resources pool")))
+}
@@ -3,6 +3,7 @@ package executables
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 	"strings"
 
@@ -195,6 +196,22 @@ func (h *Helm) ListCharts(ctx context.Context, kubeconfigFilePath, filter, names
 	return charts, nil
 }
 
+// ListReleases lists the helm releases of all the namespaces, in any state.
+func (h *Helm) ListReleases(ctx context.Context, kubeconfigFilePath string) ([]helm.Release, error) {
+	params := []string{"list", "--all", "--all-namespaces", "--output", "json", "--kubeconfig", kubeconfigFilePath}
+	out, err := h.executable.Command(ctx, params...).WithEnvVars(h.env).Run()
+	if err != nil {
+		return nil, err
+	}
+
+	releases := []helm.Release{}
+	if err := json.Unmarshal(out.Bytes(), &releases); err != nil {
+		return nil, fmt.Errorf("parsing helm releases: %v", err)
+	}
+
+	return releases, nil
+}
+
 func (h *Helm) addInsecureFlagIfProvided(params []string) []string {
 	if h.helmConfig.Insecure {
 		return append(params, insecureSkipVerifyFlag)
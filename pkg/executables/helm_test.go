@@ -324,6 +324,29 @@ func TestHelmListCharts(t *testing.T) {
 	})
 }
 
+func TestHelmListReleases(t *testing.T) {
+	tt := newHelmTest(t, helm.WithInsecure())
+	kubeconfig := "/root/.kube/config"
+	t.Run("Normal functionality", func(t *testing.T) {
+		output := []byte(`[{"name":"harbor","namespace":"harbor","chart":"harbor-2.5.1","status":"deployed"}]`)
+		expected := []helm.Release{{Name: "harbor", Namespace: "harbor", Chart: "harbor-2.5.1", Status: "deployed"}}
+		expectCommand(tt.e, tt.ctx, "list", "--all", "--all-namespaces", "--output", "json", "--kubeconfig", kubeconfig).withEnvVars(tt.envVars).to().Return(*bytes.NewBuffer(output), nil)
+		tt.Expect(tt.h.ListReleases(tt.ctx, kubeconfig)).To(Equal(expected))
+	})
+
+	t.Run("Invalid output", func(t *testing.T) {
+		expectCommand(tt.e, tt.ctx, "list", "--all", "--all-namespaces", "--output", "json", "--kubeconfig", kubeconfig).withEnvVars(tt.envVars).to().Return(*bytes.NewBufferString("not json"), nil)
+		_, err := tt.h.ListReleases(tt.ctx, kubeconfig)
+		tt.Expect(err).To(MatchError(ContainSubstring("parsing helm releases")))
+	})
+
+	t.Run("Errored out", func(t *testing.T) {
+		expectCommand(tt.e, tt.ctx, "list", "--all", "--all-namespaces", "--output", "json", "--kubeconfig", kubeconfig).withEnvVars(tt.envVars).to().Return(bytes.Buffer{}, errors.New("Error"))
+		_, err := tt.h.ListReleases(tt.ctx, kubeconfig)
+		tt.Expect(err).To(HaveOccurred())
+	})
+}
+
 func TestHelmDelete(s *testing.T) {
 	kubeconfig := "/root/.kube/config"
 
@@ -0,0 +1,94 @@
+package executables
+
+import (
+	"os"
+	"regexp"
+	"runtime"
+	"strings"
+)
+
+const (
+	windowsOS = "windows"
+
+	dockerSocket = "/var/run/docker.sock"
+	// Docker Desktop on Windows exposes the engine socket of its Linux VM under this path,
+	// while the host CLI talks to the engine through a named pipe.
+	windowsDockerSocket = "//var/run/docker.sock"
+)
+
+// hostOS is the operating system the CLI runs on. It's a variable so tests can simulate
+// running on a Windows admin machine.
+var hostOS = runtime.GOOS
+
+var windowsDrivePath = regexp.MustCompile(`^([A-Za-z]):[\\/]`)
+
+func isWindowsHost() bool {
+	return hostOS == windowsOS
+}
+
+// ContainerPath returns the path where a host path is available in the tools container.
+// Linux containers can't reference drive letters, so on Windows hosts C:\Users\admin is
+// mounted as /c/Users/admin and relative paths get forward slashes. Paths on other hosts
+// are mounted at the same location and are returned unchanged.
+func ContainerPath(hostPath string) string {
+	if !isWindowsHost() {
+		return hostPath
+	}
+
+	containerPath := strings.ReplaceAll(hostPath, `\`, "/")
+	if m := windowsDrivePath.FindStringSubmatch(hostPath); m != nil {
+		containerPath = "/" + strings.ToLower(m[1]) + containerPath[len(m[1])+1:]
+	}
+
+	return containerPath
+}
+
+func dockerSocketMount() string {
+	if isWindowsHost() {
+		return windowsDockerSocket + ":" + dockerSocket
+	}
+	return dockerSocket + ":" + dockerSocket
+}
+
+// containerArgs rewrites the arguments that reference host files or folders, either directly
+// or as the value of a --flag=value pair, so they can be resolved inside the tools container.
+func containerArgs(args []string) []string {
+	if !isWindowsHost() {
+		return args
+	}
+
+	containerArgs := make([]string, 0, len(args))
+	for _, arg := range args {
+		flag, value, hasValue := strings.Cut(arg, "=")
+		switch {
+		case isHostPath(arg):
+			arg = ContainerPath(arg)
+		case hasValue && strings.HasPrefix(flag, "-") && isHostPath(value):
+			arg = flag + "=" + ContainerPath(value)
+		}
+		containerArgs = append(containerArgs, arg)
+	}
+
+	return containerArgs
+}
+
+// isHostPath reports whether arg looks like a Windows path to a file, or to a file to be
+// created, on the host. Only arguments that need translating are considered.
+func isHostPath(arg string) bool {
+	if !strings.Contains(arg, `\`) && !windowsDrivePath.MatchString(arg) {
+		return false
+	}
+
+	// The parent folder is checked as well for files the executable is expected to create.
+	paths := []string{arg}
+	if i := strings.LastIndexAny(arg, `\/`); i > 0 {
+		paths = append(paths, arg[:i])
+	}
+	for _, p := range paths {
+		if _, err := os.Stat(p); err == nil {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,83 @@
+package executables_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/aws/eks-anywhere/pkg/executables"
+)
+
+func TestContainerPath(t *testing.T) {
+	tests := []struct {
+		name     string
+		hostOS   string
+		hostPath string
+		want     string
+	}{
+		{
+			name:     "linux absolute path",
+			hostOS:   "linux",
+			hostPath: "/home/admin/mgmt",
+			want:     "/home/admin/mgmt",
+		},
+		{
+			name:     "windows absolute path",
+			hostOS:   "windows",
+			hostPath: `C:\Users\admin\mgmt`,
+			want:     "/c/Users/admin/mgmt",
+		},
+		{
+			name:     "windows absolute path with forward slashes",
+			hostOS:   "windows",
+			hostPath: "D:/clusters/mgmt",
+			want:     "/d/clusters/mgmt",
+		},
+		{
+			name:     "windows relative path",
+			hostOS:   "windows",
+			hostPath: `mgmt\mgmt-eks-a-cluster.kubeconfig`,
+			want:     "mgmt/mgmt-eks-a-cluster.kubeconfig",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			executables.SetHostOS(t, tt.hostOS)
+			g.Expect(executables.ContainerPath(tt.hostPath)).To(Equal(tt.want))
+		})
+	}
+}
+
+func TestContainerArgsWindows(t *testing.T) {
+	g := NewWithT(t)
+	executables.SetHostOS(t, "windows")
+	dir := t.TempDir()
+	// Backslashes are regular characters on the Linux machines running the tests, so both
+	// folders are created to make the paths resolvable.
+	g.Expect(os.MkdirAll(filepath.Join(dir, "mgmt"), 0o755)).To(Succeed())
+	g.Expect(os.MkdirAll(filepath.Join(dir, `mgmt\generated`), 0o755)).To(Succeed())
+	t.Chdir(dir)
+
+	args := []string{
+		"apply", "-f", `mgmt\generated\manifest.yaml`,
+		`--kubeconfig=mgmt\mgmt-eks-a-cluster.kubeconfig`,
+		`--selector=app\name`,
+		`p\ss`,
+	}
+	g.Expect(executables.ContainerArgs(args)).To(Equal([]string{
+		"apply", "-f", "mgmt/generated/manifest.yaml",
+		"--kubeconfig=mgmt/mgmt-eks-a-cluster.kubeconfig",
+		`--selector=app\name`,
+		`p\ss`,
+	}))
+}
+
+func TestContainerArgsLinux(t *testing.T) {
+	g := NewWithT(t)
+	executables.SetHostOS(t, "linux")
+	args := []string{"apply", "-f", `mgmt\manifest.yaml`}
+	g.Expect(executables.ContainerArgs(args)).To(Equal(args))
+}
@@ -32,6 +32,10 @@ var hostsTomlTemplate string
 
 const configFileName = "kind_tmp.yaml"
 
+// dockerDefaultPlatformEnv sets the platform the docker commands run by kind pull and run the
+// node image for.
+const dockerDefaultPlatformEnv = "DOCKER_DEFAULT_PLATFORM"
+
 type Kind struct {
 	writer filewriter.FileWriter
 	Executable
@@ -80,8 +84,8 @@ func (k *Kind) CreateAuditPolicy(clusterSpec *cluster.Spec) error {
 	if err := os.WriteFile(filepath.Join(auditPath, "audit-policy.yaml"), []byte(auditPolicy), 0o644); err != nil {
 		return fmt.Errorf("error writing the audit policy file: %w", err)
 	}
-	auditPath = filepath.Join(auditPath, "audit-policy.yaml")
-	k.execConfig.AuditPolicyPath = auditPath
+	// kind runs in the Linux tools container by default, so paths in its config use forward slashes.
+	k.execConfig.AuditPolicyPath = filepath.ToSlash(filepath.Join(auditPath, "audit-policy.yaml"))
 	return nil
 }
 
@@ -244,6 +248,7 @@ func setupRegistryConfig(config RegistryConfig) error {
 func (k *Kind) setupExecConfig(clusterSpec *cluster.Spec) error {
 	versionsBundle := clusterSpec.RootVersionsBundle()
 	registryMirror := registrymirror.FromCluster(clusterSpec.Cluster)
+	WarnIfEmulated(versionsBundle.EksD.KindNode)
 	k.execConfig = &kindExecConfig{
 		KindImage:            registryMirror.ReplaceRegistry(versionsBundle.EksD.KindNode.VersionedImage()),
 		KubernetesRepository: registryMirror.ReplaceRegistry(versionsBundle.KubeDistro.Kubernetes.Repository),
@@ -255,6 +260,12 @@ func (k *Kind) setupExecConfig(clusterSpec *cluster.Spec) error {
 		env:                  make(map[string]string),
 	}
 
+	// Pin the node image platform so kind doesn't reuse a cached image pulled for another
+	// architecture. A platform set by the user in the environment takes precedence.
+	if platform := ImagePlatform(versionsBundle.EksD.KindNode); platform != "" && os.Getenv(dockerDefaultPlatformEnv) == "" {
+		k.execConfig.env[dockerDefaultPlatformEnv] = platform
+	}
+
 	if clusterSpec.Cluster.Spec.RegistryMirrorConfiguration != nil {
 		if err := k.setupRegistryMirror(clusterSpec, registryMirror); err != nil {
 			return err
@@ -274,7 +285,7 @@ func (k *Kind) setupRegistryMirror(clusterSpec *cluster.Spec, registryMirror *re
 
 	// Create the base certs.d directory
 	certsBasePath := filepath.Join(clusterSpec.Cluster.Name, "generated", "certs.d")
-	k.execConfig.RegistryConfigDir = certsBasePath
+	k.execConfig.RegistryConfigDir = filepath.ToSlash(certsBasePath)
 
 	// Generate authorization header if authentication is required
 	var authHeader string
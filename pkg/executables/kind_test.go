@@ -149,6 +149,67 @@ func TestKindCreateBootstrapClusterSuccess(t *testing.T) {
 	}
 }
 
+func TestKindCreateBootstrapClusterNodeImagePlatform(t *testing.T) {
+	tests := []struct {
+		name      string
+		hostArch  string
+		imageArch []string
+		userEnv   string
+		wantEnv   map[string]string
+	}{
+		{
+			name:      "multi arch image on arm64",
+			hostArch:  "arm64",
+			imageArch: []string{"amd64", "arm64"},
+			wantEnv:   map[string]string{"DOCKER_DEFAULT_PLATFORM": "linux/arm64"},
+		},
+		{
+			name:      "amd64 image on arm64",
+			hostArch:  "arm64",
+			imageArch: []string{"amd64"},
+			wantEnv:   map[string]string{"DOCKER_DEFAULT_PLATFORM": "linux/amd64"},
+		},
+		{
+			name:      "platform set by the user",
+			hostArch:  "arm64",
+			imageArch: []string{"amd64", "arm64"},
+			userEnv:   "linux/amd64",
+			wantEnv:   map[string]string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, writer := test.NewWriter(t)
+			ctx := context.Background()
+			executables.SetHostArch(t, tt.hostArch)
+			if tt.userEnv != "" {
+				t.Setenv("DOCKER_DEFAULT_PLATFORM", tt.userEnv)
+			}
+			bundle := &cluster.VersionsBundle{
+				VersionsBundle: versionBundle.VersionsBundle.DeepCopy(),
+				KubeDistro:     versionBundle.KubeDistro,
+			}
+			bundle.EksD.KindNode.Arch = tt.imageArch
+			spec := test.NewClusterSpec(func(s *cluster.Spec) {
+				s.Cluster.Name = "test_cluster"
+				s.VersionsBundles["1.19"] = bundle
+			})
+			executable := mockexecutables.NewMockExecutable(gomock.NewController(t))
+			executable.EXPECT().ExecuteWithEnv(
+				ctx,
+				tt.wantEnv,
+				"create", "cluster", "--name", "test_cluster-eks-a-cluster", "--kubeconfig", test.OfType("string"),
+				"--image", "public.ecr.aws/l0g8r8j6/kubernetes-sigs/kind/node:v1.20.2", "--config", test.OfType("string"),
+			).Return(bytes.Buffer{}, nil)
+
+			k := executables.NewKind(executable, writer)
+			if _, err := k.CreateBootstrapCluster(ctx, spec); err != nil {
+				t.Fatalf("CreateBootstrapCluster() error = %v, wantErr %v", err, nil)
+			}
+		})
+	}
+}
+
 func TestKindCreateBootstrapClusterSuccessWithRegistryMirror(t *testing.T) {
 	_, writer := test.NewWriter(t)
 
@@ -867,30 +867,39 @@ func (k *Kubectl) DeleteOldWorkerNodeGroup(ctx context.Context, md *clusterv1bet
 }
 
 func (k *Kubectl) ValidateControlPlaneNodes(ctx context.Context, cluster *types.Cluster, clusterName string) error {
-	cp, err := k.GetKubeadmControlPlane(ctx, cluster, clusterName, WithCluster(cluster), WithNamespace(constants.EksaSystemNamespace))
+	ready, total, err := k.CountControlPlaneReplicasReady(ctx, cluster, clusterName)
 	if err != nil {
 		return err
 	}
+	if ready != total {
+		return fmt.Errorf("control plane is not fully ready: %d/%d replicas ready", ready, total)
+	}
+
+	return nil
+}
+
+// CountControlPlaneReplicasReady returns the number of ready and total replicas of the kubeadm control plane
+// of a cluster. It fails when the status of the control plane doesn't reflect its latest generation yet.
+func (k *Kubectl) CountControlPlaneReplicasReady(ctx context.Context, cluster *types.Cluster, clusterName string) (ready, total int, err error) {
+	cp, err := k.GetKubeadmControlPlane(ctx, cluster, clusterName, WithCluster(cluster), WithNamespace(constants.EksaSystemNamespace))
+	if err != nil {
+		return 0, 0, err
+	}
 
 	observedGeneration := cp.Status.ObservedGeneration
 	generation := cp.Generation
 	if observedGeneration != generation {
-		return fmt.Errorf("kubeadm control plane %s status needs to be refreshed: generation=%v, observedGeneration=%d", cp.Name, generation, observedGeneration)
+		return 0, 0, fmt.Errorf("kubeadm control plane %s status needs to be refreshed: generation=%v, observedGeneration=%d", cp.Name, generation, observedGeneration)
 	}
 
-	var readyReplicas, replicas int32
 	if cp.Status.ReadyReplicas != nil {
-		readyReplicas = *cp.Status.ReadyReplicas
+		ready = int(*cp.Status.ReadyReplicas)
 	}
 	if cp.Status.Replicas != nil {
-		replicas = *cp.Status.Replicas
-	}
-	if readyReplicas != replicas {
-		return fmt.Errorf("control plane is not fully ready: %d/%d replicas ready",
-			readyReplicas, replicas)
+		total = int(*cp.Status.Replicas)
 	}
 
-	return nil
+	return ready, total, nil
 }
 
 func (k *Kubectl) ValidateWorkerNodes(ctx context.Context, clusterName string, kubeconfig string) error {
@@ -2505,6 +2514,26 @@ func (k *Kubectl) AllTinkerbellHardware(ctx context.Context, kubeconfig string)
 	return list.Items, nil
 }
 
+// AllRufioMachines returns all the rufio BMC machine resources in the cluster.
+func (k *Kubectl) AllRufioMachines(ctx context.Context, kubeconfig string) ([]rufiov1alpha1.Machine, error) {
+	stdOut, err := k.Execute(ctx,
+		"get", rufioMachineResourceType,
+		"-o", "json",
+		"--kubeconfig", kubeconfig,
+		"--all-namespaces=true",
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var list rufiov1alpha1.MachineList
+	if err := json.Unmarshal(stdOut.Bytes(), &list); err != nil {
+		return nil, err
+	}
+
+	return list.Items, nil
+}
+
 // HasCRD checks if the given CRD exists in the cluster specified by kubeconfig.
 func (k *Kubectl) HasCRD(ctx context.Context, crd, kubeconfig string) (bool, error) {
 	_, err := k.Execute(ctx, "get", "customresourcedefinition", crd, "--kubeconfig", kubeconfig)
@@ -2840,6 +2840,23 @@ func TestGetTinkerbellDatacenterConfig(t *testing.T) {
 	tt.Expect(got).To(Equal(wantDatacenter))
 }
 
+func TestKubectlAllRufioMachines(t *testing.T) {
+	t.Parallel()
+	tt := newKubectlTest(t)
+	machinesJson := `{"apiVersion": "v1", "kind": "List", "items": [{"apiVersion": "bmc.tinkerbell.org/v1alpha1", "kind": "Machine", "metadata": {"name": "bmc-node-1", "namespace": "eksa-system"}, "spec": {"connection": {"host": "10.0.0.10", "authSecretRef": {"name": "bmc-node-1-auth", "namespace": "eksa-system"}, "insecureTLS": true}}}]}`
+
+	params := []string{
+		"get", "machines.bmc.tinkerbell.org", "-o", "json", "--kubeconfig", tt.cluster.KubeconfigFile, "--all-namespaces=true",
+	}
+	tt.e.EXPECT().Execute(tt.ctx, gomock.Eq(params)).Return(*bytes.NewBufferString(machinesJson), nil)
+
+	got, err := tt.k.AllRufioMachines(tt.ctx, tt.cluster.KubeconfigFile)
+	tt.Expect(err).To(BeNil())
+	tt.Expect(got).To(HaveLen(1))
+	tt.Expect(got[0].Name).To(Equal("bmc-node-1"))
+	tt.Expect(got[0].Spec.Connection.Host).To(Equal("10.0.0.10"))
+}
+
 func TestGetTinkerbellMachineConfig(t *testing.T) {
 	t.Parallel()
 	tt := newKubectlTest(t)
@@ -4188,3 +4205,33 @@ func TestKubectlValidateControlPlaneNodes(t *testing.T) {
 		})
 	}
 }
+
+func TestKubectlCountControlPlaneReplicasReady(t *testing.T) {
+	g := NewWithT(t)
+	k, ctx, cluster, e := newKubectl(t)
+	clusterName := "test-cluster"
+	kcpJSON := `{
+		"apiVersion": "controlplane.cluster.x-k8s.io/v1beta1",
+		"kind": "KubeadmControlPlane",
+		"metadata": {
+			"name": "test-cluster",
+			"generation": 1
+		},
+		"status": {
+			"observedGeneration": 1,
+			"replicas": 3,
+			"readyReplicas": 2
+		}
+	}`
+
+	e.EXPECT().Execute(ctx, gomock.Eq([]string{
+		"get", "kubeadmcontrolplanes.controlplane.cluster.x-k8s.io", clusterName,
+		"-o", "json", "--kubeconfig", cluster.KubeconfigFile,
+		"--namespace", constants.EksaSystemNamespace,
+	})).Return(*bytes.NewBufferString(kcpJSON), nil)
+
+	ready, total, err := k.CountControlPlaneReplicasReady(ctx, cluster, clusterName)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(ready).To(Equal(2))
+	g.Expect(total).To(Equal(3))
+}
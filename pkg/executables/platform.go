@@ -0,0 +1,47 @@
+package executables
+
+import (
+	"runtime"
+
+	"github.com/aws/eks-anywhere/pkg/logger"
+	releasev1alpha1 "github.com/aws/eks-anywhere/release/api/v1alpha1"
+)
+
+// hostArch is the CPU architecture of the admin machine. It's a variable so tests can
+// simulate running on a different one.
+var hostArch = runtime.GOARCH
+
+// WarnIfEmulated logs a warning and returns true when image is not published for the admin
+// machine architecture. Docker can still run it, but only through emulation, which is much
+// slower and not available on every machine.
+func WarnIfEmulated(image releasev1alpha1.Image) bool {
+	if image.SupportsArch(hostArch) {
+		return false
+	}
+
+	logger.Info("Warning: image is not available for the admin machine architecture, it will run under emulation",
+		"image", image.VersionedImage(), "arch", hostArch, "imageArch", image.Arch)
+	return true
+}
+
+// HostArch returns the CPU architecture of the admin machine.
+func HostArch() string {
+	return hostArch
+}
+
+// ImagePlatform returns the docker platform to run image with on the admin machine. It's the admin
+// machine architecture when image is published for it, and otherwise the first architecture image
+// is published for, which docker runs under emulation. It returns an empty string for images
+// without architecture metadata, leaving the choice to docker.
+func ImagePlatform(image releasev1alpha1.Image) string {
+	if len(image.Arch) == 0 {
+		return ""
+	}
+
+	arch := hostArch
+	if !image.SupportsArch(arch) {
+		arch = image.Arch[0]
+	}
+
+	return "linux/" + arch
+}
@@ -0,0 +1,94 @@
+package executables_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/aws/eks-anywhere/pkg/executables"
+	releasev1alpha1 "github.com/aws/eks-anywhere/release/api/v1alpha1"
+)
+
+func TestWarnIfEmulated(t *testing.T) {
+	tests := []struct {
+		name     string
+		hostArch string
+		arch     []string
+		want     bool
+	}{
+		{
+			name:     "multi arch image on arm64",
+			hostArch: "arm64",
+			arch:     []string{"amd64", "arm64"},
+			want:     false,
+		},
+		{
+			name:     "amd64 image on arm64",
+			hostArch: "arm64",
+			arch:     []string{"amd64"},
+			want:     true,
+		},
+		{
+			name:     "amd64 image on amd64",
+			hostArch: "amd64",
+			arch:     []string{"amd64"},
+			want:     false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			executables.SetHostArch(t, tt.hostArch)
+			image := releasev1alpha1.Image{
+				URI:  "public.ecr.aws/eks-anywhere/cli-tools:v0.0.0",
+				Arch: tt.arch,
+			}
+			g.Expect(executables.WarnIfEmulated(image)).To(Equal(tt.want))
+		})
+	}
+}
+
+func TestImagePlatform(t *testing.T) {
+	tests := []struct {
+		name     string
+		hostArch string
+		arch     []string
+		want     string
+	}{
+		{
+			name:     "multi arch image on arm64",
+			hostArch: "arm64",
+			arch:     []string{"amd64", "arm64"},
+			want:     "linux/arm64",
+		},
+		{
+			name:     "multi arch image on amd64",
+			hostArch: "amd64",
+			arch:     []string{"amd64", "arm64"},
+			want:     "linux/amd64",
+		},
+		{
+			name:     "amd64 image on arm64",
+			hostArch: "arm64",
+			arch:     []string{"amd64"},
+			want:     "linux/amd64",
+		},
+		{
+			name:     "image without arch metadata",
+			hostArch: "arm64",
+			arch:     nil,
+			want:     "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			executables.SetHostArch(t, tt.hostArch)
+			image := releasev1alpha1.Image{
+				URI:  "public.ecr.aws/eks-anywhere/kubernetes-sigs/kind/node:v1.33.1-eks-d-1-33-1",
+				Arch: tt.arch,
+			}
+			g.Expect(executables.ImagePlatform(image)).To(Equal(tt.want))
+		})
+	}
+}
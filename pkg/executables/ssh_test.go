@@ -47,3 +47,31 @@ func TestSSHRunCommandError(t *testing.T) {
 	_, err := ssh.RunCommand(ctx, privateKeyPath, username, ip, command...)
 	g.Expect(err).To(MatchError(fmt.Sprintf("running SSH command: %s", errMsg)))
 }
+
+func TestSSHRunCommandWithStdinNoError(t *testing.T) {
+	ctx := context.Background()
+	g := NewWithT(t)
+	mockCtrl := gomock.NewController(t)
+	executable := mockexecutables.NewMockExecutable(mockCtrl)
+	ssh := executables.NewSSH(executable)
+	in := []byte("snapshot")
+
+	executable.EXPECT().ExecuteWithStdin(ctx, in, "-i", privateKeyPath, "-o", "StrictHostKeyChecking=no", fmt.Sprintf("%s@%s", username, ip), "some", "random", "test", "command")
+
+	_, err := ssh.RunCommandWithStdin(ctx, in, privateKeyPath, username, ip, command...)
+	g.Expect(err).To(Not(HaveOccurred()))
+}
+
+func TestSSHRunCommandWithStdinError(t *testing.T) {
+	ctx := context.Background()
+	g := NewWithT(t)
+	mockCtrl := gomock.NewController(t)
+	executable := mockexecutables.NewMockExecutable(mockCtrl)
+	ssh := executables.NewSSH(executable)
+	in := []byte("snapshot")
+
+	executable.EXPECT().ExecuteWithStdin(ctx, in, "-i", privateKeyPath, "-o", "StrictHostKeyChecking=no", fmt.Sprintf("%s@%s", username, ip), "some", "random", "test", "command").Return(bytes.Buffer{}, errors.New("broken pipe"))
+
+	_, err := ssh.RunCommandWithStdin(ctx, in, privateKeyPath, username, ip, command...)
+	g.Expect(err).To(MatchError("running SSH command: broken pipe"))
+}
@@ -0,0 +1,121 @@
+// Package externaldns installs and configures external-dns on EKS Anywhere clusters, so
+// DNS records for Ingresses and LoadBalancer Services are created in the cluster DNS provider.
+package externaldns
+
+import (
+	"crypto/sha256"
+	_ "embed"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/aws/eks-anywhere/pkg/cluster"
+	"github.com/aws/eks-anywhere/pkg/clusterapi"
+	"github.com/aws/eks-anywhere/pkg/registrymirror"
+	"github.com/aws/eks-anywhere/pkg/templater"
+)
+
+const (
+	// Namespace is the namespace external-dns is installed in.
+	Namespace = "external-dns"
+
+	// CredentialsSecretName is the name of the secret in Namespace with the DNS provider credentials.
+	CredentialsSecretName = "external-dns-credentials"
+)
+
+//go:embed config/external-dns.yaml
+var externalDNSTemplate string
+
+// GenerateManifest generates the manifest that installs external-dns with the configuration in the
+// cluster spec. credentials is the content of the provider credentials secret, used to roll out
+// external-dns when it changes.
+func GenerateManifest(spec *cluster.Spec, credentials map[string][]byte) ([]byte, error) {
+	config := spec.Cluster.Spec.ExternalDNS
+	if config == nil {
+		return nil, errors.New("cluster doesn't have an external-dns configuration")
+	}
+
+	bundle := spec.RootVersionsBundle()
+	if bundle == nil || bundle.ExternalDNS == nil {
+		return nil, errors.New("the EKS Anywhere bundle for the cluster doesn't include external-dns, please upgrade to a release that supports it")
+	}
+
+	image := registrymirror.FromCluster(spec.Cluster).ReplaceRegistry(bundle.ExternalDNS.ExternalDNS.VersionedImage())
+
+	values := map[string]interface{}{
+		"namespace":             Namespace,
+		"image":                 image,
+		"provider":              string(config.Provider),
+		"ownerID":               spec.Cluster.Name,
+		"domainFilters":         config.DomainFilters,
+		"credentialsSecretName": CredentialsSecretName,
+		"credentialsHash":       hashCredentials(credentials),
+	}
+
+	if proxy := spec.Cluster.Spec.ProxyConfiguration; proxy != nil {
+		values["proxy"] = true
+		values["httpProxy"] = proxy.HttpProxy
+		values["httpsProxy"] = proxy.HttpsProxy
+		values["noProxy"] = strings.Join(noProxyList(spec), ",")
+	}
+
+	manifest, err := templater.Execute(externalDNSTemplate, values)
+	if err != nil {
+		return nil, fmt.Errorf("generating external-dns manifest: %v", err)
+	}
+
+	return manifest, nil
+}
+
+// CredentialsSecret returns the secret external-dns reads the provider credentials from.
+func CredentialsSecret(credentials map[string][]byte) *corev1.Secret {
+	return &corev1.Secret{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "v1",
+			Kind:       "Secret",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      CredentialsSecretName,
+			Namespace: Namespace,
+		},
+		Type: corev1.SecretTypeOpaque,
+		Data: credentials,
+	}
+}
+
+func noProxyList(spec *cluster.Spec) []string {
+	c := spec.Cluster
+	noProxy := make([]string, 0, len(c.Spec.ClusterNetwork.Pods.CidrBlocks)+len(c.Spec.ClusterNetwork.Services.CidrBlocks)+len(c.Spec.ProxyConfiguration.NoProxy)+4)
+	noProxy = append(noProxy, c.Spec.ClusterNetwork.Pods.CidrBlocks...)
+	noProxy = append(noProxy, c.Spec.ClusterNetwork.Services.CidrBlocks...)
+	noProxy = append(noProxy, c.Spec.ProxyConfiguration.NoProxy...)
+	noProxy = append(noProxy, clusterapi.NoProxyDefaults()...)
+	if endpoint := c.Spec.ControlPlaneConfiguration.Endpoint; endpoint != nil && endpoint.Host != "" {
+		noProxy = append(noProxy, endpoint.Hostname())
+	}
+
+	return noProxy
+}
+
+func hashCredentials(credentials map[string][]byte) string {
+	keys := make([]string, 0, len(credentials))
+	for k := range credentials {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	h := sha256.New()
+	for _, k := range keys {
+		h.Write([]byte(k))
+		h.Write([]byte{0})
+		h.Write(credentials[k])
+		h.Write([]byte{0})
+	}
+
+	return hex.EncodeToString(h.Sum(nil))
+}
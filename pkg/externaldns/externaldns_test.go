@@ -0,0 +1,109 @@
+package externaldns_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/aws/eks-anywhere/internal/test"
+	"github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/cluster"
+	"github.com/aws/eks-anywhere/pkg/externaldns"
+	releasev1alpha1 "github.com/aws/eks-anywhere/release/api/v1alpha1"
+)
+
+var credentials = map[string][]byte{
+	"AWS_ACCESS_KEY_ID":     []byte("AKIA"),
+	"AWS_SECRET_ACCESS_KEY": []byte("secret"),
+}
+
+func externalDNSSpec(opts ...test.ClusterSpecOpt) *cluster.Spec {
+	return test.NewClusterSpec(append([]test.ClusterSpecOpt{
+		func(s *cluster.Spec) {
+			s.Cluster.Name = "test-cluster"
+			s.Cluster.Spec.ClusterNetwork.Pods.CidrBlocks = []string{"192.168.0.0/16"}
+			s.Cluster.Spec.ClusterNetwork.Services.CidrBlocks = []string{"10.96.0.0/12"}
+			s.Cluster.Spec.ControlPlaneConfiguration.Endpoint = &v1alpha1.Endpoint{Host: "1.2.3.4"}
+			s.Cluster.Spec.ExternalDNS = &v1alpha1.ExternalDNSConfiguration{
+				Provider:              v1alpha1.Route53DNSProvider,
+				DomainFilters:         []string{"apps.example.com"},
+				CredentialsSecretName: "route53-credentials",
+			}
+			s.VersionsBundles["1.19"].ExternalDNS = &releasev1alpha1.ExternalDNSBundle{
+				Version: "v0.15.1",
+				ExternalDNS: releasev1alpha1.Image{
+					URI: "public.ecr.aws/eks-anywhere/external-dns/external-dns:v0.15.1-eks-a-1",
+				},
+			}
+		},
+	}, opts...)...)
+}
+
+func TestGenerateManifest(t *testing.T) {
+	g := NewWithT(t)
+
+	manifest, err := externaldns.GenerateManifest(externalDNSSpec(), credentials)
+	g.Expect(err).NotTo(HaveOccurred())
+	test.AssertContentToFile(t, string(manifest), "testdata/expected_results_external_dns.yaml")
+}
+
+func TestGenerateManifestRegistryMirrorAndProxy(t *testing.T) {
+	g := NewWithT(t)
+	spec := externalDNSSpec(func(s *cluster.Spec) {
+		s.Cluster.Spec.RegistryMirrorConfiguration = &v1alpha1.RegistryMirrorConfiguration{
+			Endpoint: "1.2.3.4",
+			Port:     "443",
+		}
+		s.Cluster.Spec.ProxyConfiguration = &v1alpha1.ProxyConfiguration{
+			HttpProxy:  "http://proxy.example.com:3128",
+			HttpsProxy: "http://proxy.example.com:3128",
+			NoProxy:    []string{"internal.example.com"},
+		}
+	})
+
+	manifest, err := externaldns.GenerateManifest(spec, credentials)
+	g.Expect(err).NotTo(HaveOccurred())
+	test.AssertContentToFile(t, string(manifest), "testdata/expected_results_external_dns_mirror_proxy.yaml")
+}
+
+func TestGenerateManifestCredentialsChange(t *testing.T) {
+	g := NewWithT(t)
+
+	manifest, err := externaldns.GenerateManifest(externalDNSSpec(), credentials)
+	g.Expect(err).NotTo(HaveOccurred())
+	rotated, err := externaldns.GenerateManifest(externalDNSSpec(), map[string][]byte{
+		"AWS_ACCESS_KEY_ID":     []byte("AKIA"),
+		"AWS_SECRET_ACCESS_KEY": []byte("rotated"),
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(rotated).NotTo(Equal(manifest))
+}
+
+func TestGenerateManifestNoBundle(t *testing.T) {
+	g := NewWithT(t)
+	spec := externalDNSSpec(func(s *cluster.Spec) {
+		s.VersionsBundles["1.19"].ExternalDNS = nil
+	})
+
+	_, err := externaldns.GenerateManifest(spec, credentials)
+	g.Expect(err).To(MatchError(ContainSubstring("doesn't include external-dns")))
+}
+
+func TestGenerateManifestNoConfiguration(t *testing.T) {
+	g := NewWithT(t)
+	spec := externalDNSSpec(func(s *cluster.Spec) {
+		s.Cluster.Spec.ExternalDNS = nil
+	})
+
+	_, err := externaldns.GenerateManifest(spec, credentials)
+	g.Expect(err).To(MatchError("cluster doesn't have an external-dns configuration"))
+}
+
+func TestCredentialsSecret(t *testing.T) {
+	g := NewWithT(t)
+
+	secret := externaldns.CredentialsSecret(credentials)
+	g.Expect(secret.Name).To(Equal(externaldns.CredentialsSecretName))
+	g.Expect(secret.Namespace).To(Equal(externaldns.Namespace))
+	g.Expect(secret.Data).To(Equal(credentials))
+}
@@ -0,0 +1,86 @@
+package externaldns
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/cluster"
+	"github.com/aws/eks-anywhere/pkg/controller"
+	"github.com/aws/eks-anywhere/pkg/controller/clientutil"
+	"github.com/aws/eks-anywhere/pkg/controller/clusters"
+	"github.com/aws/eks-anywhere/pkg/controller/serverside"
+)
+
+// RemoteClientRegistry gets clients for remote clusters.
+type RemoteClientRegistry interface {
+	GetClient(ctx context.Context, cluster client.ObjectKey) (client.Client, error)
+}
+
+// Reconciler installs external-dns on the clusters with an external-dns configuration and keeps
+// it in sync with it.
+type Reconciler struct {
+	client               client.Client
+	remoteClientRegistry RemoteClientRegistry
+}
+
+// New returns a new Reconciler.
+func New(client client.Client, remoteClientRegistry RemoteClientRegistry) *Reconciler {
+	return &Reconciler{
+		client:               client,
+		remoteClientRegistry: remoteClientRegistry,
+	}
+}
+
+// Reconcile applies the external-dns manifest and the provider credentials to the cluster, once
+// its control plane is ready. It's a no-op for clusters without an external-dns configuration.
+func (r *Reconciler) Reconcile(ctx context.Context, log logr.Logger, eksaCluster *anywherev1.Cluster) (controller.Result, error) {
+	config := eksaCluster.Spec.ExternalDNS
+	if config == nil {
+		return controller.Result{}, nil
+	}
+
+	clusterSpec, err := cluster.BuildSpec(ctx, clientutil.NewKubeClient(r.client), eksaCluster)
+	if err != nil {
+		return controller.Result{}, err
+	}
+
+	result, err := clusters.CheckControlPlaneReady(ctx, r.client, log, eksaCluster)
+	if err != nil {
+		return controller.Result{}, errors.Wrap(err, "checking controlplane ready")
+	}
+	if result.Return() {
+		return result, nil
+	}
+
+	credentials := &corev1.Secret{}
+	key := client.ObjectKey{Name: config.CredentialsSecretName, Namespace: eksaCluster.Namespace}
+	if err := r.client.Get(ctx, key, credentials); err != nil {
+		return controller.Result{}, errors.Wrapf(err, "reading external-dns credentials secret %s", key)
+	}
+
+	manifest, err := GenerateManifest(clusterSpec, credentials.Data)
+	if err != nil {
+		return controller.Result{}, err
+	}
+
+	remoteClient, err := r.remoteClientRegistry.GetClient(ctx, controller.CapiClusterObjectKey(eksaCluster))
+	if err != nil {
+		return controller.Result{}, errors.Wrap(err, "getting workload cluster's client to reconcile external-dns")
+	}
+
+	log.Info("Applying external-dns manifest")
+	if err := serverside.ReconcileYaml(ctx, remoteClient, manifest); err != nil {
+		return controller.Result{}, errors.Wrap(err, "applying external-dns manifest")
+	}
+
+	if err := serverside.ReconcileObject(ctx, remoteClient, CredentialsSecret(credentials.Data)); err != nil {
+		return controller.Result{}, errors.Wrap(err, "applying external-dns credentials")
+	}
+
+	return controller.Result{}, nil
+}
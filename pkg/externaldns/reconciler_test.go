@@ -0,0 +1,208 @@
+package externaldns_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	eksdv1 "github.com/aws/eks-distro-build-tooling/release/api/v1alpha1"
+	"github.com/go-logr/logr"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	controlplanev1beta2 "sigs.k8s.io/cluster-api/api/controlplane/kubeadm/v1beta2"
+	clusterv1beta2 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/aws/eks-anywhere/internal/test"
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/controller"
+	"github.com/aws/eks-anywhere/pkg/externaldns"
+	"github.com/aws/eks-anywhere/pkg/externaldns/mocks"
+	releasev1 "github.com/aws/eks-anywhere/release/api/v1alpha1"
+)
+
+type reconcilerTest struct {
+	*WithT
+	ctx                  context.Context
+	remoteClientRegistry *mocks.MockRemoteClientRegistry
+	bundle               *releasev1.Bundles
+	cluster              *anywherev1.Cluster
+	kcp                  *controlplanev1beta2.KubeadmControlPlane
+	credentials          *corev1.Secret
+}
+
+func newReconcilerTest(t testing.TB) *reconcilerTest {
+	ctrl := gomock.NewController(t)
+	bundle := test.Bundle()
+	for i := range bundle.Spec.VersionsBundles {
+		bundle.Spec.VersionsBundles[i].ExternalDNS = &releasev1.ExternalDNSBundle{
+			Version: "v0.14.0",
+			ExternalDNS: releasev1.Image{
+				URI: "public.ecr.aws/eks-anywhere/external-dns/external-dns:v0.14.0",
+			},
+		}
+	}
+	version := test.DevEksaVersion()
+	cluster := &anywherev1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-cluster",
+			Namespace: "eksa-system",
+		},
+		Spec: anywherev1.ClusterSpec{
+			KubernetesVersion: "1.22",
+			ControlPlaneConfiguration: anywherev1.ControlPlaneConfiguration{
+				Endpoint: &anywherev1.Endpoint{
+					Host: "1.2.3.4",
+				},
+			},
+			BundlesRef: &anywherev1.BundlesRef{
+				Name:       bundle.Name,
+				Namespace:  bundle.Namespace,
+				APIVersion: bundle.APIVersion,
+			},
+			ExternalDNS: &anywherev1.ExternalDNSConfiguration{
+				Provider:              anywherev1.Route53DNSProvider,
+				DomainFilters:         []string{"example.com"},
+				CredentialsSecretName: "route53-credentials",
+			},
+			EksaVersion: &version,
+		},
+	}
+	kcpVersion := "test"
+	kcp := test.KubeadmControlPlane(func(kcp *controlplanev1beta2.KubeadmControlPlane) {
+		kcp.Name = cluster.Name
+		kcp.Spec.Version = kcpVersion
+		kcp.Status = controlplanev1beta2.KubeadmControlPlaneStatus{
+			Conditions: []metav1.Condition{
+				{
+					Type:               clusterv1beta2.AvailableCondition,
+					Status:             metav1.ConditionTrue,
+					LastTransitionTime: metav1.NewTime(time.Now()),
+				},
+			},
+			Version:            kcpVersion,
+			ReadyReplicas:      ptr.To(int32(1)),
+			Replicas:           ptr.To(int32(1)),
+			ObservedGeneration: 1,
+		}
+		kcp.Generation = 1
+	})
+	credentials := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "route53-credentials",
+			Namespace: cluster.Namespace,
+		},
+		Data: map[string][]byte{
+			"AWS_ACCESS_KEY_ID":     []byte("key-id"),
+			"AWS_SECRET_ACCESS_KEY": []byte("secret"),
+		},
+	}
+
+	return &reconcilerTest{
+		WithT:                NewWithT(t),
+		ctx:                  context.Background(),
+		remoteClientRegistry: mocks.NewMockRemoteClientRegistry(ctrl),
+		bundle:               bundle,
+		cluster:              cluster,
+		kcp:                  kcp,
+		credentials:          credentials,
+	}
+}
+
+func (tt *reconcilerTest) client(objs ...runtime.Object) client.Client {
+	scheme := runtime.NewScheme()
+	_ = releasev1.AddToScheme(scheme)
+	_ = eksdv1.AddToScheme(scheme)
+	_ = corev1.AddToScheme(scheme)
+	_ = controlplanev1beta2.AddToScheme(scheme)
+	return fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(objs...).Build()
+}
+
+func (tt *reconcilerTest) managementClient(objs ...runtime.Object) client.Client {
+	return tt.client(append([]runtime.Object{tt.bundle, test.EksdRelease("1-22"), test.EKSARelease()}, objs...)...)
+}
+
+func nullLog() logr.Logger {
+	return logr.New(logf.NullLogSink{})
+}
+
+func TestReconcileNoExternalDNS(t *testing.T) {
+	tt := newReconcilerTest(t)
+	tt.cluster.Spec.ExternalDNS = nil
+	r := externaldns.New(tt.client(), tt.remoteClientRegistry)
+
+	result, err := r.Reconcile(tt.ctx, nullLog(), tt.cluster)
+	tt.Expect(err).NotTo(HaveOccurred())
+	tt.Expect(result).To(Equal(controller.Result{}))
+}
+
+func TestReconcileBuildClusterSpecError(t *testing.T) {
+	tt := newReconcilerTest(t)
+	r := externaldns.New(tt.client(), tt.remoteClientRegistry)
+
+	result, err := r.Reconcile(tt.ctx, nullLog(), tt.cluster)
+	tt.Expect(err).To(HaveOccurred())
+	tt.Expect(result).To(Equal(controller.Result{}))
+}
+
+func TestReconcileControlPlaneNotReady(t *testing.T) {
+	tt := newReconcilerTest(t)
+	r := externaldns.New(tt.managementClient(tt.credentials), tt.remoteClientRegistry)
+
+	result, err := r.Reconcile(tt.ctx, nullLog(), tt.cluster)
+	tt.Expect(err).NotTo(HaveOccurred())
+	tt.Expect(result).To(Equal(controller.ResultWithRequeue(5 * time.Second)))
+}
+
+func TestReconcileMissingCredentialsSecret(t *testing.T) {
+	tt := newReconcilerTest(t)
+	r := externaldns.New(tt.managementClient(tt.kcp), tt.remoteClientRegistry)
+
+	result, err := r.Reconcile(tt.ctx, nullLog(), tt.cluster)
+	tt.Expect(err).To(MatchError(ContainSubstring("reading external-dns credentials secret eksa-system/route53-credentials")))
+	tt.Expect(result).To(Equal(controller.Result{}))
+}
+
+func TestReconcileBundleWithoutExternalDNS(t *testing.T) {
+	tt := newReconcilerTest(t)
+	for i := range tt.bundle.Spec.VersionsBundles {
+		tt.bundle.Spec.VersionsBundles[i].ExternalDNS = nil
+	}
+	r := externaldns.New(tt.managementClient(tt.kcp, tt.credentials), tt.remoteClientRegistry)
+
+	result, err := r.Reconcile(tt.ctx, nullLog(), tt.cluster)
+	tt.Expect(err).To(MatchError(ContainSubstring("doesn't include external-dns")))
+	tt.Expect(result).To(Equal(controller.Result{}))
+}
+
+func TestReconcileRemoteGetClientError(t *testing.T) {
+	tt := newReconcilerTest(t)
+	r := externaldns.New(tt.managementClient(tt.kcp, tt.credentials), tt.remoteClientRegistry)
+	tt.remoteClientRegistry.EXPECT().
+		GetClient(tt.ctx, client.ObjectKey{Name: "my-cluster", Namespace: "eksa-system"}).
+		Return(nil, errors.New("client error"))
+
+	result, err := r.Reconcile(tt.ctx, nullLog(), tt.cluster)
+	tt.Expect(err).To(MatchError(ContainSubstring("client error")))
+	tt.Expect(result).To(Equal(controller.Result{}))
+}
+
+func TestReconcileApplyError(t *testing.T) {
+	tt := newReconcilerTest(t)
+	r := externaldns.New(tt.managementClient(tt.kcp, tt.credentials), tt.remoteClientRegistry)
+	// The remote client doesn't know about RBAC objects, so applying the manifest fails.
+	tt.remoteClientRegistry.EXPECT().
+		GetClient(tt.ctx, gomock.AssignableToTypeOf(client.ObjectKey{})).
+		Return(fake.NewClientBuilder().WithScheme(runtime.NewScheme()).Build(), nil)
+
+	result, err := r.Reconcile(tt.ctx, nullLog(), tt.cluster)
+	tt.Expect(err).To(MatchError(ContainSubstring("applying external-dns manifest")))
+	tt.Expect(result).To(Equal(controller.Result{}))
+}
@@ -2,11 +2,16 @@ package features
 
 // These are environment variables used as flags to enable/disable features.
 const (
-	CloudStackKubeVipDisabledEnvVar = "CLOUDSTACK_KUBE_VIP_DISABLED"
-	CheckpointEnabledEnvVar         = "CHECKPOINT_ENABLED"
-	UseControllerForCli             = "USE_CONTROLLER_FOR_CLI"
-	VSphereInPlaceEnvVar            = "VSPHERE_IN_PLACE_UPGRADE"
-	APIServerExtraArgsEnabledEnvVar = "API_SERVER_EXTRA_ARGS_ENABLED"
+	CloudStackKubeVipDisabledEnvVar  = "CLOUDSTACK_KUBE_VIP_DISABLED"
+	CheckpointEnabledEnvVar          = "CHECKPOINT_ENABLED"
+	UseControllerForCli              = "USE_CONTROLLER_FOR_CLI"
+	VSphereInPlaceEnvVar             = "VSPHERE_IN_PLACE_UPGRADE"
+	APIServerExtraArgsEnabledEnvVar  = "API_SERVER_EXTRA_ARGS_ENABLED"
+	ClusterClassEnabledEnvVar        = "CLUSTER_CLASS_ENABLED"
+	VSphereOnDemandProvisionerEnvVar = "VSPHERE_ON_DEMAND_PROVISIONER"
+	RolloutGuardrailsEnvVar          = "ROLLOUT_GUARDRAILS_ENABLED"
+
+	clusterClassGate = "ClusterClass"
 )
 
 func FeedGates(featureGates []string) {
@@ -57,3 +62,29 @@ func APIServerExtraArgsEnabled() Feature {
 	}
 }
 
+// ClusterClassEnabled is the feature flag for generating the CAPI objects through a ClusterClass
+// with a managed topology instead of the provider templates.
+func ClusterClassEnabled() Feature {
+	return Feature{
+		Name:     "Generate CAPI objects with a ClusterClass",
+		IsActive: globalFeatures.isActiveForEnvVarOrGate(ClusterClassEnabledEnvVar, clusterClassGate),
+	}
+}
+
+// VSphereOnDemandProvisionerEnabled is the feature flag for scaling vSphere worker node groups
+// with the on-demand provisioner.
+func VSphereOnDemandProvisionerEnabled() Feature {
+	return Feature{
+		Name:     "Scale vSphere worker node groups with the on-demand provisioner",
+		IsActive: globalFeatures.isActiveForEnvVar(VSphereOnDemandProvisionerEnvVar),
+	}
+}
+
+// RolloutGuardrailsEnabled is the feature flag for requiring an acknowledgement of the cluster spec
+// changes that replace the machines of the cluster.
+func RolloutGuardrailsEnabled() Feature {
+	return Feature{
+		Name:     "Require acknowledging cluster changes that roll out new machines",
+		IsActive: globalFeatures.isActiveForEnvVar(RolloutGuardrailsEnvVar),
+	}
+}
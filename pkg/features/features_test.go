@@ -86,3 +86,34 @@ func TestAPIServerExtraArgsEnabledFeatureFlag(t *testing.T) {
 	g.Expect(IsActive(APIServerExtraArgsEnabled())).To(BeTrue())
 }
 
+func TestClusterClassEnabledFeatureFlag(t *testing.T) {
+	g := NewWithT(t)
+	setupContext(t)
+
+	g.Expect(os.Setenv(ClusterClassEnabledEnvVar, "true")).To(Succeed())
+	g.Expect(IsActive(ClusterClassEnabled())).To(BeTrue())
+}
+
+func TestClusterClassEnabledFeatureGate(t *testing.T) {
+	g := NewWithT(t)
+	setupContext(t)
+
+	FeedGates([]string{"ClusterClass=true"})
+	g.Expect(IsActive(ClusterClassEnabled())).To(BeTrue())
+}
+
+func TestVSphereOnDemandProvisionerEnabledFeatureFlag(t *testing.T) {
+	g := NewWithT(t)
+	setupContext(t)
+
+	g.Expect(os.Setenv(VSphereOnDemandProvisionerEnvVar, "true")).To(Succeed())
+	g.Expect(IsActive(VSphereOnDemandProvisionerEnabled())).To(BeTrue())
+}
+
+func TestRolloutGuardrailsEnabledFeatureFlag(t *testing.T) {
+	g := NewWithT(t)
+	setupContext(t)
+
+	g.Expect(os.Setenv(RolloutGuardrailsEnvVar, "true")).To(Succeed())
+	g.Expect(IsActive(RolloutGuardrailsEnabled())).To(BeTrue())
+}
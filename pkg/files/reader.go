@@ -17,7 +17,9 @@ import (
 
 const (
 	httpsScheme = "https"
+	httpScheme  = "http"
 	embedScheme = "embed"
+	fileScheme  = "file"
 )
 
 type Reader struct {
@@ -115,10 +117,12 @@ func (r *Reader) ReadFile(uri string) ([]byte, error) {
 	}
 
 	switch url.Scheme {
-	case httpsScheme:
+	case httpsScheme, httpScheme:
 		return r.readHttpFile(uri)
 	case embedScheme:
 		return r.readEmbedFile(url)
+	case fileScheme:
+		return readLocalFile(url.Path)
 	default:
 		return readLocalFile(uri)
 	}
@@ -95,6 +95,32 @@ func TestReaderReadFileHTTPSSuccess(t *testing.T) {
 	test.AssertContentToFile(t, string(got), filePath)
 }
 
+func TestReaderReadFileHTTPSuccess(t *testing.T) {
+	g := NewWithT(t)
+	filePath := "testdata/file.yaml"
+
+	server := httptest.NewServer(http.FileServer(http.Dir(".")))
+	t.Cleanup(server.Close)
+	uri := server.URL + "/" + filePath
+
+	r := files.NewReader()
+	got, err := r.ReadFile(uri)
+	g.Expect(err).To(BeNil())
+	test.AssertContentToFile(t, string(got), filePath)
+}
+
+func TestReaderReadFileFileSchemeSuccess(t *testing.T) {
+	g := NewWithT(t)
+	filePath := "testdata/file.yaml"
+	wd, err := os.Getwd()
+	g.Expect(err).To(BeNil())
+
+	r := files.NewReader()
+	got, err := r.ReadFile("file://" + wd + "/" + filePath)
+	g.Expect(err).To(BeNil())
+	test.AssertContentToFile(t, string(got), filePath)
+}
+
 func TestReaderReadFileHTTPSProxySuccess(t *testing.T) {
 	t.Skip("Flaky (https://github.com/aws/eks-anywhere/issues/5775)")
 
@@ -12,6 +12,7 @@ import (
 	"github.com/go-git/go-git/v5/plumbing/transport/http"
 	gogitssh "github.com/go-git/go-git/v5/plumbing/transport/ssh"
 	"golang.org/x/crypto/ssh"
+	"golang.org/x/oauth2"
 
 	"github.com/aws/eks-anywhere/pkg/api/v1alpha1"
 	"github.com/aws/eks-anywhere/pkg/config"
@@ -41,17 +42,26 @@ func Build(ctx context.Context, cluster *v1alpha1.Cluster, fluxConfig *v1alpha1.
 
 	switch {
 	case fluxConfig.Spec.Github != nil:
-		githubToken, err := github.GetGithubAccessTokenFromEnv()
+		githubToken, err := github.GetGithubAccessTokenFromEnv(ctx)
 		if err != nil {
 			return nil, err
 		}
 
-		tools.Provider, err = buildGithubProvider(ctx, githubToken, fluxConfig.Spec.Github)
+		appTokenSource, err := github.AppTokenSourceFromEnv(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		tools.Provider, err = buildGithubProvider(ctx, githubToken, appTokenSource, fluxConfig.Spec.Github)
 		if err != nil {
 			return nil, fmt.Errorf("building github provider: %v", err)
 		}
 
-		gitAuth = &http.BasicAuth{Password: githubToken, Username: fluxConfig.Spec.Github.Owner}
+		username := fluxConfig.Spec.Github.Owner
+		if appTokenSource != nil {
+			username = github.AppTokenUsername
+		}
+		gitAuth = &http.BasicAuth{Password: githubToken, Username: username}
 		repo = fluxConfig.Spec.Github.Repository
 		repoUrl = github.RepoUrl(fluxConfig.Spec.Github.Owner, repo)
 	case fluxConfig.Spec.Git != nil:
@@ -105,11 +115,15 @@ func buildGitClient(ctx context.Context, auth transport.AuthMethod, repoUrl stri
 	return gitclient.New(opts...)
 }
 
-func buildGithubProvider(ctx context.Context, githubToken string, config *v1alpha1.GithubProviderConfig) (git.ProviderClient, error) {
+func buildGithubProvider(ctx context.Context, githubToken string, appTokenSource oauth2.TokenSource, config *v1alpha1.GithubProviderConfig) (git.ProviderClient, error) {
 	auth := git.TokenAuth{Token: githubToken, Username: config.Owner}
-	gogithubOpts := gogithub.Options{Auth: auth}
+	gogithubOpts := gogithub.Options{Auth: auth, TokenSource: appTokenSource}
+	var providerOpts []github.Opt
+	if appTokenSource != nil {
+		providerOpts = append(providerOpts, github.WithAppAuth())
+	}
 	githubProviderClient := gogithub.New(ctx, gogithubOpts)
-	provider, err := github.New(githubProviderClient, config, auth)
+	provider, err := github.New(githubProviderClient, config, auth, providerOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,67 @@
+package gogithub
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	. "github.com/onsi/gomega"
+)
+
+func TestAppTokenSourceToken(t *testing.T) {
+	g := NewWithT(t)
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	g.Expect(err).NotTo(HaveOccurred())
+	privateKey := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})
+	expiresAt := time.Now().Add(time.Hour).UTC().Truncate(time.Second)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		g.Expect(r.Method).To(Equal(http.MethodPost))
+		g.Expect(r.URL.Path).To(Equal("/app/installations/42/access_tokens"))
+		claims := &jwt.RegisteredClaims{}
+		_, err := jwt.ParseWithClaims(strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer "), claims, func(*jwt.Token) (interface{}, error) {
+			return &key.PublicKey, nil
+		})
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(claims.Issuer).To(Equal("7"))
+
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"token":"ghs_installation","expires_at":"` + expiresAt.Format(time.RFC3339) + `"}`))
+	}))
+	defer server.Close()
+	baseURL, err := url.Parse(server.URL + "/")
+	g.Expect(err).NotTo(HaveOccurred())
+
+	ts := &appTokenSource{
+		ctx:     context.Background(),
+		auth:    AppAuth{AppID: 7, InstallationID: 42, PrivateKey: privateKey},
+		baseURL: baseURL,
+		now:     time.Now,
+	}
+
+	token, err := ts.Token()
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(token.AccessToken).To(Equal("ghs_installation"))
+	g.Expect(token.Expiry).To(BeTemporally("==", expiresAt))
+}
+
+func TestAppTokenSourceTokenInvalidKey(t *testing.T) {
+	g := NewWithT(t)
+	ts := &appTokenSource{
+		ctx:  context.Background(),
+		auth: AppAuth{AppID: 7, InstallationID: 42, PrivateKey: []byte("not a key")},
+		now:  time.Now,
+	}
+
+	_, err := ts.Token()
+	g.Expect(err).To(MatchError(ContainSubstring("parsing github app private key")))
+}
@@ -6,14 +6,12 @@ import (
 	"fmt"
 	"net/http"
 	"strings"
-	"time"
 
 	goGithub "github.com/google/go-github/v35/github"
 	"golang.org/x/oauth2"
 
 	"github.com/aws/eks-anywhere/pkg/git"
 	"github.com/aws/eks-anywhere/pkg/logger"
-	"github.com/aws/eks-anywhere/pkg/retrier"
 )
 
 type GoGithub struct {
@@ -23,6 +21,9 @@ type GoGithub struct {
 
 type Options struct {
 	Auth git.TokenAuth
+	// TokenSource provides the tokens to authenticate with instead of the Auth token,
+	// like the short-lived GitHub App installation tokens.
+	TokenSource oauth2.TokenSource
 }
 
 func New(ctx context.Context, opts Options) *GoGithub {
@@ -55,7 +56,7 @@ type githubClient struct {
 var HttpClient HTTPClient
 
 func init() {
-	HttpClient = &http.Client{}
+	HttpClient = &http.Client{Transport: newRateLimitTransport(http.DefaultTransport)}
 }
 
 func (ggc *githubClient) CreateRepo(ctx context.Context, org string, repo *goGithub.Repository) (*goGithub.Repository, *goGithub.Response, error) {
@@ -128,18 +129,16 @@ func (g *GoGithub) GetAccessTokenPermissions(accessToken string) (string, error)
 	}
 	req.Header.Set("Authorization", "token "+accessToken)
 
-	var resp *http.Response
-	r := retrier.New(3 * time.Minute)
-	err = r.Retry(func() error {
-		resp, err = HttpClient.Do(req)
-		if err != nil {
-			return fmt.Errorf("getting Github Personal Access Token permissions %v", err)
-		}
-		return nil
-	})
+	resp, err := HttpClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("getting Github Personal Access Token permissions %v", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("getting Github Personal Access Token permissions: unexpected status %s", resp.Status)
+	}
 
 	permissionsScopes := resp.Header.Get("X-Oauth-Scopes")
-	defer resp.Body.Close()
 
 	return permissionsScopes, nil
 }
@@ -238,8 +237,16 @@ func (g *GoGithub) DeleteRepo(ctx context.Context, opts git.DeleteRepoOpts) erro
 }
 
 func newClient(ctx context.Context, opts Options) Client {
-	ts := oauth2.StaticTokenSource(&oauth2.Token{AccessToken: opts.Auth.Token})
-	tc := oauth2.NewClient(ctx, ts)
+	ts := opts.TokenSource
+	if ts == nil {
+		ts = oauth2.StaticTokenSource(&oauth2.Token{AccessToken: opts.Auth.Token})
+	}
+	tc := &http.Client{
+		Transport: &oauth2.Transport{
+			Source: ts,
+			Base:   newRateLimitTransport(http.DefaultTransport),
+		},
+	}
 	return &githubClient{goGithub.NewClient(tc)}
 }
 
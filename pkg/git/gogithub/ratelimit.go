@@ -0,0 +1,261 @@
+package gogithub
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+
+	"github.com/aws/eks-anywhere/pkg/logger"
+	"github.com/aws/eks-anywhere/pkg/retrier"
+)
+
+const (
+	// GitHub recommends waiting at least one second between mutating requests to avoid its
+	// secondary rate limits. Reads are spaced out less aggressively.
+	readRequestsPerSecond  = 10
+	writeRequestsPerSecond = 1
+
+	maxRetries = 5
+	// maxRateLimitWait is the longest wait for a rate limit reset before failing the request,
+	// so a command doesn't hang for up to an hour when the primary rate limit is exhausted.
+	maxRateLimitWait   = 5 * time.Minute
+	serverErrorBackoff = 2 * time.Second
+)
+
+// rateLimitTransport is an http.RoundTripper that keeps the requests to the GitHub API under its rate limits.
+// It spaces out the requests with token buckets, turns repeated GET requests into conditional requests,
+// which don't count against the rate limit when the resource didn't change, and retries the requests
+// rejected for rate limiting, waiting for the limit to reset, or failing with a server error.
+type rateLimitTransport struct {
+	base          http.RoundTripper
+	readLimiter   *rate.Limiter
+	writeLimiter  *rate.Limiter
+	now           func() time.Time
+	backoff       time.Duration
+	maxWait       time.Duration
+	cacheLock     sync.Mutex
+	responseCache map[string]*cachedResponse
+}
+
+type cachedResponse struct {
+	etag         string
+	lastModified string
+	header       http.Header
+	body         []byte
+}
+
+// retryableError is returned for the attempts that should be retried after wait,
+// or after an exponential backoff when wait is zero.
+type retryableError struct {
+	err  error
+	wait time.Duration
+}
+
+func (e *retryableError) Error() string {
+	return e.err.Error()
+}
+
+func newRateLimitTransport(base http.RoundTripper) *rateLimitTransport {
+	return &rateLimitTransport{
+		base:          base,
+		readLimiter:   rate.NewLimiter(rate.Limit(readRequestsPerSecond), readRequestsPerSecond),
+		writeLimiter:  rate.NewLimiter(rate.Limit(writeRequestsPerSecond), writeRequestsPerSecond),
+		now:           time.Now,
+		backoff:       serverErrorBackoff,
+		maxWait:       maxRateLimitWait,
+		responseCache: map[string]*cachedResponse{},
+	}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *rateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	// Requests with a body that can't be read again can only be sent once.
+	replayable := req.Body == nil || req.Body == http.NoBody || req.GetBody != nil
+
+	var resp *http.Response
+	r := retrier.New(time.Duration(math.MaxInt64), retrier.WithRetryPolicy(func(totalRetries int, err error) (bool, time.Duration) {
+		var retryErr *retryableError
+		if !replayable || totalRetries >= maxRetries || !errors.As(err, &retryErr) {
+			return false, 0
+		}
+		if retryErr.wait > 0 {
+			return true, retryErr.wait
+		}
+		return true, t.backoff * time.Duration(1<<(totalRetries-1))
+	}))
+
+	err := r.Retry(func() error {
+		var err error
+		resp, err = t.roundTrip(req)
+		return err
+	})
+	if resp != nil {
+		// The last attempt was rejected but its response still carries the GitHub error, with the
+		// rate limit details, for the client to report.
+		return resp, nil
+	}
+
+	return nil, err
+}
+
+func (t *rateLimitTransport) roundTrip(req *http.Request) (*http.Response, error) {
+	limiter := t.writeLimiter
+	if req.Method == http.MethodGet || req.Method == http.MethodHead {
+		limiter = t.readLimiter
+	}
+	if err := limiter.Wait(req.Context()); err != nil {
+		return nil, err
+	}
+
+	attempt := req.Clone(req.Context())
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		attempt.Body = body
+	}
+
+	cacheKey := attempt.URL.String()
+	cached := t.cachedResponse(attempt.Method, cacheKey)
+	if cached != nil {
+		if cached.etag != "" {
+			attempt.Header.Set("If-None-Match", cached.etag)
+		}
+		if cached.lastModified != "" {
+			attempt.Header.Set("If-Modified-Since", cached.lastModified)
+		}
+	}
+
+	resp, err := t.base.RoundTrip(attempt)
+	if err != nil {
+		if req.Context().Err() != nil {
+			return nil, err
+		}
+		return nil, &retryableError{err: err}
+	}
+
+	if cached != nil && resp.StatusCode == http.StatusNotModified {
+		logger.V(6).Info("Github resource not modified, using cached response", "url", cacheKey)
+		resp.Body.Close()
+		return cached.response(attempt), nil
+	}
+
+	if wait, rateLimited := t.rateLimitWait(resp); rateLimited {
+		if wait > t.maxWait {
+			return resp, fmt.Errorf("github rate limit exceeded, resets in %s", wait.Round(time.Second))
+		}
+		logger.V(2).Info("Github rate limit reached, waiting before retrying", "url", cacheKey, "wait", wait.Round(time.Second))
+		discard(resp)
+		return nil, &retryableError{err: fmt.Errorf("github rate limit exceeded for %s", cacheKey), wait: wait}
+	}
+
+	if resp.StatusCode >= http.StatusInternalServerError {
+		logger.V(2).Info("Github request failed, retrying", "url", cacheKey, "status", resp.StatusCode)
+		discard(resp)
+		return nil, &retryableError{err: fmt.Errorf("github request %s failed with status %d", cacheKey, resp.StatusCode)}
+	}
+
+	if attempt.Method == http.MethodGet && resp.StatusCode == http.StatusOK {
+		return t.cacheResponse(cacheKey, resp)
+	}
+
+	return resp, nil
+}
+
+// rateLimitWait returns how long to wait before retrying a request rejected by a GitHub rate limit.
+func (t *rateLimitTransport) rateLimitWait(resp *http.Response) (wait time.Duration, rateLimited bool) {
+	if resp.StatusCode != http.StatusForbidden && resp.StatusCode != http.StatusTooManyRequests {
+		return 0, false
+	}
+
+	// Secondary rate limits set how many seconds to wait.
+	if retryAfter := resp.Header.Get("Retry-After"); retryAfter != "" {
+		seconds, err := strconv.Atoi(retryAfter)
+		if err == nil {
+			return time.Duration(seconds) * time.Second, true
+		}
+	}
+
+	// The primary rate limit is exhausted until its reset time, in UTC epoch seconds.
+	if resp.Header.Get("X-RateLimit-Remaining") == "0" {
+		reset, err := strconv.ParseInt(resp.Header.Get("X-RateLimit-Reset"), 10, 64)
+		if err != nil {
+			return 0, true
+		}
+		wait := time.Unix(reset, 0).Sub(t.now())
+		if wait < 0 {
+			wait = 0
+		}
+		// Leave some margin for the clock skew with the GitHub servers.
+		return wait + time.Second, true
+	}
+
+	if resp.StatusCode == http.StatusTooManyRequests {
+		return 0, true
+	}
+
+	return 0, false
+}
+
+func (t *rateLimitTransport) cachedResponse(method, key string) *cachedResponse {
+	if method != http.MethodGet {
+		return nil
+	}
+	t.cacheLock.Lock()
+	defer t.cacheLock.Unlock()
+	return t.responseCache[key]
+}
+
+func (t *rateLimitTransport) cacheResponse(key string, resp *http.Response) (*http.Response, error) {
+	etag := resp.Header.Get("ETag")
+	lastModified := resp.Header.Get("Last-Modified")
+	if etag == "" && lastModified == "" {
+		return resp, nil
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	t.cacheLock.Lock()
+	defer t.cacheLock.Unlock()
+	t.responseCache[key] = &cachedResponse{
+		etag:         etag,
+		lastModified: lastModified,
+		header:       resp.Header.Clone(),
+		body:         body,
+	}
+
+	return resp, nil
+}
+
+func (c *cachedResponse) response(req *http.Request) *http.Response {
+	return &http.Response{
+		Status:        "200 OK",
+		StatusCode:    http.StatusOK,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        c.header.Clone(),
+		Body:          io.NopCloser(bytes.NewReader(c.body)),
+		ContentLength: int64(len(c.body)),
+		Request:       req,
+	}
+}
+
+func discard(resp *http.Response) {
+	_, _ = io.Copy(io.Discard, resp.Body)
+	resp.Body.Close()
+}
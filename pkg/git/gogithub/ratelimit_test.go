@@ -0,0 +1,138 @@
+package gogithub
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+)
+
+func newTestTransport() *rateLimitTransport {
+	t := newRateLimitTransport(http.DefaultTransport)
+	t.backoff = 0
+	return t
+}
+
+func TestRateLimitTransportConditionalRequests(t *testing.T) {
+	g := NewWithT(t)
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", `"v1"`)
+		w.Write([]byte(`{"name":"repo"}`))
+	}))
+	defer server.Close()
+	client := &http.Client{Transport: newTestTransport()}
+
+	for i := 0; i < 2; i++ {
+		resp, err := client.Get(server.URL + "/repos/owner/repo")
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(resp.StatusCode).To(Equal(http.StatusOK))
+		body, err := io.ReadAll(resp.Body)
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(string(body)).To(Equal(`{"name":"repo"}`))
+	}
+	g.Expect(requests).To(Equal(2))
+}
+
+func TestRateLimitTransportRetriesPrimaryRateLimit(t *testing.T) {
+	g := NewWithT(t)
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if requests == 1 {
+			w.Header().Set("X-RateLimit-Remaining", "0")
+			w.Header().Set("X-RateLimit-Reset", strconv.FormatInt(time.Now().Unix(), 10))
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer server.Close()
+	client := &http.Client{Transport: newTestTransport()}
+
+	resp, err := client.Post(server.URL+"/orgs/org/repos", "application/json", strings.NewReader(`{"name":"repo"}`))
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(resp.StatusCode).To(Equal(http.StatusCreated))
+	g.Expect(requests).To(Equal(2))
+}
+
+func TestRateLimitTransportRetriesSecondaryRateLimit(t *testing.T) {
+	g := NewWithT(t)
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if requests == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+	client := &http.Client{Transport: newTestTransport()}
+
+	resp, err := client.Get(server.URL + "/user")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(resp.StatusCode).To(Equal(http.StatusOK))
+	g.Expect(requests).To(Equal(2))
+}
+
+func TestRateLimitTransportRateLimitResetTooLate(t *testing.T) {
+	g := NewWithT(t)
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("X-RateLimit-Remaining", "0")
+		w.Header().Set("X-RateLimit-Reset", strconv.FormatInt(time.Now().Add(time.Hour).Unix(), 10))
+		w.WriteHeader(http.StatusForbidden)
+	}))
+	defer server.Close()
+	client := &http.Client{Transport: newTestTransport()}
+
+	resp, err := client.Get(server.URL + "/user")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(resp.StatusCode).To(Equal(http.StatusForbidden))
+	g.Expect(requests).To(Equal(1))
+}
+
+func TestRateLimitTransportRetriesServerErrors(t *testing.T) {
+	g := NewWithT(t)
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer server.Close()
+	client := &http.Client{Transport: newTestTransport()}
+
+	_, err := client.Get(server.URL + "/user")
+	g.Expect(err).To(MatchError(ContainSubstring("failed with status 502")))
+	g.Expect(requests).To(Equal(maxRetries))
+}
+
+func TestRateLimitTransportDoesNotRetryForbidden(t *testing.T) {
+	g := NewWithT(t)
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("X-RateLimit-Remaining", "4999")
+		w.WriteHeader(http.StatusForbidden)
+	}))
+	defer server.Close()
+	client := &http.Client{Transport: newTestTransport()}
+
+	resp, err := client.Get(server.URL + "/orgs/org")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(resp.StatusCode).To(Equal(http.StatusForbidden))
+	g.Expect(requests).To(Equal(1))
+}
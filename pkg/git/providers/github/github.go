@@ -6,12 +6,16 @@ import (
 	"fmt"
 	"os"
 	"regexp"
+	"strconv"
 	"strings"
+	"sync"
 
 	goGithub "github.com/google/go-github/v35/github"
+	"golang.org/x/oauth2"
 
 	"github.com/aws/eks-anywhere/pkg/api/v1alpha1"
 	"github.com/aws/eks-anywhere/pkg/git"
+	"github.com/aws/eks-anywhere/pkg/git/gogithub"
 	"github.com/aws/eks-anywhere/pkg/logger"
 )
 
@@ -19,15 +23,39 @@ const (
 	GitProviderName    = "github"
 	EksaGithubTokenEnv = "EKSA_GITHUB_TOKEN"
 	GithubTokenEnv     = "GITHUB_TOKEN"
-	githubUrlTemplate  = "https://github.com/%v/%v.git"
-	patRegex           = "^ghp_[a-zA-Z0-9]{36}|github_pat_[a-zA-Z0-9]{22}_[a-zA-Z0-9]{59}$"
-	repoPermissions    = "repo"
+	// EksaGithubAppIDEnv, EksaGithubAppInstallationIDEnv and EksaGithubAppPrivateKeyFileEnv configure
+	// the authentication as a GitHub App installation, used instead of the EksaGithubTokenEnv access token.
+	EksaGithubAppIDEnv             = "EKSA_GITHUB_APP_ID"
+	EksaGithubAppInstallationIDEnv = "EKSA_GITHUB_APP_INSTALLATION_ID"
+	EksaGithubAppPrivateKeyFileEnv = "EKSA_GITHUB_APP_PRIVATE_KEY_FILE"
+	// AppTokenUsername is the git username to authenticate with a GitHub App installation token.
+	AppTokenUsername  = "x-access-token"
+	githubUrlTemplate = "https://github.com/%v/%v.git"
+	patRegex          = "^ghp_[a-zA-Z0-9]{36}|github_pat_[a-zA-Z0-9]{22}_[a-zA-Z0-9]{59}$"
+	repoPermissions   = "repo"
+)
+
+var (
+	appTokenSourceLock sync.Mutex
+	appTokenSource     oauth2.TokenSource
 )
 
 type githubProvider struct {
 	githubProviderClient GithubClient
 	config               *v1alpha1.GithubProviderConfig
 	auth                 git.TokenAuth
+	appAuth              bool
+}
+
+// Opt configures the Github provider.
+type Opt func(*githubProvider)
+
+// WithAppAuth configures the provider for a GitHub App installation token,
+// which is not tied to a user nor has the scopes of a personal access token.
+func WithAppAuth() Opt {
+	return func(g *githubProvider) {
+		g.appAuth = true
+	}
 }
 
 type Options struct {
@@ -49,12 +77,17 @@ type GithubClient interface {
 	DeleteRepo(ctx context.Context, opts git.DeleteRepoOpts) error
 }
 
-func New(githubProviderClient GithubClient, config *v1alpha1.GithubProviderConfig, auth git.TokenAuth) (*githubProvider, error) {
-	return &githubProvider{
+func New(githubProviderClient GithubClient, config *v1alpha1.GithubProviderConfig, auth git.TokenAuth, opts ...Opt) (*githubProvider, error) {
+	g := &githubProvider{
 		githubProviderClient: githubProviderClient,
 		config:               config,
 		auth:                 auth,
-	}, nil
+	}
+	for _, opt := range opts {
+		opt(g)
+	}
+
+	return g, nil
 }
 
 // CreateRepo creates an empty Github Repository. The repository must be initialized locally or
@@ -87,6 +120,10 @@ func (g *githubProvider) AddDeployKeyToRepo(ctx context.Context, opts git.AddDep
 
 // validates the github setup and access.
 func (g *githubProvider) Validate(ctx context.Context) error {
+	if g.appAuth {
+		return g.validateAppAuth(ctx)
+	}
+
 	user, err := g.githubProviderClient.AuthenticatedUser(ctx)
 	if err != nil {
 		return err
@@ -117,6 +154,21 @@ func (g *githubProvider) Validate(ctx context.Context) error {
 	return nil
 }
 
+func (g *githubProvider) validateAppAuth(ctx context.Context) error {
+	if g.config.Personal {
+		return errors.New("github app authentication is not supported for personal repositories; use a personal access token or an organization repository")
+	}
+	org, err := g.githubProviderClient.Organization(ctx, g.config.Owner)
+	if err != nil {
+		return fmt.Errorf("the github app installation doesn't have proper access to github organization %s, %v", g.config.Owner, err)
+	}
+	if org == nil {
+		return fmt.Errorf("the github app installation doesn't have proper access to github organization %s", g.config.Owner)
+	}
+	logger.MarkPass("Github app installation has access to the organization")
+	return nil
+}
+
 func validateGithubAccessToken() error {
 	r := regexp.MustCompile(patRegex)
 	logger.V(4).Info("Checking validity of Github Access Token environment variable", "env var", EksaGithubTokenEnv)
@@ -130,8 +182,70 @@ func validateGithubAccessToken() error {
 	return nil
 }
 
-func GetGithubAccessTokenFromEnv() (string, error) {
-	err := validateGithubAccessToken()
+// AppAuthFromEnv returns the GitHub App installation configured in the environment,
+// or nil if the GitHub App authentication is not configured.
+func AppAuthFromEnv() (*gogithub.AppAuth, error) {
+	appID, ok := os.LookupEnv(EksaGithubAppIDEnv)
+	if !ok || appID == "" {
+		return nil, nil
+	}
+
+	auth := &gogithub.AppAuth{}
+	var err error
+	if auth.AppID, err = strconv.ParseInt(appID, 10, 64); err != nil {
+		return nil, fmt.Errorf("github app id environment variable %s is invalid; must be a number", EksaGithubAppIDEnv)
+	}
+	if auth.InstallationID, err = strconv.ParseInt(os.Getenv(EksaGithubAppInstallationIDEnv), 10, 64); err != nil {
+		return nil, fmt.Errorf("github app installation id environment variable %s is invalid; must be a number", EksaGithubAppInstallationIDEnv)
+	}
+	keyFile := os.Getenv(EksaGithubAppPrivateKeyFileEnv)
+	if keyFile == "" {
+		return nil, fmt.Errorf("github app private key file environment variable %s is not set", EksaGithubAppPrivateKeyFileEnv)
+	}
+	if auth.PrivateKey, err = os.ReadFile(keyFile); err != nil {
+		return nil, fmt.Errorf("reading github app private key file: %v", err)
+	}
+
+	return auth, nil
+}
+
+// AppTokenSourceFromEnv returns a token source for the GitHub App installation configured in the environment,
+// shared by all the callers so the installation tokens are only renewed when they expire.
+// It returns nil if the GitHub App authentication is not configured.
+func AppTokenSourceFromEnv(ctx context.Context) (oauth2.TokenSource, error) {
+	auth, err := AppAuthFromEnv()
+	if err != nil || auth == nil {
+		return nil, err
+	}
+
+	appTokenSourceLock.Lock()
+	defer appTokenSourceLock.Unlock()
+	if appTokenSource == nil {
+		appTokenSource = gogithub.NewAppTokenSource(ctx, *auth)
+	}
+
+	return appTokenSource, nil
+}
+
+// GetGithubAccessTokenFromEnv returns the token to access GitHub: an installation token when a GitHub App
+// is configured in the environment, otherwise the personal access token. It also exports it as GITHUB_TOKEN.
+func GetGithubAccessTokenFromEnv(ctx context.Context) (string, error) {
+	ts, err := AppTokenSourceFromEnv(ctx)
+	if err != nil {
+		return "", err
+	}
+	if ts != nil {
+		token, err := ts.Token()
+		if err != nil {
+			return "", err
+		}
+		if err := os.Setenv(GithubTokenEnv, token.AccessToken); err != nil {
+			return "", fmt.Errorf("unable to set %s: %v", GithubTokenEnv, err)
+		}
+		return token.AccessToken, nil
+	}
+
+	err = validateGithubAccessToken()
 	if err != nil {
 		return "", err
 	}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 	"math/rand"
+	"os"
+	"path/filepath"
 	"reflect"
 	"testing"
 
@@ -13,6 +15,7 @@ import (
 
 	"github.com/aws/eks-anywhere/pkg/api/v1alpha1"
 	"github.com/aws/eks-anywhere/pkg/git"
+	"github.com/aws/eks-anywhere/pkg/git/gogithub"
 	"github.com/aws/eks-anywhere/pkg/git/providers/github"
 	"github.com/aws/eks-anywhere/pkg/git/providers/github/mocks"
 )
@@ -124,7 +127,7 @@ func TestIsGithubAccessTokenValidWithEnv(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.testName, func(t *testing.T) {
-			_, err := github.GetGithubAccessTokenFromEnv()
+			_, err := github.GetGithubAccessTokenFromEnv(context.Background())
 			if err != nil {
 				t.Errorf("github.GetGithubAccessTokenFromEnv returned an error, wanted none; %s", err)
 			}
@@ -240,3 +243,111 @@ func TestGetNonExistantRepoSucceeds(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateAppAuth(t *testing.T) {
+	tests := []struct {
+		testName string
+		personal bool
+		org      *goGithub.Organization
+		wantErr  string
+	}{
+		{
+			testName: "organization repo",
+			org:      &goGithub.Organization{},
+		},
+		{
+			testName: "installation without access to the organization",
+			wantErr:  "the github app installation doesn't have proper access to github organization orgA",
+		},
+		{
+			testName: "personal repo",
+			personal: true,
+			wantErr:  "github app authentication is not supported for personal repositories; use a personal access token or an organization repository",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			ctx := context.Background()
+			githubproviderclient := mocks.NewMockGithubClient(gomock.NewController(t))
+			config := &v1alpha1.GithubProviderConfig{
+				Owner:      "orgA",
+				Repository: "testRepo",
+				Personal:   tt.personal,
+			}
+			if !tt.personal {
+				githubproviderclient.EXPECT().Organization(ctx, "orgA").Return(tt.org, nil)
+			}
+
+			githubProvider, err := github.New(githubproviderclient, config, git.TokenAuth{Token: "ghs_token"}, github.WithAppAuth())
+			assert.NoError(t, err)
+
+			err = githubProvider.Validate(ctx)
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestAppAuthFromEnv(t *testing.T) {
+	keyFile := filepath.Join(t.TempDir(), "app.pem")
+	if err := os.WriteFile(keyFile, []byte("key"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		testName       string
+		appID          string
+		installationID string
+		keyFile        string
+		want           *gogithub.AppAuth
+		wantErr        string
+	}{
+		{
+			testName: "not configured",
+		},
+		{
+			testName:       "configured",
+			appID:          "7",
+			installationID: "42",
+			keyFile:        keyFile,
+			want:           &gogithub.AppAuth{AppID: 7, InstallationID: 42, PrivateKey: []byte("key")},
+		},
+		{
+			testName:       "invalid app id",
+			appID:          "app",
+			installationID: "42",
+			keyFile:        keyFile,
+			wantErr:        "github app id environment variable EKSA_GITHUB_APP_ID is invalid; must be a number",
+		},
+		{
+			testName: "missing installation id",
+			appID:    "7",
+			keyFile:  keyFile,
+			wantErr:  "github app installation id environment variable EKSA_GITHUB_APP_INSTALLATION_ID is invalid; must be a number",
+		},
+		{
+			testName:       "missing private key file",
+			appID:          "7",
+			installationID: "42",
+			wantErr:        "github app private key file environment variable EKSA_GITHUB_APP_PRIVATE_KEY_FILE is not set",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			t.Setenv(github.EksaGithubAppIDEnv, tt.appID)
+			t.Setenv(github.EksaGithubAppInstallationIDEnv, tt.installationID)
+			t.Setenv(github.EksaGithubAppPrivateKeyFileEnv, tt.keyFile)
+
+			got, err := github.AppAuthFromEnv()
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.wantErr)
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
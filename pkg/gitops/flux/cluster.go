@@ -257,6 +257,10 @@ func (fc *fluxForCluster) eksaSystemDir() string {
 	return path.Join(fc.path(), fc.clusterSpec.Cluster.GetName(), eksaSystemDirName)
 }
 
+func (fc *fluxForCluster) curatedPackagesDir() string {
+	return path.Join(fc.path(), fc.clusterSpec.Cluster.GetName(), curatedPackagesDirName)
+}
+
 func (fc *fluxForCluster) fluxSystemDir() string {
 	return path.Join(fc.path(), fc.namespace())
 }
@@ -12,16 +12,21 @@ import (
 )
 
 const (
-	eksaSystemDirName     = "eksa-system"
-	kustomizeFileName     = "kustomization.yaml"
-	clusterConfigFileName = "eksa-cluster.yaml"
-	fluxSyncFileName      = "gotk-sync.yaml"
-	fluxPatchFileName     = "gotk-patches.yaml"
+	eksaSystemDirName      = "eksa-system"
+	curatedPackagesDirName = "curated-packages"
+	kustomizeFileName      = "kustomization.yaml"
+	clusterConfigFileName  = "eksa-cluster.yaml"
+	packagesFileName       = "packages.yaml"
+	fluxSyncFileName       = "gotk-sync.yaml"
+	fluxPatchFileName      = "gotk-patches.yaml"
 )
 
 //go:embed manifests/eksa-system/kustomization.yaml
 var eksaKustomizeContent string
 
+//go:embed manifests/curated-packages/kustomization.yaml
+var packagesKustomizeContent string
+
 //go:embed manifests/flux-system/kustomization.yaml
 var fluxKustomizeContent string
 
@@ -147,3 +152,26 @@ func (g *FileGenerator) WriteFluxSync() error {
 	}
 	return nil
 }
+
+// WritePackagesFiles writes the curated packages manifests and their kustomization into dir,
+// replacing the ones previously written there.
+func (g *FileGenerator) WritePackagesFiles(writer filewriter.FileWriter, dir string, packages []byte) error {
+	packagesWriter, err := writer.WithDir(dir)
+	if err != nil {
+		return fmt.Errorf("initializing curated packages writer: %v", err)
+	}
+	packagesWriter.CleanUpTemp()
+
+	if filePath, err := packagesWriter.Write(packagesFileName, packages, filewriter.PersistentFile); err != nil {
+		return fmt.Errorf("writing curated packages file into %s: %v", filePath, err)
+	}
+
+	values := map[string]string{
+		"PackagesFileName": packagesFileName,
+	}
+
+	if path, err := templater.New(packagesWriter).WriteToFile(packagesKustomizeContent, values, kustomizeFileName, filewriter.PersistentFile); err != nil {
+		return fmt.Errorf("writing curated packages kustomization manifest file into %s: %v", path, err)
+	}
+	return nil
+}
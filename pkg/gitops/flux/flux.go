@@ -23,6 +23,7 @@ const (
 	initialClusterconfigCommitMessage = "Initial commit of cluster configuration; generated by EKS-A CLI"
 	updateClusterconfigCommitMessage  = "Update commit of cluster configuration; generated by EKS-A CLI"
 	deleteClusterconfigCommitMessage  = "Delete commit of cluster configuration; generated by EKS-A CLI"
+	updatePackagesCommitMessage       = "Update commit of curated packages; generated by EKS-A CLI"
 )
 
 type GitOpsFluxClient interface {
@@ -246,6 +247,36 @@ func (f *Flux) UpdateGitEksaSpec(ctx context.Context, clusterSpec *cluster.Spec,
 	return nil
 }
 
+// UpdateGitPackages writes the curated packages manifests into the cluster folder in the git repository
+// and pushes them, so Flux reconciles them in the management cluster instead of the CLI creating them.
+func (f *Flux) UpdateGitPackages(ctx context.Context, clusterSpec *cluster.Spec, packages []byte) error {
+	if f.shouldSkipFlux() {
+		logger.Info("GitOps field not specified, update curated packages in git repo skipped")
+		return nil
+	}
+
+	fc := newFluxForCluster(f, clusterSpec, nil, nil)
+
+	if err := fc.syncGitRepo(ctx); err != nil {
+		return err
+	}
+
+	path := fc.curatedPackagesDir()
+	if err := NewFileGenerator().WritePackagesFiles(f.writer, path, packages); err != nil {
+		return err
+	}
+
+	if err := f.gitClient.Add(path); err != nil {
+		return fmt.Errorf("adding %s to git: %v", path, err)
+	}
+
+	if err := f.pushToRemoteRepo(ctx, path, updatePackagesCommitMessage); err != nil {
+		return err
+	}
+	logger.V(3).Info("Finished pushing curated packages to git", "repository", fc.repository())
+	return nil
+}
+
 func (f *Flux) Validations(ctx context.Context, clusterSpec *cluster.Spec) []validations.Validation {
 	if f.shouldSkipFlux() {
 		return nil
@@ -902,6 +902,64 @@ func TestUpdateGitRepoEksaSpecSkip(t *testing.T) {
 	g.Expect(f.UpdateGitEksaSpec(g.ctx, clusterSpec, datacenterConfig, []providers.MachineConfig{machineConfig})).To(Succeed())
 }
 
+func TestUpdateGitPackagesLocalRepoNotExists(t *testing.T) {
+	clusterName := "management-cluster"
+	clusterConfig := NewCluster(clusterName)
+	packagesDirPath := "clusters/management-cluster/management-cluster/curated-packages"
+	g := newFluxTest(t)
+	clusterSpec := newClusterSpec(t, clusterConfig, "")
+	packages := []byte("apiVersion: packages.eks.amazonaws.com/v1alpha1\nkind: Package\n")
+
+	g.git.EXPECT().Clone(g.ctx).Return(nil)
+	g.git.EXPECT().Branch(clusterSpec.FluxConfig.Spec.Branch).Return(nil)
+	g.git.EXPECT().Add(packagesDirPath).Return(nil)
+	g.git.EXPECT().Commit(test.OfType("string")).Return(nil)
+	g.git.EXPECT().Push(g.ctx).Return(nil)
+
+	g.Expect(g.gitOpsFlux.UpdateGitPackages(g.ctx, clusterSpec, packages)).To(Succeed())
+
+	content, err := os.ReadFile(path.Join(g.writer.Dir(), packagesDirPath, "packages.yaml"))
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(content).To(Equal(packages))
+	test.AssertFilesEquals(t, path.Join(g.writer.Dir(), packagesDirPath, defaultKustomizationManifestFileName), "./testdata/packages-kustomization.yaml")
+}
+
+func TestUpdateGitPackagesErrorAddFile(t *testing.T) {
+	clusterName := "management-cluster"
+	clusterConfig := NewCluster(clusterName)
+	clusterSpec := newClusterSpec(t, clusterConfig, "")
+	g := newFluxTest(t)
+
+	g.git.EXPECT().Clone(g.ctx).Return(nil)
+	g.git.EXPECT().Branch(clusterSpec.FluxConfig.Spec.Branch).Return(nil)
+	g.git.EXPECT().Add("clusters/management-cluster/management-cluster/curated-packages").Return(errors.New("failed to add file"))
+
+	g.Expect(g.gitOpsFlux.UpdateGitPackages(g.ctx, clusterSpec, []byte("packages"))).To(MatchError(ContainSubstring("failed to add file")))
+}
+
+func TestUpdateGitPackagesErrorPush(t *testing.T) {
+	clusterName := "management-cluster"
+	clusterConfig := NewCluster(clusterName)
+	clusterSpec := newClusterSpec(t, clusterConfig, "")
+	g := newFluxTest(t)
+
+	g.git.EXPECT().Clone(g.ctx).Return(nil)
+	g.git.EXPECT().Branch(clusterSpec.FluxConfig.Spec.Branch).Return(nil)
+	g.git.EXPECT().Add("clusters/management-cluster/management-cluster/curated-packages").Return(nil)
+	g.git.EXPECT().Commit(test.OfType("string")).Return(nil)
+	g.git.EXPECT().Push(g.ctx).MaxTimes(2).Return(errors.New("failed to push code"))
+
+	g.Expect(g.gitOpsFlux.UpdateGitPackages(g.ctx, clusterSpec, []byte("packages"))).To(MatchError(ContainSubstring("failed to push code")))
+}
+
+func TestUpdateGitPackagesSkip(t *testing.T) {
+	g := newFluxTest(t)
+	clusterSpec := newClusterSpec(t, NewCluster("management-cluster"), "")
+	f := flux.NewFlux(nil, nil, nil, nil)
+
+	g.Expect(f.UpdateGitPackages(g.ctx, clusterSpec, []byte("packages"))).To(Succeed())
+}
+
 func TestForceReconcileGitRepo(t *testing.T) {
 	cluster := &types.Cluster{}
 	clusterConfig := NewCluster("")
@@ -2,6 +2,7 @@ package govmomi
 
 import (
 	"context"
+	"net/url"
 
 	"github.com/vmware/govmomi"
 	"github.com/vmware/govmomi/find"
@@ -37,7 +38,9 @@ type VMOMIClient struct {
 	Gcvm                 *govmomi.Client
 	Finder               VMOMIFinder
 	username             string
+	userinfo             *url.Userinfo
 	AuthorizationManager VMOMIAuthorizationManager
+	release              func(ctx context.Context) error
 }
 
 func NewVMOMIClientCustom(gcvm *govmomi.Client, f VMOMIFinder, username string, am VMOMIAuthorizationManager) *VMOMIClient {
@@ -53,6 +56,17 @@ func (vsc *VMOMIClient) Username() string {
 	return vsc.username
 }
 
+// Close gives the client session back to the pool it came from or, if it wasn't pooled, logs it out.
+func (vsc *VMOMIClient) Close(ctx context.Context) error {
+	if vsc.release != nil {
+		return vsc.release(ctx)
+	}
+	if vsc.Gcvm == nil {
+		return nil
+	}
+	return vsc.Gcvm.Logout(ctx)
+}
+
 func (vsc *VMOMIClient) GetPrivsOnEntity(ctx context.Context, path string, objType string, username string) ([]string, error) {
 	var vSphereObjectReference types.ManagedObjectReference
 	emptyResult := []string{}
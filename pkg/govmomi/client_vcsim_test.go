@@ -0,0 +1,75 @@
+//go:build vcsim
+
+package govmomi_test
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/aws/eks-anywhere/internal/test/vcsim"
+	"github.com/aws/eks-anywhere/pkg/govmomi"
+)
+
+func TestVMOMIClientBuilderBuildSimulator(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	sim := vcsim.New(t)
+
+	vsc, err := govmomi.NewVMOMIClientBuilder().Build(ctx, sim.Server(), sim.Username(), sim.Password(), true, vcsim.Datacenter)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(vsc.Username()).To(Equal(sim.Username()))
+	g.Expect(vsc.Close(ctx)).To(Succeed())
+}
+
+func TestVMOMIClientBuilderBuildSimulatorMissingDatacenter(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	sim := vcsim.New(t)
+
+	_, err := govmomi.NewVMOMIClientBuilder().Build(ctx, sim.Server(), sim.Username(), sim.Password(), true, "missing-dc")
+	g.Expect(err).To(MatchError(ContainSubstring("missing-dc")))
+}
+
+func TestVMOMIClientGetPrivsOnEntitySimulator(t *testing.T) {
+	ctx := context.Background()
+	sim := vcsim.New(t)
+
+	tests := []struct {
+		objType string
+		path    string
+	}{
+		{objType: govmomi.VSphereTypeFolder, path: vcsim.Folder},
+		{objType: govmomi.VSphereTypeNetwork, path: vcsim.Network},
+		{objType: govmomi.VSphereTypeDatastore, path: vcsim.Datastore},
+		{objType: govmomi.VSphereTypeResourcePool, path: vcsim.ResourcePool},
+		{objType: govmomi.VSphereTypeComputeCluster, path: vcsim.ComputeCluster},
+		{objType: govmomi.VSphereTypeVirtualMachine, path: vcsim.VirtualMachine},
+	}
+	for _, tt := range tests {
+		t.Run(tt.objType, func(t *testing.T) {
+			g := NewWithT(t)
+			vsc, err := govmomi.NewVMOMIClientBuilder().Build(ctx, sim.Server(), sim.Username(), sim.Password(), true, vcsim.Datacenter)
+			g.Expect(err).NotTo(HaveOccurred())
+			t.Cleanup(func() { _ = vsc.Close(ctx) })
+
+			privs, err := vsc.GetPrivsOnEntity(ctx, tt.path, tt.objType, sim.Username())
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(privs).To(ContainElement("System.Read"))
+		})
+	}
+}
+
+func TestVMOMIClientGetPrivsOnEntitySimulatorNotFound(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	sim := vcsim.New(t)
+
+	vsc, err := govmomi.NewVMOMIClientBuilder().Build(ctx, sim.Server(), sim.Username(), sim.Password(), true, vcsim.Datacenter)
+	g.Expect(err).NotTo(HaveOccurred())
+	t.Cleanup(func() { _ = vsc.Close(ctx) })
+
+	_, err = vsc.GetPrivsOnEntity(ctx, "/DC0/datastore/missing", govmomi.VSphereTypeDatastore, sim.Username())
+	g.Expect(err).To(MatchError(ContainSubstring("not found")))
+}
@@ -3,6 +3,7 @@ package govmomi
 import (
 	"context"
 	"net/url"
+	"time"
 
 	"github.com/vmware/govmomi"
 	"github.com/vmware/govmomi/object"
@@ -13,6 +14,8 @@ import (
 type VSphereClient interface {
 	Username() string
 	GetPrivsOnEntity(ctx context.Context, path string, objType string, username string) ([]string, error)
+	Close(ctx context.Context) error
+	PasswordExpiration(ctx context.Context) (*time.Time, error)
 }
 
 type VMOMIFinderBuilder interface {
@@ -37,6 +40,12 @@ func NewVMOMIClientBuilder() *vMOMIClientBuilder {
 	return &vMOMIClientBuilder{vfb: &vMOMIFinderBuilder{}, gcb: &vMOMISessionBuilder{}, amb: &vMOMIAuthorizationManagerBuilder{}}
 }
 
+// NewVMOMIClientBuilderWithSessionPool returns a client builder that takes its sessions from pool
+// instead of logging in for every client.
+func NewVMOMIClientBuilderWithSessionPool(pool *SessionPool) *vMOMIClientBuilder {
+	return &vMOMIClientBuilder{vfb: &vMOMIFinderBuilder{}, gcb: pool, amb: &vMOMIAuthorizationManagerBuilder{}}
+}
+
 func NewVMOMIClientBuilderOverride(vfb VMOMIFinderBuilder, gcb VMOMISessionBuilder, amb VMOMIAuthorizationManagerBuilder) *vMOMIClientBuilder {
 	return &vMOMIClientBuilder{vfb: vfb, gcb: gcb, amb: amb}
 }
@@ -54,10 +63,13 @@ func (vcb *vMOMIClientBuilder) Build(ctx context.Context, host string, username
 		return nil, err
 	}
 
+	release := vcb.releaseFunc(gvmc)
+
 	f := vcb.vfb.Build(gvmc.Client, true)
 
 	dc, err := f.Datacenter(ctx, datacenter)
 	if err != nil {
+		_ = release(ctx)
 		return nil, err
 	}
 
@@ -65,5 +77,23 @@ func (vcb *vMOMIClientBuilder) Build(ctx context.Context, host string, username
 
 	am := vcb.amb.Build(gvmc.Client)
 
-	return &VMOMIClient{gvmc, f, username, am}, nil
+	return &VMOMIClient{
+		Gcvm:                 gvmc,
+		Finder:               f,
+		username:             username,
+		userinfo:             u.User,
+		AuthorizationManager: am,
+		release:              release,
+	}, nil
+}
+
+func (vcb *vMOMIClientBuilder) releaseFunc(gvmc *govmomi.Client) func(ctx context.Context) error {
+	if pool, ok := vcb.gcb.(*SessionPool); ok {
+		return func(context.Context) error {
+			pool.Release(gvmc)
+			return nil
+		}
+	}
+
+	return gvmc.Logout
 }
@@ -0,0 +1,6 @@
+package govmomi
+
+var (
+	DaysRemainingUntilPasswordExpiration = daysRemainingUntilPasswordExpiration
+	PrincipalID                          = principalID
+)
@@ -8,6 +8,7 @@ import (
 	context "context"
 	url "net/url"
 	reflect "reflect"
+	time "time"
 
 	govmomi "github.com/aws/eks-anywhere/pkg/govmomi"
 	gomock "github.com/golang/mock/gomock"
@@ -41,6 +42,20 @@ func (m *MockVSphereClient) EXPECT() *MockVSphereClientMockRecorder {
 	return m.recorder
 }
 
+// Close mocks base method.
+func (m *MockVSphereClient) Close(arg0 context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Close", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Close indicates an expected call of Close.
+func (mr *MockVSphereClientMockRecorder) Close(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockVSphereClient)(nil).Close), arg0)
+}
+
 // GetPrivsOnEntity mocks base method.
 func (m *MockVSphereClient) GetPrivsOnEntity(arg0 context.Context, arg1, arg2, arg3 string) ([]string, error) {
 	m.ctrl.T.Helper()
@@ -56,6 +71,21 @@ func (mr *MockVSphereClientMockRecorder) GetPrivsOnEntity(arg0, arg1, arg2, arg3
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPrivsOnEntity", reflect.TypeOf((*MockVSphereClient)(nil).GetPrivsOnEntity), arg0, arg1, arg2, arg3)
 }
 
+// PasswordExpiration mocks base method.
+func (m *MockVSphereClient) PasswordExpiration(arg0 context.Context) (*time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PasswordExpiration", arg0)
+	ret0, _ := ret[0].(*time.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PasswordExpiration indicates an expected call of PasswordExpiration.
+func (mr *MockVSphereClientMockRecorder) PasswordExpiration(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PasswordExpiration", reflect.TypeOf((*MockVSphereClient)(nil).PasswordExpiration), arg0)
+}
+
 // Username mocks base method.
 func (m *MockVSphereClient) Username() string {
 	m.ctrl.T.Helper()
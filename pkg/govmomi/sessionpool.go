@@ -0,0 +1,181 @@
+package govmomi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/vmware/govmomi"
+	"github.com/vmware/govmomi/session/keepalive"
+
+	"github.com/aws/eks-anywhere/pkg/logger"
+)
+
+const defaultKeepAliveInterval = 5 * time.Minute
+
+// sessionLimitMessages are the fault messages vCenter and ESXi reply with when no more sessions can be opened.
+// A plain 503 is not enough to tell a session limit apart from an unavailable or overloaded endpoint.
+var sessionLimitMessages = []string{
+	"maximum number of sessions",
+	"session limit exceeded",
+}
+
+// IsSessionLimitError reports whether err was caused by the vCenter session limit being reached.
+func IsSessionLimitError(err error) bool {
+	if err == nil {
+		return false
+	}
+	msg := strings.ToLower(err.Error())
+	for _, m := range sessionLimitMessages {
+		if strings.Contains(msg, strings.ToLower(m)) {
+			return true
+		}
+	}
+	return false
+}
+
+// SessionLimitError wraps a login error caused by the vCenter session limit being reached.
+type SessionLimitError struct {
+	Err error
+}
+
+func (e *SessionLimitError) Error() string {
+	return fmt.Sprintf("vCenter session limit reached, log out unused sessions or wait for them to expire before retrying: %v", e.Err)
+}
+
+func (e *SessionLimitError) Unwrap() error {
+	return e.Err
+}
+
+type pooledSession struct {
+	client    *govmomi.Client
+	keepAlive *keepalive.HandlerSOAP
+	refs      int
+}
+
+// SessionPool shares logged in vSphere sessions between all the clients built in a CLI run.
+// Sessions are kept alive while in the pool and they are only logged out when the pool is closed.
+// SessionPool implements VMOMISessionBuilder so it can be plugged into a client builder.
+type SessionPool struct {
+	builder           VMOMISessionBuilder
+	keepAliveInterval time.Duration
+
+	mu       sync.Mutex
+	sessions map[string]*pooledSession
+}
+
+// SessionPoolOpt allows to customize a SessionPool.
+type SessionPoolOpt func(*SessionPool)
+
+// WithKeepAliveInterval sets how often idle sessions are refreshed so vCenter doesn't expire them.
+func WithKeepAliveInterval(interval time.Duration) SessionPoolOpt {
+	return func(p *SessionPool) {
+		p.keepAliveInterval = interval
+	}
+}
+
+// WithSessionBuilder sets the builder used to log in new sessions.
+func WithSessionBuilder(builder VMOMISessionBuilder) SessionPoolOpt {
+	return func(p *SessionPool) {
+		p.builder = builder
+	}
+}
+
+// NewSessionPool returns an empty SessionPool.
+func NewSessionPool(opts ...SessionPoolOpt) *SessionPool {
+	p := &SessionPool{
+		builder:           &vMOMISessionBuilder{},
+		keepAliveInterval: defaultKeepAliveInterval,
+		sessions:          map[string]*pooledSession{},
+	}
+
+	for _, opt := range opts {
+		opt(p)
+	}
+
+	return p
+}
+
+// Build returns the pooled session for the server and user in u, logging in only if there isn't one yet.
+// Every call must be paired with a call to Release.
+func (p *SessionPool) Build(ctx context.Context, u *url.URL, insecure bool) (*govmomi.Client, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	key := sessionKey(u, insecure)
+	if s, ok := p.sessions[key]; ok {
+		s.refs++
+		logger.V(6).Info("Reusing vSphere session", "server", u.Host, "refs", s.refs)
+		return s.client, nil
+	}
+
+	client, err := p.builder.Build(ctx, u, insecure)
+	if err != nil {
+		if IsSessionLimitError(err) {
+			return nil, &SessionLimitError{Err: err}
+		}
+		return nil, err
+	}
+
+	h := keepalive.NewHandlerSOAP(client.Client.RoundTripper, p.keepAliveInterval, nil)
+	client.Client.RoundTripper = h
+	h.Start()
+
+	p.sessions[key] = &pooledSession{client: client, keepAlive: h, refs: 1}
+	logger.V(6).Info("Created vSphere session", "server", u.Host)
+
+	return client, nil
+}
+
+// Release gives back a session obtained with Build. The session stays in the pool so it can be reused.
+func (p *SessionPool) Release(client *govmomi.Client) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for _, s := range p.sessions {
+		if s.client == client && s.refs > 0 {
+			s.refs--
+			return
+		}
+	}
+}
+
+// InUse returns the number of sessions that have been built but not released yet.
+func (p *SessionPool) InUse() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	inUse := 0
+	for _, s := range p.sessions {
+		inUse += s.refs
+	}
+	return inUse
+}
+
+// Close logs out all the pooled sessions.
+func (p *SessionPool) Close(ctx context.Context) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	var errs []error
+	for key, s := range p.sessions {
+		if s.refs > 0 {
+			logger.V(4).Info("Logging out vSphere session still in use", "refs", s.refs)
+		}
+		s.keepAlive.Stop()
+		if err := s.client.Logout(ctx); err != nil {
+			errs = append(errs, fmt.Errorf("logging out vSphere session: %v", err))
+		}
+		delete(p.sessions, key)
+	}
+
+	return errors.Join(errs...)
+}
+
+func sessionKey(u *url.URL, insecure bool) string {
+	return fmt.Sprintf("%s|%s|%t", u.Host, u.User.Username(), insecure)
+}
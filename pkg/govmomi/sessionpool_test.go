@@ -0,0 +1,145 @@
+package govmomi_test
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	govmomi_internal "github.com/vmware/govmomi"
+	"github.com/vmware/govmomi/vim25"
+
+	"github.com/aws/eks-anywhere/pkg/govmomi"
+	"github.com/aws/eks-anywhere/pkg/govmomi/mocks"
+)
+
+func TestSessionPoolBuildReusesSession(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	sb := mocks.NewMockVMOMISessionBuilder(ctrl)
+	pool := govmomi.NewSessionPool(govmomi.WithSessionBuilder(sb))
+
+	u := &url.URL{Host: "vcenter.example.com", User: url.UserPassword("admin", "pass")}
+	client := &govmomi_internal.Client{Client: &vim25.Client{}}
+	sb.EXPECT().Build(ctx, u, false).Return(client, nil).Times(1)
+
+	c1, err := pool.Build(ctx, u, false)
+	g.Expect(err).NotTo(HaveOccurred())
+	c2, err := pool.Build(ctx, u, false)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	g.Expect(c1).To(BeIdenticalTo(c2))
+	g.Expect(pool.InUse()).To(Equal(2))
+
+	pool.Release(c1)
+	pool.Release(c2)
+	g.Expect(pool.InUse()).To(Equal(0))
+}
+
+func TestSessionPoolBuildDifferentUsers(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	sb := mocks.NewMockVMOMISessionBuilder(ctrl)
+	pool := govmomi.NewSessionPool(govmomi.WithSessionBuilder(sb))
+
+	adminURL := &url.URL{Host: "vcenter.example.com", User: url.UserPassword("admin", "pass")}
+	cpURL := &url.URL{Host: "vcenter.example.com", User: url.UserPassword("cp-user", "pass")}
+	sb.EXPECT().Build(ctx, adminURL, false).Return(&govmomi_internal.Client{Client: &vim25.Client{}}, nil)
+	sb.EXPECT().Build(ctx, cpURL, false).Return(&govmomi_internal.Client{Client: &vim25.Client{}}, nil)
+
+	c1, err := pool.Build(ctx, adminURL, false)
+	g.Expect(err).NotTo(HaveOccurred())
+	c2, err := pool.Build(ctx, cpURL, false)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	g.Expect(c1).NotTo(BeIdenticalTo(c2))
+}
+
+func TestSessionPoolBuildSessionLimitError(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	sb := mocks.NewMockVMOMISessionBuilder(ctrl)
+	pool := govmomi.NewSessionPool(govmomi.WithSessionBuilder(sb))
+
+	u := &url.URL{Host: "vcenter.example.com", User: url.UserPassword("admin", "pass")}
+	loginErr := errors.New("ServerFaultCode: The maximum number of sessions has been exceeded")
+	sb.EXPECT().Build(ctx, u, false).Return(nil, loginErr)
+
+	_, err := pool.Build(ctx, u, false)
+	g.Expect(err).To(MatchError(ContainSubstring("vCenter session limit reached")))
+	g.Expect(errors.Is(err, loginErr)).To(BeTrue())
+	g.Expect(pool.InUse()).To(Equal(0))
+}
+
+func TestSessionPoolBuildError(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	sb := mocks.NewMockVMOMISessionBuilder(ctrl)
+	pool := govmomi.NewSessionPool(govmomi.WithSessionBuilder(sb))
+
+	u := &url.URL{Host: "vcenter.example.com", User: url.UserPassword("admin", "pass")}
+	sb.EXPECT().Build(ctx, u, false).Return(nil, errors.New("incorrect user name or password"))
+
+	_, err := pool.Build(ctx, u, false)
+	g.Expect(err).To(MatchError("incorrect user name or password"))
+}
+
+func TestSessionPoolBuildServiceUnavailable(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	sb := mocks.NewMockVMOMISessionBuilder(ctrl)
+	pool := govmomi.NewSessionPool(govmomi.WithSessionBuilder(sb))
+
+	u := &url.URL{Host: "vcenter.example.com", User: url.UserPassword("admin", "pass")}
+	sb.EXPECT().Build(ctx, u, false).Return(nil, errors.New("POST \"/sdk\": 503 Service Unavailable"))
+
+	_, err := pool.Build(ctx, u, false)
+	g.Expect(err).To(MatchError("POST \"/sdk\": 503 Service Unavailable"))
+}
+
+func TestIsSessionLimitError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{
+			name: "nil",
+			err:  nil,
+			want: false,
+		},
+		{
+			name: "service unavailable",
+			err:  errors.New("POST \"/sdk\": 503 Service Unavailable"),
+			want: false,
+		},
+		{
+			name: "max sessions",
+			err:  errors.New("The maximum number of sessions has been exceeded"),
+			want: true,
+		},
+		{
+			name: "session limit exceeded",
+			err:  errors.New("ServerFaultCode: Session limit exceeded"),
+			want: true,
+		},
+		{
+			name: "other error",
+			err:  errors.New("connection refused"),
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(govmomi.IsSessionLimitError(tt.err)).To(Equal(tt.want))
+		})
+	}
+}
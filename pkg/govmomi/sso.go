@@ -0,0 +1,102 @@
+package govmomi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/vmware/govmomi/ssoadmin"
+	ssotypes "github.com/vmware/govmomi/ssoadmin/types"
+	"github.com/vmware/govmomi/sts"
+	"github.com/vmware/govmomi/vim25/soap"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+// PasswordExpiration returns when the vCenter SSO password of the logged in user expires, or nil
+// if it never expires. It logs in to the SSO admin service with a token issued for the client
+// credentials.
+func (vsc *VMOMIClient) PasswordExpiration(ctx context.Context) (*time.Time, error) {
+	if vsc.Gcvm == nil || vsc.userinfo == nil {
+		return nil, errors.New("vCenter credentials are unknown")
+	}
+
+	tokens, err := sts.NewClient(ctx, vsc.Gcvm.Client)
+	if err != nil {
+		return nil, fmt.Errorf("building vCenter STS client: %v", err)
+	}
+
+	signer, err := tokens.Issue(ctx, sts.TokenRequest{Userinfo: vsc.userinfo})
+	if err != nil {
+		return nil, fmt.Errorf("issuing vCenter SSO token: %v", err)
+	}
+
+	admin, err := ssoadmin.NewClient(ctx, vsc.Gcvm.Client)
+	if err != nil {
+		return nil, fmt.Errorf("building vCenter SSO admin client: %v", err)
+	}
+
+	if err := admin.Login(admin.WithHeader(ctx, soap.Header{Security: signer})); err != nil {
+		return nil, fmt.Errorf("logging in to vCenter SSO admin service: %v", err)
+	}
+	defer func() { _ = admin.Logout(ctx) }()
+
+	days, err := daysRemainingUntilPasswordExpiration(ctx, admin, admin.ServiceContent.PrincipalManagementService, principalID(vsc.username, admin.Domain))
+	if err != nil {
+		return nil, fmt.Errorf("reading vCenter SSO password expiration of %s: %v", vsc.username, err)
+	}
+
+	// SSO reports a negative number of days for passwords that don't expire.
+	if days < 0 {
+		return nil, nil
+	}
+
+	expiration := time.Now().Add(time.Duration(days) * 24 * time.Hour)
+	return &expiration, nil
+}
+
+// principalID parses an SSO user name, either user@domain or domain\user, defaulting to domain.
+func principalID(username, domain string) ssotypes.PrincipalId {
+	if d, name, ok := strings.Cut(username, `\`); ok {
+		return ssotypes.PrincipalId{Name: name, Domain: d}
+	}
+	if name, d, ok := strings.Cut(username, "@"); ok {
+		return ssotypes.PrincipalId{Name: name, Domain: d}
+	}
+	return ssotypes.PrincipalId{Name: username, Domain: domain}
+}
+
+// govmomi doesn't implement the GetDaysRemainingUntilPasswordExpiration method of the SSO
+// PrincipalManagementService, so its request is built like the ones in the ssoadmin/methods package.
+
+type getDaysRemainingUntilPasswordExpirationRequest struct {
+	This   types.ManagedObjectReference `xml:"_this"`
+	UserID ssotypes.PrincipalId         `xml:"userId"`
+}
+
+type getDaysRemainingUntilPasswordExpirationResponse struct {
+	Returnval int64 `xml:"returnval"`
+}
+
+type getDaysRemainingUntilPasswordExpirationBody struct {
+	Req    *getDaysRemainingUntilPasswordExpirationRequest  `xml:"urn:sso GetDaysRemainingUntilPasswordExpiration,omitempty"`
+	Res    *getDaysRemainingUntilPasswordExpirationResponse `xml:"urn:sso GetDaysRemainingUntilPasswordExpirationResponse,omitempty"`
+	Fault_ *soap.Fault                                      `xml:"http://schemas.xmlsoap.org/soap/envelope/ Fault,omitempty"`
+}
+
+func (b *getDaysRemainingUntilPasswordExpirationBody) Fault() *soap.Fault { return b.Fault_ }
+
+func daysRemainingUntilPasswordExpiration(ctx context.Context, r soap.RoundTripper, this types.ManagedObjectReference, id ssotypes.PrincipalId) (int64, error) {
+	var reqBody, resBody getDaysRemainingUntilPasswordExpirationBody
+	reqBody.Req = &getDaysRemainingUntilPasswordExpirationRequest{This: this, UserID: id}
+
+	if err := r.RoundTrip(ctx, &reqBody, &resBody); err != nil {
+		return 0, err
+	}
+	if resBody.Res == nil {
+		return 0, errors.New("empty response")
+	}
+
+	return resBody.Res.Returnval, nil
+}
@@ -0,0 +1,76 @@
+package govmomi_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	ssotypes "github.com/vmware/govmomi/ssoadmin/types"
+	"github.com/vmware/govmomi/vim25/soap"
+	"github.com/vmware/govmomi/vim25/types"
+
+	"github.com/aws/eks-anywhere/pkg/govmomi"
+)
+
+func TestPrincipalID(t *testing.T) {
+	tests := []struct {
+		username string
+		want     ssotypes.PrincipalId
+	}{
+		{username: "administrator@vsphere.local", want: ssotypes.PrincipalId{Name: "administrator", Domain: "vsphere.local"}},
+		{username: `corp\eksa`, want: ssotypes.PrincipalId{Name: "eksa", Domain: "corp"}},
+		{username: "eksa", want: ssotypes.PrincipalId{Name: "eksa", Domain: "vsphere.local"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.username, func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(govmomi.PrincipalID(tt.username, "vsphere.local")).To(Equal(tt.want))
+		})
+	}
+}
+
+func TestDaysRemainingUntilPasswordExpiration(t *testing.T) {
+	g := NewWithT(t)
+	var request string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		request = string(body)
+		w.Header().Set("Content-Type", "text/xml")
+		_, _ = io.WriteString(w, `<?xml version="1.0" encoding="UTF-8"?>
+<soapenv:Envelope xmlns:soapenv="http://schemas.xmlsoap.org/soap/envelope/">
+<soapenv:Body><GetDaysRemainingUntilPasswordExpirationResponse xmlns="urn:sso"><returnval>12</returnval></GetDaysRemainingUntilPasswordExpirationResponse></soapenv:Body>
+</soapenv:Envelope>`)
+	}))
+	t.Cleanup(server.Close)
+	u, err := url.Parse(server.URL)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	this := types.ManagedObjectReference{Type: "SsoAdminPrincipalManagementService", Value: "principalManagementService"}
+	days, err := govmomi.DaysRemainingUntilPasswordExpiration(context.Background(), soap.NewClient(u, true), this, ssotypes.PrincipalId{Name: "eksa", Domain: "vsphere.local"})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(days).To(Equal(int64(12)))
+	g.Expect(request).To(ContainSubstring(`<GetDaysRemainingUntilPasswordExpiration xmlns="urn:sso">`))
+	g.Expect(request).To(ContainSubstring(`<userId><name>eksa</name><domain>vsphere.local</domain></userId>`))
+}
+
+func TestDaysRemainingUntilPasswordExpirationFault(t *testing.T) {
+	g := NewWithT(t)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "text/xml")
+		w.WriteHeader(http.StatusInternalServerError)
+		_, _ = io.WriteString(w, `<?xml version="1.0" encoding="UTF-8"?>
+<soapenv:Envelope xmlns:soapenv="http://schemas.xmlsoap.org/soap/envelope/">
+<soapenv:Body><soapenv:Fault><faultcode>ServerFaultCode</faultcode><faultstring>Access denied</faultstring></soapenv:Fault></soapenv:Body>
+</soapenv:Envelope>`)
+	}))
+	t.Cleanup(server.Close)
+	u, err := url.Parse(server.URL)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	_, err = govmomi.DaysRemainingUntilPasswordExpiration(context.Background(), soap.NewClient(u, true), types.ManagedObjectReference{}, ssotypes.PrincipalId{Name: "eksa"})
+	g.Expect(err).To(MatchError(ContainSubstring("Access denied")))
+}
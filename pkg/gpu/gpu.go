@@ -0,0 +1,63 @@
+// Package gpu enables the NVIDIA GPUs of the worker node groups with GPU support of EKS Anywhere
+// clusters, with the nvidia runtime class and the NVIDIA device plugin.
+package gpu
+
+import (
+	_ "embed"
+	"errors"
+	"fmt"
+
+	"github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/cluster"
+	"github.com/aws/eks-anywhere/pkg/providers/common"
+	"github.com/aws/eks-anywhere/pkg/registrymirror"
+	"github.com/aws/eks-anywhere/pkg/templater"
+)
+
+const (
+	// Namespace is the namespace the NVIDIA device plugin is installed in.
+	Namespace = "kube-system"
+
+	// RuntimeClass is the name of the runtime class running containers with the NVIDIA container
+	// runtime, through the containerd runtime handler configured on the nodes with GPU support.
+	RuntimeClass = common.NvidiaRuntimeHandler
+)
+
+//go:embed config/nvidia.yaml
+var nvidiaTemplate string
+
+// Enabled returns whether any of the worker node groups of the cluster has GPU support.
+func Enabled(c *v1alpha1.Cluster) bool {
+	for _, w := range c.Spec.WorkerNodeGroupConfigurations {
+		if w.GPU != nil {
+			return true
+		}
+	}
+
+	return false
+}
+
+// GenerateManifest generates the manifest that installs the nvidia runtime class and the NVIDIA
+// device plugin on the nodes with GPU support.
+func GenerateManifest(spec *cluster.Spec) ([]byte, error) {
+	bundle := spec.RootVersionsBundle()
+	if bundle == nil || bundle.NvidiaDevicePlugin == nil {
+		return nil, errors.New("the EKS Anywhere bundle for the cluster doesn't include the NVIDIA device plugin, please upgrade to a release that supports it")
+	}
+
+	mirror := registrymirror.FromCluster(spec.Cluster)
+	values := map[string]interface{}{
+		"namespace":         Namespace,
+		"runtimeClass":      RuntimeClass,
+		"nodeLabel":         v1alpha1.GPUNodeLabel,
+		"taintKey":          v1alpha1.GPUTaintKey,
+		"devicePluginImage": mirror.ReplaceRegistry(bundle.NvidiaDevicePlugin.DevicePlugin.VersionedImage()),
+	}
+
+	manifest, err := templater.Execute(nvidiaTemplate, values)
+	if err != nil {
+		return nil, fmt.Errorf("generating gpu manifest: %v", err)
+	}
+
+	return manifest, nil
+}
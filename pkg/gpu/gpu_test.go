@@ -0,0 +1,71 @@
+package gpu_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/aws/eks-anywhere/internal/test"
+	"github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/cluster"
+	"github.com/aws/eks-anywhere/pkg/gpu"
+	releasev1alpha1 "github.com/aws/eks-anywhere/release/api/v1alpha1"
+)
+
+func gpuSpec(opts ...test.ClusterSpecOpt) *cluster.Spec {
+	return test.NewClusterSpec(append([]test.ClusterSpecOpt{
+		func(s *cluster.Spec) {
+			s.Cluster.Name = "test-cluster"
+			s.Cluster.Spec.WorkerNodeGroupConfigurations = []v1alpha1.WorkerNodeGroupConfiguration{
+				{Name: "md-gpu", GPU: &v1alpha1.GPUConfiguration{}},
+			}
+			s.VersionsBundles["1.19"].NvidiaDevicePlugin = &releasev1alpha1.NvidiaDevicePluginBundle{
+				Version: "v0.17.0",
+				DevicePlugin: releasev1alpha1.Image{
+					URI: "public.ecr.aws/eks-anywhere/nvidia/k8s-device-plugin:v0.17.0-eks-a-1",
+				},
+			}
+		},
+	}, opts...)...)
+}
+
+func TestEnabled(t *testing.T) {
+	g := NewWithT(t)
+	spec := gpuSpec()
+	g.Expect(gpu.Enabled(spec.Cluster)).To(BeTrue())
+
+	spec.Cluster.Spec.WorkerNodeGroupConfigurations[0].GPU = nil
+	g.Expect(gpu.Enabled(spec.Cluster)).To(BeFalse())
+}
+
+func TestGenerateManifest(t *testing.T) {
+	g := NewWithT(t)
+
+	manifest, err := gpu.GenerateManifest(gpuSpec())
+	g.Expect(err).NotTo(HaveOccurred())
+	test.AssertContentToFile(t, string(manifest), "testdata/expected_results_nvidia.yaml")
+}
+
+func TestGenerateManifestRegistryMirror(t *testing.T) {
+	g := NewWithT(t)
+	spec := gpuSpec(func(s *cluster.Spec) {
+		s.Cluster.Spec.RegistryMirrorConfiguration = &v1alpha1.RegistryMirrorConfiguration{
+			Endpoint: "1.2.3.4",
+			Port:     "443",
+		}
+	})
+
+	manifest, err := gpu.GenerateManifest(spec)
+	g.Expect(err).NotTo(HaveOccurred())
+	test.AssertContentToFile(t, string(manifest), "testdata/expected_results_nvidia_mirror.yaml")
+}
+
+func TestGenerateManifestBundleWithoutNvidiaDevicePlugin(t *testing.T) {
+	g := NewWithT(t)
+	spec := gpuSpec(func(s *cluster.Spec) {
+		s.VersionsBundles["1.19"].NvidiaDevicePlugin = nil
+	})
+
+	_, err := gpu.GenerateManifest(spec)
+	g.Expect(err).To(MatchError(ContainSubstring("doesn't include the NVIDIA device plugin")))
+}
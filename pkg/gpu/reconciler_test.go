@@ -0,0 +1,214 @@
+package gpu_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	eksdv1 "github.com/aws/eks-distro-build-tooling/release/api/v1alpha1"
+	"github.com/go-logr/logr"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	nodev1 "k8s.io/api/node/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	controlplanev1beta2 "sigs.k8s.io/cluster-api/api/controlplane/kubeadm/v1beta2"
+	clusterv1beta2 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/aws/eks-anywhere/internal/test"
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/controller"
+	"github.com/aws/eks-anywhere/pkg/gpu"
+	"github.com/aws/eks-anywhere/pkg/gpu/mocks"
+	releasev1 "github.com/aws/eks-anywhere/release/api/v1alpha1"
+)
+
+type reconcilerTest struct {
+	*WithT
+	ctx                  context.Context
+	remoteClientRegistry *mocks.MockRemoteClientRegistry
+	bundle               *releasev1.Bundles
+	cluster              *anywherev1.Cluster
+	kcp                  *controlplanev1beta2.KubeadmControlPlane
+}
+
+func newReconcilerTest(t testing.TB) *reconcilerTest {
+	ctrl := gomock.NewController(t)
+	bundle := test.Bundle()
+	for i := range bundle.Spec.VersionsBundles {
+		bundle.Spec.VersionsBundles[i].NvidiaDevicePlugin = &releasev1.NvidiaDevicePluginBundle{
+			Version: "v0.17.0",
+			DevicePlugin: releasev1.Image{
+				URI: "public.ecr.aws/eks-anywhere/nvidia/k8s-device-plugin:v0.17.0",
+			},
+		}
+	}
+	version := test.DevEksaVersion()
+	cluster := &anywherev1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-cluster",
+			Namespace: "eksa-system",
+		},
+		Spec: anywherev1.ClusterSpec{
+			KubernetesVersion: "1.22",
+			ControlPlaneConfiguration: anywherev1.ControlPlaneConfiguration{
+				Endpoint: &anywherev1.Endpoint{
+					Host: "1.2.3.4",
+				},
+			},
+			BundlesRef: &anywherev1.BundlesRef{
+				Name:       bundle.Name,
+				Namespace:  bundle.Namespace,
+				APIVersion: bundle.APIVersion,
+			},
+			WorkerNodeGroupConfigurations: []anywherev1.WorkerNodeGroupConfiguration{
+				{
+					Name: "md-gpu",
+					GPU:  &anywherev1.GPUConfiguration{},
+				},
+			},
+			EksaVersion: &version,
+		},
+	}
+	kcpVersion := "test"
+	kcp := test.KubeadmControlPlane(func(kcp *controlplanev1beta2.KubeadmControlPlane) {
+		kcp.Name = cluster.Name
+		kcp.Spec.Version = kcpVersion
+		kcp.Status = controlplanev1beta2.KubeadmControlPlaneStatus{
+			Conditions: []metav1.Condition{
+				{
+					Type:               clusterv1beta2.AvailableCondition,
+					Status:             metav1.ConditionTrue,
+					LastTransitionTime: metav1.NewTime(time.Now()),
+				},
+			},
+			Version:            kcpVersion,
+			ReadyReplicas:      ptr.To(int32(1)),
+			Replicas:           ptr.To(int32(1)),
+			ObservedGeneration: 1,
+		}
+		kcp.Generation = 1
+	})
+	return &reconcilerTest{
+		WithT:                NewWithT(t),
+		ctx:                  context.Background(),
+		remoteClientRegistry: mocks.NewMockRemoteClientRegistry(ctrl),
+		bundle:               bundle,
+		cluster:              cluster,
+		kcp:                  kcp,
+	}
+}
+
+func (tt *reconcilerTest) client(objs ...runtime.Object) client.Client {
+	scheme := runtime.NewScheme()
+	_ = releasev1.AddToScheme(scheme)
+	_ = eksdv1.AddToScheme(scheme)
+	_ = corev1.AddToScheme(scheme)
+	_ = controlplanev1beta2.AddToScheme(scheme)
+	return fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(objs...).Build()
+}
+
+func (tt *reconcilerTest) managementClient(objs ...runtime.Object) client.Client {
+	return tt.client(append([]runtime.Object{tt.bundle, test.EksdRelease("1-22"), test.EKSARelease()}, objs...)...)
+}
+
+func nullLog() logr.Logger {
+	return logr.New(logf.NullLogSink{})
+}
+
+func TestReconcileNoGPU(t *testing.T) {
+	tt := newReconcilerTest(t)
+	tt.cluster.Spec.WorkerNodeGroupConfigurations[0].GPU = nil
+	r := gpu.New(tt.client(), tt.remoteClientRegistry)
+
+	result, err := r.Reconcile(tt.ctx, nullLog(), tt.cluster)
+	tt.Expect(err).NotTo(HaveOccurred())
+	tt.Expect(result).To(Equal(controller.Result{}))
+}
+
+func TestReconcileBuildClusterSpecError(t *testing.T) {
+	tt := newReconcilerTest(t)
+	r := gpu.New(tt.client(), tt.remoteClientRegistry)
+
+	result, err := r.Reconcile(tt.ctx, nullLog(), tt.cluster)
+	tt.Expect(err).To(HaveOccurred())
+	tt.Expect(result).To(Equal(controller.Result{}))
+}
+
+func TestReconcileControlPlaneNotReady(t *testing.T) {
+	tt := newReconcilerTest(t)
+	r := gpu.New(tt.managementClient(), tt.remoteClientRegistry)
+
+	result, err := r.Reconcile(tt.ctx, nullLog(), tt.cluster)
+	tt.Expect(err).NotTo(HaveOccurred())
+	tt.Expect(result).To(Equal(controller.ResultWithRequeue(5 * time.Second)))
+}
+
+func TestReconcileBundleWithoutNvidiaDevicePlugin(t *testing.T) {
+	tt := newReconcilerTest(t)
+	for i := range tt.bundle.Spec.VersionsBundles {
+		tt.bundle.Spec.VersionsBundles[i].NvidiaDevicePlugin = nil
+	}
+	r := gpu.New(tt.managementClient(tt.kcp), tt.remoteClientRegistry)
+
+	result, err := r.Reconcile(tt.ctx, nullLog(), tt.cluster)
+	tt.Expect(err).To(MatchError(ContainSubstring("doesn't include the NVIDIA device plugin")))
+	tt.Expect(result).To(Equal(controller.Result{}))
+}
+
+func TestReconcileRemoteGetClientError(t *testing.T) {
+	tt := newReconcilerTest(t)
+	r := gpu.New(tt.managementClient(tt.kcp), tt.remoteClientRegistry)
+	tt.remoteClientRegistry.EXPECT().
+		GetClient(tt.ctx, client.ObjectKey{Name: "my-cluster", Namespace: "eksa-system"}).
+		Return(nil, errors.New("client error"))
+
+	result, err := r.Reconcile(tt.ctx, nullLog(), tt.cluster)
+	tt.Expect(err).To(MatchError(ContainSubstring("client error")))
+	tt.Expect(result).To(Equal(controller.Result{}))
+}
+
+func TestReconcileApplyError(t *testing.T) {
+	tt := newReconcilerTest(t)
+	r := gpu.New(tt.managementClient(tt.kcp), tt.remoteClientRegistry)
+	remoteClient := fake.NewClientBuilder().WithInterceptorFuncs(interceptor.Funcs{
+		Patch: func(context.Context, client.WithWatch, client.Object, client.Patch, ...client.PatchOption) error {
+			return errors.New("patch error")
+		},
+	}).Build()
+	tt.remoteClientRegistry.EXPECT().
+		GetClient(tt.ctx, gomock.AssignableToTypeOf(client.ObjectKey{})).
+		Return(remoteClient, nil)
+
+	result, err := r.Reconcile(tt.ctx, nullLog(), tt.cluster)
+	tt.Expect(err).To(MatchError(ContainSubstring("applying gpu manifest")))
+	tt.Expect(result).To(Equal(controller.Result{}))
+}
+
+func TestReconcileAppliesManifest(t *testing.T) {
+	tt := newReconcilerTest(t)
+	r := gpu.New(tt.managementClient(tt.kcp), tt.remoteClientRegistry)
+	scheme := runtime.NewScheme()
+	_ = corev1.AddToScheme(scheme)
+	_ = appsv1.AddToScheme(scheme)
+	_ = nodev1.AddToScheme(scheme)
+	remoteClient := fake.NewClientBuilder().WithScheme(scheme).Build()
+	tt.remoteClientRegistry.EXPECT().
+		GetClient(tt.ctx, client.ObjectKey{Name: "my-cluster", Namespace: "eksa-system"}).
+		Return(remoteClient, nil)
+
+	result, err := r.Reconcile(tt.ctx, nullLog(), tt.cluster)
+	tt.Expect(err).NotTo(HaveOccurred())
+	tt.Expect(result).To(Equal(controller.Result{}))
+
+	tt.Expect(remoteClient.Get(tt.ctx, client.ObjectKey{Name: "nvidia"}, &nodev1.RuntimeClass{})).To(Succeed())
+	tt.Expect(remoteClient.Get(tt.ctx, client.ObjectKey{Name: "eksa-nvidia-device-plugin", Namespace: "kube-system"}, &appsv1.DaemonSet{})).To(Succeed())
+}
@@ -7,6 +7,7 @@ type Client interface {
 	PushChart(ctx context.Context, chart, registry string) error
 	PullChart(ctx context.Context, ociURI, version string) error
 	ListCharts(ctx context.Context, kubeconfigFilePath, filter, namespace string) ([]string, error)
+	ListReleases(ctx context.Context, kubeconfigFilePath string) ([]Release, error)
 	SaveChart(ctx context.Context, ociURI, version, folder string) error
 	Delete(ctx context.Context, kubeconfigFilePath, installName, namespace string) error
 	UpgradeInstallChartWithValuesFile(ctx context.Context, chart, ociURI, version, kubeconfigFilePath, namespace, valuesFilePath string, opts ...Opt) error
@@ -15,3 +16,12 @@ type Client interface {
 	Template(ctx context.Context, ociURI, version, namespace string, values interface{}, kubeVersion string) ([]byte, error)
 	RegistryLogin(ctx context.Context, registry, username, password string) error
 }
+
+// Release is a Helm release installed in a cluster.
+type Release struct {
+	Name      string `json:"name"`
+	Namespace string `json:"namespace"`
+	// Chart is the name and version of the release chart, like "harbor-2.5.1".
+	Chart  string `json:"chart"`
+	Status string `json:"status"`
+}
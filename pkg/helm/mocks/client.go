@@ -78,6 +78,21 @@ func (mr *MockClientMockRecorder) ListCharts(ctx, kubeconfigFilePath, filter, na
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCharts", reflect.TypeOf((*MockClient)(nil).ListCharts), ctx, kubeconfigFilePath, filter, namespace)
 }
 
+// ListReleases mocks base method.
+func (m *MockClient) ListReleases(ctx context.Context, kubeconfigFilePath string) ([]helm.Release, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListReleases", ctx, kubeconfigFilePath)
+	ret0, _ := ret[0].([]helm.Release)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListReleases indicates an expected call of ListReleases.
+func (mr *MockClientMockRecorder) ListReleases(ctx, kubeconfigFilePath interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListReleases", reflect.TypeOf((*MockClient)(nil).ListReleases), ctx, kubeconfigFilePath)
+}
+
 // PullChart mocks base method.
 func (m *MockClient) PullChart(ctx context.Context, ociURI, version string) error {
 	m.ctrl.T.Helper()
@@ -0,0 +1,73 @@
+package kubeconfig
+
+import (
+	"fmt"
+
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+
+	"github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+)
+
+// OIDCFormat defines the format of the file name of the kubeconfig authenticating with OIDC.
+const OIDCFormat = "%s-oidc.kubeconfig"
+
+// oidcLoginPlugin is the kubectl plugin getting ID tokens from the OIDC identity provider, https://github.com/int128/kubelogin.
+const oidcLoginPlugin = "oidc-login"
+
+// NewOIDCKubeconfig builds a kubeconfig for a cluster authenticating users with ID tokens of its OIDC identity
+// provider, through the kubectl oidc-login exec plugin, instead of embedded client certificates.
+// The API server endpoint and certificate authority are taken from the current context of adminKubeconfig.
+func NewOIDCKubeconfig(clusterName string, adminKubeconfig []byte, oidc *v1alpha1.OIDCConfigSpec) ([]byte, error) {
+	admin, err := clientcmd.Load(adminKubeconfig)
+	if err != nil {
+		return nil, fmt.Errorf("loading kubeconfig: %v", err)
+	}
+
+	adminContext, ok := admin.Contexts[admin.CurrentContext]
+	if !ok {
+		return nil, fmt.Errorf("kubeconfig current context %q not found", admin.CurrentContext)
+	}
+	adminCluster, ok := admin.Clusters[adminContext.Cluster]
+	if !ok {
+		return nil, fmt.Errorf("kubeconfig cluster %q not found", adminContext.Cluster)
+	}
+
+	args := []string{
+		oidcLoginPlugin,
+		"get-token",
+		"--oidc-issuer-url=" + oidc.IssuerUrl,
+		"--oidc-client-id=" + oidc.ClientId,
+	}
+	// The username and groups claims are only in the ID token when their scopes are requested.
+	if oidc.UsernameClaim == "email" {
+		args = append(args, "--oidc-extra-scope=email")
+	}
+	if oidc.GroupsClaim != "" {
+		args = append(args, "--oidc-extra-scope="+oidc.GroupsClaim)
+	}
+
+	user := clusterName + "-oidc"
+	config := clientcmdapi.NewConfig()
+	config.Clusters[clusterName] = &clientcmdapi.Cluster{
+		Server:                   adminCluster.Server,
+		TLSServerName:            adminCluster.TLSServerName,
+		CertificateAuthorityData: adminCluster.CertificateAuthorityData,
+		CertificateAuthority:     adminCluster.CertificateAuthority,
+	}
+	config.AuthInfos[user] = &clientcmdapi.AuthInfo{
+		Exec: &clientcmdapi.ExecConfig{
+			APIVersion:      "client.authentication.k8s.io/v1beta1",
+			Command:         "kubectl",
+			Args:            args,
+			InteractiveMode: clientcmdapi.IfAvailableExecInteractiveMode,
+		},
+	}
+	config.Contexts[user] = &clientcmdapi.Context{
+		Cluster:  clusterName,
+		AuthInfo: user,
+	}
+	config.CurrentContext = user
+
+	return clientcmd.Write(*config)
+}
@@ -0,0 +1,82 @@
+package kubeconfig_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/kubeconfig"
+)
+
+const adminKubeconfig = `apiVersion: v1
+clusters:
+- cluster:
+    certificate-authority-data: Y2EtZGF0YQ==
+    server: https://10.0.0.1:6443
+  name: mgmt
+contexts:
+- context:
+    cluster: mgmt
+    user: mgmt-admin
+  name: mgmt-admin@mgmt
+current-context: mgmt-admin@mgmt
+kind: Config
+preferences: {}
+users:
+- name: mgmt-admin
+  user:
+    client-certificate-data: Y2VydA==
+    client-key-data: a2V5
+`
+
+func TestNewOIDCKubeconfig(t *testing.T) {
+	g := NewWithT(t)
+	oidc := &v1alpha1.OIDCConfigSpec{
+		ClientId:      "eks-a",
+		IssuerUrl:     "https://issuer.example.com",
+		UsernameClaim: "email",
+		GroupsClaim:   "groups",
+	}
+
+	config, err := kubeconfig.NewOIDCKubeconfig("mgmt", []byte(adminKubeconfig), oidc)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(string(config)).To(Equal(`apiVersion: v1
+clusters:
+- cluster:
+    certificate-authority-data: Y2EtZGF0YQ==
+    server: https://10.0.0.1:6443
+  name: mgmt
+contexts:
+- context:
+    cluster: mgmt
+    user: mgmt-oidc
+  name: mgmt-oidc
+current-context: mgmt-oidc
+kind: Config
+users:
+- name: mgmt-oidc
+  user:
+    exec:
+      apiVersion: client.authentication.k8s.io/v1beta1
+      args:
+      - oidc-login
+      - get-token
+      - --oidc-issuer-url=https://issuer.example.com
+      - --oidc-client-id=eks-a
+      - --oidc-extra-scope=email
+      - --oidc-extra-scope=groups
+      command: kubectl
+      env: null
+      interactiveMode: IfAvailable
+      provideClusterInfo: false
+`))
+}
+
+func TestNewOIDCKubeconfigInvalidCurrentContext(t *testing.T) {
+	g := NewWithT(t)
+	oidc := &v1alpha1.OIDCConfigSpec{ClientId: "eks-a", IssuerUrl: "https://issuer.example.com"}
+
+	_, err := kubeconfig.NewOIDCKubeconfig("mgmt", []byte("apiVersion: v1\nkind: Config\ncurrent-context: missing\n"), oidc)
+	g.Expect(err).To(MatchError(`kubeconfig current context "missing" not found`))
+}
@@ -21,6 +21,11 @@ Level zero, the default, is important for the end user.
   - 8: Truncated external binaries and clients output/responses.
   - 9: Full external binaries and clients output/responses.
 
+Subsystems:
+
+The verbosity of the console output can be set per subsystem (providers, executables, workflows),
+overriding the global level. Logs from the packages of a subsystem are routed to its logger, see For().
+
 Logging WithValues:
 
 Logging WithValues should be preferred to embedding values into log messages because it allows
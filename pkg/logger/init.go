@@ -5,6 +5,7 @@ import (
 	"os"
 	"time"
 
+	"github.com/go-logr/logr"
 	"github.com/go-logr/zapr"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
@@ -13,13 +14,24 @@ import (
 // Init initializes the package logger. Repeat calls will overwrite the package logger which may
 // result in unexpected behavior.
 func Init(opts Options) error {
+	if err := opts.validate(); err != nil {
+		return err
+	}
+
+	// consoleLevel is the most verbose of the global and subsystem levels, the subsystem core
+	// filters each entry by the level of its subsystem.
+	consoleLevel := opts.Level
+	for _, l := range opts.SubsystemLevels {
+		consoleLevel = max(consoleLevel, l)
+	}
+
 	encoderCfg := zap.NewDevelopmentEncoderConfig()
 	encoderCfg.EncodeLevel = nil
 	encoderCfg.EncodeTime = func(t time.Time, enc zapcore.PrimitiveArrayEncoder) {}
 
 	// Level 4 and above are used for debugging and we want a different log structure for debug
-	// logs.
-	if opts.Level >= 4 {
+	// logs. JSON output is meant for ingestion so it always carries the level and time.
+	if consoleLevel >= 4 || opts.Format == FormatJSON {
 		encoderCfg.EncodeLevel = func(l zapcore.Level, enc zapcore.PrimitiveArrayEncoder) {
 			// Because we use negated levels it is necessary to negate the level again so the
 			// output appears in a V0 format.
@@ -45,10 +57,24 @@ func Init(opts Options) error {
 
 	// Build the encoders and logger.
 
-	fileEncoder := zapcore.NewJSONEncoder(encoderCfg)
+	// JSON entries name the subsystem that logged them, text output stays as is.
+	jsonEncoderCfg := encoderCfg
+	jsonEncoderCfg.NameKey = "subsystem"
+	encoderCfg.NameKey = ""
+
+	fileEncoder := zapcore.NewJSONEncoder(jsonEncoderCfg)
 	consoleEncoder := zapcore.NewConsoleEncoder(encoderCfg)
+	if opts.Format == FormatJSON {
+		consoleEncoder = zapcore.NewJSONEncoder(jsonEncoderCfg)
+	}
+
+	consoleCore := zapcore.NewCore(consoleEncoder, zapcore.AddSync(os.Stdout), logrAtomicLevel(consoleLevel))
+	if len(opts.SubsystemLevels) > 0 {
+		consoleCore = newSubsystemCore(consoleCore, opts.Level, opts.SubsystemLevels)
+	}
+
 	core := zapcore.NewTee(
-		zapcore.NewCore(consoleEncoder, zapcore.AddSync(os.Stdout), logrAtomicLevel(opts.Level)),
+		consoleCore,
 		zapcore.NewCore(fileEncoder, logFile, logrAtomicLevel(MaxLogLevel)),
 	)
 	logger := zap.New(core)
@@ -58,6 +84,12 @@ func Init(opts Options) error {
 	setLogger(zapr.NewLogger(logger))
 	setOutputFilePath(opts.OutputFilePath)
 
+	var subsystems map[string]logr.Logger
+	if len(opts.SubsystemLevels) > 0 || opts.Format == FormatJSON {
+		subsystems = newSubsystemLoggers(logger, zapr.NewLogger)
+	}
+	setSubsystemLoggers(subsystems)
+
 	return nil
 }
 
@@ -69,6 +101,30 @@ type Options struct {
 	// OutputFilePath is an absolute file path. The file will be created if it doesn't exist.
 	// All logs available at level 9 will be written to the file.
 	OutputFilePath string
+
+	// Format is the format of the console output, FormatText or FormatJSON. It defaults to
+	// FormatText.
+	Format string
+
+	// SubsystemLevels overrides Level for the console output of the logs of each subsystem.
+	// See Subsystems().
+	SubsystemLevels map[string]int
+}
+
+// Console output formats.
+const (
+	FormatText = "text"
+	FormatJSON = "json"
+)
+
+func (o Options) validate() error {
+	switch o.Format {
+	case "", FormatText, FormatJSON:
+	default:
+		return fmt.Errorf("invalid log format %s, supported formats are %s and %s", o.Format, FormatText, FormatJSON)
+	}
+
+	return validateSubsystemLevels(o.SubsystemLevels)
 }
 
 // logrAtomicLevel creates a zapcore.AtomicLevel compatible with go-logr.
@@ -77,5 +133,5 @@ func logrAtomicLevel(level int) zap.AtomicLevel {
 	// necessary to negate the level to circumvent Zap level constraints.
 	//
 	// See https://github.com/go-logr/zapr/blob/master/zapr.go#L50.
-	return zap.NewAtomicLevelAt(zapcore.Level(-level))
+	return zap.NewAtomicLevelAt(logrLevel(level))
 }
@@ -2,9 +2,11 @@ package logger_test
 
 import (
 	"bytes"
+	"encoding/json"
 	"io"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/aws/eks-anywhere/pkg/logger"
@@ -43,3 +45,115 @@ func TestInit(t *testing.T) {
 		t.Fatalf("Log file does not contain expected message: %s", message)
 	}
 }
+
+func captureStdout(t *testing.T, f func()) string {
+	t.Helper()
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	stdout := os.Stdout
+	os.Stdout = w
+	defer func() { os.Stdout = stdout }()
+
+	f()
+
+	w.Close()
+	out, err := io.ReadAll(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return string(out)
+}
+
+func TestInitSubsystemLevels(t *testing.T) {
+	out := captureStdout(t, func() {
+		err := logger.Init(logger.Options{
+			Level:           2,
+			SubsystemLevels: map[string]int{logger.Executables: 6},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		logger.V(2).Info("global info")
+		logger.V(6).Info("global debug")
+		logger.For(logger.Executables).V(6).Info("executables debug")
+		logger.For(logger.Providers).V(6).Info("providers debug")
+		logger.For(logger.Providers).V(2).Info("providers info")
+	})
+
+	for _, msg := range []string{"global info", "executables debug", "providers info"} {
+		if !strings.Contains(out, msg) {
+			t.Errorf("Output doesn't contain expected message %q:\n%s", msg, out)
+		}
+	}
+	for _, msg := range []string{"global debug", "providers debug"} {
+		if strings.Contains(out, msg) {
+			t.Errorf("Output contains unexpected message %q:\n%s", msg, out)
+		}
+	}
+}
+
+func TestInitJSONFormat(t *testing.T) {
+	out := captureStdout(t, func() {
+		if err := logger.Init(logger.Options{Format: logger.FormatJSON}); err != nil {
+			t.Fatal(err)
+		}
+
+		logger.For(logger.Workflows).Info("Creating cluster", "cluster", "test")
+	})
+
+	entry := map[string]interface{}{}
+	if err := json.Unmarshal([]byte(out), &entry); err != nil {
+		t.Fatalf("Output is not a json entry: %v\n%s", err, out)
+	}
+
+	want := map[string]interface{}{
+		"M":         "Creating cluster",
+		"L":         "V0",
+		"subsystem": logger.Workflows,
+		"cluster":   "test",
+	}
+	for k, v := range want {
+		if entry[k] != v {
+			t.Errorf("entry[%s] = %v, want %v", k, entry[k], v)
+		}
+	}
+	if entry["T"] == nil {
+		t.Errorf("Entry doesn't contain a timestamp: %v", entry)
+	}
+}
+
+func TestInitInvalidOptions(t *testing.T) {
+	tests := []struct {
+		name    string
+		opts    logger.Options
+		wantErr string
+	}{
+		{
+			name:    "format",
+			opts:    logger.Options{Format: "xml"},
+			wantErr: "invalid log format xml, supported formats are text and json",
+		},
+		{
+			name:    "subsystem",
+			opts:    logger.Options{SubsystemLevels: map[string]int{"network": 4}},
+			wantErr: "unknown log subsystem network, supported subsystems are executables, providers, workflows",
+		},
+		{
+			name:    "level",
+			opts:    logger.Options{SubsystemLevels: map[string]int{logger.Providers: 10}},
+			wantErr: "invalid log level 10 for subsystem providers, it must be between 0 and 9",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := logger.Init(tt.opts)
+			if err == nil || err.Error() != tt.wantErr {
+				t.Fatalf("Init() error = %v, want %s", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -54,38 +54,40 @@ func Fatal(err error, msg string) {
 // variable information. The key/value pairs should alternate string
 // keys and arbitrary values.
 func Info(msg string, keysAndValues ...interface{}) {
-	Get().Info(msg, keysAndValues...)
+	callerLogger(1).Info(msg, keysAndValues...)
 }
 
 // V returns an Logger value for a specific verbosity level, relative to
 // this Logger. In other words, V values are additive.  V higher verbosity
 // level means a log message is less important.  It's illegal to pass a log
 // level less than zero.
+//
+// Calls from the packages of a subsystem use the logger of the subsystem. See For().
 func V(level int) logr.Logger {
-	return Get().V(level)
+	return callerLogger(1).V(level)
 }
 
 // Error logs an error message using the package logger.
 func Error(err error, msg string, keysAndValues ...interface{}) {
-	Get().Error(err, msg, keysAndValues...)
+	callerLogger(1).Error(err, msg, keysAndValues...)
 }
 
 // MarkPass logs a message prefixed with a green check emoji.
 func MarkPass(msg string, keysAndValues ...interface{}) {
-	Get().V(0).Info(markPass+msg, keysAndValues...)
+	callerLogger(1).V(0).Info(markPass+msg, keysAndValues...)
 }
 
 // MarkSuccess logs a message prefixed with a popper emoji.
 func MarkSuccess(msg string, keysAndValues ...interface{}) {
-	Get().V(0).Info(markSuccess+msg, keysAndValues...)
+	callerLogger(1).V(0).Info(markSuccess+msg, keysAndValues...)
 }
 
 // MarkFail logs a message prefixed with a cross emoji.
 func MarkFail(msg string, keysAndValues ...interface{}) {
-	Get().V(0).Info(markFailed+msg, keysAndValues...)
+	callerLogger(1).V(0).Info(markFailed+msg, keysAndValues...)
 }
 
 // MarkWarning logs a message prefixed with a warning mark.
 func MarkWarning(msg string, keysAndValues ...interface{}) {
-	Get().V(0).Info(markWarning+msg, keysAndValues...)
+	callerLogger(1).V(0).Info(markWarning+msg, keysAndValues...)
 }
@@ -0,0 +1,166 @@
+package logger
+
+import (
+	"fmt"
+	"runtime"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/go-logr/logr"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+// Subsystems whose verbosity can be configured independently from the global log level.
+const (
+	Providers   = "providers"
+	Executables = "executables"
+	Workflows   = "workflows"
+)
+
+// subsystemPackages maps each subsystem to the package trees that log on its behalf.
+var subsystemPackages = map[string][]string{
+	Providers:   {"github.com/aws/eks-anywhere/pkg/providers"},
+	Executables: {"github.com/aws/eks-anywhere/pkg/executables"},
+	Workflows:   {"github.com/aws/eks-anywhere/pkg/workflows"},
+}
+
+// Subsystems returns the names of the subsystems.
+func Subsystems() []string {
+	names := make([]string, 0, len(subsystemPackages))
+	for name := range subsystemPackages {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+func validateSubsystemLevels(levels map[string]int) error {
+	for name, level := range levels {
+		if _, ok := subsystemPackages[name]; !ok {
+			return fmt.Errorf("unknown log subsystem %s, supported subsystems are %s", name, strings.Join(Subsystems(), ", "))
+		}
+		if level < 0 || level > MaxLogLevel {
+			return fmt.Errorf("invalid log level %d for subsystem %s, it must be between 0 and %d", level, name, MaxLogLevel)
+		}
+	}
+
+	return nil
+}
+
+var (
+	subsystemLoggers    map[string]logr.Logger
+	subsystemLoggersMtx sync.RWMutex
+)
+
+func setSubsystemLoggers(loggers map[string]logr.Logger) {
+	subsystemLoggersMtx.Lock()
+	defer subsystemLoggersMtx.Unlock()
+	subsystemLoggers = loggers
+}
+
+// For returns the logger of a subsystem. If the package logger hasn't been configured with
+// subsystems, it returns the package logger.
+func For(subsystem string) logr.Logger {
+	subsystemLoggersMtx.RLock()
+	defer subsystemLoggersMtx.RUnlock()
+	if l, ok := subsystemLoggers[subsystem]; ok {
+		return l
+	}
+
+	return Get()
+}
+
+// callerLogger returns the logger of the subsystem of the function calling the package
+// function, skip frames above. Callers outside any subsystem get the package logger.
+func callerLogger(skip int) logr.Logger {
+	subsystemLoggersMtx.RLock()
+	enabled := len(subsystemLoggers) > 0
+	subsystemLoggersMtx.RUnlock()
+	if !enabled {
+		return Get()
+	}
+
+	pc, _, _, ok := runtime.Caller(skip + 1)
+	if !ok {
+		return Get()
+	}
+	fn := runtime.FuncForPC(pc)
+	if fn == nil {
+		return Get()
+	}
+
+	return For(subsystemForFunc(fn.Name()))
+}
+
+// subsystemForFunc returns the subsystem of a fully qualified function name, like
+// github.com/aws/eks-anywhere/pkg/executables.(*Kubectl).Apply.
+func subsystemForFunc(name string) string {
+	for subsystem, packages := range subsystemPackages {
+		for _, pkg := range packages {
+			if strings.HasPrefix(name, pkg+".") || strings.HasPrefix(name, pkg+"/") {
+				return subsystem
+			}
+		}
+	}
+
+	return ""
+}
+
+// subsystemCore filters entries by the log level of the subsystem named in the entry logger
+// name, falling back to the global level.
+type subsystemCore struct {
+	zapcore.Core
+	level           zapcore.Level
+	subsystemLevels map[string]zapcore.Level
+}
+
+func newSubsystemCore(core zapcore.Core, level int, subsystemLevels map[string]int) zapcore.Core {
+	c := &subsystemCore{
+		Core:            core,
+		level:           logrLevel(level),
+		subsystemLevels: make(map[string]zapcore.Level, len(subsystemLevels)),
+	}
+	for name, l := range subsystemLevels {
+		c.subsystemLevels[name] = logrLevel(l)
+	}
+
+	return c
+}
+
+func (c *subsystemCore) With(fields []zapcore.Field) zapcore.Core {
+	return &subsystemCore{
+		Core:            c.Core.With(fields),
+		level:           c.level,
+		subsystemLevels: c.subsystemLevels,
+	}
+}
+
+func (c *subsystemCore) Check(entry zapcore.Entry, checked *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	level, ok := c.subsystemLevels[entry.LoggerName]
+	if !ok {
+		level = c.level
+	}
+	if !level.Enabled(entry.Level) {
+		return checked
+	}
+
+	return c.Core.Check(entry, checked)
+}
+
+// newSubsystemLoggers returns a logger per subsystem, named after it.
+func newSubsystemLoggers(logger *zap.Logger, newLogr func(*zap.Logger) logr.Logger) map[string]logr.Logger {
+	loggers := make(map[string]logr.Logger, len(subsystemPackages))
+	for name := range subsystemPackages {
+		loggers[name] = newLogr(logger.Named(name))
+	}
+
+	return loggers
+}
+
+// logrLevel converts a go-logr verbosity to the zap level the go-logr wrapper logs at.
+func logrLevel(level int) zapcore.Level {
+	return zapcore.Level(-level)
+}
@@ -0,0 +1,19 @@
+package logger
+
+import "testing"
+
+func TestSubsystemForFunc(t *testing.T) {
+	tests := map[string]string{
+		"github.com/aws/eks-anywhere/pkg/executables.(*Kubectl).Apply":               Executables,
+		"github.com/aws/eks-anywhere/pkg/providers/vsphere.(*vsphereProvider).Name":  Providers,
+		"github.com/aws/eks-anywhere/pkg/providers.ConfigsMapToSlice":                Providers,
+		"github.com/aws/eks-anywhere/pkg/workflows/management.(*Create).Run":         Workflows,
+		"github.com/aws/eks-anywhere/pkg/providerscustom.Func":                       "",
+		"github.com/aws/eks-anywhere/pkg/clustermanager.(*ClusterManager).Something": "",
+	}
+	for name, want := range tests {
+		if got := subsystemForFunc(name); got != want {
+			t.Errorf("subsystemForFunc(%s) = %q, want %q", name, got, want)
+		}
+	}
+}
@@ -0,0 +1,172 @@
+package managementbackup
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/yaml"
+
+	"github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/clients/kubernetes"
+	unstructuredutil "github.com/aws/eks-anywhere/pkg/utils/unstructured"
+	releasev1 "github.com/aws/eks-anywhere/release/api/v1alpha1"
+)
+
+const (
+	// FormatVersion is the version of the backup archive format.
+	FormatVersion = "v1"
+
+	metadataFileName = "metadata.yaml"
+	releasesFileName = "releases.yaml"
+	eksaFolder       = "eksa"
+	capiFolder       = "capi"
+)
+
+// Metadata describes the content of a management cluster backup archive.
+type Metadata struct {
+	FormatVersion     string       `json:"formatVersion"`
+	CLIVersion        string       `json:"cliVersion"`
+	ManagementCluster string       `json:"managementCluster"`
+	CreatedAt         metav1.Time  `json:"createdAt"`
+	Clusters          []ClusterRef `json:"clusters"`
+}
+
+// ClusterRef identifies a cluster saved in a backup archive.
+type ClusterRef struct {
+	Name      string `json:"name"`
+	Namespace string `json:"namespace"`
+}
+
+// WorkloadClusters returns the clusters of the backup managed by the management cluster.
+func (m *Metadata) WorkloadClusters() []ClusterRef {
+	clusters := make([]ClusterRef, 0, len(m.Clusters))
+	for _, c := range m.Clusters {
+		if c.Name != m.ManagementCluster {
+			clusters = append(clusters, c)
+		}
+	}
+
+	return clusters
+}
+
+// ClusterConfigPath returns the path of the EKS Anywhere objects of a cluster in an extracted backup archive.
+func ClusterConfigPath(folder, clusterName string) string {
+	return filepath.Join(folder, eksaFolder, clusterName+".yaml")
+}
+
+func capiPath(folder, clusterName string) string {
+	return filepath.Join(folder, capiFolder, clusterName)
+}
+
+func releasesPath(folder string) string {
+	return filepath.Join(folder, eksaFolder, releasesFileName)
+}
+
+func writeMetadata(folder string, m *Metadata) error {
+	content, err := yaml.Marshal(m)
+	if err != nil {
+		return fmt.Errorf("marshalling backup metadata: %v", err)
+	}
+
+	return os.WriteFile(filepath.Join(folder, metadataFileName), content, 0o600)
+}
+
+func readMetadata(folder string) (*Metadata, error) {
+	content, err := os.ReadFile(filepath.Join(folder, metadataFileName))
+	if err != nil {
+		return nil, fmt.Errorf("reading backup metadata: %v", err)
+	}
+
+	m := &Metadata{}
+	if err := yaml.Unmarshal(content, m); err != nil {
+		return nil, fmt.Errorf("parsing backup metadata: %v", err)
+	}
+
+	if m.FormatVersion != FormatVersion {
+		return nil, fmt.Errorf("unsupported backup format version %q, expected %q", m.FormatVersion, FormatVersion)
+	}
+
+	return m, nil
+}
+
+// writeObjects writes objects as a multi-document yaml file, without the fields set by
+// the API server, so they can be created again in another cluster.
+func writeObjects(path string, objs []kubernetes.Object) error {
+	items := make([]unstructured.Unstructured, 0, len(objs))
+	for _, obj := range objs {
+		u, err := toCleanUnstructured(obj)
+		if err != nil {
+			return err
+		}
+		items = append(items, *u)
+	}
+
+	content, err := unstructuredutil.UnstructuredToYaml(items)
+	if err != nil {
+		return fmt.Errorf("marshalling objects: %v", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, content, 0o600)
+}
+
+func readObjects(path string) ([]unstructured.Unstructured, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	objs, err := unstructuredutil.YamlToUnstructured(content)
+	if err != nil {
+		return nil, fmt.Errorf("parsing objects in %s: %v", path, err)
+	}
+
+	return objs, nil
+}
+
+var backupScheme = newBackupScheme()
+
+func newBackupScheme() *runtime.Scheme {
+	s := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(s)
+	_ = v1alpha1.AddToScheme(s)
+	_ = releasev1.AddToScheme(s)
+	return s
+}
+
+func toCleanUnstructured(obj kubernetes.Object) (*unstructured.Unstructured, error) {
+	gvk := obj.GetObjectKind().GroupVersionKind()
+	if gvk.Empty() {
+		var err error
+		gvk, err = apiutil.GVKForObject(obj, backupScheme)
+		if err != nil {
+			return nil, fmt.Errorf("getting kind of %s: %v", obj.GetName(), err)
+		}
+	}
+
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return nil, fmt.Errorf("converting %s %s: %v", gvk.Kind, obj.GetName(), err)
+	}
+
+	u := &unstructured.Unstructured{Object: content}
+	u.SetGroupVersionKind(gvk)
+	u.SetResourceVersion("")
+	u.SetUID("")
+	u.SetGeneration(0)
+	u.SetManagedFields(nil)
+	u.SetOwnerReferences(nil)
+	unstructured.RemoveNestedField(u.Object, "metadata", "creationTimestamp")
+	unstructured.RemoveNestedField(u.Object, "status")
+
+	return u, nil
+}
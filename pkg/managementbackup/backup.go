@@ -0,0 +1,166 @@
+package managementbackup
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/clients/kubernetes"
+	"github.com/aws/eks-anywhere/pkg/cluster"
+	"github.com/aws/eks-anywhere/pkg/logger"
+	"github.com/aws/eks-anywhere/pkg/tar"
+	"github.com/aws/eks-anywhere/pkg/types"
+	releasev1 "github.com/aws/eks-anywhere/release/api/v1alpha1"
+)
+
+// CAPIClient saves and restores the CAPI objects of clusters.
+type CAPIClient interface {
+	BackupManagement(ctx context.Context, cluster *types.Cluster, managementStatePath, clusterName string) error
+	RestoreManagement(ctx context.Context, cluster *types.Cluster, backupPath string) error
+}
+
+// Backupper saves the state of a management cluster to an archive: the CAPI objects and the
+// EKS Anywhere objects of the management cluster and of all its workload clusters, and the
+// EKS Anywhere releases they use.
+type Backupper struct {
+	client     kubernetes.Client
+	capi       CAPIClient
+	cliVersion string
+	now        func() time.Time
+}
+
+// NewBackupper builds a Backupper. The client must point to the management cluster.
+func NewBackupper(client kubernetes.Client, capi CAPIClient, cliVersion string) *Backupper {
+	return &Backupper{
+		client:     client,
+		capi:       capi,
+		cliVersion: cliVersion,
+		now:        time.Now,
+	}
+}
+
+// Backup writes the backup archive of the management cluster to archivePath.
+func (b *Backupper) Backup(ctx context.Context, managementCluster *types.Cluster, archivePath string) (*Metadata, error) {
+	clusters, err := b.clusters(ctx, managementCluster.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	now := b.now()
+	// The CAPI backups are written by clusterctl relative to the management cluster folder.
+	statePath := fmt.Sprintf("management-backup-%s", now.Format("2006-01-02T15_04_05"))
+	folder := filepath.Join(managementCluster.Name, statePath)
+	// The backup contains Secrets, so it's only readable by the current user.
+	if err := os.MkdirAll(folder, 0o700); err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(folder)
+
+	metadata := &Metadata{
+		FormatVersion:     FormatVersion,
+		CLIVersion:        b.cliVersion,
+		ManagementCluster: managementCluster.Name,
+		CreatedAt:         metav1.NewTime(now.UTC()),
+	}
+
+	for i := range clusters {
+		c := &clusters[i]
+		logger.V(3).Info("Saving cluster", "cluster", c.Name)
+		config, err := cluster.NewDefaultConfigClientBuilder().Build(ctx, b.client, c)
+		if err != nil {
+			return nil, fmt.Errorf("reading EKS Anywhere objects of cluster %s: %v", c.Name, err)
+		}
+
+		// Children go first so the Cluster references existing objects when created again.
+		objs := append(config.ChildObjects(), config.Cluster)
+		if err := writeObjects(ClusterConfigPath(folder, c.Name), objs); err != nil {
+			return nil, fmt.Errorf("saving EKS Anywhere objects of cluster %s: %v", c.Name, err)
+		}
+
+		if err := b.capi.BackupManagement(ctx, managementCluster, filepath.Join(statePath, capiFolder, c.Name), c.Name); err != nil {
+			return nil, fmt.Errorf("saving CAPI objects of cluster %s: %v", c.Name, err)
+		}
+
+		metadata.Clusters = append(metadata.Clusters, ClusterRef{Name: c.Name, Namespace: c.Namespace})
+	}
+
+	if err := b.backupReleases(ctx, folder); err != nil {
+		return nil, err
+	}
+
+	if err := writeMetadata(folder, metadata); err != nil {
+		return nil, err
+	}
+
+	if err := tar.GzipTarFolder(folder, archivePath); err != nil {
+		return nil, fmt.Errorf("writing backup archive %s: %v", archivePath, err)
+	}
+	if err := os.Chmod(archivePath, 0o600); err != nil {
+		return nil, fmt.Errorf("restricting permissions of backup archive %s: %v", archivePath, err)
+	}
+
+	return metadata, nil
+}
+
+// clusters returns the management cluster followed by its workload clusters.
+func (b *Backupper) clusters(ctx context.Context, managementClusterName string) ([]v1alpha1.Cluster, error) {
+	list := &v1alpha1.ClusterList{}
+	if err := b.client.List(ctx, list); err != nil {
+		return nil, fmt.Errorf("listing clusters: %v", err)
+	}
+
+	var management *v1alpha1.Cluster
+	var workloads []v1alpha1.Cluster
+	for i := range list.Items {
+		c := list.Items[i]
+		switch {
+		case c.Name == managementClusterName:
+			management = &c
+		case c.ManagedBy() == managementClusterName:
+			workloads = append(workloads, c)
+		}
+	}
+
+	if management == nil {
+		return nil, fmt.Errorf("cluster %s not found", managementClusterName)
+	}
+	if !management.IsSelfManaged() {
+		return nil, fmt.Errorf("cluster %s is not a management cluster", managementClusterName)
+	}
+
+	sort.Slice(workloads, func(i, j int) bool { return workloads[i].Name < workloads[j].Name })
+
+	return append([]v1alpha1.Cluster{*management}, workloads...), nil
+}
+
+func (b *Backupper) backupReleases(ctx context.Context, folder string) error {
+	releases := &releasev1.EKSAReleaseList{}
+	if err := b.client.List(ctx, releases); err != nil {
+		return fmt.Errorf("listing EKSAReleases: %v", err)
+	}
+
+	bundles := &releasev1.BundlesList{}
+	if err := b.client.List(ctx, bundles); err != nil {
+		return fmt.Errorf("listing Bundles: %v", err)
+	}
+
+	objs := make([]kubernetes.Object, 0, len(releases.Items)+len(bundles.Items))
+	for i := range bundles.Items {
+		objs = append(objs, &bundles.Items[i])
+	}
+	for i := range releases.Items {
+		objs = append(objs, &releases.Items[i])
+	}
+
+	if err := writeObjects(releasesPath(folder), objs); err != nil {
+		return fmt.Errorf("saving EKS Anywhere releases: %v", err)
+	}
+
+	return nil
+}
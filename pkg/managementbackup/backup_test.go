@@ -0,0 +1,202 @@
+package managementbackup_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/aws/eks-anywhere/internal/test"
+	"github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/constants"
+	"github.com/aws/eks-anywhere/pkg/managementbackup"
+	"github.com/aws/eks-anywhere/pkg/managementbackup/mocks"
+	"github.com/aws/eks-anywhere/pkg/types"
+	releasev1 "github.com/aws/eks-anywhere/release/api/v1alpha1"
+)
+
+type backupTest struct {
+	*WithT
+	ctx        context.Context
+	capi       *mocks.MockCAPIClient
+	management *types.Cluster
+}
+
+func newBackupTest(t *testing.T) *backupTest {
+	t.Chdir(t.TempDir())
+	ctrl := gomock.NewController(t)
+	return &backupTest{
+		WithT:      NewWithT(t),
+		ctx:        context.Background(),
+		capi:       mocks.NewMockCAPIClient(ctrl),
+		management: &types.Cluster{Name: "mgmt", KubeconfigFile: "mgmt/mgmt-eks-a-cluster.kubeconfig"},
+	}
+}
+
+func dockerCluster(name, managementCluster string) *v1alpha1.Cluster {
+	return &v1alpha1.Cluster{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: v1alpha1.GroupVersion.String(),
+			Kind:       v1alpha1.ClusterKind,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            name,
+			Namespace:       "default",
+			ResourceVersion: "12",
+			UID:             "3b1c8b8e-1d0e-4e0a-8d38-6b2a7f1b9d4e",
+		},
+		Spec: v1alpha1.ClusterSpec{
+			KubernetesVersion: v1alpha1.Kube133,
+			ManagementCluster: v1alpha1.ManagementCluster{Name: managementCluster},
+			DatacenterRef: v1alpha1.Ref{
+				Kind: v1alpha1.DockerDatacenterKind,
+				Name: name,
+			},
+		},
+		Status: v1alpha1.ClusterStatus{
+			ObservedGeneration: 3,
+		},
+	}
+}
+
+func dockerDatacenter(name string) *v1alpha1.DockerDatacenterConfig {
+	return &v1alpha1.DockerDatacenterConfig{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: v1alpha1.GroupVersion.String(),
+			Kind:       v1alpha1.DockerDatacenterKind,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "default",
+		},
+	}
+}
+
+func releaseObjects() []client.Object {
+	return []client.Object{
+		&releasev1.Bundles{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: releasev1.GroupVersion.String(),
+				Kind:       "Bundles",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "bundles-1",
+				Namespace: constants.EksaSystemNamespace,
+			},
+		},
+		&releasev1.EKSARelease{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: releasev1.GroupVersion.String(),
+				Kind:       releasev1.EKSAReleaseKind,
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "eksa-v0-24-0",
+				Namespace: constants.EksaSystemNamespace,
+			},
+			Spec: releasev1.EKSAReleaseSpec{
+				BundlesRef: releasev1.BundlesRef{Name: "bundles-1", Namespace: constants.EksaSystemNamespace},
+			},
+		},
+	}
+}
+
+// expectCAPIBackup writes a file in the CAPI backup folder, like clusterctl does.
+func (tt *backupTest) expectCAPIBackup(clusterName string) {
+	tt.capi.EXPECT().BackupManagement(tt.ctx, tt.management, gomock.Any(), clusterName).DoAndReturn(
+		func(_ context.Context, cluster *types.Cluster, managementStatePath, _ string) error {
+			folder := filepath.Join(cluster.Name, managementStatePath)
+			if err := os.MkdirAll(folder, os.ModePerm); err != nil {
+				return err
+			}
+			return os.WriteFile(filepath.Join(folder, "Cluster_eksa-system_"+clusterName+".yaml"), []byte("kind: Cluster"), 0o644)
+		},
+	)
+}
+
+func TestBackupperBackup(t *testing.T) {
+	tt := newBackupTest(t)
+	objs := append(releaseObjects(),
+		dockerCluster("mgmt", "mgmt"), dockerDatacenter("mgmt"),
+		dockerCluster("w02", "mgmt"), dockerDatacenter("w02"),
+		dockerCluster("w01", "mgmt"), dockerDatacenter("w01"),
+		dockerCluster("other", "other-mgmt"), dockerDatacenter("other"),
+	)
+	kubeClient := test.NewFakeKubeClient(objs...)
+
+	tt.expectCAPIBackup("mgmt")
+	tt.expectCAPIBackup("w01")
+	tt.expectCAPIBackup("w02")
+
+	b := managementbackup.NewBackupper(kubeClient, tt.capi, "v0.24.0")
+	metadata, err := b.Backup(tt.ctx, tt.management, "backup.tar.gz")
+	tt.Expect(err).NotTo(HaveOccurred())
+	tt.Expect(metadata.FormatVersion).To(Equal(managementbackup.FormatVersion))
+	tt.Expect(metadata.CLIVersion).To(Equal("v0.24.0"))
+	tt.Expect(metadata.ManagementCluster).To(Equal("mgmt"))
+	tt.Expect(metadata.Clusters).To(Equal([]managementbackup.ClusterRef{
+		{Name: "mgmt", Namespace: "default"},
+		{Name: "w01", Namespace: "default"},
+		{Name: "w02", Namespace: "default"},
+	}))
+	tt.Expect(metadata.WorkloadClusters()).To(HaveLen(2))
+
+	info, err := os.Stat("backup.tar.gz")
+	tt.Expect(err).NotTo(HaveOccurred())
+	tt.Expect(info.Mode().Perm()).To(Equal(os.FileMode(0o600)))
+
+	read, folder, err := managementbackup.ReadMetadata("backup.tar.gz", "mgmt", time.Now())
+	tt.Expect(err).NotTo(HaveOccurred())
+	tt.Expect(read.Clusters).To(Equal(metadata.Clusters))
+	tt.Expect(filepath.Join(folder, "capi", "w01", "Cluster_eksa-system_w01.yaml")).To(BeAnExistingFile())
+	info, err = os.Stat(folder)
+	tt.Expect(err).NotTo(HaveOccurred())
+	tt.Expect(info.Mode().Perm()).To(Equal(os.FileMode(0o700)))
+
+	content, err := os.ReadFile(managementbackup.ClusterConfigPath(folder, "w01"))
+	tt.Expect(err).NotTo(HaveOccurred())
+	tt.Expect(string(content)).To(ContainSubstring("kind: DockerDatacenterConfig"))
+	tt.Expect(string(content)).To(ContainSubstring("kind: Cluster"))
+	tt.Expect(string(content)).NotTo(ContainSubstring("resourceVersion"))
+	tt.Expect(string(content)).NotTo(ContainSubstring("uid:"))
+	tt.Expect(string(content)).NotTo(ContainSubstring("observedGeneration"))
+	backupFolders, err := filepath.Glob(filepath.Join("mgmt", "management-backup-*"))
+	tt.Expect(err).NotTo(HaveOccurred())
+	tt.Expect(backupFolders).To(BeEmpty())
+}
+
+func TestBackupperBackupNotManagementCluster(t *testing.T) {
+	tt := newBackupTest(t)
+	kubeClient := test.NewFakeKubeClient(dockerCluster("mgmt", "other-mgmt"), dockerDatacenter("mgmt"))
+
+	b := managementbackup.NewBackupper(kubeClient, tt.capi, "v0.24.0")
+	_, err := b.Backup(tt.ctx, tt.management, "backup.tar.gz")
+	tt.Expect(err).To(MatchError("cluster mgmt is not a management cluster"))
+}
+
+func TestBackupperBackupClusterNotFound(t *testing.T) {
+	tt := newBackupTest(t)
+	kubeClient := test.NewFakeKubeClient()
+
+	b := managementbackup.NewBackupper(kubeClient, tt.capi, "v0.24.0")
+	_, err := b.Backup(tt.ctx, tt.management, "backup.tar.gz")
+	tt.Expect(err).To(MatchError("cluster mgmt not found"))
+}
+
+func TestBackupperBackupCAPIError(t *testing.T) {
+	tt := newBackupTest(t)
+	kubeClient := test.NewFakeKubeClient(append(releaseObjects(), dockerCluster("mgmt", "mgmt"), dockerDatacenter("mgmt"))...)
+
+	tt.capi.EXPECT().BackupManagement(tt.ctx, tt.management, gomock.Any(), "mgmt").Return(errors.New("move failed"))
+
+	b := managementbackup.NewBackupper(kubeClient, tt.capi, "v0.24.0")
+	_, err := b.Backup(tt.ctx, tt.management, "backup.tar.gz")
+	tt.Expect(err).To(MatchError(ContainSubstring("saving CAPI objects of cluster mgmt: move failed")))
+	tt.Expect("backup.tar.gz").NotTo(BeAnExistingFile())
+}
@@ -0,0 +1,177 @@
+package managementbackup
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/clients/kubernetes"
+	"github.com/aws/eks-anywhere/pkg/logger"
+	"github.com/aws/eks-anywhere/pkg/tar"
+	"github.com/aws/eks-anywhere/pkg/types"
+)
+
+// Restorer rebuilds the workload clusters of a lost management cluster in a new management cluster,
+// created with the same name from the cluster config saved in the backup archive.
+type Restorer struct {
+	client kubernetes.Client
+	capi   CAPIClient
+	now    func() time.Time
+}
+
+// NewRestorer builds a Restorer. The client must point to the new management cluster.
+func NewRestorer(client kubernetes.Client, capi CAPIClient) *Restorer {
+	return &Restorer{
+		client: client,
+		capi:   capi,
+		now:    time.Now,
+	}
+}
+
+// ReadMetadata extracts the backup archive in the management cluster folder and returns its metadata
+// and the folder it was extracted to.
+func ReadMetadata(archivePath, managementClusterName string, now time.Time) (*Metadata, string, error) {
+	folder := filepath.Join(managementClusterName, fmt.Sprintf("management-restore-%s", now.Format("2006-01-02T15_04_05")))
+	if err := os.MkdirAll(folder, 0o700); err != nil {
+		return nil, "", err
+	}
+	if err := tar.UnGzipTarFile(archivePath, folder); err != nil {
+		return nil, "", fmt.Errorf("extracting backup archive %s: %v", archivePath, err)
+	}
+
+	m, err := readMetadata(folder)
+	if err != nil {
+		os.RemoveAll(folder)
+		return nil, "", err
+	}
+
+	return m, folder, nil
+}
+
+// Restore restores the workload clusters of the backup archive in the management cluster: first
+// the EKS Anywhere releases they use, then, for each cluster, its CAPI objects, so it's adopted
+// without recreating its machines, and its EKS Anywhere objects. Clusters that already exist in
+// the management cluster are skipped. It returns the names of the restored clusters.
+func (r *Restorer) Restore(ctx context.Context, managementCluster *types.Cluster, archivePath string) ([]string, error) {
+	metadata, folder, err := ReadMetadata(archivePath, managementCluster.Name, r.now())
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(folder)
+
+	if metadata.ManagementCluster != managementCluster.Name {
+		return nil, fmt.Errorf(
+			"the archive is a backup of management cluster %s, create the new management cluster with the same name from the cluster config in the archive",
+			metadata.ManagementCluster,
+		)
+	}
+
+	if err := r.validateManagementCluster(ctx, metadata); err != nil {
+		return nil, err
+	}
+
+	if err := r.createObjects(ctx, releasesPath(folder)); err != nil {
+		return nil, fmt.Errorf("restoring EKS Anywhere releases: %v", err)
+	}
+
+	var restored []string
+	for _, c := range metadata.WorkloadClusters() {
+		err := r.client.Get(ctx, c.Name, c.Namespace, &v1alpha1.Cluster{})
+		if err == nil {
+			logger.Info("Cluster already exists in the management cluster, skipping", "cluster", c.Name)
+			continue
+		}
+		if !apierrors.IsNotFound(err) {
+			return restored, fmt.Errorf("reading cluster %s: %v", c.Name, err)
+		}
+
+		logger.V(3).Info("Restoring cluster", "cluster", c.Name)
+		if err := r.capi.RestoreManagement(ctx, managementCluster, capiPath(folder, c.Name)); err != nil {
+			return restored, fmt.Errorf("restoring CAPI objects of cluster %s: %v", c.Name, err)
+		}
+
+		if err := r.createNamespace(ctx, c.Namespace); err != nil {
+			return restored, err
+		}
+
+		if err := r.createObjects(ctx, ClusterConfigPath(folder, c.Name)); err != nil {
+			return restored, fmt.Errorf("restoring EKS Anywhere objects of cluster %s: %v", c.Name, err)
+		}
+
+		restored = append(restored, c.Name)
+	}
+
+	return restored, nil
+}
+
+func (r *Restorer) validateManagementCluster(ctx context.Context, metadata *Metadata) error {
+	for _, c := range metadata.Clusters {
+		if c.Name != metadata.ManagementCluster {
+			continue
+		}
+
+		cluster := &v1alpha1.Cluster{}
+		if err := r.client.Get(ctx, c.Name, c.Namespace, cluster); err != nil {
+			return fmt.Errorf("reading management cluster %s, it must be created before restoring the backup: %v", c.Name, err)
+		}
+		if !cluster.IsSelfManaged() {
+			return fmt.Errorf("cluster %s is not a management cluster", c.Name)
+		}
+
+		return nil
+	}
+
+	return fmt.Errorf("management cluster %s is missing in the backup", metadata.ManagementCluster)
+}
+
+func (r *Restorer) createNamespace(ctx context.Context, name string) error {
+	if name == "" {
+		return nil
+	}
+
+	ns := &corev1.Namespace{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "v1",
+			Kind:       "Namespace",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+		},
+	}
+	if err := r.client.Create(ctx, ns); err != nil && !apierrors.IsAlreadyExists(err) {
+		return fmt.Errorf("creating namespace %s: %v", name, err)
+	}
+
+	return nil
+}
+
+func (r *Restorer) createObjects(ctx context.Context, path string) error {
+	objs, err := readObjects(path)
+	if err != nil {
+		return err
+	}
+
+	for i := range objs {
+		if err := r.createObject(ctx, &objs[i]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *Restorer) createObject(ctx context.Context, obj *unstructured.Unstructured) error {
+	if err := r.client.Create(ctx, obj); err != nil && !apierrors.IsAlreadyExists(err) {
+		return fmt.Errorf("creating %s %s: %v", obj.GetKind(), obj.GetName(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,102 @@
+package managementbackup_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/aws/eks-anywhere/internal/test"
+	"github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/constants"
+	"github.com/aws/eks-anywhere/pkg/managementbackup"
+	releasev1 "github.com/aws/eks-anywhere/release/api/v1alpha1"
+)
+
+// writeBackup builds a backup archive of a management cluster with workload clusters w01 and w02.
+func (tt *backupTest) writeBackup(archivePath string) {
+	objs := append(releaseObjects(),
+		dockerCluster("mgmt", "mgmt"), dockerDatacenter("mgmt"),
+		dockerCluster("w01", "mgmt"), dockerDatacenter("w01"),
+		dockerCluster("w02", "mgmt"), dockerDatacenter("w02"),
+	)
+	tt.expectCAPIBackup("mgmt")
+	tt.expectCAPIBackup("w01")
+	tt.expectCAPIBackup("w02")
+
+	b := managementbackup.NewBackupper(test.NewFakeKubeClient(objs...), tt.capi, "v0.24.0")
+	_, err := b.Backup(tt.ctx, tt.management, archivePath)
+	tt.Expect(err).NotTo(HaveOccurred())
+}
+
+func TestRestorerRestore(t *testing.T) {
+	tt := newBackupTest(t)
+	tt.writeBackup("backup.tar.gz")
+
+	kubeClient := test.NewFakeKubeClient(dockerCluster("mgmt", "mgmt"), dockerDatacenter("mgmt"), dockerCluster("w02", "mgmt"))
+	tt.capi.EXPECT().RestoreManagement(tt.ctx, tt.management, gomock.Any()).Return(nil)
+
+	r := managementbackup.NewRestorer(kubeClient, tt.capi)
+	restored, err := r.Restore(tt.ctx, tt.management, "backup.tar.gz")
+	tt.Expect(err).NotTo(HaveOccurred())
+	tt.Expect(restored).To(Equal([]string{"w01"}))
+
+	tt.Expect(kubeClient.Get(tt.ctx, "w01", "default", &v1alpha1.Cluster{})).To(Succeed())
+	tt.Expect(kubeClient.Get(tt.ctx, "w01", "default", &v1alpha1.DockerDatacenterConfig{})).To(Succeed())
+	tt.Expect(kubeClient.Get(tt.ctx, "bundles-1", constants.EksaSystemNamespace, &releasev1.Bundles{})).To(Succeed())
+	tt.Expect(kubeClient.Get(tt.ctx, "eksa-v0-24-0", constants.EksaSystemNamespace, &releasev1.EKSARelease{})).To(Succeed())
+	err = kubeClient.Get(tt.ctx, "w02", "default", &v1alpha1.DockerDatacenterConfig{})
+	tt.Expect(apierrors.IsNotFound(err)).To(BeTrue(), "existing clusters should be skipped")
+}
+
+func TestRestorerRestoreDifferentManagementCluster(t *testing.T) {
+	tt := newBackupTest(t)
+	tt.writeBackup("backup.tar.gz")
+
+	r := managementbackup.NewRestorer(test.NewFakeKubeClient(), tt.capi)
+	tt.management.Name = "other-mgmt"
+	_, err := r.Restore(tt.ctx, tt.management, "backup.tar.gz")
+	tt.Expect(err).To(MatchError(ContainSubstring("the archive is a backup of management cluster mgmt")))
+}
+
+func TestRestorerRestoreMissingManagementCluster(t *testing.T) {
+	tt := newBackupTest(t)
+	tt.writeBackup("backup.tar.gz")
+
+	r := managementbackup.NewRestorer(test.NewFakeKubeClient(), tt.capi)
+	_, err := r.Restore(tt.ctx, tt.management, "backup.tar.gz")
+	tt.Expect(err).To(MatchError(ContainSubstring("reading management cluster mgmt, it must be created before restoring the backup")))
+}
+
+func TestRestorerRestoreNotManagementCluster(t *testing.T) {
+	tt := newBackupTest(t)
+	tt.writeBackup("backup.tar.gz")
+
+	r := managementbackup.NewRestorer(test.NewFakeKubeClient(dockerCluster("mgmt", "other-mgmt")), tt.capi)
+	_, err := r.Restore(tt.ctx, tt.management, "backup.tar.gz")
+	tt.Expect(err).To(MatchError("cluster mgmt is not a management cluster"))
+}
+
+func TestRestorerRestoreCAPIError(t *testing.T) {
+	tt := newBackupTest(t)
+	tt.writeBackup("backup.tar.gz")
+
+	objs := []client.Object{dockerCluster("mgmt", "mgmt"), dockerDatacenter("mgmt")}
+	tt.capi.EXPECT().RestoreManagement(tt.ctx, tt.management, gomock.Any()).Return(errors.New("move failed"))
+
+	r := managementbackup.NewRestorer(test.NewFakeKubeClient(objs...), tt.capi)
+	restored, err := r.Restore(tt.ctx, tt.management, "backup.tar.gz")
+	tt.Expect(err).To(MatchError("restoring CAPI objects of cluster w01: move failed"))
+	tt.Expect(restored).To(BeEmpty())
+}
+
+func TestRestorerRestoreInvalidArchive(t *testing.T) {
+	tt := newBackupTest(t)
+
+	r := managementbackup.NewRestorer(test.NewFakeKubeClient(), tt.capi)
+	_, err := r.Restore(tt.ctx, tt.management, "missing.tar.gz")
+	tt.Expect(err).To(MatchError(ContainSubstring("extracting backup archive missing.tar.gz")))
+}
@@ -2,6 +2,7 @@ package releases
 
 import (
 	"fmt"
+	"os"
 
 	"github.com/pkg/errors"
 	"sigs.k8s.io/yaml"
@@ -12,11 +13,21 @@ import (
 	releasev1 "github.com/aws/eks-anywhere/release/api/v1alpha1"
 )
 
+// ManifestURLEnv is the env var used to override the url of the eksa releases manifest.
+// It allows to read the manifest from a mirror or a local file in disconnected environments.
+const ManifestURLEnv = "EKSA_RELEASE_MANIFEST_URL"
+
 // manifestURL holds the url to the eksa releases manifest
 // this is injected at build time, this is just a sane default for development.
 var manifestURL = "https://dev-release-assets.eks-anywhere.model-rocket.aws.dev/eks-a-release.yaml"
 
+// ManifestURL returns the url to the eksa releases manifest. If EKSA_RELEASE_MANIFEST_URL is set,
+// its value takes precedence over the url injected at build time.
 func ManifestURL() string {
+	if url := os.Getenv(ManifestURLEnv); url != "" {
+		return url
+	}
+
 	return manifestURL
 }
 
@@ -248,3 +248,29 @@ func TestBundleManifestURL(t *testing.T) {
 		})
 	}
 }
+
+func TestManifestURLFromEnv(t *testing.T) {
+	g := NewWithT(t)
+	t.Setenv(releases.ManifestURLEnv, "https://mirror.local/eks-a-release.yaml")
+
+	g.Expect(releases.ManifestURL()).To(Equal("https://mirror.local/eks-a-release.yaml"))
+}
+
+func TestReadReleasesFromMirroredManifest(t *testing.T) {
+	g := NewWithT(t)
+	ctrl := gomock.NewController(t)
+	reader := mocks.NewMockReader(ctrl)
+	url := "/mirror/eks-a-release.yaml"
+	t.Setenv(releases.ManifestURLEnv, url)
+
+	manifest := `apiVersion: anywhere.eks.amazonaws.com/v1alpha1
+kind: Release
+metadata:
+  name: release-1`
+
+	reader.EXPECT().ReadFile(url).Return([]byte(manifest), nil)
+
+	release, err := releases.ReadReleases(reader)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(release.Name).To(Equal("release-1"))
+}
@@ -0,0 +1,64 @@
+package calico
+
+import (
+	"context"
+
+	appsv1 "k8s.io/api/apps/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/aws/eks-anywhere/pkg/constants"
+)
+
+const (
+	// DaemonSetName is the name of the Calico node DaemonSet installed in EKS-A clusters.
+	DaemonSetName = "calico-node"
+	// KubeControllersDeploymentName is the name of the Calico kube-controllers Deployment
+	// installed in EKS-A clusters.
+	KubeControllersDeploymentName = "calico-kube-controllers"
+
+	nodeContainerName            = "calico-node"
+	kubeControllersContainerName = "calico-kube-controllers"
+	namespace                    = constants.KubeSystemNamespace
+)
+
+// Installation is an installation of Calico components.
+type Installation struct {
+	DaemonSet       *appsv1.DaemonSet
+	KubeControllers *appsv1.Deployment
+}
+
+// Installed determines if all Calico components are present.
+func (i Installation) Installed() bool {
+	return i.DaemonSet != nil && i.KubeControllers != nil
+}
+
+// GetInstallation returns the Calico Installation of a cluster. The DaemonSet and KubeControllers
+// fields are nil if they could not be found within the target cluster.
+func GetInstallation(ctx context.Context, client client.Client) (*Installation, error) {
+	ds := &appsv1.DaemonSet{}
+	if err := get(ctx, client, DaemonSetName, ds); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return nil, err
+		}
+		ds = nil
+	}
+
+	kubeControllers := &appsv1.Deployment{}
+	if err := get(ctx, client, KubeControllersDeploymentName, kubeControllers); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return nil, err
+		}
+		kubeControllers = nil
+	}
+
+	return &Installation{
+		DaemonSet:       ds,
+		KubeControllers: kubeControllers,
+	}, nil
+}
+
+func get(ctx context.Context, c client.Client, name string, obj client.Object) error {
+	return c.Get(ctx, types.NamespacedName{Name: name, Namespace: namespace}, obj)
+}
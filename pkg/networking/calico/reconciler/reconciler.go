@@ -0,0 +1,106 @@
+package reconciler
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta1"
+	v1beta1conditions "sigs.k8s.io/cluster-api/util/deprecated/v1beta1/conditions"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/cluster"
+	"github.com/aws/eks-anywhere/pkg/controller"
+	"github.com/aws/eks-anywhere/pkg/controller/serverside"
+	"github.com/aws/eks-anywhere/pkg/networking/calico"
+	"github.com/aws/eks-anywhere/pkg/networking/cilium"
+)
+
+const defaultRequeueTime = time.Second * 10
+
+// Templater generates the Calico manifest for a cluster.
+type Templater interface {
+	GenerateManifest(ctx context.Context, spec *cluster.Spec) ([]byte, error)
+}
+
+// Reconciler allows to reconcile a Calico CNI.
+type Reconciler struct {
+	templater Templater
+}
+
+// New creates a new Calico reconciler.
+func New(templater Templater) *Reconciler {
+	return &Reconciler{
+		templater: templater,
+	}
+}
+
+// Reconcile takes the Calico CNI in a cluster to the desired state defined in a cluster Spec.
+// It uses a controller.Result to indicate when requeues are needed. client is connected to the
+// target Kubernetes cluster, not the management cluster.
+func (r *Reconciler) Reconcile(ctx context.Context, logger logr.Logger, client client.Client, spec *cluster.Spec) (controller.Result, error) {
+	installation, err := calico.GetInstallation(ctx, client)
+	if err != nil {
+		return controller.Result{}, err
+	}
+
+	calicoCfg := spec.Cluster.Spec.ClusterNetwork.CNIConfig.Calico
+
+	// A CNI is required for the cluster to be created, so Calico is always installed when
+	// missing, even if its upgrades are skipped.
+	if !installation.Installed() {
+		logger.Info("Installing Calico")
+		if err := r.apply(ctx, client, spec); err != nil {
+			return controller.Result{}, errors.Wrap(err, "installing Calico")
+		}
+
+		v1beta1conditions.MarkTrue(spec.Cluster, anywherev1.DefaultCNIConfiguredCondition)
+		return controller.Result{}, nil
+	}
+
+	if !calicoCfg.IsManaged() {
+		logger.Info("Calico configured as unmanaged, skipping upgrade")
+		v1beta1conditions.MarkFalse(spec.Cluster, anywherev1.DefaultCNIConfiguredCondition, anywherev1.SkipUpgradesForDefaultCNIConfiguredReason, clusterv1.ConditionSeverityWarning, "Configured to skip default Calico CNI upgrades")
+		return controller.Result{}, nil
+	}
+
+	logger.Info("Calico is already installed, checking if it needs upgrade")
+	upgradePlan, err := calico.BuildUpgradePlan(installation, spec)
+	if err != nil {
+		return controller.Result{}, err
+	}
+
+	if upgradePlan.Needed() {
+		logger.Info("Calico upgrade needed", "reason", upgradePlan.Reason())
+		if err := cilium.CheckDaemonSetReady(installation.DaemonSet); err != nil {
+			logger.Info("Calico DS is not ready, requeueing", "reason", err.Error())
+			v1beta1conditions.MarkFalse(spec.Cluster, anywherev1.DefaultCNIConfiguredCondition, anywherev1.DefaultCNIUpgradeInProgressReason, clusterv1.ConditionSeverityInfo, "Calico version upgrade needed")
+			return controller.Result{Result: &ctrl.Result{
+				RequeueAfter: defaultRequeueTime,
+			}}, nil
+		}
+
+		logger.Info("Upgrading Calico")
+		if err := r.apply(ctx, client, spec); err != nil {
+			return controller.Result{}, errors.Wrap(err, "upgrading Calico")
+		}
+	} else {
+		logger.Info("Calico is already up to date")
+	}
+
+	v1beta1conditions.MarkTrue(spec.Cluster, anywherev1.DefaultCNIConfiguredCondition)
+
+	return controller.Result{}, nil
+}
+
+func (r *Reconciler) apply(ctx context.Context, client client.Client, spec *cluster.Spec) error {
+	manifest, err := r.templater.GenerateManifest(ctx, spec)
+	if err != nil {
+		return errors.Wrap(err, "generating Calico manifest")
+	}
+
+	return serverside.ReconcileYaml(ctx, client, manifest)
+}
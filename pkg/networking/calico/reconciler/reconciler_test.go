@@ -0,0 +1,160 @@
+package reconciler_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	v1beta1conditions "sigs.k8s.io/cluster-api/util/deprecated/v1beta1/conditions"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/aws/eks-anywhere/internal/test"
+	"github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/cluster"
+	"github.com/aws/eks-anywhere/pkg/networking/calico/reconciler"
+	"github.com/aws/eks-anywhere/pkg/networking/calico/reconciler/mocks"
+	releasev1 "github.com/aws/eks-anywhere/release/api/v1alpha1"
+)
+
+const (
+	nodeImage            = "public.ecr.aws/eks-anywhere/projectcalico/node:v3.29.3-eks-a-1"
+	kubeControllersImage = "public.ecr.aws/eks-anywhere/projectcalico/kube-controllers:v3.29.3-eks-a-1"
+	manifest             = `apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: calico-config
+  namespace: kube-system
+data:
+  veth_mtu: "0"
+`
+)
+
+type reconcilerTest struct {
+	*WithT
+	ctx       context.Context
+	templater *mocks.MockTemplater
+	spec      *cluster.Spec
+}
+
+func newReconcilerTest(t *testing.T) *reconcilerTest {
+	ctrl := gomock.NewController(t)
+	return &reconcilerTest{
+		WithT:     NewWithT(t),
+		ctx:       context.Background(),
+		templater: mocks.NewMockTemplater(ctrl),
+		spec: test.NewClusterSpec(func(s *cluster.Spec) {
+			s.Cluster.Spec.ClusterNetwork.CNIConfig = &v1alpha1.CNIConfig{
+				Calico: &v1alpha1.CalicoConfig{},
+			}
+			s.VersionsBundles["1.19"].Calico = &releasev1.CalicoBundle{
+				Version:         "v3.29.3",
+				Node:            releasev1.Image{URI: nodeImage},
+				KubeControllers: releasev1.Image{URI: kubeControllersImage},
+			}
+		}),
+	}
+}
+
+func daemonSet(image string, ready bool) *appsv1.DaemonSet {
+	ds := &appsv1.DaemonSet{
+		ObjectMeta: metav1.ObjectMeta{Name: "calico-node", Namespace: "kube-system"},
+		Spec: appsv1.DaemonSetSpec{
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{{Name: "calico-node", Image: image}},
+				},
+			},
+		},
+		Status: appsv1.DaemonSetStatus{
+			DesiredNumberScheduled: 3,
+			NumberReady:            3,
+		},
+	}
+	if !ready {
+		ds.Status.NumberReady = 1
+	}
+	return ds
+}
+
+func kubeControllers(image string) *appsv1.Deployment {
+	return &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "calico-kube-controllers", Namespace: "kube-system"},
+		Spec: appsv1.DeploymentSpec{
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{{Name: "calico-kube-controllers", Image: image}},
+				},
+			},
+		},
+	}
+}
+
+func (tt *reconcilerTest) client(objs ...client.Object) client.Client {
+	return fake.NewClientBuilder().WithObjects(objs...).WithStatusSubresource(objs...).Build()
+}
+
+func (tt *reconcilerTest) expectConfigMapApplied(c client.Client) {
+	cm := &corev1.ConfigMap{}
+	tt.Expect(c.Get(tt.ctx, client.ObjectKey{Name: "calico-config", Namespace: "kube-system"}, cm)).To(Succeed())
+}
+
+func TestReconcilerReconcileInstall(t *testing.T) {
+	tt := newReconcilerTest(t)
+	c := tt.client()
+	tt.templater.EXPECT().GenerateManifest(tt.ctx, tt.spec).Return([]byte(manifest), nil)
+
+	result, err := reconciler.New(tt.templater).Reconcile(tt.ctx, test.NewNullLogger(), c, tt.spec)
+	tt.Expect(err).NotTo(HaveOccurred())
+	tt.Expect(result.Return()).To(BeFalse())
+	tt.expectConfigMapApplied(c)
+	tt.Expect(v1beta1conditions.IsTrue(tt.spec.Cluster, v1alpha1.DefaultCNIConfiguredCondition)).To(BeTrue())
+}
+
+func TestReconcilerReconcileUpToDate(t *testing.T) {
+	tt := newReconcilerTest(t)
+	c := tt.client(daemonSet(nodeImage, true), kubeControllers(kubeControllersImage))
+
+	result, err := reconciler.New(tt.templater).Reconcile(tt.ctx, test.NewNullLogger(), c, tt.spec)
+	tt.Expect(err).NotTo(HaveOccurred())
+	tt.Expect(result.Return()).To(BeFalse())
+	tt.Expect(v1beta1conditions.IsTrue(tt.spec.Cluster, v1alpha1.DefaultCNIConfiguredCondition)).To(BeTrue())
+}
+
+func TestReconcilerReconcileUpgrade(t *testing.T) {
+	tt := newReconcilerTest(t)
+	c := tt.client(daemonSet("calico/node:v3.28.0", true), kubeControllers(kubeControllersImage))
+	tt.templater.EXPECT().GenerateManifest(tt.ctx, tt.spec).Return([]byte(manifest), nil)
+
+	result, err := reconciler.New(tt.templater).Reconcile(tt.ctx, test.NewNullLogger(), c, tt.spec)
+	tt.Expect(err).NotTo(HaveOccurred())
+	tt.Expect(result.Return()).To(BeFalse())
+	tt.expectConfigMapApplied(c)
+	tt.Expect(v1beta1conditions.IsTrue(tt.spec.Cluster, v1alpha1.DefaultCNIConfiguredCondition)).To(BeTrue())
+}
+
+func TestReconcilerReconcileUpgradeDaemonSetNotReady(t *testing.T) {
+	tt := newReconcilerTest(t)
+	c := tt.client(daemonSet("calico/node:v3.28.0", false), kubeControllers(kubeControllersImage))
+
+	result, err := reconciler.New(tt.templater).Reconcile(tt.ctx, test.NewNullLogger(), c, tt.spec)
+	tt.Expect(err).NotTo(HaveOccurred())
+	tt.Expect(result.Return()).To(BeTrue())
+	tt.Expect(v1beta1conditions.GetReason(tt.spec.Cluster, v1alpha1.DefaultCNIConfiguredCondition)).To(Equal(v1alpha1.DefaultCNIUpgradeInProgressReason))
+}
+
+func TestReconcilerReconcileSkipUpgrade(t *testing.T) {
+	tt := newReconcilerTest(t)
+	skip := true
+	tt.spec.Cluster.Spec.ClusterNetwork.CNIConfig.Calico.SkipUpgrade = &skip
+	c := tt.client(daemonSet("calico/node:v3.28.0", true), kubeControllers(kubeControllersImage))
+
+	result, err := reconciler.New(tt.templater).Reconcile(tt.ctx, test.NewNullLogger(), c, tt.spec)
+	tt.Expect(err).NotTo(HaveOccurred())
+	tt.Expect(result.Return()).To(BeFalse())
+	tt.Expect(v1beta1conditions.GetReason(tt.spec.Cluster, v1alpha1.DefaultCNIConfiguredCondition)).To(Equal(v1alpha1.SkipUpgradesForDefaultCNIConfiguredReason))
+}
@@ -0,0 +1,159 @@
+package calico
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/aws/eks-anywhere/pkg/cluster"
+	"github.com/aws/eks-anywhere/pkg/registrymirror"
+	unstructuredutil "github.com/aws/eks-anywhere/pkg/utils/unstructured"
+	releasev1 "github.com/aws/eks-anywhere/release/api/v1alpha1"
+)
+
+const podCIDREnvVar = "CALICO_IPV4POOL_CIDR"
+
+// Reader reads the content of a manifest from its URI.
+type Reader interface {
+	ReadFile(url string) ([]byte, error)
+}
+
+// Templater generates the Calico manifest for a cluster.
+type Templater struct {
+	reader Reader
+}
+
+// NewTemplater returns a new Templater.
+func NewTemplater(reader Reader) *Templater {
+	return &Templater{
+		reader: reader,
+	}
+}
+
+// GenerateManifest reads the Calico manifest of the cluster bundle and configures it for the
+// cluster: it sets the pod CIDR of the default IP pool and pulls the images from the bundle,
+// through the registry mirror when the cluster has one.
+func (t *Templater) GenerateManifest(_ context.Context, spec *cluster.Spec) ([]byte, error) {
+	bundle, err := calicoBundle(spec)
+	if err != nil {
+		return nil, err
+	}
+
+	content, err := t.reader.ReadFile(bundle.Manifest.URI)
+	if err != nil {
+		return nil, fmt.Errorf("reading calico manifest: %v", err)
+	}
+
+	objs, err := unstructuredutil.YamlToUnstructured(content)
+	if err != nil {
+		return nil, fmt.Errorf("parsing calico manifest: %v", err)
+	}
+
+	images := newImages(spec, bundle)
+	for i := range objs {
+		switch {
+		case objs[i].GetKind() == "DaemonSet" && objs[i].GetName() == DaemonSetName:
+			err = updateDaemonSet(&objs[i], images, spec.Cluster.Spec.ClusterNetwork.Pods.CidrBlocks)
+		case objs[i].GetKind() == "Deployment" && objs[i].GetName() == KubeControllersDeploymentName:
+			err = updateDeployment(&objs[i], images)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return unstructuredutil.UnstructuredToYaml(objs)
+}
+
+func calicoBundle(spec *cluster.Spec) (*releasev1.CalicoBundle, error) {
+	bundle := spec.RootVersionsBundle()
+	if bundle == nil || bundle.Calico == nil {
+		return nil, errors.New("the EKS Anywhere bundle for the cluster doesn't include Calico, please upgrade to a release that supports it")
+	}
+
+	return bundle.Calico, nil
+}
+
+// images are the Calico images of a cluster, indexed by their name, like node or cni.
+type images map[string]string
+
+func newImages(spec *cluster.Spec, bundle *releasev1.CalicoBundle) images {
+	mirror := registrymirror.FromCluster(spec.Cluster)
+	return images{
+		"node":             mirror.ReplaceRegistry(bundle.Node.VersionedImage()),
+		"cni":              mirror.ReplaceRegistry(bundle.CNI.VersionedImage()),
+		"kube-controllers": mirror.ReplaceRegistry(bundle.KubeControllers.VersionedImage()),
+	}
+}
+
+// replace sets the image of the containers to the image of the cluster with the same name,
+// keeping the image of the containers that don't run a Calico image.
+func (i images) replace(containers []corev1.Container) {
+	for c := range containers {
+		repository, _, _ := strings.Cut(containers[c].Image, "@")
+		if tagIndex := strings.LastIndex(repository, ":"); tagIndex > strings.LastIndex(repository, "/") {
+			repository = repository[:tagIndex]
+		}
+		name := repository[strings.LastIndex(repository, "/")+1:]
+		if image, ok := i[name]; ok {
+			containers[c].Image = image
+		}
+	}
+}
+
+func updateDaemonSet(obj *unstructured.Unstructured, images images, podCIDRs []string) error {
+	ds := &appsv1.DaemonSet{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, ds); err != nil {
+		return fmt.Errorf("converting calico daemonset: %v", err)
+	}
+
+	images.replace(ds.Spec.Template.Spec.InitContainers)
+	images.replace(ds.Spec.Template.Spec.Containers)
+
+	for c := range ds.Spec.Template.Spec.Containers {
+		if ds.Spec.Template.Spec.Containers[c].Name == nodeContainerName && len(podCIDRs) > 0 {
+			ds.Spec.Template.Spec.Containers[c].Env = setEnv(ds.Spec.Template.Spec.Containers[c].Env, podCIDREnvVar, podCIDRs[0])
+		}
+	}
+
+	return toUnstructured(ds, obj)
+}
+
+func updateDeployment(obj *unstructured.Unstructured, images images) error {
+	deployment := &appsv1.Deployment{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, deployment); err != nil {
+		return fmt.Errorf("converting calico kube-controllers deployment: %v", err)
+	}
+
+	images.replace(deployment.Spec.Template.Spec.Containers)
+
+	return toUnstructured(deployment, obj)
+}
+
+func toUnstructured(in runtime.Object, out *unstructured.Unstructured) error {
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(in)
+	if err != nil {
+		return fmt.Errorf("converting calico object to unstructured: %v", err)
+	}
+	out.Object = content
+
+	return nil
+}
+
+func setEnv(env []corev1.EnvVar, name, value string) []corev1.EnvVar {
+	for i := range env {
+		if env[i].Name == name {
+			env[i].Value = value
+			env[i].ValueFrom = nil
+			return env
+		}
+	}
+
+	return append(env, corev1.EnvVar{Name: name, Value: value})
+}
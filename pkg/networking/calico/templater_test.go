@@ -0,0 +1,126 @@
+package calico_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	"sigs.k8s.io/yaml"
+
+	"github.com/aws/eks-anywhere/internal/test"
+	"github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/cluster"
+	"github.com/aws/eks-anywhere/pkg/networking/calico"
+	"github.com/aws/eks-anywhere/pkg/utils/unstructured"
+	releasev1 "github.com/aws/eks-anywhere/release/api/v1alpha1"
+)
+
+type fileReader struct {
+	err error
+}
+
+func (f fileReader) ReadFile(url string) ([]byte, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return os.ReadFile(url)
+}
+
+func calicoSpec(opts ...test.ClusterSpecOpt) *cluster.Spec {
+	return test.NewClusterSpec(append([]test.ClusterSpecOpt{func(s *cluster.Spec) {
+		s.Cluster.Spec.ClusterNetwork.Pods.CidrBlocks = []string{"10.10.0.0/16"}
+		s.Cluster.Spec.ClusterNetwork.CNIConfig = &v1alpha1.CNIConfig{
+			Calico: &v1alpha1.CalicoConfig{},
+		}
+		s.VersionsBundles["1.19"].Calico = &releasev1.CalicoBundle{
+			Version:         "v3.29.3",
+			Node:            releasev1.Image{URI: "public.ecr.aws/eks-anywhere/projectcalico/node:v3.29.3-eks-a-1"},
+			CNI:             releasev1.Image{URI: "public.ecr.aws/eks-anywhere/projectcalico/cni:v3.29.3-eks-a-1"},
+			KubeControllers: releasev1.Image{URI: "public.ecr.aws/eks-anywhere/projectcalico/kube-controllers:v3.29.3-eks-a-1"},
+			Manifest:        releasev1.Manifest{URI: "testdata/calico.yaml"},
+		}
+	}}, opts...)...)
+}
+
+func generatedObjects(t *testing.T, manifest []byte) (*appsv1.DaemonSet, *appsv1.Deployment) {
+	t.Helper()
+	objs, err := unstructured.YamlToUnstructured(manifest)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ds := &appsv1.DaemonSet{}
+	deployment := &appsv1.Deployment{}
+	for _, o := range objs {
+		content, err := yaml.Marshal(o.Object)
+		if err != nil {
+			t.Fatal(err)
+		}
+		switch o.GetName() {
+		case calico.DaemonSetName:
+			if o.GetKind() == "DaemonSet" {
+				err = yaml.Unmarshal(content, ds)
+			}
+		case calico.KubeControllersDeploymentName:
+			err = yaml.Unmarshal(content, deployment)
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return ds, deployment
+}
+
+func TestTemplaterGenerateManifest(t *testing.T) {
+	g := NewWithT(t)
+	spec := calicoSpec()
+
+	manifest, err := calico.NewTemplater(fileReader{}).GenerateManifest(context.Background(), spec)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	ds, deployment := generatedObjects(t, manifest)
+	g.Expect(ds.Spec.Template.Spec.InitContainers[0].Image).To(Equal("public.ecr.aws/eks-anywhere/projectcalico/cni:v3.29.3-eks-a-1"))
+	g.Expect(ds.Spec.Template.Spec.Containers[0].Image).To(Equal("public.ecr.aws/eks-anywhere/projectcalico/node:v3.29.3-eks-a-1"))
+	g.Expect(ds.Spec.Template.Spec.Containers[0].Env).To(ConsistOf(
+		HaveField("Value", "10.10.0.0/16"),
+	))
+	g.Expect(deployment.Spec.Template.Spec.Containers[0].Image).To(Equal("public.ecr.aws/eks-anywhere/projectcalico/kube-controllers:v3.29.3-eks-a-1"))
+}
+
+func TestTemplaterGenerateManifestRegistryMirror(t *testing.T) {
+	g := NewWithT(t)
+	spec := calicoSpec(func(s *cluster.Spec) {
+		s.Cluster.Spec.RegistryMirrorConfiguration = &v1alpha1.RegistryMirrorConfiguration{
+			Endpoint: "1.2.3.4",
+			Port:     "443",
+		}
+	})
+
+	manifest, err := calico.NewTemplater(fileReader{}).GenerateManifest(context.Background(), spec)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	ds, deployment := generatedObjects(t, manifest)
+	g.Expect(ds.Spec.Template.Spec.Containers[0].Image).To(Equal("1.2.3.4:443/eks-anywhere/projectcalico/node:v3.29.3-eks-a-1"))
+	g.Expect(deployment.Spec.Template.Spec.Containers[0].Image).To(Equal("1.2.3.4:443/eks-anywhere/projectcalico/kube-controllers:v3.29.3-eks-a-1"))
+}
+
+func TestTemplaterGenerateManifestMissingBundle(t *testing.T) {
+	g := NewWithT(t)
+	spec := calicoSpec(func(s *cluster.Spec) {
+		s.VersionsBundles["1.19"].Calico = nil
+	})
+
+	_, err := calico.NewTemplater(fileReader{}).GenerateManifest(context.Background(), spec)
+	g.Expect(err).To(MatchError(ContainSubstring("doesn't include Calico")))
+}
+
+func TestTemplaterGenerateManifestReadError(t *testing.T) {
+	g := NewWithT(t)
+
+	_, err := calico.NewTemplater(fileReader{err: errors.New("no network")}).GenerateManifest(context.Background(), calicoSpec())
+	g.Expect(err).To(MatchError(ContainSubstring("reading calico manifest: no network")))
+}
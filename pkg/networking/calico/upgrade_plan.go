@@ -0,0 +1,144 @@
+package calico
+
+import (
+	"fmt"
+	"strings"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/aws/eks-anywhere/pkg/cluster"
+	"github.com/aws/eks-anywhere/pkg/types"
+)
+
+// UpgradePlan contains information about a Calico installation upgrade.
+type UpgradePlan struct {
+	DaemonSet       VersionedComponentUpgradePlan
+	KubeControllers VersionedComponentUpgradePlan
+}
+
+// Needed determines if an upgrade is needed or not.
+// Returns true if any of the installation components needs an upgrade.
+func (c UpgradePlan) Needed() bool {
+	return c.DaemonSet.Needed() || c.KubeControllers.Needed()
+}
+
+// Reason returns the reason why an upgrade might be needed.
+// If no upgrade needed, returns empty string.
+func (c UpgradePlan) Reason() string {
+	s := make([]string, 0, 2)
+	for _, component := range []VersionedComponentUpgradePlan{c.DaemonSet, c.KubeControllers} {
+		if component.UpgradeReason != "" {
+			s = append(s, component.UpgradeReason)
+		}
+	}
+
+	return strings.Join(s, " - ")
+}
+
+// VersionedComponentUpgradePlan contains upgrade information for a Calico versioned component.
+type VersionedComponentUpgradePlan struct {
+	UpgradeReason string
+	OldImage      string
+	NewImage      string
+}
+
+// Needed determines if an upgrade is needed or not.
+func (c VersionedComponentUpgradePlan) Needed() bool {
+	return c.UpgradeReason != ""
+}
+
+// BuildUpgradePlan generates the upgrade plan information for a Calico installation by comparing
+// the images it runs with the ones of the cluster Spec.
+func BuildUpgradePlan(installation *Installation, spec *cluster.Spec) (UpgradePlan, error) {
+	bundle, err := calicoBundle(spec)
+	if err != nil {
+		return UpgradePlan{}, err
+	}
+	images := newImages(spec, bundle)
+
+	return UpgradePlan{
+		DaemonSet:       daemonSetUpgradePlan(installation.DaemonSet, images["node"]),
+		KubeControllers: kubeControllersUpgradePlan(installation.KubeControllers, images["kube-controllers"]),
+	}, nil
+}
+
+func daemonSetUpgradePlan(ds *appsv1.DaemonSet, newImage string) VersionedComponentUpgradePlan {
+	info := VersionedComponentUpgradePlan{
+		NewImage: newImage,
+	}
+
+	if ds == nil {
+		info.UpgradeReason = "DaemonSet doesn't exist"
+		return info
+	}
+
+	info.OldImage = containerImage(ds.Spec.Template.Spec.Containers, nodeContainerName)
+	if info.OldImage != newImage {
+		info.UpgradeReason = fmt.Sprintf("DaemonSet container %s doesn't match image [%s] -> [%s]", nodeContainerName, info.OldImage, newImage)
+	}
+
+	return info
+}
+
+func kubeControllersUpgradePlan(deployment *appsv1.Deployment, newImage string) VersionedComponentUpgradePlan {
+	info := VersionedComponentUpgradePlan{
+		NewImage: newImage,
+	}
+
+	if deployment == nil {
+		info.UpgradeReason = "kube-controllers deployment doesn't exist"
+		return info
+	}
+
+	info.OldImage = containerImage(deployment.Spec.Template.Spec.Containers, kubeControllersContainerName)
+	if info.OldImage != newImage {
+		info.UpgradeReason = fmt.Sprintf("kube-controllers container doesn't match image [%s] -> [%s]", info.OldImage, newImage)
+	}
+
+	return info
+}
+
+func containerImage(containers []corev1.Container, name string) string {
+	for _, c := range containers {
+		if c.Name == name {
+			return c.Image
+		}
+	}
+
+	return ""
+}
+
+// ChangeDiff returns the change diff of Calico between the current and new cluster specs.
+func ChangeDiff(currentSpec, newSpec *cluster.Spec) *types.ChangeDiff {
+	newCalicoCfg := newSpec.Cluster.Spec.ClusterNetwork.CNIConfig.Calico
+	if newCalicoCfg == nil {
+		return nil
+	}
+
+	var oldVersion, newVersion string
+	if b := currentSpec.RootVersionsBundle(); b != nil && b.Calico != nil {
+		oldVersion = b.Calico.Version
+	}
+	if b := newSpec.RootVersionsBundle(); b != nil && b.Calico != nil {
+		newVersion = b.Calico.Version
+	}
+
+	if !newCalicoCfg.IsManaged() {
+		return types.NewChangeDiff(&types.ComponentChangeDiff{
+			ComponentName: "calico",
+			OldVersion:    oldVersion,
+			NewVersion:    "Upgrade skipped (skipUpgrade: true)",
+		})
+	}
+
+	if oldVersion == newVersion {
+		return nil
+	}
+
+	return types.NewChangeDiff(&types.ComponentChangeDiff{
+		ComponentName: "calico",
+		OldVersion:    oldVersion,
+		NewVersion:    newVersion,
+	})
+}
@@ -0,0 +1,127 @@
+package calico_test
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/cluster"
+	"github.com/aws/eks-anywhere/pkg/networking/calico"
+	"github.com/aws/eks-anywhere/pkg/types"
+)
+
+func calicoDaemonSet(image string) *appsv1.DaemonSet {
+	return &appsv1.DaemonSet{
+		ObjectMeta: metav1.ObjectMeta{Name: calico.DaemonSetName, Namespace: "kube-system"},
+		Spec: appsv1.DaemonSetSpec{
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{{Name: "calico-node", Image: image}},
+				},
+			},
+		},
+	}
+}
+
+func calicoKubeControllers(image string) *appsv1.Deployment {
+	return &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: calico.KubeControllersDeploymentName, Namespace: "kube-system"},
+		Spec: appsv1.DeploymentSpec{
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{{Name: "calico-kube-controllers", Image: image}},
+				},
+			},
+		},
+	}
+}
+
+func TestGetInstallation(t *testing.T) {
+	g := NewWithT(t)
+	ds := calicoDaemonSet("node:v1")
+	client := fake.NewClientBuilder().WithObjects(ds).Build()
+
+	installation, err := calico.GetInstallation(context.Background(), client)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(installation.DaemonSet).NotTo(BeNil())
+	g.Expect(installation.KubeControllers).To(BeNil())
+	g.Expect(installation.Installed()).To(BeFalse())
+}
+
+func TestBuildUpgradePlan(t *testing.T) {
+	spec := calicoSpec()
+	nodeImage := "public.ecr.aws/eks-anywhere/projectcalico/node:v3.29.3-eks-a-1"
+	kubeControllersImage := "public.ecr.aws/eks-anywhere/projectcalico/kube-controllers:v3.29.3-eks-a-1"
+
+	tests := []struct {
+		name         string
+		installation *calico.Installation
+		wantNeeded   bool
+		wantReason   string
+	}{
+		{
+			name: "up to date",
+			installation: &calico.Installation{
+				DaemonSet:       calicoDaemonSet(nodeImage),
+				KubeControllers: calicoKubeControllers(kubeControllersImage),
+			},
+		},
+		{
+			name: "daemonset image changed",
+			installation: &calico.Installation{
+				DaemonSet:       calicoDaemonSet("node:old"),
+				KubeControllers: calicoKubeControllers(kubeControllersImage),
+			},
+			wantNeeded: true,
+			wantReason: "DaemonSet container calico-node doesn't match image [node:old] -> [" + nodeImage + "]",
+		},
+		{
+			name: "kube-controllers missing",
+			installation: &calico.Installation{
+				DaemonSet: calicoDaemonSet(nodeImage),
+			},
+			wantNeeded: true,
+			wantReason: "kube-controllers deployment doesn't exist",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			plan, err := calico.BuildUpgradePlan(tt.installation, spec)
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(plan.Needed()).To(Equal(tt.wantNeeded))
+			g.Expect(plan.Reason()).To(Equal(tt.wantReason))
+		})
+	}
+}
+
+func TestChangeDiff(t *testing.T) {
+	g := NewWithT(t)
+	current := calicoSpec()
+	newSpec := calicoSpec(func(s *cluster.Spec) {
+		s.VersionsBundles["1.19"].Calico.Version = "v3.30.0"
+	})
+
+	g.Expect(calico.ChangeDiff(current, current)).To(BeNil())
+	g.Expect(calico.ChangeDiff(current, newSpec)).To(Equal(types.NewChangeDiff(&types.ComponentChangeDiff{
+		ComponentName: "calico",
+		OldVersion:    "v3.29.3",
+		NewVersion:    "v3.30.0",
+	})))
+}
+
+func TestChangeDiffSkipUpgrade(t *testing.T) {
+	g := NewWithT(t)
+	skip := true
+	newSpec := calicoSpec(func(s *cluster.Spec) {
+		s.Cluster.Spec.ClusterNetwork.CNIConfig.Calico = &v1alpha1.CalicoConfig{SkipUpgrade: &skip}
+	})
+
+	g.Expect(calico.ChangeDiff(calicoSpec(), newSpec).ComponentReports[0].NewVersion).To(Equal("Upgrade skipped (skipUpgrade: true)"))
+}
@@ -28,6 +28,10 @@ const (
 	ConfigMapName = "cilium-config"
 	// ServiceName is the default name for the Cilium Service installed in EKS-A clusters.
 	ServiceName = "cilium-agent"
+	// HubbleRelayDeploymentName is the name of the Hubble Relay Deployment installed with Cilium.
+	HubbleRelayDeploymentName = "hubble-relay"
+	// HubbleUIDeploymentName is the name of the Hubble UI Deployment installed with Cilium.
+	HubbleUIDeploymentName = "hubble-ui"
 
 	ciliumConfigMapName   = "cilium-config"
 	ciliumConfigNamespace = "kube-system"
@@ -35,9 +39,11 @@ const (
 
 // Installation is an installation of EKSA Cilium components.
 type Installation struct {
-	DaemonSet *appsv1.DaemonSet
-	Operator  *appsv1.Deployment
-	ConfigMap *corev1.ConfigMap
+	DaemonSet   *appsv1.DaemonSet
+	Operator    *appsv1.Deployment
+	ConfigMap   *corev1.ConfigMap
+	HubbleRelay *appsv1.Deployment
+	HubbleUI    *appsv1.Deployment
 }
 
 // Installed determines if all EKS-A Embedded Cilium components are present. It identifies
@@ -54,14 +60,25 @@ func (i Installation) Installed() bool {
 }
 
 // GetInstallation creates a new Installation instance. The returned installation's DaemonSet,
-// Operator and ConfigMap fields will be nil if they could not be found within the target cluster.
+// Operator, ConfigMap, HubbleRelay and HubbleUI fields will be nil if they could not be found within
+// the target cluster.
 func GetInstallation(ctx context.Context, client client.Client) (*Installation, error) {
 	ds, err := getDaemonSet(ctx, client)
 	if err != nil {
 		return nil, err
 	}
 
-	operator, err := getDeployment(ctx, client)
+	operator, err := getDeployment(ctx, client, DeploymentName)
+	if err != nil {
+		return nil, err
+	}
+
+	hubbleRelay, err := getDeployment(ctx, client, HubbleRelayDeploymentName)
+	if err != nil {
+		return nil, err
+	}
+
+	hubbleUI, err := getDeployment(ctx, client, HubbleUIDeploymentName)
 	if err != nil {
 		return nil, err
 	}
@@ -72,9 +89,11 @@ func GetInstallation(ctx context.Context, client client.Client) (*Installation,
 	}
 
 	return &Installation{
-		DaemonSet: ds,
-		Operator:  operator,
-		ConfigMap: cm,
+		DaemonSet:   ds,
+		Operator:    operator,
+		ConfigMap:   cm,
+		HubbleRelay: hubbleRelay,
+		HubbleUI:    hubbleUI,
 	}, nil
 }
 
@@ -104,10 +123,10 @@ func getConfigMap(ctx context.Context, client client.Client, name string, namesp
 	return c, nil
 }
 
-func getDeployment(ctx context.Context, client client.Client) (*appsv1.Deployment, error) {
+func getDeployment(ctx context.Context, client client.Client, name string) (*appsv1.Deployment, error) {
 	deployment := &appsv1.Deployment{}
 	key := types.NamespacedName{
-		Name:      DeploymentName,
+		Name:      name,
 		Namespace: constants.KubeSystemNamespace,
 	}
 	err := client.Get(ctx, key, deployment)
@@ -4,7 +4,9 @@ import (
 	"context"
 	_ "embed"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 
@@ -15,6 +17,7 @@ import (
 	"github.com/aws/eks-anywhere/pkg/retrier"
 	"github.com/aws/eks-anywhere/pkg/semver"
 	"github.com/aws/eks-anywhere/pkg/templater"
+	releasev1 "github.com/aws/eks-anywhere/release/api/v1alpha1"
 )
 
 //go:embed network_policy.yaml
@@ -45,6 +48,10 @@ func NewTemplater(helmFactory HelmClientFactory) *Templater {
 func (t *Templater) GenerateUpgradePreflightManifest(ctx context.Context, spec *cluster.Spec) ([]byte, error) {
 	versionsBundle := spec.RootVersionsBundle()
 	v := templateValues(spec, versionsBundle)
+	setIPFamily(v, spec)
+	if err := setKubeProxyReplacement(v, spec); err != nil {
+		return nil, err
+	}
 	v.set(true, "preflight", "enabled")
 	v.set(versionsBundle.Cilium.Cilium.Image(), "preflight", "image", "repository")
 	v.set(versionsBundle.Cilium.Cilium.Tag(), "preflight", "image", "tag")
@@ -131,6 +138,13 @@ func (t *Templater) GenerateManifest(ctx context.Context, spec *cluster.Spec, op
 		kubeVersion: kubeVersion,
 		retrier:     retrier.NewWithMaxRetries(maxRetries, defaultBackOffPeriod),
 	}
+	setIPFamily(c.values, spec)
+	if err := setKubeProxyReplacement(c.values, spec); err != nil {
+		return nil, err
+	}
+	if err := setHubble(c.values, spec, versionsBundle); err != nil {
+		return nil, err
+	}
 	for _, o := range opts {
 		o(c)
 	}
@@ -333,6 +347,143 @@ func templateValues(spec *cluster.Spec, versionsBundle *cluster.VersionsBundle)
 	return val
 }
 
+// setIPFamily configures the IP families of Cilium. IPv6 clusters disable IPv4 and, in tunnel
+// routing mode, encapsulate the pod traffic in IPv6 packets, since the nodes don't have IPv4
+// addresses. Dual-stack clusters enable IPv6 next to the default IPv4. Values set in the
+// helmValues of the cluster take precedence.
+func setIPFamily(v values, spec *cluster.Spec) {
+	switch spec.Cluster.Spec.ClusterNetwork.IPFamily() {
+	case anywherev1.IPv6IPFamily:
+		if _, ok := v["ipv4"]; !ok {
+			v["ipv4"] = values{"enabled": false}
+		}
+		if _, ok := v["ipv6"]; !ok {
+			v["ipv6"] = values{"enabled": true}
+		}
+		if _, ok := v["underlayProtocol"]; !ok && v["routingMode"] != "native" {
+			v["underlayProtocol"] = "ipv6"
+		}
+	case anywherev1.DualStackIPFamily:
+		if _, ok := v["ipv6"]; !ok {
+			v["ipv6"] = values{"enabled": true}
+		}
+	}
+}
+
+// setKubeProxyReplacement enables the kube-proxy replacement of Cilium when the cluster doesn't run
+// kube-proxy. Without kube-proxy, the kubernetes service isn't reachable until Cilium runs, so Cilium
+// reaches the API server through the control plane endpoint. Values set in the helmValues of the
+// cluster take precedence.
+func setKubeProxyReplacement(v values, spec *cluster.Spec) error {
+	if !spec.Cluster.Spec.ClusterNetwork.CNIConfig.KubeProxyReplacementEnabled() {
+		return nil
+	}
+
+	host, port, err := kubeAPIServerEndpoint(spec)
+	if err != nil {
+		return fmt.Errorf("configuring cilium kube-proxy replacement: %v", err)
+	}
+
+	for key, value := range map[string]interface{}{
+		"kubeProxyReplacement": true,
+		"k8sServiceHost":       host,
+		"k8sServicePort":       port,
+	} {
+		if _, ok := v[key]; !ok {
+			v[key] = value
+		}
+	}
+
+	return nil
+}
+
+// setHubble enables Hubble with the Relay, UI and metrics configured in the cluster, so they are kept
+// when EKS-A upgrades Cilium. Hubble values set in the helmValues of the cluster take precedence.
+func setHubble(v values, spec *cluster.Spec, versionsBundle *cluster.VersionsBundle) error {
+	hubble := spec.Cluster.Spec.ClusterNetwork.CNIConfig.Cilium.Hubble
+	if hubble == nil {
+		return nil
+	}
+	if _, ok := v["hubble"]; ok {
+		return nil
+	}
+
+	hubbleValues := values{
+		"enabled": true,
+	}
+
+	if len(hubble.Metrics) > 0 {
+		hubbleValues["metrics"] = values{
+			"enabled": hubble.Metrics,
+		}
+	}
+
+	if hubble.Relay || hubble.UI {
+		images := versionsBundle.Cilium.Hubble
+		if images == nil {
+			return errors.New("the EKS Anywhere bundle for the cluster doesn't include the Hubble images, please upgrade to a release that supports them")
+		}
+
+		if hubble.Relay {
+			hubbleValues["relay"] = values{
+				"enabled": true,
+				"image":   imageValues(images.Relay),
+			}
+		}
+
+		if hubble.UI {
+			hubbleValues["ui"] = values{
+				"enabled": true,
+				"frontend": values{
+					"image": imageValues(images.UI),
+				},
+				"backend": values{
+					"image": imageValues(images.UIBackend),
+				},
+			}
+		}
+	}
+
+	v["hubble"] = hubbleValues
+
+	return nil
+}
+
+func imageValues(image releasev1.Image) values {
+	return values{
+		"repository": image.Image(),
+		"tag":        image.Tag(),
+	}
+}
+
+// kubeAPIServerEndpoint returns the host and port of the control plane endpoint of the cluster.
+func kubeAPIServerEndpoint(spec *cluster.Spec) (host string, port int, err error) {
+	defaultPort, _ := strconv.Atoi(anywherev1.ControlEndpointDefaultPort)
+
+	// Docker clusters don't have an endpoint in their spec, CAPD fronts the API servers with a
+	// load balancer container that the nodes reach by its name.
+	if spec.Cluster.Spec.DatacenterRef.Kind == anywherev1.DockerDatacenterKind {
+		return fmt.Sprintf("%s-lb", spec.Cluster.Name), defaultPort, nil
+	}
+
+	endpoint := spec.Cluster.Spec.ControlPlaneConfiguration.Endpoint
+	if endpoint == nil || endpoint.Host == "" {
+		return "", 0, fmt.Errorf("cluster %s doesn't have a control plane endpoint", spec.Cluster.Name)
+	}
+
+	host, p, err := anywherev1.GetControlPlaneHostPort(endpoint.Host, anywherev1.ControlEndpointDefaultPort)
+	if err != nil {
+		return "", 0, err
+	}
+
+	port, err = strconv.Atoi(p)
+	if err != nil {
+		return "", 0, fmt.Errorf("invalid control plane endpoint port %s: %v", p, err)
+	}
+
+	return host, port, nil
+}
+
 func getChartURIAndVersion(versionsBundle *cluster.VersionsBundle) (uri, version string) {
 	chart := versionsBundle.Cilium.HelmChart
 	uri = fmt.Sprintf("oci://%s", chart.Image())
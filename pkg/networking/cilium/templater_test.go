@@ -20,6 +20,8 @@ import (
 	"github.com/aws/eks-anywhere/pkg/networking/cilium/mocks"
 	"github.com/aws/eks-anywhere/pkg/retrier"
 	"github.com/aws/eks-anywhere/pkg/semver"
+	"github.com/aws/eks-anywhere/pkg/utils/ptr"
+	releasev1 "github.com/aws/eks-anywhere/release/api/v1alpha1"
 )
 
 // Helper function to create JSON from map.
@@ -481,6 +483,238 @@ func TestTemplaterGenerateManifestCNIExclusiveWithOtherConfigs(t *testing.T) {
 	tt.Expect(len(gotManifest)).To(BeNumerically(">", len(tt.manifest)))
 }
 
+func TestTemplaterGenerateManifestKubeProxyReplacement(t *testing.T) {
+	tests := []struct {
+		name         string
+		datacenter   string
+		endpoint     string
+		wantHost     string
+		wantPort     int
+		clusterName  string
+		withEndpoint bool
+	}{
+		{
+			name:         "endpoint without port",
+			datacenter:   v1alpha1.VSphereDatacenterKind,
+			endpoint:     "1.2.3.4",
+			wantHost:     "1.2.3.4",
+			wantPort:     6443,
+			withEndpoint: true,
+		},
+		{
+			name:         "endpoint with port",
+			datacenter:   v1alpha1.CloudStackDatacenterKind,
+			endpoint:     "1.2.3.4:8443",
+			wantHost:     "1.2.3.4",
+			wantPort:     8443,
+			withEndpoint: true,
+		},
+		{
+			name:        "docker load balancer",
+			datacenter:  v1alpha1.DockerDatacenterKind,
+			clusterName: "my-cluster",
+			wantHost:    "my-cluster-lb",
+			wantPort:    6443,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			wantValues := baseTemplateValues()
+			wantValues["kubeProxyReplacement"] = true
+			wantValues["k8sServiceHost"] = tc.wantHost
+			wantValues["k8sServicePort"] = tc.wantPort
+
+			tt := newtemplaterTest(t)
+			tt.spec.Cluster.Spec.ClusterNetwork.CNIConfig.Cilium.KubeProxyReplacement = ptr.Bool(true)
+			tt.spec.Cluster.Spec.DatacenterRef.Kind = tc.datacenter
+			if tc.clusterName != "" {
+				tt.spec.Cluster.Name = tc.clusterName
+			}
+			if tc.withEndpoint {
+				tt.spec.Cluster.Spec.ControlPlaneConfiguration.Endpoint = &v1alpha1.Endpoint{Host: tc.endpoint}
+			}
+
+			tt.hf.EXPECT().Get(tt.ctx, tt.spec.Cluster).Return(tt.h, nil)
+			tt.expectHelmTemplateWith(eqMap(wantValues), "1.22").Return(tt.manifest, nil)
+
+			tt.Expect(tt.t.GenerateManifest(tt.ctx, tt.spec)).To(Equal(tt.manifest))
+		})
+	}
+}
+
+func TestTemplaterGenerateManifestIPv6(t *testing.T) {
+	wantValues := baseTemplateValues()
+	wantValues["ipv4"] = map[string]interface{}{"enabled": false}
+	wantValues["ipv6"] = map[string]interface{}{"enabled": true}
+	wantValues["underlayProtocol"] = "ipv6"
+
+	tt := newtemplaterTest(t)
+	tt.spec.Cluster.Spec.ClusterNetwork.Pods.CidrBlocks = []string{"fd00:100::/48"}
+	tt.spec.Cluster.Spec.ClusterNetwork.Services.CidrBlocks = []string{"fd00:200::/108"}
+
+	tt.hf.EXPECT().Get(tt.ctx, tt.spec.Cluster).Return(tt.h, nil)
+	tt.expectHelmTemplateWith(eqMap(wantValues), "1.22").Return(tt.manifest, nil)
+
+	tt.Expect(tt.t.GenerateManifest(tt.ctx, tt.spec)).To(Equal(tt.manifest))
+}
+
+func TestTemplaterGenerateManifestIPv6DirectRouting(t *testing.T) {
+	wantValues := baseTemplateValues()
+	withDirectRouting(wantValues)
+	withNativeRoutingCIDRs(wantValues, "", "fd00:100::/48")
+	wantValues["ipv4"] = map[string]interface{}{"enabled": false}
+	wantValues["ipv6"] = map[string]interface{}{"enabled": true}
+
+	tt := newtemplaterTest(t)
+	tt.spec.Cluster.Spec.ClusterNetwork.Pods.CidrBlocks = []string{"fd00:100::/48"}
+	tt.spec.Cluster.Spec.ClusterNetwork.Services.CidrBlocks = []string{"fd00:200::/108"}
+	tt.spec.Cluster.Spec.ClusterNetwork.CNIConfig.Cilium.RoutingMode = v1alpha1.CiliumRoutingModeDirect
+	tt.spec.Cluster.Spec.ClusterNetwork.CNIConfig.Cilium.IPv6NativeRoutingCIDR = "fd00:100::/48"
+
+	tt.hf.EXPECT().Get(tt.ctx, tt.spec.Cluster).Return(tt.h, nil)
+	tt.expectHelmTemplateWith(eqMap(wantValues), "1.22").Return(tt.manifest, nil)
+
+	tt.Expect(tt.t.GenerateManifest(tt.ctx, tt.spec)).To(Equal(tt.manifest))
+}
+
+func TestTemplaterGenerateManifestDualStack(t *testing.T) {
+	wantValues := baseTemplateValues()
+	wantValues["ipv6"] = map[string]interface{}{"enabled": true}
+
+	tt := newtemplaterTest(t)
+	tt.spec.Cluster.Spec.ClusterNetwork.Pods.CidrBlocks = []string{"192.168.0.0/16", "fd00:100::/48"}
+	tt.spec.Cluster.Spec.ClusterNetwork.Services.CidrBlocks = []string{"10.96.0.0/12", "fd00:200::/108"}
+
+	tt.hf.EXPECT().Get(tt.ctx, tt.spec.Cluster).Return(tt.h, nil)
+	tt.expectHelmTemplateWith(eqMap(wantValues), "1.22").Return(tt.manifest, nil)
+
+	tt.Expect(tt.t.GenerateManifest(tt.ctx, tt.spec)).To(Equal(tt.manifest))
+}
+
+func TestTemplaterGenerateManifestDualStackDirectRouting(t *testing.T) {
+	wantValues := baseTemplateValues()
+	withDirectRouting(wantValues)
+	withNativeRoutingCIDRs(wantValues, "192.168.0.0/16", "fd00:100::/48")
+	wantValues["ipv6"] = map[string]interface{}{"enabled": true}
+
+	tt := newtemplaterTest(t)
+	tt.spec.Cluster.Spec.ClusterNetwork.Pods.CidrBlocks = []string{"192.168.0.0/16", "fd00:100::/48"}
+	tt.spec.Cluster.Spec.ClusterNetwork.Services.CidrBlocks = []string{"10.96.0.0/12", "fd00:200::/108"}
+	tt.spec.Cluster.Spec.ClusterNetwork.CNIConfig.Cilium.RoutingMode = v1alpha1.CiliumRoutingModeDirect
+	tt.spec.Cluster.Spec.ClusterNetwork.CNIConfig.Cilium.IPv4NativeRoutingCIDR = "192.168.0.0/16"
+	tt.spec.Cluster.Spec.ClusterNetwork.CNIConfig.Cilium.IPv6NativeRoutingCIDR = "fd00:100::/48"
+
+	tt.hf.EXPECT().Get(tt.ctx, tt.spec.Cluster).Return(tt.h, nil)
+	tt.expectHelmTemplateWith(eqMap(wantValues), "1.22").Return(tt.manifest, nil)
+
+	tt.Expect(tt.t.GenerateManifest(tt.ctx, tt.spec)).To(Equal(tt.manifest))
+}
+
+func TestTemplaterGenerateManifestKubeProxyReplacementHelmValuesPrecedence(t *testing.T) {
+	customHelmValues := map[string]interface{}{
+		"k8sServiceHost": "api.example.com",
+	}
+	wantValues := map[string]interface{}{
+		"kubeProxyReplacement": true,
+		"k8sServiceHost":       "api.example.com",
+		"k8sServicePort":       6443,
+	}
+
+	tt := newtemplaterTest(t)
+	tt.spec.Cluster.Spec.ClusterNetwork.CNIConfig.Cilium.HelmValues = toJSON(customHelmValues)
+	tt.spec.Cluster.Spec.ClusterNetwork.CNIConfig.Cilium.KubeProxyReplacement = ptr.Bool(true)
+	tt.spec.Cluster.Spec.ControlPlaneConfiguration.Endpoint = &v1alpha1.Endpoint{Host: "1.2.3.4"}
+
+	tt.expectHelmClientFactoryGet("", "")
+	tt.expectHelmTemplateWith(eqMap(wantValues), "1.22").Return(tt.manifest, nil)
+
+	tt.Expect(tt.t.GenerateManifest(tt.ctx, tt.spec)).To(Equal(tt.manifest))
+}
+
+func TestTemplaterGenerateManifestKubeProxyReplacementNoEndpoint(t *testing.T) {
+	tt := newtemplaterTest(t)
+	tt.spec.Cluster.Spec.ClusterNetwork.CNIConfig.Cilium.KubeProxyReplacement = ptr.Bool(true)
+	tt.spec.Cluster.Spec.DatacenterRef.Kind = v1alpha1.VSphereDatacenterKind
+	tt.spec.Cluster.Spec.ControlPlaneConfiguration.Endpoint = nil
+
+	_, err := tt.t.GenerateManifest(tt.ctx, tt.spec)
+	tt.Expect(err).To(MatchError(ContainSubstring("doesn't have a control plane endpoint")))
+}
+
+func TestTemplaterGenerateManifestHubble(t *testing.T) {
+	wantValues := baseTemplateValues()
+	wantValues["hubble"] = map[string]interface{}{
+		"enabled": true,
+		"metrics": map[string]interface{}{
+			"enabled": []string{"dns", "drop", "flow"},
+		},
+		"relay": map[string]interface{}{
+			"enabled": true,
+			"image": map[string]interface{}{
+				"repository": "public.ecr.aws/eks/cilium/hubble-relay",
+				"tag":        "v1.17.8-0",
+			},
+		},
+		"ui": map[string]interface{}{
+			"enabled": true,
+			"frontend": map[string]interface{}{
+				"image": map[string]interface{}{
+					"repository": "public.ecr.aws/eks/cilium/hubble-ui",
+					"tag":        "v0.13.2",
+				},
+			},
+			"backend": map[string]interface{}{
+				"image": map[string]interface{}{
+					"repository": "public.ecr.aws/eks/cilium/hubble-ui-backend",
+					"tag":        "v0.13.2",
+				},
+			},
+		},
+	}
+
+	tt := newtemplaterTest(t)
+	tt.spec.VersionsBundles["1.22"].Cilium.Hubble = &releasev1.HubbleBundle{
+		Relay:     releasev1.Image{URI: "public.ecr.aws/eks/cilium/hubble-relay:v1.17.8-0"},
+		UI:        releasev1.Image{URI: "public.ecr.aws/eks/cilium/hubble-ui:v0.13.2"},
+		UIBackend: releasev1.Image{URI: "public.ecr.aws/eks/cilium/hubble-ui-backend:v0.13.2"},
+	}
+	tt.spec.Cluster.Spec.ClusterNetwork.CNIConfig.Cilium.Hubble = &v1alpha1.CiliumHubbleConfig{
+		Relay:   true,
+		UI:      true,
+		Metrics: []string{"dns", "drop", "flow"},
+	}
+
+	tt.expectHelmClientFactoryGet("", "")
+	tt.expectHelmTemplateWith(eqMap(wantValues), "1.22").Return(tt.manifest, nil)
+
+	tt.Expect(tt.t.GenerateManifest(tt.ctx, tt.spec)).To(Equal(tt.manifest))
+}
+
+func TestTemplaterGenerateManifestHubbleHelmValuesPrecedence(t *testing.T) {
+	customHelmValues := map[string]interface{}{
+		"hubble": map[string]interface{}{
+			"enabled": false,
+		},
+	}
+
+	tt := newtemplaterTest(t)
+	tt.spec.Cluster.Spec.ClusterNetwork.CNIConfig.Cilium.HelmValues = toJSON(customHelmValues)
+	tt.spec.Cluster.Spec.ClusterNetwork.CNIConfig.Cilium.Hubble = &v1alpha1.CiliumHubbleConfig{Relay: true}
+
+	tt.expectHelmClientFactoryGet("", "")
+	tt.expectHelmTemplateWith(eqMap(customHelmValues), "1.22").Return(tt.manifest, nil)
+
+	tt.Expect(tt.t.GenerateManifest(tt.ctx, tt.spec)).To(Equal(tt.manifest))
+}
+
+func TestTemplaterGenerateManifestHubbleMissingBundleImages(t *testing.T) {
+	tt := newtemplaterTest(t)
+	tt.spec.Cluster.Spec.ClusterNetwork.CNIConfig.Cilium.Hubble = &v1alpha1.CiliumHubbleConfig{Relay: true}
+
+	_, err := tt.t.GenerateManifest(tt.ctx, tt.spec)
+	tt.Expect(err).To(MatchError(ContainSubstring("doesn't include the Hubble images")))
+}
+
 func TestTemplaterGenerateManifestPolicyEnforcementModeSuccess(t *testing.T) {
 	wantValues := baseTemplateValues()
 	withPolicyEnforcementMode(wantValues, "always")
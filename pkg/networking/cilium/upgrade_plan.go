@@ -2,6 +2,7 @@ package cilium
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	appsv1 "k8s.io/api/apps/v1"
@@ -35,6 +36,22 @@ const (
 	// CniExclusiveComponentName is the ConfigComponentUpdatePlan name for the
 	// CniExclusive configuration component.
 	CniExclusiveComponentName = "CniExclusive"
+
+	// HubbleMetricsConfigMapKey is the key used in the "cilium-config" ConfigMap to
+	// store the space separated list of Hubble metrics.
+	HubbleMetricsConfigMapKey = "hubble-metrics"
+
+	// HubbleMetricsComponentName is the ConfigComponentUpdatePlan name for the
+	// Hubble metrics configuration component.
+	HubbleMetricsComponentName = "HubbleMetrics"
+
+	// HubbleRelayComponentName is the ConfigComponentUpdatePlan name for the
+	// Hubble Relay configuration component.
+	HubbleRelayComponentName = "HubbleRelay"
+
+	// HubbleUIComponentName is the ConfigComponentUpdatePlan name for the
+	// Hubble UI configuration component.
+	HubbleUIComponentName = "HubbleUI"
 )
 
 // UpgradePlan contains information about a Cilium installation upgrade.
@@ -143,10 +160,14 @@ type ConfigComponentUpdatePlan struct {
 // BuildUpgradePlan generates the upgrade plan information for a cilium installation by comparing it
 // with a desired cluster Spec.
 func BuildUpgradePlan(installation *Installation, clusterSpec *cluster.Spec) UpgradePlan {
+	configPlan := configMapUpgradePlan(installation.ConfigMap, clusterSpec)
+	configPlan.Components = append(configPlan.Components, hubbleUpdatePlan(installation, clusterSpec)...)
+	configPlan.generateUpdateReasonFromComponents()
+
 	return UpgradePlan{
 		DaemonSet: daemonSetUpgradePlan(installation.DaemonSet, clusterSpec),
 		Operator:  operatorUpgradePlan(installation.Operator, clusterSpec),
-		ConfigMap: configMapUpgradePlan(installation.ConfigMap, clusterSpec),
+		ConfigMap: configPlan,
 	}
 }
 
@@ -292,6 +313,46 @@ func configMapUpgradePlan(configMap *corev1.ConfigMap, clusterSpec *cluster.Spec
 	return *updatePlan
 }
 
+// hubbleUpdatePlan compares the Hubble components of the installation with the Hubble configuration of
+// the cluster. Disabled Relay and UI deployments are not removed by the manifest, so only the missing ones
+// require an update.
+func hubbleUpdatePlan(installation *Installation, clusterSpec *cluster.Spec) []ConfigComponentUpdatePlan {
+	hubble := clusterSpec.Cluster.Spec.ClusterNetwork.CNIConfig.Cilium.Hubble
+	if hubble == nil {
+		return nil
+	}
+
+	metricsUpdate := ConfigComponentUpdatePlan{
+		Name:     HubbleMetricsComponentName,
+		NewValue: strings.Join(hubble.Metrics, " "),
+	}
+	if installation.ConfigMap != nil {
+		metricsUpdate.OldValue = strings.Join(strings.Fields(installation.ConfigMap.Data[HubbleMetricsConfigMapKey]), " ")
+	}
+	if metricsUpdate.OldValue != metricsUpdate.NewValue {
+		metricsUpdate.UpdateReason = fmt.Sprintf("Hubble metrics changed: [%s] -> [%s]", metricsUpdate.OldValue, metricsUpdate.NewValue)
+	}
+
+	return []ConfigComponentUpdatePlan{
+		metricsUpdate,
+		hubbleDeploymentUpdatePlan(HubbleRelayComponentName, installation.HubbleRelay, hubble.Relay),
+		hubbleDeploymentUpdatePlan(HubbleUIComponentName, installation.HubbleUI, hubble.UI),
+	}
+}
+
+func hubbleDeploymentUpdatePlan(name string, deployment *appsv1.Deployment, enabled bool) ConfigComponentUpdatePlan {
+	update := ConfigComponentUpdatePlan{
+		Name:     name,
+		OldValue: strconv.FormatBool(deployment != nil),
+		NewValue: strconv.FormatBool(enabled),
+	}
+	if enabled && deployment == nil {
+		update.UpdateReason = fmt.Sprintf("%s is enabled but not installed", name)
+	}
+
+	return update
+}
+
 // ChangeDiff returns the change diff between the current and new cluster specs.
 func ChangeDiff(currentSpec, newSpec *cluster.Spec) *types.ChangeDiff {
 	return ciliumChangeDiff(currentSpec, newSpec)
@@ -301,6 +362,10 @@ func ciliumChangeDiff(currentSpec, newSpec *cluster.Spec) *types.ChangeDiff {
 	currentVersionsBundle := currentSpec.RootVersionsBundle()
 
 	newCiliumCfg := newSpec.Cluster.Spec.ClusterNetwork.CNIConfig.Cilium
+	if newCiliumCfg == nil {
+		return nil
+	}
+
 	if !newCiliumCfg.IsManaged() {
 		return &types.ChangeDiff{
 			ComponentReports: []types.ComponentChangeDiff{
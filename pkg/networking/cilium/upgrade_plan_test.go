@@ -793,6 +793,138 @@ func TestBuildUpgradePlan(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "Hubble relay and metrics up to date",
+			installation: &cilium.Installation{
+				DaemonSet: daemonSet("cilium:v1.0.0"),
+				Operator:  deployment("cilium-operator:v1.0.0"),
+				ConfigMap: ciliumConfigMapWithCNIExclusive("default", "", "true", func(cm *corev1.ConfigMap) {
+					cm.Data[cilium.HubbleMetricsConfigMapKey] = " dns\n drop"
+				}),
+				HubbleRelay: deployment("hubble-relay:v1.0.0"),
+			},
+			clusterSpec: test.NewClusterSpec(func(s *cluster.Spec) {
+				s.VersionsBundles["1.19"].Cilium.Cilium.URI = "cilium:v1.0.0"
+				s.VersionsBundles["1.19"].Cilium.Operator.URI = "cilium-operator:v1.0.0"
+				s.Cluster.Spec.ClusterNetwork.CNIConfig = &anywherev1.CNIConfig{
+					Cilium: &anywherev1.CiliumConfig{
+						Hubble: &anywherev1.CiliumHubbleConfig{
+							Relay:   true,
+							Metrics: []string{"dns", "drop"},
+						},
+					},
+				}
+			}),
+			want: cilium.UpgradePlan{
+				DaemonSet: cilium.VersionedComponentUpgradePlan{
+					OldImage: "cilium:v1.0.0",
+					NewImage: "cilium:v1.0.0",
+				},
+				Operator: cilium.VersionedComponentUpgradePlan{
+					OldImage: "cilium-operator:v1.0.0",
+					NewImage: "cilium-operator:v1.0.0",
+				},
+				ConfigMap: cilium.ConfigUpdatePlan{
+					Components: []cilium.ConfigComponentUpdatePlan{
+						{
+							Name:     cilium.PolicyEnforcementComponentName,
+							OldValue: "default",
+							NewValue: "default",
+						},
+						{
+							Name: "EgressMasqueradeInterfaces",
+						},
+						{
+							Name:     cilium.CniExclusiveComponentName,
+							OldValue: "true",
+							NewValue: "true",
+						},
+						{
+							Name:     cilium.HubbleMetricsComponentName,
+							OldValue: "dns drop",
+							NewValue: "dns drop",
+						},
+						{
+							Name:     cilium.HubbleRelayComponentName,
+							OldValue: "true",
+							NewValue: "true",
+						},
+						{
+							Name:     cilium.HubbleUIComponentName,
+							OldValue: "false",
+							NewValue: "false",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "Hubble relay not installed and metrics changed",
+			installation: &cilium.Installation{
+				DaemonSet: daemonSet("cilium:v1.0.0"),
+				Operator:  deployment("cilium-operator:v1.0.0"),
+				ConfigMap: ciliumConfigMapWithCNIExclusive("default", "", "true", func(cm *corev1.ConfigMap) {
+					cm.Data[cilium.HubbleMetricsConfigMapKey] = "dns"
+				}),
+			},
+			clusterSpec: test.NewClusterSpec(func(s *cluster.Spec) {
+				s.VersionsBundles["1.19"].Cilium.Cilium.URI = "cilium:v1.0.0"
+				s.VersionsBundles["1.19"].Cilium.Operator.URI = "cilium-operator:v1.0.0"
+				s.Cluster.Spec.ClusterNetwork.CNIConfig = &anywherev1.CNIConfig{
+					Cilium: &anywherev1.CiliumConfig{
+						Hubble: &anywherev1.CiliumHubbleConfig{
+							Relay:   true,
+							Metrics: []string{"dns", "drop"},
+						},
+					},
+				}
+			}),
+			want: cilium.UpgradePlan{
+				DaemonSet: cilium.VersionedComponentUpgradePlan{
+					OldImage: "cilium:v1.0.0",
+					NewImage: "cilium:v1.0.0",
+				},
+				Operator: cilium.VersionedComponentUpgradePlan{
+					OldImage: "cilium-operator:v1.0.0",
+					NewImage: "cilium-operator:v1.0.0",
+				},
+				ConfigMap: cilium.ConfigUpdatePlan{
+					UpdateReason: "Hubble metrics changed: [dns] -> [dns drop] - HubbleRelay is enabled but not installed",
+					Components: []cilium.ConfigComponentUpdatePlan{
+						{
+							Name:     cilium.PolicyEnforcementComponentName,
+							OldValue: "default",
+							NewValue: "default",
+						},
+						{
+							Name: "EgressMasqueradeInterfaces",
+						},
+						{
+							Name:     cilium.CniExclusiveComponentName,
+							OldValue: "true",
+							NewValue: "true",
+						},
+						{
+							Name:         cilium.HubbleMetricsComponentName,
+							UpdateReason: "Hubble metrics changed: [dns] -> [dns drop]",
+							OldValue:     "dns",
+							NewValue:     "dns drop",
+						},
+						{
+							Name:         cilium.HubbleRelayComponentName,
+							UpdateReason: "HubbleRelay is enabled but not installed",
+							OldValue:     "false",
+							NewValue:     "true",
+						},
+						{
+							Name:     cilium.HubbleUIComponentName,
+							OldValue: "false",
+							NewValue: "false",
+						},
+					},
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -52,3 +52,41 @@ func (mr *MockCiliumReconcilerMockRecorder) Reconcile(ctx, logger, client, spec
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reconcile", reflect.TypeOf((*MockCiliumReconciler)(nil).Reconcile), ctx, logger, client, spec)
 }
+
+// MockCalicoReconciler is a mock of CalicoReconciler interface.
+type MockCalicoReconciler struct {
+	ctrl     *gomock.Controller
+	recorder *MockCalicoReconcilerMockRecorder
+}
+
+// MockCalicoReconcilerMockRecorder is the mock recorder for MockCalicoReconciler.
+type MockCalicoReconcilerMockRecorder struct {
+	mock *MockCalicoReconciler
+}
+
+// NewMockCalicoReconciler creates a new mock instance.
+func NewMockCalicoReconciler(ctrl *gomock.Controller) *MockCalicoReconciler {
+	mock := &MockCalicoReconciler{ctrl: ctrl}
+	mock.recorder = &MockCalicoReconcilerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCalicoReconciler) EXPECT() *MockCalicoReconcilerMockRecorder {
+	return m.recorder
+}
+
+// Reconcile mocks base method.
+func (m *MockCalicoReconciler) Reconcile(ctx context.Context, logger logr.Logger, client client.Client, spec *cluster.Spec) (controller.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reconcile", ctx, logger, client, spec)
+	ret0, _ := ret[0].(controller.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Reconcile indicates an expected call of Reconcile.
+func (mr *MockCalicoReconcilerMockRecorder) Reconcile(ctx, logger, client, spec interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reconcile", reflect.TypeOf((*MockCalicoReconciler)(nil).Reconcile), ctx, logger, client, spec)
+}
@@ -15,24 +15,34 @@ type CiliumReconciler interface {
 	Reconcile(ctx context.Context, logger logr.Logger, client client.Client, spec *cluster.Spec) (controller.Result, error)
 }
 
+// CalicoReconciler reconciles the Calico CNI of a cluster.
+type CalicoReconciler interface {
+	Reconcile(ctx context.Context, logger logr.Logger, client client.Client, spec *cluster.Spec) (controller.Result, error)
+}
+
 type Reconciler struct {
 	ciliumReconciler CiliumReconciler
+	calicoReconciler CalicoReconciler
 }
 
-func New(ciliumReconciler CiliumReconciler) *Reconciler {
+func New(ciliumReconciler CiliumReconciler, calicoReconciler CalicoReconciler) *Reconciler {
 	return &Reconciler{
 		ciliumReconciler: ciliumReconciler,
+		calicoReconciler: calicoReconciler,
 	}
 }
 
 // Reconcile takes the specified CNI in a cluster to the desired state defined in a cluster Spec
 // It uses a controller.Result to indicate when requeues are needed
 // Intended to be used in a kubernetes controller
-// Only Cilium CNI is supported for now.
+// Cilium and Calico CNIs are supported.
 func (r *Reconciler) Reconcile(ctx context.Context, logger logr.Logger, client client.Client, spec *cluster.Spec) (controller.Result, error) {
-	if spec.Cluster.Spec.ClusterNetwork.CNIConfig.Cilium != nil {
+	switch {
+	case spec.Cluster.Spec.ClusterNetwork.CNIConfig.Cilium != nil:
 		return r.ciliumReconciler.Reconcile(ctx, logger, client, spec)
-	} else {
-		return controller.Result{}, errors.New("unsupported CNI, only Cilium is supported at this time")
+	case spec.Cluster.Spec.ClusterNetwork.CNIConfig.Calico != nil:
+		return r.calicoReconciler.Reconcile(ctx, logger, client, spec)
+	default:
+		return controller.Result{}, errors.New("unsupported CNI, only Cilium and Calico are supported at this time")
 	}
 }
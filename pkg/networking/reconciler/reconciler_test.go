@@ -29,9 +29,10 @@ func TestReconcilerReconcileCilium(t *testing.T) {
 	g := NewWithT(t)
 	ctrl := gomock.NewController(t)
 	ciliumReconciler := mocks.NewMockCiliumReconciler(ctrl)
+	calicoReconciler := mocks.NewMockCalicoReconciler(ctrl)
 	ciliumReconciler.EXPECT().Reconcile(ctx, logger, client, spec)
 
-	r := reconciler.New(ciliumReconciler)
+	r := reconciler.New(ciliumReconciler, calicoReconciler)
 	result, err := r.Reconcile(ctx, logger, client, spec)
 	g.Expect(result).To(Equal(controller.Result{}))
 	g.Expect(err).NotTo(HaveOccurred())
@@ -48,8 +49,31 @@ func TestReconcilerReconcileUnsupportedCNI(t *testing.T) {
 	g := NewWithT(t)
 	ctrl := gomock.NewController(t)
 	ciliumReconciler := mocks.NewMockCiliumReconciler(ctrl)
+	calicoReconciler := mocks.NewMockCalicoReconciler(ctrl)
 
-	r := reconciler.New(ciliumReconciler)
+	r := reconciler.New(ciliumReconciler, calicoReconciler)
 	_, err := r.Reconcile(ctx, logger, client, spec)
-	g.Expect(err).To(MatchError(ContainSubstring("unsupported CNI, only Cilium is supported at this time")))
+	g.Expect(err).To(MatchError(ContainSubstring("unsupported CNI, only Cilium and Calico are supported at this time")))
+}
+
+func TestReconcilerReconcileCalico(t *testing.T) {
+	ctx := context.Background()
+	logger := test.NewNullLogger()
+	client := fake.NewClientBuilder().Build()
+	spec := test.NewClusterSpec(func(s *cluster.Spec) {
+		s.Cluster.Spec.ClusterNetwork.CNIConfig = &v1alpha1.CNIConfig{
+			Calico: &v1alpha1.CalicoConfig{},
+		}
+	})
+
+	g := NewWithT(t)
+	ctrl := gomock.NewController(t)
+	ciliumReconciler := mocks.NewMockCiliumReconciler(ctrl)
+	calicoReconciler := mocks.NewMockCalicoReconciler(ctrl)
+	calicoReconciler.EXPECT().Reconcile(ctx, logger, client, spec)
+
+	r := reconciler.New(ciliumReconciler, calicoReconciler)
+	result, err := r.Reconcile(ctx, logger, client, spec)
+	g.Expect(result).To(Equal(controller.Result{}))
+	g.Expect(err).NotTo(HaveOccurred())
 }
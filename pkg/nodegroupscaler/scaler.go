@@ -0,0 +1,165 @@
+package nodegroupscaler
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"k8s.io/utils/ptr"
+	clusterv1beta2 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/clients/kubernetes"
+	"github.com/aws/eks-anywhere/pkg/clusterapi"
+	"github.com/aws/eks-anywhere/pkg/constants"
+	"github.com/aws/eks-anywhere/pkg/logger"
+	"github.com/aws/eks-anywhere/pkg/retrier"
+)
+
+const (
+	defaultScaleTimeout = time.Hour
+	defaultScaleBackoff = 10 * time.Second
+)
+
+// Scaling holds the parameters of a worker node group scaling.
+type Scaling struct {
+	ClusterName     string
+	Namespace       string
+	WorkerNodeGroup string
+	Count           int
+}
+
+// Scaler changes the count of a worker node group in the Cluster object and waits for its
+// MachineDeployments to reach it.
+type Scaler struct {
+	client  kubernetes.Client
+	retrier *retrier.Retrier
+}
+
+// ScalerOpt allows to customize a Scaler.
+type ScalerOpt func(*Scaler)
+
+// WithScalerRetrier sets the retrier used to wait for the MachineDeployments to be scaled.
+func WithScalerRetrier(r *retrier.Retrier) ScalerOpt {
+	return func(s *Scaler) {
+		s.retrier = r
+	}
+}
+
+// NewScaler returns a new Scaler. The client reads and updates the objects in the management cluster.
+func NewScaler(client kubernetes.Client, opts ...ScalerOpt) *Scaler {
+	s := &Scaler{
+		client:  client,
+		retrier: retrier.New(defaultScaleTimeout, retrier.WithRetryPolicy(retrier.BackOffPolicy(defaultScaleBackoff))),
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	return s
+}
+
+// Scale updates the count of the worker node group in the Cluster object. The controller
+// reconciles the change into the replicas of the group MachineDeployments, without touching
+// the rest of the cluster. It refuses to scale groups the autoscaler manages and clusters
+// managed by GitOps, since the change would be reverted.
+func (s *Scaler) Scale(ctx context.Context, scaling Scaling) error {
+	if scaling.Count < 0 {
+		return fmt.Errorf("count must be a non-negative number, got %d", scaling.Count)
+	}
+
+	cluster := &anywherev1.Cluster{}
+	if err := s.client.Get(ctx, scaling.ClusterName, scaling.Namespace, cluster); err != nil {
+		return fmt.Errorf("reading cluster %s: %v", scaling.ClusterName, err)
+	}
+
+	if cluster.Spec.GitOpsRef != nil {
+		return fmt.Errorf("cluster %s is managed by GitOps, update the count of worker node group %s in its git repository instead", cluster.Name, scaling.WorkerNodeGroup)
+	}
+
+	wng := workerNodeGroup(cluster, scaling.WorkerNodeGroup)
+	if wng == nil {
+		return fmt.Errorf("worker node group %s not found in cluster %s", scaling.WorkerNodeGroup, cluster.Name)
+	}
+
+	if wng.AutoScalingConfiguration != nil {
+		return fmt.Errorf("worker node group %s is autoscaled between %d and %d nodes, update its autoscalingConfiguration instead",
+			wng.Name, wng.AutoScalingConfiguration.MinCount, wng.AutoScalingConfiguration.MaxCount)
+	}
+
+	if wng.Count != nil && *wng.Count == scaling.Count {
+		logger.Info("Worker node group already has the desired count", "workerNodeGroup", wng.Name, "count", scaling.Count)
+		return nil
+	}
+
+	logger.Info("Updating worker node group count", "workerNodeGroup", wng.Name, "from", ptr.Deref(wng.Count, 1), "to", scaling.Count)
+	wng.Count = ptr.To(scaling.Count)
+	if err := s.client.Update(ctx, cluster); err != nil {
+		return fmt.Errorf("updating cluster %s: %v", cluster.Name, err)
+	}
+
+	return nil
+}
+
+// Wait waits for the MachineDeployments of the worker node group to have as many ready
+// replicas as its count.
+func (s *Scaler) Wait(ctx context.Context, scaling Scaling) error {
+	cluster := &anywherev1.Cluster{}
+	if err := s.client.Get(ctx, scaling.ClusterName, scaling.Namespace, cluster); err != nil {
+		return fmt.Errorf("reading cluster %s: %v", scaling.ClusterName, err)
+	}
+
+	wng := workerNodeGroup(cluster, scaling.WorkerNodeGroup)
+	if wng == nil {
+		return fmt.Errorf("worker node group %s not found in cluster %s", scaling.WorkerNodeGroup, cluster.Name)
+	}
+
+	names := clusterapi.MachineDeploymentNames(cluster, *wng)
+	logger.Info("Waiting for worker node group to be scaled", "workerNodeGroup", wng.Name, "count", scaling.Count)
+	if err := s.retrier.Retry(func() error {
+		return s.checkScaled(ctx, names, scaling.Count)
+	}); err != nil {
+		return fmt.Errorf("waiting for worker node group %s to be scaled: %v", wng.Name, err)
+	}
+
+	logger.Info("Worker node group scaled", "workerNodeGroup", wng.Name, "count", scaling.Count)
+
+	return nil
+}
+
+// checkScaled checks the MachineDeployments of a worker node group have been reconciled with
+// the desired count of the group split across them, and all their replicas are ready.
+func (s *Scaler) checkScaled(ctx context.Context, machineDeployments []string, count int) error {
+	var desired, replicas, ready int32
+	for _, name := range machineDeployments {
+		md := &clusterv1beta2.MachineDeployment{}
+		if err := s.client.Get(ctx, name, constants.EksaSystemNamespace, md); err != nil {
+			return fmt.Errorf("reading machine deployment %s: %v", name, err)
+		}
+		if md.Status.ObservedGeneration < md.Generation {
+			return fmt.Errorf("machine deployment %s has not been reconciled yet", name)
+		}
+		desired += ptr.Deref(md.Spec.Replicas, 0)
+		replicas += ptr.Deref(md.Status.Replicas, 0)
+		ready += ptr.Deref(md.Status.ReadyReplicas, 0)
+	}
+
+	if int(desired) != count {
+		return fmt.Errorf("machine deployments have %d desired replicas, expected %d", desired, count)
+	}
+	if replicas != desired || ready != desired {
+		return fmt.Errorf("machine deployments have %d replicas and %d ready replicas, expected %d", replicas, ready, desired)
+	}
+
+	return nil
+}
+
+func workerNodeGroup(cluster *anywherev1.Cluster, name string) *anywherev1.WorkerNodeGroupConfiguration {
+	for i := range cluster.Spec.WorkerNodeGroupConfigurations {
+		if cluster.Spec.WorkerNodeGroupConfigurations[i].Name == name {
+			return &cluster.Spec.WorkerNodeGroupConfigurations[i]
+		}
+	}
+
+	return nil
+}
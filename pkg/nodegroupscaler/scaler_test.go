@@ -0,0 +1,245 @@
+package nodegroupscaler_test
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	clusterv1beta2 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+
+	"github.com/aws/eks-anywhere/internal/test"
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/constants"
+	"github.com/aws/eks-anywhere/pkg/nodegroupscaler"
+	"github.com/aws/eks-anywhere/pkg/retrier"
+)
+
+func cluster(opts ...func(*anywherev1.Cluster)) *anywherev1.Cluster {
+	c := &anywherev1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-cluster", Namespace: "default"},
+		Spec: anywherev1.ClusterSpec{
+			WorkerNodeGroupConfigurations: []anywherev1.WorkerNodeGroupConfiguration{
+				{Name: "md-0", Count: ptr.To(2)},
+			},
+		},
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	return c
+}
+
+func machineDeployment(desired, ready int32) *clusterv1beta2.MachineDeployment {
+	return &clusterv1beta2.MachineDeployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-cluster-md-0", Namespace: constants.EksaSystemNamespace, Generation: 2},
+		Spec:       clusterv1beta2.MachineDeploymentSpec{Replicas: ptr.To(desired)},
+		Status: clusterv1beta2.MachineDeploymentStatus{
+			ObservedGeneration: 2,
+			Replicas:           ptr.To(desired),
+			ReadyReplicas:      ptr.To(ready),
+		},
+	}
+}
+
+func scaling(count int) nodegroupscaler.Scaling {
+	return nodegroupscaler.Scaling{ClusterName: "my-cluster", Namespace: "default", WorkerNodeGroup: "md-0", Count: count}
+}
+
+func TestScalerScale(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	c := test.NewFakeKubeClient(cluster())
+
+	g.Expect(nodegroupscaler.NewScaler(c).Scale(ctx, scaling(5))).To(Succeed())
+
+	updated := &anywherev1.Cluster{}
+	g.Expect(c.Get(ctx, "my-cluster", "default", updated)).To(Succeed())
+	g.Expect(updated.Spec.WorkerNodeGroupConfigurations[0].Count).To(Equal(ptr.To(5)))
+}
+
+func TestScalerScaleErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		cluster *anywherev1.Cluster
+		scaling nodegroupscaler.Scaling
+		wantErr string
+	}{
+		{
+			name:    "negative count",
+			cluster: cluster(),
+			scaling: scaling(-1),
+			wantErr: "count must be a non-negative number, got -1",
+		},
+		{
+			name: "cluster not found",
+			cluster: cluster(func(c *anywherev1.Cluster) {
+				c.Name = "other-cluster"
+			}),
+			scaling: scaling(3),
+			wantErr: "reading cluster my-cluster",
+		},
+		{
+			name: "worker node group not found",
+			cluster: cluster(func(c *anywherev1.Cluster) {
+				c.Spec.WorkerNodeGroupConfigurations[0].Name = "md-1"
+			}),
+			scaling: scaling(3),
+			wantErr: "worker node group md-0 not found in cluster my-cluster",
+		},
+		{
+			name: "autoscaled worker node group",
+			cluster: cluster(func(c *anywherev1.Cluster) {
+				c.Spec.WorkerNodeGroupConfigurations[0].AutoScalingConfiguration = &anywherev1.AutoScalingConfiguration{MinCount: 1, MaxCount: 5}
+			}),
+			scaling: scaling(3),
+			wantErr: "worker node group md-0 is autoscaled between 1 and 5 nodes",
+		},
+		{
+			name: "gitops cluster",
+			cluster: cluster(func(c *anywherev1.Cluster) {
+				c.Spec.GitOpsRef = &anywherev1.Ref{Kind: anywherev1.FluxConfigKind, Name: "flux"}
+			}),
+			scaling: scaling(3),
+			wantErr: "cluster my-cluster is managed by GitOps",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			scaler := nodegroupscaler.NewScaler(test.NewFakeKubeClient(tc.cluster))
+			g.Expect(scaler.Scale(context.Background(), tc.scaling)).To(MatchError(ContainSubstring(tc.wantErr)))
+		})
+	}
+}
+
+func TestScalerWait(t *testing.T) {
+	g := NewWithT(t)
+	c := test.NewFakeKubeClient(cluster(), machineDeployment(2, 2))
+	scaler := nodegroupscaler.NewScaler(c, nodegroupscaler.WithScalerRetrier(retrier.NewWithMaxRetries(1, 0)))
+
+	g.Expect(scaler.Wait(context.Background(), scaling(2))).To(Succeed())
+}
+
+func TestScalerWaitNotReady(t *testing.T) {
+	tests := []struct {
+		name    string
+		md      *clusterv1beta2.MachineDeployment
+		wantErr string
+	}{
+		{
+			name:    "desired replicas not updated",
+			md:      machineDeployment(2, 2),
+			wantErr: "machine deployments have 2 desired replicas, expected 5",
+		},
+		{
+			name:    "replicas not ready",
+			md:      machineDeployment(5, 3),
+			wantErr: "machine deployments have 5 replicas and 3 ready replicas, expected 5",
+		},
+		{
+			name: "not reconciled",
+			md: func() *clusterv1beta2.MachineDeployment {
+				md := machineDeployment(5, 5)
+				md.Status.ObservedGeneration = 1
+				return md
+			}(),
+			wantErr: "machine deployment my-cluster-md-0 has not been reconciled yet",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			c := test.NewFakeKubeClient(cluster(), tc.md)
+			scaler := nodegroupscaler.NewScaler(c, nodegroupscaler.WithScalerRetrier(retrier.NewWithMaxRetries(1, 0)))
+
+			g.Expect(scaler.Wait(context.Background(), scaling(5))).To(MatchError(ContainSubstring(tc.wantErr)))
+		})
+	}
+}
+
+func TestUpdateSpecFile(t *testing.T) {
+	g := NewWithT(t)
+	content := []byte(`apiVersion: anywhere.eks.amazonaws.com/v1alpha1
+kind: Cluster
+metadata:
+  name: my-cluster
+spec:
+  workerNodeGroupConfigurations:
+  - count: 2 # worker nodes
+    name: md-0
+  - name: md-1
+---
+apiVersion: anywhere.eks.amazonaws.com/v1alpha1
+kind: VSphereMachineConfig
+metadata:
+  name: my-cluster
+`)
+
+	updated, err := nodegroupscaler.UpdateSpecFile(content, scaling(5))
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(string(updated)).To(Equal(`apiVersion: anywhere.eks.amazonaws.com/v1alpha1
+kind: Cluster
+metadata:
+  name: my-cluster
+spec:
+  workerNodeGroupConfigurations:
+  - count: 5 # worker nodes
+    name: md-0
+  - name: md-1
+---
+apiVersion: anywhere.eks.amazonaws.com/v1alpha1
+kind: VSphereMachineConfig
+metadata:
+  name: my-cluster
+`))
+
+	s := scaling(3)
+	s.WorkerNodeGroup = "md-1"
+	updated, err = nodegroupscaler.UpdateSpecFile(updated, s)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(string(updated)).To(ContainSubstring("  - name: md-1\n    count: 3\n---"))
+}
+
+func TestUpdateSpecFileErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		wantErr string
+	}{
+		{
+			name:    "invalid yaml",
+			content: "kind: [Cluster",
+			wantErr: "parsing cluster spec file",
+		},
+		{
+			name:    "cluster not found",
+			content: "kind: Cluster\nmetadata:\n  name: other-cluster\n",
+			wantErr: "cluster my-cluster not found in cluster spec file",
+		},
+		{
+			name:    "flow style worker node group",
+			content: "kind: Cluster\nmetadata:\n  name: my-cluster\nspec:\n  workerNodeGroupConfigurations: [{name: md-0}]\n",
+			wantErr: "worker node group md-0 must be in block style in cluster spec file",
+		},
+		{
+			name:    "quoted count",
+			content: "kind: Cluster\nmetadata:\n  name: my-cluster\nspec:\n  workerNodeGroupConfigurations:\n  - name: md-0\n    count: \"2\"\n",
+			wantErr: "count of worker node group md-0 must be a plain number in cluster spec file",
+		},
+		{
+			name:    "worker node group not found",
+			content: "kind: Cluster\nmetadata:\n  name: my-cluster\nspec:\n  workerNodeGroupConfigurations:\n  - name: md-1\n",
+			wantErr: "worker node group md-0 not found in cluster spec file",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			_, err := nodegroupscaler.UpdateSpecFile([]byte(tc.content), scaling(5))
+			g.Expect(err).To(MatchError(ContainSubstring(tc.wantErr)))
+		})
+	}
+}
@@ -0,0 +1,113 @@
+package nodegroupscaler
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+)
+
+// UpdateSpecFile sets the count of a worker node group of the cluster in the content of a
+// cluster spec file. Only the count line is changed, or added after the group name when it's
+// not set, so the rest of the file, including its formatting and comments, is kept as is.
+func UpdateSpecFile(content []byte, scaling Scaling) ([]byte, error) {
+	decoder := yaml.NewDecoder(bytes.NewReader(content))
+	for {
+		doc := &yaml.Node{}
+		err := decoder.Decode(doc)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("parsing cluster spec file: %v", err)
+		}
+		if len(doc.Content) == 0 || !isCluster(doc.Content[0], scaling.ClusterName) {
+			continue
+		}
+
+		return setWorkerNodeGroupCount(content, doc.Content[0], scaling)
+	}
+
+	return nil, fmt.Errorf("cluster %s not found in cluster spec file", scaling.ClusterName)
+}
+
+func isCluster(obj *yaml.Node, name string) bool {
+	kind := mappingValue(obj, "kind")
+	if kind == nil || kind.Value != anywherev1.ClusterKind {
+		return false
+	}
+	objName := mappingValue(mappingValue(obj, "metadata"), "name")
+
+	return objName != nil && objName.Value == name
+}
+
+func setWorkerNodeGroupCount(content []byte, cluster *yaml.Node, scaling Scaling) ([]byte, error) {
+	groups := mappingValue(mappingValue(cluster, "spec"), "workerNodeGroupConfigurations")
+	if groups == nil || groups.Kind != yaml.SequenceNode {
+		return nil, fmt.Errorf("worker node group %s not found in cluster spec file", scaling.WorkerNodeGroup)
+	}
+
+	for _, group := range groups.Content {
+		name := mappingValue(group, "name")
+		if name == nil || name.Value != scaling.WorkerNodeGroup {
+			continue
+		}
+
+		if group.Style&yaml.FlowStyle != 0 {
+			return nil, fmt.Errorf("worker node group %s must be in block style in cluster spec file", scaling.WorkerNodeGroup)
+		}
+
+		lines := bytes.SplitAfter(content, []byte("\n"))
+		count := strconv.Itoa(scaling.Count)
+		if value := mappingValue(group, "count"); value != nil {
+			if value.Kind != yaml.ScalarNode || value.Style != 0 {
+				return nil, fmt.Errorf("count of worker node group %s must be a plain number in cluster spec file", scaling.WorkerNodeGroup)
+			}
+			line := lines[value.Line-1]
+			start := value.Column - 1
+			lines[value.Line-1] = append(append(append([]byte{}, line[:start]...), count...), line[start+len(value.Value):]...)
+			return bytes.Join(lines, nil), nil
+		}
+
+		// The name key column is the indentation of the keys of the group.
+		nameKey := group.Content[indexOfKey(group, "name")]
+		countLine := []byte(strings.Repeat(" ", nameKey.Column-1) + "count: " + count + "\n")
+		after := name.Line
+		if after == len(lines) && !bytes.HasSuffix(lines[after-1], []byte("\n")) {
+			lines[after-1] = append(lines[after-1], '\n')
+		}
+		lines = append(lines[:after], append([][]byte{countLine}, lines[after:]...)...)
+		return bytes.Join(lines, nil), nil
+	}
+
+	return nil, fmt.Errorf("worker node group %s not found in cluster spec file", scaling.WorkerNodeGroup)
+}
+
+func indexOfKey(node *yaml.Node, key string) int {
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// mappingValue returns the value of the key in a mapping node, or nil if the node is not a
+// mapping or doesn't have the key.
+func mappingValue(node *yaml.Node, key string) *yaml.Node {
+	if node == nil || node.Kind != yaml.MappingNode {
+		return nil
+	}
+	if i := indexOfKey(node, key); i >= 0 {
+		return node.Content[i+1]
+	}
+
+	return nil
+}
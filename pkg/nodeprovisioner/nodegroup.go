@@ -0,0 +1,202 @@
+package nodeprovisioner
+
+import (
+	"sort"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
+)
+
+// NodeGroup is a worker node group scaled by the on-demand provisioner, as seen by the pods.
+type NodeGroup struct {
+	// Labels are the labels of the nodes of the group.
+	Labels map[string]string
+	// Taints are the taints of the nodes of the group.
+	Taints []corev1.Taint
+	// Allocatable is the resources of a new node of the group available for the pods.
+	Allocatable corev1.ResourceList
+}
+
+// Accepts returns whether the pod can be scheduled on the nodes of the group, ignoring their resources:
+// it must tolerate their taints and match them with its node selector and required node affinity.
+func (g *NodeGroup) Accepts(pod *corev1.Pod) bool {
+	for i := range g.Taints {
+		taint := &g.Taints[i]
+		if taint.Effect != corev1.TaintEffectNoSchedule && taint.Effect != corev1.TaintEffectNoExecute {
+			continue
+		}
+		if !toleratesTaint(pod.Spec.Tolerations, taint) {
+			return false
+		}
+	}
+
+	if !labels.SelectorFromSet(pod.Spec.NodeSelector).Matches(labels.Set(g.Labels)) {
+		return false
+	}
+
+	affinity := pod.Spec.Affinity
+	if affinity == nil || affinity.NodeAffinity == nil || affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution == nil {
+		return true
+	}
+
+	// The terms are ORed.
+	for _, term := range affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms {
+		if matchesNodeSelectorTerm(term, g.Labels) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// NodesFor returns the number of new nodes of the group needed to schedule the pods it accepts,
+// packing them on as few nodes as possible, and the pods left out, because the group doesn't
+// accept them or they don't fit in one of its nodes.
+func (g *NodeGroup) NodesFor(pods []*corev1.Pod) (int, []*corev1.Pod) {
+	type request struct {
+		pod       *corev1.Pod
+		resources corev1.ResourceList
+	}
+
+	requests := make([]request, 0, len(pods))
+	var left []*corev1.Pod
+	for _, pod := range pods {
+		resources := podRequests(pod)
+		if !g.Accepts(pod) || !fits(resources, g.Allocatable) {
+			left = append(left, pod)
+			continue
+		}
+		requests = append(requests, request{pod: pod, resources: resources})
+	}
+
+	// First fit decreasing: the largest pods are placed first, each one on the first node it fits in.
+	sort.SliceStable(requests, func(i, j int) bool {
+		if c := requests[i].resources.Cpu().Cmp(*requests[j].resources.Cpu()); c != 0 {
+			return c > 0
+		}
+		return requests[i].resources.Memory().Cmp(*requests[j].resources.Memory()) > 0
+	})
+
+	var nodes []corev1.ResourceList
+	for _, r := range requests {
+		placed := false
+		for _, free := range nodes {
+			if fits(r.resources, free) {
+				subtract(free, r.resources)
+				placed = true
+				break
+			}
+		}
+		if !placed {
+			free := g.Allocatable.DeepCopy()
+			subtract(free, r.resources)
+			nodes = append(nodes, free)
+		}
+	}
+
+	return len(nodes), left
+}
+
+// podRequests returns the resources needed to run the pod: the sum of the requests of its containers,
+// or the largest request of its init containers when it's higher, plus its overhead and the pod itself.
+func podRequests(pod *corev1.Pod) corev1.ResourceList {
+	requests := corev1.ResourceList{}
+	for _, c := range pod.Spec.Containers {
+		add(requests, c.Resources.Requests)
+	}
+
+	for _, c := range pod.Spec.InitContainers {
+		for name, quantity := range c.Resources.Requests {
+			if current, ok := requests[name]; !ok || quantity.Cmp(current) > 0 {
+				requests[name] = quantity.DeepCopy()
+			}
+		}
+	}
+
+	add(requests, pod.Spec.Overhead)
+	add(requests, corev1.ResourceList{corev1.ResourcePods: *resource.NewQuantity(1, resource.DecimalSI)})
+
+	return requests
+}
+
+// fits returns whether the requests fit in the available resources. Resources not
+// available at all, like extended resources missing in the group, never fit.
+func fits(requests, available corev1.ResourceList) bool {
+	for name, quantity := range requests {
+		if quantity.IsZero() {
+			continue
+		}
+		free, ok := available[name]
+		if !ok || quantity.Cmp(free) > 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+func add(to, resources corev1.ResourceList) {
+	for name, quantity := range resources {
+		current := to[name]
+		current.Add(quantity)
+		to[name] = current
+	}
+}
+
+func subtract(from, resources corev1.ResourceList) {
+	for name, quantity := range resources {
+		current, ok := from[name]
+		if !ok {
+			continue
+		}
+		current.Sub(quantity)
+		from[name] = current
+	}
+}
+
+func toleratesTaint(tolerations []corev1.Toleration, taint *corev1.Taint) bool {
+	for i := range tolerations {
+		if tolerations[i].ToleratesTaint(taint) {
+			return true
+		}
+	}
+
+	return false
+}
+
+var nodeSelectorOperators = map[corev1.NodeSelectorOperator]selection.Operator{
+	corev1.NodeSelectorOpIn:           selection.In,
+	corev1.NodeSelectorOpNotIn:        selection.NotIn,
+	corev1.NodeSelectorOpExists:       selection.Exists,
+	corev1.NodeSelectorOpDoesNotExist: selection.DoesNotExist,
+	corev1.NodeSelectorOpGt:           selection.GreaterThan,
+	corev1.NodeSelectorOpLt:           selection.LessThan,
+}
+
+// matchesNodeSelectorTerm returns whether the node labels match all the expressions of the term.
+// Terms with field expressions select specific nodes, so they never match a new node.
+func matchesNodeSelectorTerm(term corev1.NodeSelectorTerm, nodeLabels map[string]string) bool {
+	if len(term.MatchFields) > 0 {
+		return false
+	}
+	if len(term.MatchExpressions) == 0 {
+		return false
+	}
+
+	selector := labels.NewSelector()
+	for _, expr := range term.MatchExpressions {
+		op, ok := nodeSelectorOperators[expr.Operator]
+		if !ok {
+			return false
+		}
+		requirement, err := labels.NewRequirement(expr.Key, op, expr.Values)
+		if err != nil {
+			return false
+		}
+		selector = selector.Add(*requirement)
+	}
+
+	return selector.Matches(labels.Set(nodeLabels))
+}
@@ -0,0 +1,205 @@
+package nodeprovisioner_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/aws/eks-anywhere/pkg/nodeprovisioner"
+)
+
+func resources(cpu, memory string) corev1.ResourceList {
+	return corev1.ResourceList{
+		corev1.ResourceCPU:    resource.MustParse(cpu),
+		corev1.ResourceMemory: resource.MustParse(memory),
+	}
+}
+
+func pendingPod(name, cpu, memory string, opts ...func(*corev1.Pod)) *corev1.Pod {
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "default",
+		},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{
+				{
+					Name:      "app",
+					Resources: corev1.ResourceRequirements{Requests: resources(cpu, memory)},
+				},
+			},
+		},
+		Status: corev1.PodStatus{
+			Phase: corev1.PodPending,
+			Conditions: []corev1.PodCondition{
+				{
+					Type:   corev1.PodScheduled,
+					Status: corev1.ConditionFalse,
+					Reason: corev1.PodReasonUnschedulable,
+				},
+			},
+		},
+	}
+	for _, opt := range opts {
+		opt(pod)
+	}
+
+	return pod
+}
+
+func nodeGroup() *nodeprovisioner.NodeGroup {
+	allocatable := resources("4", "8Gi")
+	allocatable[corev1.ResourcePods] = resource.MustParse("110")
+	return &nodeprovisioner.NodeGroup{
+		Labels: map[string]string{
+			"kubernetes.io/os": "linux",
+			"type":             "batch",
+		},
+		Taints: []corev1.Taint{
+			{Key: "dedicated", Value: "batch", Effect: corev1.TaintEffectNoSchedule},
+			{Key: "preferred", Effect: corev1.TaintEffectPreferNoSchedule},
+		},
+		Allocatable: allocatable,
+	}
+}
+
+func tolerateBatch(pod *corev1.Pod) {
+	pod.Spec.Tolerations = []corev1.Toleration{
+		{Key: "dedicated", Operator: corev1.TolerationOpEqual, Value: "batch", Effect: corev1.TaintEffectNoSchedule},
+	}
+}
+
+func requireNodeAffinity(terms ...corev1.NodeSelectorTerm) func(*corev1.Pod) {
+	return func(pod *corev1.Pod) {
+		pod.Spec.Affinity = &corev1.Affinity{
+			NodeAffinity: &corev1.NodeAffinity{
+				RequiredDuringSchedulingIgnoredDuringExecution: &corev1.NodeSelector{NodeSelectorTerms: terms},
+			},
+		}
+	}
+}
+
+func TestNodeGroupAccepts(t *testing.T) {
+	tests := []struct {
+		name string
+		pod  *corev1.Pod
+		want bool
+	}{
+		{
+			name: "tolerates taints",
+			pod:  pendingPod("pod", "1", "1Gi", tolerateBatch),
+			want: true,
+		},
+		{
+			name: "doesn't tolerate taints",
+			pod:  pendingPod("pod", "1", "1Gi"),
+			want: false,
+		},
+		{
+			name: "node selector matches",
+			pod: pendingPod("pod", "1", "1Gi", tolerateBatch, func(p *corev1.Pod) {
+				p.Spec.NodeSelector = map[string]string{"type": "batch"}
+			}),
+			want: true,
+		},
+		{
+			name: "node selector doesn't match",
+			pod: pendingPod("pod", "1", "1Gi", tolerateBatch, func(p *corev1.Pod) {
+				p.Spec.NodeSelector = map[string]string{"type": "web"}
+			}),
+			want: false,
+		},
+		{
+			name: "node affinity matches one term",
+			pod: pendingPod("pod", "1", "1Gi", tolerateBatch, requireNodeAffinity(
+				corev1.NodeSelectorTerm{MatchExpressions: []corev1.NodeSelectorRequirement{
+					{Key: "type", Operator: corev1.NodeSelectorOpIn, Values: []string{"web"}},
+				}},
+				corev1.NodeSelectorTerm{MatchExpressions: []corev1.NodeSelectorRequirement{
+					{Key: "type", Operator: corev1.NodeSelectorOpIn, Values: []string{"batch"}},
+					{Key: "gpu", Operator: corev1.NodeSelectorOpDoesNotExist},
+				}},
+			)),
+			want: true,
+		},
+		{
+			name: "node affinity doesn't match",
+			pod: pendingPod("pod", "1", "1Gi", tolerateBatch, requireNodeAffinity(
+				corev1.NodeSelectorTerm{MatchExpressions: []corev1.NodeSelectorRequirement{
+					{Key: "type", Operator: corev1.NodeSelectorOpNotIn, Values: []string{"batch"}},
+				}},
+			)),
+			want: false,
+		},
+		{
+			name: "node affinity with fields",
+			pod: pendingPod("pod", "1", "1Gi", tolerateBatch, requireNodeAffinity(
+				corev1.NodeSelectorTerm{MatchFields: []corev1.NodeSelectorRequirement{
+					{Key: "metadata.name", Operator: corev1.NodeSelectorOpIn, Values: []string{"node-1"}},
+				}},
+			)),
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(nodeGroup().Accepts(tt.pod)).To(Equal(tt.want))
+		})
+	}
+}
+
+func TestNodeGroupNodesFor(t *testing.T) {
+	g := NewWithT(t)
+	group := nodeGroup()
+	tooBig := pendingPod("too-big", "8", "1Gi", tolerateBatch)
+	notAccepted := pendingPod("not-accepted", "1", "1Gi")
+	pods := []*corev1.Pod{
+		pendingPod("small", "1", "1Gi", tolerateBatch),
+		pendingPod("large-1", "2", "2Gi", tolerateBatch),
+		tooBig,
+		pendingPod("large-2", "2", "2Gi", tolerateBatch),
+		notAccepted,
+		pendingPod("large-3", "2", "2Gi", tolerateBatch),
+	}
+
+	nodes, left := group.NodesFor(pods)
+	g.Expect(nodes).To(Equal(2))
+	g.Expect(left).To(ConsistOf(tooBig, notAccepted))
+	g.Expect(group.Allocatable.Cpu().String()).To(Equal("4"), "the group allocatable resources should not change")
+}
+
+func TestNodeGroupNodesForInitContainersAndPodsLimit(t *testing.T) {
+	g := NewWithT(t)
+	group := nodeGroup()
+	group.Allocatable[corev1.ResourcePods] = resource.MustParse("2")
+
+	withInit := pendingPod("with-init", "1", "1Gi", tolerateBatch, func(p *corev1.Pod) {
+		p.Spec.InitContainers = []corev1.Container{
+			{Name: "init", Resources: corev1.ResourceRequirements{Requests: resources("3", "1Gi")}},
+		}
+	})
+	pods := []*corev1.Pod{
+		withInit,
+		pendingPod("pod-1", "100m", "100Mi", tolerateBatch),
+		pendingPod("pod-2", "100m", "100Mi", tolerateBatch),
+	}
+
+	nodes, left := group.NodesFor(pods)
+	g.Expect(nodes).To(Equal(2))
+	g.Expect(left).To(BeEmpty())
+}
+
+func TestNodeGroupNodesForMissingExtendedResource(t *testing.T) {
+	g := NewWithT(t)
+	gpuPod := pendingPod("gpu", "1", "1Gi", tolerateBatch, func(p *corev1.Pod) {
+		p.Spec.Containers[0].Resources.Requests["nvidia.com/gpu"] = resource.MustParse("1")
+	})
+
+	nodes, left := nodeGroup().NodesFor([]*corev1.Pod{gpuPod})
+	g.Expect(nodes).To(Equal(0))
+	g.Expect(left).To(ConsistOf(gpuPod))
+}
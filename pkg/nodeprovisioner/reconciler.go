@@ -0,0 +1,391 @@
+package nodeprovisioner
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	clusterv1beta2 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/clusterapi"
+	"github.com/aws/eks-anywhere/pkg/constants"
+	"github.com/aws/eks-anywhere/pkg/controller"
+	"github.com/aws/eks-anywhere/pkg/controller/clusters"
+)
+
+const (
+	// requeueAfter is how often the on-demand worker node groups are scaled.
+	requeueAfter = 30 * time.Second
+	// consolidationDelay is how long a node must stay empty before being removed.
+	consolidationDelay = 10 * time.Minute
+	// EmptySinceAnnotation records on a Machine since when its node runs no pods other than the daemon set ones.
+	EmptySinceAnnotation = "anywhere.eks.amazonaws.com/on-demand-empty-since"
+	// defaultMaxPods is the kubelet default for the maximum number of pods of a node.
+	defaultMaxPods = 110
+)
+
+// RemoteClientRegistry gets clients for remote clusters.
+type RemoteClientRegistry interface {
+	GetClient(ctx context.Context, cluster client.ObjectKey) (client.Client, error)
+}
+
+// Reconciler scales the worker node groups of a cluster configured with the on-demand provisioner:
+// it adds nodes to the groups when pods can't be scheduled and removes the nodes that stay empty.
+type Reconciler struct {
+	client               client.Client
+	remoteClientRegistry RemoteClientRegistry
+	now                  func() time.Time
+}
+
+// New returns a new Reconciler.
+func New(client client.Client, remoteClientRegistry RemoteClientRegistry) *Reconciler {
+	return &Reconciler{
+		client:               client,
+		remoteClientRegistry: remoteClientRegistry,
+		now:                  time.Now,
+	}
+}
+
+// Enabled returns whether the cluster has worker node groups scaled by the on-demand provisioner.
+func Enabled(cluster *anywherev1.Cluster) bool {
+	for _, w := range cluster.Spec.WorkerNodeGroupConfigurations {
+		if w.AutoScalingConfiguration.OnDemand() {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Reconcile scales the on-demand worker node groups of the cluster, once its control plane is ready.
+// It always requeues, since the scaling depends on the pods of the cluster and not on its spec.
+func (r *Reconciler) Reconcile(ctx context.Context, log logr.Logger, cluster *anywherev1.Cluster) (controller.Result, error) {
+	if !Enabled(cluster) {
+		return controller.Result{}, nil
+	}
+
+	log = log.WithValues("provisioner", anywherev1.OnDemandProvisioner)
+
+	result, err := clusters.CheckControlPlaneReady(ctx, r.client, log, cluster)
+	if err != nil {
+		return controller.Result{}, errors.Wrap(err, "checking controlplane ready")
+	}
+	if result.Return() {
+		return controller.ResultWithRequeue(requeueAfter), nil
+	}
+
+	remoteClient, err := r.remoteClientRegistry.GetClient(ctx, controller.CapiClusterObjectKey(cluster))
+	if err != nil {
+		return controller.Result{}, errors.Wrap(err, "getting workload cluster's client to scale on-demand worker node groups")
+	}
+
+	pods := &corev1.PodList{}
+	if err := remoteClient.List(ctx, pods); err != nil {
+		return controller.Result{}, errors.Wrap(err, "listing pods")
+	}
+
+	var pending []*corev1.Pod
+	podsByNode := map[string][]*corev1.Pod{}
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		if unschedulable(pod) {
+			pending = append(pending, pod)
+		}
+		if pod.Spec.NodeName != "" && !terminated(pod) {
+			podsByNode[pod.Spec.NodeName] = append(podsByNode[pod.Spec.NodeName], pod)
+		}
+	}
+
+	for _, w := range cluster.Spec.WorkerNodeGroupConfigurations {
+		if !w.AutoScalingConfiguration.OnDemand() {
+			continue
+		}
+
+		s := &nodeGroupScaler{
+			Reconciler:   r,
+			log:          log.WithValues("workerNodeGroup", w.Name),
+			remoteClient: remoteClient,
+			cluster:      cluster,
+			config:       w,
+			podsByNode:   podsByNode,
+		}
+		pending, err = s.scale(ctx, pending)
+		if err != nil {
+			return controller.Result{}, errors.Wrapf(err, "scaling worker node group %s", w.Name)
+		}
+	}
+
+	return controller.ResultWithRequeue(requeueAfter), nil
+}
+
+// nodeGroupScaler scales one on-demand worker node group.
+type nodeGroupScaler struct {
+	*Reconciler
+	log          logr.Logger
+	remoteClient client.Client
+	cluster      *anywherev1.Cluster
+	config       anywherev1.WorkerNodeGroupConfiguration
+	podsByNode   map[string][]*corev1.Pod
+}
+
+// scale adds the nodes needed for the pending pods the group accepts, or removes one of its
+// empty nodes when it doesn't need more. It returns the pending pods left for the other groups.
+func (s *nodeGroupScaler) scale(ctx context.Context, pending []*corev1.Pod) ([]*corev1.Pod, error) {
+	md := &clusterv1beta2.MachineDeployment{}
+	key := client.ObjectKey{Name: clusterapi.MachineDeploymentName(s.cluster, s.config), Namespace: constants.EksaSystemNamespace}
+	if err := s.client.Get(ctx, key, md); err != nil {
+		if apierrors.IsNotFound(err) {
+			s.log.Info("MachineDeployment does not exist yet, skipping")
+			return pending, nil
+		}
+		return nil, errors.Wrapf(err, "reading MachineDeployment %s", key.Name)
+	}
+
+	machines := &clusterv1beta2.MachineList{}
+	if err := s.client.List(ctx, machines,
+		client.InNamespace(constants.EksaSystemNamespace),
+		client.MatchingLabels{clusterv1beta2.MachineDeploymentNameLabel: md.Name},
+	); err != nil {
+		return nil, errors.Wrapf(err, "listing machines of MachineDeployment %s", md.Name)
+	}
+
+	group, err := s.nodeGroup(ctx, machines.Items)
+	if err != nil {
+		return nil, err
+	}
+
+	needed, left := group.NodesFor(pending)
+
+	replicas := int32Value(md.Spec.Replicas)
+	ready := int32Value(md.Status.ReadyReplicas)
+	// The machines still being provisioned will take some of the pending pods.
+	provisioning := max(replicas-ready, 0)
+	desired := replicas + max(int32(needed)-provisioning, 0)
+	desired = min(max(desired, int32(s.config.AutoScalingConfiguration.MinCount)), int32(s.config.AutoScalingConfiguration.MaxCount))
+
+	if desired != replicas {
+		s.log.Info("Scaling worker node group", "pendingPods", len(pending)-len(left), "from", replicas, "to", desired)
+		return left, s.setReplicas(ctx, md, desired)
+	}
+
+	if needed > 0 || ready != replicas {
+		return left, nil
+	}
+
+	return left, s.consolidate(ctx, md, machines.Items)
+}
+
+// nodeGroup builds the NodeGroup of the worker node group. The resources of a new node are read
+// from a ready node of the group, without the daemon set pods it runs, or, before the group has
+// any node, from its machine config. The architecture depends on the template of the machine config,
+// so it's only known from a ready node: until then, pods selecting an architecture don't match the group.
+func (s *nodeGroupScaler) nodeGroup(ctx context.Context, machines []clusterv1beta2.Machine) (*NodeGroup, error) {
+	group := &NodeGroup{
+		Labels: map[string]string{
+			corev1.LabelOSStable: "linux",
+		},
+		Taints: append([]corev1.Taint{}, s.config.Taints...),
+	}
+	for k, v := range s.config.Labels {
+		group.Labels[k] = v
+	}
+	if s.config.GPU != nil {
+		group.Labels[anywherev1.GPUNodeLabel] = "true"
+		if s.config.GPU.TaintEnabled() {
+			group.Taints = append(group.Taints, corev1.Taint{Key: anywherev1.GPUTaintKey, Effect: corev1.TaintEffectNoSchedule})
+		}
+	}
+
+	for _, m := range machines {
+		if m.Status.NodeRef.Name == "" {
+			continue
+		}
+
+		node := &corev1.Node{}
+		if err := s.remoteClient.Get(ctx, client.ObjectKey{Name: m.Status.NodeRef.Name}, node); err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return nil, errors.Wrapf(err, "reading node %s", m.Status.NodeRef.Name)
+		}
+		if !nodeReady(node) {
+			continue
+		}
+
+		if arch, ok := node.Labels[corev1.LabelArchStable]; ok {
+			group.Labels[corev1.LabelArchStable] = arch
+		}
+
+		allocatable := node.Status.Allocatable.DeepCopy()
+		for _, pod := range s.podsByNode[node.Name] {
+			if ownedByDaemonSet(pod) {
+				subtract(allocatable, podRequests(pod))
+			}
+		}
+		group.Allocatable = allocatable
+
+		return group, nil
+	}
+
+	machineConfig := &anywherev1.VSphereMachineConfig{}
+	key := client.ObjectKey{Name: s.config.MachineGroupRef.Name, Namespace: s.cluster.Namespace}
+	if err := s.client.Get(ctx, key, machineConfig); err != nil {
+		return nil, errors.Wrapf(err, "reading VSphereMachineConfig %s", key.Name)
+	}
+
+	group.Allocatable = corev1.ResourceList{
+		corev1.ResourceCPU:    *resource.NewQuantity(int64(machineConfig.Spec.NumCPUs), resource.DecimalSI),
+		corev1.ResourceMemory: *resource.NewQuantity(int64(machineConfig.Spec.MemoryMiB)*1024*1024, resource.BinarySI),
+		corev1.ResourcePods:   *resource.NewQuantity(defaultMaxPods, resource.DecimalSI),
+	}
+
+	return group, nil
+}
+
+// consolidate removes one node of the group that stayed empty for longer than the consolidation
+// delay, as long as the group stays over its min count. It tracks since when the nodes are empty
+// with an annotation in their machines.
+func (s *nodeGroupScaler) consolidate(ctx context.Context, md *clusterv1beta2.MachineDeployment, machines []clusterv1beta2.Machine) error {
+	now := s.now()
+	var candidates []*clusterv1beta2.Machine
+	for i := range machines {
+		m := &machines[i]
+		if m.Status.NodeRef.Name == "" || !m.DeletionTimestamp.IsZero() {
+			continue
+		}
+
+		_, tracked := m.Annotations[EmptySinceAnnotation]
+		empty := s.emptyNode(m.Status.NodeRef.Name)
+		switch {
+		case empty && !tracked:
+			if err := s.annotateMachine(ctx, m, EmptySinceAnnotation, now.UTC().Format(time.RFC3339)); err != nil {
+				return err
+			}
+		case !empty && tracked:
+			if err := s.annotateMachine(ctx, m, EmptySinceAnnotation, ""); err != nil {
+				return err
+			}
+		case empty && tracked:
+			since, err := time.Parse(time.RFC3339, m.Annotations[EmptySinceAnnotation])
+			if err != nil || now.Sub(since) >= consolidationDelay {
+				candidates = append(candidates, m)
+			}
+		}
+	}
+
+	replicas := int32Value(md.Spec.Replicas)
+	if len(candidates) == 0 || replicas <= int32(s.config.AutoScalingConfiguration.MinCount) {
+		return nil
+	}
+
+	// The oldest empty node goes first.
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].Annotations[EmptySinceAnnotation] < candidates[j].Annotations[EmptySinceAnnotation]
+	})
+	m := candidates[0]
+
+	s.log.Info("Removing empty node", "machine", m.Name, "node", m.Status.NodeRef.Name, "emptySince", m.Annotations[EmptySinceAnnotation])
+	if err := s.annotateMachine(ctx, m, clusterv1beta2.DeleteMachineAnnotation, "yes"); err != nil {
+		return err
+	}
+
+	return s.setReplicas(ctx, md, replicas-1)
+}
+
+// emptyNode returns whether the node runs no pods other than the daemon set and static ones.
+func (s *nodeGroupScaler) emptyNode(name string) bool {
+	for _, pod := range s.podsByNode[name] {
+		if !ownedByDaemonSet(pod) && !mirrorPod(pod) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (s *nodeGroupScaler) setReplicas(ctx context.Context, md *clusterv1beta2.MachineDeployment, replicas int32) error {
+	patch := client.MergeFrom(md.DeepCopy())
+	md.Spec.Replicas = &replicas
+	if err := s.client.Patch(ctx, md, patch); err != nil {
+		return errors.Wrapf(err, "scaling MachineDeployment %s", md.Name)
+	}
+
+	return nil
+}
+
+// annotateMachine sets an annotation in the machine, or removes it when the value is empty.
+func (s *nodeGroupScaler) annotateMachine(ctx context.Context, m *clusterv1beta2.Machine, key, value string) error {
+	patch := client.MergeFrom(m.DeepCopy())
+	if value == "" {
+		delete(m.Annotations, key)
+	} else {
+		if m.Annotations == nil {
+			m.Annotations = map[string]string{}
+		}
+		m.Annotations[key] = value
+	}
+
+	if err := s.client.Patch(ctx, m, patch); err != nil {
+		return errors.Wrapf(err, "annotating machine %s", m.Name)
+	}
+
+	return nil
+}
+
+// unschedulable returns whether the scheduler couldn't find a node for the pod.
+func unschedulable(pod *corev1.Pod) bool {
+	if pod.Spec.NodeName != "" || !pod.DeletionTimestamp.IsZero() || ownedByDaemonSet(pod) {
+		return false
+	}
+
+	for _, c := range pod.Status.Conditions {
+		if c.Type == corev1.PodScheduled && c.Status == corev1.ConditionFalse && c.Reason == corev1.PodReasonUnschedulable {
+			return true
+		}
+	}
+
+	return false
+}
+
+func terminated(pod *corev1.Pod) bool {
+	return pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed
+}
+
+func ownedByDaemonSet(pod *corev1.Pod) bool {
+	for _, ref := range pod.OwnerReferences {
+		if ref.Kind == "DaemonSet" {
+			return true
+		}
+	}
+
+	return false
+}
+
+func mirrorPod(pod *corev1.Pod) bool {
+	_, ok := pod.Annotations[corev1.MirrorPodAnnotationKey]
+	return ok
+}
+
+func nodeReady(node *corev1.Node) bool {
+	for _, c := range node.Status.Conditions {
+		if c.Type == corev1.NodeReady {
+			return c.Status == corev1.ConditionTrue
+		}
+	}
+
+	return false
+}
+
+func int32Value(v *int32) int32 {
+	if v == nil {
+		return 0
+	}
+	return *v
+}
@@ -0,0 +1,382 @@
+package nodeprovisioner_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	controlplanev1beta2 "sigs.k8s.io/cluster-api/api/controlplane/kubeadm/v1beta2"
+	clusterv1beta2 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/aws/eks-anywhere/internal/test"
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/constants"
+	"github.com/aws/eks-anywhere/pkg/controller"
+	"github.com/aws/eks-anywhere/pkg/nodeprovisioner"
+	"github.com/aws/eks-anywhere/pkg/nodeprovisioner/mocks"
+)
+
+type reconcilerTest struct {
+	*WithT
+	ctx                  context.Context
+	remoteClientRegistry *mocks.MockRemoteClientRegistry
+	cluster              *anywherev1.Cluster
+	kcp                  *controlplanev1beta2.KubeadmControlPlane
+	machineConfig        *anywherev1.VSphereMachineConfig
+}
+
+func newReconcilerTest(t testing.TB) *reconcilerTest {
+	ctrl := gomock.NewController(t)
+	cluster := &anywherev1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-cluster",
+			Namespace: constants.EksaSystemNamespace,
+		},
+		Spec: anywherev1.ClusterSpec{
+			KubernetesVersion: "1.33",
+			WorkerNodeGroupConfigurations: []anywherev1.WorkerNodeGroupConfiguration{
+				{
+					Name:            "md-0",
+					MachineGroupRef: &anywherev1.Ref{Kind: anywherev1.VSphereMachineConfigKind, Name: "worker"},
+					AutoScalingConfiguration: &anywherev1.AutoScalingConfiguration{
+						MinCount:    1,
+						MaxCount:    3,
+						Provisioner: anywherev1.OnDemandProvisioner,
+					},
+				},
+			},
+		},
+	}
+	kcpVersion := "test"
+	kcp := test.KubeadmControlPlane(func(kcp *controlplanev1beta2.KubeadmControlPlane) {
+		kcp.Name = cluster.Name
+		kcp.Spec.Version = kcpVersion
+		kcp.Status = controlplanev1beta2.KubeadmControlPlaneStatus{
+			Conditions: []metav1.Condition{
+				{
+					Type:               clusterv1beta2.AvailableCondition,
+					Status:             metav1.ConditionTrue,
+					LastTransitionTime: metav1.NewTime(time.Now()),
+				},
+			},
+			Version:            kcpVersion,
+			ReadyReplicas:      ptr.To(int32(1)),
+			Replicas:           ptr.To(int32(1)),
+			ObservedGeneration: 1,
+		}
+		kcp.Generation = 1
+	})
+	machineConfig := &anywherev1.VSphereMachineConfig{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "worker",
+			Namespace: constants.EksaSystemNamespace,
+		},
+		Spec: anywherev1.VSphereMachineConfigSpec{
+			NumCPUs:   4,
+			MemoryMiB: 8192,
+		},
+	}
+
+	return &reconcilerTest{
+		WithT:                NewWithT(t),
+		ctx:                  context.Background(),
+		remoteClientRegistry: mocks.NewMockRemoteClientRegistry(ctrl),
+		cluster:              cluster,
+		kcp:                  kcp,
+		machineConfig:        machineConfig,
+	}
+}
+
+func (tt *reconcilerTest) client(objs ...runtime.Object) client.Client {
+	scheme := runtime.NewScheme()
+	_ = anywherev1.AddToScheme(scheme)
+	_ = corev1.AddToScheme(scheme)
+	_ = clusterv1beta2.AddToScheme(scheme)
+	_ = controlplanev1beta2.AddToScheme(scheme)
+	return fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(objs...).Build()
+}
+
+func (tt *reconcilerTest) managementClient(objs ...runtime.Object) client.Client {
+	return tt.client(append([]runtime.Object{tt.kcp, tt.machineConfig}, objs...)...)
+}
+
+func (tt *reconcilerTest) expectRemoteClient(remoteClient client.Client) {
+	tt.remoteClientRegistry.EXPECT().GetClient(tt.ctx, client.ObjectKey{Name: tt.cluster.Name, Namespace: constants.EksaSystemNamespace}).Return(remoteClient, nil)
+}
+
+func machineDeployment(replicas, readyReplicas int32) *clusterv1beta2.MachineDeployment {
+	return &clusterv1beta2.MachineDeployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-cluster-md-0",
+			Namespace: constants.EksaSystemNamespace,
+		},
+		Spec: clusterv1beta2.MachineDeploymentSpec{
+			Replicas: ptr.To(replicas),
+		},
+		Status: clusterv1beta2.MachineDeploymentStatus{
+			ReadyReplicas: ptr.To(readyReplicas),
+		},
+	}
+}
+
+func machine(name, nodeName string, annotations map[string]string) *clusterv1beta2.Machine {
+	return &clusterv1beta2.Machine{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        name,
+			Namespace:   constants.EksaSystemNamespace,
+			Labels:      map[string]string{clusterv1beta2.MachineDeploymentNameLabel: "my-cluster-md-0"},
+			Annotations: annotations,
+		},
+		Status: clusterv1beta2.MachineStatus{
+			NodeRef: clusterv1beta2.MachineNodeReference{Name: nodeName},
+		},
+	}
+}
+
+func readyNode(name, cpu, memory string) *corev1.Node {
+	allocatable := resources(cpu, memory)
+	allocatable[corev1.ResourcePods] = resource.MustParse("110")
+	return &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Status: corev1.NodeStatus{
+			Allocatable: allocatable,
+			Conditions: []corev1.NodeCondition{
+				{Type: corev1.NodeReady, Status: corev1.ConditionTrue},
+			},
+		},
+	}
+}
+
+func runningPod(name, nodeName, cpu string, opts ...func(*corev1.Pod)) *corev1.Pod {
+	pod := pendingPod(name, cpu, "1Gi", opts...)
+	pod.Spec.NodeName = nodeName
+	pod.Status = corev1.PodStatus{Phase: corev1.PodRunning}
+	return pod
+}
+
+func selectArch(arch string) func(*corev1.Pod) {
+	return func(pod *corev1.Pod) {
+		pod.Spec.NodeSelector = map[string]string{corev1.LabelArchStable: arch}
+	}
+}
+
+func ownedByDaemonSet(pod *corev1.Pod) {
+	pod.OwnerReferences = []metav1.OwnerReference{{Kind: "DaemonSet", Name: "kube-proxy"}}
+}
+
+func (tt *reconcilerTest) getMachineDeployment(c client.Client) *clusterv1beta2.MachineDeployment {
+	md := &clusterv1beta2.MachineDeployment{}
+	tt.Expect(c.Get(tt.ctx, client.ObjectKey{Name: "my-cluster-md-0", Namespace: constants.EksaSystemNamespace}, md)).To(Succeed())
+	return md
+}
+
+func (tt *reconcilerTest) getMachine(c client.Client, name string) *clusterv1beta2.Machine {
+	m := &clusterv1beta2.Machine{}
+	tt.Expect(c.Get(tt.ctx, client.ObjectKey{Name: name, Namespace: constants.EksaSystemNamespace}, m)).To(Succeed())
+	return m
+}
+
+func TestReconcilerReconcileNotEnabled(t *testing.T) {
+	tt := newReconcilerTest(t)
+	tt.cluster.Spec.WorkerNodeGroupConfigurations[0].AutoScalingConfiguration.Provisioner = anywherev1.ClusterAutoscalerProvisioner
+
+	r := nodeprovisioner.New(tt.client(), tt.remoteClientRegistry)
+	result, err := r.Reconcile(tt.ctx, logr.Discard(), tt.cluster)
+	tt.Expect(err).NotTo(HaveOccurred())
+	tt.Expect(result).To(Equal(controller.Result{}))
+}
+
+func TestReconcilerReconcileControlPlaneNotReady(t *testing.T) {
+	tt := newReconcilerTest(t)
+
+	r := nodeprovisioner.New(tt.client(tt.machineConfig), tt.remoteClientRegistry)
+	result, err := r.Reconcile(tt.ctx, logr.Discard(), tt.cluster)
+	tt.Expect(err).NotTo(HaveOccurred())
+	tt.Expect(result.Return()).To(BeTrue())
+	tt.Expect(result.Result.RequeueAfter).To(Equal(30 * time.Second))
+}
+
+func TestReconcilerReconcileScaleUpFromMachineConfig(t *testing.T) {
+	tt := newReconcilerTest(t)
+	tt.cluster.Spec.WorkerNodeGroupConfigurations[0].AutoScalingConfiguration.MinCount = 0
+	managementClient := tt.managementClient(machineDeployment(0, 0))
+	tt.expectRemoteClient(tt.client(
+		pendingPod("pod-1", "2", "1Gi"),
+		pendingPod("pod-2", "2", "1Gi"),
+		pendingPod("pod-3", "2", "1Gi"),
+	))
+
+	r := nodeprovisioner.New(managementClient, tt.remoteClientRegistry)
+	result, err := r.Reconcile(tt.ctx, logr.Discard(), tt.cluster)
+	tt.Expect(err).NotTo(HaveOccurred())
+	tt.Expect(result.Result.RequeueAfter).To(Equal(30 * time.Second))
+	tt.Expect(tt.getMachineDeployment(managementClient).Spec.Replicas).To(HaveValue(BeEquivalentTo(2)))
+}
+
+func TestReconcilerReconcileScaleUpFromNodeCapacity(t *testing.T) {
+	tt := newReconcilerTest(t)
+	managementClient := tt.managementClient(machineDeployment(1, 1), machine("machine-1", "node-1", nil))
+	tt.expectRemoteClient(tt.client(
+		readyNode("node-1", "4", "8Gi"),
+		runningPod("kube-proxy", "node-1", "2", ownedByDaemonSet),
+		runningPod("app", "node-1", "2"),
+		pendingPod("pod-1", "2", "1Gi"),
+		pendingPod("pod-2", "2", "1Gi"),
+	))
+
+	r := nodeprovisioner.New(managementClient, tt.remoteClientRegistry)
+	_, err := r.Reconcile(tt.ctx, logr.Discard(), tt.cluster)
+	tt.Expect(err).NotTo(HaveOccurred())
+	// Each new node has 2 CPUs left once the daemon set pods run.
+	tt.Expect(tt.getMachineDeployment(managementClient).Spec.Replicas).To(HaveValue(BeEquivalentTo(3)))
+}
+
+func TestReconcilerReconcileScaleUpNodeArchitecture(t *testing.T) {
+	tt := newReconcilerTest(t)
+	node := readyNode("node-1", "4", "8Gi")
+	node.Labels = map[string]string{corev1.LabelArchStable: "arm64"}
+	managementClient := tt.managementClient(machineDeployment(1, 1), machine("machine-1", "node-1", nil))
+	tt.expectRemoteClient(tt.client(
+		node,
+		runningPod("app", "node-1", "4"),
+		pendingPod("pod-1", "4", "1Gi", selectArch("arm64")),
+		pendingPod("pod-2", "4", "1Gi", selectArch("arm64")),
+		pendingPod("pod-3", "4", "1Gi", selectArch("amd64")),
+	))
+
+	r := nodeprovisioner.New(managementClient, tt.remoteClientRegistry)
+	_, err := r.Reconcile(tt.ctx, logr.Discard(), tt.cluster)
+	tt.Expect(err).NotTo(HaveOccurred())
+	// Only the pods selecting the architecture of the nodes of the group get new nodes.
+	tt.Expect(tt.getMachineDeployment(managementClient).Spec.Replicas).To(HaveValue(BeEquivalentTo(3)))
+}
+
+func TestReconcilerReconcileScaleUpCappedAtMaxCount(t *testing.T) {
+	tt := newReconcilerTest(t)
+	managementClient := tt.managementClient(machineDeployment(1, 1))
+	tt.expectRemoteClient(tt.client(
+		pendingPod("pod-1", "3", "1Gi"),
+		pendingPod("pod-2", "3", "1Gi"),
+		pendingPod("pod-3", "3", "1Gi"),
+		pendingPod("pod-4", "3", "1Gi"),
+	))
+
+	r := nodeprovisioner.New(managementClient, tt.remoteClientRegistry)
+	_, err := r.Reconcile(tt.ctx, logr.Discard(), tt.cluster)
+	tt.Expect(err).NotTo(HaveOccurred())
+	tt.Expect(tt.getMachineDeployment(managementClient).Spec.Replicas).To(HaveValue(BeEquivalentTo(3)))
+}
+
+func TestReconcilerReconcileScaleUpWithProvisioningMachines(t *testing.T) {
+	tt := newReconcilerTest(t)
+	managementClient := tt.managementClient(machineDeployment(2, 1))
+	tt.expectRemoteClient(tt.client(
+		pendingPod("pod-1", "3", "1Gi"),
+		pendingPod("pod-2", "3", "1Gi"),
+	))
+
+	r := nodeprovisioner.New(managementClient, tt.remoteClientRegistry)
+	_, err := r.Reconcile(tt.ctx, logr.Discard(), tt.cluster)
+	tt.Expect(err).NotTo(HaveOccurred())
+	tt.Expect(tt.getMachineDeployment(managementClient).Spec.Replicas).To(HaveValue(BeEquivalentTo(3)))
+}
+
+func TestReconcilerReconcileEnforcesMinCount(t *testing.T) {
+	tt := newReconcilerTest(t)
+	managementClient := tt.managementClient(machineDeployment(0, 0))
+	tt.expectRemoteClient(tt.client())
+
+	r := nodeprovisioner.New(managementClient, tt.remoteClientRegistry)
+	_, err := r.Reconcile(tt.ctx, logr.Discard(), tt.cluster)
+	tt.Expect(err).NotTo(HaveOccurred())
+	tt.Expect(tt.getMachineDeployment(managementClient).Spec.Replicas).To(HaveValue(BeEquivalentTo(1)))
+}
+
+func TestReconcilerReconcileMachineDeploymentNotFound(t *testing.T) {
+	tt := newReconcilerTest(t)
+	tt.expectRemoteClient(tt.client(pendingPod("pod-1", "1", "1Gi")))
+
+	r := nodeprovisioner.New(tt.managementClient(), tt.remoteClientRegistry)
+	_, err := r.Reconcile(tt.ctx, logr.Discard(), tt.cluster)
+	tt.Expect(err).NotTo(HaveOccurred())
+}
+
+func TestReconcilerReconcileTracksEmptyNodes(t *testing.T) {
+	tt := newReconcilerTest(t)
+	managementClient := tt.managementClient(
+		machineDeployment(2, 2),
+		machine("machine-1", "node-1", nil),
+		machine("machine-2", "node-2", map[string]string{nodeprovisioner.EmptySinceAnnotation: "2026-01-01T00:00:00Z"}),
+	)
+	tt.expectRemoteClient(tt.client(
+		readyNode("node-1", "4", "8Gi"),
+		readyNode("node-2", "4", "8Gi"),
+		runningPod("kube-proxy", "node-1", "100m", ownedByDaemonSet),
+		runningPod("app", "node-2", "1"),
+	))
+
+	r := nodeprovisioner.New(managementClient, tt.remoteClientRegistry)
+	_, err := r.Reconcile(tt.ctx, logr.Discard(), tt.cluster)
+	tt.Expect(err).NotTo(HaveOccurred())
+	tt.Expect(tt.getMachine(managementClient, "machine-1").Annotations).To(HaveKey(nodeprovisioner.EmptySinceAnnotation))
+	tt.Expect(tt.getMachine(managementClient, "machine-2").Annotations).NotTo(HaveKey(nodeprovisioner.EmptySinceAnnotation))
+	tt.Expect(tt.getMachineDeployment(managementClient).Spec.Replicas).To(HaveValue(BeEquivalentTo(2)))
+}
+
+func TestReconcilerReconcileRemovesEmptyNode(t *testing.T) {
+	tt := newReconcilerTest(t)
+	emptySince := time.Now().Add(-time.Hour).UTC().Format(time.RFC3339)
+	managementClient := tt.managementClient(
+		machineDeployment(2, 2),
+		machine("machine-1", "node-1", map[string]string{nodeprovisioner.EmptySinceAnnotation: emptySince}),
+		machine("machine-2", "node-2", nil),
+	)
+	tt.expectRemoteClient(tt.client(
+		readyNode("node-1", "4", "8Gi"),
+		readyNode("node-2", "4", "8Gi"),
+		runningPod("app", "node-2", "1"),
+	))
+
+	r := nodeprovisioner.New(managementClient, tt.remoteClientRegistry)
+	_, err := r.Reconcile(tt.ctx, logr.Discard(), tt.cluster)
+	tt.Expect(err).NotTo(HaveOccurred())
+	tt.Expect(tt.getMachine(managementClient, "machine-1").Annotations).To(HaveKeyWithValue(clusterv1beta2.DeleteMachineAnnotation, "yes"))
+	tt.Expect(tt.getMachine(managementClient, "machine-2").Annotations).NotTo(HaveKey(clusterv1beta2.DeleteMachineAnnotation))
+	tt.Expect(tt.getMachineDeployment(managementClient).Spec.Replicas).To(HaveValue(BeEquivalentTo(1)))
+}
+
+func TestReconcilerReconcileKeepsEmptyNodeAtMinCount(t *testing.T) {
+	tt := newReconcilerTest(t)
+	emptySince := time.Now().Add(-time.Hour).UTC().Format(time.RFC3339)
+	managementClient := tt.managementClient(
+		machineDeployment(1, 1),
+		machine("machine-1", "node-1", map[string]string{nodeprovisioner.EmptySinceAnnotation: emptySince}),
+	)
+	tt.expectRemoteClient(tt.client(readyNode("node-1", "4", "8Gi")))
+
+	r := nodeprovisioner.New(managementClient, tt.remoteClientRegistry)
+	_, err := r.Reconcile(tt.ctx, logr.Discard(), tt.cluster)
+	tt.Expect(err).NotTo(HaveOccurred())
+	tt.Expect(tt.getMachine(managementClient, "machine-1").Annotations).NotTo(HaveKey(clusterv1beta2.DeleteMachineAnnotation))
+	tt.Expect(tt.getMachineDeployment(managementClient).Spec.Replicas).To(HaveValue(BeEquivalentTo(1)))
+}
+
+func TestReconcilerReconcileRemoteClientError(t *testing.T) {
+	tt := newReconcilerTest(t)
+	tt.remoteClientRegistry.EXPECT().GetClient(tt.ctx, gomock.Any()).Return(nil, errors.New("connection refused"))
+
+	r := nodeprovisioner.New(tt.managementClient(), tt.remoteClientRegistry)
+	_, err := r.Reconcile(tt.ctx, logr.Discard(), tt.cluster)
+	tt.Expect(err).To(MatchError(ContainSubstring("getting workload cluster's client to scale on-demand worker node groups: connection refused")))
+}
@@ -0,0 +1,271 @@
+package nodereplacer
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	controlplanev1beta2 "sigs.k8s.io/cluster-api/api/controlplane/kubeadm/v1beta2"
+	clusterv1beta2 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+
+	"github.com/aws/eks-anywhere/pkg/clients/kubernetes"
+	"github.com/aws/eks-anywhere/pkg/constants"
+	"github.com/aws/eks-anywhere/pkg/logger"
+	"github.com/aws/eks-anywhere/pkg/retrier"
+)
+
+const (
+	defaultReplacementTimeout = time.Hour
+	defaultReplacementBackoff = 10 * time.Second
+
+	// minControlPlaneReplicas is the minimum number of control plane nodes that allows removing one
+	// of them without losing etcd quorum.
+	minControlPlaneReplicas = 3
+
+	machineSetKind          = "MachineSet"
+	kubeadmControlPlaneKind = "KubeadmControlPlane"
+)
+
+// Replacement holds the parameters of a node replacement.
+type Replacement struct {
+	ClusterName string
+	// MachineName is the name of the CAPI Machine backing the node to replace.
+	MachineName string
+}
+
+// Replacer replaces a single node of a cluster by deleting its CAPI Machine and letting the
+// owning MachineSet or KubeadmControlPlane create a new one.
+type Replacer struct {
+	managementClient kubernetes.Client
+	workloadClient   kubernetes.Client
+	retrier          *retrier.Retrier
+}
+
+// ReplacerOpt allows to customize a Replacer.
+type ReplacerOpt func(*Replacer)
+
+// WithReplacerRetrier sets the retrier used to wait for the machine to be replaced.
+func WithReplacerRetrier(r *retrier.Retrier) ReplacerOpt {
+	return func(replacer *Replacer) {
+		replacer.retrier = r
+	}
+}
+
+// NewReplacer returns a new Replacer. managementClient reads and deletes the CAPI objects and
+// workloadClient reads and cordons the nodes of the cluster. They are the same for self-managed clusters.
+func NewReplacer(managementClient, workloadClient kubernetes.Client, opts ...ReplacerOpt) *Replacer {
+	r := &Replacer{
+		managementClient: managementClient,
+		workloadClient:   workloadClient,
+		retrier:          retrier.New(defaultReplacementTimeout, retrier.WithRetryPolicy(retrier.BackOffPolicy(defaultReplacementBackoff))),
+	}
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	return r
+}
+
+// machineGroup identifies the machines that are replaced by the same owner.
+type machineGroup struct {
+	// label is the label all the machines of the group share, with value.
+	label, value string
+}
+
+func (g machineGroup) contains(machine *clusterv1beta2.Machine) bool {
+	v, ok := machine.Labels[g.label]
+	return ok && v == g.value
+}
+
+// Replace cordons the node of the machine, deletes the machine and waits for its replacement to
+// join the cluster. CAPI drains the node, honoring PodDisruptionBudgets, before deleting the
+// underlying infrastructure. It refuses to replace the node if any other node of the same
+// group is not ready or if removing a control plane node would lose etcd quorum.
+func (r *Replacer) Replace(ctx context.Context, replacement Replacement) error {
+	machine := &clusterv1beta2.Machine{}
+	if err := r.managementClient.Get(ctx, replacement.MachineName, constants.EksaSystemNamespace, machine); err != nil {
+		return fmt.Errorf("reading machine %s: %v", replacement.MachineName, err)
+	}
+
+	if machine.Labels[clusterv1beta2.ClusterNameLabel] != replacement.ClusterName {
+		return fmt.Errorf("machine %s does not belong to cluster %s", machine.Name, replacement.ClusterName)
+	}
+
+	if !machine.DeletionTimestamp.IsZero() {
+		return fmt.Errorf("machine %s is already being deleted", machine.Name)
+	}
+
+	group, err := r.machineGroup(ctx, machine)
+	if err != nil {
+		return err
+	}
+
+	machines, err := r.groupMachines(ctx, replacement.ClusterName, group)
+	if err != nil {
+		return err
+	}
+
+	existing := map[string]bool{}
+	for _, m := range machines {
+		existing[m.Name] = true
+		if m.Name == machine.Name {
+			continue
+		}
+		if err := r.checkMachineReady(ctx, m); err != nil {
+			return fmt.Errorf("refusing to replace machine %s while other nodes are unhealthy: %v", machine.Name, err)
+		}
+	}
+
+	if machine.Status.NodeRef.IsDefined() {
+		logger.Info("Cordoning node", "node", machine.Status.NodeRef.Name)
+		if err := r.cordon(ctx, machine.Status.NodeRef.Name); err != nil {
+			return err
+		}
+	}
+
+	logger.Info("Deleting machine, the node will be drained first", "machine", machine.Name)
+	if err := r.managementClient.Delete(ctx, machine); err != nil {
+		return fmt.Errorf("deleting machine %s: %v", machine.Name, err)
+	}
+
+	logger.Info("Waiting for machine to be deleted", "machine", machine.Name)
+	if err := r.retrier.Retry(func() error {
+		err := r.managementClient.Get(ctx, machine.Name, machine.Namespace, &clusterv1beta2.Machine{})
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		return fmt.Errorf("machine %s still exists", machine.Name)
+	}); err != nil {
+		return fmt.Errorf("waiting for machine %s to be deleted: %v", machine.Name, err)
+	}
+
+	logger.Info("Waiting for replacement node to be ready")
+	var replacementName string
+	if err := r.retrier.Retry(func() error {
+		machines, err := r.groupMachines(ctx, replacement.ClusterName, group)
+		if err != nil {
+			return err
+		}
+
+		for _, m := range machines {
+			if existing[m.Name] {
+				continue
+			}
+			replacementName = m.Name
+			return r.checkMachineReady(ctx, m)
+		}
+
+		return fmt.Errorf("replacement for machine %s has not been created yet", machine.Name)
+	}); err != nil {
+		return fmt.Errorf("waiting for replacement of machine %s: %v", machine.Name, err)
+	}
+
+	logger.Info("Node replaced", "oldMachine", machine.Name, "newMachine", replacementName)
+
+	return nil
+}
+
+// machineGroup returns the group of the machine, failing if the machine is not managed by an
+// owner that would replace it once deleted.
+func (r *Replacer) machineGroup(ctx context.Context, machine *clusterv1beta2.Machine) (machineGroup, error) {
+	owner := metav1.GetControllerOf(machine)
+	if owner == nil {
+		return machineGroup{}, fmt.Errorf("machine %s is not owned by a MachineSet or KubeadmControlPlane, it wouldn't be replaced", machine.Name)
+	}
+
+	switch owner.Kind {
+	case machineSetKind:
+		deployment, ok := machine.Labels[clusterv1beta2.MachineDeploymentNameLabel]
+		if !ok {
+			return machineGroup{label: clusterv1beta2.MachineSetNameLabel, value: machine.Labels[clusterv1beta2.MachineSetNameLabel]}, nil
+		}
+		return machineGroup{label: clusterv1beta2.MachineDeploymentNameLabel, value: deployment}, nil
+	case kubeadmControlPlaneKind:
+		kcp := &controlplanev1beta2.KubeadmControlPlane{}
+		if err := r.managementClient.Get(ctx, owner.Name, machine.Namespace, kcp); err != nil {
+			return machineGroup{}, fmt.Errorf("reading kubeadm control plane %s: %v", owner.Name, err)
+		}
+		if kcp.Spec.Replicas == nil || *kcp.Spec.Replicas < minControlPlaneReplicas {
+			return machineGroup{}, fmt.Errorf("replacing control plane machine %s requires at least %d control plane nodes to keep etcd quorum", machine.Name, minControlPlaneReplicas)
+		}
+		return machineGroup{label: clusterv1beta2.MachineControlPlaneLabel, value: ""}, nil
+	default:
+		return machineGroup{}, fmt.Errorf("machine %s is owned by unsupported kind %s, it wouldn't be replaced", machine.Name, owner.Kind)
+	}
+}
+
+func (r *Replacer) groupMachines(ctx context.Context, clusterName string, group machineGroup) ([]*clusterv1beta2.Machine, error) {
+	machines := &clusterv1beta2.MachineList{}
+	if err := r.managementClient.List(ctx, machines, kubernetes.ListOptions{Namespace: constants.EksaSystemNamespace}); err != nil {
+		return nil, fmt.Errorf("listing machines: %v", err)
+	}
+
+	var groupMachines []*clusterv1beta2.Machine
+	for i := range machines.Items {
+		m := &machines.Items[i]
+		if m.Labels[clusterv1beta2.ClusterNameLabel] != clusterName || !group.contains(m) {
+			continue
+		}
+		groupMachines = append(groupMachines, m)
+	}
+
+	return groupMachines, nil
+}
+
+func (r *Replacer) checkMachineReady(ctx context.Context, machine *clusterv1beta2.Machine) error {
+	if !machine.DeletionTimestamp.IsZero() {
+		return fmt.Errorf("machine %s is being deleted", machine.Name)
+	}
+
+	if !machine.Status.NodeRef.IsDefined() {
+		return fmt.Errorf("machine %s doesn't have a node yet", machine.Name)
+	}
+
+	node := &corev1.Node{}
+	if err := r.workloadClient.Get(ctx, machine.Status.NodeRef.Name, "", node); err != nil {
+		return fmt.Errorf("reading node %s: %v", machine.Status.NodeRef.Name, err)
+	}
+
+	if !nodeReady(node) {
+		return fmt.Errorf("node %s of machine %s is not ready", node.Name, machine.Name)
+	}
+
+	return nil
+}
+
+func (r *Replacer) cordon(ctx context.Context, nodeName string) error {
+	node := &corev1.Node{}
+	if err := r.workloadClient.Get(ctx, nodeName, "", node); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("reading node %s: %v", nodeName, err)
+	}
+
+	if node.Spec.Unschedulable {
+		return nil
+	}
+
+	node.Spec.Unschedulable = true
+	if err := r.workloadClient.Update(ctx, node); err != nil {
+		return fmt.Errorf("cordoning node %s: %v", nodeName, err)
+	}
+
+	return nil
+}
+
+func nodeReady(node *corev1.Node) bool {
+	for _, c := range node.Status.Conditions {
+		if c.Type == corev1.NodeReady {
+			return c.Status == corev1.ConditionTrue
+		}
+	}
+
+	return false
+}
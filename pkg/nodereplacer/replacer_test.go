@@ -0,0 +1,211 @@
+package nodereplacer
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	controlplanev1beta2 "sigs.k8s.io/cluster-api/api/controlplane/kubeadm/v1beta2"
+	clusterv1beta2 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/aws/eks-anywhere/internal/test"
+	"github.com/aws/eks-anywhere/pkg/clients/kubernetes"
+	"github.com/aws/eks-anywhere/pkg/constants"
+	"github.com/aws/eks-anywhere/pkg/retrier"
+)
+
+// replacingClient simulates the owner of a deleted machine by creating a new machine of the
+// same group, with a ready node.
+type replacingClient struct {
+	kubernetes.Client
+}
+
+func (c *replacingClient) Delete(ctx context.Context, obj kubernetes.Object) error {
+	if err := c.Client.Delete(ctx, obj); err != nil {
+		return err
+	}
+
+	machine, ok := obj.(*clusterv1beta2.Machine)
+	if !ok {
+		return nil
+	}
+
+	replacement := workerMachine("my-cluster-md-0-new", "node-new")
+	replacement.Labels = machine.Labels
+	replacement.OwnerReferences = machine.OwnerReferences
+	if err := c.Client.Create(ctx, replacement); err != nil {
+		return err
+	}
+
+	return c.Client.Create(ctx, node("node-new", corev1.ConditionTrue))
+}
+
+func workerMachine(name, nodeName string) *clusterv1beta2.Machine {
+	return &clusterv1beta2.Machine{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: constants.EksaSystemNamespace,
+			Labels: map[string]string{
+				clusterv1beta2.ClusterNameLabel:           "my-cluster",
+				clusterv1beta2.MachineDeploymentNameLabel: "my-cluster-md-0",
+			},
+			OwnerReferences: []metav1.OwnerReference{
+				{Kind: machineSetKind, Name: "my-cluster-md-0-abc", Controller: ptr.To(true)},
+			},
+		},
+		Status: clusterv1beta2.MachineStatus{
+			NodeRef: clusterv1beta2.MachineNodeReference{Name: nodeName},
+		},
+	}
+}
+
+func controlPlaneMachine(name, nodeName string) *clusterv1beta2.Machine {
+	return &clusterv1beta2.Machine{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: constants.EksaSystemNamespace,
+			Labels: map[string]string{
+				clusterv1beta2.ClusterNameLabel:         "my-cluster",
+				clusterv1beta2.MachineControlPlaneLabel: "",
+			},
+			OwnerReferences: []metav1.OwnerReference{
+				{Kind: kubeadmControlPlaneKind, Name: "my-cluster", Controller: ptr.To(true)},
+			},
+		},
+		Status: clusterv1beta2.MachineStatus{
+			NodeRef: clusterv1beta2.MachineNodeReference{Name: nodeName},
+		},
+	}
+}
+
+func kcp(replicas int32) *controlplanev1beta2.KubeadmControlPlane {
+	return &controlplanev1beta2.KubeadmControlPlane{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-cluster", Namespace: constants.EksaSystemNamespace},
+		Spec:       controlplanev1beta2.KubeadmControlPlaneSpec{Replicas: ptr.To(replicas)},
+	}
+}
+
+func node(name string, ready corev1.ConditionStatus) *corev1.Node {
+	return &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Status: corev1.NodeStatus{
+			Conditions: []corev1.NodeCondition{{Type: corev1.NodeReady, Status: ready}},
+		},
+	}
+}
+
+func newTestReplacer(objs ...client.Object) (*Replacer, kubernetes.Client) {
+	c := &replacingClient{Client: test.NewFakeKubeClient(objs...)}
+	return NewReplacer(c, c, WithReplacerRetrier(retrier.NewWithMaxRetries(1, 0))), c
+}
+
+func replacement(machineName string) Replacement {
+	return Replacement{ClusterName: "my-cluster", MachineName: machineName}
+}
+
+func TestReplacerReplaceWorker(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	replacer, c := newTestReplacer(
+		workerMachine("my-cluster-md-0-1", "node-1"),
+		workerMachine("my-cluster-md-0-2", "node-2"),
+		node("node-1", corev1.ConditionFalse),
+		node("node-2", corev1.ConditionTrue),
+	)
+
+	g.Expect(replacer.Replace(ctx, replacement("my-cluster-md-0-1"))).To(Succeed())
+
+	g.Expect(c.Get(ctx, "my-cluster-md-0-1", constants.EksaSystemNamespace, &clusterv1beta2.Machine{})).NotTo(Succeed())
+	g.Expect(c.Get(ctx, "my-cluster-md-0-new", constants.EksaSystemNamespace, &clusterv1beta2.Machine{})).To(Succeed())
+	cordoned := &corev1.Node{}
+	g.Expect(c.Get(ctx, "node-1", "", cordoned)).To(Succeed())
+	g.Expect(cordoned.Spec.Unschedulable).To(BeTrue())
+}
+
+func TestReplacerReplaceControlPlane(t *testing.T) {
+	g := NewWithT(t)
+	replacer, _ := newTestReplacer(
+		kcp(3),
+		controlPlaneMachine("my-cluster-cp-1", "cp-1"),
+		controlPlaneMachine("my-cluster-cp-2", "cp-2"),
+		controlPlaneMachine("my-cluster-cp-3", "cp-3"),
+		node("cp-1", corev1.ConditionTrue),
+		node("cp-2", corev1.ConditionTrue),
+		node("cp-3", corev1.ConditionTrue),
+	)
+
+	g.Expect(replacer.Replace(context.Background(), replacement("my-cluster-cp-1"))).To(Succeed())
+}
+
+func TestReplacerReplaceErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		objs    []client.Object
+		machine string
+		wantErr string
+	}{
+		{
+			name:    "machine not found",
+			machine: "missing",
+			wantErr: "reading machine missing",
+		},
+		{
+			name: "other cluster",
+			objs: []client.Object{
+				func() client.Object {
+					m := workerMachine("my-cluster-md-0-1", "node-1")
+					m.Labels[clusterv1beta2.ClusterNameLabel] = "other"
+					return m
+				}(),
+			},
+			machine: "my-cluster-md-0-1",
+			wantErr: "machine my-cluster-md-0-1 does not belong to cluster my-cluster",
+		},
+		{
+			name: "no owner",
+			objs: []client.Object{
+				func() client.Object {
+					m := workerMachine("my-cluster-md-0-1", "node-1")
+					m.OwnerReferences = nil
+					return m
+				}(),
+			},
+			machine: "my-cluster-md-0-1",
+			wantErr: "machine my-cluster-md-0-1 is not owned by a MachineSet or KubeadmControlPlane, it wouldn't be replaced",
+		},
+		{
+			name: "single control plane node",
+			objs: []client.Object{
+				kcp(1),
+				controlPlaneMachine("my-cluster-cp-1", "cp-1"),
+				node("cp-1", corev1.ConditionTrue),
+			},
+			machine: "my-cluster-cp-1",
+			wantErr: "replacing control plane machine my-cluster-cp-1 requires at least 3 control plane nodes to keep etcd quorum",
+		},
+		{
+			name: "other node not ready",
+			objs: []client.Object{
+				workerMachine("my-cluster-md-0-1", "node-1"),
+				workerMachine("my-cluster-md-0-2", "node-2"),
+				node("node-1", corev1.ConditionTrue),
+				node("node-2", corev1.ConditionFalse),
+			},
+			machine: "my-cluster-md-0-1",
+			wantErr: "refusing to replace machine my-cluster-md-0-1 while other nodes are unhealthy: node node-2 of machine my-cluster-md-0-2 is not ready",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			replacer, _ := newTestReplacer(tt.objs...)
+
+			g.Expect(replacer.Replace(context.Background(), replacement(tt.machine))).To(MatchError(ContainSubstring(tt.wantErr)))
+		})
+	}
+}
@@ -0,0 +1,225 @@
+package nodessh
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/clusterinventory"
+	"github.com/aws/eks-anywhere/pkg/constants"
+)
+
+const (
+	sshPath             = "ssh"
+	strictHostCheckFlag = "StrictHostKeyChecking=no"
+	privateKeyFileName  = "eks-a-id_rsa"
+	runningPhase        = "Running"
+)
+
+// Target is a machine of a cluster to open an SSH session on.
+type Target struct {
+	Machine   string
+	NodeGroup string
+	IP        string
+	User      string
+	OSFamily  anywherev1.OSFamily
+	// ProviderID is the provider ID of the machine, which identifies the machine for the
+	// infrastructure provider, like the container of a Docker machine.
+	ProviderID string
+}
+
+// DefaultPrivateKeyPath returns the path of the private key the CLI generates for a cluster when
+// its machine configs don't set an SSH key.
+func DefaultPrivateKeyPath(clusterName string) string {
+	return filepath.Join(clusterName, privateKeyFileName)
+}
+
+// Resolve finds the machine of the cluster to open an SSH session on, either by the name of its
+// CAPI machine or node, or as the first running machine of a node group. The user is the first
+// user of the machine config of the node group, or the default user of its OS family.
+func Resolve(ctx context.Context, management client.Reader, cluster *anywherev1.Cluster, name, nodeGroup string) (*Target, error) {
+	inventory, err := clusterinventory.Collect(ctx, management, nil, cluster.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	machine, err := findMachine(inventory.Machines, name, nodeGroup)
+	if err != nil {
+		return nil, err
+	}
+	if machine.IP == "" {
+		return nil, fmt.Errorf("machine %s doesn't have an IP address yet", machine.Name)
+	}
+
+	user, osFamily, err := machineAccess(ctx, management, cluster, machine.NodeGroup)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Target{
+		Machine:    machine.Name,
+		NodeGroup:  machine.NodeGroup,
+		IP:         machine.IP,
+		User:       user,
+		OSFamily:   osFamily,
+		ProviderID: machine.ProviderID,
+	}, nil
+}
+
+// ResolveNodeGroup returns all the running machines of a node group of the cluster, failing when
+// any machine of the node group isn't running or doesn't have an IP address yet. Docker machines
+// don't have a machine config, so their targets don't have a user nor an OS family.
+func ResolveNodeGroup(ctx context.Context, management client.Reader, cluster *anywherev1.Cluster, nodeGroup string) ([]*Target, error) {
+	inventory, err := clusterinventory.Collect(ctx, management, nil, cluster.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	var user string
+	var osFamily anywherev1.OSFamily
+	if cluster.Spec.DatacenterRef.Kind != anywherev1.DockerDatacenterKind {
+		user, osFamily, err = machineAccess(ctx, management, cluster, nodeGroup)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var targets []*Target
+	for _, m := range inventory.Machines {
+		if m.NodeGroup != nodeGroup {
+			continue
+		}
+		if m.Phase != runningPhase || m.IP == "" {
+			return nil, fmt.Errorf("machine %s of node group %s is not running", m.Name, nodeGroup)
+		}
+		targets = append(targets, &Target{
+			Machine:    m.Name,
+			NodeGroup:  m.NodeGroup,
+			IP:         m.IP,
+			User:       user,
+			OSFamily:   osFamily,
+			ProviderID: m.ProviderID,
+		})
+	}
+	if len(targets) == 0 {
+		return nil, fmt.Errorf("no machines found for node group %s", nodeGroup)
+	}
+
+	return targets, nil
+}
+
+// Args returns the arguments of the ssh command logging into the target with the private key,
+// followed by the command to run, if any.
+func Args(privateKeyPath string, target *Target, command ...string) []string {
+	args := []string{
+		"-i", privateKeyPath,
+		"-o", strictHostCheckFlag,
+		fmt.Sprintf("%s@%s", target.User, target.IP),
+	}
+
+	return append(args, command...)
+}
+
+// OpenSession opens an interactive SSH session on the target, attached to the standard input and
+// outputs of the process. It runs the ssh binary of the host, since the session needs a terminal.
+func OpenSession(ctx context.Context, privateKeyPath string, target *Target) error {
+	cmd := exec.CommandContext(ctx, sshPath, Args(privateKeyPath, target)...)
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("running SSH session on machine %s: %v", target.Machine, err)
+	}
+
+	return nil
+}
+
+func findMachine(machines []clusterinventory.Machine, name, nodeGroup string) (*clusterinventory.Machine, error) {
+	if name != "" {
+		for i := range machines {
+			if machines[i].Name == name || machines[i].Node == name {
+				return &machines[i], nil
+			}
+		}
+		return nil, fmt.Errorf("machine or node %s not found", name)
+	}
+
+	var found *clusterinventory.Machine
+	for i := range machines {
+		if machines[i].NodeGroup != nodeGroup {
+			continue
+		}
+		if machines[i].Phase == runningPhase {
+			return &machines[i], nil
+		}
+		if found == nil {
+			found = &machines[i]
+		}
+	}
+	if found == nil {
+		return nil, fmt.Errorf("no machines found for node group %s", nodeGroup)
+	}
+
+	return found, nil
+}
+
+// machineAccess reads the user and OS family of the machine config of a node group. Machine configs
+// are read as unstructured objects, since all providers keep the users and OS family in the same fields.
+func machineAccess(ctx context.Context, c client.Reader, cluster *anywherev1.Cluster, nodeGroup string) (user string, osFamily anywherev1.OSFamily, err error) {
+	ref := machineGroupRef(cluster, nodeGroup)
+	if ref == nil {
+		return "", "", fmt.Errorf("node group %s doesn't have a machine config", nodeGroup)
+	}
+
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(anywherev1.GroupVersion.WithKind(ref.Kind))
+	if err := c.Get(ctx, client.ObjectKey{Name: ref.Name, Namespace: cluster.Namespace}, obj); err != nil {
+		return "", "", errors.Wrapf(err, "reading %s %s", ref.Kind, ref.Name)
+	}
+
+	family, _, _ := unstructured.NestedString(obj.Object, "spec", "osFamily")
+	osFamily = anywherev1.OSFamily(family)
+
+	users, _, _ := unstructured.NestedSlice(obj.Object, "spec", "users")
+	if len(users) > 0 {
+		if u, ok := users[0].(map[string]interface{}); ok {
+			if name, ok := u["name"].(string); ok && name != "" {
+				return name, osFamily, nil
+			}
+		}
+	}
+
+	if osFamily == anywherev1.Bottlerocket {
+		return constants.BottlerocketDefaultUser, osFamily, nil
+	}
+
+	return constants.UbuntuDefaultUser, osFamily, nil
+}
+
+func machineGroupRef(cluster *anywherev1.Cluster, nodeGroup string) *anywherev1.Ref {
+	switch nodeGroup {
+	case clusterinventory.ControlPlaneNodeGroup:
+		return cluster.Spec.ControlPlaneConfiguration.MachineGroupRef
+	case clusterinventory.EtcdNodeGroup:
+		if cluster.Spec.ExternalEtcdConfiguration == nil {
+			return nil
+		}
+		return cluster.Spec.ExternalEtcdConfiguration.MachineGroupRef
+	}
+
+	for _, w := range cluster.Spec.WorkerNodeGroupConfigurations {
+		if w.Name == nodeGroup {
+			return w.MachineGroupRef
+		}
+	}
+
+	return nil
+}
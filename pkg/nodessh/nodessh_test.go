@@ -0,0 +1,203 @@
+package nodessh_test
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clusterv1beta2 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/constants"
+	"github.com/aws/eks-anywhere/pkg/nodessh"
+)
+
+func newClient(objs ...runtime.Object) client.Client {
+	scheme := runtime.NewScheme()
+	_ = anywherev1.AddToScheme(scheme)
+	_ = clusterv1beta2.AddToScheme(scheme)
+	return fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(objs...).Build()
+}
+
+func machine(name, nodeName, phase, ip string, labels map[string]string) *clusterv1beta2.Machine {
+	l := map[string]string{clusterv1beta2.ClusterNameLabel: "my-cluster"}
+	for k, v := range labels {
+		l[k] = v
+	}
+	m := &clusterv1beta2.Machine{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: constants.EksaSystemNamespace,
+			Labels:    l,
+		},
+		Spec: clusterv1beta2.MachineSpec{
+			ClusterName: "my-cluster",
+		},
+		Status: clusterv1beta2.MachineStatus{
+			Phase:   phase,
+			NodeRef: clusterv1beta2.MachineNodeReference{Name: nodeName},
+		},
+	}
+	if ip != "" {
+		m.Status.Addresses = clusterv1beta2.MachineAddresses{{Type: clusterv1beta2.MachineInternalIP, Address: ip}}
+	}
+
+	return m
+}
+
+func machineConfig(name string, osFamily anywherev1.OSFamily, users ...string) *anywherev1.VSphereMachineConfig {
+	m := &anywherev1.VSphereMachineConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+		Spec:       anywherev1.VSphereMachineConfigSpec{OSFamily: osFamily},
+	}
+	for _, u := range users {
+		m.Spec.Users = append(m.Spec.Users, anywherev1.UserConfiguration{Name: u})
+	}
+
+	return m
+}
+
+func eksaCluster() *anywherev1.Cluster {
+	return &anywherev1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-cluster", Namespace: "default"},
+		Spec: anywherev1.ClusterSpec{
+			ControlPlaneConfiguration: anywherev1.ControlPlaneConfiguration{
+				MachineGroupRef: &anywherev1.Ref{Kind: anywherev1.VSphereMachineConfigKind, Name: "cp"},
+			},
+			WorkerNodeGroupConfigurations: []anywherev1.WorkerNodeGroupConfiguration{
+				{
+					Name:            "md-0",
+					MachineGroupRef: &anywherev1.Ref{Kind: anywherev1.VSphereMachineConfigKind, Name: "workers"},
+				},
+			},
+		},
+	}
+}
+
+func objects() []runtime.Object {
+	return []runtime.Object{
+		machine("my-cluster-cp-abc", "node-1", "Running", "192.168.0.1", map[string]string{clusterv1beta2.MachineControlPlaneLabel: ""}),
+		machine("my-cluster-md-0-new", "", "Provisioning", "", map[string]string{clusterv1beta2.MachineDeploymentNameLabel: "my-cluster-md-0"}),
+		machine("my-cluster-md-0-xyz", "node-2", "Running", "192.168.0.2", map[string]string{clusterv1beta2.MachineDeploymentNameLabel: "my-cluster-md-0"}),
+		machineConfig("cp", anywherev1.Ubuntu, "capv"),
+		machineConfig("workers", anywherev1.Bottlerocket),
+	}
+}
+
+func TestResolve(t *testing.T) {
+	tests := []struct {
+		name      string
+		machine   string
+		nodeGroup string
+		want      *nodessh.Target
+		wantErr   string
+	}{
+		{
+			name:    "by machine name",
+			machine: "my-cluster-cp-abc",
+			want:    &nodessh.Target{Machine: "my-cluster-cp-abc", NodeGroup: "control-plane", IP: "192.168.0.1", User: "capv", OSFamily: anywherev1.Ubuntu},
+		},
+		{
+			name:    "by node name",
+			machine: "node-2",
+			want:    &nodessh.Target{Machine: "my-cluster-md-0-xyz", NodeGroup: "md-0", IP: "192.168.0.2", User: "ec2-user", OSFamily: anywherev1.Bottlerocket},
+		},
+		{
+			name:      "by node group prefers running machines",
+			nodeGroup: "md-0",
+			want:      &nodessh.Target{Machine: "my-cluster-md-0-xyz", NodeGroup: "md-0", IP: "192.168.0.2", User: "ec2-user", OSFamily: anywherev1.Bottlerocket},
+		},
+		{
+			name:    "machine not found",
+			machine: "missing",
+			wantErr: "machine or node missing not found",
+		},
+		{
+			name:      "node group not found",
+			nodeGroup: "md-1",
+			wantErr:   "no machines found for node group md-1",
+		},
+		{
+			name:    "machine without IP",
+			machine: "my-cluster-md-0-new",
+			wantErr: "machine my-cluster-md-0-new doesn't have an IP address yet",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			got, err := nodessh.Resolve(context.Background(), newClient(objects()...), eksaCluster(), tt.machine, tt.nodeGroup)
+			if tt.wantErr != "" {
+				g.Expect(err).To(MatchError(ContainSubstring(tt.wantErr)))
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(got).To(Equal(tt.want))
+		})
+	}
+}
+
+func TestResolveMissingMachineConfig(t *testing.T) {
+	g := NewWithT(t)
+	objs := objects()[:3]
+
+	_, err := nodessh.Resolve(context.Background(), newClient(objs...), eksaCluster(), "node-1", "")
+	g.Expect(err).To(MatchError(ContainSubstring("reading VSphereMachineConfig cp")))
+}
+
+func TestResolveNodeGroup(t *testing.T) {
+	g := NewWithT(t)
+
+	got, err := nodessh.ResolveNodeGroup(context.Background(), newClient(objects()...), eksaCluster(), "control-plane")
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(got).To(Equal([]*nodessh.Target{
+		{Machine: "my-cluster-cp-abc", NodeGroup: "control-plane", IP: "192.168.0.1", User: "capv", OSFamily: anywherev1.Ubuntu},
+	}))
+}
+
+func TestResolveNodeGroupMachineNotRunning(t *testing.T) {
+	g := NewWithT(t)
+
+	_, err := nodessh.ResolveNodeGroup(context.Background(), newClient(objects()...), eksaCluster(), "md-0")
+	g.Expect(err).To(MatchError("machine my-cluster-md-0-new of node group md-0 is not running"))
+}
+
+func TestResolveNodeGroupNotFound(t *testing.T) {
+	g := NewWithT(t)
+
+	_, err := nodessh.ResolveNodeGroup(context.Background(), newClient(objects()...), eksaCluster(), "etcd")
+	g.Expect(err).To(MatchError(ContainSubstring("node group etcd doesn't have a machine config")))
+}
+
+func TestArgs(t *testing.T) {
+	g := NewWithT(t)
+	target := &nodessh.Target{Machine: "m", IP: "192.168.0.1", User: "capv"}
+
+	g.Expect(nodessh.Args("my-cluster/eks-a-id_rsa", target, "uptime")).To(Equal([]string{
+		"-i", "my-cluster/eks-a-id_rsa", "-o", "StrictHostKeyChecking=no", "capv@192.168.0.1", "uptime",
+	}))
+}
+
+func TestDefaultPrivateKeyPath(t *testing.T) {
+	g := NewWithT(t)
+	g.Expect(nodessh.DefaultPrivateKeyPath("my-cluster")).To(Equal("my-cluster/eks-a-id_rsa"))
+}
+
+func TestResolveNodeGroupDocker(t *testing.T) {
+	g := NewWithT(t)
+	cp := machine("my-cluster-cp-abc", "node-1", "Running", "172.18.0.5", map[string]string{clusterv1beta2.MachineControlPlaneLabel: ""})
+	cp.Spec.ProviderID = "docker:////my-cluster-cp-abc"
+	cluster := eksaCluster()
+	cluster.Spec.DatacenterRef = anywherev1.Ref{Kind: anywherev1.DockerDatacenterKind, Name: "my-cluster"}
+	cluster.Spec.ControlPlaneConfiguration.MachineGroupRef = nil
+
+	got, err := nodessh.ResolveNodeGroup(context.Background(), newClient(cp), cluster, "control-plane")
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(got).To(Equal([]*nodessh.Target{
+		{Machine: "my-cluster-cp-abc", NodeGroup: "control-plane", IP: "172.18.0.5", ProviderID: "docker:////my-cluster-cp-abc"},
+	}))
+}
@@ -0,0 +1,94 @@
+package nodeupgrader
+
+import (
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	bootstrapv1beta2 "sigs.k8s.io/cluster-api/api/bootstrap/kubeadm/v1beta2"
+
+	"github.com/aws/eks-anywhere/pkg/constants"
+)
+
+// SSHUsersPatcherContainerName holds the name of the ssh users patcher container.
+const SSHUsersPatcherContainerName = "ssh-users-patcher"
+
+// SSHUsersPatcherPodName returns the name of the ssh users patcher pod based on the nodeName.
+func SSHUsersPatcherPodName(nodeName string) string {
+	return fmt.Sprintf("%s-ssh-users-patcher", nodeName)
+}
+
+// SSHUsersPatcherPod returns a pod that updates the ssh users of a node in place.
+// Users that don't exist in the node are created and the authorized keys and sudo policy
+// of every user are replaced with the given ones. The authorized keys and sudo policy of
+// the removedUsers are cleared, without deleting their accounts.
+func SSHUsersPatcherPod(nodeName, image string, users []bootstrapv1beta2.User, removedUsers []string) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      SSHUsersPatcherPodName(nodeName),
+			Namespace: constants.EksaSystemNamespace,
+			Labels: map[string]string{
+				"eks-a-ssh-users-patcher": "true",
+			},
+		},
+		Spec: corev1.PodSpec{
+			NodeName: nodeName,
+			HostPID:  true,
+			Containers: []corev1.Container{
+				nsenterContainer(image, SSHUsersPatcherContainerName, "sh", "-c", sshUsersScript(users, removedUsers)),
+			},
+			RestartPolicy: corev1.RestartPolicyNever,
+		},
+	}
+}
+
+func sshUsersScript(users []bootstrapv1beta2.User, removedUsers []string) string {
+	b := &strings.Builder{}
+	b.WriteString("set -e\n")
+
+	for _, user := range users {
+		name := shellQuote(user.Name)
+		fmt.Fprintf(b, "id -u %s >/dev/null 2>&1 || useradd -m -s /bin/bash %s\n", name, name)
+		fmt.Fprintf(b, "home=$(getent passwd %s | cut -d: -f6)\n", name)
+		b.WriteString("mkdir -p \"$home/.ssh\"\n")
+		b.WriteString("printf '%s\\n'")
+		for _, key := range user.SSHAuthorizedKeys {
+			fmt.Fprintf(b, " %s", shellQuote(key))
+		}
+		b.WriteString(" > \"$home/.ssh/authorized_keys\"\n")
+		b.WriteString("chmod 700 \"$home/.ssh\"\n")
+		b.WriteString("chmod 600 \"$home/.ssh/authorized_keys\"\n")
+		fmt.Fprintf(b, "chown -R %s: \"$home/.ssh\"\n", name)
+
+		sudoersFile := shellQuote(sudoersFile(user.Name))
+		if user.Sudo == "" {
+			fmt.Fprintf(b, "rm -f %s\n", sudoersFile)
+			continue
+		}
+		fmt.Fprintf(b, "printf '%%s\\n' %s > %s\n", shellQuote(user.Name+" "+user.Sudo), sudoersFile)
+		fmt.Fprintf(b, "chmod 440 %s\n", sudoersFile)
+	}
+
+	for _, user := range removedUsers {
+		name := shellQuote(user)
+		fmt.Fprintf(b, "if id -u %s >/dev/null 2>&1; then\n", name)
+		fmt.Fprintf(b, "  home=$(getent passwd %s | cut -d: -f6)\n", name)
+		b.WriteString("  if [ -f \"$home/.ssh/authorized_keys\" ]; then : > \"$home/.ssh/authorized_keys\"; fi\n")
+		b.WriteString("fi\n")
+		fmt.Fprintf(b, "rm -f %s\n", shellQuote(sudoersFile(user)))
+	}
+
+	return b.String()
+}
+
+// sudoersFile returns the path of the sudoers drop-in file for a user.
+// sudo ignores files in sudoers.d that contain a '.', so dots in the user name are replaced.
+func sudoersFile(userName string) string {
+	return "/etc/sudoers.d/90-eksa-" + strings.ReplaceAll(userName, ".", "_")
+}
+
+// shellQuote wraps s in single quotes so it's interpreted literally by the shell.
+func shellQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
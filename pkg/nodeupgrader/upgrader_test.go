@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	. "github.com/onsi/gomega"
+	bootstrapv1beta2 "sigs.k8s.io/cluster-api/api/bootstrap/kubeadm/v1beta2"
 	"sigs.k8s.io/yaml"
 
 	"github.com/aws/eks-anywhere/internal/test"
@@ -46,3 +47,24 @@ func TestUpgradeWorkerPod(t *testing.T) {
 	g.Expect(err).ToNot(HaveOccurred())
 	test.AssertContentToFile(t, string(data), "testdata/expected_worker_upgrader_pod.yaml")
 }
+
+func TestSSHUsersPatcherPod(t *testing.T) {
+	g := NewWithT(t)
+	users := []bootstrapv1beta2.User{
+		{
+			Name:              "ec2-user",
+			Sudo:              "ALL=(ALL) NOPASSWD:ALL",
+			SSHAuthorizedKeys: []string{"ssh-rsa AAAA", "ssh-ed25519 BBBB"},
+		},
+		{
+			Name:              "ops.team",
+			SSHAuthorizedKeys: []string{"ssh-rsa CCCC"},
+		},
+	}
+	pod := nodeupgrader.SSHUsersPatcherPod(nodeName, upgraderImage, users, []string{"o'brien"})
+	g.Expect(pod).ToNot(BeNil())
+
+	data, err := yaml.Marshal(pod)
+	g.Expect(err).ToNot(HaveOccurred())
+	test.AssertContentToFile(t, string(data), "testdata/expected_ssh_users_patcher_pod.yaml")
+}
@@ -0,0 +1,438 @@
+// Package providermigration moves the workers of a cluster to a node pool running on another
+// provider under the same management cluster, like vSphere to bare metal, one node at a time.
+package providermigration
+
+import (
+	"context"
+	"fmt"
+	"errors"
+	"sort"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/ptr"
+	clusterv1beta2 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/clients/kubernetes"
+	"github.com/aws/eks-anywhere/pkg/constants"
+	"github.com/aws/eks-anywhere/pkg/logger"
+	"github.com/aws/eks-anywhere/pkg/retrier"
+)
+
+const (
+	defaultNodeTimeout = time.Hour
+	defaultNodeBackoff = 10 * time.Second
+
+	machineDeploymentKind = "MachineDeployment"
+	machineTemplateSuffix = "MachineTemplate"
+)
+
+// Migration holds the parameters of a node pool migration.
+type Migration struct {
+	ClusterName      string
+	ClusterNamespace string
+	// SourceMachineDeployment is the name of the CAPI MachineDeployment of the node pool to retire.
+	SourceMachineDeployment string
+	// TargetObjects are the CAPI objects of the node pool on the new provider: one
+	// MachineDeployment and the infrastructure and bootstrap templates it references.
+	TargetObjects []unstructured.Unstructured
+	// ManualCutover acknowledges that the migration doesn't update the EKS Anywhere cluster spec,
+	// which still describes the source node pool, so the cluster reconciliation stays paused until
+	// the cutover is done by hand. Migrate refuses to run without it.
+	ManualCutover bool
+}
+
+// Migrator stands up a node pool on a second provider next to an existing one, moves the
+// workloads to it node by node and retires the original node pool.
+type Migrator struct {
+	managementClient kubernetes.Client
+	workloadClient   kubernetes.Client
+	retrier          *retrier.Retrier
+}
+
+// MigratorOpt allows to customize a Migrator.
+type MigratorOpt func(*Migrator)
+
+// WithMigratorRetrier sets the retrier used to wait for each node to be added or removed.
+func WithMigratorRetrier(r *retrier.Retrier) MigratorOpt {
+	return func(m *Migrator) {
+		m.retrier = r
+	}
+}
+
+// NewMigrator returns a new Migrator. managementClient reads and updates the EKS Anywhere and CAPI
+// objects and workloadClient reads and cordons the nodes of the cluster. They are the same for
+// self-managed clusters.
+func NewMigrator(managementClient, workloadClient kubernetes.Client, opts ...MigratorOpt) *Migrator {
+	m := &Migrator{
+		managementClient: managementClient,
+		workloadClient:   workloadClient,
+		retrier:          retrier.New(defaultNodeTimeout, retrier.WithRetryPolicy(retrier.BackOffPolicy(defaultNodeBackoff))),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+
+	return m
+}
+
+// Migrate checks that the infrastructure of the target provider exists for the cluster, pauses the
+// reconciliation of the EKS Anywhere cluster, so its controller doesn't remove the new node pool,
+// and creates the target objects. Then, for every node of the source pool, it adds a node to the
+// target pool, waits for it to be ready and removes a source node, which CAPI drains honoring
+// PodDisruptionBudgets. The empty source MachineDeployment is kept, so the CAPI objects still match
+// the cluster spec and the migration can be reverted by scaling it up again. The cutover, updating
+// or replacing the cluster spec and deleting the source MachineDeployment, is manual, so the cluster
+// is left paused. Migrate can be run again to resume an interrupted migration.
+func (m *Migrator) Migrate(ctx context.Context, migration Migration) error {
+	if !migration.ManualCutover {
+		return errors.New("the cluster spec is not updated by the migration and its reconciliation stays paused until a manual cutover, which must be acknowledged")
+	}
+
+	target, err := targetMachineDeployment(migration)
+	if err != nil {
+		return err
+	}
+
+	source := &clusterv1beta2.MachineDeployment{}
+	if err := m.managementClient.Get(ctx, migration.SourceMachineDeployment, constants.EksaSystemNamespace, source); err != nil {
+		return fmt.Errorf("reading source machine deployment %s: %v", migration.SourceMachineDeployment, err)
+	}
+
+	if source.Spec.ClusterName != migration.ClusterName {
+		return fmt.Errorf("source machine deployment %s does not belong to cluster %s", source.Name, migration.ClusterName)
+	}
+
+	if source.Name == target.Name {
+		return fmt.Errorf("target machine deployment must be different from the source one %s", source.Name)
+	}
+
+	if err := m.validateTargetInfrastructure(ctx, migration, target); err != nil {
+		return err
+	}
+
+	if err := m.pauseCluster(ctx, migration); err != nil {
+		return err
+	}
+
+	if err := m.createTargetObjects(ctx, migration.TargetObjects); err != nil {
+		return err
+	}
+
+	for {
+		if err := m.managementClient.Get(ctx, source.Name, source.Namespace, source); err != nil {
+			return fmt.Errorf("reading source machine deployment %s: %v", source.Name, err)
+		}
+		if ptr.Deref(source.Spec.Replicas, 0) == 0 {
+			break
+		}
+
+		if err := m.addTargetNode(ctx, target.Name); err != nil {
+			return err
+		}
+
+		if err := m.removeSourceNode(ctx, source); err != nil {
+			return err
+		}
+	}
+
+	logger.Info("Warning: the cluster reconciliation remains paused and its spec still describes the source node pool. "+
+		"Complete the cutover by hand before deleting the empty source machine deployment",
+		"cluster", migration.ClusterName, "machineDeployment", source.Name)
+
+	return nil
+}
+
+func targetMachineDeployment(migration Migration) (*clusterv1beta2.MachineDeployment, error) {
+	var target *clusterv1beta2.MachineDeployment
+	for _, obj := range migration.TargetObjects {
+		gvk := obj.GroupVersionKind()
+		if gvk.Group != clusterv1beta2.GroupVersion.Group || gvk.Kind != machineDeploymentKind {
+			continue
+		}
+		if target != nil {
+			return nil, fmt.Errorf("target objects must contain only one %s, found %s and %s", machineDeploymentKind, target.Name, obj.GetName())
+		}
+		target = &clusterv1beta2.MachineDeployment{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, target); err != nil {
+			return nil, fmt.Errorf("reading target machine deployment %s: %v", obj.GetName(), err)
+		}
+	}
+
+	if target == nil {
+		return nil, fmt.Errorf("target objects must contain a %s", machineDeploymentKind)
+	}
+
+	if target.Spec.ClusterName != migration.ClusterName {
+		return nil, fmt.Errorf("target machine deployment %s does not belong to cluster %s", target.Name, migration.ClusterName)
+	}
+
+	if target.Namespace != constants.EksaSystemNamespace {
+		return nil, fmt.Errorf("target machine deployment %s must be in namespace %s", target.Name, constants.EksaSystemNamespace)
+	}
+
+	return target, nil
+}
+
+// validateTargetInfrastructure checks that the infrastructure cluster object of the target provider,
+// like a TinkerbellCluster for a TinkerbellMachineTemplate, exists for the CAPI Cluster. CAPI
+// Clusters only reference the infrastructure of their original provider, so it's created by hand.
+func (m *Migrator) validateTargetInfrastructure(ctx context.Context, migration Migration, target *clusterv1beta2.MachineDeployment) error {
+	ref := target.Spec.Template.Spec.InfrastructureRef
+	if !strings.HasSuffix(ref.Kind, machineTemplateSuffix) {
+		return fmt.Errorf("target machine deployment %s infrastructure %s is not a machine template", target.Name, ref.Kind)
+	}
+
+	template := findObject(migration.TargetObjects, ref.APIGroup, ref.Kind, ref.Name)
+	if template == nil {
+		return fmt.Errorf("target objects must contain the infrastructure template %s %s", ref.Kind, ref.Name)
+	}
+
+	gvk := schema.GroupVersionKind{
+		Group:   ref.APIGroup,
+		Version: template.GroupVersionKind().Version,
+		Kind:    strings.TrimSuffix(ref.Kind, machineTemplateSuffix) + "Cluster",
+	}
+	list := &unstructured.UnstructuredList{}
+	list.SetGroupVersionKind(gvk.GroupVersion().WithKind(gvk.Kind + "List"))
+	if err := m.managementClient.List(ctx, list, kubernetes.ListOptions{Namespace: constants.EksaSystemNamespace}); err != nil {
+		return fmt.Errorf("listing %s: %v", gvk.Kind, err)
+	}
+
+	for _, obj := range list.Items {
+		if obj.GetLabels()[clusterv1beta2.ClusterNameLabel] == migration.ClusterName {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("target infrastructure not found: no %s in namespace %s with label %s=%s, create it for the cluster before migrating",
+		gvk.Kind, constants.EksaSystemNamespace, clusterv1beta2.ClusterNameLabel, migration.ClusterName)
+}
+
+func findObject(objs []unstructured.Unstructured, group, kind, name string) *unstructured.Unstructured {
+	for i := range objs {
+		gvk := objs[i].GroupVersionKind()
+		if gvk.Group == group && gvk.Kind == kind && objs[i].GetName() == name {
+			return &objs[i]
+		}
+	}
+	return nil
+}
+
+func (m *Migrator) pauseCluster(ctx context.Context, migration Migration) error {
+	cluster := &anywherev1.Cluster{}
+	if err := m.managementClient.Get(ctx, migration.ClusterName, migration.ClusterNamespace, cluster); err != nil {
+		return fmt.Errorf("reading cluster %s: %v", migration.ClusterName, err)
+	}
+
+	if cluster.IsReconcilePaused() {
+		return nil
+	}
+
+	logger.Info("Pausing cluster reconciliation", "cluster", cluster.Name)
+	cluster.PauseReconcile()
+	if err := m.managementClient.Update(ctx, cluster); err != nil {
+		return fmt.Errorf("pausing cluster %s reconciliation: %v", cluster.Name, err)
+	}
+
+	return nil
+}
+
+// createTargetObjects creates the target objects that don't exist yet. A new target
+// MachineDeployment starts without replicas, so nodes are only added one at a time.
+func (m *Migrator) createTargetObjects(ctx context.Context, objs []unstructured.Unstructured) error {
+	for i := range objs {
+		obj := objs[i].DeepCopy()
+		existing := &unstructured.Unstructured{}
+		existing.SetGroupVersionKind(obj.GroupVersionKind())
+		err := m.managementClient.Get(ctx, obj.GetName(), obj.GetNamespace(), existing)
+		if err == nil {
+			logger.V(3).Info("Target object already exists", "kind", obj.GetKind(), "name", obj.GetName())
+			continue
+		}
+		if !apierrors.IsNotFound(err) {
+			return fmt.Errorf("reading %s %s: %v", obj.GetKind(), obj.GetName(), err)
+		}
+
+		if obj.GetKind() == machineDeploymentKind {
+			if err := unstructured.SetNestedField(obj.Object, int64(0), "spec", "replicas"); err != nil {
+				return fmt.Errorf("setting replicas of %s %s: %v", obj.GetKind(), obj.GetName(), err)
+			}
+		}
+
+		logger.Info("Creating target object", "kind", obj.GetKind(), "name", obj.GetName())
+		if err := m.managementClient.Create(ctx, obj); err != nil {
+			return fmt.Errorf("creating %s %s: %v", obj.GetKind(), obj.GetName(), err)
+		}
+	}
+
+	return nil
+}
+
+// addTargetNode scales the target MachineDeployment up by one and waits for all its nodes to be ready.
+func (m *Migrator) addTargetNode(ctx context.Context, name string) error {
+	target := &clusterv1beta2.MachineDeployment{}
+	if err := m.managementClient.Get(ctx, name, constants.EksaSystemNamespace, target); err != nil {
+		return fmt.Errorf("reading target machine deployment %s: %v", name, err)
+	}
+
+	replicas := ptr.Deref(target.Spec.Replicas, 0) + 1
+	logger.Info("Adding node to target machine deployment", "machineDeployment", name, "replicas", replicas)
+	target.Spec.Replicas = ptr.To(replicas)
+	if err := m.managementClient.Update(ctx, target); err != nil {
+		return fmt.Errorf("scaling target machine deployment %s: %v", name, err)
+	}
+
+	logger.Info("Waiting for target nodes to be ready", "machineDeployment", name)
+	if err := m.retrier.Retry(func() error {
+		machines, err := m.machines(ctx, target)
+		if err != nil {
+			return err
+		}
+
+		if len(machines) != int(replicas) {
+			return fmt.Errorf("machine deployment %s has %d machines, expected %d", name, len(machines), replicas)
+		}
+
+		for _, machine := range machines {
+			if err := m.checkMachineReady(ctx, machine); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}); err != nil {
+		return fmt.Errorf("waiting for target machine deployment %s nodes: %v", name, err)
+	}
+
+	return nil
+}
+
+// removeSourceNode cordons a node of the source MachineDeployment, marks its machine for deletion,
+// scales the MachineDeployment down by one and waits for the machine to be deleted.
+func (m *Migrator) removeSourceNode(ctx context.Context, source *clusterv1beta2.MachineDeployment) error {
+	machines, err := m.machines(ctx, source)
+	if err != nil {
+		return err
+	}
+
+	var machine *clusterv1beta2.Machine
+	for _, candidate := range machines {
+		if candidate.DeletionTimestamp.IsZero() {
+			machine = candidate
+			break
+		}
+	}
+	if machine == nil {
+		return fmt.Errorf("source machine deployment %s has no machine to remove", source.Name)
+	}
+
+	if machine.Status.NodeRef.IsDefined() {
+		logger.Info("Cordoning node", "node", machine.Status.NodeRef.Name)
+		if err := m.cordon(ctx, machine.Status.NodeRef.Name); err != nil {
+			return err
+		}
+	}
+
+	if machine.Annotations == nil {
+		machine.Annotations = map[string]string{}
+	}
+	machine.Annotations[clusterv1beta2.DeleteMachineAnnotation] = "true"
+	if err := m.managementClient.Update(ctx, machine); err != nil {
+		return fmt.Errorf("marking machine %s for deletion: %v", machine.Name, err)
+	}
+
+	replicas := ptr.Deref(source.Spec.Replicas, 0) - 1
+	logger.Info("Removing node from source machine deployment, the node will be drained first",
+		"machineDeployment", source.Name, "machine", machine.Name, "replicas", replicas)
+	source.Spec.Replicas = ptr.To(replicas)
+	if err := m.managementClient.Update(ctx, source); err != nil {
+		return fmt.Errorf("scaling source machine deployment %s: %v", source.Name, err)
+	}
+
+	logger.Info("Waiting for machine to be deleted", "machine", machine.Name)
+	if err := m.retrier.Retry(func() error {
+		err := m.managementClient.Get(ctx, machine.Name, machine.Namespace, &clusterv1beta2.Machine{})
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		return fmt.Errorf("machine %s still exists", machine.Name)
+	}); err != nil {
+		return fmt.Errorf("waiting for machine %s to be deleted: %v", machine.Name, err)
+	}
+
+	return nil
+}
+
+// machines returns the machines of the MachineDeployment sorted by name.
+func (m *Migrator) machines(ctx context.Context, md *clusterv1beta2.MachineDeployment) ([]*clusterv1beta2.Machine, error) {
+	machines := &clusterv1beta2.MachineList{}
+	if err := m.managementClient.List(ctx, machines, kubernetes.ListOptions{Namespace: md.Namespace}); err != nil {
+		return nil, fmt.Errorf("listing machines: %v", err)
+	}
+
+	var mdMachines []*clusterv1beta2.Machine
+	for i := range machines.Items {
+		machine := &machines.Items[i]
+		if machine.Labels[clusterv1beta2.ClusterNameLabel] != md.Spec.ClusterName ||
+			machine.Labels[clusterv1beta2.MachineDeploymentNameLabel] != md.Name {
+			continue
+		}
+		mdMachines = append(mdMachines, machine)
+	}
+
+	sort.Slice(mdMachines, func(i, j int) bool {
+		return mdMachines[i].Name < mdMachines[j].Name
+	})
+
+	return mdMachines, nil
+}
+
+func (m *Migrator) checkMachineReady(ctx context.Context, machine *clusterv1beta2.Machine) error {
+	if !machine.Status.NodeRef.IsDefined() {
+		return fmt.Errorf("machine %s doesn't have a node yet", machine.Name)
+	}
+
+	node := &corev1.Node{}
+	if err := m.workloadClient.Get(ctx, machine.Status.NodeRef.Name, "", node); err != nil {
+		return fmt.Errorf("reading node %s: %v", machine.Status.NodeRef.Name, err)
+	}
+
+	for _, c := range node.Status.Conditions {
+		if c.Type == corev1.NodeReady && c.Status == corev1.ConditionTrue {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("node %s of machine %s is not ready", node.Name, machine.Name)
+}
+
+func (m *Migrator) cordon(ctx context.Context, nodeName string) error {
+	node := &corev1.Node{}
+	if err := m.workloadClient.Get(ctx, nodeName, "", node); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("reading node %s: %v", nodeName, err)
+	}
+
+	if node.Spec.Unschedulable {
+		return nil
+	}
+
+	node.Spec.Unschedulable = true
+	if err := m.workloadClient.Update(ctx, node); err != nil {
+		return fmt.Errorf("cordoning node %s: %v", nodeName, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,330 @@
+package providermigration
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/utils/ptr"
+	clusterv1beta2 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/aws/eks-anywhere/internal/test"
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/clients/kubernetes"
+	"github.com/aws/eks-anywhere/pkg/constants"
+	"github.com/aws/eks-anywhere/pkg/retrier"
+	unstructuredutil "github.com/aws/eks-anywhere/pkg/utils/unstructured"
+)
+
+// scalingClient simulates the CAPI MachineDeployment controller by creating machines with a ready
+// node, or deleting the machines marked for deletion, when a MachineDeployment is scaled.
+type scalingClient struct {
+	kubernetes.Client
+	created int
+}
+
+func (c *scalingClient) Update(ctx context.Context, obj kubernetes.Object) error {
+	if err := c.Client.Update(ctx, obj); err != nil {
+		return err
+	}
+
+	md, ok := obj.(*clusterv1beta2.MachineDeployment)
+	if !ok {
+		return nil
+	}
+
+	machines := &clusterv1beta2.MachineList{}
+	if err := c.Client.List(ctx, machines); err != nil {
+		return err
+	}
+
+	var existing []clusterv1beta2.Machine
+	for _, m := range machines.Items {
+		if m.Labels[clusterv1beta2.MachineDeploymentNameLabel] == md.Name {
+			existing = append(existing, m)
+		}
+	}
+
+	replicas := int(ptr.Deref(md.Spec.Replicas, 0))
+	for i := len(existing); i < replicas; i++ {
+		c.created++
+		name := fmt.Sprintf("%s-new-%d", md.Name, c.created)
+		if err := c.Client.Create(ctx, machine(name, md.Name, "node-"+name)); err != nil {
+			return err
+		}
+		if err := c.Client.Create(ctx, node("node-"+name)); err != nil {
+			return err
+		}
+	}
+
+	for i := len(existing); i > replicas; i-- {
+		for j := range existing {
+			if _, ok := existing[j].Annotations[clusterv1beta2.DeleteMachineAnnotation]; ok {
+				if err := c.Client.Delete(ctx, &existing[j]); err != nil {
+					return err
+				}
+				existing = append(existing[:j], existing[j+1:]...)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+func machine(name, mdName, nodeName string) *clusterv1beta2.Machine {
+	return &clusterv1beta2.Machine{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: constants.EksaSystemNamespace,
+			Labels: map[string]string{
+				clusterv1beta2.ClusterNameLabel:           "my-cluster",
+				clusterv1beta2.MachineDeploymentNameLabel: mdName,
+			},
+		},
+		Status: clusterv1beta2.MachineStatus{
+			NodeRef: clusterv1beta2.MachineNodeReference{Name: nodeName},
+		},
+	}
+}
+
+func node(name string) *corev1.Node {
+	return &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Status: corev1.NodeStatus{
+			Conditions: []corev1.NodeCondition{{Type: corev1.NodeReady, Status: corev1.ConditionTrue}},
+		},
+	}
+}
+
+func machineDeployment(name string, replicas int32) *clusterv1beta2.MachineDeployment {
+	return &clusterv1beta2.MachineDeployment{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: constants.EksaSystemNamespace},
+		Spec: clusterv1beta2.MachineDeploymentSpec{
+			ClusterName: "my-cluster",
+			Replicas:    ptr.To(replicas),
+		},
+	}
+}
+
+func cluster() *anywherev1.Cluster {
+	return &anywherev1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-cluster", Namespace: "default"},
+	}
+}
+
+func targetObjects(t *testing.T, clusterName string) []unstructured.Unstructured {
+	objs, err := unstructuredutil.YamlToUnstructured([]byte(`apiVersion: cluster.x-k8s.io/v1beta2
+kind: MachineDeployment
+metadata:
+  name: my-cluster-baremetal
+  namespace: eksa-system
+spec:
+  clusterName: ` + clusterName + `
+  replicas: 3
+  selector: {}
+  template:
+    spec:
+      clusterName: ` + clusterName + `
+      bootstrap: {}
+      infrastructureRef:
+        apiGroup: infrastructure.cluster.x-k8s.io
+        kind: TinkerbellMachineTemplate
+        name: my-cluster-baremetal-1
+---
+apiVersion: infrastructure.cluster.x-k8s.io/v1beta1
+kind: TinkerbellMachineTemplate
+metadata:
+  name: my-cluster-baremetal-1
+  namespace: eksa-system
+spec:
+  template:
+    spec: {}
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return objs
+}
+
+func tinkerbellCluster(clusterName string) *unstructured.Unstructured {
+	u := &unstructured.Unstructured{}
+	u.SetAPIVersion("infrastructure.cluster.x-k8s.io/v1beta1")
+	u.SetKind("TinkerbellCluster")
+	u.SetName(clusterName + "-baremetal")
+	u.SetNamespace(constants.EksaSystemNamespace)
+	u.SetLabels(map[string]string{clusterv1beta2.ClusterNameLabel: clusterName})
+	return u
+}
+
+func migration(t *testing.T) Migration {
+	return Migration{
+		ClusterName:             "my-cluster",
+		ClusterNamespace:        "default",
+		SourceMachineDeployment: "my-cluster-md-0",
+		TargetObjects:           targetObjects(t, "my-cluster"),
+		ManualCutover:           true,
+	}
+}
+
+func newTestMigrator(objs ...client.Object) (*Migrator, kubernetes.Client) {
+	c := &scalingClient{Client: test.NewFakeKubeClient(objs...)}
+	return NewMigrator(c, c, WithMigratorRetrier(retrier.NewWithMaxRetries(1, 0))), c
+}
+
+func TestMigratorMigrate(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	migrator, c := newTestMigrator(
+		cluster(),
+		tinkerbellCluster("my-cluster"),
+		machineDeployment("my-cluster-md-0", 2),
+		machine("my-cluster-md-0-1", "my-cluster-md-0", "node-1"),
+		machine("my-cluster-md-0-2", "my-cluster-md-0", "node-2"),
+		node("node-1"),
+		node("node-2"),
+	)
+
+	g.Expect(migrator.Migrate(ctx, migration(t))).To(Succeed())
+
+	eksaCluster := &anywherev1.Cluster{}
+	g.Expect(c.Get(ctx, "my-cluster", "default", eksaCluster)).To(Succeed())
+	g.Expect(eksaCluster.IsReconcilePaused()).To(BeTrue())
+
+	source := &clusterv1beta2.MachineDeployment{}
+	g.Expect(c.Get(ctx, "my-cluster-md-0", constants.EksaSystemNamespace, source)).To(Succeed())
+	g.Expect(source.Spec.Replicas).To(HaveValue(BeEquivalentTo(0)))
+
+	target := &clusterv1beta2.MachineDeployment{}
+	g.Expect(c.Get(ctx, "my-cluster-baremetal", constants.EksaSystemNamespace, target)).To(Succeed())
+	g.Expect(target.Spec.Replicas).To(HaveValue(BeEquivalentTo(2)))
+
+	machines := &clusterv1beta2.MachineList{}
+	g.Expect(c.List(ctx, machines)).To(Succeed())
+	g.Expect(machines.Items).To(HaveLen(2))
+	for _, m := range machines.Items {
+		g.Expect(m.Labels[clusterv1beta2.MachineDeploymentNameLabel]).To(Equal("my-cluster-baremetal"))
+	}
+
+	for _, name := range []string{"node-1", "node-2"} {
+		n := &corev1.Node{}
+		g.Expect(c.Get(ctx, name, "", n)).To(Succeed())
+		g.Expect(n.Spec.Unschedulable).To(BeTrue())
+	}
+}
+
+func TestMigratorMigrateResumesWithExistingTarget(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	migrator, c := newTestMigrator(
+		cluster(),
+		tinkerbellCluster("my-cluster"),
+		machineDeployment("my-cluster-md-0", 1),
+		machine("my-cluster-md-0-2", "my-cluster-md-0", "node-2"),
+		node("node-2"),
+		machineDeployment("my-cluster-baremetal", 1),
+		machine("my-cluster-baremetal-1", "my-cluster-baremetal", "node-3"),
+		node("node-3"),
+	)
+
+	g.Expect(migrator.Migrate(ctx, migration(t))).To(Succeed())
+
+	target := &clusterv1beta2.MachineDeployment{}
+	g.Expect(c.Get(ctx, "my-cluster-baremetal", constants.EksaSystemNamespace, target)).To(Succeed())
+	g.Expect(target.Spec.Replicas).To(HaveValue(BeEquivalentTo(2)))
+}
+
+func TestMigratorMigrateErrorSourceNotFound(t *testing.T) {
+	g := NewWithT(t)
+	migrator, _ := newTestMigrator(cluster())
+
+	g.Expect(migrator.Migrate(context.Background(), migration(t))).To(MatchError(ContainSubstring("reading source machine deployment my-cluster-md-0")))
+}
+
+func TestMigratorMigrateErrorSourceOtherCluster(t *testing.T) {
+	g := NewWithT(t)
+	source := machineDeployment("my-cluster-md-0", 1)
+	source.Spec.ClusterName = "other-cluster"
+	migrator, _ := newTestMigrator(cluster(), source)
+
+	g.Expect(migrator.Migrate(context.Background(), migration(t))).To(MatchError(ContainSubstring("does not belong to cluster my-cluster")))
+}
+
+func TestMigratorMigrateErrorTargetOtherCluster(t *testing.T) {
+	g := NewWithT(t)
+	migrator, _ := newTestMigrator(cluster(), machineDeployment("my-cluster-md-0", 1))
+	m := migration(t)
+	m.TargetObjects = targetObjects(t, "other-cluster")
+
+	g.Expect(migrator.Migrate(context.Background(), m)).To(MatchError(ContainSubstring("target machine deployment my-cluster-baremetal does not belong to cluster my-cluster")))
+}
+
+func TestMigratorMigrateErrorNoTargetMachineDeployment(t *testing.T) {
+	g := NewWithT(t)
+	migrator, _ := newTestMigrator(cluster(), machineDeployment("my-cluster-md-0", 1))
+	m := migration(t)
+	m.TargetObjects = nil
+
+	g.Expect(migrator.Migrate(context.Background(), m)).To(MatchError("target objects must contain a MachineDeployment"))
+}
+
+func TestMigratorMigrateErrorTargetNotReady(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	c := test.NewFakeKubeClient(
+		cluster(),
+		tinkerbellCluster("my-cluster"),
+		machineDeployment("my-cluster-md-0", 1),
+		machine("my-cluster-md-0-1", "my-cluster-md-0", "node-1"),
+		node("node-1"),
+	)
+	migrator := NewMigrator(c, c, WithMigratorRetrier(retrier.NewWithMaxRetries(1, 0)))
+
+	g.Expect(migrator.Migrate(ctx, migration(t))).To(MatchError(ContainSubstring("waiting for target machine deployment my-cluster-baremetal nodes")))
+
+	n := &corev1.Node{}
+	g.Expect(c.Get(ctx, "node-1", "", n)).To(Succeed())
+	g.Expect(n.Spec.Unschedulable).To(BeFalse())
+}
+
+func TestMigratorMigrateErrorManualCutoverNotAcknowledged(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	migrator, c := newTestMigrator(cluster(), tinkerbellCluster("my-cluster"), machineDeployment("my-cluster-md-0", 1))
+	m := migration(t)
+	m.ManualCutover = false
+
+	g.Expect(migrator.Migrate(ctx, m)).To(MatchError(ContainSubstring("manual cutover, which must be acknowledged")))
+
+	eksaCluster := &anywherev1.Cluster{}
+	g.Expect(c.Get(ctx, "my-cluster", "default", eksaCluster)).To(Succeed())
+	g.Expect(eksaCluster.IsReconcilePaused()).To(BeFalse())
+}
+
+func TestMigratorMigrateErrorTargetInfrastructureNotFound(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	migrator, c := newTestMigrator(cluster(), tinkerbellCluster("other-cluster"), machineDeployment("my-cluster-md-0", 1))
+
+	g.Expect(migrator.Migrate(ctx, migration(t))).To(MatchError(ContainSubstring(
+		"target infrastructure not found: no TinkerbellCluster in namespace eksa-system with label cluster.x-k8s.io/cluster-name=my-cluster",
+	)))
+
+	eksaCluster := &anywherev1.Cluster{}
+	g.Expect(c.Get(ctx, "my-cluster", "default", eksaCluster)).To(Succeed())
+	g.Expect(eksaCluster.IsReconcilePaused()).To(BeFalse())
+}
+
+func TestMigratorMigrateErrorTargetTemplateMissing(t *testing.T) {
+	g := NewWithT(t)
+	migrator, _ := newTestMigrator(cluster(), tinkerbellCluster("my-cluster"), machineDeployment("my-cluster-md-0", 1))
+	m := migration(t)
+	m.TargetObjects = m.TargetObjects[:1]
+
+	g.Expect(migrator.Migrate(context.Background(), m)).To(MatchError("target objects must contain the infrastructure template TinkerbellMachineTemplate my-cluster-baremetal-1"))
+}
@@ -0,0 +1,39 @@
+package azurestackhci
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strconv"
+	"time"
+)
+
+// cloudAgentPort is the port the Azure Stack HCI cloud agent (MOC) listens on.
+const cloudAgentPort = 55000
+
+// Client is a minimal Azure Stack HCI cloud agent client used to validate the environment.
+type Client interface {
+	CheckCloudAgent(ctx context.Context, fqdn string) error
+}
+
+type cloudAgentClient struct {
+	dialer *net.Dialer
+}
+
+// NewClient returns an Azure Stack HCI cloud agent client.
+func NewClient() Client {
+	return &cloudAgentClient{
+		dialer: &net.Dialer{Timeout: 10 * time.Second},
+	}
+}
+
+// CheckCloudAgent checks the cloud agent is reachable.
+func (c *cloudAgentClient) CheckCloudAgent(ctx context.Context, fqdn string) error {
+	address := net.JoinHostPort(fqdn, strconv.Itoa(cloudAgentPort))
+	conn, err := c.dialer.DialContext(ctx, "tcp", address)
+	if err != nil {
+		return fmt.Errorf("dialing cloud agent %s: %v", address, err)
+	}
+
+	return conn.Close()
+}
@@ -0,0 +1,34 @@
+package azurestackhci
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/aws/eks-anywhere/pkg/constants"
+)
+
+// Env vars read by the CAPI Azure Stack HCI provider (CAPH) components manifest.
+const (
+	cloudAgentFQDNKey = "AZURESTACKHCI_CLOUDAGENT_FQDN"
+	loginConfigKey    = "AZURESTACKHCI_LOGIN_CONFIG_B64"
+)
+
+// GetLoginConfigFromEnv returns the content of the cloud agent login config file
+// whose path is set in the environment.
+func GetLoginConfigFromEnv() ([]byte, error) {
+	path, ok := os.LookupEnv(constants.EksaAzureStackHCILoginConfigKey)
+	if !ok || len(path) == 0 {
+		return nil, fmt.Errorf("%s is not set or is empty", constants.EksaAzureStackHCILoginConfigKey)
+	}
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading azure stack hci login config: %v", err)
+	}
+
+	if len(content) == 0 {
+		return nil, fmt.Errorf("azure stack hci login config %s is empty", path)
+	}
+
+	return content, nil
+}
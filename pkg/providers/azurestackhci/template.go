@@ -0,0 +1,359 @@
+package azurestackhci
+
+import (
+	_ "embed"
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/yaml"
+
+	"github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/cluster"
+	"github.com/aws/eks-anywhere/pkg/clusterapi"
+	"github.com/aws/eks-anywhere/pkg/config"
+	"github.com/aws/eks-anywhere/pkg/constants"
+	"github.com/aws/eks-anywhere/pkg/crypto"
+	"github.com/aws/eks-anywhere/pkg/providers"
+	"github.com/aws/eks-anywhere/pkg/providers/common"
+	"github.com/aws/eks-anywhere/pkg/registrymirror"
+	"github.com/aws/eks-anywhere/pkg/registrymirror/containerd"
+	"github.com/aws/eks-anywhere/pkg/templater"
+	"github.com/aws/eks-anywhere/pkg/types"
+)
+
+//go:embed config/template-cp.yaml
+var defaultCAPIConfigCP string
+
+//go:embed config/template-md.yaml
+var defaultClusterConfigMD string
+
+// TemplateBuilder builds the CAPI AzureStackHCI templates.
+type TemplateBuilder struct {
+	now types.NowFunc
+}
+
+var _ providers.TemplateBuilder = &TemplateBuilder{}
+
+// NewTemplateBuilder returns a new TemplateBuilder.
+func NewTemplateBuilder(now types.NowFunc) *TemplateBuilder {
+	return &TemplateBuilder{
+		now: now,
+	}
+}
+
+// GenerateCAPISpecControlPlane generates the yaml spec for the CAPI control plane objects.
+func (tb *TemplateBuilder) GenerateCAPISpecControlPlane(clusterSpec *cluster.Spec, buildOptions ...providers.BuildMapOption) (content []byte, err error) {
+	controlPlaneMachineSpec, err := machineSpec(clusterSpec, clusterSpec.Cluster.Spec.ControlPlaneConfiguration.MachineGroupRef)
+	if err != nil {
+		return nil, err
+	}
+
+	values, err := buildTemplateMapCP(clusterSpec, *controlPlaneMachineSpec)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, buildOption := range buildOptions {
+		buildOption(values)
+	}
+
+	bytes, err := templater.Execute(defaultCAPIConfigCP, values)
+	if err != nil {
+		return nil, err
+	}
+
+	return bytes, nil
+}
+
+// GenerateCAPISpecWorkers generates the yaml spec for the CAPI worker objects.
+func (tb *TemplateBuilder) GenerateCAPISpecWorkers(clusterSpec *cluster.Spec, workloadTemplateNames, kubeadmconfigTemplateNames map[string]string) (content []byte, err error) {
+	workerSpecs := make([][]byte, 0, len(clusterSpec.Cluster.Spec.WorkerNodeGroupConfigurations))
+	for _, workerNodeGroupConfiguration := range clusterSpec.Cluster.Spec.WorkerNodeGroupConfigurations {
+		workerMachineSpec, err := machineSpec(clusterSpec, workerNodeGroupConfiguration.MachineGroupRef)
+		if err != nil {
+			return nil, err
+		}
+
+		values, err := buildTemplateMapMD(clusterSpec, *workerMachineSpec, workerNodeGroupConfiguration)
+		if err != nil {
+			return nil, err
+		}
+		values["workloadTemplateName"] = workloadTemplateNames[workerNodeGroupConfiguration.Name]
+		values["workloadkubeadmconfigTemplateName"] = kubeadmconfigTemplateNames[workerNodeGroupConfiguration.Name]
+		values["autoscalingConfig"] = workerNodeGroupConfiguration.AutoScalingConfiguration
+
+		if workerNodeGroupConfiguration.UpgradeRolloutStrategy != nil {
+			values["upgradeRolloutStrategy"] = true
+			values["maxSurge"] = workerNodeGroupConfiguration.UpgradeRolloutStrategy.RollingUpdate.MaxSurge
+			values["maxUnavailable"] = workerNodeGroupConfiguration.UpgradeRolloutStrategy.RollingUpdate.MaxUnavailable
+		}
+
+		bytes, err := templater.Execute(defaultClusterConfigMD, values)
+		if err != nil {
+			return nil, err
+		}
+		workerSpecs = append(workerSpecs, bytes)
+	}
+
+	return templater.AppendYamlResources(workerSpecs...), nil
+}
+
+// CAPIWorkersSpecWithInitialNames generates a yaml spec with the CAPI objects representing the worker
+// nodes for a particular eks-a cluster. It uses default initial names (ended in '-1') for the azurestackhci
+// machine templates and kubeadm config templates.
+func (tb *TemplateBuilder) CAPIWorkersSpecWithInitialNames(spec *cluster.Spec) (content []byte, err error) {
+	machineTemplateNames, kubeadmConfigTemplateNames := clusterapi.InitialTemplateNamesForWorkers(spec)
+	return tb.GenerateCAPISpecWorkers(spec, machineTemplateNames, kubeadmConfigTemplateNames)
+}
+
+func machineSpec(clusterSpec *cluster.Spec, ref *v1alpha1.Ref) (*v1alpha1.AzureStackHCIMachineConfigSpec, error) {
+	if ref == nil {
+		return nil, fmt.Errorf("machineGroupRef is not set")
+	}
+
+	machineConfig := clusterSpec.AzureStackHCIMachineConfig(ref.Name)
+	if machineConfig == nil {
+		return nil, fmt.Errorf("AzureStackHCIMachineConfig %s not found", ref.Name)
+	}
+
+	return &machineConfig.Spec, nil
+}
+
+func machineDeploymentName(clusterName, nodeGroupName string) string {
+	return fmt.Sprintf("%s-%s", clusterName, nodeGroupName)
+}
+
+func buildTemplateMapCP(clusterSpec *cluster.Spec, controlPlaneMachineSpec v1alpha1.AzureStackHCIMachineConfigSpec) (map[string]interface{}, error) {
+	versionsBundle := clusterSpec.RootVersionsBundle()
+	apiServerExtraArgs := clusterapi.OIDCToExtraArgs(clusterSpec.OIDCConfig).
+		Append(clusterapi.AwsIamAuthExtraArgs(clusterSpec.AWSIamConfig)).
+		Append(clusterapi.APIServerExtraArgs(clusterSpec.Cluster.Spec.ControlPlaneConfiguration.APIServerExtraArgs)).
+		Append(clusterapi.EtcdEncryptionExtraArgs(clusterSpec.Cluster.Spec.EtcdEncryption))
+	clusterapi.SetPodIAMAuthExtraArgs(clusterSpec.Cluster.Spec.PodIAMConfig, apiServerExtraArgs)
+
+	var auditPolicy string
+	if clusterSpec.Cluster.Spec.ControlPlaneConfiguration.AuditPolicyContent != "" {
+		auditPolicy = strings.TrimSpace(clusterSpec.Cluster.Spec.ControlPlaneConfiguration.AuditPolicyContent)
+	} else {
+		var err error
+		auditPolicy, err = common.GetAuditPolicy(clusterSpec.Cluster.Spec.KubernetesVersion)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	values := map[string]interface{}{
+		"auditPolicy":                 auditPolicy,
+		"auditLog":                    common.GetAuditLogSettings(clusterSpec.Cluster),
+		"apiServerExtraArgs":          apiServerExtraArgs,
+		"apiServerCertSANs":           clusterSpec.Cluster.Spec.ControlPlaneConfiguration.CertSANs,
+		"clusterName":                 clusterSpec.Cluster.Name,
+		"controlPlaneEndpointIp":      clusterSpec.Cluster.Spec.ControlPlaneConfiguration.Endpoint.Host,
+		"controlPlaneEndpointVIPCIDR": common.KubeVipCIDR(clusterSpec.Cluster.Spec.ControlPlaneConfiguration.Endpoint.Host),
+		"controlPlaneReplicas":        clusterSpec.Cluster.Spec.ControlPlaneConfiguration.Count,
+		"controlPlaneUsers":           common.BootstrapUsers(controlPlaneMachineSpec.Users),
+		"controlPlaneTaints":          clusterSpec.Cluster.Spec.ControlPlaneConfiguration.Taints,
+		"controlPlaneTemplateName":    clusterapi.ControlPlaneMachineTemplateName(clusterSpec.Cluster),
+		"eksaSystemNamespace":         constants.EksaSystemNamespace,
+		"podCidrs":                    clusterSpec.Cluster.Spec.ClusterNetwork.Pods.CidrBlocks,
+		"serviceCidrs":                clusterSpec.Cluster.Spec.ClusterNetwork.Services.CidrBlocks,
+		"skipKubeProxy":               clusterSpec.Cluster.Spec.ClusterNetwork.CNIConfig.KubeProxyReplacementEnabled(),
+		"kubernetesVersion":           versionsBundle.KubeDistro.Kubernetes.Tag,
+		"kubernetesRepository":        versionsBundle.KubeDistro.Kubernetes.Repository,
+		"corednsRepository":           versionsBundle.KubeDistro.CoreDNS.Repository,
+		"corednsVersion":              versionsBundle.KubeDistro.CoreDNS.Tag,
+		"etcdRepository":              versionsBundle.KubeDistro.Etcd.Repository,
+		"etcdImageTag":                versionsBundle.KubeDistro.Etcd.Tag,
+		"kubeVipImage":                versionsBundle.AzureStackHCI.KubeVip.VersionedImage(),
+		"resourceGroup":               resourceGroup(clusterSpec),
+		"virtualNetwork":              clusterSpec.AzureStackHCIDatacenter.Spec.VirtualNetwork,
+	}
+
+	addDatacenterValues(values, clusterSpec.AzureStackHCIDatacenter.Spec)
+	addMachineValues(values, controlPlaneMachineSpec)
+
+	if clusterSpec.Cluster.Spec.ControlPlaneConfiguration.SkipAdmissionForSystemResources != nil &&
+		*clusterSpec.Cluster.Spec.ControlPlaneConfiguration.SkipAdmissionForSystemResources {
+		admissionExclusionPolicy, err := common.GetAdmissionPluginExclusionPolicy()
+		if err != nil {
+			return nil, err
+		}
+		values["admissionExclusionPolicy"] = admissionExclusionPolicy
+	}
+
+	if err := addRegistryMirrorValues(values, clusterSpec); err != nil {
+		return values, err
+	}
+
+	if clusterSpec.AWSIamConfig != nil {
+		values["awsIamAuth"] = true
+	}
+
+	if clusterSpec.Cluster.Spec.ProxyConfiguration != nil {
+		values["proxyConfig"] = true
+		values["httpProxy"] = clusterSpec.Cluster.Spec.ProxyConfiguration.HttpProxy
+		values["httpsProxy"] = clusterSpec.Cluster.Spec.ProxyConfiguration.HttpsProxy
+		values["noProxy"] = generateNoProxyList(clusterSpec)
+	}
+
+	if clusterSpec.Cluster.Spec.ControlPlaneConfiguration.UpgradeRolloutStrategy != nil {
+		values["upgradeRolloutStrategy"] = true
+		values["maxSurge"] = clusterSpec.Cluster.Spec.ControlPlaneConfiguration.UpgradeRolloutStrategy.RollingUpdate.MaxSurge
+	}
+
+	if clusterSpec.Cluster.Spec.EtcdEncryption != nil && len(*clusterSpec.Cluster.Spec.EtcdEncryption) != 0 {
+		conf, err := common.GenerateKMSEncryptionConfiguration(clusterSpec.Cluster.Spec.EtcdEncryption)
+		if err != nil {
+			return nil, err
+		}
+
+		values["encryptionProviderConfig"] = conf
+		values["kmsV1"] = common.KMSV1Enabled(clusterSpec.Cluster.Spec.EtcdEncryption)
+		values["kmsPluginSocketDirs"] = common.KMSPluginSocketDirs(clusterSpec.Cluster.Spec.EtcdEncryption)
+	}
+
+	if err := addKubeletValues(values, clusterSpec, clusterSpec.Cluster.Spec.ControlPlaneConfiguration.KubeletConfiguration); err != nil {
+		return nil, err
+	}
+
+	nodeLabelArgs := clusterapi.ControlPlaneNodeLabelsExtraArgs(clusterSpec.Cluster.Spec.ControlPlaneConfiguration)
+	if len(nodeLabelArgs) != 0 {
+		values["nodeLabelArgs"] = nodeLabelArgs
+	}
+
+	return values, nil
+}
+
+func buildTemplateMapMD(clusterSpec *cluster.Spec, workerNodeGroupMachineSpec v1alpha1.AzureStackHCIMachineConfigSpec, workerNodeGroupConfiguration v1alpha1.WorkerNodeGroupConfiguration) (map[string]interface{}, error) {
+	versionsBundle := clusterSpec.WorkerNodeGroupVersionsBundle(workerNodeGroupConfiguration)
+
+	values := map[string]interface{}{
+		"clusterName":           clusterSpec.Cluster.Name,
+		"eksaSystemNamespace":   constants.EksaSystemNamespace,
+		"kubernetesVersion":     versionsBundle.KubeDistro.Kubernetes.Tag,
+		"workerReplicas":        *workerNodeGroupConfiguration.Count,
+		"workerUsers":           common.BootstrapUsers(workerNodeGroupMachineSpec.Users),
+		"workerNodeGroupName":   machineDeploymentName(clusterSpec.Cluster.Name, workerNodeGroupConfiguration.Name),
+		"workerNodeGroupTaints": workerNodeGroupConfiguration.Taints,
+	}
+
+	addDatacenterValues(values, clusterSpec.AzureStackHCIDatacenter.Spec)
+	addMachineValues(values, workerNodeGroupMachineSpec)
+
+	if err := addRegistryMirrorValues(values, clusterSpec); err != nil {
+		return values, err
+	}
+
+	if clusterSpec.Cluster.Spec.ProxyConfiguration != nil {
+		values["proxyConfig"] = true
+		values["httpProxy"] = clusterSpec.Cluster.Spec.ProxyConfiguration.HttpProxy
+		values["httpsProxy"] = clusterSpec.Cluster.Spec.ProxyConfiguration.HttpsProxy
+		values["noProxy"] = generateNoProxyList(clusterSpec)
+	}
+
+	if err := addKubeletValues(values, clusterSpec, workerNodeGroupConfiguration.KubeletConfiguration); err != nil {
+		return nil, err
+	}
+
+	nodeLabelArgs := clusterapi.WorkerNodeLabelsExtraArgs(workerNodeGroupConfiguration)
+	if len(nodeLabelArgs) != 0 {
+		values["nodeLabelArgs"] = nodeLabelArgs
+	}
+
+	return values, nil
+}
+
+// resourceGroup returns the resource group the cluster resources are created in,
+// which defaults to the cluster name.
+func resourceGroup(clusterSpec *cluster.Spec) string {
+	if clusterSpec.AzureStackHCIDatacenter.Spec.ResourceGroup != "" {
+		return clusterSpec.AzureStackHCIDatacenter.Spec.ResourceGroup
+	}
+
+	return clusterSpec.Cluster.Name
+}
+
+func addDatacenterValues(values map[string]interface{}, datacenterSpec v1alpha1.AzureStackHCIDatacenterConfigSpec) {
+	values["location"] = datacenterSpec.Location
+	values["storageContainer"] = datacenterSpec.StorageContainer
+}
+
+func addMachineValues(values map[string]interface{}, machineSpec v1alpha1.AzureStackHCIMachineConfigSpec) {
+	values["vmSize"] = machineSpec.VMSize
+	values["image"] = machineSpec.Image
+	values["osDiskSizeGiB"] = machineSpec.OSDiskSizeGiB
+}
+
+func addRegistryMirrorValues(values map[string]interface{}, clusterSpec *cluster.Spec) error {
+	if clusterSpec.Cluster.Spec.RegistryMirrorConfiguration == nil {
+		return nil
+	}
+
+	registryMirror := registrymirror.FromCluster(clusterSpec.Cluster)
+	values["registryMirrorMap"] = containerd.ToAPIEndpoints(registryMirror.NamespacedRegistryMap)
+	values["mirrorBase"] = registryMirror.BaseRegistry
+	values["mirrorBaseAPIEndpoint"] = containerd.ToAPIEndpoint(registryMirror.BaseRegistry)
+	values["insecureSkip"] = registryMirror.InsecureSkipVerify
+	if len(registryMirror.CACertContent) > 0 {
+		values["registryCACert"] = registryMirror.CACertContent
+	}
+
+	if registryMirror.Auth {
+		values["registryAuth"] = registryMirror.Auth
+		username, password, err := config.ReadCredentials()
+		if err != nil {
+			return err
+		}
+		values["registryUsername"] = username
+		values["registryPassword"] = password
+	}
+
+	return nil
+}
+
+func addKubeletValues(values map[string]interface{}, clusterSpec *cluster.Spec, kubeletConfiguration *unstructured.Unstructured) error {
+	if kubeletConfiguration == nil {
+		kubeletExtraArgs := clusterapi.SecureTlsCipherSuitesExtraArgs().
+			Append(clusterapi.ResolvConfExtraArgs(clusterSpec.Cluster.Spec.ClusterNetwork.DNS.ResolvConf))
+		values["kubeletExtraArgs"] = kubeletExtraArgs
+		return nil
+	}
+
+	kubeletConfig := kubeletConfiguration.Object
+	if _, ok := kubeletConfig["tlsCipherSuites"]; !ok {
+		kubeletConfig["tlsCipherSuites"] = crypto.SecureCipherSuiteNames()
+	}
+
+	if _, ok := kubeletConfig["resolvConf"]; !ok {
+		if clusterSpec.Cluster.Spec.ClusterNetwork.DNS.ResolvConf != nil {
+			kubeletConfig["resolvConf"] = clusterSpec.Cluster.Spec.ClusterNetwork.DNS.ResolvConf.Path
+		}
+	}
+
+	kcString, err := yaml.Marshal(kubeletConfig)
+	if err != nil {
+		return fmt.Errorf("error marshaling %v", err)
+	}
+
+	values["kubeletConfiguration"] = string(kcString)
+	return nil
+}
+
+func generateNoProxyList(clusterSpec *cluster.Spec) []string {
+	capacity := len(clusterSpec.Cluster.Spec.ClusterNetwork.Pods.CidrBlocks) +
+		len(clusterSpec.Cluster.Spec.ClusterNetwork.Services.CidrBlocks) +
+		len(clusterSpec.Cluster.Spec.ProxyConfiguration.NoProxy) + 4
+
+	noProxyList := make([]string, 0, capacity)
+	noProxyList = append(noProxyList, clusterSpec.Cluster.Spec.ClusterNetwork.Pods.CidrBlocks...)
+	noProxyList = append(noProxyList, clusterSpec.Cluster.Spec.ClusterNetwork.Services.CidrBlocks...)
+	noProxyList = append(noProxyList, clusterSpec.Cluster.Spec.ProxyConfiguration.NoProxy...)
+
+	// Add no-proxy defaults
+	noProxyList = append(noProxyList, clusterapi.NoProxyDefaults()...)
+	noProxyList = append(noProxyList, clusterSpec.Cluster.Spec.ControlPlaneConfiguration.Endpoint.Host)
+	noProxyList = append(noProxyList, clusterSpec.AzureStackHCIDatacenter.Spec.CloudAgentFQDN)
+
+	return noProxyList
+}
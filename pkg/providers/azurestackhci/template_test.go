@@ -0,0 +1,199 @@
+package azurestackhci
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/yaml"
+
+	"github.com/aws/eks-anywhere/internal/test"
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/cluster"
+)
+
+func azurestackhciClusterSpec() *cluster.Spec {
+	return test.NewClusterSpec(func(s *cluster.Spec) {
+		s.Cluster.Name = "test"
+		s.Cluster.Spec.ControlPlaneConfiguration = anywherev1.ControlPlaneConfiguration{
+			Count:    1,
+			Endpoint: &anywherev1.Endpoint{Host: "10.0.0.5"},
+			MachineGroupRef: &anywherev1.Ref{
+				Kind: anywherev1.AzureStackHCIMachineConfigKind,
+				Name: "test-cp",
+			},
+		}
+		s.Cluster.Spec.WorkerNodeGroupConfigurations = []anywherev1.WorkerNodeGroupConfiguration{
+			{
+				Name:  "md-0",
+				Count: ptrInt(2),
+				MachineGroupRef: &anywherev1.Ref{
+					Kind: anywherev1.AzureStackHCIMachineConfigKind,
+					Name: "test-md",
+				},
+			},
+		}
+		s.Cluster.Spec.DatacenterRef = anywherev1.Ref{
+			Kind: anywherev1.AzureStackHCIDatacenterKind,
+			Name: "test",
+		}
+		s.Cluster.Spec.ClusterNetwork = anywherev1.ClusterNetwork{
+			Pods:     anywherev1.Pods{CidrBlocks: []string{"192.168.0.0/16"}},
+			Services: anywherev1.Services{CidrBlocks: []string{"10.96.0.0/12"}},
+		}
+		s.AzureStackHCIDatacenter = &anywherev1.AzureStackHCIDatacenterConfig{
+			Spec: anywherev1.AzureStackHCIDatacenterConfigSpec{
+				CloudAgentFQDN:   "cloudagent.example.com",
+				Location:         "hci-location",
+				VirtualNetwork:   "hci-vnet",
+				StorageContainer: "hci-storage",
+			},
+		}
+		s.AzureStackHCIMachineConfigs = map[string]*anywherev1.AzureStackHCIMachineConfig{
+			"test-cp": azurestackhciMachineConfig("test-cp", "Standard_A4_v2"),
+			"test-md": azurestackhciMachineConfig("test-md", "Standard_D4s_v3"),
+		}
+		s.VersionsBundles[anywherev1.Kube119].KubeDistro.Kubernetes.Tag = "v1.19.8-eks-1-19-4"
+	})
+}
+
+func azurestackhciMachineConfig(name, vmSize string) *anywherev1.AzureStackHCIMachineConfig {
+	m := &anywherev1.AzureStackHCIMachineConfig{
+		Spec: anywherev1.AzureStackHCIMachineConfigSpec{
+			VMSize: vmSize,
+			Image:  "ubuntu-2204-kube-v1-29",
+			Users: []anywherev1.UserConfiguration{
+				{Name: "eksa", SshAuthorizedKeys: []string{"ssh-rsa AAAA"}},
+			},
+		},
+	}
+	m.Name = name
+	m.SetDefaults()
+	return m
+}
+
+func ptrInt(i int) *int {
+	return &i
+}
+
+func parseObjects(t *testing.T, content []byte) map[string]*unstructured.Unstructured {
+	t.Helper()
+	objs := map[string]*unstructured.Unstructured{}
+	for _, doc := range strings.Split(string(content), "\n---\n") {
+		if strings.TrimSpace(doc) == "" {
+			continue
+		}
+		json, err := yaml.YAMLToJSON([]byte(doc))
+		if err != nil {
+			t.Fatalf("parsing generated yaml: %v", err)
+		}
+		u := &unstructured.Unstructured{}
+		if err := u.UnmarshalJSON(json); err != nil {
+			t.Fatalf("parsing generated yaml: %v", err)
+		}
+		objs[u.GetKind()+"/"+u.GetName()] = u
+	}
+	return objs
+}
+
+func TestTemplateBuilderGenerateCAPISpecControlPlane(t *testing.T) {
+	g := NewWithT(t)
+	builder := NewTemplateBuilder(time.Now)
+
+	content, err := builder.GenerateCAPISpecControlPlane(azurestackhciClusterSpec())
+	g.Expect(err).NotTo(HaveOccurred())
+
+	objs := parseObjects(t, content)
+	g.Expect(objs).To(HaveKey("Cluster/test"))
+	g.Expect(objs).To(HaveKey("KubeadmControlPlane/test"))
+
+	azurestackhciCluster := objs["AzureStackHCICluster/test"]
+	g.Expect(azurestackhciCluster).NotTo(BeNil())
+	host, _, _ := unstructured.NestedString(azurestackhciCluster.Object, "spec", "controlPlaneEndpoint", "host")
+	g.Expect(host).To(Equal("10.0.0.5"))
+	resourceGroup, _, _ := unstructured.NestedString(azurestackhciCluster.Object, "spec", "resourceGroup")
+	g.Expect(resourceGroup).To(Equal("test"))
+	vnet, _, _ := unstructured.NestedString(azurestackhciCluster.Object, "spec", "networkSpec", "vnet", "name")
+	g.Expect(vnet).To(Equal("hci-vnet"))
+
+	var machineTemplate *unstructured.Unstructured
+	for key, obj := range objs {
+		if strings.HasPrefix(key, "AzureStackHCIMachineTemplate/") {
+			machineTemplate = obj
+		}
+	}
+	g.Expect(machineTemplate).NotTo(BeNil())
+	vmSize, _, _ := unstructured.NestedString(machineTemplate.Object, "spec", "template", "spec", "vmSize")
+	g.Expect(vmSize).To(Equal("Standard_A4_v2"))
+	image, _, _ := unstructured.NestedString(machineTemplate.Object, "spec", "template", "spec", "image", "name")
+	g.Expect(image).To(Equal("ubuntu-2204-kube-v1-29"))
+	storageContainer, _, _ := unstructured.NestedString(machineTemplate.Object, "spec", "template", "spec", "storageContainer")
+	g.Expect(storageContainer).To(Equal("hci-storage"))
+}
+
+func TestTemplateBuilderGenerateCAPISpecControlPlaneResourceGroup(t *testing.T) {
+	g := NewWithT(t)
+	spec := azurestackhciClusterSpec()
+	spec.AzureStackHCIDatacenter.Spec.ResourceGroup = "eksa-rg"
+
+	content, err := NewTemplateBuilder(time.Now).GenerateCAPISpecControlPlane(spec)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	azurestackhciCluster := parseObjects(t, content)["AzureStackHCICluster/test"]
+	g.Expect(azurestackhciCluster).NotTo(BeNil())
+	resourceGroup, _, _ := unstructured.NestedString(azurestackhciCluster.Object, "spec", "resourceGroup")
+	g.Expect(resourceGroup).To(Equal("eksa-rg"))
+}
+
+func TestTemplateBuilderGenerateCAPISpecControlPlaneMissingMachineConfig(t *testing.T) {
+	g := NewWithT(t)
+	spec := azurestackhciClusterSpec()
+	delete(spec.AzureStackHCIMachineConfigs, "test-cp")
+
+	_, err := NewTemplateBuilder(time.Now).GenerateCAPISpecControlPlane(spec)
+	g.Expect(err).To(HaveOccurred())
+}
+
+func TestTemplateBuilderCAPIWorkersSpecWithInitialNames(t *testing.T) {
+	g := NewWithT(t)
+
+	content, err := NewTemplateBuilder(time.Now).CAPIWorkersSpecWithInitialNames(azurestackhciClusterSpec())
+	g.Expect(err).NotTo(HaveOccurred())
+
+	objs := parseObjects(t, content)
+	md := objs["MachineDeployment/test-md-0"]
+	g.Expect(md).NotTo(BeNil())
+	replicas, _, _ := unstructured.NestedInt64(md.Object, "spec", "replicas")
+	g.Expect(replicas).To(Equal(int64(2)))
+
+	machineTemplate := objs["AzureStackHCIMachineTemplate/test-md-0-1"]
+	g.Expect(machineTemplate).NotTo(BeNil())
+	vmSize, _, _ := unstructured.NestedString(machineTemplate.Object, "spec", "template", "spec", "vmSize")
+	g.Expect(vmSize).To(Equal("Standard_D4s_v3"))
+	location, _, _ := unstructured.NestedString(machineTemplate.Object, "spec", "template", "spec", "location")
+	g.Expect(location).To(Equal("hci-location"))
+	g.Expect(objs).To(HaveKey("KubeadmConfigTemplate/test-md-0-1"))
+}
+
+func TestTemplateBuilderGenerateCAPISpecControlPlaneMultipleUsers(t *testing.T) {
+	g := NewWithT(t)
+	spec := azurestackhciClusterSpec()
+	spec.AzureStackHCIMachineConfigs["test-cp"].Spec.Users = []anywherev1.UserConfiguration{
+		{Name: "eksa", SshAuthorizedKeys: []string{"ssh-rsa AAAA", "ssh-rsa BBBB"}},
+		{Name: "ops", SshAuthorizedKeys: []string{"ssh-rsa CCCC"}, Sudo: "ALL=(ALL) ALL"},
+	}
+
+	content, err := NewTemplateBuilder(time.Now).GenerateCAPISpecControlPlane(spec)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	kcp := parseObjects(t, content)["KubeadmControlPlane/test"]
+	g.Expect(kcp).NotTo(BeNil())
+	users, _, _ := unstructured.NestedSlice(kcp.Object, "spec", "kubeadmConfigSpec", "users")
+	g.Expect(users).To(HaveLen(2))
+	g.Expect(users[0]).To(HaveKeyWithValue("sshAuthorizedKeys", []interface{}{"ssh-rsa AAAA", "ssh-rsa BBBB"}))
+	g.Expect(users[0]).To(HaveKeyWithValue("sudo", anywherev1.DefaultUserSudoPolicy))
+	g.Expect(users[1]).To(HaveKeyWithValue("name", "ops"))
+	g.Expect(users[1]).To(HaveKeyWithValue("sudo", "ALL=(ALL) ALL"))
+}
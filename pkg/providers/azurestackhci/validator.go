@@ -0,0 +1,59 @@
+package azurestackhci
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/cluster"
+)
+
+// IPValidator is an interface that defines methods to validate the control plane IP.
+type IPValidator interface {
+	ValidateControlPlaneIPUniqueness(cluster *anywherev1.Cluster) error
+}
+
+// Validator validates a cluster spec against an Azure Stack HCI environment.
+type Validator struct {
+	client Client
+}
+
+// NewValidator returns a new Validator.
+func NewValidator(client Client) *Validator {
+	return &Validator{
+		client: client,
+	}
+}
+
+// ValidateClusterSpec validates the cluster spec and checks the Azure Stack HCI cloud agent is reachable.
+func (v *Validator) ValidateClusterSpec(ctx context.Context, spec *cluster.Spec) error {
+	if err := ValidateClusterConfig(spec); err != nil {
+		return err
+	}
+
+	if err := v.client.CheckCloudAgent(ctx, spec.AzureStackHCIDatacenter.Spec.CloudAgentFQDN); err != nil {
+		return fmt.Errorf("connecting to azure stack hci cloud agent %s: %v", spec.AzureStackHCIDatacenter.Spec.CloudAgentFQDN, err)
+	}
+
+	return nil
+}
+
+// ValidateClusterConfig runs the validations that don't require access to the Azure Stack HCI cloud agent.
+func ValidateClusterConfig(spec *cluster.Spec) error {
+	if spec.Cluster.Spec.ExternalEtcdConfiguration != nil {
+		return errors.New("external etcd is not supported for azurestackhci clusters")
+	}
+
+	if spec.Cluster.Spec.ControlPlaneConfiguration.Endpoint == nil || spec.Cluster.Spec.ControlPlaneConfiguration.Endpoint.Host == "" {
+		return errors.New("cluster controlPlaneConfiguration.Endpoint.Host is not set or is empty")
+	}
+
+	endpoint := net.ParseIP(spec.Cluster.Spec.ControlPlaneConfiguration.Endpoint.Host)
+	if endpoint == nil || endpoint.To4() == nil {
+		return fmt.Errorf("cluster controlPlaneConfiguration.Endpoint.Host %s is not a valid IPv4 address", spec.Cluster.Spec.ControlPlaneConfiguration.Endpoint.Host)
+	}
+
+	return nil
+}
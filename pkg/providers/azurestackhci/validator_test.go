@@ -0,0 +1,104 @@
+package azurestackhci
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+)
+
+type fakeClient struct {
+	fqdn string
+	err  error
+}
+
+func (c *fakeClient) CheckCloudAgent(_ context.Context, fqdn string) error {
+	c.fqdn = fqdn
+	return c.err
+}
+
+func TestValidatorValidateClusterSpecSuccess(t *testing.T) {
+	g := NewWithT(t)
+	client := &fakeClient{}
+
+	g.Expect(NewValidator(client).ValidateClusterSpec(context.Background(), azurestackhciClusterSpec())).To(Succeed())
+	g.Expect(client.fqdn).To(Equal("cloudagent.example.com"))
+}
+
+func TestValidatorValidateClusterSpecCloudAgentUnreachable(t *testing.T) {
+	g := NewWithT(t)
+	client := &fakeClient{err: errors.New("connection refused")}
+
+	err := NewValidator(client).ValidateClusterSpec(context.Background(), azurestackhciClusterSpec())
+	g.Expect(err).To(MatchError("connecting to azure stack hci cloud agent cloudagent.example.com: connection refused"))
+}
+
+func TestValidatorValidateClusterSpecInvalidConfig(t *testing.T) {
+	g := NewWithT(t)
+	client := &fakeClient{}
+	spec := azurestackhciClusterSpec()
+	spec.Cluster.Spec.ExternalEtcdConfiguration = &anywherev1.ExternalEtcdConfiguration{Count: 3}
+
+	err := NewValidator(client).ValidateClusterSpec(context.Background(), spec)
+	g.Expect(err).To(MatchError("external etcd is not supported for azurestackhci clusters"))
+	g.Expect(client.fqdn).To(BeEmpty())
+}
+
+func TestValidateClusterConfig(t *testing.T) {
+	tests := []struct {
+		name    string
+		update  func(*anywherev1.Cluster)
+		wantErr string
+	}{
+		{
+			name:   "valid",
+			update: func(*anywherev1.Cluster) {},
+		},
+		{
+			name: "external etcd",
+			update: func(c *anywherev1.Cluster) {
+				c.Spec.ExternalEtcdConfiguration = &anywherev1.ExternalEtcdConfiguration{Count: 3}
+			},
+			wantErr: "external etcd is not supported for azurestackhci clusters",
+		},
+		{
+			name: "endpoint not set",
+			update: func(c *anywherev1.Cluster) {
+				c.Spec.ControlPlaneConfiguration.Endpoint = nil
+			},
+			wantErr: "cluster controlPlaneConfiguration.Endpoint.Host is not set or is empty",
+		},
+		{
+			name: "endpoint not an ip",
+			update: func(c *anywherev1.Cluster) {
+				c.Spec.ControlPlaneConfiguration.Endpoint.Host = "cp.example.com"
+			},
+			wantErr: "cluster controlPlaneConfiguration.Endpoint.Host cp.example.com is not a valid IPv4 address",
+		},
+		{
+			name: "endpoint ipv6",
+			update: func(c *anywherev1.Cluster) {
+				c.Spec.ControlPlaneConfiguration.Endpoint.Host = "fd00::5"
+			},
+			wantErr: "cluster controlPlaneConfiguration.Endpoint.Host fd00::5 is not a valid IPv4 address",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			spec := azurestackhciClusterSpec()
+			tt.update(spec.Cluster)
+
+			err := ValidateClusterConfig(spec)
+			if tt.wantErr == "" {
+				g.Expect(err).NotTo(HaveOccurred())
+			} else {
+				g.Expect(err).To(MatchError(tt.wantErr))
+			}
+		})
+	}
+}
@@ -0,0 +1,73 @@
+package azurestackhci
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/aws/eks-anywhere/pkg/clients/kubernetes"
+	"github.com/aws/eks-anywhere/pkg/cluster"
+	"github.com/aws/eks-anywhere/pkg/clusterapi"
+	capiyaml "github.com/aws/eks-anywhere/pkg/clusterapi/yaml"
+	"github.com/aws/eks-anywhere/pkg/yamlutil"
+)
+
+type (
+	// Workers represents the azurestackhci specific CAPI spec for worker nodes.
+	Workers        = clusterapi.Workers[*unstructured.Unstructured]
+	workersBuilder = capiyaml.WorkersBuilder[*unstructured.Unstructured]
+)
+
+// WorkersSpec generates an AzureStackHCI specific CAPI spec for an eks-a cluster worker nodes.
+// It talks to the cluster with a client to detect changes in immutable objects and generates new
+// names for them.
+func WorkersSpec(ctx context.Context, logger logr.Logger, client kubernetes.Client, spec *cluster.Spec) (*Workers, error) {
+	workers, err := workersSpecWithInitialNames(logger, spec)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = workers.UpdateImmutableObjectNames(ctx, client, GetMachineTemplate, MachineTemplateEqual); err != nil {
+		return nil, errors.Wrap(err, "updating azurestackhci worker immutable object names")
+	}
+
+	return workers, nil
+}
+
+func workersSpecWithInitialNames(logger logr.Logger, spec *cluster.Spec) (*Workers, error) {
+	templateBuilder := NewTemplateBuilder(time.Now)
+	workersYaml, err := templateBuilder.CAPIWorkersSpecWithInitialNames(spec)
+	if err != nil {
+		return nil, err
+	}
+
+	parser, builder, err := newWorkersParserAndBuilder(logger)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = parser.Parse(workersYaml, builder); err != nil {
+		return nil, errors.Wrap(err, "parsing azurestackhci CAPI workers yaml")
+	}
+
+	return builder.Workers, nil
+}
+
+func newWorkersParserAndBuilder(logger logr.Logger) (*yamlutil.Parser, *workersBuilder, error) {
+	parser, builder, err := capiyaml.NewWorkersParserAndBuilder(
+		logger,
+		machineTemplateMapping(),
+	)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "building azurestackhci workers parser and builder")
+	}
+
+	return parser, builder, nil
+}
+
+func machineTemplateMapping() yamlutil.Mapping[*unstructured.Unstructured] {
+	return yamlutil.NewMapping(azurestackhciMachineTemplateKind, newAzureStackHCIMachineTemplate)
+}
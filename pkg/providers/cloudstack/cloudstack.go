@@ -493,6 +493,7 @@ func hasSameAvailabilityZones(old, nw []v1alpha1.CloudStackAvailabilityZone) boo
 			old.Name == nw.Name &&
 			old.CredentialsRef == nw.CredentialsRef &&
 			old.Account == nw.Account &&
+			old.Project == nw.Project &&
 			old.Domain == nw.Domain
 	}
 
@@ -56,6 +56,20 @@ func (mr *MockProviderCmkClientMockRecorder) GetManagementApiEndpoint(arg0 inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetManagementApiEndpoint", reflect.TypeOf((*MockProviderCmkClient)(nil).GetManagementApiEndpoint), arg0)
 }
 
+// TagVirtualMachines mocks base method.
+func (m *MockProviderCmkClient) TagVirtualMachines(arg0 context.Context, arg1, arg2 string, arg3 map[string]string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TagVirtualMachines", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TagVirtualMachines indicates an expected call of TagVirtualMachines.
+func (mr *MockProviderCmkClientMockRecorder) TagVirtualMachines(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TagVirtualMachines", reflect.TypeOf((*MockProviderCmkClient)(nil).TagVirtualMachines), arg0, arg1, arg2, arg3)
+}
+
 // ValidateAccountPresent mocks base method.
 func (m *MockProviderCmkClient) ValidateAccountPresent(arg0 context.Context, arg1, arg2, arg3 string) error {
 	m.ctrl.T.Helper()
@@ -127,6 +141,20 @@ func (mr *MockProviderCmkClientMockRecorder) ValidateNetworkPresent(arg0, arg1,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateNetworkPresent", reflect.TypeOf((*MockProviderCmkClient)(nil).ValidateNetworkPresent), arg0, arg1, arg2, arg3, arg4, arg5)
 }
 
+// ValidateProjectMembership mocks base method.
+func (m *MockProviderCmkClient) ValidateProjectMembership(arg0 context.Context, arg1, arg2, arg3, arg4 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateProjectMembership", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ValidateProjectMembership indicates an expected call of ValidateProjectMembership.
+func (mr *MockProviderCmkClientMockRecorder) ValidateProjectMembership(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateProjectMembership", reflect.TypeOf((*MockProviderCmkClient)(nil).ValidateProjectMembership), arg0, arg1, arg2, arg3, arg4)
+}
+
 // ValidateServiceOfferingPresent mocks base method.
 func (m *MockProviderCmkClient) ValidateServiceOfferingPresent(arg0 context.Context, arg1, arg2 string, arg3 v1alpha1.CloudStackResourceIdentifier) error {
 	m.ctrl.T.Helper()
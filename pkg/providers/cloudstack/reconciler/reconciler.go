@@ -69,6 +69,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, log logr.Logger, cluster *an
 		r.CheckControlPlaneReady,
 		r.ReconcileCNI,
 		r.ReconcileWorkers,
+		r.ReconcileResourceTags,
 	).Run(ctx, log, clusterSpec)
 }
 
@@ -155,6 +156,32 @@ func (r *Reconciler) ReconcileWorkers(ctx context.Context, log logr.Logger, clus
 	return clusters.ReconcileWorkersForEKSA(ctx, log, r.client, clusterSpec.Cluster, clusters.ToWorkers(w))
 }
 
+// ReconcileResourceTags adds the datacenter resource tags to all the virtual machines of the cluster.
+// It runs after the workers are reconciled so machines created during an upgrade are tagged as well.
+func (r *Reconciler) ReconcileResourceTags(ctx context.Context, log logr.Logger, spec *c.Spec) (controller.Result, error) {
+	datacenterConfig := spec.CloudStackDatacenter
+	if len(datacenterConfig.Spec.ResourceTags) == 0 {
+		return controller.Result{}, nil
+	}
+
+	log = log.WithValues("phase", "reconcileResourceTags")
+	log.Info("Tagging virtual machines")
+
+	execConfig, err := cloudstack.GetCloudstackExecConfig(ctx, r.client, datacenterConfig)
+	if err != nil {
+		return controller.Result{}, err
+	}
+	validator, err := r.validatorRegistry.Get(execConfig)
+	if err != nil {
+		return controller.Result{}, err
+	}
+	if err = validator.ReconcileResourceTags(ctx, datacenterConfig, spec.Cluster.Name); err != nil {
+		return controller.Result{}, errors.Wrap(err, "reconciling resource tags")
+	}
+
+	return controller.Result{}, nil
+}
+
 // ReconcileCNI reconciles the CNI to the desired state.
 func (r *Reconciler) ReconcileCNI(ctx context.Context, log logr.Logger, clusterSpec *c.Spec) (controller.Result, error) {
 	log = log.WithValues("phase", "reconcileCNI")
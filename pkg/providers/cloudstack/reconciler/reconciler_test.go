@@ -432,6 +432,58 @@ func TestReconcilerReconcileWorkersFailure(t *testing.T) {
 	tt.Expect(err).To(MatchError(ContainSubstring("Generate worker node CAPI spec")))
 }
 
+func TestReconcilerReconcileResourceTagsNoTags(t *testing.T) {
+	tt := newReconcilerTest(t)
+	tt.createAllObjs()
+
+	spec := tt.buildSpec()
+	logger := test.NewNullLogger()
+
+	result, err := tt.reconciler().ReconcileResourceTags(tt.ctx, logger, spec)
+
+	tt.Expect(err).NotTo(HaveOccurred())
+	tt.Expect(result).To(Equal(controller.Result{}))
+}
+
+func TestReconcilerReconcileResourceTagsSuccess(t *testing.T) {
+	tt := newReconcilerTest(t)
+	tt.datacenterConfig.Spec.ResourceTags = map[string]string{"env": "prod"}
+	tt.eksaSupportObjs = append(tt.eksaSupportObjs, tt.secret)
+	tt.createAllObjs()
+
+	spec := tt.buildSpec()
+	logger := test.NewNullLogger()
+
+	ctrl := gomock.NewController(t)
+	validator := cloudstack.NewMockProviderValidator(ctrl)
+	tt.validatorRegistry.EXPECT().Get(tt.execConfig).Return(validator, nil)
+	validator.EXPECT().ReconcileResourceTags(tt.ctx, spec.CloudStackDatacenter, tt.cluster.Name).Return(nil)
+
+	result, err := tt.reconciler().ReconcileResourceTags(tt.ctx, logger, spec)
+
+	tt.Expect(err).NotTo(HaveOccurred())
+	tt.Expect(result).To(Equal(controller.Result{}))
+}
+
+func TestReconcilerReconcileResourceTagsFailure(t *testing.T) {
+	tt := newReconcilerTest(t)
+	tt.datacenterConfig.Spec.ResourceTags = map[string]string{"env": "prod"}
+	tt.eksaSupportObjs = append(tt.eksaSupportObjs, tt.secret)
+	tt.createAllObjs()
+
+	spec := tt.buildSpec()
+	logger := test.NewNullLogger()
+
+	ctrl := gomock.NewController(t)
+	validator := cloudstack.NewMockProviderValidator(ctrl)
+	tt.validatorRegistry.EXPECT().Get(tt.execConfig).Return(validator, nil)
+	validator.EXPECT().ReconcileResourceTags(tt.ctx, spec.CloudStackDatacenter, tt.cluster.Name).Return(errors.New("cmk failed"))
+
+	_, err := tt.reconciler().ReconcileResourceTags(tt.ctx, logger, spec)
+
+	tt.Expect(err).To(MatchError(ContainSubstring("reconciling resource tags: cmk failed")))
+}
+
 func (tt *reconcilerTest) withFakeClient() {
 	tt.client = fake.NewClientBuilder().WithObjects(clientutil.ObjectsToClientObjects(tt.allObjs())...).Build()
 }
@@ -5,6 +5,7 @@ import (
 	"net"
 	"strings"
 
+	bootstrapv1beta2 "sigs.k8s.io/cluster-api/api/bootstrap/kubeadm/v1beta2"
 	"sigs.k8s.io/yaml"
 
 	"github.com/aws/eks-anywhere/pkg/api/v1alpha1"
@@ -126,19 +127,22 @@ func buildTemplateMapCP(clusterSpec *cluster.Spec) (map[string]interface{}, erro
 		Append(sharedExtraArgs)
 	clusterapi.SetPodIAMAuthExtraArgs(clusterSpec.Cluster.Spec.PodIAMConfig, apiServerExtraArgs)
 	controllerManagerExtraArgs := clusterapi.SecureTlsCipherSuitesExtraArgs().
-		Append(clusterapi.NodeCIDRMaskExtraArgs(&clusterSpec.Cluster.Spec.ClusterNetwork))
+		Append(clusterapi.NodeCIDRMaskExtraArgs(&clusterSpec.Cluster.Spec.ClusterNetwork)).
+		Append(clusterapi.ControllerManagerExtraArgs(clusterSpec.Cluster.Spec.ControlPlaneConfiguration.ControllerManagerExtraArgs))
+	schedulerExtraArgs := clusterapi.SecureTlsCipherSuitesExtraArgs().
+		Append(clusterapi.SchedulerExtraArgs(clusterSpec.Cluster.Spec.ControlPlaneConfiguration.SchedulerExtraArgs))
 
 	controlPlaneMachineSpec := controlPlaneMachineConfig(clusterSpec).Spec
-	controlPlaneSSHKey, err := common.StripSshAuthorizedKeyComment(controlPlaneMachineSpec.Users[0].SshAuthorizedKeys[0])
+	controlPlaneUsers, err := common.BootstrapUsersWithoutKeyComments(controlPlaneMachineSpec.Users)
 	if err != nil {
 		return nil, fmt.Errorf("formatting ssh key for cloudstack control plane template: %v", err)
 	}
 
 	var etcdMachineSpec v1alpha1.CloudStackMachineConfigSpec
-	var etcdSSHAuthorizedKey string
+	var etcdUsers []bootstrapv1beta2.User
 	if clusterSpec.Cluster.Spec.ExternalEtcdConfiguration != nil {
 		etcdMachineSpec = etcdMachineConfig(clusterSpec).Spec
-		etcdSSHAuthorizedKey, err = common.StripSshAuthorizedKeyComment(etcdMachineSpec.Users[0].SshAuthorizedKeys[0])
+		etcdUsers, err = common.BootstrapUsersWithoutKeyComments(etcdMachineSpec.Users)
 		if err != nil {
 			return nil, fmt.Errorf("formatting ssh key for cloudstack etcd template: %v", err)
 		}
@@ -148,6 +152,7 @@ func buildTemplateMapCP(clusterSpec *cluster.Spec) (map[string]interface{}, erro
 		"clusterName":                                clusterSpec.Cluster.Name,
 		"controlPlaneEndpointHost":                   host,
 		"controlPlaneEndpointPort":                   port,
+		"controlPlaneEndpointVIPCIDR":                common.KubeVipCIDR(host),
 		"controlPlaneReplicas":                       clusterSpec.Cluster.Spec.ControlPlaneConfiguration.Count,
 		"apiServerCertSANs":                          clusterSpec.Cluster.Spec.ControlPlaneConfiguration.CertSANs,
 		"kubernetesRepository":                       versionsBundle.KubeDistro.Kubernetes.Repository,
@@ -176,16 +181,15 @@ func buildTemplateMapCP(clusterSpec *cluster.Spec) (map[string]interface{}, erro
 		"cloudstackEtcdSymlinks":                     etcdMachineSpec.Symlinks,
 		"cloudstackEtcdAffinity":                     etcdMachineSpec.Affinity,
 		"cloudstackEtcdAffinityGroupIds":             etcdMachineSpec.AffinityGroupIds,
-		"controlPlaneSshUsername":                    controlPlaneMachineSpec.Users[0].Name,
-		"cloudstackControlPlaneSshAuthorizedKey":     controlPlaneSSHKey,
-		"cloudstackEtcdSshAuthorizedKey":             etcdSSHAuthorizedKey,
+		"controlPlaneUsers":                          controlPlaneUsers,
 		"podCidrs":                                   clusterSpec.Cluster.Spec.ClusterNetwork.Pods.CidrBlocks,
 		"serviceCidrs":                               clusterSpec.Cluster.Spec.ClusterNetwork.Services.CidrBlocks,
+		"skipKubeProxy":                              clusterSpec.Cluster.Spec.ClusterNetwork.CNIConfig.KubeProxyReplacementEnabled(),
 		"apiserverExtraArgs":                         apiServerExtraArgs,
 		"etcdExtraArgs":                              etcdExtraArgs,
 		"etcdCipherSuites":                           crypto.SecureCipherSuitesString(),
 		"controllermanagerExtraArgs":                 controllerManagerExtraArgs,
-		"schedulerExtraArgs":                         sharedExtraArgs,
+		"schedulerExtraArgs":                         schedulerExtraArgs,
 		"format":                                     format,
 		"externalEtcdVersion":                        versionsBundle.KubeDistro.EtcdVersion,
 		"externalEtcdReleaseUrl":                     versionsBundle.KubeDistro.EtcdURL,
@@ -202,6 +206,7 @@ func buildTemplateMapCP(clusterSpec *cluster.Spec) (map[string]interface{}, erro
 		}
 		values["auditPolicy"] = auditPolicy
 	}
+	values["auditLog"] = common.GetAuditLogSettings(clusterSpec.Cluster)
 
 	if clusterSpec.Cluster.Spec.ControlPlaneConfiguration.SkipAdmissionForSystemResources != nil &&
 		*clusterSpec.Cluster.Spec.ControlPlaneConfiguration.SkipAdmissionForSystemResources {
@@ -212,6 +217,14 @@ func buildTemplateMapCP(clusterSpec *cluster.Spec) (map[string]interface{}, erro
 		values["admissionExclusionPolicy"] = admissionExclusionPolicy
 	}
 
+	podSecurityAdmissionConfig, err := common.GetPodSecurityAdmissionConfig(clusterSpec.Cluster)
+	if err != nil {
+		return nil, err
+	}
+	if podSecurityAdmissionConfig != "" {
+		values["podSecurityAdmissionConfig"] = podSecurityAdmissionConfig
+	}
+
 	fillDiskOffering(values, controlPlaneMachineSpec.DiskOffering, "ControlPlane")
 	fillDiskOffering(values, etcdMachineSpec.DiskOffering, "Etcd")
 
@@ -238,7 +251,7 @@ func buildTemplateMapCP(clusterSpec *cluster.Spec) (map[string]interface{}, erro
 		values["externalEtcd"] = true
 		values["externalEtcdReplicas"] = clusterSpec.Cluster.Spec.ExternalEtcdConfiguration.Count
 		values["placeholderExternalEtcdEndpoint"] = constants.PlaceholderExternalEtcdEndpoint
-		values["etcdSshUsername"] = etcdMachineSpec.Users[0].Name
+		values["etcdUsers"] = etcdUsers
 		etcdURL, _ := common.GetExternalEtcdReleaseURL(clusterSpec.Cluster.Spec.EksaVersion, versionsBundle)
 		if etcdURL != "" {
 			values["externalEtcdReleaseUrl"] = etcdURL
@@ -263,6 +276,8 @@ func buildTemplateMapCP(clusterSpec *cluster.Spec) (map[string]interface{}, erro
 			return nil, err
 		}
 		values["encryptionProviderConfig"] = conf
+		values["kmsV1"] = common.KMSV1Enabled(clusterSpec.Cluster.Spec.EtcdEncryption)
+		values["kmsPluginSocketDirs"] = common.KMSPluginSocketDirs(clusterSpec.Cluster.Spec.EtcdEncryption)
 	}
 
 	if clusterSpec.Cluster.Spec.ControlPlaneConfiguration.KubeletConfiguration != nil {
@@ -374,31 +389,30 @@ func buildTemplateMapMD(clusterSpec *cluster.Spec, workerNodeGroupConfiguration
 	format := "cloud-config"
 
 	workerNodeGroupMachineSpec := workerMachineConfig(clusterSpec, workerNodeGroupConfiguration).Spec
-	workerUser := workerNodeGroupMachineSpec.Users[0]
-	workerSSHKey, err := common.StripSshAuthorizedKeyComment(workerUser.SshAuthorizedKeys[0])
+	workerUsers, err := common.BootstrapUsersWithoutKeyComments(workerNodeGroupMachineSpec.Users)
 	if err != nil {
 		return nil, fmt.Errorf("formatting ssh key for cloudstack worker template: %v", err)
 	}
 
 	values := map[string]interface{}{
-		"clusterName":                      clusterSpec.Cluster.Name,
-		"kubernetesVersion":                versionsBundle.KubeDistro.Kubernetes.Tag,
-		"cloudstackAnnotationSuffix":       constants.CloudstackAnnotationSuffix,
-		"cloudstackTemplateId":             workerNodeGroupMachineSpec.Template.Id,
-		"cloudstackTemplateName":           workerNodeGroupMachineSpec.Template.Name,
-		"cloudstackOfferingId":             workerNodeGroupMachineSpec.ComputeOffering.Id,
-		"cloudstackOfferingName":           workerNodeGroupMachineSpec.ComputeOffering.Name,
-		"cloudstackCustomDetails":          workerNodeGroupMachineSpec.UserCustomDetails,
-		"cloudstackSymlinks":               workerNodeGroupMachineSpec.Symlinks,
-		"cloudstackAffinity":               workerNodeGroupMachineSpec.Affinity,
-		"cloudstackAffinityGroupIds":       workerNodeGroupMachineSpec.AffinityGroupIds,
-		"workerReplicas":                   *workerNodeGroupConfiguration.Count,
-		"workerSshUsername":                workerNodeGroupMachineSpec.Users[0].Name,
-		"cloudstackWorkerSshAuthorizedKey": workerSSHKey,
-		"format":                           format,
-		"eksaSystemNamespace":              constants.EksaSystemNamespace,
-		"workerNodeGroupName":              fmt.Sprintf("%s-%s", clusterSpec.Cluster.Name, workerNodeGroupConfiguration.Name),
-		"workerNodeGroupTaints":            workerNodeGroupConfiguration.Taints,
+		"clusterName":                clusterSpec.Cluster.Name,
+		"kubernetesVersion":          versionsBundle.KubeDistro.Kubernetes.Tag,
+		"cloudstackAnnotationSuffix": constants.CloudstackAnnotationSuffix,
+		"cloudstackTemplateId":       workerNodeGroupMachineSpec.Template.Id,
+		"cloudstackTemplateName":     workerNodeGroupMachineSpec.Template.Name,
+		"cloudstackOfferingId":       workerNodeGroupMachineSpec.ComputeOffering.Id,
+		"cloudstackOfferingName":     workerNodeGroupMachineSpec.ComputeOffering.Name,
+		"cloudstackCustomDetails":    workerNodeGroupMachineSpec.UserCustomDetails,
+		"cloudstackSymlinks":         workerNodeGroupMachineSpec.Symlinks,
+		"cloudstackAffinity":         workerNodeGroupMachineSpec.Affinity,
+		"cloudstackAffinityGroupIds": workerNodeGroupMachineSpec.AffinityGroupIds,
+		"workerReplicas":             *workerNodeGroupConfiguration.Count,
+		"workerUsers":                workerUsers,
+		"format":                     format,
+		"eksaSystemNamespace":        constants.EksaSystemNamespace,
+		"workerNodeGroupName":        fmt.Sprintf("%s-%s", clusterSpec.Cluster.Name, workerNodeGroupConfiguration.Name),
+		"workerNodeGroupTaints":      workerNodeGroupConfiguration.Taints,
+		"machineDeployments":         workerMachineDeployments(clusterSpec, workerNodeGroupConfiguration),
 	}
 	fillDiskOffering(values, workerNodeGroupMachineSpec.DiskOffering, "")
 	values["cloudstackAnnotations"] = values["cloudstackDiskOfferingProvided"].(bool) || len(workerNodeGroupMachineSpec.Symlinks) > 0
@@ -447,10 +461,50 @@ func buildTemplateMapMD(clusterSpec *cluster.Spec, workerNodeGroupConfiguration
 		values["kubeletExtraArgs"] = kubeletExtraArgs
 	}
 
-	nodeLabelArgs := clusterapi.WorkerNodeLabelsExtraArgs(workerNodeGroupConfiguration)
+	// All the MachineDeployments of a worker node group share the same bootstrap config,
+	// so nodes can only be labeled with their zone when the group has a single failure domain.
+	var zone string
+	if len(workerNodeGroupConfiguration.FailureDomains) == 1 {
+		zone = workerNodeGroupConfiguration.FailureDomains[0]
+	}
+	nodeLabelArgs := clusterapi.WorkerNodeTopologyLabelsExtraArgs(workerNodeGroupConfiguration, zone)
 	if len(nodeLabelArgs) != 0 {
 		values["nodeLabelArgs"] = nodeLabelArgs
 	}
 
 	return values, nil
 }
+
+// workerMachineDeployment holds the values that differ between the MachineDeployments
+// generated for a worker node group.
+type workerMachineDeployment struct {
+	Name          string
+	FailureDomain string
+	Replicas      int
+}
+
+// workerMachineDeployments returns the MachineDeployments for a worker node group, one per failure domain.
+// The node count is spread evenly between them, the first failure domains taking the remainder.
+func workerMachineDeployments(clusterSpec *cluster.Spec, workerNodeGroupConfiguration v1alpha1.WorkerNodeGroupConfiguration) []workerMachineDeployment {
+	names := clusterapi.MachineDeploymentNames(clusterSpec.Cluster, workerNodeGroupConfiguration)
+	count := *workerNodeGroupConfiguration.Count
+	failureDomains := workerNodeGroupConfiguration.FailureDomains
+	if len(failureDomains) == 0 {
+		return []workerMachineDeployment{{Name: names[0], Replicas: count}}
+	}
+
+	mds := make([]workerMachineDeployment, 0, len(failureDomains))
+	for i, fd := range failureDomains {
+		replicas := count / len(failureDomains)
+		if i < count%len(failureDomains) {
+			replicas++
+		}
+		mds = append(mds, workerMachineDeployment{
+			Name:          names[min(i, len(names)-1)],
+			FailureDomain: fd,
+			Replicas:      replicas,
+		})
+	}
+
+	return mds
+}
@@ -3,17 +3,22 @@ package cloudstack_test
 import (
 	"path"
 	"regexp"
+	"strings"
 	"testing"
 	"time"
 
 	. "github.com/onsi/gomega"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	bootstrapv1beta2 "sigs.k8s.io/cluster-api/api/bootstrap/kubeadm/v1beta2"
+	clusterv1beta2 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/yaml"
 
 	"github.com/aws/eks-anywhere/internal/test"
 	"github.com/aws/eks-anywhere/pkg/api/v1alpha1"
 	"github.com/aws/eks-anywhere/pkg/clusterapi"
 	"github.com/aws/eks-anywhere/pkg/providers/cloudstack"
 	"github.com/aws/eks-anywhere/pkg/providers/common"
+	"github.com/aws/eks-anywhere/pkg/utils/ptr"
 )
 
 const (
@@ -145,6 +150,118 @@ func TestTemplateBuilderGenerateCAPISpecWorkersInvalidEndpoint(t *testing.T) {
 	g.Expect(err).To(MatchError(ContainSubstring("building template map for MD host 1.1.1.1:: is invalid: address 1.1.1.1::: too many colons in address")))
 }
 
+func TestTemplateBuilderGenerateCAPISpecWorkersFailureDomains(t *testing.T) {
+	tests := []struct {
+		name           string
+		failureDomains []string
+		want           map[string]workerMachineDeployment
+	}{
+		{
+			name: "no failure domains",
+			want: map[string]workerMachineDeployment{
+				"test-md-0": {replicas: 3},
+			},
+		},
+		{
+			name:           "one failure domain",
+			failureDomains: []string{"az-1"},
+			want: map[string]workerMachineDeployment{
+				"test-md-0": {failureDomain: "az-1", replicas: 3},
+			},
+		},
+		{
+			name:           "multiple failure domains",
+			failureDomains: []string{"az-1", "az-2"},
+			want: map[string]workerMachineDeployment{
+				"test-md-0-az-1": {failureDomain: "az-1", replicas: 2},
+				"test-md-0-az-2": {failureDomain: "az-2", replicas: 1},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			templateBuilder := cloudstack.NewTemplateBuilder(time.Now)
+			clusterSpec := test.NewFullClusterSpec(t, path.Join(testDataDir, testClusterConfigMainFilename))
+			clusterSpec.Cluster.Spec.WorkerNodeGroupConfigurations[0].Count = ptr.Int(3)
+			clusterSpec.Cluster.Spec.WorkerNodeGroupConfigurations[0].FailureDomains = tt.failureDomains
+			machineTemplateNames, kubeadmConfigTemplateNames := clusterapi.InitialTemplateNamesForWorkers(clusterSpec)
+
+			content, err := templateBuilder.GenerateCAPISpecWorkers(clusterSpec, machineTemplateNames, kubeadmConfigTemplateNames)
+			g.Expect(err).NotTo(HaveOccurred())
+
+			got := map[string]workerMachineDeployment{}
+			for _, doc := range strings.Split(string(content), "\n---\n") {
+				md := &clusterv1beta2.MachineDeployment{}
+				g.Expect(yaml.Unmarshal([]byte(doc), md)).To(Succeed())
+				if md.Kind != "MachineDeployment" {
+					continue
+				}
+				got[md.Name] = workerMachineDeployment{
+					failureDomain: md.Spec.Template.Spec.FailureDomain,
+					replicas:      *md.Spec.Replicas,
+				}
+				g.Expect(md.Spec.Template.Spec.InfrastructureRef.Name).To(Equal(machineTemplateNames["md-0"]))
+				g.Expect(md.Spec.Template.Spec.Bootstrap.ConfigRef.Name).To(Equal(kubeadmConfigTemplateNames["md-0"]))
+			}
+			g.Expect(got).To(Equal(tt.want))
+		})
+	}
+}
+
+func TestTemplateBuilderGenerateCAPISpecWorkersFailureDomainZoneLabel(t *testing.T) {
+	tests := []struct {
+		name           string
+		failureDomains []string
+		want           string
+	}{
+		{
+			name: "no failure domains",
+		},
+		{
+			name:           "one failure domain",
+			failureDomains: []string{"az-1"},
+			want:           "topology.kubernetes.io/zone=az-1",
+		},
+		{
+			name:           "multiple failure domains",
+			failureDomains: []string{"az-1", "az-2"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			templateBuilder := cloudstack.NewTemplateBuilder(time.Now)
+			clusterSpec := test.NewFullClusterSpec(t, path.Join(testDataDir, testClusterConfigMainFilename))
+			clusterSpec.Cluster.Spec.WorkerNodeGroupConfigurations[0].FailureDomains = tt.failureDomains
+			machineTemplateNames, kubeadmConfigTemplateNames := clusterapi.InitialTemplateNamesForWorkers(clusterSpec)
+
+			content, err := templateBuilder.GenerateCAPISpecWorkers(clusterSpec, machineTemplateNames, kubeadmConfigTemplateNames)
+			g.Expect(err).NotTo(HaveOccurred())
+
+			for _, doc := range strings.Split(string(content), "\n---\n") {
+				kct := &bootstrapv1beta2.KubeadmConfigTemplate{}
+				g.Expect(yaml.Unmarshal([]byte(doc), kct)).To(Succeed())
+				if kct.Kind != "KubeadmConfigTemplate" {
+					continue
+				}
+				var got string
+				for _, arg := range kct.Spec.Template.Spec.JoinConfiguration.NodeRegistration.KubeletExtraArgs {
+					if arg.Name == "node-labels" {
+						got = *arg.Value
+					}
+				}
+				g.Expect(got).To(Equal(tt.want))
+			}
+		})
+	}
+}
+
+type workerMachineDeployment struct {
+	failureDomain string
+	replicas      int32
+}
+
 func TestTemplateBuilder_CertSANs(t *testing.T) {
 	for _, tc := range []struct {
 		Input  string
@@ -173,6 +290,20 @@ func TestTemplateBuilder_CertSANs(t *testing.T) {
 	}
 }
 
+func TestTemplateBuilderGenerateCAPISpecControlPlaneWithProject(t *testing.T) {
+	g := NewWithT(t)
+	clusterSpec := test.NewFullClusterSpec(t, "testdata/cluster_api_server_cert_san_ip.yaml")
+	clusterSpec.CloudStackDatacenter.Spec.AvailabilityZones[0].Project = "project1"
+
+	bldr := cloudstack.NewTemplateBuilder(time.Now)
+
+	data, err := bldr.GenerateCAPISpecControlPlane(clusterSpec, func(values map[string]interface{}) {
+		values["controlPlaneTemplateName"] = clusterapi.ControlPlaneMachineTemplateName(clusterSpec.Cluster)
+	})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(string(data)).To(ContainSubstring("    account: admin\n    project: project1\n    acsEndpoint:"))
+}
+
 func TestVsphereTemplateBuilderGenerateCAPISpecControlPlaneValidKubeletConfigWN(t *testing.T) {
 	g := NewWithT(t)
 	spec := test.NewFullClusterSpec(t, path.Join(testDataDir, testClusterConfigMainFilename))
@@ -49,6 +49,8 @@ type ProviderCmkClient interface {
 	ValidateNetworkPresent(ctx context.Context, profile string, domainId string, network anywherev1.CloudStackResourceIdentifier, zoneId string, account string) error
 	ValidateDomainAndGetId(ctx context.Context, profile string, domain string) (string, error)
 	ValidateAccountPresent(ctx context.Context, profile string, account string, domainId string) error
+	ValidateProjectMembership(ctx context.Context, profile string, project string, account string, domainId string) error
+	TagVirtualMachines(ctx context.Context, profile string, clusterName string, tags map[string]string) error
 }
 
 func (v *Validator) ValidateCloudStackDatacenterConfig(ctx context.Context, datacenterConfig *anywherev1.CloudStackDatacenterConfig) error {
@@ -77,6 +79,12 @@ func (v *Validator) ValidateCloudStackDatacenterConfig(ctx context.Context, data
 			return err
 		}
 
+		if az.Project != "" {
+			if err := v.cmk.ValidateProjectMembership(ctx, az.CredentialsRef, az.Project, az.Account, az.DomainId); err != nil {
+				return err
+			}
+		}
+
 		zoneId, err := v.cmk.ValidateZoneAndGetId(ctx, az.CredentialsRef, az.CloudStackAvailabilityZone.Zone)
 		if err != nil {
 			return err
@@ -140,6 +148,10 @@ func (v *Validator) ValidateClusterMachineConfigs(ctx context.Context, clusterSp
 			return fmt.Errorf("cannot find CloudStackMachineConfig %v for worker nodes", workerNodeGroupConfiguration.MachineGroupRef.Name)
 		}
 
+		if err := validateWorkerNodeGroupFailureDomains(clusterSpec.CloudStackDatacenter, workerNodeGroupConfiguration); err != nil {
+			return fmt.Errorf("worker node group %s validation failed: %v", workerNodeGroupConfiguration.Name, err)
+		}
+
 		version := string(clusterSpec.Cluster.Spec.KubernetesVersion)
 		// validate template field of worker group spec with the kubernetes version of each workerNodeGroup - in case of modular upgrade.
 		if workerNodeGroupConfiguration.KubernetesVersion != nil {
@@ -210,6 +222,32 @@ func (v *Validator) validateMachineConfig(ctx context.Context, datacenterConfig
 	return nil
 }
 
+// validateWorkerNodeGroupFailureDomains checks the failure domains of a worker node group
+// reference availability zones of the datacenter and their spread policy is supported.
+func validateWorkerNodeGroupFailureDomains(datacenterConfig *anywherev1.CloudStackDatacenterConfig, workerNodeGroupConfiguration anywherev1.WorkerNodeGroupConfiguration) error {
+	if policy := workerNodeGroupConfiguration.FailureDomainSpreadPolicy; policy != "" && policy != anywherev1.FailureDomainSpreadPolicyEven {
+		return fmt.Errorf("unsupported failureDomainSpreadPolicy %s, only %s is supported", policy, anywherev1.FailureDomainSpreadPolicyEven)
+	}
+
+	availabilityZones := make(map[string]struct{}, len(datacenterConfig.Spec.AvailabilityZones))
+	for _, az := range datacenterConfig.Spec.AvailabilityZones {
+		availabilityZones[az.Name] = struct{}{}
+	}
+
+	seen := make(map[string]struct{}, len(workerNodeGroupConfiguration.FailureDomains))
+	for _, fd := range workerNodeGroupConfiguration.FailureDomains {
+		if _, ok := availabilityZones[fd]; !ok {
+			return fmt.Errorf("failure domain %s is not an availability zone of CloudStackDatacenterConfig %s", fd, datacenterConfig.Name)
+		}
+		if _, ok := seen[fd]; ok {
+			return fmt.Errorf("failure domain %s is duplicated", fd)
+		}
+		seen[fd] = struct{}{}
+	}
+
+	return nil
+}
+
 func (v *Validator) validateTemplateMatchesKubernetesVersion(ctx context.Context, templateName string, kubernetesVersionName string) error {
 	// Replace 1.23, 1-23, 1_23 to 123 in the template name string.
 	templateReplacer := strings.NewReplacer("-", "", ".", "", "_", "")
@@ -250,3 +288,26 @@ func secretDifferentFromProfile(secret *corev1.Secret, profile decoder.CloudStac
 		string(secret.Data[decoder.SecretKeyKey]) != profile.SecretKey ||
 		string(secret.Data[decoder.VerifySslKey]) != profile.VerifySsl
 }
+
+// ReconcileResourceTags adds the datacenter resource tags to the virtual machines of a cluster in every availability zone.
+// CAPC doesn't support tagging the instances it creates, so the tags are reconciled directly with the CloudStack API
+// using the same client the validator uses for the exec config.
+func (v *Validator) ReconcileResourceTags(ctx context.Context, datacenterConfig *anywherev1.CloudStackDatacenterConfig, clusterName string) error {
+	if len(datacenterConfig.Spec.ResourceTags) == 0 {
+		return nil
+	}
+
+	profiles := map[string]struct{}{}
+	for _, az := range datacenterConfig.Spec.AvailabilityZones {
+		if _, ok := profiles[az.CredentialsRef]; ok {
+			continue
+		}
+		profiles[az.CredentialsRef] = struct{}{}
+
+		if err := v.cmk.TagVirtualMachines(ctx, az.CredentialsRef, clusterName, datacenterConfig.Spec.ResourceTags); err != nil {
+			return fmt.Errorf("tagging virtual machines in availability zone %s: %v", az.Name, err)
+		}
+	}
+
+	return nil
+}
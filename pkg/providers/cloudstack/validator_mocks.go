@@ -38,6 +38,20 @@ func (m *MockProviderValidator) EXPECT() *MockProviderValidatorMockRecorder {
 	return m.recorder
 }
 
+// ReconcileResourceTags mocks base method.
+func (m *MockProviderValidator) ReconcileResourceTags(arg0 context.Context, arg1 *v1alpha1.CloudStackDatacenterConfig, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReconcileResourceTags", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReconcileResourceTags indicates an expected call of ReconcileResourceTags.
+func (mr *MockProviderValidatorMockRecorder) ReconcileResourceTags(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReconcileResourceTags", reflect.TypeOf((*MockProviderValidator)(nil).ReconcileResourceTags), arg0, arg1, arg2)
+}
+
 // ValidateCloudStackDatacenterConfig mocks base method.
 func (m *MockProviderValidator) ValidateCloudStackDatacenterConfig(arg0 context.Context, arg1 *v1alpha1.CloudStackDatacenterConfig) error {
 	m.ctrl.T.Helper()
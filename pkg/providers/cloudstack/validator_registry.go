@@ -35,6 +35,7 @@ type ProviderValidator interface {
 	ValidateClusterMachineConfigs(ctx context.Context, clusterSpec *cluster.Spec) error
 	ValidateControlPlaneEndpointUniqueness(endpoint string) error
 	ValidateSecretsUnchanged(ctx context.Context, cluster *types.Cluster, execConfig *decoder.CloudStackExecConfig, client ProviderKubectlClient) error
+	ReconcileResourceTags(ctx context.Context, datacenterConfig *anywherev1.CloudStackDatacenterConfig, clusterName string) error
 }
 
 // NewValidatorFactory initializes a factory for the CloudStack provider validator.
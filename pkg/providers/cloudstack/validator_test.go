@@ -93,6 +93,51 @@ func TestValidateCloudStackDatacenterConfigWithAZ(t *testing.T) {
 	}
 }
 
+func TestValidateCloudStackDatacenterConfigWithProject(t *testing.T) {
+	ctx := context.Background()
+	setupContext(t)
+	cmk := mocks.NewMockProviderCmkClient(gomock.NewController(t))
+	validator := NewValidator(cmk, &DummyNetClient{}, true)
+
+	datacenterConfig, err := v1alpha1.GetCloudStackDatacenterConfig(path.Join(testDataDir, testClusterConfigMainWithAZsFilename))
+	if err != nil {
+		t.Fatalf("unable to get datacenter config from file")
+	}
+	for i := range datacenterConfig.Spec.AvailabilityZones {
+		datacenterConfig.Spec.AvailabilityZones[i].Project = "project1"
+	}
+
+	setupMockForAvailabilityZonesValidation(cmk, ctx, datacenterConfig.Spec.AvailabilityZones)
+	for _, az := range datacenterConfig.Spec.AvailabilityZones {
+		cmk.EXPECT().ValidateProjectMembership(ctx, az.CredentialsRef, "project1", az.Account, gomock.Any()).Return(nil)
+	}
+
+	err = validator.ValidateCloudStackDatacenterConfig(ctx, datacenterConfig)
+	if err != nil {
+		t.Fatalf("failed to validate CloudStackDataCenterConfig: %v", err)
+	}
+}
+
+func TestValidateCloudStackDatacenterConfigWithProjectNotMember(t *testing.T) {
+	ctx := context.Background()
+	setupContext(t)
+	cmk := mocks.NewMockProviderCmkClient(gomock.NewController(t))
+	validator := NewValidator(cmk, &DummyNetClient{}, true)
+
+	datacenterConfig, err := v1alpha1.GetCloudStackDatacenterConfig(path.Join(testDataDir, testClusterConfigMainWithAZsFilename))
+	if err != nil {
+		t.Fatalf("unable to get datacenter config from file")
+	}
+	datacenterConfig.Spec.AvailabilityZones[0].Project = "project1"
+
+	setupMockForAvailabilityZonesValidation(cmk, ctx, datacenterConfig.Spec.AvailabilityZones)
+	cmk.EXPECT().ValidateProjectMembership(ctx, gomock.Any(), "project1", gomock.Any(), gomock.Any()).
+		Return(errors.New("project project1 not found or account is not a member of it"))
+
+	err = validator.ValidateCloudStackDatacenterConfig(ctx, datacenterConfig)
+	thenErrorExpected(t, "project project1 not found or account is not a member of it", err)
+}
+
 func TestValidateSkipControlPlaneIpCheck(t *testing.T) {
 	cmk := mocks.NewMockProviderCmkClient(gomock.NewController(t))
 	validator := NewValidator(cmk, &DummyNetClient{}, true)
@@ -363,6 +408,45 @@ func TestValidateClusterMachineConfigsModularUpgradeError(t *testing.T) {
 	}
 }
 
+func TestValidateClusterMachineConfigsFailureDomainsError(t *testing.T) {
+	tests := []struct {
+		name           string
+		failureDomains []string
+		spreadPolicy   v1alpha1.FailureDomainSpreadPolicy
+		wantErr        string
+	}{
+		{
+			name:           "unknown availability zone",
+			failureDomains: []string{"missing-az"},
+			wantErr:        "failure domain missing-az is not an availability zone",
+		},
+		{
+			name:           "duplicated failure domain",
+			failureDomains: []string{"default-az-0", "default-az-0"},
+			wantErr:        "failure domain default-az-0 is duplicated",
+		},
+		{
+			name:         "unsupported spread policy",
+			spreadPolicy: "Packed",
+			wantErr:      "unsupported failureDomainSpreadPolicy Packed",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			cmk := mocks.NewMockProviderCmkClient(gomock.NewController(t))
+			clusterSpec := test.NewFullClusterSpec(t, path.Join(testDataDir, testClusterConfigMainFilename))
+			clusterSpec.Cluster.Spec.WorkerNodeGroupConfigurations[0].FailureDomains = tt.failureDomains
+			clusterSpec.Cluster.Spec.WorkerNodeGroupConfigurations[0].FailureDomainSpreadPolicy = tt.spreadPolicy
+
+			validator := NewValidator(cmk, &DummyNetClient{}, true)
+
+			err := validator.ValidateClusterMachineConfigs(ctx, clusterSpec)
+			assert.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
+
 func TestValidateClusterMachineConfigsSuccess(t *testing.T) {
 	ctx := context.Background()
 	cmk := mocks.NewMockProviderCmkClient(gomock.NewController(t))
@@ -518,6 +602,63 @@ func TestValidateSecretsUnchangedFailureSecretNotFound(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func TestReconcileResourceTagsNoTags(t *testing.T) {
+	ctx := context.Background()
+	mockCtrl := gomock.NewController(t)
+	cmk := mocks.NewMockProviderCmkClient(mockCtrl)
+	validator := NewValidator(cmk, &DummyNetClient{}, true)
+
+	datacenterConfig := &v1alpha1.CloudStackDatacenterConfig{
+		Spec: v1alpha1.CloudStackDatacenterConfigSpec{
+			AvailabilityZones: []v1alpha1.CloudStackAvailabilityZone{{Name: "az-1", CredentialsRef: "global"}},
+		},
+	}
+
+	assert.Nil(t, validator.ReconcileResourceTags(ctx, datacenterConfig, "test"))
+}
+
+func TestReconcileResourceTagsSuccess(t *testing.T) {
+	ctx := context.Background()
+	mockCtrl := gomock.NewController(t)
+	cmk := mocks.NewMockProviderCmkClient(mockCtrl)
+	validator := NewValidator(cmk, &DummyNetClient{}, true)
+
+	tags := map[string]string{"env": "prod"}
+	datacenterConfig := &v1alpha1.CloudStackDatacenterConfig{
+		Spec: v1alpha1.CloudStackDatacenterConfigSpec{
+			AvailabilityZones: []v1alpha1.CloudStackAvailabilityZone{
+				{Name: "az-1", CredentialsRef: "global"},
+				{Name: "az-2", CredentialsRef: "global"},
+				{Name: "az-3", CredentialsRef: "other"},
+			},
+			ResourceTags: tags,
+		},
+	}
+
+	cmk.EXPECT().TagVirtualMachines(ctx, "global", "test", tags).Return(nil)
+	cmk.EXPECT().TagVirtualMachines(ctx, "other", "test", tags).Return(nil)
+	assert.Nil(t, validator.ReconcileResourceTags(ctx, datacenterConfig, "test"))
+}
+
+func TestReconcileResourceTagsFailure(t *testing.T) {
+	ctx := context.Background()
+	mockCtrl := gomock.NewController(t)
+	cmk := mocks.NewMockProviderCmkClient(mockCtrl)
+	validator := NewValidator(cmk, &DummyNetClient{}, true)
+
+	tags := map[string]string{"env": "prod"}
+	datacenterConfig := &v1alpha1.CloudStackDatacenterConfig{
+		Spec: v1alpha1.CloudStackDatacenterConfigSpec{
+			AvailabilityZones: []v1alpha1.CloudStackAvailabilityZone{{Name: "az-1", CredentialsRef: "global"}},
+			ResourceTags:      tags,
+		},
+	}
+
+	cmk.EXPECT().TagVirtualMachines(ctx, "global", "test", tags).Return(errors.New("cmk failed"))
+	err := validator.ReconcileResourceTags(ctx, datacenterConfig, "test")
+	thenErrorExpected(t, "tagging virtual machines in availability zone az-1: cmk failed", err)
+}
+
 var testProfiles = []decoder.CloudStackProfileConfig{
 	{
 		Name:          "global",
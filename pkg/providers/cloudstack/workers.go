@@ -46,6 +46,13 @@ func WorkersSpec(ctx context.Context, logger logr.Logger, client kubernetes.Clie
 		return nil, errors.Wrap(err, "updating cloudstack worker immutable object names")
 	}
 
+	// Worker node groups spread across failure domains have one MachineDeployment per failure domain,
+	// all sharing the same templates. Make sure all of them reference the final template names.
+	for _, g := range workers.Groups {
+		g.MachineDeployment.Spec.Template.Spec.InfrastructureRef.Name = g.ProviderMachineTemplate.GetName()
+		g.MachineDeployment.Spec.Template.Spec.Bootstrap.ConfigRef.Name = g.KubeadmConfigTemplate.Name
+	}
+
 	return workers, nil
 }
 
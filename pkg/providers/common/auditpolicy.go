@@ -313,3 +313,43 @@ func AuditPolicyV1() *auditv1.Policy {
 		},
 	}
 }
+
+// Default kube-apiserver audit log rotation settings.
+const (
+	defaultAuditLogMaxAge    = 30
+	defaultAuditLogMaxBackup = 10
+	defaultAuditLogMaxSize   = 512
+)
+
+// AuditLogSettings holds the kube-apiserver audit log rotation settings used in the templates.
+type AuditLogSettings struct {
+	MaxAge    int
+	MaxBackup int
+	MaxSize   int
+}
+
+// GetAuditLogSettings returns the audit log rotation settings for the cluster control plane,
+// using the defaults for the ones not set in the cluster spec.
+func GetAuditLogSettings(cluster *v1alpha1.Cluster) AuditLogSettings {
+	settings := AuditLogSettings{
+		MaxAge:    defaultAuditLogMaxAge,
+		MaxBackup: defaultAuditLogMaxBackup,
+		MaxSize:   defaultAuditLogMaxSize,
+	}
+
+	auditLog := cluster.Spec.ControlPlaneConfiguration.AuditLog
+	if auditLog == nil {
+		return settings
+	}
+	if auditLog.MaxAge != nil {
+		settings.MaxAge = *auditLog.MaxAge
+	}
+	if auditLog.MaxBackup != nil {
+		settings.MaxBackup = *auditLog.MaxBackup
+	}
+	if auditLog.MaxSize != nil {
+		settings.MaxSize = *auditLog.MaxSize
+	}
+
+	return settings
+}
@@ -0,0 +1,42 @@
+package common_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"k8s.io/utils/ptr"
+
+	"github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/providers/common"
+)
+
+func TestGetAuditLogSettingsDefaults(t *testing.T) {
+	g := NewWithT(t)
+	cluster := &v1alpha1.Cluster{}
+
+	g.Expect(common.GetAuditLogSettings(cluster)).To(Equal(common.AuditLogSettings{
+		MaxAge:    30,
+		MaxBackup: 10,
+		MaxSize:   512,
+	}))
+}
+
+func TestGetAuditLogSettingsFromClusterSpec(t *testing.T) {
+	g := NewWithT(t)
+	cluster := &v1alpha1.Cluster{
+		Spec: v1alpha1.ClusterSpec{
+			ControlPlaneConfiguration: v1alpha1.ControlPlaneConfiguration{
+				AuditLog: &v1alpha1.AuditLogConfiguration{
+					MaxAge:    ptr.To(7),
+					MaxBackup: ptr.To(0),
+				},
+			},
+		},
+	}
+
+	g.Expect(common.GetAuditLogSettings(cluster)).To(Equal(common.AuditLogSettings{
+		MaxAge:    7,
+		MaxBackup: 0,
+		MaxSize:   512,
+	}))
+}
@@ -57,6 +57,38 @@ func StripSshAuthorizedKeyComment(key string) (string, error) {
 	return strings.TrimSpace(string(ssh.MarshalAuthorizedKey(public))), nil
 }
 
+// BootstrapUsers converts the users of a machine config into the users rendered in the CAPI
+// bootstrap configs, defaulting their sudo policy.
+func BootstrapUsers(users []v1alpha1.UserConfiguration) []bootstrapv1beta2.User {
+	bootstrapUsers := make([]bootstrapv1beta2.User, 0, len(users))
+	for _, user := range users {
+		bootstrapUsers = append(bootstrapUsers, bootstrapv1beta2.User{
+			Name:              user.Name,
+			Sudo:              user.SudoPolicy(),
+			SSHAuthorizedKeys: append([]string{}, user.SshAuthorizedKeys...),
+		})
+	}
+
+	return bootstrapUsers
+}
+
+// BootstrapUsersWithoutKeyComments is like BootstrapUsers but also strips the comments from
+// all the ssh keys of the users.
+func BootstrapUsersWithoutKeyComments(users []v1alpha1.UserConfiguration) ([]bootstrapv1beta2.User, error) {
+	bootstrapUsers := BootstrapUsers(users)
+	for i, user := range bootstrapUsers {
+		for j, key := range user.SSHAuthorizedKeys {
+			stripped, err := StripSshAuthorizedKeyComment(key)
+			if err != nil {
+				return nil, err
+			}
+			bootstrapUsers[i].SSHAuthorizedKeys[j] = stripped
+		}
+	}
+
+	return bootstrapUsers, nil
+}
+
 func GenerateSSHAuthKey(writer filewriter.FileWriter) (string, error) {
 	privateKeyPath, sshAuthorizedKeyBytes, err := crypto.NewSshKeyPairUsingFileWriter(writer, privateKeyFileName, publicKeyFileName)
 	if err != nil {
@@ -141,6 +173,58 @@ func GetCAPIBottlerocketSettingsConfig(config *v1alpha1.HostOSConfiguration, brK
 	return getCAPIConfig(b)
 }
 
+// bottlerocketContainers holds the additional Bottlerocket containers of a kubeadm init or join configuration.
+type bottlerocketContainers struct {
+	BootstrapContainers []bootstrapv1beta2.BottlerocketBootstrapContainer `json:"bottlerocketCustomBootstrapContainers,omitempty"`
+	HostContainers      []bootstrapv1beta2.BottlerocketHostContainer      `json:"bottlerocketCustomHostContainers,omitempty"`
+}
+
+// GetCAPIBottlerocketContainersConfig returns the additional Bottlerocket bootstrap and host containers
+// of the host OS configuration, as kubeadm init and join configuration fields, or an empty string if there aren't any.
+func GetCAPIBottlerocketContainersConfig(config *v1alpha1.HostOSConfiguration) (string, error) {
+	if config == nil || config.BottlerocketConfiguration == nil {
+		return "", nil
+	}
+
+	containers := bottlerocketContainers{}
+	for _, c := range config.BottlerocketConfiguration.BootstrapContainers {
+		repository, tag := v1alpha1.SplitContainerImage(c.Image)
+		mode := c.Mode
+		if mode == "" {
+			mode = v1alpha1.BottlerocketBootstrapContainerModeAlways
+		}
+		containers.BootstrapContainers = append(containers.BootstrapContainers, bootstrapv1beta2.BottlerocketBootstrapContainer{
+			Name:            c.Name,
+			ImageRepository: repository,
+			ImageTag:        tag,
+			Essential:       c.Essential,
+			Mode:            string(mode),
+			UserData:        c.UserData,
+		})
+	}
+	for _, c := range config.BottlerocketConfiguration.HostContainers {
+		repository, tag := v1alpha1.SplitContainerImage(c.Image)
+		containers.HostContainers = append(containers.HostContainers, bootstrapv1beta2.BottlerocketHostContainer{
+			Name:            c.Name,
+			Superpowered:    c.Superpowered,
+			ImageRepository: repository,
+			ImageTag:        tag,
+			UserData:        c.UserData,
+		})
+	}
+
+	if len(containers.BootstrapContainers) == 0 && len(containers.HostContainers) == 0 {
+		return "", nil
+	}
+
+	marshaledConfig, err := yaml.Marshal(containers)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal bottlerocket containers: %v", err)
+	}
+
+	return strings.Trim(string(marshaledConfig), "\n"), nil
+}
+
 func getCAPIConfig(b *bootstrapv1beta2.BottlerocketSettings) (string, error) {
 	brMap := map[string]*bootstrapv1beta2.BottlerocketSettings{
 		"bottlerocket": b,
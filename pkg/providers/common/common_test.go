@@ -183,6 +183,67 @@ func TestGetCAPIBottlerocketSettingsConfig(t *testing.T) {
 	}
 }
 
+func TestGetCAPIBottlerocketContainersConfig(t *testing.T) {
+	tests := []struct {
+		name     string
+		config   *v1alpha1.HostOSConfiguration
+		expected string
+	}{
+		{
+			name:     "nil config",
+			expected: "",
+		},
+		{
+			name: "no containers",
+			config: &v1alpha1.HostOSConfiguration{
+				BottlerocketConfiguration: &v1alpha1.BottlerocketConfiguration{},
+			},
+			expected: "",
+		},
+		{
+			name: "bootstrap and host containers",
+			config: &v1alpha1.HostOSConfiguration{
+				BottlerocketConfiguration: &v1alpha1.BottlerocketConfiguration{
+					BootstrapContainers: []v1alpha1.BottlerocketBootstrapContainer{
+						{
+							Name:     "setup",
+							Image:    "public.ecr.aws/setup:v1",
+							UserData: "ZWNobyBoZWxsbw==",
+						},
+					},
+					HostContainers: []v1alpha1.BottlerocketHostContainer{
+						{
+							Name:         "agent",
+							Image:        "registry:5000/security/agent@sha256:abcd",
+							Superpowered: true,
+						},
+					},
+				},
+			},
+			expected: `bottlerocketCustomBootstrapContainers:
+- essential: false
+  imageRepository: public.ecr.aws/setup
+  imageTag: v1
+  mode: always
+  name: setup
+  userData: ZWNobyBoZWxsbw==
+bottlerocketCustomHostContainers:
+- imageRepository: registry:5000/security/agent@sha256
+  imageTag: abcd
+  name: agent
+  superpowered: true`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			got, err := common.GetCAPIBottlerocketContainersConfig(tt.config)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(got).To(Equal(tt.expected))
+		})
+	}
+}
+
 func TestGetExternalEtcdReleaseURL(t *testing.T) {
 	g := NewWithT(t)
 	testcases := []struct {
@@ -239,6 +300,46 @@ func TestGetExternalEtcdReleaseURLWithNilEksaVersion(t *testing.T) {
 	g.Expect(got).To(BeEmpty())
 }
 
+func TestBootstrapUsers(t *testing.T) {
+	g := NewWithT(t)
+	users := []v1alpha1.UserConfiguration{
+		{Name: "capv", SshAuthorizedKeys: []string{"ssh-rsa AAAA me@example.com", "ssh-rsa BBBB"}},
+		{Name: "ops", SshAuthorizedKeys: []string{"ssh-rsa CCCC"}, Sudo: "ALL=(ALL) ALL"},
+	}
+
+	g.Expect(common.BootstrapUsers(users)).To(Equal([]bootstrapv1beta2.User{
+		{Name: "capv", Sudo: v1alpha1.DefaultUserSudoPolicy, SSHAuthorizedKeys: []string{"ssh-rsa AAAA me@example.com", "ssh-rsa BBBB"}},
+		{Name: "ops", Sudo: "ALL=(ALL) ALL", SSHAuthorizedKeys: []string{"ssh-rsa CCCC"}},
+	}))
+}
+
+func TestBootstrapUsersWithoutKeyComments(t *testing.T) {
+	g := NewWithT(t)
+	key := "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIFQUhZ1nQEMnhhKnOaqrz3bRSfRvzW7XJyRmNDvDIr4J"
+	users := []v1alpha1.UserConfiguration{
+		{Name: "capv", SshAuthorizedKeys: []string{key + " me@example.com"}},
+		{Name: "ops", SshAuthorizedKeys: []string{key}},
+	}
+
+	got, err := common.BootstrapUsersWithoutKeyComments(users)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(got).To(Equal([]bootstrapv1beta2.User{
+		{Name: "capv", Sudo: v1alpha1.DefaultUserSudoPolicy, SSHAuthorizedKeys: []string{key}},
+		{Name: "ops", Sudo: v1alpha1.DefaultUserSudoPolicy, SSHAuthorizedKeys: []string{key}},
+	}))
+	g.Expect(users[0].SshAuthorizedKeys[0]).To(Equal(key + " me@example.com"))
+}
+
+func TestBootstrapUsersWithoutKeyCommentsInvalidKey(t *testing.T) {
+	g := NewWithT(t)
+	users := []v1alpha1.UserConfiguration{
+		{Name: "capv", SshAuthorizedKeys: []string{"ssh-rsa AAAA"}},
+	}
+
+	_, err := common.BootstrapUsersWithoutKeyComments(users)
+	g.Expect(err).To(MatchError(ContainSubstring("ssh: no key found")))
+}
+
 func TestValidateBottlerocketKC(t *testing.T) {
 	g := NewWithT(t)
 	tests := []struct {
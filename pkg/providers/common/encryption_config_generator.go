@@ -2,6 +2,9 @@ package common
 
 import (
 	"fmt"
+	"net/url"
+	"path"
+	"slices"
 	"strings"
 
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -13,8 +16,11 @@ import (
 
 const (
 	encryptionConfigurationKind  = "EncryptionConfiguration"
-	encryptionProviderVersion    = "v1"
 	encryptionProviderNamePrefix = "aws-encryption-provider"
+
+	// DefaultKMSPluginSocketDir is the directory of the KMS plugin sockets that is always
+	// mounted in kube-apiserver when etcd encryption is enabled.
+	DefaultKMSPluginSocketDir = "/var/run/kmsplugin/"
 )
 
 var identityProvider = apiserverv1.ProviderConfiguration{
@@ -37,18 +43,23 @@ func GenerateKMSEncryptionConfiguration(confs *[]v1alpha1.EtcdEncryption) (strin
 	for _, conf := range *confs {
 		providers := []apiserverv1.ProviderConfiguration{}
 		for _, provider := range conf.Providers {
-			provider := apiserverv1.ProviderConfiguration{
-				KMS: &apiserverv1.KMSConfiguration{
-					APIVersion: encryptionProviderVersion,
-					Name:       provider.KMS.Name,
-					Endpoint:   provider.KMS.SocketListenAddress,
-					CacheSize:  provider.KMS.CacheSize,
-					Timeout: &v1.Duration{
-						Duration: provider.KMS.Timeout.Duration,
-					},
-				},
+			kms := &apiserverv1.KMSConfiguration{
+				APIVersion: string(v1alpha1.KMSAPIVersionV1),
+				Name:       provider.KMS.Name,
+				Endpoint:   provider.KMS.SocketListenAddress,
+				CacheSize:  provider.KMS.CacheSize,
+			}
+			if provider.KMS.IsV2() {
+				// kube-apiserver doesn't cache DEKs with the v2 API, it rejects any cachesize.
+				kms.APIVersion = string(v1alpha1.KMSAPIVersionV2)
+				kms.CacheSize = nil
 			}
-			providers = append(providers, provider)
+			if provider.KMS.Timeout != nil {
+				kms.Timeout = &v1.Duration{
+					Duration: provider.KMS.Timeout.Duration,
+				}
+			}
+			providers = append(providers, apiserverv1.ProviderConfiguration{KMS: kms})
 		}
 		providers = append(providers, identityProvider)
 		resourceConfig := apiserverv1.ResourceConfiguration{
@@ -65,3 +76,49 @@ func GenerateKMSEncryptionConfiguration(confs *[]v1alpha1.EtcdEncryption) (strin
 	}
 	return strings.Trim(string(marshaledConf), "\n"), nil
 }
+
+// KMSPluginSocketDirs returns the directories of the KMS plugin sockets, other than
+// DefaultKMSPluginSocketDir, that need to be mounted in kube-apiserver.
+func KMSPluginSocketDirs(confs *[]v1alpha1.EtcdEncryption) []string {
+	if confs == nil {
+		return nil
+	}
+
+	var dirs []string
+	for _, conf := range *confs {
+		for _, provider := range conf.Providers {
+			if provider.KMS == nil {
+				continue
+			}
+			u, err := url.Parse(provider.KMS.SocketListenAddress)
+			if err != nil {
+				continue
+			}
+			dir := path.Dir(u.Path) + "/"
+			if dir == DefaultKMSPluginSocketDir || slices.Contains(dirs, dir) {
+				continue
+			}
+			dirs = append(dirs, dir)
+		}
+	}
+
+	return dirs
+}
+
+// KMSV1Enabled returns true if any of the KMS providers uses the v1 API, which
+// requires the KMSv1 feature gate since Kubernetes 1.29.
+func KMSV1Enabled(confs *[]v1alpha1.EtcdEncryption) bool {
+	if confs == nil {
+		return false
+	}
+
+	for _, conf := range *confs {
+		for _, provider := range conf.Providers {
+			if provider.KMS != nil && !provider.KMS.IsV2() {
+				return true
+			}
+		}
+	}
+
+	return false
+}
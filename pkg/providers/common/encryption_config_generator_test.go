@@ -98,3 +98,126 @@ func TestGenerateEncryptionConfiguration(t *testing.T) {
 	}
 	test.AssertContentToFile(t, conf, expectedEncryptionConfig)
 }
+
+func TestGenerateEncryptionConfigurationKMSV2(t *testing.T) {
+	encryptionConf := &[]v1alpha1.EtcdEncryption{
+		{
+			Providers: []v1alpha1.EtcdEncryptionProvider{
+				{
+					KMS: &v1alpha1.KMS{
+						APIVersion:          v1alpha1.KMSAPIVersionV2,
+						Name:                "vault",
+						SocketListenAddress: "unix:///var/run/vault/kms.sock",
+						Timeout:             &v1alpha1.DefaultKMSTimeout,
+					},
+				},
+				{
+					KMS: &v1alpha1.KMS{
+						Name:                "aws-encryption-provider",
+						SocketListenAddress: "unix:///var/run/kmsplugin/socket.sock",
+						CacheSize:           v1alpha1.DefaultKMSCacheSize,
+						Timeout:             &v1alpha1.DefaultKMSTimeout,
+					},
+				},
+			},
+			Resources: []string{"secrets"},
+		},
+	}
+
+	conf, err := GenerateKMSEncryptionConfiguration(encryptionConf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	test.AssertContentToFile(t, conf, "testdata/expected_encryption_config_kms_v2.yaml")
+}
+
+func TestKMSPluginSocketDirs(t *testing.T) {
+	tests := []struct {
+		name   string
+		config *[]v1alpha1.EtcdEncryption
+		want   []string
+	}{
+		{
+			name:   "nil config",
+			config: nil,
+			want:   nil,
+		},
+		{
+			name: "default socket dir",
+			config: &[]v1alpha1.EtcdEncryption{
+				{
+					Providers: []v1alpha1.EtcdEncryptionProvider{
+						{KMS: &v1alpha1.KMS{SocketListenAddress: "unix:///var/run/kmsplugin/socket.sock"}},
+					},
+				},
+			},
+			want: nil,
+		},
+		{
+			name: "other socket dirs",
+			config: &[]v1alpha1.EtcdEncryption{
+				{
+					Providers: []v1alpha1.EtcdEncryptionProvider{
+						{KMS: &v1alpha1.KMS{SocketListenAddress: "unix:///var/run/vault/kms.sock"}},
+						{KMS: &v1alpha1.KMS{SocketListenAddress: "unix:///var/run/kmsplugin/socket.sock"}},
+						{KMS: &v1alpha1.KMS{SocketListenAddress: "unix:///var/run/vault/kms-old.sock"}},
+						{KMS: &v1alpha1.KMS{SocketListenAddress: "unix:///run/kms/socket.sock"}},
+					},
+				},
+			},
+			want: []string{"/var/run/vault/", "/run/kms/"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(KMSPluginSocketDirs(tt.config)).To(Equal(tt.want))
+		})
+	}
+}
+
+func TestKMSV1Enabled(t *testing.T) {
+	tests := []struct {
+		name   string
+		config *[]v1alpha1.EtcdEncryption
+		want   bool
+	}{
+		{
+			name:   "nil config",
+			config: nil,
+			want:   false,
+		},
+		{
+			name: "default api version",
+			config: &[]v1alpha1.EtcdEncryption{
+				{Providers: []v1alpha1.EtcdEncryptionProvider{{KMS: &v1alpha1.KMS{}}}},
+			},
+			want: true,
+		},
+		{
+			name: "v2 only",
+			config: &[]v1alpha1.EtcdEncryption{
+				{Providers: []v1alpha1.EtcdEncryptionProvider{{KMS: &v1alpha1.KMS{APIVersion: v1alpha1.KMSAPIVersionV2}}}},
+			},
+			want: false,
+		},
+		{
+			name: "v2 and v1",
+			config: &[]v1alpha1.EtcdEncryption{
+				{Providers: []v1alpha1.EtcdEncryptionProvider{
+					{KMS: &v1alpha1.KMS{APIVersion: v1alpha1.KMSAPIVersionV2}},
+					{KMS: &v1alpha1.KMS{APIVersion: v1alpha1.KMSAPIVersionV1}},
+				}},
+			},
+			want: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(KMSV1Enabled(tt.config)).To(Equal(tt.want))
+		})
+	}
+}
@@ -0,0 +1,34 @@
+package common
+
+import (
+	"github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+)
+
+// NvidiaRuntimeHandler is the containerd runtime handler running containers with the NVIDIA
+// container runtime on the nodes of the worker node groups with GPU support.
+const NvidiaRuntimeHandler = "nvidia"
+
+// nvidiaContainerdConfigDropIn registers the nvidia runtime handler in containerd, backed by the
+// NVIDIA container runtime installed in the node image.
+var nvidiaContainerdConfigDropIn = v1alpha1.ContainerdConfigDropIn{
+	Name: "eksa-nvidia",
+	Content: `[plugins."io.containerd.grpc.v1.cri".containerd.runtimes.nvidia]
+  runtime_type = "io.containerd.runc.v2"
+  [plugins."io.containerd.grpc.v1.cri".containerd.runtimes.nvidia.options]
+    BinaryName = "/usr/bin/nvidia-container-runtime"`,
+}
+
+// WorkerContainerdConfigDropIns returns the containerd config drop-ins of the machines of a worker
+// node group: the ones in its host OS configuration, plus the nvidia runtime handler when the group
+// has GPU support.
+func WorkerContainerdConfigDropIns(hostOS *v1alpha1.HostOSConfiguration, worker v1alpha1.WorkerNodeGroupConfiguration) []v1alpha1.ContainerdConfigDropIn {
+	var dropIns []v1alpha1.ContainerdConfigDropIn
+	if hostOS != nil && hostOS.ContainerdConfiguration != nil {
+		dropIns = append(dropIns, hostOS.ContainerdConfiguration.ConfigDropIns...)
+	}
+	if worker.GPU != nil {
+		dropIns = append(dropIns, nvidiaContainerdConfigDropIn)
+	}
+
+	return dropIns
+}
@@ -0,0 +1,30 @@
+package common_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/providers/common"
+)
+
+func TestWorkerContainerdConfigDropIns(t *testing.T) {
+	g := NewWithT(t)
+	hostOS := &v1alpha1.HostOSConfiguration{
+		ContainerdConfiguration: &v1alpha1.ContainerdConfiguration{
+			ConfigDropIns: []v1alpha1.ContainerdConfigDropIn{{Name: "nri", Content: "[plugins.\"io.containerd.nri.v1.nri\"]"}},
+		},
+	}
+	gpuWorker := v1alpha1.WorkerNodeGroupConfiguration{Name: "md-gpu", GPU: &v1alpha1.GPUConfiguration{}}
+
+	g.Expect(common.WorkerContainerdConfigDropIns(nil, v1alpha1.WorkerNodeGroupConfiguration{})).To(BeEmpty())
+	g.Expect(common.WorkerContainerdConfigDropIns(hostOS, v1alpha1.WorkerNodeGroupConfiguration{})).To(Equal(hostOS.ContainerdConfiguration.ConfigDropIns))
+
+	dropIns := common.WorkerContainerdConfigDropIns(hostOS, gpuWorker)
+	g.Expect(dropIns).To(HaveLen(2))
+	g.Expect(dropIns[0].Name).To(Equal("nri"))
+	g.Expect(dropIns[1].Name).To(Equal("eksa-nvidia"))
+	g.Expect(dropIns[1].Content).To(ContainSubstring(`BinaryName = "/usr/bin/nvidia-container-runtime"`))
+	g.Expect(hostOS.ContainerdConfiguration.ConfigDropIns).To(HaveLen(1))
+}
@@ -0,0 +1,139 @@
+package common
+
+import (
+	_ "embed"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/templater"
+	releasev1 "github.com/aws/eks-anywhere/release/api/v1alpha1"
+)
+
+//go:embed config/konnectivity-egress-selector.yaml
+var konnectivityEgressSelectorConfig string
+
+//go:embed config/konnectivity-server.yaml
+var konnectivityServerManifest string
+
+//go:embed config/konnectivity-agent.yaml
+var konnectivityAgentManifest string
+
+const (
+	// KonnectivityEgressSelectorConfigPath is the path of the API server egress selector configuration
+	// routing the traffic to the nodes through the konnectivity server.
+	KonnectivityEgressSelectorConfigPath = "/etc/kubernetes/egress-selector-configuration.yaml"
+	// KonnectivityServerManifestPath is the path of the konnectivity server static pod manifest.
+	KonnectivityServerManifestPath = "/etc/kubernetes/manifests/konnectivity-server.yaml"
+	// KonnectivityAgentManifestPath is the path of the konnectivity agent static pod manifest.
+	KonnectivityAgentManifestPath = "/etc/kubernetes/manifests/konnectivity-agent.yaml"
+)
+
+// KonnectivityEgressSelectorConfig returns the API server egress selector configuration sending the
+// traffic to the nodes through the unix socket of the konnectivity server, or an empty string if the
+// cluster doesn't use konnectivity.
+func KonnectivityEgressSelectorConfig(cluster *v1alpha1.Cluster) string {
+	if cluster.Spec.ControlPlaneConfiguration.Konnectivity == nil {
+		return ""
+	}
+
+	return strings.TrimSpace(konnectivityEgressSelectorConfig)
+}
+
+// KonnectivityServerManifest returns the static pod manifest of the konnectivity server running on each
+// control plane node, or an empty string if the cluster doesn't use konnectivity. The servers authenticate
+// the agents with their kubelet client certificates and share the API server certificate with them.
+func KonnectivityServerManifest(cluster *v1alpha1.Cluster, bundle *releasev1.KonnectivityBundle, osFamily v1alpha1.OSFamily) (string, error) {
+	k := cluster.Spec.ControlPlaneConfiguration.Konnectivity
+	if k == nil {
+		return "", nil
+	}
+	if bundle == nil {
+		return "", fmt.Errorf("konnectivity is not available in the bundle")
+	}
+
+	values := map[string]interface{}{
+		"image":       bundle.Server.VersionedImage(),
+		"agentPort":   konnectivityAgentPort(k),
+		"adminPort":   v1alpha1.KonnectivityServerAdminPort,
+		"healthPort":  v1alpha1.KonnectivityServerHealthPort,
+		"serverCount": cluster.Spec.ControlPlaneConfiguration.Count,
+		"pkiDir":      konnectivityHostDir(osFamily, "pki"),
+		"udsDir":      konnectivityHostDir(osFamily, "konnectivity-server"),
+	}
+
+	return executeKonnectivityTemplate(konnectivityServerManifest, values)
+}
+
+// KonnectivityAgentManifest returns the static pod manifest of the konnectivity agent running on each node,
+// or an empty string if the cluster doesn't use konnectivity.
+func KonnectivityAgentManifest(cluster *v1alpha1.Cluster, bundle *releasev1.KonnectivityBundle, osFamily v1alpha1.OSFamily) (string, error) {
+	k := cluster.Spec.ControlPlaneConfiguration.Konnectivity
+	if k == nil {
+		return "", nil
+	}
+	if bundle == nil {
+		return "", fmt.Errorf("konnectivity is not available in the bundle")
+	}
+
+	values := map[string]interface{}{
+		"image":      bundle.Agent.VersionedImage(),
+		"serverHost": KonnectivityServerHost(cluster),
+		"agentPort":  konnectivityAgentPort(k),
+		"adminPort":  v1alpha1.KonnectivityAgentAdminPort,
+		"healthPort": v1alpha1.KonnectivityAgentHealthPort,
+		"pkiDir":     konnectivityHostDir(osFamily, "pki"),
+	}
+
+	return executeKonnectivityTemplate(konnectivityAgentManifest, values)
+}
+
+// KonnectivityServerHost returns the address the konnectivity agents of a cluster connect to.
+func KonnectivityServerHost(cluster *v1alpha1.Cluster) string {
+	k := cluster.Spec.ControlPlaneConfiguration.Konnectivity
+	if k != nil && k.ServerHost != "" {
+		return k.ServerHost
+	}
+	if cluster.Spec.ControlPlaneConfiguration.Endpoint == nil {
+		return ""
+	}
+
+	return cluster.Spec.ControlPlaneConfiguration.Endpoint.Host
+}
+
+// APIServerCertSANs returns the extra SANs of the API server certificate of a cluster. The konnectivity
+// servers present the API server certificate to the agents, so it must be valid for the konnectivity server host.
+func APIServerCertSANs(cluster *v1alpha1.Cluster) []string {
+	sans := cluster.Spec.ControlPlaneConfiguration.CertSANs
+	k := cluster.Spec.ControlPlaneConfiguration.Konnectivity
+	if k == nil || k.ServerHost == "" || slices.Contains(sans, k.ServerHost) {
+		return sans
+	}
+
+	return append(append([]string{}, sans...), k.ServerHost)
+}
+
+func konnectivityAgentPort(k *v1alpha1.KonnectivityConfiguration) int {
+	if k.AgentPort == 0 {
+		return v1alpha1.DefaultKonnectivityAgentPort
+	}
+	return k.AgentPort
+}
+
+// konnectivityHostDir returns the host path of a directory of /etc/kubernetes, which Bottlerocket keeps in /var/lib/kubeadm.
+func konnectivityHostDir(osFamily v1alpha1.OSFamily, dir string) string {
+	if osFamily == v1alpha1.Bottlerocket {
+		return "/var/lib/kubeadm/" + dir
+	}
+	return "/etc/kubernetes/" + dir
+}
+
+func executeKonnectivityTemplate(template string, values map[string]interface{}) (string, error) {
+	content, err := templater.Execute(template, values)
+	if err != nil {
+		return "", fmt.Errorf("generating konnectivity manifest: %v", err)
+	}
+
+	return strings.TrimSpace(string(content)), nil
+}
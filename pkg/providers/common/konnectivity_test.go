@@ -0,0 +1,139 @@
+package common_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/providers/common"
+	releasev1 "github.com/aws/eks-anywhere/release/api/v1alpha1"
+)
+
+func konnectivityCluster(k *v1alpha1.KonnectivityConfiguration) *v1alpha1.Cluster {
+	return &v1alpha1.Cluster{
+		Spec: v1alpha1.ClusterSpec{
+			ControlPlaneConfiguration: v1alpha1.ControlPlaneConfiguration{
+				Count:        3,
+				Endpoint:     &v1alpha1.Endpoint{Host: "10.0.0.10"},
+				CertSANs:     []string{"api.example.com"},
+				Konnectivity: k,
+			},
+		},
+	}
+}
+
+func konnectivityBundle() *releasev1.KonnectivityBundle {
+	return &releasev1.KonnectivityBundle{
+		Server: releasev1.Image{URI: "public.ecr.aws/eks-anywhere/konnectivity/server:v0.31.0"},
+		Agent:  releasev1.Image{URI: "public.ecr.aws/eks-anywhere/konnectivity/agent:v0.31.0"},
+	}
+}
+
+func TestKonnectivityNotConfigured(t *testing.T) {
+	g := NewWithT(t)
+	cluster := konnectivityCluster(nil)
+
+	g.Expect(common.KonnectivityEgressSelectorConfig(cluster)).To(BeEmpty())
+	g.Expect(common.KonnectivityServerManifest(cluster, nil, v1alpha1.Ubuntu)).To(BeEmpty())
+	g.Expect(common.KonnectivityAgentManifest(cluster, nil, v1alpha1.Ubuntu)).To(BeEmpty())
+	g.Expect(common.APIServerCertSANs(cluster)).To(Equal([]string{"api.example.com"}))
+}
+
+func TestKonnectivityMissingBundle(t *testing.T) {
+	g := NewWithT(t)
+	cluster := konnectivityCluster(&v1alpha1.KonnectivityConfiguration{})
+
+	_, err := common.KonnectivityServerManifest(cluster, nil, v1alpha1.Ubuntu)
+	g.Expect(err).To(MatchError(ContainSubstring("konnectivity is not available in the bundle")))
+	_, err = common.KonnectivityAgentManifest(cluster, nil, v1alpha1.Ubuntu)
+	g.Expect(err).To(MatchError(ContainSubstring("konnectivity is not available in the bundle")))
+}
+
+func TestKonnectivityEgressSelectorConfig(t *testing.T) {
+	g := NewWithT(t)
+	cluster := konnectivityCluster(&v1alpha1.KonnectivityConfiguration{})
+
+	g.Expect(common.KonnectivityEgressSelectorConfig(cluster)).To(Equal(`apiVersion: apiserver.k8s.io/v1beta1
+kind: EgressSelectorConfiguration
+egressSelections:
+- name: cluster
+  connection:
+    proxyProtocol: GRPC
+    transport:
+      uds:
+        udsName: /etc/kubernetes/konnectivity-server/konnectivity-server.socket`))
+}
+
+func TestKonnectivityServerManifest(t *testing.T) {
+	g := NewWithT(t)
+	cluster := konnectivityCluster(&v1alpha1.KonnectivityConfiguration{AgentPort: 9132})
+
+	manifest, err := common.KonnectivityServerManifest(cluster, konnectivityBundle(), v1alpha1.Ubuntu)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(manifest).To(ContainSubstring("image: public.ecr.aws/eks-anywhere/konnectivity/server:v0.31.0"))
+	g.Expect(manifest).To(ContainSubstring("- --agent-port=9132"))
+	g.Expect(manifest).To(ContainSubstring("- --server-count=3"))
+	g.Expect(manifest).To(ContainSubstring("path: /etc/kubernetes/pki\n"))
+	g.Expect(manifest).To(ContainSubstring("path: /etc/kubernetes/konnectivity-server\n"))
+}
+
+func TestKonnectivityServerManifestBottlerocket(t *testing.T) {
+	g := NewWithT(t)
+	cluster := konnectivityCluster(&v1alpha1.KonnectivityConfiguration{})
+
+	manifest, err := common.KonnectivityServerManifest(cluster, konnectivityBundle(), v1alpha1.Bottlerocket)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(manifest).To(ContainSubstring("- --agent-port=8132"))
+	g.Expect(manifest).To(ContainSubstring("path: /var/lib/kubeadm/pki\n"))
+	g.Expect(manifest).To(ContainSubstring("path: /var/lib/kubeadm/konnectivity-server\n"))
+}
+
+func TestKonnectivityAgentManifest(t *testing.T) {
+	tests := []struct {
+		name       string
+		konn       *v1alpha1.KonnectivityConfiguration
+		osFamily   v1alpha1.OSFamily
+		serverHost string
+		caPath     string
+	}{
+		{
+			name:       "default server host",
+			konn:       &v1alpha1.KonnectivityConfiguration{AgentPort: 8132},
+			osFamily:   v1alpha1.Ubuntu,
+			serverHost: "10.0.0.10",
+			caPath:     "/etc/kubernetes/pki/ca.crt",
+		},
+		{
+			name:       "server host bottlerocket",
+			konn:       &v1alpha1.KonnectivityConfiguration{ServerHost: "konnectivity.example.com", AgentPort: 8132},
+			osFamily:   v1alpha1.Bottlerocket,
+			serverHost: "konnectivity.example.com",
+			caPath:     "/var/lib/kubeadm/pki/ca.crt",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			cluster := konnectivityCluster(tt.konn)
+
+			manifest, err := common.KonnectivityAgentManifest(cluster, konnectivityBundle(), tt.osFamily)
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(manifest).To(ContainSubstring("image: public.ecr.aws/eks-anywhere/konnectivity/agent:v0.31.0"))
+			g.Expect(manifest).To(ContainSubstring("- --proxy-server-host=" + tt.serverHost + "\n"))
+			g.Expect(manifest).To(ContainSubstring("- --proxy-server-port=8132"))
+			g.Expect(manifest).To(ContainSubstring("path: " + tt.caPath + "\n"))
+		})
+	}
+}
+
+func TestAPIServerCertSANsKonnectivityServerHost(t *testing.T) {
+	g := NewWithT(t)
+	cluster := konnectivityCluster(&v1alpha1.KonnectivityConfiguration{ServerHost: "konnectivity.example.com"})
+
+	g.Expect(common.APIServerCertSANs(cluster)).To(Equal([]string{"api.example.com", "konnectivity.example.com"}))
+	g.Expect(cluster.Spec.ControlPlaneConfiguration.CertSANs).To(Equal([]string{"api.example.com"}))
+
+	cluster.Spec.ControlPlaneConfiguration.Konnectivity.ServerHost = "api.example.com"
+	g.Expect(common.APIServerCertSANs(cluster)).To(Equal([]string{"api.example.com"}))
+}
@@ -0,0 +1,18 @@
+package common
+
+import "net"
+
+const (
+	kubeVipIPv4CIDR = "32"
+	kubeVipIPv6CIDR = "128"
+)
+
+// KubeVipCIDR returns the prefix length kube-vip announces the control plane endpoint address with:
+// a single host route for the address family of the endpoint host.
+func KubeVipCIDR(host string) string {
+	if ip := net.ParseIP(host); ip != nil && ip.To4() == nil {
+		return kubeVipIPv6CIDR
+	}
+
+	return kubeVipIPv4CIDR
+}
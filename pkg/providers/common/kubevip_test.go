@@ -0,0 +1,44 @@
+package common_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/aws/eks-anywhere/pkg/providers/common"
+)
+
+func TestKubeVipCIDR(t *testing.T) {
+	tests := []struct {
+		name string
+		host string
+		want string
+	}{
+		{
+			name: "ipv4",
+			host: "1.2.3.4",
+			want: "32",
+		},
+		{
+			name: "ipv6",
+			host: "fd00::10",
+			want: "128",
+		},
+		{
+			name: "ipv4 mapped ipv6",
+			host: "::ffff:1.2.3.4",
+			want: "32",
+		},
+		{
+			name: "hostname",
+			host: "cp.example.com",
+			want: "32",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(common.KubeVipCIDR(tt.host)).To(Equal(tt.want))
+		})
+	}
+}
@@ -0,0 +1,53 @@
+package common
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	bootstrapv1beta2 "sigs.k8s.io/cluster-api/api/bootstrap/kubeadm/v1beta2"
+
+	"github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+)
+
+// GetNodeOSSysctlsConfig returns the content of a sysctl.d file with the sysctls of the cluster
+// node OS configuration, sorted by name, or an empty string if there are none.
+func GetNodeOSSysctlsConfig(nodeOS *v1alpha1.NodeOSConfiguration) string {
+	if nodeOS == nil || len(nodeOS.Sysctls) == 0 {
+		return ""
+	}
+
+	lines := make([]string, 0, len(nodeOS.Sysctls))
+	for _, name := range slices.Sorted(maps.Keys(nodeOS.Sysctls)) {
+		lines = append(lines, fmt.Sprintf("%s = %s", name, nodeOS.Sysctls[name]))
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// BottlerocketHostOSConfiguration returns the host OS configuration of a Bottlerocket machine with
+// the sysctls of the cluster node OS configuration added to its kernel settings. The sysctl
+// settings of the machine take precedence.
+func BottlerocketHostOSConfiguration(hostOS *v1alpha1.HostOSConfiguration, nodeOS *v1alpha1.NodeOSConfiguration) *v1alpha1.HostOSConfiguration {
+	if nodeOS == nil || len(nodeOS.Sysctls) == 0 {
+		return hostOS
+	}
+
+	config := &v1alpha1.HostOSConfiguration{}
+	if hostOS != nil {
+		config = hostOS.DeepCopy()
+	}
+	if config.BottlerocketConfiguration == nil {
+		config.BottlerocketConfiguration = &v1alpha1.BottlerocketConfiguration{}
+	}
+	if config.BottlerocketConfiguration.Kernel == nil {
+		config.BottlerocketConfiguration.Kernel = &bootstrapv1beta2.BottlerocketKernelSettings{}
+	}
+
+	sysctls := maps.Clone(nodeOS.Sysctls)
+	maps.Copy(sysctls, config.BottlerocketConfiguration.Kernel.SysctlSettings)
+	config.BottlerocketConfiguration.Kernel.SysctlSettings = sysctls
+
+	return config
+}
@@ -0,0 +1,58 @@
+package common_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	bootstrapv1beta2 "sigs.k8s.io/cluster-api/api/bootstrap/kubeadm/v1beta2"
+
+	"github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/providers/common"
+)
+
+func TestGetNodeOSSysctlsConfig(t *testing.T) {
+	g := NewWithT(t)
+	nodeOS := &v1alpha1.NodeOSConfiguration{
+		Sysctls: map[string]string{
+			"vm.max_map_count":             "262144",
+			"net.ipv4.ip_local_port_range": "1024 65535",
+		},
+	}
+
+	g.Expect(common.GetNodeOSSysctlsConfig(nodeOS)).To(Equal("net.ipv4.ip_local_port_range = 1024 65535\nvm.max_map_count = 262144"))
+	g.Expect(common.GetNodeOSSysctlsConfig(nil)).To(BeEmpty())
+	g.Expect(common.GetNodeOSSysctlsConfig(&v1alpha1.NodeOSConfiguration{KernelModules: []string{"overlay"}})).To(BeEmpty())
+}
+
+func TestBottlerocketHostOSConfiguration(t *testing.T) {
+	g := NewWithT(t)
+	hostOS := &v1alpha1.HostOSConfiguration{
+		BottlerocketConfiguration: &v1alpha1.BottlerocketConfiguration{
+			Kernel: &bootstrapv1beta2.BottlerocketKernelSettings{
+				SysctlSettings: map[string]string{"vm.max_map_count": "524288"},
+			},
+		},
+	}
+	nodeOS := &v1alpha1.NodeOSConfiguration{
+		Sysctls: map[string]string{
+			"vm.max_map_count":   "262144",
+			"net.core.somaxconn": "65535",
+		},
+	}
+
+	config := common.BottlerocketHostOSConfiguration(hostOS, nodeOS)
+	g.Expect(config.BottlerocketConfiguration.Kernel.SysctlSettings).To(Equal(map[string]string{
+		"vm.max_map_count":   "524288",
+		"net.core.somaxconn": "65535",
+	}))
+	g.Expect(hostOS.BottlerocketConfiguration.Kernel.SysctlSettings).To(HaveLen(1))
+}
+
+func TestBottlerocketHostOSConfigurationWithoutHostOS(t *testing.T) {
+	g := NewWithT(t)
+	nodeOS := &v1alpha1.NodeOSConfiguration{Sysctls: map[string]string{"net.core.somaxconn": "65535"}}
+
+	config := common.BottlerocketHostOSConfiguration(nil, nodeOS)
+	g.Expect(config.BottlerocketConfiguration.Kernel.SysctlSettings).To(Equal(nodeOS.Sysctls))
+	g.Expect(common.BottlerocketHostOSConfiguration(nil, nil)).To(BeNil())
+}
@@ -0,0 +1,129 @@
+package common
+
+import (
+	"slices"
+	"strings"
+
+	"sigs.k8s.io/yaml"
+
+	"github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/constants"
+)
+
+const podSecurityLevelVersion = "latest"
+
+// podSecurityExemptNamespaces are the namespaces of the EKS Anywhere components, which need
+// privileged pods and are always exempted from the PodSecurity admission plugin checks.
+var podSecurityExemptNamespaces = []string{
+	constants.KubeSystemNamespace,
+	constants.EksaSystemNamespace,
+	constants.EksaPackagesName,
+	constants.CertManagerNamespace,
+	constants.CapiSystemNamespace,
+	constants.CapiKubeadmBootstrapSystemNamespace,
+	constants.CapiKubeadmControlPlaneSystemNamespace,
+	constants.EtcdAdmBootstrapProviderSystemNamespace,
+	constants.EtcdAdmControllerSystemNamespace,
+	constants.CapdSystemNamespace,
+	constants.CapcSystemNamespace,
+	constants.CapvSystemNamespace,
+	v1alpha1.FluxDefaultNamespace,
+}
+
+type admissionConfiguration struct {
+	APIVersion string                         `json:"apiVersion"`
+	Kind       string                         `json:"kind"`
+	Plugins    []admissionPluginConfiguration `json:"plugins"`
+}
+
+type admissionPluginConfiguration struct {
+	Name          string                   `json:"name"`
+	Configuration podSecurityConfiguration `json:"configuration"`
+}
+
+type podSecurityConfiguration struct {
+	APIVersion string                `json:"apiVersion"`
+	Kind       string                `json:"kind"`
+	Defaults   podSecurityDefaults   `json:"defaults"`
+	Exemptions podSecurityExemptions `json:"exemptions"`
+}
+
+type podSecurityDefaults struct {
+	Enforce        v1alpha1.PodSecurityLevel `json:"enforce"`
+	EnforceVersion string                    `json:"enforce-version"`
+	Audit          v1alpha1.PodSecurityLevel `json:"audit"`
+	AuditVersion   string                    `json:"audit-version"`
+	Warn           v1alpha1.PodSecurityLevel `json:"warn"`
+	WarnVersion    string                    `json:"warn-version"`
+}
+
+type podSecurityExemptions struct {
+	Usernames      []string `json:"usernames"`
+	RuntimeClasses []string `json:"runtimeClasses"`
+	Namespaces     []string `json:"namespaces"`
+}
+
+// GetPodSecurityAdmissionConfig returns the API server admission configuration with the
+// PodSecurity plugin defaults of the cluster, or an empty string if the cluster doesn't configure them.
+// Unset levels default to privileged, like the API server does, and the EKS Anywhere namespaces
+// are always exempted.
+func GetPodSecurityAdmissionConfig(cluster *v1alpha1.Cluster) (string, error) {
+	psa := cluster.Spec.ControlPlaneConfiguration.PodSecurityAdmission
+	if psa == nil {
+		return "", nil
+	}
+
+	namespaces := append([]string{}, podSecurityExemptNamespaces...)
+	namespaces = append(namespaces, constants.EksaPackagesName+"-"+cluster.Name)
+	exemptions := podSecurityExemptions{
+		Usernames:      []string{},
+		RuntimeClasses: []string{},
+	}
+	if psa.Exemptions != nil {
+		exemptions.Usernames = append(exemptions.Usernames, psa.Exemptions.Usernames...)
+		exemptions.RuntimeClasses = append(exemptions.RuntimeClasses, psa.Exemptions.RuntimeClasses...)
+		namespaces = append(namespaces, psa.Exemptions.Namespaces...)
+	}
+	slices.Sort(namespaces)
+	exemptions.Namespaces = slices.Compact(namespaces)
+	slices.Sort(exemptions.Usernames)
+	exemptions.Usernames = slices.Compact(exemptions.Usernames)
+	slices.Sort(exemptions.RuntimeClasses)
+	exemptions.RuntimeClasses = slices.Compact(exemptions.RuntimeClasses)
+
+	config := admissionConfiguration{
+		APIVersion: "apiserver.config.k8s.io/v1",
+		Kind:       "AdmissionConfiguration",
+		Plugins: []admissionPluginConfiguration{
+			{
+				Name: "PodSecurity",
+				Configuration: podSecurityConfiguration{
+					APIVersion: "pod-security.admission.config.k8s.io/v1",
+					Kind:       "PodSecurityConfiguration",
+					Defaults: podSecurityDefaults{
+						Enforce:        podSecurityLevel(psa.Enforce),
+						EnforceVersion: podSecurityLevelVersion,
+						Audit:          podSecurityLevel(psa.Audit),
+						AuditVersion:   podSecurityLevelVersion,
+						Warn:           podSecurityLevel(psa.Warn),
+						WarnVersion:    podSecurityLevelVersion,
+					},
+					Exemptions: exemptions,
+				},
+			},
+		},
+	}
+
+	content, err := yaml.Marshal(config)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(content)), nil
+}
+
+func podSecurityLevel(level v1alpha1.PodSecurityLevel) v1alpha1.PodSecurityLevel {
+	if level == "" {
+		return v1alpha1.PodSecurityLevelPrivileged
+	}
+	return level
+}
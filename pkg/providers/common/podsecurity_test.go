@@ -0,0 +1,92 @@
+package common_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/providers/common"
+)
+
+func TestGetPodSecurityAdmissionConfigNotConfigured(t *testing.T) {
+	g := NewWithT(t)
+	cluster := &v1alpha1.Cluster{}
+
+	g.Expect(common.GetPodSecurityAdmissionConfig(cluster)).To(BeEmpty())
+}
+
+func TestGetPodSecurityAdmissionConfig(t *testing.T) {
+	g := NewWithT(t)
+	cluster := &v1alpha1.Cluster{
+		Spec: v1alpha1.ClusterSpec{
+			ControlPlaneConfiguration: v1alpha1.ControlPlaneConfiguration{
+				PodSecurityAdmission: &v1alpha1.PodSecurityAdmissionConfiguration{
+					Enforce: v1alpha1.PodSecurityLevelBaseline,
+					Warn:    v1alpha1.PodSecurityLevelRestricted,
+					Exemptions: &v1alpha1.PodSecurityAdmissionExemptions{
+						Usernames:      []string{"system:serviceaccount:ci:deployer"},
+						RuntimeClasses: []string{"gvisor"},
+						Namespaces:     []string{"monitoring", "kube-system"},
+					},
+				},
+			},
+		},
+	}
+	cluster.Name = "mgmt"
+
+	g.Expect(common.GetPodSecurityAdmissionConfig(cluster)).To(Equal(`apiVersion: apiserver.config.k8s.io/v1
+kind: AdmissionConfiguration
+plugins:
+- configuration:
+    apiVersion: pod-security.admission.config.k8s.io/v1
+    defaults:
+      audit: privileged
+      audit-version: latest
+      enforce: baseline
+      enforce-version: latest
+      warn: restricted
+      warn-version: latest
+    exemptions:
+      namespaces:
+      - capc-system
+      - capd-system
+      - capi-kubeadm-bootstrap-system
+      - capi-kubeadm-control-plane-system
+      - capi-system
+      - capv-system
+      - cert-manager
+      - eksa-packages
+      - eksa-packages-mgmt
+      - eksa-system
+      - etcdadm-bootstrap-provider-system
+      - etcdadm-controller-system
+      - flux-system
+      - kube-system
+      - monitoring
+      runtimeClasses:
+      - gvisor
+      usernames:
+      - system:serviceaccount:ci:deployer
+    kind: PodSecurityConfiguration
+  name: PodSecurity`))
+}
+
+func TestGetPodSecurityAdmissionConfigDefaults(t *testing.T) {
+	g := NewWithT(t)
+	cluster := &v1alpha1.Cluster{
+		Spec: v1alpha1.ClusterSpec{
+			ControlPlaneConfiguration: v1alpha1.ControlPlaneConfiguration{
+				PodSecurityAdmission: &v1alpha1.PodSecurityAdmissionConfiguration{},
+			},
+		},
+	}
+
+	config, err := common.GetPodSecurityAdmissionConfig(cluster)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(config).To(ContainSubstring("enforce: privileged"))
+	g.Expect(config).To(ContainSubstring("audit: privileged"))
+	g.Expect(config).To(ContainSubstring("warn: privileged"))
+	g.Expect(config).To(ContainSubstring("runtimeClasses: []"))
+	g.Expect(config).To(ContainSubstring("usernames: []"))
+}
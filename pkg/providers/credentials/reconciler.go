@@ -0,0 +1,179 @@
+// Package credentials validates the provider credentials stored in the management cluster, so
+// rejected or expiring credentials are reported in the cluster status before a reconciliation
+// needs them.
+package credentials
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta1"
+	v1beta1conditions "sigs.k8s.io/cluster-api/util/deprecated/v1beta1/conditions"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/controller"
+	"github.com/aws/eks-anywhere/pkg/types"
+)
+
+// ExpirationAnnotation can be set in a provider credentials Secret, with an RFC 3339 timestamp,
+// to report when the credentials expire when the provider doesn't, like Prism Central. The earliest
+// of the annotated and the provider expirations is reported.
+const ExpirationAnnotation = "anywhere.eks.amazonaws.com/credentials-expiration"
+
+const (
+	// CheckInterval is how often the credentials are validated against the provider API.
+	// Changes to the credentials Secret are validated right away.
+	CheckInterval = time.Hour
+
+	// ExpirationWarning is how long before their expiration the credentials are reported as expiring.
+	ExpirationWarning = 14 * 24 * time.Hour
+)
+
+// Validator validates the credentials of a provider.
+type Validator interface {
+	// Secret returns the Secret holding the provider credentials of the cluster.
+	Secret(ctx context.Context, cluster *anywherev1.Cluster) (*corev1.Secret, error)
+	// Validate authenticates against the provider API with the credentials in the Secret and returns
+	// their expiration, if the provider reports one.
+	Validate(ctx context.Context, cluster *anywherev1.Cluster, secret *corev1.Secret) (*time.Time, error)
+}
+
+type check struct {
+	secretVersion string
+	time          time.Time
+	expiration    *time.Time
+	err           error
+}
+
+// Reconciler periodically validates the provider credentials of the clusters and reports the
+// result in their ProviderCredentialsValid condition.
+type Reconciler struct {
+	validators map[string]Validator
+	now        types.NowFunc
+
+	mu     sync.Mutex
+	checks map[client.ObjectKey]check
+}
+
+// New returns a new Reconciler. validators are indexed by datacenter kind, clusters of other
+// kinds are not validated.
+func New(now types.NowFunc, validators map[string]Validator) *Reconciler {
+	return &Reconciler{
+		validators: validators,
+		now:        now,
+		checks:     map[client.ObjectKey]check{},
+	}
+}
+
+// Reconcile validates the provider credentials of the cluster, at most once per CheckInterval
+// unless the credentials Secret changes, checks their expiration reported by the provider or
+// annotated in the Secret, if any, and updates the ProviderCredentialsValid condition.
+// Invalid or expiring credentials don't fail the reconciliation, so the result only asks to
+// requeue for the next check.
+func (r *Reconciler) Reconcile(ctx context.Context, log logr.Logger, cluster *anywherev1.Cluster) (controller.Result, error) {
+	validator, ok := r.validators[cluster.Spec.DatacenterRef.Kind]
+	if !ok {
+		return controller.Result{}, nil
+	}
+
+	key := client.ObjectKeyFromObject(cluster)
+	secret, err := validator.Secret(ctx, cluster)
+	if apierrors.IsNotFound(err) {
+		r.forgetCheck(key)
+		v1beta1conditions.MarkFalse(cluster, anywherev1.ProviderCredentialsValidCondition, anywherev1.ProviderCredentialsInvalidReason, clusterv1.ConditionSeverityError, "%s", err.Error())
+		return controller.ResultWithRequeue(CheckInterval), nil
+	}
+	if err != nil {
+		return controller.Result{}, errors.Wrap(err, "reading provider credentials")
+	}
+
+	now := r.now()
+	last := r.lastCheck(key)
+	if last == nil || last.secretVersion != secret.ResourceVersion || now.Sub(last.time) >= CheckInterval {
+		log.Info("Validating provider credentials", "secret", client.ObjectKeyFromObject(secret))
+		expiration, err := validator.Validate(ctx, cluster, secret)
+		last = &check{
+			secretVersion: secret.ResourceVersion,
+			time:          now,
+			expiration:    expiration,
+			err:           err,
+		}
+		r.setLastCheck(key, *last)
+	}
+
+	if last.err != nil {
+		v1beta1conditions.MarkFalse(cluster, anywherev1.ProviderCredentialsValidCondition, anywherev1.ProviderCredentialsInvalidReason, clusterv1.ConditionSeverityError,
+			"Credentials in Secret %s were rejected: %v", client.ObjectKeyFromObject(secret), last.err)
+		return controller.ResultWithRequeue(CheckInterval), nil
+	}
+
+	expiration, source := last.expiration, "as reported by the provider"
+	annotated, err := annotatedExpiration(secret)
+	if err != nil {
+		log.Error(err, "Ignoring credentials expiration annotation", "secret", client.ObjectKeyFromObject(secret))
+	}
+	if annotated != nil && (expiration == nil || annotated.Before(*expiration)) {
+		expiration, source = annotated, fmt.Sprintf("according to its %s annotation", ExpirationAnnotation)
+	}
+
+	switch {
+	case expiration == nil:
+		v1beta1conditions.MarkTrue(cluster, anywherev1.ProviderCredentialsValidCondition)
+	case !now.Before(*expiration):
+		v1beta1conditions.MarkFalse(cluster, anywherev1.ProviderCredentialsValidCondition, anywherev1.ProviderCredentialsExpiredReason, clusterv1.ConditionSeverityError,
+			"Credentials in Secret %s expired at %s, %s", client.ObjectKeyFromObject(secret), expiration.Format(time.RFC3339), source)
+	case expiration.Sub(now) <= ExpirationWarning:
+		v1beta1conditions.MarkFalse(cluster, anywherev1.ProviderCredentialsValidCondition, anywherev1.ProviderCredentialsExpiringReason, clusterv1.ConditionSeverityWarning,
+			"Credentials in Secret %s expire in %d days, at %s, %s", client.ObjectKeyFromObject(secret), int(expiration.Sub(now).Hours()/24), expiration.Format(time.RFC3339), source)
+	default:
+		v1beta1conditions.MarkTrue(cluster, anywherev1.ProviderCredentialsValidCondition)
+	}
+
+	return controller.ResultWithRequeue(CheckInterval), nil
+}
+
+func (r *Reconciler) lastCheck(key client.ObjectKey) *check {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	c, ok := r.checks[key]
+	if !ok {
+		return nil
+	}
+	return &c
+}
+
+func (r *Reconciler) setLastCheck(key client.ObjectKey, c check) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.checks[key] = c
+}
+
+// forgetCheck drops the last check of a cluster, so checks don't pile up for clusters without
+// credentials Secret, like deleted ones.
+func (r *Reconciler) forgetCheck(key client.ObjectKey) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.checks, key)
+}
+
+// annotatedExpiration returns the expiration date set in the ExpirationAnnotation of the Secret, if any.
+func annotatedExpiration(secret *corev1.Secret) (*time.Time, error) {
+	value, ok := secret.Annotations[ExpirationAnnotation]
+	if !ok {
+		return nil, nil
+	}
+
+	expiration, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s annotation: %v", ExpirationAnnotation, err)
+	}
+
+	return &expiration, nil
+}
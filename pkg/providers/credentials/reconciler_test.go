@@ -0,0 +1,254 @@
+package credentials_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta1"
+	v1beta1conditions "sigs.k8s.io/cluster-api/util/deprecated/v1beta1/conditions"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/controller"
+	"github.com/aws/eks-anywhere/pkg/providers/credentials"
+	"github.com/aws/eks-anywhere/pkg/providers/credentials/mocks"
+)
+
+type reconcilerTest struct {
+	*WithT
+	ctx       context.Context
+	now       time.Time
+	validator *mocks.MockValidator
+	cluster   *anywherev1.Cluster
+	secret    *corev1.Secret
+	r         *credentials.Reconciler
+}
+
+func newReconcilerTest(t testing.TB) *reconcilerTest {
+	ctrl := gomock.NewController(t)
+	tt := &reconcilerTest{
+		WithT:     NewWithT(t),
+		ctx:       context.Background(),
+		now:       time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC),
+		validator: mocks.NewMockValidator(ctrl),
+		cluster: &anywherev1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "my-cluster",
+				Namespace: "default",
+			},
+			Spec: anywherev1.ClusterSpec{
+				DatacenterRef: anywherev1.Ref{
+					Kind: anywherev1.VSphereDatacenterKind,
+					Name: "my-datacenter",
+				},
+			},
+		},
+		secret: &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            "vsphere-credentials",
+				Namespace:       "eksa-system",
+				ResourceVersion: "1",
+			},
+		},
+	}
+	tt.r = credentials.New(func() time.Time { return tt.now }, map[string]credentials.Validator{
+		anywherev1.VSphereDatacenterKind: tt.validator,
+	})
+
+	return tt
+}
+
+func (tt *reconcilerTest) expectCondition(reason string, severity clusterv1.ConditionSeverity, message string) {
+	condition := v1beta1conditions.Get(tt.cluster, anywherev1.ProviderCredentialsValidCondition)
+	tt.Expect(condition).NotTo(BeNil())
+	tt.Expect(string(condition.Status)).To(Equal("False"))
+	tt.Expect(condition.Reason).To(Equal(reason))
+	tt.Expect(condition.Severity).To(Equal(severity))
+	tt.Expect(condition.Message).To(ContainSubstring(message))
+}
+
+func nullLog() logr.Logger {
+	return logr.New(logf.NullLogSink{})
+}
+
+func TestReconcileUnsupportedProvider(t *testing.T) {
+	tt := newReconcilerTest(t)
+	tt.cluster.Spec.DatacenterRef.Kind = anywherev1.DockerDatacenterKind
+
+	result, err := tt.r.Reconcile(tt.ctx, nullLog(), tt.cluster)
+	tt.Expect(err).NotTo(HaveOccurred())
+	tt.Expect(result).To(Equal(controller.Result{}))
+	tt.Expect(v1beta1conditions.Get(tt.cluster, anywherev1.ProviderCredentialsValidCondition)).To(BeNil())
+}
+
+func TestReconcileValidCredentials(t *testing.T) {
+	tt := newReconcilerTest(t)
+	tt.validator.EXPECT().Secret(tt.ctx, tt.cluster).Return(tt.secret, nil)
+	tt.validator.EXPECT().Validate(tt.ctx, tt.cluster, tt.secret).Return(nil, nil)
+
+	result, err := tt.r.Reconcile(tt.ctx, nullLog(), tt.cluster)
+	tt.Expect(err).NotTo(HaveOccurred())
+	tt.Expect(result).To(Equal(controller.ResultWithRequeue(credentials.CheckInterval)))
+	tt.Expect(v1beta1conditions.IsTrue(tt.cluster, anywherev1.ProviderCredentialsValidCondition)).To(BeTrue())
+}
+
+func TestReconcileInvalidCredentials(t *testing.T) {
+	tt := newReconcilerTest(t)
+	tt.validator.EXPECT().Secret(tt.ctx, tt.cluster).Return(tt.secret, nil)
+	tt.validator.EXPECT().Validate(tt.ctx, tt.cluster, tt.secret).Return(nil, errors.New("incorrect user name or password"))
+
+	result, err := tt.r.Reconcile(tt.ctx, nullLog(), tt.cluster)
+	tt.Expect(err).NotTo(HaveOccurred())
+	tt.Expect(result).To(Equal(controller.ResultWithRequeue(credentials.CheckInterval)))
+	tt.expectCondition(anywherev1.ProviderCredentialsInvalidReason, clusterv1.ConditionSeverityError, "eksa-system/vsphere-credentials were rejected: incorrect user name or password")
+}
+
+func TestReconcileMissingSecret(t *testing.T) {
+	tt := newReconcilerTest(t)
+	notFound := apierrors.NewNotFound(schema.GroupResource{Resource: "secrets"}, "vsphere-credentials")
+	tt.validator.EXPECT().Secret(tt.ctx, tt.cluster).Return(nil, notFound)
+
+	result, err := tt.r.Reconcile(tt.ctx, nullLog(), tt.cluster)
+	tt.Expect(err).NotTo(HaveOccurred())
+	tt.Expect(result).To(Equal(controller.ResultWithRequeue(credentials.CheckInterval)))
+	tt.expectCondition(anywherev1.ProviderCredentialsInvalidReason, clusterv1.ConditionSeverityError, "not found")
+}
+
+func TestReconcileSecretError(t *testing.T) {
+	tt := newReconcilerTest(t)
+	tt.validator.EXPECT().Secret(tt.ctx, tt.cluster).Return(nil, errors.New("connection refused"))
+
+	_, err := tt.r.Reconcile(tt.ctx, nullLog(), tt.cluster)
+	tt.Expect(err).To(MatchError(ContainSubstring("reading provider credentials: connection refused")))
+}
+
+func TestReconcileAnnotatedExpiringCredentials(t *testing.T) {
+	tt := newReconcilerTest(t)
+	tt.secret.Annotations = map[string]string{credentials.ExpirationAnnotation: "2026-10-06T00:00:00Z"}
+	tt.validator.EXPECT().Secret(tt.ctx, tt.cluster).Return(tt.secret, nil)
+	tt.validator.EXPECT().Validate(tt.ctx, tt.cluster, tt.secret).Return(nil, nil)
+
+	_, err := tt.r.Reconcile(tt.ctx, nullLog(), tt.cluster)
+	tt.Expect(err).NotTo(HaveOccurred())
+	tt.expectCondition(anywherev1.ProviderCredentialsExpiringReason, clusterv1.ConditionSeverityWarning, "expire in 5 days, at 2026-10-06T00:00:00Z, according to its anywhere.eks.amazonaws.com/credentials-expiration annotation")
+}
+
+func TestReconcileAnnotatedExpiredCredentials(t *testing.T) {
+	tt := newReconcilerTest(t)
+	tt.secret.Annotations = map[string]string{credentials.ExpirationAnnotation: "2026-09-30T00:00:00Z"}
+	tt.validator.EXPECT().Secret(tt.ctx, tt.cluster).Return(tt.secret, nil)
+	tt.validator.EXPECT().Validate(tt.ctx, tt.cluster, tt.secret).Return(nil, nil)
+
+	_, err := tt.r.Reconcile(tt.ctx, nullLog(), tt.cluster)
+	tt.Expect(err).NotTo(HaveOccurred())
+	tt.expectCondition(anywherev1.ProviderCredentialsExpiredReason, clusterv1.ConditionSeverityError, "expired at 2026-09-30T00:00:00Z, according to its anywhere.eks.amazonaws.com/credentials-expiration annotation")
+}
+
+func TestReconcileCredentialsAnnotatedExpirationLater(t *testing.T) {
+	tt := newReconcilerTest(t)
+	tt.secret.Annotations = map[string]string{credentials.ExpirationAnnotation: "2027-01-01T00:00:00Z"}
+	tt.validator.EXPECT().Secret(tt.ctx, tt.cluster).Return(tt.secret, nil)
+	tt.validator.EXPECT().Validate(tt.ctx, tt.cluster, tt.secret).Return(nil, nil)
+
+	_, err := tt.r.Reconcile(tt.ctx, nullLog(), tt.cluster)
+	tt.Expect(err).NotTo(HaveOccurred())
+	tt.Expect(v1beta1conditions.IsTrue(tt.cluster, anywherev1.ProviderCredentialsValidCondition)).To(BeTrue())
+}
+
+func TestReconcileProviderExpiringCredentials(t *testing.T) {
+	tt := newReconcilerTest(t)
+	expiration := time.Date(2026, 10, 11, 0, 0, 0, 0, time.UTC)
+	tt.validator.EXPECT().Secret(tt.ctx, tt.cluster).Return(tt.secret, nil)
+	tt.validator.EXPECT().Validate(tt.ctx, tt.cluster, tt.secret).Return(&expiration, nil)
+
+	_, err := tt.r.Reconcile(tt.ctx, nullLog(), tt.cluster)
+	tt.Expect(err).NotTo(HaveOccurred())
+	tt.expectCondition(anywherev1.ProviderCredentialsExpiringReason, clusterv1.ConditionSeverityWarning, "expire in 10 days, at 2026-10-11T00:00:00Z, as reported by the provider")
+}
+
+func TestReconcileProviderExpirationLaterThanAnnotated(t *testing.T) {
+	tt := newReconcilerTest(t)
+	expiration := time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC)
+	tt.secret.Annotations = map[string]string{credentials.ExpirationAnnotation: "2026-09-30T00:00:00Z"}
+	tt.validator.EXPECT().Secret(tt.ctx, tt.cluster).Return(tt.secret, nil)
+	tt.validator.EXPECT().Validate(tt.ctx, tt.cluster, tt.secret).Return(&expiration, nil)
+
+	_, err := tt.r.Reconcile(tt.ctx, nullLog(), tt.cluster)
+	tt.Expect(err).NotTo(HaveOccurred())
+	tt.expectCondition(anywherev1.ProviderCredentialsExpiredReason, clusterv1.ConditionSeverityError, "expired at 2026-09-30T00:00:00Z, according to its anywhere.eks.amazonaws.com/credentials-expiration annotation")
+}
+
+func TestReconcileInvalidExpirationAnnotation(t *testing.T) {
+	tt := newReconcilerTest(t)
+	tt.secret.Annotations = map[string]string{credentials.ExpirationAnnotation: "next week"}
+	tt.validator.EXPECT().Secret(tt.ctx, tt.cluster).Return(tt.secret, nil)
+	tt.validator.EXPECT().Validate(tt.ctx, tt.cluster, tt.secret).Return(nil, nil)
+
+	_, err := tt.r.Reconcile(tt.ctx, nullLog(), tt.cluster)
+	tt.Expect(err).NotTo(HaveOccurred())
+	tt.Expect(v1beta1conditions.IsTrue(tt.cluster, anywherev1.ProviderCredentialsValidCondition)).To(BeTrue())
+}
+
+func TestReconcileValidatesOncePerInterval(t *testing.T) {
+	tt := newReconcilerTest(t)
+	tt.validator.EXPECT().Secret(tt.ctx, tt.cluster).Return(tt.secret, nil).Times(3)
+	tt.validator.EXPECT().Validate(tt.ctx, tt.cluster, tt.secret).Return(nil, errors.New("incorrect user name or password")).Times(2)
+
+	_, err := tt.r.Reconcile(tt.ctx, nullLog(), tt.cluster)
+	tt.Expect(err).NotTo(HaveOccurred())
+
+	tt.now = tt.now.Add(time.Minute)
+	_, err = tt.r.Reconcile(tt.ctx, nullLog(), tt.cluster)
+	tt.Expect(err).NotTo(HaveOccurred())
+	tt.expectCondition(anywherev1.ProviderCredentialsInvalidReason, clusterv1.ConditionSeverityError, "incorrect user name or password")
+
+	tt.now = tt.now.Add(credentials.CheckInterval)
+	_, err = tt.r.Reconcile(tt.ctx, nullLog(), tt.cluster)
+	tt.Expect(err).NotTo(HaveOccurred())
+}
+
+func TestReconcileForgetsCheckWhenSecretNotFound(t *testing.T) {
+	tt := newReconcilerTest(t)
+	notFound := apierrors.NewNotFound(schema.GroupResource{Resource: "secrets"}, "vsphere-credentials")
+	gomock.InOrder(
+		tt.validator.EXPECT().Secret(tt.ctx, tt.cluster).Return(tt.secret, nil),
+		tt.validator.EXPECT().Validate(tt.ctx, tt.cluster, tt.secret).Return(nil, nil),
+		tt.validator.EXPECT().Secret(tt.ctx, tt.cluster).Return(nil, notFound),
+		tt.validator.EXPECT().Secret(tt.ctx, tt.cluster).Return(tt.secret, nil),
+		tt.validator.EXPECT().Validate(tt.ctx, tt.cluster, tt.secret).Return(nil, nil),
+	)
+
+	for i := 0; i < 3; i++ {
+		_, err := tt.r.Reconcile(tt.ctx, nullLog(), tt.cluster)
+		tt.Expect(err).NotTo(HaveOccurred())
+	}
+	tt.Expect(v1beta1conditions.IsTrue(tt.cluster, anywherev1.ProviderCredentialsValidCondition)).To(BeTrue())
+}
+
+func TestReconcileValidatesUpdatedSecret(t *testing.T) {
+	tt := newReconcilerTest(t)
+	rotated := tt.secret.DeepCopy()
+	rotated.ResourceVersion = "2"
+	gomock.InOrder(
+		tt.validator.EXPECT().Secret(tt.ctx, tt.cluster).Return(tt.secret, nil),
+		tt.validator.EXPECT().Validate(tt.ctx, tt.cluster, tt.secret).Return(nil, errors.New("incorrect user name or password")),
+		tt.validator.EXPECT().Secret(tt.ctx, tt.cluster).Return(rotated, nil),
+		tt.validator.EXPECT().Validate(tt.ctx, tt.cluster, rotated).Return(nil, nil),
+	)
+
+	_, err := tt.r.Reconcile(tt.ctx, nullLog(), tt.cluster)
+	tt.Expect(err).NotTo(HaveOccurred())
+
+	_, err = tt.r.Reconcile(tt.ctx, nullLog(), tt.cluster)
+	tt.Expect(err).NotTo(HaveOccurred())
+	tt.Expect(v1beta1conditions.IsTrue(tt.cluster, anywherev1.ProviderCredentialsValidCondition)).To(BeTrue())
+}
@@ -0,0 +1,56 @@
+package docker
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	dockerv1beta2 "sigs.k8s.io/cluster-api/test/infrastructure/docker/api/v1beta2"
+
+	"github.com/aws/eks-anywhere/pkg/cluster"
+	"github.com/aws/eks-anywhere/pkg/clusterapi"
+)
+
+// ClusterClass represents the docker specific CAPI spec for a cluster with a managed topology.
+type ClusterClass = clusterapi.ClusterClass[*dockerv1beta2.DockerClusterTemplate, *dockerv1beta2.DockerMachineTemplate]
+
+// ClusterClassSpec generates a docker ClusterClass and a CAPI Cluster with a managed topology
+// based on an eks-a cluster spec. Templates are named after their content, so there is no need
+// to read the existing ones from the cluster to detect changes.
+func ClusterClassSpec(_ context.Context, logger logr.Logger, spec *cluster.Spec) (*ClusterClass, error) {
+	cp, err := controlPlaneSpecWithInitialNames(logger, spec)
+	if err != nil {
+		return nil, err
+	}
+
+	workers, err := workersSpecWithInitialNames(logger, spec)
+	if err != nil {
+		return nil, err
+	}
+
+	cc, err := clusterapi.NewClusterClass(cp, workers, dockerClusterTemplate(cp.ProviderCluster))
+	if err != nil {
+		return nil, errors.Wrap(err, "building docker ClusterClass")
+	}
+
+	return cc, nil
+}
+
+func dockerClusterTemplate(dockerCluster *dockerv1beta2.DockerCluster) *dockerv1beta2.DockerClusterTemplate {
+	return &dockerv1beta2.DockerClusterTemplate{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: dockerv1beta2.GroupVersion.String(),
+			Kind:       "DockerClusterTemplate",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      dockerCluster.Name,
+			Namespace: dockerCluster.Namespace,
+		},
+		Spec: dockerv1beta2.DockerClusterTemplateSpec{
+			Template: dockerv1beta2.DockerClusterTemplateResource{
+				Spec: *dockerCluster.Spec.DeepCopy(),
+			},
+		},
+	}
+}
@@ -0,0 +1,54 @@
+package docker_test
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	clusterv1beta2 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+
+	"github.com/aws/eks-anywhere/internal/test"
+	"github.com/aws/eks-anywhere/pkg/cluster"
+	"github.com/aws/eks-anywhere/pkg/providers/docker"
+)
+
+func TestClusterClassSpec(t *testing.T) {
+	g := NewWithT(t)
+	logger := test.NewNullLogger()
+	ctx := context.Background()
+	spec := testClusterSpec(func(s *cluster.Spec) {
+		s.Cluster.Spec.ExternalEtcdConfiguration = nil
+	})
+
+	cc, err := docker.ClusterClassSpec(ctx, logger, spec)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	g.Expect(cc.ClusterClass.Name).To(Equal("test"))
+	g.Expect(cc.ProviderClusterTemplate.Kind).To(Equal("DockerClusterTemplate"))
+	g.Expect(cc.ProviderClusterTemplate.Name).To(HavePrefix("test-"))
+	g.Expect(cc.ClusterClass.Spec.Infrastructure.TemplateRef.Name).To(Equal(cc.ProviderClusterTemplate.Name))
+	g.Expect(cc.ControlPlaneMachineTemplate.Name).To(HavePrefix("test-control-plane-1-"))
+	g.Expect(cc.Workers).To(HaveLen(2))
+	g.Expect(cc.ClusterClass.Spec.Workers.MachineDeployments).To(HaveLen(2))
+	g.Expect(cc.ClusterClass.Spec.Workers.MachineDeployments[0].Class).To(Equal("md-0"))
+	g.Expect(cc.ClusterClass.Spec.Workers.MachineDeployments[1].Class).To(Equal("md-1"))
+
+	g.Expect(cc.Cluster.Spec.ControlPlaneRef.IsDefined()).To(BeFalse())
+	g.Expect(cc.Cluster.Spec.InfrastructureRef.IsDefined()).To(BeFalse())
+	g.Expect(cc.Cluster.Spec.Topology.ClassRef).To(Equal(clusterv1beta2.ClusterClassRef{Name: "test"}))
+	g.Expect(cc.Cluster.Spec.Topology.Version).To(Equal("v1.23.12-eks-1-23-6"))
+	g.Expect(*cc.Cluster.Spec.Topology.ControlPlane.Replicas).To(Equal(int32(3)))
+	g.Expect(cc.Cluster.Spec.Topology.Workers.MachineDeployments).To(HaveLen(2))
+	g.Expect(cc.Cluster.Spec.Topology.Workers.MachineDeployments[0].Name).To(Equal("md-0"))
+	g.Expect(*cc.Cluster.Spec.Topology.Workers.MachineDeployments[0].Replicas).To(Equal(int32(3)))
+}
+
+func TestClusterClassSpecErrorExternalEtcd(t *testing.T) {
+	g := NewWithT(t)
+	logger := test.NewNullLogger()
+	ctx := context.Background()
+	spec := testClusterSpec()
+
+	_, err := docker.ClusterClassSpec(ctx, logger, spec)
+	g.Expect(err).To(MatchError(ContainSubstring("building docker ClusterClass: external etcd is not supported")))
+}
@@ -22,6 +22,19 @@ type controlPlaneBuilder = yamlcapi.ControlPlaneBuilder[*dockerv1beta2.DockerClu
 
 // ControlPlaneSpec builds a docker ControlPlane definition based on an eks-a cluster spec.
 func ControlPlaneSpec(ctx context.Context, logger logr.Logger, client kubernetes.Client, spec *cluster.Spec) (*ControlPlane, error) {
+	cp, err := controlPlaneSpecWithInitialNames(logger, spec)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = cp.UpdateImmutableObjectNames(ctx, client, GetMachineTemplate, MachineTemplateEqual); err != nil {
+		return nil, errors.Wrap(err, "updating docker immutable object names")
+	}
+
+	return cp, nil
+}
+
+func controlPlaneSpecWithInitialNames(logger logr.Logger, spec *cluster.Spec) (*ControlPlane, error) {
 	templateBuilder := NewDockerTemplateBuilder(time.Now)
 
 	controlPlaneYaml, err := templateBuilder.GenerateCAPISpecControlPlane(
@@ -45,12 +58,7 @@ func ControlPlaneSpec(ctx context.Context, logger logr.Logger, client kubernetes
 		return nil, errors.Wrap(err, "parsing docker control plane yaml")
 	}
 
-	cp := builder.ControlPlane
-	if err = cp.UpdateImmutableObjectNames(ctx, client, GetMachineTemplate, MachineTemplateEqual); err != nil {
-		return nil, errors.Wrap(err, "updating docker immutable object names")
-	}
-
-	return cp, nil
+	return builder.ControlPlane, nil
 }
 
 func newControlPlaneParser(logger logr.Logger) (*yamlutil.Parser, *controlPlaneBuilder, error) {
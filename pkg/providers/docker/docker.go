@@ -6,8 +6,11 @@ import (
 	_ "embed"
 	"fmt"
 	"io"
+	"net"
 	"os"
 	"regexp"
+	"strconv"
+	"strings"
 
 	etcdv1 "github.com/aws/etcdadm-controller/api/v1beta1"
 	controlplanev1beta2 "sigs.k8s.io/cluster-api/api/controlplane/kubeadm/v1beta2"
@@ -33,7 +36,8 @@ import (
 )
 
 const (
-	githubTokenEnvVar = "GITHUB_TOKEN"
+	githubTokenEnvVar          = "GITHUB_TOKEN"
+	defaultLoadBalancerAddress = "127.0.0.1"
 )
 
 //go:embed config/template-cp.yaml
@@ -63,8 +67,9 @@ type KubeconfigReader interface {
 
 // KubeconfigWriter reads the kubeconfig secret on a docker cluster and copies the contents to a writer.
 type KubeconfigWriter struct {
-	docker ProviderClient
-	reader KubeconfigReader
+	docker       ProviderClient
+	reader       KubeconfigReader
+	loadBalancer *v1alpha1.DockerLoadBalancer
 }
 
 // InstallCustomProviderComponents is a no-op. It implements providers.Provider.
@@ -145,6 +150,9 @@ func (p *Provider) SetupAndValidateCreateCluster(ctx context.Context, clusterSpe
 	if err := ValidateControlPlaneEndpoint(clusterSpec); err != nil {
 		return err
 	}
+	if err := ValidateNodeImagesArch(clusterSpec, executables.HostArch()); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -153,9 +161,10 @@ func (p *Provider) SetupAndValidateDeleteCluster(ctx context.Context, _ *types.C
 	return nil
 }
 
-// SetupAndValidateUpgradeCluster is a no-op. It implements providers.Provider.
-func (p *Provider) SetupAndValidateUpgradeCluster(ctx context.Context, _ *types.Cluster, _ *cluster.Spec, _ *cluster.Spec) error {
-	return nil
+// SetupAndValidateUpgradeCluster validates the new kind node images can run on the admin machine.
+// It implements providers.Provider.
+func (p *Provider) SetupAndValidateUpgradeCluster(ctx context.Context, _ *types.Cluster, clusterSpec *cluster.Spec, _ *cluster.Spec) error {
+	return ValidateNodeImagesArch(clusterSpec, executables.HostArch())
 }
 
 // SetupAndValidateUpgradeManagementComponents performs necessary setup for upgrade management components operation.
@@ -273,7 +282,10 @@ func buildTemplateMapCP(clusterSpec *cluster.Spec) (map[string]interface{}, erro
 		Append(sharedExtraArgs)
 	clusterapi.SetPodIAMAuthExtraArgs(clusterSpec.Cluster.Spec.PodIAMConfig, apiServerExtraArgs)
 	controllerManagerExtraArgs := clusterapi.SecureTlsCipherSuitesExtraArgs().
-		Append(clusterapi.NodeCIDRMaskExtraArgs(&clusterSpec.Cluster.Spec.ClusterNetwork))
+		Append(clusterapi.NodeCIDRMaskExtraArgs(&clusterSpec.Cluster.Spec.ClusterNetwork)).
+		Append(clusterapi.ControllerManagerExtraArgs(clusterSpec.Cluster.Spec.ControlPlaneConfiguration.ControllerManagerExtraArgs))
+	schedulerExtraArgs := clusterapi.SecureTlsCipherSuitesExtraArgs().
+		Append(clusterapi.SchedulerExtraArgs(clusterSpec.Cluster.Spec.ControlPlaneConfiguration.SchedulerExtraArgs))
 
 	values := map[string]interface{}{
 		"clusterName":                   clusterSpec.Cluster.Name,
@@ -289,11 +301,12 @@ func buildTemplateMapCP(clusterSpec *cluster.Spec) (map[string]interface{}, erro
 		"etcdCipherSuites":              crypto.SecureCipherSuitesString(),
 		"apiserverExtraArgs":            apiServerExtraArgs,
 		"controllermanagerExtraArgs":    controllerManagerExtraArgs,
-		"schedulerExtraArgs":            sharedExtraArgs,
+		"schedulerExtraArgs":            schedulerExtraArgs,
 		"externalEtcdVersion":           versionsBundle.KubeDistro.EtcdVersion,
 		"eksaSystemNamespace":           constants.EksaSystemNamespace,
 		"podCidrs":                      clusterSpec.Cluster.Spec.ClusterNetwork.Pods.CidrBlocks,
 		"serviceCidrs":                  clusterSpec.Cluster.Spec.ClusterNetwork.Services.CidrBlocks,
+		"skipKubeProxy":                 clusterSpec.Cluster.Spec.ClusterNetwork.CNIConfig.KubeProxyReplacementEnabled(),
 		"haproxyImageRepository":        getHAProxyImageRepo(versionsBundle.Haproxy.Image),
 		"haproxyImageTag":               versionsBundle.Haproxy.Image.Tag(),
 		"workerNodeGroupConfigurations": clusterSpec.Cluster.Spec.WorkerNodeGroupConfigurations,
@@ -315,11 +328,16 @@ func buildTemplateMapCP(clusterSpec *cluster.Spec) (map[string]interface{}, erro
 
 	values["controlPlaneTaints"] = clusterSpec.Cluster.Spec.ControlPlaneConfiguration.Taints
 
-	auditPolicy, err := common.GetAuditPolicy(clusterSpec.Cluster.Spec.KubernetesVersion)
-	if err != nil {
-		return nil, err
+	if clusterSpec.Cluster.Spec.ControlPlaneConfiguration.AuditPolicyContent != "" {
+		values["auditPolicy"] = strings.TrimSpace(clusterSpec.Cluster.Spec.ControlPlaneConfiguration.AuditPolicyContent)
+	} else {
+		auditPolicy, err := common.GetAuditPolicy(clusterSpec.Cluster.Spec.KubernetesVersion)
+		if err != nil {
+			return nil, err
+		}
+		values["auditPolicy"] = auditPolicy
 	}
-	values["auditPolicy"] = auditPolicy
+	values["auditLog"] = common.GetAuditLogSettings(clusterSpec.Cluster)
 
 	if clusterSpec.Cluster.Spec.ControlPlaneConfiguration.SkipAdmissionForSystemResources != nil &&
 		*clusterSpec.Cluster.Spec.ControlPlaneConfiguration.SkipAdmissionForSystemResources {
@@ -330,6 +348,14 @@ func buildTemplateMapCP(clusterSpec *cluster.Spec) (map[string]interface{}, erro
 		values["admissionExclusionPolicy"] = admissionExclusionPolicy
 	}
 
+	podSecurityAdmissionConfig, err := common.GetPodSecurityAdmissionConfig(clusterSpec.Cluster)
+	if err != nil {
+		return nil, err
+	}
+	if podSecurityAdmissionConfig != "" {
+		values["podSecurityAdmissionConfig"] = podSecurityAdmissionConfig
+	}
+
 	if clusterSpec.Cluster.Spec.RegistryMirrorConfiguration != nil {
 		values, err := populateRegistryMirrorValues(clusterSpec, values)
 		if err != nil {
@@ -514,20 +540,45 @@ func buildTemplateMapMD(clusterSpec *cluster.Spec, workerNodeGroupConfiguration
 func (p *Provider) UpdateKubeConfig(content *[]byte, clusterName string) error {
 	// The Docker provider is for testing only. We don't want to change the interface just for the test
 	ctx := context.Background()
-	if port, err := p.docker.GetDockerLBPort(ctx, clusterName); err != nil {
+	address, port, err := loadBalancerEndpoint(ctx, p.docker, p.LoadBalancer(), clusterName)
+	if err != nil {
 		return err
-	} else {
-		updateKubeconfig(content, port)
+	}
+
+	updateKubeconfig(content, address, port)
+	return nil
+}
+
+// LoadBalancer returns the load balancer configuration of the DockerDatacenterConfig.
+func (p *Provider) LoadBalancer() *v1alpha1.DockerLoadBalancer {
+	if p.datacenterConfig == nil {
 		return nil
 	}
+	return p.datacenterConfig.Spec.LoadBalancer
+}
+
+// KubeconfigWriterOpt configures a KubeconfigWriter.
+type KubeconfigWriterOpt func(*KubeconfigWriter)
+
+// WithLoadBalancer makes the KubeconfigWriter point the kubeconfig to the load balancer
+// endpoint configured in the DockerDatacenterConfig.
+func WithLoadBalancer(lb *v1alpha1.DockerLoadBalancer) KubeconfigWriterOpt {
+	return func(kr *KubeconfigWriter) {
+		kr.loadBalancer = lb
+	}
 }
 
 // NewKubeconfigWriter creates a KubeconfigWriter.
-func NewKubeconfigWriter(docker ProviderClient, reader KubeconfigReader) KubeconfigWriter {
-	return KubeconfigWriter{
+func NewKubeconfigWriter(docker ProviderClient, reader KubeconfigReader, opts ...KubeconfigWriterOpt) KubeconfigWriter {
+	kr := KubeconfigWriter{
 		reader: reader,
 		docker: docker,
 	}
+	for _, opt := range opts {
+		opt(&kr)
+	}
+
+	return kr
 }
 
 // WriteKubeconfig retrieves the contents of the specified cluster's kubeconfig from a secret and copies it to an io.Writer.
@@ -546,12 +597,12 @@ func (kr KubeconfigWriter) WriteKubeconfig(ctx context.Context, clusterName, kub
 
 // WriteKubeconfigContent retrieves the contents of the specified cluster's kubeconfig from a secret and copies it to an io.Writer.
 func (kr KubeconfigWriter) WriteKubeconfigContent(ctx context.Context, clusterName string, content []byte, w io.Writer) error {
-	port, err := kr.docker.GetDockerLBPort(ctx, clusterName)
+	address, port, err := loadBalancerEndpoint(ctx, kr.docker, kr.loadBalancer, clusterName)
 	if err != nil {
 		return err
 	}
 
-	updateKubeconfig(&content, port)
+	updateKubeconfig(&content, address, port)
 
 	if _, err := io.Copy(w, bytes.NewReader(content)); err != nil {
 		return err
@@ -560,10 +611,30 @@ func (kr KubeconfigWriter) WriteKubeconfigContent(ctx context.Context, clusterNa
 	return nil
 }
 
+// loadBalancerEndpoint returns the address and port the kubeconfig of a cluster should use to
+// reach its load balancer, defaulting to the host port docker published on localhost.
+func loadBalancerEndpoint(ctx context.Context, docker ProviderClient, lb *v1alpha1.DockerLoadBalancer, clusterName string) (address, port string, err error) {
+	address = defaultLoadBalancerAddress
+	if lb != nil && lb.ExternalAddress != "" {
+		address = lb.ExternalAddress
+	}
+
+	if lb != nil && lb.HostPort != 0 {
+		return address, strconv.Itoa(lb.HostPort), nil
+	}
+
+	port, err = docker.GetDockerLBPort(ctx, clusterName)
+	if err != nil {
+		return "", "", err
+	}
+
+	return address, port, nil
+}
+
 // this is required for docker provider.
-func updateKubeconfig(content *[]byte, dockerLbPort string) {
+func updateKubeconfig(content *[]byte, dockerLbAddress, dockerLbPort string) {
 	mc := regexp.MustCompile("server:.*")
-	updatedConfig := mc.ReplaceAllString(string(*content), fmt.Sprintf("server: https://127.0.0.1:%s", dockerLbPort))
+	updatedConfig := mc.ReplaceAllString(string(*content), fmt.Sprintf("server: https://%s", net.JoinHostPort(dockerLbAddress, dockerLbPort)))
 	mc = regexp.MustCompile("certificate-authority-data:.*")
 	updatedConfig = mc.ReplaceAllString(updatedConfig, "insecure-skip-tls-verify: true")
 	updatedContentByte := []byte(updatedConfig)
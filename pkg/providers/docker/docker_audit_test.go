@@ -0,0 +1,33 @@
+package docker_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/aws/eks-anywhere/internal/test"
+	"github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/providers/docker"
+	"github.com/aws/eks-anywhere/pkg/utils/ptr"
+)
+
+func TestDockerTemplateBuilderGenerateCAPISpecControlPlaneWithCustomAudit(t *testing.T) {
+	g := NewWithT(t)
+	spec := test.NewFullClusterSpec(t, "testdata/cluster_api_server_cert_san_ip.yaml")
+	spec.Cluster.Spec.ControlPlaneConfiguration.AuditPolicyContent = `apiVersion: audit.k8s.io/v1
+kind: Policy
+rules:
+- level: Metadata
+`
+	spec.Cluster.Spec.ControlPlaneConfiguration.AuditLog = &v1alpha1.AuditLogConfiguration{
+		MaxAge:    ptr.Int(7),
+		MaxBackup: ptr.Int(5),
+		MaxSize:   ptr.Int(100),
+	}
+
+	builder := docker.NewDockerTemplateBuilder(test.FakeNow)
+	data, err := builder.GenerateCAPISpecControlPlane(spec)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	test.AssertYAMLSubset(t, data, "testdata/audit_log_custom.yaml")
+}
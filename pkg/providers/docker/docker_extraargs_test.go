@@ -0,0 +1,32 @@
+package docker_test
+
+import (
+	"strings"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/aws/eks-anywhere/internal/test"
+	"github.com/aws/eks-anywhere/pkg/providers/docker"
+)
+
+func TestDockerTemplateBuilderGenerateCAPISpecControlPlaneWithComponentExtraArgs(t *testing.T) {
+	g := NewWithT(t)
+	spec := test.NewFullClusterSpec(t, "testdata/cluster_api_server_cert_san_ip.yaml")
+	spec.Cluster.Spec.ControlPlaneConfiguration.ControllerManagerExtraArgs = map[string]string{
+		"profiling":    "true",
+		"bind-address": "0.0.0.0",
+	}
+	spec.Cluster.Spec.ControlPlaneConfiguration.SchedulerExtraArgs = map[string]string{
+		"bind-address": "0.0.0.0",
+	}
+
+	builder := docker.NewDockerTemplateBuilder(test.FakeNow)
+	data, err := builder.GenerateCAPISpecControlPlane(spec)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	test.AssertYAMLSubset(t, data, "testdata/component_extra_args.yaml")
+	// The user provided profiling flag replaces the controller manager default instead of being
+	// duplicated, so the flag is only set once for each of the api server, controller manager and scheduler.
+	g.Expect(strings.Count(string(data), "name: profiling")).To(Equal(3))
+}
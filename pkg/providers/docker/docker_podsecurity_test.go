@@ -0,0 +1,39 @@
+package docker_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/aws/eks-anywhere/internal/test"
+	"github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/providers/docker"
+)
+
+func TestDockerTemplateBuilderGenerateCAPISpecControlPlaneWithPodSecurityAdmission(t *testing.T) {
+	g := NewWithT(t)
+	spec := test.NewFullClusterSpec(t, "testdata/cluster_api_server_cert_san_ip.yaml")
+	spec.Cluster.Spec.ControlPlaneConfiguration.PodSecurityAdmission = &v1alpha1.PodSecurityAdmissionConfiguration{
+		Enforce: v1alpha1.PodSecurityLevelBaseline,
+		Warn:    v1alpha1.PodSecurityLevelRestricted,
+	}
+
+	builder := docker.NewDockerTemplateBuilder(test.FakeNow)
+	data, err := builder.GenerateCAPISpecControlPlane(spec)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	test.AssertYAMLSubset(t, data, "testdata/pod_security_admission.yaml")
+	g.Expect(string(data)).To(ContainSubstring("path: /etc/kubernetes/pod-security-admission.yaml"))
+	g.Expect(string(data)).To(ContainSubstring("enforce: baseline"))
+}
+
+func TestDockerTemplateBuilderGenerateCAPISpecControlPlaneWithoutPodSecurityAdmission(t *testing.T) {
+	g := NewWithT(t)
+	spec := test.NewFullClusterSpec(t, "testdata/cluster_api_server_cert_san_ip.yaml")
+
+	builder := docker.NewDockerTemplateBuilder(test.FakeNow)
+	data, err := builder.GenerateCAPISpecControlPlane(spec)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	g.Expect(string(data)).ToNot(ContainSubstring("pod-security-admission"))
+}
@@ -113,6 +113,32 @@ clusters:
 	}
 }
 
+func TestProviderUpdateKubeConfigExternalLoadBalancer(t *testing.T) {
+	g := NewWithT(t)
+	content := []byte(`
+clusters:
+- cluster:
+    certificate-authority-data: LS0tLS1CRUdJTiBDRVJUSUZJ
+    server: https://172.18.0.3:6443`)
+	mockCtrl := gomock.NewController(t)
+	client := dockerMocks.NewMockProviderClient(mockCtrl)
+	client.EXPECT().GetDockerLBPort(gomock.Any(), "test").Return("4332", nil)
+	kubectl := dockerMocks.NewMockProviderKubectlClient(mockCtrl)
+	datacenterConfig := &v1alpha1.DockerDatacenterConfig{
+		Spec: v1alpha1.DockerDatacenterConfigSpec{
+			LoadBalancer: &v1alpha1.DockerLoadBalancer{ExternalAddress: "docker-host.example.com"},
+		},
+	}
+	p := docker.NewProvider(datacenterConfig, client, kubectl, test.FakeNow)
+
+	g.Expect(p.UpdateKubeConfig(&content, "test")).To(Succeed())
+	g.Expect(string(content)).To(Equal(`
+clusters:
+- cluster:
+    insecure-skip-tls-verify: true
+    server: https://docker-host.example.com:4332`))
+}
+
 func TestGetInfrastructureBundleSuccess(t *testing.T) {
 	mockCtrl := gomock.NewController(t)
 
@@ -522,6 +548,18 @@ func TestTemplateBuilder_CertSANs(t *testing.T) {
 	}
 }
 
+func TestTemplateBuilderKubeProxyReplacement(t *testing.T) {
+	g := NewWithT(t)
+	clusterSpec := test.NewFullClusterSpec(t, "testdata/cluster_kube_proxy_replacement.yaml")
+
+	bldr := docker.NewDockerTemplateBuilder(time.Now)
+
+	data, err := bldr.GenerateCAPISpecControlPlane(clusterSpec)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	test.AssertContentToFile(t, string(data), "testdata/expected_results_kube_proxy_replacement_cp.yaml")
+}
+
 func TestDockerWriteKubeconfig(t *testing.T) {
 	for _, tc := range []struct {
 		clusterName    string
@@ -563,3 +601,20 @@ func TestDockerWriteKubeconfig(t *testing.T) {
 		}
 	}
 }
+
+func TestDockerWriteKubeconfigContentLoadBalancerHostPort(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	buf := bytes.NewBuffer([]byte{})
+	mockCtrl := gomock.NewController(t)
+	dockerClient := dockerMocks.NewMockProviderClient(mockCtrl)
+	reader := dockerMocks.NewMockKubeconfigReader(mockCtrl)
+	writer := docker.NewKubeconfigWriter(dockerClient, reader, docker.WithLoadBalancer(&v1alpha1.DockerLoadBalancer{
+		ExternalAddress: "10.0.0.5",
+		HostPort:        30443,
+	}))
+
+	err := writer.WriteKubeconfigContent(ctx, "test", []byte("    server: https://172.18.0.3:6443"), buf)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(buf.String()).To(Equal("    server: https://10.0.0.5:30443"))
+}
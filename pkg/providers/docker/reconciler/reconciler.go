@@ -8,11 +8,13 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/clients/kubernetes"
 	"github.com/aws/eks-anywhere/pkg/cluster"
 	"github.com/aws/eks-anywhere/pkg/controller"
 	"github.com/aws/eks-anywhere/pkg/controller/clientutil"
 	"github.com/aws/eks-anywhere/pkg/controller/clusters"
 	"github.com/aws/eks-anywhere/pkg/controller/serverside"
+	"github.com/aws/eks-anywhere/pkg/features"
 	"github.com/aws/eks-anywhere/pkg/providers/docker"
 )
 
@@ -52,6 +54,15 @@ func (r *Reconciler) Reconcile(ctx context.Context, log logr.Logger, c *anywhere
 		return controller.Result{}, err
 	}
 
+	if features.IsActive(features.ClusterClassEnabled()) {
+		return controller.NewPhaseRunner[*cluster.Spec]().Register(
+			clusters.CleanupStatusAfterValidate,
+			r.ReconcileClusterTopology,
+			r.CheckControlPlaneReady,
+			r.ReconcileCNI,
+		).Run(ctx, log, clusterSpec)
+	}
+
 	return controller.NewPhaseRunner[*cluster.Spec]().Register(
 		clusters.CleanupStatusAfterValidate,
 		r.ReconcileControlPlane,
@@ -61,6 +72,22 @@ func (r *Reconciler) Reconcile(ctx context.Context, log logr.Logger, c *anywhere
 	).Run(ctx, log, clusterSpec)
 }
 
+// ReconcileClusterTopology applies a ClusterClass, its templates and a CAPI Cluster with a managed
+// topology to the cluster. The CAPI topology controller takes care of creating and rolling out the
+// control plane and worker objects, so it requires the CAPI ClusterTopology feature gate.
+func (r *Reconciler) ReconcileClusterTopology(ctx context.Context, log logr.Logger, spec *cluster.Spec) (controller.Result, error) {
+	log = log.WithValues("phase", "reconcileClusterTopology")
+	log.Info("Applying ClusterClass and cluster topology CAPI objects")
+	return r.Apply(ctx, func() ([]kubernetes.Object, error) {
+		cc, err := docker.ClusterClassSpec(ctx, log, spec)
+		if err != nil {
+			return nil, errors.Wrap(err, "generating ClusterClass spec")
+		}
+
+		return cc.Objects(), nil
+	})
+}
+
 // CheckControlPlaneReady checks whether the control plane for an eks-a cluster is ready or not.
 // Requeues with the appropriate wait times whenever the cluster is not ready yet.
 func (r *Reconciler) CheckControlPlaneReady(ctx context.Context, log logr.Logger, spec *cluster.Spec) (controller.Result, error) {
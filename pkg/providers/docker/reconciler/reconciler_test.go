@@ -220,6 +220,48 @@ func TestReconcilerReconcileWorkersErrorGeneratingSpec(t *testing.T) {
 	).Error().To(MatchError(ContainSubstring("generating workers spec")))
 }
 
+func TestReconcileClusterTopologySuccess(t *testing.T) {
+	tt := newReconcilerTest(t)
+	tt.createAllObjs()
+	logger := test.NewNullLogger()
+	result, err := tt.reconciler().ReconcileClusterTopology(tt.ctx, logger, tt.buildSpec())
+
+	tt.Expect(err).NotTo(HaveOccurred())
+	tt.Expect(tt.cluster.Status.FailureMessage).To(BeZero())
+	tt.Expect(tt.cluster.Status.FailureReason).To(BeZero())
+	tt.Expect(result).To(Equal(controller.Result{}))
+
+	tt.ShouldEventuallyExist(tt.ctx,
+		&clusterv1beta2.ClusterClass{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      tt.cluster.Name,
+				Namespace: constants.EksaSystemNamespace,
+			},
+		},
+	)
+	capiCluster := &clusterv1beta2.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      tt.cluster.Name,
+			Namespace: constants.EksaSystemNamespace,
+		},
+	}
+	tt.ShouldEventuallyMatch(tt.ctx, capiCluster, func(g Gomega) {
+		g.Expect(capiCluster.Spec.Topology.ClassRef.Name).To(Equal(tt.cluster.Name))
+	})
+}
+
+func TestReconcileClusterTopologyErrorGeneratingSpec(t *testing.T) {
+	tt := newReconcilerTest(t)
+	tt.cluster.Spec.ExternalEtcdConfiguration = &anywherev1.ExternalEtcdConfiguration{
+		Count: 1,
+	}
+	tt.createAllObjs()
+
+	tt.Expect(
+		tt.reconciler().ReconcileClusterTopology(tt.ctx, test.NewNullLogger(), tt.buildSpec()),
+	).Error().To(MatchError(ContainSubstring("generating ClusterClass spec")))
+}
+
 func TestReconcileControlPlaneStackedEtcdSuccess(t *testing.T) {
 	tt := newReconcilerTest(t)
 	tt.createAllObjs()
@@ -2,7 +2,9 @@ package docker
 
 import (
 	"fmt"
+	"sort"
 
+	"github.com/aws/eks-anywhere/pkg/api/v1alpha1"
 	"github.com/aws/eks-anywhere/pkg/cluster"
 )
 
@@ -13,3 +15,23 @@ func ValidateControlPlaneEndpoint(clusterSpec *cluster.Spec) error {
 	}
 	return nil
 }
+
+// ValidateNodeImagesArch checks that the kind node images for all the Kubernetes versions of the
+// cluster are published for arch. CAPD always pulls the node images for the architecture of the
+// docker host, so nodes can't run from images built for a different one.
+func ValidateNodeImagesArch(clusterSpec *cluster.Spec, arch string) error {
+	versions := make([]v1alpha1.KubernetesVersion, 0, len(clusterSpec.VersionsBundles))
+	for version := range clusterSpec.VersionsBundles {
+		versions = append(versions, version)
+	}
+	sort.Slice(versions, func(i, j int) bool { return versions[i] < versions[j] })
+
+	for _, version := range versions {
+		image := clusterSpec.VersionsBundles[version].EksD.KindNode
+		if !image.SupportsArch(arch) {
+			return fmt.Errorf("kind node image %s for Kubernetes %s is not available for the %s architecture of the admin machine, available architectures: %v",
+				image.VersionedImage(), version, arch, image.Arch)
+		}
+	}
+	return nil
+}
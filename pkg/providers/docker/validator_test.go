@@ -9,6 +9,7 @@ import (
 	"github.com/aws/eks-anywhere/pkg/cluster"
 	"github.com/aws/eks-anywhere/pkg/providers/docker"
 	"github.com/aws/eks-anywhere/pkg/utils/ptr"
+	releasev1alpha1 "github.com/aws/eks-anywhere/release/api/v1alpha1"
 )
 
 func TestValidateControlplaneEndpoint(t *testing.T) {
@@ -29,3 +30,47 @@ func TestValidateControlplaneEndpoint(t *testing.T) {
 		t.Errorf("Got err %v, wanted %v", err, wantErr)
 	}
 }
+
+func TestValidateNodeImagesArch(t *testing.T) {
+	tests := []struct {
+		name      string
+		arch      string
+		imageArch []string
+		wantErr   string
+	}{
+		{
+			name:      "multi arch image on arm64",
+			arch:      "arm64",
+			imageArch: []string{"amd64", "arm64"},
+		},
+		{
+			name:      "image without arch metadata",
+			arch:      "arm64",
+			imageArch: nil,
+		},
+		{
+			name:      "amd64 image on arm64",
+			arch:      "arm64",
+			imageArch: []string{"amd64"},
+			wantErr:   "kind node image public.ecr.aws/eks-anywhere/kubernetes-sigs/kind/node:v1.19.8-eks-d-1-19-4 for Kubernetes 1.19 is not available for the arm64 architecture of the admin machine, available architectures: [amd64]",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clusterSpec := test.NewClusterSpec(func(s *cluster.Spec) {
+				s.VersionsBundles["1.19"].EksD.KindNode = releasev1alpha1.Image{
+					URI:  "public.ecr.aws/eks-anywhere/kubernetes-sigs/kind/node:v1.19.8-eks-d-1-19-4",
+					Arch: tt.imageArch,
+				}
+			})
+
+			err := docker.ValidateNodeImagesArch(clusterSpec, tt.arch)
+			if tt.wantErr == "" && err != nil {
+				t.Errorf("Got err %v, wanted nil", err)
+			}
+			if tt.wantErr != "" && (err == nil || err.Error() != tt.wantErr) {
+				t.Errorf("Got err %v, wanted %v", err, tt.wantErr)
+			}
+		})
+	}
+}
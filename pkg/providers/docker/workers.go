@@ -25,6 +25,19 @@ type (
 // It talks to the cluster with a client to detect changes in immutable objects and generates new
 // names for them.
 func WorkersSpec(ctx context.Context, logger logr.Logger, client kubernetes.Client, spec *cluster.Spec) (*Workers, error) {
+	workers, err := workersSpecWithInitialNames(logger, spec)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = workers.UpdateImmutableObjectNames(ctx, client, GetMachineTemplate, MachineTemplateEqual); err != nil {
+		return nil, errors.Wrap(err, "updating docker worker immutable object names")
+	}
+
+	return workers, nil
+}
+
+func workersSpecWithInitialNames(logger logr.Logger, spec *cluster.Spec) (*Workers, error) {
 	templateBuilder := NewDockerTemplateBuilder(time.Now)
 	workersYaml, err := templateBuilder.CAPIWorkersSpecWithInitialNames(spec)
 	if err != nil {
@@ -40,12 +53,7 @@ func WorkersSpec(ctx context.Context, logger logr.Logger, client kubernetes.Clie
 		return nil, errors.Wrap(err, "parsing docker CAPI workers yaml")
 	}
 
-	workers := builder.Workers
-	if err = workers.UpdateImmutableObjectNames(ctx, client, GetMachineTemplate, MachineTemplateEqual); err != nil {
-		return nil, errors.Wrap(err, "updating docker worker immutable object names")
-	}
-
-	return workers, nil
+	return builder.Workers, nil
 }
 
 func newWorkersParserAndBuilder(logger logr.Logger) (*yamlutil.Parser, *workersBuilder, error) {
@@ -0,0 +1,143 @@
+package harvester
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/clientcmd"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var (
+	// networkAttachmentDefinitionGVK is the kind Harvester VM networks are stored as.
+	networkAttachmentDefinitionGVK = schema.GroupVersionKind{Group: "k8s.cni.cncf.io", Version: "v1", Kind: "NetworkAttachmentDefinition"}
+	virtualMachineImageGVK         = schema.GroupVersionKind{Group: "harvesterhci.io", Version: "v1beta1", Kind: "VirtualMachineImage"}
+)
+
+const imageImportedCondition = "Imported"
+
+// Image is a Harvester VM image.
+type Image struct {
+	Name     string
+	Imported bool
+	// Message is the message of the Imported condition, set when the import failed.
+	Message string
+}
+
+// Client is a minimal Harvester API client used to validate the environment and upload images.
+type Client interface {
+	// Server returns the URL of the Harvester cluster API the client talks to.
+	Server() string
+	NamespaceExists(ctx context.Context, name string) (bool, error)
+	NetworkExists(ctx context.Context, namespace, name string) (bool, error)
+	// GetImage returns the image with the given name, or nil if it doesn't exist.
+	GetImage(ctx context.Context, namespace, name string) (*Image, error)
+	// CreateImage creates an image Harvester downloads from url.
+	CreateImage(ctx context.Context, namespace, name, url string) error
+}
+
+type apiClient struct {
+	server string
+	client client.Client
+}
+
+// NewClient returns a Harvester API client authenticated with the given kubeconfig.
+// Harvester objects are handled as unstructured since its Go API is not vendored.
+func NewClient(kubeconfig []byte) (Client, error) {
+	restConfig, err := clientcmd.RESTConfigFromKubeConfig(kubeconfig)
+	if err != nil {
+		return nil, fmt.Errorf("parsing harvester kubeconfig: %v", err)
+	}
+
+	scheme := runtime.NewScheme()
+	if err := clientgoscheme.AddToScheme(scheme); err != nil {
+		return nil, err
+	}
+
+	c, err := client.New(restConfig, client.Options{Scheme: scheme})
+	if err != nil {
+		return nil, fmt.Errorf("building harvester client: %v", err)
+	}
+
+	return &apiClient{
+		server: restConfig.Host,
+		client: c,
+	}, nil
+}
+
+// Server returns the URL of the Harvester cluster API.
+func (c *apiClient) Server() string {
+	return c.server
+}
+
+// NamespaceExists returns whether the namespace exists in the Harvester cluster.
+func (c *apiClient) NamespaceExists(ctx context.Context, name string) (bool, error) {
+	return c.exists(ctx, client.ObjectKey{Name: name}, &corev1.Namespace{})
+}
+
+// NetworkExists returns whether the VM network exists in the Harvester cluster.
+func (c *apiClient) NetworkExists(ctx context.Context, namespace, name string) (bool, error) {
+	network := &unstructured.Unstructured{}
+	network.SetGroupVersionKind(networkAttachmentDefinitionGVK)
+	return c.exists(ctx, client.ObjectKey{Namespace: namespace, Name: name}, network)
+}
+
+// GetImage returns the VM image with the given name, or nil if it doesn't exist.
+func (c *apiClient) GetImage(ctx context.Context, namespace, name string) (*Image, error) {
+	u := &unstructured.Unstructured{}
+	u.SetGroupVersionKind(virtualMachineImageGVK)
+	err := c.client.Get(ctx, client.ObjectKey{Namespace: namespace, Name: name}, u)
+	if apierrors.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	image := &Image{Name: name}
+	conditions, _, _ := unstructured.NestedSlice(u.Object, "status", "conditions")
+	for _, c := range conditions {
+		condition, ok := c.(map[string]interface{})
+		if !ok || condition["type"] != imageImportedCondition {
+			continue
+		}
+		image.Imported = condition["status"] == string(metav1.ConditionTrue)
+		image.Message, _ = condition["message"].(string)
+	}
+
+	return image, nil
+}
+
+// CreateImage creates a VM image Harvester downloads from url.
+func (c *apiClient) CreateImage(ctx context.Context, namespace, name, url string) error {
+	u := &unstructured.Unstructured{}
+	u.SetGroupVersionKind(virtualMachineImageGVK)
+	u.SetNamespace(namespace)
+	u.SetName(name)
+	u.Object["spec"] = map[string]interface{}{
+		"displayName": name,
+		"sourceType":  "download",
+		"url":         url,
+	}
+
+	return c.client.Create(ctx, u)
+}
+
+func (c *apiClient) exists(ctx context.Context, key client.ObjectKey, obj client.Object) (bool, error) {
+	err := c.client.Get(ctx, key, obj)
+	if apierrors.IsNotFound(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
@@ -0,0 +1,23 @@
+package harvester
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/aws/eks-anywhere/pkg/constants"
+)
+
+// GetKubeconfigFromEnv returns the contents of the Harvester kubeconfig file pointed to by the environment.
+func GetKubeconfigFromEnv() ([]byte, error) {
+	path, ok := os.LookupEnv(constants.EksaHarvesterKubeconfigKey)
+	if !ok || len(path) == 0 {
+		return nil, fmt.Errorf("%s is not set or is empty", constants.EksaHarvesterKubeconfigKey)
+	}
+
+	kubeconfig, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading harvester kubeconfig %s: %v", path, err)
+	}
+
+	return kubeconfig, nil
+}
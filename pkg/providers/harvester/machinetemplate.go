@@ -0,0 +1,49 @@
+package harvester
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/aws/eks-anywhere/pkg/clients/kubernetes"
+)
+
+const (
+	harvesterClusterKind         = "HarvesterCluster"
+	harvesterMachineTemplateKind = "HarvesterMachineTemplate"
+)
+
+// infrastructureGroupVersion is the API group version of the CAPI Harvester provider (CAPHV) objects.
+// CAPHV objects are handled as unstructured since its Go API is not vendored.
+var infrastructureGroupVersion = schema.GroupVersion{Group: "infrastructure.cluster.x-k8s.io", Version: "v1alpha1"}
+
+func newHarvesterCluster() *unstructured.Unstructured {
+	u := &unstructured.Unstructured{}
+	u.SetGroupVersionKind(infrastructureGroupVersion.WithKind(harvesterClusterKind))
+	return u
+}
+
+func newHarvesterMachineTemplate() *unstructured.Unstructured {
+	u := &unstructured.Unstructured{}
+	u.SetGroupVersionKind(infrastructureGroupVersion.WithKind(harvesterMachineTemplateKind))
+	return u
+}
+
+// GetMachineTemplate gets an HarvesterMachineTemplate object using the provided client
+// If the object doesn't exist, it returns a NotFound error.
+func GetMachineTemplate(ctx context.Context, client kubernetes.Client, name, namespace string) (*unstructured.Unstructured, error) {
+	m := newHarvesterMachineTemplate()
+	if err := client.Get(ctx, name, namespace, m); err != nil {
+		return nil, errors.Wrap(err, "reading harvesterMachineTemplate")
+	}
+
+	return m, nil
+}
+
+// MachineTemplateEqual returns a boolean indicating whether or not the provided HarvesterMachineTemplates are equal.
+func MachineTemplateEqual(new, old *unstructured.Unstructured) bool {
+	return equality.Semantic.DeepDerivative(new.Object["spec"], old.Object["spec"])
+}
@@ -0,0 +1,39 @@
+package harvester
+
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/aws/eks-anywhere/pkg/constants"
+)
+
+// identitySecretKubeconfigKey is the key of the identity secret CAPHV reads the Harvester kubeconfig from.
+const identitySecretKubeconfigKey = "kubeconfig"
+
+// IdentitySecretName returns the name of the secret holding the kubeconfig CAPHV uses
+// to talk to the Harvester cluster for a cluster.
+func IdentitySecretName(clusterName string) string {
+	return fmt.Sprintf("%s-harvester-identity", clusterName)
+}
+
+// IdentitySecret builds the identity secret the HarvesterCluster of a cluster points to.
+func IdentitySecret(clusterName string, kubeconfig []byte) *corev1.Secret {
+	return &corev1.Secret{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       constants.SecretKind,
+			APIVersion: corev1.SchemeGroupVersion.Version,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      IdentitySecretName(clusterName),
+			Namespace: constants.EksaSystemNamespace,
+			Labels: map[string]string{
+				constants.ClusterctlMoveLabelName: "true",
+			},
+		},
+		Data: map[string][]byte{
+			identitySecretKubeconfigKey: kubeconfig,
+		},
+	}
+}
@@ -0,0 +1,19 @@
+package harvester
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/aws/eks-anywhere/pkg/constants"
+)
+
+func TestIdentitySecret(t *testing.T) {
+	g := NewWithT(t)
+
+	secret := IdentitySecret("test", []byte("kubeconfig-content"))
+	g.Expect(secret.Name).To(Equal("test-harvester-identity"))
+	g.Expect(secret.Namespace).To(Equal(constants.EksaSystemNamespace))
+	g.Expect(secret.Labels).To(HaveKeyWithValue(constants.ClusterctlMoveLabelName, "true"))
+	g.Expect(secret.Data).To(HaveKeyWithValue("kubeconfig", []byte("kubeconfig-content")))
+}
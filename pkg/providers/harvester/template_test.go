@@ -0,0 +1,189 @@
+package harvester
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/yaml"
+
+	"github.com/aws/eks-anywhere/internal/test"
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/cluster"
+)
+
+func harvesterClusterSpec() *cluster.Spec {
+	return test.NewClusterSpec(func(s *cluster.Spec) {
+		s.Cluster.Name = "test"
+		s.Cluster.Spec.ControlPlaneConfiguration = anywherev1.ControlPlaneConfiguration{
+			Count:    1,
+			Endpoint: &anywherev1.Endpoint{Host: "10.0.0.5"},
+			MachineGroupRef: &anywherev1.Ref{
+				Kind: anywherev1.HarvesterMachineConfigKind,
+				Name: "test-cp",
+			},
+		}
+		s.Cluster.Spec.WorkerNodeGroupConfigurations = []anywherev1.WorkerNodeGroupConfiguration{
+			{
+				Name:  "md-0",
+				Count: ptrInt(2),
+				MachineGroupRef: &anywherev1.Ref{
+					Kind: anywherev1.HarvesterMachineConfigKind,
+					Name: "test-md",
+				},
+			},
+		}
+		s.Cluster.Spec.DatacenterRef = anywherev1.Ref{
+			Kind: anywherev1.HarvesterDatacenterKind,
+			Name: "test",
+		}
+		s.Cluster.Spec.ClusterNetwork = anywherev1.ClusterNetwork{
+			Pods:     anywherev1.Pods{CidrBlocks: []string{"192.168.0.0/16"}},
+			Services: anywherev1.Services{CidrBlocks: []string{"10.96.0.0/12"}},
+		}
+		s.HarvesterDatacenter = &anywherev1.HarvesterDatacenterConfig{
+			Spec: anywherev1.HarvesterDatacenterConfigSpec{
+				Server:    "https://harvester.example.com:6443",
+				Namespace: "eksa",
+			},
+		}
+		s.HarvesterMachineConfigs = map[string]*anywherev1.HarvesterMachineConfig{
+			"test-cp": harvesterMachineConfig("test-cp", 4),
+			"test-md": harvesterMachineConfig("test-md", 8),
+		}
+		s.VersionsBundles[anywherev1.Kube119].KubeDistro.Kubernetes.Tag = "v1.19.8-eks-1-19-4"
+	})
+}
+
+func harvesterMachineConfig(name string, cpu int32) *anywherev1.HarvesterMachineConfig {
+	m := &anywherev1.HarvesterMachineConfig{
+		Spec: anywherev1.HarvesterMachineConfigSpec{
+			CPU:      cpu,
+			Image:    "ubuntu-kube-v1-19",
+			Networks: []string{"eksa-net"},
+			Users: []anywherev1.UserConfiguration{
+				{Name: "eksa", SshAuthorizedKeys: []string{"ssh-rsa AAAA"}},
+			},
+		},
+	}
+	m.Name = name
+	m.SetDefaults()
+	return m
+}
+
+func ptrInt(i int) *int {
+	return &i
+}
+
+func parseObjects(t *testing.T, content []byte) map[string]*unstructured.Unstructured {
+	t.Helper()
+	objs := map[string]*unstructured.Unstructured{}
+	for _, doc := range strings.Split(string(content), "\n---\n") {
+		if strings.TrimSpace(doc) == "" {
+			continue
+		}
+		json, err := yaml.YAMLToJSON([]byte(doc))
+		if err != nil {
+			t.Fatalf("parsing generated yaml: %v", err)
+		}
+		u := &unstructured.Unstructured{}
+		if err := u.UnmarshalJSON(json); err != nil {
+			t.Fatalf("parsing generated yaml: %v", err)
+		}
+		objs[u.GetKind()+"/"+u.GetName()] = u
+	}
+	return objs
+}
+
+func TestTemplateBuilderGenerateCAPISpecControlPlane(t *testing.T) {
+	g := NewWithT(t)
+	builder := NewTemplateBuilder(time.Now)
+
+	content, err := builder.GenerateCAPISpecControlPlane(harvesterClusterSpec())
+	g.Expect(err).NotTo(HaveOccurred())
+
+	objs := parseObjects(t, content)
+	g.Expect(objs).To(HaveKey("Cluster/test"))
+	g.Expect(objs).To(HaveKey("KubeadmControlPlane/test"))
+
+	harvesterCluster := objs["HarvesterCluster/test"]
+	g.Expect(harvesterCluster).NotTo(BeNil())
+	host, _, _ := unstructured.NestedString(harvesterCluster.Object, "spec", "controlPlaneEndpoint", "host")
+	g.Expect(host).To(Equal("10.0.0.5"))
+	secretName, _, _ := unstructured.NestedString(harvesterCluster.Object, "spec", "identitySecret", "name")
+	g.Expect(secretName).To(Equal("test-harvester-identity"))
+	server, _, _ := unstructured.NestedString(harvesterCluster.Object, "spec", "server")
+	g.Expect(server).To(Equal("https://harvester.example.com:6443"))
+	targetNamespace, _, _ := unstructured.NestedString(harvesterCluster.Object, "spec", "targetNamespace")
+	g.Expect(targetNamespace).To(Equal("eksa"))
+
+	var machineTemplate *unstructured.Unstructured
+	for key, obj := range objs {
+		if strings.HasPrefix(key, "HarvesterMachineTemplate/") {
+			machineTemplate = obj
+		}
+	}
+	g.Expect(machineTemplate).NotTo(BeNil())
+	cpu, _, _ := unstructured.NestedInt64(machineTemplate.Object, "spec", "template", "spec", "cpu")
+	g.Expect(cpu).To(Equal(int64(4)))
+	memory, _, _ := unstructured.NestedString(machineTemplate.Object, "spec", "template", "spec", "memory")
+	g.Expect(memory).To(Equal("4Gi"))
+	sshUser, _, _ := unstructured.NestedString(machineTemplate.Object, "spec", "template", "spec", "sshUser")
+	g.Expect(sshUser).To(Equal("eksa"))
+	volumes, _, _ := unstructured.NestedSlice(machineTemplate.Object, "spec", "template", "spec", "volumes")
+	g.Expect(volumes).To(Equal([]interface{}{map[string]interface{}{
+		"volumeType": "image",
+		"imageName":  "eksa/ubuntu-kube-v1-19",
+		"volumeSize": "40Gi",
+		"bootOrder":  int64(0),
+	}}))
+}
+
+func TestTemplateBuilderGenerateCAPISpecControlPlaneNetworks(t *testing.T) {
+	g := NewWithT(t)
+	spec := harvesterClusterSpec()
+	spec.HarvesterMachineConfigs["test-cp"].Spec.Networks = []string{"eksa-net", "vm-networks/storage-net"}
+
+	content, err := NewTemplateBuilder(time.Now).GenerateCAPISpecControlPlane(spec)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	for key, obj := range parseObjects(t, content) {
+		if !strings.HasPrefix(key, "HarvesterMachineTemplate/") {
+			continue
+		}
+		networks, _, _ := unstructured.NestedStringSlice(obj.Object, "spec", "template", "spec", "networks")
+		g.Expect(networks).To(Equal([]string{"eksa/eksa-net", "vm-networks/storage-net"}))
+	}
+}
+
+func TestTemplateBuilderGenerateCAPISpecControlPlaneMissingMachineConfig(t *testing.T) {
+	g := NewWithT(t)
+	spec := harvesterClusterSpec()
+	delete(spec.HarvesterMachineConfigs, "test-cp")
+
+	_, err := NewTemplateBuilder(time.Now).GenerateCAPISpecControlPlane(spec)
+	g.Expect(err).To(HaveOccurred())
+}
+
+func TestTemplateBuilderCAPIWorkersSpecWithInitialNames(t *testing.T) {
+	g := NewWithT(t)
+
+	content, err := NewTemplateBuilder(time.Now).CAPIWorkersSpecWithInitialNames(harvesterClusterSpec())
+	g.Expect(err).NotTo(HaveOccurred())
+
+	objs := parseObjects(t, content)
+	md := objs["MachineDeployment/test-md-0"]
+	g.Expect(md).NotTo(BeNil())
+	replicas, _, _ := unstructured.NestedInt64(md.Object, "spec", "replicas")
+	g.Expect(replicas).To(Equal(int64(2)))
+
+	machineTemplate := objs["HarvesterMachineTemplate/test-md-0-1"]
+	g.Expect(machineTemplate).NotTo(BeNil())
+	cpu, _, _ := unstructured.NestedInt64(machineTemplate.Object, "spec", "template", "spec", "cpu")
+	g.Expect(cpu).To(Equal(int64(8)))
+	networks, _, _ := unstructured.NestedStringSlice(machineTemplate.Object, "spec", "template", "spec", "networks")
+	g.Expect(networks).To(Equal([]string{"eksa/eksa-net"}))
+	g.Expect(objs).To(HaveKey("KubeadmConfigTemplate/test-md-0-1"))
+}
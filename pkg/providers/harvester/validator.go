@@ -0,0 +1,146 @@
+package harvester
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/cluster"
+	"github.com/aws/eks-anywhere/pkg/logger"
+	"github.com/aws/eks-anywhere/pkg/retrier"
+)
+
+const (
+	imageImportMaxRetries = 120
+	imageImportBackOff    = 15 * time.Second
+)
+
+// IPValidator is an interface that defines methods to validate the control plane IP.
+type IPValidator interface {
+	ValidateControlPlaneIPUniqueness(cluster *anywherev1.Cluster) error
+}
+
+// Validator validates a cluster spec against a Harvester cluster.
+type Validator struct {
+	client  Client
+	retrier *retrier.Retrier
+}
+
+// NewValidator returns a new Validator.
+func NewValidator(client Client) *Validator {
+	return &Validator{
+		client:  client,
+		retrier: retrier.NewWithMaxRetries(imageImportMaxRetries, imageImportBackOff),
+	}
+}
+
+// ValidateClusterSpec validates the cluster spec against the Harvester APIs. VM images that
+// don't exist yet are uploaded from their imageURL, if set.
+func (v *Validator) ValidateClusterSpec(ctx context.Context, spec *cluster.Spec) error {
+	if err := ValidateClusterConfig(spec); err != nil {
+		return err
+	}
+
+	datacenter := spec.HarvesterDatacenter
+	if strings.TrimSuffix(v.client.Server(), "/") != strings.TrimSuffix(datacenter.Spec.Server, "/") {
+		return fmt.Errorf("HarvesterDatacenterConfig server %s does not match the server %s of the harvester kubeconfig", datacenter.Spec.Server, v.client.Server())
+	}
+
+	exists, err := v.client.NamespaceExists(ctx, datacenter.Spec.Namespace)
+	if err != nil {
+		return fmt.Errorf("getting harvester namespace %s: %v", datacenter.Spec.Namespace, err)
+	}
+
+	if !exists {
+		return fmt.Errorf("harvester namespace %s not found", datacenter.Spec.Namespace)
+	}
+
+	for _, machineConfig := range spec.HarvesterMachineConfigs {
+		for _, network := range machineConfig.Spec.Networks {
+			namespace, name := networkKey(datacenter.Spec.Namespace, network)
+			exists, err := v.client.NetworkExists(ctx, namespace, name)
+			if err != nil {
+				return fmt.Errorf("getting harvester network %s/%s: %v", namespace, name, err)
+			}
+
+			if !exists {
+				return fmt.Errorf("network %s/%s of HarvesterMachineConfig %s not found", namespace, name, machineConfig.Name)
+			}
+		}
+
+		if err := v.ensureImage(ctx, datacenter.Spec.Namespace, machineConfig); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (v *Validator) ensureImage(ctx context.Context, namespace string, machineConfig *anywherev1.HarvesterMachineConfig) error {
+	imageName := machineConfig.Spec.Image
+	image, err := v.client.GetImage(ctx, namespace, imageName)
+	if err != nil {
+		return fmt.Errorf("getting harvester image %s/%s: %v", namespace, imageName, err)
+	}
+
+	if image == nil {
+		if machineConfig.Spec.ImageURL == "" {
+			return fmt.Errorf("image %s/%s of HarvesterMachineConfig %s not found and no imageURL is set to upload it from", namespace, imageName, machineConfig.Name)
+		}
+
+		logger.Info("Uploading harvester image", "image", imageName, "url", machineConfig.Spec.ImageURL)
+		if err := v.client.CreateImage(ctx, namespace, imageName, machineConfig.Spec.ImageURL); err != nil {
+			return fmt.Errorf("creating harvester image %s/%s: %v", namespace, imageName, err)
+		}
+	}
+
+	err = v.retrier.Retry(func() error {
+		image, err := v.client.GetImage(ctx, namespace, imageName)
+		if err != nil {
+			return err
+		}
+
+		if image == nil || !image.Imported {
+			return fmt.Errorf("image %s/%s is not imported yet", namespace, imageName)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("waiting for harvester image %s/%s of HarvesterMachineConfig %s to be imported: %v", namespace, imageName, machineConfig.Name, err)
+	}
+
+	return nil
+}
+
+// networkKey returns the namespace and name of a VM network referenced either as
+// namespace/name or as a name in the default namespace.
+func networkKey(defaultNamespace, network string) (namespace, name string) {
+	if ns, n, ok := strings.Cut(network, "/"); ok {
+		return ns, n
+	}
+
+	return defaultNamespace, network
+}
+
+// ValidateClusterConfig runs the validations that don't require access to the Harvester APIs.
+func ValidateClusterConfig(spec *cluster.Spec) error {
+	if spec.Cluster.Spec.ExternalEtcdConfiguration != nil {
+		return errors.New("external etcd is not supported for harvester clusters")
+	}
+
+	if spec.Cluster.Spec.ControlPlaneConfiguration.Endpoint == nil || spec.Cluster.Spec.ControlPlaneConfiguration.Endpoint.Host == "" {
+		return errors.New("cluster controlPlaneConfiguration.Endpoint.Host is not set or is empty")
+	}
+
+	endpoint := net.ParseIP(spec.Cluster.Spec.ControlPlaneConfiguration.Endpoint.Host)
+	if endpoint == nil || endpoint.To4() == nil {
+		return fmt.Errorf("cluster controlPlaneConfiguration.Endpoint.Host %s is not a valid IPv4 address", spec.Cluster.Spec.ControlPlaneConfiguration.Endpoint.Host)
+	}
+
+	return nil
+}
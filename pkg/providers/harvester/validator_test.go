@@ -0,0 +1,207 @@
+package harvester
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/retrier"
+)
+
+type fakeClient struct {
+	server     string
+	namespaces map[string]bool
+	networks   map[string]bool
+	images     map[string]*Image
+	created    map[string]string
+	getErr     error
+	// importAfter is the number of GetImage calls after which created images are imported.
+	importAfter int
+	gets        int
+}
+
+func newFakeClient() *fakeClient {
+	return &fakeClient{
+		server:     "https://harvester.example.com:6443",
+		namespaces: map[string]bool{"eksa": true},
+		networks:   map[string]bool{"eksa/eksa-net": true},
+		images: map[string]*Image{
+			"eksa/ubuntu-kube-v1-19": {Name: "ubuntu-kube-v1-19", Imported: true},
+		},
+		created: map[string]string{},
+	}
+}
+
+func (c *fakeClient) Server() string {
+	return c.server
+}
+
+func (c *fakeClient) NamespaceExists(_ context.Context, name string) (bool, error) {
+	return c.namespaces[name], nil
+}
+
+func (c *fakeClient) NetworkExists(_ context.Context, namespace, name string) (bool, error) {
+	return c.networks[namespace+"/"+name], nil
+}
+
+func (c *fakeClient) GetImage(_ context.Context, namespace, name string) (*Image, error) {
+	if c.getErr != nil {
+		return nil, c.getErr
+	}
+
+	c.gets++
+	image := c.images[namespace+"/"+name]
+	if image != nil && !image.Imported && c.gets > c.importAfter {
+		image.Imported = true
+	}
+
+	return image, nil
+}
+
+func (c *fakeClient) CreateImage(_ context.Context, namespace, name, url string) error {
+	c.created[namespace+"/"+name] = url
+	c.images[namespace+"/"+name] = &Image{Name: name}
+	return nil
+}
+
+func newTestValidator(client Client) *Validator {
+	v := NewValidator(client)
+	v.retrier = retrier.NewWithMaxRetries(3, 0)
+	return v
+}
+
+func TestValidatorValidateClusterSpecSuccess(t *testing.T) {
+	g := NewWithT(t)
+	client := newFakeClient()
+
+	g.Expect(newTestValidator(client).ValidateClusterSpec(context.Background(), harvesterClusterSpec())).To(Succeed())
+	g.Expect(client.created).To(BeEmpty())
+}
+
+func TestValidatorValidateClusterSpecUploadsImage(t *testing.T) {
+	g := NewWithT(t)
+	client := newFakeClient()
+	client.importAfter = 2
+	spec := harvesterClusterSpec()
+	spec.HarvesterMachineConfigs["test-md"].Spec.Image = "ubuntu-new"
+	spec.HarvesterMachineConfigs["test-md"].Spec.ImageURL = "https://images.example.com/ubuntu.img"
+
+	g.Expect(newTestValidator(client).ValidateClusterSpec(context.Background(), spec)).To(Succeed())
+	g.Expect(client.created).To(Equal(map[string]string{"eksa/ubuntu-new": "https://images.example.com/ubuntu.img"}))
+}
+
+func TestValidatorValidateClusterSpecErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		update  func(*fakeClient, *anywherev1.HarvesterDatacenterConfig, map[string]*anywherev1.HarvesterMachineConfig)
+		wantErr string
+	}{
+		{
+			name: "server mismatch",
+			update: func(c *fakeClient, _ *anywherev1.HarvesterDatacenterConfig, _ map[string]*anywherev1.HarvesterMachineConfig) {
+				c.server = "https://other.example.com:6443"
+			},
+			wantErr: "HarvesterDatacenterConfig server https://harvester.example.com:6443 does not match the server https://other.example.com:6443 of the harvester kubeconfig",
+		},
+		{
+			name: "namespace not found",
+			update: func(_ *fakeClient, dc *anywherev1.HarvesterDatacenterConfig, _ map[string]*anywherev1.HarvesterMachineConfig) {
+				dc.Spec.Namespace = "other"
+			},
+			wantErr: "harvester namespace other not found",
+		},
+		{
+			name: "network not found",
+			update: func(_ *fakeClient, _ *anywherev1.HarvesterDatacenterConfig, m map[string]*anywherev1.HarvesterMachineConfig) {
+				m["test-md"].Spec.Networks = []string{"vm-networks/other-net"}
+			},
+			wantErr: "network vm-networks/other-net of HarvesterMachineConfig test-md not found",
+		},
+		{
+			name: "image not found",
+			update: func(_ *fakeClient, _ *anywherev1.HarvesterDatacenterConfig, m map[string]*anywherev1.HarvesterMachineConfig) {
+				m["test-md"].Spec.Image = "missing"
+			},
+			wantErr: "image eksa/missing of HarvesterMachineConfig test-md not found and no imageURL is set to upload it from",
+		},
+		{
+			name: "image not imported",
+			update: func(c *fakeClient, _ *anywherev1.HarvesterDatacenterConfig, _ map[string]*anywherev1.HarvesterMachineConfig) {
+				c.images["eksa/ubuntu-kube-v1-19"].Imported = false
+				c.importAfter = 10
+			},
+			wantErr: "waiting for harvester image eksa/ubuntu-kube-v1-19 of HarvesterMachineConfig test-",
+		},
+		{
+			name: "get image error",
+			update: func(c *fakeClient, _ *anywherev1.HarvesterDatacenterConfig, _ map[string]*anywherev1.HarvesterMachineConfig) {
+				c.getErr = errors.New("forbidden")
+			},
+			wantErr: "getting harvester image eksa/ubuntu-kube-v1-19: forbidden",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			client := newFakeClient()
+			spec := harvesterClusterSpec()
+			tt.update(client, spec.HarvesterDatacenter, spec.HarvesterMachineConfigs)
+
+			err := newTestValidator(client).ValidateClusterSpec(context.Background(), spec)
+			g.Expect(err).To(MatchError(ContainSubstring(tt.wantErr)))
+		})
+	}
+}
+
+func TestValidateClusterConfig(t *testing.T) {
+	tests := []struct {
+		name    string
+		update  func(*anywherev1.Cluster)
+		wantErr string
+	}{
+		{
+			name:   "valid",
+			update: func(*anywherev1.Cluster) {},
+		},
+		{
+			name: "external etcd",
+			update: func(c *anywherev1.Cluster) {
+				c.Spec.ExternalEtcdConfiguration = &anywherev1.ExternalEtcdConfiguration{Count: 3}
+			},
+			wantErr: "external etcd is not supported for harvester clusters",
+		},
+		{
+			name: "endpoint not set",
+			update: func(c *anywherev1.Cluster) {
+				c.Spec.ControlPlaneConfiguration.Endpoint = nil
+			},
+			wantErr: "cluster controlPlaneConfiguration.Endpoint.Host is not set or is empty",
+		},
+		{
+			name: "endpoint not an ip",
+			update: func(c *anywherev1.Cluster) {
+				c.Spec.ControlPlaneConfiguration.Endpoint.Host = "cp.example.com"
+			},
+			wantErr: "cluster controlPlaneConfiguration.Endpoint.Host cp.example.com is not a valid IPv4 address",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			spec := harvesterClusterSpec()
+			tt.update(spec.Cluster)
+
+			err := ValidateClusterConfig(spec)
+			if tt.wantErr == "" {
+				g.Expect(err).NotTo(HaveOccurred())
+			} else {
+				g.Expect(err).To(MatchError(tt.wantErr))
+			}
+		})
+	}
+}
@@ -5,11 +5,14 @@ import (
 	"encoding/pem"
 	"fmt"
 	"net"
+	"net/url"
 	"strconv"
+	"strings"
 
 	prismgoclient "github.com/nutanix-cloud-native/prism-go-client"
 	"github.com/nutanix-cloud-native/prism-go-client/environment/credentials"
 	v3 "github.com/nutanix-cloud-native/prism-go-client/v3"
+	"golang.org/x/net/http/httpproxy"
 
 	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
 )
@@ -45,6 +48,11 @@ func (cb *ClientCache) GetNutanixClient(datacenterConfig *anywherev1.NutanixData
 		clientOpts = append(clientOpts, v3.WithCertificate(certs[0]))
 	}
 
+	proxyURL, err := prismCentralProxyURL(datacenterConfig.Spec)
+	if err != nil {
+		return nil, err
+	}
+
 	endpoint := datacenterConfig.Spec.Endpoint
 	port := datacenterConfig.Spec.Port
 	url := net.JoinHostPort(endpoint, strconv.Itoa(port))
@@ -55,6 +63,7 @@ func (cb *ClientCache) GetNutanixClient(datacenterConfig *anywherev1.NutanixData
 		Endpoint: endpoint,
 		Port:     fmt.Sprintf("%d", port),
 		Insecure: datacenterConfig.Spec.Insecure,
+		ProxyURL: proxyURL,
 	}
 
 	client, err := v3.NewV3Client(nutanixCreds, clientOpts...)
@@ -65,3 +74,27 @@ func (cb *ClientCache) GetNutanixClient(datacenterConfig *anywherev1.NutanixData
 	cb.clients[datacenterConfig.Name] = client.V3
 	return client.V3, nil
 }
+
+// prismCentralProxyURL returns the provider proxy to use for Prism Central calls, or an empty
+// string when no proxy is configured or the endpoint is excluded by noProxy.
+func prismCentralProxyURL(spec anywherev1.NutanixDatacenterConfigSpec) (string, error) {
+	proxy := spec.ProviderProxyConfiguration
+	if proxy == nil {
+		return "", nil
+	}
+
+	proxyConfig := &httpproxy.Config{
+		HTTPSProxy: proxy.HttpsProxy,
+		NoProxy:    strings.Join(proxy.NoProxy, ","),
+	}
+	endpoint := &url.URL{Scheme: "https", Host: net.JoinHostPort(spec.Endpoint, strconv.Itoa(spec.Port))}
+	proxyURL, err := proxyConfig.ProxyFunc()(endpoint)
+	if err != nil {
+		return "", fmt.Errorf("invalid provider proxy for prism central: %v", err)
+	}
+	if proxyURL == nil {
+		return "", nil
+	}
+
+	return proxyURL.String(), nil
+}
@@ -22,3 +22,45 @@ func TestNewClientCache(t *testing.T) {
 	assert.NoError(t, err)
 	assert.NotNil(t, c)
 }
+
+func TestPrismCentralProxyURL(t *testing.T) {
+	tests := []struct {
+		name    string
+		proxy   *anywherev1.ProxyConfiguration
+		wantURL string
+	}{
+		{
+			name:    "no proxy configured",
+			wantURL: "",
+		},
+		{
+			name: "proxy configured",
+			proxy: &anywherev1.ProxyConfiguration{
+				HttpProxy:  "http://10.0.0.1:3128",
+				HttpsProxy: "http://10.0.0.1:3129",
+			},
+			wantURL: "http://10.0.0.1:3129",
+		},
+		{
+			name: "endpoint excluded by noProxy",
+			proxy: &anywherev1.ProxyConfiguration{
+				HttpProxy:  "http://10.0.0.1:3128",
+				HttpsProxy: "http://10.0.0.1:3129",
+				NoProxy:    []string{".nutanix.example.com"},
+			},
+			wantURL: "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			spec := anywherev1.NutanixDatacenterConfigSpec{
+				Endpoint:                   "prism.nutanix.example.com",
+				Port:                       9440,
+				ProviderProxyConfiguration: tt.proxy,
+			}
+			proxyURL, err := prismCentralProxyURL(spec)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantURL, proxyURL)
+		})
+	}
+}
@@ -0,0 +1,93 @@
+package nutanix
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/nutanix-cloud-native/prism-go-client/environment/credentials"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/constants"
+)
+
+// CredentialsValidator validates the Prism Central credentials stored in the management cluster.
+type CredentialsValidator struct {
+	client    client.Client
+	newClient func(*anywherev1.NutanixDatacenterConfig, credentials.BasicAuthCredential) (Client, error)
+}
+
+// NewCredentialsValidator returns a new CredentialsValidator.
+func NewCredentialsValidator(client client.Client) *CredentialsValidator {
+	return &CredentialsValidator{
+		client: client,
+		// The ClientCache keeps the first credentials for each datacenter, so every validation
+		// builds its own to pick up rotated credentials.
+		newClient: func(datacenter *anywherev1.NutanixDatacenterConfig, creds credentials.BasicAuthCredential) (Client, error) {
+			return NewClientCache().GetNutanixClient(datacenter, creds)
+		},
+	}
+}
+
+// Secret returns the Secret referenced by the NutanixDatacenterConfig of the cluster.
+func (v *CredentialsValidator) Secret(ctx context.Context, cluster *anywherev1.Cluster) (*corev1.Secret, error) {
+	datacenter, err := v.datacenter(ctx, cluster)
+	if err != nil {
+		return nil, err
+	}
+
+	name := constants.NutanixCredentialsName
+	if datacenter.Spec.CredentialRef != nil {
+		name = datacenter.Spec.CredentialRef.Name
+	}
+
+	secret := &corev1.Secret{}
+	key := client.ObjectKey{Namespace: constants.EksaSystemNamespace, Name: name}
+	if err := v.client.Get(ctx, key, secret); err != nil {
+		return nil, fmt.Errorf("reading Nutanix credentials Secret %s: %w", key, err)
+	}
+
+	return secret, nil
+}
+
+// Validate gets the current Prism Central user with the credentials in secret. Prism Central
+// doesn't report when the credentials expire, so no expiration is returned.
+func (v *CredentialsValidator) Validate(ctx context.Context, cluster *anywherev1.Cluster, secret *corev1.Secret) (*time.Time, error) {
+	datacenter, err := v.datacenter(ctx, cluster)
+	if err != nil {
+		return nil, err
+	}
+
+	creds, err := credentials.ParseCredentials(secret.Data["credentials"])
+	if err != nil {
+		return nil, fmt.Errorf("parsing Nutanix credentials: %v", err)
+	}
+
+	prismClient, err := v.newClient(datacenter, credentials.BasicAuthCredential{PrismCentral: credentials.PrismCentralBasicAuth{
+		BasicAuth: credentials.BasicAuth{
+			Username: creds.Username,
+			Password: creds.Password,
+		},
+	}})
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := prismClient.GetCurrentLoggedInUser(ctx); err != nil {
+		return nil, fmt.Errorf("authenticating to Prism Central %s: %v", datacenter.Spec.Endpoint, err)
+	}
+
+	return nil, nil
+}
+
+func (v *CredentialsValidator) datacenter(ctx context.Context, cluster *anywherev1.Cluster) (*anywherev1.NutanixDatacenterConfig, error) {
+	datacenter := &anywherev1.NutanixDatacenterConfig{}
+	key := client.ObjectKey{Namespace: cluster.Namespace, Name: cluster.Spec.DatacenterRef.Name}
+	if err := v.client.Get(ctx, key, datacenter); err != nil {
+		return nil, fmt.Errorf("reading NutanixDatacenterConfig %s: %v", key, err)
+	}
+
+	return datacenter, nil
+}
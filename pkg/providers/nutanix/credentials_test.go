@@ -0,0 +1,120 @@
+package nutanix
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/nutanix-cloud-native/prism-go-client/environment/credentials"
+	v3 "github.com/nutanix-cloud-native/prism-go-client/v3"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	mocknutanix "github.com/aws/eks-anywhere/pkg/providers/nutanix/mocks"
+)
+
+func credentialsTestClient(credentialRef *anywherev1.Ref) (client.Client, *anywherev1.Cluster) {
+	cluster := &anywherev1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-cluster", Namespace: "default"},
+		Spec: anywherev1.ClusterSpec{
+			DatacenterRef: anywherev1.Ref{Kind: anywherev1.NutanixDatacenterKind, Name: "my-datacenter"},
+		},
+	}
+	datacenter := &anywherev1.NutanixDatacenterConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-datacenter", Namespace: "default"},
+		Spec: anywherev1.NutanixDatacenterConfigSpec{
+			Endpoint:      "prism.example.com",
+			Port:          9440,
+			CredentialRef: credentialRef,
+		},
+	}
+	secrets := []runtime.Object{}
+	for _, name := range []string{"nutanix-credentials", "my-credentials"} {
+		secrets = append(secrets, &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "eksa-system"},
+			Data: map[string][]byte{
+				"credentials": []byte(`[{"type":"basic_auth","data":{"prismCentral":{"username":"admin","password":"` + name + `"}}}]`),
+			},
+		})
+	}
+
+	scheme := runtime.NewScheme()
+	_ = corev1.AddToScheme(scheme)
+	_ = anywherev1.AddToScheme(scheme)
+	c := fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(append(secrets, datacenter)...).Build()
+
+	return c, cluster
+}
+
+func TestCredentialsValidatorSecret(t *testing.T) {
+	g := NewWithT(t)
+	c, cluster := credentialsTestClient(nil)
+	v := NewCredentialsValidator(c)
+
+	secret, err := v.Secret(context.Background(), cluster)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(secret.Name).To(Equal("nutanix-credentials"))
+}
+
+func TestCredentialsValidatorSecretCredentialRef(t *testing.T) {
+	g := NewWithT(t)
+	c, cluster := credentialsTestClient(&anywherev1.Ref{Kind: "Secret", Name: "my-credentials"})
+	v := NewCredentialsValidator(c)
+
+	secret, err := v.Secret(context.Background(), cluster)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(secret.Name).To(Equal("my-credentials"))
+}
+
+func TestCredentialsValidatorValidate(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	c, cluster := credentialsTestClient(nil)
+	mockClient := mocknutanix.NewMockClient(gomock.NewController(t))
+	mockClient.EXPECT().GetCurrentLoggedInUser(ctx).Return(&v3.UserIntentResponse{}, nil)
+	v := NewCredentialsValidator(c)
+	v.newClient = func(_ *anywherev1.NutanixDatacenterConfig, creds credentials.BasicAuthCredential) (Client, error) {
+		g.Expect(creds.PrismCentral.Username).To(Equal("admin"))
+		g.Expect(creds.PrismCentral.Password).To(Equal("nutanix-credentials"))
+		return mockClient, nil
+	}
+
+	secret, err := v.Secret(ctx, cluster)
+	g.Expect(err).NotTo(HaveOccurred())
+	expiration, err := v.Validate(ctx, cluster, secret)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(expiration).To(BeNil())
+}
+
+func TestCredentialsValidatorValidateRejected(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	c, cluster := credentialsTestClient(nil)
+	mockClient := mocknutanix.NewMockClient(gomock.NewController(t))
+	mockClient.EXPECT().GetCurrentLoggedInUser(ctx).Return(nil, errors.New("401 Unauthorized"))
+	v := NewCredentialsValidator(c)
+	v.newClient = func(_ *anywherev1.NutanixDatacenterConfig, _ credentials.BasicAuthCredential) (Client, error) {
+		return mockClient, nil
+	}
+
+	secret, err := v.Secret(ctx, cluster)
+	g.Expect(err).NotTo(HaveOccurred())
+	_, err = v.Validate(ctx, cluster, secret)
+	g.Expect(err).To(MatchError(ContainSubstring("authenticating to Prism Central prism.example.com: 401 Unauthorized")))
+}
+
+func TestCredentialsValidatorValidateInvalidSecret(t *testing.T) {
+	g := NewWithT(t)
+	c, cluster := credentialsTestClient(nil)
+	v := NewCredentialsValidator(c)
+	secret := &corev1.Secret{Data: map[string][]byte{"credentials": []byte("[]")}}
+
+	_, err := v.Validate(context.Background(), cluster, secret)
+	g.Expect(err).To(MatchError(ContainSubstring("parsing Nutanix credentials")))
+}
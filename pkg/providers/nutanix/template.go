@@ -4,7 +4,9 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"maps"
 	"net"
+	"slices"
 	"strings"
 
 	capxv1beta1 "github.com/nutanix-cloud-native/cluster-api-provider-nutanix/api/v1beta1"
@@ -151,6 +153,20 @@ func (ntb *TemplateBuilder) generateSpecSecret(clusterSpec *cluster.Spec, secret
 	return bytes, nil
 }
 
+// machineCategories returns the categories for a machine, which are the machine additional categories
+// followed by the datacenter resource tags, sorted by key, that are not already set in the machine.
+func machineCategories(datacenterSpec *v1alpha1.NutanixDatacenterConfigSpec, machineSpec v1alpha1.NutanixMachineConfigSpec) []v1alpha1.NutanixCategoryIdentifier {
+	categories := append([]v1alpha1.NutanixCategoryIdentifier{}, machineSpec.AdditionalCategories...)
+	for _, key := range slices.Sorted(maps.Keys(datacenterSpec.ResourceTags)) {
+		category := v1alpha1.NutanixCategoryIdentifier{Key: key, Value: datacenterSpec.ResourceTags[key]}
+		if !slices.Contains(categories, category) {
+			categories = append(categories, category)
+		}
+	}
+
+	return categories
+}
+
 func machineDeploymentName(clusterName, nodeGroupName string) string {
 	return fmt.Sprintf("%s-%s", clusterName, nodeGroupName)
 }
@@ -187,20 +203,22 @@ func buildTemplateMapCP(
 
 	values := map[string]interface{}{
 		"auditPolicy":                  auditPolicy,
+		"auditLog":                     common.GetAuditLogSettings(clusterSpec.Cluster),
 		"apiServerExtraArgs":           apiServerExtraArgs,
 		"ccmIgnoredNodeIPs":            ccmIgnoredNodeIPs,
 		"cloudProviderImage":           versionsBundle.Nutanix.CloudProvider.VersionedImage(),
 		"clusterName":                  clusterSpec.Cluster.Name,
 		"controlPlaneEndpointIp":       clusterSpec.Cluster.Spec.ControlPlaneConfiguration.Endpoint.Host,
+		"controlPlaneEndpointVIPCIDR":  common.KubeVipCIDR(clusterSpec.Cluster.Spec.ControlPlaneConfiguration.Endpoint.Host),
 		"controlPlaneReplicas":         clusterSpec.Cluster.Spec.ControlPlaneConfiguration.Count,
-		"controlPlaneSshAuthorizedKey": controlPlaneMachineSpec.Users[0].SshAuthorizedKeys[0],
-		"controlPlaneSshUsername":      controlPlaneMachineSpec.Users[0].Name,
+		"controlPlaneUsers":            common.BootstrapUsers(controlPlaneMachineSpec.Users),
 		"controlPlaneTaints":           clusterSpec.Cluster.Spec.ControlPlaneConfiguration.Taints,
 		"eksaSystemNamespace":          constants.EksaSystemNamespace,
 		"format":                       format,
 		"failureDomains":               failureDomains,
 		"podCidrs":                     clusterSpec.Cluster.Spec.ClusterNetwork.Pods.CidrBlocks,
 		"serviceCidrs":                 clusterSpec.Cluster.Spec.ClusterNetwork.Services.CidrBlocks,
+		"skipKubeProxy":                clusterSpec.Cluster.Spec.ClusterNetwork.CNIConfig.KubeProxyReplacementEnabled(),
 		"kubernetesVersion":            versionsBundle.KubeDistro.Kubernetes.Tag,
 		"kubernetesRepository":         versionsBundle.KubeDistro.Kubernetes.Repository,
 		"corednsRepository":            versionsBundle.KubeDistro.CoreDNS.Repository,
@@ -250,8 +268,8 @@ func buildTemplateMapCP(
 		values["projectUUID"] = controlPlaneMachineSpec.Project.UUID
 	}
 
-	if len(controlPlaneMachineSpec.AdditionalCategories) > 0 {
-		values["additionalCategories"] = controlPlaneMachineSpec.AdditionalCategories
+	if categories := machineCategories(datacenterSpec, controlPlaneMachineSpec); len(categories) > 0 {
+		values["additionalCategories"] = categories
 	}
 
 	if controlPlaneMachineSpec.BootType != "" {
@@ -284,8 +302,7 @@ func buildTemplateMapCP(
 		values["externalEtcd"] = true
 		values["externalEtcdReplicas"] = clusterSpec.Cluster.Spec.ExternalEtcdConfiguration.Count
 		values["placeholderExternalEtcdEndpoint"] = constants.PlaceholderExternalEtcdEndpoint
-		values["etcdSshUsername"] = etcdMachineSpec.Users[0].Name
-		values["etcdSshAuthorizedKey"] = etcdMachineSpec.Users[0].SshAuthorizedKeys[0]
+		values["etcdUsers"] = common.BootstrapUsers(etcdMachineSpec.Users)
 		values["etcdVCPUsPerSocket"] = etcdMachineSpec.VCPUsPerSocket
 		values["etcdVcpuSockets"] = etcdMachineSpec.VCPUSockets
 		values["etcdMemorySize"] = etcdMachineSpec.MemorySize.String()
@@ -310,8 +327,8 @@ func buildTemplateMapCP(
 			values["etcdBootType"] = etcdMachineSpec.BootType
 		}
 
-		if len(etcdMachineSpec.AdditionalCategories) > 0 {
-			values["etcdAdditionalCategories"] = etcdMachineSpec.AdditionalCategories
+		if categories := machineCategories(datacenterSpec, etcdMachineSpec); len(categories) > 0 {
+			values["etcdAdditionalCategories"] = categories
 		}
 	}
 
@@ -342,6 +359,8 @@ func buildTemplateMapCP(
 		}
 
 		values["encryptionProviderConfig"] = conf
+		values["kmsV1"] = common.KMSV1Enabled(clusterSpec.Cluster.Spec.EtcdEncryption)
+		values["kmsPluginSocketDirs"] = common.KMSPluginSocketDirs(clusterSpec.Cluster.Spec.EtcdEncryption)
 	}
 
 	if clusterSpec.Cluster.Spec.ControlPlaneConfiguration.KubeletConfiguration != nil {
@@ -372,6 +391,14 @@ func buildTemplateMapCP(
 		values["nodeLabelArgs"] = nodeLabelArgs
 	}
 
+	machineNamingTemplate, err := clusterapi.ControlPlaneMachineNamingTemplate(clusterSpec.Cluster)
+	if err != nil {
+		return nil, err
+	}
+	if machineNamingTemplate != "" {
+		values["machineNamingTemplate"] = machineNamingTemplate
+	}
+
 	return values, nil
 }
 
@@ -429,8 +456,7 @@ func buildTemplateMapMD(clusterSpec *cluster.Spec, workerNodeGroupMachineSpec v1
 		"kubernetesVersion":      versionsBundle.KubeDistro.Kubernetes.Tag,
 		"workerReplicas":         *workerNodeGroupConfiguration.Count,
 		"workerPoolName":         "md-0",
-		"workerSshAuthorizedKey": workerNodeGroupMachineSpec.Users[0].SshAuthorizedKeys[0],
-		"workerSshUsername":      workerNodeGroupMachineSpec.Users[0].Name,
+		"workerUsers":            common.BootstrapUsers(workerNodeGroupMachineSpec.Users),
 		"vcpusPerSocket":         workerNodeGroupMachineSpec.VCPUsPerSocket,
 		"vcpuSockets":            workerNodeGroupMachineSpec.VCPUSockets,
 		"memorySize":             workerNodeGroupMachineSpec.MemorySize.String(),
@@ -490,14 +516,18 @@ func buildTemplateMapMD(clusterSpec *cluster.Spec, workerNodeGroupMachineSpec v1
 		values["noProxy"] = generateNoProxyList(clusterSpec)
 	}
 
-	if len(workerNodeGroupMachineSpec.AdditionalCategories) > 0 {
-		values["additionalCategories"] = workerNodeGroupMachineSpec.AdditionalCategories
+	if categories := machineCategories(&clusterSpec.NutanixDatacenter.Spec, workerNodeGroupMachineSpec); len(categories) > 0 {
+		values["additionalCategories"] = categories
 	}
 
 	if len(workerNodeGroupMachineSpec.GPUs) > 0 {
 		values["GPUs"] = workerNodeGroupMachineSpec.GPUs
 	}
 
+	if dropIns := common.WorkerContainerdConfigDropIns(nil, workerNodeGroupConfiguration); len(dropIns) > 0 {
+		values["containerdConfigDropIns"] = dropIns
+	}
+
 	if workerNodeGroupConfiguration.KubeletConfiguration != nil {
 		wnKubeletConfig := workerNodeGroupConfiguration.KubeletConfiguration.Object
 		if _, ok := wnKubeletConfig["tlsCipherSuites"]; !ok {
@@ -521,11 +551,39 @@ func buildTemplateMapMD(clusterSpec *cluster.Spec, workerNodeGroupMachineSpec v1
 		values["kubeletExtraArgs"] = kubeletExtraArgs
 	}
 
-	nodeLabelArgs := clusterapi.WorkerNodeLabelsExtraArgs(workerNodeGroupConfiguration)
+	// All the MachineDeployments of a worker node group share the same bootstrap config,
+	// so nodes can only be labeled with their zone when the group has a single failure domain.
+	var zone string
+	if len(failureDomainsForWorkerNodeGroup) == 1 {
+		zone = failureDomainsForWorkerNodeGroup[0].Name
+	}
+	nodeLabelArgs := clusterapi.WorkerNodeTopologyLabelsExtraArgs(workerNodeGroupConfiguration, zone)
 	if len(nodeLabelArgs) != 0 {
 		values["nodeLabelArgs"] = nodeLabelArgs
 	}
 
+	// Worker node groups spread across failure domains have one machine deployment per failure domain,
+	// each with the failure domain as the zone of its machine names.
+	if workerNodeGroupConfiguration.MachineNaming != nil {
+		if len(failureDomainsForWorkerNodeGroup) == 0 {
+			machineNamingTemplate, err := clusterapi.WorkerMachineNamingTemplate(workerNodeGroupConfiguration, "")
+			if err != nil {
+				return nil, err
+			}
+			values["machineNamingTemplate"] = machineNamingTemplate
+		} else {
+			machineNamingTemplates := make(map[string]string, len(failureDomainsForWorkerNodeGroup))
+			for _, fd := range failureDomainsForWorkerNodeGroup {
+				machineNamingTemplate, err := clusterapi.WorkerMachineNamingTemplate(workerNodeGroupConfiguration, fd.Name)
+				if err != nil {
+					return nil, err
+				}
+				machineNamingTemplates[fd.Name] = machineNamingTemplate
+			}
+			values["machineNamingTemplates"] = machineNamingTemplates
+		}
+	}
+
 	return values, nil
 }
 
@@ -405,6 +405,33 @@ func TestNewNutanixTemplateBuilderAdditionalCategories(t *testing.T) {
 	test.AssertContentToFile(t, string(workerSpec), "testdata/expected_results_additional_categories_md.yaml")
 }
 
+func TestNewNutanixTemplateBuilderResourceTags(t *testing.T) {
+	t.Setenv(constants.EksaNutanixUsernameKey, "admin")
+	t.Setenv(constants.EksaNutanixPasswordKey, "password")
+	creds := GetCredsFromEnv()
+
+	buildSpec := test.NewFullClusterSpec(t, "testdata/eksa-cluster-resource-tags.yaml")
+	machineConf := buildSpec.NutanixMachineConfig("eksa-unit-test")
+	workerConfs := map[string]anywherev1.NutanixMachineConfigSpec{
+		"eksa-unit-test": machineConf.Spec,
+	}
+	builder := NewNutanixTemplateBuilder(&buildSpec.NutanixDatacenter.Spec, &machineConf.Spec, &machineConf.Spec, workerConfs, creds, time.Now)
+
+	cpSpec, err := builder.GenerateCAPISpecControlPlane(buildSpec)
+	require.NoError(t, err)
+	test.AssertContentToFile(t, string(cpSpec), "testdata/expected_results_resource_tags.yaml")
+
+	workloadTemplateNames := map[string]string{
+		"eksa-unit-test": "eksa-unit-test",
+	}
+	kubeadmconfigTemplateNames := map[string]string{
+		"eksa-unit-test": "eksa-unit-test",
+	}
+	workerSpec, err := builder.GenerateCAPISpecWorkers(buildSpec, workloadTemplateNames, kubeadmconfigTemplateNames)
+	require.NoError(t, err)
+	test.AssertContentToFile(t, string(workerSpec), "testdata/expected_results_resource_tags_md.yaml")
+}
+
 func TestNewNutanixTemplateBuilderExternalEtcd(t *testing.T) {
 	t.Setenv(constants.EksaNutanixUsernameKey, "admin")
 	t.Setenv(constants.EksaNutanixPasswordKey, "password")
@@ -673,6 +700,37 @@ func TestTemplateBuilderEtcdEncryption(t *testing.T) {
 	}
 }
 
+func TestTemplateBuilderEtcdEncryptionKMSV2(t *testing.T) {
+	clusterSpec := test.NewFullClusterSpec(t, "testdata/cluster_nutanix_etcd_encryption.yaml")
+	providers := (*clusterSpec.Cluster.Spec.EtcdEncryption)[0].Providers
+	providers[0].KMS.APIVersion = anywherev1.KMSAPIVersionV2
+	providers[0].KMS.CacheSize = nil
+	providers[0].KMS.SocketListenAddress = "unix:///var/run/vault/kms.sock"
+
+	machineCfg := clusterSpec.NutanixMachineConfig(clusterSpec.Cluster.Spec.ControlPlaneConfiguration.MachineGroupRef.Name)
+
+	t.Setenv(constants.EksaNutanixUsernameKey, "admin")
+	t.Setenv(constants.EksaNutanixPasswordKey, "password")
+	creds := GetCredsFromEnv()
+
+	bldr := NewNutanixTemplateBuilder(&clusterSpec.NutanixDatacenter.Spec, &machineCfg.Spec, nil,
+		map[string]anywherev1.NutanixMachineConfigSpec{}, creds, time.Now)
+
+	data, err := bldr.GenerateCAPISpecControlPlane(clusterSpec)
+	assert.NoError(t, err)
+	assert.Contains(t, string(data), `        - hostPath: /var/run/vault/
+          mountPath: /var/run/vault/
+          name: kms-plugin-0
+          readOnly: false
+`)
+	assert.Contains(t, string(data), `          - kms:
+              apiVersion: v2
+              endpoint: unix:///var/run/vault/kms.sock
+              name: config1
+              timeout: 3s
+`)
+}
+
 func TestTemplateBuilderEtcdEncryptionKubernetes129(t *testing.T) {
 	for _, tc := range []struct {
 		Input  string
@@ -850,6 +908,67 @@ func TestTemplateBuilderGPUs(t *testing.T) {
 	}
 }
 
+func TestTemplateBuilderWorkerNodeGroupGPU(t *testing.T) {
+	clusterSpec := test.NewFullClusterSpec(t, "testdata/eksa-cluster-gpus.yaml")
+	clusterSpec.Cluster.Spec.WorkerNodeGroupConfigurations[0].GPU = &anywherev1.GPUConfiguration{}
+
+	machineCfg := clusterSpec.NutanixMachineConfig(clusterSpec.Cluster.Spec.ControlPlaneConfiguration.MachineGroupRef.Name)
+	workerConfs := map[string]anywherev1.NutanixMachineConfigSpec{
+		"eksa-unit-test": machineCfg.Spec,
+	}
+
+	t.Setenv(constants.EksaNutanixUsernameKey, "admin")
+	t.Setenv(constants.EksaNutanixPasswordKey, "password")
+	creds := GetCredsFromEnv()
+
+	bldr := NewNutanixTemplateBuilder(&clusterSpec.NutanixDatacenter.Spec, &machineCfg.Spec, &machineCfg.Spec,
+		workerConfs, creds, time.Now)
+
+	workloadTemplateNames := map[string]string{
+		"eksa-unit-test": "eksa-unit-test",
+	}
+	kubeadmconfigTemplateNames := map[string]string{
+		"eksa-unit-test": "eksa-unit-test",
+	}
+
+	data, err := bldr.GenerateCAPISpecWorkers(clusterSpec, workloadTemplateNames, kubeadmconfigTemplateNames)
+	assert.NoError(t, err)
+	test.AssertContentToFile(t, string(data), "testdata/expected_results_worker_gpu_md.yaml")
+}
+
+func TestTemplateBuilderMachineNaming(t *testing.T) {
+	clusterSpec := test.NewFullClusterSpec(t, "testdata/eksa-cluster-worker-fds.yaml")
+	clusterSpec.Cluster.Spec.ControlPlaneConfiguration.MachineNaming = &anywherev1.MachineNamingConfiguration{
+		Template: "corp-{{ .cluster.name }}-{{ .nodeGroup.name }}-{{ .random }}",
+	}
+	clusterSpec.Cluster.Spec.WorkerNodeGroupConfigurations[0].MachineNaming = &anywherev1.MachineNamingConfiguration{
+		Template: "corp-{{ .nodeGroup.name }}-{{ .zone }}-{{ .random }}",
+	}
+	machineConf := clusterSpec.NutanixMachineConfig(clusterSpec.Cluster.Spec.ControlPlaneConfiguration.MachineGroupRef.Name)
+	workerConfSpecs := map[string]anywherev1.NutanixMachineConfigSpec{
+		"eksa-unit-test": machineConf.Spec,
+	}
+
+	t.Setenv(constants.EksaNutanixUsernameKey, "admin")
+	t.Setenv(constants.EksaNutanixPasswordKey, "password")
+	creds := GetCredsFromEnv()
+	builder := NewNutanixTemplateBuilder(&clusterSpec.NutanixDatacenter.Spec, &machineConf.Spec, &machineConf.Spec, workerConfSpecs, creds, time.Now)
+
+	cpSpec, err := builder.GenerateCAPISpecControlPlane(clusterSpec)
+	assert.NoError(t, err)
+	assert.Contains(t, string(cpSpec), "  machineNaming:\n    template: \"corp-{{ .cluster.name }}-control-plane-{{ .random }}\"\n")
+
+	workloadTemplateNames := map[string]string{
+		"eksa-unit-test": "eksa-unit-test",
+	}
+	kubeadmconfigTemplateNames := map[string]string{
+		"eksa-unit-test": "eksa-unit-test",
+	}
+	workerSpec, err := builder.GenerateCAPISpecWorkers(clusterSpec, workloadTemplateNames, kubeadmconfigTemplateNames)
+	assert.NoError(t, err)
+	test.AssertContentToFile(t, string(workerSpec), "testdata/expected_results_machine_naming_md.yaml")
+}
+
 func TestTemplateBuilderBootType(t *testing.T) {
 	for _, tc := range []struct {
 		Input    string
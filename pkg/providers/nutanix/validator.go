@@ -4,9 +4,11 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"maps"
 	"net"
 	"net/http"
 	"regexp"
+	"slices"
 	"strconv"
 	"strings"
 
@@ -27,6 +29,10 @@ const (
 	minNutanixCPUPerSocket = 1
 	minNutanixMemoryMiB    = 2048
 	minNutanixDiskGiB      = 20
+
+	// GPU modes reported by Prism Central for host GPUs.
+	prismGPUModePassthroughPrefix = "PASSTHROUGH"
+	prismGPUModeVirtual           = "VIRTUAL"
 )
 
 // IPValidator is an interface that defines methods to validate the control plane IP.
@@ -156,6 +162,26 @@ func (v *Validator) ValidateDatacenterConfig(ctx context.Context, client Client,
 		}
 	}
 
+	if len(config.Spec.ResourceTags) > 0 {
+		if err := v.validateResourceTags(ctx, client, config.Spec.ResourceTags); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validateResourceTags validates the resource tags exist as categories in Prism Central, so they can be added to the VMs.
+func (v *Validator) validateResourceTags(ctx context.Context, client Client, tags map[string]string) error {
+	categories := make([]anywherev1.NutanixCategoryIdentifier, 0, len(tags))
+	for _, key := range slices.Sorted(maps.Keys(tags)) {
+		categories = append(categories, anywherev1.NutanixCategoryIdentifier{Key: key, Value: tags[key]})
+	}
+
+	if err := v.validateAdditionalCategories(ctx, client, categories); err != nil {
+		return fmt.Errorf("validating resource tags: %v", err)
+	}
+
 	return nil
 }
 
@@ -581,6 +607,10 @@ func (v *Validator) validateGPUConfig(gpu anywherev1.NutanixGPUIdentifier) error
 		}
 	}
 
+	if gpu.Mode != "" && gpu.Mode != anywherev1.NutanixGPUModePassthrough && gpu.Mode != anywherev1.NutanixGPUModeVirtual {
+		return fmt.Errorf("invalid GPU mode: %s; valid modes are: %q and %q", gpu.Mode, anywherev1.NutanixGPUModePassthrough, anywherev1.NutanixGPUModeVirtual)
+	}
+
 	return nil
 }
 
@@ -608,6 +638,11 @@ func (v *Validator) tryAssignGPUsToMachineConfig(machineCount int, requestedGpus
 			return nil, errorGPUNotFound(requestedGpu, cluster)
 		}
 
+		// vGPU profiles are shared between VMs, so they aren't used up by a machine.
+		if requestedGpu.Mode == anywherev1.NutanixGPUModeVirtual {
+			continue
+		}
+
 		clusterGpuList = append(clusterGpuList[:found], clusterGpuList[found+1:]...)
 	}
 
@@ -963,6 +998,10 @@ func findProjectUUIDByName(ctx context.Context, v3Client Client, projectName str
 }
 
 func isRequestedGPUAssignable(gpu v3.GPU, requestedGpu anywherev1.NutanixGPUIdentifier) bool {
+	if !gpuModeMatches(gpu, requestedGpu.Mode) {
+		return false
+	}
+
 	if requestedGpu.Type == anywherev1.NutanixGPUIdentifierDeviceID {
 		return (*gpu.DeviceID == *requestedGpu.DeviceID) && gpu.Assignable
 	}
@@ -970,6 +1009,19 @@ func isRequestedGPUAssignable(gpu v3.GPU, requestedGpu anywherev1.NutanixGPUIden
 	return (gpu.Name == requestedGpu.Name) && gpu.Assignable
 }
 
+// gpuModeMatches checks the mode reported by Prism Central for a host GPU, e.g. PASSTHROUGH_COMPUTE
+// or VIRTUAL, is the requested one.
+func gpuModeMatches(gpu v3.GPU, mode anywherev1.NutanixGPUMode) bool {
+	switch mode {
+	case anywherev1.NutanixGPUModePassthrough:
+		return strings.HasPrefix(gpu.Mode, prismGPUModePassthroughPrefix)
+	case anywherev1.NutanixGPUModeVirtual:
+		return gpu.Mode == prismGPUModeVirtual
+	default:
+		return true
+	}
+}
+
 func errorGPUNotFound(gpu anywherev1.NutanixGPUIdentifier, cluster anywherev1.NutanixResourceIdentifier) error {
 	clusterAddonString := ""
 	if cluster.Type == anywherev1.NutanixIdentifierUUID {
@@ -982,9 +1034,14 @@ func errorGPUNotFound(gpu anywherev1.NutanixGPUIdentifier, cluster anywherev1.Nu
 		}
 	}
 
+	modeAddonString := ""
+	if gpu.Mode != "" {
+		modeAddonString = fmt.Sprintf(" in %s mode", gpu.Mode)
+	}
+
 	if gpu.Type == anywherev1.NutanixGPUIdentifierDeviceID {
-		return fmt.Errorf("GPU with device ID %d not found %s", *gpu.DeviceID, clusterAddonString)
+		return fmt.Errorf("GPU with device ID %d%s not found %s", *gpu.DeviceID, modeAddonString, clusterAddonString)
 	}
 
-	return fmt.Errorf("GPU with name %s not found %s", gpu.Name, clusterAddonString)
+	return fmt.Errorf("GPU with name %s%s not found %s", gpu.Name, modeAddonString, clusterAddonString)
 }
@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"slices"
 	"strings"
 	"testing"
 
@@ -1447,6 +1448,66 @@ func TestNutanixValidatorValidateFreeGPU(t *testing.T) {
 	}
 }
 
+func TestNutanixValidatorTryAssignGPUsToMachineConfigMode(t *testing.T) {
+	clusterName := "prism-cluster"
+	cluster := anywherev1.NutanixResourceIdentifier{Type: anywherev1.NutanixIdentifierName, Name: &clusterName}
+	clusterGPUs := []v3.GPU{
+		{Assignable: true, DeviceID: utils.Int64Ptr(8757), Name: "Ampere 40", Mode: "PASSTHROUGH_COMPUTE"},
+		{Assignable: true, DeviceID: utils.Int64Ptr(557), Name: "NVIDIA A40-1Q", Mode: "VIRTUAL"},
+	}
+
+	tests := []struct {
+		name          string
+		machineCount  int
+		requestedGPU  anywherev1.NutanixGPUIdentifier
+		wantRemaining int
+		expectedError string
+	}{
+		{
+			name:          "passthrough GPU is used up",
+			machineCount:  1,
+			requestedGPU:  anywherev1.NutanixGPUIdentifier{Type: anywherev1.NutanixGPUIdentifierName, Name: "Ampere 40", Mode: anywherev1.NutanixGPUModePassthrough},
+			wantRemaining: 1,
+		},
+		{
+			name:          "not enough passthrough GPUs",
+			machineCount:  2,
+			requestedGPU:  anywherev1.NutanixGPUIdentifier{Type: anywherev1.NutanixGPUIdentifierName, Name: "Ampere 40", Mode: anywherev1.NutanixGPUModePassthrough},
+			expectedError: "GPU with name Ampere 40 in passthrough mode not found on cluster with name prism-cluster",
+		},
+		{
+			name:          "vGPU profile is shared",
+			machineCount:  3,
+			requestedGPU:  anywherev1.NutanixGPUIdentifier{Type: anywherev1.NutanixGPUIdentifierName, Name: "NVIDIA A40-1Q", Mode: anywherev1.NutanixGPUModeVirtual},
+			wantRemaining: 2,
+		},
+		{
+			name:          "mode doesn't match",
+			machineCount:  1,
+			requestedGPU:  anywherev1.NutanixGPUIdentifier{Type: anywherev1.NutanixGPUIdentifierDeviceID, DeviceID: utils.Int64Ptr(8757), Mode: anywherev1.NutanixGPUModeVirtual},
+			expectedError: "GPU with device ID 8757 in virtual mode not found on cluster with name prism-cluster",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			v := &Validator{}
+			remaining, err := v.tryAssignGPUsToMachineConfig(tc.machineCount, []anywherev1.NutanixGPUIdentifier{tc.requestedGPU}, slices.Clone(clusterGPUs), cluster)
+			if tc.expectedError != "" {
+				assert.EqualError(t, err, tc.expectedError)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Len(t, remaining, tc.wantRemaining)
+		})
+	}
+}
+
+func TestNutanixValidatorValidateGPUConfigInvalidMode(t *testing.T) {
+	v := &Validator{}
+	err := v.validateGPUConfig(anywherev1.NutanixGPUIdentifier{Type: anywherev1.NutanixGPUIdentifierName, Name: "Ampere 40", Mode: "shared"})
+	assert.EqualError(t, err, `invalid GPU mode: shared; valid modes are: "passthrough" and "virtual"`)
+}
+
 func TestNutanixValidatorValidateDatacenterConfig(t *testing.T) {
 	tests := []struct {
 		name       string
@@ -1595,6 +1656,70 @@ func TestNutanixValidatorValidateDatacenterConfig(t *testing.T) {
 	}
 }
 
+func TestNutanixValidatorValidateDatacenterConfigResourceTags(t *testing.T) {
+	tests := []struct {
+		name          string
+		setup         func(*mocknutanix.MockClient)
+		expectedError string
+	}{
+		{
+			name: "valid resource tags",
+			setup: func(mockClient *mocknutanix.MockClient) {
+				mockClient.EXPECT().GetCategoryKey(gomock.Any(), "env").Return(&v3.CategoryKeyStatus{}, nil)
+				mockClient.EXPECT().GetCategoryValue(gomock.Any(), "env", "prod").Return(&v3.CategoryValueStatus{}, nil)
+				mockClient.EXPECT().GetCategoryKey(gomock.Any(), "owner").Return(&v3.CategoryKeyStatus{}, nil)
+				mockClient.EXPECT().GetCategoryValue(gomock.Any(), "owner", "platform").Return(&v3.CategoryValueStatus{}, nil)
+			},
+		},
+		{
+			name: "resource tag key not found",
+			setup: func(mockClient *mocknutanix.MockClient) {
+				mockClient.EXPECT().GetCategoryKey(gomock.Any(), "env").Return(nil, errors.New("category key not found"))
+			},
+			expectedError: "validating resource tags: failed to find category with key \"env\"",
+		},
+		{
+			name: "resource tag value not found",
+			setup: func(mockClient *mocknutanix.MockClient) {
+				mockClient.EXPECT().GetCategoryKey(gomock.Any(), "env").Return(&v3.CategoryKeyStatus{}, nil)
+				mockClient.EXPECT().GetCategoryValue(gomock.Any(), "env", "prod").Return(nil, errors.New("category value not found"))
+			},
+			expectedError: "validating resource tags: failed to find category value \"prod\" for category \"env\"",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockClient := mocknutanix.NewMockClient(ctrl)
+			mockClient.EXPECT().GetCurrentLoggedInUser(gomock.Any()).Return(&v3.UserIntentResponse{}, nil)
+			tc.setup(mockClient)
+
+			mockTLSValidator := mockCrypto.NewMockTlsValidator(ctrl)
+			mockTransport := mocknutanix.NewMockRoundTripper(ctrl)
+			mockTransport.EXPECT().RoundTrip(gomock.Any()).Return(&http.Response{}, nil).AnyTimes()
+			clientCache := &ClientCache{clients: map[string]Client{"test": mockClient}}
+			validator := NewValidator(clientCache, mockTLSValidator, &http.Client{Transport: mockTransport})
+
+			dcConf := &anywherev1.NutanixDatacenterConfig{}
+			require.NoError(t, yaml.Unmarshal([]byte(nutanixDatacenterConfigSpec), dcConf))
+			dcConf.Spec.ResourceTags = map[string]string{
+				"owner": "platform",
+				"env":   "prod",
+			}
+			clusterSpec := test.NewFullClusterSpec(t, "testdata/eksa-cluster.yaml")
+			clusterSpec.NutanixDatacenter = dcConf
+
+			err := validator.ValidateDatacenterConfig(context.Background(), mockClient, clusterSpec)
+			if tc.expectedError != "" {
+				assert.ErrorContains(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestNutanixValidatorValidateDatacenterConfigWithInvalidCreds(t *testing.T) {
 	tests := []struct {
 		name       string
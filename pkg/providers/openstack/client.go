@@ -0,0 +1,266 @@
+package openstack
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+)
+
+// Flavor is an OpenStack compute flavor.
+type Flavor struct {
+	Name  string
+	VCPUs int
+	RAM   int
+}
+
+// Network is an OpenStack network and the IDs of its subnets.
+type Network struct {
+	ID      string
+	Name    string
+	Subnets []string
+}
+
+// Subnet is an OpenStack subnet.
+type Subnet struct {
+	ID   string
+	Name string
+}
+
+// Client is a minimal OpenStack API client used to validate the environment.
+type Client interface {
+	Flavors(ctx context.Context) ([]Flavor, error)
+	ImageExists(ctx context.Context, name string) (bool, error)
+	Networks(ctx context.Context, name string) ([]Network, error)
+	Subnets(ctx context.Context, networkID string) ([]Subnet, error)
+}
+
+type apiClient struct {
+	authURL    string
+	region     string
+	creds      Credentials
+	httpClient *http.Client
+
+	authOnce  sync.Once
+	authErr   error
+	token     string
+	endpoints map[string]string
+}
+
+// NewClient returns an OpenStack API client for the cloud described by the datacenter config,
+// authenticated with an application credential.
+func NewClient(datacenter *anywherev1.OpenStackDatacenterConfig, creds Credentials) (Client, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	tlsConfig := &tls.Config{InsecureSkipVerify: datacenter.Spec.Insecure}
+	if datacenter.Spec.CACertBase64 != "" {
+		caCert, err := base64.StdEncoding.DecodeString(datacenter.Spec.CACertBase64)
+		if err != nil {
+			return nil, fmt.Errorf("decoding OpenStackDatacenterConfig caCertBase64: %v", err)
+		}
+
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(caCert) {
+			return nil, errors.New("OpenStackDatacenterConfig caCertBase64 does not contain a valid PEM certificate")
+		}
+		tlsConfig.RootCAs = pool
+	}
+	transport.TLSClientConfig = tlsConfig
+
+	return &apiClient{
+		authURL:    strings.TrimSuffix(datacenter.Spec.AuthURL, "/"),
+		region:     datacenter.Spec.Region,
+		creds:      creds,
+		httpClient: &http.Client{Transport: transport},
+	}, nil
+}
+
+// Flavors returns the compute flavors available to the project.
+func (c *apiClient) Flavors(ctx context.Context) ([]Flavor, error) {
+	resp := &struct {
+		Flavors []struct {
+			Name  string `json:"name"`
+			VCPUs int    `json:"vcpus"`
+			RAM   int    `json:"ram"`
+		} `json:"flavors"`
+	}{}
+	if err := c.get(ctx, "compute", "/flavors/detail", resp); err != nil {
+		return nil, err
+	}
+
+	flavors := make([]Flavor, 0, len(resp.Flavors))
+	for _, f := range resp.Flavors {
+		flavors = append(flavors, Flavor{Name: f.Name, VCPUs: f.VCPUs, RAM: f.RAM})
+	}
+
+	return flavors, nil
+}
+
+// ImageExists returns true if an active image with the given name is visible to the project.
+func (c *apiClient) ImageExists(ctx context.Context, name string) (bool, error) {
+	resp := &struct {
+		Images []struct {
+			Status string `json:"status"`
+		} `json:"images"`
+	}{}
+	if err := c.get(ctx, "image", "/v2/images?status=active&name="+url.QueryEscape(name), resp); err != nil {
+		return false, err
+	}
+
+	return len(resp.Images) > 0, nil
+}
+
+// Networks returns the networks with the given name.
+func (c *apiClient) Networks(ctx context.Context, name string) ([]Network, error) {
+	resp := &struct {
+		Networks []struct {
+			ID      string   `json:"id"`
+			Name    string   `json:"name"`
+			Subnets []string `json:"subnets"`
+		} `json:"networks"`
+	}{}
+	if err := c.get(ctx, "network", "/v2.0/networks?name="+url.QueryEscape(name), resp); err != nil {
+		return nil, err
+	}
+
+	networks := make([]Network, 0, len(resp.Networks))
+	for _, n := range resp.Networks {
+		networks = append(networks, Network{ID: n.ID, Name: n.Name, Subnets: n.Subnets})
+	}
+
+	return networks, nil
+}
+
+// Subnets returns the subnets of the given network.
+func (c *apiClient) Subnets(ctx context.Context, networkID string) ([]Subnet, error) {
+	resp := &struct {
+		Subnets []struct {
+			ID   string `json:"id"`
+			Name string `json:"name"`
+		} `json:"subnets"`
+	}{}
+	if err := c.get(ctx, "network", "/v2.0/subnets?network_id="+url.QueryEscape(networkID), resp); err != nil {
+		return nil, err
+	}
+
+	subnets := make([]Subnet, 0, len(resp.Subnets))
+	for _, s := range resp.Subnets {
+		subnets = append(subnets, Subnet{ID: s.ID, Name: s.Name})
+	}
+
+	return subnets, nil
+}
+
+// authenticate gets a token for the application credential and the public endpoints of
+// the services in the catalog for the configured region.
+func (c *apiClient) authenticate(ctx context.Context) error {
+	c.authOnce.Do(func() {
+		body, err := json.Marshal(map[string]interface{}{
+			"auth": map[string]interface{}{
+				"identity": map[string]interface{}{
+					"methods": []string{"application_credential"},
+					"application_credential": map[string]string{
+						"id":     c.creds.ID,
+						"secret": c.creds.Secret,
+					},
+				},
+			},
+		})
+		if err != nil {
+			c.authErr = fmt.Errorf("building openstack auth request: %v", err)
+			return
+		}
+
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.authURL+"/auth/tokens", bytes.NewReader(body))
+		if err != nil {
+			c.authErr = fmt.Errorf("building openstack auth request: %v", err)
+			return
+		}
+		req.Header.Set("Content-Type", "application/json")
+
+		resp, err := c.httpClient.Do(req)
+		if err != nil {
+			c.authErr = fmt.Errorf("authenticating with openstack: %v", err)
+			return
+		}
+		defer resp.Body.Close()
+
+		if resp.StatusCode != http.StatusCreated {
+			c.authErr = fmt.Errorf("authenticating with openstack: unexpected status %s", resp.Status)
+			return
+		}
+
+		token := &struct {
+			Token struct {
+				Catalog []struct {
+					Type      string `json:"type"`
+					Endpoints []struct {
+						Interface string `json:"interface"`
+						RegionID  string `json:"region_id"`
+						URL       string `json:"url"`
+					} `json:"endpoints"`
+				} `json:"catalog"`
+			} `json:"token"`
+		}{}
+		if err := json.NewDecoder(resp.Body).Decode(token); err != nil {
+			c.authErr = fmt.Errorf("decoding openstack auth response: %v", err)
+			return
+		}
+
+		c.token = resp.Header.Get("X-Subject-Token")
+		c.endpoints = map[string]string{}
+		for _, service := range token.Token.Catalog {
+			for _, endpoint := range service.Endpoints {
+				if endpoint.Interface == "public" && (c.region == "" || endpoint.RegionID == c.region) {
+					c.endpoints[service.Type] = strings.TrimSuffix(endpoint.URL, "/")
+					break
+				}
+			}
+		}
+	})
+
+	return c.authErr
+}
+
+func (c *apiClient) get(ctx context.Context, serviceType, path string, out interface{}) error {
+	if err := c.authenticate(ctx); err != nil {
+		return err
+	}
+
+	endpoint, ok := c.endpoints[serviceType]
+	if !ok {
+		return fmt.Errorf("no public %s endpoint found in the openstack catalog for region %q", serviceType, c.region)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint+path, nil)
+	if err != nil {
+		return fmt.Errorf("building openstack request: %v", err)
+	}
+	req.Header.Set("X-Auth-Token", c.token)
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("calling openstack %s api %s: %v", serviceType, path, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("calling openstack %s api %s: unexpected status %s", serviceType, path, resp.Status)
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("decoding openstack %s api %s response: %v", serviceType, path, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,69 @@
+package openstack
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/aws/eks-anywhere/pkg/clients/kubernetes"
+	"github.com/aws/eks-anywhere/pkg/cluster"
+	"github.com/aws/eks-anywhere/pkg/clusterapi"
+	yamlcapi "github.com/aws/eks-anywhere/pkg/clusterapi/yaml"
+	"github.com/aws/eks-anywhere/pkg/yamlutil"
+)
+
+// ControlPlane represents a CAPI OpenStack control plane.
+type ControlPlane = clusterapi.ControlPlane[*unstructured.Unstructured, *unstructured.Unstructured]
+
+type controlPlaneBuilder = yamlcapi.ControlPlaneBuilder[*unstructured.Unstructured, *unstructured.Unstructured]
+
+// ControlPlaneSpec builds an openstack ControlPlane definition based on an eks-a cluster spec.
+func ControlPlaneSpec(ctx context.Context, logger logr.Logger, client kubernetes.Client, spec *cluster.Spec) (*ControlPlane, error) {
+	cp, err := controlPlaneSpecWithInitialNames(logger, spec)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = cp.UpdateImmutableObjectNames(ctx, client, GetMachineTemplate, MachineTemplateEqual); err != nil {
+		return nil, errors.Wrap(err, "updating openstack immutable object names")
+	}
+
+	return cp, nil
+}
+
+func controlPlaneSpecWithInitialNames(logger logr.Logger, spec *cluster.Spec) (*ControlPlane, error) {
+	templateBuilder := NewTemplateBuilder(time.Now)
+
+	controlPlaneYaml, err := templateBuilder.GenerateCAPISpecControlPlane(spec)
+	if err != nil {
+		return nil, errors.Wrap(err, "generating openstack control plane yaml spec")
+	}
+
+	parser, builder, err := newControlPlaneParser(logger)
+	if err != nil {
+		return nil, err
+	}
+
+	err = parser.Parse(controlPlaneYaml, builder)
+	if err != nil {
+		return nil, errors.Wrap(err, "parsing openstack control plane yaml")
+	}
+
+	return builder.ControlPlane, nil
+}
+
+func newControlPlaneParser(logger logr.Logger) (*yamlutil.Parser, *controlPlaneBuilder, error) {
+	parser, builder, err := yamlcapi.NewControlPlaneParserAndBuilder(
+		logger,
+		yamlutil.NewMapping(openstackClusterKind, newOpenStackCluster),
+		machineTemplateMapping(),
+	)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "building openstack control plane parser")
+	}
+
+	return parser, builder, nil
+}
@@ -0,0 +1,29 @@
+package openstack
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/aws/eks-anywhere/pkg/constants"
+)
+
+// Credentials is an OpenStack application credential.
+type Credentials struct {
+	ID     string
+	Secret string
+}
+
+// GetCredsFromEnv returns the OpenStack application credential based on the environment.
+func GetCredsFromEnv() (Credentials, error) {
+	id, ok := os.LookupEnv(constants.EksaOpenStackApplicationCredentialIDKey)
+	if !ok || len(id) == 0 {
+		return Credentials{}, fmt.Errorf("%s is not set or is empty", constants.EksaOpenStackApplicationCredentialIDKey)
+	}
+
+	secret, ok := os.LookupEnv(constants.EksaOpenStackApplicationCredentialSecretKey)
+	if !ok || len(secret) == 0 {
+		return Credentials{}, fmt.Errorf("%s is not set or is empty", constants.EksaOpenStackApplicationCredentialSecretKey)
+	}
+
+	return Credentials{ID: id, Secret: secret}, nil
+}
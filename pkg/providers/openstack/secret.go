@@ -0,0 +1,81 @@
+package openstack
+
+import (
+	"encoding/base64"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/constants"
+)
+
+const (
+	// cloudName is the name of the cloud in the clouds.yaml the OpenStackCluster identityRef points to.
+	cloudName = "openstack"
+
+	cloudsYAMLKey = "clouds.yaml"
+	caCertKey     = "cacert"
+)
+
+// CloudConfigSecretName returns the name of the secret holding the clouds.yaml CAPO uses
+// to talk to the OpenStack APIs for a cluster.
+func CloudConfigSecretName(clusterName string) string {
+	return fmt.Sprintf("%s-openstack-cloud-config", clusterName)
+}
+
+// CloudConfigSecret builds the secret holding the clouds.yaml for a cluster, authenticating
+// with the given application credential.
+func CloudConfigSecret(clusterName string, datacenter *anywherev1.OpenStackDatacenterConfig, creds Credentials) (*corev1.Secret, error) {
+	cloud := map[string]interface{}{
+		"auth": map[string]string{
+			"auth_url":                      datacenter.Spec.AuthURL,
+			"application_credential_id":     creds.ID,
+			"application_credential_secret": creds.Secret,
+		},
+		"auth_type":            "v3applicationcredential",
+		"identity_api_version": 3,
+		"interface":            "public",
+	}
+	if datacenter.Spec.Region != "" {
+		cloud["region_name"] = datacenter.Spec.Region
+	}
+	if datacenter.Spec.Insecure {
+		cloud["verify"] = false
+	}
+
+	cloudsYAML, err := yaml.Marshal(map[string]interface{}{
+		"clouds": map[string]interface{}{cloudName: cloud},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("marshalling clouds.yaml: %v", err)
+	}
+
+	data := map[string][]byte{
+		cloudsYAMLKey: cloudsYAML,
+	}
+	if datacenter.Spec.CACertBase64 != "" {
+		caCert, err := base64.StdEncoding.DecodeString(datacenter.Spec.CACertBase64)
+		if err != nil {
+			return nil, fmt.Errorf("decoding OpenStackDatacenterConfig caCertBase64: %v", err)
+		}
+		data[caCertKey] = caCert
+	}
+
+	return &corev1.Secret{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       constants.SecretKind,
+			APIVersion: corev1.SchemeGroupVersion.Version,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      CloudConfigSecretName(clusterName),
+			Namespace: constants.EksaSystemNamespace,
+			Labels: map[string]string{
+				constants.ClusterctlMoveLabelName: "true",
+			},
+		},
+		Data: data,
+	}, nil
+}
@@ -0,0 +1,56 @@
+package openstack
+
+import (
+	"encoding/base64"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"sigs.k8s.io/yaml"
+
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/constants"
+)
+
+func TestCloudConfigSecret(t *testing.T) {
+	g := NewWithT(t)
+	datacenter := &anywherev1.OpenStackDatacenterConfig{
+		Spec: anywherev1.OpenStackDatacenterConfigSpec{
+			AuthURL:      "https://openstack.example.com:5000/v3",
+			Region:       "RegionOne",
+			Insecure:     true,
+			CACertBase64: base64.StdEncoding.EncodeToString([]byte("ca")),
+		},
+	}
+
+	secret, err := CloudConfigSecret("test", datacenter, Credentials{ID: "id", Secret: "secret"})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(secret.Name).To(Equal("test-openstack-cloud-config"))
+	g.Expect(secret.Namespace).To(Equal(constants.EksaSystemNamespace))
+	g.Expect(secret.Labels).To(HaveKeyWithValue(constants.ClusterctlMoveLabelName, "true"))
+	g.Expect(secret.Data).To(HaveKeyWithValue("cacert", []byte("ca")))
+
+	clouds := map[string]map[string]map[string]interface{}{}
+	g.Expect(yaml.Unmarshal(secret.Data["clouds.yaml"], &clouds)).To(Succeed())
+	cloud := clouds["clouds"]["openstack"]
+	g.Expect(cloud).To(HaveKeyWithValue("auth", map[string]interface{}{
+		"auth_url":                      "https://openstack.example.com:5000/v3",
+		"application_credential_id":     "id",
+		"application_credential_secret": "secret",
+	}))
+	g.Expect(cloud).To(HaveKeyWithValue("auth_type", "v3applicationcredential"))
+	g.Expect(cloud).To(HaveKeyWithValue("region_name", "RegionOne"))
+	g.Expect(cloud).To(HaveKeyWithValue("verify", false))
+}
+
+func TestCloudConfigSecretInvalidCACert(t *testing.T) {
+	g := NewWithT(t)
+	datacenter := &anywherev1.OpenStackDatacenterConfig{
+		Spec: anywherev1.OpenStackDatacenterConfigSpec{
+			AuthURL:      "https://openstack.example.com:5000/v3",
+			CACertBase64: "not base64",
+		},
+	}
+
+	_, err := CloudConfigSecret("test", datacenter, Credentials{ID: "id", Secret: "secret"})
+	g.Expect(err).To(MatchError(ContainSubstring("decoding OpenStackDatacenterConfig caCertBase64")))
+}
@@ -0,0 +1,191 @@
+package openstack
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/yaml"
+
+	"github.com/aws/eks-anywhere/internal/test"
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/cluster"
+)
+
+func openstackClusterSpec() *cluster.Spec {
+	return test.NewClusterSpec(func(s *cluster.Spec) {
+		s.Cluster.Name = "test"
+		s.Cluster.Spec.ControlPlaneConfiguration = anywherev1.ControlPlaneConfiguration{
+			Count:    1,
+			Endpoint: &anywherev1.Endpoint{Host: "10.0.0.5"},
+			MachineGroupRef: &anywherev1.Ref{
+				Kind: anywherev1.OpenStackMachineConfigKind,
+				Name: "test-cp",
+			},
+		}
+		s.Cluster.Spec.WorkerNodeGroupConfigurations = []anywherev1.WorkerNodeGroupConfiguration{
+			{
+				Name:  "md-0",
+				Count: ptrInt(2),
+				MachineGroupRef: &anywherev1.Ref{
+					Kind: anywherev1.OpenStackMachineConfigKind,
+					Name: "test-md",
+				},
+			},
+		}
+		s.Cluster.Spec.DatacenterRef = anywherev1.Ref{
+			Kind: anywherev1.OpenStackDatacenterKind,
+			Name: "test",
+		}
+		s.Cluster.Spec.ClusterNetwork = anywherev1.ClusterNetwork{
+			Pods:     anywherev1.Pods{CidrBlocks: []string{"192.168.0.0/16"}},
+			Services: anywherev1.Services{CidrBlocks: []string{"10.96.0.0/12"}},
+		}
+		s.OpenStackDatacenter = &anywherev1.OpenStackDatacenterConfig{
+			Spec: anywherev1.OpenStackDatacenterConfigSpec{
+				AuthURL:        "https://openstack.example.com:5000/v3",
+				Region:         "RegionOne",
+				Network:        "eksa-net",
+				Subnet:         "eksa-subnet",
+				FailureDomains: []string{"az1", "az2"},
+			},
+		}
+		s.OpenStackMachineConfigs = map[string]*anywherev1.OpenStackMachineConfig{
+			"test-cp": openstackMachineConfig("test-cp", "m1.medium"),
+			"test-md": openstackMachineConfig("test-md", "m1.large"),
+		}
+		s.VersionsBundles[anywherev1.Kube119].KubeDistro.Kubernetes.Tag = "v1.19.8-eks-1-19-4"
+	})
+}
+
+func openstackMachineConfig(name, flavor string) *anywherev1.OpenStackMachineConfig {
+	m := &anywherev1.OpenStackMachineConfig{
+		Spec: anywherev1.OpenStackMachineConfigSpec{
+			Flavor: flavor,
+			Image:  "ubuntu-kube-v1.19",
+			Users: []anywherev1.UserConfiguration{
+				{Name: "eksa", SshAuthorizedKeys: []string{"ssh-rsa AAAA"}},
+			},
+		},
+	}
+	m.Name = name
+	m.SetDefaults()
+	return m
+}
+
+func ptrInt(i int) *int {
+	return &i
+}
+
+func parseObjects(t *testing.T, content []byte) map[string]*unstructured.Unstructured {
+	t.Helper()
+	objs := map[string]*unstructured.Unstructured{}
+	for _, doc := range strings.Split(string(content), "\n---\n") {
+		if strings.TrimSpace(doc) == "" {
+			continue
+		}
+		json, err := yaml.YAMLToJSON([]byte(doc))
+		if err != nil {
+			t.Fatalf("parsing generated yaml: %v", err)
+		}
+		u := &unstructured.Unstructured{}
+		if err := u.UnmarshalJSON(json); err != nil {
+			t.Fatalf("parsing generated yaml: %v", err)
+		}
+		objs[u.GetKind()+"/"+u.GetName()] = u
+	}
+	return objs
+}
+
+func TestTemplateBuilderGenerateCAPISpecControlPlane(t *testing.T) {
+	g := NewWithT(t)
+	builder := NewTemplateBuilder(time.Now)
+
+	content, err := builder.GenerateCAPISpecControlPlane(openstackClusterSpec())
+	g.Expect(err).NotTo(HaveOccurred())
+
+	objs := parseObjects(t, content)
+	g.Expect(objs).To(HaveKey("Cluster/test"))
+	g.Expect(objs).To(HaveKey("KubeadmControlPlane/test"))
+
+	openstackCluster := objs["OpenStackCluster/test"]
+	g.Expect(openstackCluster).NotTo(BeNil())
+	host, _, _ := unstructured.NestedString(openstackCluster.Object, "spec", "controlPlaneEndpoint", "host")
+	g.Expect(host).To(Equal("10.0.0.5"))
+	secretName, _, _ := unstructured.NestedString(openstackCluster.Object, "spec", "identityRef", "name")
+	g.Expect(secretName).To(Equal("test-openstack-cloud-config"))
+	network, _, _ := unstructured.NestedString(openstackCluster.Object, "spec", "network", "filter", "name")
+	g.Expect(network).To(Equal("eksa-net"))
+	zones, _, _ := unstructured.NestedStringSlice(openstackCluster.Object, "spec", "controlPlaneAvailabilityZones")
+	g.Expect(zones).To(Equal([]string{"az1", "az2"}))
+
+	var machineTemplate *unstructured.Unstructured
+	for key, obj := range objs {
+		if strings.HasPrefix(key, "OpenStackMachineTemplate/") {
+			machineTemplate = obj
+		}
+	}
+	g.Expect(machineTemplate).NotTo(BeNil())
+	flavor, _, _ := unstructured.NestedString(machineTemplate.Object, "spec", "template", "spec", "flavor")
+	g.Expect(flavor).To(Equal("m1.medium"))
+	_, hasRootVolume, _ := unstructured.NestedMap(machineTemplate.Object, "spec", "template", "spec", "rootVolume")
+	g.Expect(hasRootVolume).To(BeFalse())
+	ports, _, _ := unstructured.NestedSlice(machineTemplate.Object, "spec", "template", "spec", "ports")
+	g.Expect(ports).To(HaveLen(1))
+	g.Expect(ports[0]).To(HaveKeyWithValue("allowedAddressPairs", []interface{}{map[string]interface{}{"ipAddress": "10.0.0.5"}}))
+}
+
+func TestTemplateBuilderGenerateCAPISpecControlPlaneRootVolume(t *testing.T) {
+	g := NewWithT(t)
+	spec := openstackClusterSpec()
+	spec.OpenStackMachineConfigs["test-cp"].Spec.RootVolumeSizeGiB = 40
+	spec.OpenStackMachineConfigs["test-cp"].Spec.RootVolumeType = "ssd"
+	spec.OpenStackMachineConfigs["test-cp"].Spec.SecurityGroups = []string{"ssh"}
+
+	content, err := NewTemplateBuilder(time.Now).GenerateCAPISpecControlPlane(spec)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	for key, obj := range parseObjects(t, content) {
+		if !strings.HasPrefix(key, "OpenStackMachineTemplate/") {
+			continue
+		}
+		size, _, _ := unstructured.NestedInt64(obj.Object, "spec", "template", "spec", "rootVolume", "sizeGiB")
+		g.Expect(size).To(Equal(int64(40)))
+		volumeType, _, _ := unstructured.NestedString(obj.Object, "spec", "template", "spec", "rootVolume", "type")
+		g.Expect(volumeType).To(Equal("ssd"))
+		groups, _, _ := unstructured.NestedSlice(obj.Object, "spec", "template", "spec", "securityGroups")
+		g.Expect(groups).To(Equal([]interface{}{map[string]interface{}{"filter": map[string]interface{}{"name": "ssh"}}}))
+	}
+}
+
+func TestTemplateBuilderGenerateCAPISpecControlPlaneMissingMachineConfig(t *testing.T) {
+	g := NewWithT(t)
+	spec := openstackClusterSpec()
+	delete(spec.OpenStackMachineConfigs, "test-cp")
+
+	_, err := NewTemplateBuilder(time.Now).GenerateCAPISpecControlPlane(spec)
+	g.Expect(err).To(HaveOccurred())
+}
+
+func TestTemplateBuilderCAPIWorkersSpecWithInitialNames(t *testing.T) {
+	g := NewWithT(t)
+
+	content, err := NewTemplateBuilder(time.Now).CAPIWorkersSpecWithInitialNames(openstackClusterSpec())
+	g.Expect(err).NotTo(HaveOccurred())
+
+	objs := parseObjects(t, content)
+	md := objs["MachineDeployment/test-md-0"]
+	g.Expect(md).NotTo(BeNil())
+	replicas, _, _ := unstructured.NestedInt64(md.Object, "spec", "replicas")
+	g.Expect(replicas).To(Equal(int64(2)))
+
+	machineTemplate := objs["OpenStackMachineTemplate/test-md-0-1"]
+	g.Expect(machineTemplate).NotTo(BeNil())
+	flavor, _, _ := unstructured.NestedString(machineTemplate.Object, "spec", "template", "spec", "flavor")
+	g.Expect(flavor).To(Equal("m1.large"))
+	image, _, _ := unstructured.NestedString(machineTemplate.Object, "spec", "template", "spec", "image", "filter", "name")
+	g.Expect(image).To(Equal("ubuntu-kube-v1.19"))
+	g.Expect(objs).To(HaveKey("KubeadmConfigTemplate/test-md-0-1"))
+}
@@ -0,0 +1,132 @@
+package openstack
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/cluster"
+)
+
+const (
+	minControlPlaneVCPUs  = 2
+	minControlPlaneRAMMiB = 2048
+)
+
+// IPValidator is an interface that defines methods to validate the control plane IP.
+type IPValidator interface {
+	ValidateControlPlaneIPUniqueness(cluster *anywherev1.Cluster) error
+}
+
+// Validator validates a cluster spec against an OpenStack cloud.
+type Validator struct {
+	client Client
+}
+
+// NewValidator returns a new Validator.
+func NewValidator(client Client) *Validator {
+	return &Validator{
+		client: client,
+	}
+}
+
+// ValidateClusterSpec validates the cluster spec against the OpenStack APIs.
+func (v *Validator) ValidateClusterSpec(ctx context.Context, spec *cluster.Spec) error {
+	if err := ValidateClusterConfig(spec); err != nil {
+		return err
+	}
+
+	if err := v.validateNetwork(ctx, spec.OpenStackDatacenter); err != nil {
+		return err
+	}
+
+	flavors, err := v.client.Flavors(ctx)
+	if err != nil {
+		return fmt.Errorf("listing openstack flavors: %v", err)
+	}
+
+	flavorsByName := make(map[string]Flavor, len(flavors))
+	for _, f := range flavors {
+		flavorsByName[f.Name] = f
+	}
+
+	for _, machineConfig := range spec.OpenStackMachineConfigs {
+		flavor, ok := flavorsByName[machineConfig.Spec.Flavor]
+		if !ok {
+			return fmt.Errorf("flavor %s of OpenStackMachineConfig %s not found", machineConfig.Spec.Flavor, machineConfig.Name)
+		}
+
+		if machineConfig.Name == spec.Cluster.Spec.ControlPlaneConfiguration.MachineGroupRef.Name &&
+			(flavor.VCPUs < minControlPlaneVCPUs || flavor.RAM < minControlPlaneRAMMiB) {
+			return fmt.Errorf("flavor %s of control plane OpenStackMachineConfig %s must have at least %d vCPUs and %d MiB of RAM",
+				flavor.Name, machineConfig.Name, minControlPlaneVCPUs, minControlPlaneRAMMiB)
+		}
+
+		exists, err := v.client.ImageExists(ctx, machineConfig.Spec.Image)
+		if err != nil {
+			return fmt.Errorf("getting openstack image %s: %v", machineConfig.Spec.Image, err)
+		}
+
+		if !exists {
+			return fmt.Errorf("image %s of OpenStackMachineConfig %s not found or not active", machineConfig.Spec.Image, machineConfig.Name)
+		}
+	}
+
+	return nil
+}
+
+func (v *Validator) validateNetwork(ctx context.Context, datacenter *anywherev1.OpenStackDatacenterConfig) error {
+	networks, err := v.client.Networks(ctx, datacenter.Spec.Network)
+	if err != nil {
+		return fmt.Errorf("getting openstack network %s: %v", datacenter.Spec.Network, err)
+	}
+
+	if len(networks) == 0 {
+		return fmt.Errorf("openstack network %s not found", datacenter.Spec.Network)
+	}
+
+	if len(networks) > 1 {
+		return fmt.Errorf("found %d openstack networks named %s, the network name must be unique", len(networks), datacenter.Spec.Network)
+	}
+
+	network := networks[0]
+	if datacenter.Spec.Subnet == "" {
+		if len(network.Subnets) != 1 {
+			return fmt.Errorf("openstack network %s has %d subnets, set the OpenStackDatacenterConfig subnet to pick one", network.Name, len(network.Subnets))
+		}
+		return nil
+	}
+
+	subnets, err := v.client.Subnets(ctx, network.ID)
+	if err != nil {
+		return fmt.Errorf("listing subnets of openstack network %s: %v", network.Name, err)
+	}
+
+	for _, s := range subnets {
+		if s.Name == datacenter.Spec.Subnet {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("subnet %s not found in openstack network %s", datacenter.Spec.Subnet, network.Name)
+}
+
+// ValidateClusterConfig runs the validations that don't require access to the OpenStack APIs.
+func ValidateClusterConfig(spec *cluster.Spec) error {
+	if spec.Cluster.Spec.ExternalEtcdConfiguration != nil {
+		return errors.New("external etcd is not supported for openstack clusters")
+	}
+
+	if spec.Cluster.Spec.ControlPlaneConfiguration.Endpoint == nil || spec.Cluster.Spec.ControlPlaneConfiguration.Endpoint.Host == "" {
+		return errors.New("cluster controlPlaneConfiguration.Endpoint.Host is not set or is empty")
+	}
+
+	endpoint := net.ParseIP(spec.Cluster.Spec.ControlPlaneConfiguration.Endpoint.Host)
+	if endpoint == nil || endpoint.To4() == nil {
+		return fmt.Errorf("cluster controlPlaneConfiguration.Endpoint.Host %s is not a valid IPv4 address", spec.Cluster.Spec.ControlPlaneConfiguration.Endpoint.Host)
+	}
+
+	return nil
+}
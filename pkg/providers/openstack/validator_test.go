@@ -0,0 +1,251 @@
+package openstack
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+)
+
+type fakeOpenStack struct {
+	flavors  []map[string]interface{}
+	images   map[string]bool
+	networks []map[string]interface{}
+	subnets  []map[string]interface{}
+}
+
+func newFakeOpenStack() *fakeOpenStack {
+	return &fakeOpenStack{
+		flavors: []map[string]interface{}{
+			{"name": "m1.medium", "vcpus": 2, "ram": 4096},
+			{"name": "m1.large", "vcpus": 4, "ram": 8192},
+			{"name": "m1.tiny", "vcpus": 1, "ram": 512},
+		},
+		images: map[string]bool{"ubuntu-kube-v1.19": true},
+		networks: []map[string]interface{}{
+			{"id": "net-1", "name": "eksa-net", "subnets": []string{"subnet-1"}},
+		},
+		subnets: []map[string]interface{}{
+			{"id": "subnet-1", "name": "eksa-subnet"},
+		},
+	}
+}
+
+func (f *fakeOpenStack) start(t *testing.T) *httptest.Server {
+	t.Helper()
+	mux := http.NewServeMux()
+	var server *httptest.Server
+	mux.HandleFunc("/v3/auth/tokens", func(w http.ResponseWriter, r *http.Request) {
+		body := &struct {
+			Auth struct {
+				Identity struct {
+					ApplicationCredential struct {
+						ID     string `json:"id"`
+						Secret string `json:"secret"`
+					} `json:"application_credential"`
+				} `json:"identity"`
+			} `json:"auth"`
+		}{}
+		if err := json.NewDecoder(r.Body).Decode(body); err != nil || body.Auth.Identity.ApplicationCredential.Secret != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Header().Set("X-Subject-Token", "token")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprintf(w, `{"token":{"catalog":[
+			{"type":"compute","endpoints":[{"interface":"public","region_id":"RegionOne","url":"%[1]s/compute"}]},
+			{"type":"image","endpoints":[{"interface":"public","region_id":"RegionOne","url":"%[1]s/image"}]},
+			{"type":"network","endpoints":[{"interface":"internal","region_id":"RegionOne","url":"%[1]s/internal"},{"interface":"public","region_id":"RegionOne","url":"%[1]s/network"}]}
+		]}}`, server.URL)
+	})
+	handle := func(path string, response func(r *http.Request) interface{}) {
+		mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+			if r.Header.Get("X-Auth-Token") != "token" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			_ = json.NewEncoder(w).Encode(response(r))
+		})
+	}
+	handle("/compute/flavors/detail", func(*http.Request) interface{} {
+		return map[string]interface{}{"flavors": f.flavors}
+	})
+	handle("/image/v2/images", func(r *http.Request) interface{} {
+		images := []map[string]string{}
+		if f.images[r.URL.Query().Get("name")] {
+			images = append(images, map[string]string{"status": "active"})
+		}
+		return map[string]interface{}{"images": images}
+	})
+	handle("/network/v2.0/networks", func(r *http.Request) interface{} {
+		networks := []map[string]interface{}{}
+		for _, n := range f.networks {
+			if n["name"] == r.URL.Query().Get("name") {
+				networks = append(networks, n)
+			}
+		}
+		return map[string]interface{}{"networks": networks}
+	})
+	handle("/network/v2.0/subnets", func(*http.Request) interface{} {
+		return map[string]interface{}{"subnets": f.subnets}
+	})
+	server = httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return server
+}
+
+func newTestValidator(t *testing.T, server *httptest.Server, secret string) *Validator {
+	t.Helper()
+	client, err := NewClient(&anywherev1.OpenStackDatacenterConfig{
+		Spec: anywherev1.OpenStackDatacenterConfigSpec{
+			AuthURL: server.URL + "/v3",
+			Region:  "RegionOne",
+		},
+	}, Credentials{ID: "id", Secret: secret})
+	if err != nil {
+		t.Fatalf("creating openstack client: %v", err)
+	}
+	return NewValidator(client)
+}
+
+func TestValidatorValidateClusterSpecSuccess(t *testing.T) {
+	g := NewWithT(t)
+	server := newFakeOpenStack().start(t)
+
+	g.Expect(newTestValidator(t, server, "secret").ValidateClusterSpec(context.Background(), openstackClusterSpec())).To(Succeed())
+}
+
+func TestValidatorValidateClusterSpecUnauthorized(t *testing.T) {
+	g := NewWithT(t)
+	server := newFakeOpenStack().start(t)
+
+	err := newTestValidator(t, server, "wrong").ValidateClusterSpec(context.Background(), openstackClusterSpec())
+	g.Expect(err).To(MatchError(ContainSubstring("authenticating with openstack")))
+}
+
+func TestValidatorValidateClusterSpecErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		update  func(*fakeOpenStack, *anywherev1.OpenStackDatacenterConfig, map[string]*anywherev1.OpenStackMachineConfig)
+		wantErr string
+	}{
+		{
+			name: "network not found",
+			update: func(_ *fakeOpenStack, dc *anywherev1.OpenStackDatacenterConfig, _ map[string]*anywherev1.OpenStackMachineConfig) {
+				dc.Spec.Network = "other-net"
+			},
+			wantErr: "openstack network other-net not found",
+		},
+		{
+			name: "duplicated network name",
+			update: func(f *fakeOpenStack, _ *anywherev1.OpenStackDatacenterConfig, _ map[string]*anywherev1.OpenStackMachineConfig) {
+				f.networks = append(f.networks, map[string]interface{}{"id": "net-2", "name": "eksa-net"})
+			},
+			wantErr: "found 2 openstack networks named eksa-net, the network name must be unique",
+		},
+		{
+			name: "subnet not found",
+			update: func(_ *fakeOpenStack, dc *anywherev1.OpenStackDatacenterConfig, _ map[string]*anywherev1.OpenStackMachineConfig) {
+				dc.Spec.Subnet = "other-subnet"
+			},
+			wantErr: "subnet other-subnet not found in openstack network eksa-net",
+		},
+		{
+			name: "subnet required",
+			update: func(f *fakeOpenStack, dc *anywherev1.OpenStackDatacenterConfig, _ map[string]*anywherev1.OpenStackMachineConfig) {
+				dc.Spec.Subnet = ""
+				f.networks[0]["subnets"] = []string{"subnet-1", "subnet-2"}
+			},
+			wantErr: "openstack network eksa-net has 2 subnets, set the OpenStackDatacenterConfig subnet to pick one",
+		},
+		{
+			name: "flavor not found",
+			update: func(_ *fakeOpenStack, _ *anywherev1.OpenStackDatacenterConfig, m map[string]*anywherev1.OpenStackMachineConfig) {
+				m["test-md"].Spec.Flavor = "m1.huge"
+			},
+			wantErr: "flavor m1.huge of OpenStackMachineConfig test-md not found",
+		},
+		{
+			name: "control plane flavor too small",
+			update: func(_ *fakeOpenStack, _ *anywherev1.OpenStackDatacenterConfig, m map[string]*anywherev1.OpenStackMachineConfig) {
+				m["test-cp"].Spec.Flavor = "m1.tiny"
+			},
+			wantErr: "flavor m1.tiny of control plane OpenStackMachineConfig test-cp must have at least 2 vCPUs and 2048 MiB of RAM",
+		},
+		{
+			name: "image not found",
+			update: func(_ *fakeOpenStack, _ *anywherev1.OpenStackDatacenterConfig, m map[string]*anywherev1.OpenStackMachineConfig) {
+				m["test-md"].Spec.Image = "missing"
+			},
+			wantErr: "image missing of OpenStackMachineConfig test-md not found or not active",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			fake := newFakeOpenStack()
+			spec := openstackClusterSpec()
+			tt.update(fake, spec.OpenStackDatacenter, spec.OpenStackMachineConfigs)
+			server := fake.start(t)
+
+			err := newTestValidator(t, server, "secret").ValidateClusterSpec(context.Background(), spec)
+			g.Expect(err).To(MatchError(tt.wantErr))
+		})
+	}
+}
+
+func TestValidateClusterConfig(t *testing.T) {
+	tests := []struct {
+		name    string
+		update  func(*anywherev1.Cluster)
+		wantErr string
+	}{
+		{
+			name:   "valid",
+			update: func(*anywherev1.Cluster) {},
+		},
+		{
+			name: "external etcd",
+			update: func(c *anywherev1.Cluster) {
+				c.Spec.ExternalEtcdConfiguration = &anywherev1.ExternalEtcdConfiguration{Count: 3}
+			},
+			wantErr: "external etcd is not supported for openstack clusters",
+		},
+		{
+			name: "endpoint not set",
+			update: func(c *anywherev1.Cluster) {
+				c.Spec.ControlPlaneConfiguration.Endpoint = nil
+			},
+			wantErr: "cluster controlPlaneConfiguration.Endpoint.Host is not set or is empty",
+		},
+		{
+			name: "endpoint not an ip",
+			update: func(c *anywherev1.Cluster) {
+				c.Spec.ControlPlaneConfiguration.Endpoint.Host = "cp.example.com"
+			},
+			wantErr: "cluster controlPlaneConfiguration.Endpoint.Host cp.example.com is not a valid IPv4 address",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			spec := openstackClusterSpec()
+			tt.update(spec.Cluster)
+
+			err := ValidateClusterConfig(spec)
+			if tt.wantErr == "" {
+				g.Expect(err).NotTo(HaveOccurred())
+			} else {
+				g.Expect(err).To(MatchError(tt.wantErr))
+			}
+		})
+	}
+}
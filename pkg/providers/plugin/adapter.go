@@ -0,0 +1,271 @@
+package plugin
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/yaml"
+
+	"github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/bootstrapper"
+	"github.com/aws/eks-anywhere/pkg/cluster"
+	"github.com/aws/eks-anywhere/pkg/providers"
+	"github.com/aws/eks-anywhere/pkg/types"
+	releasev1alpha1 "github.com/aws/eks-anywhere/release/api/v1alpha1"
+)
+
+// pausedAnnotation matches the annotation the in-tree datacenter configs use to pause reconciliation.
+const pausedAnnotation = "anywhere.eks.amazonaws.com/paused"
+
+var yamlSeparator = regexp.MustCompile(`(?m)^---\s*$`)
+
+// Adapter implements providers.Provider for a provider plugin. The cluster Config parser
+// ignores the plugin datacenter and machine config kinds, so the adapter reads them from the
+// raw cluster config.
+type Adapter struct {
+	plugin         Provider
+	metadata       Metadata
+	clusterConfig  []byte
+	datacenter     *unstructuredConfig
+	machineConfigs []*unstructuredConfig
+}
+
+var _ providers.Provider = &Adapter{}
+
+// NewAdapter returns a providers.Provider that delegates to the plugin, for the cluster config
+// yaml manifest clusterConfig.
+func NewAdapter(plugin Provider, metadata Metadata, clusterConfig []byte) (*Adapter, error) {
+	a := &Adapter{
+		plugin:        plugin,
+		metadata:      metadata,
+		clusterConfig: clusterConfig,
+	}
+
+	for _, doc := range yamlSeparator.Split(string(clusterConfig), -1) {
+		obj := &unstructured.Unstructured{}
+		if err := yaml.Unmarshal([]byte(doc), &obj.Object); err != nil {
+			return nil, fmt.Errorf("parsing cluster config: %v", err)
+		}
+
+		switch obj.GetKind() {
+		case metadata.DatacenterKind:
+			if a.datacenter != nil {
+				return nil, fmt.Errorf("only one %s per cluster config is allowed", metadata.DatacenterKind)
+			}
+			a.datacenter = &unstructuredConfig{obj}
+		case metadata.MachineConfigKind:
+			a.machineConfigs = append(a.machineConfigs, &unstructuredConfig{obj})
+		}
+	}
+
+	if a.datacenter == nil {
+		return nil, fmt.Errorf("cluster config doesn't contain a %s", metadata.DatacenterKind)
+	}
+
+	return a, nil
+}
+
+func (a *Adapter) request(kubeconfig string) *ClusterRequest {
+	return &ClusterRequest{
+		ClusterConfig: a.clusterConfig,
+		Kubeconfig:    kubeconfig,
+	}
+}
+
+// Name returns the name of the plugin provider.
+func (a *Adapter) Name() string {
+	return a.metadata.Name
+}
+
+// DatacenterResourceType returns the resource type of the plugin datacenter config.
+func (a *Adapter) DatacenterResourceType() string {
+	return a.metadata.DatacenterResourceType
+}
+
+// MachineResourceType returns the resource type of the plugin machine configs.
+func (a *Adapter) MachineResourceType() string {
+	return a.metadata.MachineResourceType
+}
+
+// SetupAndValidateCreateCluster calls the plugin to validate the cluster config.
+func (a *Adapter) SetupAndValidateCreateCluster(ctx context.Context, _ *cluster.Spec) error {
+	return a.plugin.SetupAndValidateCreateCluster(ctx, a.request(""))
+}
+
+// SetupAndValidateDeleteCluster calls the plugin to validate the cluster config.
+func (a *Adapter) SetupAndValidateDeleteCluster(ctx context.Context, cluster *types.Cluster, _ *cluster.Spec) error {
+	return a.plugin.SetupAndValidateDeleteCluster(ctx, a.request(kubeconfigFile(cluster)))
+}
+
+// SetupAndValidateUpgradeCluster calls the plugin to validate the cluster config.
+func (a *Adapter) SetupAndValidateUpgradeCluster(ctx context.Context, cluster *types.Cluster, _ *cluster.Spec, _ *cluster.Spec) error {
+	return a.plugin.SetupAndValidateUpgradeCluster(ctx, a.request(kubeconfigFile(cluster)))
+}
+
+// SetupAndValidateUpgradeManagementComponents is a no-op. It implements providers.Provider.
+func (a *Adapter) SetupAndValidateUpgradeManagementComponents(_ context.Context, _ *cluster.Spec) error {
+	return nil
+}
+
+// UpdateSecrets calls the plugin to apply its credentials to the cluster.
+func (a *Adapter) UpdateSecrets(ctx context.Context, cluster *types.Cluster, _ *cluster.Spec) error {
+	return a.plugin.UpdateSecrets(ctx, a.request(kubeconfigFile(cluster)))
+}
+
+// PreCAPIInstallOnBootstrap calls the plugin to apply its credentials to the bootstrap cluster.
+func (a *Adapter) PreCAPIInstallOnBootstrap(ctx context.Context, cluster *types.Cluster, clusterSpec *cluster.Spec) error {
+	return a.UpdateSecrets(ctx, cluster, clusterSpec)
+}
+
+// PostBootstrapSetup is a no-op. It implements providers.Provider.
+func (a *Adapter) PostBootstrapSetup(_ context.Context, _ *v1alpha1.Cluster, _ *types.Cluster) error {
+	return nil
+}
+
+// PostWorkloadInit is a no-op. It implements providers.Provider.
+func (a *Adapter) PostWorkloadInit(_ context.Context, _ *types.Cluster, _ *cluster.Spec) error {
+	return nil
+}
+
+// BootstrapClusterOpts returns no options. It implements providers.Provider.
+func (a *Adapter) BootstrapClusterOpts(_ *cluster.Spec) ([]bootstrapper.BootstrapClusterOption, error) {
+	return nil, nil
+}
+
+// UpdateKubeConfig is a no-op. It implements providers.Provider.
+func (a *Adapter) UpdateKubeConfig(_ *[]byte, _ string) error {
+	return nil
+}
+
+// Version returns the version of the plugin CAPI infrastructure provider.
+func (a *Adapter) Version(_ *cluster.ManagementComponents) string {
+	return a.metadata.Version
+}
+
+// EnvMap calls the plugin for the environment clusterctl needs.
+func (a *Adapter) EnvMap(_ *cluster.ManagementComponents, _ *cluster.Spec) (map[string]string, error) {
+	env, err := a.plugin.EnvMap(context.Background(), a.request(""))
+	if err != nil {
+		return nil, err
+	}
+	if env == nil {
+		env = map[string]string{}
+	}
+
+	return env, nil
+}
+
+// GetDeployments returns the deployments of the plugin CAPI infrastructure provider.
+func (a *Adapter) GetDeployments() map[string][]string {
+	return a.metadata.Deployments
+}
+
+// GetInfrastructureBundle returns the manifests of the plugin CAPI infrastructure provider.
+func (a *Adapter) GetInfrastructureBundle(_ *cluster.ManagementComponents) *types.InfrastructureBundle {
+	return &types.InfrastructureBundle{
+		FolderName: fmt.Sprintf("infrastructure-%s/%s/", a.metadata.Name, a.metadata.Version),
+		Manifests: []releasev1alpha1.Manifest{
+			{URI: a.metadata.InfrastructureComponents},
+			{URI: a.metadata.InfrastructureMetadata},
+		},
+	}
+}
+
+// DatacenterConfig returns the plugin datacenter config.
+func (a *Adapter) DatacenterConfig(_ *cluster.Spec) providers.DatacenterConfig {
+	return a.datacenter
+}
+
+// MachineConfigs returns the plugin machine configs.
+func (a *Adapter) MachineConfigs(_ *cluster.Spec) []providers.MachineConfig {
+	configs := make([]providers.MachineConfig, 0, len(a.machineConfigs))
+	for _, c := range a.machineConfigs {
+		configs = append(configs, c)
+	}
+
+	return configs
+}
+
+// ValidateNewSpec is a no-op, validation happens in SetupAndValidateUpgradeCluster. It implements providers.Provider.
+func (a *Adapter) ValidateNewSpec(_ context.Context, _ *types.Cluster, _ *cluster.Spec) error {
+	return nil
+}
+
+// ChangeDiff returns nil since plugin versions are not part of the management components. It
+// implements providers.Provider.
+func (a *Adapter) ChangeDiff(_, _ *cluster.ManagementComponents) *types.ComponentChangeDiff {
+	return nil
+}
+
+// RunPostControlPlaneUpgrade is a no-op. It implements providers.Provider.
+func (a *Adapter) RunPostControlPlaneUpgrade(_ context.Context, _ *cluster.Spec, _ *cluster.Spec, _ *types.Cluster, _ *types.Cluster) error {
+	return nil
+}
+
+// InstallCustomProviderComponents is a no-op. It implements providers.Provider.
+func (a *Adapter) InstallCustomProviderComponents(_ context.Context, _ string) error {
+	return nil
+}
+
+// PostClusterDeleteValidate is a no-op. It implements providers.Provider.
+func (a *Adapter) PostClusterDeleteValidate(_ context.Context, _ *types.Cluster) error {
+	return nil
+}
+
+// PostMoveManagementToBootstrap is a no-op. It implements providers.Provider.
+func (a *Adapter) PostMoveManagementToBootstrap(_ context.Context, _ *types.Cluster) error {
+	return nil
+}
+
+// PreCoreComponentsUpgrade is a no-op. It implements providers.Provider.
+func (a *Adapter) PreCoreComponentsUpgrade(_ context.Context, _ *types.Cluster, _ *cluster.ManagementComponents, _ *cluster.Spec) error {
+	return nil
+}
+
+func kubeconfigFile(cluster *types.Cluster) string {
+	if cluster == nil {
+		return ""
+	}
+
+	return cluster.KubeconfigFile
+}
+
+// unstructuredConfig is a plugin datacenter or machine config.
+type unstructuredConfig struct {
+	*unstructured.Unstructured
+}
+
+// Kind returns the kind of the config.
+func (c *unstructuredConfig) Kind() string {
+	return c.GetKind()
+}
+
+// PauseReconcile pauses the reconciliation of the config.
+func (c *unstructuredConfig) PauseReconcile() {
+	annotations := c.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[pausedAnnotation] = "true"
+	c.SetAnnotations(annotations)
+}
+
+// ClearPauseAnnotation resumes the reconciliation of the config.
+func (c *unstructuredConfig) ClearPauseAnnotation() {
+	annotations := c.GetAnnotations()
+	delete(annotations, pausedAnnotation)
+	c.SetAnnotations(annotations)
+}
+
+// Marshallable returns the config object.
+func (c *unstructuredConfig) Marshallable() v1alpha1.Marshallable {
+	return c.Object
+}
+
+// OSFamily returns the spec.osFamily of a machine config.
+func (c *unstructuredConfig) OSFamily() v1alpha1.OSFamily {
+	osFamily, _, _ := unstructured.NestedString(c.Object, "spec", "osFamily")
+	return v1alpha1.OSFamily(osFamily)
+}
@@ -0,0 +1,151 @@
+package plugin_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/providers/plugin"
+	"github.com/aws/eks-anywhere/pkg/types"
+	releasev1alpha1 "github.com/aws/eks-anywhere/release/api/v1alpha1"
+)
+
+const clusterConfig = `apiVersion: anywhere.eks.amazonaws.com/v1alpha1
+kind: Cluster
+metadata:
+  name: test
+spec:
+  datacenterRef:
+    kind: FakeDatacenterConfig
+    name: test
+---
+apiVersion: fake.example.com/v1
+kind: FakeDatacenterConfig
+metadata:
+  name: test
+spec:
+  endpoint: https://fake.example.com
+---
+apiVersion: fake.example.com/v1
+kind: FakeMachineConfig
+metadata:
+  name: test-cp
+  namespace: default
+spec:
+  osFamily: bottlerocket
+---
+apiVersion: fake.example.com/v1
+kind: FakeMachineConfig
+metadata:
+  name: test-md
+spec:
+  osFamily: ubuntu
+`
+
+var fakeMetadata = plugin.Metadata{
+	Name:                     "fake",
+	ProtocolVersion:          plugin.ProtocolVersion,
+	DatacenterKind:           "FakeDatacenterConfig",
+	DatacenterResourceType:   "fakedatacenterconfigs.fake.example.com",
+	MachineConfigKind:        "FakeMachineConfig",
+	MachineResourceType:      "fakemachineconfigs.fake.example.com",
+	Version:                  "v1.0.0",
+	InfrastructureComponents: "https://fake.example.com/infrastructure-components.yaml",
+	InfrastructureMetadata:   "https://fake.example.com/metadata.yaml",
+	Deployments:              map[string][]string{"capf-system": {"capf-controller-manager"}},
+}
+
+func TestNewAdapter(t *testing.T) {
+	g := NewWithT(t)
+
+	a, err := plugin.NewAdapter(&fakeProvider{}, fakeMetadata, []byte(clusterConfig))
+	g.Expect(err).NotTo(HaveOccurred())
+
+	g.Expect(a.Name()).To(Equal("fake"))
+	g.Expect(a.Version(nil)).To(Equal("v1.0.0"))
+	g.Expect(a.DatacenterResourceType()).To(Equal("fakedatacenterconfigs.fake.example.com"))
+	g.Expect(a.MachineResourceType()).To(Equal("fakemachineconfigs.fake.example.com"))
+	g.Expect(a.GetDeployments()).To(Equal(fakeMetadata.Deployments))
+	g.Expect(a.GetInfrastructureBundle(nil)).To(Equal(&types.InfrastructureBundle{
+		FolderName: "infrastructure-fake/v1.0.0/",
+		Manifests: []releasev1alpha1.Manifest{
+			{URI: "https://fake.example.com/infrastructure-components.yaml"},
+			{URI: "https://fake.example.com/metadata.yaml"},
+		},
+	}))
+
+	datacenter := a.DatacenterConfig(nil)
+	g.Expect(datacenter.Kind()).To(Equal("FakeDatacenterConfig"))
+	datacenter.PauseReconcile()
+	g.Expect(datacenter.Marshallable()).To(HaveKeyWithValue("metadata", HaveKeyWithValue("annotations", HaveKeyWithValue("anywhere.eks.amazonaws.com/paused", "true"))))
+	datacenter.ClearPauseAnnotation()
+	g.Expect(datacenter.Marshallable()).NotTo(HaveKeyWithValue("metadata", HaveKeyWithValue("annotations", HaveKey("anywhere.eks.amazonaws.com/paused"))))
+
+	machineConfigs := a.MachineConfigs(nil)
+	g.Expect(machineConfigs).To(HaveLen(2))
+	g.Expect(machineConfigs[0].GetName()).To(Equal("test-cp"))
+	g.Expect(machineConfigs[0].GetNamespace()).To(Equal("default"))
+	g.Expect(machineConfigs[0].OSFamily()).To(Equal(v1alpha1.Bottlerocket))
+	g.Expect(machineConfigs[1].GetName()).To(Equal("test-md"))
+	g.Expect(machineConfigs[1].OSFamily()).To(Equal(v1alpha1.Ubuntu))
+}
+
+func TestNewAdapterMissingDatacenter(t *testing.T) {
+	g := NewWithT(t)
+	metadata := fakeMetadata
+	metadata.DatacenterKind = "OtherDatacenterConfig"
+
+	_, err := plugin.NewAdapter(&fakeProvider{}, metadata, []byte(clusterConfig))
+	g.Expect(err).To(MatchError("cluster config doesn't contain a OtherDatacenterConfig"))
+}
+
+func TestNewAdapterMultipleDatacenters(t *testing.T) {
+	g := NewWithT(t)
+	config := clusterConfig + `---
+apiVersion: fake.example.com/v1
+kind: FakeDatacenterConfig
+metadata:
+  name: other
+`
+
+	_, err := plugin.NewAdapter(&fakeProvider{}, fakeMetadata, []byte(config))
+	g.Expect(err).To(MatchError("only one FakeDatacenterConfig per cluster config is allowed"))
+}
+
+func TestAdapterDelegatesToPlugin(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	provider := &fakeProvider{env: map[string]string{"FAKE_TOKEN": "token"}}
+	cluster := &types.Cluster{Name: "test", KubeconfigFile: "test.kubeconfig"}
+
+	a, err := plugin.NewAdapter(provider, fakeMetadata, []byte(clusterConfig))
+	g.Expect(err).NotTo(HaveOccurred())
+
+	g.Expect(a.SetupAndValidateCreateCluster(ctx, nil)).To(Succeed())
+	g.Expect(a.SetupAndValidateUpgradeCluster(ctx, cluster, nil, nil)).To(Succeed())
+	g.Expect(a.SetupAndValidateDeleteCluster(ctx, cluster, nil)).To(Succeed())
+	g.Expect(a.PreCAPIInstallOnBootstrap(ctx, cluster, nil)).To(Succeed())
+
+	env, err := a.EnvMap(nil, nil)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(env).To(Equal(map[string]string{"FAKE_TOKEN": "token"}))
+
+	g.Expect(provider.requests).To(HaveLen(5))
+	g.Expect(provider.requests[0]).To(Equal(&plugin.ClusterRequest{ClusterConfig: []byte(clusterConfig)}))
+	g.Expect(provider.requests[1]).To(Equal(&plugin.ClusterRequest{ClusterConfig: []byte(clusterConfig), Kubeconfig: "test.kubeconfig"}))
+}
+
+func TestAdapterPluginError(t *testing.T) {
+	g := NewWithT(t)
+	provider := &fakeProvider{err: errors.New("invalid endpoint")}
+
+	a, err := plugin.NewAdapter(provider, fakeMetadata, []byte(clusterConfig))
+	g.Expect(err).NotTo(HaveOccurred())
+
+	g.Expect(a.SetupAndValidateCreateCluster(context.Background(), nil)).To(MatchError("invalid endpoint"))
+	_, err = a.EnvMap(nil, nil)
+	g.Expect(err).To(MatchError("invalid endpoint"))
+}
@@ -0,0 +1,64 @@
+package plugin
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+const (
+	// DirEnvVar overrides the directory provider plugins are discovered from.
+	DirEnvVar = "EKSA_PROVIDER_PLUGINS_DIR"
+	// BinaryPrefix is the prefix of the names of provider plugin binaries.
+	BinaryPrefix = "eksctl-anywhere-provider-"
+
+	defaultDir = ".eksctl-anywhere/plugins"
+)
+
+// Dir returns the directory provider plugins are discovered from: the value of DirEnvVar if
+// set, ~/.eksctl-anywhere/plugins otherwise.
+func Dir() (string, error) {
+	if dir := os.Getenv(DirEnvVar); dir != "" {
+		return dir, nil
+	}
+
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("getting provider plugins directory: %v", err)
+	}
+
+	return filepath.Join(home, defaultDir), nil
+}
+
+// Discover returns the paths of the provider plugins in dir by plugin name. Plugins are the
+// executable files named BinaryPrefix<name>. A missing dir has no plugins.
+func Discover(dir string) (map[string]string, error) {
+	entries, err := os.ReadDir(dir)
+	if os.IsNotExist(err) {
+		return map[string]string{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reading provider plugins directory: %v", err)
+	}
+
+	plugins := map[string]string{}
+	for _, entry := range entries {
+		name, ok := strings.CutPrefix(entry.Name(), BinaryPrefix)
+		if !ok || name == "" || entry.IsDir() {
+			continue
+		}
+
+		info, err := entry.Info()
+		if err != nil {
+			return nil, fmt.Errorf("reading provider plugin %s: %v", entry.Name(), err)
+		}
+		if info.Mode()&0o111 == 0 {
+			continue
+		}
+
+		plugins[name] = filepath.Join(dir, entry.Name())
+	}
+
+	return plugins, nil
+}
@@ -0,0 +1,62 @@
+package plugin_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/aws/eks-anywhere/pkg/providers/plugin"
+)
+
+func TestDiscover(t *testing.T) {
+	g := NewWithT(t)
+	dir := t.TempDir()
+
+	files := map[string]os.FileMode{
+		"eksctl-anywhere-provider-fake":        0o755,
+		"eksctl-anywhere-provider-other":       0o755,
+		"eksctl-anywhere-provider-notexecable": 0o644,
+		"eksctl-anywhere-provider-":            0o755,
+		"some-binary":                          0o755,
+	}
+	for name, mode := range files {
+		g.Expect(os.WriteFile(filepath.Join(dir, name), []byte("#!/bin/sh"), mode)).To(Succeed())
+	}
+	g.Expect(os.Mkdir(filepath.Join(dir, "eksctl-anywhere-provider-dir"), 0o755)).To(Succeed())
+
+	plugins, err := plugin.Discover(dir)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(plugins).To(Equal(map[string]string{
+		"fake":  filepath.Join(dir, "eksctl-anywhere-provider-fake"),
+		"other": filepath.Join(dir, "eksctl-anywhere-provider-other"),
+	}))
+}
+
+func TestDiscoverMissingDir(t *testing.T) {
+	g := NewWithT(t)
+
+	plugins, err := plugin.Discover(filepath.Join(t.TempDir(), "missing"))
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(plugins).To(BeEmpty())
+}
+
+func TestDirFromEnv(t *testing.T) {
+	g := NewWithT(t)
+	t.Setenv(plugin.DirEnvVar, "/opt/plugins")
+
+	dir, err := plugin.Dir()
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(dir).To(Equal("/opt/plugins"))
+}
+
+func TestDirDefault(t *testing.T) {
+	g := NewWithT(t)
+	t.Setenv(plugin.DirEnvVar, "")
+	t.Setenv("HOME", "/home/user")
+
+	dir, err := plugin.Dir()
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(dir).To(Equal("/home/user/.eksctl-anywhere/plugins"))
+}
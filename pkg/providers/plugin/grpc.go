@@ -0,0 +1,176 @@
+package plugin
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/encoding"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	serviceName = "eksa.provider.v1.Provider"
+	// codecName is the gRPC content subtype of the messages. Messages are encoded as json so
+	// plugins don't need generated protobuf code.
+	codecName = "json"
+)
+
+func init() {
+	encoding.RegisterCodec(jsonCodec{})
+}
+
+type jsonCodec struct{}
+
+func (jsonCodec) Marshal(v any) ([]byte, error) {
+	return json.Marshal(v)
+}
+
+func (jsonCodec) Unmarshal(data []byte, v any) error {
+	return json.Unmarshal(data, v)
+}
+
+func (jsonCodec) Name() string {
+	return codecName
+}
+
+type empty struct{}
+
+type envMapResponse struct {
+	Env map[string]string `json:"env"`
+}
+
+var serviceDesc = grpc.ServiceDesc{
+	ServiceName: serviceName,
+	HandlerType: (*Provider)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Metadata",
+			Handler: unaryHandler("Metadata", func(ctx context.Context, p Provider, _ *empty) (*Metadata, error) {
+				return p.Metadata(ctx)
+			}),
+		},
+		{
+			MethodName: "SetupAndValidateCreateCluster",
+			Handler: unaryHandler("SetupAndValidateCreateCluster", func(ctx context.Context, p Provider, req *ClusterRequest) (*empty, error) {
+				return &empty{}, p.SetupAndValidateCreateCluster(ctx, req)
+			}),
+		},
+		{
+			MethodName: "SetupAndValidateUpgradeCluster",
+			Handler: unaryHandler("SetupAndValidateUpgradeCluster", func(ctx context.Context, p Provider, req *ClusterRequest) (*empty, error) {
+				return &empty{}, p.SetupAndValidateUpgradeCluster(ctx, req)
+			}),
+		},
+		{
+			MethodName: "SetupAndValidateDeleteCluster",
+			Handler: unaryHandler("SetupAndValidateDeleteCluster", func(ctx context.Context, p Provider, req *ClusterRequest) (*empty, error) {
+				return &empty{}, p.SetupAndValidateDeleteCluster(ctx, req)
+			}),
+		},
+		{
+			MethodName: "UpdateSecrets",
+			Handler: unaryHandler("UpdateSecrets", func(ctx context.Context, p Provider, req *ClusterRequest) (*empty, error) {
+				return &empty{}, p.UpdateSecrets(ctx, req)
+			}),
+		},
+		{
+			MethodName: "EnvMap",
+			Handler: unaryHandler("EnvMap", func(ctx context.Context, p Provider, req *ClusterRequest) (*envMapResponse, error) {
+				env, err := p.EnvMap(ctx, req)
+				return &envMapResponse{Env: env}, err
+			}),
+		},
+	},
+}
+
+func unaryHandler[Req, Resp any](method string, call func(context.Context, Provider, *Req) (*Resp, error)) grpc.MethodHandler {
+	return func(srv any, ctx context.Context, dec func(any) error, interceptor grpc.UnaryServerInterceptor) (any, error) {
+		req := new(Req)
+		if err := dec(req); err != nil {
+			return nil, err
+		}
+
+		handler := func(ctx context.Context, req any) (any, error) {
+			return call(ctx, srv.(Provider), req.(*Req))
+		}
+		if interceptor == nil {
+			return handler(ctx, req)
+		}
+
+		info := &grpc.UnaryServerInfo{Server: srv, FullMethod: fullMethod(method)}
+		return interceptor(ctx, req, info, handler)
+	}
+}
+
+func fullMethod(method string) string {
+	return "/" + serviceName + "/" + method
+}
+
+// RegisterProviderServer registers provider in a gRPC server.
+func RegisterProviderServer(s grpc.ServiceRegistrar, provider Provider) {
+	s.RegisterService(&serviceDesc, provider)
+}
+
+// grpcClient is a Provider that calls a plugin over gRPC.
+type grpcClient struct {
+	conn grpc.ClientConnInterface
+}
+
+// NewGRPCClient returns a Provider that calls the plugin served on conn.
+func NewGRPCClient(conn grpc.ClientConnInterface) Provider {
+	return &grpcClient{conn: conn}
+}
+
+func (c *grpcClient) invoke(ctx context.Context, method string, req, resp any) error {
+	if err := c.conn.Invoke(ctx, fullMethod(method), req, resp, grpc.CallContentSubtype(codecName)); err != nil {
+		// Surface the plugin error message as is, without the gRPC status decoration.
+		if s, ok := status.FromError(err); ok {
+			return errors.New(s.Message())
+		}
+		return err
+	}
+
+	return nil
+}
+
+// Metadata returns the description of the plugin.
+func (c *grpcClient) Metadata(ctx context.Context) (*Metadata, error) {
+	metadata := &Metadata{}
+	if err := c.invoke(ctx, "Metadata", &empty{}, metadata); err != nil {
+		return nil, err
+	}
+
+	return metadata, nil
+}
+
+// SetupAndValidateCreateCluster validates the cluster config before creating a cluster.
+func (c *grpcClient) SetupAndValidateCreateCluster(ctx context.Context, req *ClusterRequest) error {
+	return c.invoke(ctx, "SetupAndValidateCreateCluster", req, &empty{})
+}
+
+// SetupAndValidateUpgradeCluster validates the cluster config before upgrading a cluster.
+func (c *grpcClient) SetupAndValidateUpgradeCluster(ctx context.Context, req *ClusterRequest) error {
+	return c.invoke(ctx, "SetupAndValidateUpgradeCluster", req, &empty{})
+}
+
+// SetupAndValidateDeleteCluster validates the cluster config before deleting a cluster.
+func (c *grpcClient) SetupAndValidateDeleteCluster(ctx context.Context, req *ClusterRequest) error {
+	return c.invoke(ctx, "SetupAndValidateDeleteCluster", req, &empty{})
+}
+
+// UpdateSecrets creates or updates the credentials of the CAPI infrastructure provider.
+func (c *grpcClient) UpdateSecrets(ctx context.Context, req *ClusterRequest) error {
+	return c.invoke(ctx, "UpdateSecrets", req, &empty{})
+}
+
+// EnvMap returns the environment variables clusterctl needs to install the CAPI infrastructure provider.
+func (c *grpcClient) EnvMap(ctx context.Context, req *ClusterRequest) (map[string]string, error) {
+	resp := &envMapResponse{}
+	if err := c.invoke(ctx, "EnvMap", req, resp); err != nil {
+		return nil, err
+	}
+
+	return resp.Env, nil
+}
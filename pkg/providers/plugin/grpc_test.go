@@ -0,0 +1,126 @@
+package plugin_test
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/aws/eks-anywhere/pkg/providers/plugin"
+)
+
+type fakeProvider struct {
+	metadata *plugin.Metadata
+	env      map[string]string
+	err      error
+	requests []*plugin.ClusterRequest
+}
+
+func (f *fakeProvider) Metadata(_ context.Context) (*plugin.Metadata, error) {
+	return f.metadata, f.err
+}
+
+func (f *fakeProvider) SetupAndValidateCreateCluster(_ context.Context, req *plugin.ClusterRequest) error {
+	f.requests = append(f.requests, req)
+	return f.err
+}
+
+func (f *fakeProvider) SetupAndValidateUpgradeCluster(_ context.Context, req *plugin.ClusterRequest) error {
+	f.requests = append(f.requests, req)
+	return f.err
+}
+
+func (f *fakeProvider) SetupAndValidateDeleteCluster(_ context.Context, req *plugin.ClusterRequest) error {
+	f.requests = append(f.requests, req)
+	return f.err
+}
+
+func (f *fakeProvider) UpdateSecrets(_ context.Context, req *plugin.ClusterRequest) error {
+	f.requests = append(f.requests, req)
+	return f.err
+}
+
+func (f *fakeProvider) EnvMap(_ context.Context, req *plugin.ClusterRequest) (map[string]string, error) {
+	f.requests = append(f.requests, req)
+	return f.env, f.err
+}
+
+func newGRPCClient(t *testing.T, provider plugin.Provider) plugin.Provider {
+	listener := bufconn.Listen(1024 * 1024)
+	server := grpc.NewServer()
+	plugin.RegisterProviderServer(server, provider)
+	go func() {
+		_ = server.Serve(listener)
+	}()
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.NewClient(
+		"passthrough:///bufconn",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatalf("connecting to test server: %v", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+
+	return plugin.NewGRPCClient(conn)
+}
+
+func TestGRPCClientRoundTrip(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	provider := &fakeProvider{
+		metadata: &plugin.Metadata{
+			Name:            "fake",
+			ProtocolVersion: plugin.ProtocolVersion,
+			DatacenterKind:  "FakeDatacenterConfig",
+			Version:         "v1.0.0",
+			Deployments:     map[string][]string{"capf-system": {"capf-controller-manager"}},
+		},
+		env: map[string]string{"FAKE_TOKEN": "token"},
+	}
+	client := newGRPCClient(t, provider)
+	req := &plugin.ClusterRequest{
+		ClusterConfig: []byte("kind: Cluster"),
+		Kubeconfig:    "cluster.kubeconfig",
+	}
+
+	metadata, err := client.Metadata(ctx)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(metadata).To(Equal(provider.metadata))
+
+	g.Expect(client.SetupAndValidateCreateCluster(ctx, req)).To(Succeed())
+	g.Expect(client.SetupAndValidateUpgradeCluster(ctx, req)).To(Succeed())
+	g.Expect(client.SetupAndValidateDeleteCluster(ctx, req)).To(Succeed())
+	g.Expect(client.UpdateSecrets(ctx, req)).To(Succeed())
+
+	env, err := client.EnvMap(ctx, req)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(env).To(Equal(provider.env))
+
+	g.Expect(provider.requests).To(HaveLen(5))
+	for _, r := range provider.requests {
+		g.Expect(r).To(Equal(req))
+	}
+}
+
+func TestGRPCClientError(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	client := newGRPCClient(t, &fakeProvider{err: errors.New("invalid datacenter config")})
+	req := &plugin.ClusterRequest{}
+
+	g.Expect(client.SetupAndValidateCreateCluster(ctx, req)).To(MatchError("invalid datacenter config"))
+	g.Expect(client.UpdateSecrets(ctx, req)).To(MatchError("invalid datacenter config"))
+
+	_, err := client.EnvMap(ctx, req)
+	g.Expect(err).To(MatchError("invalid datacenter config"))
+}
@@ -0,0 +1,163 @@
+package plugin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/aws/eks-anywhere/pkg/logger"
+)
+
+const (
+	startMaxRetries = 40
+	startBackoff    = 250 * time.Millisecond
+	stopTimeout     = 10 * time.Second
+)
+
+// Plugin is a running provider plugin process.
+type Plugin struct {
+	Provider
+	Metadata Metadata
+
+	path      string
+	cmd       *exec.Cmd
+	conn      *grpc.ClientConn
+	socketDir string
+	exited    chan error
+}
+
+// Start runs the plugin binary at path and connects to it, failing if it doesn't speak the
+// ProtocolVersion of the CLI. Callers must Stop the plugin once done with it.
+func Start(ctx context.Context, path string) (*Plugin, error) {
+	socketDir, err := os.MkdirTemp("", "eksa-provider-plugin-")
+	if err != nil {
+		return nil, fmt.Errorf("creating provider plugin socket directory: %v", err)
+	}
+	socket := filepath.Join(socketDir, "plugin.sock")
+
+	cmd := exec.Command(path)
+	cmd.Env = append(os.Environ(), SocketEnvVar+"="+socket)
+	cmd.Stdout = os.Stderr
+	cmd.Stderr = os.Stderr
+	if err := cmd.Start(); err != nil {
+		os.RemoveAll(socketDir)
+		return nil, fmt.Errorf("starting provider plugin %s: %v", path, err)
+	}
+
+	p := &Plugin{
+		path:      path,
+		cmd:       cmd,
+		socketDir: socketDir,
+		exited:    make(chan error, 1),
+	}
+	go func() {
+		p.exited <- cmd.Wait()
+	}()
+
+	p.conn, err = grpc.NewClient(
+		"unix://"+socket,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		p.Stop()
+		return nil, fmt.Errorf("connecting to provider plugin %s: %v", path, err)
+	}
+	p.Provider = NewGRPCClient(p.conn)
+
+	metadata, err := p.waitForMetadata(ctx)
+	if err != nil {
+		p.Stop()
+		return nil, fmt.Errorf("reading provider plugin %s metadata: %v", path, err)
+	}
+
+	if metadata.ProtocolVersion != ProtocolVersion {
+		p.Stop()
+		return nil, fmt.Errorf("provider plugin %s uses protocol version %d, expected %d", path, metadata.ProtocolVersion, ProtocolVersion)
+	}
+	p.Metadata = *metadata
+
+	return p, nil
+}
+
+// waitForMetadata reads the plugin metadata, retrying while the plugin starts serving.
+func (p *Plugin) waitForMetadata(ctx context.Context) (*Metadata, error) {
+	for retries := 0; ; retries++ {
+		select {
+		case err := <-p.exited:
+			p.exited <- err
+			return nil, fmt.Errorf("provider plugin exited: %v", err)
+		default:
+		}
+
+		metadata, err := p.Provider.Metadata(ctx)
+		if err == nil {
+			return metadata, nil
+		}
+		if retries >= startMaxRetries {
+			return nil, err
+		}
+		time.Sleep(startBackoff)
+	}
+}
+
+// Stop closes the connection to the plugin and terminates its process.
+func (p *Plugin) Stop() {
+	if p.conn != nil {
+		p.conn.Close()
+	}
+
+	if err := p.cmd.Process.Signal(os.Interrupt); err == nil {
+		select {
+		case <-p.exited:
+		case <-time.After(stopTimeout):
+			logger.V(4).Info("Provider plugin didn't stop in time, killing it", "plugin", p.path)
+			_ = p.cmd.Process.Kill()
+			<-p.exited
+		}
+	}
+
+	os.RemoveAll(p.socketDir)
+}
+
+// ForDatacenterKind starts the plugins in dir until it finds the one that handles the
+// datacenter kind, stopping the rest.
+func ForDatacenterKind(ctx context.Context, dir, kind string) (*Plugin, error) {
+	paths, err := Discover(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var errs []error
+	for name, path := range paths {
+		p, err := Start(ctx, path)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("provider plugin %s: %v", name, err))
+			continue
+		}
+
+		if p.Metadata.DatacenterKind == kind {
+			logger.V(2).Info("Using provider plugin", "name", p.Metadata.Name, "path", path)
+			return p, nil
+		}
+		p.Stop()
+	}
+
+	if len(errs) > 0 {
+		return nil, fmt.Errorf("no provider plugin found in %s for datacenter kind %s: %v", dir, kind, errors.Join(errs...))
+	}
+
+	return nil, fmt.Errorf("no provider plugin found in %s for datacenter kind %s", dir, kind)
+}
+
+// Close stops the plugin. It implements types.Closer.
+func (p *Plugin) Close(_ context.Context) error {
+	p.Stop()
+	return nil
+}
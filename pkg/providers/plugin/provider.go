@@ -0,0 +1,62 @@
+package plugin
+
+import "context"
+
+// ProtocolVersion is the version of the protocol between the CLI and provider plugins. The CLI
+// refuses to use plugins that report a different version.
+const ProtocolVersion = 1
+
+// Metadata describes a provider plugin and the CAPI infrastructure provider it installs.
+type Metadata struct {
+	// Name is the name of the provider, used as the clusterctl infrastructure provider name.
+	Name string `json:"name"`
+	// ProtocolVersion is the ProtocolVersion the plugin was built with.
+	ProtocolVersion int `json:"protocolVersion"`
+	// DatacenterKind is the kind of the datacenter config the provider handles. The CLI uses the
+	// plugin for clusters whose datacenterRef has this kind.
+	DatacenterKind string `json:"datacenterKind"`
+	// DatacenterResourceType is the resource type of the datacenter config, like
+	// exampledatacenterconfigs.anywhere.eks.amazonaws.com.
+	DatacenterResourceType string `json:"datacenterResourceType"`
+	// MachineConfigKind is the kind of the machine configs the provider handles.
+	MachineConfigKind string `json:"machineConfigKind"`
+	// MachineResourceType is the resource type of the machine configs.
+	MachineResourceType string `json:"machineResourceType"`
+	// Version is the version of the CAPI infrastructure provider.
+	Version string `json:"version"`
+	// InfrastructureComponents is the URI of the infrastructure-components.yaml of the CAPI
+	// infrastructure provider.
+	InfrastructureComponents string `json:"infrastructureComponents"`
+	// InfrastructureMetadata is the URI of the clusterctl metadata.yaml of the CAPI infrastructure provider.
+	InfrastructureMetadata string `json:"infrastructureMetadata"`
+	// Deployments are the deployments of the CAPI infrastructure provider by namespace, which the
+	// CLI waits for after installing it.
+	Deployments map[string][]string `json:"deployments,omitempty"`
+}
+
+// ClusterRequest holds the cluster an operation applies to.
+type ClusterRequest struct {
+	// ClusterConfig is the cluster config yaml manifest, including the provider objects.
+	ClusterConfig []byte `json:"clusterConfig"`
+	// Kubeconfig is the path to the kubeconfig of the cluster that holds the CAPI objects of the
+	// cluster. It's empty before that cluster exists.
+	Kubeconfig string `json:"kubeconfig,omitempty"`
+}
+
+// Provider is the stable interface out-of-tree infrastructure providers implement. Plugins
+// serve it over gRPC with Serve and the CLI adapts it to its internal provider interface.
+type Provider interface {
+	// Metadata returns the description of the plugin.
+	Metadata(ctx context.Context) (*Metadata, error)
+	// SetupAndValidateCreateCluster validates the cluster config before creating a cluster.
+	SetupAndValidateCreateCluster(ctx context.Context, req *ClusterRequest) error
+	// SetupAndValidateUpgradeCluster validates the cluster config before upgrading a cluster.
+	SetupAndValidateUpgradeCluster(ctx context.Context, req *ClusterRequest) error
+	// SetupAndValidateDeleteCluster validates the cluster config before deleting a cluster.
+	SetupAndValidateDeleteCluster(ctx context.Context, req *ClusterRequest) error
+	// UpdateSecrets creates or updates the credentials the CAPI infrastructure provider needs in
+	// the cluster of req.Kubeconfig.
+	UpdateSecrets(ctx context.Context, req *ClusterRequest) error
+	// EnvMap returns the environment variables clusterctl needs to install the CAPI infrastructure provider.
+	EnvMap(ctx context.Context, req *ClusterRequest) (map[string]string, error)
+}
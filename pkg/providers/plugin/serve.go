@@ -0,0 +1,41 @@
+package plugin
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"google.golang.org/grpc"
+)
+
+// SocketEnvVar is the environment variable the CLI sets with the path of the unix socket the
+// plugin has to serve on.
+const SocketEnvVar = "EKSA_PROVIDER_PLUGIN_SOCKET"
+
+// Serve serves provider over gRPC on the unix socket set by the CLI in SocketEnvVar, until the
+// process receives SIGINT or SIGTERM. Plugin binaries call it from their main function.
+func Serve(provider Provider) error {
+	socket := os.Getenv(SocketEnvVar)
+	if socket == "" {
+		return fmt.Errorf("%s is not set, provider plugins are started by eksctl anywhere", SocketEnvVar)
+	}
+
+	listener, err := net.Listen("unix", socket)
+	if err != nil {
+		return fmt.Errorf("listening on %s: %v", socket, err)
+	}
+
+	server := grpc.NewServer()
+	RegisterProviderServer(server, provider)
+
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGINT, syscall.SIGTERM)
+	go func() {
+		<-signals
+		server.GracefulStop()
+	}()
+
+	return server.Serve(listener)
+}
@@ -0,0 +1,93 @@
+package proxmox
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// Client is a minimal Proxmox VE API client used to validate the environment.
+type Client interface {
+	Version(ctx context.Context) (string, error)
+	Nodes(ctx context.Context) ([]string, error)
+}
+
+type apiClient struct {
+	endpoint   string
+	creds      Credentials
+	httpClient *http.Client
+}
+
+// NewClient returns a Proxmox VE API client for the given endpoint and API token.
+func NewClient(endpoint string, insecure bool, creds Credentials) Client {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if insecure {
+		transport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
+	}
+
+	return &apiClient{
+		endpoint:   strings.TrimSuffix(endpoint, "/"),
+		creds:      creds,
+		httpClient: &http.Client{Transport: transport},
+	}
+}
+
+// Version returns the Proxmox VE version.
+func (c *apiClient) Version(ctx context.Context) (string, error) {
+	resp := &struct {
+		Data struct {
+			Version string `json:"version"`
+		} `json:"data"`
+	}{}
+	if err := c.get(ctx, "/version", resp); err != nil {
+		return "", err
+	}
+
+	return resp.Data.Version, nil
+}
+
+// Nodes returns the names of the nodes in the Proxmox VE cluster.
+func (c *apiClient) Nodes(ctx context.Context) ([]string, error) {
+	resp := &struct {
+		Data []struct {
+			Node string `json:"node"`
+		} `json:"data"`
+	}{}
+	if err := c.get(ctx, "/nodes", resp); err != nil {
+		return nil, err
+	}
+
+	nodes := make([]string, 0, len(resp.Data))
+	for _, n := range resp.Data {
+		nodes = append(nodes, n.Node)
+	}
+
+	return nodes, nil
+}
+
+func (c *apiClient) get(ctx context.Context, path string, out interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.endpoint+"/api2/json"+path, nil)
+	if err != nil {
+		return fmt.Errorf("building proxmox request: %v", err)
+	}
+	req.Header.Set("Authorization", fmt.Sprintf("PVEAPIToken=%s=%s", c.creds.TokenID, c.creds.Secret))
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("calling proxmox api %s: %v", path, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("calling proxmox api %s: unexpected status %s", path, resp.Status)
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("decoding proxmox api %s response: %v", path, err)
+	}
+
+	return nil
+}
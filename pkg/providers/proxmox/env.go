@@ -0,0 +1,36 @@
+package proxmox
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/aws/eks-anywhere/pkg/constants"
+)
+
+// Env vars read by the CAPI Proxmox provider (CAPMOX) components manifest.
+const (
+	proxmoxURLKey    = "PROXMOX_URL"
+	proxmoxTokenKey  = "PROXMOX_TOKEN"
+	proxmoxSecretKey = "PROXMOX_SECRET"
+)
+
+// Credentials is a Proxmox VE API token.
+type Credentials struct {
+	TokenID string
+	Secret  string
+}
+
+// GetCredsFromEnv returns the Proxmox VE API token based on the environment.
+func GetCredsFromEnv() (Credentials, error) {
+	tokenID, ok := os.LookupEnv(constants.EksaProxmoxTokenIDKey)
+	if !ok || len(tokenID) == 0 {
+		return Credentials{}, fmt.Errorf("%s is not set or is empty", constants.EksaProxmoxTokenIDKey)
+	}
+
+	secret, ok := os.LookupEnv(constants.EksaProxmoxTokenSecretKey)
+	if !ok || len(secret) == 0 {
+		return Credentials{}, fmt.Errorf("%s is not set or is empty", constants.EksaProxmoxTokenSecretKey)
+	}
+
+	return Credentials{TokenID: tokenID, Secret: secret}, nil
+}
@@ -0,0 +1,49 @@
+package proxmox
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/aws/eks-anywhere/pkg/clients/kubernetes"
+)
+
+const (
+	proxmoxClusterKind         = "ProxmoxCluster"
+	proxmoxMachineTemplateKind = "ProxmoxMachineTemplate"
+)
+
+// infrastructureGroupVersion is the API group version of the CAPI Proxmox provider (CAPMOX) objects.
+// CAPMOX objects are handled as unstructured since its Go API is not vendored.
+var infrastructureGroupVersion = schema.GroupVersion{Group: "infrastructure.cluster.x-k8s.io", Version: "v1alpha1"}
+
+func newProxmoxCluster() *unstructured.Unstructured {
+	u := &unstructured.Unstructured{}
+	u.SetGroupVersionKind(infrastructureGroupVersion.WithKind(proxmoxClusterKind))
+	return u
+}
+
+func newProxmoxMachineTemplate() *unstructured.Unstructured {
+	u := &unstructured.Unstructured{}
+	u.SetGroupVersionKind(infrastructureGroupVersion.WithKind(proxmoxMachineTemplateKind))
+	return u
+}
+
+// GetMachineTemplate gets a ProxmoxMachineTemplate object using the provided client
+// If the object doesn't exist, it returns a NotFound error.
+func GetMachineTemplate(ctx context.Context, client kubernetes.Client, name, namespace string) (*unstructured.Unstructured, error) {
+	m := newProxmoxMachineTemplate()
+	if err := client.Get(ctx, name, namespace, m); err != nil {
+		return nil, errors.Wrap(err, "reading proxmoxMachineTemplate")
+	}
+
+	return m, nil
+}
+
+// MachineTemplateEqual returns a boolean indicating whether or not the provided ProxmoxMachineTemplates are equal.
+func MachineTemplateEqual(new, old *unstructured.Unstructured) bool {
+	return equality.Semantic.DeepDerivative(new.Object["spec"], old.Object["spec"])
+}
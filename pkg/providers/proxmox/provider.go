@@ -0,0 +1,298 @@
+package proxmox
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/bootstrapper"
+	"github.com/aws/eks-anywhere/pkg/cluster"
+	"github.com/aws/eks-anywhere/pkg/constants"
+	"github.com/aws/eks-anywhere/pkg/filewriter"
+	"github.com/aws/eks-anywhere/pkg/logger"
+	"github.com/aws/eks-anywhere/pkg/providers"
+	"github.com/aws/eks-anywhere/pkg/providers/common"
+	"github.com/aws/eks-anywhere/pkg/types"
+	releasev1alpha1 "github.com/aws/eks-anywhere/release/api/v1alpha1"
+)
+
+var (
+	eksaProxmoxDatacenterResourceType = fmt.Sprintf("proxmoxdatacenterconfigs.%s", v1alpha1.GroupVersion.Group)
+	eksaProxmoxMachineResourceType    = fmt.Sprintf("proxmoxmachineconfigs.%s", v1alpha1.GroupVersion.Group)
+)
+
+// Provider implements the Proxmox VE Provider.
+type Provider struct {
+	clusterConfig    *v1alpha1.Cluster
+	datacenterConfig *v1alpha1.ProxmoxDatacenterConfig
+	machineConfigs   map[string]*v1alpha1.ProxmoxMachineConfig
+	validator        *Validator
+	writer           filewriter.FileWriter
+	ipValidator      IPValidator
+	skipIPCheck      bool
+}
+
+var _ providers.Provider = &Provider{}
+
+// NewProvider returns a new proxmox provider.
+func NewProvider(
+	datacenterConfig *v1alpha1.ProxmoxDatacenterConfig,
+	machineConfigs map[string]*v1alpha1.ProxmoxMachineConfig,
+	clusterConfig *v1alpha1.Cluster,
+	validator *Validator,
+	writer filewriter.FileWriter,
+	ipValidator IPValidator,
+	skipIPCheck bool,
+) *Provider {
+	for _, machineConfig := range machineConfigs {
+		machineConfig.SetDefaults()
+	}
+
+	return &Provider{
+		clusterConfig:    clusterConfig,
+		datacenterConfig: datacenterConfig,
+		machineConfigs:   machineConfigs,
+		validator:        validator,
+		writer:           writer,
+		ipValidator:      ipValidator,
+		skipIPCheck:      skipIPCheck,
+	}
+}
+
+// Name returns the name of the provider.
+func (p *Provider) Name() string {
+	return constants.ProxmoxProviderName
+}
+
+// DatacenterResourceType returns the resource type of the ProxmoxDatacenterConfig.
+func (p *Provider) DatacenterResourceType() string {
+	return eksaProxmoxDatacenterResourceType
+}
+
+// MachineResourceType returns the resource type of the ProxmoxMachineConfig.
+func (p *Provider) MachineResourceType() string {
+	return eksaProxmoxMachineResourceType
+}
+
+// BootstrapClusterOpts returns the options for the bootstrap cluster.
+func (p *Provider) BootstrapClusterOpts(_ *cluster.Spec) ([]bootstrapper.BootstrapClusterOption, error) {
+	return nil, nil
+}
+
+// PostBootstrapSetup is a no-op. It implements providers.Provider.
+func (p *Provider) PostBootstrapSetup(_ context.Context, _ *v1alpha1.Cluster, _ *types.Cluster) error {
+	return nil
+}
+
+// PostWorkloadInit is a no-op. It implements providers.Provider.
+func (p *Provider) PostWorkloadInit(_ context.Context, _ *types.Cluster, _ *cluster.Spec) error {
+	return nil
+}
+
+// SetupAndValidateCreateCluster validates the cluster spec against the Proxmox VE environment
+// and generates an SSH key for the machines if none is provided.
+func (p *Provider) SetupAndValidateCreateCluster(ctx context.Context, clusterSpec *cluster.Spec) error {
+	if err := p.validator.ValidateClusterSpec(ctx, clusterSpec); err != nil {
+		return fmt.Errorf("failed to validate cluster spec: %v", err)
+	}
+
+	if err := p.generateSSHKeysIfNotSet(); err != nil {
+		return fmt.Errorf("failed to generate ssh key: %v", err)
+	}
+	clusterSpec.ProxmoxMachineConfigs = p.machineConfigs
+
+	if !p.skipIPCheck {
+		if err := p.ipValidator.ValidateControlPlaneIPUniqueness(clusterSpec.Cluster); err != nil {
+			return err
+		}
+	} else {
+		logger.Info("Skipping check for whether control plane ip is in use")
+	}
+
+	return nil
+}
+
+// SetupAndValidateDeleteCluster checks the Proxmox VE credentials are set.
+func (p *Provider) SetupAndValidateDeleteCluster(_ context.Context, _ *types.Cluster, _ *cluster.Spec) error {
+	if _, err := GetCredsFromEnv(); err != nil {
+		return fmt.Errorf("failed setup and validations: %v", err)
+	}
+
+	return nil
+}
+
+// SetupAndValidateUpgradeCluster validates the new cluster spec against the Proxmox VE environment.
+func (p *Provider) SetupAndValidateUpgradeCluster(ctx context.Context, _ *types.Cluster, clusterSpec *cluster.Spec, _ *cluster.Spec) error {
+	if err := p.validator.ValidateClusterSpec(ctx, clusterSpec); err != nil {
+		return fmt.Errorf("failed to validate cluster spec: %v", err)
+	}
+
+	return nil
+}
+
+// SetupAndValidateUpgradeManagementComponents checks the Proxmox VE credentials are set.
+func (p *Provider) SetupAndValidateUpgradeManagementComponents(_ context.Context, _ *cluster.Spec) error {
+	if _, err := GetCredsFromEnv(); err != nil {
+		return fmt.Errorf("failed setup and validations: %v", err)
+	}
+
+	return nil
+}
+
+func (p *Provider) generateSSHKeysIfNotSet() error {
+	var generatedKey string
+	for _, machineConfig := range p.machineConfigs {
+		user := machineConfig.Spec.Users[0]
+		if user.SshAuthorizedKeys[0] == "" {
+			if generatedKey != "" { // use the same key
+				user.SshAuthorizedKeys[0] = generatedKey
+			} else {
+				logger.Info("Provided sshAuthorizedKey is not set or is empty, auto-generating new key pair...", "ProxmoxMachineConfig", machineConfig.Name)
+				var err error
+				generatedKey, err = common.GenerateSSHAuthKey(p.writer)
+				if err != nil {
+					return err
+				}
+				user.SshAuthorizedKeys[0] = generatedKey
+			}
+		}
+	}
+
+	return nil
+}
+
+// UpdateSecrets is a no-op. CAPMOX reads the API token from the secret created by its components manifest.
+func (p *Provider) UpdateSecrets(_ context.Context, _ *types.Cluster, _ *cluster.Spec) error {
+	return nil
+}
+
+// PreCAPIInstallOnBootstrap is a no-op. It implements providers.Provider.
+func (p *Provider) PreCAPIInstallOnBootstrap(_ context.Context, _ *types.Cluster, _ *cluster.Spec) error {
+	return nil
+}
+
+// UpdateKubeConfig is a no-op. It implements providers.Provider.
+func (p *Provider) UpdateKubeConfig(_ *[]byte, _ string) error {
+	return nil
+}
+
+// Version returns the version of the provider.
+func (p *Provider) Version(components *cluster.ManagementComponents) string {
+	return components.Proxmox.Version
+}
+
+// EnvMap returns the environment variables CAPMOX needs to be installed with.
+func (p *Provider) EnvMap(_ *cluster.ManagementComponents, _ *cluster.Spec) (map[string]string, error) {
+	creds, err := GetCredsFromEnv()
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]string{
+		proxmoxURLKey:    p.datacenterConfig.Spec.Endpoint,
+		proxmoxTokenKey:  creds.TokenID,
+		proxmoxSecretKey: creds.Secret,
+	}, nil
+}
+
+// GetDeployments returns the CAPMOX deployments.
+func (p *Provider) GetDeployments() map[string][]string {
+	return map[string][]string{
+		constants.CapmoxSystemNamespace: {"capmox-controller-manager"},
+	}
+}
+
+// GetInfrastructureBundle returns the infrastructure bundle for the provider.
+func (p *Provider) GetInfrastructureBundle(components *cluster.ManagementComponents) *types.InfrastructureBundle {
+	manifests := []releasev1alpha1.Manifest{
+		components.Proxmox.Components,
+		components.Proxmox.Metadata,
+	}
+	folderName := fmt.Sprintf("infrastructure-proxmox/%s/", components.Proxmox.Version)
+	infraBundle := types.InfrastructureBundle{
+		FolderName: folderName,
+		Manifests:  manifests,
+	}
+	return &infraBundle
+}
+
+// DatacenterConfig returns the ProxmoxDatacenterConfig.
+func (p *Provider) DatacenterConfig(_ *cluster.Spec) providers.DatacenterConfig {
+	return p.datacenterConfig
+}
+
+// MachineConfigs returns a MachineConfig slice.
+func (p *Provider) MachineConfigs(_ *cluster.Spec) []providers.MachineConfig {
+	configs := make(map[string]providers.MachineConfig, len(p.machineConfigs))
+	controlPlaneMachineName := p.clusterConfig.Spec.ControlPlaneConfiguration.MachineGroupRef.Name
+	p.machineConfigs[controlPlaneMachineName].Annotations = map[string]string{p.clusterConfig.ControlPlaneAnnotation(): "true"}
+	if p.clusterConfig.IsManaged() {
+		p.machineConfigs[controlPlaneMachineName].SetManagedBy(p.clusterConfig.ManagedBy())
+	}
+	configs[controlPlaneMachineName] = p.machineConfigs[controlPlaneMachineName]
+
+	for _, workerNodeGroupConfiguration := range p.clusterConfig.Spec.WorkerNodeGroupConfigurations {
+		workerMachineName := workerNodeGroupConfiguration.MachineGroupRef.Name
+		if _, ok := configs[workerMachineName]; !ok {
+			configs[workerMachineName] = p.machineConfigs[workerMachineName]
+			if p.clusterConfig.IsManaged() {
+				p.machineConfigs[workerMachineName].SetManagedBy(p.clusterConfig.ManagedBy())
+			}
+		}
+	}
+
+	machineConfigs := make([]providers.MachineConfig, 0, len(configs))
+	for _, config := range configs {
+		machineConfigs = append(machineConfigs, config)
+	}
+
+	return machineConfigs
+}
+
+// ValidateNewSpec is a no-op. It implements providers.Provider.
+func (p *Provider) ValidateNewSpec(_ context.Context, _ *types.Cluster, _ *cluster.Spec) error {
+	return nil
+}
+
+// ChangeDiff returns the component change diff for the provider.
+func (p *Provider) ChangeDiff(currentComponents, newComponents *cluster.ManagementComponents) *types.ComponentChangeDiff {
+	if currentComponents.Proxmox.Version == newComponents.Proxmox.Version {
+		return nil
+	}
+
+	return &types.ComponentChangeDiff{
+		ComponentName: constants.ProxmoxProviderName,
+		NewVersion:    newComponents.Proxmox.Version,
+		OldVersion:    currentComponents.Proxmox.Version,
+	}
+}
+
+// RunPostControlPlaneUpgrade is a no-op. It implements providers.Provider.
+func (p *Provider) RunPostControlPlaneUpgrade(_ context.Context, _ *cluster.Spec, _ *cluster.Spec, _ *types.Cluster, _ *types.Cluster) error {
+	return nil
+}
+
+// InstallCustomProviderComponents is a no-op. It implements providers.Provider.
+func (p *Provider) InstallCustomProviderComponents(_ context.Context, _ string) error {
+	return nil
+}
+
+// PostClusterDeleteValidate is a no-op. It implements providers.Provider.
+func (p *Provider) PostClusterDeleteValidate(_ context.Context, _ *types.Cluster) error {
+	return nil
+}
+
+// PostMoveManagementToBootstrap is a no-op. It implements providers.Provider.
+func (p *Provider) PostMoveManagementToBootstrap(_ context.Context, _ *types.Cluster) error {
+	return nil
+}
+
+// PreCoreComponentsUpgrade is a no-op. It implements providers.Provider.
+func (p *Provider) PreCoreComponentsUpgrade(
+	_ context.Context,
+	_ *types.Cluster,
+	_ *cluster.ManagementComponents,
+	_ *cluster.Spec,
+) error {
+	return nil
+}
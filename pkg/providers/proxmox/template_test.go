@@ -0,0 +1,187 @@
+package proxmox
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/yaml"
+
+	"github.com/aws/eks-anywhere/internal/test"
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/cluster"
+)
+
+func proxmoxClusterSpec() *cluster.Spec {
+	return test.NewClusterSpec(func(s *cluster.Spec) {
+		s.Cluster.Name = "test"
+		s.Cluster.Spec.ControlPlaneConfiguration = anywherev1.ControlPlaneConfiguration{
+			Count:    1,
+			Endpoint: &anywherev1.Endpoint{Host: "10.0.0.5"},
+			MachineGroupRef: &anywherev1.Ref{
+				Kind: anywherev1.ProxmoxMachineConfigKind,
+				Name: "test-cp",
+			},
+		}
+		s.Cluster.Spec.WorkerNodeGroupConfigurations = []anywherev1.WorkerNodeGroupConfiguration{
+			{
+				Name:  "md-0",
+				Count: ptrInt(2),
+				MachineGroupRef: &anywherev1.Ref{
+					Kind: anywherev1.ProxmoxMachineConfigKind,
+					Name: "test-md",
+				},
+			},
+		}
+		s.Cluster.Spec.DatacenterRef = anywherev1.Ref{
+			Kind: anywherev1.ProxmoxDatacenterKind,
+			Name: "test",
+		}
+		s.Cluster.Spec.ClusterNetwork = anywherev1.ClusterNetwork{
+			Pods:     anywherev1.Pods{CidrBlocks: []string{"192.168.0.0/16"}},
+			Services: anywherev1.Services{CidrBlocks: []string{"10.96.0.0/12"}},
+		}
+		s.ProxmoxDatacenter = &anywherev1.ProxmoxDatacenterConfig{
+			Spec: anywherev1.ProxmoxDatacenterConfigSpec{
+				Endpoint: "https://pve.example.com:8006",
+				Nodes:    []string{"pve1", "pve2"},
+				IPv4Config: anywherev1.ProxmoxIPv4Config{
+					Addresses: []string{"10.0.0.10-10.0.0.50"},
+					Prefix:    24,
+					Gateway:   "10.0.0.1",
+				},
+				DNSServers: []string{"10.0.0.1"},
+			},
+		}
+		s.ProxmoxMachineConfigs = map[string]*anywherev1.ProxmoxMachineConfig{
+			"test-cp": proxmoxMachineConfig("test-cp", 4096),
+			"test-md": proxmoxMachineConfig("test-md", 8192),
+		}
+		s.VersionsBundles[anywherev1.Kube119].KubeDistro.Kubernetes.Tag = "v1.19.8-eks-1-19-4"
+	})
+}
+
+func proxmoxMachineConfig(name string, memoryMiB int32) *anywherev1.ProxmoxMachineConfig {
+	m := &anywherev1.ProxmoxMachineConfig{
+		Spec: anywherev1.ProxmoxMachineConfigSpec{
+			SourceNode: "pve1",
+			TemplateID: 9000,
+			MemoryMiB:  memoryMiB,
+			Bridge:     "vmbr0",
+			Users: []anywherev1.UserConfiguration{
+				{Name: "eksa", SshAuthorizedKeys: []string{"ssh-rsa AAAA"}},
+			},
+		},
+	}
+	m.Name = name
+	m.SetDefaults()
+	return m
+}
+
+func ptrInt(i int) *int {
+	return &i
+}
+
+func parseObjects(t *testing.T, content []byte) map[string]*unstructured.Unstructured {
+	t.Helper()
+	objs := map[string]*unstructured.Unstructured{}
+	for _, doc := range strings.Split(string(content), "\n---\n") {
+		if strings.TrimSpace(doc) == "" {
+			continue
+		}
+		json, err := yaml.YAMLToJSON([]byte(doc))
+		if err != nil {
+			t.Fatalf("parsing generated yaml: %v", err)
+		}
+		u := &unstructured.Unstructured{}
+		if err := u.UnmarshalJSON(json); err != nil {
+			t.Fatalf("parsing generated yaml: %v", err)
+		}
+		objs[u.GetKind()+"/"+u.GetName()] = u
+	}
+	return objs
+}
+
+func TestTemplateBuilderGenerateCAPISpecControlPlane(t *testing.T) {
+	g := NewWithT(t)
+	builder := NewTemplateBuilder(time.Now)
+
+	content, err := builder.GenerateCAPISpecControlPlane(proxmoxClusterSpec())
+	g.Expect(err).NotTo(HaveOccurred())
+
+	objs := parseObjects(t, content)
+	g.Expect(objs).To(HaveKey("Cluster/test"))
+	g.Expect(objs).To(HaveKey("KubeadmControlPlane/test"))
+
+	proxmoxCluster := objs["ProxmoxCluster/test"]
+	g.Expect(proxmoxCluster).NotTo(BeNil())
+	host, _, _ := unstructured.NestedString(proxmoxCluster.Object, "spec", "controlPlaneEndpoint", "host")
+	g.Expect(host).To(Equal("10.0.0.5"))
+	nodes, _, _ := unstructured.NestedStringSlice(proxmoxCluster.Object, "spec", "allowedNodes")
+	g.Expect(nodes).To(Equal([]string{"pve1", "pve2"}))
+	addresses, _, _ := unstructured.NestedStringSlice(proxmoxCluster.Object, "spec", "ipv4Config", "addresses")
+	g.Expect(addresses).To(Equal([]string{"10.0.0.10-10.0.0.50"}))
+
+	var machineTemplate *unstructured.Unstructured
+	for key, obj := range objs {
+		if strings.HasPrefix(key, "ProxmoxMachineTemplate/") {
+			machineTemplate = obj
+		}
+	}
+	g.Expect(machineTemplate).NotTo(BeNil())
+	templateID, _, _ := unstructured.NestedInt64(machineTemplate.Object, "spec", "template", "spec", "templateID")
+	g.Expect(templateID).To(Equal(int64(9000)))
+	memory, _, _ := unstructured.NestedInt64(machineTemplate.Object, "spec", "template", "spec", "memoryMiB")
+	g.Expect(memory).To(Equal(int64(4096)))
+}
+
+func TestTemplateBuilderGenerateCAPISpecControlPlaneMissingMachineConfig(t *testing.T) {
+	g := NewWithT(t)
+	spec := proxmoxClusterSpec()
+	delete(spec.ProxmoxMachineConfigs, "test-cp")
+
+	_, err := NewTemplateBuilder(time.Now).GenerateCAPISpecControlPlane(spec)
+	g.Expect(err).To(HaveOccurred())
+}
+
+func TestTemplateBuilderCAPIWorkersSpecWithInitialNames(t *testing.T) {
+	g := NewWithT(t)
+
+	content, err := NewTemplateBuilder(time.Now).CAPIWorkersSpecWithInitialNames(proxmoxClusterSpec())
+	g.Expect(err).NotTo(HaveOccurred())
+
+	objs := parseObjects(t, content)
+	md := objs["MachineDeployment/test-md-0"]
+	g.Expect(md).NotTo(BeNil())
+	replicas, _, _ := unstructured.NestedInt64(md.Object, "spec", "replicas")
+	g.Expect(replicas).To(Equal(int64(2)))
+
+	machineTemplate := objs["ProxmoxMachineTemplate/test-md-0-1"]
+	g.Expect(machineTemplate).NotTo(BeNil())
+	memory, _, _ := unstructured.NestedInt64(machineTemplate.Object, "spec", "template", "spec", "memoryMiB")
+	g.Expect(memory).To(Equal(int64(8192)))
+	g.Expect(objs).To(HaveKey("KubeadmConfigTemplate/test-md-0-1"))
+}
+
+func TestTemplateBuilderGenerateCAPISpecControlPlaneMultipleUsers(t *testing.T) {
+	g := NewWithT(t)
+	spec := proxmoxClusterSpec()
+	spec.ProxmoxMachineConfigs["test-cp"].Spec.Users = []anywherev1.UserConfiguration{
+		{Name: "eksa", SshAuthorizedKeys: []string{"ssh-rsa AAAA", "ssh-rsa BBBB"}},
+		{Name: "ops", SshAuthorizedKeys: []string{"ssh-rsa CCCC"}, Sudo: "ALL=(ALL) ALL"},
+	}
+
+	content, err := NewTemplateBuilder(time.Now).GenerateCAPISpecControlPlane(spec)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	kcp := parseObjects(t, content)["KubeadmControlPlane/test"]
+	g.Expect(kcp).NotTo(BeNil())
+	users, _, _ := unstructured.NestedSlice(kcp.Object, "spec", "kubeadmConfigSpec", "users")
+	g.Expect(users).To(HaveLen(2))
+	g.Expect(users[0]).To(HaveKeyWithValue("sshAuthorizedKeys", []interface{}{"ssh-rsa AAAA", "ssh-rsa BBBB"}))
+	g.Expect(users[0]).To(HaveKeyWithValue("sudo", anywherev1.DefaultUserSudoPolicy))
+	g.Expect(users[1]).To(HaveKeyWithValue("name", "ops"))
+	g.Expect(users[1]).To(HaveKeyWithValue("sudo", "ALL=(ALL) ALL"))
+}
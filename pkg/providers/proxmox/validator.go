@@ -0,0 +1,111 @@
+package proxmox
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"slices"
+	"strings"
+
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/cluster"
+)
+
+// IPValidator is an interface that defines methods to validate the control plane IP.
+type IPValidator interface {
+	ValidateControlPlaneIPUniqueness(cluster *anywherev1.Cluster) error
+}
+
+// Validator validates a cluster spec against a Proxmox VE environment.
+type Validator struct {
+	client Client
+}
+
+// NewValidator returns a new Validator.
+func NewValidator(client Client) *Validator {
+	return &Validator{
+		client: client,
+	}
+}
+
+// ValidateClusterSpec validates the cluster spec against the Proxmox VE API.
+func (v *Validator) ValidateClusterSpec(ctx context.Context, spec *cluster.Spec) error {
+	if err := ValidateClusterConfig(spec); err != nil {
+		return err
+	}
+
+	if _, err := v.client.Version(ctx); err != nil {
+		return fmt.Errorf("connecting to proxmox endpoint %s: %v", spec.ProxmoxDatacenter.Spec.Endpoint, err)
+	}
+
+	nodes, err := v.client.Nodes(ctx)
+	if err != nil {
+		return fmt.Errorf("listing proxmox nodes: %v", err)
+	}
+
+	for _, node := range spec.ProxmoxDatacenter.Spec.Nodes {
+		if !slices.Contains(nodes, node) {
+			return fmt.Errorf("proxmox node %s not found", node)
+		}
+	}
+
+	for _, machineConfig := range spec.ProxmoxMachineConfigs {
+		if !slices.Contains(nodes, machineConfig.Spec.SourceNode) {
+			return fmt.Errorf("sourceNode %s of ProxmoxMachineConfig %s not found", machineConfig.Spec.SourceNode, machineConfig.Name)
+		}
+	}
+
+	return nil
+}
+
+// ValidateClusterConfig runs the validations that don't require access to the Proxmox VE API.
+func ValidateClusterConfig(spec *cluster.Spec) error {
+	if spec.Cluster.Spec.ExternalEtcdConfiguration != nil {
+		return errors.New("external etcd is not supported for proxmox clusters")
+	}
+
+	if spec.Cluster.Spec.ControlPlaneConfiguration.Endpoint == nil || spec.Cluster.Spec.ControlPlaneConfiguration.Endpoint.Host == "" {
+		return errors.New("cluster controlPlaneConfiguration.Endpoint.Host is not set or is empty")
+	}
+
+	endpoint := net.ParseIP(spec.Cluster.Spec.ControlPlaneConfiguration.Endpoint.Host)
+	if endpoint == nil || endpoint.To4() == nil {
+		return fmt.Errorf("cluster controlPlaneConfiguration.Endpoint.Host %s is not a valid IPv4 address", spec.Cluster.Spec.ControlPlaneConfiguration.Endpoint.Host)
+	}
+
+	if ipInAddresses(endpoint, spec.ProxmoxDatacenter.Spec.IPv4Config.Addresses) {
+		return fmt.Errorf("cluster controlPlaneConfiguration.Endpoint.Host %s must not be part of the ProxmoxDatacenterConfig ipv4Config addresses", endpoint)
+	}
+
+	return nil
+}
+
+// ipInAddresses returns true if ip is one of the addresses, CIDRs or ranges in addresses.
+func ipInAddresses(ip net.IP, addresses []string) bool {
+	ip = ip.To4()
+	for _, address := range addresses {
+		if _, cidr, err := net.ParseCIDR(address); err == nil {
+			if cidr.Contains(ip) {
+				return true
+			}
+			continue
+		}
+
+		start, end, isRange := strings.Cut(address, "-")
+		if !isRange {
+			if ip.Equal(net.ParseIP(address)) {
+				return true
+			}
+			continue
+		}
+
+		startIP, endIP := net.ParseIP(start).To4(), net.ParseIP(end).To4()
+		if startIP != nil && endIP != nil && bytes.Compare(ip, startIP) >= 0 && bytes.Compare(ip, endIP) <= 0 {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,138 @@
+package proxmox
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+)
+
+func newFakeProxmoxServer(t *testing.T, nodes ...string) *httptest.Server {
+	t.Helper()
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api2/json/version", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "PVEAPIToken=capmox@pve!eksa=secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		fmt.Fprint(w, `{"data":{"version":"8.2.4"}}`)
+	})
+	mux.HandleFunc("/api2/json/nodes", func(w http.ResponseWriter, _ *http.Request) {
+		fmt.Fprint(w, `{"data":[`)
+		for i, n := range nodes {
+			if i > 0 {
+				fmt.Fprint(w, ",")
+			}
+			fmt.Fprintf(w, `{"node":%q}`, n)
+		}
+		fmt.Fprint(w, `]}`)
+	})
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return server
+}
+
+func newTestValidator(endpoint, secret string) *Validator {
+	return NewValidator(NewClient(endpoint, false, Credentials{TokenID: "capmox@pve!eksa", Secret: secret}))
+}
+
+func TestValidatorValidateClusterSpecSuccess(t *testing.T) {
+	g := NewWithT(t)
+	server := newFakeProxmoxServer(t, "pve1", "pve2")
+
+	g.Expect(newTestValidator(server.URL, "secret").ValidateClusterSpec(context.Background(), proxmoxClusterSpec())).To(Succeed())
+}
+
+func TestValidatorValidateClusterSpecUnauthorized(t *testing.T) {
+	g := NewWithT(t)
+	server := newFakeProxmoxServer(t, "pve1", "pve2")
+
+	err := newTestValidator(server.URL, "wrong").ValidateClusterSpec(context.Background(), proxmoxClusterSpec())
+	g.Expect(err).To(MatchError(ContainSubstring("connecting to proxmox endpoint")))
+}
+
+func TestValidatorValidateClusterSpecNodeNotFound(t *testing.T) {
+	g := NewWithT(t)
+	server := newFakeProxmoxServer(t, "pve1")
+
+	err := newTestValidator(server.URL, "secret").ValidateClusterSpec(context.Background(), proxmoxClusterSpec())
+	g.Expect(err).To(MatchError("proxmox node pve2 not found"))
+}
+
+func TestValidatorValidateClusterSpecSourceNodeNotFound(t *testing.T) {
+	g := NewWithT(t)
+	server := newFakeProxmoxServer(t, "pve1", "pve2")
+	spec := proxmoxClusterSpec()
+	spec.ProxmoxMachineConfigs["test-md"].Spec.SourceNode = "pve3"
+
+	err := newTestValidator(server.URL, "secret").ValidateClusterSpec(context.Background(), spec)
+	g.Expect(err).To(MatchError("sourceNode pve3 of ProxmoxMachineConfig test-md not found"))
+}
+
+func TestValidateClusterConfig(t *testing.T) {
+	tests := []struct {
+		name    string
+		update  func(*anywherev1.Cluster, *anywherev1.ProxmoxDatacenterConfig)
+		wantErr string
+	}{
+		{
+			name:   "valid",
+			update: func(*anywherev1.Cluster, *anywherev1.ProxmoxDatacenterConfig) {},
+		},
+		{
+			name: "external etcd",
+			update: func(c *anywherev1.Cluster, _ *anywherev1.ProxmoxDatacenterConfig) {
+				c.Spec.ExternalEtcdConfiguration = &anywherev1.ExternalEtcdConfiguration{Count: 3}
+			},
+			wantErr: "external etcd is not supported for proxmox clusters",
+		},
+		{
+			name: "endpoint not an ip",
+			update: func(c *anywherev1.Cluster, _ *anywherev1.ProxmoxDatacenterConfig) {
+				c.Spec.ControlPlaneConfiguration.Endpoint.Host = "cp.example.com"
+			},
+			wantErr: "cluster controlPlaneConfiguration.Endpoint.Host cp.example.com is not a valid IPv4 address",
+		},
+		{
+			name: "endpoint in address range",
+			update: func(c *anywherev1.Cluster, _ *anywherev1.ProxmoxDatacenterConfig) {
+				c.Spec.ControlPlaneConfiguration.Endpoint.Host = "10.0.0.20"
+			},
+			wantErr: "cluster controlPlaneConfiguration.Endpoint.Host 10.0.0.20 must not be part of the ProxmoxDatacenterConfig ipv4Config addresses",
+		},
+		{
+			name: "endpoint in address cidr",
+			update: func(_ *anywherev1.Cluster, dc *anywherev1.ProxmoxDatacenterConfig) {
+				dc.Spec.IPv4Config.Addresses = []string{"10.0.0.0/28"}
+			},
+			wantErr: "cluster controlPlaneConfiguration.Endpoint.Host 10.0.0.5 must not be part of the ProxmoxDatacenterConfig ipv4Config addresses",
+		},
+		{
+			name: "endpoint equal to address",
+			update: func(_ *anywherev1.Cluster, dc *anywherev1.ProxmoxDatacenterConfig) {
+				dc.Spec.IPv4Config.Addresses = []string{"10.0.0.10-10.0.0.50", "10.0.0.5"}
+			},
+			wantErr: "cluster controlPlaneConfiguration.Endpoint.Host 10.0.0.5 must not be part of the ProxmoxDatacenterConfig ipv4Config addresses",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			spec := proxmoxClusterSpec()
+			tt.update(spec.Cluster, spec.ProxmoxDatacenter)
+
+			err := ValidateClusterConfig(spec)
+			if tt.wantErr == "" {
+				g.Expect(err).NotTo(HaveOccurred())
+			} else {
+				g.Expect(err).To(MatchError(tt.wantErr))
+			}
+		})
+	}
+}
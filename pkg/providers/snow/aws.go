@@ -13,6 +13,7 @@ type AwsClient interface {
 	EC2InstanceTypes(ctx context.Context) ([]aws.EC2InstanceType, error)
 	IsSnowballDeviceUnlocked(ctx context.Context) (bool, error)
 	SnowballDeviceSoftwareVersion(ctx context.Context) (string, error)
+	SnowballDeviceCapacities(ctx context.Context) ([]aws.SnowballDeviceCapacity, error)
 }
 
 // LocalIMDSClient contains methods that fetch metadata from the local imds.
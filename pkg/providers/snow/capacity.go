@@ -0,0 +1,240 @@
+package snow
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/aws"
+	"github.com/aws/eks-anywhere/pkg/cluster"
+	"github.com/aws/eks-anywhere/pkg/logger"
+)
+
+const (
+	vCPUCapacityName   = "vCPU"
+	memoryCapacityName = "Memory"
+)
+
+// machineGroup is a group of machines created from the same snow machine config.
+type machineGroup struct {
+	name          string
+	machineConfig string
+	count         int
+}
+
+func machineGroups(c *v1alpha1.Cluster) []machineGroup {
+	groups := []machineGroup{
+		{
+			name:          "control-plane",
+			machineConfig: machineConfigName(c.Spec.ControlPlaneConfiguration.MachineGroupRef),
+			count:         c.Spec.ControlPlaneConfiguration.Count,
+		},
+	}
+
+	if etcd := c.Spec.ExternalEtcdConfiguration; etcd != nil {
+		groups = append(groups, machineGroup{
+			name:          "etcd",
+			machineConfig: machineConfigName(etcd.MachineGroupRef),
+			count:         etcd.Count,
+		})
+	}
+
+	for _, w := range c.Spec.WorkerNodeGroupConfigurations {
+		count := 0
+		if w.Count != nil {
+			count = *w.Count
+		}
+		// The autoscaler can scale the group up to its max count.
+		if w.AutoScalingConfiguration != nil {
+			count = max(count, w.AutoScalingConfiguration.MaxCount)
+		}
+		groups = append(groups, machineGroup{
+			name:          w.Name,
+			machineConfig: machineConfigName(w.MachineGroupRef),
+			count:         count,
+		})
+	}
+
+	return groups
+}
+
+func machineConfigName(ref *v1alpha1.Ref) string {
+	if ref == nil {
+		return ""
+	}
+	return ref.Name
+}
+
+// deviceRequest is the capacity the machines placed on a device need.
+type deviceRequest struct {
+	instances int
+	vCPU      int64
+	memoryMiB int64
+}
+
+// deviceCapacity is the available capacity of a device. A negative value means the device
+// doesn't report the capacity.
+type deviceCapacity struct {
+	vCPU      int64
+	memoryMiB int64
+}
+
+// ValidateDeviceCapacity validates each device has enough vCPU and memory available for the
+// machines of the cluster, assuming the machines of each group are spread evenly across the
+// devices of its machine config. When current is set, only the machines the upgrade adds are
+// accounted for, plus the extra machine a rolling upgrade creates for each group.
+func (v *Validator) ValidateDeviceCapacity(ctx context.Context, desired, current *cluster.Config) error {
+	currentCounts := map[string]int{}
+	if current != nil {
+		for _, g := range machineGroups(current.Cluster) {
+			currentCounts[g.name] = g.count
+		}
+	}
+
+	placements := map[string]map[string]int{}
+	for _, g := range machineGroups(desired.Cluster) {
+		m, ok := desired.SnowMachineConfigs[g.machineConfig]
+		if !ok || len(m.Spec.Devices) == 0 {
+			continue
+		}
+
+		count := g.count
+		if currentCount, ok := currentCounts[g.name]; ok {
+			count = max(count-currentCount, 0) + 1
+		}
+
+		for i := 0; i < count; i++ {
+			ip := m.Spec.Devices[i%len(m.Spec.Devices)]
+			if placements[ip] == nil {
+				placements[ip] = map[string]int{}
+			}
+			placements[ip][m.Spec.InstanceType]++
+		}
+	}
+
+	if len(placements) == 0 {
+		return nil
+	}
+
+	clientMap, err := v.clientRegistry.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	ips := make([]string, 0, len(placements))
+	for ip := range placements {
+		ips = append(ips, ip)
+	}
+	sort.Strings(ips)
+
+	var insufficient []string
+	for _, ip := range ips {
+		client, ok := clientMap[ip]
+		if !ok {
+			return fmt.Errorf("credentials not found for device [%s]", ip)
+		}
+
+		request, err := deviceRequestFor(ctx, client, ip, placements[ip])
+		if err != nil {
+			return err
+		}
+
+		available, err := availableDeviceCapacity(ctx, client, ip)
+		if err != nil {
+			return err
+		}
+
+		report := capacityReport(ip, request, available)
+		logger.V(3).Info("Snow device capacity", "device", ip, "report", report)
+
+		if (available.vCPU >= 0 && request.vCPU > available.vCPU) || (available.memoryMiB >= 0 && request.memoryMiB > available.memoryMiB) {
+			insufficient = append(insufficient, report)
+		}
+	}
+
+	if len(insufficient) > 0 {
+		return fmt.Errorf("insufficient capacity on snow devices:\n%s", strings.Join(insufficient, "\n"))
+	}
+
+	return nil
+}
+
+func deviceRequestFor(ctx context.Context, client AwsClient, ip string, instances map[string]int) (deviceRequest, error) {
+	instanceTypes, err := client.EC2InstanceTypes(ctx)
+	if err != nil {
+		return deviceRequest{}, fmt.Errorf("fetching supported instance types for device [%s]: %v", ip, err)
+	}
+
+	byName := make(map[string]aws.EC2InstanceType, len(instanceTypes))
+	for _, it := range instanceTypes {
+		byName[it.Name] = it
+	}
+
+	request := deviceRequest{}
+	for instanceType, count := range instances {
+		it, ok := byName[instanceType]
+		if !ok {
+			return deviceRequest{}, fmt.Errorf("the instance type [%s] is not supported in device [%s]", instanceType, ip)
+		}
+
+		request.instances += count
+		if it.DefaultVCPU != nil {
+			request.vCPU += int64(*it.DefaultVCPU) * int64(count)
+		}
+		if it.MemoryMiB != nil {
+			request.memoryMiB += *it.MemoryMiB * int64(count)
+		}
+	}
+
+	return request, nil
+}
+
+func availableDeviceCapacity(ctx context.Context, client AwsClient, ip string) (deviceCapacity, error) {
+	capacities, err := client.SnowballDeviceCapacities(ctx)
+	if err != nil {
+		return deviceCapacity{}, fmt.Errorf("fetching capacity for device [%s]: %v", ip, err)
+	}
+
+	available := deviceCapacity{vCPU: -1, memoryMiB: -1}
+	for _, c := range capacities {
+		switch c.Name {
+		case vCPUCapacityName:
+			available.vCPU = c.Available
+		case memoryCapacityName:
+			memory, err := toMiB(c.Available, c.Unit)
+			if err != nil {
+				return deviceCapacity{}, fmt.Errorf("reading memory capacity for device [%s]: %v", ip, err)
+			}
+			available.memoryMiB = memory
+		}
+	}
+
+	return available, nil
+}
+
+func toMiB(value int64, unit string) (int64, error) {
+	switch strings.ToLower(unit) {
+	case "byte", "bytes":
+		return value / (1 << 20), nil
+	case "mb", "mib":
+		return value, nil
+	case "gb", "gib":
+		return value * (1 << 10), nil
+	default:
+		return 0, fmt.Errorf("unsupported unit %s", unit)
+	}
+}
+
+func capacityReport(ip string, request deviceRequest, available deviceCapacity) string {
+	return fmt.Sprintf("device [%s]: %d instances require %d vCPU and %d MiB memory, available %s vCPU and %s MiB memory",
+		ip, request.instances, request.vCPU, request.memoryMiB, capacityString(available.vCPU), capacityString(available.memoryMiB))
+}
+
+func capacityString(c int64) string {
+	if c < 0 {
+		return "unknown"
+	}
+	return fmt.Sprint(c)
+}
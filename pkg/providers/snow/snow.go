@@ -73,6 +73,9 @@ func (p *SnowProvider) SetupAndValidateCreateCluster(ctx context.Context, cluste
 	if err := p.configManager.SetDefaultsAndValidate(ctx, clusterSpec.Config); err != nil {
 		return fmt.Errorf("setting defaults and validate snow config: %v", err)
 	}
+	if err := p.configManager.validator.ValidateDeviceCapacity(ctx, clusterSpec.Config, nil); err != nil {
+		return err
+	}
 	if !p.skipIpCheck {
 		if err := p.ipValidator.ValidateControlPlaneIPUniqueness(clusterSpec.Cluster); err != nil {
 			return err
@@ -83,10 +86,13 @@ func (p *SnowProvider) SetupAndValidateCreateCluster(ctx context.Context, cluste
 	return nil
 }
 
-func (p *SnowProvider) SetupAndValidateUpgradeCluster(ctx context.Context, cluster *types.Cluster, clusterSpec *cluster.Spec, _ *cluster.Spec) error {
+func (p *SnowProvider) SetupAndValidateUpgradeCluster(ctx context.Context, cluster *types.Cluster, clusterSpec *cluster.Spec, currentSpec *cluster.Spec) error {
 	if err := p.configManager.SetDefaultsAndValidate(ctx, clusterSpec.Config); err != nil {
 		return fmt.Errorf("setting defaults and validate snow config: %v", err)
 	}
+	if err := p.configManager.validator.ValidateDeviceCapacity(ctx, clusterSpec.Config, currentSpec.Config); err != nil {
+		return err
+	}
 	return nil
 }
 
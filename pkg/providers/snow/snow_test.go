@@ -556,12 +556,28 @@ func supportedInstanceTypes() []aws.EC2InstanceType {
 	}
 }
 
+func deviceCapacities() []aws.SnowballDeviceCapacity {
+	return []aws.SnowballDeviceCapacity{
+		{
+			Name:      "vCPU",
+			Unit:      "Number",
+			Available: 96,
+		},
+		{
+			Name:      "Memory",
+			Unit:      "Byte",
+			Available: 412316860416,
+		},
+	}
+}
+
 func TestSetupAndValidateCreateClusterSuccess(t *testing.T) {
 	tt := newSnowTest(t)
 	setupContext(t)
 	tt.aws.EXPECT().EC2ImageExists(tt.ctx, gomock.Any()).Return(true, nil).Times(4)
 	tt.aws.EXPECT().EC2KeyNameExists(tt.ctx, gomock.Any()).Return(true, nil).Times(4)
-	tt.aws.EXPECT().EC2InstanceTypes(tt.ctx).Return(supportedInstanceTypes(), nil).Times(4)
+	tt.aws.EXPECT().EC2InstanceTypes(tt.ctx).Return(supportedInstanceTypes(), nil).Times(6)
+	tt.aws.EXPECT().SnowballDeviceCapacities(tt.ctx).Return(deviceCapacities(), nil).Times(2)
 	tt.aws.EXPECT().IsSnowballDeviceUnlocked(tt.ctx).Return(true, nil).Times(4)
 	tt.aws.EXPECT().SnowballDeviceSoftwareVersion(tt.ctx).Return("102", nil).Times(4)
 	tt.imds.EXPECT().EC2InstanceIP(tt.ctx).Return("1.2.3.5", nil)
@@ -669,6 +685,42 @@ func TestSetupAndValidateCreateClusterInstanceTypeVCPUError(t *testing.T) {
 	tt.Expect(err).To(MatchError(ContainSubstring("has 1 vCPU. Please choose an instance type with at least 2 default vCPU")))
 }
 
+func TestSetupAndValidateCreateClusterInsufficientCapacity(t *testing.T) {
+	tt := newSnowTest(t)
+	setupContext(t)
+	tt.aws.EXPECT().EC2ImageExists(tt.ctx, gomock.Any()).Return(true, nil).Times(4)
+	tt.aws.EXPECT().EC2KeyNameExists(tt.ctx, gomock.Any()).Return(true, nil).Times(4)
+	tt.aws.EXPECT().EC2InstanceTypes(tt.ctx).Return(supportedInstanceTypes(), nil).Times(6)
+	tt.aws.EXPECT().IsSnowballDeviceUnlocked(tt.ctx).Return(true, nil).Times(4)
+	tt.aws.EXPECT().SnowballDeviceSoftwareVersion(tt.ctx).Return("102", nil).Times(4)
+	tt.aws.EXPECT().SnowballDeviceCapacities(tt.ctx).Return([]aws.SnowballDeviceCapacity{
+		{
+			Name:      "vCPU",
+			Unit:      "Number",
+			Available: 8,
+		},
+	}, nil).Times(2)
+	tt.imds.EXPECT().EC2InstanceIP(tt.ctx).Return("1.2.3.5", nil)
+	err := tt.provider.SetupAndValidateCreateCluster(tt.ctx, tt.clusterSpec)
+	tt.Expect(err).To(MatchError(ContainSubstring("insufficient capacity on snow devices:\n" +
+		"device [1.2.3.4]: 4 instances require 12 vCPU and 0 MiB memory, available 8 vCPU and unknown MiB memory")))
+	tt.Expect(err).NotTo(MatchError(ContainSubstring("device [1.2.3.5]")))
+}
+
+func TestSetupAndValidateCreateClusterDeviceCapacitiesError(t *testing.T) {
+	tt := newSnowTest(t)
+	setupContext(t)
+	tt.aws.EXPECT().EC2ImageExists(tt.ctx, gomock.Any()).Return(true, nil).Times(4)
+	tt.aws.EXPECT().EC2KeyNameExists(tt.ctx, gomock.Any()).Return(true, nil).Times(4)
+	tt.aws.EXPECT().EC2InstanceTypes(tt.ctx).Return(supportedInstanceTypes(), nil).Times(5)
+	tt.aws.EXPECT().IsSnowballDeviceUnlocked(tt.ctx).Return(true, nil).Times(4)
+	tt.aws.EXPECT().SnowballDeviceSoftwareVersion(tt.ctx).Return("102", nil).Times(4)
+	tt.aws.EXPECT().SnowballDeviceCapacities(tt.ctx).Return(nil, errors.New("describe device error"))
+	tt.imds.EXPECT().EC2InstanceIP(tt.ctx).Return("1.2.3.5", nil)
+	err := tt.provider.SetupAndValidateCreateCluster(tt.ctx, tt.clusterSpec)
+	tt.Expect(err).To(MatchError(ContainSubstring("fetching capacity for device [1.2.3.4]: describe device error")))
+}
+
 func TestSetupAndValidateCreateClusterNoCredsEnv(t *testing.T) {
 	tt := newSnowTest(t)
 	setupContext(t)
@@ -690,7 +742,8 @@ func TestSetupAndValidateUpgradeClusterSuccess(t *testing.T) {
 	setupContext(t)
 	tt.aws.EXPECT().EC2ImageExists(tt.ctx, gomock.Any()).Return(true, nil).Times(4)
 	tt.aws.EXPECT().EC2KeyNameExists(tt.ctx, gomock.Any()).Return(true, nil).Times(4)
-	tt.aws.EXPECT().EC2InstanceTypes(tt.ctx).Return(supportedInstanceTypes(), nil).Times(4)
+	tt.aws.EXPECT().EC2InstanceTypes(tt.ctx).Return(supportedInstanceTypes(), nil).Times(5)
+	tt.aws.EXPECT().SnowballDeviceCapacities(tt.ctx).Return(deviceCapacities(), nil)
 	tt.aws.EXPECT().IsSnowballDeviceUnlocked(tt.ctx).Return(true, nil).Times(4)
 	tt.aws.EXPECT().SnowballDeviceSoftwareVersion(tt.ctx).Return("102", nil).Times(4)
 	tt.imds.EXPECT().EC2InstanceIP(tt.ctx).Return("1.2.3.5", nil)
@@ -699,6 +752,29 @@ func TestSetupAndValidateUpgradeClusterSuccess(t *testing.T) {
 	tt.Expect(err).To(Succeed())
 }
 
+func TestSetupAndValidateUpgradeClusterCapacityForNewMachinesOnly(t *testing.T) {
+	tt := newSnowTest(t)
+	setupContext(t)
+	currentSpec := tt.clusterSpec.DeepCopy()
+	tt.clusterSpec.Cluster.Spec.WorkerNodeGroupConfigurations[0].Count = ptr.Int(5)
+	tt.aws.EXPECT().EC2ImageExists(tt.ctx, gomock.Any()).Return(true, nil).Times(4)
+	tt.aws.EXPECT().EC2KeyNameExists(tt.ctx, gomock.Any()).Return(true, nil).Times(4)
+	tt.aws.EXPECT().EC2InstanceTypes(tt.ctx).Return(supportedInstanceTypes(), nil).Times(6)
+	tt.aws.EXPECT().IsSnowballDeviceUnlocked(tt.ctx).Return(true, nil).Times(4)
+	tt.aws.EXPECT().SnowballDeviceSoftwareVersion(tt.ctx).Return("102", nil).Times(4)
+	tt.aws.EXPECT().SnowballDeviceCapacities(tt.ctx).Return([]aws.SnowballDeviceCapacity{
+		{
+			Name:      "vCPU",
+			Unit:      "Number",
+			Available: 4,
+		},
+	}, nil).Times(2)
+	tt.imds.EXPECT().EC2InstanceIP(tt.ctx).Return("1.2.3.5", nil)
+	err := tt.provider.SetupAndValidateUpgradeCluster(tt.ctx, tt.cluster, tt.clusterSpec, currentSpec)
+	tt.Expect(err).To(MatchError(ContainSubstring("device [1.2.3.4]: 3 instances require 10 vCPU and 0 MiB memory, available 4 vCPU and unknown MiB memory")))
+	tt.Expect(err).NotTo(MatchError(ContainSubstring("device [1.2.3.5]")))
+}
+
 func TestSetupAndValidateUpgradeClusterNoCredsEnv(t *testing.T) {
 	tt := newSnowTest(t)
 	setupContext(t)
@@ -197,8 +197,26 @@ func HardwareSatisfiesOnlyOneSelectorAssertion(catalogue *hardware.Catalogue) Cl
 	}
 }
 
+// HardwareHasBMCAssertionForISOBoot ensures all hardware in catalogue has a BMC when machines boot
+// HookOS from an ISO, as the ISO is mounted as virtual media through the BMC.
+func HardwareHasBMCAssertionForISOBoot(catalogue *hardware.Catalogue) ClusterSpecAssertion {
+	return func(spec *ClusterSpec) error {
+		if !spec.DatacenterConfig.Spec.IsoBoot {
+			return nil
+		}
+
+		for _, hw := range catalogue.AllHardware() {
+			if hw.Spec.BMCRef == nil {
+				return fmt.Errorf("hardware %v has no BMC configured: BMC is required for all hardware when isoBoot is enabled", hw.Name)
+			}
+		}
+
+		return nil
+	}
+}
+
 // selectorsFromClusterSpec extracts all selectors specified on MachineConfig's from spec.
-// When HardwareAffinity is used, it extracts matchLabels from Required terms.
+// When HardwareAffinity is used, it extracts the label selectors, including matchExpressions, from Required terms.
 func selectorsFromClusterSpec(spec *ClusterSpec) (selectorSet, error) {
 	selectors := selectorSet{}
 
@@ -222,17 +240,13 @@ func selectorsFromClusterSpec(spec *ClusterSpec) (selectorSet, error) {
 }
 
 // addSelectorsFromMachineConfig extracts selectors from a machine config.
-// If HardwareAffinity is set, it extracts matchLabels from Required terms.
+// If HardwareAffinity is set, it extracts the label selectors from Required terms.
 // Otherwise, it uses the HardwareSelector.
 func addSelectorsFromMachineConfig(config *v1alpha1.TinkerbellMachineConfig, selectors *selectorSet) error {
 	if config.Spec.HardwareAffinity != nil {
-		// Extract matchLabels from each Required term
-		for _, term := range config.Spec.HardwareAffinity.Required {
-			if len(term.LabelSelector.MatchLabels) > 0 {
-				selector := v1alpha1.HardwareSelector(term.LabelSelector.MatchLabels)
-				if err := selectors.Add(selector); err != nil {
-					return err
-				}
+		for _, selector := range GetLabelSelectorsFromMachineConfig(config) {
+			if err := selectors.AddLabelSelector(selector); err != nil {
+				return err
 			}
 		}
 		return nil
@@ -258,26 +272,26 @@ func MinimumHardwareAvailableAssertionForCreate(catalogue *hardware.Catalogue) C
 		// will account for the same selector being specified on different groups.
 		requirements := MinimumHardwareRequirements{}
 
-		selectors := GetSelectorsFromMachineConfig(spec.ControlPlaneMachineConfig())
+		selectors := GetLabelSelectorsFromMachineConfig(spec.ControlPlaneMachineConfig())
 		for _, selector := range selectors {
-			if err := requirements.Add(selector, spec.ControlPlaneConfiguration().Count); err != nil {
+			if err := requirements.AddLabelSelector(selector, spec.ControlPlaneConfiguration().Count); err != nil {
 				return err
 			}
 		}
 
 		for _, nodeGroup := range spec.WorkerNodeGroupConfigurations() {
-			selectors := GetSelectorsFromMachineConfig(spec.WorkerNodeGroupMachineConfig(nodeGroup))
+			selectors := GetLabelSelectorsFromMachineConfig(spec.WorkerNodeGroupMachineConfig(nodeGroup))
 			for _, selector := range selectors {
-				if err := requirements.Add(selector, *nodeGroup.Count); err != nil {
+				if err := requirements.AddLabelSelector(selector, *nodeGroup.Count); err != nil {
 					return err
 				}
 			}
 		}
 
 		if spec.HasExternalEtcd() {
-			selectors := GetSelectorsFromMachineConfig(spec.ExternalEtcdMachineConfig())
+			selectors := GetLabelSelectorsFromMachineConfig(spec.ExternalEtcdMachineConfig())
 			for _, selector := range selectors {
-				if err := requirements.Add(selector, spec.ExternalEtcdConfiguration().Count); err != nil {
+				if err := requirements.AddLabelSelector(selector, spec.ExternalEtcdConfiguration().Count); err != nil {
 					return err
 				}
 			}
@@ -409,9 +423,9 @@ func AssertionsForScaleUpDown(catalogue *hardware.Catalogue, current Validatable
 				return fmt.Errorf("cannot perform scale up or down during rolling upgrades")
 			}
 			if current.ControlPlaneReplicaCount() < spec.Cluster.Spec.ControlPlaneConfiguration.Count {
-				selectors := GetSelectorsFromMachineConfig(spec.ControlPlaneMachineConfig())
+				selectors := GetLabelSelectorsFromMachineConfig(spec.ControlPlaneMachineConfig())
 				for _, selector := range selectors {
-					if err := requirements.Add(selector, spec.Cluster.Spec.ControlPlaneConfiguration.Count-current.ControlPlaneReplicaCount()); err != nil {
+					if err := requirements.AddLabelSelector(selector, spec.Cluster.Spec.ControlPlaneConfiguration.Count-current.ControlPlaneReplicaCount()); err != nil {
 						return fmt.Errorf("error during scale up: %v", err)
 					}
 				}
@@ -431,9 +445,9 @@ func AssertionsForScaleUpDown(catalogue *hardware.Catalogue, current Validatable
 						return fmt.Errorf("cannot perform scale up or down during rolling upgrades")
 					}
 					if *nodeGroupNewSpec.Count > workerNodeGroupOldSpec.Replicas {
-						selectors := GetSelectorsFromMachineConfig(spec.WorkerNodeGroupMachineConfig(nodeGroupNewSpec))
+						selectors := GetLabelSelectorsFromMachineConfig(spec.WorkerNodeGroupMachineConfig(nodeGroupNewSpec))
 						for _, selector := range selectors {
-							if err := requirements.Add(selector, *nodeGroupNewSpec.Count-workerNodeGroupOldSpec.Replicas); err != nil {
+							if err := requirements.AddLabelSelector(selector, *nodeGroupNewSpec.Count-workerNodeGroupOldSpec.Replicas); err != nil {
 								return fmt.Errorf("error during scale up: %v", err)
 							}
 						}
@@ -443,9 +457,9 @@ func AssertionsForScaleUpDown(catalogue *hardware.Catalogue, current Validatable
 				if rollingUpgrade {
 					return fmt.Errorf("cannot perform scale up or down during rolling upgrades")
 				}
-				selectors := GetSelectorsFromMachineConfig(spec.WorkerNodeGroupMachineConfig(nodeGroupNewSpec))
+				selectors := GetLabelSelectorsFromMachineConfig(spec.WorkerNodeGroupMachineConfig(nodeGroupNewSpec))
 				for _, selector := range selectors {
-					if err := requirements.Add(selector, *nodeGroupNewSpec.Count); err != nil {
+					if err := requirements.AddLabelSelector(selector, *nodeGroupNewSpec.Count); err != nil {
 						return fmt.Errorf("error during scale up: %v", err)
 					}
 				}
@@ -501,9 +515,9 @@ func ensureCPHardwareAvailability(spec *ClusterSpec, hwReq MinimumHardwareRequir
 	if rolloutStrategy != nil && rolloutStrategy.Type == "RollingUpdate" {
 		maxSurge = spec.Cluster.Spec.ControlPlaneConfiguration.UpgradeRolloutStrategy.RollingUpdate.MaxSurge
 	}
-	selectors := GetSelectorsFromMachineConfig(spec.ControlPlaneMachineConfig())
+	selectors := GetLabelSelectorsFromMachineConfig(spec.ControlPlaneMachineConfig())
 	for _, selector := range selectors {
-		if err := hwReq.Add(selector, maxSurge); err != nil {
+		if err := hwReq.AddLabelSelector(selector, maxSurge); err != nil {
 			return fmt.Errorf("for rolling upgrade, %v", err)
 		}
 	}
@@ -521,9 +535,9 @@ func ensureWorkerHardwareAvailability(spec *ClusterSpec, current ValidatableClus
 			if nodeGroup.UpgradeRolloutStrategy != nil && nodeGroup.UpgradeRolloutStrategy.Type == "RollingUpdate" {
 				maxSurge = nodeGroup.UpgradeRolloutStrategy.RollingUpdate.MaxSurge
 			}
-			selectors := GetSelectorsFromMachineConfig(spec.WorkerNodeGroupMachineConfig(nodeGroup))
+			selectors := GetLabelSelectorsFromMachineConfig(spec.WorkerNodeGroupMachineConfig(nodeGroup))
 			for _, selector := range selectors {
-				if err := hwReq.Add(selector, maxSurge); err != nil {
+				if err := hwReq.AddLabelSelector(selector, maxSurge); err != nil {
 					return fmt.Errorf("for rolling upgrade, %v", err)
 				}
 			}
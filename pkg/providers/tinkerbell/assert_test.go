@@ -10,6 +10,7 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/onsi/gomega"
 	"github.com/tinkerbell/tink/api/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	controlplanev1beta2 "sigs.k8s.io/cluster-api/api/controlplane/kubeadm/v1beta2"
@@ -1027,6 +1028,53 @@ func TestHardwareSatisfiesOnlyOneSelectorAssertion_MeetsOnlyOneSelector(t *testi
 	g.Expect(assertion(clusterSpec)).To(gomega.Succeed())
 }
 
+func TestHardwareHasBMCAssertionForISOBoot_NetbootSkipsBMCCheck(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	clusterSpec := NewDefaultValidClusterSpecBuilder().Build()
+
+	catalogue := hardware.NewCatalogue()
+	g.Expect(catalogue.InsertHardware(&v1alpha1.Hardware{
+		ObjectMeta: v1.ObjectMeta{Name: "test"},
+	})).To(gomega.Succeed())
+
+	assertion := tinkerbell.HardwareHasBMCAssertionForISOBoot(catalogue)
+	g.Expect(assertion(clusterSpec)).To(gomega.Succeed())
+}
+
+func TestHardwareHasBMCAssertionForISOBoot_WithBMC(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	clusterSpec := NewDefaultValidClusterSpecBuilder().Build()
+	clusterSpec.DatacenterConfig.Spec.IsoBoot = true
+
+	catalogue := hardware.NewCatalogue()
+	g.Expect(catalogue.InsertHardware(&v1alpha1.Hardware{
+		ObjectMeta: v1.ObjectMeta{Name: "test"},
+		Spec: v1alpha1.HardwareSpec{
+			BMCRef: &corev1.TypedLocalObjectReference{Name: "bmc-test"},
+		},
+	})).To(gomega.Succeed())
+
+	assertion := tinkerbell.HardwareHasBMCAssertionForISOBoot(catalogue)
+	g.Expect(assertion(clusterSpec)).To(gomega.Succeed())
+}
+
+func TestHardwareHasBMCAssertionForISOBoot_MissingBMCFails(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	clusterSpec := NewDefaultValidClusterSpecBuilder().Build()
+	clusterSpec.DatacenterConfig.Spec.IsoBoot = true
+
+	catalogue := hardware.NewCatalogue()
+	g.Expect(catalogue.InsertHardware(&v1alpha1.Hardware{
+		ObjectMeta: v1.ObjectMeta{Name: "test"},
+	})).To(gomega.Succeed())
+
+	assertion := tinkerbell.HardwareHasBMCAssertionForISOBoot(catalogue)
+	g.Expect(assertion(clusterSpec)).To(gomega.MatchError(gomega.ContainSubstring("hardware test has no BMC configured")))
+}
+
 func TestHardwareSatisfiesOnlyOneSelectorAssertion_MeetsMultipleSelectorFails(t *testing.T) {
 	g := gomega.NewWithT(t)
 
@@ -1448,3 +1496,162 @@ func TestSelectorsFromClusterSpec_WithWorkerHardwareAffinity(t *testing.T) {
 	assertion := tinkerbell.HardwareSatisfiesOnlyOneSelectorAssertion(catalogue)
 	g.Expect(assertion(clusterSpec)).To(gomega.Succeed())
 }
+
+func TestGetLabelSelectorsFromMachineConfig_WithHardwareSelector(t *testing.T) {
+	g := gomega.NewWithT(t)
+	config := &eksav1alpha1.TinkerbellMachineConfig{
+		Spec: eksav1alpha1.TinkerbellMachineConfigSpec{
+			HardwareSelector: map[string]string{"type": "cp"},
+		},
+	}
+	selectors := tinkerbell.GetLabelSelectorsFromMachineConfig(config)
+	g.Expect(selectors).To(gomega.ConsistOf(metav1.LabelSelector{MatchLabels: map[string]string{"type": "cp"}}))
+}
+
+func TestGetLabelSelectorsFromMachineConfig_WithMatchExpressions(t *testing.T) {
+	g := gomega.NewWithT(t)
+	selector := metav1.LabelSelector{
+		MatchExpressions: []metav1.LabelSelectorRequirement{
+			{
+				Key:      "gpu",
+				Operator: metav1.LabelSelectorOpExists,
+			},
+		},
+	}
+	config := &eksav1alpha1.TinkerbellMachineConfig{
+		Spec: eksav1alpha1.TinkerbellMachineConfigSpec{
+			HardwareAffinity: &eksav1alpha1.HardwareAffinity{
+				Required: []eksav1alpha1.HardwareAffinityTerm{
+					{LabelSelector: selector},
+					{LabelSelector: metav1.LabelSelector{}},
+				},
+			},
+		},
+	}
+	selectors := tinkerbell.GetLabelSelectorsFromMachineConfig(config)
+	g.Expect(selectors).To(gomega.ConsistOf(selector))
+}
+
+func TestGetLabelSelectorsFromMachineConfig_WithNoSelection(t *testing.T) {
+	g := gomega.NewWithT(t)
+	config := &eksav1alpha1.TinkerbellMachineConfig{
+		Spec: eksav1alpha1.TinkerbellMachineConfigSpec{},
+	}
+	g.Expect(tinkerbell.GetLabelSelectorsFromMachineConfig(config)).To(gomega.BeNil())
+}
+
+func TestMinimumHardwareAvailableAssertionForCreate_WithHardwareAffinityMatchExpressions(t *testing.T) {
+	g := gomega.NewWithT(t)
+	catalogue := hardware.NewCatalogue()
+	g.Expect(catalogue.InsertHardware(&v1alpha1.Hardware{
+		ObjectMeta: v1.ObjectMeta{
+			Name:   "hw1",
+			Labels: map[string]string{"type": "cp"},
+		},
+	})).To(gomega.Succeed())
+	g.Expect(catalogue.InsertHardware(&v1alpha1.Hardware{
+		ObjectMeta: v1.ObjectMeta{
+			Name:   "hw2",
+			Labels: map[string]string{"gpu": "true", "disk": "2TB"},
+		},
+	})).To(gomega.Succeed())
+
+	builder := NewDefaultValidClusterSpecBuilder()
+	clusterSpec := builder.Build()
+	clusterSpec.Spec.Cluster.Spec.ExternalEtcdConfiguration = nil
+	clusterSpec.MachineConfigs[builder.WorkerNodeGroupMachineName].Spec.HardwareSelector = nil
+	clusterSpec.MachineConfigs[builder.WorkerNodeGroupMachineName].Spec.HardwareAffinity = &eksav1alpha1.HardwareAffinity{
+		Required: []eksav1alpha1.HardwareAffinityTerm{
+			{
+				LabelSelector: metav1.LabelSelector{
+					MatchExpressions: []metav1.LabelSelectorRequirement{
+						{Key: "gpu", Operator: metav1.LabelSelectorOpExists},
+						{Key: "disk", Operator: metav1.LabelSelectorOpIn, Values: []string{"1TB", "2TB"}},
+					},
+				},
+			},
+		},
+	}
+
+	assertion := tinkerbell.MinimumHardwareAvailableAssertionForCreate(catalogue)
+	g.Expect(assertion(clusterSpec)).To(gomega.Succeed())
+}
+
+func TestMinimumHardwareAvailableAssertionForCreate_WithHardwareAffinityMatchExpressionsInsufficientFails(t *testing.T) {
+	g := gomega.NewWithT(t)
+	catalogue := hardware.NewCatalogue()
+	g.Expect(catalogue.InsertHardware(&v1alpha1.Hardware{
+		ObjectMeta: v1.ObjectMeta{
+			Name:   "hw1",
+			Labels: map[string]string{"type": "cp"},
+		},
+	})).To(gomega.Succeed())
+	g.Expect(catalogue.InsertHardware(&v1alpha1.Hardware{
+		ObjectMeta: v1.ObjectMeta{
+			Name:   "hw2",
+			Labels: map[string]string{"gpu": "true", "disk": "500GB"},
+		},
+	})).To(gomega.Succeed())
+
+	builder := NewDefaultValidClusterSpecBuilder()
+	clusterSpec := builder.Build()
+	clusterSpec.Spec.Cluster.Spec.ExternalEtcdConfiguration = nil
+	clusterSpec.MachineConfigs[builder.WorkerNodeGroupMachineName].Spec.HardwareSelector = nil
+	clusterSpec.MachineConfigs[builder.WorkerNodeGroupMachineName].Spec.HardwareAffinity = &eksav1alpha1.HardwareAffinity{
+		Required: []eksav1alpha1.HardwareAffinityTerm{
+			{
+				LabelSelector: metav1.LabelSelector{
+					MatchLabels: map[string]string{"gpu": "true"},
+					MatchExpressions: []metav1.LabelSelectorRequirement{
+						{Key: "disk", Operator: metav1.LabelSelectorOpNotIn, Values: []string{"500GB"}},
+					},
+				},
+			},
+		},
+	}
+
+	assertion := tinkerbell.MinimumHardwareAvailableAssertionForCreate(catalogue)
+	g.Expect(assertion(clusterSpec)).To(gomega.MatchError(gomega.ContainSubstring(
+		`minimum hardware count not met for selector '{"matchLabels":{"gpu":"true"},"matchExpressions":[{"key":"disk","operator":"NotIn","values":["500GB"]}]}': have 0, require 1`,
+	)))
+}
+
+func TestHardwareSatisfiesOnlyOneSelectorAssertion_WithHardwareAffinityMatchExpressionsFails(t *testing.T) {
+	g := gomega.NewWithT(t)
+	catalogue := hardware.NewCatalogue()
+	g.Expect(catalogue.InsertHardware(&v1alpha1.Hardware{
+		ObjectMeta: v1.ObjectMeta{
+			Name:   "hw1",
+			Labels: map[string]string{"type": "cp", "gpu": "true"},
+		},
+	})).To(gomega.Succeed())
+
+	builder := NewDefaultValidClusterSpecBuilder()
+	clusterSpec := builder.Build()
+	clusterSpec.MachineConfigs[builder.WorkerNodeGroupMachineName].Spec.HardwareSelector = nil
+	clusterSpec.MachineConfigs[builder.WorkerNodeGroupMachineName].Spec.HardwareAffinity = &eksav1alpha1.HardwareAffinity{
+		Required: []eksav1alpha1.HardwareAffinityTerm{
+			{
+				LabelSelector: metav1.LabelSelector{
+					MatchExpressions: []metav1.LabelSelectorRequirement{
+						{Key: "gpu", Operator: metav1.LabelSelectorOpExists},
+					},
+				},
+			},
+		},
+	}
+
+	assertion := tinkerbell.HardwareSatisfiesOnlyOneSelectorAssertion(catalogue)
+	g.Expect(assertion(clusterSpec)).To(gomega.MatchError(gomega.ContainSubstring("hardware must only satisfy 1 selector: hardware name 'hw1'")))
+}
+
+func TestMinimumHardwareRequirementsAddLabelSelectorInvalid(t *testing.T) {
+	g := gomega.NewWithT(t)
+	req := tinkerbell.MinimumHardwareRequirements{}
+	err := req.AddLabelSelector(metav1.LabelSelector{
+		MatchExpressions: []metav1.LabelSelectorRequirement{
+			{Key: "gpu", Operator: metav1.LabelSelectorOpIn},
+		},
+	}, 1)
+	g.Expect(err).To(gomega.MatchError(gomega.ContainSubstring("invalid hardware selector")))
+}
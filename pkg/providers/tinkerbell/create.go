@@ -54,6 +54,7 @@ func (p *Provider) PreCAPIInstallOnBootstrap(ctx context.Context, cluster *types
 		stack.WithLoadBalancerEnabled(false),
 		stack.WithStackServiceEnabled(false),
 		stack.WithHookIsoOverride(p.datacenterConfig.Spec.HookIsoURL),
+		stack.WithDHCPEnabled(!p.datacenterConfig.Spec.DisableDHCP),
 	)
 	if err != nil {
 		return fmt.Errorf("install Tinkerbell stack on bootstrap cluster: %v", err)
@@ -120,6 +121,7 @@ func (p *Provider) PostWorkloadInit(ctx context.Context, cluster *types.Cluster,
 			len(clusterSpec.Cluster.Spec.WorkerNodeGroupConfigurations) != 0 && // load balancer is handled by kube-vip in control plane nodes
 				!p.datacenterConfig.Spec.SkipLoadBalancerDeployment), // configure load balancer based on datacenterConfig.Spec.SkipLoadBalancerDeployment
 		stack.WithHookIsoOverride(p.datacenterConfig.Spec.HookIsoURL),
+		stack.WithDHCPEnabled(!p.datacenterConfig.Spec.DisableDHCP),
 	)
 	if err != nil {
 		return fmt.Errorf("installing stack on workload cluster: %v", err)
@@ -197,6 +199,7 @@ func (p *Provider) SetupAndValidateCreateCluster(ctx context.Context, clusterSpe
 	clusterSpecValidator := NewClusterSpecValidator(
 		MinimumHardwareAvailableAssertionForCreate(p.catalogue),
 		HardwareSatisfiesOnlyOneSelectorAssertion(p.catalogue),
+		HardwareHasBMCAssertionForISOBoot(p.catalogue),
 	)
 
 	clusterSpecValidator.Register(AssertPortsNotInUse(p.netClient))
@@ -2,6 +2,7 @@ package hardware
 
 import (
 	"fmt"
+	"strconv"
 
 	corev1 "k8s.io/api/core/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -14,6 +15,9 @@ import (
 // GofishProviderOption is the provider name for Redfish Provider in Rufio.
 const GofishProviderOption = "gofish"
 
+// IPMIToolProviderOption is the provider name for the IPMI Provider in Rufio.
+const IPMIToolProviderOption = "ipmitool"
+
 // IndexBMCs indexes BMC instances on index by extracfting the key using fn.
 func (c *Catalogue) IndexBMCs(index string, fn KeyExtractorFunc) {
 	c.bmcIndex.IndexField(index, fn)
@@ -99,14 +103,31 @@ func toRufioMachine(m Machine) *v1alpha1.Machine {
 			Name:      formatBMCSecretRef(m),
 			Namespace: constants.EksaSystemNamespace,
 		},
-		InsecureTLS: true,
+		InsecureTLS: m.BMCInsecureTLS == "" || isTrue(m.BMCInsecureTLS),
 		// Redfish bmc client generally seems to be more reliable in bmc interactions
 		// compared to other clients. Prefer Redfish client if available
 		ProviderOptions: &v1alpha1.ProviderOptions{
 			PreferredOrder: []v1alpha1.ProviderName{GofishProviderOption},
 		},
 	}
-	if m.BMCOptions != nil && m.BMCOptions.RPC.ConsumerURL != "" {
+
+	port, _ := strconv.Atoi(m.BMCPort)
+	switch m.BMCProtocol {
+	case BMCProtocolIPMI:
+		conn.ProviderOptions.PreferredOrder = []v1alpha1.ProviderName{IPMIToolProviderOption}
+		if port != 0 {
+			conn.Port = port
+			conn.ProviderOptions.IPMITOOL = &v1alpha1.IPMITOOLOptions{Port: port}
+		}
+	case BMCProtocolRedfish:
+		conn.ProviderOptions.Redfish = &v1alpha1.RedfishOptions{
+			Port:         port,
+			UseBasicAuth: isTrue(m.BMCRedfishBasicAuth),
+			SystemName:   m.BMCRedfishSystemName,
+		}
+	}
+
+	if m.BMCOptions != nil && m.BMCOptions.RPC != nil && m.BMCOptions.RPC.ConsumerURL != "" {
 		conn.ProviderOptions.RPC = toRPCOptions(m.BMCOptions.RPC, m)
 	}
 
@@ -241,3 +262,10 @@ func toExperimentalOpts(e ExperimentalOpts) *v1alpha1.ExperimentalOpts {
 
 	return exp
 }
+
+// isTrue reports whether s is a true boolean string. Machines are validated before being translated,
+// so invalid values are considered false.
+func isTrue(s string) bool {
+	b, _ := strconv.ParseBool(s)
+	return b
+}
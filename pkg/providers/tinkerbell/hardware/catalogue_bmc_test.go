@@ -141,3 +141,52 @@ func TestBMCMachineWithOptions(t *testing.T) {
 	got := catalogue.AllBMCs()[0]
 	g.Expect(got.Spec).To(gomega.Equal(want.Spec))
 }
+
+func TestBMCMachineWithRedfishProtocol(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	catalogue := hardware.NewCatalogue()
+	writer := hardware.NewBMCCatalogueWriter(catalogue)
+	machine := NewValidMachine()
+	machine.BMCProtocol = hardware.BMCProtocolRedfish
+	machine.BMCPort = "8443"
+	machine.BMCInsecureTLS = "false"
+	machine.BMCRedfishSystemName = "System.Embedded.1"
+	machine.BMCRedfishBasicAuth = "true"
+
+	err := writer.Write(machine)
+	g.Expect(err).To(gomega.Succeed())
+
+	got := catalogue.AllBMCs()[0]
+	g.Expect(got.Spec.Connection.Port).To(gomega.Equal(0))
+	g.Expect(got.Spec.Connection.InsecureTLS).To(gomega.BeFalse())
+	g.Expect(got.Spec.Connection.ProviderOptions).To(gomega.Equal(&v1alpha1.ProviderOptions{
+		PreferredOrder: []v1alpha1.ProviderName{hardware.GofishProviderOption},
+		Redfish: &v1alpha1.RedfishOptions{
+			Port:         8443,
+			UseBasicAuth: true,
+			SystemName:   "System.Embedded.1",
+		},
+	}))
+}
+
+func TestBMCMachineWithIPMIProtocol(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	catalogue := hardware.NewCatalogue()
+	writer := hardware.NewBMCCatalogueWriter(catalogue)
+	machine := NewValidMachine()
+	machine.BMCProtocol = hardware.BMCProtocolIPMI
+	machine.BMCPort = "6230"
+
+	err := writer.Write(machine)
+	g.Expect(err).To(gomega.Succeed())
+
+	got := catalogue.AllBMCs()[0]
+	g.Expect(got.Spec.Connection.Port).To(gomega.Equal(6230))
+	g.Expect(got.Spec.Connection.InsecureTLS).To(gomega.BeTrue())
+	g.Expect(got.Spec.Connection.ProviderOptions).To(gomega.Equal(&v1alpha1.ProviderOptions{
+		PreferredOrder: []v1alpha1.ProviderName{hardware.IPMIToolProviderOption},
+		IPMITOOL:       &v1alpha1.IPMITOOLOptions{Port: 6230},
+	}))
+}
@@ -29,6 +29,20 @@ type Machine struct {
 	BMCPassword  string `csv:"bmc_password, omitempty"`
 	VLANID       string `csv:"vlan_id, omitempty"`
 
+	// BMCProtocol is the protocol used to manage the machine through its BMC, either ipmi or redfish.
+	// When empty, all the protocols supported by Rufio are tried, starting with Redfish.
+	BMCProtocol string `csv:"bmc_protocol, omitempty"`
+	// BMCPort is the port of the BMC endpoint for the selected protocol.
+	BMCPort string `csv:"bmc_port, omitempty"`
+	// BMCInsecureTLS disables the verification of the BMC TLS certificate. Defaults to true.
+	BMCInsecureTLS string `csv:"bmc_insecure_tls, omitempty"`
+	// BMCRedfishSystemName is the Redfish system to manage, for BMCs that expose multiple systems
+	// or that don't use the default system name.
+	BMCRedfishSystemName string `csv:"bmc_redfish_system_name, omitempty"`
+	// BMCRedfishBasicAuth uses basic auth instead of session tokens for Redfish calls,
+	// for BMCs with unreliable Redfish session support.
+	BMCRedfishBasicAuth string `csv:"bmc_redfish_basic_auth, omitempty"`
+
 	// BMCOptions are the options used for Rufio providers.
 	BMCOptions *BMCOptions `csv:"-"`
 }
@@ -104,6 +118,12 @@ func (m *Machine) HasBMC() bool {
 	return m.BMCIPAddress != "" || m.BMCUsername != "" || m.BMCPassword != ""
 }
 
+// Supported values for the BMCProtocol field of a Machine.
+const (
+	BMCProtocolIPMI    = "ipmi"
+	BMCProtocolRedfish = "redfish"
+)
+
 // NameserversSeparator is used to unmarshal Nameservers.
 const NameserversSeparator = "|"
 
@@ -52,10 +52,17 @@ func LowercaseMACAddress(m Machine) Machine {
 	return m
 }
 
+// LowercaseBMCProtocol ensures m's BMCProtocol field has lower case characters.
+func LowercaseBMCProtocol(m Machine) Machine {
+	m.BMCProtocol = strings.ToLower(m.BMCProtocol)
+	return m
+}
+
 // RegisterDefaultNormalizations registers a set of default normalizations on n.
 func RegisterDefaultNormalizations(n *Normalizer) {
 	for _, fn := range []NormalizerFunc{
 		LowercaseMACAddress,
+		LowercaseBMCProtocol,
 	} {
 		n.Register(fn)
 	}
@@ -8,9 +8,11 @@ import (
 	"strconv"
 	"strings"
 
+	tinkv1alpha1 "github.com/tinkerbell/tink/api/v1alpha1"
 	apimachineryvalidation "k8s.io/apimachinery/pkg/util/validation"
 
 	"github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	rufiov1alpha1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1/thirdparty/tinkerbell/rufio"
 	"github.com/aws/eks-anywhere/pkg/networkutils"
 )
 
@@ -136,6 +138,10 @@ func StaticMachineAssertions() MachineAssertion {
 					return newEmptyFieldError("BMCPassword")
 				}
 			}
+
+			if err := validateBMCConnection(m); err != nil {
+				return err
+			}
 		}
 
 		if m.VLANID != "" {
@@ -203,6 +209,44 @@ func UniqueHostnames() MachineAssertion {
 	}
 }
 
+// validateBMCConnection validates the optional fields used to configure how Rufio connects to the BMC.
+func validateBMCConnection(m Machine) error {
+	switch m.BMCProtocol {
+	case "", BMCProtocolIPMI, BMCProtocolRedfish:
+	default:
+		return fmt.Errorf("BMCProtocol: must be one of %v, %v", BMCProtocolIPMI, BMCProtocolRedfish)
+	}
+
+	if m.BMCPort != "" {
+		if m.BMCProtocol == "" {
+			return errors.New("BMCPort: requires BMCProtocol to be set")
+		}
+
+		port, err := strconv.Atoi(m.BMCPort)
+		if err != nil || port < 1 || port > 65535 {
+			return errors.New("BMCPort: must be a string integer between 1 and 65535")
+		}
+	}
+
+	if m.BMCInsecureTLS != "" {
+		if _, err := strconv.ParseBool(m.BMCInsecureTLS); err != nil {
+			return errors.New("BMCInsecureTLS: must be true or false")
+		}
+	}
+
+	if m.BMCProtocol != BMCProtocolRedfish && (m.BMCRedfishSystemName != "" || m.BMCRedfishBasicAuth != "") {
+		return fmt.Errorf("BMCRedfishSystemName and BMCRedfishBasicAuth require BMCProtocol to be %v", BMCProtocolRedfish)
+	}
+
+	if m.BMCRedfishBasicAuth != "" {
+		if _, err := strconv.ParseBool(m.BMCRedfishBasicAuth); err != nil {
+			return errors.New("BMCRedfishBasicAuth: must be true or false")
+		}
+	}
+
+	return nil
+}
+
 // UniqueBMCIPAddress asserts a given Machine instance has a unique BMCIPAddress field relative to previously seen
 // Machine instances. If there is no BMC configuration as defined by machine.HasBMC() the check is a noop. It is
 // not thread safe. It has a 1 time use.
@@ -227,6 +271,55 @@ func UniqueBMCIPAddress() MachineAssertion {
 	}
 }
 
+// NoInventoryCollisions asserts a given Machine instance doesn't collide with the hardware and BMC machines
+// already registered in a cluster. Its MACAddress, IPAddress, Hostname and BMCIPAddress must not be in use
+// by the existing inventory.
+func NoInventoryCollisions(hardware []tinkv1alpha1.Hardware, bmcs []rufiov1alpha1.Machine) MachineAssertion {
+	macs := make(map[string]string)
+	ips := make(map[string]string)
+	hostnames := make(map[string]struct{})
+	bmcIPs := make(map[string]string)
+
+	for _, hw := range hardware {
+		hostnames[hw.Name] = struct{}{}
+		for _, iface := range hw.Spec.Interfaces {
+			if iface.DHCP == nil {
+				continue
+			}
+			macs[strings.ToLower(iface.DHCP.MAC)] = hw.Name
+			if iface.DHCP.IP != nil {
+				ips[iface.DHCP.IP.Address] = hw.Name
+			}
+		}
+	}
+
+	for _, bmc := range bmcs {
+		bmcIPs[bmc.Spec.Connection.Host] = bmc.Name
+	}
+
+	return func(m Machine) error {
+		if _, found := hostnames[m.Hostname]; found {
+			return fmt.Errorf("hardware %v already exists in the cluster", m.Hostname)
+		}
+
+		if name, found := macs[strings.ToLower(m.MACAddress)]; found {
+			return fmt.Errorf("MACAddress %v of hardware %v is already used by hardware %v", m.MACAddress, m.Hostname, name)
+		}
+
+		if name, found := ips[m.IPAddress]; found {
+			return fmt.Errorf("IPAddress %v of hardware %v is already used by hardware %v", m.IPAddress, m.Hostname, name)
+		}
+
+		if m.HasBMC() {
+			if name, found := bmcIPs[m.BMCIPAddress]; found {
+				return fmt.Errorf("BMCIPAddress %v of hardware %v is already used by bmc machine %v", m.BMCIPAddress, m.Hostname, name)
+			}
+		}
+
+		return nil
+	}
+}
+
 // RegisterDefaultAssertions applies a set of default assertions to validator. The default assertions
 // include UniqueHostnames and UniqueIDs.
 func RegisterDefaultAssertions(validator *DefaultMachineValidator) {
@@ -7,7 +7,10 @@ import (
 	"time"
 
 	"github.com/onsi/gomega"
+	tinkv1alpha1 "github.com/tinkerbell/tink/api/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	rufiov1alpha1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1/thirdparty/tinkerbell/rufio"
 	"github.com/aws/eks-anywhere/pkg/providers/tinkerbell/hardware"
 )
 
@@ -137,6 +140,72 @@ func TestUniquenessAssertionsWithDupes(t *testing.T) {
 	}
 }
 
+func TestNoInventoryCollisions(t *testing.T) {
+	existingHardware := []tinkv1alpha1.Hardware{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "existing"},
+			Spec: tinkv1alpha1.HardwareSpec{
+				Interfaces: []tinkv1alpha1.Interface{
+					{
+						DHCP: &tinkv1alpha1.DHCP{
+							MAC: "AA:BB:CC:DD:EE:FF",
+							IP:  &tinkv1alpha1.IP{Address: "10.10.10.20"},
+						},
+					},
+				},
+			},
+		},
+	}
+	existingBMCs := []rufiov1alpha1.Machine{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "bmc-existing"},
+			Spec: rufiov1alpha1.MachineSpec{
+				Connection: rufiov1alpha1.Connection{Host: "10.10.10.21"},
+			},
+		},
+	}
+
+	cases := map[string]struct {
+		Modify      func(*hardware.Machine)
+		ExpectedErr string
+	}{
+		"NoCollisions": {
+			Modify: func(*hardware.Machine) {},
+		},
+		"Hostname": {
+			Modify:      func(m *hardware.Machine) { m.Hostname = "existing" },
+			ExpectedErr: "hardware existing already exists in the cluster",
+		},
+		"MACAddress": {
+			Modify:      func(m *hardware.Machine) { m.MACAddress = "aa:bb:cc:dd:ee:ff" },
+			ExpectedErr: "MACAddress aa:bb:cc:dd:ee:ff of hardware localhost is already used by hardware existing",
+		},
+		"IPAddress": {
+			Modify:      func(m *hardware.Machine) { m.IPAddress = "10.10.10.20" },
+			ExpectedErr: "IPAddress 10.10.10.20 of hardware localhost is already used by hardware existing",
+		},
+		"BMCIPAddress": {
+			Modify:      func(m *hardware.Machine) { m.BMCIPAddress = "10.10.10.21" },
+			ExpectedErr: "BMCIPAddress 10.10.10.21 of hardware localhost is already used by bmc machine bmc-existing",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			machine := NewValidMachine()
+			tc.Modify(&machine)
+
+			err := hardware.NoInventoryCollisions(existingHardware, existingBMCs)(machine)
+			if tc.ExpectedErr == "" {
+				g.Expect(err).ToNot(gomega.HaveOccurred())
+			} else {
+				g.Expect(err).To(gomega.MatchError(tc.ExpectedErr))
+			}
+		})
+	}
+}
+
 func TestStaticMachineAssertions_ValidMachine(t *testing.T) {
 	g := gomega.NewWithT(t)
 
@@ -146,6 +215,20 @@ func TestStaticMachineAssertions_ValidMachine(t *testing.T) {
 	g.Expect(validate(machine)).ToNot(gomega.HaveOccurred())
 }
 
+func TestStaticMachineAssertions_ValidRedfishMachine(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	machine := NewValidMachine()
+	machine.BMCProtocol = hardware.BMCProtocolRedfish
+	machine.BMCPort = "8443"
+	machine.BMCInsecureTLS = "false"
+	machine.BMCRedfishSystemName = "System.Embedded.1"
+	machine.BMCRedfishBasicAuth = "true"
+
+	validate := hardware.StaticMachineAssertions()
+	g.Expect(validate(machine)).ToNot(gomega.HaveOccurred())
+}
+
 func TestStaticMachineAssertions_InvalidMachines(t *testing.T) {
 	g := gomega.NewWithT(t)
 
@@ -216,6 +299,34 @@ func TestStaticMachineAssertions_InvalidMachines(t *testing.T) {
 		"NonIntVLAN": func(h *hardware.Machine) {
 			h.VLANID = "im not an int"
 		},
+		"InvalidBMCProtocol": func(h *hardware.Machine) {
+			h.BMCProtocol = "intelamt"
+		},
+		"BMCPortWithoutProtocol": func(h *hardware.Machine) {
+			h.BMCPort = "443"
+		},
+		"InvalidBMCPortOver": func(h *hardware.Machine) {
+			h.BMCProtocol = hardware.BMCProtocolRedfish
+			h.BMCPort = "65536"
+		},
+		"NonIntBMCPort": func(h *hardware.Machine) {
+			h.BMCProtocol = hardware.BMCProtocolIPMI
+			h.BMCPort = "im not an int"
+		},
+		"InvalidBMCInsecureTLS": func(h *hardware.Machine) {
+			h.BMCInsecureTLS = "maybe"
+		},
+		"BMCRedfishSystemNameWithIPMI": func(h *hardware.Machine) {
+			h.BMCProtocol = hardware.BMCProtocolIPMI
+			h.BMCRedfishSystemName = "System.Embedded.1"
+		},
+		"BMCRedfishBasicAuthWithoutProtocol": func(h *hardware.Machine) {
+			h.BMCRedfishBasicAuth = "true"
+		},
+		"InvalidBMCRedfishBasicAuth": func(h *hardware.Machine) {
+			h.BMCProtocol = hardware.BMCProtocolRedfish
+			h.BMCRedfishBasicAuth = "maybe"
+		},
 	}
 
 	validate := hardware.StaticMachineAssertions()
@@ -406,9 +406,9 @@ func (r *Reconciler) validateHardwareReqForKCP(validatableCAPI *tinkerbell.Valid
 		if upgradeStrategy != nil && upgradeStrategy.Type == anywherev1.RollingUpdateStrategyType {
 			maxSurge = upgradeStrategy.RollingUpdate.MaxSurge
 		}
-		selectors := tinkerbell.GetSelectorsFromMachineConfig(tinkerbellClusterSpec.ControlPlaneMachineConfig())
+		selectors := tinkerbell.GetLabelSelectorsFromMachineConfig(tinkerbellClusterSpec.ControlPlaneMachineConfig())
 		for _, selector := range selectors {
-			if err := requirements.Add(selector, maxSurge); err != nil {
+			if err := requirements.AddLabelSelector(selector, maxSurge); err != nil {
 				return nil, err
 			}
 		}
@@ -448,9 +448,9 @@ func (r *Reconciler) validateHardwareReqForMachineDeployments(ctx context.Contex
 			if upgradeStrategy != nil && upgradeStrategy.Type == anywherev1.RollingUpdateStrategyType {
 				maxSurge = upgradeStrategy.RollingUpdate.MaxSurge
 			}
-			selectors := tinkerbell.GetSelectorsFromMachineConfig(tinkerbellClusterSpec.WorkerNodeGroupMachineConfig(workerNodeGroup))
+			selectors := tinkerbell.GetLabelSelectorsFromMachineConfig(tinkerbellClusterSpec.WorkerNodeGroupMachineConfig(workerNodeGroup))
 			for _, selector := range selectors {
-				if err := requirements.Add(selector, maxSurge); err != nil {
+				if err := requirements.AddLabelSelector(selector, maxSurge); err != nil {
 					return nil, err
 				}
 			}
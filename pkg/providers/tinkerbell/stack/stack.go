@@ -76,6 +76,7 @@ type Installer struct {
 	loadBalancer          bool
 	stackService          bool
 	dhcpRelay             bool
+	disableDHCP           bool
 }
 
 type InstallOption func(s *Installer)
@@ -129,6 +130,14 @@ func WithDHCPRelayEnabled(enabled bool) InstallOption {
 	}
 }
 
+// WithDHCPEnabled is an InstallOption that allows you to enable/disable the Smee DHCP server.
+// DHCP is enabled by default. When disabled, DHCP Relay is disabled too.
+func WithDHCPEnabled(enabled bool) InstallOption {
+	return func(s *Installer) {
+		s.disableDHCP = !enabled
+	}
+}
+
 // WithHookIsoOverride is an InstallOption allows you to set a URL of the HookOS ISO image.
 func WithHookIsoOverride(url string) InstallOption {
 	return func(s *Installer) {
@@ -260,7 +269,7 @@ func (s *Installer) installSmeeOnDocker(ctx context.Context, bundle releasev1alp
 		"-e", "TINKERBELL_ENABLE_RUFIO_CONTROLLER=false",
 		"-e", "TINKERBELL_ENABLE_SECONDSTAR=false",
 		"-e", "TINKERBELL_ENABLE_CRD_MIGRATIONS=false",
-		"-e", "TINKERBELL_DHCP_ENABLED=true",
+		"-e", fmt.Sprintf("TINKERBELL_DHCP_ENABLED=%t", !s.disableDHCP),
 		"-e", "TINKERBELL_DHCP_MODE=reservation",
 		"-e", fmt.Sprintf("TINKERBELL_DHCP_IP_FOR_PACKET=%s", tinkServerIP),
 		"-e", fmt.Sprintf("TINKERBELL_DHCP_SYSLOG_IP=%s", tinkServerIP),
@@ -589,7 +598,7 @@ func (s *Installer) createValuesOverride(bundle releasev1alpha1.TinkerbellBundle
 				},
 			},
 			"init": map[string]any{
-				"enabled":       s.dhcpRelay,
+				"enabled":       s.dhcpRelay && !s.disableDHCP,
 				"image":         relayInitImageURI,
 				"interfaceMode": "macvlan",
 			},
@@ -606,7 +615,7 @@ func (s *Installer) createValuesOverride(bundle releasev1alpha1.TinkerbellBundle
 					"enableCRDMigrations":   false,
 				},
 				"smee": map[string]any{
-					"dhcpEnabled":                     true,
+					"dhcpEnabled":                     !s.disableDHCP,
 					"dhcpMode":                        "reservation",
 					"dhcpIPForPacket":                 tinkerbellIP,
 					"dhcpSyslogIP":                    tinkerbellIP,
@@ -118,6 +118,7 @@ func TestTinkerbellStackInstallWithDifferentOptions(t *testing.T) {
 		installOnDocker   bool
 		registryMirror    *registrymirror.RegistryMirror
 		proxyConfig       *v1alpha1.ProxyConfiguration
+		dhcpDisabled      bool
 		opts              []stack.InstallOption
 	}{
 		{
@@ -186,6 +187,26 @@ func TestTinkerbellStackInstallWithDifferentOptions(t *testing.T) {
 			expectedFile:    "testdata/expected_with_hook_iso_override.yaml",
 			opts:            []stack.InstallOption{},
 		},
+		{
+			name:         "with_dhcp_disabled",
+			expectedFile: "testdata/expected_with_dhcp_disabled.yaml",
+			dhcpDisabled: true,
+			opts: []stack.InstallOption{
+				stack.WithSmeeOnKubernetes(),
+				stack.WithDHCPRelayEnabled(true),
+				stack.WithDHCPEnabled(false),
+			},
+		},
+		{
+			name:            "with_dhcp_disabled_on_docker",
+			expectedFile:    "testdata/expected_with_dhcp_disabled_on_docker.yaml",
+			installOnDocker: true,
+			dhcpDisabled:    true,
+			opts: []stack.InstallOption{
+				stack.WithSmeeOnDocker(),
+				stack.WithDHCPEnabled(false),
+			},
+		},
 		{
 			name:         "with_registry_mirror",
 			expectedFile: "testdata/expected_with_registry_mirror.yaml",
@@ -250,7 +271,7 @@ func TestTinkerbellStackInstallWithDifferentOptions(t *testing.T) {
 					"-e", "TINKERBELL_ENABLE_SECONDSTAR=false",
 					"-e", "TINKERBELL_ENABLE_CRD_MIGRATIONS=false",
 					// DHCP settings
-					"-e", "TINKERBELL_DHCP_ENABLED=true",
+					"-e", fmt.Sprintf("TINKERBELL_DHCP_ENABLED=%t", !stackTest.dhcpDisabled),
 					"-e", "TINKERBELL_DHCP_MODE=reservation",
 					"-e", gomock.Any(), // TINKERBELL_DHCP_IP_FOR_PACKET
 					"-e", gomock.Any(), // TINKERBELL_DHCP_SYSLOG_IP
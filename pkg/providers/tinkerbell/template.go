@@ -13,6 +13,7 @@ import (
 	"github.com/pkg/errors"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	yamlutil "k8s.io/apimachinery/pkg/util/yaml"
+	bootstrapv1beta2 "sigs.k8s.io/cluster-api/api/bootstrap/kubeadm/v1beta2"
 	"sigs.k8s.io/yaml"
 
 	"github.com/aws/eks-anywhere/pkg/api/v1alpha1"
@@ -155,7 +156,7 @@ func (tb *TemplateBuilder) GenerateCAPISpecWorkers(clusterSpec *cluster.Spec, wo
 		if kubeadmconfigTemplateNames == nil || !ok {
 			return nil, fmt.Errorf("kubeadmconfigTemplateNames invalid in GenerateCAPISpecWorkers: %v", err)
 		}
-		values["workerSshAuthorizedKey"] = tb.WorkerNodeGroupMachineSpecs[workerNodeGroupConfiguration.MachineGroupRef.Name].Users[0].SshAuthorizedKeys[0]
+		values["workerUsers"] = common.BootstrapUsers(tb.WorkerNodeGroupMachineSpecs[workerNodeGroupConfiguration.MachineGroupRef.Name].Users)
 		values["workerReplicas"] = *workerNodeGroupConfiguration.Count
 		values["workloadTemplateName"] = workloadTemplateNames[workerNodeGroupConfiguration.Name]
 		values["workerNodeGroupName"] = workerNodeGroupConfiguration.Name
@@ -215,18 +216,20 @@ func buildTemplateMapCP(
 
 	values := map[string]interface{}{
 		"auditPolicy":                   auditPolicy,
+		"auditLog":                      common.GetAuditLogSettings(clusterSpec.Cluster),
 		"clusterName":                   clusterSpec.Cluster.Name,
 		"controlPlaneEndpointIp":        clusterSpec.Cluster.Spec.ControlPlaneConfiguration.Endpoint.Host,
+		"controlPlaneEndpointVIPCIDR":   common.KubeVipCIDR(clusterSpec.Cluster.Spec.ControlPlaneConfiguration.Endpoint.Host),
 		"controlPlaneReplicas":          clusterSpec.Cluster.Spec.ControlPlaneConfiguration.Count,
-		"apiServerCertSANs":             clusterSpec.Cluster.Spec.ControlPlaneConfiguration.CertSANs,
-		"controlPlaneSshAuthorizedKey":  controlPlaneMachineSpec.Users[0].SshAuthorizedKeys[0],
-		"controlPlaneSshUsername":       controlPlaneMachineSpec.Users[0].Name,
+		"apiServerCertSANs":             common.APIServerCertSANs(clusterSpec.Cluster),
+		"controlPlaneUsers":             common.BootstrapUsers(controlPlaneMachineSpec.Users),
 		"eksaSystemNamespace":           constants.EksaSystemNamespace,
 		"format":                        format,
 		"kubernetesVersion":             versionsBundle.KubeDistro.Kubernetes.Tag,
 		"kubeVipImage":                  versionsBundle.Tinkerbell.KubeVip.VersionedImage(),
 		"podCidrs":                      clusterSpec.Cluster.Spec.ClusterNetwork.Pods.CidrBlocks,
 		"serviceCidrs":                  clusterSpec.Cluster.Spec.ClusterNetwork.Services.CidrBlocks,
+		"skipKubeProxy":                 clusterSpec.Cluster.Spec.ClusterNetwork.CNIConfig.KubeProxyReplacementEnabled(),
 		"apiserverExtraArgs":            apiServerExtraArgs,
 		"baseRegistry":                  "", // TODO: need to get this values for creating template IMAGE_URL
 		"osDistro":                      "", // TODO: need to get this values for creating template IMAGE_URL
@@ -282,7 +285,7 @@ func buildTemplateMapCP(
 		values["externalEtcd"] = true
 		values["externalEtcdReplicas"] = clusterSpec.Cluster.Spec.ExternalEtcdConfiguration.Count
 		values["placeholderExternalEtcdEndpoint"] = constants.PlaceholderExternalEtcdEndpoint
-		values["etcdSshUsername"] = etcdMachineSpec.Users[0].Name
+		values["etcdUsers"] = common.BootstrapUsers(etcdMachineSpec.Users)
 		values["etcdTemplateOverride"] = etcdTemplateOverride
 		values["etcdHardwareSelector"] = etcdMachineSpec.HardwareSelector
 		values["etcdHardwareAffinity"] = etcdMachineSpec.HardwareAffinity
@@ -292,12 +295,21 @@ func buildTemplateMapCP(
 		}
 	}
 
+	var bottlerocketKubernetesSettings *bootstrapv1beta2.BottlerocketKubernetesSettings
 	if controlPlaneMachineSpec.OSFamily == v1alpha1.Bottlerocket {
 		values["format"] = string(v1alpha1.Bottlerocket)
 		values["pauseRepository"] = versionsBundle.KubeDistro.Pause.Image()
 		values["pauseVersion"] = versionsBundle.KubeDistro.Pause.Tag()
 		values["bottlerocketBootstrapRepository"] = versionsBundle.BottleRocketHostContainers.KubeadmBootstrap.Image()
 		values["bottlerocketBootstrapVersion"] = versionsBundle.BottleRocketHostContainers.KubeadmBootstrap.Tag()
+
+		if clusterSpec.Cluster.Spec.ControlPlaneConfiguration.KubeletConfiguration != nil {
+			br, err := common.ConvertToBottlerocketKubernetesSettings(clusterSpec.Cluster.Spec.ControlPlaneConfiguration.KubeletConfiguration)
+			if err != nil {
+				return nil, err
+			}
+			bottlerocketKubernetesSettings = br
+		}
 	}
 
 	if clusterSpec.AWSIamConfig != nil {
@@ -314,8 +326,17 @@ func buildTemplateMapCP(
 		}
 	}
 
-	if controlPlaneMachineSpec.HostOSConfiguration != nil && controlPlaneMachineSpec.HostOSConfiguration.BottlerocketConfiguration != nil {
-		brSettings, err := common.GetCAPIBottlerocketSettingsConfig(controlPlaneMachineSpec.HostOSConfiguration, controlPlaneMachineSpec.HostOSConfiguration.BottlerocketConfiguration.Kubernetes)
+	if bottlerocketKubernetesSettings == nil && controlPlaneMachineSpec.HostOSConfiguration != nil && controlPlaneMachineSpec.HostOSConfiguration.BottlerocketConfiguration != nil {
+		bottlerocketKubernetesSettings = controlPlaneMachineSpec.HostOSConfiguration.BottlerocketConfiguration.Kubernetes
+	}
+
+	brHostOSConfiguration := controlPlaneMachineSpec.HostOSConfiguration
+	if controlPlaneMachineSpec.OSFamily == v1alpha1.Bottlerocket {
+		brHostOSConfiguration = common.BottlerocketHostOSConfiguration(brHostOSConfiguration, clusterSpec.Cluster.Spec.NodeOSConfiguration)
+	}
+
+	if bottlerocketKubernetesSettings != nil || (brHostOSConfiguration != nil && brHostOSConfiguration.BottlerocketConfiguration != nil) {
+		brSettings, err := common.GetCAPIBottlerocketSettingsConfig(brHostOSConfiguration, bottlerocketKubernetesSettings)
 		if err != nil {
 			return nil, err
 		}
@@ -324,6 +345,23 @@ func buildTemplateMapCP(
 		}
 	}
 
+	brContainers, err := common.GetCAPIBottlerocketContainersConfig(controlPlaneMachineSpec.HostOSConfiguration)
+	if err != nil {
+		return nil, err
+	}
+	if brContainers != "" {
+		values["bottlerocketContainers"] = brContainers
+	}
+
+	if hostOS := controlPlaneMachineSpec.HostOSConfiguration; hostOS != nil && hostOS.ContainerdConfiguration != nil && controlPlaneMachineSpec.OSFamily != v1alpha1.Bottlerocket {
+		values["containerdConfigDropIns"] = hostOS.ContainerdConfiguration.ConfigDropIns
+	}
+
+	if nodeOS := clusterSpec.Cluster.Spec.NodeOSConfiguration; nodeOS != nil && controlPlaneMachineSpec.OSFamily != v1alpha1.Bottlerocket {
+		values["nodeSysctls"] = common.GetNodeOSSysctlsConfig(nodeOS)
+		values["nodeKernelModules"] = nodeOS.KernelModules
+	}
+
 	if clusterSpec.Cluster.Spec.ControlPlaneConfiguration.KubeletConfiguration != nil && controlPlaneMachineSpec.OSFamily != v1alpha1.Bottlerocket {
 		cpKubeletConfig := clusterSpec.Cluster.Spec.ControlPlaneConfiguration.KubeletConfiguration.Object
 
@@ -354,6 +392,10 @@ func buildTemplateMapCP(
 		values["cpNodeLabelArgs"] = cpNodeLabelArgs
 	}
 
+	if nodeIPArgs := clusterapi.NodeIPExtraArgs(&clusterSpec.Cluster.Spec.ClusterNetwork); len(nodeIPArgs) != 0 {
+		values["nodeIPArgs"] = nodeIPArgs
+	}
+
 	if !datacenterSpec.IsoBoot {
 		values["bootMode"] = netbootMode
 	} else {
@@ -380,6 +422,20 @@ func buildTemplateMapCP(
 		values["admissionExclusionPolicy"] = admissionExclusionPolicy
 	}
 
+	if clusterSpec.Cluster.Spec.ControlPlaneConfiguration.Konnectivity != nil {
+		konnectivityServerManifest, err := common.KonnectivityServerManifest(clusterSpec.Cluster, versionsBundle.Konnectivity, controlPlaneMachineSpec.OSFamily)
+		if err != nil {
+			return nil, err
+		}
+		konnectivityAgentManifest, err := common.KonnectivityAgentManifest(clusterSpec.Cluster, versionsBundle.Konnectivity, controlPlaneMachineSpec.OSFamily)
+		if err != nil {
+			return nil, err
+		}
+		values["konnectivityEgressSelectorConfig"] = common.KonnectivityEgressSelectorConfig(clusterSpec.Cluster)
+		values["konnectivityServerManifest"] = konnectivityServerManifest
+		values["konnectivityAgentManifest"] = konnectivityAgentManifest
+	}
+
 	return values, nil
 }
 
@@ -394,24 +450,40 @@ func buildTemplateMapMD(
 	format := "cloud-config"
 
 	values := map[string]interface{}{
-		"clusterName":            clusterSpec.Cluster.Name,
-		"eksaSystemNamespace":    constants.EksaSystemNamespace,
-		"format":                 format,
-		"kubernetesVersion":      versionsBundle.KubeDistro.Kubernetes.Tag,
-		"workerNodeGroupName":    workerNodeGroupConfiguration.Name,
-		"workerSshAuthorizedKey": workerNodeGroupMachineSpec.Users[0].SshAuthorizedKeys[0],
-		"workerSshUsername":      workerNodeGroupMachineSpec.Users[0].Name,
-		"hardwareSelector":       workerNodeGroupMachineSpec.HardwareSelector,
-		"hardwareAffinity":       workerNodeGroupMachineSpec.HardwareAffinity,
-		"workerNodeGroupTaints":  workerNodeGroupConfiguration.Taints,
+		"clusterName":           clusterSpec.Cluster.Name,
+		"eksaSystemNamespace":   constants.EksaSystemNamespace,
+		"format":                format,
+		"kubernetesVersion":     versionsBundle.KubeDistro.Kubernetes.Tag,
+		"workerNodeGroupName":   workerNodeGroupConfiguration.Name,
+		"workerUsers":           common.BootstrapUsers(workerNodeGroupMachineSpec.Users),
+		"hardwareSelector":      workerNodeGroupMachineSpec.HardwareSelector,
+		"hardwareAffinity":      workerNodeGroupMachineSpec.HardwareAffinity,
+		"workerNodeGroupTaints": workerNodeGroupConfiguration.Taints,
+	}
+
+	konnectivityAgentManifest, err := common.KonnectivityAgentManifest(clusterSpec.Cluster, versionsBundle.Konnectivity, workerNodeGroupMachineSpec.OSFamily)
+	if err != nil {
+		return nil, err
+	}
+	if konnectivityAgentManifest != "" {
+		values["konnectivityAgentManifest"] = konnectivityAgentManifest
 	}
 
+	var bottlerocketKubernetesSettings *bootstrapv1beta2.BottlerocketKubernetesSettings
 	if workerNodeGroupMachineSpec.OSFamily == v1alpha1.Bottlerocket {
 		values["format"] = string(v1alpha1.Bottlerocket)
 		values["pauseRepository"] = versionsBundle.KubeDistro.Pause.Image()
 		values["pauseVersion"] = versionsBundle.KubeDistro.Pause.Tag()
 		values["bottlerocketBootstrapRepository"] = versionsBundle.BottleRocketHostContainers.KubeadmBootstrap.Image()
 		values["bottlerocketBootstrapVersion"] = versionsBundle.BottleRocketHostContainers.KubeadmBootstrap.Tag()
+
+		if workerNodeGroupConfiguration.KubeletConfiguration != nil {
+			br, err := common.ConvertToBottlerocketKubernetesSettings(workerNodeGroupConfiguration.KubeletConfiguration)
+			if err != nil {
+				return nil, err
+			}
+			bottlerocketKubernetesSettings = br
+		}
 	}
 
 	if clusterSpec.Cluster.Spec.RegistryMirrorConfiguration != nil {
@@ -445,14 +517,42 @@ func buildTemplateMapMD(
 		}
 	}
 
-	if workerNodeGroupMachineSpec.HostOSConfiguration != nil && workerNodeGroupMachineSpec.HostOSConfiguration.BottlerocketConfiguration != nil {
-		brSettings, err := common.GetCAPIBottlerocketSettingsConfig(workerNodeGroupMachineSpec.HostOSConfiguration, workerNodeGroupMachineSpec.HostOSConfiguration.BottlerocketConfiguration.Kubernetes)
+	if bottlerocketKubernetesSettings == nil && workerNodeGroupMachineSpec.HostOSConfiguration != nil && workerNodeGroupMachineSpec.HostOSConfiguration.BottlerocketConfiguration != nil {
+		bottlerocketKubernetesSettings = workerNodeGroupMachineSpec.HostOSConfiguration.BottlerocketConfiguration.Kubernetes
+	}
+
+	brHostOSConfiguration := workerNodeGroupMachineSpec.HostOSConfiguration
+	if workerNodeGroupMachineSpec.OSFamily == v1alpha1.Bottlerocket {
+		brHostOSConfiguration = common.BottlerocketHostOSConfiguration(brHostOSConfiguration, clusterSpec.Cluster.Spec.NodeOSConfiguration)
+	}
+
+	if bottlerocketKubernetesSettings != nil || (brHostOSConfiguration != nil && brHostOSConfiguration.BottlerocketConfiguration != nil) {
+		brSettings, err := common.GetCAPIBottlerocketSettingsConfig(brHostOSConfiguration, bottlerocketKubernetesSettings)
 		if err != nil {
 			return nil, err
 		}
 		values["bottlerocketSettings"] = brSettings
 	}
 
+	brContainers, err := common.GetCAPIBottlerocketContainersConfig(workerNodeGroupMachineSpec.HostOSConfiguration)
+	if err != nil {
+		return nil, err
+	}
+	if brContainers != "" {
+		values["bottlerocketContainers"] = brContainers
+	}
+
+	if workerNodeGroupMachineSpec.OSFamily != v1alpha1.Bottlerocket {
+		if dropIns := common.WorkerContainerdConfigDropIns(workerNodeGroupMachineSpec.HostOSConfiguration, workerNodeGroupConfiguration); len(dropIns) > 0 {
+			values["containerdConfigDropIns"] = dropIns
+		}
+	}
+
+	if nodeOS := clusterSpec.Cluster.Spec.NodeOSConfiguration; nodeOS != nil && workerNodeGroupMachineSpec.OSFamily != v1alpha1.Bottlerocket {
+		values["nodeSysctls"] = common.GetNodeOSSysctlsConfig(nodeOS)
+		values["nodeKernelModules"] = nodeOS.KernelModules
+	}
+
 	if workerNodeGroupConfiguration.KubeletConfiguration != nil && workerNodeGroupMachineSpec.OSFamily != v1alpha1.Bottlerocket {
 		wnKubeletConfig := workerNodeGroupConfiguration.KubeletConfiguration.Object
 		if _, ok := wnKubeletConfig["tlsCipherSuites"]; !ok {
@@ -481,6 +581,10 @@ func buildTemplateMapMD(
 		values["wnNodeLabelArgs"] = wnNodeLabelArgs
 	}
 
+	if nodeIPArgs := clusterapi.NodeIPExtraArgs(&clusterSpec.Cluster.Spec.ClusterNetwork); len(nodeIPArgs) != 0 {
+		values["nodeIPArgs"] = nodeIPArgs
+	}
+
 	if !datacenterSpec.IsoBoot {
 		values["bootMode"] = netbootMode
 	} else {
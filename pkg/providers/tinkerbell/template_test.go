@@ -14,6 +14,7 @@ import (
 	"github.com/aws/eks-anywhere/pkg/clusterapi"
 	"github.com/aws/eks-anywhere/pkg/providers/common"
 	"github.com/aws/eks-anywhere/pkg/utils/ptr"
+	releasev1 "github.com/aws/eks-anywhere/release/api/v1alpha1"
 )
 
 func TestGenerateTemplateBuilder(t *testing.T) {
@@ -201,6 +202,94 @@ func TestTemplateBuilder(t *testing.T) {
 	}
 }
 
+func TestTemplateBuilderContainerdConfigDropIns(t *testing.T) {
+	g := NewWithT(t)
+	clusterSpec := test.NewFullClusterSpec(t, "testdata/cluster_tinkerbell_stacked_etcd.yaml")
+	clusterSpec.Cluster.AddTinkerbellIPAnnotation("1.1.1.1")
+	for _, machineConfig := range clusterSpec.TinkerbellMachineConfigs {
+		machineConfig.Spec.OSFamily = v1alpha1.Ubuntu
+		machineConfig.Spec.HostOSConfiguration = &v1alpha1.HostOSConfiguration{
+			ContainerdConfiguration: &v1alpha1.ContainerdConfiguration{
+				ConfigDropIns: []v1alpha1.ContainerdConfigDropIn{
+					{Name: "nri", Content: "[plugins.\"io.containerd.nri.v1.nri\"]\n  disable = false\n"},
+				},
+			},
+		}
+	}
+	importsCommand := `grep -q '^imports' /etc/containerd/config.toml || sed -i '1i imports = ["/etc/containerd/conf.d/*.toml"]' /etc/containerd/config.toml`
+
+	cpMachineCfg, err := getControlPlaneMachineSpec(clusterSpec)
+	g.Expect(err).ToNot(HaveOccurred())
+	wngMachineCfgs, err := getWorkerNodeGroupMachineSpec(clusterSpec)
+	g.Expect(err).ToNot(HaveOccurred())
+	bldr := NewTemplateBuilder(&clusterSpec.TinkerbellDatacenter.Spec, cpMachineCfg, nil, wngMachineCfgs, "0.0.0.0", time.Now)
+
+	data, err := bldr.GenerateCAPISpecControlPlane(clusterSpec)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(string(data)).To(ContainSubstring(`      - content: |
+          [plugins."io.containerd.nri.v1.nri"]
+            disable = false
+        owner: root:root
+        path: /etc/containerd/conf.d/nri.toml`))
+	g.Expect(string(data)).To(ContainSubstring("    - " + importsCommand + "\n    - sudo systemctl daemon-reload\n    - sudo systemctl restart containerd"))
+
+	workerTemplateNames, kubeadmTemplateNames := clusterapi.InitialTemplateNamesForWorkers(clusterSpec)
+	data, err = bldr.GenerateCAPISpecWorkers(clusterSpec, workerTemplateNames, kubeadmTemplateNames)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(string(data)).To(ContainSubstring(`      files:
+        - content: |
+            [plugins."io.containerd.nri.v1.nri"]
+              disable = false
+          owner: root:root
+          path: /etc/containerd/conf.d/nri.toml`))
+	g.Expect(string(data)).To(ContainSubstring("      - " + importsCommand + "\n      - sudo systemctl daemon-reload\n      - sudo systemctl restart containerd"))
+}
+
+func TestTemplateBuilderNodeOSConfiguration(t *testing.T) {
+	g := NewWithT(t)
+	clusterSpec := test.NewFullClusterSpec(t, "testdata/cluster_tinkerbell_stacked_etcd.yaml")
+	clusterSpec.Cluster.AddTinkerbellIPAnnotation("1.1.1.1")
+	clusterSpec.Cluster.Spec.NodeOSConfiguration = &v1alpha1.NodeOSConfiguration{
+		Sysctls:       map[string]string{"vm.max_map_count": "262144"},
+		KernelModules: []string{"br_netfilter"},
+	}
+	for _, machineConfig := range clusterSpec.TinkerbellMachineConfigs {
+		machineConfig.Spec.OSFamily = v1alpha1.Ubuntu
+	}
+
+	cpMachineCfg, err := getControlPlaneMachineSpec(clusterSpec)
+	g.Expect(err).ToNot(HaveOccurred())
+	wngMachineCfgs, err := getWorkerNodeGroupMachineSpec(clusterSpec)
+	g.Expect(err).ToNot(HaveOccurred())
+	bldr := NewTemplateBuilder(&clusterSpec.TinkerbellDatacenter.Spec, cpMachineCfg, nil, wngMachineCfgs, "0.0.0.0", time.Now)
+
+	data, err := bldr.GenerateCAPISpecControlPlane(clusterSpec)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(string(data)).To(ContainSubstring(`      - content: |
+          vm.max_map_count = 262144
+        owner: root:root
+        path: /etc/sysctl.d/99-eksa-node-os.conf
+      - content: |
+          br_netfilter
+        owner: root:root
+        path: /etc/modules-load.d/eksa-node-os.conf`))
+	g.Expect(string(data)).To(ContainSubstring("    preKubeadmCommands:\n    - systemctl restart systemd-modules-load.service\n    - sysctl --system\n"))
+
+	workerTemplateNames, kubeadmTemplateNames := clusterapi.InitialTemplateNamesForWorkers(clusterSpec)
+	data, err = bldr.GenerateCAPISpecWorkers(clusterSpec, workerTemplateNames, kubeadmTemplateNames)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(string(data)).To(ContainSubstring(`      files:
+        - content: |
+            vm.max_map_count = 262144
+          owner: root:root
+          path: /etc/sysctl.d/99-eksa-node-os.conf
+        - content: |
+            br_netfilter
+          owner: root:root
+          path: /etc/modules-load.d/eksa-node-os.conf`))
+	g.Expect(string(data)).To(ContainSubstring("      preKubeadmCommands:\n      - systemctl restart systemd-modules-load.service\n      - sysctl --system\n      users:"))
+}
+
 func TestTemplateBuilderCPKubeletConfig(t *testing.T) {
 	for _, tc := range []struct {
 		Input  string
@@ -298,6 +387,78 @@ func TestTemplateBuilderWNKubeletConfig(t *testing.T) {
 	}
 }
 
+func TestTemplateBuilderKubeletConfigBottlerocket(t *testing.T) {
+	g := NewWithT(t)
+	clusterSpec := test.NewFullClusterSpec(t, "testdata/cluster_tinkerbell_bottlerocket_minimal_registry_mirror.yaml")
+	kubeletConfig := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"maxPods":    int64(20),
+			"apiVersion": "kubelet.config.k8s.io/v1beta1",
+			"kind":       "KubeletConfiguration",
+		},
+	}
+	clusterSpec.Cluster.Spec.ControlPlaneConfiguration.KubeletConfiguration = kubeletConfig
+	clusterSpec.Cluster.Spec.WorkerNodeGroupConfigurations[0].KubeletConfiguration = kubeletConfig.DeepCopy()
+
+	cpMachineCfg, _ := getControlPlaneMachineSpec(clusterSpec)
+	wngMachineCfgs, _ := getWorkerNodeGroupMachineSpec(clusterSpec)
+	bldr := NewTemplateBuilder(&clusterSpec.TinkerbellDatacenter.Spec, cpMachineCfg, nil, wngMachineCfgs, "0.0.0.0", time.Now)
+
+	data, err := bldr.GenerateCAPISpecControlPlane(clusterSpec)
+	g.Expect(err).ToNot(HaveOccurred())
+	test.AssertContentToFile(t, string(data), "testdata/expected_results_bottlerocket_kubelet_config_cp.yaml")
+
+	workerTemplateNames, kubeadmTemplateNames := clusterapi.InitialTemplateNamesForWorkers(clusterSpec)
+	data, err = bldr.GenerateCAPISpecWorkers(clusterSpec, workerTemplateNames, kubeadmTemplateNames)
+	g.Expect(err).ToNot(HaveOccurred())
+	test.AssertContentToFile(t, string(data), "testdata/expected_results_bottlerocket_kubelet_config_md.yaml")
+}
+
+func TestTemplateBuilderKonnectivityBottlerocket(t *testing.T) {
+	g := NewWithT(t)
+	clusterSpec := test.NewFullClusterSpec(t, "testdata/cluster_tinkerbell_bottlerocket_minimal_registry_mirror.yaml")
+	clusterSpec.Cluster.Spec.ControlPlaneConfiguration.Konnectivity = &v1alpha1.KonnectivityConfiguration{
+		ServerHost: "konnectivity.example.com",
+		AgentPort:  8132,
+	}
+	clusterSpec.RootVersionsBundle().Konnectivity = &releasev1.KonnectivityBundle{
+		Server: releasev1.Image{URI: "public.ecr.aws/eks-anywhere/konnectivity/server:v0.31.0"},
+		Agent:  releasev1.Image{URI: "public.ecr.aws/eks-anywhere/konnectivity/agent:v0.31.0"},
+	}
+
+	cpMachineCfg, _ := getControlPlaneMachineSpec(clusterSpec)
+	wngMachineCfgs, _ := getWorkerNodeGroupMachineSpec(clusterSpec)
+	bldr := NewTemplateBuilder(&clusterSpec.TinkerbellDatacenter.Spec, cpMachineCfg, nil, wngMachineCfgs, "0.0.0.0", time.Now)
+
+	data, err := bldr.GenerateCAPISpecControlPlane(clusterSpec)
+	g.Expect(err).ToNot(HaveOccurred())
+	test.AssertContentToFile(t, string(data), "testdata/expected_results_bottlerocket_konnectivity_cp.yaml")
+
+	workerTemplateNames, kubeadmTemplateNames := clusterapi.InitialTemplateNamesForWorkers(clusterSpec)
+	data, err = bldr.GenerateCAPISpecWorkers(clusterSpec, workerTemplateNames, kubeadmTemplateNames)
+	g.Expect(err).ToNot(HaveOccurred())
+	test.AssertContentToFile(t, string(data), "testdata/expected_results_bottlerocket_konnectivity_md.yaml")
+}
+
+func TestTemplateBuilderKubeletConfigBottlerocketInvalid(t *testing.T) {
+	g := NewWithT(t)
+	clusterSpec := test.NewFullClusterSpec(t, "testdata/cluster_tinkerbell_bottlerocket_minimal_registry_mirror.yaml")
+	clusterSpec.Cluster.Spec.ControlPlaneConfiguration.KubeletConfiguration = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"maxPods":    "twenty",
+			"apiVersion": "kubelet.config.k8s.io/v1beta1",
+			"kind":       "KubeletConfiguration",
+		},
+	}
+
+	cpMachineCfg, _ := getControlPlaneMachineSpec(clusterSpec)
+	wngMachineCfgs, _ := getWorkerNodeGroupMachineSpec(clusterSpec)
+	bldr := NewTemplateBuilder(&clusterSpec.TinkerbellDatacenter.Spec, cpMachineCfg, nil, wngMachineCfgs, "0.0.0.0", time.Now)
+
+	_, err := bldr.GenerateCAPISpecControlPlane(clusterSpec)
+	g.Expect(err).To(MatchError(ContainSubstring("unmarshaling KubeletConfiguration")))
+}
+
 func TestTemplateBuilderCPHookIso(t *testing.T) {
 	for _, tc := range []struct {
 		Input  string
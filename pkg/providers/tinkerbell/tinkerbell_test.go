@@ -254,6 +254,7 @@ func TestPreCAPIInstallOnBootstrapSuccess(t *testing.T) {
 		gomock.Any(),
 		gomock.Any(),
 		gomock.Any(),
+		gomock.Any(),
 	)
 
 	err := provider.PreCAPIInstallOnBootstrap(ctx, cluster, clusterSpec)
@@ -302,6 +303,7 @@ func TestPostWorkloadInitSuccess(t *testing.T) {
 		gomock.Any(),
 		gomock.Any(),
 		gomock.Any(),
+		gomock.Any(),
 	)
 	stackInstaller.EXPECT().UninstallLocal(ctx)
 
@@ -130,6 +130,7 @@ func (p *Provider) SetupAndValidateUpgradeManagementComponents(_ context.Context
 func (p *Provider) validateAvailableHardwareForUpgrade(ctx context.Context, currentSpec, newClusterSpec *cluster.Spec) (err error) {
 	clusterSpecValidator := NewClusterSpecValidator(
 		HardwareSatisfiesOnlyOneSelectorAssertion(p.catalogue),
+		HardwareHasBMCAssertionForISOBoot(p.catalogue),
 	)
 	eksaVersionUpgrade := currentSpec.Bundles.Spec.Number != newClusterSpec.Bundles.Spec.Number
 
@@ -182,9 +183,9 @@ func (p *Provider) validateHardwareReqForControlPlaneRollOut(currentSpec, newClu
 		maxSurge = newClusterSpec.Cluster.Spec.ControlPlaneConfiguration.UpgradeRolloutStrategy.RollingUpdate.MaxSurge
 	}
 	if oldCP.Spec.OSImageURL != newCP.Spec.OSImageURL {
-		selectors := GetSelectorsFromMachineConfig(newCP)
+		selectors := GetLabelSelectorsFromMachineConfig(newCP)
 		for _, selector := range selectors {
-			if err := requirements.Add(selector, maxSurge); err != nil {
+			if err := requirements.AddLabelSelector(selector, maxSurge); err != nil {
 				return nil, fmt.Errorf("validating hardware requirements for control-plane nodes roll out: %v", err)
 			}
 		}
@@ -207,9 +208,9 @@ func (p *Provider) validateHardwareReqForWorkerNodeGroupsRollOut(currentSpec, ne
 			if rolloutStrategy != nil && rolloutStrategy.Type == "RollingUpdate" {
 				maxSurge = rolloutStrategy.RollingUpdate.MaxSurge
 			}
-			selectors := GetSelectorsFromMachineConfig(newWng)
+			selectors := GetLabelSelectorsFromMachineConfig(newWng)
 			for _, selector := range selectors {
-				if err := requirements.Add(selector, maxSurge); err != nil {
+				if err := requirements.AddLabelSelector(selector, maxSurge); err != nil {
 					return nil, fmt.Errorf("validating hardware requirements for worker node groups roll out: %v", err)
 				}
 			}
@@ -562,6 +563,7 @@ func (p *Provider) PreCoreComponentsUpgrade(
 			len(clusterSpec.Cluster.Spec.WorkerNodeGroupConfigurations) != 0 && // load balancer is handled by kube-vip in control plane nodes
 				!p.datacenterConfig.Spec.SkipLoadBalancerDeployment), // configure load balancer based on datacenterConfig.Spec.SkipLoadBalancerDeployment
 		stack.WithHookIsoOverride(p.datacenterConfig.Spec.HookIsoURL),
+		stack.WithDHCPEnabled(!p.datacenterConfig.Spec.DisableDHCP),
 	)
 	if err != nil {
 		return fmt.Errorf("upgrading stack: %v", err)
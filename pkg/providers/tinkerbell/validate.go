@@ -1,12 +1,16 @@
 package tinkerbell
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"path"
+	"sort"
 	"strings"
 
 	tinkv1alpha1 "github.com/tinkerbell/tink/api/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 
 	"github.com/aws/eks-anywhere/pkg/api/v1alpha1"
 	"github.com/aws/eks-anywhere/pkg/networkutils"
@@ -280,13 +284,34 @@ func GetSelectorsFromMachineConfig(config *v1alpha1.TinkerbellMachineConfig) []v
 	return nil
 }
 
+// GetLabelSelectorsFromMachineConfig extracts the label selectors used to select hardware for a machine config.
+// If HardwareAffinity is set, it returns the full label selector, including set-based matchExpressions,
+// of each Required term. Otherwise, it returns the HardwareSelector as a label selector.
+func GetLabelSelectorsFromMachineConfig(config *v1alpha1.TinkerbellMachineConfig) []metav1.LabelSelector {
+	if config.Spec.HardwareAffinity != nil {
+		var selectors []metav1.LabelSelector
+		for _, term := range config.Spec.HardwareAffinity.Required {
+			if len(term.LabelSelector.MatchLabels) > 0 || len(term.LabelSelector.MatchExpressions) > 0 {
+				selectors = append(selectors, term.LabelSelector)
+			}
+		}
+		return selectors
+	}
+
+	if len(config.Spec.HardwareSelector) > 0 {
+		return []metav1.LabelSelector{{MatchLabels: config.Spec.HardwareSelector}}
+	}
+
+	return nil
+}
+
 // minimumHardwareRequirement defines the minimum requirement for a hardware selector.
 type minimumHardwareRequirement struct {
 	// MinCount is the minimum number of hardware required to satisfy the requirement
 	MinCount int
 	// Selector defines what labels should be present on Hardware to consider it eligable for
 	// this requirement.
-	Selector v1alpha1.HardwareSelector
+	Selector labels.Selector
 	// count is used internally by validation to sum the actual available hardware.
 	count int
 }
@@ -298,14 +323,25 @@ type MinimumHardwareRequirements map[string]*minimumHardwareRequirement
 
 // Add a minimumHardwareRequirement to r.
 func (r *MinimumHardwareRequirements) Add(selector v1alpha1.HardwareSelector, min int) error {
-	name, err := selector.ToString()
+	return r.AddLabelSelector(metav1.LabelSelector{MatchLabels: selector}, min)
+}
+
+// AddLabelSelector adds a minimumHardwareRequirement to r for a label selector that can include
+// set-based matchExpressions.
+func (r *MinimumHardwareRequirements) AddLabelSelector(selector metav1.LabelSelector, min int) error {
+	name, err := labelSelectorToString(selector)
 	if err != nil {
 		return err
 	}
 
+	s, err := metav1.LabelSelectorAsSelector(&selector)
+	if err != nil {
+		return fmt.Errorf("invalid hardware selector '%v': %v", name, err)
+	}
+
 	(*r)[name] = &minimumHardwareRequirement{
 		MinCount: min,
-		Selector: selector,
+		Selector: s,
 	}
 
 	return nil
@@ -319,7 +355,7 @@ func validateMinimumHardwareRequirements(requirements MinimumHardwareRequirement
 	// selectors. That requires a different validation ideally run before this one.
 	for _, h := range catalogue.AllHardware() {
 		for _, r := range requirements {
-			if hardware.LabelsMatchSelector(r.Selector, h.Labels) {
+			if r.Selector.Matches(labels.Set(h.Labels)) {
 				r.count++
 			}
 		}
@@ -347,15 +383,11 @@ func validateMinimumHardwareRequirements(requirements MinimumHardwareRequirement
 func validateHardwareSatisfiesOnlyOneSelector(allHardware []*tinkv1alpha1.Hardware, selectors selectorSet) error {
 	for _, h := range allHardware {
 		if matches := getMatchingHardwareSelectors(h, selectors); len(matches) > 1 {
-			slctrStrs, err := getHardwareSelectorsAsStrings(matches)
-			if err != nil {
-				return err
-			}
-
+			sort.Strings(matches)
 			return fmt.Errorf(
 				"hardware must only satisfy 1 selector: hardware name '%v'; selectors '%v'",
 				h.Name,
-				strings.Join(slctrStrs, ", "),
+				strings.Join(matches, ", "),
 			)
 		}
 	}
@@ -366,41 +398,51 @@ func validateHardwareSatisfiesOnlyOneSelector(allHardware []*tinkv1alpha1.Hardwa
 // selectorSet defines a set of selectors. Selectors should be added using the Add method to ensure
 // deterministic key generation. The construct is useful to avoid treating selectors that are the
 // same as different.
-type selectorSet map[string]v1alpha1.HardwareSelector
+type selectorSet map[string]labels.Selector
 
 // Add adds selector to ss.
 func (ss *selectorSet) Add(selector v1alpha1.HardwareSelector) error {
-	slctrStr, err := selector.ToString()
+	return ss.AddLabelSelector(metav1.LabelSelector{MatchLabels: selector})
+}
+
+// AddLabelSelector adds a label selector, that can include set-based matchExpressions, to ss.
+func (ss *selectorSet) AddLabelSelector(selector metav1.LabelSelector) error {
+	slctrStr, err := labelSelectorToString(selector)
 	if err != nil {
 		return err
 	}
 
-	(*ss)[slctrStr] = selector
+	s, err := metav1.LabelSelectorAsSelector(&selector)
+	if err != nil {
+		return fmt.Errorf("invalid hardware selector '%v': %v", slctrStr, err)
+	}
+
+	(*ss)[slctrStr] = s
 
 	return nil
 }
 
-func getMatchingHardwareSelectors(
-	hw *tinkv1alpha1.Hardware,
-	selectors selectorSet,
-) []v1alpha1.HardwareSelector {
-	var satisfies []v1alpha1.HardwareSelector
-	for _, selector := range selectors {
-		if hardware.LabelsMatchSelector(selector, hw.Labels) {
-			satisfies = append(satisfies, selector)
+// getMatchingHardwareSelectors returns the string representation of all selectors in selectors hw satisfies.
+func getMatchingHardwareSelectors(hw *tinkv1alpha1.Hardware, selectors selectorSet) []string {
+	var satisfies []string
+	for name, selector := range selectors {
+		if selector.Matches(labels.Set(hw.Labels)) {
+			satisfies = append(satisfies, name)
 		}
 	}
 	return satisfies
 }
 
-func getHardwareSelectorsAsStrings(selectors []v1alpha1.HardwareSelector) ([]string, error) {
-	var slctrs []string
-	for _, selector := range selectors {
-		s, err := selector.ToString()
-		if err != nil {
-			return nil, err
-		}
-		slctrs = append(slctrs, s)
+// labelSelectorToString returns a deterministic string representation of selector. Selectors with
+// only matchLabels are represented the same way as the equivalent HardwareSelector.
+func labelSelectorToString(selector metav1.LabelSelector) (string, error) {
+	if len(selector.MatchExpressions) == 0 {
+		return v1alpha1.HardwareSelector(selector.MatchLabels).ToString()
+	}
+
+	encoded, err := json.Marshal(selector)
+	if err != nil {
+		return "", err
 	}
-	return slctrs, nil
+	return string(encoded), nil
 }
@@ -0,0 +1,73 @@
+package vsphere
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/constants"
+	"github.com/aws/eks-anywhere/pkg/logger"
+)
+
+// CredentialsValidator validates the vCenter credentials stored in the management cluster.
+type CredentialsValidator struct {
+	client               client.Client
+	vSphereClientBuilder VSphereClientBuilder
+}
+
+// NewCredentialsValidator returns a new CredentialsValidator. vscb must log in for every client
+// it builds, since pooled sessions outlive a password change or expiration.
+func NewCredentialsValidator(client client.Client, vscb VSphereClientBuilder) *CredentialsValidator {
+	return &CredentialsValidator{
+		client:               client,
+		vSphereClientBuilder: vscb,
+	}
+}
+
+// Secret returns the Secret holding the vCenter credentials.
+func (v *CredentialsValidator) Secret(ctx context.Context, _ *anywherev1.Cluster) (*corev1.Secret, error) {
+	secret := &corev1.Secret{}
+	key := client.ObjectKey{Namespace: constants.EksaSystemNamespace, Name: CredentialsObjectName}
+	if err := v.client.Get(ctx, key, secret); err != nil {
+		return nil, fmt.Errorf("reading vSphere credentials Secret %s: %w", key, err)
+	}
+
+	return secret, nil
+}
+
+// Validate logs in to the vCenter server of the cluster with the credentials in secret and reads
+// when their SSO password expires. vCenter rejects expired passwords, so they are reported as invalid
+// credentials. Reading the expiration needs access to the SSO admin service, so when it fails the
+// credentials are still reported as valid, without expiration.
+func (v *CredentialsValidator) Validate(ctx context.Context, cluster *anywherev1.Cluster, secret *corev1.Secret) (*time.Time, error) {
+	datacenter := &anywherev1.VSphereDatacenterConfig{}
+	key := client.ObjectKey{Namespace: cluster.Namespace, Name: cluster.Spec.DatacenterRef.Name}
+	if err := v.client.Get(ctx, key, datacenter); err != nil {
+		return nil, fmt.Errorf("reading VSphereDatacenterConfig %s: %v", key, err)
+	}
+
+	vsc, err := v.vSphereClientBuilder.Build(
+		ctx,
+		datacenter.Spec.Server,
+		string(secret.Data["username"]),
+		string(secret.Data["password"]),
+		datacenter.Spec.Insecure,
+		datacenter.Spec.Datacenter,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("logging in to vCenter %s: %v", datacenter.Spec.Server, err)
+	}
+	defer closeVSphereClient(ctx, vsc)
+
+	expiration, err := vsc.PasswordExpiration(ctx)
+	if err != nil {
+		logger.V(2).Info("Can't read vCenter password expiration", "server", datacenter.Spec.Server, "error", err)
+		return nil, nil
+	}
+
+	return expiration, nil
+}
@@ -0,0 +1,132 @@
+package vsphere_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	govmomimocks "github.com/aws/eks-anywhere/pkg/govmomi/mocks"
+	"github.com/aws/eks-anywhere/pkg/providers/vsphere"
+	"github.com/aws/eks-anywhere/pkg/providers/vsphere/mocks"
+)
+
+func credentialsTestObjects() (*anywherev1.Cluster, *anywherev1.VSphereDatacenterConfig, *corev1.Secret) {
+	cluster := &anywherev1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-cluster", Namespace: "default"},
+		Spec: anywherev1.ClusterSpec{
+			DatacenterRef: anywherev1.Ref{Kind: anywherev1.VSphereDatacenterKind, Name: "my-datacenter"},
+		},
+	}
+	datacenter := &anywherev1.VSphereDatacenterConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-datacenter", Namespace: "default"},
+		Spec: anywherev1.VSphereDatacenterConfigSpec{
+			Server:     "vcenter.example.com",
+			Datacenter: "SDDC-Datacenter",
+			Insecure:   true,
+		},
+	}
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: vsphere.CredentialsObjectName, Namespace: "eksa-system"},
+		Data: map[string][]byte{
+			"username": []byte("admin"),
+			"password": []byte("password"),
+		},
+	}
+
+	return cluster, datacenter, secret
+}
+
+func credentialsTestClient(objs ...runtime.Object) *fake.ClientBuilder {
+	scheme := runtime.NewScheme()
+	_ = corev1.AddToScheme(scheme)
+	_ = anywherev1.AddToScheme(scheme)
+	return fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(objs...)
+}
+
+func TestCredentialsValidatorSecret(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	cluster, _, secret := credentialsTestObjects()
+	v := vsphere.NewCredentialsValidator(credentialsTestClient(secret).Build(), nil)
+
+	got, err := v.Secret(ctx, cluster)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(got.Data).To(Equal(secret.Data))
+}
+
+func TestCredentialsValidatorSecretNotFound(t *testing.T) {
+	g := NewWithT(t)
+	cluster, _, _ := credentialsTestObjects()
+	v := vsphere.NewCredentialsValidator(credentialsTestClient().Build(), nil)
+
+	_, err := v.Secret(context.Background(), cluster)
+	g.Expect(apierrors.IsNotFound(err)).To(BeTrue())
+}
+
+func TestCredentialsValidatorValidate(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	cluster, datacenter, secret := credentialsTestObjects()
+	clientBuilder := mocks.NewMockVSphereClientBuilder(ctrl)
+	vsc := govmomimocks.NewMockVSphereClient(ctrl)
+	clientBuilder.EXPECT().Build(ctx, "vcenter.example.com", "admin", "password", true, "SDDC-Datacenter").Return(vsc, nil)
+	expiration := time.Date(2026, 12, 31, 0, 0, 0, 0, time.UTC)
+	vsc.EXPECT().PasswordExpiration(ctx).Return(&expiration, nil)
+	vsc.EXPECT().Close(ctx).Return(nil)
+	v := vsphere.NewCredentialsValidator(credentialsTestClient(datacenter).Build(), clientBuilder)
+
+	got, err := v.Validate(ctx, cluster, secret)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(got).To(Equal(&expiration))
+}
+
+func TestCredentialsValidatorValidatePasswordExpirationError(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	cluster, datacenter, secret := credentialsTestObjects()
+	clientBuilder := mocks.NewMockVSphereClientBuilder(ctrl)
+	vsc := govmomimocks.NewMockVSphereClient(ctrl)
+	clientBuilder.EXPECT().Build(ctx, "vcenter.example.com", "admin", "password", true, "SDDC-Datacenter").Return(vsc, nil)
+	vsc.EXPECT().PasswordExpiration(ctx).Return(nil, errors.New("NoPermission"))
+	vsc.EXPECT().Close(ctx).Return(nil)
+	v := vsphere.NewCredentialsValidator(credentialsTestClient(datacenter).Build(), clientBuilder)
+
+	got, err := v.Validate(ctx, cluster, secret)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(got).To(BeNil())
+}
+
+func TestCredentialsValidatorValidateLoginError(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	cluster, datacenter, secret := credentialsTestObjects()
+	clientBuilder := mocks.NewMockVSphereClientBuilder(ctrl)
+	clientBuilder.EXPECT().Build(ctx, "vcenter.example.com", "admin", "password", true, "SDDC-Datacenter").
+		Return(nil, errors.New("ServerFaultCode: Cannot complete login due to an incorrect user name or password."))
+	v := vsphere.NewCredentialsValidator(credentialsTestClient(datacenter).Build(), clientBuilder)
+
+	_, err := v.Validate(ctx, cluster, secret)
+	g.Expect(err).To(MatchError(ContainSubstring("logging in to vCenter vcenter.example.com: ServerFaultCode")))
+}
+
+func TestCredentialsValidatorValidateMissingDatacenter(t *testing.T) {
+	g := NewWithT(t)
+	cluster, _, secret := credentialsTestObjects()
+	v := vsphere.NewCredentialsValidator(credentialsTestClient().Build(), nil)
+
+	_, err := v.Validate(context.Background(), cluster, secret)
+	g.Expect(err).To(MatchError(ContainSubstring("reading VSphereDatacenterConfig default/my-datacenter")))
+}
@@ -3,11 +3,14 @@ package vsphere
 import (
 	"context"
 	"fmt"
+	"net/url"
+	"path"
 	"path/filepath"
 	"strings"
 
 	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
 	"github.com/aws/eks-anywhere/pkg/cluster"
+	"github.com/aws/eks-anywhere/pkg/config"
 	"github.com/aws/eks-anywhere/pkg/logger"
 	"github.com/aws/eks-anywhere/pkg/providers/vsphere/internal/templates"
 	releasev1 "github.com/aws/eks-anywhere/release/api/v1alpha1"
@@ -16,18 +19,46 @@ import (
 const minDiskGib int = 20
 
 type Defaulter struct {
-	govc ProviderGovcClient
+	govc           ProviderGovcClient
+	ovaDownloadDir string
 }
 
-func NewDefaulter(govc ProviderGovcClient) *Defaulter {
-	return &Defaulter{
+// DefaulterOpt allows to customize a Defaulter.
+type DefaulterOpt func(*Defaulter)
+
+// WithOVADownloadDir sets the folder where the OVAs configured in machine configs are downloaded before
+// being imported. It has to be mounted in the tools container, like the cluster folder.
+func WithOVADownloadDir(dir string) DefaulterOpt {
+	return func(d *Defaulter) {
+		d.ovaDownloadDir = dir
+	}
+}
+
+func NewDefaulter(govc ProviderGovcClient, opts ...DefaulterOpt) *Defaulter {
+	d := &Defaulter{
 		govc: govc,
 	}
+
+	for _, opt := range opts {
+		opt(d)
+	}
+
+	return d
 }
 
 func (d *Defaulter) setDefaultsForMachineConfig(ctx context.Context, spec *Spec) error {
 	setDefaultsForEtcdMachineConfig(spec.etcdMachineConfig())
 
+	for _, m := range spec.machineConfigs() {
+		if err := d.deployTemplateFromLibraryIfMissing(ctx, spec, m); err != nil {
+			return err
+		}
+
+		if err := d.importTemplateFromOVAIfMissing(ctx, spec, m); err != nil {
+			return err
+		}
+	}
+
 	for _, w := range spec.Cluster.Spec.WorkerNodeGroupConfigurations {
 		if err := d.setWorkerDefaultTemplateIfMissing(ctx, spec, w); err != nil {
 			return err
@@ -66,6 +97,71 @@ func (d *Defaulter) SetDefaultsForDatacenterConfig(ctx context.Context, datacent
 	return nil
 }
 
+// createMissingResources creates the folders and resource pools referenced by the cluster that don't exist in vCenter.
+// It's a no-op unless the datacenter config opts in through createMissingResources.
+func (d *Defaulter) createMissingResources(ctx context.Context, spec *Spec) error {
+	if !spec.VSphereDatacenter.Spec.CreateMissingResources {
+		return nil
+	}
+
+	if config.ReadOnlyCredentialsInUse() {
+		logger.Info("Skipping creation of missing vSphere resources, read-only credentials in use")
+		return nil
+	}
+
+	datacenter := spec.VSphereDatacenter.Spec.Datacenter
+	seen := map[string]struct{}{}
+	for _, r := range spec.resourcePaths() {
+		paths := r.ResourcePaths()
+
+		if folder := paths["folder"]; folder != "" {
+			if _, ok := seen[folder]; !ok {
+				seen[folder] = struct{}{}
+				if err := d.createFolderIfMissing(ctx, datacenter, folder); err != nil {
+					return err
+				}
+			}
+		}
+
+		if pool := paths["resourcePool"]; pool != "" {
+			if _, ok := seen[pool]; !ok {
+				seen[pool] = struct{}{}
+				if err := d.createResourcePoolIfMissing(ctx, datacenter, pool); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+func (d *Defaulter) createFolderIfMissing(ctx context.Context, datacenter, folder string) error {
+	exists, err := d.govc.FolderExists(ctx, datacenter, folder)
+	if err != nil {
+		return err
+	}
+	if exists {
+		return nil
+	}
+
+	logger.Info("Creating missing vSphere folder", "folder", folder)
+	return d.govc.CreateFolder(ctx, datacenter, folder)
+}
+
+func (d *Defaulter) createResourcePoolIfMissing(ctx context.Context, datacenter, resourcePool string) error {
+	exists, err := d.govc.ResourcePoolExists(ctx, datacenter, resourcePool)
+	if err != nil {
+		return err
+	}
+	if exists {
+		return nil
+	}
+
+	logger.Info("Creating missing vSphere resource pool", "resourcePool", resourcePool)
+	return d.govc.CreateResourcePool(ctx, datacenter, resourcePool)
+}
+
 func setDefaultsForEtcdMachineConfig(machineConfig *anywherev1.VSphereMachineConfig) {
 	if machineConfig != nil && machineConfig.Spec.MemoryMiB < 8192 {
 		logger.Info("Warning: VSphereMachineConfig MemoryMiB for etcd machines should not be less than 8192. Defaulting to 8192")
@@ -130,6 +226,61 @@ func (d *Defaulter) setupDefaultTemplate(ctx context.Context, spec *Spec, machin
 	return nil
 }
 
+// importTemplateFromOVAIfMissing creates the machine config template from the OVA in its spec when the template
+// doesn't exist. If the template is not set, it's named after the OVA file and its checksum.
+func (d *Defaulter) importTemplateFromOVAIfMissing(ctx context.Context, spec *Spec, machineConfig *anywherev1.VSphereMachineConfig) error {
+	ova := machineConfig.Spec.OVA
+	if ova == nil {
+		return nil
+	}
+
+	if d.ovaDownloadDir == "" {
+		return fmt.Errorf("can't import ova for VSphereMachineConfig %s: ova download folder is not configured", machineConfig.Name)
+	}
+
+	if machineConfig.Spec.Template == "" {
+		u, err := url.Parse(ova.URL)
+		if err != nil {
+			return fmt.Errorf("parsing ova url for VSphereMachineConfig %s: %v", machineConfig.Name, err)
+		}
+		templateName := fmt.Sprintf("%s-%s", strings.TrimSuffix(path.Base(u.Path), ".ova"), ova.SHA256[:7])
+		machineConfig.Spec.Template = filepath.Join("/", spec.VSphereDatacenter.Spec.Datacenter, defaultTemplatesFolder, templateName)
+	}
+
+	tags := requiredTemplateTagsByCategory(machineConfig, machineConfigVersionsBundle(spec, machineConfig))
+	templateFactory := templates.NewFactory(d.govc, spec.VSphereDatacenter.Spec.Datacenter, machineConfig.Spec.Datastore, spec.VSphereDatacenter.Spec.Network, machineConfig.Spec.ResourcePool, defaultTemplateLibrary)
+	downloader := templates.NewOVADownloader(d.ovaDownloadDir)
+
+	return templateFactory.CreateFromOVAIfMissing(ctx, spec.VSphereDatacenter.Spec.Datacenter, machineConfig, downloader, tags)
+}
+
+// deployTemplateFromLibraryIfMissing deploys the content library item referenced by the machine config template
+// as a VM template in the default templates folder, and makes the machine config use it.
+func (d *Defaulter) deployTemplateFromLibraryIfMissing(ctx context.Context, spec *Spec, machineConfig *anywherev1.VSphereMachineConfig) error {
+	library, item, ok := machineConfig.TemplateLibraryItem()
+	if !ok {
+		return nil
+	}
+
+	machineConfig.Spec.Template = filepath.Join("/", spec.VSphereDatacenter.Spec.Datacenter, defaultTemplatesFolder, item)
+
+	tags := requiredTemplateTagsByCategory(machineConfig, machineConfigVersionsBundle(spec, machineConfig))
+	templateFactory := templates.NewFactory(d.govc, spec.VSphereDatacenter.Spec.Datacenter, machineConfig.Spec.Datastore, spec.VSphereDatacenter.Spec.Network, machineConfig.Spec.ResourcePool, defaultTemplateLibrary)
+
+	return templateFactory.CreateFromLibraryItemIfMissing(ctx, spec.VSphereDatacenter.Spec.Datacenter, machineConfig, library, item, tags)
+}
+
+// machineConfigVersionsBundle returns the VersionsBundle for the Kubernetes version of the nodes using machineConfig.
+func machineConfigVersionsBundle(spec *Spec, machineConfig *anywherev1.VSphereMachineConfig) *cluster.VersionsBundle {
+	for _, w := range spec.Cluster.Spec.WorkerNodeGroupConfigurations {
+		if w.MachineGroupRef != nil && w.MachineGroupRef.Name == machineConfig.Name {
+			return spec.WorkerNodeGroupVersionsBundle(w)
+		}
+	}
+
+	return spec.RootVersionsBundle()
+}
+
 func max(a, b int) int {
 	if a > b {
 		return a
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"strings"
 
 	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
 	"github.com/aws/eks-anywhere/pkg/config"
@@ -50,5 +51,24 @@ func SetupEnvVars(datacenterConfig *anywherev1.VSphereDatacenterConfig) error {
 	if err := os.Setenv(govcDatacenterKey, datacenterConfig.Spec.Datacenter); err != nil {
 		return fmt.Errorf("unable to set %s: %v", govcDatacenterKey, err)
 	}
+	return setupProviderProxyEnvVars(datacenterConfig.Spec.ProviderProxyConfiguration)
+}
+
+func setupProviderProxyEnvVars(proxy *anywherev1.ProxyConfiguration) error {
+	envs := map[string]string{
+		config.EksavSphereHttpsProxyKey: "",
+		config.EksavSphereHttpProxyKey:  "",
+		config.EksavSphereNoProxyKey:    "",
+	}
+	if proxy != nil {
+		envs[config.EksavSphereHttpsProxyKey] = proxy.HttpsProxy
+		envs[config.EksavSphereHttpProxyKey] = proxy.HttpProxy
+		envs[config.EksavSphereNoProxyKey] = strings.Join(proxy.NoProxy, ",")
+	}
+	for key, value := range envs {
+		if err := os.Setenv(key, value); err != nil {
+			return fmt.Errorf("unable to set %s: %v", key, err)
+		}
+	}
 	return nil
 }
@@ -1,9 +1,13 @@
 package vsphere_test
 
 import (
+	"os"
 	"testing"
 
+	. "github.com/onsi/gomega"
+
 	"github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/config"
 	"github.com/aws/eks-anywhere/pkg/providers/vsphere"
 )
 
@@ -19,3 +23,31 @@ func TestSetupEnvVarsErrorDatacenter(t *testing.T) {
 		t.Fatal("SetupEnvVars() err = nil, want err not nil")
 	}
 }
+
+func TestSetupEnvVarsProviderProxy(t *testing.T) {
+	t.Setenv(config.EksavSphereUsernameKey, "user")
+	t.Setenv(config.EksavSpherePasswordKey, "pass")
+	t.Setenv(config.EksavSphereHttpsProxyKey, "")
+	t.Setenv(config.EksavSphereHttpProxyKey, "")
+	t.Setenv(config.EksavSphereNoProxyKey, "")
+	datacenterConfig := &v1alpha1.VSphereDatacenterConfig{
+		Spec: v1alpha1.VSphereDatacenterConfigSpec{
+			Server:     "vcenter.example.com",
+			Datacenter: "SDDC-Datacenter",
+			ProviderProxyConfiguration: &v1alpha1.ProxyConfiguration{
+				HttpProxy:  "http://10.0.0.1:3128",
+				HttpsProxy: "http://10.0.0.1:3129",
+				NoProxy:    []string{"10.0.0.0/8", ".example.com"},
+			},
+		},
+	}
+	g := NewWithT(t)
+	g.Expect(vsphere.SetupEnvVars(datacenterConfig)).To(Succeed())
+	g.Expect(os.Getenv(config.EksavSphereHttpProxyKey)).To(Equal("http://10.0.0.1:3128"))
+	g.Expect(os.Getenv(config.EksavSphereHttpsProxyKey)).To(Equal("http://10.0.0.1:3129"))
+	g.Expect(os.Getenv(config.EksavSphereNoProxyKey)).To(Equal("10.0.0.0/8,.example.com"))
+
+	datacenterConfig.Spec.ProviderProxyConfiguration = nil
+	g.Expect(vsphere.SetupEnvVars(datacenterConfig)).To(Succeed())
+	g.Expect(os.Getenv(config.EksavSphereHttpsProxyKey)).To(BeEmpty())
+}
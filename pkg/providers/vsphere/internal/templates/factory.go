@@ -32,6 +32,7 @@ type GovcClient interface {
 	DeployTemplateFromLibrary(ctx context.Context, templateDir, templateName, library, datacenter, datastore, network, resourcePool string, resizeBRDisk bool) error
 	SearchTemplate(ctx context.Context, datacenter, template string) (string, error)
 	ImportTemplate(ctx context.Context, library, ovaURL, name string) error
+	ImportTemplateFromFile(ctx context.Context, library, ovaPath, name string) error
 	LibraryElementExists(ctx context.Context, library string) (bool, error)
 	GetLibraryElementContentVersion(ctx context.Context, element string) (string, error)
 	DeleteLibraryElement(ctx context.Context, element string) error
@@ -62,7 +63,48 @@ func NewFactory(client GovcClient, datacenter, datastore, network, resourcePool,
 	}
 }
 
+// importOVAFunc imports the OVA of a template into the template library with the name templateName.
+type importOVAFunc func(ctx context.Context, templateName string) error
+
+// createTemplateFunc creates the template at templatePath.
+type createTemplateFunc func(ctx context.Context, templatePath, osFamily string) error
+
 func (f *Factory) CreateIfMissing(ctx context.Context, datacenter string, machineConfig *v1alpha1.VSphereMachineConfig, ovaURL string, tagsByCategory map[string][]string) error {
+	return f.createIfMissing(ctx, datacenter, machineConfig, tagsByCategory, f.createTemplateFromOVA(func(ctx context.Context, templateName string) error {
+		logger.V(2).Info("Importing template from ova url", "ova", ovaURL)
+		return f.client.ImportTemplate(ctx, f.templateLibrary, ovaURL, templateName)
+	}))
+}
+
+// CreateFromOVAIfMissing creates the machine config template from the OVA set in its spec if the template doesn't exist.
+// The OVA is only downloaded, and verified against its checksum, when it's not already in the template library.
+func (f *Factory) CreateFromOVAIfMissing(ctx context.Context, datacenter string, machineConfig *v1alpha1.VSphereMachineConfig, downloader *OVADownloader, tagsByCategory map[string][]string) error {
+	ova := machineConfig.Spec.OVA
+	if ova == nil {
+		return fmt.Errorf("VSphereMachineConfig %s doesn't have an ova", machineConfig.Name)
+	}
+
+	return f.createIfMissing(ctx, datacenter, machineConfig, tagsByCategory, f.createTemplateFromOVA(func(ctx context.Context, templateName string) error {
+		ovaPath, err := downloader.Download(ctx, ova.URL, ova.SHA256)
+		if err != nil {
+			return err
+		}
+
+		logger.V(2).Info("Importing template from ova file", "ova", ovaPath)
+		return f.client.ImportTemplateFromFile(ctx, f.templateLibrary, ovaPath, templateName)
+	}))
+}
+
+// CreateFromLibraryItemIfMissing creates the machine config template by deploying item from the content library
+// if the template doesn't exist. The library item is expected to exist.
+func (f *Factory) CreateFromLibraryItemIfMissing(ctx context.Context, datacenter string, machineConfig *v1alpha1.VSphereMachineConfig, library, item string, tagsByCategory map[string][]string) error {
+	return f.createIfMissing(ctx, datacenter, machineConfig, tagsByCategory, func(ctx context.Context, templatePath, osFamily string) error {
+		logger.Info("Deploying template from content library. This might take a while.", "library", library, "item", item)
+		return f.deployTemplate(ctx, filepath.Dir(templatePath), osFamily, library, item)
+	})
+}
+
+func (f *Factory) createIfMissing(ctx context.Context, datacenter string, machineConfig *v1alpha1.VSphereMachineConfig, tagsByCategory map[string][]string, createTemplate createTemplateFunc) error {
 	templateFullPath, err := f.client.SearchTemplate(ctx, datacenter, machineConfig.Spec.Template)
 	if err != nil {
 		return fmt.Errorf("checking for template: %v", err)
@@ -76,7 +118,7 @@ func (f *Factory) CreateIfMissing(ctx context.Context, datacenter string, machin
 	logger.V(2).Info("Template not available. Creating", "template", machineConfig.Spec.Template)
 
 	osFamily := machineConfig.Spec.OSFamily
-	if err = f.createTemplate(ctx, machineConfig.Spec.Template, ovaURL, string(osFamily)); err != nil {
+	if err = createTemplate(ctx, machineConfig.Spec.Template, string(osFamily)); err != nil {
 		return err
 	}
 
@@ -86,24 +128,33 @@ func (f *Factory) CreateIfMissing(ctx context.Context, datacenter string, machin
 	return nil
 }
 
-func (f *Factory) createTemplate(ctx context.Context, templatePath, ovaURL, osFamily string) error {
-	if err := f.createLibraryIfMissing(ctx); err != nil {
-		return err
-	}
+// createTemplateFromOVA returns a createTemplateFunc that imports the OVA into the template library,
+// if it's not there yet, and deploys the template from it.
+func (f *Factory) createTemplateFromOVA(importOVA importOVAFunc) createTemplateFunc {
+	return func(ctx context.Context, templatePath, osFamily string) error {
+		if err := f.createLibraryIfMissing(ctx); err != nil {
+			return err
+		}
 
-	logger.Info("Creating template. This might take a while.") // TODO: add rough estimate timing?
-	templateName := filepath.Base(templatePath)
-	templateDir := filepath.Dir(templatePath)
+		logger.Info("Creating template. This might take a while.") // TODO: add rough estimate timing?
+		templateName := filepath.Base(templatePath)
 
-	if err := f.importOVAIfMissing(ctx, templateName, ovaURL); err != nil {
-		return err
+		if err := f.importOVAIfMissing(ctx, templateName, importOVA); err != nil {
+			return err
+		}
+
+		return f.deployTemplate(ctx, filepath.Dir(templatePath), osFamily, f.templateLibrary, templateName)
 	}
+}
 
+// deployTemplate deploys the library item as a template with the same name in templateDir.
+func (f *Factory) deployTemplate(ctx context.Context, templateDir, osFamily, library, item string) error {
 	var resizeBRDisk bool
 	if strings.EqualFold(osFamily, string(v1alpha1.Bottlerocket)) {
 		resizeBRDisk = true
 	}
-	if err := f.client.DeployTemplateFromLibrary(ctx, templateDir, templateName, f.templateLibrary, f.datacenter, f.datastore, f.network, f.resourcePool, resizeBRDisk); err != nil {
+
+	if err := f.client.DeployTemplateFromLibrary(ctx, templateDir, item, library, f.datacenter, f.datastore, f.network, f.resourcePool, resizeBRDisk); err != nil {
 		return fmt.Errorf("failed deploying template: %v", err)
 	}
 
@@ -126,7 +177,7 @@ func (f *Factory) createLibraryIfMissing(ctx context.Context) error {
 	return nil
 }
 
-func (f *Factory) importOVAIfMissing(ctx context.Context, templateName, ovaURL string) error {
+func (f *Factory) importOVAIfMissing(ctx context.Context, templateName string, importOVA importOVAFunc) error {
 	contentVersion, err := f.client.GetLibraryElementContentVersion(ctx, filepath.Join(f.templateLibrary, templateName))
 	if err != nil {
 		return fmt.Errorf("failed to validate template in library for new template: %v", err)
@@ -141,8 +192,7 @@ func (f *Factory) importOVAIfMissing(ctx context.Context, templateName, ovaURL s
 	}
 
 	if contentVersion == libraryContentDoesNotExist {
-		logger.V(2).Info("Importing template from ova url", "ova", ovaURL)
-		if err = f.client.ImportTemplate(ctx, f.templateLibrary, ovaURL, templateName); err != nil {
+		if err = importOVA(ctx, templateName); err != nil {
 			return fmt.Errorf("failed importing template into library: %v", err)
 		}
 	}
@@ -3,6 +3,9 @@ package templates_test
 import (
 	"context"
 	"errors"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
 	"testing"
 
 	"github.com/golang/mock/gomock"
@@ -267,3 +270,122 @@ func TestFactoryCreateIfMissingSuccessTemplateInLibraryCorrupted(t *testing.T) {
 
 	ct.assertSuccessFromCreateIfMissing()
 }
+
+func newOVAServer(t *testing.T, content []byte) (url, checksum string) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write(content)
+	}))
+	t.Cleanup(server.Close)
+	return server.URL + "/ubuntu.ova", sha256Hex(content)
+}
+
+func TestFactoryCreateFromOVAIfMissingSuccess(t *testing.T) {
+	ct := newCreateTest(t)
+	url, checksum := newOVAServer(t, []byte("ova content"))
+	ct.machineConfig.Spec.OVA = &v1alpha1.VSphereTemplateOVA{URL: url, SHA256: checksum}
+	dir := t.TempDir()
+	downloader := templates.NewOVADownloader(dir)
+
+	ct.govc.EXPECT().SearchTemplate(ct.ctx, ct.datacenter, ct.machineConfig.Spec.Template).Return("", nil) // template not present
+	ct.govc.EXPECT().LibraryElementExists(ct.ctx, ct.templateLibrary).Return(true, nil)
+	ct.govc.EXPECT().GetLibraryElementContentVersion(ct.ctx, ct.templateInLibrary).Return(ct.libraryContentDoesNotExist, nil)
+	ct.govc.EXPECT().ImportTemplateFromFile(ct.ctx, ct.templateLibrary, filepath.Join(dir, checksum+".ova"), ct.templateName).Return(nil)
+	ct.govc.EXPECT().DeployTemplateFromLibrary(
+		ct.ctx, ct.templateDir, ct.templateName, ct.templateLibrary, ct.datacenter, ct.datastore, ct.network, ct.resourcePool, ct.resizeDisk2,
+	).Return(nil)
+
+	// expects for tagging
+	ct.govc.EXPECT().ListCategories(ct.ctx).Return(nil, nil)
+	ct.govc.EXPECT().ListTags(ct.ctx).Return(nil, nil)
+
+	if err := ct.factory.CreateFromOVAIfMissing(ct.ctx, ct.datacenter, ct.machineConfig, downloader, ct.tagsByCategory); err != nil {
+		t.Fatalf("factory.CreateFromOVAIfMissing() err = %v, want err = nil", err)
+	}
+}
+
+func TestFactoryCreateFromOVAIfMissingTemplateInLibraryExists(t *testing.T) {
+	ct := newCreateTest(t)
+	ct.machineConfig.Spec.OVA = &v1alpha1.VSphereTemplateOVA{URL: "https://example.com/ubuntu.ova", SHA256: "unused"}
+	downloader := templates.NewOVADownloader(t.TempDir())
+
+	ct.govc.EXPECT().SearchTemplate(ct.ctx, ct.datacenter, ct.machineConfig.Spec.Template).Return("", nil) // template not present
+	ct.govc.EXPECT().LibraryElementExists(ct.ctx, ct.templateLibrary).Return(true, nil)
+	ct.govc.EXPECT().GetLibraryElementContentVersion(ct.ctx, ct.templateInLibrary).Return(ct.libraryContentValid, nil)
+	ct.govc.EXPECT().DeployTemplateFromLibrary(
+		ct.ctx, ct.templateDir, ct.templateName, ct.templateLibrary, ct.datacenter, ct.datastore, ct.network, ct.resourcePool, ct.resizeDisk2,
+	).Return(nil)
+
+	// expects for tagging
+	ct.govc.EXPECT().ListCategories(ct.ctx).Return(nil, nil)
+	ct.govc.EXPECT().ListTags(ct.ctx).Return(nil, nil)
+
+	if err := ct.factory.CreateFromOVAIfMissing(ct.ctx, ct.datacenter, ct.machineConfig, downloader, ct.tagsByCategory); err != nil {
+		t.Fatalf("factory.CreateFromOVAIfMissing() err = %v, want err = nil", err)
+	}
+}
+
+func TestFactoryCreateFromOVAIfMissingErrorChecksum(t *testing.T) {
+	ct := newCreateTest(t)
+	url, _ := newOVAServer(t, []byte("ova content"))
+	ct.machineConfig.Spec.OVA = &v1alpha1.VSphereTemplateOVA{URL: url, SHA256: "0000000000000000000000000000000000000000000000000000000000000000"}
+	downloader := templates.NewOVADownloader(t.TempDir())
+
+	ct.govc.EXPECT().SearchTemplate(ct.ctx, ct.datacenter, ct.machineConfig.Spec.Template).Return("", nil) // template not present
+	ct.govc.EXPECT().LibraryElementExists(ct.ctx, ct.templateLibrary).Return(true, nil)
+	ct.govc.EXPECT().GetLibraryElementContentVersion(ct.ctx, ct.templateInLibrary).Return(ct.libraryContentDoesNotExist, nil)
+
+	if err := ct.factory.CreateFromOVAIfMissing(ct.ctx, ct.datacenter, ct.machineConfig, downloader, ct.tagsByCategory); err == nil {
+		t.Fatal("factory.CreateFromOVAIfMissing() err = nil, want err not nil")
+	}
+}
+
+func TestFactoryCreateFromOVAIfMissingErrorNoOVA(t *testing.T) {
+	ct := newCreateTest(t)
+	downloader := templates.NewOVADownloader(t.TempDir())
+
+	if err := ct.factory.CreateFromOVAIfMissing(ct.ctx, ct.datacenter, ct.machineConfig, downloader, ct.tagsByCategory); err == nil {
+		t.Fatal("factory.CreateFromOVAIfMissing() err = nil, want err not nil")
+	}
+}
+
+func TestFactoryCreateFromLibraryItemIfMissingSuccess(t *testing.T) {
+	ct := newCreateTest(t)
+	library := "custom-library"
+
+	ct.govc.EXPECT().SearchTemplate(ct.ctx, ct.datacenter, ct.machineConfig.Spec.Template).Return("", nil) // template not present
+	ct.govc.EXPECT().DeployTemplateFromLibrary(
+		ct.ctx, ct.templateDir, ct.templateName, library, ct.datacenter, ct.datastore, ct.network, ct.resourcePool, ct.resizeDisk2,
+	).Return(nil)
+
+	// expects for tagging
+	ct.govc.EXPECT().ListCategories(ct.ctx).Return(nil, nil)
+	ct.govc.EXPECT().ListTags(ct.ctx).Return(nil, nil)
+
+	if err := ct.factory.CreateFromLibraryItemIfMissing(ct.ctx, ct.datacenter, ct.machineConfig, library, ct.templateName, ct.tagsByCategory); err != nil {
+		t.Fatalf("factory.CreateFromLibraryItemIfMissing() err = %v, want err = nil", err)
+	}
+}
+
+func TestFactoryCreateFromLibraryItemIfMissingTemplateExists(t *testing.T) {
+	ct := newCreateTest(t)
+
+	ct.govc.EXPECT().SearchTemplate(ct.ctx, ct.datacenter, ct.machineConfig.Spec.Template).Return(ct.machineConfig.Spec.Template, nil)
+
+	if err := ct.factory.CreateFromLibraryItemIfMissing(ct.ctx, ct.datacenter, ct.machineConfig, "custom-library", ct.templateName, ct.tagsByCategory); err != nil {
+		t.Fatalf("factory.CreateFromLibraryItemIfMissing() err = %v, want err = nil", err)
+	}
+}
+
+func TestFactoryCreateFromLibraryItemIfMissingErrorDeploy(t *testing.T) {
+	ct := newCreateTest(t)
+	library := "custom-library"
+
+	ct.govc.EXPECT().SearchTemplate(ct.ctx, ct.datacenter, ct.machineConfig.Spec.Template).Return("", nil) // template not present
+	ct.govc.EXPECT().DeployTemplateFromLibrary(
+		ct.ctx, ct.templateDir, ct.templateName, library, ct.datacenter, ct.datastore, ct.network, ct.resourcePool, ct.resizeDisk2,
+	).Return(errors.New("error deploying"))
+
+	if err := ct.factory.CreateFromLibraryItemIfMissing(ct.ctx, ct.datacenter, ct.machineConfig, library, ct.templateName, ct.tagsByCategory); err == nil {
+		t.Fatal("factory.CreateFromLibraryItemIfMissing() err = nil, want err not nil")
+	}
+}
@@ -205,6 +205,20 @@ func (mr *MockGovcClientMockRecorder) GroupExists(ctx, name interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GroupExists", reflect.TypeOf((*MockGovcClient)(nil).GroupExists), ctx, name)
 }
 
+// ImportTemplateFromFile mocks base method.
+func (m *MockGovcClient) ImportTemplateFromFile(ctx context.Context, library, ovaPath, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportTemplateFromFile", ctx, library, ovaPath, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ImportTemplateFromFile indicates an expected call of ImportTemplateFromFile.
+func (mr *MockGovcClientMockRecorder) ImportTemplateFromFile(ctx, library, ovaPath, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportTemplateFromFile", reflect.TypeOf((*MockGovcClient)(nil).ImportTemplateFromFile), ctx, library, ovaPath, name)
+}
+
 // ImportTemplate mocks base method.
 func (m *MockGovcClient) ImportTemplate(ctx context.Context, library, ovaURL, name string) error {
 	m.ctrl.T.Helper()
@@ -0,0 +1,182 @@
+package templates
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/aws/eks-anywhere/pkg/logger"
+)
+
+const (
+	ovaExtension             = ".ova"
+	partialDownloadExtension = ".part"
+	progressReportStep       = 10
+)
+
+// OVADownloader downloads OVAs to a local folder and verifies them against their checksum.
+// Interrupted downloads are resumed from where they were left when the server supports range requests.
+type OVADownloader struct {
+	dir    string
+	client *http.Client
+}
+
+// OVADownloaderOpt allows to customize an OVADownloader.
+type OVADownloaderOpt func(*OVADownloader)
+
+// WithHTTPClient sets the http client used to download OVAs.
+func WithHTTPClient(client *http.Client) OVADownloaderOpt {
+	return func(d *OVADownloader) {
+		d.client = client
+	}
+}
+
+// NewOVADownloader builds an OVADownloader that stores OVAs in dir.
+func NewOVADownloader(dir string, opts ...OVADownloaderOpt) *OVADownloader {
+	d := &OVADownloader{
+		dir:    dir,
+		client: &http.Client{},
+	}
+
+	for _, opt := range opts {
+		opt(d)
+	}
+
+	return d
+}
+
+// Download downloads the OVA at url and returns its local path once its content matches checksum.
+// An OVA already downloaded and verified is not downloaded again.
+func (d *OVADownloader) Download(ctx context.Context, url, checksum string) (string, error) {
+	checksum = strings.ToLower(checksum)
+	ovaPath := filepath.Join(d.dir, checksum+ovaExtension)
+	if _, err := os.Stat(ovaPath); err == nil {
+		logger.V(2).Info("OVA already downloaded, skipping download", "ova", ovaPath)
+		return ovaPath, nil
+	}
+
+	if err := os.MkdirAll(d.dir, os.ModePerm); err != nil {
+		return "", fmt.Errorf("creating ova download folder: %v", err)
+	}
+
+	partPath := ovaPath + partialDownloadExtension
+	if err := d.download(ctx, url, partPath); err != nil {
+		return "", err
+	}
+
+	if err := verifyChecksum(partPath, checksum); err != nil {
+		if removeErr := os.Remove(partPath); removeErr != nil {
+			logger.V(2).Info("Failed removing corrupted ova", "ova", partPath, "error", removeErr)
+		}
+		return "", fmt.Errorf("verifying ova %s: %v", url, err)
+	}
+
+	if err := os.Rename(partPath, ovaPath); err != nil {
+		return "", fmt.Errorf("moving downloaded ova: %v", err)
+	}
+
+	return ovaPath, nil
+}
+
+func (d *OVADownloader) download(ctx context.Context, url, path string) error {
+	var offset int64
+	if info, err := os.Stat(path); err == nil {
+		offset = info.Size()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return fmt.Errorf("building request for ova %s: %v", url, err)
+	}
+	if offset > 0 {
+		req.Header.Set("Range", fmt.Sprintf("bytes=%d-", offset))
+	}
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("downloading ova %s: %v", url, err)
+	}
+	defer resp.Body.Close()
+
+	flags := os.O_CREATE | os.O_WRONLY
+	switch {
+	case offset > 0 && resp.StatusCode == http.StatusPartialContent:
+		logger.V(2).Info("Resuming ova download", "ova", url, "offset", offset)
+		flags |= os.O_APPEND
+	case offset > 0 && resp.StatusCode == http.StatusRequestedRangeNotSatisfiable:
+		// The partial file is already complete, the checksum verification will tell if it's valid.
+		return nil
+	case resp.StatusCode == http.StatusOK:
+		offset = 0
+		flags |= os.O_TRUNC
+	default:
+		return fmt.Errorf("downloading ova %s: unexpected status code %d", url, resp.StatusCode)
+	}
+
+	f, err := os.OpenFile(path, flags, 0o644)
+	if err != nil {
+		return fmt.Errorf("opening ova file: %v", err)
+	}
+	defer f.Close()
+
+	total := int64(-1)
+	if resp.ContentLength >= 0 {
+		total = offset + resp.ContentLength
+	}
+
+	logger.Info("Downloading OVA. This might take a while.", "ova", url)
+	progress := &progressWriter{url: url, written: offset, total: total}
+	if _, err := io.Copy(f, io.TeeReader(resp.Body, progress)); err != nil {
+		return fmt.Errorf("downloading ova %s: %v", url, err)
+	}
+
+	return nil
+}
+
+func verifyChecksum(path, checksum string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return err
+	}
+
+	if got := hex.EncodeToString(h.Sum(nil)); got != checksum {
+		return fmt.Errorf("sha256 checksum %s doesn't match expected %s", got, checksum)
+	}
+
+	return nil
+}
+
+// progressWriter logs the download progress every progressReportStep percent.
+type progressWriter struct {
+	url          string
+	written      int64
+	total        int64
+	lastReported int64
+}
+
+func (p *progressWriter) Write(b []byte) (int, error) {
+	p.written += int64(len(b))
+	if p.total <= 0 {
+		return len(b), nil
+	}
+
+	percent := p.written * 100 / p.total
+	if percent-p.lastReported >= progressReportStep || (percent == 100 && p.lastReported != 100) {
+		p.lastReported = percent
+		logger.V(2).Info("Downloading OVA", "ova", p.url, "progress", fmt.Sprintf("%d%%", percent))
+	}
+
+	return len(b), nil
+}
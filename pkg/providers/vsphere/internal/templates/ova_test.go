@@ -0,0 +1,111 @@
+package templates_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/aws/eks-anywhere/pkg/providers/vsphere/internal/templates"
+)
+
+func TestOVADownloaderDownload(t *testing.T) {
+	g := NewWithT(t)
+	content := bytes.Repeat([]byte("ova"), 1000)
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		http.ServeContent(w, r, "ubuntu.ova", time.Time{}, bytes.NewReader(content))
+	}))
+	defer server.Close()
+	checksum := sha256Hex(content)
+	dir := t.TempDir()
+	d := templates.NewOVADownloader(dir)
+
+	path, err := d.Download(context.Background(), server.URL, checksum)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(path).To(Equal(filepath.Join(dir, checksum+".ova")))
+	g.Expect(os.ReadFile(path)).To(Equal(content))
+
+	_, err = d.Download(context.Background(), server.URL, checksum)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(requests).To(Equal(1), "verified ovas shouldn't be downloaded again")
+}
+
+func TestOVADownloaderDownloadResume(t *testing.T) {
+	g := NewWithT(t)
+	content := bytes.Repeat([]byte("ova"), 1000)
+	var rangeHeader string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		rangeHeader = r.Header.Get("Range")
+		http.ServeContent(w, r, "ubuntu.ova", time.Time{}, bytes.NewReader(content))
+	}))
+	defer server.Close()
+	checksum := sha256Hex(content)
+	dir := t.TempDir()
+	g.Expect(os.WriteFile(filepath.Join(dir, checksum+".ova.part"), content[:1200], 0o644)).To(Succeed())
+	d := templates.NewOVADownloader(dir)
+
+	path, err := d.Download(context.Background(), server.URL, checksum)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(rangeHeader).To(Equal("bytes=1200-"))
+	g.Expect(os.ReadFile(path)).To(Equal(content))
+}
+
+func TestOVADownloaderDownloadRestartWithoutRangeSupport(t *testing.T) {
+	g := NewWithT(t)
+	content := bytes.Repeat([]byte("ova"), 1000)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write(content)
+	}))
+	defer server.Close()
+	checksum := sha256Hex(content)
+	dir := t.TempDir()
+	g.Expect(os.WriteFile(filepath.Join(dir, checksum+".ova.part"), []byte("stale"), 0o644)).To(Succeed())
+	d := templates.NewOVADownloader(dir)
+
+	path, err := d.Download(context.Background(), server.URL, checksum)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(os.ReadFile(path)).To(Equal(content))
+}
+
+func TestOVADownloaderDownloadChecksumMismatch(t *testing.T) {
+	g := NewWithT(t)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("tampered"))
+	}))
+	defer server.Close()
+	checksum := sha256Hex([]byte("original"))
+	dir := t.TempDir()
+	d := templates.NewOVADownloader(dir)
+
+	_, err := d.Download(context.Background(), server.URL, checksum)
+	g.Expect(err).To(MatchError(ContainSubstring("doesn't match expected " + checksum)))
+	g.Expect(filepath.Join(dir, checksum+".ova.part")).NotTo(BeAnExistingFile())
+	g.Expect(filepath.Join(dir, checksum+".ova")).NotTo(BeAnExistingFile())
+}
+
+func TestOVADownloaderDownloadErrorStatus(t *testing.T) {
+	g := NewWithT(t)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+	d := templates.NewOVADownloader(t.TempDir())
+
+	_, err := d.Download(context.Background(), server.URL, sha256Hex([]byte("ova")))
+	g.Expect(err).To(MatchError(ContainSubstring("unexpected status code 404")))
+}
+
+func sha256Hex(content []byte) string {
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:])
+}
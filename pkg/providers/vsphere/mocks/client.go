@@ -98,6 +98,20 @@ func (mr *MockProviderGovcClientMockRecorder) CreateCategoryForVM(arg0, arg1 int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCategoryForVM", reflect.TypeOf((*MockProviderGovcClient)(nil).CreateCategoryForVM), arg0, arg1)
 }
 
+// CreateFolder mocks base method.
+func (m *MockProviderGovcClient) CreateFolder(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateFolder", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateFolder indicates an expected call of CreateFolder.
+func (mr *MockProviderGovcClientMockRecorder) CreateFolder(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFolder", reflect.TypeOf((*MockProviderGovcClient)(nil).CreateFolder), arg0, arg1, arg2)
+}
+
 // CreateGroup mocks base method.
 func (m *MockProviderGovcClient) CreateGroup(arg0 context.Context, arg1 string) error {
 	m.ctrl.T.Helper()
@@ -126,6 +140,20 @@ func (mr *MockProviderGovcClientMockRecorder) CreateLibrary(arg0, arg1, arg2 int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateLibrary", reflect.TypeOf((*MockProviderGovcClient)(nil).CreateLibrary), arg0, arg1, arg2)
 }
 
+// CreateResourcePool mocks base method.
+func (m *MockProviderGovcClient) CreateResourcePool(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateResourcePool", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateResourcePool indicates an expected call of CreateResourcePool.
+func (mr *MockProviderGovcClientMockRecorder) CreateResourcePool(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateResourcePool", reflect.TypeOf((*MockProviderGovcClient)(nil).CreateResourcePool), arg0, arg1, arg2)
+}
+
 // CreateRole mocks base method.
 func (m *MockProviderGovcClient) CreateRole(arg0 context.Context, arg1 string, arg2 []string) error {
 	m.ctrl.T.Helper()
@@ -211,6 +239,21 @@ func (mr *MockProviderGovcClientMockRecorder) DeployTemplateFromLibrary(arg0, ar
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployTemplateFromLibrary", reflect.TypeOf((*MockProviderGovcClient)(nil).DeployTemplateFromLibrary), arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7, arg8)
 }
 
+// FolderExists mocks base method.
+func (m *MockProviderGovcClient) FolderExists(arg0 context.Context, arg1, arg2 string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FolderExists", arg0, arg1, arg2)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FolderExists indicates an expected call of FolderExists.
+func (mr *MockProviderGovcClientMockRecorder) FolderExists(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FolderExists", reflect.TypeOf((*MockProviderGovcClient)(nil).FolderExists), arg0, arg1, arg2)
+}
+
 // GetCertThumbprint mocks base method.
 func (m *MockProviderGovcClient) GetCertThumbprint(arg0 context.Context) (string, error) {
 	m.ctrl.T.Helper()
@@ -301,6 +344,21 @@ func (mr *MockProviderGovcClientMockRecorder) GetLibraryElementContentVersion(ar
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLibraryElementContentVersion", reflect.TypeOf((*MockProviderGovcClient)(nil).GetLibraryElementContentVersion), arg0, arg1)
 }
 
+// GetLibraryItem mocks base method.
+func (m *MockProviderGovcClient) GetLibraryItem(arg0 context.Context, arg1, arg2 string) (*executables.LibraryItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLibraryItem", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*executables.LibraryItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLibraryItem indicates an expected call of GetLibraryItem.
+func (mr *MockProviderGovcClientMockRecorder) GetLibraryItem(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLibraryItem", reflect.TypeOf((*MockProviderGovcClient)(nil).GetLibraryItem), arg0, arg1, arg2)
+}
+
 // GetResourcePoolInfo mocks base method.
 func (m *MockProviderGovcClient) GetResourcePoolInfo(arg0 context.Context, arg1, arg2 string, arg3 ...string) (map[string]int, error) {
 	m.ctrl.T.Helper()
@@ -396,6 +454,20 @@ func (mr *MockProviderGovcClientMockRecorder) GroupExists(arg0, arg1 interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GroupExists", reflect.TypeOf((*MockProviderGovcClient)(nil).GroupExists), arg0, arg1)
 }
 
+// ImportTemplateFromFile mocks base method.
+func (m *MockProviderGovcClient) ImportTemplateFromFile(arg0 context.Context, arg1, arg2, arg3 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportTemplateFromFile", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ImportTemplateFromFile indicates an expected call of ImportTemplateFromFile.
+func (mr *MockProviderGovcClientMockRecorder) ImportTemplateFromFile(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportTemplateFromFile", reflect.TypeOf((*MockProviderGovcClient)(nil).ImportTemplateFromFile), arg0, arg1, arg2, arg3)
+}
+
 // ImportTemplate mocks base method.
 func (m *MockProviderGovcClient) ImportTemplate(arg0 context.Context, arg1, arg2, arg3 string) error {
 	m.ctrl.T.Helper()
@@ -484,6 +556,21 @@ func (mr *MockProviderGovcClientMockRecorder) NetworkExists(arg0, arg1 interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NetworkExists", reflect.TypeOf((*MockProviderGovcClient)(nil).NetworkExists), arg0, arg1)
 }
 
+// ResourcePoolExists mocks base method.
+func (m *MockProviderGovcClient) ResourcePoolExists(arg0 context.Context, arg1, arg2 string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResourcePoolExists", arg0, arg1, arg2)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResourcePoolExists indicates an expected call of ResourcePoolExists.
+func (mr *MockProviderGovcClientMockRecorder) ResourcePoolExists(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResourcePoolExists", reflect.TypeOf((*MockProviderGovcClient)(nil).ResourcePoolExists), arg0, arg1, arg2)
+}
+
 // RoleExists mocks base method.
 func (m *MockProviderGovcClient) RoleExists(arg0 context.Context, arg1 string) (bool, error) {
 	m.ctrl.T.Helper()
@@ -528,6 +615,21 @@ func (mr *MockProviderGovcClientMockRecorder) SetGroupRoleOnObject(arg0, arg1, a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetGroupRoleOnObject", reflect.TypeOf((*MockProviderGovcClient)(nil).SetGroupRoleOnObject), arg0, arg1, arg2, arg3, arg4)
 }
 
+// StoragePolicyExists mocks base method.
+func (m *MockProviderGovcClient) StoragePolicyExists(arg0 context.Context, arg1 string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StoragePolicyExists", arg0, arg1)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StoragePolicyExists indicates an expected call of StoragePolicyExists.
+func (mr *MockProviderGovcClientMockRecorder) StoragePolicyExists(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StoragePolicyExists", reflect.TypeOf((*MockProviderGovcClient)(nil).StoragePolicyExists), arg0, arg1)
+}
+
 // TemplateHasSnapshot mocks base method.
 func (m *MockProviderGovcClient) TemplateHasSnapshot(arg0 context.Context, arg1 string) (bool, error) {
 	m.ctrl.T.Helper()
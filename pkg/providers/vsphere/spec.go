@@ -37,6 +37,30 @@ func (s *Spec) machineConfigs() []*anywherev1.VSphereMachineConfig {
 	return machineConfigs
 }
 
+// resourcePaths returns the machine configs and failure domains that reference vSphere resources.
+func (s *Spec) resourcePaths() []ResourcePaths {
+	resourcePaths := []ResourcePaths{}
+	if cp := s.controlPlaneMachineConfig(); cp != nil {
+		resourcePaths = append(resourcePaths, cp)
+	}
+
+	for _, workerNodeGroupConfiguration := range s.Cluster.Spec.WorkerNodeGroupConfigurations {
+		if w := s.workerMachineConfig(workerNodeGroupConfiguration); w != nil {
+			resourcePaths = append(resourcePaths, w)
+		}
+	}
+
+	if etcd := s.etcdMachineConfig(); etcd != nil {
+		resourcePaths = append(resourcePaths, etcd)
+	}
+
+	for i := range s.VSphereDatacenter.Spec.FailureDomains {
+		resourcePaths = append(resourcePaths, &s.VSphereDatacenter.Spec.FailureDomains[i])
+	}
+
+	return resourcePaths
+}
+
 // MachineConfigCount represents a machineConfig with it's associated count.
 type MachineConfigCount struct {
 	*anywherev1.VSphereMachineConfig
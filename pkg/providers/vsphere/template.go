@@ -2,6 +2,7 @@ package vsphere
 
 import (
 	"fmt"
+	"slices"
 	"strings"
 
 	corev1 "k8s.io/api/core/v1"
@@ -115,11 +116,6 @@ func (vs *VsphereTemplateBuilder) GenerateCAPISpecWorkers(
 
 		values["cgroupDriverSystemd"] = cgroupDriverSystemd
 
-		if len(workerNodeGroupConfiguration.FailureDomains) > 0 {
-			workerNodeGroupFailureDomain := workerNodeGroupConfiguration.FailureDomains[0]
-			values["failureDomain"] = FailureDomainTemplateName(clusterSpec, workerNodeGroupFailureDomain)
-		}
-
 		if workerNodeGroupConfiguration.UpgradeRolloutStrategy != nil {
 			values["upgradeRolloutStrategy"] = true
 			if workerNodeGroupConfiguration.UpgradeRolloutStrategy.Type == anywherev1.InPlaceStrategyType {
@@ -177,12 +173,14 @@ func buildTemplateMapCP(
 		Append(sharedExtraArgs)
 	clusterapi.SetPodIAMAuthExtraArgs(clusterSpec.Cluster.Spec.PodIAMConfig, apiServerExtraArgs)
 	controllerManagerExtraArgs := clusterapi.SecureTlsCipherSuitesExtraArgs().
-		Append(clusterapi.NodeCIDRMaskExtraArgs(&clusterSpec.Cluster.Spec.ClusterNetwork))
+		Append(clusterapi.NodeCIDRMaskExtraArgs(&clusterSpec.Cluster.Spec.ClusterNetwork)).
+		Append(clusterapi.ControllerManagerExtraArgs(clusterSpec.Cluster.Spec.ControlPlaneConfiguration.ControllerManagerExtraArgs))
+	schedulerExtraArgs := clusterapi.SecureTlsCipherSuitesExtraArgs().
+		Append(clusterapi.SchedulerExtraArgs(clusterSpec.Cluster.Spec.ControlPlaneConfiguration.SchedulerExtraArgs))
 
 	vuc := config.NewVsphereUserConfig()
 
-	firstControlPlaneMachinesUser := controlPlaneMachineSpec.Users[0]
-	controlPlaneSSHKey, err := common.StripSshAuthorizedKeyComment(firstControlPlaneMachinesUser.SshAuthorizedKeys[0])
+	controlPlaneUsers, err := common.BootstrapUsersWithoutKeyComments(controlPlaneMachineSpec.Users)
 	if err != nil {
 		return nil, fmt.Errorf("formatting ssh key for vsphere control plane template: %v", err)
 	}
@@ -190,8 +188,9 @@ func buildTemplateMapCP(
 	values := map[string]interface{}{
 		"clusterName":                          clusterSpec.Cluster.Name,
 		"controlPlaneEndpointIp":               clusterSpec.Cluster.Spec.ControlPlaneConfiguration.Endpoint.Host,
+		"controlPlaneEndpointVIPCIDR":          common.KubeVipCIDR(clusterSpec.Cluster.Spec.ControlPlaneConfiguration.Endpoint.Host),
 		"controlPlaneReplicas":                 clusterSpec.Cluster.Spec.ControlPlaneConfiguration.Count,
-		"apiServerCertSANs":                    clusterSpec.Cluster.Spec.ControlPlaneConfiguration.CertSANs,
+		"apiServerCertSANs":                    common.APIServerCertSANs(clusterSpec.Cluster),
 		"kubernetesRepository":                 versionsBundle.KubeDistro.Kubernetes.Repository,
 		"kubernetesVersion":                    versionsBundle.KubeDistro.Kubernetes.Tag,
 		"etcdRepository":                       versionsBundle.KubeDistro.Etcd.Repository,
@@ -216,15 +215,17 @@ func buildTemplateMapCP(
 		"controlPlaneDiskGiB":                  controlPlaneMachineSpec.DiskGiB,
 		"controlPlaneTagIDs":                   controlPlaneMachineSpec.TagIDs,
 		"etcdTagIDs":                           etcdMachineSpec.TagIDs,
-		"controlPlaneSshUsername":              firstControlPlaneMachinesUser.Name,
-		"vsphereControlPlaneSshAuthorizedKey":  controlPlaneSSHKey,
+		"controlPlaneGuestInfo":                controlPlaneMachineSpec.GuestInfo,
+		"etcdGuestInfo":                        etcdMachineSpec.GuestInfo,
+		"controlPlaneUsers":                    controlPlaneUsers,
 		"podCidrs":                             clusterSpec.Cluster.Spec.ClusterNetwork.Pods.CidrBlocks,
 		"serviceCidrs":                         clusterSpec.Cluster.Spec.ClusterNetwork.Services.CidrBlocks,
+		"skipKubeProxy":                        clusterSpec.Cluster.Spec.ClusterNetwork.CNIConfig.KubeProxyReplacementEnabled(),
 		"etcdExtraArgs":                        etcdExtraArgs,
 		"etcdCipherSuites":                     crypto.SecureCipherSuitesString(),
 		"apiserverExtraArgs":                   apiServerExtraArgs,
 		"controllerManagerExtraArgs":           controllerManagerExtraArgs,
-		"schedulerExtraArgs":                   sharedExtraArgs,
+		"schedulerExtraArgs":                   schedulerExtraArgs,
 		"format":                               format,
 		"externalEtcdVersion":                  versionsBundle.KubeDistro.EtcdVersion,
 		"etcdImage":                            versionsBundle.KubeDistro.EtcdImage.VersionedImage(),
@@ -247,6 +248,7 @@ func buildTemplateMapCP(
 		}
 		values["auditPolicy"] = auditPolicy
 	}
+	values["auditLog"] = common.GetAuditLogSettings(clusterSpec.Cluster)
 
 	if clusterSpec.Cluster.Spec.ControlPlaneConfiguration.SkipAdmissionForSystemResources != nil &&
 		*clusterSpec.Cluster.Spec.ControlPlaneConfiguration.SkipAdmissionForSystemResources {
@@ -257,6 +259,33 @@ func buildTemplateMapCP(
 		values["admissionExclusionPolicy"] = admissionExclusionPolicy
 	}
 
+	podSecurityAdmissionConfig, err := common.GetPodSecurityAdmissionConfig(clusterSpec.Cluster)
+	if err != nil {
+		return nil, err
+	}
+	if podSecurityAdmissionConfig != "" {
+		values["podSecurityAdmissionConfig"] = podSecurityAdmissionConfig
+	}
+
+	if clusterSpec.Cluster.Spec.ControlPlaneConfiguration.Konnectivity != nil {
+		konnectivityServerManifest, err := common.KonnectivityServerManifest(clusterSpec.Cluster, versionsBundle.Konnectivity, controlPlaneMachineSpec.OSFamily)
+		if err != nil {
+			return nil, err
+		}
+		konnectivityAgentManifest, err := common.KonnectivityAgentManifest(clusterSpec.Cluster, versionsBundle.Konnectivity, controlPlaneMachineSpec.OSFamily)
+		if err != nil {
+			return nil, err
+		}
+		values["konnectivityEgressSelectorConfig"] = common.KonnectivityEgressSelectorConfig(clusterSpec.Cluster)
+		values["konnectivityServerManifest"] = konnectivityServerManifest
+		values["konnectivityAgentManifest"] = konnectivityAgentManifest
+	}
+
+	if datacenterSpec.TopologyCategories != nil {
+		values["topologyRegionCategory"] = datacenterSpec.TopologyCategories.Region
+		values["topologyZoneCategory"] = datacenterSpec.TopologyCategories.Zone
+	}
+
 	if clusterSpec.Cluster.Spec.RegistryMirrorConfiguration != nil {
 		registryMirror := registrymirror.FromCluster(clusterSpec.Cluster)
 		values["registryMirrorMap"] = containerd.ToAPIEndpoints(registryMirror.NamespacedRegistryMap)
@@ -308,8 +337,7 @@ func buildTemplateMapCP(
 	}
 
 	if clusterSpec.Cluster.Spec.ExternalEtcdConfiguration != nil {
-		firstEtcdMachinesUser := etcdMachineSpec.Users[0]
-		etcdSSHKey, err := common.StripSshAuthorizedKeyComment(firstEtcdMachinesUser.SshAuthorizedKeys[0])
+		etcdUsers, err := common.BootstrapUsersWithoutKeyComments(etcdMachineSpec.Users)
 		if err != nil {
 			return nil, fmt.Errorf("formatting ssh key for vsphere etcd template: %v", err)
 		}
@@ -324,8 +352,7 @@ func buildTemplateMapCP(
 		values["etcdVMsNumCPUs"] = etcdMachineSpec.NumCPUs
 		values["etcdVsphereResourcePool"] = etcdMachineSpec.ResourcePool
 		values["etcdVsphereStoragePolicyName"] = etcdMachineSpec.StoragePolicyName
-		values["etcdSshUsername"] = firstEtcdMachinesUser.Name
-		values["vsphereEtcdSshAuthorizedKey"] = etcdSSHKey
+		values["etcdUsers"] = etcdUsers
 
 		if etcdMachineSpec.HostOSConfiguration != nil {
 			if etcdMachineSpec.HostOSConfiguration.NTPConfiguration != nil {
@@ -400,10 +427,17 @@ func buildTemplateMapCP(
 			return nil, err
 		}
 		values["encryptionProviderConfig"] = conf
+		values["kmsV1"] = common.KMSV1Enabled(clusterSpec.Cluster.Spec.EtcdEncryption)
+		values["kmsPluginSocketDirs"] = common.KMSPluginSocketDirs(clusterSpec.Cluster.Spec.EtcdEncryption)
 	}
 
-	if bottlerocketKubernetesSettings != nil || controlPlaneMachineSpec.HostOSConfiguration != nil {
-		brSettings, err := common.GetCAPIBottlerocketSettingsConfig(controlPlaneMachineSpec.HostOSConfiguration, bottlerocketKubernetesSettings)
+	brHostOSConfiguration := controlPlaneMachineSpec.HostOSConfiguration
+	if controlPlaneMachineSpec.OSFamily == anywherev1.Bottlerocket {
+		brHostOSConfiguration = common.BottlerocketHostOSConfiguration(brHostOSConfiguration, clusterSpec.Cluster.Spec.NodeOSConfiguration)
+	}
+
+	if bottlerocketKubernetesSettings != nil || brHostOSConfiguration != nil {
+		brSettings, err := common.GetCAPIBottlerocketSettingsConfig(brHostOSConfiguration, bottlerocketKubernetesSettings)
 		if err != nil {
 			return nil, err
 		}
@@ -412,6 +446,23 @@ func buildTemplateMapCP(
 		}
 	}
 
+	brContainers, err := common.GetCAPIBottlerocketContainersConfig(controlPlaneMachineSpec.HostOSConfiguration)
+	if err != nil {
+		return nil, err
+	}
+	if brContainers != "" {
+		values["bottlerocketContainers"] = brContainers
+	}
+
+	if hostOS := controlPlaneMachineSpec.HostOSConfiguration; hostOS != nil && hostOS.ContainerdConfiguration != nil && controlPlaneMachineSpec.OSFamily != anywherev1.Bottlerocket {
+		values["containerdConfigDropIns"] = hostOS.ContainerdConfiguration.ConfigDropIns
+	}
+
+	if nodeOS := clusterSpec.Cluster.Spec.NodeOSConfiguration; nodeOS != nil && controlPlaneMachineSpec.OSFamily != anywherev1.Bottlerocket {
+		values["nodeSysctls"] = common.GetNodeOSSysctlsConfig(nodeOS)
+		values["nodeKernelModules"] = nodeOS.KernelModules
+	}
+
 	if clusterSpec.Cluster.Spec.ControlPlaneConfiguration.KubeletConfiguration != nil && controlPlaneMachineSpec.OSFamily != anywherev1.Bottlerocket {
 		cpKubeletConfig := clusterSpec.Cluster.Spec.ControlPlaneConfiguration.KubeletConfiguration.Object
 
@@ -440,6 +491,13 @@ func buildTemplateMapCP(
 		values["nodeLabelArgs"] = nodeLabelArgs
 	}
 
+	if nodeIPArgs := clusterapi.NodeIPExtraArgs(&clusterSpec.Cluster.Spec.ClusterNetwork); len(nodeIPArgs) != 0 {
+		values["nodeIPArgs"] = nodeIPArgs
+	}
+
+	// Nodes of clusters with IPv6 pods and services need an IPv6 address too.
+	values["dhcp6"] = clusterSpec.Cluster.Spec.ClusterNetwork.IPFamily() != anywherev1.IPv4IPFamily
+
 	if clusterSpec.Cluster.Spec.ControlPlaneConfiguration.UpgradeRolloutStrategy != nil {
 		values["upgradeRolloutStrategy"] = true
 		if clusterSpec.Cluster.Spec.ControlPlaneConfiguration.UpgradeRolloutStrategy.Type == anywherev1.InPlaceStrategyType {
@@ -449,6 +507,18 @@ func buildTemplateMapCP(
 		}
 	}
 
+	if len(clusterSpec.Cluster.Spec.ControlPlaneConfiguration.FailureDomains) > 0 {
+		values["controlPlaneFailureDomains"] = true
+	}
+
+	machineNamingTemplate, err := clusterapi.ControlPlaneMachineNamingTemplate(clusterSpec.Cluster)
+	if err != nil {
+		return nil, err
+	}
+	if machineNamingTemplate != "" {
+		values["machineNamingTemplate"] = machineNamingTemplate
+	}
+
 	return values, nil
 }
 
@@ -464,8 +534,7 @@ func buildTemplateMapMD(
 	}
 	format := "cloud-config"
 
-	firstUser := workerNodeGroupMachineSpec.Users[0]
-	sshKey, err := common.StripSshAuthorizedKeyComment(firstUser.SshAuthorizedKeys[0])
+	workerUsers, err := common.BootstrapUsersWithoutKeyComments(workerNodeGroupMachineSpec.Users)
 	if err != nil {
 		return nil, fmt.Errorf("formatting ssh key for vsphere workers template: %v", err)
 	}
@@ -479,6 +548,7 @@ func buildTemplateMapMD(
 		"workerVsphereFolder":            workerNodeGroupMachineSpec.Folder,
 		"vsphereNetwork":                 datacenterSpec.Network,
 		"vsphereMultiNetworks":           workerNodeGroupMachineSpec.Networks,
+		"vsphereNetworkDevices":          workerNodeGroupMachineSpec.NetworkDevices,
 		"workerVsphereResourcePool":      workerNodeGroupMachineSpec.ResourcePool,
 		"vsphereServer":                  datacenterSpec.Server,
 		"workerVsphereStoragePolicyName": workerNodeGroupMachineSpec.StoragePolicyName,
@@ -487,12 +557,10 @@ func buildTemplateMapMD(
 		"workloadVMsNumCPUs":             workerNodeGroupMachineSpec.NumCPUs,
 		"workloadDiskGiB":                workerNodeGroupMachineSpec.DiskGiB,
 		"workerTagIDs":                   workerNodeGroupMachineSpec.TagIDs,
-		"workerSshUsername":              firstUser.Name,
-		"vsphereWorkerSshAuthorizedKey":  sshKey,
+		"workerGuestInfo":                workerNodeGroupMachineSpec.GuestInfo,
+		"workerUsers":                    workerUsers,
 		"format":                         format,
 		"eksaSystemNamespace":            constants.EksaSystemNamespace,
-		"workerReplicas":                 *workerNodeGroupConfiguration.Count,
-		"workerNodeGroupName":            fmt.Sprintf("%s-%s", clusterSpec.Cluster.Name, workerNodeGroupConfiguration.Name),
 		"workerNodeGroupTaints":          workerNodeGroupConfiguration.Taints,
 		"autoscalingConfig":              workerNodeGroupConfiguration.AutoScalingConfiguration,
 		"workerCloneMode":                workerNodeGroupMachineSpec.CloneMode,
@@ -548,6 +616,14 @@ func buildTemplateMapMD(
 		values["noProxy"] = noProxyList
 	}
 
+	konnectivityAgentManifest, err := common.KonnectivityAgentManifest(clusterSpec.Cluster, bundle.Konnectivity, workerNodeGroupMachineSpec.OSFamily)
+	if err != nil {
+		return nil, err
+	}
+	if konnectivityAgentManifest != "" {
+		values["konnectivityAgentManifest"] = konnectivityAgentManifest
+	}
+
 	var bottlerocketKubernetesSettings *bootstrapv1beta2.BottlerocketKubernetesSettings
 	if workerNodeGroupMachineSpec.OSFamily == anywherev1.Bottlerocket {
 		values["format"] = string(anywherev1.Bottlerocket)
@@ -581,14 +657,38 @@ func buildTemplateMapMD(
 		}
 	}
 
-	if bottlerocketKubernetesSettings != nil || workerNodeGroupMachineSpec.HostOSConfiguration != nil {
-		brSettings, err := common.GetCAPIBottlerocketSettingsConfig(workerNodeGroupMachineSpec.HostOSConfiguration, bottlerocketKubernetesSettings)
+	brHostOSConfiguration := workerNodeGroupMachineSpec.HostOSConfiguration
+	if workerNodeGroupMachineSpec.OSFamily == anywherev1.Bottlerocket {
+		brHostOSConfiguration = common.BottlerocketHostOSConfiguration(brHostOSConfiguration, clusterSpec.Cluster.Spec.NodeOSConfiguration)
+	}
+
+	if bottlerocketKubernetesSettings != nil || brHostOSConfiguration != nil {
+		brSettings, err := common.GetCAPIBottlerocketSettingsConfig(brHostOSConfiguration, bottlerocketKubernetesSettings)
 		if err != nil {
 			return nil, err
 		}
 		values["bottlerocketSettings"] = brSettings
 	}
 
+	brContainers, err := common.GetCAPIBottlerocketContainersConfig(workerNodeGroupMachineSpec.HostOSConfiguration)
+	if err != nil {
+		return nil, err
+	}
+	if brContainers != "" {
+		values["bottlerocketContainers"] = brContainers
+	}
+
+	if workerNodeGroupMachineSpec.OSFamily != anywherev1.Bottlerocket {
+		if dropIns := common.WorkerContainerdConfigDropIns(workerNodeGroupMachineSpec.HostOSConfiguration, workerNodeGroupConfiguration); len(dropIns) > 0 {
+			values["containerdConfigDropIns"] = dropIns
+		}
+	}
+
+	if nodeOS := clusterSpec.Cluster.Spec.NodeOSConfiguration; nodeOS != nil && workerNodeGroupMachineSpec.OSFamily != anywherev1.Bottlerocket {
+		values["nodeSysctls"] = common.GetNodeOSSysctlsConfig(nodeOS)
+		values["nodeKernelModules"] = nodeOS.KernelModules
+	}
+
 	if workerNodeGroupConfiguration.KubeletConfiguration != nil && workerNodeGroupMachineSpec.OSFamily != anywherev1.Bottlerocket {
 		wnKubeletConfig := workerNodeGroupConfiguration.KubeletConfiguration.Object
 
@@ -613,14 +713,79 @@ func buildTemplateMapMD(
 		values["kubeletExtraArgs"] = kubeletExtraArgs
 	}
 
-	nodeLabelArgs := clusterapi.WorkerNodeLabelsExtraArgs(workerNodeGroupConfiguration)
+	// The vSphere cloud provider labels the nodes itself when topology categories are configured.
+	// Otherwise, since all the MachineDeployments of a worker node group share the same bootstrap
+	// config, nodes can only be labeled with their zone when the group has a single failure domain.
+	var zone string
+	if len(workerNodeGroupConfiguration.FailureDomains) == 1 && clusterSpec.VSphereDatacenter.Spec.TopologyCategories == nil {
+		zone = workerNodeGroupConfiguration.FailureDomains[0]
+	}
+	nodeLabelArgs := clusterapi.WorkerNodeTopologyLabelsExtraArgs(workerNodeGroupConfiguration, zone)
 	if len(nodeLabelArgs) != 0 {
 		values["nodeLabelArgs"] = nodeLabelArgs
 	}
 
+	if nodeIPArgs := clusterapi.NodeIPExtraArgs(&clusterSpec.Cluster.Spec.ClusterNetwork); len(nodeIPArgs) != 0 {
+		values["nodeIPArgs"] = nodeIPArgs
+	}
+
+	// Nodes of clusters with IPv6 pods and services need an IPv6 address too.
+	values["dhcp6"] = clusterSpec.Cluster.Spec.ClusterNetwork.IPFamily() != anywherev1.IPv4IPFamily
+
+	machineDeployments, err := workerMachineDeployments(clusterSpec, workerNodeGroupConfiguration)
+	if err != nil {
+		return nil, err
+	}
+	values["machineDeployments"] = machineDeployments
+
 	return values, nil
 }
 
+// workerMachineDeployment holds the values that differ between the MachineDeployments
+// generated for a worker node group.
+type workerMachineDeployment struct {
+	Name                  string
+	FailureDomain         string
+	Replicas              int
+	MachineNamingTemplate string
+}
+
+// workerMachineDeployments returns the MachineDeployments for a worker node group, one per failure domain,
+// each one placed in the CAPV deployment zone of its failure domain. The node count is spread evenly
+// between them, the first failure domains taking the remainder.
+func workerMachineDeployments(clusterSpec *cluster.Spec, workerNodeGroupConfiguration anywherev1.WorkerNodeGroupConfiguration) ([]workerMachineDeployment, error) {
+	names := clusterapi.MachineDeploymentNames(clusterSpec.Cluster, workerNodeGroupConfiguration)
+	count := *workerNodeGroupConfiguration.Count
+	failureDomains := workerNodeGroupConfiguration.FailureDomains
+	if len(failureDomains) == 0 {
+		machineNamingTemplate, err := clusterapi.WorkerMachineNamingTemplate(workerNodeGroupConfiguration, "")
+		if err != nil {
+			return nil, err
+		}
+		return []workerMachineDeployment{{Name: names[0], Replicas: count, MachineNamingTemplate: machineNamingTemplate}}, nil
+	}
+
+	mds := make([]workerMachineDeployment, 0, len(failureDomains))
+	for i, fd := range failureDomains {
+		machineNamingTemplate, err := clusterapi.WorkerMachineNamingTemplate(workerNodeGroupConfiguration, fd)
+		if err != nil {
+			return nil, err
+		}
+		replicas := count / len(failureDomains)
+		if i < count%len(failureDomains) {
+			replicas++
+		}
+		mds = append(mds, workerMachineDeployment{
+			Name:                  names[min(i, len(names)-1)],
+			FailureDomain:         FailureDomainTemplateName(clusterSpec, fd),
+			Replicas:              replicas,
+			MachineNamingTemplate: machineNamingTemplate,
+		})
+	}
+
+	return mds, nil
+}
+
 func buildTemplateMapFailureDomain(
 	clusterSpec *cluster.Spec,
 	failureDomain anywherev1.FailureDomain,
@@ -641,10 +806,33 @@ func buildTemplateMapFailureDomain(
 		"regionName":                  regionName,
 		"zoneType":                    zoneType,
 		"zoneName":                    zoneName,
+		"regionTagCategory":           defaultIfEmpty(failureDomain.RegionTagCategory, defaultRegionTagCategory),
+		"zoneTagCategory":             defaultIfEmpty(failureDomain.ZoneTagCategory, defaultZoneTagCategory),
 	}
+
+	if topology := clusterSpec.VSphereDatacenter.Spec.TopologyCategories; topology != nil {
+		values["regionTagCategory"] = defaultIfEmpty(failureDomain.RegionTagCategory, topology.Region)
+		values["zoneTagCategory"] = defaultIfEmpty(failureDomain.ZoneTagCategory, topology.Zone)
+	}
+
+	// Once the control plane is spread across failure domains, CAPV considers every deployment zone
+	// without an explicit controlPlane value as suitable for control plane machines.
+	cpFailureDomains := clusterSpec.Cluster.Spec.ControlPlaneConfiguration.FailureDomains
+	if len(cpFailureDomains) > 0 {
+		values["controlPlaneFailureDomains"] = true
+		values["controlPlane"] = slices.Contains(cpFailureDomains, failureDomain.Name)
+	}
+
 	return values
 }
 
+func defaultIfEmpty(value, defaultValue string) string {
+	if value == "" {
+		return defaultValue
+	}
+	return value
+}
+
 // Currently, we only support compute cluster topology in failure domain
 // In future, when we add supports for other topologies, update this get region type and name based on topology type.
 // For example, if topology type is host group, region will be one level above host group i.e ComputeCluster.
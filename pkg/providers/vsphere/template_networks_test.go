@@ -144,3 +144,14 @@ func validateNetworkDevicesInYAML(t *testing.T, yamlData []byte, expectedNetwork
 			"Expected networkName %s, got %s for device %d", expectedNetwork, networkName, i)
 	}
 }
+
+func TestVsphereTemplateBuilderGenerateCAPISpecWorkersWithNetworkDevices(t *testing.T) {
+	g := NewWithT(t)
+	spec := test.NewFullClusterSpec(t, "testdata/cluster_with_network_devices.yaml")
+
+	builder := vsphere.NewVsphereTemplateBuilder(time.Now)
+	data, err := builder.GenerateCAPISpecWorkers(spec, nil, nil)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	test.AssertContentToFile(t, string(data), "testdata/expected_results_network_devices_md.yaml")
+}
@@ -2,11 +2,13 @@ package vsphere_test
 
 import (
 	"regexp"
+	"strings"
 	"testing"
 	"time"
 
 	. "github.com/onsi/gomega"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	bootstrapv1beta2 "sigs.k8s.io/cluster-api/api/bootstrap/kubeadm/v1beta2"
 
 	"github.com/aws/eks-anywhere/internal/test"
 	"github.com/aws/eks-anywhere/pkg/api/v1alpha1"
@@ -14,6 +16,9 @@ import (
 	"github.com/aws/eks-anywhere/pkg/config"
 	"github.com/aws/eks-anywhere/pkg/providers/common"
 	"github.com/aws/eks-anywhere/pkg/providers/vsphere"
+	"github.com/aws/eks-anywhere/pkg/utils/ptr"
+	unstructuredutil "github.com/aws/eks-anywhere/pkg/utils/unstructured"
+	releasev1 "github.com/aws/eks-anywhere/release/api/v1alpha1"
 )
 
 const (
@@ -196,6 +201,134 @@ func TestVsphereTemplateBuilderGenerateFailureDomainYaml(t *testing.T) {
 	test.AssertContentToFile(t, string(data), "testdata/expected_results_failuredomain.yaml")
 }
 
+func TestVsphereTemplateBuilderGenerateFailureDomainYamlTagCategories(t *testing.T) {
+	g := NewWithT(t)
+	spec := test.NewFullClusterSpec(t, "testdata/cluster_vsphere_failuredomain.yaml")
+	spec.VSphereDatacenter.Spec.FailureDomains[0].RegionTagCategory = "placement-region"
+	spec.VSphereDatacenter.Spec.FailureDomains[0].ZoneTagCategory = "placement-zone"
+	templateNames := map[string]string{
+		"fd-1": "test-test-fd-1",
+	}
+	builder := vsphere.NewVsphereTemplateBuilder(time.Now)
+	data, err := builder.GenerateVsphereFailureDomainsSpec(spec, templateNames)
+	g.Expect(err).ToNot(HaveOccurred())
+	test.AssertContentToFile(t, string(data), "testdata/expected_results_failuredomain_tag_categories.yaml")
+}
+
+func TestVsphereTemplateBuilderGenerateFailureDomainYamlTopologyCategories(t *testing.T) {
+	g := NewWithT(t)
+	spec := test.NewFullClusterSpec(t, "testdata/cluster_vsphere_failuredomain.yaml")
+	spec.VSphereDatacenter.Spec.TopologyCategories = &v1alpha1.VSphereTopologyCategories{
+		Region: "placement-region",
+		Zone:   "placement-zone",
+	}
+	templateNames := map[string]string{
+		"fd-1": "test-test-fd-1",
+	}
+	builder := vsphere.NewVsphereTemplateBuilder(time.Now)
+	data, err := builder.GenerateVsphereFailureDomainsSpec(spec, templateNames)
+	g.Expect(err).ToNot(HaveOccurred())
+	test.AssertContentToFile(t, string(data), "testdata/expected_results_failuredomain_tag_categories.yaml")
+}
+
+func TestVsphereTemplateBuilderGenerateFailureDomainYamlControlPlane(t *testing.T) {
+	g := NewWithT(t)
+	spec := test.NewFullClusterSpec(t, "testdata/cluster_vsphere_failuredomain.yaml")
+	spec.Cluster.Spec.ControlPlaneConfiguration.FailureDomains = []string{"fd-1"}
+	templateNames := map[string]string{
+		"fd-1": "test-test-fd-1",
+		"fd-2": "test-test-fd-2",
+	}
+	builder := vsphere.NewVsphereTemplateBuilder(time.Now)
+	data, err := builder.GenerateVsphereFailureDomainsSpec(spec, templateNames)
+	g.Expect(err).ToNot(HaveOccurred())
+	test.AssertContentToFile(t, string(data), "testdata/expected_results_failuredomain_control_plane.yaml")
+}
+
+func TestVsphereTemplateBuilderGenerateCAPISpecControlPlaneFailureDomains(t *testing.T) {
+	g := NewWithT(t)
+	spec := test.NewFullClusterSpec(t, "testdata/cluster_vsphere_failuredomain.yaml")
+	spec.Cluster.Spec.ControlPlaneConfiguration.FailureDomains = []string{"fd-1", "fd-2"}
+	builder := vsphere.NewVsphereTemplateBuilder(time.Now)
+	data, err := builder.GenerateCAPISpecControlPlane(spec, func(values map[string]interface{}) {
+		values["controlPlaneTemplateName"] = clusterapi.ControlPlaneMachineTemplateName(spec.Cluster)
+	})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(string(data)).To(ContainSubstring(`  failureDomainSelector:
+    matchLabels:
+      infrastructure.cluster.x-k8s.io/cluster-name: test
+`))
+}
+
+func TestVsphereTemplateBuilderGenerateCAPISpecWorkersFailureDomainZoneLabel(t *testing.T) {
+	g := NewWithT(t)
+	spec := test.NewFullClusterSpec(t, "testdata/cluster_vsphere_failuredomain.yaml")
+	spec.Cluster.Spec.WorkerNodeGroupConfigurations[0].FailureDomains = []string{"fd-2"}
+	builder := vsphere.NewVsphereTemplateBuilder(time.Now)
+	data, err := builder.GenerateCAPISpecWorkers(spec, nil, nil)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(string(data)).To(ContainSubstring(`          - name: node-labels
+            value: "topology.kubernetes.io/zone=fd-2"
+`))
+}
+
+func TestVsphereTemplateBuilderGenerateCAPISpecWorkersMultipleFailureDomains(t *testing.T) {
+	g := NewWithT(t)
+	spec := test.NewFullClusterSpec(t, "testdata/cluster_vsphere_failuredomain.yaml")
+	spec.Cluster.Spec.WorkerNodeGroupConfigurations[0].FailureDomains = []string{"fd-1", "fd-2"}
+	builder := vsphere.NewVsphereTemplateBuilder(time.Now)
+	data, err := builder.GenerateCAPISpecWorkers(spec, nil, nil)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(string(data)).To(ContainSubstring(`  name: test-md-0-fd-1
+  namespace: eksa-system
+spec:
+  clusterName: test
+  replicas: 2
+`))
+	g.Expect(string(data)).To(ContainSubstring(`  name: test-md-0-fd-2
+  namespace: eksa-system
+spec:
+  clusterName: test
+  replicas: 1
+`))
+	g.Expect(string(data)).To(ContainSubstring("      failureDomain: test-test-fd-1\n"))
+	g.Expect(string(data)).To(ContainSubstring("      failureDomain: test-test-fd-2\n"))
+	g.Expect(string(data)).NotTo(ContainSubstring("topology.kubernetes.io/zone"))
+}
+
+func TestVsphereTemplateBuilderGenerateCAPISpecWorkersFailureDomainTopologyCategories(t *testing.T) {
+	g := NewWithT(t)
+	spec := test.NewFullClusterSpec(t, "testdata/cluster_vsphere_failuredomain.yaml")
+	spec.Cluster.Spec.WorkerNodeGroupConfigurations[0].FailureDomains = []string{"fd-2"}
+	spec.VSphereDatacenter.Spec.TopologyCategories = &v1alpha1.VSphereTopologyCategories{
+		Region: "k8s-region",
+		Zone:   "k8s-zone",
+	}
+	builder := vsphere.NewVsphereTemplateBuilder(time.Now)
+	data, err := builder.GenerateCAPISpecWorkers(spec, nil, nil)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(string(data)).NotTo(ContainSubstring("topology.kubernetes.io/zone"))
+}
+
+func TestVsphereTemplateBuilderGenerateCAPISpecControlPlaneTopologyCategories(t *testing.T) {
+	g := NewWithT(t)
+	spec := test.NewFullClusterSpec(t, "testdata/cluster_main.yaml")
+	spec.VSphereDatacenter.Spec.TopologyCategories = &v1alpha1.VSphereTopologyCategories{
+		Region: "k8s-region",
+		Zone:   "k8s-zone",
+	}
+	builder := vsphere.NewVsphereTemplateBuilder(time.Now)
+	data, err := builder.GenerateCAPISpecControlPlane(spec, func(values map[string]interface{}) {
+		values["controlPlaneTemplateName"] = clusterapi.ControlPlaneMachineTemplateName(spec.Cluster)
+	})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(string(data)).To(ContainSubstring(`            thumbprint: 'ABCDEFG'
+        labels:
+          region: 'k8s-region'
+          zone: 'k8s-zone'
+    kind: ConfigMap`))
+}
+
 func TestVsphereTemplateBuilderGenerateCAPISpecVCenterTags(t *testing.T) {
 	g := NewWithT(t)
 	spec := test.NewFullClusterSpec(t, "testdata/cluster_main.yaml")
@@ -220,6 +353,27 @@ func TestVsphereTemplateBuilderGenerateCAPISpecVCenterTags(t *testing.T) {
 	test.AssertContentToFile(t, string(wData), "testdata/expected_kct_vcenter_tags.yaml")
 }
 
+func TestVsphereTemplateBuilderGenerateCAPISpecGuestInfo(t *testing.T) {
+	g := NewWithT(t)
+	spec := test.NewFullClusterSpec(t, "testdata/cluster_main.yaml")
+	guestInfo := map[string]string{"agent.token": "abc123", "site": "dc-1"}
+	controlPlaneMachineConfigName := spec.Cluster.Spec.ControlPlaneConfiguration.MachineGroupRef.Name
+	spec.VSphereMachineConfigs[controlPlaneMachineConfigName].Spec.GuestInfo = guestInfo
+	etcdMachineConfigName := spec.Cluster.Spec.ExternalEtcdConfiguration.MachineGroupRef.Name
+	spec.VSphereMachineConfigs[etcdMachineConfigName].Spec.GuestInfo = guestInfo
+	firstMachineConfigName := spec.Cluster.Spec.WorkerNodeGroupConfigurations[0].MachineGroupRef.Name
+	spec.VSphereMachineConfigs[firstMachineConfigName].Spec.GuestInfo = guestInfo
+	builder := vsphere.NewVsphereTemplateBuilder(time.Now)
+	cpData, err := builder.GenerateCAPISpecControlPlane(spec, func(values map[string]interface{}) {
+		values["controlPlaneTemplateName"] = clusterapi.ControlPlaneMachineTemplateName(spec.Cluster)
+	})
+	g.Expect(err).ToNot(HaveOccurred())
+	test.AssertContentToFile(t, string(cpData), "testdata/expected_kcp_guestinfo.yaml")
+	wData, err := builder.GenerateCAPISpecWorkers(spec, nil, nil)
+	g.Expect(err).ToNot(HaveOccurred())
+	test.AssertContentToFile(t, string(wData), "testdata/expected_kct_guestinfo.yaml")
+}
+
 func TestVsphereTemplateBuilderGenerateCAPISpecControlPlaneWithCustomAuditPolicy(t *testing.T) {
 	g := NewWithT(t)
 	spec := test.NewFullClusterSpec(t, "testdata/cluster_main.yaml")
@@ -270,3 +424,349 @@ func TestVsphereTemplateBuilderGenerateCAPISpecControlPlaneWithDefaultAuditPolic
 
 	g.Expect(collapseWhitespace(string(data))).To(ContainSubstring(collapseWhitespace(defaultAuditPolicy)))
 }
+
+func TestVsphereTemplateBuilderGenerateCAPISpecControlPlaneWithPodSecurityAdmission(t *testing.T) {
+	g := NewWithT(t)
+	spec := test.NewFullClusterSpec(t, "testdata/cluster_main.yaml")
+	spec.Cluster.Spec.ControlPlaneConfiguration.PodSecurityAdmission = &v1alpha1.PodSecurityAdmissionConfiguration{
+		Enforce: v1alpha1.PodSecurityLevelRestricted,
+	}
+	spec.VSphereMachineConfigs[spec.Cluster.Spec.ControlPlaneConfiguration.MachineGroupRef.Name].Spec.OSFamily = v1alpha1.Bottlerocket
+
+	builder := vsphere.NewVsphereTemplateBuilder(time.Now)
+	data, err := builder.GenerateCAPISpecControlPlane(spec, func(values map[string]interface{}) {
+		values["controlPlaneTemplateName"] = clusterapi.ControlPlaneMachineTemplateName(spec.Cluster)
+	})
+	g.Expect(err).ToNot(HaveOccurred())
+
+	podSecurityAdmissionConfig, err := common.GetPodSecurityAdmissionConfig(spec.Cluster)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	str := collapseWhitespace(string(data))
+	g.Expect(str).To(ContainSubstring(collapseWhitespace(podSecurityAdmissionConfig)))
+	g.Expect(str).To(ContainSubstring("- name: admission-control-config-file value: /etc/kubernetes/pod-security-admission.yaml"))
+	g.Expect(str).To(ContainSubstring("- hostPath: /var/lib/kubeadm/pod-security-admission.yaml mountPath: /etc/kubernetes/pod-security-admission.yaml name: pod-security-admission"))
+}
+
+func TestVsphereTemplateBuilderGenerateCAPISpecWithBottlerocketContainers(t *testing.T) {
+	g := NewWithT(t)
+	spec := test.NewFullClusterSpec(t, "testdata/cluster_main.yaml")
+	for _, machineConfig := range spec.VSphereMachineConfigs {
+		machineConfig.Spec.OSFamily = v1alpha1.Bottlerocket
+		machineConfig.Spec.HostOSConfiguration = &v1alpha1.HostOSConfiguration{
+			BottlerocketConfiguration: &v1alpha1.BottlerocketConfiguration{
+				BootstrapContainers: []v1alpha1.BottlerocketBootstrapContainer{
+					{Name: "setup", Image: "public.ecr.aws/setup:v1", Mode: v1alpha1.BottlerocketBootstrapContainerModeOnce},
+				},
+				HostContainers: []v1alpha1.BottlerocketHostContainer{
+					{Name: "agent", Image: "public.ecr.aws/agent:v1", Superpowered: true},
+				},
+			},
+		}
+	}
+	containers := `bottlerocketCustomBootstrapContainers:
+      - essential: false
+        imageRepository: public.ecr.aws/setup
+        imageTag: v1
+        mode: once
+        name: setup
+      bottlerocketCustomHostContainers:
+      - imageRepository: public.ecr.aws/agent
+        imageTag: v1
+        name: agent
+        superpowered: true`
+
+	builder := vsphere.NewVsphereTemplateBuilder(time.Now)
+	data, err := builder.GenerateCAPISpecControlPlane(spec, func(values map[string]interface{}) {
+		values["controlPlaneTemplateName"] = clusterapi.ControlPlaneMachineTemplateName(spec.Cluster)
+	})
+	g.Expect(err).ToNot(HaveOccurred())
+	// Both the init and the join configurations of the control plane run the containers.
+	g.Expect(strings.Count(string(data), containers)).To(Equal(2))
+
+	data, err = builder.GenerateCAPISpecWorkers(spec, nil, nil)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(string(data)).To(ContainSubstring(strings.ReplaceAll(containers, "\n      ", "\n        ")))
+}
+
+func TestVsphereTemplateBuilderGenerateCAPISpecWithContainerdConfigDropIns(t *testing.T) {
+	g := NewWithT(t)
+	spec := test.NewFullClusterSpec(t, "testdata/cluster_main.yaml")
+	for _, machineConfig := range spec.VSphereMachineConfigs {
+		machineConfig.Spec.OSFamily = v1alpha1.Ubuntu
+		machineConfig.Spec.HostOSConfiguration = &v1alpha1.HostOSConfiguration{
+			ContainerdConfiguration: &v1alpha1.ContainerdConfiguration{
+				ConfigDropIns: []v1alpha1.ContainerdConfigDropIn{
+					{
+						Name:    "gvisor",
+						Content: "[plugins.\"io.containerd.grpc.v1.cri\".containerd.runtimes.runsc]\n  runtime_type = \"io.containerd.runsc.v1\"\n",
+					},
+				},
+			},
+		}
+	}
+	importsCommand := `grep -q '^imports' /etc/containerd/config.toml || sed -i '1i imports = ["/etc/containerd/conf.d/*.toml"]' /etc/containerd/config.toml`
+
+	builder := vsphere.NewVsphereTemplateBuilder(time.Now)
+	data, err := builder.GenerateCAPISpecControlPlane(spec, func(values map[string]interface{}) {
+		values["controlPlaneTemplateName"] = clusterapi.ControlPlaneMachineTemplateName(spec.Cluster)
+	})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(string(data)).To(ContainSubstring(`    - content: |
+        [plugins."io.containerd.grpc.v1.cri".containerd.runtimes.runsc]
+          runtime_type = "io.containerd.runsc.v1"
+      owner: root:root
+      path: /etc/containerd/conf.d/gvisor.toml`))
+	g.Expect(string(data)).To(ContainSubstring("    - " + importsCommand + "\n    - sudo systemctl daemon-reload\n    - sudo systemctl restart containerd"))
+	_, err = unstructuredutil.YamlToUnstructured(data)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	data, err = builder.GenerateCAPISpecWorkers(spec, nil, nil)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(string(data)).To(ContainSubstring(`      files:
+      - content: |
+          [plugins."io.containerd.grpc.v1.cri".containerd.runtimes.runsc]
+            runtime_type = "io.containerd.runsc.v1"
+        owner: root:root
+        path: /etc/containerd/conf.d/gvisor.toml`))
+	g.Expect(string(data)).To(ContainSubstring("      - " + importsCommand + "\n      - sudo systemctl daemon-reload\n      - sudo systemctl restart containerd"))
+	_, err = unstructuredutil.YamlToUnstructured(data)
+	g.Expect(err).ToNot(HaveOccurred())
+}
+
+func TestVsphereTemplateBuilderGenerateCAPISpecWithNodeOSConfiguration(t *testing.T) {
+	g := NewWithT(t)
+	spec := test.NewFullClusterSpec(t, "testdata/cluster_main.yaml")
+	spec.Cluster.Spec.NodeOSConfiguration = &v1alpha1.NodeOSConfiguration{
+		Sysctls:       map[string]string{"vm.max_map_count": "262144", "net.core.somaxconn": "65535"},
+		KernelModules: []string{"br_netfilter", "overlay"},
+	}
+	for _, machineConfig := range spec.VSphereMachineConfigs {
+		machineConfig.Spec.OSFamily = v1alpha1.Ubuntu
+	}
+
+	builder := vsphere.NewVsphereTemplateBuilder(time.Now)
+	data, err := builder.GenerateCAPISpecControlPlane(spec, func(values map[string]interface{}) {
+		values["controlPlaneTemplateName"] = clusterapi.ControlPlaneMachineTemplateName(spec.Cluster)
+	})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(string(data)).To(ContainSubstring(`    - content: |
+        net.core.somaxconn = 65535
+        vm.max_map_count = 262144
+      owner: root:root
+      path: /etc/sysctl.d/99-eksa-node-os.conf
+    - content: |
+        br_netfilter
+        overlay
+      owner: root:root
+      path: /etc/modules-load.d/eksa-node-os.conf`))
+	g.Expect(string(data)).To(ContainSubstring("    preKubeadmCommands:\n    - systemctl restart systemd-modules-load.service\n    - sysctl --system\n"))
+
+	data, err = builder.GenerateCAPISpecWorkers(spec, nil, nil)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(string(data)).To(ContainSubstring(`      files:
+      - content: |
+          net.core.somaxconn = 65535
+          vm.max_map_count = 262144
+        owner: root:root
+        path: /etc/sysctl.d/99-eksa-node-os.conf
+      - content: |
+          br_netfilter
+          overlay
+        owner: root:root
+        path: /etc/modules-load.d/eksa-node-os.conf`))
+	g.Expect(string(data)).To(ContainSubstring("      preKubeadmCommands:\n      - systemctl restart systemd-modules-load.service\n      - sysctl --system\n"))
+	_, err = unstructuredutil.YamlToUnstructured(data)
+	g.Expect(err).ToNot(HaveOccurred())
+}
+
+func TestVsphereTemplateBuilderGenerateCAPISpecWithMachineNaming(t *testing.T) {
+	g := NewWithT(t)
+	spec := test.NewFullClusterSpec(t, "testdata/cluster_main.yaml")
+	spec.Cluster.Spec.ControlPlaneConfiguration.MachineNaming = &v1alpha1.MachineNamingConfiguration{
+		Template: "corp-{{ .cluster.name }}-{{ .nodeGroup.name }}-{{ .random }}",
+	}
+	spec.Cluster.Spec.WorkerNodeGroupConfigurations[0].MachineNaming = &v1alpha1.MachineNamingConfiguration{
+		Template: "corp-{{ .nodeGroup.name }}-{{ .random }}",
+	}
+
+	builder := vsphere.NewVsphereTemplateBuilder(time.Now)
+	data, err := builder.GenerateCAPISpecControlPlane(spec, func(values map[string]interface{}) {
+		values["controlPlaneTemplateName"] = clusterapi.ControlPlaneMachineTemplateName(spec.Cluster)
+	})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(string(data)).To(ContainSubstring("spec:\n  machineNaming:\n    template: \"corp-{{ .cluster.name }}-control-plane-{{ .random }}\"\n"))
+
+	data, err = builder.GenerateCAPISpecWorkers(spec, nil, nil)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(string(data)).To(ContainSubstring("  machineNaming:\n    template: \"corp-md-0-{{ .random }}\"\n"))
+	_, err = unstructuredutil.YamlToUnstructured(data)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	spec.Cluster.Spec.WorkerNodeGroupConfigurations[0].MachineNaming.Template = "corp-{{ .zone }}-{{ .random }}"
+	_, err = builder.GenerateCAPISpecWorkers(spec, nil, nil)
+	g.Expect(err).To(MatchError(ContainSubstring(`worker node group md-0: rendering machine naming template`)))
+}
+
+func TestVsphereTemplateBuilderGenerateCAPISpecWithNodeOSConfigurationBottlerocket(t *testing.T) {
+	g := NewWithT(t)
+	spec := test.NewFullClusterSpec(t, "testdata/cluster_main.yaml")
+	spec.Cluster.Spec.NodeOSConfiguration = &v1alpha1.NodeOSConfiguration{
+		Sysctls: map[string]string{"vm.max_map_count": "262144", "net.core.somaxconn": "65535"},
+	}
+	for _, machineConfig := range spec.VSphereMachineConfigs {
+		machineConfig.Spec.OSFamily = v1alpha1.Bottlerocket
+		machineConfig.Spec.HostOSConfiguration = &v1alpha1.HostOSConfiguration{
+			BottlerocketConfiguration: &v1alpha1.BottlerocketConfiguration{
+				Kernel: &bootstrapv1beta2.BottlerocketKernelSettings{
+					SysctlSettings: map[string]string{"vm.max_map_count": "524288"},
+				},
+			},
+		}
+	}
+	kernel := `        bottlerocket:
+          kernel:
+            sysctlSettings:
+              net.core.somaxconn: "65535"
+              vm.max_map_count: "524288"`
+
+	builder := vsphere.NewVsphereTemplateBuilder(time.Now)
+	data, err := builder.GenerateCAPISpecWorkers(spec, nil, nil)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(string(data)).To(ContainSubstring(kernel))
+	g.Expect(string(data)).NotTo(ContainSubstring("sysctl.d"))
+}
+
+func TestVsphereTemplateBuilderGenerateCAPISpecControlPlaneWithKonnectivity(t *testing.T) {
+	g := NewWithT(t)
+	spec := test.NewFullClusterSpec(t, "testdata/cluster_main.yaml")
+	spec.Cluster.Spec.ControlPlaneConfiguration.Konnectivity = &v1alpha1.KonnectivityConfiguration{
+		ServerHost: "konnectivity.example.com",
+		AgentPort:  8132,
+	}
+	spec.RootVersionsBundle().Konnectivity = &releasev1.KonnectivityBundle{
+		Server: releasev1.Image{URI: "public.ecr.aws/eks-anywhere/konnectivity/server:v0.31.0"},
+		Agent:  releasev1.Image{URI: "public.ecr.aws/eks-anywhere/konnectivity/agent:v0.31.0"},
+	}
+	spec.VSphereMachineConfigs[spec.Cluster.Spec.ControlPlaneConfiguration.MachineGroupRef.Name].Spec.OSFamily = v1alpha1.Bottlerocket
+
+	builder := vsphere.NewVsphereTemplateBuilder(time.Now)
+	data, err := builder.GenerateCAPISpecControlPlane(spec, func(values map[string]interface{}) {
+		values["controlPlaneTemplateName"] = clusterapi.ControlPlaneMachineTemplateName(spec.Cluster)
+	})
+	g.Expect(err).ToNot(HaveOccurred())
+
+	str := collapseWhitespace(string(data))
+	g.Expect(str).To(ContainSubstring("- konnectivity.example.com"))
+	g.Expect(str).To(ContainSubstring("- name: egress-selector-config-file value: /etc/kubernetes/egress-selector-configuration.yaml"))
+	g.Expect(str).To(ContainSubstring("- hostPath: /var/lib/kubeadm/egress-selector-configuration.yaml mountPath: /etc/kubernetes/egress-selector-configuration.yaml name: egress-selector-configuration"))
+	g.Expect(str).To(ContainSubstring("- hostPath: /var/lib/kubeadm/konnectivity-server mountPath: /etc/kubernetes/konnectivity-server name: konnectivity-uds"))
+	g.Expect(str).To(ContainSubstring("path: /etc/kubernetes/manifests/konnectivity-server.yaml"))
+	g.Expect(str).To(ContainSubstring("path: /etc/kubernetes/manifests/konnectivity-agent.yaml"))
+	g.Expect(str).To(ContainSubstring("- --proxy-server-host=konnectivity.example.com"))
+}
+
+func TestVsphereTemplateBuilderGenerateCAPISpecControlPlaneKonnectivityMissingBundle(t *testing.T) {
+	g := NewWithT(t)
+	spec := test.NewFullClusterSpec(t, "testdata/cluster_main.yaml")
+	spec.Cluster.Spec.ControlPlaneConfiguration.Konnectivity = &v1alpha1.KonnectivityConfiguration{}
+
+	builder := vsphere.NewVsphereTemplateBuilder(time.Now)
+	_, err := builder.GenerateCAPISpecControlPlane(spec)
+	g.Expect(err).To(MatchError(ContainSubstring("konnectivity is not available in the bundle")))
+}
+
+func TestVsphereTemplateBuilderGenerateCAPISpecWorkersWithKonnectivity(t *testing.T) {
+	g := NewWithT(t)
+	spec := test.NewFullClusterSpec(t, "testdata/cluster_main.yaml")
+	spec.Cluster.Spec.ControlPlaneConfiguration.Konnectivity = &v1alpha1.KonnectivityConfiguration{AgentPort: 8132}
+	spec.RootVersionsBundle().Konnectivity = &releasev1.KonnectivityBundle{
+		Server: releasev1.Image{URI: "public.ecr.aws/eks-anywhere/konnectivity/server:v0.31.0"},
+		Agent:  releasev1.Image{URI: "public.ecr.aws/eks-anywhere/konnectivity/agent:v0.31.0"},
+	}
+
+	builder := vsphere.NewVsphereTemplateBuilder(time.Now)
+	data, err := builder.GenerateCAPISpecWorkers(spec, nil, nil)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	str := collapseWhitespace(string(data))
+	g.Expect(str).To(ContainSubstring("image: public.ecr.aws/eks-anywhere/konnectivity/agent:v0.31.0"))
+	g.Expect(str).To(ContainSubstring("- --proxy-server-host=" + spec.Cluster.Spec.ControlPlaneConfiguration.Endpoint.Host))
+	g.Expect(str).To(ContainSubstring("path: /etc/kubernetes/manifests/konnectivity-agent.yaml"))
+}
+
+func TestVsphereTemplateBuilderGenerateCAPISpecWorkersWithOnDemandProvisioner(t *testing.T) {
+	g := NewWithT(t)
+	spec := test.NewFullClusterSpec(t, "testdata/cluster_main.yaml")
+	spec.Cluster.Spec.WorkerNodeGroupConfigurations[0].AutoScalingConfiguration = &v1alpha1.AutoScalingConfiguration{
+		MinCount:    0,
+		MaxCount:    5,
+		Provisioner: v1alpha1.OnDemandProvisioner,
+	}
+
+	builder := vsphere.NewVsphereTemplateBuilder(time.Now)
+	data, err := builder.GenerateCAPISpecWorkers(spec, nil, nil)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	str := string(data)
+	g.Expect(str).To(ContainSubstring("    anywhere.eks.amazonaws.com/on-demand-node-group-min-size: \"0\"\n"))
+	g.Expect(str).To(ContainSubstring("    anywhere.eks.amazonaws.com/on-demand-node-group-max-size: \"5\"\n"))
+	g.Expect(str).NotTo(ContainSubstring("cluster-api-autoscaler-node-group"))
+	g.Expect(str).NotTo(ContainSubstring("  replicas:"))
+}
+
+func TestVsphereTemplateBuilderGenerateCAPISpecControlPlaneIPv6(t *testing.T) {
+	g := NewWithT(t)
+	spec := test.NewFullClusterSpec(t, "testdata/cluster_main.yaml")
+	spec.Cluster.Spec.ControlPlaneConfiguration.Endpoint.Host = "fd00::10"
+	spec.Cluster.Spec.RegistryMirrorConfiguration = &v1alpha1.RegistryMirrorConfiguration{
+		Endpoint: "fd00::20",
+		Port:     "443",
+		OCINamespaces: []v1alpha1.OCINamespace{
+			{Registry: "public.ecr.aws", Namespace: "eks-anywhere"},
+			{Registry: "docker.io", Namespace: "docker"},
+		},
+	}
+	spec.VSphereMachineConfigs[spec.Cluster.Spec.ControlPlaneConfiguration.MachineGroupRef.Name].Spec.OSFamily = v1alpha1.Bottlerocket
+
+	builder := vsphere.NewVsphereTemplateBuilder(time.Now)
+	data, err := builder.GenerateCAPISpecControlPlane(spec, func(values map[string]interface{}) {
+		values["controlPlaneTemplateName"] = clusterapi.ControlPlaneMachineTemplateName(spec.Cluster)
+	})
+	g.Expect(err).ToNot(HaveOccurred())
+	_, err = unstructuredutil.YamlToUnstructured(data)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	str := collapseWhitespace(string(data))
+	g.Expect(str).To(ContainSubstring("host: fd00::10"))
+	g.Expect(str).To(ContainSubstring(`- name: vip_cidr value: "128"`))
+	g.Expect(str).To(ContainSubstring(`endpoint: "[fd00::20]:443/v2/eks-anywhere"`))
+}
+
+func TestVsphereTemplateBuilderGenerateCAPISpecControlPlaneDualStack(t *testing.T) {
+	g := NewWithT(t)
+	spec := test.NewFullClusterSpec(t, "testdata/cluster_main.yaml")
+	spec.Cluster.Spec.ControlPlaneConfiguration.Endpoint.Host = "fd00::10"
+	spec.Cluster.Spec.ClusterNetwork.Pods.CidrBlocks = []string{"fd00:100::/48", "192.168.0.0/16"}
+	spec.Cluster.Spec.ClusterNetwork.Services.CidrBlocks = []string{"fd00:200::/108", "10.96.0.0/12"}
+	spec.Cluster.Spec.ClusterNetwork.Nodes = &v1alpha1.Nodes{CIDRMaskSize: ptr.Int(26)}
+
+	builder := vsphere.NewVsphereTemplateBuilder(time.Now)
+	data, err := builder.GenerateCAPISpecControlPlane(spec, func(values map[string]interface{}) {
+		values["controlPlaneTemplateName"] = clusterapi.ControlPlaneMachineTemplateName(spec.Cluster)
+	})
+	g.Expect(err).ToNot(HaveOccurred())
+	_, err = unstructuredutil.YamlToUnstructured(data)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	str := collapseWhitespace(string(data))
+	g.Expect(str).To(ContainSubstring("pods: cidrBlocks: [fd00:100::/48, 192.168.0.0/16]"))
+	g.Expect(str).To(ContainSubstring("services: cidrBlocks: [fd00:200::/108, 10.96.0.0/12]"))
+	g.Expect(str).To(ContainSubstring(`- name: node-cidr-mask-size-ipv4 value: "26"`))
+	g.Expect(str).To(ContainSubstring(`- name: node-ip value: "::"`))
+	g.Expect(str).To(ContainSubstring("- dhcp4: true dhcp6: true networkName: /SDDC-Datacenter/network/sddc-cgw-network-1"))
+
+	data, err = builder.GenerateCAPISpecWorkers(spec, nil, nil)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(collapseWhitespace(string(data))).To(ContainSubstring("- dhcp4: true dhcp6: true networkName: /SDDC-Datacenter/network/sddc-cgw-network-1"))
+}
@@ -8,6 +8,8 @@ import (
 	"fmt"
 	"net"
 	"path/filepath"
+	"slices"
+	"strings"
 
 	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
 	"github.com/aws/eks-anywhere/pkg/collection"
@@ -19,6 +21,12 @@ import (
 
 const (
 	vsphereRootPath = "/"
+
+	folderCreatePriv       = "Folder.Create"
+	resourcePoolCreatePriv = "Resource.CreatePool"
+
+	libraryItemTypeOVF        = "ovf"
+	libraryItemTypeVMTemplate = "vm-template"
 )
 
 type PrivAssociation struct {
@@ -107,7 +115,12 @@ func (v *Validator) ValidateFailureDomains(ctx context.Context, vsphereClusterSp
 		return err
 	}
 
-	if !failureDomainsAssigned {
+	cpFailureDomainsAssigned, err := v.validateControlPlaneDomains(vsphereClusterSpec, providedFailureDomains)
+	if err != nil {
+		return err
+	}
+
+	if !failureDomainsAssigned && !cpFailureDomainsAssigned {
 		// TODO: Error message here if Failure Domain not being used by workernodegroups?
 		// Skipping further validation currently
 		// return fmt.Errorf("failure domain defined, but no worker node group references")
@@ -128,19 +141,49 @@ func (v *Validator) validateWorkerNodeGroupDomains(vsphereClusterSpec *Spec, pro
 			continue
 		}
 
-		if len(wng.FailureDomains) > 1 {
-			return failureDomainsAssigned, fmt.Errorf("multiple failure domains provided in the worker node group: %s. Please provide only one failure domain", wng.Name)
+		if policy := wng.FailureDomainSpreadPolicy; policy != "" && policy != anywherev1.FailureDomainSpreadPolicyEven {
+			return failureDomainsAssigned, fmt.Errorf("unsupported failureDomainSpreadPolicy %s in the worker node group %s, only %s is supported", policy, wng.Name, anywherev1.FailureDomainSpreadPolicyEven)
 		}
 
-		assignedFailureDomain := wng.FailureDomains[0]
-		if !providedFailureDomains.Contains(assignedFailureDomain) {
-			return failureDomainsAssigned, fmt.Errorf("provided invalid failure domain %s in the worker node group %s", assignedFailureDomain, wng.Name)
+		seen := make(map[string]struct{}, len(wng.FailureDomains))
+		for _, assignedFailureDomain := range wng.FailureDomains {
+			if !providedFailureDomains.Contains(assignedFailureDomain) {
+				return failureDomainsAssigned, fmt.Errorf("provided invalid failure domain %s in the worker node group %s", assignedFailureDomain, wng.Name)
+			}
+			if _, ok := seen[assignedFailureDomain]; ok {
+				return failureDomainsAssigned, fmt.Errorf("failure domain %s is duplicated in the worker node group %s", assignedFailureDomain, wng.Name)
+			}
+			seen[assignedFailureDomain] = struct{}{}
 		}
 		failureDomainsAssigned = true
 	}
 	return failureDomainsAssigned, nil
 }
 
+func (v *Validator) validateControlPlaneDomains(vsphereClusterSpec *Spec, providedFailureDomains collection.Set[string]) (bool, error) {
+	cpFailureDomains := vsphereClusterSpec.Cluster.Spec.ControlPlaneConfiguration.FailureDomains
+	if len(cpFailureDomains) == 0 {
+		return false, nil
+	}
+
+	assigned := collection.NewSet[string]()
+	for _, fd := range cpFailureDomains {
+		if !providedFailureDomains.Contains(fd) {
+			return false, fmt.Errorf("provided invalid failure domain %s in the control plane configuration", fd)
+		}
+		if assigned.Contains(fd) {
+			return false, fmt.Errorf("duplicated failure domain %s in the control plane configuration", fd)
+		}
+		assigned.Add(fd)
+	}
+
+	if cpCount := vsphereClusterSpec.Cluster.Spec.ControlPlaneConfiguration.Count; cpCount < len(cpFailureDomains) {
+		logger.Info("Warning: control plane count is lower than the number of control plane failure domains, some failure domains won't have control plane nodes", "count", cpCount, "failureDomains", len(cpFailureDomains))
+	}
+
+	return true, nil
+}
+
 func (v *Validator) validateFailureDomainResources(ctx context.Context, vsphereClusterSpec *Spec, failureDomains []anywherev1.FailureDomain) error {
 	for index, fd := range failureDomains {
 		message := fmt.Sprintf("Start failure domain validation for '%s' ", failureDomains[index].Name)
@@ -222,6 +265,10 @@ func (v *Validator) ValidateClusterMachineConfigs(ctx context.Context, vsphereCl
 		}
 	}
 
+	if err := v.validateStoragePolicies(ctx, vsphereClusterSpec.machineConfigs()); err != nil {
+		return err
+	}
+
 	if err := v.validateTemplates(ctx, vsphereClusterSpec); err != nil {
 		return err
 	}
@@ -243,6 +290,56 @@ func (v *Validator) ValidateClusterMachineConfigs(ctx context.Context, vsphereCl
 	return nil
 }
 
+// validateTemplateLibraryItems checks that the content library items referenced by the machine config templates
+// exist and can be deployed as templates.
+func (v *Validator) validateTemplateLibraryItems(ctx context.Context, spec *Spec) error {
+	for _, mc := range spec.machineConfigs() {
+		library, item, ok := mc.TemplateLibraryItem()
+		if !ok {
+			continue
+		}
+
+		libraryItem, err := v.govc.GetLibraryItem(ctx, library, item)
+		if err != nil {
+			return fmt.Errorf("validating template for VSphereMachineConfig %s: %v", mc.Name, err)
+		}
+		if libraryItem == nil {
+			return fmt.Errorf("content library item %s not found in library %s for VSphereMachineConfig %s", item, library, mc.Name)
+		}
+		if libraryItem.Type != libraryItemTypeOVF && libraryItem.Type != libraryItemTypeVMTemplate {
+			return fmt.Errorf("content library item %s in library %s for VSphereMachineConfig %s has type %s, only %s and %s items can be used as templates",
+				item, library, mc.Name, libraryItem.Type, libraryItemTypeOVF, libraryItemTypeVMTemplate)
+		}
+	}
+
+	return nil
+}
+
+// validateStoragePolicies checks that the storage policies referenced by the machine configs exist in vCenter.
+func (v *Validator) validateStoragePolicies(ctx context.Context, machineConfigs []*anywherev1.VSphereMachineConfig) error {
+	validated := map[string]struct{}{}
+	for _, mc := range machineConfigs {
+		policy := mc.Spec.StoragePolicyName
+		if policy == "" {
+			continue
+		}
+		if _, ok := validated[policy]; ok {
+			continue
+		}
+
+		exists, err := v.govc.StoragePolicyExists(ctx, policy)
+		if err != nil {
+			return fmt.Errorf("validating storage policy for VSphereMachineConfig %s: %v", mc.Name, err)
+		}
+		if !exists {
+			return fmt.Errorf("storage policy %s for VSphereMachineConfig %s not found in vCenter", policy, mc.Name)
+		}
+		validated[policy] = struct{}{}
+	}
+
+	return nil
+}
+
 func (v *Validator) validateControlPlaneIp(ip string) error {
 	// check if controlPlaneEndpointIp is valid
 	parsedIp := net.ParseIP(ip)
@@ -400,14 +497,14 @@ func (v *Validator) validateNetwork(ctx context.Context, network string) error {
 func (v *Validator) validateNetworksFieldUsage(ctx context.Context, vsphereClusterSpec *Spec) error {
 	// Check control plane - should NOT have networks field
 	controlPlaneMachineConfig := vsphereClusterSpec.controlPlaneMachineConfig()
-	if controlPlaneMachineConfig != nil && len(controlPlaneMachineConfig.Spec.Networks) > 0 {
+	if controlPlaneMachineConfig != nil && len(controlPlaneMachineConfig.NetworkNames()) > 0 {
 		return fmt.Errorf("networks field is not supported for control plane machine config '%s'. Control plane uses the datacenter network configuration", controlPlaneMachineConfig.Name)
 	}
 
 	// Check etcd - should NOT have networks field
 	if vsphereClusterSpec.Cluster.Spec.ExternalEtcdConfiguration != nil {
 		etcdMachineConfig := vsphereClusterSpec.etcdMachineConfig()
-		if etcdMachineConfig != nil && len(etcdMachineConfig.Spec.Networks) > 0 {
+		if etcdMachineConfig != nil && len(etcdMachineConfig.NetworkNames()) > 0 {
 			return fmt.Errorf("networks field is not supported for etcd machine config '%s'. Etcd uses the datacenter network configuration", etcdMachineConfig.Name)
 		}
 	}
@@ -427,7 +524,7 @@ func (v *Validator) validateNetworksFieldUsage(ctx context.Context, vsphereClust
 }
 
 func (v *Validator) validateWorkerMachineConfigNetworks(ctx context.Context, machineConfig *anywherev1.VSphereMachineConfig, workerGroupName string) error {
-	for _, network := range machineConfig.Spec.Networks {
+	for _, network := range machineConfig.NetworkNames() {
 		if err := v.validateNetwork(ctx, network); err != nil {
 			return fmt.Errorf("network '%s' not found for worker group '%s' machine config '%s'", network, workerGroupName, machineConfig.Name)
 		}
@@ -435,28 +532,81 @@ func (v *Validator) validateWorkerMachineConfigNetworks(ctx context.Context, mac
 	return nil
 }
 
-func (v *Validator) collectResourcePathConfig(_ context.Context, spec *Spec) ([]ResourcePaths, error) {
-	resourcePaths := []ResourcePaths{}
-	controlPlaneMachineConfig := spec.controlPlaneMachineConfig()
-	resourcePaths = append(resourcePaths, controlPlaneMachineConfig)
+// validateResourcesExist checks that the resource pools and folders referenced by the cluster exist in vCenter,
+// reporting all the missing ones at once along with the privileges needed to create them.
+// Folders whose parent exists are not reported since they are created during machine config validation.
+func (v *Validator) validateResourcesExist(ctx context.Context, spec *Spec) error {
+	datacenter := spec.VSphereDatacenter.Spec.Datacenter
+	var missing []string
+	var privs []string
+	seen := map[string]struct{}{}
+
+	for _, r := range spec.resourcePaths() {
+		paths := r.ResourcePaths()
+
+		if pool := paths["resourcePool"]; pool != "" {
+			if _, ok := seen[pool]; !ok {
+				seen[pool] = struct{}{}
+				exists, err := v.govc.ResourcePoolExists(ctx, datacenter, pool)
+				if err != nil {
+					return fmt.Errorf("validating resource pool %s: %v", pool, err)
+				}
+				if !exists {
+					missing = append(missing, fmt.Sprintf("resource pool %s", pool))
+					privs = appendIfMissing(privs, resourcePoolCreatePriv)
+				}
+			}
+		}
 
-	for _, workerNodeGroupConfiguration := range spec.Cluster.Spec.WorkerNodeGroupConfigurations {
-		workerNodeGroupMachineConfig := spec.workerMachineConfig(workerNodeGroupConfiguration)
-		resourcePaths = append(resourcePaths, workerNodeGroupMachineConfig)
+		if folder := paths["folder"]; folder != "" {
+			if _, ok := seen[folder]; !ok {
+				seen[folder] = struct{}{}
+				exists, err := v.folderOrParentExists(ctx, datacenter, folder)
+				if err != nil {
+					return fmt.Errorf("validating folder %s: %v", folder, err)
+				}
+				if !exists {
+					missing = append(missing, fmt.Sprintf("folder %s", folder))
+					privs = appendIfMissing(privs, folderCreatePriv)
+				}
+			}
+		}
 	}
 
-	if spec.Cluster.Spec.ExternalEtcdConfiguration != nil {
-		etcdMachineConfig := spec.etcdMachineConfig()
-		resourcePaths = append(resourcePaths, etcdMachineConfig)
+	if len(missing) == 0 {
+		logger.MarkPass("Folders and resource pools validated")
+		return nil
 	}
 
-	if spec.VSphereDatacenter.Spec.FailureDomains != nil {
-		for _, failureDomain := range spec.VSphereDatacenter.Spec.FailureDomains {
-			resourcePaths = append(resourcePaths, &failureDomain)
-		}
+	return fmt.Errorf(
+		"missing vSphere resources: [%s]. Create them or set createMissingResources in VSphereDatacenterConfig %s to let the CLI create them, which requires the %s privileges on their parent objects",
+		strings.Join(missing, ", "), spec.VSphereDatacenter.Name, strings.Join(privs, ", "),
+	)
+}
+
+func appendIfMissing(s []string, e string) []string {
+	if slices.Contains(s, e) {
+		return s
+	}
+	return append(s, e)
+}
+
+func (v *Validator) folderOrParentExists(ctx context.Context, datacenter, folder string) (bool, error) {
+	exists, err := v.govc.FolderExists(ctx, datacenter, folder)
+	if err != nil || exists {
+		return exists, err
 	}
 
-	return resourcePaths, nil
+	parent := filepath.Dir(folder)
+	if parent == "." || parent == vsphereRootPath || parent == filepath.Join(vsphereRootPath, datacenter, "vm") {
+		return true, nil
+	}
+
+	return v.govc.FolderExists(ctx, datacenter, parent)
+}
+
+func (v *Validator) collectResourcePathConfig(_ context.Context, spec *Spec) ([]ResourcePaths, error) {
+	return spec.resourcePaths(), nil
 }
 
 func (v *Validator) validateVsphereUserPrivs(ctx context.Context, vSphereClusterSpec *Spec) error {
@@ -464,13 +614,23 @@ func (v *Validator) validateVsphereUserPrivs(ctx context.Context, vSphereCluster
 	var err error
 	vuc := config.NewVsphereUserConfig()
 
+	if config.ReadOnlyCredentialsInUse() {
+		// Read-only credentials are only used by commands that don't modify vSphere, so the
+		// write privileges required to manage the cluster aren't expected.
+		if passed, err = v.validateReadOnlyPrivs(ctx, vSphereClusterSpec, vuc.EksaVsphereUsername, vuc.EksaVspherePassword); err != nil {
+			return err
+		}
+		markPrivsValidationPass(passed, vuc.EksaVsphereUsername)
+		return nil
+	}
+
 	if passed, err = v.validateUserPrivs(ctx, vSphereClusterSpec, vuc); err != nil {
 		return err
 	}
 	markPrivsValidationPass(passed, vuc.EksaVsphereUsername)
 
 	if len(vuc.EksaVsphereCPUsername) > 0 && vuc.EksaVsphereCPUsername != vuc.EksaVsphereUsername {
-		if passed, err = v.validateCPUserPrivs(ctx, vSphereClusterSpec, vuc); err != nil {
+		if passed, err = v.validateReadOnlyPrivs(ctx, vSphereClusterSpec, vuc.EksaVsphereCPUsername, vuc.EksaVsphereCPPassword); err != nil {
 			return err
 		}
 		markPrivsValidationPass(passed, vuc.EksaVsphereCPUsername)
@@ -593,12 +753,13 @@ func (v *Validator) validateUserPrivs(ctx context.Context, spec *Spec, vuc *conf
 	if err != nil {
 		return false, err
 	}
+	defer closeVSphereClient(ctx, vsc)
 
 	return v.validatePrivs(ctx, requiredPrivAssociations, vsc)
 }
 
-func (v *Validator) validateCPUserPrivs(ctx context.Context, spec *Spec, vuc *config.VSphereUserConfig) (bool, error) {
-	// CP role just needs read only
+// validateReadOnlyPrivs validates the user has read only privileges, which is all the CP role needs.
+func (v *Validator) validateReadOnlyPrivs(ctx context.Context, spec *Spec, username, password string) (bool, error) {
 	privObjs := []PrivAssociation{
 		{
 			objectType:   govmomi.VSphereTypeFolder,
@@ -613,18 +774,25 @@ func (v *Validator) validateCPUserPrivs(ctx context.Context, spec *Spec, vuc *co
 	vsc, err := v.vSphereClientBuilder.Build(
 		ctx,
 		host,
-		vuc.EksaVsphereCPUsername,
-		vuc.EksaVsphereCPPassword,
+		username,
+		password,
 		spec.VSphereDatacenter.Spec.Insecure,
 		datacenter,
 	)
 	if err != nil {
 		return false, err
 	}
+	defer closeVSphereClient(ctx, vsc)
 
 	return v.validatePrivs(ctx, privObjs, vsc)
 }
 
+func closeVSphereClient(ctx context.Context, vsc govmomi.VSphereClient) {
+	if err := vsc.Close(ctx); err != nil {
+		logger.V(4).Info("Failed closing vSphere client", "error", err)
+	}
+}
+
 func (v *Validator) validatePrivs(ctx context.Context, privObjs []PrivAssociation, vsc govmomi.VSphereClient) (bool, error) {
 	var privs []string
 	var err error
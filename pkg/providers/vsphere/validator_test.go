@@ -10,6 +10,7 @@ import (
 	"github.com/golang/mock/gomock"
 	. "github.com/onsi/gomega"
 	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/aws/eks-anywhere/pkg/api/v1alpha1"
 	"github.com/aws/eks-anywhere/pkg/cluster"
@@ -253,6 +254,7 @@ func TestValidatorValidateVsphereCPUserPrivsError(t *testing.T) {
 
 	vsc.EXPECT().Username().Return("foobar").AnyTimes()
 	vsc.EXPECT().GetPrivsOnEntity(ctx, gomock.Any(), gomock.Any(), "foobar").Return(privs, nil).AnyTimes()
+	vsc.EXPECT().Close(ctx).Return(nil)
 
 	vscb.EXPECT().Build(ctx, gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), spec.VSphereDatacenter.Spec.Datacenter).Return(vsc, nil)
 	vscb.EXPECT().Build(ctx, gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), spec.VSphereDatacenter.Spec.Datacenter).Return(nil, fmt.Errorf("error"))
@@ -262,6 +264,46 @@ func TestValidatorValidateVsphereCPUserPrivsError(t *testing.T) {
 	g.Expect(err).To(MatchError(ContainSubstring("error")))
 }
 
+func TestValidatorValidateVsphereUserPrivsReadOnlyCredentials(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	govc := govcmocks.NewMockProviderGovcClient(ctrl)
+	vscb := govcmocks.NewMockVSphereClientBuilder(ctrl)
+	vsc := mocks.NewMockVSphereClient(ctrl)
+
+	wantEnv := map[string]string{
+		config.EksavSphereUsernameKey:          "reader",
+		config.EksavSpherePasswordKey:          "reader-pass",
+		config.EksavSphereCPUsernameKey:        "reader",
+		config.EksavSphereCPPasswordKey:        "reader-pass",
+		config.EksaReadOnlyCredentialsInUseKey: "true",
+	}
+	for k, v := range wantEnv {
+		t.Setenv(k, v)
+	}
+
+	v := Validator{
+		govc:                 govc,
+		vSphereClientBuilder: vscb,
+	}
+
+	var privs []string
+	err := json.Unmarshal([]byte(config.VSphereReadOnlyPrivs), &privs)
+	if err != nil {
+		t.Fatalf("failed to validate privs: %v", err)
+	}
+
+	spec := clusterSpec()
+
+	vscb.EXPECT().Build(ctx, spec.VSphereDatacenter.Spec.Server, "reader", "reader-pass", spec.VSphereDatacenter.Spec.Insecure, spec.VSphereDatacenter.Spec.Datacenter).Return(vsc, nil)
+	vsc.EXPECT().Username().Return("reader")
+	vsc.EXPECT().GetPrivsOnEntity(ctx, vsphereRootPath, govmomi.VSphereTypeFolder, "reader").Return(privs, nil)
+	vsc.EXPECT().Close(ctx).Return(nil)
+	g := NewWithT(t)
+
+	g.Expect(v.validateVsphereUserPrivs(ctx, spec)).To(Succeed())
+}
+
 func TestValidatorValidateMachineConfigTagsExistErrorListingTag(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	govc := govcmocks.NewMockProviderGovcClient(ctrl)
@@ -355,6 +397,143 @@ func TestValidatorValidateMachineConfigTagsExistTagDoesNotExist(t *testing.T) {
 	g.Expect(err).To(Not(BeNil()))
 }
 
+func TestValidatorValidateStoragePoliciesSuccess(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	govc := govcmocks.NewMockProviderGovcClient(ctrl)
+	ctx := context.Background()
+	g := NewWithT(t)
+
+	v := Validator{
+		govc: govc,
+	}
+
+	machineConfigs := []*v1alpha1.VSphereMachineConfig{
+		{Spec: v1alpha1.VSphereMachineConfigSpec{StoragePolicyName: "vSAN RAID-1"}},
+		{Spec: v1alpha1.VSphereMachineConfigSpec{StoragePolicyName: "vSAN RAID-1"}},
+		{Spec: v1alpha1.VSphereMachineConfigSpec{}},
+	}
+
+	govc.EXPECT().StoragePolicyExists(ctx, "vSAN RAID-1").Return(true, nil)
+
+	g.Expect(v.validateStoragePolicies(ctx, machineConfigs)).To(Succeed())
+}
+
+func TestValidatorValidateStoragePoliciesNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	govc := govcmocks.NewMockProviderGovcClient(ctrl)
+	ctx := context.Background()
+	g := NewWithT(t)
+
+	v := Validator{
+		govc: govc,
+	}
+
+	machineConfigs := []*v1alpha1.VSphereMachineConfig{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "cp"},
+			Spec:       v1alpha1.VSphereMachineConfigSpec{StoragePolicyName: "vSAN RAID-5"},
+		},
+	}
+
+	govc.EXPECT().StoragePolicyExists(ctx, "vSAN RAID-5").Return(false, nil)
+
+	g.Expect(v.validateStoragePolicies(ctx, machineConfigs)).To(MatchError("storage policy vSAN RAID-5 for VSphereMachineConfig cp not found in vCenter"))
+}
+
+func TestValidatorValidateStoragePoliciesError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	govc := govcmocks.NewMockProviderGovcClient(ctrl)
+	ctx := context.Background()
+	g := NewWithT(t)
+
+	v := Validator{
+		govc: govc,
+	}
+
+	machineConfigs := []*v1alpha1.VSphereMachineConfig{
+		{Spec: v1alpha1.VSphereMachineConfigSpec{StoragePolicyName: "vSAN RAID-5"}},
+	}
+
+	govc.EXPECT().StoragePolicyExists(ctx, "vSAN RAID-5").Return(false, errors.New("govc error"))
+
+	g.Expect(v.validateStoragePolicies(ctx, machineConfigs)).To(MatchError(ContainSubstring("govc error")))
+}
+
+func TestValidatorValidateTemplateLibraryItemsSuccess(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	govc := govcmocks.NewMockProviderGovcClient(ctrl)
+	ctx := context.Background()
+	g := NewWithT(t)
+
+	v := Validator{
+		govc: govc,
+	}
+
+	spec := NewSpec(givenClusterSpec(t, testClusterConfigMainFilename))
+	spec.controlPlaneMachineConfig().Spec.Template = "library://golden-images/ubuntu-2204-kube-1-30"
+
+	govc.EXPECT().GetLibraryItem(ctx, "golden-images", "ubuntu-2204-kube-1-30").Return(&executables.LibraryItem{Name: "ubuntu-2204-kube-1-30", Type: "ovf"}, nil)
+
+	g.Expect(v.validateTemplateLibraryItems(ctx, spec)).To(Succeed())
+}
+
+func TestValidatorValidateTemplateLibraryItemsNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	govc := govcmocks.NewMockProviderGovcClient(ctrl)
+	ctx := context.Background()
+	g := NewWithT(t)
+
+	v := Validator{
+		govc: govc,
+	}
+
+	spec := NewSpec(givenClusterSpec(t, testClusterConfigMainFilename))
+	machineConfig := spec.controlPlaneMachineConfig()
+	machineConfig.Spec.Template = "library://golden-images/ubuntu-2204-kube-1-30"
+
+	govc.EXPECT().GetLibraryItem(ctx, "golden-images", "ubuntu-2204-kube-1-30").Return(nil, nil)
+
+	g.Expect(v.validateTemplateLibraryItems(ctx, spec)).To(MatchError(
+		fmt.Sprintf("content library item ubuntu-2204-kube-1-30 not found in library golden-images for VSphereMachineConfig %s", machineConfig.Name),
+	))
+}
+
+func TestValidatorValidateTemplateLibraryItemsInvalidType(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	govc := govcmocks.NewMockProviderGovcClient(ctrl)
+	ctx := context.Background()
+	g := NewWithT(t)
+
+	v := Validator{
+		govc: govc,
+	}
+
+	spec := NewSpec(givenClusterSpec(t, testClusterConfigMainFilename))
+	spec.controlPlaneMachineConfig().Spec.Template = "library://golden-images/ubuntu.iso"
+
+	govc.EXPECT().GetLibraryItem(ctx, "golden-images", "ubuntu.iso").Return(&executables.LibraryItem{Name: "ubuntu.iso", Type: "iso"}, nil)
+
+	g.Expect(v.validateTemplateLibraryItems(ctx, spec)).To(MatchError(ContainSubstring("has type iso, only ovf and vm-template items can be used as templates")))
+}
+
+func TestValidatorValidateTemplateLibraryItemsError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	govc := govcmocks.NewMockProviderGovcClient(ctrl)
+	ctx := context.Background()
+	g := NewWithT(t)
+
+	v := Validator{
+		govc: govc,
+	}
+
+	spec := NewSpec(givenClusterSpec(t, testClusterConfigMainFilename))
+	spec.controlPlaneMachineConfig().Spec.Template = "library://golden-images/ubuntu-2204-kube-1-30"
+
+	govc.EXPECT().GetLibraryItem(ctx, "golden-images", "ubuntu-2204-kube-1-30").Return(nil, errors.New("govc error"))
+
+	g.Expect(v.validateTemplateLibraryItems(ctx, spec)).To(MatchError(ContainSubstring("govc error")))
+}
+
 func TestValidatorValidateMachineConfigTemplateDoesNotExist(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	govc := govcmocks.NewMockProviderGovcClient(ctrl)
@@ -428,6 +607,7 @@ func TestValidatorValidateUserPrivsWithComputeCluster(t *testing.T) {
 
 	// Mock the username call
 	vsc.EXPECT().Username().Return(vuc.EksaVsphereUsername).AnyTimes()
+	vsc.EXPECT().Close(ctx).Return(nil)
 
 	// Mock the privilege checks
 	// For root folder
@@ -520,8 +700,8 @@ func TestValidateFailureDomains(t *testing.T) {
 			},
 		},
 		{
-			name:        "TestValidateFailureDomains worker node group with more than assigned failure domain case",
-			expectedErr: "multiple failure domains provided in the worker node group",
+			name:        "TestValidateFailureDomains worker node group with duplicated failure domain case",
+			expectedErr: "failure domain fd-1 is duplicated in the worker node group wd-1",
 			spec: &Spec{
 				Spec: &cluster.Spec{
 					Config: &cluster.Config{
@@ -530,7 +710,7 @@ func TestValidateFailureDomains(t *testing.T) {
 								WorkerNodeGroupConfigurations: []v1alpha1.WorkerNodeGroupConfiguration{
 									{
 										Name:           "wd-1",
-										FailureDomains: []string{"fd-1", "fd-2"},
+										FailureDomains: []string{"fd-1", "fd-2", "fd-1"},
 									},
 								},
 							},
@@ -609,6 +789,92 @@ func TestValidateFailureDomains(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:        "TestValidateFailureDomains control plane with invalid assigned failure domain",
+			expectedErr: "provided invalid failure domain fd-3 in the control plane configuration",
+			spec: &Spec{
+				Spec: &cluster.Spec{
+					Config: &cluster.Config{
+						Cluster: &v1alpha1.Cluster{
+							Spec: v1alpha1.ClusterSpec{
+								ControlPlaneConfiguration: v1alpha1.ControlPlaneConfiguration{
+									Count:          3,
+									FailureDomains: []string{"fd-1", "fd-3"},
+								},
+							},
+						},
+						VSphereDatacenter: &v1alpha1.VSphereDatacenterConfig{
+							Spec: v1alpha1.VSphereDatacenterConfigSpec{
+								Datacenter: "myDatacenter",
+								Server:     "myServer",
+								Network:    "/myDatacenter/network/myNetwork",
+								FailureDomains: []v1alpha1.FailureDomain{
+									{
+										Name:           "fd-1",
+										ComputeCluster: "myComputeCluster",
+										ResourcePool:   "myResourcePool",
+										Datastore:      "myDatastore",
+										Folder:         "myFolder",
+										Network:        "/myDatacenter/network/myNetwork",
+									},
+									{
+										Name:           "fd-2",
+										ComputeCluster: "myComputeCluster",
+										ResourcePool:   "myResourcePool",
+										Datastore:      "myDatastore",
+										Folder:         "myFolder",
+										Network:        "/myDatacenter/network/myNetwork",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:        "TestValidateFailureDomains control plane with duplicated failure domain",
+			expectedErr: "duplicated failure domain fd-1 in the control plane configuration",
+			spec: &Spec{
+				Spec: &cluster.Spec{
+					Config: &cluster.Config{
+						Cluster: &v1alpha1.Cluster{
+							Spec: v1alpha1.ClusterSpec{
+								ControlPlaneConfiguration: v1alpha1.ControlPlaneConfiguration{
+									Count:          3,
+									FailureDomains: []string{"fd-1", "fd-1"},
+								},
+							},
+						},
+						VSphereDatacenter: &v1alpha1.VSphereDatacenterConfig{
+							Spec: v1alpha1.VSphereDatacenterConfigSpec{
+								Datacenter: "myDatacenter",
+								Server:     "myServer",
+								Network:    "/myDatacenter/network/myNetwork",
+								FailureDomains: []v1alpha1.FailureDomain{
+									{
+										Name:           "fd-1",
+										ComputeCluster: "myComputeCluster",
+										ResourcePool:   "myResourcePool",
+										Datastore:      "myDatastore",
+										Folder:         "myFolder",
+										Network:        "/myDatacenter/network/myNetwork",
+									},
+									{
+										Name:           "fd-2",
+										ComputeCluster: "myComputeCluster",
+										ResourcePool:   "myResourcePool",
+										Datastore:      "myDatastore",
+										Folder:         "myFolder",
+										Network:        "/myDatacenter/network/myNetwork",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -693,6 +959,63 @@ func TestValidateFailureDomainsSuccess(t *testing.T) {
 	features.ClearCache()
 }
 
+func TestValidateFailureDomainsControlPlaneSuccess(t *testing.T) {
+	spec := &Spec{
+		Spec: &cluster.Spec{
+			Config: &cluster.Config{
+				Cluster: &v1alpha1.Cluster{
+					Spec: v1alpha1.ClusterSpec{
+						ControlPlaneConfiguration: v1alpha1.ControlPlaneConfiguration{
+							Count:          3,
+							FailureDomains: []string{"fd-1"},
+						},
+					},
+				},
+				VSphereDatacenter: &v1alpha1.VSphereDatacenterConfig{
+					Spec: v1alpha1.VSphereDatacenterConfigSpec{
+						Datacenter: "myDatacenter",
+						Server:     "myServer",
+						Network:    "/myDatacenter/network/myNetwork",
+						FailureDomains: []v1alpha1.FailureDomain{
+							{
+								Name:           "fd-1",
+								ComputeCluster: "myComputeCluster",
+								ResourcePool:   "myResourcePool",
+								Datastore:      "myDatastore",
+								Folder:         "myFolder",
+								Network:        "/myDatacenter/network/myNetwork",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	govc := govcmocks.NewMockProviderGovcClient(ctrl)
+
+	govc.EXPECT().
+		NetworkExists(ctx, "/myDatacenter/network/myNetwork").
+		Return(true, nil)
+
+	govc.EXPECT().
+		ValidateFailureDomainConfig(
+			ctx,
+			spec.VSphereDatacenter,
+			&spec.VSphereDatacenter.Spec.FailureDomains[0],
+		).
+		Return(nil)
+
+	v := Validator{
+		govc: govc,
+	}
+
+	err := v.ValidateFailureDomains(ctx, spec)
+	assert.NoError(t, err)
+}
+
 func TestValidateFailureDomainsNetworkNotFound(t *testing.T) {
 	spec := &Spec{
 		Spec: &cluster.Spec{
@@ -1066,3 +1389,86 @@ func TestValidator_validateTemplates(t *testing.T) {
 		})
 	}
 }
+
+func TestValidatorValidateResourcesExistSuccess(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	govc := govcmocks.NewMockProviderGovcClient(ctrl)
+	ctx := context.Background()
+	g := NewWithT(t)
+
+	v := Validator{
+		govc: govc,
+	}
+	spec := NewSpec(givenClusterSpec(t, testClusterConfigMainFilename))
+	datacenter := spec.VSphereDatacenter.Spec.Datacenter
+
+	govc.EXPECT().ResourcePoolExists(ctx, datacenter, "*/Resources").Return(true, nil)
+	govc.EXPECT().FolderExists(ctx, datacenter, "/SDDC-Datacenter/vm").Return(true, nil)
+
+	g.Expect(v.validateResourcesExist(ctx, spec)).To(Succeed())
+}
+
+func TestValidatorValidateResourcesExistFolderParentExists(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	govc := govcmocks.NewMockProviderGovcClient(ctrl)
+	ctx := context.Background()
+	g := NewWithT(t)
+
+	v := Validator{
+		govc: govc,
+	}
+	spec := NewSpec(givenClusterSpec(t, testClusterConfigMainFilename))
+	datacenter := spec.VSphereDatacenter.Spec.Datacenter
+	for _, m := range spec.VSphereMachineConfigs {
+		m.Spec.Folder = "/SDDC-Datacenter/vm/eksa/test"
+	}
+
+	govc.EXPECT().ResourcePoolExists(ctx, datacenter, "*/Resources").Return(true, nil)
+	govc.EXPECT().FolderExists(ctx, datacenter, "/SDDC-Datacenter/vm/eksa/test").Return(false, nil)
+	govc.EXPECT().FolderExists(ctx, datacenter, "/SDDC-Datacenter/vm/eksa").Return(true, nil)
+
+	g.Expect(v.validateResourcesExist(ctx, spec)).To(Succeed())
+}
+
+func TestValidatorValidateResourcesExistMissing(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	govc := govcmocks.NewMockProviderGovcClient(ctrl)
+	ctx := context.Background()
+	g := NewWithT(t)
+
+	v := Validator{
+		govc: govc,
+	}
+	spec := NewSpec(givenClusterSpec(t, testClusterConfigMainFilename))
+	datacenter := spec.VSphereDatacenter.Spec.Datacenter
+	for _, m := range spec.VSphereMachineConfigs {
+		m.Spec.Folder = "/SDDC-Datacenter/vm/eksa/test"
+		m.Spec.ResourcePool = "Cluster-1/Resources/eksa"
+	}
+
+	govc.EXPECT().ResourcePoolExists(ctx, datacenter, "Cluster-1/Resources/eksa").Return(false, nil)
+	govc.EXPECT().FolderExists(ctx, datacenter, "/SDDC-Datacenter/vm/eksa/test").Return(false, nil)
+	govc.EXPECT().FolderExists(ctx, datacenter, "/SDDC-Datacenter/vm/eksa").Return(false, nil)
+
+	g.Expect(v.validateResourcesExist(ctx, spec)).To(MatchError(
+		"missing vSphere resources: [resource pool Cluster-1/Resources/eksa, folder /SDDC-Datacenter/vm/eksa/test]. " +
+			"Create them or set createMissingResources in VSphereDatacenterConfig test to let the CLI create them, " +
+			"which requires the Resource.CreatePool, Folder.Create privileges on their parent objects",
+	))
+}
+
+func TestValidatorValidateResourcesExistError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	govc := govcmocks.NewMockProviderGovcClient(ctrl)
+	ctx := context.Background()
+	g := NewWithT(t)
+
+	v := Validator{
+		govc: govc,
+	}
+	spec := NewSpec(givenClusterSpec(t, testClusterConfigMainFilename))
+
+	govc.EXPECT().ResourcePoolExists(ctx, spec.VSphereDatacenter.Spec.Datacenter, "*/Resources").Return(false, errors.New("govc error"))
+
+	g.Expect(v.validateResourcesExist(ctx, spec)).To(MatchError("validating resource pool */Resources: govc error"))
+}
@@ -0,0 +1,55 @@
+//go:build vcsim
+
+package vsphere
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/aws/eks-anywhere/internal/test/vcsim"
+	"github.com/aws/eks-anywhere/pkg/config"
+	"github.com/aws/eks-anywhere/pkg/govmomi"
+)
+
+func simulatorClusterSpec(sim *vcsim.Simulator) *Spec {
+	return clusterSpec(func(s *Spec) {
+		s.VSphereDatacenter.Spec.Server = sim.Server()
+		s.VSphereDatacenter.Spec.Datacenter = vcsim.Datacenter
+		s.VSphereDatacenter.Spec.Network = vcsim.Network
+		s.VSphereDatacenter.Spec.Insecure = true
+
+		cp := s.VSphereMachineConfigs["test-cp"]
+		cp.Spec.Datastore = vcsim.Datastore
+		cp.Spec.ResourcePool = vcsim.ResourcePool
+		cp.Spec.Folder = vcsim.Folder
+		cp.Spec.Template = vcsim.VirtualMachine
+	})
+}
+
+func TestValidatorValidateVsphereUserPrivsSimulator(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	sim := vcsim.New(t)
+	t.Setenv(config.EksavSphereUsernameKey, sim.Username())
+	t.Setenv(config.EksavSpherePasswordKey, sim.Password())
+
+	v := NewValidator(nil, govmomi.NewVMOMIClientBuilder())
+
+	g.Expect(v.validateVsphereUserPrivs(ctx, simulatorClusterSpec(sim))).To(Succeed())
+}
+
+func TestValidatorValidateVsphereUserPrivsSimulatorMissingDatastore(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	sim := vcsim.New(t)
+	t.Setenv(config.EksavSphereUsernameKey, sim.Username())
+	t.Setenv(config.EksavSpherePasswordKey, sim.Password())
+
+	spec := simulatorClusterSpec(sim)
+	spec.VSphereMachineConfigs["test-cp"].Spec.Datastore = "/DC0/datastore/missing"
+	v := NewValidator(nil, govmomi.NewVMOMIClientBuilder())
+
+	g.Expect(v.validateVsphereUserPrivs(ctx, spec)).To(MatchError(ContainSubstring("failed to get missing privileges")))
+}
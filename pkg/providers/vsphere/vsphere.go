@@ -6,6 +6,7 @@ import (
 	_ "embed"
 	"fmt"
 	"os"
+	"path/filepath"
 	"text/template"
 	"time"
 
@@ -48,8 +49,13 @@ const (
 	disk1                    = "Hard disk 1"
 	disk2                    = "Hard disk 2"
 	MemoryAvailable          = "Memory_Available"
+	defaultRegionTagCategory = "k8s-region"
+	defaultZoneTagCategory   = "k8s-zone"
 )
 
+// OVADownloadDir is the folder, inside the writer's temp folder, where OVAs are downloaded before being imported.
+const OVADownloadDir = "ovas"
+
 const (
 	// Documentation URLs.
 	vSpherePermissionDoc = "https://anywhere.eks.amazonaws.com/docs/getting-started/vsphere/vsphere-preparation/"
@@ -92,6 +98,7 @@ type ProviderGovcClient interface {
 	SearchTemplate(ctx context.Context, datacenter, template string) (string, error)
 	LibraryElementExists(ctx context.Context, library string) (bool, error)
 	GetLibraryElementContentVersion(ctx context.Context, element string) (string, error)
+	GetLibraryItem(ctx context.Context, library, item string) (*executables.LibraryItem, error)
 	DeleteLibraryElement(ctx context.Context, element string) error
 	TemplateHasSnapshot(ctx context.Context, template string) (bool, error)
 	GetWorkloadAvailableSpace(ctx context.Context, datastore string) (float64, error)
@@ -105,12 +112,17 @@ type ProviderGovcClient interface {
 	DatacenterExists(ctx context.Context, datacenter string) (bool, error)
 	NetworkExists(ctx context.Context, network string) (bool, error)
 	GetFolderPath(ctx context.Context, datacenter string, folder string, envMap map[string]string) (string, error)
+	FolderExists(ctx context.Context, datacenter, folder string) (bool, error)
+	CreateFolder(ctx context.Context, datacenter, folder string) error
 	GetDatastorePath(ctx context.Context, datacenter string, datastorePath string, envMap map[string]string) (string, error)
 	GetResourcePoolPath(ctx context.Context, datacenter string, resourcePool string, envMap map[string]string) (string, error)
+	ResourcePoolExists(ctx context.Context, datacenter, resourcePool string) (bool, error)
+	CreateResourcePool(ctx context.Context, datacenter, resourcePool string) error
 	GetComputeClusterPath(ctx context.Context, datacenter string, computeCluster string, envMap map[string]string) (string, error)
 	CreateLibrary(ctx context.Context, datastore, library string) error
 	DeployTemplateFromLibrary(ctx context.Context, templateDir, templateName, library, datacenter, datastore, network, resourcePool string, resizeDisk2 bool) error
 	ImportTemplate(ctx context.Context, library, ovaURL, name string) error
+	ImportTemplateFromFile(ctx context.Context, library, ovaPath, name string) error
 	GetVMDiskSizeInGB(ctx context.Context, vm, datacenter string) (int, error)
 	GetTags(ctx context.Context, path string) (tags []string, err error)
 	ListTags(ctx context.Context) ([]executables.Tag, error)
@@ -126,6 +138,7 @@ type ProviderGovcClient interface {
 	RoleExists(ctx context.Context, name string) (bool, error)
 	CreateRole(ctx context.Context, name string, privileges []string) error
 	SetGroupRoleOnObject(ctx context.Context, principal, role, object, domain string) error
+	StoragePolicyExists(ctx context.Context, name string) (bool, error)
 	GetHardDiskSize(ctx context.Context, vm, datacenter string) (map[string]float64, error)
 	GetResourcePoolInfo(ctx context.Context, datacenter, resourcepool string, args ...string) (map[string]int, error)
 }
@@ -217,12 +230,20 @@ func NewProviderCustomNet(
 		skipIPCheck:        skipIPCheck,
 		Retrier:            retrier,
 		validator:          v,
-		defaulter:          NewDefaulter(providerGovcClient),
+		defaulter:          newDefaulter(providerGovcClient, writer),
 		ipValidator:        ipValidator,
 		skippedValidations: skippedValidations,
 	}
 }
 
+func newDefaulter(govc ProviderGovcClient, writer filewriter.FileWriter) *Defaulter {
+	if writer == nil {
+		return NewDefaulter(govc)
+	}
+
+	return NewDefaulter(govc, WithOVADownloadDir(filepath.Join(writer.TempDir(), OVADownloadDir)))
+}
+
 func (p *vsphereProvider) UpdateKubeConfig(_ *[]byte, _ string) error {
 	// customize generated kube config
 	return nil
@@ -300,10 +321,22 @@ func (p *vsphereProvider) SetupAndValidateCreateCluster(ctx context.Context, clu
 		return err
 	}
 
+	if err := p.defaulter.createMissingResources(ctx, vSphereClusterSpec); err != nil {
+		return fmt.Errorf("failed creating missing vsphere resources: %v", err)
+	}
+
+	if err := p.validator.validateResourcesExist(ctx, vSphereClusterSpec); err != nil {
+		return err
+	}
+
 	if err := p.validator.ValidateFailureDomains(ctx, vSphereClusterSpec); err != nil {
 		return err
 	}
 
+	if err := p.validator.validateTemplateLibraryItems(ctx, vSphereClusterSpec); err != nil {
+		return err
+	}
+
 	if err := p.defaulter.setDefaultsForMachineConfig(ctx, vSphereClusterSpec); err != nil {
 		return fmt.Errorf("failed setting default values for vsphere machine configs: %v", err)
 	}
@@ -387,10 +420,22 @@ func (p *vsphereProvider) SetupAndValidateUpgradeCluster(ctx context.Context, cl
 		return err
 	}
 
+	if err := p.defaulter.createMissingResources(ctx, vSphereClusterSpec); err != nil {
+		return fmt.Errorf("failed creating missing vsphere resources: %v", err)
+	}
+
+	if err := p.validator.validateResourcesExist(ctx, vSphereClusterSpec); err != nil {
+		return err
+	}
+
 	if err := p.validator.ValidateFailureDomains(ctx, vSphereClusterSpec); err != nil {
 		return err
 	}
 
+	if err := p.validator.validateTemplateLibraryItems(ctx, vSphereClusterSpec); err != nil {
+		return err
+	}
+
 	if err := p.defaulter.setDefaultsForMachineConfig(ctx, vSphereClusterSpec); err != nil {
 		return fmt.Errorf("failed setting default values for vsphere machine configs: %v", err)
 	}
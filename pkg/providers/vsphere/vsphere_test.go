@@ -108,6 +108,14 @@ func (pc *DummyProviderGovcClient) NetworkExists(ctx context.Context, network st
 	return true, nil
 }
 
+func (pc *DummyProviderGovcClient) StoragePolicyExists(ctx context.Context, name string) (bool, error) {
+	return true, nil
+}
+
+func (pc *DummyProviderGovcClient) GetLibraryItem(ctx context.Context, library, item string) (*executables.LibraryItem, error) {
+	return &executables.LibraryItem{Name: item, Type: "ovf"}, nil
+}
+
 //nolint:revive
 func (pc *DummyProviderGovcClient) GetResourcePoolPath(ctx context.Context, datacenter string, resourcePool string, envMap map[string]string) (string, error) {
 	return "", nil
@@ -133,6 +141,26 @@ func (pc *DummyProviderGovcClient) ValidateFailureDomainConfig(ctx context.Conte
 	return nil
 }
 
+//nolint:revive
+func (pc *DummyProviderGovcClient) FolderExists(ctx context.Context, datacenter, folder string) (bool, error) {
+	return true, nil
+}
+
+//nolint:revive
+func (pc *DummyProviderGovcClient) CreateFolder(ctx context.Context, datacenter, folder string) error {
+	return nil
+}
+
+//nolint:revive
+func (pc *DummyProviderGovcClient) ResourcePoolExists(ctx context.Context, datacenter, resourcePool string) (bool, error) {
+	return true, nil
+}
+
+//nolint:revive
+func (pc *DummyProviderGovcClient) CreateResourcePool(ctx context.Context, datacenter, resourcePool string) error {
+	return nil
+}
+
 func (pc *DummyProviderGovcClient) ValidateVCenterSetupMachineConfig(ctx context.Context, datacenterConfig *v1alpha1.VSphereDatacenterConfig, machineConfig *v1alpha1.VSphereMachineConfig, selfSigned *bool) error {
 	return nil
 }
@@ -169,6 +197,10 @@ func (pc *DummyProviderGovcClient) ImportTemplate(ctx context.Context, library,
 	return nil
 }
 
+func (pc *DummyProviderGovcClient) ImportTemplateFromFile(ctx context.Context, library, ovaPath, name string) error {
+	return nil
+}
+
 func (pc *DummyProviderGovcClient) GetVMDiskSizeInGB(ctx context.Context, vm, datacenter string) (int, error) {
 	return 25, nil
 }
@@ -410,6 +442,10 @@ func (tt *providerTest) setExpectationForVCenterValidation() {
 	tt.govc.EXPECT().IsCertSelfSigned(tt.ctx).Return(false)
 	tt.govc.EXPECT().DatacenterExists(tt.ctx, tt.datacenterConfig.Spec.Datacenter).Return(true, nil)
 	tt.govc.EXPECT().NetworkExists(tt.ctx, tt.datacenterConfig.Spec.Network).Return(true, nil)
+	for _, m := range tt.machineConfigs {
+		tt.govc.EXPECT().ResourcePoolExists(tt.ctx, tt.datacenterConfig.Spec.Datacenter, m.Spec.ResourcePool).Return(true, nil).AnyTimes()
+		tt.govc.EXPECT().FolderExists(tt.ctx, tt.datacenterConfig.Spec.Datacenter, m.Spec.Folder).Return(true, nil).AnyTimes()
+	}
 }
 
 func (tt *providerTest) setExpectationForSetup() {
@@ -422,6 +458,9 @@ func (tt *providerTest) setExpectationsForMachineConfigsVCenterValidation() {
 	for _, m := range tt.machineConfigs {
 		var b bool
 		tt.govc.EXPECT().ValidateVCenterSetupMachineConfig(tt.ctx, tt.datacenterConfig, m, &b).Return(nil)
+		if m.Spec.StoragePolicyName != "" {
+			tt.govc.EXPECT().StoragePolicyExists(tt.ctx, m.Spec.StoragePolicyName).Return(true, nil).MaxTimes(1)
+		}
 	}
 }
 
@@ -538,6 +577,7 @@ func setDefaultVSphereClientMock(vsc *govmomi_mocks.MockVSphereClient) error {
 	}
 
 	vsc.EXPECT().GetPrivsOnEntity(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(privs, nil).AnyTimes()
+	vsc.EXPECT().Close(gomock.Any()).Return(nil).AnyTimes()
 
 	return nil
 }
@@ -2634,3 +2674,122 @@ func TestVsphereProviderMachineConfigsManagedCluster(t *testing.T) {
 		}
 	}
 }
+
+func TestDefaulterCreateMissingResourcesDisabled(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	govc := mocks.NewMockProviderGovcClient(ctrl)
+	spec := NewSpec(givenClusterSpec(t, testClusterConfigMainFilename))
+
+	err := NewDefaulter(govc).createMissingResources(context.Background(), spec)
+	NewWithT(t).Expect(err).To(Succeed())
+}
+
+func TestDefaulterCreateMissingResources(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	govc := mocks.NewMockProviderGovcClient(ctrl)
+	spec := NewSpec(givenClusterSpec(t, testClusterConfigMainFilename))
+	spec.VSphereDatacenter.Spec.CreateMissingResources = true
+	datacenter := spec.VSphereDatacenter.Spec.Datacenter
+	for _, m := range spec.VSphereMachineConfigs {
+		m.Spec.Folder = "/SDDC-Datacenter/vm/eksa/test"
+		m.Spec.ResourcePool = "Cluster-1/Resources/eksa"
+	}
+
+	govc.EXPECT().FolderExists(ctx, datacenter, "/SDDC-Datacenter/vm/eksa/test").Return(false, nil)
+	govc.EXPECT().CreateFolder(ctx, datacenter, "/SDDC-Datacenter/vm/eksa/test").Return(nil)
+	govc.EXPECT().ResourcePoolExists(ctx, datacenter, "Cluster-1/Resources/eksa").Return(false, nil)
+	govc.EXPECT().CreateResourcePool(ctx, datacenter, "Cluster-1/Resources/eksa").Return(nil)
+
+	g.Expect(NewDefaulter(govc).createMissingResources(ctx, spec)).To(Succeed())
+}
+
+func TestDefaulterCreateMissingResourcesError(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	govc := mocks.NewMockProviderGovcClient(ctrl)
+	spec := NewSpec(givenClusterSpec(t, testClusterConfigMainFilename))
+	spec.VSphereDatacenter.Spec.CreateMissingResources = true
+	datacenter := spec.VSphereDatacenter.Spec.Datacenter
+
+	govc.EXPECT().FolderExists(ctx, datacenter, "/SDDC-Datacenter/vm").Return(true, nil)
+	govc.EXPECT().ResourcePoolExists(ctx, datacenter, "*/Resources").Return(false, nil)
+	govc.EXPECT().CreateResourcePool(ctx, datacenter, "*/Resources").Return(errors.New("path contains a wildcard"))
+
+	g.Expect(NewDefaulter(govc).createMissingResources(ctx, spec)).To(MatchError("path contains a wildcard"))
+}
+
+func TestDefaulterDeployTemplateFromLibraryIfMissingNoLibraryItem(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	govc := mocks.NewMockProviderGovcClient(ctrl)
+	spec := NewSpec(givenClusterSpec(t, testClusterConfigMainFilename))
+	machineConfig := spec.controlPlaneMachineConfig()
+
+	err := NewDefaulter(govc).deployTemplateFromLibraryIfMissing(context.Background(), spec, machineConfig)
+	NewWithT(t).Expect(err).To(Succeed())
+}
+
+func TestDefaulterDeployTemplateFromLibraryIfMissingTemplateExists(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	govc := mocks.NewMockProviderGovcClient(ctrl)
+	spec := NewSpec(givenClusterSpec(t, testClusterConfigMainFilename))
+	machineConfig := spec.controlPlaneMachineConfig()
+	machineConfig.Spec.Template = "library://golden-images/ubuntu-2204-kube-1-30"
+	datacenter := spec.VSphereDatacenter.Spec.Datacenter
+	template := "/SDDC-Datacenter/vm/Templates/ubuntu-2204-kube-1-30"
+
+	govc.EXPECT().SearchTemplate(ctx, datacenter, template).Return(template, nil)
+
+	g.Expect(NewDefaulter(govc).deployTemplateFromLibraryIfMissing(ctx, spec, machineConfig)).To(Succeed())
+	g.Expect(machineConfig.Spec.Template).To(Equal(template))
+}
+
+func TestDefaulterImportTemplateFromOVAIfMissingNoOVA(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	govc := mocks.NewMockProviderGovcClient(ctrl)
+	spec := NewSpec(givenClusterSpec(t, testClusterConfigMainFilename))
+	machineConfig := spec.controlPlaneMachineConfig()
+
+	err := NewDefaulter(govc).importTemplateFromOVAIfMissing(context.Background(), spec, machineConfig)
+	NewWithT(t).Expect(err).To(Succeed())
+}
+
+func TestDefaulterImportTemplateFromOVAIfMissingNoDownloadDir(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	govc := mocks.NewMockProviderGovcClient(ctrl)
+	spec := NewSpec(givenClusterSpec(t, testClusterConfigMainFilename))
+	machineConfig := spec.controlPlaneMachineConfig()
+	machineConfig.Spec.OVA = &v1alpha1.VSphereTemplateOVA{
+		URL:    "https://example.com/ovas/ubuntu-2204-kube-1-30.ova",
+		SHA256: "63a8dce1683379cb8df7d15e9c5adf9462a2b9803a544dd79b16f19a4657967f",
+	}
+
+	err := NewDefaulter(govc).importTemplateFromOVAIfMissing(context.Background(), spec, machineConfig)
+	NewWithT(t).Expect(err).To(MatchError(ContainSubstring("ova download folder is not configured")))
+}
+
+func TestDefaulterImportTemplateFromOVAIfMissingTemplateExists(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	govc := mocks.NewMockProviderGovcClient(ctrl)
+	spec := NewSpec(givenClusterSpec(t, testClusterConfigMainFilename))
+	machineConfig := spec.controlPlaneMachineConfig()
+	machineConfig.Spec.Template = ""
+	machineConfig.Spec.OVA = &v1alpha1.VSphereTemplateOVA{
+		URL:    "https://example.com/ovas/ubuntu-2204-kube-1-30.ova",
+		SHA256: "63a8dce1683379cb8df7d15e9c5adf9462a2b9803a544dd79b16f19a4657967f",
+	}
+	datacenter := spec.VSphereDatacenter.Spec.Datacenter
+	template := "/SDDC-Datacenter/vm/Templates/ubuntu-2204-kube-1-30-63a8dce"
+
+	govc.EXPECT().SearchTemplate(ctx, datacenter, template).Return(template, nil)
+
+	d := NewDefaulter(govc, WithOVADownloadDir(t.TempDir()))
+	g.Expect(d.importTemplateFromOVAIfMissing(ctx, spec, machineConfig)).To(Succeed())
+	g.Expect(machineConfig.Spec.Template).To(Equal(template))
+}
@@ -35,6 +35,11 @@ func TestToAPIEndpoint(t *testing.T) {
 			URL:  "registry-mirror.test:443/namespace",
 			want: "registry-mirror.test:443/v2/namespace",
 		},
+		{
+			name: "ipv6 with namespace",
+			URL:  "[fd00::20]:443/namespace",
+			want: "[fd00::20]:443/v2/namespace",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -200,6 +200,26 @@ func TestFromClusterRegistryMirrorConfiguration(t *testing.T) {
 				Auth: false,
 			},
 		},
+		{
+			testName: "ipv6 endpoint",
+			config: &v1alpha1.RegistryMirrorConfiguration{
+				Endpoint: "fd00::20",
+				Port:     "443",
+				OCINamespaces: []v1alpha1.OCINamespace{
+					{
+						Registry:  "public.ecr.aws",
+						Namespace: "eks-anywhere",
+					},
+				},
+			},
+			want: &registrymirror.RegistryMirror{
+				BaseRegistry: "[fd00::20]:443",
+				NamespacedRegistryMap: map[string]string{
+					constants.DefaultCoreEKSARegistry: "[fd00::20]:443/eks-anywhere",
+				},
+				Auth: false,
+			},
+		},
 	}
 	for _, tt := range testCases {
 		t.Run(tt.testName, func(t *testing.T) {
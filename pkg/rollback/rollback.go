@@ -0,0 +1,135 @@
+package rollback
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/clients/kubernetes"
+	"github.com/aws/eks-anywhere/pkg/constants"
+	releasev1 "github.com/aws/eks-anywhere/release/api/v1alpha1"
+)
+
+// Plan is the rollback of a cluster to the spec it had before an EKS Anywhere version upgrade. Reverting
+// the spec, including the EKS Anywhere version, makes the controller regenerate the CAPI objects of the
+// cluster with the templates and the Bundles of that version, which are still stored in the management cluster.
+type Plan struct {
+	// Cluster is the Cluster object with the rolled back spec.
+	Cluster *v1alpha1.Cluster
+
+	// From is the EKS Anywhere version the cluster is rolled back from.
+	From v1alpha1.EksaVersion
+
+	// To is the EKS Anywhere version the cluster is rolled back to.
+	To v1alpha1.EksaVersion
+}
+
+// NewPlan builds the rollback Plan for a cluster from its ClusterHistory. If an upgrade hasn't completed,
+// the cluster is rolled back to the spec it was last ready with. Otherwise, the last completed EKS Anywhere
+// version upgrade is reverted. The client must point to the management cluster.
+func NewPlan(ctx context.Context, client kubernetes.Client, clusterName, namespace string) (*Plan, error) {
+	cluster := &v1alpha1.Cluster{}
+	if err := client.Get(ctx, clusterName, namespace, cluster); err != nil {
+		return nil, fmt.Errorf("reading cluster %s: %v", clusterName, err)
+	}
+
+	history := &v1alpha1.ClusterHistory{}
+	if err := client.Get(ctx, clusterName, namespace, history); err != nil {
+		return nil, fmt.Errorf("reading history of cluster %s: %v", clusterName, err)
+	}
+
+	p, err := planFromHistory(cluster, history)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validateReleaseAvailable(ctx, client, p.To); err != nil {
+		return nil, err
+	}
+
+	return p, nil
+}
+
+// Apply updates the Cluster object in the management cluster with the rolled back spec.
+func (p *Plan) Apply(ctx context.Context, client kubernetes.Client) error {
+	if err := client.Update(ctx, p.Cluster); err != nil {
+		return fmt.Errorf("updating cluster %s: %v", p.Cluster.Name, err)
+	}
+
+	return nil
+}
+
+func planFromHistory(cluster *v1alpha1.Cluster, history *v1alpha1.ClusterHistory) (*Plan, error) {
+	if cluster.IsSelfManaged() {
+		return nil, errors.New("rollback is only supported for workload clusters, the management components of a self-managed cluster can't be rolled back")
+	}
+
+	if cluster.Spec.EksaVersion == nil {
+		return nil, fmt.Errorf("cluster %s doesn't have an EKS Anywhere version", cluster.Name)
+	}
+
+	observed := history.Status.Observed
+	if observed == nil {
+		return nil, fmt.Errorf("no state recorded for cluster %s, it has never been ready", cluster.Name)
+	}
+
+	from := *cluster.Spec.EksaVersion
+	var spec *v1alpha1.ClusterSpec
+	if string(from) != observed.EksaVersion {
+		// The upgrade hasn't completed, go back to the spec the cluster was last ready with.
+		spec = observed.Spec
+	} else {
+		if lastEksaVersionUpgrade(history) == nil {
+			return nil, fmt.Errorf("no EKS Anywhere version upgrade recorded for cluster %s", cluster.Name)
+		}
+		spec = history.Status.PreviousSpec
+	}
+
+	if spec == nil || spec.EksaVersion == nil || *spec.EksaVersion == "" {
+		return nil, fmt.Errorf("the previous spec of cluster %s is unknown", cluster.Name)
+	}
+
+	if cluster.Spec.KubernetesVersion != spec.KubernetesVersion {
+		return nil, fmt.Errorf(
+			"the Kubernetes version upgrade from %s to %s can't be rolled back: Kubernetes doesn't support downgrades",
+			spec.KubernetesVersion, cluster.Spec.KubernetesVersion,
+		)
+	}
+
+	rolledBack := cluster.DeepCopy()
+	rolledBack.Spec = *spec.DeepCopy()
+	// Reverting to a lower EKS Anywhere version is rejected by the version skew check.
+	rolledBack.DisableEksaVersionSkewCheck()
+
+	return &Plan{
+		Cluster: rolledBack,
+		From:    from,
+		To:      *spec.EksaVersion,
+	}, nil
+}
+
+func lastEksaVersionUpgrade(history *v1alpha1.ClusterHistory) *v1alpha1.ClusterHistoryEvent {
+	for i := len(history.Status.Events) - 1; i >= 0; i-- {
+		if history.Status.Events[i].Type == v1alpha1.EksaVersionUpgradedEvent {
+			return &history.Status.Events[i]
+		}
+	}
+
+	return nil
+}
+
+func validateReleaseAvailable(ctx context.Context, client kubernetes.Client, version v1alpha1.EksaVersion) error {
+	release := &releasev1.EKSARelease{}
+	releaseName := releasev1.GenerateEKSAReleaseName(string(version))
+	if err := client.Get(ctx, releaseName, constants.EksaSystemNamespace, release); err != nil {
+		return fmt.Errorf("reading EKSARelease for version %s: %v", version, err)
+	}
+
+	bundles := &releasev1.Bundles{}
+	if err := client.Get(ctx, release.Spec.BundlesRef.Name, release.Spec.BundlesRef.Namespace, bundles); err != nil {
+		return fmt.Errorf("reading Bundles for version %s: %v", version, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,191 @@
+package rollback_test
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/aws/eks-anywhere/internal/test"
+	"github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/constants"
+	"github.com/aws/eks-anywhere/pkg/rollback"
+	releasev1 "github.com/aws/eks-anywhere/release/api/v1alpha1"
+)
+
+func workloadCluster(eksaVersion string) *v1alpha1.Cluster {
+	version := v1alpha1.EksaVersion(eksaVersion)
+	return &v1alpha1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "workload", Namespace: "default"},
+		Spec: v1alpha1.ClusterSpec{
+			KubernetesVersion: v1alpha1.Kube129,
+			EksaVersion:       &version,
+			ManagementCluster: v1alpha1.ManagementCluster{Name: "mgmt"},
+		},
+	}
+}
+
+func clusterHistory(observedEksaVersion string, events ...v1alpha1.ClusterHistoryEvent) *v1alpha1.ClusterHistory {
+	history := &v1alpha1.ClusterHistory{
+		ObjectMeta: metav1.ObjectMeta{Name: "workload", Namespace: "default"},
+		Status: v1alpha1.ClusterHistoryStatus{
+			Events: events,
+			Observed: &v1alpha1.ClusterHistoryObservedState{
+				KubernetesVersion: v1alpha1.Kube129,
+				EksaVersion:       observedEksaVersion,
+				Spec:              &workloadCluster(observedEksaVersion).Spec,
+			},
+		},
+	}
+
+	// The spec before the last upgrade is recorded with it.
+	for i := len(events) - 1; i >= 0; i-- {
+		if events[i].Type == v1alpha1.EksaVersionUpgradedEvent {
+			history.Status.PreviousSpec = &workloadCluster(events[i].From).Spec
+			break
+		}
+	}
+
+	return history
+}
+
+func release(version string) []client.Object {
+	return []client.Object{
+		&releasev1.EKSARelease{
+			ObjectMeta: metav1.ObjectMeta{Name: releasev1.GenerateEKSAReleaseName(version), Namespace: constants.EksaSystemNamespace},
+			Spec: releasev1.EKSAReleaseSpec{
+				Version:    version,
+				BundlesRef: releasev1.BundlesRef{Name: "bundles-" + version, Namespace: constants.EksaSystemNamespace},
+			},
+		},
+		&releasev1.Bundles{
+			ObjectMeta: metav1.ObjectMeta{Name: "bundles-" + version, Namespace: constants.EksaSystemNamespace},
+		},
+	}
+}
+
+func upgradeEvent(from, to string) v1alpha1.ClusterHistoryEvent {
+	return v1alpha1.ClusterHistoryEvent{Type: v1alpha1.EksaVersionUpgradedEvent, From: from, To: to}
+}
+
+func TestNewPlanCompletedUpgrade(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	objs := append(release("v0.19.0"),
+		workloadCluster("v0.20.0"),
+		clusterHistory("v0.20.0", upgradeEvent("v0.18.0", "v0.19.0"), upgradeEvent("v0.19.0", "v0.20.0")),
+	)
+	c := test.NewFakeKubeClient(objs...)
+
+	p, err := rollback.NewPlan(ctx, c, "workload", "default")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(p.From).To(Equal(v1alpha1.EksaVersion("v0.20.0")))
+	g.Expect(p.To).To(Equal(v1alpha1.EksaVersion("v0.19.0")))
+	g.Expect(*p.Cluster.Spec.EksaVersion).To(Equal(v1alpha1.EksaVersion("v0.19.0")))
+	g.Expect(p.Cluster.EksaVersionSkewCheckDisabled()).To(BeTrue())
+
+	g.Expect(p.Apply(ctx, c)).To(Succeed())
+	cluster := &v1alpha1.Cluster{}
+	g.Expect(c.Get(ctx, "workload", "default", cluster)).To(Succeed())
+	g.Expect(*cluster.Spec.EksaVersion).To(Equal(v1alpha1.EksaVersion("v0.19.0")))
+}
+
+func TestNewPlanRestoresPreviousSpec(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	upgraded := workloadCluster("v0.20.0")
+	upgraded.Spec.WorkerNodeGroupConfigurations = []v1alpha1.WorkerNodeGroupConfiguration{{Name: "md-0", Labels: map[string]string{"upgraded": "true"}}}
+	history := clusterHistory("v0.20.0", upgradeEvent("v0.19.0", "v0.20.0"))
+	history.Status.PreviousSpec.WorkerNodeGroupConfigurations = []v1alpha1.WorkerNodeGroupConfiguration{{Name: "md-0"}}
+	c := test.NewFakeKubeClient(append(release("v0.19.0"), upgraded, history)...)
+
+	p, err := rollback.NewPlan(ctx, c, "workload", "default")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(p.Cluster.Spec).To(Equal(*history.Status.PreviousSpec))
+	g.Expect(p.Cluster.EksaVersionSkewCheckDisabled()).To(BeTrue())
+}
+
+func TestNewPlanFailedUpgrade(t *testing.T) {
+	g := NewWithT(t)
+	objs := append(release("v0.19.0"),
+		workloadCluster("v0.20.0"),
+		clusterHistory("v0.19.0", upgradeEvent("v0.18.0", "v0.19.0")),
+	)
+
+	p, err := rollback.NewPlan(context.Background(), test.NewFakeKubeClient(objs...), "workload", "default")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(p.To).To(Equal(v1alpha1.EksaVersion("v0.19.0")))
+}
+
+func TestNewPlanErrors(t *testing.T) {
+	selfManaged := workloadCluster("v0.20.0")
+	selfManaged.Spec.ManagementCluster.Name = "workload"
+	kubernetesUpgraded := workloadCluster("v0.20.0")
+	kubernetesUpgraded.Spec.KubernetesVersion = v1alpha1.Kube130
+	noObserved := clusterHistory("v0.20.0")
+	noObserved.Status.Observed = nil
+	noPreviousSpec := clusterHistory("v0.20.0", upgradeEvent("v0.19.0", "v0.20.0"))
+	noPreviousSpec.Status.PreviousSpec = nil
+	previousKubernetesVersion := clusterHistory("v0.20.0", upgradeEvent("v0.19.0", "v0.20.0"))
+	previousKubernetesVersion.Status.PreviousSpec.KubernetesVersion = v1alpha1.Kube128
+
+	tests := []struct {
+		name    string
+		objs    []client.Object
+		wantErr string
+	}{
+		{
+			name:    "no cluster",
+			wantErr: "reading cluster workload",
+		},
+		{
+			name:    "no history",
+			objs:    []client.Object{workloadCluster("v0.20.0")},
+			wantErr: "reading history of cluster workload",
+		},
+		{
+			name:    "self-managed cluster",
+			objs:    []client.Object{selfManaged, clusterHistory("v0.20.0")},
+			wantErr: "only supported for workload clusters",
+		},
+		{
+			name:    "never ready",
+			objs:    []client.Object{workloadCluster("v0.20.0"), noObserved},
+			wantErr: "it has never been ready",
+		},
+		{
+			name:    "kubernetes version upgrade",
+			objs:    []client.Object{kubernetesUpgraded, clusterHistory("v0.19.0")},
+			wantErr: "Kubernetes doesn't support downgrades",
+		},
+		{
+			name:    "kubernetes version upgraded with the last upgrade",
+			objs:    []client.Object{workloadCluster("v0.20.0"), previousKubernetesVersion},
+			wantErr: "upgrade from 1.28 to 1.29 can't be rolled back",
+		},
+		{
+			name:    "no previous spec",
+			objs:    []client.Object{workloadCluster("v0.20.0"), noPreviousSpec},
+			wantErr: "the previous spec of cluster workload is unknown",
+		},
+		{
+			name:    "no upgrade",
+			objs:    []client.Object{workloadCluster("v0.20.0"), clusterHistory("v0.20.0")},
+			wantErr: "no EKS Anywhere version upgrade recorded",
+		},
+		{
+			name:    "release not available",
+			objs:    []client.Object{workloadCluster("v0.20.0"), clusterHistory("v0.20.0", upgradeEvent("v0.19.0", "v0.20.0"))},
+			wantErr: "reading EKSARelease for version v0.19.0",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			_, err := rollback.NewPlan(context.Background(), test.NewFakeKubeClient(tt.objs...), "workload", "default")
+			g.Expect(err).To(MatchError(ContainSubstring(tt.wantErr)))
+		})
+	}
+}
@@ -0,0 +1,74 @@
+package serviceloadbalancer
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/cluster"
+	"github.com/aws/eks-anywhere/pkg/controller"
+	"github.com/aws/eks-anywhere/pkg/controller/clientutil"
+	"github.com/aws/eks-anywhere/pkg/controller/clusters"
+	"github.com/aws/eks-anywhere/pkg/controller/serverside"
+)
+
+// RemoteClientRegistry gets clients for remote clusters.
+type RemoteClientRegistry interface {
+	GetClient(ctx context.Context, cluster client.ObjectKey) (client.Client, error)
+}
+
+// Reconciler installs kube-vip in services mode on the clusters with a service load balancer
+// configuration and keeps it in sync with it.
+type Reconciler struct {
+	client               client.Client
+	remoteClientRegistry RemoteClientRegistry
+}
+
+// New returns a new Reconciler.
+func New(client client.Client, remoteClientRegistry RemoteClientRegistry) *Reconciler {
+	return &Reconciler{
+		client:               client,
+		remoteClientRegistry: remoteClientRegistry,
+	}
+}
+
+// Reconcile applies the service load balancer manifest to the cluster, once its control plane
+// is ready. It's a no-op for clusters without a service load balancer configuration.
+func (r *Reconciler) Reconcile(ctx context.Context, log logr.Logger, eksaCluster *anywherev1.Cluster) (controller.Result, error) {
+	if eksaCluster.Spec.ServiceLoadBalancer == nil {
+		return controller.Result{}, nil
+	}
+
+	clusterSpec, err := cluster.BuildSpec(ctx, clientutil.NewKubeClient(r.client), eksaCluster)
+	if err != nil {
+		return controller.Result{}, err
+	}
+
+	result, err := clusters.CheckControlPlaneReady(ctx, r.client, log, eksaCluster)
+	if err != nil {
+		return controller.Result{}, errors.Wrap(err, "checking controlplane ready")
+	}
+	if result.Return() {
+		return result, nil
+	}
+
+	manifest, err := GenerateManifest(clusterSpec)
+	if err != nil {
+		return controller.Result{}, err
+	}
+
+	remoteClient, err := r.remoteClientRegistry.GetClient(ctx, controller.CapiClusterObjectKey(eksaCluster))
+	if err != nil {
+		return controller.Result{}, errors.Wrap(err, "getting workload cluster's client to reconcile the service load balancer")
+	}
+
+	log.Info("Applying service load balancer manifest")
+	if err := serverside.ReconcileYaml(ctx, remoteClient, manifest); err != nil {
+		return controller.Result{}, errors.Wrap(err, "applying service load balancer manifest")
+	}
+
+	return controller.Result{}, nil
+}
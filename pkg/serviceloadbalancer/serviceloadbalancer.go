@@ -0,0 +1,75 @@
+// Package serviceloadbalancer installs kube-vip in services mode on EKS Anywhere clusters, so
+// Services of type LoadBalancer get an address from the pool in the cluster spec.
+package serviceloadbalancer
+
+import (
+	_ "embed"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/aws/eks-anywhere/pkg/cluster"
+	"github.com/aws/eks-anywhere/pkg/registrymirror"
+	"github.com/aws/eks-anywhere/pkg/templater"
+)
+
+const (
+	// Namespace is the namespace kube-vip and its cloud provider are installed in.
+	Namespace = "kube-system"
+
+	// LoadBalancerClass is the load balancer class handled by the kube-vip instance managed by
+	// EKS Anywhere, besides Services without a class. It's different from the kube-vip default
+	// one so it doesn't take over the Services handled by the Tinkerbell stack kube-vip.
+	LoadBalancerClass = "anywhere.eks.amazonaws.com/kube-vip"
+)
+
+//go:embed config/kube-vip.yaml
+var kubeVipTemplate string
+
+// GenerateManifest generates the manifest that installs kube-vip in services mode and the
+// kube-vip cloud provider with the address pool in the cluster spec.
+func GenerateManifest(spec *cluster.Spec) ([]byte, error) {
+	config := spec.Cluster.Spec.ServiceLoadBalancer
+	if config == nil || config.KubeVip == nil {
+		return nil, errors.New("cluster doesn't have a kube-vip service load balancer configuration")
+	}
+
+	bundle := spec.RootVersionsBundle()
+	if bundle == nil || bundle.ServiceLoadBalancer == nil {
+		return nil, errors.New("the EKS Anywhere bundle for the cluster doesn't include the service load balancer, please upgrade to a release that supports it")
+	}
+
+	mirror := registrymirror.FromCluster(spec.Cluster)
+	cidrs, ranges := splitAddressPool(config.KubeVip.AddressPool)
+
+	values := map[string]interface{}{
+		"namespace":          Namespace,
+		"kubeVipImage":       mirror.ReplaceRegistry(bundle.ServiceLoadBalancer.KubeVip.VersionedImage()),
+		"cloudProviderImage": mirror.ReplaceRegistry(bundle.ServiceLoadBalancer.CloudProvider.VersionedImage()),
+		"loadBalancerClass":  LoadBalancerClass,
+		"cidrs":              strings.Join(cidrs, ","),
+		"ranges":             strings.Join(ranges, ","),
+	}
+
+	manifest, err := templater.Execute(kubeVipTemplate, values)
+	if err != nil {
+		return nil, fmt.Errorf("generating service load balancer manifest: %v", err)
+	}
+
+	return manifest, nil
+}
+
+// splitAddressPool separates the CIDRs from the IP ranges, since the kube-vip cloud provider
+// reads them from different keys.
+func splitAddressPool(pool []string) (cidrs, ranges []string) {
+	for _, entry := range pool {
+		entry = strings.ReplaceAll(entry, " ", "")
+		if strings.Contains(entry, "/") {
+			cidrs = append(cidrs, entry)
+		} else {
+			ranges = append(ranges, entry)
+		}
+	}
+
+	return cidrs, ranges
+}
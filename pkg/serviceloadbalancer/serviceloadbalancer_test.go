@@ -0,0 +1,79 @@
+package serviceloadbalancer_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/aws/eks-anywhere/internal/test"
+	"github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/cluster"
+	"github.com/aws/eks-anywhere/pkg/serviceloadbalancer"
+	releasev1alpha1 "github.com/aws/eks-anywhere/release/api/v1alpha1"
+)
+
+func serviceLoadBalancerSpec(opts ...test.ClusterSpecOpt) *cluster.Spec {
+	return test.NewClusterSpec(append([]test.ClusterSpecOpt{
+		func(s *cluster.Spec) {
+			s.Cluster.Name = "test-cluster"
+			s.Cluster.Spec.ControlPlaneConfiguration.Endpoint = &v1alpha1.Endpoint{Host: "1.2.3.4"}
+			s.Cluster.Spec.ServiceLoadBalancer = &v1alpha1.ServiceLoadBalancerConfiguration{
+				KubeVip: &v1alpha1.KubeVipServiceLoadBalancer{
+					AddressPool: []string{"10.0.0.64/28", "10.0.0.100-10.0.0.120", "10.0.1.0/28"},
+				},
+			}
+			s.VersionsBundles["1.19"].ServiceLoadBalancer = &releasev1alpha1.ServiceLoadBalancerBundle{
+				Version: "v0.8.9",
+				KubeVip: releasev1alpha1.Image{
+					URI: "public.ecr.aws/eks-anywhere/kube-vip/kube-vip:v0.8.9-eks-a-1",
+				},
+				CloudProvider: releasev1alpha1.Image{
+					URI: "public.ecr.aws/eks-anywhere/kube-vip/kube-vip-cloud-provider:v0.0.10-eks-a-1",
+				},
+			}
+		},
+	}, opts...)...)
+}
+
+func TestGenerateManifest(t *testing.T) {
+	g := NewWithT(t)
+
+	manifest, err := serviceloadbalancer.GenerateManifest(serviceLoadBalancerSpec())
+	g.Expect(err).NotTo(HaveOccurred())
+	test.AssertContentToFile(t, string(manifest), "testdata/expected_results_kube_vip.yaml")
+}
+
+func TestGenerateManifestRegistryMirrorOnlyRanges(t *testing.T) {
+	g := NewWithT(t)
+	spec := serviceLoadBalancerSpec(func(s *cluster.Spec) {
+		s.Cluster.Spec.RegistryMirrorConfiguration = &v1alpha1.RegistryMirrorConfiguration{
+			Endpoint: "1.2.3.4",
+			Port:     "443",
+		}
+		s.Cluster.Spec.ServiceLoadBalancer.KubeVip.AddressPool = []string{"10.0.0.100 - 10.0.0.120"}
+	})
+
+	manifest, err := serviceloadbalancer.GenerateManifest(spec)
+	g.Expect(err).NotTo(HaveOccurred())
+	test.AssertContentToFile(t, string(manifest), "testdata/expected_results_kube_vip_mirror_ranges.yaml")
+}
+
+func TestGenerateManifestNoBundle(t *testing.T) {
+	g := NewWithT(t)
+	spec := serviceLoadBalancerSpec(func(s *cluster.Spec) {
+		s.VersionsBundles["1.19"].ServiceLoadBalancer = nil
+	})
+
+	_, err := serviceloadbalancer.GenerateManifest(spec)
+	g.Expect(err).To(MatchError(ContainSubstring("doesn't include the service load balancer")))
+}
+
+func TestGenerateManifestNoConfiguration(t *testing.T) {
+	g := NewWithT(t)
+	spec := serviceLoadBalancerSpec(func(s *cluster.Spec) {
+		s.Cluster.Spec.ServiceLoadBalancer = nil
+	})
+
+	_, err := serviceloadbalancer.GenerateManifest(spec)
+	g.Expect(err).To(MatchError("cluster doesn't have a kube-vip service load balancer configuration"))
+}
@@ -0,0 +1,91 @@
+package spire
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/cluster"
+	"github.com/aws/eks-anywhere/pkg/controller"
+	"github.com/aws/eks-anywhere/pkg/controller/clientutil"
+	"github.com/aws/eks-anywhere/pkg/controller/clusters"
+	"github.com/aws/eks-anywhere/pkg/controller/serverside"
+)
+
+// RemoteClientRegistry gets clients for remote clusters.
+type RemoteClientRegistry interface {
+	GetClient(ctx context.Context, cluster client.ObjectKey) (client.Client, error)
+}
+
+// Reconciler installs SPIRE on the clusters with a SPIRE configuration and keeps it in sync with it.
+type Reconciler struct {
+	client               client.Client
+	remoteClientRegistry RemoteClientRegistry
+}
+
+// New returns a new Reconciler.
+func New(client client.Client, remoteClientRegistry RemoteClientRegistry) *Reconciler {
+	return &Reconciler{
+		client:               client,
+		remoteClientRegistry: remoteClientRegistry,
+	}
+}
+
+// Reconcile applies the SPIRE manifest and the node CA bundle to the cluster, once its control
+// plane is ready. It's a no-op for clusters without a SPIRE configuration.
+func (r *Reconciler) Reconcile(ctx context.Context, log logr.Logger, eksaCluster *anywherev1.Cluster) (controller.Result, error) {
+	config := eksaCluster.Spec.Spire
+	if config == nil {
+		return controller.Result{}, nil
+	}
+
+	clusterSpec, err := cluster.BuildSpec(ctx, clientutil.NewKubeClient(r.client), eksaCluster)
+	if err != nil {
+		return controller.Result{}, err
+	}
+
+	result, err := clusters.CheckControlPlaneReady(ctx, r.client, log, eksaCluster)
+	if err != nil {
+		return controller.Result{}, errors.Wrap(err, "checking controlplane ready")
+	}
+	if result.Return() {
+		return result, nil
+	}
+
+	var caBundle map[string][]byte
+	if config.CABundleSecretName != "" {
+		secret := &corev1.Secret{}
+		key := client.ObjectKey{Name: config.CABundleSecretName, Namespace: eksaCluster.Namespace}
+		if err := r.client.Get(ctx, key, secret); err != nil {
+			return controller.Result{}, errors.Wrapf(err, "reading spire CA bundle secret %s", key)
+		}
+		caBundle = secret.Data
+	}
+
+	manifest, err := GenerateManifest(clusterSpec, caBundle)
+	if err != nil {
+		return controller.Result{}, err
+	}
+
+	remoteClient, err := r.remoteClientRegistry.GetClient(ctx, controller.CapiClusterObjectKey(eksaCluster))
+	if err != nil {
+		return controller.Result{}, errors.Wrap(err, "getting workload cluster's client to reconcile spire")
+	}
+
+	log.Info("Applying spire manifest", "nodeAttestor", config.Attestor())
+	if err := serverside.ReconcileYaml(ctx, remoteClient, manifest); err != nil {
+		return controller.Result{}, errors.Wrap(err, "applying spire manifest")
+	}
+
+	if needsCABundle(config.Attestor()) {
+		if err := serverside.ReconcileObject(ctx, remoteClient, NodeCASecret(caBundle)); err != nil {
+			return controller.Result{}, errors.Wrap(err, "applying spire node CA bundle")
+		}
+	}
+
+	return controller.Result{}, nil
+}
@@ -0,0 +1,210 @@
+package spire_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	eksdv1 "github.com/aws/eks-distro-build-tooling/release/api/v1alpha1"
+	"github.com/go-logr/logr"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	controlplanev1beta2 "sigs.k8s.io/cluster-api/api/controlplane/kubeadm/v1beta2"
+	clusterv1beta2 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/aws/eks-anywhere/internal/test"
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/controller"
+	"github.com/aws/eks-anywhere/pkg/spire"
+	"github.com/aws/eks-anywhere/pkg/spire/mocks"
+	releasev1 "github.com/aws/eks-anywhere/release/api/v1alpha1"
+)
+
+type reconcilerTest struct {
+	*WithT
+	ctx                  context.Context
+	remoteClientRegistry *mocks.MockRemoteClientRegistry
+	bundle               *releasev1.Bundles
+	cluster              *anywherev1.Cluster
+	kcp                  *controlplanev1beta2.KubeadmControlPlane
+	caBundle             *corev1.Secret
+}
+
+func newReconcilerTest(t testing.TB) *reconcilerTest {
+	ctrl := gomock.NewController(t)
+	bundle := test.Bundle()
+	for i := range bundle.Spec.VersionsBundles {
+		bundle.Spec.VersionsBundles[i].Spire = &releasev1.SpireBundle{
+			Version: "v1.11.2",
+			Server: releasev1.Image{
+				URI: "public.ecr.aws/eks-anywhere/spiffe/spire-server:v1.11.2",
+			},
+			Agent: releasev1.Image{
+				URI: "public.ecr.aws/eks-anywhere/spiffe/spire-agent:v1.11.2",
+			},
+		}
+	}
+	version := test.DevEksaVersion()
+	cluster := &anywherev1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-cluster",
+			Namespace: "eksa-system",
+		},
+		Spec: anywherev1.ClusterSpec{
+			KubernetesVersion: "1.22",
+			ControlPlaneConfiguration: anywherev1.ControlPlaneConfiguration{
+				Endpoint: &anywherev1.Endpoint{
+					Host: "1.2.3.4",
+				},
+			},
+			BundlesRef: &anywherev1.BundlesRef{
+				Name:       bundle.Name,
+				Namespace:  bundle.Namespace,
+				APIVersion: bundle.APIVersion,
+			},
+			Spire: &anywherev1.SpireConfiguration{
+				TrustDomain:        "example.org",
+				NodeAttestor:       anywherev1.X509PopSpireNodeAttestor,
+				CABundleSecretName: "node-ca",
+			},
+			EksaVersion: &version,
+		},
+	}
+	kcpVersion := "test"
+	kcp := test.KubeadmControlPlane(func(kcp *controlplanev1beta2.KubeadmControlPlane) {
+		kcp.Name = cluster.Name
+		kcp.Spec.Version = kcpVersion
+		kcp.Status = controlplanev1beta2.KubeadmControlPlaneStatus{
+			Conditions: []metav1.Condition{
+				{
+					Type:               clusterv1beta2.AvailableCondition,
+					Status:             metav1.ConditionTrue,
+					LastTransitionTime: metav1.NewTime(time.Now()),
+				},
+			},
+			Version:            kcpVersion,
+			ReadyReplicas:      ptr.To(int32(1)),
+			Replicas:           ptr.To(int32(1)),
+			ObservedGeneration: 1,
+		}
+		kcp.Generation = 1
+	})
+	caBundle := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "node-ca",
+			Namespace: cluster.Namespace,
+		},
+		Data: map[string][]byte{
+			"ca.crt": []byte("node-ca"),
+		},
+	}
+
+	return &reconcilerTest{
+		WithT:                NewWithT(t),
+		ctx:                  context.Background(),
+		remoteClientRegistry: mocks.NewMockRemoteClientRegistry(ctrl),
+		bundle:               bundle,
+		cluster:              cluster,
+		kcp:                  kcp,
+		caBundle:             caBundle,
+	}
+}
+
+func (tt *reconcilerTest) client(objs ...runtime.Object) client.Client {
+	scheme := runtime.NewScheme()
+	_ = releasev1.AddToScheme(scheme)
+	_ = eksdv1.AddToScheme(scheme)
+	_ = corev1.AddToScheme(scheme)
+	_ = controlplanev1beta2.AddToScheme(scheme)
+	return fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(objs...).Build()
+}
+
+func (tt *reconcilerTest) managementClient(objs ...runtime.Object) client.Client {
+	return tt.client(append([]runtime.Object{tt.bundle, test.EksdRelease("1-22"), test.EKSARelease()}, objs...)...)
+}
+
+func nullLog() logr.Logger {
+	return logr.New(logf.NullLogSink{})
+}
+
+func TestReconcileNoSpire(t *testing.T) {
+	tt := newReconcilerTest(t)
+	tt.cluster.Spec.Spire = nil
+	r := spire.New(tt.client(), tt.remoteClientRegistry)
+
+	result, err := r.Reconcile(tt.ctx, nullLog(), tt.cluster)
+	tt.Expect(err).NotTo(HaveOccurred())
+	tt.Expect(result).To(Equal(controller.Result{}))
+}
+
+func TestReconcileBuildClusterSpecError(t *testing.T) {
+	tt := newReconcilerTest(t)
+	r := spire.New(tt.client(), tt.remoteClientRegistry)
+
+	result, err := r.Reconcile(tt.ctx, nullLog(), tt.cluster)
+	tt.Expect(err).To(HaveOccurred())
+	tt.Expect(result).To(Equal(controller.Result{}))
+}
+
+func TestReconcileControlPlaneNotReady(t *testing.T) {
+	tt := newReconcilerTest(t)
+	r := spire.New(tt.managementClient(tt.caBundle), tt.remoteClientRegistry)
+
+	result, err := r.Reconcile(tt.ctx, nullLog(), tt.cluster)
+	tt.Expect(err).NotTo(HaveOccurred())
+	tt.Expect(result).To(Equal(controller.ResultWithRequeue(5 * time.Second)))
+}
+
+func TestReconcileMissingCABundleSecret(t *testing.T) {
+	tt := newReconcilerTest(t)
+	r := spire.New(tt.managementClient(tt.kcp), tt.remoteClientRegistry)
+
+	result, err := r.Reconcile(tt.ctx, nullLog(), tt.cluster)
+	tt.Expect(err).To(MatchError(ContainSubstring("reading spire CA bundle secret eksa-system/node-ca")))
+	tt.Expect(result).To(Equal(controller.Result{}))
+}
+
+func TestReconcileBundleWithoutSpire(t *testing.T) {
+	tt := newReconcilerTest(t)
+	for i := range tt.bundle.Spec.VersionsBundles {
+		tt.bundle.Spec.VersionsBundles[i].Spire = nil
+	}
+	r := spire.New(tt.managementClient(tt.kcp, tt.caBundle), tt.remoteClientRegistry)
+
+	result, err := r.Reconcile(tt.ctx, nullLog(), tt.cluster)
+	tt.Expect(err).To(MatchError(ContainSubstring("doesn't include SPIRE")))
+	tt.Expect(result).To(Equal(controller.Result{}))
+}
+
+func TestReconcileRemoteGetClientError(t *testing.T) {
+	tt := newReconcilerTest(t)
+	r := spire.New(tt.managementClient(tt.kcp, tt.caBundle), tt.remoteClientRegistry)
+	tt.remoteClientRegistry.EXPECT().
+		GetClient(tt.ctx, client.ObjectKey{Name: "my-cluster", Namespace: "eksa-system"}).
+		Return(nil, errors.New("client error"))
+
+	result, err := r.Reconcile(tt.ctx, nullLog(), tt.cluster)
+	tt.Expect(err).To(MatchError(ContainSubstring("client error")))
+	tt.Expect(result).To(Equal(controller.Result{}))
+}
+
+func TestReconcileApplyError(t *testing.T) {
+	tt := newReconcilerTest(t)
+	r := spire.New(tt.managementClient(tt.kcp, tt.caBundle), tt.remoteClientRegistry)
+	// The remote client doesn't know about RBAC objects, so applying the manifest fails.
+	tt.remoteClientRegistry.EXPECT().
+		GetClient(tt.ctx, gomock.AssignableToTypeOf(client.ObjectKey{})).
+		Return(fake.NewClientBuilder().WithScheme(runtime.NewScheme()).Build(), nil)
+
+	result, err := r.Reconcile(tt.ctx, nullLog(), tt.cluster)
+	tt.Expect(err).To(MatchError(ContainSubstring("applying spire manifest")))
+	tt.Expect(result).To(Equal(controller.Result{}))
+}
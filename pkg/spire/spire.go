@@ -0,0 +1,142 @@
+// Package spire installs a SPIRE server and agents on EKS Anywhere clusters, so the nodes are
+// attested and the workloads get SPIFFE identities from the SPIRE Workload API on every node.
+package spire
+
+import (
+	"crypto/sha256"
+	_ "embed"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sort"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/cluster"
+	"github.com/aws/eks-anywhere/pkg/registrymirror"
+	"github.com/aws/eks-anywhere/pkg/templater"
+)
+
+const (
+	// Namespace is the namespace SPIRE is installed in.
+	Namespace = "spire-system"
+
+	// NodeCASecretName is the name of the secret in Namespace with the CA certificates the SPIRE
+	// server verifies the node certificates against.
+	NodeCASecretName = "spire-node-ca"
+
+	// CACertKey is the key of the CA bundle secret with the CA certificates of the node certificates.
+	CACertKey = "ca.crt"
+	// EndorsementCACertKey is the key of the CA bundle secret with the CA certificates of the TPM
+	// endorsement keys, required by the tpm_devid node attestor.
+	EndorsementCACertKey = "endorsement-ca.crt"
+)
+
+//go:embed config/spire.yaml
+var spireTemplate string
+
+// GenerateManifest generates the manifest that installs SPIRE with the configuration in the cluster
+// spec. caBundle is the content of the CA bundle secret of the configuration, required by the
+// x509pop and tpm_devid node attestors, and used to roll out SPIRE when it changes.
+func GenerateManifest(spec *cluster.Spec, caBundle map[string][]byte) ([]byte, error) {
+	config := spec.Cluster.Spec.Spire
+	if config == nil {
+		return nil, errors.New("cluster doesn't have a spire configuration")
+	}
+
+	bundle := spec.RootVersionsBundle()
+	if bundle == nil || bundle.Spire == nil {
+		return nil, errors.New("the EKS Anywhere bundle for the cluster doesn't include SPIRE, please upgrade to a release that supports it")
+	}
+
+	attestor := config.Attestor()
+	if err := validateCABundle(attestor, caBundle); err != nil {
+		return nil, err
+	}
+
+	mirror := registrymirror.FromCluster(spec.Cluster)
+	values := map[string]interface{}{
+		"namespace":    Namespace,
+		"serverImage":  mirror.ReplaceRegistry(bundle.Spire.Server.VersionedImage()),
+		"agentImage":   mirror.ReplaceRegistry(bundle.Spire.Agent.VersionedImage()),
+		"trustDomain":  config.TrustDomain,
+		"clusterName":  spec.Cluster.Name,
+		"nodeAttestor": string(attestor),
+		"configHash":   hashConfig(config, attestor, caBundle),
+	}
+	if needsCABundle(attestor) {
+		values["nodeCASecretName"] = NodeCASecretName
+	}
+
+	manifest, err := templater.Execute(spireTemplate, values)
+	if err != nil {
+		return nil, fmt.Errorf("generating spire manifest: %v", err)
+	}
+
+	return manifest, nil
+}
+
+// NodeCASecret returns the secret the SPIRE server reads the CA certificates of the node
+// certificates from.
+func NodeCASecret(caBundle map[string][]byte) *corev1.Secret {
+	return &corev1.Secret{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "v1",
+			Kind:       "Secret",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      NodeCASecretName,
+			Namespace: Namespace,
+		},
+		Type: corev1.SecretTypeOpaque,
+		Data: caBundle,
+	}
+}
+
+func needsCABundle(attestor anywherev1.SpireNodeAttestor) bool {
+	return attestor != anywherev1.K8sPsatSpireNodeAttestor
+}
+
+func validateCABundle(attestor anywherev1.SpireNodeAttestor, caBundle map[string][]byte) error {
+	if !needsCABundle(attestor) {
+		return nil
+	}
+
+	required := []string{CACertKey}
+	if attestor == anywherev1.TPMDevIDSpireNodeAttestor {
+		required = append(required, EndorsementCACertKey)
+	}
+	for _, key := range required {
+		if len(caBundle[key]) == 0 {
+			return fmt.Errorf("spire CA bundle secret doesn't have the %s key required by the %s node attestor", key, attestor)
+		}
+	}
+
+	return nil
+}
+
+// hashConfig hashes the settings the SPIRE configuration files are rendered from, so the SPIRE
+// pods are rolled out when they change, since they don't reload their configuration.
+func hashConfig(config *anywherev1.SpireConfiguration, attestor anywherev1.SpireNodeAttestor, caBundle map[string][]byte) string {
+	h := sha256.New()
+	h.Write([]byte(config.TrustDomain))
+	h.Write([]byte{0})
+	h.Write([]byte(attestor))
+	h.Write([]byte{0})
+
+	keys := make([]string, 0, len(caBundle))
+	for k := range caBundle {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		h.Write([]byte(k))
+		h.Write([]byte{0})
+		h.Write(caBundle[k])
+		h.Write([]byte{0})
+	}
+
+	return hex.EncodeToString(h.Sum(nil))
+}
@@ -0,0 +1,133 @@
+package spire_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/aws/eks-anywhere/internal/test"
+	"github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/cluster"
+	"github.com/aws/eks-anywhere/pkg/spire"
+	releasev1alpha1 "github.com/aws/eks-anywhere/release/api/v1alpha1"
+)
+
+var caBundle = map[string][]byte{
+	"ca.crt":             []byte("node-ca"),
+	"endorsement-ca.crt": []byte("endorsement-ca"),
+}
+
+func spireSpec(opts ...test.ClusterSpecOpt) *cluster.Spec {
+	return test.NewClusterSpec(append([]test.ClusterSpecOpt{
+		func(s *cluster.Spec) {
+			s.Cluster.Name = "test-cluster"
+			s.Cluster.Spec.DatacenterRef = v1alpha1.Ref{Kind: v1alpha1.VSphereDatacenterKind, Name: "test-cluster"}
+			s.Cluster.Spec.Spire = &v1alpha1.SpireConfiguration{
+				TrustDomain: "example.org",
+			}
+			s.VersionsBundles["1.19"].Spire = &releasev1alpha1.SpireBundle{
+				Version: "v1.11.2",
+				Server: releasev1alpha1.Image{
+					URI: "public.ecr.aws/eks-anywhere/spiffe/spire-server:v1.11.2-eks-a-1",
+				},
+				Agent: releasev1alpha1.Image{
+					URI: "public.ecr.aws/eks-anywhere/spiffe/spire-agent:v1.11.2-eks-a-1",
+				},
+			}
+		},
+	}, opts...)...)
+}
+
+func TestGenerateManifestK8sPsat(t *testing.T) {
+	g := NewWithT(t)
+
+	manifest, err := spire.GenerateManifest(spireSpec(), nil)
+	g.Expect(err).NotTo(HaveOccurred())
+	test.AssertContentToFile(t, string(manifest), "testdata/expected_results_spire_k8s_psat.yaml")
+}
+
+func TestGenerateManifestTPMDevIDRegistryMirror(t *testing.T) {
+	g := NewWithT(t)
+	spec := spireSpec(func(s *cluster.Spec) {
+		s.Cluster.Spec.DatacenterRef.Kind = v1alpha1.TinkerbellDatacenterKind
+		s.Cluster.Spec.Spire.NodeAttestor = v1alpha1.TPMDevIDSpireNodeAttestor
+		s.Cluster.Spec.Spire.CABundleSecretName = "devid-ca"
+		s.Cluster.Spec.RegistryMirrorConfiguration = &v1alpha1.RegistryMirrorConfiguration{
+			Endpoint: "1.2.3.4",
+			Port:     "443",
+		}
+	})
+
+	manifest, err := spire.GenerateManifest(spec, caBundle)
+	g.Expect(err).NotTo(HaveOccurred())
+	test.AssertContentToFile(t, string(manifest), "testdata/expected_results_spire_tpm_devid_mirror.yaml")
+}
+
+func TestGenerateManifestX509Pop(t *testing.T) {
+	g := NewWithT(t)
+	spec := spireSpec(func(s *cluster.Spec) {
+		s.Cluster.Spec.Spire.NodeAttestor = v1alpha1.X509PopSpireNodeAttestor
+		s.Cluster.Spec.Spire.CABundleSecretName = "node-ca"
+	})
+
+	manifest, err := spire.GenerateManifest(spec, map[string][]byte{"ca.crt": []byte("node-ca")})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(string(manifest)).To(ContainSubstring(`NodeAttestor "x509pop"`))
+	g.Expect(string(manifest)).To(ContainSubstring("secretName: spire-node-ca"))
+	g.Expect(string(manifest)).To(ContainSubstring("path: /etc/spire/agent"))
+	g.Expect(string(manifest)).NotTo(ContainSubstring("k8s_psat"))
+}
+
+func TestGenerateManifestCABundleChange(t *testing.T) {
+	g := NewWithT(t)
+	spec := spireSpec(func(s *cluster.Spec) {
+		s.Cluster.Spec.Spire.NodeAttestor = v1alpha1.X509PopSpireNodeAttestor
+		s.Cluster.Spec.Spire.CABundleSecretName = "node-ca"
+	})
+
+	manifest, err := spire.GenerateManifest(spec, map[string][]byte{"ca.crt": []byte("node-ca")})
+	g.Expect(err).NotTo(HaveOccurred())
+	rotated, err := spire.GenerateManifest(spec, map[string][]byte{"ca.crt": []byte("rotated-node-ca")})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(rotated).NotTo(Equal(manifest))
+}
+
+func TestGenerateManifestMissingCABundleKey(t *testing.T) {
+	g := NewWithT(t)
+	spec := spireSpec(func(s *cluster.Spec) {
+		s.Cluster.Spec.Spire.NodeAttestor = v1alpha1.TPMDevIDSpireNodeAttestor
+		s.Cluster.Spec.Spire.CABundleSecretName = "devid-ca"
+	})
+
+	_, err := spire.GenerateManifest(spec, map[string][]byte{"ca.crt": []byte("node-ca")})
+	g.Expect(err).To(MatchError("spire CA bundle secret doesn't have the endorsement-ca.crt key required by the tpm_devid node attestor"))
+}
+
+func TestGenerateManifestNoBundle(t *testing.T) {
+	g := NewWithT(t)
+	spec := spireSpec(func(s *cluster.Spec) {
+		s.VersionsBundles["1.19"].Spire = nil
+	})
+
+	_, err := spire.GenerateManifest(spec, nil)
+	g.Expect(err).To(MatchError(ContainSubstring("doesn't include SPIRE")))
+}
+
+func TestGenerateManifestNoConfiguration(t *testing.T) {
+	g := NewWithT(t)
+	spec := spireSpec(func(s *cluster.Spec) {
+		s.Cluster.Spec.Spire = nil
+	})
+
+	_, err := spire.GenerateManifest(spec, nil)
+	g.Expect(err).To(MatchError("cluster doesn't have a spire configuration"))
+}
+
+func TestNodeCASecret(t *testing.T) {
+	g := NewWithT(t)
+
+	secret := spire.NodeCASecret(caBundle)
+	g.Expect(secret.Name).To(Equal(spire.NodeCASecretName))
+	g.Expect(secret.Namespace).To(Equal(spire.Namespace))
+	g.Expect(secret.Data).To(Equal(caBundle))
+}
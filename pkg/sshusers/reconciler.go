@@ -0,0 +1,315 @@
+package sshusers
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	bootstrapv1beta2 "sigs.k8s.io/cluster-api/api/bootstrap/kubeadm/v1beta2"
+	clusterv1beta2 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/cluster"
+	"github.com/aws/eks-anywhere/pkg/clusterapi"
+	"github.com/aws/eks-anywhere/pkg/constants"
+	"github.com/aws/eks-anywhere/pkg/controller"
+	"github.com/aws/eks-anywhere/pkg/controller/clientutil"
+	"github.com/aws/eks-anywhere/pkg/nodeupgrader"
+	"github.com/aws/eks-anywhere/pkg/providers/common"
+)
+
+const (
+	// UsersHashAnnotation is set on nodes after their ssh users have been patched in place.
+	// It holds a hash of the users applied to the node.
+	UsersHashAnnotation = "anywhere.eks.amazonaws.com/ssh-users-hash"
+
+	// UsersAnnotation is set on nodes after their ssh users have been patched in place.
+	// It holds a comma separated list of the names of the users applied to the node.
+	UsersAnnotation = "anywhere.eks.amazonaws.com/ssh-users"
+
+	patchRequeueTime = 10 * time.Second
+)
+
+// RemoteClientRegistry gets clients for remote clusters.
+type RemoteClientRegistry interface {
+	GetClient(ctx context.Context, cluster client.ObjectKey) (client.Client, error)
+}
+
+// Reconciler updates the ssh users of existing nodes in place when they change in the
+// machine configs, so key rotations don't require replacing machines.
+type Reconciler struct {
+	client               client.Client
+	remoteClientRegistry RemoteClientRegistry
+}
+
+// New returns a new Reconciler.
+func New(client client.Client, remoteClientRegistry RemoteClientRegistry) *Reconciler {
+	return &Reconciler{
+		client:               client,
+		remoteClientRegistry: remoteClientRegistry,
+	}
+}
+
+type machineConfig interface {
+	OSFamily() anywherev1.OSFamily
+	Users() []anywherev1.UserConfiguration
+}
+
+// Reconcile patches the ssh users of the control plane and worker nodes of a cluster whose
+// bootstrap users don't match the ones in their machine configs anymore.
+// External etcd machines are not patched, since changes to their users roll out new machines.
+func (r *Reconciler) Reconcile(ctx context.Context, log logr.Logger, eksaCluster *anywherev1.Cluster) (controller.Result, error) {
+	clusterSpec, err := cluster.BuildSpec(ctx, clientutil.NewKubeClient(r.client), eksaCluster)
+	if err != nil {
+		return controller.Result{}, err
+	}
+
+	machines := &clusterv1beta2.MachineList{}
+	if err := r.client.List(ctx, machines,
+		client.InNamespace(constants.EksaSystemNamespace),
+		client.MatchingLabels{clusterv1beta2.ClusterNameLabel: eksaCluster.Name},
+	); err != nil {
+		return controller.Result{}, fmt.Errorf("listing machines for ssh users reconciliation: %v", err)
+	}
+
+	var remoteClient client.Client
+	image := clusterSpec.RootVersionsBundle().Upgrader.Upgrader.VersionedImage()
+	result := controller.Result{}
+
+	for i := range machines.Items {
+		machine := &machines.Items[i]
+		if !machine.Status.NodeRef.IsDefined() || !machine.DeletionTimestamp.IsZero() {
+			continue
+		}
+
+		config := machineConfigForMachine(clusterSpec, machine)
+		if config == nil || config.OSFamily() == anywherev1.Bottlerocket {
+			continue
+		}
+
+		bootstrapUsers, err := r.machineBootstrapUsers(ctx, machine)
+		if err != nil {
+			return controller.Result{}, err
+		}
+		if bootstrapUsers == nil {
+			continue
+		}
+
+		if remoteClient == nil {
+			remoteClient, err = r.remoteClientRegistry.GetClient(ctx, client.ObjectKey{Name: eksaCluster.Name, Namespace: eksaCluster.Namespace})
+			if err != nil {
+				return controller.Result{}, err
+			}
+		}
+
+		desiredUsers := common.BootstrapUsers(config.Users())
+		nodeResult, err := reconcileNode(ctx, log, remoteClient, machine.Status.NodeRef.Name, image, desiredUsers, bootstrapUsers)
+		if err != nil {
+			return controller.Result{}, err
+		}
+		if nodeResult.Return() {
+			result = nodeResult
+		}
+	}
+
+	return result, nil
+}
+
+func (r *Reconciler) machineBootstrapUsers(ctx context.Context, machine *clusterv1beta2.Machine) ([]bootstrapv1beta2.User, error) {
+	ref := machine.Spec.Bootstrap.ConfigRef
+	if ref.Name == "" || ref.Kind != "KubeadmConfig" {
+		return nil, nil
+	}
+
+	kubeadmConfig := &bootstrapv1beta2.KubeadmConfig{}
+	if err := r.client.Get(ctx, client.ObjectKey{Name: ref.Name, Namespace: machine.Namespace}, kubeadmConfig); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("reading kubeadm config for machine %s: %v", machine.Name, err)
+	}
+
+	return append([]bootstrapv1beta2.User{}, kubeadmConfig.Spec.Users...), nil
+}
+
+func reconcileNode(ctx context.Context, log logr.Logger, remoteClient client.Client, nodeName, image string, desiredUsers, bootstrapUsers []bootstrapv1beta2.User) (controller.Result, error) {
+	node := &corev1.Node{}
+	if err := remoteClient.Get(ctx, client.ObjectKey{Name: nodeName}, node); err != nil {
+		return controller.Result{}, fmt.Errorf("reading node %s: %v", nodeName, err)
+	}
+
+	desiredUsers = normalizeUsers(desiredUsers)
+	hash := usersHash(desiredUsers)
+	if nodeUpToDate(node, hash, bootstrapUsers) {
+		return controller.Result{}, nil
+	}
+
+	pod := &corev1.Pod{}
+	podKey := client.ObjectKey{Name: nodeupgrader.SSHUsersPatcherPodName(nodeName), Namespace: constants.EksaSystemNamespace}
+	err := remoteClient.Get(ctx, podKey, pod)
+	if apierrors.IsNotFound(err) {
+		removed := removedUsers(desiredUsers, bootstrapUsers, node.Annotations[UsersAnnotation])
+		log.Info("Patching ssh users in node", "node", nodeName)
+		pod = nodeupgrader.SSHUsersPatcherPod(nodeName, image, desiredUsers, removed)
+		if err := remoteClient.Create(ctx, pod); err != nil {
+			return controller.Result{}, fmt.Errorf("creating ssh users patcher pod for node %s: %v", nodeName, err)
+		}
+		return controller.ResultWithRequeue(patchRequeueTime), nil
+	}
+	if err != nil {
+		return controller.Result{}, fmt.Errorf("reading ssh users patcher pod for node %s: %v", nodeName, err)
+	}
+
+	switch pod.Status.Phase {
+	case corev1.PodSucceeded:
+		clientutil.AddAnnotation(node, UsersHashAnnotation, hash)
+		clientutil.AddAnnotation(node, UsersAnnotation, strings.Join(userNames(desiredUsers), ","))
+		if err := remoteClient.Update(ctx, node); err != nil {
+			return controller.Result{}, fmt.Errorf("annotating node %s after patching ssh users: %v", nodeName, err)
+		}
+		if err := remoteClient.Delete(ctx, pod); err != nil && !apierrors.IsNotFound(err) {
+			return controller.Result{}, fmt.Errorf("deleting ssh users patcher pod for node %s: %v", nodeName, err)
+		}
+		return controller.Result{}, nil
+	case corev1.PodFailed:
+		if err := remoteClient.Delete(ctx, pod); err != nil && !apierrors.IsNotFound(err) {
+			return controller.Result{}, fmt.Errorf("deleting ssh users patcher pod for node %s: %v", nodeName, err)
+		}
+		return controller.Result{}, fmt.Errorf("ssh users patcher pod for node %s failed", nodeName)
+	default:
+		log.Info("Waiting for ssh users to be patched in node", "node", nodeName)
+		return controller.ResultWithRequeue(patchRequeueTime), nil
+	}
+}
+
+// nodeUpToDate returns true if the users with the given hash have either been patched in the node
+// or were the ones it was bootstrapped with, as long as it was never patched.
+func nodeUpToDate(node *corev1.Node, hash string, bootstrapUsers []bootstrapv1beta2.User) bool {
+	patchedHash, patched := node.Annotations[UsersHashAnnotation]
+	return patchedHash == hash || (!patched && usersHash(normalizeUsers(bootstrapUsers)) == hash)
+}
+
+func machineConfigForMachine(spec *cluster.Spec, machine *clusterv1beta2.Machine) machineConfig {
+	return machineConfigForRef(spec, machineConfigRefForMachine(spec, machine))
+}
+
+// machineConfigRefForMachine returns the machine config ref of the control plane or worker node group
+// a machine belongs to, or nil for any other machine.
+func machineConfigRefForMachine(spec *cluster.Spec, machine *clusterv1beta2.Machine) *anywherev1.Ref {
+	if _, ok := machine.Labels[clusterv1beta2.MachineControlPlaneLabel]; ok {
+		return spec.Cluster.Spec.ControlPlaneConfiguration.MachineGroupRef
+	}
+
+	deploymentName, ok := machine.Labels[clusterv1beta2.MachineDeploymentNameLabel]
+	if !ok {
+		return nil
+	}
+
+	for _, group := range spec.Cluster.Spec.WorkerNodeGroupConfigurations {
+		if slices.Contains(clusterapi.MachineDeploymentNames(spec.Cluster, group), deploymentName) {
+			return group.MachineGroupRef
+		}
+	}
+
+	return nil
+}
+
+func machineConfigForRef(spec *cluster.Spec, ref *anywherev1.Ref) machineConfig {
+	if ref == nil {
+		return nil
+	}
+
+	switch ref.Kind {
+	case anywherev1.VSphereMachineConfigKind:
+		return lookup(spec.VSphereMachineConfigs, ref.Name)
+	case anywherev1.CloudStackMachineConfigKind:
+		return lookup(spec.CloudStackMachineConfigs, ref.Name)
+	case anywherev1.NutanixMachineConfigKind:
+		return lookup(spec.NutanixMachineConfigs, ref.Name)
+	case anywherev1.TinkerbellMachineConfigKind:
+		return lookup(spec.TinkerbellMachineConfigs, ref.Name)
+	case anywherev1.ProxmoxMachineConfigKind:
+		return lookup(spec.ProxmoxMachineConfigs, ref.Name)
+	case anywherev1.OpenStackMachineConfigKind:
+		return lookup(spec.OpenStackMachineConfigs, ref.Name)
+	case anywherev1.AzureStackHCIMachineConfigKind:
+		return lookup(spec.AzureStackHCIMachineConfigs, ref.Name)
+	case anywherev1.HarvesterMachineConfigKind:
+		return lookup(spec.HarvesterMachineConfigs, ref.Name)
+	default:
+		return nil
+	}
+}
+
+func lookup[M machineConfig](configs map[string]M, name string) machineConfig {
+	config, ok := configs[name]
+	if !ok {
+		return nil
+	}
+	return config
+}
+
+// normalizeUsers strips the comments from the ssh keys so users rendered by providers that
+// keep them compare equal to the ones that don't.
+func normalizeUsers(users []bootstrapv1beta2.User) []bootstrapv1beta2.User {
+	normalized := make([]bootstrapv1beta2.User, 0, len(users))
+	for _, user := range users {
+		keys := make([]string, 0, len(user.SSHAuthorizedKeys))
+		for _, key := range user.SSHAuthorizedKeys {
+			if stripped, err := common.StripSshAuthorizedKeyComment(key); err == nil {
+				key = stripped
+			}
+			keys = append(keys, key)
+		}
+		normalized = append(normalized, bootstrapv1beta2.User{
+			Name:              user.Name,
+			Sudo:              user.Sudo,
+			SSHAuthorizedKeys: keys,
+		})
+	}
+
+	return normalized
+}
+
+func usersHash(users []bootstrapv1beta2.User) string {
+	// Marshalling a slice of structs with string fields can't fail.
+	b, _ := json.Marshal(users)
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:])
+}
+
+func userNames(users []bootstrapv1beta2.User) []string {
+	names := make([]string, 0, len(users))
+	for _, user := range users {
+		names = append(names, user.Name)
+	}
+	return names
+}
+
+// removedUsers returns the users that were either bootstrapped or previously patched in a node
+// and are not part of the desired users anymore.
+func removedUsers(desiredUsers, bootstrapUsers []bootstrapv1beta2.User, patchedUsers string) []string {
+	desired := userNames(desiredUsers)
+	previous := userNames(bootstrapUsers)
+	if patchedUsers != "" {
+		previous = append(previous, strings.Split(patchedUsers, ",")...)
+	}
+
+	var removed []string
+	for _, name := range previous {
+		if !slices.Contains(desired, name) && !slices.Contains(removed, name) {
+			removed = append(removed, name)
+		}
+	}
+
+	return removed
+}
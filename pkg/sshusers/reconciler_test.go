@@ -0,0 +1,172 @@
+package sshusers
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	bootstrapv1beta2 "sigs.k8s.io/cluster-api/api/bootstrap/kubeadm/v1beta2"
+	clusterv1beta2 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/aws/eks-anywhere/internal/test"
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/cluster"
+	"github.com/aws/eks-anywhere/pkg/constants"
+	"github.com/aws/eks-anywhere/pkg/nodeupgrader"
+)
+
+const (
+	nodeName = "node-1"
+	image    = "public.ecr.aws/eks-anywhere/node-upgrader:latest"
+)
+
+var (
+	oldUsers = []bootstrapv1beta2.User{
+		{Name: "capv", Sudo: anywherev1.DefaultUserSudoPolicy, SSHAuthorizedKeys: []string{"ssh-rsa AAAA"}},
+	}
+	newUsers = []bootstrapv1beta2.User{
+		{Name: "capv", Sudo: anywherev1.DefaultUserSudoPolicy, SSHAuthorizedKeys: []string{"ssh-rsa AAAA", "ssh-rsa BBBB"}},
+	}
+)
+
+func node(annotations map[string]string) *corev1.Node {
+	return &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        nodeName,
+			Annotations: annotations,
+		},
+	}
+}
+
+func patcherPodKey() client.ObjectKey {
+	return client.ObjectKey{Name: nodeupgrader.SSHUsersPatcherPodName(nodeName), Namespace: constants.EksaSystemNamespace}
+}
+
+func TestReconcileNodeUpToDate(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	c := fake.NewClientBuilder().WithObjects(node(nil)).Build()
+
+	result, err := reconcileNode(ctx, test.NewNullLogger(), c, nodeName, image, oldUsers, oldUsers)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(result.Return()).To(BeFalse())
+
+	pod := &corev1.Pod{}
+	g.Expect(c.Get(ctx, patcherPodKey(), pod)).NotTo(Succeed())
+}
+
+func TestReconcileNodeCreatesPatcherPod(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	c := fake.NewClientBuilder().WithObjects(node(nil)).Build()
+
+	result, err := reconcileNode(ctx, test.NewNullLogger(), c, nodeName, image, newUsers, oldUsers)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(result.Return()).To(BeTrue())
+
+	pod := &corev1.Pod{}
+	g.Expect(c.Get(ctx, patcherPodKey(), pod)).To(Succeed())
+	g.Expect(pod.Spec.NodeName).To(Equal(nodeName))
+	g.Expect(pod.Spec.Containers[0].Args).To(ContainElement(ContainSubstring("'ssh-rsa BBBB'")))
+}
+
+func TestReconcileNodePatcherPodSucceeded(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	pod := nodeupgrader.SSHUsersPatcherPod(nodeName, image, newUsers, nil)
+	pod.Status.Phase = corev1.PodSucceeded
+	c := fake.NewClientBuilder().WithObjects(node(nil), pod).Build()
+
+	result, err := reconcileNode(ctx, test.NewNullLogger(), c, nodeName, image, newUsers, oldUsers)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(result.Return()).To(BeFalse())
+
+	g.Expect(c.Get(ctx, patcherPodKey(), &corev1.Pod{})).NotTo(Succeed())
+	n := &corev1.Node{}
+	g.Expect(c.Get(ctx, client.ObjectKey{Name: nodeName}, n)).To(Succeed())
+	g.Expect(n.Annotations).To(HaveKeyWithValue(UsersHashAnnotation, usersHash(normalizeUsers(newUsers))))
+	g.Expect(n.Annotations).To(HaveKeyWithValue(UsersAnnotation, "capv"))
+
+	// Once annotated, the node is not patched again.
+	result, err = reconcileNode(ctx, test.NewNullLogger(), c, nodeName, image, newUsers, oldUsers)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(result.Return()).To(BeFalse())
+	g.Expect(c.Get(ctx, patcherPodKey(), &corev1.Pod{})).NotTo(Succeed())
+}
+
+func TestReconcileNodePatcherPodRunning(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	pod := nodeupgrader.SSHUsersPatcherPod(nodeName, image, newUsers, nil)
+	pod.Status.Phase = corev1.PodRunning
+	c := fake.NewClientBuilder().WithObjects(node(nil), pod).Build()
+
+	result, err := reconcileNode(ctx, test.NewNullLogger(), c, nodeName, image, newUsers, oldUsers)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(result.Return()).To(BeTrue())
+}
+
+func TestReconcileNodePatcherPodFailed(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	pod := nodeupgrader.SSHUsersPatcherPod(nodeName, image, newUsers, nil)
+	pod.Status.Phase = corev1.PodFailed
+	c := fake.NewClientBuilder().WithObjects(node(nil), pod).Build()
+
+	_, err := reconcileNode(ctx, test.NewNullLogger(), c, nodeName, image, newUsers, oldUsers)
+	g.Expect(err).To(MatchError("ssh users patcher pod for node node-1 failed"))
+	g.Expect(c.Get(ctx, patcherPodKey(), &corev1.Pod{})).NotTo(Succeed())
+}
+
+func TestReconcileNodeIgnoresKeyComments(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	c := fake.NewClientBuilder().WithObjects(node(nil)).Build()
+	key := "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIFQUhZ1nQEMnhhKnOaqrz3bRSfRvzW7XJyRmNDvDIr4J"
+	withComment := []bootstrapv1beta2.User{{Name: "capv", Sudo: anywherev1.DefaultUserSudoPolicy, SSHAuthorizedKeys: []string{key + " me@example.com"}}}
+	withoutComment := []bootstrapv1beta2.User{{Name: "capv", Sudo: anywherev1.DefaultUserSudoPolicy, SSHAuthorizedKeys: []string{key}}}
+
+	result, err := reconcileNode(ctx, test.NewNullLogger(), c, nodeName, image, withComment, withoutComment)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(result.Return()).To(BeFalse())
+}
+
+func TestRemovedUsers(t *testing.T) {
+	g := NewWithT(t)
+	desired := []bootstrapv1beta2.User{{Name: "capv"}, {Name: "ops"}}
+	bootstrap := []bootstrapv1beta2.User{{Name: "capv"}, {Name: "old"}}
+
+	g.Expect(removedUsers(desired, bootstrap, "")).To(Equal([]string{"old"}))
+	g.Expect(removedUsers(desired, bootstrap, "capv,old,temp")).To(Equal([]string{"old", "temp"}))
+	g.Expect(removedUsers(desired, desired, "")).To(BeEmpty())
+}
+
+func TestMachineConfigForMachine(t *testing.T) {
+	g := NewWithT(t)
+	spec := test.NewClusterSpec(func(s *cluster.Spec) {
+		s.Cluster.Name = "my-cluster"
+		s.Cluster.Spec.ControlPlaneConfiguration.MachineGroupRef = &anywherev1.Ref{Kind: anywherev1.VSphereMachineConfigKind, Name: "cp"}
+		s.Cluster.Spec.WorkerNodeGroupConfigurations = []anywherev1.WorkerNodeGroupConfiguration{
+			{Name: "md-0", MachineGroupRef: &anywherev1.Ref{Kind: anywherev1.VSphereMachineConfigKind, Name: "workers"}},
+		}
+		s.VSphereMachineConfigs = map[string]*anywherev1.VSphereMachineConfig{
+			"cp":      {Spec: anywherev1.VSphereMachineConfigSpec{OSFamily: anywherev1.Ubuntu}},
+			"workers": {Spec: anywherev1.VSphereMachineConfigSpec{OSFamily: anywherev1.Bottlerocket}},
+		}
+	})
+
+	cp := &clusterv1beta2.Machine{ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{clusterv1beta2.MachineControlPlaneLabel: ""}}}
+	worker := &clusterv1beta2.Machine{ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{clusterv1beta2.MachineDeploymentNameLabel: "my-cluster-md-0"}}}
+	etcd := &clusterv1beta2.Machine{}
+
+	g.Expect(machineConfigForMachine(spec, cp).OSFamily()).To(Equal(anywherev1.Ubuntu))
+	g.Expect(machineConfigForMachine(spec, worker).OSFamily()).To(Equal(anywherev1.Bottlerocket))
+	g.Expect(machineConfigForMachine(spec, etcd)).To(BeNil())
+
+	delete(spec.VSphereMachineConfigs, "cp")
+	g.Expect(machineConfigForMachine(spec, cp)).To(BeNil())
+}
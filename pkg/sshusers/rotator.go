@@ -0,0 +1,393 @@
+package sshusers
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	bootstrapv1beta2 "sigs.k8s.io/cluster-api/api/bootstrap/kubeadm/v1beta2"
+	clusterv1beta2 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/certificates"
+	"github.com/aws/eks-anywhere/pkg/clients/kubernetes"
+	"github.com/aws/eks-anywhere/pkg/cluster"
+	"github.com/aws/eks-anywhere/pkg/constants"
+	"github.com/aws/eks-anywhere/pkg/logger"
+	"github.com/aws/eks-anywhere/pkg/providers/common"
+	"github.com/aws/eks-anywhere/pkg/retrier"
+)
+
+const (
+	defaultRotationTimeout = 30 * time.Minute
+	defaultRotationBackoff = 10 * time.Second
+)
+
+// AccessVerifier checks that a node accepts ssh connections for a user with a private key.
+type AccessVerifier interface {
+	VerifyAccess(ctx context.Context, address, user, privateKeyPath string) error
+}
+
+// SSHAccessVerifier verifies access to nodes by running a no-op command over ssh.
+type SSHAccessVerifier struct{}
+
+// VerifyAccess connects to the node at address with the user and private key and runs a no-op command.
+func (SSHAccessVerifier) VerifyAccess(ctx context.Context, address, user, privateKeyPath string) error {
+	runner, err := certificates.NewSSHRunner(certificates.SSHConfig{User: user, KeyPath: privateKeyPath})
+	if err != nil {
+		return err
+	}
+
+	if _, err := runner.RunCommand(ctx, address, "true", certificates.WithSSHLogging(false)); err != nil {
+		return fmt.Errorf("verifying ssh access to node %s: %v", address, err)
+	}
+
+	return nil
+}
+
+// Rotation holds the parameters of an ssh key rotation.
+type Rotation struct {
+	ClusterName string
+	Namespace   string
+	// UserName is the user whose keys are rotated. If empty, the first user of each machine config is used.
+	UserName string
+	// AuthorizedKey is the new public key, in authorized keys format.
+	AuthorizedKey string
+	// PrivateKeyPath is the path to the private key matching AuthorizedKey, used to verify access to the nodes.
+	PrivateKeyPath string
+}
+
+// Rotator rotates the ssh keys of the nodes of a cluster by updating its machine configs and
+// waiting for the ssh users Reconciler to patch the nodes in place.
+type Rotator struct {
+	managementClient kubernetes.Client
+	workloadClient   kubernetes.Client
+	verifier         AccessVerifier
+	retrier          *retrier.Retrier
+}
+
+// RotatorOpt allows to customize a Rotator.
+type RotatorOpt func(*Rotator)
+
+// WithRotatorRetrier sets the retrier used to wait for the nodes to be updated.
+func WithRotatorRetrier(r *retrier.Retrier) RotatorOpt {
+	return func(rotator *Rotator) {
+		rotator.retrier = r
+	}
+}
+
+// NewRotator returns a new Rotator. managementClient reads and updates the cluster objects and
+// workloadClient reads the nodes of the cluster. They are the same for self-managed clusters.
+func NewRotator(managementClient, workloadClient kubernetes.Client, verifier AccessVerifier, opts ...RotatorOpt) *Rotator {
+	r := &Rotator{
+		managementClient: managementClient,
+		workloadClient:   workloadClient,
+		verifier:         verifier,
+		retrier:          retrier.New(defaultRotationTimeout, retrier.WithRetryPolicy(retrier.BackOffPolicy(defaultRotationBackoff))),
+	}
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	return r
+}
+
+type rotationTarget struct {
+	kind, name, namespace string
+	userName              string
+	oldKeys               []string
+}
+
+type clusterNode struct {
+	name      string
+	address   string
+	configRef anywherev1.Ref
+	upToDate  bool
+}
+
+// Rotate adds the new key to the machine configs of the control plane and worker nodes, waits for
+// the nodes to be patched, verifies the nodes accept the new key and then removes the keys the user
+// had before from the machine configs and the nodes.
+func (r *Rotator) Rotate(ctx context.Context, rotation Rotation) error {
+	eksaCluster := &anywherev1.Cluster{}
+	if err := r.managementClient.Get(ctx, rotation.ClusterName, rotation.Namespace, eksaCluster); err != nil {
+		return fmt.Errorf("reading cluster %s: %v", rotation.ClusterName, err)
+	}
+
+	targets, err := r.rotationTargets(ctx, eksaCluster, rotation.UserName)
+	if err != nil {
+		return err
+	}
+
+	logger.Info("Adding new ssh key to machine configs")
+	for _, target := range targets {
+		if err := r.updateKeys(ctx, target, func(keys []string) []string {
+			if slices.Contains(keys, rotation.AuthorizedKey) {
+				return keys
+			}
+			return append(keys, rotation.AuthorizedKey)
+		}); err != nil {
+			return err
+		}
+	}
+
+	logger.Info("Waiting for nodes to be updated with the new ssh key")
+	nodes, err := r.waitForNodes(ctx, eksaCluster)
+	if err != nil {
+		return err
+	}
+
+	logger.Info("Verifying ssh access to nodes with the new key")
+	for _, node := range nodes {
+		i := slices.IndexFunc(targets, func(t rotationTarget) bool {
+			return t.kind == node.configRef.Kind && t.name == node.configRef.Name
+		})
+		if i < 0 {
+			continue
+		}
+		userName := targets[i].userName
+		if err := r.retrier.Retry(func() error {
+			return r.verifier.VerifyAccess(ctx, node.address, userName, rotation.PrivateKeyPath)
+		}); err != nil {
+			return fmt.Errorf("new ssh key was added but access to node %s could not be verified, old keys were kept: %v", node.name, err)
+		}
+	}
+
+	logger.Info("Removing old ssh keys from machine configs")
+	for _, target := range targets {
+		if err := r.updateKeys(ctx, target, func(keys []string) []string {
+			return slices.DeleteFunc(keys, func(key string) bool {
+				return key != rotation.AuthorizedKey && slices.Contains(target.oldKeys, key)
+			})
+		}); err != nil {
+			return err
+		}
+	}
+
+	logger.Info("Waiting for old ssh keys to be removed from nodes")
+	if _, err := r.waitForNodes(ctx, eksaCluster); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// rotationTargets returns the machine configs of the control plane and worker nodes, failing if any of
+// them doesn't support updating its users in place.
+func (r *Rotator) rotationTargets(ctx context.Context, eksaCluster *anywherev1.Cluster, userName string) ([]rotationTarget, error) {
+	refs := []*anywherev1.Ref{eksaCluster.Spec.ControlPlaneConfiguration.MachineGroupRef}
+	for _, group := range eksaCluster.Spec.WorkerNodeGroupConfigurations {
+		refs = append(refs, group.MachineGroupRef)
+	}
+
+	var targets []rotationTarget
+	for _, ref := range refs {
+		if ref == nil || slices.ContainsFunc(targets, func(t rotationTarget) bool { return t.kind == ref.Kind && t.name == ref.Name }) {
+			continue
+		}
+
+		obj, err := emptyMachineConfig(ref.Kind)
+		if err != nil {
+			return nil, err
+		}
+		if err := r.managementClient.Get(ctx, ref.Name, eksaCluster.Namespace, obj); err != nil {
+			return nil, fmt.Errorf("reading %s %s: %v", ref.Kind, ref.Name, err)
+		}
+
+		if obj.(machineConfig).OSFamily() == anywherev1.Bottlerocket {
+			return nil, fmt.Errorf("ssh key rotation is not supported for %s %s: nodes with os family %s can't be updated in place", ref.Kind, ref.Name, anywherev1.Bottlerocket)
+		}
+
+		users := *machineConfigUsers(obj)
+		i := 0
+		if userName != "" {
+			i = slices.IndexFunc(users, func(u anywherev1.UserConfiguration) bool { return u.Name == userName })
+		}
+		if i < 0 || i >= len(users) {
+			return nil, fmt.Errorf("user %s not found in %s %s", userName, ref.Kind, ref.Name)
+		}
+
+		targets = append(targets, rotationTarget{
+			kind:      ref.Kind,
+			name:      ref.Name,
+			namespace: eksaCluster.Namespace,
+			userName:  users[i].Name,
+			oldKeys:   slices.Clone(users[i].SshAuthorizedKeys),
+		})
+	}
+
+	return targets, nil
+}
+
+func (r *Rotator) updateKeys(ctx context.Context, target rotationTarget, update func(keys []string) []string) error {
+	obj, err := emptyMachineConfig(target.kind)
+	if err != nil {
+		return err
+	}
+	if err := r.managementClient.Get(ctx, target.name, target.namespace, obj); err != nil {
+		return fmt.Errorf("reading %s %s: %v", target.kind, target.name, err)
+	}
+
+	users := *machineConfigUsers(obj)
+	for i := range users {
+		if users[i].Name == target.userName {
+			users[i].SshAuthorizedKeys = update(users[i].SshAuthorizedKeys)
+		}
+	}
+
+	if err := r.managementClient.Update(ctx, obj); err != nil {
+		return fmt.Errorf("updating ssh keys in %s %s: %v", target.kind, target.name, err)
+	}
+
+	return nil
+}
+
+func (r *Rotator) waitForNodes(ctx context.Context, eksaCluster *anywherev1.Cluster) ([]clusterNode, error) {
+	var nodes []clusterNode
+	err := r.retrier.Retry(func() error {
+		var err error
+		nodes, err = r.clusterNodes(ctx, eksaCluster)
+		if err != nil {
+			return err
+		}
+
+		for _, node := range nodes {
+			if !node.upToDate {
+				return fmt.Errorf("ssh users in node %s have not been updated yet", node.name)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("waiting for nodes to be updated: %v", err)
+	}
+
+	return nodes, nil
+}
+
+// clusterNodes returns the control plane and worker nodes of the cluster, indicating for each one
+// if its ssh users match the ones in its machine config.
+func (r *Rotator) clusterNodes(ctx context.Context, eksaCluster *anywherev1.Cluster) ([]clusterNode, error) {
+	config, err := cluster.NewDefaultConfigClientBuilder().Build(ctx, r.managementClient, eksaCluster)
+	if err != nil {
+		return nil, err
+	}
+	clusterSpec := &cluster.Spec{Config: config}
+
+	machines := &clusterv1beta2.MachineList{}
+	if err := r.managementClient.List(ctx, machines, kubernetes.ListOptions{Namespace: constants.EksaSystemNamespace}); err != nil {
+		return nil, fmt.Errorf("listing machines: %v", err)
+	}
+
+	var nodes []clusterNode
+	for i := range machines.Items {
+		machine := &machines.Items[i]
+		if machine.Labels[clusterv1beta2.ClusterNameLabel] != eksaCluster.Name || !machine.Status.NodeRef.IsDefined() || !machine.DeletionTimestamp.IsZero() {
+			continue
+		}
+
+		ref := machineConfigRefForMachine(clusterSpec, machine)
+		config := machineConfigForRef(clusterSpec, ref)
+		if config == nil {
+			continue
+		}
+
+		bootstrapUsers, err := r.machineBootstrapUsers(ctx, machine)
+		if err != nil {
+			return nil, err
+		}
+
+		node := &corev1.Node{}
+		if err := r.workloadClient.Get(ctx, machine.Status.NodeRef.Name, "", node); err != nil {
+			return nil, fmt.Errorf("reading node %s: %v", machine.Status.NodeRef.Name, err)
+		}
+
+		address := machineAddress(machine)
+		if address == "" {
+			return nil, fmt.Errorf("machine %s doesn't have an address", machine.Name)
+		}
+
+		hash := usersHash(normalizeUsers(common.BootstrapUsers(config.Users())))
+		nodes = append(nodes, clusterNode{
+			name:      node.Name,
+			address:   address,
+			configRef: *ref,
+			upToDate:  nodeUpToDate(node, hash, bootstrapUsers),
+		})
+	}
+
+	return nodes, nil
+}
+
+func (r *Rotator) machineBootstrapUsers(ctx context.Context, machine *clusterv1beta2.Machine) ([]bootstrapv1beta2.User, error) {
+	ref := machine.Spec.Bootstrap.ConfigRef
+	if ref.Name == "" || ref.Kind != "KubeadmConfig" {
+		return nil, nil
+	}
+
+	kubeadmConfig := &bootstrapv1beta2.KubeadmConfig{}
+	if err := r.managementClient.Get(ctx, ref.Name, machine.Namespace, kubeadmConfig); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("reading kubeadm config for machine %s: %v", machine.Name, err)
+	}
+
+	return kubeadmConfig.Spec.Users, nil
+}
+
+func machineAddress(machine *clusterv1beta2.Machine) string {
+	for _, addressType := range []clusterv1beta2.MachineAddressType{clusterv1beta2.MachineExternalIP, clusterv1beta2.MachineInternalIP} {
+		for _, address := range machine.Status.Addresses {
+			if address.Type == addressType && address.Address != "" {
+				return address.Address
+			}
+		}
+	}
+
+	return ""
+}
+
+// emptyMachineConfig returns an empty machine config object of the given kind, as long as the
+// users of that kind can be updated in place.
+func emptyMachineConfig(kind string) (kubernetes.Object, error) {
+	switch kind {
+	case anywherev1.VSphereMachineConfigKind:
+		return &anywherev1.VSphereMachineConfig{}, nil
+	case anywherev1.CloudStackMachineConfigKind:
+		return &anywherev1.CloudStackMachineConfig{}, nil
+	case anywherev1.ProxmoxMachineConfigKind:
+		return &anywherev1.ProxmoxMachineConfig{}, nil
+	case anywherev1.OpenStackMachineConfigKind:
+		return &anywherev1.OpenStackMachineConfig{}, nil
+	case anywherev1.AzureStackHCIMachineConfigKind:
+		return &anywherev1.AzureStackHCIMachineConfig{}, nil
+	case anywherev1.HarvesterMachineConfigKind:
+		return &anywherev1.HarvesterMachineConfig{}, nil
+	default:
+		return nil, fmt.Errorf("ssh key rotation is not supported for %s", kind)
+	}
+}
+
+func machineConfigUsers(obj kubernetes.Object) *[]anywherev1.UserConfiguration {
+	switch c := obj.(type) {
+	case *anywherev1.VSphereMachineConfig:
+		return &c.Spec.Users
+	case *anywherev1.CloudStackMachineConfig:
+		return &c.Spec.Users
+	case *anywherev1.ProxmoxMachineConfig:
+		return &c.Spec.Users
+	case *anywherev1.OpenStackMachineConfig:
+		return &c.Spec.Users
+	case *anywherev1.AzureStackHCIMachineConfig:
+		return &c.Spec.Users
+	case *anywherev1.HarvesterMachineConfig:
+		return &c.Spec.Users
+	default:
+		return nil
+	}
+}
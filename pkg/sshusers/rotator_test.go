@@ -0,0 +1,203 @@
+package sshusers
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clusterv1beta2 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/aws/eks-anywhere/internal/test"
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/clients/kubernetes"
+	"github.com/aws/eks-anywhere/pkg/constants"
+	"github.com/aws/eks-anywhere/pkg/providers/common"
+	"github.com/aws/eks-anywhere/pkg/retrier"
+)
+
+const (
+	oldKey = "ssh-rsa AAAA"
+	newKey = "ssh-rsa BBBB"
+)
+
+// patchingClient simulates the ssh users reconciler by annotating nodes with the hash of the users
+// currently set in the control plane machine config.
+type patchingClient struct {
+	kubernetes.Client
+	managementClient kubernetes.Client
+}
+
+func (c *patchingClient) Get(ctx context.Context, name, namespace string, obj kubernetes.Object) error {
+	if err := c.Client.Get(ctx, name, namespace, obj); err != nil {
+		return err
+	}
+
+	node, ok := obj.(*corev1.Node)
+	if !ok {
+		return nil
+	}
+
+	config := &anywherev1.VSphereMachineConfig{}
+	if err := c.managementClient.Get(ctx, "cp", "default", config); err != nil {
+		return err
+	}
+	node.Annotations = map[string]string{UsersHashAnnotation: usersHash(normalizeUsers(common.BootstrapUsers(config.Users())))}
+
+	return nil
+}
+
+type fakeVerifier struct {
+	err   error
+	calls []string
+}
+
+func (v *fakeVerifier) VerifyAccess(_ context.Context, address, user, privateKeyPath string) error {
+	v.calls = append(v.calls, address+" "+user+" "+privateKeyPath)
+	return v.err
+}
+
+func rotationCluster() *anywherev1.Cluster {
+	return &anywherev1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-cluster", Namespace: "default"},
+		Spec: anywherev1.ClusterSpec{
+			DatacenterRef: anywherev1.Ref{Kind: anywherev1.VSphereDatacenterKind, Name: "dc"},
+			ControlPlaneConfiguration: anywherev1.ControlPlaneConfiguration{
+				MachineGroupRef: &anywherev1.Ref{Kind: anywherev1.VSphereMachineConfigKind, Name: "cp"},
+			},
+			WorkerNodeGroupConfigurations: []anywherev1.WorkerNodeGroupConfiguration{
+				{Name: "md-0", MachineGroupRef: &anywherev1.Ref{Kind: anywherev1.VSphereMachineConfigKind, Name: "cp"}},
+			},
+		},
+	}
+}
+
+func rotationMachineConfig(osFamily anywherev1.OSFamily) *anywherev1.VSphereMachineConfig {
+	return &anywherev1.VSphereMachineConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: "cp", Namespace: "default"},
+		Spec: anywherev1.VSphereMachineConfigSpec{
+			OSFamily: osFamily,
+			Users: []anywherev1.UserConfiguration{
+				{Name: "capv", SshAuthorizedKeys: []string{oldKey}},
+				{Name: "ops", SshAuthorizedKeys: []string{"ssh-rsa CCCC"}},
+			},
+		},
+	}
+}
+
+func rotationObjects(config *anywherev1.VSphereMachineConfig) []client.Object {
+	return []client.Object{
+		rotationCluster(),
+		config,
+		&anywherev1.VSphereDatacenterConfig{ObjectMeta: metav1.ObjectMeta{Name: "dc", Namespace: "default"}},
+		&clusterv1beta2.Machine{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "my-cluster-cp-1",
+				Namespace: constants.EksaSystemNamespace,
+				Labels: map[string]string{
+					clusterv1beta2.ClusterNameLabel:         "my-cluster",
+					clusterv1beta2.MachineControlPlaneLabel: "",
+				},
+			},
+			Status: clusterv1beta2.MachineStatus{
+				NodeRef: clusterv1beta2.MachineNodeReference{Name: nodeName},
+				Addresses: clusterv1beta2.MachineAddresses{
+					{Type: clusterv1beta2.MachineInternalIP, Address: "10.0.0.1"},
+				},
+			},
+		},
+		node(nil),
+	}
+}
+
+func newTestRotator(verifier AccessVerifier, objs ...client.Object) (*Rotator, kubernetes.Client) {
+	managementClient := test.NewFakeKubeClient(objs...)
+	workloadClient := &patchingClient{Client: managementClient, managementClient: managementClient}
+	return NewRotator(managementClient, workloadClient, verifier, WithRotatorRetrier(retrier.NewWithMaxRetries(1, 0))), managementClient
+}
+
+func rotation() Rotation {
+	return Rotation{
+		ClusterName:    "my-cluster",
+		Namespace:      "default",
+		AuthorizedKey:  newKey,
+		PrivateKeyPath: "my-cluster/eks-a-id_rsa",
+	}
+}
+
+func TestRotatorRotate(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	verifier := &fakeVerifier{}
+	rotator, c := newTestRotator(verifier, rotationObjects(rotationMachineConfig(anywherev1.Ubuntu))...)
+
+	g.Expect(rotator.Rotate(ctx, rotation())).To(Succeed())
+
+	config := &anywherev1.VSphereMachineConfig{}
+	g.Expect(c.Get(ctx, "cp", "default", config)).To(Succeed())
+	g.Expect(config.Spec.Users).To(Equal([]anywherev1.UserConfiguration{
+		{Name: "capv", SshAuthorizedKeys: []string{newKey}},
+		{Name: "ops", SshAuthorizedKeys: []string{"ssh-rsa CCCC"}},
+	}))
+	g.Expect(verifier.calls).To(ConsistOf("10.0.0.1 capv my-cluster/eks-a-id_rsa"))
+}
+
+func TestRotatorRotateUser(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	verifier := &fakeVerifier{}
+	rotator, c := newTestRotator(verifier, rotationObjects(rotationMachineConfig(anywherev1.Ubuntu))...)
+	r := rotation()
+	r.UserName = "ops"
+
+	g.Expect(rotator.Rotate(ctx, r)).To(Succeed())
+
+	config := &anywherev1.VSphereMachineConfig{}
+	g.Expect(c.Get(ctx, "cp", "default", config)).To(Succeed())
+	g.Expect(config.Spec.Users).To(Equal([]anywherev1.UserConfiguration{
+		{Name: "capv", SshAuthorizedKeys: []string{oldKey}},
+		{Name: "ops", SshAuthorizedKeys: []string{newKey}},
+	}))
+	g.Expect(verifier.calls).To(ConsistOf("10.0.0.1 ops my-cluster/eks-a-id_rsa"))
+}
+
+func TestRotatorRotateVerifyAccessError(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	verifier := &fakeVerifier{err: errors.New("connection refused")}
+	rotator, c := newTestRotator(verifier, rotationObjects(rotationMachineConfig(anywherev1.Ubuntu))...)
+
+	g.Expect(rotator.Rotate(ctx, rotation())).To(MatchError(ContainSubstring("access to node node-1 could not be verified, old keys were kept")))
+
+	config := &anywherev1.VSphereMachineConfig{}
+	g.Expect(c.Get(ctx, "cp", "default", config)).To(Succeed())
+	g.Expect(config.Spec.Users[0].SshAuthorizedKeys).To(Equal([]string{oldKey, newKey}))
+}
+
+func TestRotatorRotateBottlerocket(t *testing.T) {
+	g := NewWithT(t)
+	rotator, _ := newTestRotator(&fakeVerifier{}, rotationObjects(rotationMachineConfig(anywherev1.Bottlerocket))...)
+
+	g.Expect(rotator.Rotate(context.Background(), rotation())).To(MatchError(ContainSubstring("ssh key rotation is not supported for VSphereMachineConfig cp")))
+}
+
+func TestRotatorRotateUserNotFound(t *testing.T) {
+	g := NewWithT(t)
+	rotator, _ := newTestRotator(&fakeVerifier{}, rotationObjects(rotationMachineConfig(anywherev1.Ubuntu))...)
+	r := rotation()
+	r.UserName = "admin"
+
+	g.Expect(rotator.Rotate(context.Background(), r)).To(MatchError("user admin not found in VSphereMachineConfig cp"))
+}
+
+func TestRotatorRotateUnsupportedKind(t *testing.T) {
+	g := NewWithT(t)
+	cluster := rotationCluster()
+	cluster.Spec.ControlPlaneConfiguration.MachineGroupRef.Kind = anywherev1.NutanixMachineConfigKind
+	rotator, _ := newTestRotator(&fakeVerifier{}, cluster)
+
+	g.Expect(rotator.Rotate(context.Background(), rotation())).To(MatchError("ssh key rotation is not supported for NutanixMachineConfig"))
+}
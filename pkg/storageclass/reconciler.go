@@ -0,0 +1,157 @@
+package storageclass
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/controller"
+	"github.com/aws/eks-anywhere/pkg/controller/clusters"
+	"github.com/aws/eks-anywhere/pkg/controller/serverside"
+)
+
+const (
+	// Name is the name of the default StorageClass managed by EKS Anywhere.
+	Name = "eksa-default"
+
+	// isDefaultClassAnnotation marks a StorageClass as the default one for the cluster.
+	isDefaultClassAnnotation = "storageclass.kubernetes.io/is-default-class"
+
+	// missingDriverRequeueTime is how long to wait before checking again for the CSI driver.
+	missingDriverRequeueTime = time.Minute
+)
+
+// RemoteClientRegistry gets clients for remote clusters.
+type RemoteClientRegistry interface {
+	GetClient(ctx context.Context, cluster client.ObjectKey) (client.Client, error)
+}
+
+// Reconciler creates the default StorageClass in the clusters with a default storage class
+// configuration and keeps it in sync with it.
+type Reconciler struct {
+	client               client.Client
+	remoteClientRegistry RemoteClientRegistry
+}
+
+// New returns a new Reconciler.
+func New(client client.Client, remoteClientRegistry RemoteClientRegistry) *Reconciler {
+	return &Reconciler{
+		client:               client,
+		remoteClientRegistry: remoteClientRegistry,
+	}
+}
+
+// Reconcile applies the default StorageClass to the cluster, once its control plane is ready.
+// If the CSI driver for the provisioner is not installed in the cluster, it sets a failure in
+// the cluster status and requeues until it is. It's a no-op for clusters without a default
+// storage class configuration.
+func (r *Reconciler) Reconcile(ctx context.Context, log logr.Logger, eksaCluster *anywherev1.Cluster) (controller.Result, error) {
+	config := eksaCluster.Spec.DefaultStorageClass
+	if config == nil {
+		return controller.Result{}, nil
+	}
+
+	result, err := clusters.CheckControlPlaneReady(ctx, r.client, log, eksaCluster)
+	if err != nil {
+		return controller.Result{}, errors.Wrap(err, "checking controlplane ready")
+	}
+	if result.Return() {
+		return result, nil
+	}
+
+	remoteClient, err := r.remoteClientRegistry.GetClient(ctx, controller.CapiClusterObjectKey(eksaCluster))
+	if err != nil {
+		return controller.Result{}, errors.Wrap(err, "getting workload cluster's client to reconcile the default storage class")
+	}
+
+	installed, err := csiDriverInstalled(ctx, remoteClient, config.Provisioner)
+	if err != nil {
+		return controller.Result{}, err
+	}
+	if !installed {
+		failureMessage := fmt.Sprintf("CSI driver %s for the default storage class is not installed in the cluster", config.Provisioner)
+		log.Info("Waiting for CSI driver before creating the default storage class", "provisioner", config.Provisioner)
+		eksaCluster.SetFailure(anywherev1.DefaultStorageClassInvalidReason, failureMessage)
+		return controller.ResultWithRequeue(missingDriverRequeueTime), nil
+	}
+
+	storageClass := StorageClass(config)
+
+	current := &storagev1.StorageClass{}
+	err = remoteClient.Get(ctx, client.ObjectKey{Name: Name}, current)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return controller.Result{}, errors.Wrap(err, "reading default storage class")
+	}
+	if err == nil && !immutableFieldsEqual(current, storageClass) {
+		// Provisioner, parameters and reclaim policy can't be updated, so the storage class
+		// needs to be recreated. Existing volumes are not affected.
+		log.Info("Recreating default storage class with new configuration")
+		if err := remoteClient.Delete(ctx, current); err != nil && !apierrors.IsNotFound(err) {
+			return controller.Result{}, errors.Wrap(err, "deleting outdated default storage class")
+		}
+	}
+
+	if err := serverside.ReconcileObject(ctx, remoteClient, storageClass); err != nil {
+		return controller.Result{}, errors.Wrap(err, "applying default storage class")
+	}
+
+	return controller.Result{}, nil
+}
+
+// StorageClass returns the default StorageClass for a default storage class configuration.
+func StorageClass(config *anywherev1.DefaultStorageClass) *storagev1.StorageClass {
+	reclaimPolicy := config.ReclaimPolicy
+	if reclaimPolicy == "" {
+		reclaimPolicy = corev1.PersistentVolumeReclaimDelete
+	}
+
+	return &storagev1.StorageClass{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: storagev1.SchemeGroupVersion.String(),
+			Kind:       "StorageClass",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name: Name,
+			Annotations: map[string]string{
+				isDefaultClassAnnotation: "true",
+			},
+		},
+		Provisioner:   config.Provisioner,
+		Parameters:    config.Parameters,
+		ReclaimPolicy: &reclaimPolicy,
+	}
+}
+
+func csiDriverInstalled(ctx context.Context, c client.Client, provisioner string) (bool, error) {
+	err := c.Get(ctx, client.ObjectKey{Name: provisioner}, &storagev1.CSIDriver{})
+	if apierrors.IsNotFound(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, errors.Wrapf(err, "reading CSI driver %s", provisioner)
+	}
+
+	return true, nil
+}
+
+func immutableFieldsEqual(current, desired *storagev1.StorageClass) bool {
+	if current.Provisioner != desired.Provisioner {
+		return false
+	}
+	if len(current.Parameters) != 0 || len(desired.Parameters) != 0 {
+		if !reflect.DeepEqual(current.Parameters, desired.Parameters) {
+			return false
+		}
+	}
+	return current.ReclaimPolicy != nil && *current.ReclaimPolicy == *desired.ReclaimPolicy
+}
@@ -0,0 +1,224 @@
+package storageclass_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	controlplanev1beta2 "sigs.k8s.io/cluster-api/api/controlplane/kubeadm/v1beta2"
+	clusterv1beta2 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/aws/eks-anywhere/internal/test"
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/controller"
+	"github.com/aws/eks-anywhere/pkg/storageclass"
+	"github.com/aws/eks-anywhere/pkg/storageclass/mocks"
+)
+
+type reconcilerTest struct {
+	*WithT
+	ctx                  context.Context
+	remoteClientRegistry *mocks.MockRemoteClientRegistry
+	cluster              *anywherev1.Cluster
+	kcp                  *controlplanev1beta2.KubeadmControlPlane
+	csiDriver            *storagev1.CSIDriver
+}
+
+func newReconcilerTest(t testing.TB) *reconcilerTest {
+	ctrl := gomock.NewController(t)
+	cluster := &anywherev1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-cluster",
+			Namespace: "eksa-system",
+		},
+		Spec: anywherev1.ClusterSpec{
+			DefaultStorageClass: &anywherev1.DefaultStorageClass{
+				Provisioner: "csi.vsphere.vmware.com",
+				Parameters: map[string]string{
+					"storagepolicyname": "vSAN Default Storage Policy",
+				},
+			},
+		},
+	}
+	kcp := test.KubeadmControlPlane(func(kcp *controlplanev1beta2.KubeadmControlPlane) {
+		kcp.Name = cluster.Name
+		kcp.Spec.Version = "test"
+		kcp.Status = controlplanev1beta2.KubeadmControlPlaneStatus{
+			Conditions: []metav1.Condition{
+				{
+					Type:               clusterv1beta2.AvailableCondition,
+					Status:             metav1.ConditionTrue,
+					LastTransitionTime: metav1.NewTime(time.Now()),
+				},
+			},
+			Version:            "test",
+			ReadyReplicas:      ptr.To(int32(1)),
+			Replicas:           ptr.To(int32(1)),
+			ObservedGeneration: 1,
+		}
+		kcp.Generation = 1
+	})
+	csiDriver := &storagev1.CSIDriver{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "csi.vsphere.vmware.com",
+		},
+	}
+
+	return &reconcilerTest{
+		WithT:                NewWithT(t),
+		ctx:                  context.Background(),
+		remoteClientRegistry: mocks.NewMockRemoteClientRegistry(ctrl),
+		cluster:              cluster,
+		kcp:                  kcp,
+		csiDriver:            csiDriver,
+	}
+}
+
+func (tt *reconcilerTest) managementClient(objs ...runtime.Object) client.Client {
+	scheme := runtime.NewScheme()
+	_ = controlplanev1beta2.AddToScheme(scheme)
+	return fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(objs...).Build()
+}
+
+func (tt *reconcilerTest) remoteClient(objs ...runtime.Object) client.Client {
+	scheme := runtime.NewScheme()
+	_ = storagev1.AddToScheme(scheme)
+	remoteClient := fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(objs...).Build()
+	tt.remoteClientRegistry.EXPECT().
+		GetClient(tt.ctx, client.ObjectKey{Name: "my-cluster", Namespace: "eksa-system"}).
+		Return(remoteClient, nil)
+	return remoteClient
+}
+
+func (tt *reconcilerTest) getStorageClass(c client.Client) *storagev1.StorageClass {
+	storageClass := &storagev1.StorageClass{}
+	tt.Expect(c.Get(tt.ctx, client.ObjectKey{Name: storageclass.Name}, storageClass)).To(Succeed())
+	return storageClass
+}
+
+func nullLog() logr.Logger {
+	return logr.New(logf.NullLogSink{})
+}
+
+func TestReconcileNoDefaultStorageClass(t *testing.T) {
+	tt := newReconcilerTest(t)
+	tt.cluster.Spec.DefaultStorageClass = nil
+	r := storageclass.New(tt.managementClient(), tt.remoteClientRegistry)
+
+	result, err := r.Reconcile(tt.ctx, nullLog(), tt.cluster)
+	tt.Expect(err).NotTo(HaveOccurred())
+	tt.Expect(result).To(Equal(controller.Result{}))
+}
+
+func TestReconcileControlPlaneNotReady(t *testing.T) {
+	tt := newReconcilerTest(t)
+	r := storageclass.New(tt.managementClient(), tt.remoteClientRegistry)
+
+	result, err := r.Reconcile(tt.ctx, nullLog(), tt.cluster)
+	tt.Expect(err).NotTo(HaveOccurred())
+	tt.Expect(result).To(Equal(controller.ResultWithRequeue(5 * time.Second)))
+}
+
+func TestReconcileRemoteGetClientError(t *testing.T) {
+	tt := newReconcilerTest(t)
+	r := storageclass.New(tt.managementClient(tt.kcp), tt.remoteClientRegistry)
+	tt.remoteClientRegistry.EXPECT().
+		GetClient(tt.ctx, gomock.AssignableToTypeOf(client.ObjectKey{})).
+		Return(nil, errors.New("client error"))
+
+	result, err := r.Reconcile(tt.ctx, nullLog(), tt.cluster)
+	tt.Expect(err).To(MatchError(ContainSubstring("client error")))
+	tt.Expect(result).To(Equal(controller.Result{}))
+}
+
+func TestReconcileMissingCSIDriver(t *testing.T) {
+	tt := newReconcilerTest(t)
+	r := storageclass.New(tt.managementClient(tt.kcp), tt.remoteClientRegistry)
+	remoteClient := tt.remoteClient()
+
+	result, err := r.Reconcile(tt.ctx, nullLog(), tt.cluster)
+	tt.Expect(err).NotTo(HaveOccurred())
+	tt.Expect(result).To(Equal(controller.ResultWithRequeue(time.Minute)))
+	tt.Expect(tt.cluster.Status.FailureReason).To(HaveValue(Equal(anywherev1.DefaultStorageClassInvalidReason)))
+	tt.Expect(tt.cluster.Status.FailureMessage).To(HaveValue(ContainSubstring("CSI driver csi.vsphere.vmware.com for the default storage class is not installed")))
+
+	storageClasses := &storagev1.StorageClassList{}
+	tt.Expect(remoteClient.List(tt.ctx, storageClasses)).To(Succeed())
+	tt.Expect(storageClasses.Items).To(BeEmpty())
+}
+
+func TestReconcileCreatesStorageClass(t *testing.T) {
+	tt := newReconcilerTest(t)
+	r := storageclass.New(tt.managementClient(tt.kcp), tt.remoteClientRegistry)
+	remoteClient := tt.remoteClient(tt.csiDriver)
+
+	result, err := r.Reconcile(tt.ctx, nullLog(), tt.cluster)
+	tt.Expect(err).NotTo(HaveOccurred())
+	tt.Expect(result).To(Equal(controller.Result{}))
+	tt.Expect(tt.cluster.HasFailure()).To(BeFalse())
+
+	storageClass := tt.getStorageClass(remoteClient)
+	tt.Expect(storageClass.Annotations).To(HaveKeyWithValue("storageclass.kubernetes.io/is-default-class", "true"))
+	tt.Expect(storageClass.Provisioner).To(Equal("csi.vsphere.vmware.com"))
+	tt.Expect(storageClass.Parameters).To(Equal(map[string]string{"storagepolicyname": "vSAN Default Storage Policy"}))
+	tt.Expect(storageClass.ReclaimPolicy).To(HaveValue(Equal(corev1.PersistentVolumeReclaimDelete)))
+}
+
+func TestReconcileRecreatesStorageClassWithNewConfiguration(t *testing.T) {
+	tt := newReconcilerTest(t)
+	current := storageclass.StorageClass(tt.cluster.Spec.DefaultStorageClass)
+	tt.cluster.Spec.DefaultStorageClass.ReclaimPolicy = corev1.PersistentVolumeReclaimRetain
+	tt.cluster.Spec.DefaultStorageClass.Parameters = map[string]string{
+		"storagepolicyname": "gold",
+	}
+	r := storageclass.New(tt.managementClient(tt.kcp), tt.remoteClientRegistry)
+	remoteClient := tt.remoteClient(tt.csiDriver, current)
+
+	result, err := r.Reconcile(tt.ctx, nullLog(), tt.cluster)
+	tt.Expect(err).NotTo(HaveOccurred())
+	tt.Expect(result).To(Equal(controller.Result{}))
+
+	storageClass := tt.getStorageClass(remoteClient)
+	tt.Expect(storageClass.Parameters).To(Equal(map[string]string{"storagepolicyname": "gold"}))
+	tt.Expect(storageClass.ReclaimPolicy).To(HaveValue(Equal(corev1.PersistentVolumeReclaimRetain)))
+}
+
+func TestReconcileStorageClassUpToDate(t *testing.T) {
+	tt := newReconcilerTest(t)
+	current := storageclass.StorageClass(tt.cluster.Spec.DefaultStorageClass)
+	current.Labels = map[string]string{"team": "storage"}
+	r := storageclass.New(tt.managementClient(tt.kcp), tt.remoteClientRegistry)
+	remoteClient := tt.remoteClient(tt.csiDriver, current)
+
+	result, err := r.Reconcile(tt.ctx, nullLog(), tt.cluster)
+	tt.Expect(err).NotTo(HaveOccurred())
+	tt.Expect(result).To(Equal(controller.Result{}))
+
+	// The storage class is not recreated, so fields not managed by EKS Anywhere are kept.
+	storageClass := tt.getStorageClass(remoteClient)
+	tt.Expect(storageClass.Labels).To(HaveKeyWithValue("team", "storage"))
+}
+
+func TestStorageClassDefaultReclaimPolicy(t *testing.T) {
+	g := NewWithT(t)
+	storageClass := storageclass.StorageClass(&anywherev1.DefaultStorageClass{
+		Provisioner: "ebs.csi.aws.com",
+	})
+
+	g.Expect(storageClass.Name).To(Equal("eksa-default"))
+	g.Expect(storageClass.Provisioner).To(Equal("ebs.csi.aws.com"))
+	g.Expect(storageClass.Parameters).To(BeNil())
+	g.Expect(storageClass.ReclaimPolicy).To(HaveValue(Equal(corev1.PersistentVolumeReclaimDelete)))
+}
@@ -0,0 +1,140 @@
+// Package telemetry implements the opt-in collection of anonymized usage metrics for CLI commands.
+package telemetry
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/eks-anywhere/pkg/validations"
+)
+
+const (
+	// EnabledEnvVar opts in to telemetry when set to true.
+	EnabledEnvVar = "EKSA_TELEMETRY_ENABLED"
+	// EndpointEnvVar is the URL telemetry events are sent to.
+	EndpointEnvVar = "EKSA_TELEMETRY_ENDPOINT"
+
+	sendTimeout = 5 * time.Second
+)
+
+// FailureCategory is an anonymized classification of the error a command failed with.
+type FailureCategory string
+
+const (
+	// FailureCategoryValidation is used when the command fails because of a preflight validation.
+	FailureCategoryValidation FailureCategory = "validation"
+	// FailureCategoryTimeout is used when the command fails because an operation timed out.
+	FailureCategoryTimeout FailureCategory = "timeout"
+	// FailureCategoryCanceled is used when the command is canceled.
+	FailureCategoryCanceled FailureCategory = "canceled"
+	// FailureCategoryOther is used for any other failure.
+	FailureCategoryOther FailureCategory = "other"
+)
+
+// Event is the record of a command execution. It doesn't contain any information
+// that identifies the user, their infrastructure or their clusters: error messages are
+// reduced to a FailureCategory.
+type Event struct {
+	Command         string          `json:"command"`
+	Provider        string          `json:"provider,omitempty"`
+	CLIVersion      string          `json:"cliVersion"`
+	DurationSeconds float64         `json:"durationSeconds"`
+	Success         bool            `json:"success"`
+	FailureCategory FailureCategory `json:"failureCategory,omitempty"`
+}
+
+// NewEvent builds an Event for a command that finished with err after running for duration.
+func NewEvent(command, provider, cliVersion string, duration time.Duration, err error) Event {
+	return Event{
+		Command:         command,
+		Provider:        provider,
+		CLIVersion:      cliVersion,
+		DurationSeconds: duration.Round(time.Millisecond).Seconds(),
+		Success:         err == nil,
+		FailureCategory: Categorize(err),
+	}
+}
+
+// Categorize returns the FailureCategory for an error. It returns an empty category for a nil error.
+func Categorize(err error) FailureCategory {
+	validationErr := &validations.ValidationError{}
+	versionSkewErr := &validations.VersionSkewError{}
+	switch {
+	case err == nil:
+		return ""
+	case errors.As(err, &validationErr), errors.As(err, &versionSkewErr):
+		return FailureCategoryValidation
+	case errors.Is(err, context.DeadlineExceeded):
+		return FailureCategoryTimeout
+	case errors.Is(err, context.Canceled):
+		return FailureCategoryCanceled
+	default:
+		return FailureCategoryOther
+	}
+}
+
+// ProviderFromDatacenterKind returns the provider name for a datacenter config kind,
+// e.g. vsphere for VSphereDatacenterConfig.
+func ProviderFromDatacenterKind(kind string) string {
+	return strings.ToLower(strings.TrimSuffix(kind, "DatacenterConfig"))
+}
+
+// Client sends telemetry events to an endpoint.
+type Client struct {
+	endpoint   string
+	httpClient *http.Client
+}
+
+// NewClient returns a Client that sends events to endpoint.
+func NewClient(endpoint string) *Client {
+	return &Client{
+		endpoint:   endpoint,
+		httpClient: &http.Client{Timeout: sendTimeout},
+	}
+}
+
+// NewClientFromEnv returns a Client configured from the environment.
+// It returns nil if telemetry hasn't been opted in to or no endpoint is configured.
+func NewClientFromEnv() *Client {
+	enabled, _ := strconv.ParseBool(os.Getenv(EnabledEnvVar))
+	endpoint := os.Getenv(EndpointEnvVar)
+	if !enabled || endpoint == "" {
+		return nil
+	}
+
+	return NewClient(endpoint)
+}
+
+// Send posts an event to the telemetry endpoint.
+func (c *Client) Send(ctx context.Context, event Event) error {
+	body, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("marshalling telemetry event: %v", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.endpoint, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("building telemetry request: %v", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("sending telemetry event: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("sending telemetry event: unexpected status code %d", resp.StatusCode)
+	}
+
+	return nil
+}
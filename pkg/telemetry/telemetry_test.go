@@ -0,0 +1,145 @@
+package telemetry_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/aws/eks-anywhere/pkg/telemetry"
+	"github.com/aws/eks-anywhere/pkg/validations"
+)
+
+func TestCategorize(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want telemetry.FailureCategory
+	}{
+		{
+			name: "no error",
+			want: "",
+		},
+		{
+			name: "validation error",
+			err:  fmt.Errorf("running validations: %w", &validations.ValidationError{Errs: []string{"invalid"}}),
+			want: telemetry.FailureCategoryValidation,
+		},
+		{
+			name: "version skew error",
+			err:  &validations.VersionSkewError{},
+			want: telemetry.FailureCategoryValidation,
+		},
+		{
+			name: "timeout",
+			err:  fmt.Errorf("waiting for control plane: %w", context.DeadlineExceeded),
+			want: telemetry.FailureCategoryTimeout,
+		},
+		{
+			name: "canceled",
+			err:  context.Canceled,
+			want: telemetry.FailureCategoryCanceled,
+		},
+		{
+			name: "other",
+			err:  errors.New("cluster my-cluster failed"),
+			want: telemetry.FailureCategoryOther,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(telemetry.Categorize(tt.err)).To(Equal(tt.want))
+		})
+	}
+}
+
+func TestNewEvent(t *testing.T) {
+	g := NewWithT(t)
+	got := telemetry.NewEvent("anywhere create cluster", "vsphere", "v0.21.0", 1500*time.Millisecond, errors.New("failed creating cluster my-cluster"))
+	g.Expect(got).To(Equal(telemetry.Event{
+		Command:         "anywhere create cluster",
+		Provider:        "vsphere",
+		CLIVersion:      "v0.21.0",
+		DurationSeconds: 1.5,
+		Success:         false,
+		FailureCategory: telemetry.FailureCategoryOther,
+	}))
+}
+
+func TestProviderFromDatacenterKind(t *testing.T) {
+	g := NewWithT(t)
+	g.Expect(telemetry.ProviderFromDatacenterKind("VSphereDatacenterConfig")).To(Equal("vsphere"))
+	g.Expect(telemetry.ProviderFromDatacenterKind("TinkerbellDatacenterConfig")).To(Equal("tinkerbell"))
+}
+
+func TestNewClientFromEnv(t *testing.T) {
+	tests := []struct {
+		name     string
+		enabled  string
+		endpoint string
+		wantNil  bool
+	}{
+		{
+			name:     "not opted in",
+			endpoint: "https://telemetry.example.com",
+			wantNil:  true,
+		},
+		{
+			name:    "no endpoint",
+			enabled: "true",
+			wantNil: true,
+		},
+		{
+			name:     "opted in",
+			enabled:  "true",
+			endpoint: "https://telemetry.example.com",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			t.Setenv(telemetry.EnabledEnvVar, tt.enabled)
+			t.Setenv(telemetry.EndpointEnvVar, tt.endpoint)
+			if tt.wantNil {
+				g.Expect(telemetry.NewClientFromEnv()).To(BeNil())
+			} else {
+				g.Expect(telemetry.NewClientFromEnv()).NotTo(BeNil())
+			}
+		})
+	}
+}
+
+func TestClientSend(t *testing.T) {
+	g := NewWithT(t)
+	event := telemetry.NewEvent("anywhere upgrade cluster", "cloudstack", "v0.21.0", time.Minute, nil)
+
+	var got telemetry.Event
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		g.Expect(r.Method).To(Equal(http.MethodPost))
+		g.Expect(r.Header.Get("Content-Type")).To(Equal("application/json"))
+		g.Expect(json.NewDecoder(r.Body).Decode(&got)).To(Succeed())
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer server.Close()
+
+	g.Expect(telemetry.NewClient(server.URL).Send(context.Background(), event)).To(Succeed())
+	g.Expect(got).To(Equal(event))
+}
+
+func TestClientSendErrorStatus(t *testing.T) {
+	g := NewWithT(t)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	err := telemetry.NewClient(server.URL).Send(context.Background(), telemetry.Event{})
+	g.Expect(err).To(MatchError(ContainSubstring("unexpected status code 500")))
+}
@@ -0,0 +1,320 @@
+package upgradeplan
+
+import (
+	"strconv"
+
+	"github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/cluster"
+	releasev1 "github.com/aws/eks-anywhere/release/api/v1alpha1"
+)
+
+// ControlPlaneNodeGroup is the name of the control plane in the node groups of a Diff.
+const ControlPlaneNodeGroup = "control-plane"
+
+// Diff is a structured diff of every component of a cluster between its current and target
+// Bundles, including the unchanged ones, so change management tooling can consume it.
+type Diff struct {
+	ClusterName string          `json:"clusterName"`
+	Components  []ComponentDiff `json:"components"`
+	NodeGroups  []NodeGroupDiff `json:"nodeGroups"`
+}
+
+// ComponentDiff is the version change of a cluster component.
+type ComponentDiff struct {
+	Name       string `json:"name"`
+	OldVersion string `json:"oldVersion"`
+	NewVersion string `json:"newVersion"`
+	Changed    bool   `json:"changed"`
+}
+
+// NodeGroupDiff is the change of the kubelet version and the OS template of the machines of a
+// node group. The old fields are empty for new node groups and the new fields for removed ones.
+type NodeGroupDiff struct {
+	Name              string `json:"name"`
+	OldKubeletVersion string `json:"oldKubeletVersion"`
+	NewKubeletVersion string `json:"newKubeletVersion"`
+	OldOSTemplate     string `json:"oldOSTemplate"`
+	NewOSTemplate     string `json:"newOSTemplate"`
+	Changed           bool   `json:"changed"`
+}
+
+// Changed returns true if any of the components or node groups changes.
+func (d *Diff) Changed() bool {
+	for _, c := range d.Components {
+		if c.Changed {
+			return true
+		}
+	}
+
+	for _, n := range d.NodeGroups {
+		if n.Changed {
+			return true
+		}
+	}
+
+	return false
+}
+
+// BuildDiff compares the current spec of a cluster with the spec it will be upgraded to.
+func BuildDiff(currentSpec, newSpec *cluster.Spec) *Diff {
+	return &Diff{
+		ClusterName: newSpec.Cluster.Name,
+		Components:  componentsDiff(currentSpec, newSpec),
+		NodeGroups:  nodeGroupsDiff(currentSpec, newSpec),
+	}
+}
+
+func componentsDiff(currentSpec, newSpec *cluster.Spec) []ComponentDiff {
+	current := componentVersions(currentSpec)
+	target := componentVersions(newSpec)
+
+	diffs := make([]ComponentDiff, 0, len(target))
+	for _, t := range target {
+		diffs = append(diffs, newComponentDiff(t.name, current.version(t.name), t.version))
+	}
+
+	// Components only in the current spec, like the kube-vip of a provider
+	// that doesn't need it anymore, are reported as removed.
+	for _, c := range current {
+		if target.version(c.name) == "" && c.version != "" {
+			diffs = append(diffs, newComponentDiff(c.name, c.version, ""))
+		}
+	}
+
+	return diffs
+}
+
+func newComponentDiff(name, oldVersion, newVersion string) ComponentDiff {
+	return ComponentDiff{
+		Name:       name,
+		OldVersion: oldVersion,
+		NewVersion: newVersion,
+		Changed:    oldVersion != newVersion,
+	}
+}
+
+type componentVersion struct {
+	name, version string
+}
+
+type componentVersionList []componentVersion
+
+func (l componentVersionList) version(name string) string {
+	for _, c := range l {
+		if c.name == name {
+			return c.version
+		}
+	}
+
+	return ""
+}
+
+func componentVersions(spec *cluster.Spec) componentVersionList {
+	b := spec.RootVersionsBundle()
+	if b == nil {
+		return nil
+	}
+
+	versions := componentVersionList{
+		{name: "eks-anywhere", version: b.Eksa.Version},
+		{name: "kubernetes", version: b.EksD.KubeVersion},
+		{name: "cert-manager", version: b.CertManager.Version},
+		{name: "cluster-api", version: b.ClusterAPI.Version},
+		{name: "kubeadm-bootstrap", version: b.Bootstrap.Version},
+		{name: "kubeadm-control-plane", version: b.ControlPlane.Version},
+	}
+
+	if spec.Cluster.Spec.ExternalEtcdConfiguration != nil {
+		versions = append(versions,
+			componentVersion{name: "etcdadm-bootstrap", version: b.ExternalEtcdBootstrap.Version},
+			componentVersion{name: "etcdadm-controller", version: b.ExternalEtcdController.Version},
+		)
+	}
+
+	if name, version, kubeVip := infrastructureProvider(spec.Cluster.Spec.DatacenterRef.Kind, b.VersionsBundle); name != "" {
+		versions = append(versions, componentVersion{name: name, version: version})
+		if kubeVip != nil {
+			versions = append(versions, componentVersion{name: "kube-vip", version: kubeVip.Tag()})
+		}
+	}
+
+	if cni := spec.Cluster.Spec.ClusterNetwork.CNIConfig; cni != nil && cni.Cilium != nil {
+		versions = append(versions, componentVersion{name: "cilium", version: b.Cilium.Version})
+	}
+
+	if cni := spec.Cluster.Spec.ClusterNetwork.CNIConfig; cni != nil && cni.Calico != nil && b.Calico != nil {
+		versions = append(versions, componentVersion{name: "calico", version: b.Calico.Version})
+	}
+
+	if spec.Cluster.Spec.GitOpsRef != nil {
+		versions = append(versions, componentVersion{name: "flux", version: b.Flux.Version})
+	}
+
+	return versions
+}
+
+// infrastructureProvider returns the name and version of the CAPI infrastructure provider of a
+// datacenter kind, and the kube-vip image it runs the control plane endpoint with, if any.
+func infrastructureProvider(datacenterKind string, b *releasev1.VersionsBundle) (name, version string, kubeVip *releasev1.Image) {
+	switch datacenterKind {
+	case v1alpha1.VSphereDatacenterKind:
+		return "cluster-api-provider-vsphere", b.VSphere.Version, &b.VSphere.KubeVip
+	case v1alpha1.CloudStackDatacenterKind:
+		return "cluster-api-provider-cloudstack", b.CloudStack.Version, &b.CloudStack.KubeVip
+	case v1alpha1.DockerDatacenterKind:
+		return "cluster-api-provider-docker", b.Docker.Version, nil
+	case v1alpha1.TinkerbellDatacenterKind:
+		return "cluster-api-provider-tinkerbell", b.Tinkerbell.Version, &b.Tinkerbell.KubeVip
+	case v1alpha1.SnowDatacenterKind:
+		return "cluster-api-provider-snow", b.Snow.Version, &b.Snow.KubeVip
+	case v1alpha1.NutanixDatacenterKind:
+		return "cluster-api-provider-nutanix", b.Nutanix.Version, &b.Nutanix.KubeVip
+	case v1alpha1.ProxmoxDatacenterKind:
+		return "cluster-api-provider-proxmox", b.Proxmox.Version, &b.Proxmox.KubeVip
+	case v1alpha1.OpenStackDatacenterKind:
+		return "cluster-api-provider-openstack", b.OpenStack.Version, &b.OpenStack.KubeVip
+	case v1alpha1.AzureStackHCIDatacenterKind:
+		return "cluster-api-provider-azurestackhci", b.AzureStackHCI.Version, &b.AzureStackHCI.KubeVip
+	case v1alpha1.HarvesterDatacenterKind:
+		return "cluster-api-provider-harvester", b.Harvester.Version, &b.Harvester.KubeVip
+	}
+
+	return "", "", nil
+}
+
+type nodeGroup struct {
+	name           string
+	kubeletVersion string
+	osTemplate     string
+}
+
+func nodeGroupsDiff(currentSpec, newSpec *cluster.Spec) []NodeGroupDiff {
+	current := nodeGroups(currentSpec)
+	target := nodeGroups(newSpec)
+
+	currentByName := make(map[string]nodeGroup, len(current))
+	for _, n := range current {
+		currentByName[n.name] = n
+	}
+
+	diffs := make([]NodeGroupDiff, 0, len(target))
+	targetNames := make(map[string]struct{}, len(target))
+	for _, t := range target {
+		targetNames[t.name] = struct{}{}
+		diffs = append(diffs, newNodeGroupDiff(t.name, currentByName[t.name], t))
+	}
+
+	for _, c := range current {
+		if _, ok := targetNames[c.name]; !ok {
+			diffs = append(diffs, newNodeGroupDiff(c.name, c, nodeGroup{}))
+		}
+	}
+
+	return diffs
+}
+
+func newNodeGroupDiff(name string, current, target nodeGroup) NodeGroupDiff {
+	return NodeGroupDiff{
+		Name:              name,
+		OldKubeletVersion: current.kubeletVersion,
+		NewKubeletVersion: target.kubeletVersion,
+		OldOSTemplate:     current.osTemplate,
+		NewOSTemplate:     target.osTemplate,
+		Changed:           current != target,
+	}
+}
+
+func nodeGroups(spec *cluster.Spec) []nodeGroup {
+	groups := make([]nodeGroup, 0, len(spec.Cluster.Spec.WorkerNodeGroupConfigurations)+1)
+	groups = append(groups, nodeGroup{
+		name:           ControlPlaneNodeGroup,
+		kubeletVersion: kubeletVersion(spec.RootVersionsBundle()),
+		osTemplate:     osTemplate(spec, spec.Cluster.Spec.ControlPlaneConfiguration.MachineGroupRef, spec.RootVersionsBundle()),
+	})
+
+	for _, w := range spec.Cluster.Spec.WorkerNodeGroupConfigurations {
+		b := spec.WorkerNodeGroupVersionsBundle(w)
+		groups = append(groups, nodeGroup{
+			name:           w.Name,
+			kubeletVersion: kubeletVersion(b),
+			osTemplate:     osTemplate(spec, w.MachineGroupRef, b),
+		})
+	}
+
+	return groups
+}
+
+func kubeletVersion(b *cluster.VersionsBundle) string {
+	if b == nil {
+		return ""
+	}
+
+	return b.EksD.KubeVersion
+}
+
+// osTemplate returns the identifier of the template, image or AMI the machines of a node group
+// are created from.
+func osTemplate(spec *cluster.Spec, ref *v1alpha1.Ref, b *cluster.VersionsBundle) string {
+	if spec.Cluster.Spec.DatacenterRef.Kind == v1alpha1.DockerDatacenterKind {
+		if b == nil {
+			return ""
+		}
+		return b.EksD.KindNode.VersionedImage()
+	}
+
+	if ref == nil {
+		return ""
+	}
+
+	switch ref.Kind {
+	case v1alpha1.VSphereMachineConfigKind:
+		if m := spec.VsphereMachineConfig(ref.Name); m != nil {
+			return m.Spec.Template
+		}
+	case v1alpha1.CloudStackMachineConfigKind:
+		if m := spec.CloudStackMachineConfig(ref.Name); m != nil {
+			if m.Spec.Template.Name != "" {
+				return m.Spec.Template.Name
+			}
+			return m.Spec.Template.Id
+		}
+	case v1alpha1.TinkerbellMachineConfigKind:
+		if m := spec.TinkerbellMachineConfigs[ref.Name]; m != nil && m.Spec.OSImageURL != "" {
+			return m.Spec.OSImageURL
+		}
+		if spec.TinkerbellDatacenter != nil {
+			return spec.TinkerbellDatacenter.Spec.OSImageURL
+		}
+	case v1alpha1.SnowMachineConfigKind:
+		if m := spec.SnowMachineConfig(ref.Name); m != nil {
+			return m.Spec.AMIID
+		}
+	case v1alpha1.NutanixMachineConfigKind:
+		if m := spec.NutanixMachineConfig(ref.Name); m != nil {
+			if m.Spec.Image.Name != nil {
+				return *m.Spec.Image.Name
+			}
+			if m.Spec.Image.UUID != nil {
+				return *m.Spec.Image.UUID
+			}
+		}
+	case v1alpha1.ProxmoxMachineConfigKind:
+		if m := spec.ProxmoxMachineConfig(ref.Name); m != nil {
+			return strconv.Itoa(int(m.Spec.TemplateID))
+		}
+	case v1alpha1.OpenStackMachineConfigKind:
+		if m := spec.OpenStackMachineConfig(ref.Name); m != nil {
+			return m.Spec.Image
+		}
+	case v1alpha1.AzureStackHCIMachineConfigKind:
+		if m := spec.AzureStackHCIMachineConfig(ref.Name); m != nil {
+			return m.Spec.Image
+		}
+	case v1alpha1.HarvesterMachineConfigKind:
+		if m := spec.HarvesterMachineConfig(ref.Name); m != nil {
+			return m.Spec.Image
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,128 @@
+package upgradeplan_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/aws/eks-anywhere/internal/test"
+	"github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/cluster"
+	"github.com/aws/eks-anywhere/pkg/upgradeplan"
+	releasev1 "github.com/aws/eks-anywhere/release/api/v1alpha1"
+)
+
+func vSphereSpec(kubeVersion, capiVersion, kubeVipTag, template string) *cluster.Spec {
+	return test.NewClusterSpec(func(s *cluster.Spec) {
+		s.Cluster.Name = "my-cluster"
+		s.Cluster.Spec.KubernetesVersion = v1alpha1.Kube133
+		s.Cluster.Spec.DatacenterRef = v1alpha1.Ref{Kind: v1alpha1.VSphereDatacenterKind, Name: "my-cluster"}
+		s.Cluster.Spec.ClusterNetwork.CNIConfig = &v1alpha1.CNIConfig{Cilium: &v1alpha1.CiliumConfig{}}
+		s.Cluster.Spec.ControlPlaneConfiguration.MachineGroupRef = &v1alpha1.Ref{Kind: v1alpha1.VSphereMachineConfigKind, Name: "cp"}
+		s.Cluster.Spec.WorkerNodeGroupConfigurations = []v1alpha1.WorkerNodeGroupConfiguration{
+			{
+				Name:            "md-0",
+				MachineGroupRef: &v1alpha1.Ref{Kind: v1alpha1.VSphereMachineConfigKind, Name: "worker"},
+			},
+		}
+		s.VSphereMachineConfigs = map[string]*v1alpha1.VSphereMachineConfig{
+			"cp":     {Spec: v1alpha1.VSphereMachineConfigSpec{Template: template}},
+			"worker": {Spec: v1alpha1.VSphereMachineConfigSpec{Template: template}},
+		}
+		s.VersionsBundles = map[v1alpha1.KubernetesVersion]*cluster.VersionsBundle{
+			v1alpha1.Kube133: {
+				VersionsBundle: &releasev1.VersionsBundle{
+					EksD:        releasev1.EksDRelease{KubeVersion: kubeVersion},
+					Eksa:        releasev1.EksaBundle{Version: "v0.23.0"},
+					CertManager: releasev1.CertManagerBundle{Version: "v1.16.0"},
+					ClusterAPI:  releasev1.CoreClusterAPI{Version: capiVersion},
+					Cilium:      releasev1.CiliumBundle{Version: "v1.16.8"},
+					VSphere: releasev1.VSphereBundle{
+						Version: "v1.12.0",
+						KubeVip: releasev1.Image{URI: "public.ecr.aws/eks-anywhere/kube-vip:" + kubeVipTag},
+					},
+				},
+				KubeDistro: &cluster.KubeDistro{},
+			},
+		}
+	})
+}
+
+func TestBuildDiffComponents(t *testing.T) {
+	g := NewWithT(t)
+	current := vSphereSpec("v1.33.1", "v1.9.0", "v0.8.9", "ubuntu-2204-kube-v1.33")
+	target := vSphereSpec("v1.33.1", "v1.10.0", "v0.9.2", "ubuntu-2204-kube-v1.33")
+
+	diff := upgradeplan.BuildDiff(current, target)
+
+	g.Expect(diff.ClusterName).To(Equal("my-cluster"))
+	g.Expect(diff.Changed()).To(BeTrue())
+	g.Expect(diff.Components).To(ContainElements(
+		upgradeplan.ComponentDiff{Name: "cluster-api", OldVersion: "v1.9.0", NewVersion: "v1.10.0", Changed: true},
+		upgradeplan.ComponentDiff{Name: "kube-vip", OldVersion: "v0.8.9", NewVersion: "v0.9.2", Changed: true},
+		upgradeplan.ComponentDiff{Name: "cilium", OldVersion: "v1.16.8", NewVersion: "v1.16.8", Changed: false},
+		upgradeplan.ComponentDiff{Name: "cluster-api-provider-vsphere", OldVersion: "v1.12.0", NewVersion: "v1.12.0", Changed: false},
+	))
+	g.Expect(diff.Components).NotTo(ContainElement(HaveField("Name", "flux")))
+}
+
+func TestBuildDiffNodeGroups(t *testing.T) {
+	g := NewWithT(t)
+	current := vSphereSpec("v1.33.1", "v1.9.0", "v0.8.9", "ubuntu-2204-kube-v1.33")
+	target := vSphereSpec("v1.33.4", "v1.9.0", "v0.8.9", "ubuntu-2204-kube-v1.33-2")
+	target.Cluster.Spec.WorkerNodeGroupConfigurations[0].Name = "md-1"
+
+	diff := upgradeplan.BuildDiff(current, target)
+
+	g.Expect(diff.NodeGroups).To(Equal([]upgradeplan.NodeGroupDiff{
+		{
+			Name:              upgradeplan.ControlPlaneNodeGroup,
+			OldKubeletVersion: "v1.33.1",
+			NewKubeletVersion: "v1.33.4",
+			OldOSTemplate:     "ubuntu-2204-kube-v1.33",
+			NewOSTemplate:     "ubuntu-2204-kube-v1.33-2",
+			Changed:           true,
+		},
+		{
+			Name:              "md-1",
+			NewKubeletVersion: "v1.33.4",
+			NewOSTemplate:     "ubuntu-2204-kube-v1.33-2",
+			Changed:           true,
+		},
+		{
+			Name:              "md-0",
+			OldKubeletVersion: "v1.33.1",
+			OldOSTemplate:     "ubuntu-2204-kube-v1.33",
+			Changed:           true,
+		},
+	}))
+}
+
+func TestBuildDiffNoChanges(t *testing.T) {
+	g := NewWithT(t)
+	current := vSphereSpec("v1.33.1", "v1.9.0", "v0.8.9", "ubuntu-2204-kube-v1.33")
+	target := vSphereSpec("v1.33.1", "v1.9.0", "v0.8.9", "ubuntu-2204-kube-v1.33")
+
+	diff := upgradeplan.BuildDiff(current, target)
+
+	g.Expect(diff.Changed()).To(BeFalse())
+	g.Expect(diff.Components).NotTo(BeEmpty())
+	g.Expect(diff.NodeGroups).To(HaveLen(2))
+}
+
+func TestBuildDiffDockerNodeImage(t *testing.T) {
+	g := NewWithT(t)
+	current := test.NewClusterSpec(func(s *cluster.Spec) {
+		s.Cluster.Spec.DatacenterRef = v1alpha1.Ref{Kind: v1alpha1.DockerDatacenterKind}
+		s.VersionsBundles[v1alpha1.Kube119].EksD.KindNode = releasev1.Image{URI: "public.ecr.aws/eks-anywhere/kind-node:v1.19.8-eks-1-19-7"}
+		s.VersionsBundles[v1alpha1.Kube119].Docker = releasev1.DockerBundle{Version: "v1.9.0"}
+	})
+
+	diff := upgradeplan.BuildDiff(current, current)
+
+	g.Expect(diff.NodeGroups[0].NewOSTemplate).To(Equal("public.ecr.aws/eks-anywhere/kind-node:v1.19.8-eks-1-19-7"))
+	g.Expect(diff.Components).To(ContainElement(
+		upgradeplan.ComponentDiff{Name: "cluster-api-provider-docker", OldVersion: "v1.9.0", NewVersion: "v1.9.0"},
+	))
+	g.Expect(diff.Components).NotTo(ContainElement(HaveField("Name", "kube-vip")))
+}
@@ -0,0 +1,136 @@
+package upgradeplan
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"sigs.k8s.io/yaml"
+
+	"github.com/aws/eks-anywhere/pkg/cluster"
+	"github.com/aws/eks-anywhere/pkg/filewriter"
+	"github.com/aws/eks-anywhere/pkg/types"
+	releasev1 "github.com/aws/eks-anywhere/release/api/v1alpha1"
+)
+
+const (
+	// FormatVersion is the version of the plan file format.
+	FormatVersion = "v1"
+
+	bundlesFileName = "upgrade-plan-bundles.yaml"
+)
+
+// Plan is a frozen upgrade plan for a cluster. It pins the CLI version, which determines the
+// templates used to generate the cluster objects, the cluster config and the resolved Bundles,
+// which determine the versions and images of all the components, so the upgrade can be approved
+// and executed later without drift.
+type Plan struct {
+	FormatVersion       string                      `json:"formatVersion"`
+	CLIVersion          string                      `json:"cliVersion"`
+	ClusterName         string                      `json:"clusterName"`
+	ClusterConfigSHA256 string                      `json:"clusterConfigSha256"`
+	EKSAVersion         string                      `json:"eksaVersion"`
+	Components          []types.ComponentChangeDiff `json:"components"`
+	Bundles             *releasev1.Bundles          `json:"bundles"`
+}
+
+// New builds a Plan from the resolved spec of the upgrade, the content of the cluster config file and
+// the component changes it will perform.
+func New(spec *cluster.Spec, clusterConfig []byte, diff *types.ChangeDiff, cliVersion string) *Plan {
+	p := &Plan{
+		FormatVersion:       FormatVersion,
+		CLIVersion:          cliVersion,
+		ClusterName:         spec.Cluster.Name,
+		ClusterConfigSHA256: checksum(clusterConfig),
+		Bundles:             spec.Bundles,
+		Components:          []types.ComponentChangeDiff{},
+	}
+
+	if spec.Cluster.Spec.EksaVersion != nil {
+		p.EKSAVersion = string(*spec.Cluster.Spec.EksaVersion)
+	}
+
+	if diff != nil {
+		p.Components = append(p.Components, diff.ComponentReports...)
+	}
+
+	return p
+}
+
+// Write writes the Plan as json to the given path.
+func Write(path string, p *Plan) error {
+	content, err := json.MarshalIndent(p, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshalling upgrade plan: %v", err)
+	}
+
+	if err := os.WriteFile(path, content, 0o644); err != nil {
+		return fmt.Errorf("writing upgrade plan to %s: %v", path, err)
+	}
+
+	return nil
+}
+
+// Read reads a Plan from the given path.
+func Read(path string) (*Plan, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading upgrade plan %s: %v", path, err)
+	}
+
+	p := &Plan{}
+	if err := json.Unmarshal(content, p); err != nil {
+		return nil, fmt.Errorf("parsing upgrade plan %s: %v", path, err)
+	}
+
+	if p.FormatVersion != FormatVersion {
+		return nil, fmt.Errorf("unsupported upgrade plan format version %q, expected %q", p.FormatVersion, FormatVersion)
+	}
+
+	if p.Bundles == nil {
+		return nil, fmt.Errorf("upgrade plan %s doesn't contain a Bundles manifest", path)
+	}
+
+	return p, nil
+}
+
+// Validate checks the plan can be executed by this CLI for the given cluster config, failing
+// if any of them changed since the plan was generated.
+func (p *Plan) Validate(clusterName string, clusterConfig []byte, cliVersion string) error {
+	if p.CLIVersion != cliVersion {
+		return fmt.Errorf("upgrade plan was generated with CLI version %s and can't be executed with version %s", p.CLIVersion, cliVersion)
+	}
+
+	if p.ClusterName != clusterName {
+		return fmt.Errorf("upgrade plan was generated for cluster %s, not %s", p.ClusterName, clusterName)
+	}
+
+	if p.ClusterConfigSHA256 != checksum(clusterConfig) {
+		return fmt.Errorf("cluster config changed since the upgrade plan was generated, generate a new plan")
+	}
+
+	return nil
+}
+
+// WriteBundles writes the Bundles of the plan as a manifest in the generated folder of the
+// writer, so it can be used as the Bundles override of the upgrade. It returns the path of the file.
+func (p *Plan) WriteBundles(writer filewriter.FileWriter) (string, error) {
+	content, err := yaml.Marshal(p.Bundles)
+	if err != nil {
+		return "", fmt.Errorf("marshalling upgrade plan Bundles: %v", err)
+	}
+
+	path, err := writer.Write(bundlesFileName, content)
+	if err != nil {
+		return "", err
+	}
+
+	return path, nil
+}
+
+func checksum(content []byte) string {
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:])
+}
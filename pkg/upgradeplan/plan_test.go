@@ -0,0 +1,131 @@
+package upgradeplan_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/aws/eks-anywhere/internal/test"
+	"github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/cluster"
+	"github.com/aws/eks-anywhere/pkg/files"
+	"github.com/aws/eks-anywhere/pkg/manifests/bundles"
+	"github.com/aws/eks-anywhere/pkg/types"
+	"github.com/aws/eks-anywhere/pkg/upgradeplan"
+)
+
+var clusterConfig = []byte("apiVersion: anywhere.eks.amazonaws.com/v1alpha1\nkind: Cluster\n")
+
+func newPlan() *upgradeplan.Plan {
+	spec := test.NewClusterSpec(func(s *cluster.Spec) {
+		s.Cluster.Name = "my-cluster"
+		eksaVersion := v1alpha1.EksaVersion("v0.20.0")
+		s.Cluster.Spec.EksaVersion = &eksaVersion
+		s.Bundles.Name = "bundles-1"
+	})
+	diff := types.NewChangeDiff(&types.ComponentChangeDiff{ComponentName: "EKS-A", OldVersion: "v0.19.0", NewVersion: "v0.20.0"})
+
+	return upgradeplan.New(spec, clusterConfig, diff, "v0.20.0")
+}
+
+func TestPlanWriteRead(t *testing.T) {
+	g := NewWithT(t)
+	path := filepath.Join(t.TempDir(), "plan.json")
+
+	g.Expect(upgradeplan.Write(path, newPlan())).To(Succeed())
+
+	plan, err := upgradeplan.Read(path)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(plan.ClusterName).To(Equal("my-cluster"))
+	g.Expect(plan.EKSAVersion).To(Equal("v0.20.0"))
+	g.Expect(plan.Components).To(ConsistOf(types.ComponentChangeDiff{ComponentName: "EKS-A", OldVersion: "v0.19.0", NewVersion: "v0.20.0"}))
+	g.Expect(plan.Bundles.Name).To(Equal("bundles-1"))
+}
+
+func TestReadErrorMissingFile(t *testing.T) {
+	g := NewWithT(t)
+
+	_, err := upgradeplan.Read(filepath.Join(t.TempDir(), "plan.json"))
+	g.Expect(err).To(MatchError(ContainSubstring("reading upgrade plan")))
+}
+
+func TestReadErrorFormatVersion(t *testing.T) {
+	g := NewWithT(t)
+	path := filepath.Join(t.TempDir(), "plan.json")
+	g.Expect(os.WriteFile(path, []byte(`{"formatVersion":"v0"}`), 0o644)).To(Succeed())
+
+	_, err := upgradeplan.Read(path)
+	g.Expect(err).To(MatchError(ContainSubstring("unsupported upgrade plan format version \"v0\"")))
+}
+
+func TestReadErrorNoBundles(t *testing.T) {
+	g := NewWithT(t)
+	path := filepath.Join(t.TempDir(), "plan.json")
+	g.Expect(os.WriteFile(path, []byte(`{"formatVersion":"v1"}`), 0o644)).To(Succeed())
+
+	_, err := upgradeplan.Read(path)
+	g.Expect(err).To(MatchError(ContainSubstring("doesn't contain a Bundles manifest")))
+}
+
+func TestPlanValidate(t *testing.T) {
+	tests := []struct {
+		name          string
+		clusterName   string
+		clusterConfig []byte
+		cliVersion    string
+		wantErr       string
+	}{
+		{
+			name:          "same inputs",
+			clusterName:   "my-cluster",
+			clusterConfig: clusterConfig,
+			cliVersion:    "v0.20.0",
+		},
+		{
+			name:          "different cli version",
+			clusterName:   "my-cluster",
+			clusterConfig: clusterConfig,
+			cliVersion:    "v0.20.1",
+			wantErr:       "generated with CLI version v0.20.0",
+		},
+		{
+			name:          "different cluster",
+			clusterName:   "other-cluster",
+			clusterConfig: clusterConfig,
+			cliVersion:    "v0.20.0",
+			wantErr:       "generated for cluster my-cluster",
+		},
+		{
+			name:          "changed cluster config",
+			clusterName:   "my-cluster",
+			clusterConfig: append(clusterConfig, []byte("spec: {}\n")...),
+			cliVersion:    "v0.20.0",
+			wantErr:       "cluster config changed",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			err := newPlan().Validate(tt.clusterName, tt.clusterConfig, tt.cliVersion)
+			if tt.wantErr == "" {
+				g.Expect(err).NotTo(HaveOccurred())
+			} else {
+				g.Expect(err).To(MatchError(ContainSubstring(tt.wantErr)))
+			}
+		})
+	}
+}
+
+func TestPlanWriteBundles(t *testing.T) {
+	g := NewWithT(t)
+	_, writer := test.NewWriter(t)
+
+	path, err := newPlan().WriteBundles(writer)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	b, err := bundles.Read(files.NewReader(), path)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(b.Name).To(Equal("bundles-1"))
+}
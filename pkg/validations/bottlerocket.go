@@ -0,0 +1,302 @@
+package validations
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	utilvalidation "k8s.io/apimachinery/pkg/util/validation"
+	kubeletapis "k8s.io/kubelet/pkg/apis"
+	bootstrapv1beta2 "sigs.k8s.io/cluster-api/api/bootstrap/kubeadm/v1beta2"
+
+	"github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/cluster"
+	"github.com/aws/eks-anywhere/pkg/providers/common"
+)
+
+// bottlerocketCPUManagerPolicyOptions are the CPU manager policy options available on Bottlerocket
+// nodes, with the first Kubernetes version enabling them by default. Bottlerocket doesn't allow
+// setting the kubelet feature gates, so the options only available as alpha can't be used.
+var bottlerocketCPUManagerPolicyOptions = map[string]v1alpha1.KubernetesVersion{
+	"full-pcpus-only":                  v1alpha1.Kube123,
+	"distribute-cpus-across-numa":      v1alpha1.Kube131,
+	"strict-cpu-reservation":           v1alpha1.Kube133,
+	"prefer-align-cpus-by-uncorecache": v1alpha1.Kube134,
+}
+
+var (
+	bottlerocketCPUManagerPolicies      = []string{"none", "static"}
+	bottlerocketMemoryManagerPolicies   = []string{"None", "Static"}
+	bottlerocketTopologyManagerPolicies = []string{"none", "best-effort", "restricted", "single-numa-node"}
+	bottlerocketTopologyManagerScopes   = []string{"container", "pod"}
+	bottlerocketReservedResources       = []string{"cpu", "memory", "ephemeral-storage", "pid"}
+	bottlerocketEvictionSignals         = []string{
+		"memory.available", "nodefs.available", "nodefs.inodesFree", "imagefs.available",
+		"imagefs.inodesFree", "containerfs.available", "containerfs.inodesFree", "pid.available",
+	}
+	taintEffects = []corev1.TaintEffect{corev1.TaintEffectNoSchedule, corev1.TaintEffectPreferNoSchedule, corev1.TaintEffectNoExecute}
+)
+
+// bottlerocketNodeGroup is a group of nodes using Bottlerocket, with the settings rendered for them.
+type bottlerocketNodeGroup struct {
+	name              string
+	kubernetesVersion v1alpha1.KubernetesVersion
+	kubeletConfig     *bootstrapv1beta2.BottlerocketKubernetesSettings
+	hostOSConfig      *v1alpha1.HostOSConfiguration
+	taints            []corev1.Taint
+	labels            map[string]string
+}
+
+// ValidateBottlerocketSettings checks the Bottlerocket settings rendered for the node groups using
+// Bottlerocket are supported by the Kubernetes version of each group, since the Bottlerocket
+// settings API rejects them at boot and the nodes never join the cluster. It checks the kubelet
+// settings, the taints and labels formats, and the registry mirror TLS settings.
+func ValidateBottlerocketSettings(spec *cluster.Spec) error {
+	groups, err := bottlerocketNodeGroups(spec)
+	if err != nil {
+		return err
+	}
+	if len(groups) == 0 {
+		return nil
+	}
+
+	for _, g := range groups {
+		if err := g.validate(); err != nil {
+			return fmt.Errorf("bottlerocket settings for %s: %v", g.name, err)
+		}
+	}
+
+	return validateBottlerocketRegistryMirror(spec.Cluster.Spec.RegistryMirrorConfiguration)
+}
+
+func bottlerocketNodeGroups(spec *cluster.Spec) ([]bottlerocketNodeGroup, error) {
+	var groups []bottlerocketNodeGroup
+
+	cp := spec.Cluster.Spec.ControlPlaneConfiguration
+	if IsBottlerocketMachineGroup(spec, cp.MachineGroupRef) {
+		kubeletConfig, err := common.ConvertToBottlerocketKubernetesSettings(cp.KubeletConfiguration)
+		if err != nil {
+			return nil, fmt.Errorf("control plane kubelet configuration: %v", err)
+		}
+		groups = append(groups, bottlerocketNodeGroup{
+			name:              "control plane",
+			kubernetesVersion: spec.Cluster.Spec.KubernetesVersion,
+			kubeletConfig:     kubeletConfig,
+			hostOSConfig:      hostOSConfiguration(spec, cp.MachineGroupRef),
+			taints:            cp.Taints,
+			labels:            cp.Labels,
+		})
+	}
+
+	for _, w := range spec.Cluster.Spec.WorkerNodeGroupConfigurations {
+		if !IsBottlerocketMachineGroup(spec, w.MachineGroupRef) {
+			continue
+		}
+		kubeletConfig, err := common.ConvertToBottlerocketKubernetesSettings(w.KubeletConfiguration)
+		if err != nil {
+			return nil, fmt.Errorf("worker node group %s kubelet configuration: %v", w.Name, err)
+		}
+		version := spec.Cluster.Spec.KubernetesVersion
+		if w.KubernetesVersion != nil {
+			version = *w.KubernetesVersion
+		}
+		groups = append(groups, bottlerocketNodeGroup{
+			name:              "worker node group " + w.Name,
+			kubernetesVersion: version,
+			kubeletConfig:     kubeletConfig,
+			hostOSConfig:      hostOSConfiguration(spec, w.MachineGroupRef),
+			taints:            w.Taints,
+			labels:            w.Labels,
+		})
+	}
+
+	return groups, nil
+}
+
+func hostOSConfiguration(spec *cluster.Spec, ref *v1alpha1.Ref) *v1alpha1.HostOSConfiguration {
+	if mc, ok := spec.VSphereMachineConfigs[ref.Name]; ok {
+		return mc.Spec.HostOSConfiguration
+	}
+	if mc, ok := spec.TinkerbellMachineConfigs[ref.Name]; ok {
+		return mc.Spec.HostOSConfiguration
+	}
+
+	return nil
+}
+
+func (g bottlerocketNodeGroup) validate() error {
+	if err := validateBottlerocketKubernetesSettings(g.kubeletConfig, g.kubernetesVersion); err != nil {
+		return fmt.Errorf("kubelet configuration: %v", err)
+	}
+	if g.hostOSConfig != nil && g.hostOSConfig.BottlerocketConfiguration != nil {
+		if err := validateBottlerocketKubernetesSettings(g.hostOSConfig.BottlerocketConfiguration.Kubernetes, g.kubernetesVersion); err != nil {
+			return fmt.Errorf("hostOSConfiguration.bottlerocketConfiguration.kubernetes: %v", err)
+		}
+	}
+
+	for _, t := range g.taints {
+		if err := validateTaint(t); err != nil {
+			return err
+		}
+	}
+
+	for _, k := range sortedKeys(g.labels) {
+		if restrictedKubeletLabel(k) {
+			return fmt.Errorf("label %s can't be set by the kubelet, labels in the kubernetes.io and k8s.io namespaces must be one of %s or use the %s and %s namespaces",
+				k, strings.Join(kubeletapis.KubeletLabels(), ", "), corev1.LabelNamespaceSuffixKubelet, corev1.LabelNamespaceSuffixNode)
+		}
+	}
+
+	return nil
+}
+
+func validateBottlerocketKubernetesSettings(settings *bootstrapv1beta2.BottlerocketKubernetesSettings, version v1alpha1.KubernetesVersion) error {
+	if settings == nil {
+		return nil
+	}
+
+	if err := validateOneOf("cpuManagerPolicy", settings.CPUManagerPolicy, bottlerocketCPUManagerPolicies); err != nil {
+		return err
+	}
+	if err := validateOneOf("memoryManagerPolicy", settings.MemoryManagerPolicy, bottlerocketMemoryManagerPolicies); err != nil {
+		return err
+	}
+	if err := validateOneOf("topologyManagerPolicy", settings.TopologyManagerPolicy, bottlerocketTopologyManagerPolicies); err != nil {
+		return err
+	}
+	if err := validateOneOf("topologyManagerScope", settings.TopologyManagerScope, bottlerocketTopologyManagerScopes); err != nil {
+		return err
+	}
+
+	kubeVersion, err := v1alpha1.KubeVersionToSemver(version)
+	if err != nil {
+		return fmt.Errorf("parsing kubernetes version %s: %v", version, err)
+	}
+	for _, option := range sortedKeys(settings.CPUManagerPolicyOptions) {
+		minVersion, ok := bottlerocketCPUManagerPolicyOptions[option]
+		if !ok {
+			return fmt.Errorf("cpuManagerPolicyOptions %s is not supported", option)
+		}
+		minSemver, err := v1alpha1.KubeVersionToSemver(minVersion)
+		if err != nil {
+			return err
+		}
+		if kubeVersion.LessThan(minSemver) {
+			return fmt.Errorf("cpuManagerPolicyOptions %s requires kubernetes version %s or later, got %s", option, minVersion, version)
+		}
+	}
+
+	keyedSettings := []struct {
+		field   string
+		values  map[string]string
+		allowed []string
+	}{
+		{"kubeReserved", settings.KubeReserved, bottlerocketReservedResources},
+		{"systemReserved", settings.SystemReserved, bottlerocketReservedResources},
+		{"evictionHard", settings.EvictionHard, bottlerocketEvictionSignals},
+		{"evictionSoft", settings.EvictionSoft, bottlerocketEvictionSignals},
+		{"evictionSoftGracePeriod", settings.EvictionSoftGracePeriod, bottlerocketEvictionSignals},
+	}
+	for _, k := range keyedSettings {
+		for _, key := range sortedKeys(k.values) {
+			if err := validateOneOf(k.field, key, k.allowed); err != nil {
+				return err
+			}
+		}
+	}
+
+	if settings.ImageGCHighThresholdPercent != 0 && settings.ImageGCLowThresholdPercent >= settings.ImageGCHighThresholdPercent {
+		return errors.New("imageGCLowThresholdPercent must be lower than imageGCHighThresholdPercent")
+	}
+
+	return nil
+}
+
+func validateTaint(t corev1.Taint) error {
+	if errs := utilvalidation.IsQualifiedName(t.Key); len(errs) > 0 {
+		return fmt.Errorf("taint key %q is invalid: %s", t.Key, strings.Join(errs, ", "))
+	}
+	if errs := utilvalidation.IsValidLabelValue(t.Value); len(errs) > 0 {
+		return fmt.Errorf("taint %s value %q is invalid: %s", t.Key, t.Value, strings.Join(errs, ", "))
+	}
+	if !slices.Contains(taintEffects, t.Effect) {
+		return fmt.Errorf("taint %s effect %q is invalid, must be one of %s, %s or %s", t.Key, t.Effect, taintEffects[0], taintEffects[1], taintEffects[2])
+	}
+
+	return nil
+}
+
+// restrictedKubeletLabel returns whether the kubelet refuses to start with the label in its
+// node labels, because it's in the kubernetes.io or k8s.io namespaces the node can't set.
+func restrictedKubeletLabel(key string) bool {
+	namespace, _, found := strings.Cut(key, "/")
+	if !found {
+		return false
+	}
+	for _, restricted := range []string{"kubernetes.io", "k8s.io"} {
+		if namespace == restricted || strings.HasSuffix(namespace, "."+restricted) {
+			return !kubeletapis.IsKubeletLabel(key)
+		}
+	}
+
+	return false
+}
+
+// validateBottlerocketRegistryMirror checks the registry mirror TLS settings can be rendered in the
+// Bottlerocket settings: the CA is added to the trusted certificates of the nodes, so it must be a PEM
+// bundle of certificates, and the certificate verification can't be skipped.
+func validateBottlerocketRegistryMirror(mirror *v1alpha1.RegistryMirrorConfiguration) error {
+	if mirror == nil {
+		return nil
+	}
+	if mirror.InsecureSkipVerify {
+		return errors.New("registry mirror insecureSkipVerify is not supported for bottlerocket")
+	}
+	if mirror.CACertContent == "" {
+		return nil
+	}
+
+	rest := []byte(mirror.CACertContent)
+	certs := 0
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			return fmt.Errorf("registry mirror caCertContent contains a %s block, only certificates are supported for bottlerocket", block.Type)
+		}
+		if _, err := x509.ParseCertificate(block.Bytes); err != nil {
+			return fmt.Errorf("registry mirror caCertContent is not a valid certificate: %v", err)
+		}
+		certs++
+	}
+	if certs == 0 || strings.TrimSpace(string(rest)) != "" {
+		return errors.New("registry mirror caCertContent must be a PEM encoded certificate bundle for bottlerocket")
+	}
+
+	return nil
+}
+
+func validateOneOf(field, value string, allowed []string) error {
+	if value == "" || slices.Contains(allowed, value) {
+		return nil
+	}
+
+	return fmt.Errorf("%s %s is not supported, must be one of %s", field, value, strings.Join(allowed, ", "))
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+
+	return keys
+}
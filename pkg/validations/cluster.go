@@ -5,9 +5,12 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"net"
 	"net/http"
 	"os"
+	"slices"
 	"strings"
+	"time"
 
 	eksdv1alpha1 "github.com/aws/eks-distro-build-tooling/release/api/v1alpha1"
 	"sigs.k8s.io/yaml"
@@ -20,8 +23,10 @@ import (
 	"github.com/aws/eks-anywhere/pkg/logger"
 	"github.com/aws/eks-anywhere/pkg/manifests"
 	"github.com/aws/eks-anywhere/pkg/manifests/bundles"
+	"github.com/aws/eks-anywhere/pkg/networkutils"
 	"github.com/aws/eks-anywhere/pkg/providers"
 	"github.com/aws/eks-anywhere/pkg/providers/common"
+	"github.com/aws/eks-anywhere/pkg/registrymirror"
 	"github.com/aws/eks-anywhere/pkg/semver"
 	"github.com/aws/eks-anywhere/pkg/types"
 	"github.com/aws/eks-anywhere/pkg/utils/ptr"
@@ -31,6 +36,7 @@ import (
 const (
 	supportedManagementComponentsMinorVersionIncrement int64 = 1
 	releaseV022                                              = "v0.22.0"
+	registryDialTimeout                                      = 5 * time.Second
 )
 
 // ValidateOSForRegistryMirror checks if the OS is valid for the provided registry mirror configuration.
@@ -59,6 +65,110 @@ func ValidateOSForRegistryMirror(clusterSpec *cluster.Spec, provider providers.P
 	return nil
 }
 
+// ValidateBottlerocketContainersForRegistryMirror checks the images of the additional Bottlerocket
+// bootstrap and host containers are served by the registry mirror, since the nodes of a cluster
+// using one usually can't reach any other registry.
+func ValidateBottlerocketContainersForRegistryMirror(clusterSpec *cluster.Spec) error {
+	mirror := registrymirror.FromCluster(clusterSpec.Cluster)
+	if mirror == nil {
+		return nil
+	}
+
+	hostOSConfigs := map[string]*v1alpha1.HostOSConfiguration{}
+	for name, mc := range clusterSpec.VSphereMachineConfigs {
+		hostOSConfigs[name] = mc.Spec.HostOSConfiguration
+	}
+	for name, mc := range clusterSpec.TinkerbellMachineConfigs {
+		hostOSConfigs[name] = mc.Spec.HostOSConfiguration
+	}
+
+	names := make([]string, 0, len(hostOSConfigs))
+	for name := range hostOSConfigs {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	for _, name := range names {
+		config := hostOSConfigs[name]
+		if config == nil || config.BottlerocketConfiguration == nil {
+			continue
+		}
+		images := map[string]string{}
+		for _, c := range config.BottlerocketConfiguration.BootstrapContainers {
+			images["bootstrap container "+c.Name] = c.Image
+		}
+		for _, c := range config.BottlerocketConfiguration.HostContainers {
+			images["host container "+c.Name] = c.Image
+		}
+		containers := make([]string, 0, len(images))
+		for container := range images {
+			containers = append(containers, container)
+		}
+		slices.Sort(containers)
+
+		for _, container := range containers {
+			image := images[container]
+			if !strings.HasPrefix(image, mirror.BaseRegistry+"/") && mirror.ReplaceRegistry(image) == image {
+				return fmt.Errorf("image %s of bottlerocket %s in machine config %s is not served by the registry mirror %s", image, container, name, mirror.BaseRegistry)
+			}
+		}
+	}
+
+	return nil
+}
+
+// ValidateNodeOSKernelModulesForBottlerocket checks no Bottlerocket machine config is used with the
+// kernel modules of the node OS configuration, since they can't be loaded through Bottlerocket settings.
+func ValidateNodeOSKernelModulesForBottlerocket(clusterSpec *cluster.Spec) error {
+	nodeOS := clusterSpec.Cluster.Spec.NodeOSConfiguration
+	if nodeOS == nil || len(nodeOS.KernelModules) == 0 {
+		return nil
+	}
+
+	var names []string
+	for name, mc := range clusterSpec.VSphereMachineConfigs {
+		if mc.OSFamily() == v1alpha1.Bottlerocket {
+			names = append(names, name)
+		}
+	}
+	for name, mc := range clusterSpec.TinkerbellMachineConfigs {
+		if mc.OSFamily() == v1alpha1.Bottlerocket {
+			names = append(names, name)
+		}
+	}
+	slices.Sort(names)
+
+	if len(names) > 0 {
+		return fmt.Errorf("nodeOSConfiguration kernelModules are not supported for bottlerocket, used by machine configs %s", strings.Join(names, ", "))
+	}
+
+	return nil
+}
+
+// ValidateGPUForBottlerocket checks the worker node groups with GPU support don't use Bottlerocket
+// machine configs, since the nvidia containerd runtime is configured through containerd config drop-ins.
+func ValidateGPUForBottlerocket(clusterSpec *cluster.Spec) error {
+	for _, w := range clusterSpec.Cluster.Spec.WorkerNodeGroupConfigurations {
+		if w.GPU == nil || w.MachineGroupRef == nil {
+			continue
+		}
+
+		var osFamily v1alpha1.OSFamily
+		if mc, ok := clusterSpec.VSphereMachineConfigs[w.MachineGroupRef.Name]; ok {
+			osFamily = mc.OSFamily()
+		}
+		if mc, ok := clusterSpec.TinkerbellMachineConfigs[w.MachineGroupRef.Name]; ok {
+			osFamily = mc.OSFamily()
+		}
+
+		if osFamily == v1alpha1.Bottlerocket {
+			return fmt.Errorf("worker node group %s gpu is not supported for bottlerocket, used by machine config %s", w.Name, w.MachineGroupRef.Name)
+		}
+	}
+
+	return nil
+}
+
 func ValidateCertForRegistryMirror(clusterSpec *cluster.Spec, tlsValidator TlsValidator) error {
 	cluster := clusterSpec.Cluster
 	if cluster.Spec.RegistryMirrorConfiguration == nil {
@@ -105,6 +215,28 @@ func ValidateAuthenticationForRegistryMirror(clusterSpec *cluster.Spec) error {
 	return nil
 }
 
+// ValidateRegistryMirrorReachable checks the registry mirror endpoint accepts connections.
+func ValidateRegistryMirrorReachable(client networkutils.NetClient, clusterSpec *cluster.Spec) error {
+	registryMirror := clusterSpec.Cluster.Spec.RegistryMirrorConfiguration
+	if registryMirror == nil {
+		return nil
+	}
+
+	port := registryMirror.Port
+	if port == "" {
+		port = constants.DefaultHttpsPort
+	}
+
+	address := net.JoinHostPort(registryMirror.Endpoint, port)
+	conn, err := client.DialTimeout("tcp", address, registryDialTimeout)
+	if err != nil {
+		return fmt.Errorf("registry mirror %s is not reachable: %v", address, err)
+	}
+	conn.Close()
+
+	return nil
+}
+
 // ValidateManagementClusterName checks if the management cluster specified in the workload cluster spec is valid.
 func ValidateManagementClusterName(ctx context.Context, k KubectlClient, mgmtCluster *types.Cluster, mgmtClusterName string) error {
 	cluster, err := k.GetEksaCluster(ctx, mgmtCluster, mgmtClusterName)
@@ -239,7 +371,8 @@ func ValidateEksaReleaseExistOnManagement(ctx context.Context, k kubernetes.Clie
 	return nil
 }
 
-// ValidatePauseAnnotation checks if the target cluster has annotation anywhere.eks.amazonaws.com/paused set to true or not.
+// ValidatePauseAnnotation checks if the target cluster has annotation anywhere.eks.amazonaws.com/paused set to true
+// or is frozen with annotation anywhere.eks.amazonaws.com/frozen.
 func ValidatePauseAnnotation(ctx context.Context, k KubectlClient, cluster *types.Cluster, clusterName string) error {
 	currentCluster, err := k.GetEksaCluster(ctx, cluster, clusterName)
 	if err != nil {
@@ -248,6 +381,9 @@ func ValidatePauseAnnotation(ctx context.Context, k KubectlClient, cluster *type
 	if currentCluster.IsReconcilePaused() {
 		return fmt.Errorf("cluster cannot be upgraded with paused cluster controller reconciler")
 	}
+	if currentCluster.IsFrozen() {
+		return fmt.Errorf("cluster cannot be upgraded while frozen by %s", v1alpha1.FrozenAnnotation)
+	}
 	return nil
 }
 
@@ -299,6 +435,24 @@ func ValidateBottlerocketKubeletConfig(spec *cluster.Spec) error {
 	return nil
 }
 
+// IsBottlerocketMachineGroup returns true if the machine config referenced by ref uses Bottlerocket,
+// for the providers that render the Kubelet Configuration as Bottlerocket settings.
+func IsBottlerocketMachineGroup(spec *cluster.Spec, ref *v1alpha1.Ref) bool {
+	if ref == nil {
+		return false
+	}
+
+	if machineConfig, ok := spec.VSphereMachineConfigs[ref.Name]; ok {
+		return machineConfig.Spec.OSFamily == v1alpha1.Bottlerocket
+	}
+
+	if machineConfig, ok := spec.TinkerbellMachineConfigs[ref.Name]; ok {
+		return machineConfig.Spec.OSFamily == v1alpha1.Bottlerocket
+	}
+
+	return false
+}
+
 // ValidateExtendedKubernetesVersionSupport validates the extended kubernetes version support for create and upgrade operations.
 func ValidateExtendedKubernetesVersionSupport(ctx context.Context, clusterSpec v1alpha1.Cluster, reader *manifests.Reader, k kubernetes.Client, bundlesOverride string) error {
 	if clusterSpec.Spec.DatacenterRef.Kind == "SnowDatacenterConfig" {
@@ -382,4 +536,3 @@ func getReleaseManifestFromBundle(clusterSpec v1alpha1.Cluster, bundle *releasev
 
 	return releaseManifest, nil
 }
-
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net"
 	"net/http"
 	"net/http/httptest"
 	"os"
@@ -22,6 +23,7 @@ import (
 	"github.com/aws/eks-anywhere/pkg/cluster"
 	"github.com/aws/eks-anywhere/pkg/manifests"
 	"github.com/aws/eks-anywhere/pkg/manifests/releases"
+	networkmocks "github.com/aws/eks-anywhere/pkg/networkutils/mocks"
 	"github.com/aws/eks-anywhere/pkg/providers"
 	providermocks "github.com/aws/eks-anywhere/pkg/providers/mocks"
 	"github.com/aws/eks-anywhere/pkg/types"
@@ -320,6 +322,130 @@ func TestValidateOSForRegistryMirrorNoPublicEcrRegistry(t *testing.T) {
 	}
 }
 
+func TestValidateNodeOSKernelModulesForBottlerocket(t *testing.T) {
+	tests := []struct {
+		name     string
+		nodeOS   *anywherev1.NodeOSConfiguration
+		osFamily anywherev1.OSFamily
+		wantErr  string
+	}{
+		{
+			name:     "no node OS configuration",
+			osFamily: anywherev1.Bottlerocket,
+		},
+		{
+			name:     "sysctls only",
+			nodeOS:   &anywherev1.NodeOSConfiguration{Sysctls: map[string]string{"vm.max_map_count": "262144"}},
+			osFamily: anywherev1.Bottlerocket,
+		},
+		{
+			name:     "kernel modules with ubuntu",
+			nodeOS:   &anywherev1.NodeOSConfiguration{KernelModules: []string{"br_netfilter"}},
+			osFamily: anywherev1.Ubuntu,
+		},
+		{
+			name:     "kernel modules with bottlerocket",
+			nodeOS:   &anywherev1.NodeOSConfiguration{KernelModules: []string{"br_netfilter"}},
+			osFamily: anywherev1.Bottlerocket,
+			wantErr:  "nodeOSConfiguration kernelModules are not supported for bottlerocket, used by machine configs test-cp, test-md",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			spec := test.NewClusterSpec(func(s *cluster.Spec) {
+				s.Cluster.Spec.NodeOSConfiguration = tc.nodeOS
+				s.VSphereMachineConfigs = map[string]*anywherev1.VSphereMachineConfig{
+					"test-md": {Spec: anywherev1.VSphereMachineConfigSpec{OSFamily: tc.osFamily}},
+				}
+				s.TinkerbellMachineConfigs = map[string]*anywherev1.TinkerbellMachineConfig{
+					"test-cp": {Spec: anywherev1.TinkerbellMachineConfigSpec{OSFamily: tc.osFamily}},
+				}
+			})
+
+			err := validations.ValidateNodeOSKernelModulesForBottlerocket(spec)
+			if tc.wantErr == "" {
+				g.Expect(err).NotTo(HaveOccurred())
+			} else {
+				g.Expect(err).To(MatchError(tc.wantErr))
+			}
+		})
+	}
+}
+
+func TestValidateBottlerocketContainersForRegistryMirror(t *testing.T) {
+	tests := []struct {
+		name         string
+		mirrorConfig *anywherev1.RegistryMirrorConfiguration
+		image        string
+		wantErr      string
+	}{
+		{
+			name:  "no registry mirror",
+			image: "docker.io/agent:v1",
+		},
+		{
+			name: "image from a mirrored registry",
+			mirrorConfig: &anywherev1.RegistryMirrorConfiguration{
+				Endpoint: "1.2.3.4",
+				Port:     "443",
+				OCINamespaces: []anywherev1.OCINamespace{
+					{Registry: "docker.io", Namespace: "docker"},
+				},
+			},
+			image: "docker.io/agent:v1",
+		},
+		{
+			name: "image from the registry mirror",
+			mirrorConfig: &anywherev1.RegistryMirrorConfiguration{
+				Endpoint: "1.2.3.4",
+				Port:     "443",
+			},
+			image: "1.2.3.4:443/security/agent:v1",
+		},
+		{
+			name: "image from a registry not mirrored",
+			mirrorConfig: &anywherev1.RegistryMirrorConfiguration{
+				Endpoint: "1.2.3.4",
+				Port:     "443",
+			},
+			image:   "docker.io/agent:v1",
+			wantErr: "image docker.io/agent:v1 of bottlerocket host container agent in machine config test-cp is not served by the registry mirror 1.2.3.4:443",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			spec := test.NewClusterSpec(func(s *cluster.Spec) {
+				s.Cluster.Spec.RegistryMirrorConfiguration = tc.mirrorConfig
+				s.VSphereMachineConfigs = map[string]*anywherev1.VSphereMachineConfig{
+					"test-cp": {
+						Spec: anywherev1.VSphereMachineConfigSpec{
+							OSFamily: anywherev1.Bottlerocket,
+							HostOSConfiguration: &anywherev1.HostOSConfiguration{
+								BottlerocketConfiguration: &anywherev1.BottlerocketConfiguration{
+									HostContainers: []anywherev1.BottlerocketHostContainer{
+										{Name: "agent", Image: tc.image},
+									},
+								},
+							},
+						},
+					},
+				}
+			})
+
+			err := validations.ValidateBottlerocketContainersForRegistryMirror(spec)
+			if tc.wantErr == "" {
+				g.Expect(err).ToNot(HaveOccurred())
+			} else {
+				g.Expect(err).To(MatchError(tc.wantErr))
+			}
+		})
+	}
+}
+
 func TestValidateManagementClusterNameValid(t *testing.T) {
 	mgmtName := "test"
 	tt := newTest(t, withKubectl())
@@ -664,6 +790,21 @@ func TestValidatePauseAnnotation(t *testing.T) {
 			},
 			wantErr: fmt.Errorf("cluster cannot be upgraded with paused cluster controller reconciler"),
 		},
+		{
+			name: "frozen",
+			gotCluster: &anywherev1.Cluster{
+				ObjectMeta: v1.ObjectMeta{
+					Name:        mgmtName,
+					Annotations: map[string]string{"anywhere.eks.amazonaws.com/frozen": "manual etcd repair"},
+				},
+				Spec: anywherev1.ClusterSpec{
+					ManagementCluster: anywherev1.ManagementCluster{
+						Name: mgmtName,
+					},
+				},
+			},
+			wantErr: fmt.Errorf("cluster cannot be upgraded while frozen by anywhere.eks.amazonaws.com/frozen"),
+		},
 	}
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
@@ -861,6 +1002,37 @@ func TestValidateBottlerocketKC(t *testing.T) {
 	}
 }
 
+func TestIsBottlerocketMachineGroup(t *testing.T) {
+	spec := test.NewClusterSpec(func(s *cluster.Spec) {
+		s.VSphereMachineConfigs = map[string]*anywherev1.VSphereMachineConfig{
+			"vsphere-br":     {Spec: anywherev1.VSphereMachineConfigSpec{OSFamily: anywherev1.Bottlerocket}},
+			"vsphere-ubuntu": {Spec: anywherev1.VSphereMachineConfigSpec{OSFamily: anywherev1.Ubuntu}},
+		}
+		s.TinkerbellMachineConfigs = map[string]*anywherev1.TinkerbellMachineConfig{
+			"tinkerbell-br":     {Spec: anywherev1.TinkerbellMachineConfigSpec{OSFamily: anywherev1.Bottlerocket}},
+			"tinkerbell-ubuntu": {Spec: anywherev1.TinkerbellMachineConfigSpec{OSFamily: anywherev1.Ubuntu}},
+		}
+	})
+	tests := []struct {
+		name string
+		ref  *anywherev1.Ref
+		want bool
+	}{
+		{name: "nil ref", ref: nil, want: false},
+		{name: "vsphere bottlerocket", ref: &anywherev1.Ref{Name: "vsphere-br"}, want: true},
+		{name: "vsphere ubuntu", ref: &anywherev1.Ref{Name: "vsphere-ubuntu"}, want: false},
+		{name: "tinkerbell bottlerocket", ref: &anywherev1.Ref{Name: "tinkerbell-br"}, want: true},
+		{name: "tinkerbell ubuntu", ref: &anywherev1.Ref{Name: "tinkerbell-ubuntu"}, want: false},
+		{name: "unknown machine config", ref: &anywherev1.Ref{Name: "missing"}, want: false},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(validations.IsBottlerocketMachineGroup(spec, tc.ref)).To(Equal(tc.want))
+		})
+	}
+}
+
 func TestValidateExtendedKubernetesVersionSupportCLINoError(t *testing.T) {
 	ctx := context.Background()
 
@@ -1470,3 +1642,82 @@ spec:
 	g.Expect(err.Error()).To(ContainSubstring("unmarshalling eksd release manifest from URL"))
 }
 
+func TestValidateGPUForBottlerocket(t *testing.T) {
+	tests := []struct {
+		name     string
+		gpu      *anywherev1.GPUConfiguration
+		osFamily anywherev1.OSFamily
+		wantErr  string
+	}{
+		{
+			name:     "no gpu",
+			osFamily: anywherev1.Bottlerocket,
+		},
+		{
+			name:     "gpu with ubuntu",
+			gpu:      &anywherev1.GPUConfiguration{},
+			osFamily: anywherev1.Ubuntu,
+		},
+		{
+			name:     "gpu with bottlerocket",
+			gpu:      &anywherev1.GPUConfiguration{},
+			osFamily: anywherev1.Bottlerocket,
+			wantErr:  "worker node group md-gpu gpu is not supported for bottlerocket, used by machine config test-md",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			spec := test.NewClusterSpec(func(s *cluster.Spec) {
+				s.Cluster.Spec.WorkerNodeGroupConfigurations = []anywherev1.WorkerNodeGroupConfiguration{
+					{
+						Name:            "md-gpu",
+						MachineGroupRef: &anywherev1.Ref{Kind: anywherev1.VSphereMachineConfigKind, Name: "test-md"},
+						GPU:             tc.gpu,
+					},
+				}
+				s.VSphereMachineConfigs = map[string]*anywherev1.VSphereMachineConfig{
+					"test-md": {Spec: anywherev1.VSphereMachineConfigSpec{OSFamily: tc.osFamily}},
+				}
+			})
+
+			err := validations.ValidateGPUForBottlerocket(spec)
+			if tc.wantErr == "" {
+				g.Expect(err).NotTo(HaveOccurred())
+			} else {
+				g.Expect(err).To(MatchError(tc.wantErr))
+			}
+		})
+	}
+}
+
+func TestValidateRegistryMirrorReachable(t *testing.T) {
+	g := NewWithT(t)
+	netClient := networkmocks.NewMockNetClient(gomock.NewController(t))
+	spec := test.NewClusterSpec(func(s *cluster.Spec) {
+		s.Cluster.Spec.RegistryMirrorConfiguration = &anywherev1.RegistryMirrorConfiguration{Endpoint: "1.2.3.4"}
+	})
+	conn, _ := net.Pipe()
+	netClient.EXPECT().DialTimeout("tcp", "1.2.3.4:443", gomock.Any()).Return(conn, nil)
+
+	g.Expect(validations.ValidateRegistryMirrorReachable(netClient, spec)).To(Succeed())
+}
+
+func TestValidateRegistryMirrorReachableError(t *testing.T) {
+	g := NewWithT(t)
+	netClient := networkmocks.NewMockNetClient(gomock.NewController(t))
+	spec := test.NewClusterSpec(func(s *cluster.Spec) {
+		s.Cluster.Spec.RegistryMirrorConfiguration = &anywherev1.RegistryMirrorConfiguration{Endpoint: "1.2.3.4", Port: "5000"}
+	})
+	netClient.EXPECT().DialTimeout("tcp", "1.2.3.4:5000", gomock.Any()).Return(nil, errors.New("connection refused"))
+
+	g.Expect(validations.ValidateRegistryMirrorReachable(netClient, spec)).To(MatchError("registry mirror 1.2.3.4:5000 is not reachable: connection refused"))
+}
+
+func TestValidateRegistryMirrorReachableNoRegistryMirror(t *testing.T) {
+	g := NewWithT(t)
+	netClient := networkmocks.NewMockNetClient(gomock.NewController(t))
+
+	g.Expect(validations.ValidateRegistryMirrorReachable(netClient, test.NewClusterSpec())).To(Succeed())
+}
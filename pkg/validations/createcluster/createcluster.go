@@ -8,6 +8,7 @@ import (
 	"github.com/aws/eks-anywhere/pkg/cluster"
 	"github.com/aws/eks-anywhere/pkg/gitops/flux"
 	"github.com/aws/eks-anywhere/pkg/kubeconfig"
+	"github.com/aws/eks-anywhere/pkg/networkutils"
 	"github.com/aws/eks-anywhere/pkg/providers"
 	"github.com/aws/eks-anywhere/pkg/validations"
 )
@@ -18,6 +19,7 @@ type ValidationManager struct {
 	gitOpsFlux        *flux.Flux
 	createValidations Validator
 	dockerExec        validations.DockerExecutable
+	netClient         networkutils.NetClient
 }
 
 type Validator interface {
@@ -31,16 +33,22 @@ func NewValidations(clusterSpec *cluster.Spec, provider providers.Provider, gitO
 		gitOpsFlux:        gitOpsFlux,
 		createValidations: createValidations,
 		dockerExec:        dockerExec,
+		netClient:         &networkutils.DefaultNetClient{},
 	}
 }
 
 func (v *ValidationManager) Validate(ctx context.Context) error {
+	_, err := v.ValidateWithReport(ctx)
+	return err
+}
+
+// ValidateWithReport runs the create cluster validations and returns the report of their results.
+func (v *ValidationManager) ValidateWithReport(ctx context.Context) (*validations.Report, error) {
 	runner := validations.NewRunner()
 	runner.Register(v.generateCreateValidations(ctx)...)
 	runner.Register(v.gitOpsFlux.Validations(ctx, v.clusterSpec)...)
-	err := runner.Run()
 
-	return err
+	return runner.RunWithReport()
 }
 
 func (v *ValidationManager) generateCreateValidations(ctx context.Context) []validations.Validation {
@@ -66,6 +74,13 @@ func (v *ValidationManager) generateCreateValidations(ctx context.Context) []val
 				Silent: true,
 			}
 		},
+		func() *validations.ValidationResult {
+			return &validations.ValidationResult{
+				Name:        "validate registry mirror is reachable",
+				Remediation: "ensure the registry mirror endpoint and port are correct and reachable from the admin machine",
+				Err:         validations.ValidateRegistryMirrorReachable(v.netClient, v.clusterSpec),
+			}
+		},
 		func() *validations.ValidationResult {
 			return &validations.ValidationResult{
 				Name: fmt.Sprintf("validate %s Provider", v.provider.Name()),
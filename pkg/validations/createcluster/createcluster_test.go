@@ -140,3 +140,23 @@ func TestCreateClusterValidationsSuccess(t *testing.T) {
 	g.Expect(commandVal.Validate(test.ctx)).To(Succeed())
 	g.Expect(validationFromBuild.run).To(BeTrue(), "validation coming from BuildValidations should be run")
 }
+
+func TestCreateClusterValidationsWithReport(t *testing.T) {
+	g := NewWithT(t)
+	test := newValidateTest(t)
+	test.expectValidDockerClusterSpec()
+	test.expectValidProvider()
+	test.expectEmptyFlux()
+	test.expectValidDockerExec()
+	test.expectBuildValidations()
+
+	commandVal := createcluster.NewValidations(test.clusterSpec, test.provider, test.flux, test.createValidations, test.docker)
+
+	report, err := commandVal.ValidateWithReport(test.ctx)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(report.Passed).To(BeTrue())
+	g.Expect(report.Validations).To(ContainElements(
+		validations.ReportResult{Name: "validate registry mirror is reachable", Passed: true},
+		validations.ReportResult{Name: "validate docker Provider", Passed: true},
+	))
+}
@@ -7,6 +7,7 @@ import (
 // SkippableValidations represents all the validations we offer for users to skip.
 var SkippableValidations = []string{
 	validations.VSphereUserPriv,
+	validations.CLIVersionSkew,
 }
 
 func New(opts *validations.Opts) *CreateValidations {
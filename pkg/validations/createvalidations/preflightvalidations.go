@@ -3,6 +3,7 @@ package createvalidations
 import (
 	"context"
 	"fmt"
+	"net"
 
 	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
 	"github.com/aws/eks-anywhere/pkg/config"
@@ -28,6 +29,34 @@ func (v *CreateValidations) PreflightValidations(ctx context.Context) []validati
 				Err:         validations.ValidateOSForRegistryMirror(v.Opts.Spec, v.Opts.Provider),
 			}
 		},
+		func() *validations.ValidationResult {
+			return &validations.ValidationResult{
+				Name:        "validate bottlerocket container images are served by the registry mirror",
+				Remediation: "use images from the registry mirror, or add their registries to the registry mirror ociNamespaces",
+				Err:         validations.ValidateBottlerocketContainersForRegistryMirror(v.Opts.Spec),
+			}
+		},
+		func() *validations.ValidationResult {
+			return &validations.ValidationResult{
+				Name:        "validate bottlerocket settings are supported by the kubernetes version of the node groups",
+				Remediation: "update the kubelet configuration, taints, labels or registry mirror configuration of the node groups using bottlerocket",
+				Err:         validations.ValidateBottlerocketSettings(v.Opts.Spec),
+			}
+		},
+		func() *validations.ValidationResult {
+			return &validations.ValidationResult{
+				Name:        "validate node OS kernel modules are not used with bottlerocket",
+				Remediation: "remove the nodeOSConfiguration kernelModules, or use another OS family for the machine configs",
+				Err:         validations.ValidateNodeOSKernelModulesForBottlerocket(v.Opts.Spec),
+			}
+		},
+		func() *validations.ValidationResult {
+			return &validations.ValidationResult{
+				Name:        "validate gpu worker node groups are not used with bottlerocket",
+				Remediation: "use another OS family for the machine configs of the worker node groups with gpu",
+				Err:         validations.ValidateGPUForBottlerocket(v.Opts.Spec),
+			}
+		},
 		func() *validations.ValidationResult {
 			return &validations.ValidationResult{
 				Name:        "validate certificate for registry mirror",
@@ -58,34 +87,40 @@ func (v *CreateValidations) PreflightValidations(ctx context.Context) []validati
 		},
 	}
 
-	if len(v.Opts.Spec.VSphereMachineConfigs) != 0 {
-		cpRef := v.Opts.Spec.Cluster.Spec.ControlPlaneConfiguration.MachineGroupRef.Name
-		if v.Opts.Spec.VSphereMachineConfigs[cpRef].Spec.OSFamily == anywherev1.Bottlerocket {
+	if endpoint := v.Opts.Spec.Cluster.Spec.ControlPlaneConfiguration.Endpoint; endpoint != nil && endpoint.Host != "" && net.ParseIP(endpoint.Hostname()) == nil {
+		createValidations = append(createValidations,
+			func() *validations.ValidationResult {
+				return &validations.ValidationResult{
+					Name:        "validate control plane endpoint DNS",
+					Remediation: "ensure the control plane endpoint hostname resolves to its address from this machine, or configure controlPlaneConfiguration.endpoint.dns to create the record",
+					Err:         v.Opts.EndpointValidator.ValidateEndpoint(ctx, endpoint),
+				}
+			})
+	}
+
+	if validations.IsBottlerocketMachineGroup(v.Opts.Spec, v.Opts.Spec.Cluster.Spec.ControlPlaneConfiguration.MachineGroupRef) {
+		createValidations = append(createValidations,
+			func() *validations.ValidationResult {
+				return &validations.ValidationResult{
+					Name:        "validate cluster's kubelet configuration for Bottlerocket OS",
+					Remediation: "ensure that the settings configured for Kubelet Configuration are supported by Bottlerocket",
+					Err:         validations.ValidateBottlerocketKubeletConfig(v.Opts.Spec),
+				}
+			})
+	}
+
+	wnConfigs := v.Opts.Spec.Cluster.Spec.WorkerNodeGroupConfigurations
+	for i := range wnConfigs {
+		if validations.IsBottlerocketMachineGroup(v.Opts.Spec, wnConfigs[i].MachineGroupRef) {
 			createValidations = append(createValidations,
 				func() *validations.ValidationResult {
 					return &validations.ValidationResult{
-						Name:        "validate cluster's kubelet configuration for Bottlerocket OS",
+						Name:        "validate cluster's worker node kubelet configuration for Bottlerocket OS",
 						Remediation: "ensure that the settings configured for Kubelet Configuration are supported by Bottlerocket",
 						Err:         validations.ValidateBottlerocketKubeletConfig(v.Opts.Spec),
 					}
 				})
 		}
-
-		wnConfigs := v.Opts.Spec.Cluster.Spec.WorkerNodeGroupConfigurations
-		for i := range wnConfigs {
-			workerNodeRef := wnConfigs[i].MachineGroupRef.Name
-
-			if v.Opts.Spec.VSphereMachineConfigs[workerNodeRef].Spec.OSFamily == anywherev1.Bottlerocket {
-				createValidations = append(createValidations,
-					func() *validations.ValidationResult {
-						return &validations.ValidationResult{
-							Name:        "validate cluster's worker node kubelet configuration for Bottlerocket OS",
-							Remediation: "ensure that the settings configured for Kubelet Configuration are supported by Bottlerocket",
-							Err:         validations.ValidateBottlerocketKubeletConfig(v.Opts.Spec),
-						}
-					})
-			}
-		}
 	}
 
 	if v.Opts.Spec.Cluster.IsManaged() {
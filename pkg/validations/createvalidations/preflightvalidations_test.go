@@ -2,6 +2,7 @@ package createvalidations_test
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"testing"
 
@@ -183,3 +184,51 @@ func TestPreFlightValidationsWorkloadCluster(t *testing.T) {
 
 	tt.Expect(validations.ProcessValidationResults(tt.c.PreflightValidations(tt.ctx))).To(Succeed())
 }
+
+func validationResult(results []validations.Validation, name string) *validations.ValidationResult {
+	for _, validation := range results {
+		if r := validation(); r.Name == name {
+			return r
+		}
+	}
+	return nil
+}
+
+func TestPreFlightValidationsControlPlaneEndpointHostname(t *testing.T) {
+	tt := newPreflightValidationsTest(t)
+	tt.c.Opts.ManifestReader = addManifestReaderMock(t, anywherev1.EksaVersion(tt.c.Opts.CliVersion))
+	endpointValidator := mocks.NewMockEndpointValidator(gomock.NewController(t))
+	tt.c.Opts.EndpointValidator = endpointValidator
+	endpoint := &anywherev1.Endpoint{Host: "api.cluster.example.com"}
+	tt.c.Opts.Spec.Cluster.Spec.ControlPlaneConfiguration.Endpoint = endpoint
+
+	endpointValidator.EXPECT().ValidateEndpoint(tt.ctx, endpoint).Return(nil)
+
+	result := validationResult(tt.c.PreflightValidations(tt.ctx), "validate control plane endpoint DNS")
+	tt.Expect(result).NotTo(BeNil())
+	tt.Expect(result.Err).To(Succeed())
+}
+
+func TestPreFlightValidationsControlPlaneEndpointHostnameError(t *testing.T) {
+	tt := newPreflightValidationsTest(t)
+	tt.c.Opts.ManifestReader = addManifestReaderMock(t, anywherev1.EksaVersion(tt.c.Opts.CliVersion))
+	endpointValidator := mocks.NewMockEndpointValidator(gomock.NewController(t))
+	tt.c.Opts.EndpointValidator = endpointValidator
+	endpoint := &anywherev1.Endpoint{Host: "api.cluster.example.com"}
+	tt.c.Opts.Spec.Cluster.Spec.ControlPlaneConfiguration.Endpoint = endpoint
+
+	endpointValidator.EXPECT().ValidateEndpoint(tt.ctx, endpoint).Return(errors.New("no such host"))
+
+	result := validationResult(tt.c.PreflightValidations(tt.ctx), "validate control plane endpoint DNS")
+	tt.Expect(result).NotTo(BeNil())
+	tt.Expect(result.Err).To(MatchError("no such host"))
+}
+
+func TestPreFlightValidationsControlPlaneEndpointIP(t *testing.T) {
+	tt := newPreflightValidationsTest(t)
+	tt.c.Opts.ManifestReader = addManifestReaderMock(t, anywherev1.EksaVersion(tt.c.Opts.CliVersion))
+	tt.c.Opts.EndpointValidator = mocks.NewMockEndpointValidator(gomock.NewController(t))
+	tt.c.Opts.Spec.Cluster.Spec.ControlPlaneConfiguration.Endpoint = &anywherev1.Endpoint{Host: "1.2.3.4"}
+
+	tt.Expect(validationResult(tt.c.PreflightValidations(tt.ctx), "validate control plane endpoint DNS")).To(BeNil())
+}
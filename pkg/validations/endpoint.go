@@ -0,0 +1,13 @@
+package validations
+
+import (
+	"context"
+
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+)
+
+// EndpointValidator validates the control plane endpoint hostname resolves, creating its DNS record
+// first when the endpoint configures one.
+type EndpointValidator interface {
+	ValidateEndpoint(ctx context.Context, endpoint *anywherev1.Endpoint) error
+}
@@ -0,0 +1,25 @@
+package validations
+
+// Report is the machine-readable result of running validations.
+type Report struct {
+	Passed      bool           `json:"passed"`
+	Validations []ReportResult `json:"validations"`
+}
+
+// ReportResult is the result of a validation in a Report.
+type ReportResult struct {
+	Name        string `json:"name"`
+	Passed      bool   `json:"passed"`
+	Error       string `json:"error,omitempty"`
+	Remediation string `json:"remediation,omitempty"`
+}
+
+func (r *Report) add(result *ValidationResult) {
+	reportResult := ReportResult{Name: result.Name, Passed: result.Err == nil}
+	if result.Err != nil {
+		r.Passed = false
+		reportResult.Error = result.Err.Error()
+		reportResult.Remediation = result.Remediation
+	}
+	r.Validations = append(r.Validations, reportResult)
+}
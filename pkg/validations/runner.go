@@ -24,18 +24,27 @@ func (r *Runner) Register(validations ...Validation) {
 }
 
 func (r *Runner) Run() error {
+	_, err := r.RunWithReport()
+	return err
+}
+
+// RunWithReport runs the validations like Run and returns the report of their results, which
+// includes the failed validations when an error is returned.
+func (r *Runner) RunWithReport() (*Report, error) {
+	report := &Report{Passed: true, Validations: make([]ReportResult, 0, len(r.validations))}
 	var errs []error
 	for _, v := range r.validations {
 		result := v()
 		result.Report()
+		report.add(result)
 		if result.Err != nil {
 			errs = append(errs, result.Err)
 		}
 	}
 
 	if len(errs) > 0 {
-		return fmt.Errorf("validations failed: %w", eksae.NewAggregate(errs))
+		return report, fmt.Errorf("validations failed: %w", eksae.NewAggregate(errs))
 	}
 
-	return nil
+	return report, nil
 }
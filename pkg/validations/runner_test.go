@@ -43,3 +43,30 @@ func TestRunnerRunSuccess(t *testing.T) {
 
 	g.Expect(r.Run()).To(Succeed())
 }
+
+func TestRunnerRunWithReport(t *testing.T) {
+	g := NewWithT(t)
+	r := validations.NewRunner()
+	r.Register(func() *validations.ValidationResult {
+		return &validations.ValidationResult{
+			Name: "first validation",
+		}
+	})
+	r.Register(func() *validations.ValidationResult {
+		return &validations.ValidationResult{
+			Name:        "second validation",
+			Err:         errors.New("one error"),
+			Remediation: "fix it",
+		}
+	})
+
+	report, err := r.RunWithReport()
+	g.Expect(err).To(MatchError(ContainSubstring("one error")))
+	g.Expect(report).To(Equal(&validations.Report{
+		Passed: false,
+		Validations: []validations.ReportResult{
+			{Name: "first validation", Passed: true},
+			{Name: "second validation", Passed: false, Error: "one error", Remediation: "fix it"},
+		},
+	}))
+}
@@ -10,6 +10,7 @@ const (
 	PDB             = "pod-disruption"
 	VSphereUserPriv = "vsphere-user-privilege"
 	EksaVersionSkew = "eksa-version-skew"
+	CLIVersionSkew  = "cli-version-skew"
 )
 
 // ValidSkippableValidationsMap returns a map for all valid skippable validations as keys, defaulting values to false.
@@ -32,6 +32,7 @@ func TestValidateSkippableValidation(t *testing.T) {
 				validations.PDB:             true,
 				validations.VSphereUserPriv: false,
 				validations.EksaVersionSkew: false,
+				validations.CLIVersionSkew:  false,
 			},
 			wantErr:              nil,
 			skippedValidations:   []string{validations.PDB},
@@ -41,6 +42,7 @@ func TestValidateSkippableValidation(t *testing.T) {
 			name: "valid create validation param",
 			want: map[string]bool{
 				validations.VSphereUserPriv: true,
+				validations.CLIVersionSkew:  false,
 			},
 			wantErr:              nil,
 			skippedValidations:   []string{validations.VSphereUserPriv},
@@ -337,6 +337,25 @@ func TestValidateImmutableFields(t *testing.T) {
 				desired.Spec.IdentityProviderRefs = []v1alpha1.Ref{}
 			},
 		},
+		{
+			Name: "Append control plane cert SANs",
+			ConfigureCurrent: func(current *v1alpha1.Cluster) {
+				current.Spec.ControlPlaneConfiguration.CertSANs = []string{"api.example.com"}
+			},
+			ConfigureDesired: func(desired *v1alpha1.Cluster) {
+				desired.Spec.ControlPlaneConfiguration.CertSANs = []string{"api.example.com", "lb.corp.example.com", "10.0.0.10"}
+			},
+		},
+		{
+			Name: "Remove control plane cert SANs",
+			ConfigureCurrent: func(current *v1alpha1.Cluster) {
+				current.Spec.ControlPlaneConfiguration.CertSANs = []string{"api.example.com", "10.0.0.10"}
+			},
+			ConfigureDesired: func(desired *v1alpha1.Cluster) {
+				desired.Spec.ControlPlaneConfiguration.CertSANs = []string{"10.0.0.10"}
+			},
+			ExpectedError: "spec.controlPlaneConfiguration.certSans can only be appended, cannot remove [api.example.com]",
+		},
 	}
 
 	clstr := &types.Cluster{}
@@ -9,6 +9,7 @@ import (
 	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
 	"github.com/aws/eks-anywhere/pkg/config"
 	"github.com/aws/eks-anywhere/pkg/constants"
+	"github.com/aws/eks-anywhere/pkg/features"
 	"github.com/aws/eks-anywhere/pkg/providers"
 	"github.com/aws/eks-anywhere/pkg/types"
 	"github.com/aws/eks-anywhere/pkg/validation"
@@ -38,6 +39,34 @@ func (u *UpgradeValidations) PreflightValidations(ctx context.Context) []validat
 				Err:         validations.ValidateOSForRegistryMirror(u.Opts.Spec, u.Opts.Provider),
 			}
 		},
+		func() *validations.ValidationResult {
+			return &validations.ValidationResult{
+				Name:        "validate bottlerocket container images are served by the registry mirror",
+				Remediation: "use images from the registry mirror, or add their registries to the registry mirror ociNamespaces",
+				Err:         validations.ValidateBottlerocketContainersForRegistryMirror(u.Opts.Spec),
+			}
+		},
+		func() *validations.ValidationResult {
+			return &validations.ValidationResult{
+				Name:        "validate bottlerocket settings are supported by the kubernetes version of the node groups",
+				Remediation: "update the kubelet configuration, taints, labels or registry mirror configuration of the node groups using bottlerocket",
+				Err:         validations.ValidateBottlerocketSettings(u.Opts.Spec),
+			}
+		},
+		func() *validations.ValidationResult {
+			return &validations.ValidationResult{
+				Name:        "validate node OS kernel modules are not used with bottlerocket",
+				Remediation: "remove the nodeOSConfiguration kernelModules, or use another OS family for the machine configs",
+				Err:         validations.ValidateNodeOSKernelModulesForBottlerocket(u.Opts.Spec),
+			}
+		},
+		func() *validations.ValidationResult {
+			return &validations.ValidationResult{
+				Name:        "validate gpu worker node groups are not used with bottlerocket",
+				Remediation: "use another OS family for the machine configs of the worker node groups with gpu",
+				Err:         validations.ValidateGPUForBottlerocket(u.Opts.Spec),
+			}
+		},
 		func() *validations.ValidationResult {
 			return &validations.ValidationResult{
 				Name:        "validate certificate for registry mirror",
@@ -118,7 +147,7 @@ func (u *UpgradeValidations) PreflightValidations(ctx context.Context) []validat
 		func() *validations.ValidationResult {
 			return &validations.ValidationResult{
 				Name:        "validate eksa controller is not paused",
-				Remediation: fmt.Sprintf("remove cluster controller reconciler pause annotation %s before upgrading the cluster %s", u.Opts.Spec.Cluster.PausedAnnotation(), targetCluster.Name),
+				Remediation: fmt.Sprintf("remove cluster controller reconciler pause annotation %s and freeze annotation %s before upgrading the cluster %s", u.Opts.Spec.Cluster.PausedAnnotation(), anywherev1.FrozenAnnotation, targetCluster.Name),
 				Err:         validations.ValidatePauseAnnotation(ctx, k, targetCluster, targetCluster.Name),
 			}
 		},
@@ -131,34 +160,29 @@ func (u *UpgradeValidations) PreflightValidations(ctx context.Context) []validat
 		},
 	}
 
-	if len(u.Opts.Spec.VSphereMachineConfigs) != 0 {
-		cpRef := u.Opts.Spec.Cluster.Spec.ControlPlaneConfiguration.MachineGroupRef.Name
-		if u.Opts.Spec.VSphereMachineConfigs[cpRef].Spec.OSFamily == anywherev1.Bottlerocket {
+	if validations.IsBottlerocketMachineGroup(u.Opts.Spec, u.Opts.Spec.Cluster.Spec.ControlPlaneConfiguration.MachineGroupRef) {
+		upgradeValidations = append(upgradeValidations,
+			func() *validations.ValidationResult {
+				return &validations.ValidationResult{
+					Name:        "validate cluster's control plane kubelet configuration for Bottlerocket OS",
+					Remediation: "ensure that the settings configured for Kubelet Configuration are supported by Bottlerocket",
+					Err:         validations.ValidateBottlerocketKubeletConfig(u.Opts.Spec),
+				}
+			})
+	}
+
+	wnConfigs := u.Opts.Spec.Cluster.Spec.WorkerNodeGroupConfigurations
+	for i := range wnConfigs {
+		if validations.IsBottlerocketMachineGroup(u.Opts.Spec, wnConfigs[i].MachineGroupRef) {
 			upgradeValidations = append(upgradeValidations,
 				func() *validations.ValidationResult {
 					return &validations.ValidationResult{
-						Name:        "validate cluster's control plane kubelet configuration for Bottlerocket OS",
+						Name:        "validate cluster's worker node kubelet configuration for Bottlerocket OS",
 						Remediation: "ensure that the settings configured for Kubelet Configuration are supported by Bottlerocket",
 						Err:         validations.ValidateBottlerocketKubeletConfig(u.Opts.Spec),
 					}
 				})
 		}
-
-		wnConfigs := u.Opts.Spec.Cluster.Spec.WorkerNodeGroupConfigurations
-		for i := range wnConfigs {
-			workerNodeRef := wnConfigs[i].MachineGroupRef.Name
-
-			if u.Opts.Spec.VSphereMachineConfigs[workerNodeRef].Spec.OSFamily == anywherev1.Bottlerocket {
-				upgradeValidations = append(upgradeValidations,
-					func() *validations.ValidationResult {
-						return &validations.ValidationResult{
-							Name:        "validate cluster's worker node kubelet configuration for Bottlerocket OS",
-							Remediation: "ensure that the settings configured for Kubelet Configuration are supported by Bottlerocket",
-							Err:         validations.ValidateBottlerocketKubeletConfig(u.Opts.Spec),
-						}
-					})
-			}
-		}
 	}
 
 	if u.Opts.Spec.Cluster.IsManaged() {
@@ -181,6 +205,18 @@ func (u *UpgradeValidations) PreflightValidations(ctx context.Context) []validat
 		)
 	}
 
+	if features.IsActive(features.RolloutGuardrailsEnabled()) {
+		upgradeValidations = append(
+			upgradeValidations,
+			func() *validations.ValidationResult {
+				return &validations.ValidationResult{
+					Name:        "validate machine rollouts are acknowledged",
+					Remediation: "review the changes that replace the machines of the cluster and rerun the upgrade with --acknowledge-rollout",
+					Err:         ValidateRolloutAcknowledged(ctx, u.Opts.KubeClient, u.Opts.Spec, u.Opts.AcknowledgeRollout),
+				}
+			})
+	}
+
 	if !u.Opts.SkippedValidations[validations.PDB] {
 		upgradeValidations = append(
 			upgradeValidations,
@@ -0,0 +1,48 @@
+package upgradevalidations
+
+import (
+	"context"
+	"fmt"
+
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/clients/kubernetes"
+	"github.com/aws/eks-anywhere/pkg/cluster"
+	"github.com/aws/eks-anywhere/pkg/constants"
+	"github.com/aws/eks-anywhere/pkg/logger"
+)
+
+// ValidateRolloutAcknowledged classifies the changes of the cluster config against the current cluster
+// and fails when some of them replace machines and the rollout has not been acknowledged.
+func ValidateRolloutAcknowledged(ctx context.Context, client kubernetes.Client, spec *cluster.Spec, acknowledged bool) error {
+	namespace := spec.Cluster.Namespace
+	if namespace == "" {
+		namespace = constants.DefaultNamespace
+	}
+
+	current := &anywherev1.Cluster{}
+	if err := client.Get(ctx, spec.Cluster.Name, namespace, current); err != nil {
+		return fmt.Errorf("reading cluster %s: %v", spec.Cluster.Name, err)
+	}
+
+	currentConfig, err := cluster.NewDefaultConfigClientBuilder().Build(ctx, client, current)
+	if err != nil {
+		return fmt.Errorf("reading config of cluster %s: %v", spec.Cluster.Name, err)
+	}
+
+	changes := cluster.FieldChanges(currentConfig, spec.Config)
+	for _, c := range changes {
+		logger.V(4).Info("Cluster config change", "field", c.Path, "impact", c.Impact)
+	}
+
+	rollouts := anywherev1.RolloutChanges(changes)
+	if len(rollouts) == 0 {
+		return nil
+	}
+
+	if acknowledged {
+		logger.Info("Warning: the upgrade replaces the machines of the cluster", "fields", anywherev1.FieldChangePaths(rollouts))
+		return nil
+	}
+
+	return fmt.Errorf("changes to %s replace the machines of the cluster", anywherev1.FieldChangePaths(rollouts))
+}
@@ -0,0 +1,107 @@
+package upgradevalidations_test
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/aws/eks-anywhere/internal/test"
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/cluster"
+	"github.com/aws/eks-anywhere/pkg/utils/ptr"
+	"github.com/aws/eks-anywhere/pkg/validations/upgradevalidations"
+)
+
+func rolloutTestSpec() *cluster.Spec {
+	return test.NewClusterSpec(func(s *cluster.Spec) {
+		s.Cluster = &anywherev1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-cluster", Namespace: "default"},
+			Spec: anywherev1.ClusterSpec{
+				KubernetesVersion: anywherev1.Kube130,
+				DatacenterRef:     anywherev1.Ref{Kind: anywherev1.VSphereDatacenterKind, Name: "my-datacenter"},
+				ControlPlaneConfiguration: anywherev1.ControlPlaneConfiguration{
+					Count:           3,
+					MachineGroupRef: &anywherev1.Ref{Kind: anywherev1.VSphereMachineConfigKind, Name: "my-machines"},
+				},
+				WorkerNodeGroupConfigurations: []anywherev1.WorkerNodeGroupConfiguration{
+					{
+						Name:            "md-0",
+						Count:           ptr.Int(2),
+						MachineGroupRef: &anywherev1.Ref{Kind: anywherev1.VSphereMachineConfigKind, Name: "my-machines"},
+					},
+				},
+			},
+		}
+		s.VSphereDatacenter = &anywherev1.VSphereDatacenterConfig{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-datacenter", Namespace: "default"},
+		}
+		s.VSphereMachineConfigs = map[string]*anywherev1.VSphereMachineConfig{
+			"my-machines": {
+				ObjectMeta: metav1.ObjectMeta{Name: "my-machines", Namespace: "default"},
+				Spec:       anywherev1.VSphereMachineConfigSpec{NumCPUs: 2},
+			},
+		}
+	})
+}
+
+func TestValidateRolloutAcknowledged(t *testing.T) {
+	current := rolloutTestSpec()
+	client := test.NewFakeKubeClient(current.Cluster, current.VSphereDatacenter, current.VSphereMachineConfigs["my-machines"])
+
+	tests := []struct {
+		name         string
+		update       func(*cluster.Spec)
+		acknowledged bool
+		wantErr      string
+	}{
+		{
+			name: "scaling",
+			update: func(s *cluster.Spec) {
+				s.Cluster.Spec.WorkerNodeGroupConfigurations[0].Count = ptr.Int(5)
+			},
+		},
+		{
+			name: "machine config changed",
+			update: func(s *cluster.Spec) {
+				s.VSphereMachineConfigs["my-machines"].Spec.NumCPUs = 4
+			},
+			wantErr: "changes to VSphereMachineConfig/my-machines.spec replace the machines of the cluster",
+		},
+		{
+			name: "kubernetes version upgraded",
+			update: func(s *cluster.Spec) {
+				s.Cluster.Spec.KubernetesVersion = anywherev1.Kube131
+			},
+			wantErr: "changes to spec.kubernetesVersion, spec.workerNodeGroupConfigurations[md-0].kubernetesVersion replace the machines of the cluster",
+		},
+		{
+			name: "kubernetes version upgraded and acknowledged",
+			update: func(s *cluster.Spec) {
+				s.Cluster.Spec.KubernetesVersion = anywherev1.Kube131
+			},
+			acknowledged: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			spec := rolloutTestSpec()
+			tt.update(spec)
+
+			err := upgradevalidations.ValidateRolloutAcknowledged(context.Background(), client, spec, tt.acknowledged)
+			if tt.wantErr == "" {
+				g.Expect(err).NotTo(HaveOccurred())
+			} else {
+				g.Expect(err).To(MatchError(tt.wantErr))
+			}
+		})
+	}
+}
+
+func TestValidateRolloutAcknowledgedClusterNotFound(t *testing.T) {
+	g := NewWithT(t)
+	err := upgradevalidations.ValidateRolloutAcknowledged(context.Background(), test.NewFakeKubeClient(), rolloutTestSpec(), false)
+	g.Expect(err).To(MatchError(ContainSubstring("reading cluster my-cluster")))
+}
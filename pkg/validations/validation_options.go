@@ -1,10 +1,13 @@
 package validations
 
 import (
+	"net"
+
 	"github.com/aws/eks-anywhere/pkg/clients/kubernetes"
 	"github.com/aws/eks-anywhere/pkg/cluster"
 	"github.com/aws/eks-anywhere/pkg/config"
 	"github.com/aws/eks-anywhere/pkg/crypto"
+	"github.com/aws/eks-anywhere/pkg/dns"
 	"github.com/aws/eks-anywhere/pkg/manifests"
 	"github.com/aws/eks-anywhere/pkg/providers"
 	"github.com/aws/eks-anywhere/pkg/types"
@@ -24,12 +27,17 @@ type Opts struct {
 	KubeClient         kubernetes.Client
 	ManifestReader     *manifests.Reader
 	BundlesOverride    string
+	EndpointValidator  EndpointValidator
+	AcknowledgeRollout bool
 }
 
 func (o *Opts) SetDefaults() {
 	if o.TLSValidator == nil {
 		o.TLSValidator = crypto.NewTlsValidator()
 	}
+	if o.EndpointValidator == nil {
+		o.EndpointValidator = dns.NewEndpointValidator(net.DefaultResolver)
+	}
 	if o.CliVersion == "" {
 		o.CliVersion = version.Get().GitVersion
 	}
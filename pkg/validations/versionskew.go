@@ -0,0 +1,143 @@
+package validations
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+
+	"github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/clients/kubernetes"
+	"github.com/aws/eks-anywhere/pkg/semver"
+	"github.com/aws/eks-anywhere/pkg/types"
+)
+
+// supportedCLIMinorVersionSkew is the number of minor versions the CLI can be ahead of
+// the clusters and management components it operates on.
+const supportedCLIMinorVersionSkew int64 = 1
+
+// VersionSkewError is returned when the version of a component the CLI operates on
+// is outside of the skew supported by the CLI.
+type VersionSkewError struct {
+	// Component identifies the component with an unsupported version, e.g. "management cluster mgmt".
+	Component string
+	// ComponentVersion is the eksaVersion of the component.
+	ComponentVersion string
+	// CLIVersion is the version of the EKS Anywhere CLI.
+	CLIVersion string
+	// Reason explains why the skew is not supported.
+	Reason string
+}
+
+// Error implements the error interface.
+func (e *VersionSkewError) Error() string {
+	return fmt.Sprintf(
+		"%s at eksaVersion %s is not supported by EKS Anywhere CLI %s: %s. Use --skip-validations=%s to bypass this validation",
+		e.Component, e.ComponentVersion, e.CLIVersion, e.Reason, CLIVersionSkew,
+	)
+}
+
+// ValidateCLIVersionSkew checks a component at the given eksaVersion can be operated on by the CLI.
+// The CLI can't be older than the component and can only be one minor version newer.
+// Dev builds are not subject to this validation.
+func ValidateCLIVersionSkew(cliVersion, component string, componentVersion *v1alpha1.EksaVersion) error {
+	if componentVersion == nil {
+		return nil
+	}
+
+	cli, err := semver.New(cliVersion)
+	if err != nil {
+		return fmt.Errorf("parsing eksa cli version: %v", err)
+	}
+
+	v, err := semver.New(string(*componentVersion))
+	if err != nil {
+		return fmt.Errorf("parsing %s eksaVersion: %v", component, err)
+	}
+
+	if isDevBuild(cli) || isDevBuild(v) {
+		return nil
+	}
+
+	skewErr := &VersionSkewError{
+		Component:        component,
+		ComponentVersion: string(*componentVersion),
+		CLIVersion:       cliVersion,
+	}
+
+	switch {
+	case cli.Major != v.Major:
+		skewErr.Reason = "major versions don't match"
+	case v.Minor > cli.Minor || (v.Minor == cli.Minor && v.Patch > cli.Patch):
+		skewErr.Reason = "the CLI is older than the component, use a CLI of the same or a newer version"
+	case cli.Minor-v.Minor > supportedCLIMinorVersionSkew:
+		skewErr.Reason = fmt.Sprintf("the CLI can only be %d minor version newer than the component, upgrade it sequentially with the intermediate releases", supportedCLIMinorVersionSkew)
+	default:
+		return nil
+	}
+
+	return skewErr
+}
+
+// ValidateManagementClusterCLIVersionSkew checks the CLI supports the eksaVersion of the management cluster.
+func ValidateManagementClusterCLIVersionSkew(ctx context.Context, k KubectlClient, cliVersion string, mgmtCluster *types.Cluster) error {
+	mgmt, err := k.GetEksaCluster(ctx, mgmtCluster, mgmtCluster.Name)
+	if err != nil {
+		return err
+	}
+
+	return ValidateCLIVersionSkew(cliVersion, fmt.Sprintf("management cluster %s", mgmt.Name), mgmt.Spec.EksaVersion)
+}
+
+// ValidateClusterCLIVersionSkew checks the CLI supports the eksaVersion of an existing cluster
+// and, when it's a workload cluster, of its management cluster.
+func ValidateClusterCLIVersionSkew(ctx context.Context, k KubectlClient, cliVersion string, mgmtCluster *types.Cluster, clusterName string) error {
+	if err := ValidateManagementClusterCLIVersionSkew(ctx, k, cliVersion, mgmtCluster); err != nil {
+		return err
+	}
+
+	if clusterName == mgmtCluster.Name {
+		return nil
+	}
+
+	c, err := k.GetEksaCluster(ctx, mgmtCluster, clusterName)
+	if err != nil {
+		return err
+	}
+
+	return ValidateCLIVersionSkew(cliVersion, fmt.Sprintf("cluster %s", c.Name), c.Spec.EksaVersion)
+}
+
+// ValidateCLIVersionSkewFromClient checks the CLI supports the eksaVersion of the management cluster
+// the client points to and, when clusterName isn't empty, of the cluster with that name. Clusters the
+// client can't find, like the ones in a workload cluster without EKS Anywhere objects, are not checked.
+func ValidateCLIVersionSkewFromClient(ctx context.Context, k kubernetes.Reader, cliVersion, clusterName string) error {
+	clusters := &v1alpha1.ClusterList{}
+	if err := k.List(ctx, clusters); err != nil {
+		if meta.IsNoMatchError(err) {
+			return nil
+		}
+		return fmt.Errorf("listing clusters: %v", err)
+	}
+
+	for i := range clusters.Items {
+		c := &clusters.Items[i]
+		var err error
+		switch {
+		case c.IsSelfManaged():
+			err = ValidateCLIVersionSkew(cliVersion, fmt.Sprintf("management cluster %s", c.Name), c.Spec.EksaVersion)
+		case c.Name == clusterName:
+			err = ValidateCLIVersionSkew(cliVersion, fmt.Sprintf("cluster %s", c.Name), c.Spec.EksaVersion)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func isDevBuild(v *semver.Version) bool {
+	return strings.HasPrefix(v.Prerelease, "dev")
+}
@@ -0,0 +1,248 @@
+package validations_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/aws/eks-anywhere/internal/test"
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/validations"
+)
+
+func TestValidateCLIVersionSkew(t *testing.T) {
+	tests := []struct {
+		name             string
+		cliVersion       string
+		componentVersion string
+		wantReason       string
+	}{
+		{
+			name:             "same version",
+			cliVersion:       "v0.20.1",
+			componentVersion: "v0.20.1",
+		},
+		{
+			name:             "cli newer patch",
+			cliVersion:       "v0.20.3",
+			componentVersion: "v0.20.1",
+		},
+		{
+			name:             "cli one minor version newer",
+			cliVersion:       "v0.21.0",
+			componentVersion: "v0.20.5",
+		},
+		{
+			name:             "cli two minor versions newer",
+			cliVersion:       "v0.22.0",
+			componentVersion: "v0.20.5",
+			wantReason:       "the CLI can only be 1 minor version newer than the component, upgrade it sequentially with the intermediate releases",
+		},
+		{
+			name:             "cli older minor",
+			cliVersion:       "v0.20.0",
+			componentVersion: "v0.21.0",
+			wantReason:       "the CLI is older than the component, use a CLI of the same or a newer version",
+		},
+		{
+			name:             "cli older patch",
+			cliVersion:       "v0.20.0",
+			componentVersion: "v0.20.1",
+			wantReason:       "the CLI is older than the component, use a CLI of the same or a newer version",
+		},
+		{
+			name:             "different major",
+			cliVersion:       "v1.0.0",
+			componentVersion: "v0.20.1",
+			wantReason:       "major versions don't match",
+		},
+		{
+			name:             "dev cli",
+			cliVersion:       "v0.0.0-dev+build.1234",
+			componentVersion: "v0.20.1",
+		},
+		{
+			name:             "dev component",
+			cliVersion:       "v0.22.0",
+			componentVersion: anywherev1.DevBuildVersion,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			v := anywherev1.EksaVersion(tt.componentVersion)
+
+			err := validations.ValidateCLIVersionSkew(tt.cliVersion, "cluster test", &v)
+			if tt.wantReason == "" {
+				g.Expect(err).To(Succeed())
+				return
+			}
+
+			skewErr := &validations.VersionSkewError{}
+			g.Expect(errors.As(err, &skewErr)).To(BeTrue())
+			g.Expect(skewErr.Reason).To(Equal(tt.wantReason))
+			g.Expect(skewErr.Component).To(Equal("cluster test"))
+			g.Expect(skewErr.ComponentVersion).To(Equal(tt.componentVersion))
+			g.Expect(skewErr.CLIVersion).To(Equal(tt.cliVersion))
+			g.Expect(err).To(MatchError(ContainSubstring("--skip-validations=cli-version-skew")))
+		})
+	}
+}
+
+func TestValidateCLIVersionSkewNilVersion(t *testing.T) {
+	g := NewWithT(t)
+	g.Expect(validations.ValidateCLIVersionSkew("v0.20.0", "cluster test", nil)).To(Succeed())
+}
+
+func TestValidateCLIVersionSkewInvalidCLIVersion(t *testing.T) {
+	g := NewWithT(t)
+	v := anywherev1.EksaVersion("v0.20.0")
+	g.Expect(validations.ValidateCLIVersionSkew("invalid", "cluster test", &v)).To(MatchError(ContainSubstring("parsing eksa cli version")))
+}
+
+func TestValidateCLIVersionSkewInvalidComponentVersion(t *testing.T) {
+	g := NewWithT(t)
+	v := anywherev1.EksaVersion("invalid")
+	g.Expect(validations.ValidateCLIVersionSkew("v0.20.0", "cluster test", &v)).To(MatchError(ContainSubstring("parsing cluster test eksaVersion")))
+}
+
+func TestValidateClusterCLIVersionSkewWorkloadCluster(t *testing.T) {
+	tt := newTest(t, withKubectl())
+	ctx := context.Background()
+	mgmtVersion := anywherev1.EksaVersion("v0.21.0")
+	workloadVersion := anywherev1.EksaVersion("v0.19.0")
+	mgmt := test.Cluster(func(c *anywherev1.Cluster) {
+		c.Name = "mgmt"
+		c.Spec.EksaVersion = &mgmtVersion
+	})
+	workload := test.Cluster(func(c *anywherev1.Cluster) {
+		c.Name = "workload"
+		c.Spec.EksaVersion = &workloadVersion
+	})
+
+	tt.kubectl.EXPECT().GetEksaCluster(ctx, managementCluster("mgmt"), "mgmt").Return(mgmt, nil)
+	tt.kubectl.EXPECT().GetEksaCluster(ctx, managementCluster("mgmt"), "workload").Return(workload, nil)
+
+	err := validations.ValidateClusterCLIVersionSkew(ctx, tt.kubectl, "v0.21.0", managementCluster("mgmt"), "workload")
+	skewErr := &validations.VersionSkewError{}
+	tt.Expect(errors.As(err, &skewErr)).To(BeTrue())
+	tt.Expect(skewErr.Component).To(Equal("cluster workload"))
+}
+
+func TestValidateClusterCLIVersionSkewManagementCluster(t *testing.T) {
+	tt := newTest(t, withKubectl())
+	ctx := context.Background()
+	mgmtVersion := anywherev1.EksaVersion("v0.22.0")
+	mgmt := test.Cluster(func(c *anywherev1.Cluster) {
+		c.Name = "mgmt"
+		c.Spec.EksaVersion = &mgmtVersion
+	})
+
+	tt.kubectl.EXPECT().GetEksaCluster(ctx, managementCluster("mgmt"), "mgmt").Return(mgmt, nil)
+
+	err := validations.ValidateClusterCLIVersionSkew(ctx, tt.kubectl, "v0.21.0", managementCluster("mgmt"), "workload")
+	skewErr := &validations.VersionSkewError{}
+	tt.Expect(errors.As(err, &skewErr)).To(BeTrue())
+	tt.Expect(skewErr.Component).To(Equal("management cluster mgmt"))
+}
+
+func TestValidateClusterCLIVersionSkewSelfManaged(t *testing.T) {
+	tt := newTest(t, withKubectl())
+	ctx := context.Background()
+	mgmtVersion := anywherev1.EksaVersion("v0.21.0")
+	mgmt := test.Cluster(func(c *anywherev1.Cluster) {
+		c.Name = "mgmt"
+		c.Spec.EksaVersion = &mgmtVersion
+	})
+
+	tt.kubectl.EXPECT().GetEksaCluster(ctx, managementCluster("mgmt"), "mgmt").Return(mgmt, nil)
+
+	tt.Expect(validations.ValidateClusterCLIVersionSkew(ctx, tt.kubectl, "v0.22.1", managementCluster("mgmt"), "mgmt")).To(Succeed())
+}
+
+func TestValidateClusterCLIVersionSkewGetClusterError(t *testing.T) {
+	tt := newTest(t, withKubectl())
+	ctx := context.Background()
+
+	tt.kubectl.EXPECT().GetEksaCluster(ctx, managementCluster("mgmt"), "mgmt").Return(nil, errors.New("error getting cluster"))
+
+	tt.Expect(validations.ValidateClusterCLIVersionSkew(ctx, tt.kubectl, "v0.22.1", managementCluster("mgmt"), "mgmt")).To(MatchError("error getting cluster"))
+}
+
+func TestValidateCLIVersionSkewFromClient(t *testing.T) {
+	mgmtVersion := anywherev1.EksaVersion("v0.21.0")
+	workloadVersion := anywherev1.EksaVersion("v0.19.0")
+	otherVersion := anywherev1.EksaVersion("v0.18.0")
+	mgmt := test.Cluster(func(c *anywherev1.Cluster) {
+		c.Name = "mgmt"
+		c.Namespace = "default"
+		c.Spec.EksaVersion = &mgmtVersion
+	})
+	workload := test.Cluster(func(c *anywherev1.Cluster) {
+		c.Name = "workload"
+		c.Namespace = "default"
+		c.SetManagedBy("mgmt")
+		c.Spec.EksaVersion = &workloadVersion
+	})
+	other := test.Cluster(func(c *anywherev1.Cluster) {
+		c.Name = "other"
+		c.Namespace = "default"
+		c.SetManagedBy("mgmt")
+		c.Spec.EksaVersion = &otherVersion
+	})
+
+	tests := []struct {
+		name          string
+		cliVersion    string
+		clusterName   string
+		wantComponent string
+	}{
+		{
+			name:        "management cluster supported",
+			cliVersion:  "v0.21.2",
+			clusterName: "",
+		},
+		{
+			name:          "management cluster not supported",
+			cliVersion:    "v0.20.0",
+			clusterName:   "",
+			wantComponent: "management cluster mgmt",
+		},
+		{
+			name:          "workload cluster not supported",
+			cliVersion:    "v0.21.0",
+			clusterName:   "workload",
+			wantComponent: "cluster workload",
+		},
+		{
+			name:        "other workload clusters not checked",
+			cliVersion:  "v0.21.0",
+			clusterName: "mgmt",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			k := test.NewFakeKubeClient(mgmt, workload, other)
+
+			err := validations.ValidateCLIVersionSkewFromClient(context.Background(), k, tc.cliVersion, tc.clusterName)
+			if tc.wantComponent == "" {
+				g.Expect(err).NotTo(HaveOccurred())
+				return
+			}
+			skewErr := &validations.VersionSkewError{}
+			g.Expect(errors.As(err, &skewErr)).To(BeTrue())
+			g.Expect(skewErr.Component).To(Equal(tc.wantComponent))
+		})
+	}
+}
+
+func TestValidateCLIVersionSkewFromClientListError(t *testing.T) {
+	g := NewWithT(t)
+	k := test.NewFakeKubeClientAlwaysError()
+
+	g.Expect(validations.ValidateCLIVersionSkewFromClient(context.Background(), k, "v0.21.0", "")).To(MatchError(ContainSubstring("listing clusters")))
+}
@@ -0,0 +1,125 @@
+// Package wait polls the state of cluster objects until all of them are ready, reporting the
+// progress of the wait every time it changes instead of waiting silently.
+package wait
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/eks-anywhere/pkg/logger"
+	"github.com/aws/eks-anywhere/pkg/retrier"
+)
+
+// Progress is the number of ready objects out of the total number of objects waited on.
+type Progress struct {
+	Ready int
+	Total int
+}
+
+// Done returns true when all the objects are ready.
+func (p Progress) Done() bool {
+	return p.Ready >= p.Total
+}
+
+// Pending returns the number of objects that are not ready yet.
+func (p Progress) Pending() int {
+	if p.Done() {
+		return 0
+	}
+	return p.Total - p.Ready
+}
+
+func (p Progress) String() string {
+	return fmt.Sprintf("%d/%d", p.Ready, p.Total)
+}
+
+// CheckFunc returns the current progress of a wait. An error means the progress
+// couldn't be determined and the check is retried.
+type CheckFunc func() (Progress, error)
+
+// ProgressFunc is called with the name of the wait every time its progress changes.
+type ProgressFunc func(name string, p Progress)
+
+// NotReadyError is returned by a check when not all the objects are ready yet.
+type NotReadyError struct {
+	Name     string
+	Progress Progress
+}
+
+func (e *NotReadyError) Error() string {
+	return fmt.Sprintf("%s not ready yet: %s ready", e.Name, e.Progress)
+}
+
+// Waiter runs readiness checks with a retrier.
+type Waiter struct {
+	retrier    *retrier.Retrier
+	onProgress ProgressFunc
+}
+
+// Opt allows to customize a Waiter.
+type Opt func(*Waiter)
+
+// WithProgress sets the function called when the progress of a wait changes.
+func WithProgress(fn ProgressFunc) Opt {
+	return func(w *Waiter) {
+		w.onProgress = fn
+	}
+}
+
+// New builds a Waiter that retries the checks with the retrier. By default, the
+// progress is logged to the CLI output.
+func New(r *retrier.Retrier, opts ...Opt) *Waiter {
+	w := &Waiter{
+		retrier:    r,
+		onProgress: LogProgress,
+	}
+	for _, o := range opts {
+		o(w)
+	}
+
+	return w
+}
+
+// For runs the check until all the objects are ready or the retrier gives up, in which
+// case it returns the last error. A wait that is done on the first check reports no progress.
+func (w *Waiter) For(name string, check CheckFunc) error {
+	var last Progress
+	reported := false
+	return w.retrier.Retry(func() error {
+		p, err := check()
+		if err != nil {
+			return err
+		}
+
+		if (!reported && !p.Done()) || (reported && p != last) {
+			w.onProgress(name, p)
+			reported = true
+		}
+		last = p
+
+		if !p.Done() {
+			return &NotReadyError{Name: name, Progress: p}
+		}
+		return nil
+	})
+}
+
+// LogProgress logs the progress of a wait.
+func LogProgress(name string, p Progress) {
+	logger.Info(fmt.Sprintf("Waiting for %s to be ready", name), "ready", p.String())
+}
+
+// PendingBackoffPolicy retries until the top level timeout, waiting the backoff once per pending
+// object between retries, so waits on many objects poll less often. Checks that failed before
+// determining the progress wait the backoff once.
+func PendingBackoffPolicy(backoff time.Duration) retrier.RetryPolicy {
+	return func(_ int, err error) (bool, time.Duration) {
+		pending := 1
+		notReady := &NotReadyError{}
+		if errors.As(err, &notReady) && notReady.Progress.Pending() > 1 {
+			pending = notReady.Progress.Pending()
+		}
+		return true, backoff * time.Duration(pending)
+	}
+}
@@ -0,0 +1,116 @@
+package wait_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/aws/eks-anywhere/pkg/retrier"
+	"github.com/aws/eks-anywhere/pkg/wait"
+)
+
+type progressRecorder struct {
+	reported []string
+}
+
+func (r *progressRecorder) record(name string, p wait.Progress) {
+	r.reported = append(r.reported, fmt.Sprintf("%s %s", name, p))
+}
+
+func TestWaiterForReportsProgressChanges(t *testing.T) {
+	g := NewWithT(t)
+	recorder := &progressRecorder{}
+	w := wait.New(retrier.NewWithMaxRetries(10, 0), wait.WithProgress(recorder.record))
+
+	steps := []wait.Progress{{Ready: 0, Total: 3}, {Ready: 1, Total: 3}, {Ready: 1, Total: 3}, {Ready: 3, Total: 3}}
+	calls := 0
+	err := w.For("nodes", func() (wait.Progress, error) {
+		p := steps[calls]
+		calls++
+		return p, nil
+	})
+
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(calls).To(Equal(4))
+	g.Expect(recorder.reported).To(Equal([]string{"nodes 0/3", "nodes 1/3", "nodes 3/3"}))
+}
+
+func TestWaiterForDoneOnFirstCheck(t *testing.T) {
+	g := NewWithT(t)
+	recorder := &progressRecorder{}
+	w := wait.New(retrier.NewWithMaxRetries(10, 0), wait.WithProgress(recorder.record))
+
+	err := w.For("nodes", func() (wait.Progress, error) {
+		return wait.Progress{Ready: 2, Total: 2}, nil
+	})
+
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(recorder.reported).To(BeEmpty())
+}
+
+func TestWaiterForRetriesCheckErrors(t *testing.T) {
+	g := NewWithT(t)
+	recorder := &progressRecorder{}
+	w := wait.New(retrier.NewWithMaxRetries(10, 0), wait.WithProgress(recorder.record))
+
+	calls := 0
+	err := w.For("nodes", func() (wait.Progress, error) {
+		calls++
+		if calls == 1 {
+			return wait.Progress{}, errors.New("connection refused")
+		}
+		return wait.Progress{Ready: 1, Total: 1}, nil
+	})
+
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(calls).To(Equal(2))
+}
+
+func TestWaiterForRetriesExhausted(t *testing.T) {
+	g := NewWithT(t)
+	w := wait.New(retrier.NewWithMaxRetries(3, 0), wait.WithProgress(func(string, wait.Progress) {}))
+
+	err := w.For("control plane replicas", func() (wait.Progress, error) {
+		return wait.Progress{Ready: 1, Total: 3}, nil
+	})
+
+	g.Expect(err).To(MatchError("control plane replicas not ready yet: 1/3 ready"))
+	notReady := &wait.NotReadyError{}
+	g.Expect(errors.As(err, &notReady)).To(BeTrue())
+	g.Expect(notReady.Progress.Pending()).To(Equal(2))
+}
+
+func TestPendingBackoffPolicy(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want time.Duration
+	}{
+		{
+			name: "pending objects",
+			err:  &wait.NotReadyError{Name: "nodes", Progress: wait.Progress{Ready: 1, Total: 4}},
+			want: 3 * time.Second,
+		},
+		{
+			name: "wrapped not ready error",
+			err:  fmt.Errorf("waiting: %w", &wait.NotReadyError{Name: "nodes", Progress: wait.Progress{Ready: 0, Total: 2}}),
+			want: 2 * time.Second,
+		},
+		{
+			name: "other error",
+			err:  errors.New("connection refused"),
+			want: time.Second,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			retry, backoff := wait.PendingBackoffPolicy(time.Second)(1, tt.err)
+			g.Expect(retry).To(BeTrue())
+			g.Expect(backoff).To(Equal(tt.want))
+		})
+	}
+}
@@ -69,6 +69,20 @@ func (i Image) Tag() string {
 	return i.URI[lastInd+1:]
 }
 
+// SupportsArch reports whether the Image is published for the given architecture.
+// Images without architecture metadata are assumed to support any architecture.
+func (i Image) SupportsArch(arch string) bool {
+	if len(i.Arch) == 0 {
+		return true
+	}
+	for _, a := range i.Arch {
+		if a == arch {
+			return true
+		}
+	}
+	return false
+}
+
 // ChartName constructs a typical Helm chart artifact name (with ".tgz")
 // from the Image's name by replacing the last colon with a hyphen.
 func (i Image) ChartName() string {
@@ -49,6 +49,39 @@ func TestImageVersionedImage(t *testing.T) {
 	}
 }
 
+func TestImageSupportsArch(t *testing.T) {
+	tests := []struct {
+		testName string
+		arch     []string
+		want     bool
+	}{
+		{
+			testName: "no arch metadata",
+			want:     true,
+		},
+		{
+			testName: "multi arch",
+			arch:     []string{"amd64", "arm64"},
+			want:     true,
+		},
+		{
+			testName: "amd64 only",
+			arch:     []string{"amd64"},
+			want:     false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			i := v1alpha1.Image{
+				Arch: tt.arch,
+			}
+			if got := i.SupportsArch("arm64"); got != tt.want {
+				t.Errorf("Image.SupportsArch() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestImageImage(t *testing.T) {
 	tests := []struct {
 		testName string
@@ -56,6 +56,22 @@ func (vb *VersionsBundle) Manifests() map[string][]*string {
 			&vb.Nutanix.ClusterTemplate.URI,
 			&vb.Nutanix.Metadata.URI,
 		},
+		"cluster-api-provider-proxmox": {
+			&vb.Proxmox.Components.URI,
+			&vb.Proxmox.Metadata.URI,
+		},
+		"cluster-api-provider-openstack": {
+			&vb.OpenStack.Components.URI,
+			&vb.OpenStack.Metadata.URI,
+		},
+		"cluster-api-provider-azurestackhci": {
+			&vb.AzureStackHCI.Components.URI,
+			&vb.AzureStackHCI.Metadata.URI,
+		},
+		"cluster-api-provider-harvester": {
+			&vb.Harvester.Components.URI,
+			&vb.Harvester.Metadata.URI,
+		},
 		"kindnetd": {
 			&vb.Kindnetd.Manifest.URI,
 		},
@@ -76,6 +92,12 @@ func (vb *VersionsBundle) Manifests() map[string][]*string {
 		},
 	}
 
+	if vb.Calico != nil {
+		m["calico"] = []*string{
+			&vb.Calico.Manifest.URI,
+		}
+	}
+
 	// CloudStack is deprecated — only include manifests if URIs are non-empty/non-placeholder.
 	if vb.CloudStack.Components.URI != "" && vb.CloudStack.Components.URI != "<placeholder>" {
 		m["cluster-api-provider-cloudstack"] = []*string{
@@ -178,6 +200,134 @@ func (vb *VersionsBundle) NutanixImages() []Image {
 	return i
 }
 
+// ProxmoxImages returns images needed for the Proxmox provider in a VersionsBundle.
+func (vb *VersionsBundle) ProxmoxImages() []Image {
+	i := make([]Image, 0, 2)
+	if vb.Proxmox.ClusterAPIController.URI != "" {
+		i = append(i, vb.Proxmox.ClusterAPIController)
+	}
+
+	if vb.Proxmox.KubeVip.URI != "" {
+		i = append(i, vb.Proxmox.KubeVip)
+	}
+
+	return i
+}
+
+// OpenStackImages returns images needed for the OpenStack provider in a VersionsBundle.
+func (vb *VersionsBundle) OpenStackImages() []Image {
+	i := make([]Image, 0, 2)
+	if vb.OpenStack.ClusterAPIController.URI != "" {
+		i = append(i, vb.OpenStack.ClusterAPIController)
+	}
+
+	if vb.OpenStack.KubeVip.URI != "" {
+		i = append(i, vb.OpenStack.KubeVip)
+	}
+
+	return i
+}
+
+// AzureStackHCIImages returns images needed for the Azure Stack HCI provider in a VersionsBundle.
+func (vb *VersionsBundle) AzureStackHCIImages() []Image {
+	i := make([]Image, 0, 2)
+	if vb.AzureStackHCI.ClusterAPIController.URI != "" {
+		i = append(i, vb.AzureStackHCI.ClusterAPIController)
+	}
+
+	if vb.AzureStackHCI.KubeVip.URI != "" {
+		i = append(i, vb.AzureStackHCI.KubeVip)
+	}
+
+	return i
+}
+
+// HarvesterImages returns images needed for the Harvester provider in a VersionsBundle.
+func (vb *VersionsBundle) HarvesterImages() []Image {
+	i := make([]Image, 0, 2)
+	if vb.Harvester.ClusterAPIController.URI != "" {
+		i = append(i, vb.Harvester.ClusterAPIController)
+	}
+
+	if vb.Harvester.KubeVip.URI != "" {
+		i = append(i, vb.Harvester.KubeVip)
+	}
+
+	return i
+}
+
+// ExternalDNSImages returns the external-dns images in a VersionsBundle.
+func (vb *VersionsBundle) ExternalDNSImages() []Image {
+	if vb.ExternalDNS == nil {
+		return nil
+	}
+
+	return []Image{vb.ExternalDNS.ExternalDNS}
+}
+
+// ServiceLoadBalancerImages returns the service load balancer images in a VersionsBundle.
+func (vb *VersionsBundle) ServiceLoadBalancerImages() []Image {
+	if vb.ServiceLoadBalancer == nil {
+		return nil
+	}
+
+	return []Image{vb.ServiceLoadBalancer.KubeVip, vb.ServiceLoadBalancer.CloudProvider}
+}
+
+// NvidiaDevicePluginImages returns the NVIDIA device plugin images in a VersionsBundle.
+func (vb *VersionsBundle) NvidiaDevicePluginImages() []Image {
+	if vb.NvidiaDevicePlugin == nil {
+		return nil
+	}
+
+	return []Image{vb.NvidiaDevicePlugin.DevicePlugin}
+}
+
+// KonnectivityImages returns the konnectivity images in a VersionsBundle.
+func (vb *VersionsBundle) KonnectivityImages() []Image {
+	if vb.Konnectivity == nil {
+		return nil
+	}
+
+	return []Image{vb.Konnectivity.Server, vb.Konnectivity.Agent}
+}
+
+// SpireImages returns the SPIRE images in a VersionsBundle.
+func (vb *VersionsBundle) SpireImages() []Image {
+	if vb.Spire == nil {
+		return nil
+	}
+
+	return []Image{vb.Spire.Server, vb.Spire.Agent}
+}
+
+// DNSImages returns the images of the DNS add-ons in a VersionsBundle.
+func (vb *VersionsBundle) DNSImages() []Image {
+	if vb.DNS == nil {
+		return nil
+	}
+
+	return []Image{vb.DNS.ClusterProportionalAutoscaler, vb.DNS.NodeLocalDNS}
+}
+
+// CalicoImages returns the Calico images in a VersionsBundle.
+func (vb *VersionsBundle) CalicoImages() []Image {
+	if vb.Calico == nil {
+		return nil
+	}
+
+	return []Image{vb.Calico.Node, vb.Calico.CNI, vb.Calico.KubeControllers}
+}
+
+// HubbleImages returns the Hubble images in a VersionsBundle.
+func (vb *VersionsBundle) HubbleImages() []Image {
+	if vb.Cilium.Hubble == nil {
+		return nil
+	}
+
+	return []Image{vb.Cilium.Hubble.Relay, vb.Cilium.Hubble.UI, vb.Cilium.Hubble.UIBackend}
+}
+
 // SharedImages returns images that are shared across different providers in a VersionsBundle.
 func (vb *VersionsBundle) SharedImages() []Image {
 	return []Image{
@@ -227,6 +377,18 @@ func (vb *VersionsBundle) Images() []Image {
 		vb.SnowImages(),
 		vb.TinkerbellImages(),
 		vb.NutanixImages(),
+		vb.ProxmoxImages(),
+		vb.OpenStackImages(),
+		vb.AzureStackHCIImages(),
+		vb.HarvesterImages(),
+		vb.ExternalDNSImages(),
+		vb.ServiceLoadBalancerImages(),
+		vb.NvidiaDevicePluginImages(),
+		vb.KonnectivityImages(),
+		vb.SpireImages(),
+		vb.CalicoImages(),
+		vb.HubbleImages(),
+		vb.DNSImages(),
 	}
 
 	size := 0
@@ -253,3 +253,16 @@ func TestManifestsIncludesCloudStackWhenRealURI(t *testing.T) {
 	_, hasCloudStack := manifests["cluster-api-provider-cloudstack"]
 	g.Expect(hasCloudStack).To(BeTrue(), "cloudstack should be present in manifests when real URIs are provided")
 }
+
+func TestManifestsIncludesCalico(t *testing.T) {
+	g := NewWithT(t)
+
+	vb := &v1alpha1.VersionsBundle{}
+	_, hasCalico := vb.Manifests()["calico"]
+	g.Expect(hasCalico).To(BeFalse())
+
+	vb.Calico = &v1alpha1.CalicoBundle{
+		Manifest: v1alpha1.Manifest{URI: "https://example.com/calico.yaml"},
+	}
+	g.Expect(vb.Manifests()["calico"]).To(ConsistOf(&vb.Calico.Manifest.URI))
+}
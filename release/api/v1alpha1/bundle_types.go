@@ -85,7 +85,18 @@ type VersionsBundle struct {
 	Haproxy                         HaproxyBundle                         `json:"haproxy,omitempty"`
 	Snow                            SnowBundle                            `json:"snow,omitempty"`
 	Nutanix                         NutanixBundle                         `json:"nutanix,omitempty"`
+	Proxmox                         ProxmoxBundle                         `json:"proxmox,omitempty"`
+	OpenStack                       OpenStackBundle                       `json:"openstack,omitempty"`
+	AzureStackHCI                   AzureStackHCIBundle                   `json:"azurestackhci,omitempty"`
+	Harvester                       HarvesterBundle                       `json:"harvester,omitempty"`
 	Upgrader                        UpgraderBundle                        `json:"upgrader,omitempty"`
+	ExternalDNS                     *ExternalDNSBundle                    `json:"externalDns,omitempty"`
+	ServiceLoadBalancer             *ServiceLoadBalancerBundle            `json:"serviceLoadBalancer,omitempty"`
+	NvidiaDevicePlugin              *NvidiaDevicePluginBundle             `json:"nvidiaDevicePlugin,omitempty"`
+	Konnectivity                    *KonnectivityBundle                   `json:"konnectivity,omitempty"`
+	Spire                           *SpireBundle                          `json:"spire,omitempty"`
+	Calico                          *CalicoBundle                         `json:"calico,omitempty"`
+	DNS                             *DNSBundle                            `json:"dns,omitempty"`
 	// This field has been deprecated
 	Aws *AwsBundle `json:"aws,omitempty"`
 }
@@ -145,6 +156,49 @@ type UpgraderBundle struct {
 	Upgrader Image `json:"upgrader"`
 }
 
+// ExternalDNSBundle defines the external-dns version and image for this bundle.
+type ExternalDNSBundle struct {
+	Version     string `json:"version,omitempty"`
+	ExternalDNS Image  `json:"externalDns"`
+}
+
+// ServiceLoadBalancerBundle defines the kube-vip images used to load balance Services of type
+// LoadBalancer for this bundle.
+type ServiceLoadBalancerBundle struct {
+	Version       string `json:"version,omitempty"`
+	KubeVip       Image  `json:"kubeVip"`
+	CloudProvider Image  `json:"cloudProvider"`
+}
+
+// NvidiaDevicePluginBundle defines the NVIDIA device plugin image used to expose the GPUs of the
+// worker nodes for this bundle.
+type NvidiaDevicePluginBundle struct {
+	Version      string `json:"version,omitempty"`
+	DevicePlugin Image  `json:"devicePlugin"`
+}
+
+// KonnectivityBundle defines the konnectivity images used to proxy the traffic from the
+// control plane to the nodes for this bundle.
+type KonnectivityBundle struct {
+	Version string `json:"version,omitempty"`
+	Server  Image  `json:"server"`
+	Agent   Image  `json:"agent"`
+}
+
+// SpireBundle defines the SPIRE server and agent images used to attest nodes and issue workload
+// identities for this bundle.
+type SpireBundle struct {
+	Version string `json:"version,omitempty"`
+	Server  Image  `json:"server"`
+	Agent   Image  `json:"agent"`
+}
+
+// DNSBundle defines the images of the DNS add-ons EKS Anywhere deploys next to CoreDNS.
+type DNSBundle struct {
+	ClusterProportionalAutoscaler Image `json:"clusterProportionalAutoscaler"`
+	NodeLocalDNS                  Image `json:"nodeLocalDNS"`
+}
+
 // OSImageBundle defines a set of OS images (e.g., Bottlerocket) for this bundle.
 type OSImageBundle struct {
 	Bottlerocket Archive `json:"bottlerocket,omitempty"`
@@ -258,6 +312,15 @@ type CiliumBundle struct {
 	// This field has been deprecated
 	Manifest  *Manifest `json:"manifest,omitempty"`
 	HelmChart Image     `json:"helmChart,omitempty"`
+	// Hubble contains the images of the Hubble components deployed with Cilium when enabled.
+	Hubble *HubbleBundle `json:"hubble,omitempty"`
+}
+
+// HubbleBundle defines the images of the Hubble Relay and UI deployed with Cilium.
+type HubbleBundle struct {
+	Relay     Image `json:"relay"`
+	UI        Image `json:"ui"`
+	UIBackend Image `json:"uiBackend"`
 }
 
 // KindnetdBundle defines the Kindnetd version and manifest for this bundle.
@@ -266,6 +329,15 @@ type KindnetdBundle struct {
 	Manifest Manifest `json:"manifest"`
 }
 
+// CalicoBundle defines the Calico version, images and manifest used for CNI in this bundle.
+type CalicoBundle struct {
+	Version         string   `json:"version,omitempty"`
+	Node            Image    `json:"node"`
+	CNI             Image    `json:"cni"`
+	KubeControllers Image    `json:"kubeControllers"`
+	Manifest        Manifest `json:"manifest"`
+}
+
 // FluxBundle defines the Flux components and versions used in this bundle.
 type FluxBundle struct {
 	Version                string `json:"version,omitempty"`
@@ -404,3 +476,39 @@ type NutanixBundle struct {
 	Metadata             Manifest `json:"metadata"`
 	ClusterTemplate      Manifest `json:"clusterTemplate"`
 }
+
+// ProxmoxBundle defines the Proxmox provider images and configurations for this bundle.
+type ProxmoxBundle struct {
+	Version              string   `json:"version"`
+	ClusterAPIController Image    `json:"clusterAPIController"`
+	KubeVip              Image    `json:"kubeVip"`
+	Components           Manifest `json:"components"`
+	Metadata             Manifest `json:"metadata"`
+}
+
+// OpenStackBundle defines the OpenStack provider images and configurations for this bundle.
+type OpenStackBundle struct {
+	Version              string   `json:"version"`
+	ClusterAPIController Image    `json:"clusterAPIController"`
+	KubeVip              Image    `json:"kubeVip"`
+	Components           Manifest `json:"components"`
+	Metadata             Manifest `json:"metadata"`
+}
+
+// AzureStackHCIBundle defines the Azure Stack HCI provider images and configurations for this bundle.
+type AzureStackHCIBundle struct {
+	Version              string   `json:"version"`
+	ClusterAPIController Image    `json:"clusterAPIController"`
+	KubeVip              Image    `json:"kubeVip"`
+	Components           Manifest `json:"components"`
+	Metadata             Manifest `json:"metadata"`
+}
+
+// HarvesterBundle defines the Harvester provider images and configurations for this bundle.
+type HarvesterBundle struct {
+	Version              string   `json:"version"`
+	ClusterAPIController Image    `json:"clusterAPIController"`
+	KubeVip              Image    `json:"kubeVip"`
+	Components           Manifest `json:"components"`
+	Metadata             Manifest `json:"metadata"`
+}
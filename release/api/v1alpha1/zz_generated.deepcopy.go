@@ -100,6 +100,25 @@ func (in *AwsBundle) DeepCopy() *AwsBundle {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureStackHCIBundle) DeepCopyInto(out *AzureStackHCIBundle) {
+	*out = *in
+	in.ClusterAPIController.DeepCopyInto(&out.ClusterAPIController)
+	in.KubeVip.DeepCopyInto(&out.KubeVip)
+	out.Components = in.Components
+	out.Metadata = in.Metadata
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureStackHCIBundle.
+func (in *AzureStackHCIBundle) DeepCopy() *AzureStackHCIBundle {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureStackHCIBundle)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BinaryBundle) DeepCopyInto(out *BinaryBundle) {
 	*out = *in
@@ -262,6 +281,25 @@ func (in *BundlesStatus) DeepCopy() *BundlesStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CalicoBundle) DeepCopyInto(out *CalicoBundle) {
+	*out = *in
+	in.Node.DeepCopyInto(&out.Node)
+	in.CNI.DeepCopyInto(&out.CNI)
+	in.KubeControllers.DeepCopyInto(&out.KubeControllers)
+	out.Manifest = in.Manifest
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CalicoBundle.
+func (in *CalicoBundle) DeepCopy() *CalicoBundle {
+	if in == nil {
+		return nil
+	}
+	out := new(CalicoBundle)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CertManagerBundle) DeepCopyInto(out *CertManagerBundle) {
 	*out = *in
@@ -299,6 +337,11 @@ func (in *CiliumBundle) DeepCopyInto(out *CiliumBundle) {
 		**out = **in
 	}
 	in.HelmChart.DeepCopyInto(&out.HelmChart)
+	if in.Hubble != nil {
+		in, out := &in.Hubble, &out.Hubble
+		*out = new(HubbleBundle)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CiliumBundle.
@@ -350,6 +393,23 @@ func (in *CoreClusterAPI) DeepCopy() *CoreClusterAPI {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DNSBundle) DeepCopyInto(out *DNSBundle) {
+	*out = *in
+	in.ClusterProportionalAutoscaler.DeepCopyInto(&out.ClusterProportionalAutoscaler)
+	in.NodeLocalDNS.DeepCopyInto(&out.NodeLocalDNS)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSBundle.
+func (in *DNSBundle) DeepCopy() *DNSBundle {
+	if in == nil {
+		return nil
+	}
+	out := new(DNSBundle)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DockerBundle) DeepCopyInto(out *DockerBundle) {
 	*out = *in
@@ -556,6 +616,22 @@ func (in *EtcdadmControllerBundle) DeepCopy() *EtcdadmControllerBundle {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExternalDNSBundle) DeepCopyInto(out *ExternalDNSBundle) {
+	*out = *in
+	in.ExternalDNS.DeepCopyInto(&out.ExternalDNS)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalDNSBundle.
+func (in *ExternalDNSBundle) DeepCopy() *ExternalDNSBundle {
+	if in == nil {
+		return nil
+	}
+	out := new(ExternalDNSBundle)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *FluxBundle) DeepCopyInto(out *FluxBundle) {
 	*out = *in
@@ -591,6 +667,25 @@ func (in *HaproxyBundle) DeepCopy() *HaproxyBundle {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HarvesterBundle) DeepCopyInto(out *HarvesterBundle) {
+	*out = *in
+	in.ClusterAPIController.DeepCopyInto(&out.ClusterAPIController)
+	in.KubeVip.DeepCopyInto(&out.KubeVip)
+	out.Components = in.Components
+	out.Metadata = in.Metadata
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HarvesterBundle.
+func (in *HarvesterBundle) DeepCopy() *HarvesterBundle {
+	if in == nil {
+		return nil
+	}
+	out := new(HarvesterBundle)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HookArch) DeepCopyInto(out *HookArch) {
 	*out = *in
@@ -629,6 +724,24 @@ func (in *HookBundle) DeepCopy() *HookBundle {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HubbleBundle) DeepCopyInto(out *HubbleBundle) {
+	*out = *in
+	in.Relay.DeepCopyInto(&out.Relay)
+	in.UI.DeepCopyInto(&out.UI)
+	in.UIBackend.DeepCopyInto(&out.UIBackend)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HubbleBundle.
+func (in *HubbleBundle) DeepCopy() *HubbleBundle {
+	if in == nil {
+		return nil
+	}
+	out := new(HubbleBundle)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Image) DeepCopyInto(out *Image) {
 	*out = *in
@@ -665,6 +778,23 @@ func (in *KindnetdBundle) DeepCopy() *KindnetdBundle {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KonnectivityBundle) DeepCopyInto(out *KonnectivityBundle) {
+	*out = *in
+	in.Server.DeepCopyInto(&out.Server)
+	in.Agent.DeepCopyInto(&out.Agent)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KonnectivityBundle.
+func (in *KonnectivityBundle) DeepCopy() *KonnectivityBundle {
+	if in == nil {
+		return nil
+	}
+	out := new(KonnectivityBundle)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KubeadmBootstrapBundle) DeepCopyInto(out *KubeadmBootstrapBundle) {
 	*out = *in
@@ -739,6 +869,22 @@ func (in *NutanixBundle) DeepCopy() *NutanixBundle {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NvidiaDevicePluginBundle) DeepCopyInto(out *NvidiaDevicePluginBundle) {
+	*out = *in
+	in.DevicePlugin.DeepCopyInto(&out.DevicePlugin)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NvidiaDevicePluginBundle.
+func (in *NvidiaDevicePluginBundle) DeepCopy() *NvidiaDevicePluginBundle {
+	if in == nil {
+		return nil
+	}
+	out := new(NvidiaDevicePluginBundle)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OSImageBundle) DeepCopyInto(out *OSImageBundle) {
 	*out = *in
@@ -887,6 +1033,61 @@ func (in *ReleaseStatus) DeepCopy() *ReleaseStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenStackBundle) DeepCopyInto(out *OpenStackBundle) {
+	*out = *in
+	in.ClusterAPIController.DeepCopyInto(&out.ClusterAPIController)
+	in.KubeVip.DeepCopyInto(&out.KubeVip)
+	out.Components = in.Components
+	out.Metadata = in.Metadata
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenStackBundle.
+func (in *OpenStackBundle) DeepCopy() *OpenStackBundle {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenStackBundle)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProxmoxBundle) DeepCopyInto(out *ProxmoxBundle) {
+	*out = *in
+	in.ClusterAPIController.DeepCopyInto(&out.ClusterAPIController)
+	in.KubeVip.DeepCopyInto(&out.KubeVip)
+	out.Components = in.Components
+	out.Metadata = in.Metadata
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProxmoxBundle.
+func (in *ProxmoxBundle) DeepCopy() *ProxmoxBundle {
+	if in == nil {
+		return nil
+	}
+	out := new(ProxmoxBundle)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceLoadBalancerBundle) DeepCopyInto(out *ServiceLoadBalancerBundle) {
+	*out = *in
+	in.KubeVip.DeepCopyInto(&out.KubeVip)
+	in.CloudProvider.DeepCopyInto(&out.CloudProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceLoadBalancerBundle.
+func (in *ServiceLoadBalancerBundle) DeepCopy() *ServiceLoadBalancerBundle {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceLoadBalancerBundle)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SnowBundle) DeepCopyInto(out *SnowBundle) {
 	*out = *in
@@ -907,6 +1108,23 @@ func (in *SnowBundle) DeepCopy() *SnowBundle {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SpireBundle) DeepCopyInto(out *SpireBundle) {
+	*out = *in
+	in.Server.DeepCopyInto(&out.Server)
+	in.Agent.DeepCopyInto(&out.Agent)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SpireBundle.
+func (in *SpireBundle) DeepCopy() *SpireBundle {
+	if in == nil {
+		return nil
+	}
+	out := new(SpireBundle)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TinkBundle) DeepCopyInto(out *TinkBundle) {
 	*out = *in
@@ -1074,7 +1292,46 @@ func (in *VersionsBundle) DeepCopyInto(out *VersionsBundle) {
 	in.Haproxy.DeepCopyInto(&out.Haproxy)
 	in.Snow.DeepCopyInto(&out.Snow)
 	in.Nutanix.DeepCopyInto(&out.Nutanix)
+	in.Proxmox.DeepCopyInto(&out.Proxmox)
+	in.OpenStack.DeepCopyInto(&out.OpenStack)
+	in.AzureStackHCI.DeepCopyInto(&out.AzureStackHCI)
+	in.Harvester.DeepCopyInto(&out.Harvester)
 	in.Upgrader.DeepCopyInto(&out.Upgrader)
+	if in.ExternalDNS != nil {
+		in, out := &in.ExternalDNS, &out.ExternalDNS
+		*out = new(ExternalDNSBundle)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ServiceLoadBalancer != nil {
+		in, out := &in.ServiceLoadBalancer, &out.ServiceLoadBalancer
+		*out = new(ServiceLoadBalancerBundle)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NvidiaDevicePlugin != nil {
+		in, out := &in.NvidiaDevicePlugin, &out.NvidiaDevicePlugin
+		*out = new(NvidiaDevicePluginBundle)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Konnectivity != nil {
+		in, out := &in.Konnectivity, &out.Konnectivity
+		*out = new(KonnectivityBundle)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Spire != nil {
+		in, out := &in.Spire, &out.Spire
+		*out = new(SpireBundle)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Calico != nil {
+		in, out := &in.Calico, &out.Calico
+		*out = new(CalicoBundle)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DNS != nil {
+		in, out := &in.DNS, &out.DNS
+		*out = new(DNSBundle)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Aws != nil {
 		in, out := &in.Aws, &out.Aws
 		*out = new(AwsBundle)
@@ -203,6 +203,27 @@ var bundleReleaseAssetsConfigMap = []assettypes.AssetConfig{
 			},
 		},
 	},
+	// Cluster-api-provider-azurestackhci artifacts
+	{
+		ProjectName: "cluster-api-provider-azurestackhci",
+		ProjectPath: "projects/microsoft/cluster-api-provider-azurestackhci",
+		Images: []*assettypes.Image{
+			{
+				RepoName: "cluster-api-provider-azurestackhci",
+			},
+		},
+		ImageRepoPrefix: "microsoft",
+		ImageTagOptions: []string{
+			"gitTag",
+			"projectPath",
+		},
+		Manifests: []*assettypes.ManifestComponent{
+			{
+				Name:          "infrastructure-azurestackhci",
+				ManifestFiles: []string{"infrastructure-components.yaml", "metadata.yaml"},
+			},
+		},
+	},
 	// Cluster-api-provider-cloudstack has been deprecated and removed from the bundle release pipeline.
 	// Cluster-api-provider-docker artifacts
 	{
@@ -227,6 +248,27 @@ var bundleReleaseAssetsConfigMap = []assettypes.AssetConfig{
 			},
 		},
 	},
+	// Cluster-api-provider-harvester artifacts
+	{
+		ProjectName: "cluster-api-provider-harvester",
+		ProjectPath: "projects/rancher-sandbox/cluster-api-provider-harvester",
+		Images: []*assettypes.Image{
+			{
+				RepoName: "cluster-api-provider-harvester",
+			},
+		},
+		ImageRepoPrefix: "rancher-sandbox",
+		ImageTagOptions: []string{
+			"gitTag",
+			"projectPath",
+		},
+		Manifests: []*assettypes.ManifestComponent{
+			{
+				Name:          "infrastructure-harvester",
+				ManifestFiles: []string{"infrastructure-components.yaml", "metadata.yaml"},
+			},
+		},
+	},
 	// Cluster-api-provider-nutanix artifacts
 	{
 		ProjectName: "cluster-api-provider-nutanix",
@@ -249,6 +291,49 @@ var bundleReleaseAssetsConfigMap = []assettypes.AssetConfig{
 		},
 		UsesKubeRbacProxy: true,
 	},
+	// Cluster-api-provider-openstack artifacts
+	{
+		ProjectName: "cluster-api-provider-openstack",
+		ProjectPath: "projects/kubernetes-sigs/cluster-api-provider-openstack",
+		Images: []*assettypes.Image{
+			{
+				RepoName:  "capi-openstack-controller",
+				AssetName: "cluster-api-provider-openstack",
+			},
+		},
+		ImageRepoPrefix: "kubernetes-sigs/cluster-api-provider-openstack",
+		ImageTagOptions: []string{
+			"gitTag",
+			"projectPath",
+		},
+		Manifests: []*assettypes.ManifestComponent{
+			{
+				Name:          "infrastructure-openstack",
+				ManifestFiles: []string{"infrastructure-components.yaml", "metadata.yaml"},
+			},
+		},
+	},
+	// Cluster-api-provider-proxmox artifacts
+	{
+		ProjectName: "cluster-api-provider-proxmox",
+		ProjectPath: "projects/ionos-cloud/cluster-api-provider-proxmox",
+		Images: []*assettypes.Image{
+			{
+				RepoName: "cluster-api-provider-proxmox",
+			},
+		},
+		ImageRepoPrefix: "ionos-cloud",
+		ImageTagOptions: []string{
+			"gitTag",
+			"projectPath",
+		},
+		Manifests: []*assettypes.ManifestComponent{
+			{
+				Name:          "infrastructure-proxmox",
+				ManifestFiles: []string{"infrastructure-components.yaml", "metadata.yaml"},
+			},
+		},
+	},
 	// Cluster-api-provider-tinkerbell artifacts
 	{
 		ProjectName: "cluster-api-provider-tinkerbell",
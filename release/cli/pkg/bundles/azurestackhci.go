@@ -0,0 +1,95 @@
+package bundles
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+
+	anywherev1alpha1 "github.com/aws/eks-anywhere/release/api/v1alpha1"
+	"github.com/aws/eks-anywhere/release/cli/pkg/constants"
+	releasetypes "github.com/aws/eks-anywhere/release/cli/pkg/types"
+	bundleutils "github.com/aws/eks-anywhere/release/cli/pkg/util/bundles"
+	"github.com/aws/eks-anywhere/release/cli/pkg/version"
+)
+
+// GetAzureStackHCIBundle returns the bundle for Azure Stack HCI.
+func GetAzureStackHCIBundle(r *releasetypes.ReleaseConfig, imageDigests releasetypes.ImageDigestsTable) (anywherev1alpha1.AzureStackHCIBundle, error) {
+	projectsInBundle := []string{"cluster-api-provider-azurestackhci", "kube-vip"}
+	azureStackHCIBundleArtifacts := map[string][]releasetypes.Artifact{}
+	for _, project := range projectsInBundle {
+		projectArtifacts, err := r.BundleArtifactsTable.Load(project)
+		if err != nil {
+			return anywherev1alpha1.AzureStackHCIBundle{}, fmt.Errorf("artifacts for project %s not found in bundle artifacts table", project)
+		}
+		azureStackHCIBundleArtifacts[project] = projectArtifacts
+	}
+	sortedComponentNames := bundleutils.SortArtifactsMap(azureStackHCIBundleArtifacts)
+
+	var sourceBranch string
+	var componentChecksum string
+	bundleImageArtifacts := map[string]anywherev1alpha1.Image{}
+	bundleManifestArtifacts := map[string]anywherev1alpha1.Manifest{}
+	artifactHashes := make([]string, 0)
+	for _, componentName := range sortedComponentNames {
+		for _, artifact := range azureStackHCIBundleArtifacts[componentName] {
+			if artifact.Image != nil {
+				imageArtifact := artifact.Image
+				if componentName == "cluster-api-provider-azurestackhci" {
+					sourceBranch = imageArtifact.SourcedFromBranch
+				}
+				imageDigest, err := imageDigests.Load(imageArtifact.ReleaseImageURI)
+				if err != nil {
+					return anywherev1alpha1.AzureStackHCIBundle{}, fmt.Errorf("loading digest from image digests table: %v", err)
+				}
+				bundleImageArtifact := anywherev1alpha1.Image{
+					Name:        imageArtifact.AssetName,
+					Description: fmt.Sprintf("Container image for %s image", imageArtifact.AssetName),
+					OS:          imageArtifact.OS,
+					Arch:        imageArtifact.Arch,
+					URI:         imageArtifact.ReleaseImageURI,
+					ImageDigest: imageDigest,
+				}
+				bundleImageArtifacts[imageArtifact.AssetName] = bundleImageArtifact
+				artifactHashes = append(artifactHashes, bundleImageArtifact.ImageDigest)
+			}
+
+			if artifact.Manifest != nil {
+				manifestArtifact := artifact.Manifest
+				bundleManifestArtifact := anywherev1alpha1.Manifest{
+					URI: manifestArtifact.ReleaseCdnURI,
+				}
+
+				bundleManifestArtifacts[manifestArtifact.ReleaseName] = bundleManifestArtifact
+
+				manifestHash, err := version.GenerateManifestHash(r, manifestArtifact)
+				if err != nil {
+					return anywherev1alpha1.AzureStackHCIBundle{}, err
+				}
+				artifactHashes = append(artifactHashes, manifestHash)
+			}
+		}
+	}
+
+	if r.DryRun {
+		componentChecksum = version.FakeComponentChecksum
+	} else {
+		componentChecksum = version.GenerateComponentHash(artifactHashes, r.DryRun)
+	}
+	caphVersion, err := version.BuildComponentVersion(
+		version.NewVersionerWithGITTAG(r.BuildRepoSource, constants.CaphProjectPath, sourceBranch, r),
+		componentChecksum,
+	)
+	if err != nil {
+		return anywherev1alpha1.AzureStackHCIBundle{}, errors.Wrapf(err, "Error getting version for cluster-api-provider-azurestackhci")
+	}
+
+	bundle := anywherev1alpha1.AzureStackHCIBundle{
+		Version:              caphVersion,
+		ClusterAPIController: bundleImageArtifacts["cluster-api-provider-azurestackhci"],
+		KubeVip:              bundleImageArtifacts["kube-vip"],
+		Components:           bundleManifestArtifacts["infrastructure-components.yaml"],
+		Metadata:             bundleManifestArtifacts["metadata.yaml"],
+	}
+
+	return bundle, nil
+}
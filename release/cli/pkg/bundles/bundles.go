@@ -133,6 +133,26 @@ func GetVersionsBundles(r *releasetypes.ReleaseConfig, imageDigests releasetypes
 		return nil, errors.Wrapf(err, "Error getting bundle for Nutanix infrastructure provider")
 	}
 
+	proxmoxBundle, err := GetProxmoxBundle(r, imageDigests)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Error getting bundle for Proxmox infrastructure provider")
+	}
+
+	openStackBundle, err := GetOpenStackBundle(r, imageDigests)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Error getting bundle for OpenStack infrastructure provider")
+	}
+
+	azureStackHCIBundle, err := GetAzureStackHCIBundle(r, imageDigests)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Error getting bundle for Azure Stack HCI infrastructure provider")
+	}
+
+	harvesterBundle, err := GetHarvesterBundle(r, imageDigests)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Error getting bundle for Harvester infrastructure provider")
+	}
+
 	eksDReleaseMap, err := filereader.ReadEksDReleases(r)
 	if err != nil {
 		return nil, err
@@ -208,6 +228,10 @@ func GetVersionsBundles(r *releasetypes.ReleaseConfig, imageDigests releasetypes
 			Haproxy:                         haproxyBundle,
 			Snow:                            snowBundle,
 			Nutanix:                         nutanixBundle,
+			Proxmox:                         proxmoxBundle,
+			OpenStack:                       openStackBundle,
+			AzureStackHCI:                   azureStackHCIBundle,
+			Harvester:                       harvesterBundle,
 			Upgrader:                        upgraderBundle,
 		}
 		if endOfStandardSupport != "" {
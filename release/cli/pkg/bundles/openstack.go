@@ -0,0 +1,95 @@
+package bundles
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+
+	anywherev1alpha1 "github.com/aws/eks-anywhere/release/api/v1alpha1"
+	"github.com/aws/eks-anywhere/release/cli/pkg/constants"
+	releasetypes "github.com/aws/eks-anywhere/release/cli/pkg/types"
+	bundleutils "github.com/aws/eks-anywhere/release/cli/pkg/util/bundles"
+	"github.com/aws/eks-anywhere/release/cli/pkg/version"
+)
+
+// GetOpenStackBundle returns the bundle for OpenStack.
+func GetOpenStackBundle(r *releasetypes.ReleaseConfig, imageDigests releasetypes.ImageDigestsTable) (anywherev1alpha1.OpenStackBundle, error) {
+	projectsInBundle := []string{"cluster-api-provider-openstack", "kube-vip"}
+	openStackBundleArtifacts := map[string][]releasetypes.Artifact{}
+	for _, project := range projectsInBundle {
+		projectArtifacts, err := r.BundleArtifactsTable.Load(project)
+		if err != nil {
+			return anywherev1alpha1.OpenStackBundle{}, fmt.Errorf("artifacts for project %s not found in bundle artifacts table", project)
+		}
+		openStackBundleArtifacts[project] = projectArtifacts
+	}
+	sortedComponentNames := bundleutils.SortArtifactsMap(openStackBundleArtifacts)
+
+	var sourceBranch string
+	var componentChecksum string
+	bundleImageArtifacts := map[string]anywherev1alpha1.Image{}
+	bundleManifestArtifacts := map[string]anywherev1alpha1.Manifest{}
+	artifactHashes := make([]string, 0)
+	for _, componentName := range sortedComponentNames {
+		for _, artifact := range openStackBundleArtifacts[componentName] {
+			if artifact.Image != nil {
+				imageArtifact := artifact.Image
+				if componentName == "cluster-api-provider-openstack" {
+					sourceBranch = imageArtifact.SourcedFromBranch
+				}
+				imageDigest, err := imageDigests.Load(imageArtifact.ReleaseImageURI)
+				if err != nil {
+					return anywherev1alpha1.OpenStackBundle{}, fmt.Errorf("loading digest from image digests table: %v", err)
+				}
+				bundleImageArtifact := anywherev1alpha1.Image{
+					Name:        imageArtifact.AssetName,
+					Description: fmt.Sprintf("Container image for %s image", imageArtifact.AssetName),
+					OS:          imageArtifact.OS,
+					Arch:        imageArtifact.Arch,
+					URI:         imageArtifact.ReleaseImageURI,
+					ImageDigest: imageDigest,
+				}
+				bundleImageArtifacts[imageArtifact.AssetName] = bundleImageArtifact
+				artifactHashes = append(artifactHashes, bundleImageArtifact.ImageDigest)
+			}
+
+			if artifact.Manifest != nil {
+				manifestArtifact := artifact.Manifest
+				bundleManifestArtifact := anywherev1alpha1.Manifest{
+					URI: manifestArtifact.ReleaseCdnURI,
+				}
+
+				bundleManifestArtifacts[manifestArtifact.ReleaseName] = bundleManifestArtifact
+
+				manifestHash, err := version.GenerateManifestHash(r, manifestArtifact)
+				if err != nil {
+					return anywherev1alpha1.OpenStackBundle{}, err
+				}
+				artifactHashes = append(artifactHashes, manifestHash)
+			}
+		}
+	}
+
+	if r.DryRun {
+		componentChecksum = version.FakeComponentChecksum
+	} else {
+		componentChecksum = version.GenerateComponentHash(artifactHashes, r.DryRun)
+	}
+	capoVersion, err := version.BuildComponentVersion(
+		version.NewVersionerWithGITTAG(r.BuildRepoSource, constants.CapoProjectPath, sourceBranch, r),
+		componentChecksum,
+	)
+	if err != nil {
+		return anywherev1alpha1.OpenStackBundle{}, errors.Wrapf(err, "Error getting version for cluster-api-provider-openstack")
+	}
+
+	bundle := anywherev1alpha1.OpenStackBundle{
+		Version:              capoVersion,
+		ClusterAPIController: bundleImageArtifacts["cluster-api-provider-openstack"],
+		KubeVip:              bundleImageArtifacts["kube-vip"],
+		Components:           bundleManifestArtifacts["infrastructure-components.yaml"],
+		Metadata:             bundleManifestArtifacts["metadata.yaml"],
+	}
+
+	return bundle, nil
+}
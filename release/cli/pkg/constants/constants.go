@@ -28,7 +28,11 @@ const (
 
 	// Project paths.
 	CapasProjectPath                    = "projects/aws/cluster-api-provider-aws-snow"
+	CaphProjectPath                     = "projects/microsoft/cluster-api-provider-azurestackhci"
+	CaphvProjectPath                    = "projects/rancher-sandbox/cluster-api-provider-harvester"
 	CapiProjectPath                     = "projects/kubernetes-sigs/cluster-api"
+	CapmoxProjectPath                   = "projects/ionos-cloud/cluster-api-provider-proxmox"
+	CapoProjectPath                     = "projects/kubernetes-sigs/cluster-api-provider-openstack"
 	CaptProjectPath                     = "projects/tinkerbell/cluster-api-provider-tinkerbell"
 	CapvProjectPath                     = "projects/kubernetes-sigs/cluster-api-provider-vsphere"
 	CapxProjectPath                     = "projects/nutanix-cloud-native/cluster-api-provider-nutanix"
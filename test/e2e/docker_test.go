@@ -1054,6 +1054,27 @@ func TestDockerKubernetesStackedEtcd(t *testing.T) {
 	runStackedEtcdFlow(test)
 }
 
+// Etcd restore drill
+func TestDockerKubernetes135StackedEtcdRestoreDrill(t *testing.T) {
+	test := framework.NewClusterE2ETest(
+		t,
+		framework.NewDocker(t),
+		framework.WithClusterFiller(api.WithKubernetesVersion(v1alpha1.Kube135)),
+		framework.WithClusterFiller(api.WithStackedEtcdTopology()),
+	)
+	runEtcdRestoreDrillFlow(test, v1alpha1.Kube135)
+}
+
+func TestDockerKubernetes135ExternalEtcdRestoreDrill(t *testing.T) {
+	test := framework.NewClusterE2ETest(
+		t,
+		framework.NewDocker(t),
+		framework.WithClusterFiller(api.WithKubernetesVersion(v1alpha1.Kube135)),
+		framework.WithClusterFiller(api.WithExternalEtcdTopology(1)),
+	)
+	runEtcdRestoreDrillFlow(test, v1alpha1.Kube135)
+}
+
 // Taints
 func TestDockerKubernetes132Taints(t *testing.T) {
 	provider := framework.NewDocker(t)
@@ -2950,3 +2971,15 @@ webhooks:
 	test.StopIfFailed()
 	test.DeleteCluster()
 }
+
+// Scale test
+func TestDockerKubernetes136ScaleWorkerNodeGroup(t *testing.T) {
+	test := framework.NewScaleTest(
+		framework.NewClusterE2ETest(
+			t,
+			framework.NewDocker(t),
+			framework.WithClusterFiller(api.WithKubernetesVersion(v1alpha1.Kube136)),
+		),
+	)
+	test.Run()
+}
@@ -0,0 +1,33 @@
+//go:build e2e
+// +build e2e
+
+package e2e
+
+import (
+	"github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/test/framework"
+)
+
+// runDualStackFlow creates a dual-stack cluster and validates its nodes and pods get addresses of
+// both IP families before deleting it.
+func runDualStackFlow(test *framework.ClusterE2ETest, kubeVersion v1alpha1.KubernetesVersion) {
+	test.GenerateClusterConfig()
+	test.CreateCluster()
+	test.ValidateDualStack()
+	test.ValidateCluster(kubeVersion)
+	test.StopIfFailed()
+	test.DeleteCluster()
+}
+
+// runDualStackUpgradeFlow creates a dual-stack cluster, upgrades it and validates the nodes rolled
+// out during the upgrade keep dual-stack networking before deleting it.
+func runDualStackUpgradeFlow(test *framework.ClusterE2ETest, updateVersion v1alpha1.KubernetesVersion, clusterOpts ...framework.ClusterE2ETestOpt) {
+	test.GenerateClusterConfig()
+	test.CreateCluster()
+	test.ValidateDualStack()
+	test.UpgradeClusterWithNewConfig(clusterOpts)
+	test.ValidateDualStack()
+	test.ValidateCluster(updateVersion)
+	test.StopIfFailed()
+	test.DeleteCluster()
+}
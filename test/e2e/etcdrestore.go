@@ -0,0 +1,26 @@
+//go:build e2e
+// +build e2e
+
+package e2e
+
+import (
+	"github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/test/framework"
+)
+
+// runEtcdRestoreDrillFlow creates a cluster with a marker workload, takes an etcd snapshot, loses the
+// data written after it, and restores the snapshot, validating the cluster is back to the state of
+// the snapshot and healthy before deleting it.
+func runEtcdRestoreDrillFlow(test *framework.ClusterE2ETest, kubeVersion v1alpha1.KubernetesVersion) {
+	test.GenerateClusterConfig()
+	test.GenerateSupportBundleOnCleanupIfTestFailed()
+	test.CreateCluster()
+	test.CreateEtcdRestoreWorkload()
+	test.BackupEtcd()
+	test.SimulateEtcdDataLoss()
+	test.RestoreEtcd()
+	test.ValidateEtcdRestore()
+	test.ValidateCluster(kubeVersion)
+	test.StopIfFailed()
+	test.DeleteCluster()
+}
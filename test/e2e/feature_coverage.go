@@ -0,0 +1,260 @@
+package e2e
+
+import (
+	_ "embed"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"sigs.k8s.io/yaml"
+)
+
+//go:embed FEATURE_COVERAGE.yaml
+var featureCoverageFile []byte
+
+// FeatureRegistry tags e2e tests with feature labels, grouped in dimensions like provider,
+// os or feature, and lists the label combinations the e2e tests are required to cover.
+type FeatureRegistry struct {
+	dimensions []featureDimension
+	required   []map[string][]string
+}
+
+type featureDimension struct {
+	name   string
+	labels []featureLabel
+}
+
+type featureLabel struct {
+	name string
+	re   *regexp.Regexp
+}
+
+// featureCoverageConfig is used for YAML unmarshalling.
+type featureCoverageConfig struct {
+	Dimensions []struct {
+		Name   string `json:"name"`
+		Labels []struct {
+			Name    string `json:"name"`
+			Pattern string `json:"pattern"`
+		} `json:"labels"`
+	} `json:"dimensions"`
+	Required []map[string][]string `json:"required"`
+}
+
+// TestFeatures are the feature labels of an e2e test, by dimension.
+type TestFeatures struct {
+	Name   string              `json:"name"`
+	Labels map[string][]string `json:"labels"`
+}
+
+// FeatureCombination is a combination of feature labels, one per dimension, with the e2e
+// tests covering it.
+type FeatureCombination struct {
+	Labels map[string]string `json:"labels"`
+	Tests  []string          `json:"tests"`
+}
+
+// FeatureCoverage is the coverage matrix of the required feature combinations by the e2e tests.
+type FeatureCoverage struct {
+	Dimensions   []string             `json:"dimensions"`
+	Tests        []TestFeatures       `json:"tests"`
+	Combinations []FeatureCombination `json:"combinations"`
+}
+
+// LoadFeatureRegistry parses and compiles the embedded feature coverage YAML config.
+func LoadFeatureRegistry() (*FeatureRegistry, error) {
+	return parseFeatureRegistry(featureCoverageFile)
+}
+
+func parseFeatureRegistry(content []byte) (*FeatureRegistry, error) {
+	config := &featureCoverageConfig{}
+	if err := yaml.UnmarshalStrict(content, config); err != nil {
+		return nil, fmt.Errorf("unable to unmarshal feature coverage yaml: %v", err)
+	}
+
+	registry := &FeatureRegistry{required: config.Required}
+	knownLabels := map[string]map[string]bool{}
+	for _, d := range config.Dimensions {
+		if _, ok := knownLabels[d.Name]; ok {
+			return nil, fmt.Errorf("duplicated feature dimension %s", d.Name)
+		}
+		knownLabels[d.Name] = map[string]bool{}
+		dimension := featureDimension{name: d.Name}
+		for _, l := range d.Labels {
+			re, err := regexp.Compile(l.Pattern)
+			if err != nil {
+				return nil, fmt.Errorf("invalid regex pattern %q for label %s: %v", l.Pattern, l.Name, err)
+			}
+			knownLabels[d.Name][l.Name] = true
+			dimension.labels = append(dimension.labels, featureLabel{name: l.Name, re: re})
+		}
+		registry.dimensions = append(registry.dimensions, dimension)
+	}
+
+	for _, required := range config.Required {
+		for dimension, labels := range required {
+			if _, ok := knownLabels[dimension]; !ok {
+				return nil, fmt.Errorf("unknown feature dimension %s in required combinations", dimension)
+			}
+			for _, label := range labels {
+				if !knownLabels[dimension][label] {
+					return nil, fmt.Errorf("unknown label %s of feature dimension %s in required combinations", label, dimension)
+				}
+			}
+		}
+	}
+
+	return registry, nil
+}
+
+// Labels returns the feature labels of the test, by dimension. Dimensions without
+// matching labels are not included.
+func (r *FeatureRegistry) Labels(testName string) map[string][]string {
+	labels := map[string][]string{}
+	for _, d := range r.dimensions {
+		for _, l := range d.labels {
+			if l.re.MatchString(testName) {
+				labels[d.name] = append(labels[d.name], l.name)
+			}
+		}
+	}
+
+	return labels
+}
+
+// Coverage tags the tests with their feature labels and returns, for every required
+// combination of labels, the tests that cover it.
+func (r *FeatureRegistry) Coverage(testNames []string) *FeatureCoverage {
+	coverage := &FeatureCoverage{}
+	for _, d := range r.dimensions {
+		coverage.Dimensions = append(coverage.Dimensions, d.name)
+	}
+
+	sorted := append([]string{}, testNames...)
+	sort.Strings(sorted)
+	for _, name := range sorted {
+		coverage.Tests = append(coverage.Tests, TestFeatures{Name: name, Labels: r.Labels(name)})
+	}
+
+	seen := map[string]bool{}
+	for _, required := range r.required {
+		for _, labels := range r.expand(required) {
+			key := combinationKey(coverage.Dimensions, labels)
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+
+			combination := FeatureCombination{Labels: labels, Tests: []string{}}
+			for _, test := range coverage.Tests {
+				if covers(test, labels) {
+					combination.Tests = append(combination.Tests, test.Name)
+				}
+			}
+			coverage.Combinations = append(coverage.Combinations, combination)
+		}
+	}
+
+	return coverage
+}
+
+// expand returns every combination of one label per dimension of a required entry, in the
+// order of the dimensions.
+func (r *FeatureRegistry) expand(required map[string][]string) []map[string]string {
+	combinations := []map[string]string{{}}
+	for _, d := range r.dimensions {
+		labels, ok := required[d.name]
+		if !ok {
+			continue
+		}
+		var expanded []map[string]string
+		for _, c := range combinations {
+			for _, label := range labels {
+				next := map[string]string{d.name: label}
+				for k, v := range c {
+					next[k] = v
+				}
+				expanded = append(expanded, next)
+			}
+		}
+		combinations = expanded
+	}
+
+	return combinations
+}
+
+// Gaps returns the required combinations no test covers.
+func (c *FeatureCoverage) Gaps() []FeatureCombination {
+	var gaps []FeatureCombination
+	for _, combination := range c.Combinations {
+		if len(combination.Tests) == 0 {
+			gaps = append(gaps, combination)
+		}
+	}
+
+	return gaps
+}
+
+// CombinationName returns the labels of the combination as dimension=label pairs, in the
+// order of the dimensions.
+func (c *FeatureCoverage) CombinationName(combination FeatureCombination) string {
+	return combinationKey(c.Dimensions, combination.Labels)
+}
+
+// Markdown returns the coverage matrix as a markdown table with the number of tests
+// covering each required combination, followed by the list of gaps.
+func (c *FeatureCoverage) Markdown() string {
+	b := &strings.Builder{}
+	b.WriteString("# E2E feature coverage\n\n")
+	b.WriteString("| " + strings.Join(c.Dimensions, " | ") + " | tests |\n")
+	b.WriteString(strings.Repeat("| --- ", len(c.Dimensions)+1) + "|\n")
+	for _, combination := range c.Combinations {
+		row := make([]string, 0, len(c.Dimensions)+1)
+		for _, d := range c.Dimensions {
+			label := combination.Labels[d]
+			if label == "" {
+				label = "*"
+			}
+			row = append(row, label)
+		}
+		row = append(row, fmt.Sprintf("%d", len(combination.Tests)))
+		b.WriteString("| " + strings.Join(row, " | ") + " |\n")
+	}
+
+	gaps := c.Gaps()
+	fmt.Fprintf(b, "\n## Gaps (%d)\n\n", len(gaps))
+	for _, gap := range gaps {
+		b.WriteString("- " + c.CombinationName(gap) + "\n")
+	}
+
+	return b.String()
+}
+
+func covers(test TestFeatures, labels map[string]string) bool {
+	for dimension, label := range labels {
+		found := false
+		for _, l := range test.Labels[dimension] {
+			if l == label {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
+
+func combinationKey(dimensions []string, labels map[string]string) string {
+	parts := make([]string, 0, len(labels))
+	for _, d := range dimensions {
+		if label, ok := labels[d]; ok {
+			parts = append(parts, d+"="+label)
+		}
+	}
+
+	return strings.Join(parts, ",")
+}
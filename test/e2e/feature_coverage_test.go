@@ -0,0 +1,173 @@
+package e2e
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const testFeatureCoverage = `
+dimensions:
+- name: provider
+  labels:
+  - name: vsphere
+    pattern: "^TestVSphere"
+  - name: docker
+    pattern: "^TestDocker"
+- name: os
+  labels:
+  - name: ubuntu2004
+    pattern: "Ubuntu(2004)?([^0-9]|1[0-9]{2}|$)"
+  - name: ubuntu2204
+    pattern: "Ubuntu2204"
+  - name: redhat9
+    pattern: "RedHat9"
+- name: feature
+  labels:
+  - name: proxy
+    pattern: "Proxy"
+  - name: registry-mirror
+    pattern: "RegistryMirror"
+required:
+- provider: [vsphere]
+  os: [ubuntu2004, redhat9]
+  feature: [proxy]
+- provider: [docker]
+  feature: [registry-mirror]
+`
+
+func TestFeatureRegistryLabels(t *testing.T) {
+	registry, err := parseFeatureRegistry([]byte(testFeatureCoverage))
+	if err != nil {
+		t.Fatalf("parseFeatureRegistry() error = %v", err)
+	}
+
+	tests := []struct {
+		testName string
+		want     map[string][]string
+	}{
+		{
+			testName: "TestVSphereKubernetes133UbuntuProxyConfigFlow",
+			want:     map[string][]string{"provider": {"vsphere"}, "os": {"ubuntu2004"}, "feature": {"proxy"}},
+		},
+		{
+			testName: "TestVSphereKubernetesUbuntu134EtcdEncryption",
+			want:     map[string][]string{"provider": {"vsphere"}, "os": {"ubuntu2004"}},
+		},
+		{
+			testName: "TestVSphereKubernetes133Ubuntu2204RegistryMirrorProxy",
+			want:     map[string][]string{"provider": {"vsphere"}, "os": {"ubuntu2204"}, "feature": {"proxy", "registry-mirror"}},
+		},
+		{
+			testName: "TestDockerKubernetes133SimpleFlow",
+			want:     map[string][]string{"provider": {"docker"}},
+		},
+	}
+	for _, tt := range tests {
+		if got := registry.Labels(tt.testName); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("Labels(%s) = %v, want %v", tt.testName, got, tt.want)
+		}
+	}
+}
+
+func TestFeatureRegistryCoverage(t *testing.T) {
+	registry, err := parseFeatureRegistry([]byte(testFeatureCoverage))
+	if err != nil {
+		t.Fatalf("parseFeatureRegistry() error = %v", err)
+	}
+
+	coverage := registry.Coverage([]string{
+		"TestVSphereKubernetes134UbuntuProxyConfigFlow",
+		"TestVSphereKubernetes133UbuntuProxyConfigFlow",
+		"TestVSphereKubernetes133RedHat9SimpleFlow",
+		"TestDockerKubernetes133RegistryMirror",
+	})
+
+	want := []FeatureCombination{
+		{
+			Labels: map[string]string{"provider": "vsphere", "os": "ubuntu2004", "feature": "proxy"},
+			Tests:  []string{"TestVSphereKubernetes133UbuntuProxyConfigFlow", "TestVSphereKubernetes134UbuntuProxyConfigFlow"},
+		},
+		{
+			Labels: map[string]string{"provider": "vsphere", "os": "redhat9", "feature": "proxy"},
+			Tests:  []string{},
+		},
+		{
+			Labels: map[string]string{"provider": "docker", "feature": "registry-mirror"},
+			Tests:  []string{"TestDockerKubernetes133RegistryMirror"},
+		},
+	}
+	if !reflect.DeepEqual(coverage.Combinations, want) {
+		t.Errorf("Coverage().Combinations = %v, want %v", coverage.Combinations, want)
+	}
+
+	gaps := coverage.Gaps()
+	if len(gaps) != 1 || !reflect.DeepEqual(gaps[0].Labels, want[1].Labels) {
+		t.Errorf("Gaps() = %v, want %v", gaps, want[1:2])
+	}
+
+	markdown := coverage.Markdown()
+	for _, line := range []string{
+		"| provider | os | feature | tests |",
+		"| vsphere | ubuntu2004 | proxy | 2 |",
+		"| docker | * | registry-mirror | 1 |",
+		"- provider=vsphere,os=redhat9,feature=proxy",
+	} {
+		if !strings.Contains(markdown, line) {
+			t.Errorf("Markdown() = %s, want it to contain %q", markdown, line)
+		}
+	}
+}
+
+func TestParseFeatureRegistryErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		wantErr string
+	}{
+		{
+			name:    "invalid pattern",
+			content: "dimensions:\n- name: os\n  labels:\n  - name: ubuntu\n    pattern: \"Ubuntu(\"\n",
+			wantErr: "invalid regex pattern",
+		},
+		{
+			name:    "duplicated dimension",
+			content: "dimensions:\n- name: os\n- name: os\n",
+			wantErr: "duplicated feature dimension os",
+		},
+		{
+			name:    "unknown required dimension",
+			content: "dimensions:\n- name: os\nrequired:\n- provider: [vsphere]\n",
+			wantErr: "unknown feature dimension provider in required combinations",
+		},
+		{
+			name:    "unknown required label",
+			content: "dimensions:\n- name: os\n  labels:\n  - name: ubuntu\n    pattern: Ubuntu\nrequired:\n- os: [redhat9]\n",
+			wantErr: "unknown label redhat9 of feature dimension os in required combinations",
+		},
+		{
+			name:    "unknown field",
+			content: "dimension:\n- name: os\n",
+			wantErr: "unable to unmarshal feature coverage yaml",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := parseFeatureRegistry([]byte(tt.content))
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("parseFeatureRegistry() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+// TestLoadFeatureRegistry verifies the embedded YAML can be loaded without errors.
+func TestLoadFeatureRegistry(t *testing.T) {
+	registry, err := LoadFeatureRegistry()
+	if err != nil {
+		t.Fatalf("LoadFeatureRegistry() error = %v", err)
+	}
+	if len(registry.dimensions) == 0 || len(registry.required) == 0 {
+		t.Fatal("LoadFeatureRegistry() returned an empty registry")
+	}
+}
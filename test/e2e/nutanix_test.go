@@ -806,6 +806,22 @@ func TestNutanixKubernetes134To135StackedEtcdUbuntu2204Upgrade(t *testing.T) {
 	)
 }
 
+func TestNutanixKubernetes134To135Ubuntu2204UpgradeWithPersistentWorkload(t *testing.T) {
+	provider := framework.NewNutanix(t, framework.WithUbuntu2204Kubernetes134Nutanix())
+	test := framework.NewClusterE2ETest(
+		t,
+		provider,
+		framework.WithClusterFiller(api.WithKubernetesVersion(v1alpha1.Kube134)),
+		framework.WithPersistentWorkload(),
+	)
+	runSimpleUpgradeFlowWithPersistentWorkload(
+		test,
+		v1alpha1.Kube135,
+		framework.WithClusterUpgrade(api.WithKubernetesVersion(v1alpha1.Kube135)),
+		provider.WithProviderUpgrade(provider.Ubuntu2204135Template()),
+	)
+}
+
 func TestNutanixKubernetes135To136StackedEtcdUbuntu2204Upgrade(t *testing.T) {
 	provider := framework.NewNutanix(t, framework.WithUbuntu2204135Nutanix())
 	test := framework.NewClusterE2ETest(
@@ -29,6 +29,20 @@ func runSimpleUpgradeFlowWithoutClusterConfigGeneration(test *framework.ClusterE
 	test.DeleteCluster()
 }
 
+// runSimpleUpgradeFlowWithPersistentWorkload runs the Create, Upgrade and Delete cluster flows, creating a workload
+// backed by a CSI volume before the upgrade and validating its data and volume survive the node rollout.
+func runSimpleUpgradeFlowWithPersistentWorkload(test *framework.ClusterE2ETest, updateVersion v1alpha1.KubernetesVersion, clusterOpts ...framework.ClusterE2ETestOpt) {
+	test.GenerateClusterConfig()
+	test.CreateCluster()
+	test.InstallCSIDriver()
+	test.CreatePersistentWorkload()
+	test.UpgradeClusterWithNewConfig(clusterOpts)
+	test.ValidateCluster(updateVersion)
+	test.ValidatePersistentWorkload()
+	test.StopIfFailed()
+	test.DeleteCluster()
+}
+
 func runUpgradeFlowWithCheckpoint(test *framework.ClusterE2ETest, updateVersion v1alpha1.KubernetesVersion, clusterOpts, clusterOpts2 []framework.ClusterE2ETestOpt, commandOpts []framework.CommandOpt) {
 	test.GenerateClusterConfig()
 	test.CreateCluster()
@@ -4578,6 +4578,45 @@ func TestVSphereKubernetes136StackedEtcdUbuntu(t *testing.T) {
 	runStackedEtcdFlow(test)
 }
 
+func TestVSphereKubernetes135Ubuntu2204StackedEtcdRestoreDrill(t *testing.T) {
+	test := framework.NewClusterE2ETest(t,
+		framework.NewVSphere(t, framework.WithUbuntu2204135()),
+		framework.WithClusterFiller(api.WithKubernetesVersion(v1alpha1.Kube135)),
+		framework.WithClusterFiller(api.WithControlPlaneCount(3)),
+		framework.WithClusterFiller(api.WithStackedEtcdTopology()))
+	runEtcdRestoreDrillFlow(test, v1alpha1.Kube135)
+}
+
+func TestVSphereKubernetes135Ubuntu2204ExternalEtcdRestoreDrill(t *testing.T) {
+	test := framework.NewClusterE2ETest(t,
+		framework.NewVSphere(t, framework.WithUbuntu2204135()),
+		framework.WithClusterFiller(api.WithKubernetesVersion(v1alpha1.Kube135)),
+		framework.WithClusterFiller(api.WithExternalEtcdTopology(3)))
+	runEtcdRestoreDrillFlow(test, v1alpha1.Kube135)
+}
+
+func TestVSphereKubernetes135Ubuntu2204DualStack(t *testing.T) {
+	test := framework.NewClusterE2ETest(t,
+		framework.NewVSphere(t, framework.WithUbuntu2204135()),
+		framework.WithClusterFiller(api.WithKubernetesVersion(v1alpha1.Kube135)),
+		framework.WithDualStack())
+	runDualStackFlow(test, v1alpha1.Kube135)
+}
+
+func TestVSphereKubernetes134To135Ubuntu2204DualStackUpgrade(t *testing.T) {
+	provider := framework.NewVSphere(t, framework.WithUbuntu2204134())
+	test := framework.NewClusterE2ETest(t,
+		provider,
+		framework.WithClusterFiller(api.WithKubernetesVersion(v1alpha1.Kube134)),
+		framework.WithDualStack())
+	runDualStackUpgradeFlow(
+		test,
+		v1alpha1.Kube135,
+		framework.WithClusterUpgrade(api.WithKubernetesVersion(v1alpha1.Kube135)),
+		provider.WithProviderUpgrade(provider.Ubuntu2204Kubernetes135Template()),
+	)
+}
+
 func TestVSphereKubernetes130UbuntuTaintsUpgradeFlow(t *testing.T) {
 	provider := ubuntu130ProviderWithTaints(t)
 
@@ -4742,6 +4781,22 @@ func TestVSphereKubernetes133UbuntuTo134Upgrade(t *testing.T) {
 	)
 }
 
+func TestVSphereKubernetes134UbuntuTo135UpgradeWithPersistentWorkload(t *testing.T) {
+	provider := framework.NewVSphere(t, framework.WithUbuntu2204134())
+	test := framework.NewClusterE2ETest(
+		t,
+		provider,
+		framework.WithClusterFiller(api.WithKubernetesVersion(v1alpha1.Kube134)),
+		framework.WithPersistentWorkload(),
+	)
+	runSimpleUpgradeFlowWithPersistentWorkload(
+		test,
+		v1alpha1.Kube135,
+		framework.WithClusterUpgrade(api.WithKubernetesVersion(v1alpha1.Kube135)),
+		provider.WithProviderUpgrade(provider.Ubuntu135Template()),
+	)
+}
+
 func TestVSphereKubernetes130To131Ubuntu2204Upgrade(t *testing.T) {
 	licenseToken := framework.GetLicenseToken()
 	provider := framework.NewVSphere(t)
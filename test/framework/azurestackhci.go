@@ -0,0 +1,137 @@
+package framework
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/aws/eks-anywhere/internal/pkg/api"
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/constants"
+	releasev1 "github.com/aws/eks-anywhere/release/api/v1alpha1"
+	clusterf "github.com/aws/eks-anywhere/test/framework/cluster"
+)
+
+const (
+	azurestackhciCloudAgentFQDN         = "T_AZURESTACKHCI_CLOUDAGENT_FQDN"
+	azurestackhciLocation               = "T_AZURESTACKHCI_LOCATION"
+	azurestackhciResourceGroup          = "T_AZURESTACKHCI_RESOURCE_GROUP"
+	azurestackhciVirtualNetwork         = "T_AZURESTACKHCI_VIRTUAL_NETWORK"
+	azurestackhciStorageContainer       = "T_AZURESTACKHCI_STORAGE_CONTAINER"
+	azurestackhciVMSize                 = "T_AZURESTACKHCI_VM_SIZE"
+	azurestackhciSSHAuthorizedKey       = "T_AZURESTACKHCI_SSH_AUTHORIZED_KEY"
+	azurestackhciControlPlaneEndpointIP = "T_AZURESTACKHCI_CONTROL_PLANE_ENDPOINT_IP"
+	azurestackhciImageUbuntuVarFmt      = "T_AZURESTACKHCI_IMAGE_UBUNTU_%s"
+	azurestackhciDefaultImageVersion    = anywherev1.Kube134
+)
+
+var requiredAzureStackHCIEnvVars = []string{
+	constants.EksaAzureStackHCILoginConfigKey,
+	azurestackhciCloudAgentFQDN,
+	azurestackhciLocation,
+	azurestackhciVirtualNetwork,
+	azurestackhciSSHAuthorizedKey,
+	azurestackhciControlPlaneEndpointIP,
+	azurestackhciImageEnvVar(azurestackhciDefaultImageVersion),
+}
+
+// AzureStackHCI is a test framework Provider for AzureStackHCI.
+type AzureStackHCI struct {
+	t                      *testing.T
+	fillers                []api.AzureStackHCIFiller
+	clusterFillers         []api.ClusterFiller
+	controlPlaneEndpointIP string
+}
+
+// AzureStackHCIOpt configures an AzureStackHCI test provider.
+type AzureStackHCIOpt func(*AzureStackHCI)
+
+// NewAzureStackHCI creates an AzureStackHCI test provider configured from the T_AZURESTACKHCI_* environment variables.
+func NewAzureStackHCI(t *testing.T, opts ...AzureStackHCIOpt) *AzureStackHCI {
+	checkRequiredEnvVars(t, requiredAzureStackHCIEnvVars)
+
+	a := &AzureStackHCI{
+		t: t,
+		fillers: []api.AzureStackHCIFiller{
+			api.WithAzureStackHCIStringFromEnvVar(azurestackhciCloudAgentFQDN, api.WithAzureStackHCICloudAgentFQDN),
+			api.WithAzureStackHCIStringFromEnvVar(azurestackhciLocation, api.WithAzureStackHCILocation),
+			api.WithAzureStackHCIStringFromEnvVar(azurestackhciResourceGroup, api.WithAzureStackHCIResourceGroup),
+			api.WithAzureStackHCIStringFromEnvVar(azurestackhciVirtualNetwork, api.WithAzureStackHCIVirtualNetwork),
+			api.WithAzureStackHCIStringFromEnvVar(azurestackhciStorageContainer, api.WithAzureStackHCIStorageContainer),
+			api.WithAzureStackHCIStringFromEnvVar(azurestackhciVMSize, api.WithAzureStackHCIVMSize),
+			api.WithAzureStackHCIStringFromEnvVar(azurestackhciSSHAuthorizedKey, api.WithAzureStackHCISSHAuthorizedKey),
+			api.WithAzureStackHCIStringFromEnvVar(azurestackhciImageEnvVar(azurestackhciDefaultImageVersion), api.WithAzureStackHCIImage),
+		},
+		controlPlaneEndpointIP: os.Getenv(azurestackhciControlPlaneEndpointIP),
+	}
+
+	for _, opt := range opts {
+		opt(a)
+	}
+
+	return a
+}
+
+// RequiredAzureStackHCIEnvVars returns a list of environment variables needed for AzureStackHCI tests.
+func RequiredAzureStackHCIEnvVars() []string {
+	return requiredAzureStackHCIEnvVars
+}
+
+// Name returns the provider name. It satisfies the test framework Provider.
+func (a *AzureStackHCI) Name() string {
+	return "azurestackhci"
+}
+
+// Setup does nothing. It satisfies the test framework Provider.
+func (a *AzureStackHCI) Setup() {}
+
+// UpdateKubeConfig customizes generated kubeconfig for the provider.
+func (a *AzureStackHCI) UpdateKubeConfig(content *[]byte, clusterName string) error {
+	return nil
+}
+
+// CleanupResources satisfies the test framework Provider. VMs are removed by CAPH on cluster deletion.
+func (a *AzureStackHCI) CleanupResources(clusterName string) error {
+	return nil
+}
+
+// ClusterConfigUpdates satisfies the test framework Provider.
+func (a *AzureStackHCI) ClusterConfigUpdates() []api.ClusterConfigFiller {
+	f := make([]api.ClusterFiller, 0, len(a.clusterFillers)+1)
+	f = append(f, a.clusterFillers...)
+	f = append(f, api.WithControlPlaneEndpointIP(a.controlPlaneEndpointIP))
+
+	return []api.ClusterConfigFiller{api.ClusterToConfigFiller(f...), api.AzureStackHCIToConfigFiller(a.fillers...)}
+}
+
+// ClusterStateValidations returns a list of provider specific ClusterStateValidations.
+func (a *AzureStackHCI) ClusterStateValidations() []clusterf.StateValidation {
+	return []clusterf.StateValidation{}
+}
+
+// WithKubeVersionAndOS returns a cluster config filler that sets the cluster kube version and the
+// image for all azurestackhci machine configs. Only Ubuntu images are supported.
+func (a *AzureStackHCI) WithKubeVersionAndOS(kubeVersion anywherev1.KubernetesVersion, os OS, _ *releasev1.EksARelease, _ ...string) api.ClusterConfigFiller {
+	if osFamiliesForOS[os] != anywherev1.Ubuntu {
+		a.t.Fatalf("OS %s is not supported for Azure Stack HCI", os)
+	}
+
+	return api.JoinClusterConfigFillers(
+		api.ClusterToConfigFiller(api.WithKubernetesVersion(kubeVersion)),
+		api.AzureStackHCIToConfigFiller(
+			api.WithAzureStackHCIStringFromEnvVar(azurestackhciImageEnvVar(kubeVersion), api.WithAzureStackHCIImage),
+		),
+	)
+}
+
+// WithNewWorkerNodeGroup returns an api.ClusterFiller that adds a new workerNodeGroupConfiguration and
+// a corresponding AzureStackHCIMachineConfig to the cluster config.
+func (a *AzureStackHCI) WithNewWorkerNodeGroup(name string, workerNodeGroup *WorkerNodeGroup) api.ClusterConfigFiller {
+	// TODO: Implement for Azure Stack HCI provider
+	panic("Not implemented for Azure Stack HCI yet")
+}
+
+func azurestackhciImageEnvVar(kubeVersion anywherev1.KubernetesVersion) string {
+	return fmt.Sprintf(azurestackhciImageUbuntuVarFmt, strings.ReplaceAll(string(kubeVersion), ".", "_"))
+}
@@ -111,6 +111,10 @@ type ClusterE2ETest struct {
 	// When generating a new base cluster config, it will read from disk instead of
 	// using the CLI generate command and will preserve the previous CP endpoint.
 	PersistentCluster bool
+	// persistentWorkload is the state of the workload created by CreatePersistentWorkload.
+	persistentWorkload *persistentWorkload
+	// etcdRestoreDrill is the state of the workload and snapshot of an etcd restore drill.
+	etcdRestoreDrill *etcdRestoreDrill
 }
 
 type ClusterE2ETestOpt func(e *ClusterE2ETest)
@@ -0,0 +1,93 @@
+package framework
+
+import (
+	"context"
+	"net"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/aws/eks-anywhere/internal/pkg/api"
+	"github.com/aws/eks-anywhere/pkg/constants"
+	"github.com/aws/eks-anywhere/pkg/executables"
+)
+
+const (
+	dualStackPodCIDRs     = "192.168.0.0/16,fd00:100::/48"
+	dualStackServiceCIDRs = "10.96.0.0/12,fd00:200::/108"
+)
+
+// WithDualStack configures the cluster with an IPv4 and an IPv6 pod and service CIDR block,
+// IPv4 being the primary IP family.
+func WithDualStack() ClusterE2ETestOpt {
+	return WithClusterFiller(
+		api.WithPodCidr(dualStackPodCIDRs),
+		api.WithServiceCidr(dualStackServiceCIDRs),
+	)
+}
+
+// ValidateDualStack validates every node of the cluster is allocated a pod CIDR block of each
+// IP family and the pods running in the pod network get both an IPv4 and an IPv6 address.
+func (e *ClusterE2ETest) ValidateDualStack() {
+	ctx := context.Background()
+
+	e.T.Log("Validating nodes have dual-stack pod CIDR blocks")
+	nodes, err := e.KubectlClient.GetNodes(ctx, e.KubeconfigFilePath())
+	if err != nil {
+		e.T.Fatalf("Error getting nodes: %v", err)
+	}
+	if len(nodes) == 0 {
+		e.T.Fatal("No nodes found")
+	}
+	for _, node := range nodes {
+		if !isDualStack(node.Spec.PodCIDRs) {
+			e.T.Fatalf("Node %s pod CIDR blocks %v are not dual-stack", node.Name, node.Spec.PodCIDRs)
+		}
+	}
+
+	e.T.Log("Validating pods have dual-stack addresses")
+	pods, err := e.KubectlClient.GetPods(ctx,
+		executables.WithKubeconfig(e.KubeconfigFilePath()),
+		executables.WithNamespace(constants.KubeSystemNamespace),
+	)
+	if err != nil {
+		e.T.Fatalf("Error getting pods: %v", err)
+	}
+
+	validated := 0
+	for _, pod := range pods {
+		if pod.Spec.HostNetwork || pod.Status.Phase != corev1.PodRunning {
+			continue
+		}
+		ips := make([]string, 0, len(pod.Status.PodIPs))
+		for _, ip := range pod.Status.PodIPs {
+			ips = append(ips, ip.IP)
+		}
+		if !isDualStack(ips) {
+			e.T.Fatalf("Pod %s addresses %v are not dual-stack", pod.Name, ips)
+		}
+		validated++
+	}
+	if validated == 0 {
+		e.T.Fatalf("No running pods found in the pod network of the %s namespace", constants.KubeSystemNamespace)
+	}
+
+	e.T.Log("Successfully validated dual-stack networking")
+}
+
+// isDualStack returns true if the addresses, IPs or CIDR blocks, include both an IPv4 and an IPv6 one.
+func isDualStack(addresses []string) bool {
+	var ipv4, ipv6 bool
+	for _, address := range addresses {
+		ip := net.ParseIP(strings.Split(address, "/")[0])
+		switch {
+		case ip == nil:
+		case ip.To4() != nil:
+			ipv4 = true
+		default:
+			ipv6 = true
+		}
+	}
+
+	return ipv4 && ipv6
+}
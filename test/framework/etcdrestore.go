@@ -0,0 +1,149 @@
+package framework
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/aws/eks-anywhere/pkg/retrier"
+)
+
+const (
+	etcdRestoreNamespace      = "eksa-etcd-restore-test"
+	etcdRestoreMarker         = "etcd-restore-marker"
+	etcdRestorePostBackup     = "etcd-restore-post-backup"
+	etcdRestoreSnapshotFolder = "etcd-snapshots"
+)
+
+const etcdRestoreConfigMapTemplate = `apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: %s
+  namespace: %s
+data:
+  value: %q
+`
+
+// etcdRestoreDrill is the state of a restore drill, recorded when the workload is created and the
+// snapshot is taken, used to validate the cluster is back to that state after the restore.
+type etcdRestoreDrill struct {
+	markerValue string
+	snapshot    string
+}
+
+// CreateEtcdRestoreWorkload creates a namespace with a marker ConfigMap holding a unique value,
+// which must be in the cluster after restoring a snapshot taken after it was created.
+func (e *ClusterE2ETest) CreateEtcdRestoreWorkload() {
+	ctx := context.Background()
+	value := fmt.Sprintf("%s-%d", e.ClusterName, time.Now().UnixNano())
+
+	e.T.Log("Creating etcd restore marker workload")
+	if err := e.KubectlClient.CreateNamespaceIfNotPresent(ctx, e.KubeconfigFilePath(), etcdRestoreNamespace); err != nil {
+		e.T.Fatalf("Failed creating etcd restore namespace: %v", err)
+	}
+	e.applyEtcdRestoreConfigMap(ctx, etcdRestoreMarker, value)
+
+	e.etcdRestoreDrill = &etcdRestoreDrill{markerValue: value}
+}
+
+// BackupEtcd takes a snapshot of the etcd of the cluster with the etcd backup command, saving it to
+// a folder of the cluster config folder.
+func (e *ClusterE2ETest) BackupEtcd(opts ...CommandOpt) {
+	if e.etcdRestoreDrill == nil {
+		e.T.Fatal("Etcd restore workload was not created, can't back up etcd")
+	}
+
+	folder := filepath.Join(e.ClusterConfigFolder, etcdRestoreSnapshotFolder)
+	e.T.Logf("Backing up etcd to %s", folder)
+	args := []string{"etcd", "backup", e.ClusterName, "--destination", folder, "--kubeconfig", e.KubeconfigFilePath(), "-v", "4"}
+	e.RunEKSA(append(args, e.etcdSSHArgs()...), opts...)
+
+	snapshots, err := filepath.Glob(filepath.Join(folder, e.ClusterName+"-etcd-*.db"))
+	if err != nil {
+		e.T.Fatalf("Failed listing etcd snapshots: %v", err)
+	}
+	if len(snapshots) == 0 {
+		e.T.Fatalf("Etcd backup didn't save a snapshot to %s", folder)
+	}
+
+	// Snapshot names are timestamps, so the last one is the one just taken.
+	e.etcdRestoreDrill.snapshot = snapshots[len(snapshots)-1]
+}
+
+// SimulateEtcdDataLoss deletes the marker workload and creates a ConfigMap that is not in the
+// snapshot, so the restore is validated to bring back the data of the snapshot and only that data.
+func (e *ClusterE2ETest) SimulateEtcdDataLoss() {
+	ctx := context.Background()
+
+	e.T.Log("Simulating etcd data loss")
+	e.applyEtcdRestoreConfigMap(ctx, etcdRestorePostBackup, "created-after-backup")
+	if _, err := e.KubectlClient.ExecuteCommand(ctx, "delete", "configmap", etcdRestoreMarker, "-n", etcdRestoreNamespace,
+		"--kubeconfig", e.KubeconfigFilePath()); err != nil {
+		e.T.Fatalf("Failed deleting etcd restore marker: %v", err)
+	}
+}
+
+// RestoreEtcd restores the etcd of the cluster from the snapshot taken by BackupEtcd with the etcd
+// restore command.
+func (e *ClusterE2ETest) RestoreEtcd(opts ...CommandOpt) {
+	if e.etcdRestoreDrill == nil || e.etcdRestoreDrill.snapshot == "" {
+		e.T.Fatal("Etcd snapshot was not taken, can't restore etcd")
+	}
+
+	e.T.Logf("Restoring etcd from %s", e.etcdRestoreDrill.snapshot)
+	args := []string{"etcd", "restore", e.ClusterName, "--snapshot", e.etcdRestoreDrill.snapshot, "--kubeconfig", e.KubeconfigFilePath(), "-v", "4"}
+	e.RunEKSA(append(args, e.etcdSSHArgs()...), opts...)
+}
+
+// ValidateEtcdRestore validates the cluster is back to the state of the snapshot: the marker
+// workload is back with the same value and the ConfigMap created after the backup is gone.
+func (e *ClusterE2ETest) ValidateEtcdRestore() {
+	ctx := context.Background()
+	if e.etcdRestoreDrill == nil {
+		e.T.Fatal("Etcd restore workload was not created, can't validate the restore")
+	}
+
+	e.T.Log("Validating etcd restore")
+	// The API server can take a while to serve requests again after etcd is restored.
+	err := retrier.Retry(60, 10*time.Second, func() error {
+		marker, err := e.KubectlClient.GetConfigMap(ctx, e.KubeconfigFilePath(), etcdRestoreMarker, etcdRestoreNamespace)
+		if err != nil {
+			return err
+		}
+		if marker.Data["value"] != e.etcdRestoreDrill.markerValue {
+			return fmt.Errorf("etcd restore marker value is %s, expected %s", marker.Data["value"], e.etcdRestoreDrill.markerValue)
+		}
+		return nil
+	})
+	if err != nil {
+		e.T.Fatalf("Etcd restore marker was not restored: %v", err)
+	}
+
+	_, err = e.KubectlClient.GetConfigMap(ctx, e.KubeconfigFilePath(), etcdRestorePostBackup, etcdRestoreNamespace)
+	if err == nil {
+		e.T.Fatalf("ConfigMap %s created after the backup is still in the cluster after the restore", etcdRestorePostBackup)
+	}
+	if !strings.Contains(err.Error(), "NotFound") {
+		e.T.Fatalf("Failed checking ConfigMap %s was removed by the restore: %v", etcdRestorePostBackup, err)
+	}
+
+	e.T.Log("Cluster is back to the state of the etcd snapshot")
+}
+
+// etcdSSHArgs returns the flags to log in to the machines with the SSH key of the test runner. Docker
+// machines are not accessed over SSH, so they don't need any.
+func (e *ClusterE2ETest) etcdSSHArgs() []string {
+	if e.Provider.Name() == "docker" {
+		return nil
+	}
+	return []string{"--ssh-key", SSHKeyPath}
+}
+
+func (e *ClusterE2ETest) applyEtcdRestoreConfigMap(ctx context.Context, name, value string) {
+	manifest := fmt.Sprintf(etcdRestoreConfigMapTemplate, name, etcdRestoreNamespace, value)
+	if err := e.KubectlClient.ApplyKubeSpecFromBytes(ctx, e.Cluster(), []byte(manifest)); err != nil {
+		e.T.Fatalf("Failed creating ConfigMap %s: %v", name, err)
+	}
+}
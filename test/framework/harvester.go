@@ -0,0 +1,138 @@
+package framework
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/aws/eks-anywhere/internal/pkg/api"
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/constants"
+	releasev1 "github.com/aws/eks-anywhere/release/api/v1alpha1"
+	clusterf "github.com/aws/eks-anywhere/test/framework/cluster"
+)
+
+const (
+	harvesterServer                 = "T_HARVESTER_SERVER"
+	harvesterNamespace              = "T_HARVESTER_NAMESPACE"
+	harvesterNetworks               = "T_HARVESTER_NETWORKS"
+	harvesterSSHAuthorizedKey       = "T_HARVESTER_SSH_AUTHORIZED_KEY"
+	harvesterControlPlaneEndpointIP = "T_HARVESTER_CONTROL_PLANE_ENDPOINT_IP"
+	harvesterImageUbuntuVarFmt      = "T_HARVESTER_IMAGE_UBUNTU_%s"
+	harvesterImageURLUbuntuVarFmt   = "T_HARVESTER_IMAGE_URL_UBUNTU_%s"
+	harvesterDefaultImageVersion    = anywherev1.Kube134
+)
+
+var requiredHarvesterEnvVars = []string{
+	constants.EksaHarvesterKubeconfigKey,
+	harvesterServer,
+	harvesterNamespace,
+	harvesterNetworks,
+	harvesterSSHAuthorizedKey,
+	harvesterControlPlaneEndpointIP,
+	harvesterImageEnvVar(harvesterDefaultImageVersion),
+}
+
+// Harvester is a test framework Provider for Harvester.
+type Harvester struct {
+	t                      *testing.T
+	fillers                []api.HarvesterFiller
+	clusterFillers         []api.ClusterFiller
+	controlPlaneEndpointIP string
+}
+
+// HarvesterOpt configures a Harvester test provider.
+type HarvesterOpt func(*Harvester)
+
+// NewHarvester creates a Harvester test provider configured from the T_HARVESTER_* environment variables.
+func NewHarvester(t *testing.T, opts ...HarvesterOpt) *Harvester {
+	checkRequiredEnvVars(t, requiredHarvesterEnvVars)
+
+	h := &Harvester{
+		t: t,
+		fillers: []api.HarvesterFiller{
+			api.WithHarvesterStringFromEnvVar(harvesterServer, api.WithHarvesterServer),
+			api.WithHarvesterStringFromEnvVar(harvesterNamespace, api.WithHarvesterNamespace),
+			api.WithHarvesterStringFromEnvVar(harvesterNetworks, api.WithHarvesterNetworks),
+			api.WithHarvesterStringFromEnvVar(harvesterSSHAuthorizedKey, api.WithHarvesterSSHAuthorizedKey),
+			api.WithHarvesterStringFromEnvVar(harvesterImageEnvVar(harvesterDefaultImageVersion), api.WithHarvesterImage),
+			api.WithHarvesterStringFromEnvVar(harvesterImageURLEnvVar(harvesterDefaultImageVersion), api.WithHarvesterImageURL),
+		},
+		controlPlaneEndpointIP: os.Getenv(harvesterControlPlaneEndpointIP),
+	}
+
+	for _, opt := range opts {
+		opt(h)
+	}
+
+	return h
+}
+
+// RequiredHarvesterEnvVars returns a list of environment variables needed for Harvester tests.
+func RequiredHarvesterEnvVars() []string {
+	return requiredHarvesterEnvVars
+}
+
+// Name returns the provider name. It satisfies the test framework Provider.
+func (h *Harvester) Name() string {
+	return "harvester"
+}
+
+// Setup does nothing. It satisfies the test framework Provider.
+func (h *Harvester) Setup() {}
+
+// UpdateKubeConfig customizes generated kubeconfig for the provider.
+func (h *Harvester) UpdateKubeConfig(content *[]byte, clusterName string) error {
+	return nil
+}
+
+// CleanupResources satisfies the test framework Provider. VMs are removed by CAPHV on cluster deletion.
+func (h *Harvester) CleanupResources(clusterName string) error {
+	return nil
+}
+
+// ClusterConfigUpdates satisfies the test framework Provider.
+func (h *Harvester) ClusterConfigUpdates() []api.ClusterConfigFiller {
+	f := make([]api.ClusterFiller, 0, len(h.clusterFillers)+1)
+	f = append(f, h.clusterFillers...)
+	f = append(f, api.WithControlPlaneEndpointIP(h.controlPlaneEndpointIP))
+
+	return []api.ClusterConfigFiller{api.ClusterToConfigFiller(f...), api.HarvesterToConfigFiller(h.fillers...)}
+}
+
+// ClusterStateValidations returns a list of provider specific ClusterStateValidations.
+func (h *Harvester) ClusterStateValidations() []clusterf.StateValidation {
+	return []clusterf.StateValidation{}
+}
+
+// WithKubeVersionAndOS returns a cluster config filler that sets the cluster kube version and the
+// image for all harvester machine configs. Only Ubuntu images are supported.
+func (h *Harvester) WithKubeVersionAndOS(kubeVersion anywherev1.KubernetesVersion, os OS, _ *releasev1.EksARelease, _ ...string) api.ClusterConfigFiller {
+	if osFamiliesForOS[os] != anywherev1.Ubuntu {
+		h.t.Fatalf("OS %s is not supported for Harvester", os)
+	}
+
+	return api.JoinClusterConfigFillers(
+		api.ClusterToConfigFiller(api.WithKubernetesVersion(kubeVersion)),
+		api.HarvesterToConfigFiller(
+			api.WithHarvesterStringFromEnvVar(harvesterImageEnvVar(kubeVersion), api.WithHarvesterImage),
+			api.WithHarvesterStringFromEnvVar(harvesterImageURLEnvVar(kubeVersion), api.WithHarvesterImageURL),
+		),
+	)
+}
+
+// WithNewWorkerNodeGroup returns an api.ClusterFiller that adds a new workerNodeGroupConfiguration and
+// a corresponding HarvesterMachineConfig to the cluster config.
+func (h *Harvester) WithNewWorkerNodeGroup(name string, workerNodeGroup *WorkerNodeGroup) api.ClusterConfigFiller {
+	// TODO: Implement for Harvester provider
+	panic("Not implemented for Harvester yet")
+}
+
+func harvesterImageEnvVar(kubeVersion anywherev1.KubernetesVersion) string {
+	return fmt.Sprintf(harvesterImageUbuntuVarFmt, strings.ReplaceAll(string(kubeVersion), ".", "_"))
+}
+
+func harvesterImageURLEnvVar(kubeVersion anywherev1.KubernetesVersion) string {
+	return fmt.Sprintf(harvesterImageURLUbuntuVarFmt, strings.ReplaceAll(string(kubeVersion), ".", "_"))
+}
@@ -0,0 +1,138 @@
+package framework
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/aws/eks-anywhere/internal/pkg/api"
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/constants"
+	releasev1 "github.com/aws/eks-anywhere/release/api/v1alpha1"
+	clusterf "github.com/aws/eks-anywhere/test/framework/cluster"
+)
+
+const (
+	openstackAuthURL                = "T_OPENSTACK_AUTH_URL"
+	openstackRegion                 = "T_OPENSTACK_REGION"
+	openstackInsecure               = "T_OPENSTACK_INSECURE"
+	openstackNetwork                = "T_OPENSTACK_NETWORK"
+	openstackSubnet                 = "T_OPENSTACK_SUBNET"
+	openstackFlavor                 = "T_OPENSTACK_FLAVOR"
+	openstackSSHAuthorizedKey       = "T_OPENSTACK_SSH_AUTHORIZED_KEY"
+	openstackControlPlaneEndpointIP = "T_OPENSTACK_CONTROL_PLANE_ENDPOINT_IP"
+	openstackImageUbuntuVarFmt      = "T_OPENSTACK_IMAGE_UBUNTU_%s"
+	openstackDefaultImageVersion    = anywherev1.Kube134
+)
+
+var requiredOpenStackEnvVars = []string{
+	constants.EksaOpenStackApplicationCredentialIDKey,
+	constants.EksaOpenStackApplicationCredentialSecretKey,
+	openstackAuthURL,
+	openstackNetwork,
+	openstackFlavor,
+	openstackSSHAuthorizedKey,
+	openstackControlPlaneEndpointIP,
+	openstackImageEnvVar(openstackDefaultImageVersion),
+}
+
+// OpenStack is a test framework Provider for OpenStack.
+type OpenStack struct {
+	t                      *testing.T
+	fillers                []api.OpenStackFiller
+	clusterFillers         []api.ClusterFiller
+	controlPlaneEndpointIP string
+}
+
+// OpenStackOpt configures an OpenStack test provider.
+type OpenStackOpt func(*OpenStack)
+
+// NewOpenStack creates an OpenStack test provider configured from the T_OPENSTACK_* environment variables.
+func NewOpenStack(t *testing.T, opts ...OpenStackOpt) *OpenStack {
+	checkRequiredEnvVars(t, requiredOpenStackEnvVars)
+
+	o := &OpenStack{
+		t: t,
+		fillers: []api.OpenStackFiller{
+			api.WithOpenStackStringFromEnvVar(openstackAuthURL, api.WithOpenStackAuthURL),
+			api.WithOpenStackStringFromEnvVar(openstackRegion, api.WithOpenStackRegion),
+			api.WithOpenStackBoolFromEnvVar(openstackInsecure, api.WithOpenStackInsecure),
+			api.WithOpenStackStringFromEnvVar(openstackNetwork, api.WithOpenStackNetwork),
+			api.WithOpenStackStringFromEnvVar(openstackSubnet, api.WithOpenStackSubnet),
+			api.WithOpenStackStringFromEnvVar(openstackFlavor, api.WithOpenStackFlavor),
+			api.WithOpenStackStringFromEnvVar(openstackSSHAuthorizedKey, api.WithOpenStackSSHAuthorizedKey),
+			api.WithOpenStackStringFromEnvVar(openstackImageEnvVar(openstackDefaultImageVersion), api.WithOpenStackImage),
+		},
+		controlPlaneEndpointIP: os.Getenv(openstackControlPlaneEndpointIP),
+	}
+
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	return o
+}
+
+// RequiredOpenStackEnvVars returns a list of environment variables needed for OpenStack tests.
+func RequiredOpenStackEnvVars() []string {
+	return requiredOpenStackEnvVars
+}
+
+// Name returns the provider name. It satisfies the test framework Provider.
+func (o *OpenStack) Name() string {
+	return "openstack"
+}
+
+// Setup does nothing. It satisfies the test framework Provider.
+func (o *OpenStack) Setup() {}
+
+// UpdateKubeConfig customizes generated kubeconfig for the provider.
+func (o *OpenStack) UpdateKubeConfig(content *[]byte, clusterName string) error {
+	return nil
+}
+
+// CleanupResources satisfies the test framework Provider. Servers are removed by CAPO on cluster deletion.
+func (o *OpenStack) CleanupResources(clusterName string) error {
+	return nil
+}
+
+// ClusterConfigUpdates satisfies the test framework Provider.
+func (o *OpenStack) ClusterConfigUpdates() []api.ClusterConfigFiller {
+	f := make([]api.ClusterFiller, 0, len(o.clusterFillers)+1)
+	f = append(f, o.clusterFillers...)
+	f = append(f, api.WithControlPlaneEndpointIP(o.controlPlaneEndpointIP))
+
+	return []api.ClusterConfigFiller{api.ClusterToConfigFiller(f...), api.OpenStackToConfigFiller(o.fillers...)}
+}
+
+// ClusterStateValidations returns a list of provider specific ClusterStateValidations.
+func (o *OpenStack) ClusterStateValidations() []clusterf.StateValidation {
+	return []clusterf.StateValidation{}
+}
+
+// WithKubeVersionAndOS returns a cluster config filler that sets the cluster kube version and the
+// image for all openstack machine configs. Only Ubuntu images are supported.
+func (o *OpenStack) WithKubeVersionAndOS(kubeVersion anywherev1.KubernetesVersion, os OS, _ *releasev1.EksARelease, _ ...string) api.ClusterConfigFiller {
+	if osFamiliesForOS[os] != anywherev1.Ubuntu {
+		o.t.Fatalf("OS %s is not supported for OpenStack", os)
+	}
+
+	return api.JoinClusterConfigFillers(
+		api.ClusterToConfigFiller(api.WithKubernetesVersion(kubeVersion)),
+		api.OpenStackToConfigFiller(
+			api.WithOpenStackStringFromEnvVar(openstackImageEnvVar(kubeVersion), api.WithOpenStackImage),
+		),
+	)
+}
+
+// WithNewWorkerNodeGroup returns an api.ClusterFiller that adds a new workerNodeGroupConfiguration and
+// a corresponding OpenStackMachineConfig to the cluster config.
+func (o *OpenStack) WithNewWorkerNodeGroup(name string, workerNodeGroup *WorkerNodeGroup) api.ClusterConfigFiller {
+	// TODO: Implement for OpenStack provider
+	panic("Not implemented for OpenStack yet")
+}
+
+func openstackImageEnvVar(kubeVersion anywherev1.KubernetesVersion) string {
+	return fmt.Sprintf(openstackImageUbuntuVarFmt, strings.ReplaceAll(string(kubeVersion), ".", "_"))
+}
@@ -0,0 +1,250 @@
+package framework
+
+import (
+	"context"
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+
+	"github.com/aws/eks-anywhere/pkg/templater"
+)
+
+const (
+	// CSIDriverManifestVar is the path to a manifest that installs a CSI driver and its storage class in the cluster.
+	CSIDriverManifestVar = "T_CSI_DRIVER_MANIFEST"
+	// CSIStorageClassVar is the name of the storage class used to provision the volumes of the persistent workload.
+	CSIStorageClassVar = "T_CSI_STORAGE_CLASS"
+
+	persistentWorkloadName      = "persistent-workload"
+	persistentWorkloadNamespace = "eksa-persistence-test"
+	persistentWorkloadPVC       = "data-" + persistentWorkloadName + "-0"
+	persistentWorkloadPod       = persistentWorkloadName + "-0"
+	persistentWorkloadTimeout   = "10m"
+)
+
+var persistentWorkloadRequiredEnvVars = []string{
+	CSIDriverManifestVar,
+	CSIStorageClassVar,
+}
+
+//go:embed testdata/persistent_workload.yaml
+var persistentWorkloadTemplate string
+
+// persistentWorkload is the state of the persistent workload recorded when it's created,
+// used to validate the same data and volume are available after the cluster is modified.
+type persistentWorkload struct {
+	volumeName string
+	checksum   string
+}
+
+// WithPersistentWorkload checks the env vars required to run a persistent workload
+// backed by CSI volumes are set.
+func WithPersistentWorkload() ClusterE2ETestOpt {
+	return func(e *ClusterE2ETest) {
+		checkRequiredEnvVars(e.T, persistentWorkloadRequiredEnvVars)
+	}
+}
+
+// InstallCSIDriver installs the CSI driver and storage class from the manifest in the T_CSI_DRIVER_MANIFEST env var.
+// CSI drivers are not managed by EKS Anywhere, so they need to be installed after the cluster is created.
+func (e *ClusterE2ETest) InstallCSIDriver() {
+	ctx := context.Background()
+	manifest := os.Getenv(CSIDriverManifestVar)
+	e.T.Logf("Installing CSI driver from %s", manifest)
+	if err := e.KubectlClient.ApplyManifest(ctx, e.KubeconfigFilePath(), manifest); err != nil {
+		e.T.Fatalf("Failed installing CSI driver: %v", err)
+	}
+}
+
+// CreatePersistentWorkload deploys a StatefulSet that writes random data to a CSI volume and records
+// the volume and the data checksum, so they can be validated by ValidatePersistentWorkload.
+func (e *ClusterE2ETest) CreatePersistentWorkload() {
+	ctx := context.Background()
+	data := map[string]string{
+		"name":         persistentWorkloadName,
+		"namespace":    persistentWorkloadNamespace,
+		"storageClass": os.Getenv(CSIStorageClassVar),
+	}
+
+	manifest, err := templater.Execute(persistentWorkloadTemplate, data)
+	if err != nil {
+		e.T.Fatalf("Failed generating persistent workload manifest: %v", err)
+	}
+
+	e.T.Log("Creating persistent workload")
+	if err := e.KubectlClient.ApplyKubeSpecFromBytes(ctx, e.Cluster(), manifest); err != nil {
+		e.T.Fatalf("Failed creating persistent workload: %v", err)
+	}
+
+	e.waitForPersistentWorkloadReady(ctx)
+
+	volumeName, err := e.persistentWorkloadVolumeName(ctx)
+	if err != nil {
+		e.T.Fatalf("Failed getting persistent workload volume: %v", err)
+	}
+
+	checksum, err := e.persistentWorkloadChecksum(ctx)
+	if err != nil {
+		e.T.Fatalf("Failed getting persistent workload data checksum: %v", err)
+	}
+
+	e.T.Logf("Persistent workload created with volume %s and data checksum %s", volumeName, checksum)
+	e.persistentWorkload = &persistentWorkload{
+		volumeName: volumeName,
+		checksum:   checksum,
+	}
+}
+
+// ValidatePersistentWorkload validates the persistent workload created by CreatePersistentWorkload
+// is running with the same volume, that the volume is attached to the node running it and that
+// the data written before is intact.
+func (e *ClusterE2ETest) ValidatePersistentWorkload() {
+	ctx := context.Background()
+	if e.persistentWorkload == nil {
+		e.T.Fatal("Persistent workload was not created, can't validate it")
+	}
+
+	e.T.Log("Validating persistent workload")
+	e.waitForPersistentWorkloadReady(ctx)
+
+	volumeName, err := e.persistentWorkloadVolumeName(ctx)
+	if err != nil {
+		e.T.Fatalf("Failed getting persistent workload volume: %v", err)
+	}
+	if volumeName != e.persistentWorkload.volumeName {
+		e.T.Fatalf("Persistent workload claim is bound to volume %s, expected %s", volumeName, e.persistentWorkload.volumeName)
+	}
+
+	if err := e.validatePersistentWorkloadVolumeAttached(ctx, volumeName); err != nil {
+		e.T.Fatalf("Failed validating persistent workload volume attachment: %v", err)
+	}
+
+	if _, err := e.execInPersistentWorkload(ctx, "sha256sum", "-c", "/data/payload.sha256"); err != nil {
+		e.T.Fatalf("Persistent workload data is corrupted: %v", err)
+	}
+
+	checksum, err := e.persistentWorkloadChecksum(ctx)
+	if err != nil {
+		e.T.Fatalf("Failed getting persistent workload data checksum: %v", err)
+	}
+	if checksum != e.persistentWorkload.checksum {
+		e.T.Fatalf("Persistent workload data checksum is %s, expected %s", checksum, e.persistentWorkload.checksum)
+	}
+
+	e.T.Log("Persistent workload data and volume are intact")
+}
+
+func (e *ClusterE2ETest) waitForPersistentWorkloadReady(ctx context.Context) {
+	err := e.KubectlClient.WaitJSONPathLoop(ctx, e.KubeconfigFilePath(), persistentWorkloadTimeout, "status.readyReplicas", "1",
+		"statefulset/"+persistentWorkloadName, persistentWorkloadNamespace)
+	if err != nil {
+		e.T.Fatalf("Failed waiting for persistent workload to be ready: %v", err)
+	}
+}
+
+func (e *ClusterE2ETest) persistentWorkloadVolumeName(ctx context.Context) (string, error) {
+	out, err := e.KubectlClient.ExecuteCommand(ctx, "get", "pvc", persistentWorkloadPVC, "-n", persistentWorkloadNamespace,
+		"-o", "json", "--kubeconfig", e.KubeconfigFilePath())
+	if err != nil {
+		return "", err
+	}
+
+	pvc := &corev1.PersistentVolumeClaim{}
+	if err := json.Unmarshal(out.Bytes(), pvc); err != nil {
+		return "", fmt.Errorf("parsing persistent volume claim: %v", err)
+	}
+	if pvc.Status.Phase != corev1.ClaimBound {
+		return "", fmt.Errorf("persistent volume claim %s is %s", persistentWorkloadPVC, pvc.Status.Phase)
+	}
+
+	return pvc.Spec.VolumeName, nil
+}
+
+func (e *ClusterE2ETest) persistentWorkloadChecksum(ctx context.Context) (string, error) {
+	out, err := e.execInPersistentWorkload(ctx, "cat", "/data/payload.sha256")
+	if err != nil {
+		return "", err
+	}
+
+	fields := strings.Fields(out)
+	if len(fields) == 0 {
+		return "", fmt.Errorf("checksum file is empty")
+	}
+
+	return fields[0], nil
+}
+
+// validatePersistentWorkloadVolumeAttached checks the volume is attached to the node the workload is running on.
+// CSI drivers that don't require attaching volumes don't create VolumeAttachments, so there's nothing to check for them.
+func (e *ClusterE2ETest) validatePersistentWorkloadVolumeAttached(ctx context.Context, volumeName string) error {
+	out, err := e.KubectlClient.ExecuteCommand(ctx, "get", "pv", volumeName, "-o", "json", "--kubeconfig", e.KubeconfigFilePath())
+	if err != nil {
+		return err
+	}
+	pv := &corev1.PersistentVolume{}
+	if err := json.Unmarshal(out.Bytes(), pv); err != nil {
+		return fmt.Errorf("parsing persistent volume: %v", err)
+	}
+	if pv.Spec.CSI == nil {
+		return fmt.Errorf("persistent volume %s is not provisioned by a CSI driver", volumeName)
+	}
+
+	out, err = e.KubectlClient.ExecuteCommand(ctx, "get", "csidriver", pv.Spec.CSI.Driver, "-o", "json", "--kubeconfig", e.KubeconfigFilePath())
+	if err != nil {
+		return err
+	}
+	driver := &storagev1.CSIDriver{}
+	if err := json.Unmarshal(out.Bytes(), driver); err != nil {
+		return fmt.Errorf("parsing CSI driver: %v", err)
+	}
+	if driver.Spec.AttachRequired != nil && !*driver.Spec.AttachRequired {
+		e.T.Logf("CSI driver %s doesn't attach volumes, skipping volume attachment validation", driver.Name)
+		return nil
+	}
+
+	out, err = e.KubectlClient.ExecuteCommand(ctx, "get", "pod", persistentWorkloadPod, "-n", persistentWorkloadNamespace,
+		"-o", "json", "--kubeconfig", e.KubeconfigFilePath())
+	if err != nil {
+		return err
+	}
+	pod := &corev1.Pod{}
+	if err := json.Unmarshal(out.Bytes(), pod); err != nil {
+		return fmt.Errorf("parsing pod: %v", err)
+	}
+
+	out, err = e.KubectlClient.ExecuteCommand(ctx, "get", "volumeattachments", "-o", "json", "--kubeconfig", e.KubeconfigFilePath())
+	if err != nil {
+		return err
+	}
+	attachments := &storagev1.VolumeAttachmentList{}
+	if err := json.Unmarshal(out.Bytes(), attachments); err != nil {
+		return fmt.Errorf("parsing volume attachments: %v", err)
+	}
+
+	for _, a := range attachments.Items {
+		if a.Spec.Source.PersistentVolumeName == nil || *a.Spec.Source.PersistentVolumeName != volumeName {
+			continue
+		}
+		if a.Spec.NodeName == pod.Spec.NodeName && a.Status.Attached {
+			e.T.Logf("Persistent volume %s is attached to node %s", volumeName, pod.Spec.NodeName)
+			return nil
+		}
+	}
+
+	return fmt.Errorf("persistent volume %s is not attached to node %s", volumeName, pod.Spec.NodeName)
+}
+
+func (e *ClusterE2ETest) execInPersistentWorkload(ctx context.Context, command ...string) (string, error) {
+	params := []string{"exec", persistentWorkloadPod, "-n", persistentWorkloadNamespace, "--kubeconfig", e.KubeconfigFilePath(), "--"}
+	out, err := e.KubectlClient.ExecuteCommand(ctx, append(params, command...)...)
+	if err != nil {
+		return "", err
+	}
+
+	return out.String(), nil
+}
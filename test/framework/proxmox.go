@@ -0,0 +1,150 @@
+package framework
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/aws/eks-anywhere/internal/pkg/api"
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/constants"
+	releasev1 "github.com/aws/eks-anywhere/release/api/v1alpha1"
+	clusterf "github.com/aws/eks-anywhere/test/framework/cluster"
+)
+
+const (
+	proxmoxEndpoint                 = "T_PROXMOX_ENDPOINT"
+	proxmoxInsecure                 = "T_PROXMOX_INSECURE"
+	proxmoxNodes                    = "T_PROXMOX_NODES"
+	proxmoxSourceNode               = "T_PROXMOX_SOURCE_NODE"
+	proxmoxStorage                  = "T_PROXMOX_STORAGE"
+	proxmoxBridge                   = "T_PROXMOX_BRIDGE"
+	proxmoxIPv4Addresses            = "T_PROXMOX_IPV4_ADDRESSES"
+	proxmoxIPv4Prefix               = "T_PROXMOX_IPV4_PREFIX"
+	proxmoxIPv4Gateway              = "T_PROXMOX_IPV4_GATEWAY"
+	proxmoxDNSServers               = "T_PROXMOX_DNS_SERVERS"
+	proxmoxSSHAuthorizedKey         = "T_PROXMOX_SSH_AUTHORIZED_KEY"
+	proxmoxControlPlaneEndpointIP   = "T_PROXMOX_CONTROL_PLANE_ENDPOINT_IP"
+	proxmoxTemplateIDUbuntuVarFmt   = "T_PROXMOX_TEMPLATE_ID_UBUNTU_%s"
+	proxmoxDefaultTemplateIDVersion = anywherev1.Kube134
+)
+
+var requiredProxmoxEnvVars = []string{
+	constants.EksaProxmoxTokenIDKey,
+	constants.EksaProxmoxTokenSecretKey,
+	proxmoxEndpoint,
+	proxmoxSourceNode,
+	proxmoxBridge,
+	proxmoxIPv4Addresses,
+	proxmoxIPv4Prefix,
+	proxmoxIPv4Gateway,
+	proxmoxDNSServers,
+	proxmoxSSHAuthorizedKey,
+	proxmoxControlPlaneEndpointIP,
+	proxmoxTemplateIDEnvVar(proxmoxDefaultTemplateIDVersion),
+}
+
+// Proxmox is a test framework Provider for Proxmox VE.
+type Proxmox struct {
+	t                      *testing.T
+	fillers                []api.ProxmoxFiller
+	clusterFillers         []api.ClusterFiller
+	controlPlaneEndpointIP string
+}
+
+// ProxmoxOpt configures a Proxmox test provider.
+type ProxmoxOpt func(*Proxmox)
+
+// NewProxmox creates a Proxmox test provider configured from the T_PROXMOX_* environment variables.
+func NewProxmox(t *testing.T, opts ...ProxmoxOpt) *Proxmox {
+	checkRequiredEnvVars(t, requiredProxmoxEnvVars)
+
+	p := &Proxmox{
+		t: t,
+		fillers: []api.ProxmoxFiller{
+			api.WithProxmoxStringFromEnvVar(proxmoxEndpoint, api.WithProxmoxEndpoint),
+			api.WithProxmoxBoolFromEnvVar(proxmoxInsecure, api.WithProxmoxInsecure),
+			api.WithProxmoxStringFromEnvVar(proxmoxNodes, api.WithProxmoxNodes),
+			api.WithProxmoxStringFromEnvVar(proxmoxIPv4Addresses, api.WithProxmoxIPv4Addresses),
+			api.WithProxmoxIntFromEnvVar(proxmoxIPv4Prefix, api.WithProxmoxIPv4Prefix),
+			api.WithProxmoxStringFromEnvVar(proxmoxIPv4Gateway, api.WithProxmoxIPv4Gateway),
+			api.WithProxmoxStringFromEnvVar(proxmoxDNSServers, api.WithProxmoxDNSServers),
+			api.WithProxmoxStringFromEnvVar(proxmoxSourceNode, api.WithProxmoxSourceNode),
+			api.WithProxmoxStringFromEnvVar(proxmoxStorage, api.WithProxmoxStorage),
+			api.WithProxmoxStringFromEnvVar(proxmoxBridge, api.WithProxmoxBridge),
+			api.WithProxmoxStringFromEnvVar(proxmoxSSHAuthorizedKey, api.WithProxmoxSSHAuthorizedKey),
+			api.WithProxmoxIntFromEnvVar(proxmoxTemplateIDEnvVar(proxmoxDefaultTemplateIDVersion), api.WithProxmoxTemplateID),
+		},
+		controlPlaneEndpointIP: os.Getenv(proxmoxControlPlaneEndpointIP),
+	}
+
+	for _, opt := range opts {
+		opt(p)
+	}
+
+	return p
+}
+
+// RequiredProxmoxEnvVars returns a list of environment variables needed for Proxmox tests.
+func RequiredProxmoxEnvVars() []string {
+	return requiredProxmoxEnvVars
+}
+
+// Name returns the provider name. It satisfies the test framework Provider.
+func (p *Proxmox) Name() string {
+	return "proxmox"
+}
+
+// Setup does nothing. It satisfies the test framework Provider.
+func (p *Proxmox) Setup() {}
+
+// UpdateKubeConfig customizes generated kubeconfig for the provider.
+func (p *Proxmox) UpdateKubeConfig(content *[]byte, clusterName string) error {
+	return nil
+}
+
+// CleanupResources satisfies the test framework Provider. VMs are removed by CAPMOX on cluster deletion.
+func (p *Proxmox) CleanupResources(clusterName string) error {
+	return nil
+}
+
+// ClusterConfigUpdates satisfies the test framework Provider.
+func (p *Proxmox) ClusterConfigUpdates() []api.ClusterConfigFiller {
+	f := make([]api.ClusterFiller, 0, len(p.clusterFillers)+1)
+	f = append(f, p.clusterFillers...)
+	f = append(f, api.WithControlPlaneEndpointIP(p.controlPlaneEndpointIP))
+
+	return []api.ClusterConfigFiller{api.ClusterToConfigFiller(f...), api.ProxmoxToConfigFiller(p.fillers...)}
+}
+
+// ClusterStateValidations returns a list of provider specific ClusterStateValidations.
+func (p *Proxmox) ClusterStateValidations() []clusterf.StateValidation {
+	return []clusterf.StateValidation{}
+}
+
+// WithKubeVersionAndOS returns a cluster config filler that sets the cluster kube version and the
+// VM template ID for all proxmox machine configs. Only Ubuntu templates are supported.
+func (p *Proxmox) WithKubeVersionAndOS(kubeVersion anywherev1.KubernetesVersion, os OS, _ *releasev1.EksARelease, _ ...string) api.ClusterConfigFiller {
+	if osFamiliesForOS[os] != anywherev1.Ubuntu {
+		p.t.Fatalf("OS %s is not supported for Proxmox", os)
+	}
+
+	return api.JoinClusterConfigFillers(
+		api.ClusterToConfigFiller(api.WithKubernetesVersion(kubeVersion)),
+		api.ProxmoxToConfigFiller(
+			api.WithProxmoxIntFromEnvVar(proxmoxTemplateIDEnvVar(kubeVersion), api.WithProxmoxTemplateID),
+		),
+	)
+}
+
+// WithNewWorkerNodeGroup returns an api.ClusterFiller that adds a new workerNodeGroupConfiguration and
+// a corresponding ProxmoxMachineConfig to the cluster config.
+func (p *Proxmox) WithNewWorkerNodeGroup(name string, workerNodeGroup *WorkerNodeGroup) api.ClusterConfigFiller {
+	// TODO: Implement for Proxmox provider
+	panic("Not implemented for Proxmox yet")
+}
+
+func proxmoxTemplateIDEnvVar(kubeVersion anywherev1.KubernetesVersion) string {
+	return fmt.Sprintf(proxmoxTemplateIDUbuntuVarFmt, strings.ReplaceAll(string(kubeVersion), ".", "_"))
+}
@@ -0,0 +1,355 @@
+package framework
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"sync"
+	"time"
+
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	clusterv1beta2 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/aws/eks-anywhere/internal/pkg/api"
+	"github.com/aws/eks-anywhere/pkg/clusterapi"
+	"github.com/aws/eks-anywhere/pkg/constants"
+	"github.com/aws/eks-anywhere/pkg/retrier"
+)
+
+const (
+	scaleTestNodeCountVar = "T_SCALE_TEST_NODE_COUNT"
+
+	defaultScaleTestNodeCount           = 10
+	defaultScaleTestHealthCheckInterval = 10 * time.Second
+	defaultScaleTestTimeout             = time.Hour
+)
+
+// ScaleTest provisions a cluster, scales one of its worker node groups and records
+// how long the new machines take to be ready and how the API server and etcd behave meanwhile.
+type ScaleTest struct {
+	*ClusterE2ETest
+	// WorkerNodeGroupName is the worker node group to scale. Defaults to the first worker node group.
+	WorkerNodeGroupName string
+	// NodeCount is the number of nodes the worker node group is scaled to.
+	NodeCount int
+	// HealthCheckInterval is how often the API server and etcd health is checked while scaling.
+	HealthCheckInterval time.Duration
+	// Timeout is how long to wait for all the machines of the worker node group to be ready.
+	Timeout time.Duration
+	// ReportPath is the file the benchmark report is written to.
+	ReportPath string
+}
+
+// ScaleTestOpt configures a ScaleTest.
+type ScaleTestOpt func(*ScaleTest)
+
+// NewScaleTest returns a ScaleTest that scales a worker node group of the given cluster.
+// The node count defaults to the T_SCALE_TEST_NODE_COUNT env var, or 10 if it's not set.
+func NewScaleTest(test *ClusterE2ETest, opts ...ScaleTestOpt) *ScaleTest {
+	nodeCount, err := strconv.Atoi(getEnvWithDefault(scaleTestNodeCountVar, strconv.Itoa(defaultScaleTestNodeCount)))
+	if err != nil {
+		test.T.Fatalf("Invalid %s env var: %v", scaleTestNodeCountVar, err)
+	}
+
+	s := &ScaleTest{
+		ClusterE2ETest:      test,
+		NodeCount:           nodeCount,
+		HealthCheckInterval: defaultScaleTestHealthCheckInterval,
+		Timeout:             defaultScaleTestTimeout,
+		ReportPath:          filepath.Join(test.ClusterConfigFolder, test.ClusterName+"-scale-report.json"),
+	}
+
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	return s
+}
+
+// WithScaleTestNodeCount sets the number of nodes the worker node group is scaled to.
+func WithScaleTestNodeCount(count int) ScaleTestOpt {
+	return func(s *ScaleTest) {
+		s.NodeCount = count
+	}
+}
+
+// WithScaleTestWorkerNodeGroup sets the worker node group to scale.
+func WithScaleTestWorkerNodeGroup(name string) ScaleTestOpt {
+	return func(s *ScaleTest) {
+		s.WorkerNodeGroupName = name
+	}
+}
+
+// WithScaleTestHealthCheckInterval sets how often the API server and etcd health is checked while scaling.
+func WithScaleTestHealthCheckInterval(interval time.Duration) ScaleTestOpt {
+	return func(s *ScaleTest) {
+		s.HealthCheckInterval = interval
+	}
+}
+
+// WithScaleTestTimeout sets how long to wait for all the machines of the worker node group to be ready.
+func WithScaleTestTimeout(timeout time.Duration) ScaleTestOpt {
+	return func(s *ScaleTest) {
+		s.Timeout = timeout
+	}
+}
+
+// WithScaleTestReportPath sets the file the benchmark report is written to.
+func WithScaleTestReportPath(path string) ScaleTestOpt {
+	return func(s *ScaleTest) {
+		s.ReportPath = path
+	}
+}
+
+// ScaleTestReport is the benchmark report of a ScaleTest.
+type ScaleTestReport struct {
+	ClusterName     string `json:"clusterName"`
+	WorkerNodeGroup string `json:"workerNodeGroup"`
+	NodeCount       int    `json:"nodeCount"`
+	// ScaleDurationSeconds is the time it took for the scale operation to complete.
+	ScaleDurationSeconds float64 `json:"scaleDurationSeconds"`
+	// MachineReady holds the time, in seconds, between the creation of each new machine and its node being ready.
+	MachineReady LatencyPercentiles `json:"machineReady"`
+	APIServer    HealthCheckReport  `json:"apiServer"`
+	Etcd         HealthCheckReport  `json:"etcd"`
+}
+
+// LatencyPercentiles summarizes a set of latencies, in seconds.
+type LatencyPercentiles struct {
+	Count int     `json:"count"`
+	P50   float64 `json:"p50"`
+	P90   float64 `json:"p90"`
+	P99   float64 `json:"p99"`
+	Max   float64 `json:"max"`
+}
+
+// HealthCheckReport summarizes the health checks of a component run while scaling.
+type HealthCheckReport struct {
+	Checks   int `json:"checks"`
+	Failures int `json:"failures"`
+	// Latency holds the response time of the health checks, in seconds.
+	Latency LatencyPercentiles `json:"latency"`
+}
+
+// Run provisions the cluster, scales the worker node group, writes the benchmark report and deletes the cluster.
+func (s *ScaleTest) Run() {
+	s.GenerateClusterConfig()
+	s.CreateCluster()
+	s.ScaleWorkerNodeGroup()
+	s.StopIfFailed()
+	s.DeleteCluster()
+}
+
+// ScaleWorkerNodeGroup scales the worker node group to NodeCount nodes, measuring the machines ready latency
+// and the API server and etcd health until the cluster upgrade completes. It writes the report to ReportPath.
+func (s *ScaleTest) ScaleWorkerNodeGroup() *ScaleTestReport {
+	workerNodeGroupName := s.WorkerNodeGroupName
+	if workerNodeGroupName == "" {
+		workerNodeGroupName = s.ClusterConfig.Cluster.Spec.WorkerNodeGroupConfigurations[0].Name
+	}
+
+	ctx := context.Background()
+	managementClusterClient, err := buildClusterClient(s.managementKubeconfigFilePath())
+	if err != nil {
+		s.T.Fatalf("Error building management cluster client: %v", err)
+	}
+
+	existingMachines, err := s.workerNodeGroupMachines(ctx, managementClusterClient, workerNodeGroupName)
+	if err != nil {
+		s.T.Fatalf("Error listing machines of worker node group %s: %v", workerNodeGroupName, err)
+	}
+
+	healthCtx, stopHealthChecks := context.WithCancel(ctx)
+	apiServer := &healthCheckRecorder{}
+	etcd := &healthCheckRecorder{}
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		s.runHealthChecks(healthCtx, apiServer, etcd)
+	}()
+
+	s.T.Logf("Scaling worker node group %s to %d nodes", workerNodeGroupName, s.NodeCount)
+	start := time.Now()
+	s.UpgradeClusterWithNewConfig([]ClusterE2ETestOpt{
+		WithClusterUpgrade(api.WithWorkerNodeGroup(workerNodeGroupName, api.WithCount(s.NodeCount))),
+	})
+	latencies, err := s.waitForMachinesReady(ctx, managementClusterClient, workerNodeGroupName, existingMachines)
+	scaleDuration := time.Since(start)
+	stopHealthChecks()
+	wg.Wait()
+	if err != nil {
+		s.T.Fatalf("Error waiting for worker node group %s machines to be ready: %v", workerNodeGroupName, err)
+	}
+
+	report := &ScaleTestReport{
+		ClusterName:          s.ClusterName,
+		WorkerNodeGroup:      workerNodeGroupName,
+		NodeCount:            s.NodeCount,
+		ScaleDurationSeconds: scaleDuration.Seconds(),
+		MachineReady:         newLatencyPercentiles(latencies),
+		APIServer:            apiServer.report(),
+		Etcd:                 etcd.report(),
+	}
+	s.writeReport(report)
+
+	return report
+}
+
+func (s *ScaleTest) workerNodeGroupMachines(ctx context.Context, c client.Client, workerNodeGroupName string) ([]clusterv1beta2.Machine, error) {
+	var machineDeploymentNames []string
+	for _, w := range s.ClusterConfig.Cluster.Spec.WorkerNodeGroupConfigurations {
+		if w.Name == workerNodeGroupName {
+			machineDeploymentNames = clusterapi.MachineDeploymentNames(s.ClusterConfig.Cluster, w)
+		}
+	}
+
+	machines := &clusterv1beta2.MachineList{}
+	if err := c.List(ctx, machines, client.InNamespace(constants.EksaSystemNamespace), client.MatchingLabels{
+		clusterv1beta2.ClusterNameLabel: s.ClusterName,
+	}); err != nil {
+		return nil, err
+	}
+
+	groupMachines := make([]clusterv1beta2.Machine, 0, len(machines.Items))
+	for _, m := range machines.Items {
+		if slices.Contains(machineDeploymentNames, m.Labels[clusterv1beta2.MachineDeploymentNameLabel]) {
+			groupMachines = append(groupMachines, m)
+		}
+	}
+
+	return groupMachines, nil
+}
+
+// waitForMachinesReady waits until the worker node group has NodeCount ready machines and returns,
+// for the machines created after the scale started, the time it took for their nodes to be ready.
+func (s *ScaleTest) waitForMachinesReady(ctx context.Context, c client.Client, workerNodeGroupName string, existing []clusterv1beta2.Machine) ([]time.Duration, error) {
+	existingNames := make(map[string]struct{}, len(existing))
+	for _, m := range existing {
+		existingNames[m.Name] = struct{}{}
+	}
+
+	var latencies []time.Duration
+	err := retrier.New(s.Timeout, retrier.WithRetryPolicy(retrier.BackOffPolicy(s.HealthCheckInterval))).Retry(func() error {
+		machines, err := s.workerNodeGroupMachines(ctx, c, workerNodeGroupName)
+		if err != nil {
+			return err
+		}
+
+		latencies = latencies[:0]
+		ready := 0
+		for _, m := range machines {
+			condition := apimeta.FindStatusCondition(m.Status.Conditions, clusterv1beta2.MachineNodeReadyCondition)
+			if condition == nil || condition.Status != "True" {
+				continue
+			}
+			ready++
+			if _, ok := existingNames[m.Name]; ok {
+				continue
+			}
+			latencies = append(latencies, condition.LastTransitionTime.Sub(m.CreationTimestamp.Time))
+		}
+
+		if ready < s.NodeCount {
+			return fmt.Errorf("%d of %d machines are ready", ready, s.NodeCount)
+		}
+
+		return nil
+	})
+
+	return latencies, err
+}
+
+func (s *ScaleTest) runHealthChecks(ctx context.Context, apiServer, etcd *healthCheckRecorder) {
+	ticker := time.NewTicker(s.HealthCheckInterval)
+	defer ticker.Stop()
+	for {
+		s.checkHealth(ctx, "/readyz", apiServer)
+		s.checkHealth(ctx, "/readyz/etcd", etcd)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *ScaleTest) checkHealth(ctx context.Context, path string, recorder *healthCheckRecorder) {
+	if ctx.Err() != nil {
+		return
+	}
+	start := time.Now()
+	_, err := s.KubectlClient.ExecuteCommand(ctx, "get", "--raw", path, "--kubeconfig", s.KubeconfigFilePath())
+	if ctx.Err() != nil {
+		return
+	}
+	if err != nil {
+		s.T.Logf("Health check %s failed: %v", path, err)
+	}
+	recorder.record(time.Since(start), err)
+}
+
+func (s *ScaleTest) writeReport(report *ScaleTestReport) {
+	content, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		s.T.Fatalf("Error marshalling scale test report: %v", err)
+	}
+
+	s.T.Logf("Scale test report:\n%s", content)
+	if err := os.WriteFile(s.ReportPath, content, 0o644); err != nil {
+		s.T.Fatalf("Error writing scale test report to %s: %v", s.ReportPath, err)
+	}
+	s.T.Logf("Scale test report written to %s", s.ReportPath)
+}
+
+type healthCheckRecorder struct {
+	latencies []time.Duration
+	failures  int
+}
+
+func (r *healthCheckRecorder) record(latency time.Duration, err error) {
+	r.latencies = append(r.latencies, latency)
+	if err != nil {
+		r.failures++
+	}
+}
+
+func (r *healthCheckRecorder) report() HealthCheckReport {
+	return HealthCheckReport{
+		Checks:   len(r.latencies),
+		Failures: r.failures,
+		Latency:  newLatencyPercentiles(r.latencies),
+	}
+}
+
+func newLatencyPercentiles(latencies []time.Duration) LatencyPercentiles {
+	if len(latencies) == 0 {
+		return LatencyPercentiles{}
+	}
+
+	sorted := slices.Clone(latencies)
+	slices.Sort(sorted)
+
+	return LatencyPercentiles{
+		Count: len(sorted),
+		P50:   percentile(sorted, 50).Seconds(),
+		P90:   percentile(sorted, 90).Seconds(),
+		P99:   percentile(sorted, 99).Seconds(),
+		Max:   sorted[len(sorted)-1].Seconds(),
+	}
+}
+
+// percentile returns the nearest-rank percentile p of the sorted latencies.
+func percentile(sorted []time.Duration, p float64) time.Duration {
+	rank := int(math.Ceil(p / 100 * float64(len(sorted))))
+	if rank < 1 {
+		rank = 1
+	}
+	return sorted[rank-1]
+}
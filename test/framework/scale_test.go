@@ -0,0 +1,55 @@
+package framework
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestNewLatencyPercentiles(t *testing.T) {
+	tests := []struct {
+		name      string
+		latencies []time.Duration
+		want      LatencyPercentiles
+	}{
+		{
+			name: "no latencies",
+			want: LatencyPercentiles{},
+		},
+		{
+			name:      "single latency",
+			latencies: []time.Duration{3 * time.Second},
+			want:      LatencyPercentiles{Count: 1, P50: 3, P90: 3, P99: 3, Max: 3},
+		},
+		{
+			name: "unsorted latencies",
+			latencies: []time.Duration{
+				10 * time.Second, 1 * time.Second, 9 * time.Second, 2 * time.Second, 8 * time.Second,
+				3 * time.Second, 7 * time.Second, 4 * time.Second, 6 * time.Second, 5 * time.Second,
+			},
+			want: LatencyPercentiles{Count: 10, P50: 5, P90: 9, P99: 10, Max: 10},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(newLatencyPercentiles(tt.latencies)).To(Equal(tt.want))
+		})
+	}
+}
+
+func TestHealthCheckRecorderReport(t *testing.T) {
+	g := NewWithT(t)
+	r := &healthCheckRecorder{}
+	r.record(time.Second, nil)
+	r.record(2*time.Second, errors.New("etcd not ready"))
+	r.record(4*time.Second, nil)
+
+	g.Expect(r.report()).To(Equal(HealthCheckReport{
+		Checks:   3,
+		Failures: 1,
+		Latency:  LatencyPercentiles{Count: 3, P50: 2, P90: 4, P99: 4, Max: 4},
+	}))
+}